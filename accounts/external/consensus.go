@@ -0,0 +1,92 @@
+package external
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/common/hexutil"
+	"github.com/autonity/autonity/consensus/tendermint/core/message"
+	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/crypto/blst"
+	"github.com/autonity/autonity/rpc"
+)
+
+// consensusSignTimeout bounds the time spent waiting on the external signer for a consensus message signature, so
+// that an unresponsive signer only costs the local validator a vote.
+const consensusSignTimeout = 2 * time.Second
+
+// ConsensusSigner delegates the signatures made with the consensus key of a validator to an external signer (clef).
+type ConsensusSigner struct {
+	client    *rpc.Client
+	endpoint  string
+	publicKey blst.PublicKey
+}
+
+// NewConsensusSigner connects to the external signer and retrieves the consensus key it holds.
+func NewConsensusSigner(endpoint string) (*ConsensusSigner, error) {
+	client, err := rpc.Dial(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	var key hexutil.Bytes
+	if err := client.Call(&key, "account_consensusKey"); err != nil {
+		client.Close()
+		return nil, err
+	}
+	publicKey, err := blst.PublicKeyFromBytes(key)
+	if err != nil {
+		client.Close()
+		return nil, fmt.Errorf("invalid consensus key from external signer: %w", err)
+	}
+	return &ConsensusSigner{client: client, endpoint: endpoint, publicKey: publicKey}, nil
+}
+
+// PublicKey returns the consensus key held by the external signer.
+func (s *ConsensusSigner) PublicKey() blst.PublicKey {
+	return s.publicKey
+}
+
+// SignConsensusMessage requests the signature of a consensus message. The signature is checked before being returned.
+func (s *ConsensusSigner) SignConsensusMessage(request *message.SignatureRequest) (blst.Signature, error) {
+	signatureInput, err := request.SignatureInput()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), consensusSignTimeout)
+	defer cancel()
+	var res hexutil.Bytes
+	if err := s.client.CallContext(ctx, &res, "account_signConsensusMessage", request); err != nil {
+		return nil, err
+	}
+	signature, err := blst.SignatureFromBytes(res)
+	if err != nil {
+		return nil, err
+	}
+	if !signature.Verify(s.publicKey, signatureInput[:]) {
+		return nil, fmt.Errorf("invalid signature from external signer %s", s.endpoint)
+	}
+	return signature, nil
+}
+
+// SignOwnershipProof requests the proof of possession of the consensus key for treasury, see crypto.BLSPOPProof.
+func (s *ConsensusSigner) SignOwnershipProof(treasury []byte) ([]byte, error) {
+	var res hexutil.Bytes
+	if err := s.client.Call(&res, "account_signOwnershipProof", common.BytesToAddress(treasury)); err != nil {
+		return nil, err
+	}
+	signature, err := blst.SignatureFromBytes(res)
+	if err != nil {
+		return nil, err
+	}
+	if err := crypto.BLSPOPVerify(s.publicKey, signature, treasury); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Close closes the connection to the external signer.
+func (s *ConsensusSigner) Close() {
+	s.client.Close()
+}
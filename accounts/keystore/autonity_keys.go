@@ -0,0 +1,136 @@
+package keystore
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/google/uuid"
+
+	"github.com/autonity/autonity/common/math"
+	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/crypto/blst"
+)
+
+// ErrNotEncryptedAutonityKeys is returned when trying to decrypt an autonity keys file stored in plain text.
+var ErrNotEncryptedAutonityKeys = errors.New("autonity keys file is not encrypted")
+
+// encryptedAutonityKeysJSON is the keystore-v3 style encoding of the node key and the consensus key. The public
+// identities of the keys are kept in clear so that the file can be recognised without the passphrase.
+type encryptedAutonityKeysJSON struct {
+	Address      string     `json:"address"`
+	ConsensusKey string     `json:"consensusKey"`
+	Crypto       CryptoJSON `json:"crypto"`
+	Id           string     `json:"id"`
+	Version      int        `json:"version"`
+}
+
+// EncryptAutonityKeys encrypts the node key and the consensus key using the specified scrypt parameters into a json
+// blob that can be decrypted later on.
+func EncryptAutonityKeys(nodeKey *ecdsa.PrivateKey, consensusKey blst.SecretKey, auth string, scryptN, scryptP int) ([]byte, error) {
+	keyBytes := append(math.PaddedBigBytes(nodeKey.D, crypto.ECDSAKeyLen), consensusKey.Marshal()...)
+	cryptoStruct, err := EncryptDataV3(keyBytes, []byte(auth), scryptN, scryptP)
+	if err != nil {
+		return nil, err
+	}
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+	address := crypto.PubkeyToAddress(nodeKey.PublicKey)
+	return json.Marshal(encryptedAutonityKeysJSON{
+		Address:      hex.EncodeToString(address[:]),
+		ConsensusKey: hex.EncodeToString(consensusKey.PublicKey().Marshal()),
+		Crypto:       cryptoStruct,
+		Id:           id.String(),
+		Version:      version,
+	})
+}
+
+// DecryptAutonityKeys decrypts the node key and the consensus key from a json blob.
+func DecryptAutonityKeys(keyjson []byte, auth string) (*ecdsa.PrivateKey, blst.SecretKey, error) {
+	k := new(encryptedAutonityKeysJSON)
+	if err := json.Unmarshal(keyjson, k); err != nil {
+		return nil, nil, err
+	}
+	if k.Version != version {
+		return nil, nil, fmt.Errorf("version not supported: %v", k.Version)
+	}
+	keyBytes, err := DecryptDataV3(k.Crypto, auth)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(keyBytes) != crypto.AutonityKeysLen {
+		return nil, nil, fmt.Errorf("invalid decrypted key length %d", len(keyBytes))
+	}
+	nodeKey, err := crypto.ToECDSA(keyBytes[:crypto.ECDSAKeyLen])
+	if err != nil {
+		return nil, nil, err
+	}
+	consensusKey, err := blst.SecretKeyFromBytes(keyBytes[crypto.ECDSAKeyLen:])
+	if err != nil {
+		return nil, nil, err
+	}
+	// make sure the clear text identities were not tampered with
+	address := crypto.PubkeyToAddress(nodeKey.PublicKey)
+	if k.Address != hex.EncodeToString(address[:]) || k.ConsensusKey != hex.EncodeToString(consensusKey.PublicKey().Marshal()) {
+		return nil, nil, fmt.Errorf("key content mismatch: have node address %x, want %s", address, k.Address)
+	}
+	return nodeKey, consensusKey, nil
+}
+
+// IsEncryptedAutonityKeys reports whether the autonity keys file is stored in the encrypted json format rather than
+// the plain text hex format generated by crypto.SaveAutonityKeys.
+func IsEncryptedAutonityKeys(file string) (bool, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return false, err
+	}
+	return bytes.HasPrefix(bytes.TrimSpace(content), []byte("{")), nil
+}
+
+// StoreAutonityKeys encrypts the node key and the consensus key with the passphrase and atomically writes them to
+// file with restrictive permissions.
+func StoreAutonityKeys(file string, nodeKey *ecdsa.PrivateKey, consensusKey blst.SecretKey, auth string, scryptN, scryptP int) error {
+	keyjson, err := EncryptAutonityKeys(nodeKey, consensusKey, auth, scryptN, scryptP)
+	if err != nil {
+		return err
+	}
+	return writeKeyFile(file, keyjson)
+}
+
+// LoadAutonityKeys decrypts the node key and the consensus key from an encrypted autonity keys file.
+func LoadAutonityKeys(file string, auth string) (*ecdsa.PrivateKey, blst.SecretKey, error) {
+	encrypted, err := IsEncryptedAutonityKeys(file)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !encrypted {
+		return nil, nil, ErrNotEncryptedAutonityKeys
+	}
+	keyjson, err := os.ReadFile(file)
+	if err != nil {
+		return nil, nil, err
+	}
+	return DecryptAutonityKeys(keyjson, auth)
+}
+
+// EncryptAutonityKeysFile converts a plain text autonity keys file into the encrypted format in place.
+func EncryptAutonityKeysFile(file string, auth string, scryptN, scryptP int) error {
+	encrypted, err := IsEncryptedAutonityKeys(file)
+	if err != nil {
+		return err
+	}
+	if encrypted {
+		return errors.New("autonity keys file is already encrypted")
+	}
+	nodeKey, consensusKey, err := crypto.LoadAutonityKeys(file)
+	if err != nil {
+		return err
+	}
+	return StoreAutonityKeys(file, nodeKey, consensusKey, auth, scryptN, scryptP)
+}
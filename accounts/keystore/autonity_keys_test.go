@@ -0,0 +1,57 @@
+package keystore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/autonity/autonity/crypto"
+)
+
+func TestAutonityKeysEncryptDecrypt(t *testing.T) {
+	nodeKey, consensusKey, err := crypto.GenAutonityKeys()
+	require.NoError(t, err)
+
+	keyjson, err := EncryptAutonityKeys(nodeKey, consensusKey, "foo", veryLightScryptN, veryLightScryptP)
+	require.NoError(t, err)
+
+	_, _, err = DecryptAutonityKeys(keyjson, "bar")
+	require.ErrorIs(t, err, ErrDecrypt)
+
+	decryptedNodeKey, decryptedConsensusKey, err := DecryptAutonityKeys(keyjson, "foo")
+	require.NoError(t, err)
+	require.Equal(t, crypto.FromECDSA(nodeKey), crypto.FromECDSA(decryptedNodeKey))
+	require.Equal(t, consensusKey.Marshal(), decryptedConsensusKey.Marshal())
+}
+
+func TestEncryptAutonityKeysFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "autonitykeys")
+	nodeKey, consensusKey, err := crypto.GenAutonityKeys()
+	require.NoError(t, err)
+	require.NoError(t, crypto.SaveAutonityKeys(file, nodeKey, consensusKey))
+
+	encrypted, err := IsEncryptedAutonityKeys(file)
+	require.NoError(t, err)
+	require.False(t, encrypted)
+	_, _, err = LoadAutonityKeys(file, "foo")
+	require.ErrorIs(t, err, ErrNotEncryptedAutonityKeys)
+
+	// convert the plain text file in place
+	require.NoError(t, EncryptAutonityKeysFile(file, "foo", veryLightScryptN, veryLightScryptP))
+	encrypted, err = IsEncryptedAutonityKeys(file)
+	require.NoError(t, err)
+	require.True(t, encrypted)
+	require.Error(t, EncryptAutonityKeysFile(file, "foo", veryLightScryptN, veryLightScryptP))
+
+	// the private keys must not be readable anymore
+	content, err := os.ReadFile(file)
+	require.NoError(t, err)
+	require.NotContains(t, string(content), consensusKey.Hex()[2:])
+
+	loadedNodeKey, loadedConsensusKey, err := LoadAutonityKeys(file, "foo")
+	require.NoError(t, err)
+	require.Equal(t, crypto.FromECDSA(nodeKey), crypto.FromECDSA(loadedNodeKey))
+	require.Equal(t, consensusKey.Marshal(), loadedConsensusKey.Marshal())
+}
@@ -36,6 +36,7 @@ var (
 	ErrAutonityContract = errors.New("could not call Autonity contract")
 	ErrWrongParameter   = errors.New("wrong parameter")
 	ErrNoAutonityConfig = errors.New("autonity section missing from genesis")
+	ErrEpochNotEnded    = errors.New("epoch did not end")
 )
 
 // EVMProvider provides a new evm. This allows us to decouple the contract from *core.blockchain which is required to build a new evm.
@@ -74,6 +75,11 @@ type Cache struct {
 	epochPeriodCh  chan *AutonityEpochPeriodUpdated
 	subEpochPeriod event.Subscription
 
+	// consensus timeouts
+	consensusTimeouts    atomic.Pointer[consensusTimeouts]
+	consensusTimeoutsCh  chan *AutonityConsensusTimeoutsUpdated
+	subConsensusTimeouts event.Subscription
+
 	subscriptions *event.SubscriptionScope
 	quit          chan struct{}
 	done          chan struct{}
@@ -102,22 +108,34 @@ func newCache(ac *AutonityContract, head *types.Header, state vm.StateDB) (*Cach
 		return nil, err
 	}
 
+	// initialize consensus timeouts and subscribe to updated event
+	timeouts := ac.loadConsensusTimeouts(head, state)
+	consensusTimeoutsCh := make(chan *AutonityConsensusTimeoutsUpdated)
+	subConsensusTimeouts, err := ac.WatchConsensusTimeoutsUpdated(nil, consensusTimeoutsCh)
+	if err != nil {
+		return nil, err
+	}
+
 	scope := new(event.SubscriptionScope)
 	subMinBaseFeeWrapped := scope.Track(subMinBaseFee)
 	subEpochPeriodWrapped := scope.Track(subEpochPeriod)
+	subConsensusTimeoutsWrapped := scope.Track(subConsensusTimeouts)
 	cache := &Cache{
-		minBaseFeeCh:   minBaseFeeCh,
-		subMinBaseFee:  subMinBaseFeeWrapped,
-		epochPeriodCh:  epochPeriodCh,
-		subEpochPeriod: subEpochPeriodWrapped,
-		subscriptions:  scope,
-		quit:           make(chan struct{}),
-		done:           make(chan struct{}),
+		minBaseFeeCh:         minBaseFeeCh,
+		subMinBaseFee:        subMinBaseFeeWrapped,
+		epochPeriodCh:        epochPeriodCh,
+		subEpochPeriod:       subEpochPeriodWrapped,
+		consensusTimeoutsCh:  consensusTimeoutsCh,
+		subConsensusTimeouts: subConsensusTimeoutsWrapped,
+		subscriptions:        scope,
+		quit:                 make(chan struct{}),
+		done:                 make(chan struct{}),
 	}
 
 	// store initial values
 	cache.minBaseFee.Store(minBaseFee)
 	cache.epochPeriod.Store(epochPeriod)
+	cache.consensusTimeouts.Store(timeouts)
 
 	go cache.Listen()
 	return cache, nil
@@ -197,6 +215,8 @@ func (c *Cache) Listen() {
 			c.minBaseFee.Store(ev.GasPrice)
 		case ev := <-c.epochPeriodCh:
 			c.epochPeriod.Store(ev.Period)
+		case ev := <-c.consensusTimeoutsCh:
+			c.consensusTimeouts.Store(c.consensusTimeouts.Load().update(ev.Timeouts, ev.ToBeAppliedAtBlock.Uint64()))
 		// These should never happen. Errors from subscription can happen only if the subscription is done over an RPC connection.
 		// In that case network errors can occur. Since everything is local here, no error should ever occur.
 		// we crash the client to avoid using a out-of-date value in case something goes very wrong.
@@ -204,6 +224,8 @@ func (c *Cache) Listen() {
 			log.Crit("protocol contract cache out-of-sync. Please contact the Autonity team.")
 		case <-c.subMinBaseFee.Err():
 			log.Crit("protocol contract cache out-of-sync. Please contact the Autonity team.")
+		case <-c.subConsensusTimeouts.Err():
+			log.Crit("protocol contract cache out-of-sync. Please contact the Autonity team.")
 		case <-c.quit:
 			return
 		}
@@ -223,6 +245,47 @@ func (c *Cache) EpochPeriod() *big.Int {
 	return new(big.Int).Set(c.epochPeriod.Load())
 }
 
+// ConsensusTimeouts returns the consensus timeouts governed by the Autonity contract for the given height. The
+// second return value is false if the timeouts are not set, in which case the default timeouts apply.
+func (c *Cache) ConsensusTimeouts(height uint64) (AutonityConsensusTimeouts, bool) {
+	timeouts := c.consensusTimeouts.Load().at(height)
+	return timeouts, !timeouts.isZero()
+}
+
+// consensusTimeouts holds the consensus timeouts currently applied and the ones to be applied after nextBlock, if
+// nextBlock is not zero.
+type consensusTimeouts struct {
+	current   AutonityConsensusTimeouts
+	next      AutonityConsensusTimeouts
+	nextBlock uint64
+}
+
+func (t *consensusTimeouts) at(height uint64) AutonityConsensusTimeouts {
+	if t.nextBlock != 0 && height > t.nextBlock {
+		return t.next
+	}
+	return t.current
+}
+
+// update returns the consensus timeouts after the timeouts to be applied after nextBlock were set.
+func (t *consensusTimeouts) update(next AutonityConsensusTimeouts, nextBlock uint64) *consensusTimeouts {
+	current := t.current
+	// the timeouts previously set were applied at the end of an earlier epoch
+	if t.nextBlock != 0 && t.nextBlock < nextBlock {
+		current = t.next
+	}
+	return &consensusTimeouts{current: current, next: next, nextBlock: nextBlock}
+}
+
+func (t AutonityConsensusTimeouts) isZero() bool {
+	for _, v := range []*big.Int{t.ProposeInitial, t.ProposeDelta, t.PrevoteInitial, t.PrevoteDelta, t.PrecommitInitial, t.PrecommitDelta} {
+		if v != nil && v.Sign() != 0 {
+			return false
+		}
+	}
+	return !t.Adaptive
+}
+
 func (c *AutonityContract) CommitteeEnodes(header *types.Header, db vm.StateDB, asACN bool) (*types.Nodes, error) {
 	return c.callGetCommitteeEnodes(db, header, asACN)
 }
@@ -255,8 +318,27 @@ func (c *AutonityContract) EpochPeriod(block *types.Header, db vm.StateDB) (*big
 	return c.callGetEpochPeriod(db, block)
 }
 
-// Proposer election is now computed by committee structure, it is on longer depends on AC contract.
-func (c *AutonityContract) Proposer(committee *types.Committee, _ vm.StateDB, height uint64, round int64) (proposer common.Address) {
+// loadConsensusTimeouts returns the consensus timeouts of the Autonity contract at the given block. Autonity contracts
+// which predate the consensus timeouts do not set them, the timeouts returned are then zero.
+func (c *AutonityContract) loadConsensusTimeouts(block *types.Header, db vm.StateDB) *consensusTimeouts {
+	if block.Number.Uint64() <= 1 {
+		return &consensusTimeouts{}
+	}
+	current, next, nextBlock, err := c.callGetConsensusTimeouts(db, block)
+	if err != nil {
+		log.Warn("Cannot load the consensus timeouts of the Autonity contract, using the default timeouts", "block", block.Number, "err", err)
+		return &consensusTimeouts{}
+	}
+	return &consensusTimeouts{current: *current, next: *next, nextBlock: nextBlock.Uint64()}
+}
+
+// ProposerElection elects the proposer of round at the height following height among the committee members.
+type ProposerElection func(committee *types.Committee, height uint64, round int64) (common.Address, error)
+
+// Proposer returns the proposer of round at the height following height, as elected by elect. The election is not
+// computed by the AC contract anymore, but the elected proposers are cached here whatever the election strategy. The
+// errors of the election are returned as is.
+func (c *AutonityContract) Proposer(committee *types.Committee, height uint64, round int64, elect ProposerElection) (common.Address, error) {
 	c.Lock()
 	defer c.Unlock()
 
@@ -268,9 +350,12 @@ func (c *AutonityContract) Proposer(committee *types.Committee, _ vm.StateDB, he
 		needsTrimming = true // cannot trim here, we might delete the entry we just created if it is for a very old height
 	}
 
-	proposer, ok = c.proposers[height][round]
+	proposer, ok := c.proposers[height][round]
 	if !ok {
-		proposer = committee.Proposer(height, round)
+		var err error
+		if proposer, err = elect(committee, height, round); err != nil {
+			return common.Address{}, err
+		}
 		c.proposers[height][round] = proposer
 	}
 
@@ -280,7 +365,7 @@ func (c *AutonityContract) Proposer(committee *types.Committee, _ vm.StateDB, he
 		c.trimProposerCache(height)
 	}
 
-	return proposer
+	return proposer, nil
 }
 
 /* the Proposer election function is called from core and from the fault detector.
@@ -336,6 +421,27 @@ func (c *AutonityContract) FinalizeAndGetCommittee(header *types.Header, statedb
 	return receipt, epochInfo, nil
 }
 
+// NextCommittee previews the committee of the epoch starting at nextEpochBlock, along with the enodes of its members,
+// by running the epoch rotation on top of the state of the given header. The staking operations submitted until the
+// end of the epoch are not accounted for, so that the committee elected at nextEpochBlock may still differ. The
+// state is modified, it must be discarded afterwards.
+func (c *AutonityContract) NextCommittee(header *types.Header, statedb vm.StateDB, nextEpochBlock uint64, asACN bool) (*types.Committee, *types.Nodes, error) {
+	next := types.CopyHeader(header)
+	next.Number = new(big.Int).SetUint64(nextEpochBlock)
+	_, epoch, err := c.callFinalize(statedb, next)
+	if err != nil {
+		return nil, nil, err
+	}
+	if epoch == nil {
+		return nil, nil, ErrEpochNotEnded
+	}
+	enodes, err := c.callGetCommitteeEnodes(statedb, next, asACN)
+	if err != nil {
+		return nil, nil, err
+	}
+	return epoch.Committee, enodes, nil
+}
+
 func (c *AutonityContract) upgradeAutonityContract(statedb vm.StateDB, header *types.Header) error {
 	log.Info("Initiating Autonity Contracts upgrade", "header", header.Number.Uint64())
 
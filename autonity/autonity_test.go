@@ -528,3 +528,25 @@ func testComputeCommittee(committeeSize int, validatorCount int, t *testing.T) {
 	err = isVotersSorted(output[0], members, validators, enodes, totalStake)
 	require.NoError(t, err)
 }
+
+func TestConsensusTimeoutsSchedule(t *testing.T) {
+	timeouts := func(propose int64) AutonityConsensusTimeouts {
+		return AutonityConsensusTimeouts{ProposeInitial: big.NewInt(propose)}
+	}
+	schedule := &consensusTimeouts{}
+	require.True(t, schedule.at(10).isZero())
+
+	schedule = schedule.update(timeouts(1000), 30)
+	require.True(t, schedule.at(30).isZero())
+	require.Equal(t, timeouts(1000), schedule.at(31))
+
+	// timeouts set again within the same epoch replace the pending ones
+	schedule = schedule.update(timeouts(2000), 30)
+	require.True(t, schedule.at(30).isZero())
+	require.Equal(t, timeouts(2000), schedule.at(31))
+
+	// timeouts set in a later epoch apply on top of the pending ones
+	schedule = schedule.update(timeouts(3000), 60)
+	require.Equal(t, timeouts(2000), schedule.at(60))
+	require.Equal(t, timeouts(3000), schedule.at(61))
+}
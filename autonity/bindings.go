@@ -68,6 +68,17 @@ type AutonityConfig struct {
 	ContractVersion *big.Int
 }
 
+// AutonityConsensusTimeouts is an auto generated low-level Go binding around an user-defined struct.
+type AutonityConsensusTimeouts struct {
+	ProposeInitial   *big.Int
+	ProposeDelta     *big.Int
+	PrevoteInitial   *big.Int
+	PrevoteDelta     *big.Int
+	PrecommitInitial *big.Int
+	PrecommitDelta   *big.Int
+	Adaptive         bool
+}
+
 // AutonityContracts is an auto generated low-level Go binding around an user-defined struct.
 type AutonityContracts struct {
 	AccountabilityContract      common.Address
@@ -98,6 +109,19 @@ type AutonityProtocol struct {
 	CommitteeSize   *big.Int
 }
 
+// AutonityUnbondingRequest is an auto generated low-level Go binding around an user-defined struct.
+type AutonityUnbondingRequest struct {
+	Delegator       common.Address
+	Delegatee       common.Address
+	Amount          *big.Int
+	UnbondingShare  *big.Int
+	RequestBlock    *big.Int
+	RevertingAmount *big.Int
+	State           uint8
+	Unlocked        bool
+	SelfDelegation  bool
+}
+
 // AutonityValidator is an auto generated low-level Go binding around an user-defined struct.
 type AutonityValidator struct {
 	Treasury                 common.Address
@@ -923,7 +947,7 @@ var AccountabilityMetaData = &bind.MetaData{
 		"6b5f444c": "setEpochPeriod(uint256)",
 		"e7bb0b52": "slashingHistory(address,uint256)",
 	},
-	Bin: "0x608060405260006014553480156200001657600080fd5b506040516200436f3803806200436f8339810160408190526200003991620002e7565b600180546001600160a01b0319166001600160a01b03841690811790915560408051636fd8d26960e11b8152905163dfb1a4d2916004808201926020929091908290030181865afa15801562000093573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190620000b9919062000378565b6000908155600154604080516355c7b7ff60e11b815290516001600160a01b039092169163ab8f6ffe9160048082019286929091908290030181865afa15801562000108573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f1916820160405262000132919081019062000392565b905060005b8151811015620001f857600e82828151811062000158576200015862000530565b6020908102919091018101515182546001808201855560009485529284200180546001600160a01b0319166001600160a01b0390921691909117905583519091600f91859085908110620001b057620001b062000530565b602090810291909101810151516001600160a01b03168252810191909152604001600020805460ff191691151591909117905580620001ef8162000546565b91505062000137565b50508051600355602081015160045560408101516005556060810151600655608081015160075560a081015160085560c00151600955506200056e565b6001600160a01b03811681146200024b57600080fd5b50565b634e487b7160e01b600052604160045260246000fd5b60405160e081016001600160401b03811182821017156200028957620002896200024e565b60405290565b604051606081016001600160401b03811182821017156200028957620002896200024e565b604051601f8201601f191681016001600160401b0381118282101715620002df57620002df6200024e565b604052919050565b600080828403610100811215620002fd57600080fd5b83516200030a8162000235565b925060e0601f19820112156200031f57600080fd5b506200032a62000264565b6020840151815260408401516020820152606084015160408201526080840151606082015260a0840151608082015260c084015160a082015260e084015160c0820152809150509250929050565b6000602082840312156200038b57600080fd5b5051919050565b60006020808385031215620003a657600080fd5b82516001600160401b0380821115620003be57600080fd5b818501915085601f830112620003d357600080fd5b815181811115620003e857620003e86200024e565b8060051b620003f9858201620002b4565b91825283810185019185810190898411156200041457600080fd5b86860192505b838310156200052357825185811115620004345760008081fd5b86016060601f19828d0381018213156200044e5760008081fd5b620004586200028f565b8a840151620004678162000235565b81526040848101518c830152928401519289841115620004875760008081fd5b83850194508e603f8601126200049f57600093508384fd5b8b850151935089841115620004b857620004b86200024e565b620004ca8c84601f87011601620002b4565b92508383528e81858701011115620004e25760008081fd5b60005b8481101562000502578581018201518482018e01528c01620004e5565b5060009383018c01939093529182015283525091860191908601906200041a565b9998505050505050505050565b634e487b7160e01b600052603260045260246000fd5b6000600182016200056757634e487b7160e01b600052601160045260246000fd5b5060010190565b613df1806200057e6000396000f3fe6080604052600436106100f35760003560e01c80639cb22b061161008a578063bebaa8fc11610059578063bebaa8fc14610332578063e05f87861461035f578063e08b14ed1461037f578063e7bb0b521461039f57600080fd5b80639cb22b06146102ae578063a48a9b50146102db578063a8031a1d146102fb578063b5b7a1841461030e57600080fd5b80636b5f444c116100c65780636b5f444c146101d35780636c9789b0146101f357806379502c55146102135780637ccecadd1461027757600080fd5b806301567739146100f85780630b7914301461014b5780631e85f2e5146101815780634108a95a146101a3575b600080fd5b34801561010457600080fd5b5061012e6101133660046130e7565b600a602052600090815260409020546001600160a01b031681565b6040516001600160a01b0390911681526020015b60405180910390f35b34801561015757600080fd5b5061016b61016636600461310b565b6103d7565b6040516101429a999897969594939291906131ae565b34801561018d57600080fd5b506101a161019c366004613355565b6104d4565b005b3480156101af57600080fd5b506101c36101be36600461343d565b6105ab565b6040519015158152602001610142565b3480156101df57600080fd5b506101a16101ee36600461310b565b6106d3565b3480156101ff57600080fd5b506101a161020e366004613489565b610702565b34801561021f57600080fd5b506003546004546005546006546007546008546009546102429695949392919087565b604080519788526020880196909652948601939093526060850191909152608084015260a083015260c082015260e001610142565b34801561028357600080fd5b5061029761029236600461343d565b610742565b604080519215158352602083019190915201610142565b3480156102ba57600080fd5b506102ce6102c93660046130e7565b61090e565b6040516101429190613559565b3480156102e757600080fd5b506101a16102f6366004613355565b610b11565b6101a161030936600461356c565b610be6565b34801561031a57600080fd5b5061032460005481565b604051908152602001610142565b34801561033e57600080fd5b5061035261034d3660046130e7565b610e64565b6040516101429190613598565b34801561036b57600080fd5b506101a161037a366004613355565b6110df565b34801561038b57600080fd5b506101a161039a3660046135fa565b6111b6565b3480156103ab57600080fd5b506103246103ba36600461356c565b601160209081526000928352604080842090915290825290205481565b600281815481106103e757600080fd5b600091825260209091206008909102018054600182015460028301805460ff808516965061010085041694620100009094046001600160a01b03908116949316929190610433906136ac565b80601f016020809104026020016040519081016040528092919081815260200182805461045f906136ac565b80156104ac5780601f10610481576101008083540402835291602001916104ac565b820191906000526020600020905b81548152906001019060200180831161048f57829003601f168201915b505050505090806003015490806004015490806005015490806006015490806007015490508a565b336000908152600f602052604090205460ff1615156001146105115760405162461bcd60e51b8152600401610508906136e6565b60405180910390fd5b60408101516001600160a01b0316331461053d5760405162461bcd60e51b81526004016105089061372f565b60018151600281111561055257610552613124565b1461059f5760405162461bcd60e51b815260206004820152601f60248201527f77726f6e67206576656e74207479706520666f722061636375736174696f6e006044820152606401610508565b6105a8816112d7565b50565b6000808360098111156105c0576105c0613124565b101580156105e0575060098360098111156105dd576105dd613124565b11155b6106245760405162461bcd60e51b81526020600482015260156024820152741c9d5b19481a59081b5d5cdd081899481d985b1a59605a1b6044820152606401610508565b600061062f846115da565b6001546040516396b477cb60e01b8152600481018690529192506000916001600160a01b03909116906396b477cb90602401602060405180830381865afa15801561067e573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906106a29190613766565b6001600160a01b03871660009081526011602090815260408083209383529290522054919091109150509392505050565b6001546001600160a01b031633146106fd5760405162461bcd60e51b81526004016105089061377f565b600055565b6001546001600160a01b0316331461072c5760405162461bcd60e51b81526004016105089061377f565b610734611645565b80156105a8576105a8611977565b6000808084600981111561075857610758613124565b101580156107785750600984600981111561077557610775613124565b11155b6107bc5760405162461bcd60e51b81526020600482015260156024820152741c9d5b19481a59081b5d5cdd081899481d985b1a59605a1b6044820152606401610508565b60006107c7856115da565b6001546040516396b477cb60e01b8152600481018790529192506000916001600160a01b03909116906396b477cb90602401602060405180830381865afa158015610816573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061083a9190613766565b6001600160a01b03881660009081526011602090815260408083208484529091529020549091508211610874576000935060009250610904565b6001600160a01b0387166000908152600c6020526040902054156108fb576001600160a01b0387166000908152600c60205260408120546002906108ba906001906137ea565b815481106108ca576108ca6137fd565b906000526020600020906008020190506000945060036000015481600401546108f39190613813565b935050610904565b60019350600092505b5050935093915050565b610916612f66565b6001600160a01b0382166000908152600c602052604090205461096b5760405162461bcd60e51b815260206004820152600d60248201526c37379030b1b1bab9b0ba34b7b760991b6044820152606401610508565b6001600160a01b0382166000908152600c6020526040902054600290610993906001906137ea565b815481106109a3576109a36137fd565b600091825260209091206040805161014081019091526008909202018054829060ff1660028111156109d7576109d7613124565b60028111156109e8576109e8613124565b81528154602090910190610100900460ff166009811115610a0b57610a0b613124565b6009811115610a1c57610a1c613124565b815281546001600160a01b0362010000909104811660208301526001830154166040820152600282018054606090920191610a56906136ac565b80601f0160208091040260200160405190810160405280929190818152602001828054610a82906136ac565b8015610acf5780601f10610aa457610100808354040283529160200191610acf565b820191906000526020600020905b815481529060010190602001808311610ab257829003601f168201915b50505050508152602001600382015481526020016004820154815260200160058201548152602001600682015481526020016007820154815250509050919050565b336000908152600f602052604090205460ff161515600114610b455760405162461bcd60e51b8152600401610508906136e6565b60408101516001600160a01b03163314610b715760405162461bcd60e51b81526004016105089061372f565b600081516002811115610b8657610b86613124565b14610bdd5760405162461bcd60e51b815260206004820152602160248201527f77726f6e67206576656e74207479706520666f72206d69736265686176696f756044820152603960f91b6064820152608401610508565b6105a881611c35565b6001546001600160a01b03163314610c105760405162461bcd60e51b81526004016105089061377f565b6001546001600160a01b038381166000908152600a6020526040808220549051630c825d9760e11b8152908316600482015290929190911690631904bb2e90602401600060405180830381865afa158015610c6f573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f19168201604052610c97919081019061388d565b5160015460405163a9059cbb60e01b81526001600160a01b0380841660048301526024820186905292935091169063a9059cbb906044016020604051808303816000875af1925050508015610d09575060408051601f3d908101601f19168201909252610d0691810190613a0b565b60015b15610d1057505b6000816001600160a01b0316346108fc90604051600060405180830381858888f193505050503d8060008114610d62576040519150601f19603f3d011682016040523d82523d6000602084013e610d67565b606091505b5050905080610e3a57600160009054906101000a90046001600160a01b03166001600160a01b031663f7866ee36040518163ffffffff1660e01b8152600401602060405180830381865afa158015610dc3573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610de79190613a28565b6001600160a01b03163460405160006040518083038185875af1925050503d8060008114610e31576040519150601f19603f3d011682016040523d82523d6000602084013e610e36565b606091505b5050505b5050506001600160a01b03166000908152600a6020526040902080546001600160a01b0319169055565b6001600160a01b0381166000908152600b60205260408120546060919067ffffffffffffffff811115610e9957610e99613224565b604051908082528060200260200182016040528015610ed257816020015b610ebf612f66565b815260200190600190039081610eb75790505b50905060005b6001600160a01b0384166000908152600b60205260409020548110156110d8576001600160a01b0384166000908152600b6020526040902080546002919083908110610f2657610f266137fd565b906000526020600020015481548110610f4157610f416137fd565b600091825260209091206040805161014081019091526008909202018054829060ff166002811115610f7557610f75613124565b6002811115610f8657610f86613124565b81528154602090910190610100900460ff166009811115610fa957610fa9613124565b6009811115610fba57610fba613124565b815281546001600160a01b0362010000909104811660208301526001830154166040820152600282018054606090920191610ff4906136ac565b80601f0160208091040260200160405190810160405280929190818152602001828054611020906136ac565b801561106d5780601f106110425761010080835404028352916020019161106d565b820191906000526020600020905b81548152906001019060200180831161105057829003601f168201915b50505050508152602001600382015481526020016004820154815260200160058201548152602001600682015481526020016007820154815250508282815181106110ba576110ba6137fd565b602002602001018190525080806110d090613a45565b915050610ed8565b5092915050565b336000908152600f602052604090205460ff1615156001146111135760405162461bcd60e51b8152600401610508906136e6565b60408101516001600160a01b0316331461113f5760405162461bcd60e51b81526004016105089061372f565b60028151600281111561115457611154613124565b146111ad5760405162461bcd60e51b8152602060048201526024808201527f77726f6e67206576656e74207479706520666f7220696e6e6f63656e636520706044820152633937b7b360e11b6064820152608401610508565b6105a881611e39565b6001546001600160a01b031633146111e05760405162461bcd60e51b81526004016105089061377f565b611243600d80548060200260200160405190810160405280929190818152602001828054801561123957602002820191906000526020600020905b81546001600160a01b0316815260019091019060200180831161121b575b5050505050611f7f565b6112a6600e80548060200260200160405190810160405280929190818152602001828054801561129c57602002820191906000526020600020905b81546001600160a01b0316815260019091019060200180831161127e575b505050505061204b565b600e80546112b691600d91612fcc565b506112c08161204b565b80516112d390600e90602084019061301c565b5050565b60008060008060006112ee60fc8760800151612131565b94509450945094509450846113455760405162461bcd60e51b815260206004820152601e60248201527f6661696c65642061636375736174696f6e20766572696669636174696f6e00006044820152606401610508565b85606001516001600160a01b0316846001600160a01b03161461137a5760405162461bcd60e51b815260040161050890613a5e565b8560200151600981111561139057611390613124565b83146113ae5760405162461bcd60e51b815260040161050890613a89565b6001546040516396b477cb60e01b8152600481018490526000916001600160a01b0316906396b477cb90602401602060405180830381865afa1580156113f8573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061141c9190613766565b90506000600160009054906101000a90046001600160a01b03166001600160a01b031663c9d97af46040518163ffffffff1660e01b8152600401602060405180830381865afa158015611473573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906114979190613766565b60c0890185905260e08901839052436101008a01526101208901849052905060006114c460026001613813565b600e546114d3906001906137ea565b6114dd9190613ab3565b9050818960e001511015611513576114f760026001613813565b600d54611506906001906137ea565b6115109190613ab3565b90505b3360009081526010602090815260408083208684529091529020548110156115965760405162461bcd60e51b815260206004820152603060248201527f76616c696461746f722073686f756c646e27742061627573652061636375736160448201526f0e8d2dedc40d2dc40c2dc40cae0dec6d60831b6064820152608401610508565b61159f89612198565b33600090815260106020908152604080832086845290915281208054600192906115ca908490613813565b9091555050505050505050505050565b600060098260098111156115f0576115f0613124565b036115fe5760025b92915050565b600082600981111561161257611612613124565b0361161e5760026115f8565b600182600981111561163257611632613124565b0361163e5760026115f8565b60026115f8565b6014545b60135481101561197257600060138281548110611668576116686137fd565b90600052602060002001549050806000036116835750611960565b61168e6001826137ea565b90506000600282815481106116a5576116a56137fd565b600091825260209091206040805161014081019091526008909202018054829060ff1660028111156116d9576116d9613124565b60028111156116ea576116ea613124565b81528154602090910190610100900460ff16600981111561170d5761170d613124565b600981111561171e5761171e613124565b815281546001600160a01b0362010000909104811660208301526001830154166040820152600282018054606090920191611758906136ac565b80601f0160208091040260200160405190810160405280929190818152602001828054611784906136ac565b80156117d15780601f106117a6576101008083540402835291602001916117d1565b820191906000526020600020905b8154815290600101906020018083116117b457829003601f168201915b50505050508152602001600382015481526020016004820154815260200160058201548152602001600682015481526020016007820154815250509050436003600001548261010001516118259190613813565b1115611832575050601455565b60608101516001600160a01b03166000908152600c60209081526040822082905582015161185f906115da565b60608301516001600160a01b0316600090815260116020908152604080832060e08701518452909152902054909150811161189c57505050611960565b6060820180516001600160a01b03908116600090815260116020908152604080832060e088015184528252808320869055845184168352600b8252808320805460018082018355918552838520018990556012805491820181559093527fbb8a6a4669ba250d26cd7a459eca9d215f8307e33aebe50379bc5a3617ec344490920187905592518151858152938401879052909116917f6b7783718ab8e152c193eb08bf76eed1191fcd1677a23a7fe9d338265aad132f910160405180910390a25050505b8061196a81613a45565b915050611649565b601455565b600080600160009054906101000a90046001600160a01b03166001600160a01b031663c9d97af46040518163ffffffff1660e01b8152600401602060405180830381865afa1580156119cd573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906119f19190613766565b905060005b601254811015611a685781600260128381548110611a1657611a166137fd565b906000526020600020015481548110611a3157611a316137fd565b90600052602060002090600802016005015403611a5657611a53600184613813565b92505b80611a6081613a45565b9150506119f6565b5060005b601254811015611c2857611c16600260128381548110611a8e57611a8e6137fd565b906000526020600020015481548110611aa957611aa96137fd565b600091825260209091206040805161014081019091526008909202018054829060ff166002811115611add57611add613124565b6002811115611aee57611aee613124565b81528154602090910190610100900460ff166009811115611b1157611b11613124565b6009811115611b2257611b22613124565b815281546001600160a01b0362010000909104811660208301526001830154166040820152600282018054606090920191611b5c906136ac565b80601f0160208091040260200160405190810160405280929190818152602001828054611b88906136ac565b8015611bd55780601f10611baa57610100808354040283529160200191611bd5565b820191906000526020600020905b815481529060010190602001808311611bb857829003601f168201915b505050505081526020016003820154815260200160048201548152602001600582015481526020016006820154815260200160078201548152505084612421565b80611c2081613a45565b915050611a6c565b506112d360126000613071565b6000806000806000611c4c60fe8760800151612131565b9450945094509450945084611ca35760405162461bcd60e51b815260206004820152601960248201527f6661696c65642070726f6f6620766572696669636174696f6e000000000000006044820152606401610508565b85606001516001600160a01b0316846001600160a01b031614611cd85760405162461bcd60e51b815260040161050890613a5e565b85602001516009811115611cee57611cee613124565b8314611d0c5760405162461bcd60e51b815260040161050890613a89565b438210611d545760405162461bcd60e51b815260206004820152601660248201527563616e277420626520696e207468652066757475726560501b6044820152606401610508565b60008211611d9a5760405162461bcd60e51b815260206004820152601360248201527263616e27742062652061742067656e6573697360681b6044820152606401610508565b6001546040516396b477cb60e01b8152600481018490526000916001600160a01b0316906396b477cb90602401602060405180830381865afa158015611de4573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611e089190613766565b60c0880184905260e088018190524361010089015261012088018390529050611e30876129c3565b50505050505050565b6000806000806000611e5060fd8760800151612131565b9450945094509450945084611ea75760405162461bcd60e51b815260206004820152601d60248201527f6661696c656420696e6e6f63656e636520766572696669636174696f6e0000006044820152606401610508565b85606001516001600160a01b0316846001600160a01b031614611edc5760405162461bcd60e51b815260040161050890613a5e565b85602001516009811115611ef257611ef2613124565b8314611f105760405162461bcd60e51b815260040161050890613a89565b438210611f585760405162461bcd60e51b815260206004820152601660248201527563616e277420626520696e207468652066757475726560501b6044820152606401610508565b60c08601829052610120860181905243610100870152611f7786612c05565b505050505050565b6001546001600160a01b03163314611fa95760405162461bcd60e51b81526004016105089061377f565b60005b81518110156112d357600f6000838381518110611fcb57611fcb6137fd565b6020908102919091018101516001600160a01b031682528101919091526040016000205460ff161561203957600f600083838151811061200d5761200d6137fd565b6020908102919091018101516001600160a01b03168252810191909152604001600020805460ff191690555b8061204381613a45565b915050611fac565b6001546001600160a01b031633146120755760405162461bcd60e51b81526004016105089061377f565b60005b81518110156112d357600f6000838381518110612097576120976137fd565b6020908102919091018101516001600160a01b031682528101919091526040016000205460ff16151560011461211f576001600f60008484815181106120df576120df6137fd565b60200260200101516001600160a01b03166001600160a01b0316815260200190815260200160002060006101000a81548160ff0219169083151502179055505b8061212981613a45565b915050612078565b600080600080600080865160206121489190613813565b905061215261308f565b60a081838a8c5afa61216357600080fd5b805160010361217157600196505b602081015160408201516060830151608090930151989b919a509850909695509350505050565b60608101516001600160a01b03166000908152600c6020526040902054156122025760405162461bcd60e51b815260206004820181905260248201527f616c72656164792070726f63657373696e6720616e2061636375736174696f6e6044820152606401610508565b600061221182602001516115da565b60608301516001600160a01b0316600090815260116020908152604080832060e08701518452909152902054909150811161225e5760405162461bcd60e51b815260040161050890613aca565b6002805460a08401819052600180820183556000839052845160089092027f405787fa12a823e0f2b7631cc41b3ba8828b3321ca811111fa75cd3aa3bb5ace0180548694919392849260ff199092169184908111156122bf576122bf613124565b021790555060208201518154829061ff0019166101008360098111156122e7576122e7613124565b02179055506040820151815462010000600160b01b031916620100006001600160a01b039283160217825560608301516001830180546001600160a01b03191691909216179055608082015160028201906123429082613b59565b5060a082810151600383015560c0830151600483015560e08301516005830155610100830151600683015561012090920151600790910155820151612388906001613813565b60608301516001600160a01b03166000908152600c602052604090205560a08201516013906123b8906001613813565b815460018101835560009283526020928390200155606083015160a084015160408051858152938401919091526001600160a01b03909116917f2e8e354b41470731dafa7c3df150e9498a8d5b9c51ff0259fbf77f721ba4035191015b60405180910390a25050565b6001546060830151604051630c825d9760e11b81526001600160a01b0391821660048201526000929190911690631904bb2e90602401600060405180830381865afa158015612474573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f1916820160405261249c919081019061388d565b60408481015160608601516001600160a01b039081166000908152600a6020529290922080546001600160a01b031916929091169190911790559050600381610260015160038111156124f1576124f1613124565b036124fb57505050565b600061251261250d85602001516115da565b612f0d565b6102208301516007549192509060009061252c9083613ab3565b6006546125399087613ab3565b6125439085613813565b61254d9190613813565b60095490915081111561255f57506009545b60008461012001518560c001518660a0015161257b9190613813565b6125859190613813565b6009549091506000906125988385613ab3565b6125a29190613c19565b90506000811180156125b357508181145b156126e557600060a087018190526101008701819052610120870181905260c08701526101e0860180518291906125eb908390613813565b9052506102208601805160019190612604908390613813565b905250600361026087015260006102008701526001546040516301adf0b760e51b81526001600160a01b03909116906335be16e090612647908990600401613c4b565b600060405180830381600087803b15801561266157600080fd5b505af1158015612675573d6000803e3d6000fd5b5050505060208681015160a08a810151604080516001600160a01b03909416845293830185905260008385015260016060840152608083015291517f6617e612ea2d01b5a235997fa4963b56b1097df6f968a82972433e9ff852e0f9929181900390910190a15050505050505050565b610120860151819081116127125780876101200181815161270691906137ea565b9052506000905061272d565b61012087015161272290826137ea565b600061012089015290505b80156127aa5780876101000151106127755780876101000181815161275291906137ea565b90525060a0870180518291906127699083906137ea565b905250600090506127aa565b61010087015161278590826137ea565b90508661010001518760a00181815161279e91906137ea565b90525060006101008801525b6000811180156127cd575060008760a001518860c001516127cb9190613813565b115b156128795760008760a001518860c001516127e89190613813565b60c08901516127f79084613ab3565b6128019190613c19565b905060008860a001518960c001516128199190613813565b60a08a01516128289085613ab3565b6128329190613c19565b9050818960c00181815161284691906137ea565b90525060a08901805182919061285d9083906137ea565b90525061286a8183613813565b61287490846137ea565b925050505b61288381836137ea565b915081876101e0018181516128989190613813565b90525061022087018051600191906128b1908390613813565b9052506000546102208801516008546128ca9190613ab3565b6128d49190613ab3565b6128de9043613813565b61020088015260026102608801526001546040516301adf0b760e51b81526001600160a01b03909116906335be16e09061291c908a90600401613c4b565b600060405180830381600087803b15801561293657600080fd5b505af115801561294a573d6000803e3d6000fd5b5050506020808901516102008a015160a0808e0151604080516001600160a01b039095168552948401889052938301919091526000606083015260808201929092527f6617e612ea2d01b5a235997fa4963b56b1097df6f968a82972433e9ff852e0f992500160405180910390a1505050505050505050565b60006129d282602001516115da565b60608301516001600160a01b0316600090815260116020908152604080832060e087015184529091529020549091508111612a1f5760405162461bcd60e51b815260040161050890613aca565b6002805460a08401819052600180820183556000839052845160089092027f405787fa12a823e0f2b7631cc41b3ba8828b3321ca811111fa75cd3aa3bb5ace0180548694919392849260ff19909216918490811115612a8057612a80613124565b021790555060208201518154829061ff001916610100836009811115612aa857612aa8613124565b02179055506040820151815462010000600160b01b031916620100006001600160a01b039283160217825560608301516001830180546001600160a01b0319169190921617905560808201516002820190612b039082613b59565b5060a082810151600383015560c0830151600483015560e08084015160058401556101008401516006840155610120909301516007909201919091556060840180516001600160a01b039081166000908152600b602090815260408083209589018051875460018181018a559886528486200155805160128054988901815585527fbb8a6a4669ba250d26cd7a459eca9d215f8307e33aebe50379bc5a3617ec344490970196909655845184168352601182528083209689015183529590528490208590559051915192519116917f6b7783718ab8e152c193eb08bf76eed1191fcd1677a23a7fe9d338265aad132f9161241591858252602082015260400190565b60608101516001600160a01b03166000908152600c602052604081205490819003612c725760405162461bcd60e51b815260206004820152601860248201527f6e6f206173736f6369617465642061636375736174696f6e00000000000000006044820152606401610508565b81602001516009811115612c8857612c88613124565b6002612c956001846137ea565b81548110612ca557612ca56137fd565b6000918252602090912060089091020154610100900460ff166009811115612ccf57612ccf613124565b14612d2c5760405162461bcd60e51b815260206004820152602760248201527f756e6d61746368696e672070726f6f6620616e642061636375736174696f6e206044820152661c9d5b19481a5960ca1b6064820152608401610508565b60c08201516002612d3e6001846137ea565b81548110612d4e57612d4e6137fd565b90600052602060002090600802016004015414612dbb5760405162461bcd60e51b815260206004820152602560248201527f756e6d61746368696e672070726f6f6620616e642061636375736174696f6e20604482015264626c6f636b60d81b6064820152608401610508565b6101208201516002612dce6001846137ea565b81548110612dde57612dde6137fd565b90600052602060002090600802016007015414612e495760405162461bcd60e51b8152602060048201526024808201527f756e6d61746368696e672070726f6f6620616e642061636375736174696f6e206044820152630d0c2e6d60e31b6064820152608401610508565b6014545b601354811015612eb3578160138281548110612e6b57612e6b6137fd565b906000526020600020015403612ea157600060138281548110612e9057612e906137fd565b600091825260209091200155612eb3565b80612eab81613a45565b915050612e4d565b506060820180516001600160a01b039081166000908152600c602090815260408083208390559351935191825292909116917f1fa96beb8dddcb7d4484dd00c4059e872439f7a474a2ecf49c430fc6e86c9e1f9101612415565b600081612f1c57505060055490565b60018203612f2c57505060055490565b60028203612f3c57505060055490565b60038203612f4c57505060055490565b60048203612f5d5750612710919050565b50612710919050565b60408051610140810190915280600081526020016000815260200160006001600160a01b0316815260200160006001600160a01b031681526020016060815260200160008152602001600081526020016000815260200160008152602001600081525090565b82805482825590600052602060002090810192821561300c5760005260206000209182015b8281111561300c578254825591600101919060010190612ff1565b506130189291506130ad565b5090565b82805482825590600052602060002090810192821561300c579160200282015b8281111561300c57825182546001600160a01b0319166001600160a01b0390911617825560209092019160019091019061303c565b50805460008255906000526020600020908101906105a891906130ad565b6040518060a001604052806005906020820280368337509192915050565b5b8082111561301857600081556001016130ae565b6001600160a01b03811681146105a857600080fd5b80356130e2816130c2565b919050565b6000602082840312156130f957600080fd5b8135613104816130c2565b9392505050565b60006020828403121561311d57600080fd5b5035919050565b634e487b7160e01b600052602160045260246000fd5b6003811061314a5761314a613124565b9052565b600a811061314a5761314a613124565b60005b83811015613179578181015183820152602001613161565b50506000910152565b6000815180845261319a81602086016020860161315e565b601f01601f19169290920160200192915050565b60006101406131bd838e61313a565b6131ca602084018d61314e565b6001600160a01b038b811660408501528a166060840152608083018190526131f48184018a613182565b60a0840198909852505060c081019490945260e08401929092526101008301526101209091015295945050505050565b634e487b7160e01b600052604160045260246000fd5b604051610140810167ffffffffffffffff8111828210171561325e5761325e613224565b60405290565b604051610280810167ffffffffffffffff8111828210171561325e5761325e613224565b604051601f8201601f1916810167ffffffffffffffff811182821017156132b1576132b1613224565b604052919050565b8035600381106130e257600080fd5b8035600a81106130e257600080fd5b600067ffffffffffffffff8211156132f1576132f1613224565b50601f01601f191660200190565b600082601f83011261331057600080fd5b813561332361331e826132d7565b613288565b81815284602083860101111561333857600080fd5b816020850160208301376000918101602001919091529392505050565b60006020828403121561336757600080fd5b813567ffffffffffffffff8082111561337f57600080fd5b90830190610140828603121561339457600080fd5b61339c61323a565b6133a5836132b9565b81526133b3602084016132c8565b60208201526133c4604084016130d7565b60408201526133d5606084016130d7565b60608201526080830135828111156133ec57600080fd5b6133f8878286016132ff565b60808301525060a0838101359082015260c0808401359082015260e0808401359082015261010080840135908201526101209283013592810192909252509392505050565b60008060006060848603121561345257600080fd5b833561345d816130c2565b925061346b602085016132c8565b9150604084013590509250925092565b80151581146105a857600080fd5b60006020828403121561349b57600080fd5b81356131048161347b565b60006101406134b684845161313a565b60208301516134c8602086018261314e565b5060408301516134e360408601826001600160a01b03169052565b5060608301516134fe60608601826001600160a01b03169052565b50608083015181608086015261351682860182613182565b91505060a083015160a085015260c083015160c085015260e083015160e08501526101008084015181860152506101208084015181860152508091505092915050565b60208152600061310460208301846134a6565b6000806040838503121561357f57600080fd5b823561358a816130c2565b946020939093013593505050565b6000602080830181845280855180835260408601915060408160051b870101925083870160005b828110156135ed57603f198886030184526135db8583516134a6565b945092850192908501906001016135bf565b5092979650505050505050565b6000602080838503121561360d57600080fd5b823567ffffffffffffffff8082111561362557600080fd5b818501915085601f83011261363957600080fd5b81358181111561364b5761364b613224565b8060051b915061365c848301613288565b818152918301840191848101908884111561367657600080fd5b938501935b838510156136a05784359250613690836130c2565b828252938501939085019061367b565b98975050505050505050565b600181811c908216806136c057607f821691505b6020821081036136e057634e487b7160e01b600052602260045260246000fd5b50919050565b60208082526029908201527f66756e6374696f6e207265737472696374656420746f206120636f6d6d69747460408201526832b29036b2b6b132b960b91b606082015260800190565b6020808252601d908201527f6576656e74207265706f72746572206d7573742062652063616c6c6572000000604082015260600190565b60006020828403121561377857600080fd5b5051919050565b60208082526035908201527f66756e6374696f6e207265737472696374656420746f20746865206175746f6e6040820152741a5d1e481c1c9bdd1bd8dbdb0818dbdb9d1c9858dd605a1b606082015260800190565b634e487b7160e01b600052601160045260246000fd5b818103818111156115f8576115f86137d4565b634e487b7160e01b600052603260045260246000fd5b808201808211156115f8576115f86137d4565b80516130e2816130c2565b600082601f83011261384257600080fd5b815161385061331e826132d7565b81815284602083860101111561386557600080fd5b61387682602083016020870161315e565b949350505050565b8051600481106130e257600080fd5b60006020828403121561389f57600080fd5b815167ffffffffffffffff808211156138b757600080fd5b9083019061028082860312156138cc57600080fd5b6138d4613264565b6138dd83613826565b81526138eb60208401613826565b60208201526138fc60408401613826565b604082015260608301518281111561391357600080fd5b61391f87828601613831565b6060830152506080830151608082015260a083015160a082015260c083015160c082015260e083015160e082015261010080840151818301525061012080840151818301525061014080840151818301525061016080840151818301525061018061398b818501613826565b908201526101a083810151908201526101c080840151908201526101e080840151908201526102008084015190820152610220808401519082015261024080840151838111156139da57600080fd5b6139e688828701613831565b82840152505061026091506139fc82840161387e565b91810191909152949350505050565b600060208284031215613a1d57600080fd5b81516131048161347b565b600060208284031215613a3a57600080fd5b8151613104816130c2565b600060018201613a5757613a576137d4565b5060010190565b6020808252601190820152700decccccadcc8cae440dad2e6dac2e8c6d607b1b604082015260600190565b60208082526010908201526f0e4ead8ca40d2c840dad2e6dac2e8c6d60831b604082015260600190565b80820281158282048414176115f8576115f86137d4565b60208082526024908201527f616c726561647920736c6173686564206174207468652070726f6f66277320656040820152630e0dec6d60e31b606082015260800190565b601f821115613b5457600081815260208120601f850160051c81016020861015613b355750805b601f850160051c820191505b81811015611f7757828155600101613b41565b505050565b815167ffffffffffffffff811115613b7357613b73613224565b613b8781613b8184546136ac565b84613b0e565b602080601f831160018114613bbc5760008415613ba45750858301515b600019600386901b1c1916600185901b178555611f77565b600085815260208120601f198616915b82811015613beb57888601518255948401946001909101908401613bcc565b5085821015613c095787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b600082613c3657634e487b7160e01b600052601260045260246000fd5b500490565b6004811061314a5761314a613124565b60208152613c656020820183516001600160a01b03169052565b60006020830151613c8160408401826001600160a01b03169052565b5060408301516001600160a01b0381166060840152506060830151610280806080850152613cb36102a0850183613182565b9150608085015160a085015260a085015160c085015260c085015160e085015260e08501516101008181870152808701519150506101208181870152808701519150506101408181870152808701519150506101608181870152808701519150506101808181870152808701519150506101a0613d3a818701836001600160a01b03169052565b8601516101c0868101919091528601516101e080870191909152860151610200808701919091528601516102208087019190915286015161024080870191909152860151858403601f190161026080880191909152909150613d9c8483613182565b935080870151915050613db182860182613c3b565b509094935050505056fea2646970667358221220273c78f276dd9b936fb9f2d9575f71f7ed4246f0fe7976b47ce0dfbff67410e264736f6c63430008150033",
+	Bin: "0x608060405260006014553480156200001657600080fd5b506040516200436f3803806200436f8339810160408190526200003991620002e7565b600180546001600160a01b0319166001600160a01b03841690811790915560408051636fd8d26960e11b8152905163dfb1a4d2916004808201926020929091908290030181865afa15801562000093573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190620000b9919062000378565b6000908155600154604080516355c7b7ff60e11b815290516001600160a01b039092169163ab8f6ffe9160048082019286929091908290030181865afa15801562000108573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f1916820160405262000132919081019062000392565b905060005b8151811015620001f857600e82828151811062000158576200015862000530565b6020908102919091018101515182546001808201855560009485529284200180546001600160a01b0319166001600160a01b0390921691909117905583519091600f91859085908110620001b057620001b062000530565b602090810291909101810151516001600160a01b03168252810191909152604001600020805460ff191691151591909117905580620001ef8162000546565b91505062000137565b50508051600355602081015160045560408101516005556060810151600655608081015160075560a081015160085560c00151600955506200056e565b6001600160a01b03811681146200024b57600080fd5b50565b634e487b7160e01b600052604160045260246000fd5b60405160e081016001600160401b03811182821017156200028957620002896200024e565b60405290565b604051606081016001600160401b03811182821017156200028957620002896200024e565b604051601f8201601f191681016001600160401b0381118282101715620002df57620002df6200024e565b604052919050565b600080828403610100811215620002fd57600080fd5b83516200030a8162000235565b925060e0601f19820112156200031f57600080fd5b506200032a62000264565b6020840151815260408401516020820152606084015160408201526080840151606082015260a0840151608082015260c084015160a082015260e084015160c0820152809150509250929050565b6000602082840312156200038b57600080fd5b5051919050565b60006020808385031215620003a657600080fd5b82516001600160401b0380821115620003be57600080fd5b818501915085601f830112620003d357600080fd5b815181811115620003e857620003e86200024e565b8060051b620003f9858201620002b4565b91825283810185019185810190898411156200041457600080fd5b86860192505b838310156200052357825185811115620004345760008081fd5b86016060601f19828d0381018213156200044e5760008081fd5b620004586200028f565b8a840151620004678162000235565b81526040848101518c830152928401519289841115620004875760008081fd5b83850194508e603f8601126200049f57600093508384fd5b8b850151935089841115620004b857620004b86200024e565b620004ca8c84601f87011601620002b4565b92508383528e81858701011115620004e25760008081fd5b60005b8481101562000502578581018201518482018e01528c01620004e5565b5060009383018c01939093529182015283525091860191908601906200041a565b9998505050505050505050565b634e487b7160e01b600052603260045260246000fd5b6000600182016200056757634e487b7160e01b600052601160045260246000fd5b5060010190565b613df1806200057e6000396000f3fe6080604052600436106100f35760003560e01c80639cb22b061161008a578063bebaa8fc11610059578063bebaa8fc14610332578063e05f87861461035f578063e08b14ed1461037f578063e7bb0b521461039f57600080fd5b80639cb22b06146102ae578063a48a9b50146102db578063a8031a1d146102fb578063b5b7a1841461030e57600080fd5b80636b5f444c116100c65780636b5f444c146101d35780636c9789b0146101f357806379502c55146102135780637ccecadd1461027757600080fd5b806301567739146100f85780630b7914301461014b5780631e85f2e5146101815780634108a95a146101a3575b600080fd5b34801561010457600080fd5b5061012e6101133660046130e7565b600a602052600090815260409020546001600160a01b031681565b6040516001600160a01b0390911681526020015b60405180910390f35b34801561015757600080fd5b5061016b61016636600461310b565b6103d7565b6040516101429a999897969594939291906131ae565b34801561018d57600080fd5b506101a161019c366004613355565b6104d4565b005b3480156101af57600080fd5b506101c36101be36600461343d565b6105ab565b6040519015158152602001610142565b3480156101df57600080fd5b506101a16101ee36600461310b565b6106d3565b3480156101ff57600080fd5b506101a161020e366004613489565b610702565b34801561021f57600080fd5b506003546004546005546006546007546008546009546102429695949392919087565b604080519788526020880196909652948601939093526060850191909152608084015260a083015260c082015260e001610142565b34801561028357600080fd5b5061029761029236600461343d565b610742565b604080519215158352602083019190915201610142565b3480156102ba57600080fd5b506102ce6102c93660046130e7565b61090e565b6040516101429190613559565b3480156102e757600080fd5b506101a16102f6366004613355565b610b11565b6101a161030936600461356c565b610be6565b34801561031a57600080fd5b5061032460005481565b604051908152602001610142565b34801561033e57600080fd5b5061035261034d3660046130e7565b610e64565b6040516101429190613598565b34801561036b57600080fd5b506101a161037a366004613355565b6110df565b34801561038b57600080fd5b506101a161039a3660046135fa565b6111b6565b3480156103ab57600080fd5b506103246103ba36600461356c565b601160209081526000928352604080842090915290825290205481565b600281815481106103e757600080fd5b600091825260209091206008909102018054600182015460028301805460ff808516965061010085041694620100009094046001600160a01b03908116949316929190610433906136ac565b80601f016020809104026020016040519081016040528092919081815260200182805461045f906136ac565b80156104ac5780601f10610481576101008083540402835291602001916104ac565b820191906000526020600020905b81548152906001019060200180831161048f57829003601f168201915b505050505090806003015490806004015490806005015490806006015490806007015490508a565b336000908152600f602052604090205460ff1615156001146105115760405162461bcd60e51b8152600401610508906136e6565b60405180910390fd5b60408101516001600160a01b0316331461053d5760405162461bcd60e51b81526004016105089061372f565b60018151600281111561055257610552613124565b1461059f5760405162461bcd60e51b815260206004820152601f60248201527f77726f6e67206576656e74207479706520666f722061636375736174696f6e006044820152606401610508565b6105a8816112d7565b50565b6000808360098111156105c0576105c0613124565b101580156105e0575060098360098111156105dd576105dd613124565b11155b6106245760405162461bcd60e51b81526020600482015260156024820152741c9d5b19481a59081b5d5cdd081899481d985b1a59605a1b6044820152606401610508565b600061062f846115da565b6001546040516396b477cb60e01b8152600481018690529192506000916001600160a01b03909116906396b477cb90602401602060405180830381865afa15801561067e573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906106a29190613766565b6001600160a01b03871660009081526011602090815260408083209383529290522054919091109150509392505050565b6001546001600160a01b031633146106fd5760405162461bcd60e51b81526004016105089061377f565b600055565b6001546001600160a01b0316331461072c5760405162461bcd60e51b81526004016105089061377f565b610734611645565b80156105a8576105a8611977565b6000808084600981111561075857610758613124565b101580156107785750600984600981111561077557610775613124565b11155b6107bc5760405162461bcd60e51b81526020600482015260156024820152741c9d5b19481a59081b5d5cdd081899481d985b1a59605a1b6044820152606401610508565b60006107c7856115da565b6001546040516396b477cb60e01b8152600481018790529192506000916001600160a01b03909116906396b477cb90602401602060405180830381865afa158015610816573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061083a9190613766565b6001600160a01b03881660009081526011602090815260408083208484529091529020549091508211610874576000935060009250610904565b6001600160a01b0387166000908152600c6020526040902054156108fb576001600160a01b0387166000908152600c60205260408120546002906108ba906001906137ea565b815481106108ca576108ca6137fd565b906000526020600020906008020190506000945060036000015481600401546108f39190613813565b935050610904565b60019350600092505b5050935093915050565b610916612f66565b6001600160a01b0382166000908152600c602052604090205461096b5760405162461bcd60e51b815260206004820152600d60248201526c37379030b1b1bab9b0ba34b7b760991b6044820152606401610508565b6001600160a01b0382166000908152600c6020526040902054600290610993906001906137ea565b815481106109a3576109a36137fd565b600091825260209091206040805161014081019091526008909202018054829060ff1660028111156109d7576109d7613124565b60028111156109e8576109e8613124565b81528154602090910190610100900460ff166009811115610a0b57610a0b613124565b6009811115610a1c57610a1c613124565b815281546001600160a01b0362010000909104811660208301526001830154166040820152600282018054606090920191610a56906136ac565b80601f0160208091040260200160405190810160405280929190818152602001828054610a82906136ac565b8015610acf5780601f10610aa457610100808354040283529160200191610acf565b820191906000526020600020905b815481529060010190602001808311610ab257829003601f168201915b50505050508152602001600382015481526020016004820154815260200160058201548152602001600682015481526020016007820154815250509050919050565b336000908152600f602052604090205460ff161515600114610b455760405162461bcd60e51b8152600401610508906136e6565b60408101516001600160a01b03163314610b715760405162461bcd60e51b81526004016105089061372f565b600081516002811115610b8657610b86613124565b14610bdd5760405162461bcd60e51b815260206004820152602160248201527f77726f6e67206576656e74207479706520666f72206d69736265686176696f756044820152603960f91b6064820152608401610508565b6105a881611c35565b6001546001600160a01b03163314610c105760405162461bcd60e51b81526004016105089061377f565b6001546001600160a01b038381166000908152600a6020526040808220549051630c825d9760e11b8152908316600482015290929190911690631904bb2e90602401600060405180830381865afa158015610c6f573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f19168201604052610c97919081019061388d565b5160015460405163a9059cbb60e01b81526001600160a01b0380841660048301526024820186905292935091169063a9059cbb906044016020604051808303816000875af1925050508015610d09575060408051601f3d908101601f19168201909252610d0691810190613a0b565b60015b15610d1057505b6000816001600160a01b0316346108fc90604051600060405180830381858888f193505050503d8060008114610d62576040519150601f19603f3d011682016040523d82523d6000602084013e610d67565b606091505b5050905080610e3a57600160009054906101000a90046001600160a01b03166001600160a01b031663f7866ee36040518163ffffffff1660e01b8152600401602060405180830381865afa158015610dc3573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610de79190613a28565b6001600160a01b03163460405160006040518083038185875af1925050503d8060008114610e31576040519150601f19603f3d011682016040523d82523d6000602084013e610e36565b606091505b5050505b5050506001600160a01b03166000908152600a6020526040902080546001600160a01b0319169055565b6001600160a01b0381166000908152600b60205260408120546060919067ffffffffffffffff811115610e9957610e99613224565b604051908082528060200260200182016040528015610ed257816020015b610ebf612f66565b815260200190600190039081610eb75790505b50905060005b6001600160a01b0384166000908152600b60205260409020548110156110d8576001600160a01b0384166000908152600b6020526040902080546002919083908110610f2657610f266137fd565b906000526020600020015481548110610f4157610f416137fd565b600091825260209091206040805161014081019091526008909202018054829060ff166002811115610f7557610f75613124565b6002811115610f8657610f86613124565b81528154602090910190610100900460ff166009811115610fa957610fa9613124565b6009811115610fba57610fba613124565b815281546001600160a01b0362010000909104811660208301526001830154166040820152600282018054606090920191610ff4906136ac565b80601f0160208091040260200160405190810160405280929190818152602001828054611020906136ac565b801561106d5780601f106110425761010080835404028352916020019161106d565b820191906000526020600020905b81548152906001019060200180831161105057829003601f168201915b50505050508152602001600382015481526020016004820154815260200160058201548152602001600682015481526020016007820154815250508282815181106110ba576110ba6137fd565b602002602001018190525080806110d090613a45565b915050610ed8565b5092915050565b336000908152600f602052604090205460ff1615156001146111135760405162461bcd60e51b8152600401610508906136e6565b60408101516001600160a01b0316331461113f5760405162461bcd60e51b81526004016105089061372f565b60028151600281111561115457611154613124565b146111ad5760405162461bcd60e51b8152602060048201526024808201527f77726f6e67206576656e74207479706520666f7220696e6e6f63656e636520706044820152633937b7b360e11b6064820152608401610508565b6105a881611e39565b6001546001600160a01b031633146111e05760405162461bcd60e51b81526004016105089061377f565b611243600d80548060200260200160405190810160405280929190818152602001828054801561123957602002820191906000526020600020905b81546001600160a01b0316815260019091019060200180831161121b575b5050505050611f7f565b6112a6600e80548060200260200160405190810160405280929190818152602001828054801561129c57602002820191906000526020600020905b81546001600160a01b0316815260019091019060200180831161127e575b505050505061204b565b600e80546112b691600d91612fcc565b506112c08161204b565b80516112d390600e90602084019061301c565b5050565b60008060008060006112ee60fc8760800151612131565b94509450945094509450846113455760405162461bcd60e51b815260206004820152601e60248201527f6661696c65642061636375736174696f6e20766572696669636174696f6e00006044820152606401610508565b85606001516001600160a01b0316846001600160a01b03161461137a5760405162461bcd60e51b815260040161050890613a5e565b8560200151600981111561139057611390613124565b83146113ae5760405162461bcd60e51b815260040161050890613a89565b6001546040516396b477cb60e01b8152600481018490526000916001600160a01b0316906396b477cb90602401602060405180830381865afa1580156113f8573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061141c9190613766565b90506000600160009054906101000a90046001600160a01b03166001600160a01b031663c9d97af46040518163ffffffff1660e01b8152600401602060405180830381865afa158015611473573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906114979190613766565b60c0890185905260e08901839052436101008a01526101208901849052905060006114c460026001613813565b600e546114d3906001906137ea565b6114dd9190613ab3565b9050818960e001511015611513576114f760026001613813565b600d54611506906001906137ea565b6115109190613ab3565b90505b3360009081526010602090815260408083208684529091529020548110156115965760405162461bcd60e51b815260206004820152603060248201527f76616c696461746f722073686f756c646e27742061627573652061636375736160448201526f0e8d2dedc40d2dc40c2dc40cae0dec6d60831b6064820152608401610508565b61159f89612198565b33600090815260106020908152604080832086845290915281208054600192906115ca908490613813565b9091555050505050505050505050565b600060098260098111156115f0576115f0613124565b036115fe5760025b92915050565b600082600981111561161257611612613124565b0361161e5760026115f8565b600182600981111561163257611632613124565b0361163e5760026115f8565b60026115f8565b6014545b60135481101561197257600060138281548110611668576116686137fd565b90600052602060002001549050806000036116835750611960565b61168e6001826137ea565b90506000600282815481106116a5576116a56137fd565b600091825260209091206040805161014081019091526008909202018054829060ff1660028111156116d9576116d9613124565b60028111156116ea576116ea613124565b81528154602090910190610100900460ff16600981111561170d5761170d613124565b600981111561171e5761171e613124565b815281546001600160a01b0362010000909104811660208301526001830154166040820152600282018054606090920191611758906136ac565b80601f0160208091040260200160405190810160405280929190818152602001828054611784906136ac565b80156117d15780601f106117a6576101008083540402835291602001916117d1565b820191906000526020600020905b8154815290600101906020018083116117b457829003601f168201915b50505050508152602001600382015481526020016004820154815260200160058201548152602001600682015481526020016007820154815250509050436003600001548261010001516118259190613813565b1115611832575050601455565b60608101516001600160a01b03166000908152600c60209081526040822082905582015161185f906115da565b60608301516001600160a01b0316600090815260116020908152604080832060e08701518452909152902054909150811161189c57505050611960565b6060820180516001600160a01b03908116600090815260116020908152604080832060e088015184528252808320869055845184168352600b8252808320805460018082018355918552838520018990556012805491820181559093527fbb8a6a4669ba250d26cd7a459eca9d215f8307e33aebe50379bc5a3617ec344490920187905592518151858152938401879052909116917f6b7783718ab8e152c193eb08bf76eed1191fcd1677a23a7fe9d338265aad132f910160405180910390a25050505b8061196a81613a45565b915050611649565b601455565b600080600160009054906101000a90046001600160a01b03166001600160a01b031663c9d97af46040518163ffffffff1660e01b8152600401602060405180830381865afa1580156119cd573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906119f19190613766565b905060005b601254811015611a685781600260128381548110611a1657611a166137fd565b906000526020600020015481548110611a3157611a316137fd565b90600052602060002090600802016005015403611a5657611a53600184613813565b92505b80611a6081613a45565b9150506119f6565b5060005b601254811015611c2857611c16600260128381548110611a8e57611a8e6137fd565b906000526020600020015481548110611aa957611aa96137fd565b600091825260209091206040805161014081019091526008909202018054829060ff166002811115611add57611add613124565b6002811115611aee57611aee613124565b81528154602090910190610100900460ff166009811115611b1157611b11613124565b6009811115611b2257611b22613124565b815281546001600160a01b0362010000909104811660208301526001830154166040820152600282018054606090920191611b5c906136ac565b80601f0160208091040260200160405190810160405280929190818152602001828054611b88906136ac565b8015611bd55780601f10611baa57610100808354040283529160200191611bd5565b820191906000526020600020905b815481529060010190602001808311611bb857829003601f168201915b505050505081526020016003820154815260200160048201548152602001600582015481526020016006820154815260200160078201548152505084612421565b80611c2081613a45565b915050611a6c565b506112d360126000613071565b6000806000806000611c4c60fe8760800151612131565b9450945094509450945084611ca35760405162461bcd60e51b815260206004820152601960248201527f6661696c65642070726f6f6620766572696669636174696f6e000000000000006044820152606401610508565b85606001516001600160a01b0316846001600160a01b031614611cd85760405162461bcd60e51b815260040161050890613a5e565b85602001516009811115611cee57611cee613124565b8314611d0c5760405162461bcd60e51b815260040161050890613a89565b438210611d545760405162461bcd60e51b815260206004820152601660248201527563616e277420626520696e207468652066757475726560501b6044820152606401610508565b60008211611d9a5760405162461bcd60e51b815260206004820152601360248201527263616e27742062652061742067656e6573697360681b6044820152606401610508565b6001546040516396b477cb60e01b8152600481018490526000916001600160a01b0316906396b477cb90602401602060405180830381865afa158015611de4573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611e089190613766565b60c0880184905260e088018190524361010089015261012088018390529050611e30876129c3565b50505050505050565b6000806000806000611e5060fd8760800151612131565b9450945094509450945084611ea75760405162461bcd60e51b815260206004820152601d60248201527f6661696c656420696e6e6f63656e636520766572696669636174696f6e0000006044820152606401610508565b85606001516001600160a01b0316846001600160a01b031614611edc5760405162461bcd60e51b815260040161050890613a5e565b85602001516009811115611ef257611ef2613124565b8314611f105760405162461bcd60e51b815260040161050890613a89565b438210611f585760405162461bcd60e51b815260206004820152601660248201527563616e277420626520696e207468652066757475726560501b6044820152606401610508565b60c08601829052610120860181905243610100870152611f7786612c05565b505050505050565b6001546001600160a01b03163314611fa95760405162461bcd60e51b81526004016105089061377f565b60005b81518110156112d357600f6000838381518110611fcb57611fcb6137fd565b6020908102919091018101516001600160a01b031682528101919091526040016000205460ff161561203957600f600083838151811061200d5761200d6137fd565b6020908102919091018101516001600160a01b03168252810191909152604001600020805460ff191690555b8061204381613a45565b915050611fac565b6001546001600160a01b031633146120755760405162461bcd60e51b81526004016105089061377f565b60005b81518110156112d357600f6000838381518110612097576120976137fd565b6020908102919091018101516001600160a01b031682528101919091526040016000205460ff16151560011461211f576001600f60008484815181106120df576120df6137fd565b60200260200101516001600160a01b03166001600160a01b0316815260200190815260200160002060006101000a81548160ff0219169083151502179055505b8061212981613a45565b915050612078565b600080600080600080865160206121489190613813565b905061215261308f565b60a081838a8c5afa61216357600080fd5b805160010361217157600196505b602081015160408201516060830151608090930151989b919a509850909695509350505050565b60608101516001600160a01b03166000908152600c6020526040902054156122025760405162461bcd60e51b815260206004820181905260248201527f616c72656164792070726f63657373696e6720616e2061636375736174696f6e6044820152606401610508565b600061221182602001516115da565b60608301516001600160a01b0316600090815260116020908152604080832060e08701518452909152902054909150811161225e5760405162461bcd60e51b815260040161050890613aca565b6002805460a08401819052600180820183556000839052845160089092027f405787fa12a823e0f2b7631cc41b3ba8828b3321ca811111fa75cd3aa3bb5ace0180548694919392849260ff199092169184908111156122bf576122bf613124565b021790555060208201518154829061ff0019166101008360098111156122e7576122e7613124565b02179055506040820151815462010000600160b01b031916620100006001600160a01b039283160217825560608301516001830180546001600160a01b03191691909216179055608082015160028201906123429082613b59565b5060a082810151600383015560c0830151600483015560e08301516005830155610100830151600683015561012090920151600790910155820151612388906001613813565b60608301516001600160a01b03166000908152600c602052604090205560a08201516013906123b8906001613813565b815460018101835560009283526020928390200155606083015160a084015160408051858152938401919091526001600160a01b03909116917f2e8e354b41470731dafa7c3df150e9498a8d5b9c51ff0259fbf77f721ba4035191015b60405180910390a25050565b6001546060830151604051630c825d9760e11b81526001600160a01b0391821660048201526000929190911690631904bb2e90602401600060405180830381865afa158015612474573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f1916820160405261249c919081019061388d565b60408481015160608601516001600160a01b039081166000908152600a6020529290922080546001600160a01b031916929091169190911790559050600381610260015160038111156124f1576124f1613124565b036124fb57505050565b600061251261250d85602001516115da565b612f0d565b6102208301516007549192509060009061252c9083613ab3565b6006546125399087613ab3565b6125439085613813565b61254d9190613813565b60095490915081111561255f57506009545b60008461012001518560c001518660a0015161257b9190613813565b6125859190613813565b6009549091506000906125988385613ab3565b6125a29190613c19565b90506000811180156125b357508181145b156126e557600060a087018190526101008701819052610120870181905260c08701526101e0860180518291906125eb908390613813565b9052506102208601805160019190612604908390613813565b905250600361026087015260006102008701526001546040516301adf0b760e51b81526001600160a01b03909116906335be16e090612647908990600401613c4b565b600060405180830381600087803b15801561266157600080fd5b505af1158015612675573d6000803e3d6000fd5b5050505060208681015160a08a810151604080516001600160a01b03909416845293830185905260008385015260016060840152608083015291517f6617e612ea2d01b5a235997fa4963b56b1097df6f968a82972433e9ff852e0f9929181900390910190a15050505050505050565b610120860151819081116127125780876101200181815161270691906137ea565b9052506000905061272d565b61012087015161272290826137ea565b600061012089015290505b80156127aa5780876101000151106127755780876101000181815161275291906137ea565b90525060a0870180518291906127699083906137ea565b905250600090506127aa565b61010087015161278590826137ea565b90508661010001518760a00181815161279e91906137ea565b90525060006101008801525b6000811180156127cd575060008760a001518860c001516127cb9190613813565b115b156128795760008760a001518860c001516127e89190613813565b60c08901516127f79084613ab3565b6128019190613c19565b905060008860a001518960c001516128199190613813565b60a08a01516128289085613ab3565b6128329190613c19565b9050818960c00181815161284691906137ea565b90525060a08901805182919061285d9083906137ea565b90525061286a8183613813565b61287490846137ea565b925050505b61288381836137ea565b915081876101e0018181516128989190613813565b90525061022087018051600191906128b1908390613813565b9052506000546102208801516008546128ca9190613ab3565b6128d49190613ab3565b6128de9043613813565b61020088015260026102608801526001546040516301adf0b760e51b81526001600160a01b03909116906335be16e09061291c908a90600401613c4b565b600060405180830381600087803b15801561293657600080fd5b505af115801561294a573d6000803e3d6000fd5b5050506020808901516102008a015160a0808e0151604080516001600160a01b039095168552948401889052938301919091526000606083015260808201929092527f6617e612ea2d01b5a235997fa4963b56b1097df6f968a82972433e9ff852e0f992500160405180910390a1505050505050505050565b60006129d282602001516115da565b60608301516001600160a01b0316600090815260116020908152604080832060e087015184529091529020549091508111612a1f5760405162461bcd60e51b815260040161050890613aca565b6002805460a08401819052600180820183556000839052845160089092027f405787fa12a823e0f2b7631cc41b3ba8828b3321ca811111fa75cd3aa3bb5ace0180548694919392849260ff19909216918490811115612a8057612a80613124565b021790555060208201518154829061ff001916610100836009811115612aa857612aa8613124565b02179055506040820151815462010000600160b01b031916620100006001600160a01b039283160217825560608301516001830180546001600160a01b0319169190921617905560808201516002820190612b039082613b59565b5060a082810151600383015560c0830151600483015560e08084015160058401556101008401516006840155610120909301516007909201919091556060840180516001600160a01b039081166000908152600b602090815260408083209589018051875460018181018a559886528486200155805160128054988901815585527fbb8a6a4669ba250d26cd7a459eca9d215f8307e33aebe50379bc5a3617ec344490970196909655845184168352601182528083209689015183529590528490208590559051915192519116917f6b7783718ab8e152c193eb08bf76eed1191fcd1677a23a7fe9d338265aad132f9161241591858252602082015260400190565b60608101516001600160a01b03166000908152600c602052604081205490819003612c725760405162461bcd60e51b815260206004820152601860248201527f6e6f206173736f6369617465642061636375736174696f6e00000000000000006044820152606401610508565b81602001516009811115612c8857612c88613124565b6002612c956001846137ea565b81548110612ca557612ca56137fd565b6000918252602090912060089091020154610100900460ff166009811115612ccf57612ccf613124565b14612d2c5760405162461bcd60e51b815260206004820152602760248201527f756e6d61746368696e672070726f6f6620616e642061636375736174696f6e206044820152661c9d5b19481a5960ca1b6064820152608401610508565b60c08201516002612d3e6001846137ea565b81548110612d4e57612d4e6137fd565b90600052602060002090600802016004015414612dbb5760405162461bcd60e51b815260206004820152602560248201527f756e6d61746368696e672070726f6f6620616e642061636375736174696f6e20604482015264626c6f636b60d81b6064820152608401610508565b6101208201516002612dce6001846137ea565b81548110612dde57612dde6137fd565b90600052602060002090600802016007015414612e495760405162461bcd60e51b8152602060048201526024808201527f756e6d61746368696e672070726f6f6620616e642061636375736174696f6e206044820152630d0c2e6d60e31b6064820152608401610508565b6014545b601354811015612eb3578160138281548110612e6b57612e6b6137fd565b906000526020600020015403612ea157600060138281548110612e9057612e906137fd565b600091825260209091200155612eb3565b80612eab81613a45565b915050612e4d565b506060820180516001600160a01b039081166000908152600c602090815260408083208390559351935191825292909116917f1fa96beb8dddcb7d4484dd00c4059e872439f7a474a2ecf49c430fc6e86c9e1f9101612415565b600081612f1c57505060055490565b60018203612f2c57505060055490565b60028203612f3c57505060055490565b60038203612f4c57505060055490565b60048203612f5d5750612710919050565b50612710919050565b60408051610140810190915280600081526020016000815260200160006001600160a01b0316815260200160006001600160a01b031681526020016060815260200160008152602001600081526020016000815260200160008152602001600081525090565b82805482825590600052602060002090810192821561300c5760005260206000209182015b8281111561300c578254825591600101919060010190612ff1565b506130189291506130ad565b5090565b82805482825590600052602060002090810192821561300c579160200282015b8281111561300c57825182546001600160a01b0319166001600160a01b0390911617825560209092019160019091019061303c565b50805460008255906000526020600020908101906105a891906130ad565b6040518060a001604052806005906020820280368337509192915050565b5b8082111561301857600081556001016130ae565b6001600160a01b03811681146105a857600080fd5b80356130e2816130c2565b919050565b6000602082840312156130f957600080fd5b8135613104816130c2565b9392505050565b60006020828403121561311d57600080fd5b5035919050565b634e487b7160e01b600052602160045260246000fd5b6003811061314a5761314a613124565b9052565b600a811061314a5761314a613124565b60005b83811015613179578181015183820152602001613161565b50506000910152565b6000815180845261319a81602086016020860161315e565b601f01601f19169290920160200192915050565b60006101406131bd838e61313a565b6131ca602084018d61314e565b6001600160a01b038b811660408501528a166060840152608083018190526131f48184018a613182565b60a0840198909852505060c081019490945260e08401929092526101008301526101209091015295945050505050565b634e487b7160e01b600052604160045260246000fd5b604051610140810167ffffffffffffffff8111828210171561325e5761325e613224565b60405290565b604051610280810167ffffffffffffffff8111828210171561325e5761325e613224565b604051601f8201601f1916810167ffffffffffffffff811182821017156132b1576132b1613224565b604052919050565b8035600381106130e257600080fd5b8035600a81106130e257600080fd5b600067ffffffffffffffff8211156132f1576132f1613224565b50601f01601f191660200190565b600082601f83011261331057600080fd5b813561332361331e826132d7565b613288565b81815284602083860101111561333857600080fd5b816020850160208301376000918101602001919091529392505050565b60006020828403121561336757600080fd5b813567ffffffffffffffff8082111561337f57600080fd5b90830190610140828603121561339457600080fd5b61339c61323a565b6133a5836132b9565b81526133b3602084016132c8565b60208201526133c4604084016130d7565b60408201526133d5606084016130d7565b60608201526080830135828111156133ec57600080fd5b6133f8878286016132ff565b60808301525060a0838101359082015260c0808401359082015260e0808401359082015261010080840135908201526101209283013592810192909252509392505050565b60008060006060848603121561345257600080fd5b833561345d816130c2565b925061346b602085016132c8565b9150604084013590509250925092565b80151581146105a857600080fd5b60006020828403121561349b57600080fd5b81356131048161347b565b60006101406134b684845161313a565b60208301516134c8602086018261314e565b5060408301516134e360408601826001600160a01b03169052565b5060608301516134fe60608601826001600160a01b03169052565b50608083015181608086015261351682860182613182565b91505060a083015160a085015260c083015160c085015260e083015160e08501526101008084015181860152506101208084015181860152508091505092915050565b60208152600061310460208301846134a6565b6000806040838503121561357f57600080fd5b823561358a816130c2565b946020939093013593505050565b6000602080830181845280855180835260408601915060408160051b870101925083870160005b828110156135ed57603f198886030184526135db8583516134a6565b945092850192908501906001016135bf565b5092979650505050505050565b6000602080838503121561360d57600080fd5b823567ffffffffffffffff8082111561362557600080fd5b818501915085601f83011261363957600080fd5b81358181111561364b5761364b613224565b8060051b915061365c848301613288565b818152918301840191848101908884111561367657600080fd5b938501935b838510156136a05784359250613690836130c2565b828252938501939085019061367b565b98975050505050505050565b600181811c908216806136c057607f821691505b6020821081036136e057634e487b7160e01b600052602260045260246000fd5b50919050565b60208082526029908201527f66756e6374696f6e207265737472696374656420746f206120636f6d6d69747460408201526832b29036b2b6b132b960b91b606082015260800190565b6020808252601d908201527f6576656e74207265706f72746572206d7573742062652063616c6c6572000000604082015260600190565b60006020828403121561377857600080fd5b5051919050565b60208082526035908201527f66756e6374696f6e207265737472696374656420746f20746865206175746f6e6040820152741a5d1e481c1c9bdd1bd8dbdb0818dbdb9d1c9858dd605a1b606082015260800190565b634e487b7160e01b600052601160045260246000fd5b818103818111156115f8576115f86137d4565b634e487b7160e01b600052603260045260246000fd5b808201808211156115f8576115f86137d4565b80516130e2816130c2565b600082601f83011261384257600080fd5b815161385061331e826132d7565b81815284602083860101111561386557600080fd5b61387682602083016020870161315e565b949350505050565b8051600481106130e257600080fd5b60006020828403121561389f57600080fd5b815167ffffffffffffffff808211156138b757600080fd5b9083019061028082860312156138cc57600080fd5b6138d4613264565b6138dd83613826565b81526138eb60208401613826565b60208201526138fc60408401613826565b604082015260608301518281111561391357600080fd5b61391f87828601613831565b6060830152506080830151608082015260a083015160a082015260c083015160c082015260e083015160e082015261010080840151818301525061012080840151818301525061014080840151818301525061016080840151818301525061018061398b818501613826565b908201526101a083810151908201526101c080840151908201526101e080840151908201526102008084015190820152610220808401519082015261024080840151838111156139da57600080fd5b6139e688828701613831565b82840152505061026091506139fc82840161387e565b91810191909152949350505050565b600060208284031215613a1d57600080fd5b81516131048161347b565b600060208284031215613a3a57600080fd5b8151613104816130c2565b600060018201613a5757613a576137d4565b5060010190565b6020808252601190820152700decccccadcc8cae440dad2e6dac2e8c6d607b1b604082015260600190565b60208082526010908201526f0e4ead8ca40d2c840dad2e6dac2e8c6d60831b604082015260600190565b80820281158282048414176115f8576115f86137d4565b60208082526024908201527f616c726561647920736c6173686564206174207468652070726f6f66277320656040820152630e0dec6d60e31b606082015260800190565b601f821115613b5457600081815260208120601f850160051c81016020861015613b355750805b601f850160051c820191505b81811015611f7757828155600101613b41565b505050565b815167ffffffffffffffff811115613b7357613b73613224565b613b8781613b8184546136ac565b84613b0e565b602080601f831160018114613bbc5760008415613ba45750858301515b600019600386901b1c1916600185901b178555611f77565b600085815260208120601f198616915b82811015613beb57888601518255948401946001909101908401613bcc565b5085821015613c095787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b600082613c3657634e487b7160e01b600052601260045260246000fd5b500490565b6004811061314a5761314a613124565b60208152613c656020820183516001600160a01b03169052565b60006020830151613c8160408401826001600160a01b03169052565b5060408301516001600160a01b0381166060840152506060830151610280806080850152613cb36102a0850183613182565b9150608085015160a085015260a085015160c085015260c085015160e085015260e08501516101008181870152808701519150506101208181870152808701519150506101408181870152808701519150506101608181870152808701519150506101808181870152808701519150506101a0613d3a818701836001600160a01b03169052565b8601516101c0868101919091528601516101e080870191909152860151610200808701919091528601516102208087019190915286015161024080870191909152860151858403601f190161026080880191909152909150613d9c8483613182565b935080870151915050613db182860182613c3b565b509094935050505056fea26469706673582212204a0d9601465f431e78fd108b99734d12cafc84b8be49dbbccaeb03ac294dffc764736f6c63430008150033",
 }
 
 // AccountabilityABI is the input ABI used to generate the binding from.
@@ -2207,7 +2231,7 @@ func (_Accountability *AccountabilityFilterer) ParseSlashingEvent(log types.Log)
 
 // AutonityMetaData contains all meta data concerning the Autonity contract.
 var AutonityMetaData = &bind.MetaData{
-	ABI: "[{\"inputs\":[{\"components\":[{\"internalType\":\"addresspayable\",\"name\":\"treasury\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"nodeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"oracleAddress\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"enode\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"commissionRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfBondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStakeLocked\",\"type\":\"uint256\"},{\"internalType\":\"addresspayable\",\"name\":\"liquidStateContract\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"liquidSupply\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"registrationBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"totalSlashed\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"jailReleaseBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"provableFaultCount\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"},{\"internalType\":\"enumValidatorState\",\"name\":\"state\",\"type\":\"uint8\"}],\"internalType\":\"structAutonity.Validator[]\",\"name\":\"_validators\",\"type\":\"tuple[]\"},{\"components\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"treasuryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"minBaseFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"delegationRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingPeriod\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"initialInflationReserve\",\"type\":\"uint256\"},{\"internalType\":\"addresspayable\",\"name\":\"treasuryAccount\",\"type\":\"address\"}],\"internalType\":\"structAutonity.Policy\",\"name\":\"policy\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"contractIAccountability\",\"name\":\"accountabilityContract\",\"type\":\"address\"},{\"internalType\":\"contractIOracle\",\"name\":\"oracleContract\",\"type\":\"address\"},{\"internalType\":\"contractIACU\",\"name\":\"acuContract\",\"type\":\"address\"},{\"internalType\":\"contractISupplyControl\",\"name\":\"supplyControlContract\",\"type\":\"address\"},{\"internalType\":\"contractIStabilization\",\"name\":\"stabilizationContract\",\"type\":\"address\"},{\"internalType\":\"contractUpgradeManager\",\"name\":\"upgradeManagerContract\",\"type\":\"address\"},{\"internalType\":\"contractIInflationController\",\"name\":\"inflationControllerContract\",\"type\":\"address\"},{\"internalType\":\"contractINonStakableVestingVault\",\"name\":\"nonStakableVestingContract\",\"type\":\"address\"}],\"internalType\":\"structAutonity.Contracts\",\"name\":\"contracts\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"address\",\"name\":\"operatorAccount\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"epochPeriod\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"blockPeriod\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"committeeSize\",\"type\":\"uint256\"}],\"internalType\":\"structAutonity.Protocol\",\"name\":\"protocol\",\"type\":\"tuple\"},{\"internalType\":\"uint256\",\"name\":\"contractVersion\",\"type\":\"uint256\"}],\"internalType\":\"structAutonity.Config\",\"name\":\"_config\",\"type\":\"tuple\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"treasury\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"effectiveBlock\",\"type\":\"uint256\"}],\"name\":\"ActivatedValidator\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"selfBonded\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"AppliedUnbondingReverted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"enumValidatorState\",\"name\":\"state\",\"type\":\"uint8\"}],\"name\":\"BondingRejected\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"BondingReverted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"BurnedStake\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"methodSignature\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"returnData\",\"type\":\"bytes\"}],\"name\":\"CallFailed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"rate\",\"type\":\"uint256\"}],\"name\":\"CommissionRateChange\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"period\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"toBeAppliedAtBlock\",\"type\":\"uint256\"}],\"name\":\"EpochPeriodUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"gasPrice\",\"type\":\"uint256\"}],\"name\":\"MinimumBaseFeeUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"MintedStake\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"selfBonded\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"NewBondingRequest\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"epoch\",\"type\":\"uint256\"}],\"name\":\"NewEpoch\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"selfBonded\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"NewUnbondingRequest\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"treasury\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"effectiveBlock\",\"type\":\"uint256\"}],\"name\":\"PausedValidator\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"treasury\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"oracleAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"enode\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"liquidStateContract\",\"type\":\"address\"}],\"name\":\"RegisteredValidator\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"selfBonded\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"ReleasedUnbondingReverted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"atnAmount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"ntnAmount\",\"type\":\"uint256\"}],\"name\":\"Rewarded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"selfBonded\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"UnbondingRejected\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"epochTime\",\"type\":\"uint256\"}],\"name\":\"UnlockingScheduleFailed\",\"type\":\"event\"},{\"stateMutability\":\"payable\",\"type\":\"fallback\"},{\"inputs\":[],\"name\":\"COMMISSION_RATE_PRECISION\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_contract\",\"type\":\"address\"}],\"name\":\"SetLiquidLogicContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"activateValidator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"atnTotalRedistributed\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"bond\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"burn\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_rate\",\"type\":\"uint256\"}],\"name\":\"changeCommissionRate\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"completeContractUpgrade\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"computeCommittee\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"},{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"config\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"treasuryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"minBaseFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"delegationRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingPeriod\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"initialInflationReserve\",\"type\":\"uint256\"},{\"internalType\":\"addresspayable\",\"name\":\"treasuryAccount\",\"type\":\"address\"}],\"internalType\":\"structAutonity.Policy\",\"name\":\"policy\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"contractIAccountability\",\"name\":\"accountabilityContract\",\"type\":\"address\"},{\"internalType\":\"contractIOracle\",\"name\":\"oracleContract\",\"type\":\"address\"},{\"internalType\":\"contractIACU\",\"name\":\"acuContract\",\"type\":\"address\"},{\"internalType\":\"contractISupplyControl\",\"name\":\"supplyControlContract\",\"type\":\"address\"},{\"internalType\":\"contractIStabilization\",\"name\":\"stabilizationContract\",\"type\":\"address\"},{\"internalType\":\"contractUpgradeManager\",\"name\":\"upgradeManagerContract\",\"type\":\"address\"},{\"internalType\":\"contractIInflationController\",\"name\":\"inflationControllerContract\",\"type\":\"address\"},{\"internalType\":\"contractINonStakableVestingVault\",\"name\":\"nonStakableVestingContract\",\"type\":\"address\"}],\"internalType\":\"structAutonity.Contracts\",\"name\":\"contracts\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"address\",\"name\":\"operatorAccount\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"epochPeriod\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"blockPeriod\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"committeeSize\",\"type\":\"uint256\"}],\"internalType\":\"structAutonity.Protocol\",\"name\":\"protocol\",\"type\":\"tuple\"},{\"internalType\":\"uint256\",\"name\":\"contractVersion\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"deployer\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"epochID\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"epochPeriodToBeApplied\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"epochReward\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"epochTotalBondedStake\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"finalize\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"},{\"components\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"votingPower\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"}],\"internalType\":\"structAutonity.CommitteeMember[]\",\"name\":\"\",\"type\":\"tuple[]\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"finalizeInitialization\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getBlockPeriod\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getCommittee\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"votingPower\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"}],\"internalType\":\"structAutonity.CommitteeMember[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getCommitteeEnodes\",\"outputs\":[{\"internalType\":\"string[]\",\"name\":\"\",\"type\":\"string[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_height\",\"type\":\"uint256\"}],\"name\":\"getEpochByHeight\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"votingPower\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"}],\"internalType\":\"structAutonity.CommitteeMember[]\",\"name\":\"\",\"type\":\"tuple[]\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_block\",\"type\":\"uint256\"}],\"name\":\"getEpochFromBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getEpochInfo\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"votingPower\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"}],\"internalType\":\"structAutonity.CommitteeMember[]\",\"name\":\"\",\"type\":\"tuple[]\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getEpochPeriod\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getLastEpochBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMaxCommitteeSize\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMinimumBaseFee\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getNewContract\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getNextEpochBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getOperator\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getOracle\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_unbondingID\",\"type\":\"uint256\"}],\"name\":\"getRevertingAmount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getTreasuryAccount\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getTreasuryFee\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getUnbondingPeriod\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_unbondingID\",\"type\":\"uint256\"}],\"name\":\"getUnbondingReleaseState\",\"outputs\":[{\"internalType\":\"enumAutonity.UnbondingReleaseState\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"getValidator\",\"outputs\":[{\"components\":[{\"internalType\":\"addresspayable\",\"name\":\"treasury\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"nodeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"oracleAddress\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"enode\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"commissionRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfBondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStakeLocked\",\"type\":\"uint256\"},{\"internalType\":\"addresspayable\",\"name\":\"liquidStateContract\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"liquidSupply\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"registrationBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"totalSlashed\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"jailReleaseBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"provableFaultCount\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"},{\"internalType\":\"enumValidatorState\",\"name\":\"state\",\"type\":\"uint8\"}],\"internalType\":\"structAutonity.Validator\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getValidators\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getVersion\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"inflationReserve\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"lastEpochTime\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"lastFinalizedBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"liquidLogicContract\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"maxBondAppliedGas\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"maxRewardsDistributionGas\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"maxUnbondAppliedGas\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"maxUnbondReleasedGas\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"mint\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"pauseValidator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"receiveATN\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_enode\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_oracleAddress\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"_consensusKey\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"_signatures\",\"type\":\"bytes\"}],\"name\":\"registerValidator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"resetContractUpgrade\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIAccountability\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setAccountabilityContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIACU\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setAcuContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_size\",\"type\":\"uint256\"}],\"name\":\"setCommitteeSize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_period\",\"type\":\"uint256\"}],\"name\":\"setEpochPeriod\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIInflationController\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setInflationControllerContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_gas\",\"type\":\"uint256\"}],\"name\":\"setMaxBondAppliedGas\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_gas\",\"type\":\"uint256\"}],\"name\":\"setMaxRewardsDistributionGas\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_gas\",\"type\":\"uint256\"}],\"name\":\"setMaxUnbondAppliedGas\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_gas\",\"type\":\"uint256\"}],\"name\":\"setMaxUnbondReleasedGas\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_price\",\"type\":\"uint256\"}],\"name\":\"setMinimumBaseFee\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractINonStakableVestingVault\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setNonStakableVestingContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"setOperatorAccount\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"addresspayable\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setOracleContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIStabilization\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setStabilizationContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_price\",\"type\":\"uint256\"}],\"name\":\"setStakingGasPrice\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractISupplyControl\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setSupplyControlContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"addresspayable\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"setTreasuryAccount\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_treasuryFee\",\"type\":\"uint256\"}],\"name\":\"setTreasuryFee\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_period\",\"type\":\"uint256\"}],\"name\":\"setUnbondingPeriod\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractUpgradeManager\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setUpgradeManagerContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"stakingGasPrice\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_sender\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"unbond\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_nodeAddress\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_enode\",\"type\":\"string\"}],\"name\":\"updateEnode\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"addresspayable\",\"name\":\"treasury\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"nodeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"oracleAddress\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"enode\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"commissionRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfBondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStakeLocked\",\"type\":\"uint256\"},{\"internalType\":\"addresspayable\",\"name\":\"liquidStateContract\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"liquidSupply\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"registrationBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"totalSlashed\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"jailReleaseBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"provableFaultCount\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"},{\"internalType\":\"enumValidatorState\",\"name\":\"state\",\"type\":\"uint8\"}],\"internalType\":\"structAutonity.Validator\",\"name\":\"_val\",\"type\":\"tuple\"}],\"name\":\"updateValidatorAndTransferSlashedFunds\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"_bytecode\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"_abi\",\"type\":\"string\"}],\"name\":\"upgradeContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"stateMutability\":\"payable\",\"type\":\"receive\"}]",
+	ABI: "[{\"inputs\":[{\"components\":[{\"internalType\":\"addresspayable\",\"name\":\"treasury\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"nodeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"oracleAddress\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"enode\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"commissionRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfBondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStakeLocked\",\"type\":\"uint256\"},{\"internalType\":\"addresspayable\",\"name\":\"liquidStateContract\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"liquidSupply\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"registrationBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"totalSlashed\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"jailReleaseBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"provableFaultCount\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"},{\"internalType\":\"enumValidatorState\",\"name\":\"state\",\"type\":\"uint8\"}],\"internalType\":\"structAutonity.Validator[]\",\"name\":\"_validators\",\"type\":\"tuple[]\"},{\"components\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"treasuryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"minBaseFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"delegationRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingPeriod\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"initialInflationReserve\",\"type\":\"uint256\"},{\"internalType\":\"addresspayable\",\"name\":\"treasuryAccount\",\"type\":\"address\"}],\"internalType\":\"structAutonity.Policy\",\"name\":\"policy\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"contractIAccountability\",\"name\":\"accountabilityContract\",\"type\":\"address\"},{\"internalType\":\"contractIOracle\",\"name\":\"oracleContract\",\"type\":\"address\"},{\"internalType\":\"contractIACU\",\"name\":\"acuContract\",\"type\":\"address\"},{\"internalType\":\"contractISupplyControl\",\"name\":\"supplyControlContract\",\"type\":\"address\"},{\"internalType\":\"contractIStabilization\",\"name\":\"stabilizationContract\",\"type\":\"address\"},{\"internalType\":\"contractUpgradeManager\",\"name\":\"upgradeManagerContract\",\"type\":\"address\"},{\"internalType\":\"contractIInflationController\",\"name\":\"inflationControllerContract\",\"type\":\"address\"},{\"internalType\":\"contractINonStakableVestingVault\",\"name\":\"nonStakableVestingContract\",\"type\":\"address\"}],\"internalType\":\"structAutonity.Contracts\",\"name\":\"contracts\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"address\",\"name\":\"operatorAccount\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"epochPeriod\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"blockPeriod\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"committeeSize\",\"type\":\"uint256\"}],\"internalType\":\"structAutonity.Protocol\",\"name\":\"protocol\",\"type\":\"tuple\"},{\"internalType\":\"uint256\",\"name\":\"contractVersion\",\"type\":\"uint256\"}],\"internalType\":\"structAutonity.Config\",\"name\":\"_config\",\"type\":\"tuple\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"treasury\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"effectiveBlock\",\"type\":\"uint256\"}],\"name\":\"ActivatedValidator\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"selfBonded\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"AppliedUnbondingReverted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"enumValidatorState\",\"name\":\"state\",\"type\":\"uint8\"}],\"name\":\"BondingRejected\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"BondingReverted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"BurnedStake\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"methodSignature\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"returnData\",\"type\":\"bytes\"}],\"name\":\"CallFailed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"rate\",\"type\":\"uint256\"}],\"name\":\"CommissionRateChange\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"proposeInitial\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"proposeDelta\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"prevoteInitial\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"prevoteDelta\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"precommitInitial\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"precommitDelta\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"adaptive\",\"type\":\"bool\"}],\"indexed\":false,\"internalType\":\"structAutonity.ConsensusTimeouts\",\"name\":\"timeouts\",\"type\":\"tuple\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"toBeAppliedAtBlock\",\"type\":\"uint256\"}],\"name\":\"ConsensusTimeoutsUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"period\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"toBeAppliedAtBlock\",\"type\":\"uint256\"}],\"name\":\"EpochPeriodUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"gasPrice\",\"type\":\"uint256\"}],\"name\":\"MinimumBaseFeeUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"MintedStake\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"selfBonded\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"NewBondingRequest\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"epoch\",\"type\":\"uint256\"}],\"name\":\"NewEpoch\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"selfBonded\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"NewUnbondingRequest\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"treasury\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"effectiveBlock\",\"type\":\"uint256\"}],\"name\":\"PausedValidator\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"treasury\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"oracleAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"enode\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"liquidStateContract\",\"type\":\"address\"}],\"name\":\"RegisteredValidator\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"selfBonded\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"ReleasedUnbondingReverted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"atnAmount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"ntnAmount\",\"type\":\"uint256\"}],\"name\":\"Rewarded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"selfBonded\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"UnbondingRejected\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"epochTime\",\"type\":\"uint256\"}],\"name\":\"UnlockingScheduleFailed\",\"type\":\"event\"},{\"stateMutability\":\"payable\",\"type\":\"fallback\"},{\"inputs\":[],\"name\":\"COMMISSION_RATE_PRECISION\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_contract\",\"type\":\"address\"}],\"name\":\"SetLiquidLogicContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"activateValidator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"atnTotalRedistributed\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"bond\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"burn\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_rate\",\"type\":\"uint256\"}],\"name\":\"changeCommissionRate\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"completeContractUpgrade\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"computeCommittee\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"},{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"config\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"treasuryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"minBaseFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"delegationRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingPeriod\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"initialInflationReserve\",\"type\":\"uint256\"},{\"internalType\":\"addresspayable\",\"name\":\"treasuryAccount\",\"type\":\"address\"}],\"internalType\":\"structAutonity.Policy\",\"name\":\"policy\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"contractIAccountability\",\"name\":\"accountabilityContract\",\"type\":\"address\"},{\"internalType\":\"contractIOracle\",\"name\":\"oracleContract\",\"type\":\"address\"},{\"internalType\":\"contractIACU\",\"name\":\"acuContract\",\"type\":\"address\"},{\"internalType\":\"contractISupplyControl\",\"name\":\"supplyControlContract\",\"type\":\"address\"},{\"internalType\":\"contractIStabilization\",\"name\":\"stabilizationContract\",\"type\":\"address\"},{\"internalType\":\"contractUpgradeManager\",\"name\":\"upgradeManagerContract\",\"type\":\"address\"},{\"internalType\":\"contractIInflationController\",\"name\":\"inflationControllerContract\",\"type\":\"address\"},{\"internalType\":\"contractINonStakableVestingVault\",\"name\":\"nonStakableVestingContract\",\"type\":\"address\"}],\"internalType\":\"structAutonity.Contracts\",\"name\":\"contracts\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"address\",\"name\":\"operatorAccount\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"epochPeriod\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"blockPeriod\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"committeeSize\",\"type\":\"uint256\"}],\"internalType\":\"structAutonity.Protocol\",\"name\":\"protocol\",\"type\":\"tuple\"},{\"internalType\":\"uint256\",\"name\":\"contractVersion\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"deployer\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"epochID\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"epochPeriodToBeApplied\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"epochReward\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"epochTotalBondedStake\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"finalize\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"},{\"components\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"votingPower\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"}],\"internalType\":\"structAutonity.CommitteeMember[]\",\"name\":\"\",\"type\":\"tuple[]\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"finalizeInitialization\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getBlockPeriod\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getCommittee\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"votingPower\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"}],\"internalType\":\"structAutonity.CommitteeMember[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getCommitteeEnodes\",\"outputs\":[{\"internalType\":\"string[]\",\"name\":\"\",\"type\":\"string[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getConsensusTimeouts\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"proposeInitial\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"proposeDelta\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"prevoteInitial\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"prevoteDelta\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"precommitInitial\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"precommitDelta\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"adaptive\",\"type\":\"bool\"}],\"internalType\":\"structAutonity.ConsensusTimeouts\",\"name\":\"\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"proposeInitial\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"proposeDelta\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"prevoteInitial\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"prevoteDelta\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"precommitInitial\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"precommitDelta\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"adaptive\",\"type\":\"bool\"}],\"internalType\":\"structAutonity.ConsensusTimeouts\",\"name\":\"\",\"type\":\"tuple\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_height\",\"type\":\"uint256\"}],\"name\":\"getEpochByHeight\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"votingPower\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"}],\"internalType\":\"structAutonity.CommitteeMember[]\",\"name\":\"\",\"type\":\"tuple[]\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_block\",\"type\":\"uint256\"}],\"name\":\"getEpochFromBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getEpochInfo\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"votingPower\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"}],\"internalType\":\"structAutonity.CommitteeMember[]\",\"name\":\"\",\"type\":\"tuple[]\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getEpochPeriod\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getLastEpochBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMaxCommitteeSize\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMinimumBaseFee\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getNewContract\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getNextEpochBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getOperator\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getOracle\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_unbondingID\",\"type\":\"uint256\"}],\"name\":\"getRevertingAmount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getTreasuryAccount\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getTreasuryFee\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getUnbondingPeriod\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_unbondingID\",\"type\":\"uint256\"}],\"name\":\"getUnbondingReleaseState\",\"outputs\":[{\"internalType\":\"enumAutonity.UnbondingReleaseState\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_unbondingID\",\"type\":\"uint256\"}],\"name\":\"getUnbondingRequest\",\"outputs\":[{\"components\":[{\"internalType\":\"addresspayable\",\"name\":\"delegator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"delegatee\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingShare\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"requestBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"revertingAmount\",\"type\":\"uint256\"},{\"internalType\":\"enumAutonity.UnbondingReleaseState\",\"name\":\"state\",\"type\":\"uint8\"},{\"internalType\":\"bool\",\"name\":\"unlocked\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"selfDelegation\",\"type\":\"bool\"}],\"internalType\":\"structAutonity.UnbondingRequest\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"getValidator\",\"outputs\":[{\"components\":[{\"internalType\":\"addresspayable\",\"name\":\"treasury\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"nodeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"oracleAddress\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"enode\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"commissionRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfBondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStakeLocked\",\"type\":\"uint256\"},{\"internalType\":\"addresspayable\",\"name\":\"liquidStateContract\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"liquidSupply\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"registrationBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"totalSlashed\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"jailReleaseBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"provableFaultCount\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"},{\"internalType\":\"enumValidatorState\",\"name\":\"state\",\"type\":\"uint8\"}],\"internalType\":\"structAutonity.Validator\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getValidators\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getVersion\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"inflationReserve\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"lastEpochTime\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"lastFinalizedBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"liquidLogicContract\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"maxBondAppliedGas\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"maxRewardsDistributionGas\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"maxUnbondAppliedGas\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"maxUnbondReleasedGas\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"mint\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"pauseValidator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"receiveATN\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_enode\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_oracleAddress\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"_consensusKey\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"_signatures\",\"type\":\"bytes\"}],\"name\":\"registerValidator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"resetContractUpgrade\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIAccountability\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setAccountabilityContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIACU\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setAcuContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_size\",\"type\":\"uint256\"}],\"name\":\"setCommitteeSize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"proposeInitial\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"proposeDelta\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"prevoteInitial\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"prevoteDelta\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"precommitInitial\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"precommitDelta\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"adaptive\",\"type\":\"bool\"}],\"internalType\":\"structAutonity.ConsensusTimeouts\",\"name\":\"_timeouts\",\"type\":\"tuple\"}],\"name\":\"setConsensusTimeouts\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_period\",\"type\":\"uint256\"}],\"name\":\"setEpochPeriod\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIInflationController\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setInflationControllerContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_gas\",\"type\":\"uint256\"}],\"name\":\"setMaxBondAppliedGas\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_gas\",\"type\":\"uint256\"}],\"name\":\"setMaxRewardsDistributionGas\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_gas\",\"type\":\"uint256\"}],\"name\":\"setMaxUnbondAppliedGas\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_gas\",\"type\":\"uint256\"}],\"name\":\"setMaxUnbondReleasedGas\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_price\",\"type\":\"uint256\"}],\"name\":\"setMinimumBaseFee\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractINonStakableVestingVault\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setNonStakableVestingContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"setOperatorAccount\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"addresspayable\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setOracleContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIStabilization\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setStabilizationContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_price\",\"type\":\"uint256\"}],\"name\":\"setStakingGasPrice\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractISupplyControl\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setSupplyControlContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"addresspayable\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"setTreasuryAccount\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_treasuryFee\",\"type\":\"uint256\"}],\"name\":\"setTreasuryFee\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_period\",\"type\":\"uint256\"}],\"name\":\"setUnbondingPeriod\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractUpgradeManager\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setUpgradeManagerContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"stakingGasPrice\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_sender\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"unbond\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_nodeAddress\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_enode\",\"type\":\"string\"}],\"name\":\"updateEnode\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"addresspayable\",\"name\":\"treasury\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"nodeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"oracleAddress\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"enode\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"commissionRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfBondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStakeLocked\",\"type\":\"uint256\"},{\"internalType\":\"addresspayable\",\"name\":\"liquidStateContract\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"liquidSupply\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"registrationBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"totalSlashed\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"jailReleaseBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"provableFaultCount\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"},{\"internalType\":\"enumValidatorState\",\"name\":\"state\",\"type\":\"uint8\"}],\"internalType\":\"structAutonity.Validator\",\"name\":\"_val\",\"type\":\"tuple\"}],\"name\":\"updateValidatorAndTransferSlashedFunds\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"_bytecode\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"_abi\",\"type\":\"string\"}],\"name\":\"upgradeContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"stateMutability\":\"payable\",\"type\":\"receive\"}]",
 	Sigs: map[string]string{
 		"2f2c3f2e": "COMMISSION_RATE_PRECISION()",
 		"f1d592a7": "SetLiquidLogicContract(address)",
@@ -2233,6 +2257,7 @@ var AutonityMetaData = &bind.MetaData{
 		"43645969": "getBlockPeriod()",
 		"ab8f6ffe": "getCommittee()",
 		"a8b2216e": "getCommitteeEnodes()",
+		"6be0487b": "getConsensusTimeouts()",
 		"affb1cf1": "getEpochByHeight(uint256)",
 		"96b477cb": "getEpochFromBlock(uint256)",
 		"a9fd1a8f": "getEpochInfo()",
@@ -2249,6 +2274,7 @@ var AutonityMetaData = &bind.MetaData{
 		"29070c6d": "getTreasuryFee()",
 		"6fd2c80b": "getUnbondingPeriod()",
 		"a9af1059": "getUnbondingReleaseState(uint256)",
+		"30bcb81c": "getUnbondingRequest(uint256)",
 		"1904bb2e": "getValidator(address)",
 		"b7ab4db5": "getValidators()",
 		"0d8e6e2c": "getVersion()",
@@ -2269,6 +2295,7 @@ var AutonityMetaData = &bind.MetaData{
 		"1250a28d": "setAccountabilityContract(address)",
 		"d372c07e": "setAcuContract(address)",
 		"8bac7dad": "setCommitteeSize(uint256)",
+		"8d7835ea": "setConsensusTimeouts((uint256,uint256,uint256,uint256,uint256,uint256,bool))",
 		"6b5f444c": "setEpochPeriod(uint256)",
 		"f03b959d": "setInflationControllerContract(address)",
 		"0fe50109": "setMaxBondAppliedGas(uint256)",
@@ -2296,7 +2323,7 @@ var AutonityMetaData = &bind.MetaData{
 		"35be16e0": "updateValidatorAndTransferSlashedFunds((address,address,address,string,uint256,uint256,uint256,uint256,uint256,uint256,uint256,uint256,address,uint256,uint256,uint256,uint256,uint256,bytes,uint8))",
 		"b2ea9adb": "upgradeContract(bytes,string)",
 	},
-	Bin: "0x608060405261c35060045561c35060055561c350600655614e2060075560006010556000601155633b9aca006037553480156200003b57600080fd5b506040516200c3d93803806200c3d98339810160408190526200005e91620010f5565b6024546000036200008757603d80546001600160a01b031916331790556200008782826200008f565b5050620015bc565b80518051601255602080820151601355604080830151601455606080840151601555608080850151601681905560a095860151601780546001600160a01b03199081166001600160a01b03938416179091558689015180516018805484169185169190911790558088015160198054841691851691909117905580870151601a8054841691851691909117905580860151601b8054841691851691909117905593840151601c8054831691841691909117905596830151601d8054891691831691909117905560c0830151601e8054891691831691909117905560e090920151601f805488169184169190911790558387015180518654909716969092169590951784559283015160218190558383015160225592810151602355840151602455602891909155603c9190915551620001c89062000d43565b604051809103906000f080158015620001e5573d6000803e3d6000fd5b50603e80546001600160a01b0319166001600160a01b039290921691909117905560005b8251811015620004cc5760008382815181106200022a576200022a62001332565b602002602001015160a00151905060008483815181106200024f576200024f62001332565b60200260200101516101a0018181525050600084838151811062000277576200027762001332565b602002602001015161018001906001600160a01b031690816001600160a01b0316815250506000848381518110620002b357620002b362001332565b602002602001015160a00181815250506000848381518110620002da57620002da62001332565b60209081029190910101516101c00152601454845185908490811062000304576200030462001332565b6020026020010151608001818152505060008483815181106200032b576200032b62001332565b6020026020010151610260019060038111156200034c576200034c62001348565b9081600381111562000362576200036262001348565b8152505060008483815181106200037d576200037d62001332565b6020026020010151610160018181525050620003bb848381518110620003a757620003a762001332565b6020026020010151620004d160201b60201c565b620003e8848381518110620003d457620003d462001332565b60200260200101516200060c60201b60201c565b806039600086858151811062000402576200040262001332565b6020026020010151600001516001600160a01b03166001600160a01b0316815260200190815260200160002060008282546200043f919062001374565b9250508190555080603b60008282546200045a919062001374565b92505081905550620004b48483815181106200047a576200047a62001332565b602002602001015160200151828685815181106200049c576200049c62001332565b602002602001015160000151620008b960201b60201c565b50508080620004c39062001390565b91505062000209565b505050565b6000620004e8826060015162000ad060201b60201c565b6001600160a01b039091166020840152905080156200053c5760405162461bcd60e51b815260206004820152600b60248201526a32b737b2329032b93937b960a91b60448201526064015b60405180910390fd5b6020808301516001600160a01b039081166000908152603a9092526040909120600101541615620005b05760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c7265616479207265676973746572656400000000604482015260640162000533565b61271082608001511115620006085760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e2072617465000000000000000000604482015260640162000533565b5050565b6101808101516001600160a01b03166200070b57603e546001600160a01b0316620006855760405162461bcd60e51b815260206004820152602260248201527f6c6971756964206c6f67696320636f6e7472616374206e6f74206465706c6f79604482015261195960f21b606482015260840162000533565b602554600090620006969062000b1e565b905081602001518260000151836080015183603e60009054906101000a90046001600160a01b0316604051620006cc9062000d51565b620006dc959493929190620013ac565b604051809103906000f080158015620006f9573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051602580546001808201835560009283527f401968ff42a154441da5f6c4c935ac46b8671f0e062baaa62a7545ba53bb6e4c90910180546001600160a01b03199081166001600160a01b0395861617909155845184168352603a90955260409182902086518154871690851617815593519084018054861691841691909117905584015160028301805490941691161790915560608201518291906003820190620007bd908262001496565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c820180546001600160a01b0319166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f82015561020082015160108201556102208201516011820155610240820151601282019062000887908262001496565b5061026082015160138201805460ff19166001836003811115620008af57620008af62001348565b0217905550505050565b6000808311620009185760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201526269766560e81b606482015260840162000533565b6001600160a01b038216600090815260396020526040902054831115620009825760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e63650000000000604482015260640162000533565b6001600160a01b03821660009081526039602052604081208054859290620009ac90849062001562565b9091555050604080516080810182526001600160a01b03808516825286811660208084019182528385018881524360608601908152600a805460009081526008909452968320865181549087166001600160a01b03199182161782559451600182018054919097169516949094179094555160028301559151600390910155825491929062000a3b8362001390565b90915550506001600160a01b038581166000818152603a6020908152604091829020548251908516948816948514808252918101899052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d910160405180910390a3833b1562000ab55762000ab5848762000c3e565b6001600a5462000ac6919062001562565b9695505050505050565b60008062000add62000d5f565b60008060ff9050604083875160208901845afa62000afa57600080fd5b505080516020909101516c0100000000000000000000000090910494909350915050565b60608160000362000b465750506040805180820190915260018152600360fc1b602082015290565b8160005b811562000b76578062000b5d8162001390565b915062000b6e9050600a836200158e565b915062000b4a565b6000816001600160401b0381111562000b935762000b9362000d7d565b6040519080825280601f01601f19166020018201604052801562000bbe576020820181803683370190505b5090505b841562000c365762000bd660018362001562565b915062000be5600a86620015a5565b62000bf290603062001374565b60f81b81838151811062000c0a5762000c0a62001332565b60200101906001600160f81b031916908160001a90535062000c2e600a866200158e565b945062000bc2565b949350505050565b6001600160a01b038216600090815260346020526040812054900362000cbc576001600160a01b03821660008181526034602052604081206001908190556035805491820181559091527fcfa4bec1d3298408bb5afcfcd9c430549c5b31f8aa5c5848151c0a55f473c34d0180546001600160a01b03191690911790555b6001600160a01b038083166000908152603260209081526040808320938516835292905290812054900362000608576001600160a01b03808316600081815260326020908152604080832094861680845294825280832060019081905593835260338252822080549384018155825290200180546001600160a01b03191690911790555050565b611734806200a6e383390190565b6105c2806200be1783390190565b60405180604001604052806002906020820280368337509192915050565b634e487b7160e01b600052604160045260246000fd5b604051608081016001600160401b038111828210171562000db85762000db862000d7d565b60405290565b60405160c081016001600160401b038111828210171562000db85762000db862000d7d565b60405161028081016001600160401b038111828210171562000db85762000db862000d7d565b604051601f8201601f191681016001600160401b038111828210171562000e345762000e3462000d7d565b604052919050565b6001600160a01b038116811462000e5257600080fd5b50565b805162000e628162000e3c565b919050565b60005b8381101562000e8457818101518382015260200162000e6a565b50506000910152565b600082601f83011262000e9f57600080fd5b81516001600160401b0381111562000ebb5762000ebb62000d7d565b62000ed0601f8201601f191660200162000e09565b81815284602083860101111562000ee657600080fd5b62000c3682602083016020870162000e67565b80516004811062000e6257600080fd5b600061010080838503121562000f1e57600080fd5b604051908101906001600160401b038211818310171562000f435762000f4362000d7d565b816040528092508351915062000f598262000e3c565b81815262000f6a6020850162000e55565b602082015262000f7d6040850162000e55565b604082015262000f906060850162000e55565b606082015262000fa36080850162000e55565b608082015262000fb660a0850162000e55565b60a082015262000fc960c0850162000e55565b60c082015262000fdc60e0850162000e55565b60e0820152505092915050565b60006080828403121562000ffc57600080fd5b6200100662000d93565b90508151620010158162000e3c565b8082525060208201516020820152604082015160408201526060820151606082015292915050565b60008183036102608112156200105257600080fd5b6200105c62000d93565b915060c08112156200106d57600080fd5b506200107862000dbe565b825181526020830151602082015260408301516040820152606083015160608201526080830151608082015260a0830151620010b48162000e3c565b60a08201528152620010ca8360c0840162000f09565b6020820152620010df836101c0840162000fe9565b6040820152610240820151606082015292915050565b6000806102808084860312156200110b57600080fd5b83516001600160401b03808211156200112357600080fd5b818601915086601f8301126200113857600080fd5b81516020828211156200114f576200114f62000d7d565b8160051b6200116082820162000e09565b928352848101820192828101908b8511156200117b57600080fd5b83870192505b8483101562001310578251868111156200119a57600080fd5b8701808d03601f1901891315620011b057600080fd5b620011ba62000de3565b620011c786830162000e55565b8152620011d76040830162000e55565b86820152620011e96060830162000e55565b60408201526080820151888111156200120157600080fd5b620012118f888386010162000e8d565b60608301525060a0820151608082015260c082015160a082015260e082015160c082015261010082015160e08201526101208201516101008201526101408201516101208201526101608201516101408201526101808201516101608201526200127f6101a0830162000e55565b6101808201526101c08201516101a08201526101e08201516101c08201526102008201516101e082015261022082015161020082015261024082015161022082015261026082015188811115620012d557600080fd5b620012e58f888386010162000e8d565b61024083015250620012f98a830162000ef9565b610260820152835250918301919083019062001181565b8099505050506200132489828a016200103d565b955050505050509250929050565b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052602160045260246000fd5b634e487b7160e01b600052601160045260246000fd5b808201808211156200138a576200138a6200135e565b92915050565b600060018201620013a557620013a56200135e565b5060010190565b600060018060a01b038088168352808716602084015285604084015260a0606084015284518060a0850152620013ea8160c086016020890162000e67565b9316608083015250601f91909101601f19160160c001949350505050565b600181811c908216806200141d57607f821691505b6020821081036200143e57634e487b7160e01b600052602260045260246000fd5b50919050565b601f821115620004cc57600081815260208120601f850160051c810160208610156200146d5750805b601f850160051c820191505b818110156200148e5782815560010162001479565b505050505050565b81516001600160401b03811115620014b257620014b262000d7d565b620014ca81620014c3845462001408565b8462001444565b602080601f831160018114620015025760008415620014e95750858301515b600019600386901b1c1916600185901b1785556200148e565b600085815260208120601f198616915b82811015620015335788860151825594840194600190910190840162001512565b5085821015620015525787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b818103818111156200138a576200138a6200135e565b634e487b7160e01b600052601260045260246000fd5b600082620015a057620015a062001578565b500490565b600082620015b757620015b762001578565b500690565b61911780620015cc6000396000f3fe608060405260043610620004ef5760003560e01c8063872cf0591162000287578063b46e5520116200015f578063d5f3948811620000cb578063e7f43c68116200008d578063e7f43c68146200117c578063e98712b2146200119c578063ee7ff28d14620011b4578063f03b959d14620011d9578063f1d592a714620011fe578063f7866ee3146200122357005b8063d5f3948814620010bb578063d861b0e814620010dd578063d886f8a214620010f5578063dd62ed3e146200111a578063dfb1a4d2146200116457005b8063cb696f541162000121578063cb696f541462000ff7578063ceaad455146200101c578063cef984501462001041578063cf9c57191462001059578063cfd19fb91462001071578063d372c07e146200109657005b8063b46e55201462000f46578063b66b3e791462000f6b578063b6ef8c671462000f93578063b7ab4db51462000fb8578063c9d97af41462000fdf57005b8063a5d059ca11620001ff578063ab8f6ffe11620001c1578063ab8f6ffe1462000e70578063ae1da0b51462000e97578063ae1f5fa01462000eaf578063affb1cf11462000ed7578063b2ea9adb1462000efc578063b3ecbadd1462000f2157005b8063a5d059ca1462000d9d578063a8b2216e1462000db4578063a9059cbb1462000ddb578063a9af10591462000e00578063a9fd1a8f1462000e4657005b806396f18a32116200024957806396f18a321462000d0f5780639ac310741462000d315780639c98e4711462000d495780639dc29fac1462000d61578063a515366a1462000d8657005b8063872cf0591462000c6757806389c614b81462000c7f5780638bac7dad1462000c9757806395d89b411462000cbc57806396b477cb1462000cea57005b806340c10f1911620003c757806370a08231116200033357806379502c5511620002f557806379502c5514620009e35780637e660ac91462000bad578063819b64631462000bd2578063833b1fce1462000be957806384467fdb1462000c1d578063852c48491462000c4257005b806370a08231146200091e57806371d1bc591462000958578063731b3a03146200097057806377e741c71462000999578063784304b514620009be57005b8063573963b81162000389578063573963b814620008755780635bdedd16146200088d5780636a929cef14620008a55780636b5f444c14620008ca5780636bb9cb0e14620008ef5780636fd2c80b146200090757005b806340c10f1914620007c45780634364596914620007e9578063496ccd9b14620008005780634bb278f31462000825578063520fdbbc146200085057005b8063161605e3116200046757806325ce1bb9116200042957806325ce1bb9146200071157806329070c6d146200073a5780632f2c3f2e1462000751578063313ce567146200076957806335be16e01462000787578063386a827b14620007ac57005b8063161605e314620004f757806318160ddd146200067c5780631904bb2e14620006935780631a0cf2e514620006c757806323b872dd14620006ec57005b80630fe5010911620004b15780630fe5010914620005de578063112206331462000603578063114eaf55146200061a5780631250a28d146200063f5780631604e416146200066457005b806306fdde0314620004f9578063095ea7b3146200053d5780630ae65e7a14620005735780630b21fb1d14620005985780630d8e6e2c14620005bd57005b36620004f757005b005b3480156200050657600080fd5b506040805180820190915260068152652732bbba37b760d11b60208201525b60405162000534919062007a7f565b60405180910390f35b3480156200054a57600080fd5b50620005626200055c36600462007ab1565b62001243565b604051901515815260200162000534565b3480156200058057600080fd5b50620004f76200059236600462007ae0565b6200125c565b348015620005a557600080fd5b50620004f7620005b736600462007b00565b62001303565b348015620005ca57600080fd5b506024545b60405190815260200162000534565b348015620005eb57600080fd5b50620004f7620005fd36600462007b00565b62001335565b3480156200061057600080fd5b50601354620005cf565b3480156200062757600080fd5b50620004f76200063936600462007b00565b62001367565b3480156200064c57600080fd5b50620004f76200065e36600462007ae0565b62001399565b3480156200067157600080fd5b50620005cf602f5481565b3480156200068957600080fd5b50603b54620005cf565b348015620006a057600080fd5b50620006b8620006b236600462007ae0565b620013ea565b60405162000534919062007b5e565b348015620006d457600080fd5b50620004f7620006e636600462007ae0565b62001698565b348015620006f957600080fd5b50620005626200070b36600462007cd7565b620016e7565b3480156200071e57600080fd5b506026546000908152602c6020526040902060030154620005cf565b3480156200074757600080fd5b50601254620005cf565b3480156200075e57600080fd5b50620005cf61271081565b3480156200077657600080fd5b506040516012815260200162000534565b3480156200079457600080fd5b50620004f7620007a636600462007d1d565b620017ec565b348015620007b957600080fd5b50620005cf60055481565b348015620007d157600080fd5b50620004f7620007e336600462007ab1565b620019c8565b348015620007f657600080fd5b50602254620005cf565b3480156200080d57600080fd5b50620004f76200081f36600462007ae0565b62001a05565b3480156200083257600080fd5b506200083d62001b0f565b6040516200053495949392919062007dde565b3480156200085d57600080fd5b50620004f76200086f36600462007ae0565b6200233e565b3480156200088257600080fd5b50620005cf60285481565b3480156200089a57600080fd5b50620005cf60075481565b348015620008b257600080fd5b50620004f7620008c436600462007b00565b62002539565b348015620008d757600080fd5b50620004f7620008e936600462007b00565b6200256b565b348015620008fc57600080fd5b50620005cf60375481565b3480156200091457600080fd5b50601554620005cf565b3480156200092b57600080fd5b50620005cf6200093d36600462007ae0565b6001600160a01b031660009081526039602052604090205490565b3480156200096557600080fd5b50620005cf60065481565b3480156200097d57600080fd5b506026546000908152602c6020526040902060020154620005cf565b348015620009a657600080fd5b50620004f7620009b836600462007b00565b620025f1565b348015620009cb57600080fd5b50620004f7620009dd36600462007ec2565b62002623565b348015620009f057600080fd5b506040805160c080820183526012548252601354602080840191909152601454838501526015546060808501919091526016546080808601919091526017546001600160a01b0390811660a08088019190915287516101008101895260185483168152601954831681870152601a548316818a0152601b54831681860152601c54831681850152601d54831691810191909152601e54821695810195909552601f54811660e086015286519182018752835416815260215492810192909252602254948201949094526023549381019390935260245462000acf939084565b6040805185518152602080870151818301528683015182840152606080880151818401526080808901518185015260a0988901516001600160a01b039081168a8601528851811660c080870191909152848a0151821660e0808801919091528a8801518316610100880152848b01518316610120880152928a015182166101408701529989015181166101608601529888015189166101808501529096015187166101a083015284519096166101c0820152948301516101e08601528201516102008501529101516102208301526102408201526102600162000534565b34801562000bba57600080fd5b50620004f762000bcc36600462007b00565b620027ce565b34801562000bdf57600080fd5b50602354620005cf565b34801562000bf657600080fd5b506019546001600160a01b03165b6040516001600160a01b03909116815260200162000534565b34801562000c2a57600080fd5b50620004f762000c3c36600462007f18565b62002800565b34801562000c4f57600080fd5b50620004f762000c6136600462007ab1565b6200292f565b34801562000c7457600080fd5b50620004f762002ac0565b34801562000c8c57600080fd5b50620005cf602a5481565b34801562000ca457600080fd5b50620004f762000cb636600462007b00565b62002afc565b34801562000cc957600080fd5b50604080518082019091526003815262272a2760e91b602082015262000525565b34801562000cf757600080fd5b50620005cf62000d0936600462007b00565b62002b80565b34801562000d1c57600080fd5b50603e5462000c04906001600160a01b031681565b34801562000d3e57600080fd5b50620005cf603c5481565b34801562000d5657600080fd5b50620005cf602b5481565b34801562000d6e57600080fd5b50620004f762000d8036600462007ab1565b62002c18565b620005cf62000d9736600462007ab1565b62002d35565b620005cf62000dae36600462007ab1565b62002e52565b34801562000dc157600080fd5b5062000dcc62002f2b565b60405162000534919062007fc1565b34801562000de857600080fd5b506200056262000dfa36600462007ab1565b6200300e565b34801562000e0d57600080fd5b5062000e3762000e1f36600462007b00565b6000908152600b602052604090206006015460ff1690565b60405162000534919062008027565b34801562000e5357600080fd5b5062000e5e62003066565b6040516200053494939291906200803c565b34801562000e7d57600080fd5b5062000e88620031e3565b6040516200053491906200806d565b34801562000ea457600080fd5b50620005cf60295481565b34801562000ebc57600080fd5b5062000ec7620032f5565b60405162000534929190620080c8565b34801562000ee457600080fd5b5062000e5e62000ef636600462007b00565b620035c1565b34801562000f0957600080fd5b50620004f762000f1b366004620080fa565b620037c8565b34801562000f2e57600080fd5b50620004f762000f4036600462007ae0565b6200380f565b34801562000f5357600080fd5b50620004f762000f6536600462007ae0565b6200385e565b34801562000f7857600080fd5b5062000f8362003aaf565b604051620005349291906200815b565b34801562000fa057600080fd5b50620005cf62000fb236600462007b00565b62003be6565b34801562000fc557600080fd5b5062000fd062003c78565b60405162000534919062008184565b34801562000fec57600080fd5b50620005cf60265481565b3480156200100457600080fd5b50620004f76200101636600462007b00565b62003cdc565b3480156200102957600080fd5b50620004f76200103b36600462007ae0565b62003d44565b3480156200104e57600080fd5b50620005cf60045481565b3480156200106657600080fd5b50620004f762003d93565b3480156200107e57600080fd5b50620004f76200109036600462007ae0565b62003de8565b348015620010a357600080fd5b50620004f7620010b536600462007ae0565b62003e37565b348015620010c857600080fd5b50603d5462000c04906001600160a01b031681565b348015620010ea57600080fd5b50620004f762003e86565b3480156200110257600080fd5b50620004f76200111436600462007ae0565b6200403b565b3480156200112757600080fd5b50620005cf6200113936600462008199565b6001600160a01b03918216600090815260316020908152604080832093909416825291909152205490565b3480156200117157600080fd5b50620005cf6200408a565b3480156200118957600080fd5b506020546001600160a01b031662000c04565b348015620011a957600080fd5b50620005cf602e5481565b348015620011c157600080fd5b50620004f7620011d336600462007b00565b620040a7565b348015620011e657600080fd5b50620004f7620011f836600462007ae0565b620040d9565b3480156200120b57600080fd5b50620004f76200121d36600462007ae0565b62004128565b3480156200123057600080fd5b506017546001600160a01b031662000c04565b600062001252338484620041e1565b5060015b92915050565b620012666200430a565b6001600160a01b038082166000818152603a602052604090206001015490911614620012af5760405162461bcd60e51b8152600401620012a690620081d7565b60405180910390fd5b6001600160a01b038181166000908152603a6020526040902054163314620012eb5760405162461bcd60e51b8152600401620012a6906200820e565b620012f68162004359565b6200130060008055565b50565b6020546001600160a01b03163314620013305760405162461bcd60e51b8152600401620012a6906200825a565b600555565b6020546001600160a01b03163314620013625760405162461bcd60e51b8152600401620012a6906200825a565b600455565b6020546001600160a01b03163314620013945760405162461bcd60e51b8152600401620012a6906200825a565b601555565b6020546001600160a01b03163314620013c65760405162461bcd60e51b8152600401620012a6906200825a565b601880546001600160a01b0319166001600160a01b0392909216919091179055565b565b620013f462007838565b6001600160a01b038083166000818152603a602052604090206001015490911614620014345760405162461bcd60e51b8152600401620012a69062008291565b6001600160a01b038083166000908152603a602090815260409182902082516102808101845281548516815260018201548516928101929092526002810154909316918101919091526003820180549192916060840191906200149790620082c8565b80601f0160208091040260200160405190810160405280929190818152602001828054620014c590620082c8565b8015620015165780601f10620014ea5761010080835404028352916020019162001516565b820191906000526020600020905b815481529060010190602001808311620014f857829003601f168201915b505050918352505060048201546020820152600582015460408201526006820154606082015260078201546080820152600882015460a0820152600982015460c0820152600a82015460e0820152600b820154610100820152600c8201546001600160a01b0316610120820152600d820154610140820152600e820154610160820152600f82015461018082015260108201546101a082015260118201546101c08201526012820180546101e090920191620015d290620082c8565b80601f01602080910402602001604051908101604052809291908181526020018280546200160090620082c8565b8015620016515780601f10620016255761010080835404028352916020019162001651565b820191906000526020600020905b8154815290600101906020018083116200163357829003601f168201915b5050509183525050601382015460209091019060ff1660038111156200167b576200167b62007b1a565b60038111156200168f576200168f62007b1a565b90525092915050565b6020546001600160a01b03163314620016c55760405162461bcd60e51b8152600401620012a6906200825a565b601f80546001600160a01b0319166001600160a01b0392909216919091179055565b6001600160a01b0383166000908152603160209081526040808320338452909152812054828110156200176e5760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e74206578636565647320616044820152676c6c6f77616e636560c01b6064820152608401620012a6565b6200177b85858562004449565b6200179385336200178d86856200831a565b620041e1565b836001600160a01b0316856001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef85604051620017d991815260200190565b60405180910390a3506001949350505050565b6018546001600160a01b03163314620018545760405162461bcd60e51b815260206004820152602360248201527f63616c6c6572206973206e6f742074686520736c617368696e6720636f6e74726044820152621858dd60ea1b6064820152608401620012a6565b6000610120820135603a8262001871604086016020870162007ae0565b6001600160a01b03166001600160a01b0316815260200190815260200160002060090154620018a191906200831a565b60c0830135603a6000620018bc604087016020880162007ae0565b6001600160a01b03166001600160a01b0316815260200190815260200160002060060154620018ec91906200831a565b60a0840135603a600062001907604088016020890162007ae0565b6001600160a01b03166001600160a01b03168152602001908152602001600020600501546200193791906200831a565b62001943919062008330565b6200194f919062008330565b6017546001600160a01b03166000908152603960205260408120805492935083929091906200198090849062008330565b90915550829050603a60006200199d604084016020850162007ae0565b6001600160a01b031681526020810191909152604001600020620019c282826200851e565b50505050565b6020546001600160a01b03163314620019f55760405162461bcd60e51b8152600401620012a6906200825a565b62001a0182826200450f565b5050565b6020546001600160a01b0316331462001a325760405162461bcd60e51b8152600401620012a6906200825a565b601980546001600160a01b0319166001600160a01b03838116918217909255601a54604051637adbf97360e01b8152600481019290925290911690637adbf97390602401600060405180830381600087803b15801562001a9157600080fd5b505af115801562001aa6573d6000803e3d6000fd5b5050601c54604051637adbf97360e01b81526001600160a01b0385811660048301529091169250637adbf97391506024015b600060405180830381600087803b15801562001af357600080fd5b505af115801562001b08573d6000803e3d6000fd5b5050505050565b603d546000908190606090829081906001600160a01b0316331462001b485760405162461bcd60e51b8152600401620012a69062008674565b62001b526200430a565b4360298190556026546000828152602760209081526040808320849055928252602c90528190206003015460185491516306c9789b60e41b815292101560048301819052916001600160a01b0390911690636c9789b090602401600060405180830381600087803b15801562001bc757600080fd5b505af115801562001bdc573d6000803e3d6000fd5b505050508015620020f657601e54603b54603c54602a546040516392eff3cd60e01b81526004810193909352602483019190915260448201524260648201526000916001600160a01b0316906392eff3cd90608401602060405180830381865afa15801562001c4f573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019062001c759190620086b7565b905080603c54101562001c875750603c545b62001c9330826200450f565b80603c600082825462001ca791906200831a565b9091555050601f546040805163f968f49360e01b815281516001600160a01b039093169263f968f4939260048084019391929182900301816000875af192505050801562001d14575060408051601f3d908101601f1916820190925262001d1191810190620086d1565b60015b62001d52576040514281527ff1217bb194383aa5c937c81cbbb445990a478c7511b080df329f9b0fb64233c99060200160405180910390a162001d85565b60175462001d6a906001600160a01b0316826200450f565b601f5462001d82906001600160a01b0316836200450f565b50505b3060009081526039602052604090205462001da290479062004595565b62001dac62004adc565b62001db662004d9f565b62001dc062004e8e565b62001dca62004f2d565b60008062001dd7620032f5565b60195460405163422811f960e11b81529294509092506001600160a01b03169063845023f29062001e0d90859060040162008184565b600060405180830381600087803b15801562001e2857600080fd5b505af115801562001e3d573d6000803e3d6000fd5b505060185460405163e08b14ed60e01b81526001600160a01b03909116925063e08b14ed915062001e7390849060040162008184565b600060405180830381600087803b15801562001e8e57600080fd5b505af115801562001ea3573d6000803e3d6000fd5b50506026546000908152602c6020526040902060020154602854602154919350148015915062001ed4575060285415155b1562001f42576028546021819055601854604051631ad7d11360e21b815260048101929092526001600160a01b031690636b5f444c90602401600060405180830381600087803b15801562001f2857600080fd5b505af115801562001f3d573d6000803e3d6000fd5b505050505b60215460009062001f54904362008330565b905042602a8190555060016026600082825462001f72919062008330565b909155505060265460408051602d805460a0602082028401810190945260808301818152620020b49594849391929060009085015b8282101562002095576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162001ffd90620082c8565b80601f01602080910402602001604051908101604052809291908181526020018280546200202b90620082c8565b80156200207c5780601f1062002050576101008083540402835291602001916200207c565b820191906000526020600020905b8154815290600101906020018083116200205e57829003601f168201915b5050505050815250508152602001906001019062001fa7565b5050505081526020018581526020014381526020018481525062005048565b7febad8099c467528a56c98b63c8d476d251cf1ffb4c75db94b4d23fa2b6a1e335602654604051620020e891815260200190565b60405180910390a150505050505b60195460408051634bb278f360e01b815290516000926001600160a01b031691634bb278f3916004808301926020929190829003018187875af115801562002142573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190620021689190620086f6565b90508015620021e457601a546040805163a2e6204560e01b815290516001600160a01b039092169163a2e620459160048082019260209290919082900301816000875af1925050508015620021dc575060408051601f3d908101601f19168201909252620021d991810190620086f6565b60015b15620021e457505b600380546026546000908152602c602090815260408083206001810154950154602d805483518186028101860190945280845260ff909616968996919590949293928692919084015b828210156200231b576000848152602090819020604080516060810182526003860290920180546001600160a01b03168352600181015493830193909352600283018054929392918401916200228390620082c8565b80601f0160208091040260200160405190810160405280929190818152602001828054620022b190620082c8565b8015620023025780601f10620022d65761010080835404028352916020019162002302565b820191906000526020600020905b815481529060010190602001808311620022e457829003601f168201915b505050505081525050815260200190600101906200222d565b5050505092509650965096509650965050506200233760008055565b9091929394565b6020546001600160a01b031633146200236b5760405162461bcd60e51b8152600401620012a6906200825a565b602080546001600160a01b0319166001600160a01b0383811691821790925560195460405163b3ab15fb60e01b815260048101929092529091169063b3ab15fb90602401600060405180830381600087803b158015620023ca57600080fd5b505af1158015620023df573d6000803e3d6000fd5b5050601a5460405163b3ab15fb60e01b81526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b1580156200242b57600080fd5b505af115801562002440573d6000803e3d6000fd5b5050601b5460405163b3ab15fb60e01b81526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b1580156200248c57600080fd5b505af1158015620024a1573d6000803e3d6000fd5b5050601c5460405163b3ab15fb60e01b81526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b158015620024ed57600080fd5b505af115801562002502573d6000803e3d6000fd5b5050601d5460405163b3ab15fb60e01b81526001600160a01b038581166004830152909116925063b3ab15fb915060240162001ad8565b6020546001600160a01b03163314620025665760405162461bcd60e51b8152600401620012a6906200825a565b603755565b6020546001600160a01b03163314620025985760405162461bcd60e51b8152600401620012a6906200825a565b60288190556026546000908152602c6020908152604091829020600301548251848152918201819052917f2eea6438d890c8603d4df81ad1bad2a4ea45c02b4837165f461ff3c81603abc7910160405180910390a15050565b6020546001600160a01b031633146200261e5760405162461bcd60e51b8152600401620012a6906200825a565b601255565b6001600160a01b038083166000818152603a60205260409020600181015490921614620026645760405162461bcd60e51b8152600401620012a69062008291565b80546001600160a01b03163314620026905760405162461bcd60e51b8152600401620012a6906200871a565b6200269b8362005118565b15620026f55760405162461bcd60e51b815260206004820152602260248201527f76616c696461746f72206d757374206e6f7420626520696e20636f6d6d697474604482015261656560f01b6064820152608401620012a6565b600080620027038462005189565b925090508115620027455760405162461bcd60e51b815260206004820152600b60248201526a32b737b2329032b93937b960a91b6044820152606401620012a6565b60018301546001600160a01b03828116911614620027b65760405162461bcd60e51b815260206004820152602760248201527f76616c696461746f72206e6f646520616464726573732063616e2774206265206044820152661d5c19185d195960ca1b6064820152608401620012a6565b60038301620027c6858262008769565b505050505050565b6020546001600160a01b03163314620027fb5760405162461bcd60e51b8152600401620012a6906200825a565b600755565b6000604051806102800160405280336001600160a01b0316815260200160006001600160a01b03168152602001856001600160a01b0316815260200186815260200160126000016002015481526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b031681526020016000815260200143815260200160008152602001600081526020016000815260200184815260200160006003811115620028cf57620028cf62007b1a565b90529050620028df8183620051ce565b60208101516101808201516040517f8ad8bd2eb6950e5f332fd3a6dca48cb358ecfe3057848902b98cbdfe455c915c926200292092339289918b9162008832565b60405180910390a15050505050565b6001600160a01b038083166000818152603a6020526040902060010154909116146200296f5760405162461bcd60e51b8152600401620012a690620081d7565b6001600160a01b038281166000908152603a6020526040902054163314620029ab5760405162461bcd60e51b8152600401620012a6906200820e565b612710811115620029ff5760405162461bcd60e51b815260206004820152601f60248201527f7265717569726520636f727265637420636f6d6d697373696f6e2072617465006044820152606401620012a6565b604080516060810182526001600160a01b038481168252436020808401918252838501868152601180546000908152600f909352958220855181546001600160a01b03191695169490941784559151600180850191909155915160029093019290925583549293909290919062002a7890849062008330565b90915550506040518281526001600160a01b038416907f4fba51c92fa3d6ad8374d394f6cd5766857552e153d7384a8f23aa4ce9a8a7cf9060200160405180910390a2505050565b6020546001600160a01b0316331462002aed5760405162461bcd60e51b8152600401620012a6906200825a565b6003805460ff19166001179055565b6020546001600160a01b0316331462002b295760405162461bcd60e51b8152600401620012a6906200825a565b6000811162002b7b5760405162461bcd60e51b815260206004820152601960248201527f636f6d6d69747465652073697a652063616e27742062652030000000000000006044820152606401620012a6565b602355565b6000602954600162002b93919062008330565b82111562002bf35760405162461bcd60e51b815260206004820152602660248201527f63616e6e6f74206765742065706f636820696420666f7220612066757475726560448201526520626c6f636b60d01b6064820152608401620012a6565b602954821162002c10575060009081526027602052604090205490565b505060265490565b6020546001600160a01b0316331462002c455760405162461bcd60e51b8152600401620012a6906200825a565b6001600160a01b03821660009081526039602052604090205481111562002ca85760405162461bcd60e51b8152602060048201526016602482015275416d6f756e7420657863656564732062616c616e636560501b6044820152606401620012a6565b6001600160a01b0382166000908152603960205260408120805483929062002cd29084906200831a565b9250508190555080603b600082825462002ced91906200831a565b90915550506040518181526001600160a01b038316907f5024dbeedf0c06664c9bd7be836915730c955e936972c020683dadf11d5488a3906020015b60405180910390a25050565b600062002d416200430a565b6001600160a01b038084166000818152603a60205260409020600101549091161462002d815760405162461bcd60e51b8152600401620012a69062008291565b6001600160a01b0383166000908152603a602052604081206013015460ff16600381111562002db45762002db462007b1a565b1462002e035760405162461bcd60e51b815260206004820152601b60248201527f76616c696461746f72206e65656420746f2062652061637469766500000000006044820152606401620012a6565b60375462002e12903462008891565b336000908152603860205260408120805490919062002e3390849062008330565b9091555062002e46905083833362005628565b90506200125660008055565b600062002e5e6200430a565b6001600160a01b038084166000818152603a60205260409020600101549091161462002e9e5760405162461bcd60e51b8152600401620012a69062008291565b6000821162002ee85760405162461bcd60e51b81526020600482015260156024820152740756e626f6e64696e6720616d6f756e74206973203605c1b6044820152606401620012a6565b60375462002ef7903462008891565b336000908152603860205260408120805490919062002f1890849062008330565b9091555062002e4690508383336200583f565b60606030805480602002602001604051908101604052809291908181526020016000905b828210156200300557838290600052602060002001805462002f7190620082c8565b80601f016020809104026020016040519081016040528092919081815260200182805462002f9f90620082c8565b801562002ff05780601f1062002fc45761010080835404028352916020019162002ff0565b820191906000526020600020905b81548152906001019060200180831162002fd257829003601f168201915b50505050508152602001906001019062002f4f565b50505050905090565b60006200301d33848462004449565b6040518281526001600160a01b0384169033907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef9060200160405180910390a350600192915050565b6026546000908152602c602090815260408083208151815460a094810282018501909352608081018381526060959485948594859493909284928491879085015b8282101562003195576000848152602090819020604080516060810182526003860290920180546001600160a01b0316835260018101549383019390935260028301805492939291840191620030fd90620082c8565b80601f01602080910402602001604051908101604052809291908181526020018280546200312b90620082c8565b80156200317c5780601f1062003150576101008083540402835291602001916200317c565b820191906000526020600020905b8154815290600101906020018083116200315e57829003601f168201915b50505050508152505081526020019060010190620030a7565b50505090825250600182015460208083019190915260028301546040808401919091526003909301546060928301528351908401519284015193909101519098919750919550909350915050565b6060602d805480602002602001604051908101604052809291908181526020016000905b8282101562003005576000848152602090819020604080516060810182526003860290920180546001600160a01b03168352600181015493830193909352600283018054929392918401916200325d90620082c8565b80601f01602080910402602001604051908101604052809291908181526020018280546200328b90620082c8565b8015620032dc5780601f10620032b057610100808354040283529160200191620032dc565b820191906000526020600020905b815481529060010190602001808311620032be57829003601f168201915b5050505050815250508152602001906001019062003207565b603d5460609081906001600160a01b03163314620033275760405162461bcd60e51b8152600401620012a69062008674565b602554620033785760405162461bcd60e51b815260206004820152601860248201527f5468657265206d7573742062652076616c696461746f727300000000000000006044820152606401620012a6565b6200338262007908565b602354608082015260258152603a6020820152602d6040820152602b6060820152620033ae8162005c24565b620033bc6030600062007926565b602d5480620034035760405162461bcd60e51b8152602060048201526012602482015271636f6d6d697474656520697320656d70747960701b6044820152606401620012a6565b60008167ffffffffffffffff81111562003421576200342162007e17565b6040519080825280602002602001820160405280156200344b578160200160208202803683370190505b50905060008267ffffffffffffffff8111156200346c576200346c62007e17565b60405190808252806020026020018201604052801562003496578160200160208202803683370190505b50905060005b83811015620035b5576000603a6000602d8481548110620034c157620034c1620088a8565b60009182526020808320600392830201546001600160a01b031684528301939093526040909101812060308054600181018255925292507f6ff97a59c90d62cc7236ba3a37cd85351bf564556780cf8c1157a220f31f0cbb01906200352990830182620088be565b50600281015484516001600160a01b0390911690859084908110620035525762003552620088a8565b6001600160a01b03928316602091820292909201015260018201548451911690849084908110620035875762003587620088a8565b6001600160a01b03909216602092830291909101909101525080620035ac816200899c565b9150506200349c565b50909450925050509091565b606060008060006029546001620035d9919062008330565b851115620036365760405162461bcd60e51b815260206004820152602360248201527f63616e6e6f74206765742065706f636820666f7220612066757475726520626c6044820152626f636b60e81b6064820152608401620012a6565b60265460295486116200365457506000858152602760205260409020545b6000818152602c602090815260408083208151815460a09481028201850190935260808101838152909391928492849190879085015b8282101562003778576000848152602090819020604080516060810182526003860290920180546001600160a01b0316835260018101549383019390935260028301805492939291840191620036e090620082c8565b80601f01602080910402602001604051908101604052809291908181526020018280546200370e90620082c8565b80156200375f5780601f1062003733576101008083540402835291602001916200375f565b820191906000526020600020905b8154815290600101906020018083116200374157829003601f168201915b505050505081525050815260200190600101906200368a565b5050509082525060018201546020808301919091526002830154604080840191909152600390930154606092830152835190840151928401519390910151909a9199509197509095509350505050565b6020546001600160a01b03163314620037f55760405162461bcd60e51b8152600401620012a6906200825a565b6200380260018362005c44565b62001a0160028262005c44565b6020546001600160a01b031633146200383c5760405162461bcd60e51b8152600401620012a6906200825a565b601b80546001600160a01b0319166001600160a01b0392909216919091179055565b620038686200430a565b6001600160a01b038082166000818152603a602052604090206001015490911614620038a85760405162461bcd60e51b8152600401620012a690620081d7565b6001600160a01b038082166000908152603a6020526040902080549091163314620038e75760405162461bcd60e51b8152600401620012a6906200871a565b6000601382015460ff16600381111562003905576200390562007b1a565b03620039545760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f7220616c72656164792061637469766500000000000000006044820152606401620012a6565b6002601382015460ff16600381111562003972576200397262007b1a565b148015620039835750438160100154115b15620039d25760405162461bcd60e51b815260206004820152601760248201527f76616c696461746f72207374696c6c20696e206a61696c0000000000000000006044820152606401620012a6565b6003601382015460ff166003811115620039f057620039f062007b1a565b0362003a3f5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206a61696c6564207065726d616e656e746c79000000006044820152606401620012a6565b60138101805460ff1916905580546026546000908152602c60209081526040918290206003015491519182526001600160a01b038581169316917f60fcbf2d07dc712a93e59fb28f1edb626d7c2497c57ba71a8c0b3999ecb9a3b5910160405180910390a3506200130060008055565b6060806001600281805462003ac490620082c8565b80601f016020809104026020016040519081016040528092919081815260200182805462003af290620082c8565b801562003b435780601f1062003b175761010080835404028352916020019162003b43565b820191906000526020600020905b81548152906001019060200180831162003b2557829003601f168201915b5050505050915080805462003b5890620082c8565b80601f016020809104026020016040519081016040528092919081815260200182805462003b8690620082c8565b801562003bd75780601f1062003bab5761010080835404028352916020019162003bd7565b820191906000526020600020905b81548152906001019060200180831162003bb957829003601f168201915b50505050509050915091509091565b600060036000838152600b602052604090206006015460ff16600381111562003c135762003c1362007b1a565b1462003c625760405162461bcd60e51b815260206004820152601e60248201527f756e626f6e64696e672072656c65617365206e6f7420726576657274656400006044820152606401620012a6565b506000908152600b602052604090206005015490565b6060602580548060200260200160405190810160405280929190818152602001828054801562003cd257602002820191906000526020600020905b81546001600160a01b0316815260019091019060200180831162003cb3575b5050505050905090565b6020546001600160a01b0316331462003d095760405162461bcd60e51b8152600401620012a6906200825a565b60138190556040518181527f1f4d2fc7529047a5bd96d3229bfea127fd18b7748f13586e097c69fccd3891289060200160405180910390a150565b6020546001600160a01b0316331462003d715760405162461bcd60e51b8152600401620012a6906200825a565b601d80546001600160a01b0319166001600160a01b0392909216919091179055565b6020546001600160a01b0316331462003dc05760405162461bcd60e51b8152600401620012a6906200825a565b62003dce6001600062007946565b62003ddc6002600062007946565b6003805460ff19169055565b6020546001600160a01b0316331462003e155760405162461bcd60e51b8152600401620012a6906200825a565b601c80546001600160a01b0319166001600160a01b0392909216919091179055565b6020546001600160a01b0316331462003e645760405162461bcd60e51b8152600401620012a6906200825a565b601a80546001600160a01b0319166001600160a01b0392909216919091179055565b603d546001600160a01b0316331462003eb35760405162461bcd60e51b8152600401620012a69062008674565b62003ebd6200430a565b62003ec762004d9f565b62003ed1620032f5565b505042602a5543602981905560009081526027602090815260408083208390556026548151602d805460a095810283018601909452608082018481526200403196939592948594919390919085015b828210156200400e576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162003f7690620082c8565b80601f016020809104026020016040519081016040528092919081815260200182805462003fa490620082c8565b801562003ff55780601f1062003fc95761010080835404028352916020019162003ff5565b820191906000526020600020905b81548152906001019060200180831162003fd757829003601f168201915b5050505050815250508152602001906001019062003f20565b505050908252506000602082015243604082015260215460609091015262005048565b620013e860008055565b6020546001600160a01b03163314620040685760405162461bcd60e51b8152600401620012a6906200825a565b601780546001600160a01b0319166001600160a01b0392909216919091179055565b60285460215460009114620040a0575060285490565b5060215490565b6020546001600160a01b03163314620040d45760405162461bcd60e51b8152600401620012a6906200825a565b600655565b6020546001600160a01b03163314620041065760405162461bcd60e51b8152600401620012a6906200825a565b601e80546001600160a01b0319166001600160a01b0392909216919091179055565b6020546001600160a01b03163314620041555760405162461bcd60e51b8152600401620012a6906200825a565b6001600160a01b038116620041bf5760405162461bcd60e51b815260206004820152602960248201527f696e76616c696420636f6e7472616374206164647265737320666f72206c6971604482015268756964206c6f67696360b81b6064820152608401620012a6565b603e80546001600160a01b0319166001600160a01b0392909216919091179055565b6001600160a01b038316620042455760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f206164646044820152637265737360e01b6064820152608401620012a6565b6001600160a01b038216620042a85760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f206164647265604482015261737360f01b6064820152608401620012a6565b6001600160a01b0383811660008181526031602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92591015b60405180910390a3505050565b60005415620043525760405162461bcd60e51b81526020600482015260136024820152721c99595b9d1c985b98de4819195d1958dd1959606a1b6044820152606401620012a6565b6001600055565b6001600160a01b0381166000908152603a6020526040812090601382015460ff1660038111156200438e576200438e62007b1a565b14620043dd5760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206d7573742062652061637469766500000000000000006044820152606401620012a6565b60138101805460ff1916600117905580546026546000908152602c60209081526040918290206003015491519182526001600160a01b038581169316917f75bdcdbe540758778e669d108fbcb7ede734f27f46e4e5525eeb8ecf91849a9c910160405180910390a35050565b6001600160a01b038316600090815260396020526040902054811115620044ac5760405162461bcd60e51b8152602060048201526016602482015275616d6f756e7420657863656564732062616c616e636560501b6044820152606401620012a6565b6001600160a01b03831660009081526039602052604081208054839290620044d69084906200831a565b90915550506001600160a01b038216600090815260396020526040812080548392906200450590849062008330565b9091555050505050565b6001600160a01b038216600090815260396020526040812080548392906200453990849062008330565b9250508190555080603b600082825462004554919062008330565b90915550506040518181526001600160a01b038316907f48490b4407bb949b708ec5f514b4167f08f4969baaf78d53b05028adf369bfcf9060200162002d29565b81158015620045a2575080155b15620045ac575050565b601254600090670de0b6b3a764000090620045c9908590620089b8565b620045d5919062008891565b9050801562004653576017546040516000916001600160a01b03169083908381818185875af1925050503d80600081146200462d576040519150601f19603f3d011682016040523d82523d6000602084013e62004632565b606091505b509091505080151560010362004651576200464e82856200831a565b93505b505b82602e600082825462004667919062008330565b90915550600090505b602d54811015620019c2576000603a6000602d8481548110620046975762004697620088a8565b600091825260208083206003909202909101546001600160a01b031683528201929092526040018120602b54602d8054929450909188919086908110620046e257620046e2620088a8565b906000526020600020906003020160010154620047009190620089b8565b6200470c919062008891565b90506000602b5486602d86815481106200472a576200472a620088a8565b906000526020600020906003020160010154620047489190620089b8565b62004754919062008891565b90506000821180620047665750600081115b1562004ac3576002601384015460ff1660038111156200478a576200478a62007b1a565b1480620047b257506003601384015460ff166003811115620047b057620047b062007b1a565b145b156200487c57601854620047d29030906001600160a01b03168362004449565b601854602d80546001600160a01b039092169163a8031a1d91859188908110620048005762004800620088a8565b600091825260209091206003909102015460405160e084901b6001600160e01b03191681526001600160a01b039091166004820152602481018590526044016000604051808303818588803b1580156200485957600080fd5b505af11580156200486e573d6000803e3d6000fd5b505050505050505062004ac7565b60008360050154838560080154620048959190620089b8565b620048a1919062008891565b905080156200496357835460405160009182916001600160a01b03909116906108fc90859084818181858888f193505050503d806000811462004901576040519150601f19603f3d011682016040523d82523d6000602084013e62004906565b606091505b509092509050811515600003620049605785546040517f1137d8c966ce69b9630fb2294be011f3d64cc56e91fad7d375f0662568e9d3529162004957916001600160a01b03909116908490620089d2565b60405180910390a15b50505b600084600501548386600801546200497c9190620089b8565b62004988919062008891565b90508015620049aa578454620049aa9030906001600160a01b03168362004449565b6000620049b882856200831a565b90506000620049c884876200831a565b90506000811180620049da5750600082115b1562004a7457600c870154620049fc9030906001600160a01b03168462004449565b600c87015460405163a0ce552d60e01b8152600481018490526001600160a01b039091169063a0ce552d908390602401604080518083038185885af115801562004a4a573d6000803e3d6000fd5b50505050506040513d601f19601f8201168201806040525081019062004a719190620086d1565b50505b600187015460408051888152602081018890526001600160a01b03909216917f291e8ba3c0f4b0bd86e6e2346fcee1e7ca0975b1cc1886bfbc722d34f3f24d91910160405180910390a2505050505b5050505b8062004ad3816200899c565b91505062004670565b60355460005b8181101562001a015760006035828154811062004b035762004b03620088a8565b60009182526020808320909101546001600160a01b03168083526033825260408084208054825181860281018601909352808352929550909290919083018282801562004b7a57602002820191906000526020600020905b81546001600160a01b0316815260019091019060200180831162004b5b575b5050505050905060005b815181101562004c02576001600160a01b0383166000908152603260205260408120835190919084908490811062004bc05762004bc0620088a8565b60200260200101516001600160a01b03166001600160a01b0316815260200190815260200160002060009055808062004bf9906200899c565b91505062004b84565b506001600160a01b038216600090815260336020526040812062004c269162007985565b6001600160a01b038216600090815260386020526040902054815160075462004c509190620089b8565b81111562004c6c57815160075462004c699190620089b8565b90505b60005a9050836001600160a01b031663d18736ab83856040518363ffffffff1660e01b815260040162004ca0919062008184565b600060405180830381600088803b15801562004cbb57600080fd5b5087f19350505050801562004cce575060015b62004d04575a62004ce090826200831a565b6001600160a01b038516600090815260366020526040902060019055905062004d14565b5a62004d1190826200831a565b90505b6001600160a01b03841660009081526038602052604090205481101562004d6b576001600160a01b0384166000908152603860205260408120805483929062004d5f9084906200831a565b9091555062004d859050565b6001600160a01b0384166000908152603860205260408120555b50505050808062004d96906200899c565b91505062004ae2565b6009545b600a5481101562004dcc5762004dc68162004dbe816200899c565b925062005d97565b62004da3565b50600a54600955600d54600c540362004de157565b600e545b600d5481101562004e0e5762004e088162004e00816200899c565b925062006038565b62004de5565b50600d54600e55600c54805b600d5481101562004e88576015546000828152600b6020526040902060040154439162004e479162008330565b1162004e6d5762004e5881620063d4565b62004e6560018362008330565b915062004e73565b62004e88565b8062004e7f816200899c565b91505062004e1a565b50600c55565b60355460005b8181101562004f1e5760006035828154811062004eb55762004eb5620088a8565b60009182526020808320909101546001600160a01b0316808352603482526040808420849055603690925291205490915060010362004f08576001600160a01b0381166000908152603660205260408120555b508062004f15816200899c565b91505062004e94565b50620013006035600062007985565b6011546010541015620013e8576010546000908152600f602052604090206015546001820154439162004f609162008330565b111562004f6a5750565b80546001600160a01b039081166000908152603a60205260409081902060028401546004808301829055600c83015493516319fac8fd60e01b8152929493909316926319fac8fd9262004fc292910190815260200190565b600060405180830381600087803b15801562004fdd57600080fd5b505af115801562004ff2573d6000803e3d6000fd5b5050601080546000908152600f6020526040812080546001600160a01b0319168155600180820183905560029091018290558254909450919250906200503a90849062008330565b9091555062004f2d92505050565b6000828152602c60209081526040808320918401516001830155830151600282015560608301516003820155905b825151811015620019c25782518051839190839081106200509b576200509b620088a8565b602090810291909101810151825460018082018555600094855293839020825160039092020180546001600160a01b0319166001600160a01b03909216919091178155918101519282019290925560408201516002820190620050ff908262008769565b50505080806200510f906200899c565b91505062005076565b6000805b602d548110156200518057602d81815481106200513d576200513d620088a8565b60009182526020909120600390910201546001600160a01b03908116908416036200516b5750600192915050565b8062005177816200899c565b9150506200511c565b50600092915050565b60008062005196620079a5565b60008060ff9050604083875160208901845afa620051b357600080fd5b50508051602090910151600160601b90910494909350915050565b60e2815114620052185760405162461bcd60e51b8152602060048201526014602482015273092dcecc2d8d2c840e0e4dedecc40d8cadccee8d60631b6044820152606401620012a6565b60308261024001515114620052705760405162461bcd60e51b815260206004820152601c60248201527f496e76616c696420636f6e73656e737573206b6579206c656e677468000000006044820152606401620012a6565b6200527b826200657d565b604080518082018252601a81527f19457468657265756d205369676e6564204d6573736167653a0a000000000000602080830191909152845192519192600092620052de920160609190911b6bffffffffffffffffffffffff1916815260140190565b6040516020818303038152906040529050600082620052fe8351620066aa565b83604051602001620053139392919062008a03565b60408051601f19818403018152828252805160209182012060028085526060850184529094506000939290918301908036833701905050905060008080806200536b89826200536560416002620089b8565b620067cb565b905060006200538a8a6200538260416002620089b8565b6060620067cb565b905060205b82518110156200545b57620053a58382620068e4565b6040805160008152602081018083528d905260ff8316918101919091526060810184905260808101839052929850909650945060019060a0016020604051602081039080840390855afa15801562005401573d6000803e3d6000fd5b5050604051601f1901519050876200541b60418462008891565b815181106200542e576200542e620088a8565b6001600160a01b03909216602092830291909101909101526200545360418262008330565b90506200538f565b508a602001516001600160a01b031686600081518110620054805762005480620088a8565b60200260200101516001600160a01b031614620054f25760405162461bcd60e51b815260206004820152602960248201527f496e76616c6964206e6f6465206b6579206f776e6572736869702070726f6f66604482015268081c1c9bdd9a59195960ba1b6064820152608401620012a6565b8a604001516001600160a01b031686600181518110620055165762005516620088a8565b60200260200101516001600160a01b0316146200558a5760405162461bcd60e51b815260206004820152602b60248201527f496e76616c6964206f7261636c65206b6579206f776e6572736869702070726f60448201526a1bd9881c1c9bdd9a59195960aa1b6064820152608401620012a6565b6001620055a28c6102400151838e600001516200691b565b14620056105760405162461bcd60e51b815260206004820152603660248201527f496e76616c696420636f6e73656e737573206b6579206f776e65727368697020604482015275383937b7b3103337b9103932b3b4b9ba3930ba34b7b760511b6064820152608401620012a6565b6200561b8b6200698a565b5050505050505050505050565b6000808311620056875760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201526269766560e81b6064820152608401620012a6565b6001600160a01b038216600090815260396020526040902054831115620056f15760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e636500000000006044820152606401620012a6565b6001600160a01b038216600090815260396020526040812080548592906200571b9084906200831a565b9091555050604080516080810182526001600160a01b03808516825286811660208084019182528385018881524360608601908152600a805460009081526008909452968320865181549087166001600160a01b031991821617825594516001820180549190971695169490941790945551600283015591516003909101558254919290620057aa836200899c565b90915550506001600160a01b038581166000818152603a6020908152604091829020548251908516948816948514808252918101899052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d910160405180910390a3833b15620058245762005824848762006c37565b6001600a546200583591906200831a565b9695505050505050565b6001600160a01b038084166000908152603a6020526040812080549192909184821691161480620059b757600c820154604051631092ab9160e31b81526001600160a01b03868116600483015260009216906384955c8890602401602060405180830381865afa158015620058b8573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190620058de9190620086b7565b905085811015620059465760405162461bcd60e51b815260206004820152602b60248201527f696e73756666696369656e7420756e6c6f636b6564204c6971756964204e657760448201526a746f6e2062616c616e636560a81b6064820152608401620012a6565b600c83015460405163282d3fdf60e01b81526001600160a01b038781166004830152602482018990529091169063282d3fdf90604401600060405180830381600087803b1580156200599757600080fd5b505af1158015620059ac573d6000803e3d6000fd5b505050505062005a4a565b8482600b01548360080154620059ce91906200831a565b101562005a2e5760405162461bcd60e51b815260206004820152602760248201527f696e73756666696369656e742073656c6620626f6e646564206e6577746f6e2060448201526662616c616e636560c81b6064820152608401620012a6565b8482600b01600082825462005a44919062008330565b90915550505b604051806101200160405280856001600160a01b03168152602001876001600160a01b0316815260200186815260200160008152602001438152602001600081526020016000600381111562005aa45762005aa462007b1a565b815260006020808301829052841515604093840152600d548252600b815290829020835181546001600160a01b039182166001600160a01b03199182161783559285015160018381018054929093169190941617905591830151600283015560608301516003808401919091556080840151600484015560a0840151600584015560c08401516006840180549193909260ff1990921691849081111562005b4f5762005b4f62007b1a565b021790555060e082015160069091018054610100938401511515620100000262ff0000199315159094029290921662ffff001990921691909117919091179055600d805490600062005ba1836200899c565b9190505550836001600160a01b0316866001600160a01b03167f63f8870909f7c59c9c4932bf98dbd491647c8d2e89ca0a032aacdd943a13e2fc838860405162005bf79291909115158252602082015260400190565b60405180910390a3833b1562005c135762005c13848762006c37565b6001600d546200583591906200831a565b60fa60a06000808285855af462005c3f573d6000803e3d6000fd5b505050565b81546002600180831615610100020382160482518082016020811060208410016002811462005cf3576001811462005d19578660005260208404602060002001600160028402018855602085068060200390508088018589016001836101000a0392508282511684540184556001840193506020820191505b8082101562005cdc578151845560018401935060208201915062005cbd565b815191036101000a90819004029091555062005d8e565b60028302826020036101000a846020036101000a60208901510402018501875562005d8e565b8660005260208404602060002001600160028402018855846020038088018589016001836101000a0392508282511660ff198a160184556020820191506001840193505b8082101562005d7c578151845560018401935060208201915062005d5d565b815191036101000a9081900402909155505b50505050505050565b600081815260086020908152604080832060018101546001600160a01b03168452603a90925282209091601382015460ff16600381111562005ddd5762005ddd62007b1a565b14158062005e04575081546001600160a01b03166000908152603660205260409020546001145b1562005ec457600282015482546001600160a01b03166000908152603960205260408120805490919062005e3a90849062008330565b909155505081546001830154600284015460138401546040516001600160a01b0394851694909316927f1ff2b052afa4bb37ce30d9aaccde416a700b97e632d089111749af937f8783429262005e9892909160ff9091169062008a4c565b60405180910390a38054825462005c3f9185916000916001600160a01b03918216911614600162006d3c565b805482546001600160a01b0390811691161462005fe8576000808260080154836005015462005ef491906200831a565b90508060000362005f0c578360020154915062005f32565b80846002015484600d015462005f239190620089b8565b62005f2f919062008891565b91505b600c83015484546040516340c10f1960e01b81526001600160a01b039182166004820152602481018590529116906340c10f1990604401600060405180830381600087803b15801562005f8457600080fd5b505af115801562005f99573d6000803e3d6000fd5b505050508183600d01600082825462005fb3919062008330565b9091555050600284015460058401805460009062005fd390849062008330565b9091555062001b089050858360008062006d3c565b816002015481600801600082825462006002919062008330565b909155505060028201546005820180546000906200602290849062008330565b9091555062005c3f905083600060018162006d3c565b6000818152600b6020908152604080832080546001600160a01b03168452603690925290912054600103620060da578054600182015460068301546002840154604080516201000090930460ff161515835260208301919091526001600160a01b0393841693909216917fec3ccfdbfc982a0ea188f4fd85459da1d6a7b332ddc4ca6b7a774c1abfc75866910160405180910390a362001a0182600162006ec5565b60018101546001600160a01b03166000908152603a60205260408120600683015490919062010000900460ff16620062c2576002830154600c8301548454604051637eee288d60e01b81526001600160a01b03918216600482015260248101849052911690637eee288d90604401600060405180830381600087803b1580156200616357600080fd5b505af115801562006178573d6000803e3d6000fd5b50505050600c8301548454604051632770a7eb60e21b81526001600160a01b03918216600482015260248101849052911690639dc29fac90604401600060405180830381600087803b158015620061ce57600080fd5b505af1158015620061e3573d6000803e3d6000fd5b50505050600083600801548460050154620061ff91906200831a565b600d850154909150620062138284620089b8565b6200621f919062008891565b92508184600d0160008282546200623791906200831a565b909155505060068401546000036200625657600385018390556200627e565b600684015460078501546200626c9085620089b8565b62006278919062008891565b60038601555b8284600601600082825462006294919062008330565b90915550506003850154600785018054600090620062b490849062008330565b909155506200639792505050565b5060028201546008820154811115620062dc575060088101545b8160090154600003620062f657600383018190556200631e565b6009820154600a8301546200630c9083620089b8565b62006318919062008891565b60038401555b8082600901600082825462006334919062008330565b90915550506003830154600a830180546000906200635490849062008330565b92505081905550808260080160008282546200637191906200831a565b90915550506002830154600b83018054600090620063919084906200831a565b90915550505b60068301805461ff001916610100179055600582018054829190600090620063c19084906200831a565b90915550620019c2905084600062006ec5565b6000818152600b602052604090206006810154610100900460ff16620064125760068101805460ff1916600217905562001a01826000600162007038565b60068101805460ff1916600117905560038101546000036200643c5762001a018260008062007038565b60018101546001600160a01b03166000908152603a60205260408120600683015490919062010000900460ff16620064d957816007015482600601548460030154620064899190620089b8565b62006495919062008891565b905080826006016000828254620064ad91906200831a565b90915550506003830154600783018054600090620064cd9084906200831a565b909155506200653e9050565b81600a015482600901548460030154620064f49190620089b8565b62006500919062008891565b9050808260090160008282546200651891906200831a565b90915550506003830154600a83018054600090620065389084906200831a565b90915550505b82546001600160a01b0316600090815260396020526040812080548392906200656990849062008330565b90915550620019c290508482600062007038565b60006200658e826060015162005189565b6001600160a01b03909116602084015290508015620065de5760405162461bcd60e51b815260206004820152600b60248201526a32b737b2329032b93937b960a91b6044820152606401620012a6565b6020808301516001600160a01b039081166000908152603a9092526040909120600101541615620066525760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c72656164792072656769737465726564000000006044820152606401620012a6565b6127108260800151111562001a015760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e20726174650000000000000000006044820152606401620012a6565b606081600003620066d25750506040805180820190915260018152600360fc1b602082015290565b8160005b8115620067025780620066e9816200899c565b9150620066fa9050600a8362008891565b9150620066d6565b60008167ffffffffffffffff81111562006720576200672062007e17565b6040519080825280601f01601f1916602001820160405280156200674b576020820181803683370190505b5090505b8415620067c357620067636001836200831a565b915062006772600a8662008a6b565b6200677f90603062008330565b60f81b818381518110620067975762006797620088a8565b60200101906001600160f81b031916908160001a905350620067bb600a8662008891565b94506200674f565b949350505050565b606081620067db81601f62008330565b10156200681c5760405162461bcd60e51b815260206004820152600e60248201526d736c6963655f6f766572666c6f7760901b6044820152606401620012a6565b62006828828462008330565b845110156200686e5760405162461bcd60e51b8152602060048201526011602482015270736c6963655f6f75744f66426f756e647360781b6044820152606401620012a6565b6060821580156200688f5760405191506000825260208201604052620068db565b6040519150601f8416801560200281840101858101878315602002848b0101015b81831015620068ca578051835260209283019201620068b0565b5050858452601f01601f1916604052505b50949350505050565b8181018051602082015160409092015190919060001a601b811015620069145762006911601b8262008a82565b90505b9250925092565b600062006927620079c3565b6000858585604051602001620069409392919062008a9e565b6040516020818303038152906040529050600060fb905060008251602062006969919062008330565b90506020848285855afa6200697d57600080fd5b5050905195945050505050565b6101808101516001600160a01b031662006a8957603e546001600160a01b031662006a035760405162461bcd60e51b815260206004820152602260248201527f6c6971756964206c6f67696320636f6e7472616374206e6f74206465706c6f79604482015261195960f21b6064820152608401620012a6565b60255460009062006a1490620066aa565b905081602001518260000151836080015183603e60009054906101000a90046001600160a01b031660405162006a4a90620079e1565b62006a5a95949392919062008aed565b604051809103906000f08015801562006a77573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051602580546001808201835560009283527f401968ff42a154441da5f6c4c935ac46b8671f0e062baaa62a7545ba53bb6e4c90910180546001600160a01b03199081166001600160a01b0395861617909155845184168352603a9095526040918290208651815487169085161781559351908401805486169184169190911790558401516002830180549094169116179091556060820151829190600382019062006b3b908262008769565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c820180546001600160a01b0319166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f82015561020082015160108201556102208201516011820155610240820151601282019062006c05908262008769565b5061026082015160138201805460ff1916600183600381111562006c2d5762006c2d62007b1a565b0217905550505050565b6001600160a01b038216600090815260346020526040812054900362006cb5576001600160a01b03821660008181526034602052604081206001908190556035805491820181559091527fcfa4bec1d3298408bb5afcfcd9c430549c5b31f8aa5c5848151c0a55f473c34d0180546001600160a01b03191690911790555b6001600160a01b038083166000908152603260209081526040808320938516835292905290812054900362001a01576001600160a01b03808316600081815260326020908152604080832094861680845294825280832060019081905593835260338252822080549384018155825290200180546001600160a01b03191690911790555050565b600084815260086020526040902080546001600160a01b0316803b62006d64575050620019c2565b6001600160a01b03811660009081526038602052604090205460045481111562006d8d57506004545b60005a6001850154604051634efe8dc760e11b8152600481018b90526001600160a01b039182166024820152604481018a905288151560648201528715156084820152919250841690639dfd1b8e90849060a401600060405180830381600088803b15801562006dfc57600080fd5b5087f19350505050801562006e0f575060015b62006e3a575a62006e2190826200831a565b90508462006e345762006e3488620071a8565b62006e4a565b5a62006e4790826200831a565b90505b6001600160a01b03831660009081526038602052604090205481101562006ea1576001600160a01b0383166000908152603860205260408120805483929062006e959084906200831a565b9091555062006ebb9050565b6001600160a01b0383166000908152603860205260408120555b5050505050505050565b6000828152600b6020526040902080546001600160a01b0316803b62006eeb5750505050565b6001600160a01b03811660009081526038602052604090205460055481111562006f1457506005545b60005a600185015460405163a892024160e01b8152600481018990526001600160a01b039182166024820152871515604482015291925084169063a8920241908490606401600060405180830381600088803b15801562006f7457600080fd5b5087f19350505050801562006f87575060015b62006fb2575a62006f9990826200831a565b90508462006fac5762006fac866200736f565b62006fc2565b5a62006fbf90826200831a565b90505b6001600160a01b03831660009081526038602052604090205481101562007019576001600160a01b038316600090815260386020526040812080548392906200700d9084906200831a565b90915550620027c69050565b50506001600160a01b0316600090815260386020526040812055505050565b6000838152600b6020526040902080546001600160a01b0316803b6200705f575050505050565b6001600160a01b0381166000908152603860205260409020546006548111156200708857506006545b60005a6040516303c54c2960e41b8152600481018990526024810188905286151560448201529091506001600160a01b03841690633c54c290908490606401600060405180830381600088803b158015620070e257600080fd5b5087f193505050508015620070f5575060015b62007121575a6200710790826200831a565b9050846200711b576200711b8787620075c5565b62007131565b5a6200712e90826200831a565b90505b6001600160a01b03831660009081526038602052604090205481101562007188576001600160a01b038316600090815260386020526040812080548392906200717c9084906200831a565b9091555062005d8e9050565b50506001600160a01b031660009081526038602052604081205550505050565b600081815260086020908152604080832060018101546001600160a01b039081168552603a9093529220805483549192918216911614620072ad57600081600801548260050154620071fb91906200831a565b836002015483600d0154620072119190620089b8565b6200721d919062008891565b600c8301548454604051632770a7eb60e21b81526001600160a01b039182166004820152602481018490529293501690639dc29fac90604401600060405180830381600087803b1580156200727157600080fd5b505af115801562007286573d6000803e3d6000fd5b505050508082600d016000828254620072a091906200831a565b90915550620072cd915050565b8160020154816008016000828254620072c791906200831a565b90915550505b600282015482546001600160a01b031660009081526039602052604081208054909190620072fd90849062008330565b909155505060028201546005820180546000906200731d9084906200831a565b90915550508154600183015460028401546040519081526001600160a01b0392831692909116907f2d2530c45d577d38d6fc598e41ade6fb0bfbde20d09244c88348d3a2797a9d9090602001620042fd565b6000818152600b6020908152604080832060018101546001600160a01b03168452603a909252822060068201549192909162010000900460ff16620074a5576002830154600c83015484546040516340c10f1960e01b81526001600160a01b039182166004820152602481018490529116906340c10f1990604401600060405180830381600087803b1580156200740557600080fd5b505af11580156200741a573d6000803e3d6000fd5b505050508083600d01600082825462007434919062008330565b9091555050600783015460068401546003860154620074549190620089b8565b62007460919062008891565b9150818360060160008282546200747891906200831a565b90915550506003840154600784018054600090620074989084906200831a565b9091555062007527915050565b81600a015482600901548460030154620074c09190620089b8565b620074cc919062008891565b905080826009016000828254620074e491906200831a565b90915550506003830154600a83018054600090620075049084906200831a565b925050819055508082600801600082825462007521919062008330565b90915550505b60006003840181905560068401805461ff00191690556005830180548392906200755390849062008330565b90915550508254600184015460068501546002860154604080516201000090930460ff161515835260208301919091526001600160a01b0393841693909216917f52c1b482483796c697edd43958bc63e332069083ea38782d960693145db2cf0c91015b60405180910390a350505050565b6000828152600b60205260408120908290036200764957600681018054600360ff19909116179081905581546001830154604080516201000090940460ff1615158452602084018690526001600160a01b039283169392909116917f0a4bfc4c4e35975ab1fe5fd21d963e8bb8d483f04aebdbc0a059b246bec3f5fc9101620042fd565b60018101546001600160a01b03166000908152603a60205260409020600682015462010000900460ff166200775957600080826008015483600501546200769191906200831a565b905080600003620076a557849150620076c7565b808584600d0154620076b89190620089b8565b620076c4919062008891565b91505b600c83015484546040516340c10f1960e01b81526001600160a01b039182166004820152602481018590529116906340c10f1990604401600060405180830381600087803b1580156200771957600080fd5b505af11580156200772e573d6000803e3d6000fd5b505050508183600d01600082825462007748919062008330565b90915550505060058301556200777e565b8282600501819055508281600801600082825462007778919062008330565b90915550505b60068201805460ff1916600317905581546001600160a01b031660009081526039602052604081208054859290620077b89084906200831a565b9250508190555082816005016000828254620077d5919062008330565b9091555050815460018301546006840154604080516201000090920460ff1615158252602082018790526001600160a01b0393841693909216917f0a4bfc4c4e35975ab1fe5fd21d963e8bb8d483f04aebdbc0a059b246bec3f5fc9101620075b7565b60405180610280016040528060006001600160a01b0316815260200160006001600160a01b0316815260200160006001600160a01b0316815260200160608152602001600081526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b031681526020016000815260200160008152602001600081526020016000815260200160008152602001606081526020016000600381111562007903576200790362007b1a565b905290565b6040518060a001604052806005906020820280368337509192915050565b5080546000825590600052602060002090810190620013009190620079ef565b5080546200795490620082c8565b6000825580601f1062007965575050565b601f01602090049060005260206000209081019062001300919062007a14565b508054600082559060005260206000209081019062001300919062007a14565b60405180604001604052806002906020820280368337509192915050565b60405180602001604052806001906020820280368337509192915050565b6105c28062008b2083390190565b8082111562007a1057600062007a06828262007946565b50600101620079ef565b5090565b5b8082111562007a10576000815560010162007a15565b60005b8381101562007a4857818101518382015260200162007a2e565b50506000910152565b6000815180845262007a6b81602086016020860162007a2b565b601f01601f19169290920160200192915050565b60208152600062007a94602083018462007a51565b9392505050565b6001600160a01b03811681146200130057600080fd5b6000806040838503121562007ac557600080fd5b823562007ad28162007a9b565b946020939093013593505050565b60006020828403121562007af357600080fd5b813562007a948162007a9b565b60006020828403121562007b1357600080fd5b5035919050565b634e487b7160e01b600052602160045260246000fd5b600481106200130057634e487b7160e01b600052602160045260246000fd5b62007b5a8162007b30565b9052565b6020815262007b796020820183516001600160a01b03169052565b6000602083015162007b9660408401826001600160a01b03169052565b5060408301516001600160a01b038116606084015250606083015161028080608085015262007bca6102a085018362007a51565b9150608085015160a085015260a085015160c085015260c085015160e085015260e08501516101008181870152808701519150506101208181870152808701519150506101408181870152808701519150506101608181870152808701519150506101808181870152808701519150506101a062007c52818701836001600160a01b03169052565b8601516101c0868101919091528601516101e080870191909152860151610200808701919091528601516102208087019190915286015161024080870191909152860151858403601f19016102608088019190915290915062007cb6848362007a51565b93508087015191505062007ccd8286018262007b4f565b5090949350505050565b60008060006060848603121562007ced57600080fd5b833562007cfa8162007a9b565b9250602084013562007d0c8162007a9b565b929592945050506040919091013590565b60006020828403121562007d3057600080fd5b813567ffffffffffffffff81111562007d4857600080fd5b8201610280818503121562007a9457600080fd5b600081518084526020808501808196508360051b8101915082860160005b8581101562007dd1578284038952815180516001600160a01b03168552858101518686015260409081015160609186018290529062007dbc8187018362007a51565b9a87019a955050509084019060010162007d7a565b5091979650505050505050565b8515158152841515602082015260a06040820152600062007e0360a083018662007d5c565b606083019490945250608001529392505050565b634e487b7160e01b600052604160045260246000fd5b600082601f83011262007e3f57600080fd5b813567ffffffffffffffff8082111562007e5d5762007e5d62007e17565b604051601f8301601f19908116603f0116810190828211818310171562007e885762007e8862007e17565b8160405283815286602085880101111562007ea257600080fd5b836020870160208301376000602085830101528094505050505092915050565b6000806040838503121562007ed657600080fd5b823562007ee38162007a9b565b9150602083013567ffffffffffffffff81111562007f0057600080fd5b62007f0e8582860162007e2d565b9150509250929050565b6000806000806080858703121562007f2f57600080fd5b843567ffffffffffffffff8082111562007f4857600080fd5b62007f568883890162007e2d565b95506020870135915062007f6a8262007a9b565b9093506040860135908082111562007f8157600080fd5b62007f8f8883890162007e2d565b9350606087013591508082111562007fa657600080fd5b5062007fb58782880162007e2d565b91505092959194509250565b6000602080830181845280855180835260408601915060408160051b870101925083870160005b828110156200801a57603f198886030184526200800785835162007a51565b9450928501929085019060010162007fe8565b5092979650505050505050565b60208101620080368362007b30565b91905290565b60808152600062008051608083018762007d5c565b6020830195909552506040810192909252606090910152919050565b60208152600062007a94602083018462007d5c565b600081518084526020808501945080840160005b83811015620080bd5781516001600160a01b03168752958201959082019060010162008096565b509495945050505050565b604081526000620080dd604083018562008082565b8281036020840152620080f1818562008082565b95945050505050565b600080604083850312156200810e57600080fd5b823567ffffffffffffffff808211156200812757600080fd5b620081358683870162007e2d565b935060208501359150808211156200814c57600080fd5b5062007f0e8582860162007e2d565b60408152600062008170604083018562007a51565b8281036020840152620080f1818562007a51565b60208152600062007a94602083018462008082565b60008060408385031215620081ad57600080fd5b8235620081ba8162007a9b565b91506020830135620081cc8162007a9b565b809150509250929050565b6020808252601c908201527f76616c696461746f72206d757374206265207265676973746572656400000000604082015260600190565b6020808252602c908201527f726571756972652063616c6c657220746f2062652076616c696461746f72206160408201526b191b5a5b881858d8dbdd5b9d60a21b606082015260800190565b6020808252601a908201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604082015260600190565b60208082526018908201527f76616c696461746f72206e6f7420726567697374657265640000000000000000604082015260600190565b600181811c90821680620082dd57607f821691505b602082108103620082fe57634e487b7160e01b600052602260045260246000fd5b50919050565b634e487b7160e01b600052601160045260246000fd5b8181038181111562001256576200125662008304565b8082018082111562001256576200125662008304565b60008135620012568162007a9b565b80546001600160a01b0319166001600160a01b0392909216919091179055565b6000808335601e198436030181126200838d57600080fd5b83018035915067ffffffffffffffff821115620083a957600080fd5b602001915036819003821315620083bf57600080fd5b9250929050565b601f82111562005c3f57600081815260208120601f850160051c81016020861015620083ef5750805b601f850160051c820191505b81811015620027c657828155600101620083fb565b600019600383901b1c191660019190911b1790565b67ffffffffffffffff83111562008440576200844062007e17565b6200845883620084518354620082c8565b83620083c6565b6000601f8411600181146200848b5760008515620084765750838201355b62008482868262008410565b84555062001b08565b600083815260209020601f19861690835b82811015620084be57868501358255602094850194600190920191016200849c565b5086821015620084dc5760001960f88860031b161c19848701351681555b505060018560011b0183555050505050565b60008135600481106200125657600080fd5b6200850b8262007b30565b60ff1981541660ff831681178255505050565b620085346200852d8362008346565b8262008355565b62008550620085466020840162008346565b6001830162008355565b6200856c620085626040840162008346565b6002830162008355565b6200857b606083018362008375565b6200858b81836003860162008425565b50506080820135600482015560a0820135600582015560c0820135600682015560e0820135600782015561010082013560088201556101208201356009820155610140820135600a820155610160820135600b820155620085fe620085f4610180840162008346565b600c830162008355565b6101a0820135600d8201556101c0820135600e8201556101e0820135600f820155610200820135601082015561022082013560118201556200864561024083018362008375565b6200865581836012860162008425565b505062001a016200866a6102608401620084ee565b6013830162008500565b60208082526023908201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60408201526218dbdb60ea1b606082015260800190565b600060208284031215620086ca57600080fd5b5051919050565b60008060408385031215620086e557600080fd5b505080516020909101519092909150565b6000602082840312156200870957600080fd5b8151801515811462007a9457600080fd5b6020808252602f908201527f726571756972652063616c6c657220746f2062652076616c696461746f72207460408201526e1c99585cdd5c9e481858d8dbdd5b9d608a1b606082015260800190565b815167ffffffffffffffff81111562008786576200878662007e17565b6200879e81620087978454620082c8565b84620083c6565b602080601f831160018114620087d25760008415620087bd5750858301515b620087c9858262008410565b865550620027c6565b600085815260208120601f198616915b828110156200880357888601518255948401946001909101908401620087e2565b5085821015620088225787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b600060018060a01b0380881683528087166020840152808616604084015260a060608401526200886660a084018662007a51565b91508084166080840152509695505050505050565b634e487b7160e01b600052601260045260246000fd5b600082620088a357620088a36200887b565b500490565b634e487b7160e01b600052603260045260246000fd5b818103620088ca575050565b620088d68254620082c8565b67ffffffffffffffff811115620088f157620088f162007e17565b6200890281620087978454620082c8565b6000601f821160018114620089355760008315620089205750848201545b6200892c848262008410565b85555062001b08565b600085815260209020601f19841690600086815260209020845b838110156200897157828601548255600195860195909101906020016200894f565b5085831015620088225793015460001960f8600387901b161c19169092555050600190811b01905550565b600060018201620089b157620089b162008304565b5060010190565b808202811582820484141762001256576200125662008304565b60018060a01b03831681526060602082015260006060820152608060408201526000620067c3608083018462007a51565b6000845162008a1781846020890162007a2b565b84519083019062008a2d81836020890162007a2b565b845191019062008a4281836020880162007a2b565b0195945050505050565b8281526040810162008a5e8362007b30565b8260208301529392505050565b60008262008a7d5762008a7d6200887b565b500690565b60ff818116838216019081111562001256576200125662008304565b6000845162008ab281846020890162007a2b565b84519083019062008ac881836020890162007a2b565b60609490941b6bffffffffffffffffffffffff19169301928352505060140192915050565b600060018060a01b038088168352808716602084015285604084015260a060608401526200886660a084018662007a5156fe608060405234801561001057600080fd5b506040516105c23803806105c283398101604081905261002f916101af565b806001600160a01b0316632f2c3f2e6040518163ffffffff1660e01b8152600401602060405180830381865afa15801561006d573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610091919061029f565b83111561009d57600080fd5b600c80546001600160a01b038088166001600160a01b031992831617909255600d805492871692909116919091179055600e8390556040516100e39083906020016102b8565b604051602081830303815290604052600a9081610100919061036e565b508160405160200161011291906102b8565b604051602081830303815290604052600b908161012f919061036e565b5050601080546001600160a01b031916331790555061042d92505050565b6001600160a01b038116811461016257600080fd5b50565b80516101708161014d565b919050565b634e487b7160e01b600052604160045260246000fd5b60005b838110156101a657818101518382015260200161018e565b50506000910152565b600080600080600060a086880312156101c757600080fd5b85516101d28161014d565b60208701519095506101e38161014d565b6040870151606088015191955093506001600160401b038082111561020757600080fd5b818801915088601f83011261021b57600080fd5b81518181111561022d5761022d610175565b604051601f8201601f19908116603f0116810190838211818310171561025557610255610175565b816040528281528b602084870101111561026e57600080fd5b61027f83602083016020880161018b565b809650505050505061029360808701610165565b90509295509295909350565b6000602082840312156102b157600080fd5b5051919050565b644c4e544e2d60d81b8152600082516102d881600585016020870161018b565b9190910160050192915050565b600181811c908216806102f957607f821691505b60208210810361031957634e487b7160e01b600052602260045260246000fd5b50919050565b601f82111561036957600081815260208120601f850160051c810160208610156103465750805b601f850160051c820191505b8181101561036557828155600101610352565b5050505b505050565b81516001600160401b0381111561038757610387610175565b61039b8161039584546102e5565b8461031f565b602080601f8311600181146103d057600084156103b85750858301515b600019600386901b1c1916600185901b178555610365565b600085815260208120601f198616915b828110156103ff578886015182559484019460019091019084016103e0565b508582101561041d5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b6101868061043c6000396000f3fe60806040523661001b57610019610014610022565b6100fd565b005b6100196100145b600080601060009054906101000a90046001600160a01b03166001600160a01b03166396f18a326040518163ffffffff1660e01b8152600401602060405180830381865afa158015610078573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061009c9190610120565b90506001600160a01b0381166100f85760405162461bcd60e51b815260206004820152601d60248201527f6c6971756964206c6f67696320636f6e7472616374206e6f7420736574000000604482015260640160405180910390fd5b919050565b3660008037600080366000845af43d6000803e8061011a573d6000fd5b503d6000f35b60006020828403121561013257600080fd5b81516001600160a01b038116811461014957600080fd5b939250505056fea2646970667358221220c84647d3d92939f0d0c4b8cb8870025e67a96efe3618fd5883fa09e82290991164736f6c63430008150033a2646970667358221220abe9535ad9c4f6079037700ab7b203a8ee88346b1f16fabe0b08c660684cf9e964736f6c63430008150033608060405234801561001057600080fd5b50601080546001600160a01b03191633179055611702806100326000396000f3fe6080604052600436106101855760003560e01c80633e4eb36c116100d1578063949813b81161008a578063a0ce552d11610064578063a0ce552d146104d5578063a9059cbb146104e8578063bd96102f14610508578063dd62ed3e1461051d5761018c565b8063949813b81461046b57806395d89b41146104a05780639dc29fac146104b55761018c565b80633e4eb36c1461038a57806340c10f191461039f57806359355736146103bf57806370a08231146103f55780637eee288d1461042b57806384955c881461044b5761018c565b80631eeffad01161013e5780632f2c3f2e116101185780632f2c3f2e14610325578063313ce5671461033b578063372500ab146103575780633b19e84a1461036c5761018c565b80631eeffad0146102d057806323b872dd146102e5578063282d3fdf146103055761018c565b806306fdde03146101ea578063095ea7b3146102155780631195e07e1461024557806318160ddd14610277578063187cf4d71461029657806319fac8fd146102ae5761018c565b3661018c57005b60405162461bcd60e51b815260206004820152602860248201527f66616c6c6261636b206e6f7420696d706c656d656e74656420666f72204c69716044820152677569644c6f67696360c01b60648201526084015b60405180910390fd5b3480156101f657600080fd5b506101ff610563565b60405161020c919061145c565b60405180910390f35b34801561022157600080fd5b506102356102303660046114c6565b6105f5565b604051901515815260200161020c565b34801561025157600080fd5b50600c546001600160a01b03165b6040516001600160a01b03909116815260200161020c565b34801561028357600080fd5b506003545b60405190815260200161020c565b3480156102a257600080fd5b50610288633b9aca0081565b3480156102ba57600080fd5b506102ce6102c93660046114f0565b61060c565b005b3480156102dc57600080fd5b50600f54610288565b3480156102f157600080fd5b50610235610300366004611509565b61063b565b34801561031157600080fd5b506102ce6103203660046114c6565b610730565b34801561033157600080fd5b5061028861271081565b34801561034757600080fd5b506040516012815260200161020c565b34801561036357600080fd5b506102ce610814565b34801561037857600080fd5b50600d546001600160a01b031661025f565b34801561039657600080fd5b50600e54610288565b3480156103ab57600080fd5b506102ce6103ba3660046114c6565b6109f5565b3480156103cb57600080fd5b506102886103da366004611545565b6001600160a01b031660009081526001602052604090205490565b34801561040157600080fd5b50610288610410366004611545565b6001600160a01b031660009081526020819052604090205490565b34801561043757600080fd5b506102ce6104463660046114c6565b610a5d565b34801561045757600080fd5b50610288610466366004611545565b610b23565b34801561047757600080fd5b5061048b610486366004611545565b610b50565b6040805192835260208301919091520161020c565b3480156104ac57600080fd5b506101ff610c08565b3480156104c157600080fd5b506102ce6104d03660046114c6565b610c17565b61048b6104e33660046114f0565b610c77565b3480156104f457600080fd5b506102356105033660046114c6565b610f6b565b34801561051457600080fd5b506102ce610fb8565b34801561052957600080fd5b50610288610538366004611560565b6001600160a01b03918216600090815260026020908152604080832093909416825291909152205490565b6060600a805461057290611593565b80601f016020809104026020016040519081016040528092919081815260200182805461059e90611593565b80156105eb5780601f106105c0576101008083540402835291602001916105eb565b820191906000526020600020905b8154815290600101906020018083116105ce57829003601f168201915b5050505050905090565b60006106023384846110bf565b5060015b92915050565b6010546001600160a01b031633146106365760405162461bcd60e51b81526004016101e1906115cd565b600e55565b6001600160a01b0383166000908152600260209081526040808320338452909152812054828110156106c05760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e74206578636565647320616044820152676c6c6f77616e636560c01b60648201526084016101e1565b6106d485336106cf868561162b565b6110bf565b6106de85846111e3565b6106e884846112d7565b836001600160a01b0316856001600160a01b03166000805160206116ad8339815191528560405161071b91815260200190565b60405180910390a360019150505b9392505050565b6010546001600160a01b0316331461075a5760405162461bcd60e51b81526004016101e1906115cd565b6001600160a01b038216600090815260016020908152604080832054918390529091205482916107899161162b565b10156107e35760405162461bcd60e51b8152602060048201526024808201527f63616e2774206c6f636b206d6f72652066756e6473207468616e20617661696c60448201526361626c6560e01b60648201526084016101e1565b6001600160a01b0382166000908152600160205260408120805483929061080b90849061163e565b90915550505050565b60008061082033611323565b33600090815260046020908152604080832083905560079091528120819055919350915081156109035760105460405163a9059cbb60e01b8152336004820152602481018490526001600160a01b039091169063a9059cbb906044016020604051808303816000875af115801561089b573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906108bf9190611651565b9050806109035760405162461bcd60e51b81526020600482015260126024820152712330b4b632b2103a379039b2b73210272a2760711b60448201526064016101e1565b333b1561096357336001600160a01b031663161605e3846040518263ffffffff1660e01b81526004016000604051808303818588803b15801561094557600080fd5b505af1158015610959573d6000803e3d6000fd5b5050505050505050565b60405133908490600081818185875af1925050503d80600081146109a3576040519150601f19603f3d011682016040523d82523d6000602084013e6109a8565b606091505b505080915050806109f05760405162461bcd60e51b81526020600482015260126024820152712330b4b632b2103a379039b2b7321020aa2760711b60448201526064016101e1565b505050565b6010546001600160a01b03163314610a1f5760405162461bcd60e51b81526004016101e1906115cd565b610a2982826112d7565b6040518181526001600160a01b038316906000906000805160206116ad833981519152906020015b60405180910390a35050565b6010546001600160a01b03163314610a875760405162461bcd60e51b81526004016101e1906115cd565b6001600160a01b038216600090815260016020526040902054811115610afb5760405162461bcd60e51b815260206004820152602360248201527f63616e277420756e6c6f636b206d6f72652066756e6473207468616e206c6f636044820152621ad95960ea1b60648201526084016101e1565b6001600160a01b0382166000908152600160205260408120805483929061080b90849061162b565b6001600160a01b03811660009081526001602090815260408083205491839052822054610606919061162b565b6001600160a01b0381166000908152602081815260408083205460065460059093529083205483928391610b8591849161141e565b6001600160a01b038616600090815260046020526040902054909150610bac90829061163e565b6009546001600160a01b03871660009081526008602052604081205492965091610bd791859161141e565b6001600160a01b038716600090815260076020526040902054909150610bfe90829061163e565b9350505050915091565b6060600b805461057290611593565b6010546001600160a01b03163314610c415760405162461bcd60e51b81526004016101e1906115cd565b610c4b82826111e3565b6040518181526000906001600160a01b038416906000805160206116ad83398151915290602001610a51565b60105460009081906001600160a01b03163314610ca65760405162461bcd60e51b81526004016101e1906115cd565b600e54349060009061271090610cbc9084611673565b610cc6919061168a565b905081811115610d185760405162461bcd60e51b815260206004820152601c60248201527f696e76616c69642061746e2076616c696461746f72207265776172640000000060448201526064016101e1565b610d22818361162b565b600d546040519193506000916001600160a01b03909116906108fc90849084818181858888f193505050503d8060008114610d79576040519150601f19603f3d011682016040523d82523d6000602084013e610d7e565b606091505b5090915050801515600003610da55781600f6000828254610d9f919061163e565b90915550505b6000612710600e5488610db89190611673565b610dc2919061168a565b905086811115610e145760405162461bcd60e51b815260206004820152601c60248201527f696e76616c6964206e746e2076616c696461746f72207265776172640000000060448201526064016101e1565b610e1e818861162b565b96508015610ea157601054600d5460405163a9059cbb60e01b81526001600160a01b0391821660048201526024810184905291169063a9059cbb906044016020604051808303816000875af1158015610e7b573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610e9f9190611651565b505b600354600090610eb5633b9aca0087611673565b610ebf919061168a565b905080600654610ecf919061163e565b600655600354600090610ee6633b9aca008b611673565b610ef0919061168a565b905080600954610f00919061163e565b600955600354600090633b9aca0090610f199085611673565b610f23919061168a565b90506000633b9aca0060035484610f3a9190611673565b610f44919061168a565b9050610f50828861163e565b610f5a828761163e565b995099505050505050505050915091565b6000610f7733836111e3565b610f8183836112d7565b6040518281526001600160a01b0384169033906000805160206116ad8339815191529060200160405180910390a350600192915050565b600d546001600160a01b0316331461101d5760405162461bcd60e51b815260206004820152602260248201527f6f6e6c792074726561737572792063616e20636c61696d2068697320726577616044820152611c9960f21b60648201526084016101e1565b600d54600f546040516000926001600160a01b031691908381818185875af1925050503d806000811461106c576040519150601f19603f3d011682016040523d82523d6000602084013e611071565b606091505b50509050806110b75760405162461bcd60e51b81526020600482015260126024820152713330b4b632b2103a379039b2b7321020aa2760711b60448201526064016101e1565b506000600f55565b6001600160a01b0383166111215760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f206164646044820152637265737360e01b60648201526084016101e1565b6001600160a01b0382166111825760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f206164647265604482015261737360f01b60648201526084016101e1565b6001600160a01b0383811660008181526002602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b6111ec82611323565b50506001600160a01b0382166000908152602081815260408083205460019092529091205461121b908261162b565b82111561126a5760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e7420756e6c6f636b65642066756e6473000000000060448201526064016101e1565b611274828261162b565b6001600160a01b0384166000908152602081905260409020558082036112bb576001600160a01b038316600090815260056020908152604080832083905560089091528120555b81600360008282546112cd919061162b565b9091555050505050565b6112e082611323565b50506001600160a01b0382166000908152602081905260408120805483929061130a90849061163e565b92505081905550806003600082825461080b919061163e565b6001600160a01b038116600090815260208181526040808320546006546005909352908320548392839161135891849161141e565b6001600160a01b03861660009081526004602052604090205490915061137f90829061163e565b6001600160a01b03861660009081526004602090815260408083208490556006546005835281842055600954600890925282205492965090916113c391859161141e565b6001600160a01b0387166000908152600760205260409020549091506113ea90829061163e565b6001600160a01b03909616600090815260076020908152604080832089905560095460089092529091205550929492505050565b60008360000361143057506000610729565b633b9aca0084611440848661162b565b61144a9190611673565b611454919061168a565b949350505050565b600060208083528351808285015260005b818110156114895785810183015185820160400152820161146d565b506000604082860101526040601f19601f8301168501019250505092915050565b80356001600160a01b03811681146114c157600080fd5b919050565b600080604083850312156114d957600080fd5b6114e2836114aa565b946020939093013593505050565b60006020828403121561150257600080fd5b5035919050565b60008060006060848603121561151e57600080fd5b611527846114aa565b9250611535602085016114aa565b9150604084013590509250925092565b60006020828403121561155757600080fd5b610729826114aa565b6000806040838503121561157357600080fd5b61157c836114aa565b915061158a602084016114aa565b90509250929050565b600181811c908216806115a757607f821691505b6020821081036115c757634e487b7160e01b600052602260045260246000fd5b50919050565b60208082526028908201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060408201526710dbdb9d1c9858dd60c21b606082015260800190565b634e487b7160e01b600052601160045260246000fd5b8181038181111561060657610606611615565b8082018082111561060657610606611615565b60006020828403121561166357600080fd5b8151801515811461072957600080fd5b808202811582820484141761060657610606611615565b6000826116a757634e487b7160e01b600052601260045260246000fd5b50049056feddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3efa264697066735822122048794c7bb1d2b72d7f520e80a6d347ea4d627cc772c643cc5a10c495e833a56d64736f6c63430008150033608060405234801561001057600080fd5b506040516105c23803806105c283398101604081905261002f916101af565b806001600160a01b0316632f2c3f2e6040518163ffffffff1660e01b8152600401602060405180830381865afa15801561006d573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610091919061029f565b83111561009d57600080fd5b600c80546001600160a01b038088166001600160a01b031992831617909255600d805492871692909116919091179055600e8390556040516100e39083906020016102b8565b604051602081830303815290604052600a9081610100919061036e565b508160405160200161011291906102b8565b604051602081830303815290604052600b908161012f919061036e565b5050601080546001600160a01b031916331790555061042d92505050565b6001600160a01b038116811461016257600080fd5b50565b80516101708161014d565b919050565b634e487b7160e01b600052604160045260246000fd5b60005b838110156101a657818101518382015260200161018e565b50506000910152565b600080600080600060a086880312156101c757600080fd5b85516101d28161014d565b60208701519095506101e38161014d565b6040870151606088015191955093506001600160401b038082111561020757600080fd5b818801915088601f83011261021b57600080fd5b81518181111561022d5761022d610175565b604051601f8201601f19908116603f0116810190838211818310171561025557610255610175565b816040528281528b602084870101111561026e57600080fd5b61027f83602083016020880161018b565b809650505050505061029360808701610165565b90509295509295909350565b6000602082840312156102b157600080fd5b5051919050565b644c4e544e2d60d81b8152600082516102d881600585016020870161018b565b9190910160050192915050565b600181811c908216806102f957607f821691505b60208210810361031957634e487b7160e01b600052602260045260246000fd5b50919050565b601f82111561036957600081815260208120601f850160051c810160208610156103465750805b601f850160051c820191505b8181101561036557828155600101610352565b5050505b505050565b81516001600160401b0381111561038757610387610175565b61039b8161039584546102e5565b8461031f565b602080601f8311600181146103d057600084156103b85750858301515b600019600386901b1c1916600185901b178555610365565b600085815260208120601f198616915b828110156103ff578886015182559484019460019091019084016103e0565b508582101561041d5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b6101868061043c6000396000f3fe60806040523661001b57610019610014610022565b6100fd565b005b6100196100145b600080601060009054906101000a90046001600160a01b03166001600160a01b03166396f18a326040518163ffffffff1660e01b8152600401602060405180830381865afa158015610078573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061009c9190610120565b90506001600160a01b0381166100f85760405162461bcd60e51b815260206004820152601d60248201527f6c6971756964206c6f67696320636f6e7472616374206e6f7420736574000000604482015260640160405180910390fd5b919050565b3660008037600080366000845af43d6000803e8061011a573d6000fd5b503d6000f35b60006020828403121561013257600080fd5b81516001600160a01b038116811461014957600080fd5b939250505056fea2646970667358221220c84647d3d92939f0d0c4b8cb8870025e67a96efe3618fd5883fa09e82290991164736f6c63430008150033",
+	Bin: "0x608060405261c35060045561c35060055561c350600655614e2060075560006010556000601155633b9aca006037553480156200003b57600080fd5b506040516200cc593803806200cc598339810160408190526200005e916200116c565b6024546000036200008757603d80546001600160a01b031916331790556200008782826200008f565b505062001633565b80518051601255602080820151601355604080830151601455606080840151601555608080850151601681905560a095860151601780546001600160a01b03199081166001600160a01b03938416179091558689015180516018805484169185169190911790558088015160198054841691851691909117905580870151601a8054841691851691909117905580860151601b8054841691851691909117905593840151601c8054831691841691909117905596830151601d8054891691831691909117905560c0830151601e8054891691831691909117905560e090920151601f805488169184169190911790558387015180518654909716969092169590951784559283015160218190558383015160225592810151602355840151602455602891909155603c9190915551620001c89062000dba565b604051809103906000f080158015620001e5573d6000803e3d6000fd5b50603e80546001600160a01b0319166001600160a01b039290921691909117905560005b8251811015620005435760008382815181106200022a576200022a620013a9565b602002602001015160a001519050604e8483815181106200024f576200024f620013a9565b6020908102919091018101516102600151825460018101845560009384529282902091830490910180549192909160ff601f9092166101000a918202191690836003811115620002a357620002a3620013bf565b02179055506000848381518110620002bf57620002bf620013a9565b60200260200101516101a00181815250506000848381518110620002e757620002e7620013a9565b602002602001015161018001906001600160a01b031690816001600160a01b0316815250506000848381518110620003235762000323620013a9565b602002602001015160a001818152505060008483815181106200034a576200034a620013a9565b60209081029190910101516101c001526014548451859084908110620003745762000374620013a9565b6020026020010151608001818152505060008483815181106200039b576200039b620013a9565b602002602001015161026001906003811115620003bc57620003bc620013bf565b90816003811115620003d257620003d2620013bf565b815250506000848381518110620003ed57620003ed620013a9565b60200260200101516101600181815250506200042b848381518110620004175762000417620013a9565b60200260200101516200054860201b60201c565b62000458848381518110620004445762000444620013a9565b60200260200101516200068360201b60201c565b80156200052d578060396000868581518110620004795762000479620013a9565b6020026020010151600001516001600160a01b03166001600160a01b031681526020019081526020016000206000828254620004b69190620013eb565b9250508190555080603b6000828254620004d19190620013eb565b925050819055506200052b848381518110620004f157620004f1620013a9565b60200260200101516020015182868581518110620005135762000513620013a9565b6020026020010151600001516200093060201b60201c565b505b50806200053a8162001407565b91505062000209565b505050565b60006200055f826060015162000b4760201b60201c565b6001600160a01b03909116602084015290508015620005b35760405162461bcd60e51b815260206004820152600b60248201526a32b737b2329032b93937b960a91b60448201526064015b60405180910390fd5b6020808301516001600160a01b039081166000908152603a9092526040909120600101541615620006275760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c72656164792072656769737465726564000000006044820152606401620005aa565b612710826080015111156200067f5760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e20726174650000000000000000006044820152606401620005aa565b5050565b6101808101516001600160a01b03166200078257603e546001600160a01b0316620006fc5760405162461bcd60e51b815260206004820152602260248201527f6c6971756964206c6f67696320636f6e7472616374206e6f74206465706c6f79604482015261195960f21b6064820152608401620005aa565b6025546000906200070d9062000b95565b905081602001518260000151836080015183603e60009054906101000a90046001600160a01b0316604051620007439062000dc8565b6200075395949392919062001423565b604051809103906000f08015801562000770573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051602580546001808201835560009283527f401968ff42a154441da5f6c4c935ac46b8671f0e062baaa62a7545ba53bb6e4c90910180546001600160a01b03199081166001600160a01b0395861617909155845184168352603a909552604091829020865181548716908516178155935190840180548616918416919091179055840151600283018054909416911617909155606082015182919060038201906200083490826200150d565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c820180546001600160a01b0319166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f820155610200820151601082015561022082015160118201556102408201516012820190620008fe90826200150d565b5061026082015160138201805460ff19166001836003811115620009265762000926620013bf565b0217905550505050565b60008083116200098f5760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201526269766560e81b6064820152608401620005aa565b6001600160a01b038216600090815260396020526040902054831115620009f95760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e636500000000006044820152606401620005aa565b6001600160a01b0382166000908152603960205260408120805485929062000a23908490620015d9565b9091555050604080516080810182526001600160a01b03808516825286811660208084019182528385018881524360608601908152600a805460009081526008909452968320865181549087166001600160a01b03199182161782559451600182018054919097169516949094179094555160028301559151600390910155825491929062000ab28362001407565b90915550506001600160a01b038581166000818152603a6020908152604091829020548251908516948816948514808252918101899052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d910160405180910390a3833b1562000b2c5762000b2c848762000cb5565b6001600a5462000b3d9190620015d9565b9695505050505050565b60008062000b5462000dd6565b60008060ff9050604083875160208901845afa62000b7157600080fd5b505080516020909101516c0100000000000000000000000090910494909350915050565b60608160000362000bbd5750506040805180820190915260018152600360fc1b602082015290565b8160005b811562000bed578062000bd48162001407565b915062000be59050600a8362001605565b915062000bc1565b6000816001600160401b0381111562000c0a5762000c0a62000df4565b6040519080825280601f01601f19166020018201604052801562000c35576020820181803683370190505b5090505b841562000cad5762000c4d600183620015d9565b915062000c5c600a866200161c565b62000c69906030620013eb565b60f81b81838151811062000c815762000c81620013a9565b60200101906001600160f81b031916908160001a90535062000ca5600a8662001605565b945062000c39565b949350505050565b6001600160a01b038216600090815260346020526040812054900362000d33576001600160a01b03821660008181526034602052604081206001908190556035805491820181559091527fcfa4bec1d3298408bb5afcfcd9c430549c5b31f8aa5c5848151c0a55f473c34d0180546001600160a01b03191690911790555b6001600160a01b03808316600090815260326020908152604080832093851683529290529081205490036200067f576001600160a01b03808316600081815260326020908152604080832094861680845294825280832060019081905593835260338252822080549384018155825290200180546001600160a01b03191690911790555050565b611734806200af6383390190565b6105c2806200c69783390190565b60405180604001604052806002906020820280368337509192915050565b634e487b7160e01b600052604160045260246000fd5b604051608081016001600160401b038111828210171562000e2f5762000e2f62000df4565b60405290565b60405160c081016001600160401b038111828210171562000e2f5762000e2f62000df4565b60405161028081016001600160401b038111828210171562000e2f5762000e2f62000df4565b604051601f8201601f191681016001600160401b038111828210171562000eab5762000eab62000df4565b604052919050565b6001600160a01b038116811462000ec957600080fd5b50565b805162000ed98162000eb3565b919050565b60005b8381101562000efb57818101518382015260200162000ee1565b50506000910152565b600082601f83011262000f1657600080fd5b81516001600160401b0381111562000f325762000f3262000df4565b62000f47601f8201601f191660200162000e80565b81815284602083860101111562000f5d57600080fd5b62000cad82602083016020870162000ede565b80516004811062000ed957600080fd5b600061010080838503121562000f9557600080fd5b604051908101906001600160401b038211818310171562000fba5762000fba62000df4565b816040528092508351915062000fd08262000eb3565b81815262000fe16020850162000ecc565b602082015262000ff46040850162000ecc565b6040820152620010076060850162000ecc565b60608201526200101a6080850162000ecc565b60808201526200102d60a0850162000ecc565b60a08201526200104060c0850162000ecc565b60c08201526200105360e0850162000ecc565b60e0820152505092915050565b6000608082840312156200107357600080fd5b6200107d62000e0a565b905081516200108c8162000eb3565b8082525060208201516020820152604082015160408201526060820151606082015292915050565b6000818303610260811215620010c957600080fd5b620010d362000e0a565b915060c0811215620010e457600080fd5b50620010ef62000e35565b825181526020830151602082015260408301516040820152606083015160608201526080830151608082015260a08301516200112b8162000eb3565b60a08201528152620011418360c0840162000f80565b602082015262001156836101c0840162001060565b6040820152610240820151606082015292915050565b6000806102808084860312156200118257600080fd5b83516001600160401b03808211156200119a57600080fd5b818601915086601f830112620011af57600080fd5b8151602082821115620011c657620011c662000df4565b8160051b620011d782820162000e80565b928352848101820192828101908b851115620011f257600080fd5b83870192505b8483101562001387578251868111156200121157600080fd5b8701808d03601f19018913156200122757600080fd5b6200123162000e5a565b6200123e86830162000ecc565b81526200124e6040830162000ecc565b86820152620012606060830162000ecc565b60408201526080820151888111156200127857600080fd5b620012888f888386010162000f04565b60608301525060a0820151608082015260c082015160a082015260e082015160c082015261010082015160e0820152610120820151610100820152610140820151610120820152610160820151610140820152610180820151610160820152620012f66101a0830162000ecc565b6101808201526101c08201516101a08201526101e08201516101c08201526102008201516101e0820152610220820151610200820152610240820151610220820152610260820151888111156200134c57600080fd5b6200135c8f888386010162000f04565b61024083015250620013708a830162000f70565b6102608201528352509183019190830190620011f8565b8099505050506200139b89828a01620010b4565b955050505050509250929050565b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052602160045260246000fd5b634e487b7160e01b600052601160045260246000fd5b80820180821115620014015762001401620013d5565b92915050565b6000600182016200141c576200141c620013d5565b5060010190565b600060018060a01b038088168352808716602084015285604084015260a0606084015284518060a0850152620014618160c086016020890162000ede565b9316608083015250601f91909101601f19160160c001949350505050565b600181811c908216806200149457607f821691505b602082108103620014b557634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156200054357600081815260208120601f850160051c81016020861015620014e45750805b601f850160051c820191505b818110156200150557828155600101620014f0565b505050505050565b81516001600160401b0381111562001529576200152962000df4565b62001541816200153a84546200147f565b84620014bb565b602080601f831160018114620015795760008415620015605750858301515b600019600386901b1c1916600185901b17855562001505565b600085815260208120601f198616915b82811015620015aa5788860151825594840194600190910190840162001589565b5085821015620015c95787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b81810381811115620014015762001401620013d5565b634e487b7160e01b600052601260045260246000fd5b600082620016175762001617620015ef565b500490565b6000826200162e576200162e620015ef565b500690565b61992080620016436000396000f3fe608060405260043610620005135760003560e01c8063852c4849116200029f578063b3ecbadd116200016b578063d372c07e11620000d7578063e7f43c68116200008d578063e7f43c681462001222578063e98712b21462001242578063ee7ff28d146200125a578063f03b959d146200127f578063f1d592a714620012a4578063f7866ee314620012c957005b8063d372c07e146200113c578063d5f394881462001161578063d861b0e81462001183578063d886f8a2146200119b578063dd62ed3e14620011c0578063dfb1a4d2146200120a57005b8063c9d97af4116200012d578063c9d97af41462001085578063cb696f54146200109d578063ceaad45514620010c2578063cef9845014620010e7578063cf9c571914620010ff578063cfd19fb9146200111757005b8063b3ecbadd1462000fc7578063b46e55201462000fec578063b66b3e791462001011578063b6ef8c671462001039578063b7ab4db5146200105e57005b8063a515366a116200020b578063a9fd1a8f11620001cd578063a9fd1a8f1462000eec578063ab8f6ffe1462000f16578063ae1da0b51462000f3d578063ae1f5fa01462000f55578063affb1cf11462000f7d578063b2ea9adb1462000fa257005b8063a515366a1462000e2c578063a5d059ca1462000e43578063a8b2216e1462000e5a578063a9059cbb1462000e81578063a9af10591462000ea657005b806395d89b41116200026157806395d89b411462000d6257806396b477cb1462000d9057806396f18a321462000db55780639ac310741462000dd75780639c98e4711462000def5780639dc29fac1462000e0757005b8063852c48491462000cc3578063872cf0591462000ce857806389c614b81462000d005780638bac7dad1462000d185780638d7835ea1462000d3d57005b806340c10f1911620003df5780636fd2c80b116200034b578063784304b5116200030d578063784304b51462000a3f57806379502c551462000a645780637e660ac91462000c2e578063819b64631462000c53578063833b1fce1462000c6a57806384467fdb1462000c9e57005b80636fd2c80b146200098857806370a08231146200099f57806371d1bc5914620009d9578063731b3a0314620009f157806377e741c71462000a1a57005b8063573963b811620003a1578063573963b814620008cd5780635bdedd1614620008e55780636a929cef14620008fd5780636b5f444c14620009225780636bb9cb0e14620009475780636be0487b146200095f57005b806340c10f19146200081c578063436459691462000841578063496ccd9b14620008585780634bb278f3146200087d578063520fdbbc14620008a857005b806318160ddd116200047f57806329070c6d116200044157806329070c6d146200075e5780632f2c3f2e146200077557806330bcb81c146200078d578063313ce56714620007c157806335be16e014620007df578063386a827b146200080457005b806318160ddd14620006a05780631904bb2e14620006b75780631a0cf2e514620006eb57806323b872dd146200071057806325ce1bb9146200073557005b80630fe5010911620004d55780630fe501091462000602578063112206331462000627578063114eaf55146200063e5780631250a28d14620006635780631604e4161462000688578063161605e3146200051b57005b806306fdde03146200051d578063095ea7b314620005615780630ae65e7a14620005975780630b21fb1d14620005bc5780630d8e6e2c14620005e157005b366200051b57005b005b3480156200052a57600080fd5b506040805180820190915260068152652732bbba37b760d11b60208201525b604051620005589190620080de565b60405180910390f35b3480156200056e57600080fd5b50620005866200058036600462008110565b620012e9565b604051901515815260200162000558565b348015620005a457600080fd5b506200051b620005b63660046200813f565b62001302565b348015620005c957600080fd5b506200051b620005db3660046200815f565b620013a9565b348015620005ee57600080fd5b506024545b60405190815260200162000558565b3480156200060f57600080fd5b506200051b620006213660046200815f565b620013db565b3480156200063457600080fd5b50601354620005f3565b3480156200064b57600080fd5b506200051b6200065d3660046200815f565b6200140d565b3480156200067057600080fd5b506200051b620006823660046200813f565b6200143f565b3480156200069557600080fd5b50620005f3602f5481565b348015620006ad57600080fd5b50603b54620005f3565b348015620006c457600080fd5b50620006dc620006d63660046200813f565b62001490565b604051620005589190620081bd565b348015620006f857600080fd5b506200051b6200070a3660046200813f565b6200173e565b3480156200071d57600080fd5b50620005866200072f36600462008336565b6200178d565b3480156200074257600080fd5b506026546000908152602c6020526040902060030154620005f3565b3480156200076b57600080fd5b50601254620005f3565b3480156200078257600080fd5b50620005f361271081565b3480156200079a57600080fd5b50620007b2620007ac3660046200815f565b62001892565b6040516200055891906200837c565b348015620007ce57600080fd5b506040516012815260200162000558565b348015620007ec57600080fd5b506200051b620007fe36600462008402565b62001966565b3480156200081157600080fd5b50620005f360055481565b3480156200082957600080fd5b506200051b6200083b36600462008110565b62001b42565b3480156200084e57600080fd5b50602254620005f3565b3480156200086557600080fd5b506200051b620008773660046200813f565b62001b7f565b3480156200088a57600080fd5b506200089562001c89565b60405162000558959493929190620084c2565b348015620008b557600080fd5b506200051b620008c73660046200813f565b62002503565b348015620008da57600080fd5b50620005f360285481565b348015620008f257600080fd5b50620005f360075481565b3480156200090a57600080fd5b506200051b6200091c3660046200815f565b620026fe565b3480156200092f57600080fd5b506200051b620009413660046200815f565b62002730565b3480156200095457600080fd5b50620005f360375481565b3480156200096c57600080fd5b5062000977620027b6565b604051620005589392919062008541565b3480156200099557600080fd5b50601554620005f3565b348015620009ac57600080fd5b50620005f3620009be3660046200813f565b6001600160a01b031660009081526039602052604090205490565b348015620009e657600080fd5b50620005f360065481565b348015620009fe57600080fd5b506026546000908152602c6020526040902060020154620005f3565b34801562000a2757600080fd5b506200051b62000a393660046200815f565b620028e7565b34801562000a4c57600080fd5b506200051b62000a5e3660046200861a565b62002919565b34801562000a7157600080fd5b506040805160c080820183526012548252601354602080840191909152601454838501526015546060808501919091526016546080808601919091526017546001600160a01b0390811660a08088019190915287516101008101895260185483168152601954831681870152601a548316818a0152601b54831681860152601c54831681850152601d54831691810191909152601e54821695810195909552601f54811660e086015286519182018752835416815260215492810192909252602254948201949094526023549381019390935260245462000b50939084565b6040805185518152602080870151818301528683015182840152606080880151818401526080808901518185015260a0988901516001600160a01b039081168a8601528851811660c080870191909152848a0151821660e0808801919091528a8801518316610100880152848b01518316610120880152928a015182166101408701529989015181166101608601529888015189166101808501529096015187166101a083015284519096166101c0820152948301516101e08601528201516102008501529101516102208301526102408201526102600162000558565b34801562000c3b57600080fd5b506200051b62000c4d3660046200815f565b62002ac4565b34801562000c6057600080fd5b50602354620005f3565b34801562000c7757600080fd5b506019546001600160a01b03165b6040516001600160a01b03909116815260200162000558565b34801562000cab57600080fd5b506200051b62000cbd3660046200866f565b62002af6565b34801562000cd057600080fd5b506200051b62000ce236600462008110565b62002c25565b34801562000cf557600080fd5b506200051b62002db6565b34801562000d0d57600080fd5b50620005f3602a5481565b34801562000d2557600080fd5b506200051b62000d373660046200815f565b62002df2565b34801562000d4a57600080fd5b506200051b62000d5c36600462008726565b62002e76565b34801562000d6f57600080fd5b50604080518082019091526003815262272a2760e91b602082015262000549565b34801562000d9d57600080fd5b50620005f362000daf3660046200815f565b62003053565b34801562000dc257600080fd5b50603e5462000c85906001600160a01b031681565b34801562000de457600080fd5b50620005f3603c5481565b34801562000dfc57600080fd5b50620005f3602b5481565b34801562000e1457600080fd5b506200051b62000e2636600462008110565b620030eb565b620005f362000e3d36600462008110565b62003208565b620005f362000e5436600462008110565b62003325565b34801562000e6757600080fd5b5062000e72620033fe565b604051620005589190620087b5565b34801562000e8e57600080fd5b506200058662000ea036600462008110565b620034e1565b34801562000eb357600080fd5b5062000edd62000ec53660046200815f565b6000908152600b602052604090206006015460ff1690565b6040516200055891906200881b565b34801562000ef957600080fd5b5062000f0462003539565b60405162000558949392919062008830565b34801562000f2357600080fd5b5062000f2e620036b6565b60405162000558919062008861565b34801562000f4a57600080fd5b50620005f360295481565b34801562000f6257600080fd5b5062000f6d620037c8565b60405162000558929190620088bc565b34801562000f8a57600080fd5b5062000f0462000f9c3660046200815f565b62003a92565b34801562000faf57600080fd5b506200051b62000fc1366004620088ee565b62003c99565b34801562000fd457600080fd5b506200051b62000fe63660046200813f565b62003ce0565b34801562000ff957600080fd5b506200051b6200100b3660046200813f565b62003d2f565b3480156200101e57600080fd5b506200102962003f80565b604051620005589291906200894e565b3480156200104657600080fd5b50620005f3620010583660046200815f565b620040b7565b3480156200106b57600080fd5b506200107662004149565b60405162000558919062008977565b3480156200109257600080fd5b50620005f360265481565b348015620010aa57600080fd5b506200051b620010bc3660046200815f565b620041ad565b348015620010cf57600080fd5b506200051b620010e13660046200813f565b62004210565b348015620010f457600080fd5b50620005f360045481565b3480156200110c57600080fd5b506200051b6200425f565b3480156200112457600080fd5b506200051b620011363660046200813f565b620042b4565b3480156200114957600080fd5b506200051b6200115b3660046200813f565b62004303565b3480156200116e57600080fd5b50603d5462000c85906001600160a01b031681565b3480156200119057600080fd5b506200051b62004352565b348015620011a857600080fd5b506200051b620011ba3660046200813f565b620045d2565b348015620011cd57600080fd5b50620005f3620011df3660046200898c565b6001600160a01b03918216600090815260316020908152604080832093909416825291909152205490565b3480156200121757600080fd5b50620005f362004621565b3480156200122f57600080fd5b506020546001600160a01b031662000c85565b3480156200124f57600080fd5b50620005f3602e5481565b3480156200126757600080fd5b506200051b620012793660046200815f565b6200463e565b3480156200128c57600080fd5b506200051b6200129e3660046200813f565b62004670565b348015620012b157600080fd5b506200051b620012c33660046200813f565b620046bf565b348015620012d657600080fd5b506017546001600160a01b031662000c85565b6000620012f833848462004778565b5060015b92915050565b6200130c620048a1565b6001600160a01b038082166000818152603a602052604090206001015490911614620013555760405162461bcd60e51b81526004016200134c90620089ca565b60405180910390fd5b6001600160a01b038181166000908152603a6020526040902054163314620013915760405162461bcd60e51b81526004016200134c9062008a01565b6200139c81620048f0565b620013a660008055565b50565b6020546001600160a01b03163314620013d65760405162461bcd60e51b81526004016200134c9062008a4d565b600555565b6020546001600160a01b03163314620014085760405162461bcd60e51b81526004016200134c9062008a4d565b600455565b6020546001600160a01b031633146200143a5760405162461bcd60e51b81526004016200134c9062008a4d565b601555565b6020546001600160a01b031633146200146c5760405162461bcd60e51b81526004016200134c9062008a4d565b601880546001600160a01b0319166001600160a01b0392909216919091179055565b565b6200149a62007dfe565b6001600160a01b038083166000818152603a602052604090206001015490911614620014da5760405162461bcd60e51b81526004016200134c9062008a84565b6001600160a01b038083166000908152603a602090815260409182902082516102808101845281548516815260018201548516928101929092526002810154909316918101919091526003820180549192916060840191906200153d9062008abb565b80601f01602080910402602001604051908101604052809291908181526020018280546200156b9062008abb565b8015620015bc5780601f106200159057610100808354040283529160200191620015bc565b820191906000526020600020905b8154815290600101906020018083116200159e57829003601f168201915b505050918352505060048201546020820152600582015460408201526006820154606082015260078201546080820152600882015460a0820152600982015460c0820152600a82015460e0820152600b820154610100820152600c8201546001600160a01b0316610120820152600d820154610140820152600e820154610160820152600f82015461018082015260108201546101a082015260118201546101c08201526012820180546101e090920191620016789062008abb565b80601f0160208091040260200160405190810160405280929190818152602001828054620016a69062008abb565b8015620016f75780601f10620016cb57610100808354040283529160200191620016f7565b820191906000526020600020905b815481529060010190602001808311620016d957829003601f168201915b5050509183525050601382015460209091019060ff16600381111562001721576200172162008179565b600381111562001735576200173562008179565b90525092915050565b6020546001600160a01b031633146200176b5760405162461bcd60e51b81526004016200134c9062008a4d565b601f80546001600160a01b0319166001600160a01b0392909216919091179055565b6001600160a01b038316600090815260316020908152604080832033845290915281205482811015620018145760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e74206578636565647320616044820152676c6c6f77616e636560c01b60648201526084016200134c565b62001821858585620049e0565b62001839853362001833868562008b0d565b62004778565b836001600160a01b0316856001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef856040516200187f91815260200190565b60405180910390a3506001949350505050565b6200189c62007ece565b6000828152600b602090815260409182902082516101208101845281546001600160a01b03908116825260018301541692810192909252600281015492820192909252600380830154606083015260048301546080830152600583015460a0830152600683015491929160c084019160ff9091169081111562001923576200192362008179565b600381111562001937576200193762008179565b81526006919091015460ff61010082048116151560208401526201000090910416151560409091015292915050565b6018546001600160a01b03163314620019ce5760405162461bcd60e51b815260206004820152602360248201527f63616c6c6572206973206e6f742074686520736c617368696e6720636f6e74726044820152621858dd60ea1b60648201526084016200134c565b6000610120820135603a82620019eb60408601602087016200813f565b6001600160a01b03166001600160a01b031681526020019081526020016000206009015462001a1b919062008b0d565b60c0830135603a600062001a3660408701602088016200813f565b6001600160a01b03166001600160a01b031681526020019081526020016000206006015462001a66919062008b0d565b60a0840135603a600062001a8160408801602089016200813f565b6001600160a01b03166001600160a01b031681526020019081526020016000206005015462001ab1919062008b0d565b62001abd919062008b23565b62001ac9919062008b23565b6017546001600160a01b031660009081526039602052604081208054929350839290919062001afa90849062008b23565b90915550829050603a600062001b1760408401602085016200813f565b6001600160a01b03168152602081019190915260400160002062001b3c828262008d0f565b50505050565b6020546001600160a01b0316331462001b6f5760405162461bcd60e51b81526004016200134c9062008a4d565b62001b7b828262004aa6565b5050565b6020546001600160a01b0316331462001bac5760405162461bcd60e51b81526004016200134c9062008a4d565b601980546001600160a01b0319166001600160a01b03838116918217909255601a54604051637adbf97360e01b8152600481019290925290911690637adbf97390602401600060405180830381600087803b15801562001c0b57600080fd5b505af115801562001c20573d6000803e3d6000fd5b5050601c54604051637adbf97360e01b81526001600160a01b0385811660048301529091169250637adbf97391506024015b600060405180830381600087803b15801562001c6d57600080fd5b505af115801562001c82573d6000803e3d6000fd5b5050505050565b603d546000908190606090829081906001600160a01b0316331462001cc25760405162461bcd60e51b81526004016200134c9062008e65565b62001ccc620048a1565b4360298190556026546000828152602760209081526040808320849055928252602c90528190206003015460185491516306c9789b60e41b815292101560048301819052916001600160a01b0390911690636c9789b090602401600060405180830381600087803b15801562001d4157600080fd5b505af115801562001d56573d6000803e3d6000fd5b505050508015620022bb57601e54603b54603c54602a546040516392eff3cd60e01b81526004810193909352602483019190915260448201524260648201526000916001600160a01b0316906392eff3cd90608401602060405180830381865afa15801562001dc9573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019062001def919062008ea8565b905080603c54101562001e015750603c545b62001e0d308262004aa6565b80603c600082825462001e21919062008b0d565b9091555050601f546040805163f968f49360e01b815281516001600160a01b039093169263f968f4939260048084019391929182900301816000875af192505050801562001e8e575060408051601f3d908101601f1916820190925262001e8b9181019062008ec2565b60015b62001ecc576040514281527ff1217bb194383aa5c937c81cbbb445990a478c7511b080df329f9b0fb64233c99060200160405180910390a162001eff565b60175462001ee4906001600160a01b03168262004aa6565b601f5462001efc906001600160a01b03168362004aa6565b50505b3060009081526039602052604090205462001f1c90479062004b2c565b62001f2662005073565b62001f3062005336565b62001f3a62005425565b62001f44620054c4565b60008062001f51620037c8565b60195460405163422811f960e11b81529294509092506001600160a01b03169063845023f29062001f8790859060040162008977565b600060405180830381600087803b15801562001fa257600080fd5b505af115801562001fb7573d6000803e3d6000fd5b505060185460405163e08b14ed60e01b81526001600160a01b03909116925063e08b14ed915062001fed90849060040162008977565b600060405180830381600087803b1580156200200857600080fd5b505af11580156200201d573d6000803e3d6000fd5b50506026546000908152602c602052604090206002015460285460215491935014801591506200204e575060285415155b15620020bc576028546021819055601854604051631ad7d11360e21b815260048101929092526001600160a01b031690636b5f444c90602401600060405180830381600087803b158015620020a257600080fd5b505af1158015620020b7573d6000803e3d6000fd5b505050505b604d54156200210757604654603f55604754604055604854604155604954604255604a54604355604b54604455604c546045805460ff191660ff90921615159190911790556000604d555b60215460009062002119904362008b23565b905042602a8190555060016026600082825462002137919062008b23565b909155505060265460408051602d805460a0602082028401810190945260808301818152620022799594849391929060009085015b828210156200225a576000848152602090819020604080516060810182526003860290920180546001600160a01b0316835260018101549383019390935260028301805492939291840191620021c29062008abb565b80601f0160208091040260200160405190810160405280929190818152602001828054620021f09062008abb565b8015620022415780601f10620022155761010080835404028352916020019162002241565b820191906000526020600020905b8154815290600101906020018083116200222357829003601f168201915b505050505081525050815260200190600101906200216c565b50505050815260200185815260200143815260200184815250620055df565b7febad8099c467528a56c98b63c8d476d251cf1ffb4c75db94b4d23fa2b6a1e335602654604051620022ad91815260200190565b60405180910390a150505050505b60195460408051634bb278f360e01b815290516000926001600160a01b031691634bb278f3916004808301926020929190829003018187875af115801562002307573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906200232d919062008ee7565b90508015620023a957601a546040805163a2e6204560e01b815290516001600160a01b039092169163a2e620459160048082019260209290919082900301816000875af1925050508015620023a1575060408051601f3d908101601f191682019092526200239e9181019062008ee7565b60015b15620023a957505b600380546026546000908152602c602090815260408083206001810154950154602d805483518186028101860190945280845260ff909616968996919590949293928692919084015b82821015620024e0576000848152602090819020604080516060810182526003860290920180546001600160a01b0316835260018101549383019390935260028301805492939291840191620024489062008abb565b80601f0160208091040260200160405190810160405280929190818152602001828054620024769062008abb565b8015620024c75780601f106200249b57610100808354040283529160200191620024c7565b820191906000526020600020905b815481529060010190602001808311620024a957829003601f168201915b50505050508152505081526020019060010190620023f2565b505050509250965096509650965096505050620024fc60008055565b9091929394565b6020546001600160a01b03163314620025305760405162461bcd60e51b81526004016200134c9062008a4d565b602080546001600160a01b0319166001600160a01b0383811691821790925560195460405163b3ab15fb60e01b815260048101929092529091169063b3ab15fb90602401600060405180830381600087803b1580156200258f57600080fd5b505af1158015620025a4573d6000803e3d6000fd5b5050601a5460405163b3ab15fb60e01b81526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b158015620025f057600080fd5b505af115801562002605573d6000803e3d6000fd5b5050601b5460405163b3ab15fb60e01b81526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b1580156200265157600080fd5b505af115801562002666573d6000803e3d6000fd5b5050601c5460405163b3ab15fb60e01b81526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b158015620026b257600080fd5b505af1158015620026c7573d6000803e3d6000fd5b5050601d5460405163b3ab15fb60e01b81526001600160a01b038581166004830152909116925063b3ab15fb915060240162001c52565b6020546001600160a01b031633146200272b5760405162461bcd60e51b81526004016200134c9062008a4d565b603755565b6020546001600160a01b031633146200275d5760405162461bcd60e51b81526004016200134c9062008a4d565b60288190556026546000908152602c6020908152604091829020600301548251848152918201819052917f2eea6438d890c8603d4df81ad1bad2a4ea45c02b4837165f461ff3c81603abc7910160405180910390a15050565b620027f96040518060e001604052806000815260200160008152602001600081526020016000815260200160008152602001600081526020016000151581525090565b6200283c6040518060e001604052806000815260200160008152602001600081526020016000815260200160008152602001600081526020016000151581525090565b5050604d546040805160e08082018352603f54825282546020808401919091526041548385015260425460608085019190915260435460808086019190915260445460a08087019190915260455460ff908116151560c080890191909152885196870189526046548752604754958701959095526048549786019790975260495492850192909252604a5490840152604b5490830152604c5490931615159281019290925292909190565b6020546001600160a01b03163314620029145760405162461bcd60e51b81526004016200134c9062008a4d565b601255565b6001600160a01b038083166000818152603a602052604090206001810154909216146200295a5760405162461bcd60e51b81526004016200134c9062008a84565b80546001600160a01b03163314620029865760405162461bcd60e51b81526004016200134c9062008f07565b6200299183620056af565b15620029eb5760405162461bcd60e51b815260206004820152602260248201527f76616c696461746f72206d757374206e6f7420626520696e20636f6d6d697474604482015261656560f01b60648201526084016200134c565b600080620029f98462005720565b92509050811562002a3b5760405162461bcd60e51b815260206004820152600b60248201526a32b737b2329032b93937b960a91b60448201526064016200134c565b60018301546001600160a01b0382811691161462002aac5760405162461bcd60e51b815260206004820152602760248201527f76616c696461746f72206e6f646520616464726573732063616e2774206265206044820152661d5c19185d195960ca1b60648201526084016200134c565b6003830162002abc858262008f56565b505050505050565b6020546001600160a01b0316331462002af15760405162461bcd60e51b81526004016200134c9062008a4d565b600755565b6000604051806102800160405280336001600160a01b0316815260200160006001600160a01b03168152602001856001600160a01b0316815260200186815260200160126000016002015481526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b03168152602001600081526020014381526020016000815260200160008152602001600081526020018481526020016000600381111562002bc55762002bc562008179565b9052905062002bd5818362005765565b60208101516101808201516040517f8ad8bd2eb6950e5f332fd3a6dca48cb358ecfe3057848902b98cbdfe455c915c9262002c1692339289918b916200901e565b60405180910390a15050505050565b6001600160a01b038083166000818152603a60205260409020600101549091161462002c655760405162461bcd60e51b81526004016200134c90620089ca565b6001600160a01b038281166000908152603a602052604090205416331462002ca15760405162461bcd60e51b81526004016200134c9062008a01565b61271081111562002cf55760405162461bcd60e51b815260206004820152601f60248201527f7265717569726520636f727265637420636f6d6d697373696f6e20726174650060448201526064016200134c565b604080516060810182526001600160a01b038481168252436020808401918252838501868152601180546000908152600f909352958220855181546001600160a01b03191695169490941784559151600180850191909155915160029093019290925583549293909290919062002d6e90849062008b23565b90915550506040518281526001600160a01b038416907f4fba51c92fa3d6ad8374d394f6cd5766857552e153d7384a8f23aa4ce9a8a7cf9060200160405180910390a2505050565b6020546001600160a01b0316331462002de35760405162461bcd60e51b81526004016200134c9062008a4d565b6003805460ff19166001179055565b6020546001600160a01b0316331462002e1f5760405162461bcd60e51b81526004016200134c9062008a4d565b6000811162002e715760405162461bcd60e51b815260206004820152601960248201527f636f6d6d69747465652073697a652063616e277420626520300000000000000060448201526064016200134c565b602355565b6020546001600160a01b0316331462002ea35760405162461bcd60e51b81526004016200134c9062008a4d565b805162002eb09062005bbf565b801562002ec7575062002ec7816040015162005bbf565b801562002ede575062002ede816080015162005bbf565b62002f2c5760405162461bcd60e51b815260206004820152601d60248201527f696e697469616c2074696d656f7574206f7574206f6620626f756e647300000060448201526064016200134c565b62002f3b816020015162005bd7565b801562002f52575062002f52816060015162005bd7565b801562002f69575062002f698160a0015162005bd7565b62002fb75760405162461bcd60e51b815260206004820152601b60248201527f74696d656f75742064656c7461206f7574206f6620626f756e6473000000000060448201526064016200134c565b805160465560208082015160475560408083015160485560608301516049556080830151604a5560a0830151604b5560c0830151604c805460ff19169115159190911790556026546000908152602c9092529081902060030154604d81905590517fa4cc9b6d7e44f45ad3dfc950f52c5b7c915f5aaab5b2316be12db869407309f291620030489184919062009067565b60405180910390a150565b6000602954600162003066919062008b23565b821115620030c65760405162461bcd60e51b815260206004820152602660248201527f63616e6e6f74206765742065706f636820696420666f7220612066757475726560448201526520626c6f636b60d01b60648201526084016200134c565b6029548211620030e3575060009081526027602052604090205490565b505060265490565b6020546001600160a01b03163314620031185760405162461bcd60e51b81526004016200134c9062008a4d565b6001600160a01b0382166000908152603960205260409020548111156200317b5760405162461bcd60e51b8152602060048201526016602482015275416d6f756e7420657863656564732062616c616e636560501b60448201526064016200134c565b6001600160a01b03821660009081526039602052604081208054839290620031a590849062008b0d565b9250508190555080603b6000828254620031c0919062008b0d565b90915550506040518181526001600160a01b038316907f5024dbeedf0c06664c9bd7be836915730c955e936972c020683dadf11d5488a3906020015b60405180910390a25050565b600062003214620048a1565b6001600160a01b038084166000818152603a602052604090206001015490911614620032545760405162461bcd60e51b81526004016200134c9062008a84565b6001600160a01b0383166000908152603a602052604081206013015460ff16600381111562003287576200328762008179565b14620032d65760405162461bcd60e51b815260206004820152601b60248201527f76616c696461746f72206e65656420746f20626520616374697665000000000060448201526064016200134c565b603754620032e590346200909b565b33600090815260386020526040812080549091906200330690849062008b23565b9091555062003319905083833362005bef565b9050620012fc60008055565b600062003331620048a1565b6001600160a01b038084166000818152603a602052604090206001015490911614620033715760405162461bcd60e51b81526004016200134c9062008a84565b60008211620033bb5760405162461bcd60e51b81526020600482015260156024820152740756e626f6e64696e6720616d6f756e74206973203605c1b60448201526064016200134c565b603754620033ca90346200909b565b3360009081526038602052604081208054909190620033eb90849062008b23565b9091555062003319905083833362005e06565b60606030805480602002602001604051908101604052809291908181526020016000905b82821015620034d8578382906000526020600020018054620034449062008abb565b80601f0160208091040260200160405190810160405280929190818152602001828054620034729062008abb565b8015620034c35780601f106200349757610100808354040283529160200191620034c3565b820191906000526020600020905b815481529060010190602001808311620034a557829003601f168201915b50505050508152602001906001019062003422565b50505050905090565b6000620034f0338484620049e0565b6040518281526001600160a01b0384169033907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef9060200160405180910390a350600192915050565b6026546000908152602c602090815260408083208151815460a094810282018501909352608081018381526060959485948594859493909284928491879085015b8282101562003668576000848152602090819020604080516060810182526003860290920180546001600160a01b0316835260018101549383019390935260028301805492939291840191620035d09062008abb565b80601f0160208091040260200160405190810160405280929190818152602001828054620035fe9062008abb565b80156200364f5780601f1062003623576101008083540402835291602001916200364f565b820191906000526020600020905b8154815290600101906020018083116200363157829003601f168201915b505050505081525050815260200190600101906200357a565b50505090825250600182015460208083019190915260028301546040808401919091526003909301546060928301528351908401519284015193909101519098919750919550909350915050565b6060602d805480602002602001604051908101604052809291908181526020016000905b82821015620034d8576000848152602090819020604080516060810182526003860290920180546001600160a01b0316835260018101549383019390935260028301805492939291840191620037309062008abb565b80601f01602080910402602001604051908101604052809291908181526020018280546200375e9062008abb565b8015620037af5780601f106200378357610100808354040283529160200191620037af565b820191906000526020600020905b8154815290600101906020018083116200379157829003601f168201915b50505050508152505081526020019060010190620036da565b603d5460609081906001600160a01b03163314620037fa5760405162461bcd60e51b81526004016200134c9062008e65565b6025546200384b5760405162461bcd60e51b815260206004820152601860248201527f5468657265206d7573742062652076616c696461746f7273000000000000000060448201526064016200134c565b6200385562007f40565b602354608082015260258152603a6020820152602d6040820152602b60608201526200388181620061eb565b6200388f6030600062007f5e565b602d5480620038d65760405162461bcd60e51b8152602060048201526012602482015271636f6d6d697474656520697320656d70747960701b60448201526064016200134c565b6000816001600160401b03811115620038f357620038f362008570565b6040519080825280602002602001820160405280156200391d578160200160208202803683370190505b5090506000826001600160401b038111156200393d576200393d62008570565b60405190808252806020026020018201604052801562003967578160200160208202803683370190505b50905060005b8381101562003a86576000603a6000602d8481548110620039925762003992620090b2565b60009182526020808320600392830201546001600160a01b031684528301939093526040909101812060308054600181018255925292507f6ff97a59c90d62cc7236ba3a37cd85351bf564556780cf8c1157a220f31f0cbb0190620039fa90830182620090c8565b50600281015484516001600160a01b039091169085908490811062003a235762003a23620090b2565b6001600160a01b0392831660209182029290920101526001820154845191169084908490811062003a585762003a58620090b2565b6001600160a01b0390921660209283029190910190910152508062003a7d81620091a5565b9150506200396d565b50909450925050509091565b60606000806000602954600162003aaa919062008b23565b85111562003b075760405162461bcd60e51b815260206004820152602360248201527f63616e6e6f74206765742065706f636820666f7220612066757475726520626c6044820152626f636b60e81b60648201526084016200134c565b602654602954861162003b2557506000858152602760205260409020545b6000818152602c602090815260408083208151815460a09481028201850190935260808101838152909391928492849190879085015b8282101562003c49576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162003bb19062008abb565b80601f016020809104026020016040519081016040528092919081815260200182805462003bdf9062008abb565b801562003c305780601f1062003c045761010080835404028352916020019162003c30565b820191906000526020600020905b81548152906001019060200180831162003c1257829003601f168201915b5050505050815250508152602001906001019062003b5b565b5050509082525060018201546020808301919091526002830154604080840191909152600390930154606092830152835190840151928401519390910151909a9199509197509095509350505050565b6020546001600160a01b0316331462003cc65760405162461bcd60e51b81526004016200134c9062008a4d565b62003cd36001836200620b565b62001b7b6002826200620b565b6020546001600160a01b0316331462003d0d5760405162461bcd60e51b81526004016200134c9062008a4d565b601b80546001600160a01b0319166001600160a01b0392909216919091179055565b62003d39620048a1565b6001600160a01b038082166000818152603a60205260409020600101549091161462003d795760405162461bcd60e51b81526004016200134c90620089ca565b6001600160a01b038082166000908152603a602052604090208054909116331462003db85760405162461bcd60e51b81526004016200134c9062008f07565b6000601382015460ff16600381111562003dd65762003dd662008179565b0362003e255760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f7220616c726561647920616374697665000000000000000060448201526064016200134c565b6002601382015460ff16600381111562003e435762003e4362008179565b14801562003e545750438160100154115b1562003ea35760405162461bcd60e51b815260206004820152601760248201527f76616c696461746f72207374696c6c20696e206a61696c00000000000000000060448201526064016200134c565b6003601382015460ff16600381111562003ec15762003ec162008179565b0362003f105760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206a61696c6564207065726d616e656e746c790000000060448201526064016200134c565b60138101805460ff1916905580546026546000908152602c60209081526040918290206003015491519182526001600160a01b038581169316917f60fcbf2d07dc712a93e59fb28f1edb626d7c2497c57ba71a8c0b3999ecb9a3b5910160405180910390a350620013a660008055565b6060806001600281805462003f959062008abb565b80601f016020809104026020016040519081016040528092919081815260200182805462003fc39062008abb565b8015620040145780601f1062003fe85761010080835404028352916020019162004014565b820191906000526020600020905b81548152906001019060200180831162003ff657829003601f168201915b50505050509150808054620040299062008abb565b80601f0160208091040260200160405190810160405280929190818152602001828054620040579062008abb565b8015620040a85780601f106200407c57610100808354040283529160200191620040a8565b820191906000526020600020905b8154815290600101906020018083116200408a57829003601f168201915b50505050509050915091509091565b600060036000838152600b602052604090206006015460ff166003811115620040e457620040e462008179565b14620041335760405162461bcd60e51b815260206004820152601e60248201527f756e626f6e64696e672072656c65617365206e6f74207265766572746564000060448201526064016200134c565b506000908152600b602052604090206005015490565b60606025805480602002602001604051908101604052809291908181526020018280548015620041a357602002820191906000526020600020905b81546001600160a01b0316815260019091019060200180831162004184575b5050505050905090565b6020546001600160a01b03163314620041da5760405162461bcd60e51b81526004016200134c9062008a4d565b60138190556040518181527f1f4d2fc7529047a5bd96d3229bfea127fd18b7748f13586e097c69fccd3891289060200162003048565b6020546001600160a01b031633146200423d5760405162461bcd60e51b81526004016200134c9062008a4d565b601d80546001600160a01b0319166001600160a01b0392909216919091179055565b6020546001600160a01b031633146200428c5760405162461bcd60e51b81526004016200134c9062008a4d565b6200429a6001600062007f7e565b620042a86002600062007f7e565b6003805460ff19169055565b6020546001600160a01b03163314620042e15760405162461bcd60e51b81526004016200134c9062008a4d565b601c80546001600160a01b0319166001600160a01b0392909216919091179055565b6020546001600160a01b03163314620043305760405162461bcd60e51b81526004016200134c9062008a4d565b601a80546001600160a01b0319166001600160a01b0392909216919091179055565b603d546001600160a01b031633146200437f5760405162461bcd60e51b81526004016200134c9062008e65565b62004389620048a1565b6200439362005336565b60005b604e548110156200444f57604e8181548110620043b757620043b7620090b2565b90600052602060002090602091828204019190069054906101000a900460ff16603a600060258481548110620043f157620043f1620090b2565b60009182526020808320909101546001600160a01b031683528201929092526040019020601301805460ff1916600183600381111562004435576200443562008179565b0217905550806200444681620091a5565b91505062004396565b506200445e604e600062007fbd565b62004468620037c8565b505042602a5543602981905560009081526027602090815260408083208390556026548151602d805460a09581028301860190945260808201848152620045c896939592948594919390919085015b82821015620045a5576000848152602090819020604080516060810182526003860290920180546001600160a01b03168352600181015493830193909352600283018054929392918401916200450d9062008abb565b80601f01602080910402602001604051908101604052809291908181526020018280546200453b9062008abb565b80156200458c5780601f1062004560576101008083540402835291602001916200458c565b820191906000526020600020905b8154815290600101906020018083116200456e57829003601f168201915b50505050508152505081526020019060010190620044b7565b5050509082525060006020820152436040820152602154606090910152620055df565b6200148e60008055565b6020546001600160a01b03163314620045ff5760405162461bcd60e51b81526004016200134c9062008a4d565b601780546001600160a01b0319166001600160a01b0392909216919091179055565b6028546021546000911462004637575060285490565b5060215490565b6020546001600160a01b031633146200466b5760405162461bcd60e51b81526004016200134c9062008a4d565b600655565b6020546001600160a01b031633146200469d5760405162461bcd60e51b81526004016200134c9062008a4d565b601e80546001600160a01b0319166001600160a01b0392909216919091179055565b6020546001600160a01b03163314620046ec5760405162461bcd60e51b81526004016200134c9062008a4d565b6001600160a01b038116620047565760405162461bcd60e51b815260206004820152602960248201527f696e76616c696420636f6e7472616374206164647265737320666f72206c6971604482015268756964206c6f67696360b81b60648201526084016200134c565b603e80546001600160a01b0319166001600160a01b0392909216919091179055565b6001600160a01b038316620047dc5760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f206164646044820152637265737360e01b60648201526084016200134c565b6001600160a01b0382166200483f5760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f206164647265604482015261737360f01b60648201526084016200134c565b6001600160a01b0383811660008181526031602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92591015b60405180910390a3505050565b60005415620048e95760405162461bcd60e51b81526020600482015260136024820152721c99595b9d1c985b98de4819195d1958dd1959606a1b60448201526064016200134c565b6001600055565b6001600160a01b0381166000908152603a6020526040812090601382015460ff16600381111562004925576200492562008179565b14620049745760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206d75737420626520616374697665000000000000000060448201526064016200134c565b60138101805460ff1916600117905580546026546000908152602c60209081526040918290206003015491519182526001600160a01b038581169316917f75bdcdbe540758778e669d108fbcb7ede734f27f46e4e5525eeb8ecf91849a9c910160405180910390a35050565b6001600160a01b03831660009081526039602052604090205481111562004a435760405162461bcd60e51b8152602060048201526016602482015275616d6f756e7420657863656564732062616c616e636560501b60448201526064016200134c565b6001600160a01b0383166000908152603960205260408120805483929062004a6d90849062008b0d565b90915550506001600160a01b0382166000908152603960205260408120805483929062004a9c90849062008b23565b9091555050505050565b6001600160a01b0382166000908152603960205260408120805483929062004ad090849062008b23565b9250508190555080603b600082825462004aeb919062008b23565b90915550506040518181526001600160a01b038316907f48490b4407bb949b708ec5f514b4167f08f4969baaf78d53b05028adf369bfcf90602001620031fc565b8115801562004b39575080155b1562004b43575050565b601254600090670de0b6b3a76400009062004b60908590620091c1565b62004b6c91906200909b565b9050801562004bea576017546040516000916001600160a01b03169083908381818185875af1925050503d806000811462004bc4576040519150601f19603f3d011682016040523d82523d6000602084013e62004bc9565b606091505b509091505080151560010362004be85762004be5828562008b0d565b93505b505b82602e600082825462004bfe919062008b23565b90915550600090505b602d5481101562001b3c576000603a6000602d848154811062004c2e5762004c2e620090b2565b600091825260208083206003909202909101546001600160a01b031683528201929092526040018120602b54602d805492945090918891908690811062004c795762004c79620090b2565b90600052602060002090600302016001015462004c979190620091c1565b62004ca391906200909b565b90506000602b5486602d868154811062004cc15762004cc1620090b2565b90600052602060002090600302016001015462004cdf9190620091c1565b62004ceb91906200909b565b9050600082118062004cfd5750600081115b156200505a576002601384015460ff16600381111562004d215762004d2162008179565b148062004d4957506003601384015460ff16600381111562004d475762004d4762008179565b145b1562004e135760185462004d699030906001600160a01b031683620049e0565b601854602d80546001600160a01b039092169163a8031a1d9185918890811062004d975762004d97620090b2565b600091825260209091206003909102015460405160e084901b6001600160e01b03191681526001600160a01b039091166004820152602481018590526044016000604051808303818588803b15801562004df057600080fd5b505af115801562004e05573d6000803e3d6000fd5b50505050505050506200505e565b6000836005015483856008015462004e2c9190620091c1565b62004e3891906200909b565b9050801562004efa57835460405160009182916001600160a01b03909116906108fc90859084818181858888f193505050503d806000811462004e98576040519150601f19603f3d011682016040523d82523d6000602084013e62004e9d565b606091505b50909250905081151560000362004ef75785546040517f1137d8c966ce69b9630fb2294be011f3d64cc56e91fad7d375f0662568e9d3529162004eee916001600160a01b03909116908490620091db565b60405180910390a15b50505b6000846005015483866008015462004f139190620091c1565b62004f1f91906200909b565b9050801562004f4157845462004f419030906001600160a01b031683620049e0565b600062004f4f828562008b0d565b9050600062004f5f848762008b0d565b9050600081118062004f715750600082115b156200500b57600c87015462004f939030906001600160a01b031684620049e0565b600c87015460405163a0ce552d60e01b8152600481018490526001600160a01b039091169063a0ce552d908390602401604080518083038185885af115801562004fe1573d6000803e3d6000fd5b50505050506040513d601f19601f8201168201806040525081019062005008919062008ec2565b50505b600187015460408051888152602081018890526001600160a01b03909216917f291e8ba3c0f4b0bd86e6e2346fcee1e7ca0975b1cc1886bfbc722d34f3f24d91910160405180910390a2505050505b5050505b806200506a81620091a5565b91505062004c07565b60355460005b8181101562001b7b576000603582815481106200509a576200509a620090b2565b60009182526020808320909101546001600160a01b0316808352603382526040808420805482518186028101860190935280835292955090929091908301828280156200511157602002820191906000526020600020905b81546001600160a01b03168152600190910190602001808311620050f2575b5050505050905060005b815181101562005199576001600160a01b03831660009081526032602052604081208351909190849084908110620051575762005157620090b2565b60200260200101516001600160a01b03166001600160a01b031681526020019081526020016000206000905580806200519090620091a5565b9150506200511b565b506001600160a01b0382166000908152603360205260408120620051bd9162007fe4565b6001600160a01b0382166000908152603860205260409020548151600754620051e79190620091c1565b81111562005203578151600754620052009190620091c1565b90505b60005a9050836001600160a01b031663d18736ab83856040518363ffffffff1660e01b815260040162005237919062008977565b600060405180830381600088803b1580156200525257600080fd5b5087f19350505050801562005265575060015b6200529b575a62005277908262008b0d565b6001600160a01b0385166000908152603660205260409020600190559050620052ab565b5a620052a8908262008b0d565b90505b6001600160a01b03841660009081526038602052604090205481101562005302576001600160a01b03841660009081526038602052604081208054839290620052f690849062008b0d565b909155506200531c9050565b6001600160a01b0384166000908152603860205260408120555b5050505080806200532d90620091a5565b91505062005079565b6009545b600a5481101562005363576200535d816200535581620091a5565b92506200635e565b6200533a565b50600a54600955600d54600c54036200537857565b600e545b600d54811015620053a5576200539f816200539781620091a5565b9250620065ff565b6200537c565b50600d54600e55600c54805b600d548110156200541f576015546000828152600b60205260409020600401544391620053de9162008b23565b116200540457620053ef816200699b565b620053fc60018362008b23565b91506200540a565b6200541f565b806200541681620091a5565b915050620053b1565b50600c55565b60355460005b81811015620054b5576000603582815481106200544c576200544c620090b2565b60009182526020808320909101546001600160a01b031680835260348252604080842084905560369092529120549091506001036200549f576001600160a01b0381166000908152603660205260408120555b5080620054ac81620091a5565b9150506200542b565b50620013a66035600062007fe4565b60115460105410156200148e576010546000908152600f6020526040902060155460018201544391620054f79162008b23565b1115620055015750565b80546001600160a01b039081166000908152603a60205260409081902060028401546004808301829055600c83015493516319fac8fd60e01b8152929493909316926319fac8fd926200555992910190815260200190565b600060405180830381600087803b1580156200557457600080fd5b505af115801562005589573d6000803e3d6000fd5b5050601080546000908152600f6020526040812080546001600160a01b031916815560018082018390556002909101829055825490945091925090620055d190849062008b23565b90915550620054c492505050565b6000828152602c60209081526040808320918401516001830155830151600282015560608301516003820155905b82515181101562001b3c578251805183919083908110620056325762005632620090b2565b602090810291909101810151825460018082018555600094855293839020825160039092020180546001600160a01b0319166001600160a01b0390921691909117815591810151928201929092556040820151600282019062005696908262008f56565b5050508080620056a690620091a5565b9150506200560d565b6000805b602d548110156200571757602d8181548110620056d457620056d4620090b2565b60009182526020909120600390910201546001600160a01b0390811690841603620057025750600192915050565b806200570e81620091a5565b915050620056b3565b50600092915050565b6000806200572d62008004565b60008060ff9050604083875160208901845afa6200574a57600080fd5b50508051602090910151600160601b90910494909350915050565b60e2815114620057af5760405162461bcd60e51b8152602060048201526014602482015273092dcecc2d8d2c840e0e4dedecc40d8cadccee8d60631b60448201526064016200134c565b60308261024001515114620058075760405162461bcd60e51b815260206004820152601c60248201527f496e76616c696420636f6e73656e737573206b6579206c656e6774680000000060448201526064016200134c565b620058128262006b44565b604080518082018252601a81527f19457468657265756d205369676e6564204d6573736167653a0a00000000000060208083019190915284519251919260009262005875920160609190911b6bffffffffffffffffffffffff1916815260140190565b604051602081830303815290604052905060008262005895835162006c71565b83604051602001620058aa939291906200920c565b60408051601f1981840301815282825280516020918201206002808552606085018452909450600093929091830190803683370190505090506000808080620059028982620058fc60416002620091c1565b62006d91565b90506000620059218a6200591960416002620091c1565b606062006d91565b905060205b8251811015620059f2576200593c838262006eaa565b6040805160008152602081018083528d905260ff8316918101919091526060810184905260808101839052929850909650945060019060a0016020604051602081039080840390855afa15801562005998573d6000803e3d6000fd5b5050604051601f190151905087620059b26041846200909b565b81518110620059c557620059c5620090b2565b6001600160a01b0390921660209283029190910190910152620059ea60418262008b23565b905062005926565b508a602001516001600160a01b03168660008151811062005a175762005a17620090b2565b60200260200101516001600160a01b03161462005a895760405162461bcd60e51b815260206004820152602960248201527f496e76616c6964206e6f6465206b6579206f776e6572736869702070726f6f66604482015268081c1c9bdd9a59195960ba1b60648201526084016200134c565b8a604001516001600160a01b03168660018151811062005aad5762005aad620090b2565b60200260200101516001600160a01b03161462005b215760405162461bcd60e51b815260206004820152602b60248201527f496e76616c6964206f7261636c65206b6579206f776e6572736869702070726f60448201526a1bd9881c1c9bdd9a59195960aa1b60648201526084016200134c565b600162005b398c6102400151838e6000015162006ee1565b1462005ba75760405162461bcd60e51b815260206004820152603660248201527f496e76616c696420636f6e73656e737573206b6579206f776e65727368697020604482015275383937b7b3103337b9103932b3b4b9ba3930ba34b7b760511b60648201526084016200134c565b62005bb28b62006f50565b5050505050505050505050565b600060648210158015620012fc575050617530101590565b6000600a8210158015620012fc575050612710101590565b600080831162005c4e5760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201526269766560e81b60648201526084016200134c565b6001600160a01b03821660009081526039602052604090205483111562005cb85760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e6365000000000060448201526064016200134c565b6001600160a01b0382166000908152603960205260408120805485929062005ce290849062008b0d565b9091555050604080516080810182526001600160a01b03808516825286811660208084019182528385018881524360608601908152600a805460009081526008909452968320865181549087166001600160a01b03199182161782559451600182018054919097169516949094179094555160028301559151600390910155825491929062005d7183620091a5565b90915550506001600160a01b038581166000818152603a6020908152604091829020548251908516948816948514808252918101899052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d910160405180910390a3833b1562005deb5762005deb8487620071fd565b6001600a5462005dfc919062008b0d565b9695505050505050565b6001600160a01b038084166000908152603a602052604081208054919290918482169116148062005f7e57600c820154604051631092ab9160e31b81526001600160a01b03868116600483015260009216906384955c8890602401602060405180830381865afa15801562005e7f573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019062005ea5919062008ea8565b90508581101562005f0d5760405162461bcd60e51b815260206004820152602b60248201527f696e73756666696369656e7420756e6c6f636b6564204c6971756964204e657760448201526a746f6e2062616c616e636560a81b60648201526084016200134c565b600c83015460405163282d3fdf60e01b81526001600160a01b038781166004830152602482018990529091169063282d3fdf90604401600060405180830381600087803b15801562005f5e57600080fd5b505af115801562005f73573d6000803e3d6000fd5b505050505062006011565b8482600b0154836008015462005f95919062008b0d565b101562005ff55760405162461bcd60e51b815260206004820152602760248201527f696e73756666696369656e742073656c6620626f6e646564206e6577746f6e2060448201526662616c616e636560c81b60648201526084016200134c565b8482600b0160008282546200600b919062008b23565b90915550505b604051806101200160405280856001600160a01b03168152602001876001600160a01b031681526020018681526020016000815260200143815260200160008152602001600060038111156200606b576200606b62008179565b815260006020808301829052841515604093840152600d548252600b815290829020835181546001600160a01b039182166001600160a01b03199182161783559285015160018381018054929093169190941617905591830151600283015560608301516003808401919091556080840151600484015560a0840151600584015560c08401516006840180549193909260ff1990921691849081111562006116576200611662008179565b021790555060e082015160069091018054610100938401511515620100000262ff0000199315159094029290921662ffff001990921691909117919091179055600d80549060006200616883620091a5565b9190505550836001600160a01b0316866001600160a01b03167f63f8870909f7c59c9c4932bf98dbd491647c8d2e89ca0a032aacdd943a13e2fc8388604051620061be9291909115158252602082015260400190565b60405180910390a3833b15620061da57620061da8487620071fd565b6001600d5462005dfc919062008b0d565b60fa60a06000808285855af462006206573d6000803e3d6000fd5b505050565b815460026001808316156101000203821604825180820160208110602084100160028114620062ba5760018114620062e0578660005260208404602060002001600160028402018855602085068060200390508088018589016001836101000a0392508282511684540184556001840193506020820191505b80821015620062a3578151845560018401935060208201915062006284565b815191036101000a90819004029091555062006355565b60028302826020036101000a846020036101000a60208901510402018501875562006355565b8660005260208404602060002001600160028402018855846020038088018589016001836101000a0392508282511660ff198a160184556020820191506001840193505b8082101562006343578151845560018401935060208201915062006324565b815191036101000a9081900402909155505b50505050505050565b600081815260086020908152604080832060018101546001600160a01b03168452603a90925282209091601382015460ff166003811115620063a457620063a462008179565b141580620063cb575081546001600160a01b03166000908152603660205260409020546001145b156200648b57600282015482546001600160a01b0316600090815260396020526040812080549091906200640190849062008b23565b909155505081546001830154600284015460138401546040516001600160a01b0394851694909316927f1ff2b052afa4bb37ce30d9aaccde416a700b97e632d089111749af937f878342926200645f92909160ff9091169062009255565b60405180910390a380548254620062069185916000916001600160a01b03918216911614600162007302565b805482546001600160a01b03908116911614620065af5760008082600801548360050154620064bb919062008b0d565b905080600003620064d35783600201549150620064f9565b80846002015484600d0154620064ea9190620091c1565b620064f691906200909b565b91505b600c83015484546040516340c10f1960e01b81526001600160a01b039182166004820152602481018590529116906340c10f1990604401600060405180830381600087803b1580156200654b57600080fd5b505af115801562006560573d6000803e3d6000fd5b505050508183600d0160008282546200657a919062008b23565b909155505060028401546005840180546000906200659a90849062008b23565b9091555062001c829050858360008062007302565b8160020154816008016000828254620065c9919062008b23565b90915550506002820154600582018054600090620065e990849062008b23565b9091555062006206905083600060018162007302565b6000818152600b6020908152604080832080546001600160a01b03168452603690925290912054600103620066a1578054600182015460068301546002840154604080516201000090930460ff161515835260208301919091526001600160a01b0393841693909216917fec3ccfdbfc982a0ea188f4fd85459da1d6a7b332ddc4ca6b7a774c1abfc75866910160405180910390a362001b7b8260016200748b565b60018101546001600160a01b03166000908152603a60205260408120600683015490919062010000900460ff1662006889576002830154600c8301548454604051637eee288d60e01b81526001600160a01b03918216600482015260248101849052911690637eee288d90604401600060405180830381600087803b1580156200672a57600080fd5b505af11580156200673f573d6000803e3d6000fd5b50505050600c8301548454604051632770a7eb60e21b81526001600160a01b03918216600482015260248101849052911690639dc29fac90604401600060405180830381600087803b1580156200679557600080fd5b505af1158015620067aa573d6000803e3d6000fd5b50505050600083600801548460050154620067c6919062008b0d565b600d850154909150620067da8284620091c1565b620067e691906200909b565b92508184600d016000828254620067fe919062008b0d565b909155505060068401546000036200681d576003850183905562006845565b60068401546007850154620068339085620091c1565b6200683f91906200909b565b60038601555b828460060160008282546200685b919062008b23565b909155505060038501546007850180546000906200687b90849062008b23565b909155506200695e92505050565b5060028201546008820154811115620068a3575060088101545b8160090154600003620068bd5760038301819055620068e5565b6009820154600a830154620068d39083620091c1565b620068df91906200909b565b60038401555b80826009016000828254620068fb919062008b23565b90915550506003830154600a830180546000906200691b90849062008b23565b925050819055508082600801600082825462006938919062008b0d565b90915550506002830154600b830180546000906200695890849062008b0d565b90915550505b60068301805461ff0019166101001790556005820180548291906000906200698890849062008b0d565b9091555062001b3c90508460006200748b565b6000818152600b602052604090206006810154610100900460ff16620069d95760068101805460ff1916600217905562001b7b8260006001620075fe565b60068101805460ff19166001179055600381015460000362006a035762001b7b82600080620075fe565b60018101546001600160a01b03166000908152603a60205260408120600683015490919062010000900460ff1662006aa05781600701548260060154846003015462006a509190620091c1565b62006a5c91906200909b565b90508082600601600082825462006a74919062008b0d565b9091555050600383015460078301805460009062006a9490849062008b0d565b9091555062006b059050565b81600a01548260090154846003015462006abb9190620091c1565b62006ac791906200909b565b90508082600901600082825462006adf919062008b0d565b90915550506003830154600a8301805460009062006aff90849062008b0d565b90915550505b82546001600160a01b03166000908152603960205260408120805483929062006b3090849062008b23565b9091555062001b3c905084826000620075fe565b600062006b55826060015162005720565b6001600160a01b0390911660208401529050801562006ba55760405162461bcd60e51b815260206004820152600b60248201526a32b737b2329032b93937b960a91b60448201526064016200134c565b6020808301516001600160a01b039081166000908152603a909252604090912060010154161562006c195760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c726561647920726567697374657265640000000060448201526064016200134c565b6127108260800151111562001b7b5760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e207261746500000000000000000060448201526064016200134c565b60608160000362006c995750506040805180820190915260018152600360fc1b602082015290565b8160005b811562006cc9578062006cb081620091a5565b915062006cc19050600a836200909b565b915062006c9d565b6000816001600160401b0381111562006ce65762006ce662008570565b6040519080825280601f01601f19166020018201604052801562006d11576020820181803683370190505b5090505b841562006d895762006d2960018362008b0d565b915062006d38600a8662009274565b62006d4590603062008b23565b60f81b81838151811062006d5d5762006d5d620090b2565b60200101906001600160f81b031916908160001a90535062006d81600a866200909b565b945062006d15565b949350505050565b60608162006da181601f62008b23565b101562006de25760405162461bcd60e51b815260206004820152600e60248201526d736c6963655f6f766572666c6f7760901b60448201526064016200134c565b62006dee828462008b23565b8451101562006e345760405162461bcd60e51b8152602060048201526011602482015270736c6963655f6f75744f66426f756e647360781b60448201526064016200134c565b60608215801562006e55576040519150600082526020820160405262006ea1565b6040519150601f8416801560200281840101858101878315602002848b0101015b8183101562006e9057805183526020928301920162006e76565b5050858452601f01601f1916604052505b50949350505050565b8181018051602082015160409092015190919060001a601b81101562006eda5762006ed7601b826200928b565b90505b9250925092565b600062006eed62008022565b600085858560405160200162006f0693929190620092a7565b6040516020818303038152906040529050600060fb905060008251602062006f2f919062008b23565b90506020848285855afa62006f4357600080fd5b5050905195945050505050565b6101808101516001600160a01b03166200704f57603e546001600160a01b031662006fc95760405162461bcd60e51b815260206004820152602260248201527f6c6971756964206c6f67696320636f6e7472616374206e6f74206465706c6f79604482015261195960f21b60648201526084016200134c565b60255460009062006fda9062006c71565b905081602001518260000151836080015183603e60009054906101000a90046001600160a01b0316604051620070109062008040565b62007020959493929190620092f6565b604051809103906000f0801580156200703d573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051602580546001808201835560009283527f401968ff42a154441da5f6c4c935ac46b8671f0e062baaa62a7545ba53bb6e4c90910180546001600160a01b03199081166001600160a01b0395861617909155845184168352603a9095526040918290208651815487169085161781559351908401805486169184169190911790558401516002830180549094169116179091556060820151829190600382019062007101908262008f56565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c820180546001600160a01b0319166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f820155610200820151601082015561022082015160118201556102408201516012820190620071cb908262008f56565b5061026082015160138201805460ff19166001836003811115620071f357620071f362008179565b0217905550505050565b6001600160a01b03821660009081526034602052604081205490036200727b576001600160a01b03821660008181526034602052604081206001908190556035805491820181559091527fcfa4bec1d3298408bb5afcfcd9c430549c5b31f8aa5c5848151c0a55f473c34d0180546001600160a01b03191690911790555b6001600160a01b038083166000908152603260209081526040808320938516835292905290812054900362001b7b576001600160a01b03808316600081815260326020908152604080832094861680845294825280832060019081905593835260338252822080549384018155825290200180546001600160a01b03191690911790555050565b600084815260086020526040902080546001600160a01b0316803b6200732a57505062001b3c565b6001600160a01b0381166000908152603860205260409020546004548111156200735357506004545b60005a6001850154604051634efe8dc760e11b8152600481018b90526001600160a01b039182166024820152604481018a905288151560648201528715156084820152919250841690639dfd1b8e90849060a401600060405180830381600088803b158015620073c257600080fd5b5087f193505050508015620073d5575060015b62007400575a620073e7908262008b0d565b905084620073fa57620073fa886200776e565b62007410565b5a6200740d908262008b0d565b90505b6001600160a01b03831660009081526038602052604090205481101562007467576001600160a01b038316600090815260386020526040812080548392906200745b90849062008b0d565b90915550620074819050565b6001600160a01b0383166000908152603860205260408120555b5050505050505050565b6000828152600b6020526040902080546001600160a01b0316803b620074b15750505050565b6001600160a01b038116600090815260386020526040902054600554811115620074da57506005545b60005a600185015460405163a892024160e01b8152600481018990526001600160a01b039182166024820152871515604482015291925084169063a8920241908490606401600060405180830381600088803b1580156200753a57600080fd5b5087f1935050505080156200754d575060015b62007578575a6200755f908262008b0d565b9050846200757257620075728662007935565b62007588565b5a62007585908262008b0d565b90505b6001600160a01b038316600090815260386020526040902054811015620075df576001600160a01b03831660009081526038602052604081208054839290620075d390849062008b0d565b9091555062002abc9050565b50506001600160a01b0316600090815260386020526040812055505050565b6000838152600b6020526040902080546001600160a01b0316803b62007625575050505050565b6001600160a01b0381166000908152603860205260409020546006548111156200764e57506006545b60005a6040516303c54c2960e41b8152600481018990526024810188905286151560448201529091506001600160a01b03841690633c54c290908490606401600060405180830381600088803b158015620076a857600080fd5b5087f193505050508015620076bb575060015b620076e7575a620076cd908262008b0d565b905084620076e157620076e1878762007b8b565b620076f7565b5a620076f4908262008b0d565b90505b6001600160a01b0383166000908152603860205260409020548110156200774e576001600160a01b038316600090815260386020526040812080548392906200774290849062008b0d565b90915550620063559050565b50506001600160a01b031660009081526038602052604081205550505050565b600081815260086020908152604080832060018101546001600160a01b039081168552603a90935292208054835491929182169116146200787357600081600801548260050154620077c1919062008b0d565b836002015483600d0154620077d79190620091c1565b620077e391906200909b565b600c8301548454604051632770a7eb60e21b81526001600160a01b039182166004820152602481018490529293501690639dc29fac90604401600060405180830381600087803b1580156200783757600080fd5b505af11580156200784c573d6000803e3d6000fd5b505050508082600d01600082825462007866919062008b0d565b9091555062007893915050565b81600201548160080160008282546200788d919062008b0d565b90915550505b600282015482546001600160a01b031660009081526039602052604081208054909190620078c390849062008b23565b90915550506002820154600582018054600090620078e390849062008b0d565b90915550508154600183015460028401546040519081526001600160a01b0392831692909116907f2d2530c45d577d38d6fc598e41ade6fb0bfbde20d09244c88348d3a2797a9d909060200162004894565b6000818152600b6020908152604080832060018101546001600160a01b03168452603a909252822060068201549192909162010000900460ff1662007a6b576002830154600c83015484546040516340c10f1960e01b81526001600160a01b039182166004820152602481018490529116906340c10f1990604401600060405180830381600087803b158015620079cb57600080fd5b505af1158015620079e0573d6000803e3d6000fd5b505050508083600d016000828254620079fa919062008b23565b909155505060078301546006840154600386015462007a1a9190620091c1565b62007a2691906200909b565b91508183600601600082825462007a3e919062008b0d565b9091555050600384015460078401805460009062007a5e90849062008b0d565b9091555062007aed915050565b81600a01548260090154846003015462007a869190620091c1565b62007a9291906200909b565b90508082600901600082825462007aaa919062008b0d565b90915550506003830154600a8301805460009062007aca90849062008b0d565b925050819055508082600801600082825462007ae7919062008b23565b90915550505b60006003840181905560068401805461ff001916905560058301805483929062007b1990849062008b23565b90915550508254600184015460068501546002860154604080516201000090930460ff161515835260208301919091526001600160a01b0393841693909216917f52c1b482483796c697edd43958bc63e332069083ea38782d960693145db2cf0c91015b60405180910390a350505050565b6000828152600b602052604081209082900362007c0f57600681018054600360ff19909116179081905581546001830154604080516201000090940460ff1615158452602084018690526001600160a01b039283169392909116917f0a4bfc4c4e35975ab1fe5fd21d963e8bb8d483f04aebdbc0a059b246bec3f5fc910162004894565b60018101546001600160a01b03166000908152603a60205260409020600682015462010000900460ff1662007d1f576000808260080154836005015462007c57919062008b0d565b90508060000362007c6b5784915062007c8d565b808584600d015462007c7e9190620091c1565b62007c8a91906200909b565b91505b600c83015484546040516340c10f1960e01b81526001600160a01b039182166004820152602481018590529116906340c10f1990604401600060405180830381600087803b15801562007cdf57600080fd5b505af115801562007cf4573d6000803e3d6000fd5b505050508183600d01600082825462007d0e919062008b23565b909155505050600583015562007d44565b8282600501819055508281600801600082825462007d3e919062008b23565b90915550505b60068201805460ff1916600317905581546001600160a01b03166000908152603960205260408120805485929062007d7e90849062008b0d565b925050819055508281600501600082825462007d9b919062008b23565b9091555050815460018301546006840154604080516201000090920460ff1615158252602082018790526001600160a01b0393841693909216917f0a4bfc4c4e35975ab1fe5fd21d963e8bb8d483f04aebdbc0a059b246bec3f5fc910162007b7d565b60405180610280016040528060006001600160a01b0316815260200160006001600160a01b0316815260200160006001600160a01b0316815260200160608152602001600081526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b031681526020016000815260200160008152602001600081526020016000815260200160008152602001606081526020016000600381111562007ec95762007ec962008179565b905290565b60405180610120016040528060006001600160a01b0316815260200160006001600160a01b03168152602001600081526020016000815260200160008152602001600081526020016000600381111562007f2c5762007f2c62008179565b815260006020820181905260409091015290565b6040518060a001604052806005906020820280368337509192915050565b5080546000825590600052602060002090810190620013a691906200804e565b50805462007f8c9062008abb565b6000825580601f1062007f9d575050565b601f016020900490600052602060002090810190620013a6919062008073565b50805460008255601f016020900490600052602060002090810190620013a6919062008073565b5080546000825590600052602060002090810190620013a6919062008073565b60405180604001604052806002906020820280368337509192915050565b60405180602001604052806001906020820280368337509192915050565b6105c2806200932983390190565b808211156200806f57600062008065828262007f7e565b506001016200804e565b5090565b5b808211156200806f576000815560010162008074565b60005b83811015620080a75781810151838201526020016200808d565b50506000910152565b60008151808452620080ca8160208601602086016200808a565b601f01601f19169290920160200192915050565b602081526000620080f36020830184620080b0565b9392505050565b6001600160a01b0381168114620013a657600080fd5b600080604083850312156200812457600080fd5b82356200813181620080fa565b946020939093013593505050565b6000602082840312156200815257600080fd5b8135620080f381620080fa565b6000602082840312156200817257600080fd5b5035919050565b634e487b7160e01b600052602160045260246000fd5b60048110620013a657634e487b7160e01b600052602160045260246000fd5b620081b9816200818f565b9052565b60208152620081d86020820183516001600160a01b03169052565b60006020830151620081f560408401826001600160a01b03169052565b5060408301516001600160a01b0381166060840152506060830151610280806080850152620082296102a0850183620080b0565b9150608085015160a085015260a085015160c085015260c085015160e085015260e08501516101008181870152808701519150506101208181870152808701519150506101408181870152808701519150506101608181870152808701519150506101808181870152808701519150506101a0620082b1818701836001600160a01b03169052565b8601516101c0868101919091528601516101e080870191909152860151610200808701919091528601516102208087019190915286015161024080870191909152860151858403601f190161026080880191909152909150620083158483620080b0565b9350808701519150506200832c82860182620081ae565b5090949350505050565b6000806000606084860312156200834c57600080fd5b83356200835981620080fa565b925060208401356200836b81620080fa565b929592945050506040919091013590565b60006101208201905060018060a01b038084511683528060208501511660208401525060408301516040830152606083015160608301526080830151608083015260a083015160a083015260c0830151620083db60c0840182620081ae565b5060e0830151620083f060e084018215159052565b50610100928301511515919092015290565b6000602082840312156200841557600080fd5b81356001600160401b038111156200842c57600080fd5b82016102808185031215620080f357600080fd5b600081518084526020808501808196508360051b8101915082860160005b85811015620084b5578284038952815180516001600160a01b031685528581015186860152604090810151606091860182905290620084a081870183620080b0565b9a87019a95505050908401906001016200845e565b5091979650505050505050565b8515158152841515602082015260a060408201526000620084e760a083018662008440565b606083019490945250608001529392505050565b805182526020810151602083015260408101516040830152606081015160608301526080810151608083015260a081015160a083015260c0810151151560c08301525050565b6101e08101620085528286620084fb565b6200856160e0830185620084fb565b826101c0830152949350505050565b634e487b7160e01b600052604160045260246000fd5b600082601f8301126200859857600080fd5b81356001600160401b0380821115620085b557620085b562008570565b604051601f8301601f19908116603f01168101908282118183101715620085e057620085e062008570565b81604052838152866020858801011115620085fa57600080fd5b836020870160208301376000602085830101528094505050505092915050565b600080604083850312156200862e57600080fd5b82356200863b81620080fa565b915060208301356001600160401b038111156200865757600080fd5b620086658582860162008586565b9150509250929050565b600080600080608085870312156200868657600080fd5b84356001600160401b03808211156200869e57600080fd5b620086ac8883890162008586565b955060208701359150620086c082620080fa565b90935060408601359080821115620086d757600080fd5b620086e58883890162008586565b93506060870135915080821115620086fc57600080fd5b506200870b8782880162008586565b91505092959194509250565b8015158114620013a657600080fd5b600060e082840312156200873957600080fd5b60405160e081018181106001600160401b03821117156200875e576200875e62008570565b8060405250823581526020830135602082015260408301356040820152606083013560608201526080830135608082015260a083013560a082015260c0830135620087a98162008717565b60c08201529392505050565b6000602080830181845280855180835260408601915060408160051b870101925083870160005b828110156200880e57603f19888603018452620087fb858351620080b0565b94509285019290850190600101620087dc565b5092979650505050505050565b602081016200882a836200818f565b91905290565b60808152600062008845608083018762008440565b6020830195909552506040810192909252606090910152919050565b602081526000620080f3602083018462008440565b600081518084526020808501945080840160005b83811015620088b15781516001600160a01b0316875295820195908201906001016200888a565b509495945050505050565b604081526000620088d1604083018562008876565b8281036020840152620088e5818562008876565b95945050505050565b600080604083850312156200890257600080fd5b82356001600160401b03808211156200891a57600080fd5b620089288683870162008586565b935060208501359150808211156200893f57600080fd5b50620086658582860162008586565b604081526000620089636040830185620080b0565b8281036020840152620088e58185620080b0565b602081526000620080f3602083018462008876565b60008060408385031215620089a057600080fd5b8235620089ad81620080fa565b91506020830135620089bf81620080fa565b809150509250929050565b6020808252601c908201527f76616c696461746f72206d757374206265207265676973746572656400000000604082015260600190565b6020808252602c908201527f726571756972652063616c6c657220746f2062652076616c696461746f72206160408201526b191b5a5b881858d8dbdd5b9d60a21b606082015260800190565b6020808252601a908201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604082015260600190565b60208082526018908201527f76616c696461746f72206e6f7420726567697374657265640000000000000000604082015260600190565b600181811c9082168062008ad057607f821691505b60208210810362008af157634e487b7160e01b600052602260045260246000fd5b50919050565b634e487b7160e01b600052601160045260246000fd5b81810381811115620012fc57620012fc62008af7565b80820180821115620012fc57620012fc62008af7565b60008135620012fc81620080fa565b80546001600160a01b0319166001600160a01b0392909216919091179055565b6000808335601e1984360301811262008b8057600080fd5b8301803591506001600160401b0382111562008b9b57600080fd5b60200191503681900382131562008bb157600080fd5b9250929050565b601f8211156200620657600081815260208120601f850160051c8101602086101562008be15750805b601f850160051c820191505b8181101562002abc5782815560010162008bed565b600019600383901b1c191660019190911b1790565b6001600160401b0383111562008c315762008c3162008570565b62008c498362008c42835462008abb565b8362008bb8565b6000601f84116001811462008c7c576000851562008c675750838201355b62008c73868262008c02565b84555062001c82565b600083815260209020601f19861690835b8281101562008caf578685013582556020948501946001909201910162008c8d565b508682101562008ccd5760001960f88860031b161c19848701351681555b505060018560011b0183555050505050565b6000813560048110620012fc57600080fd5b62008cfc826200818f565b60ff1981541660ff831681178255505050565b62008d2562008d1e8362008b39565b8262008b48565b62008d4162008d376020840162008b39565b6001830162008b48565b62008d5d62008d536040840162008b39565b6002830162008b48565b62008d6c606083018362008b68565b62008d7c81836003860162008c17565b50506080820135600482015560a0820135600582015560c0820135600682015560e0820135600782015561010082013560088201556101208201356009820155610140820135600a820155610160820135600b82015562008def62008de5610180840162008b39565b600c830162008b48565b6101a0820135600d8201556101c0820135600e8201556101e0820135600f8201556102008201356010820155610220820135601182015562008e3661024083018362008b68565b62008e4681836012860162008c17565b505062001b7b62008e5b610260840162008cdf565b6013830162008cf1565b60208082526023908201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60408201526218dbdb60ea1b606082015260800190565b60006020828403121562008ebb57600080fd5b5051919050565b6000806040838503121562008ed657600080fd5b505080516020909101519092909150565b60006020828403121562008efa57600080fd5b8151620080f38162008717565b6020808252602f908201527f726571756972652063616c6c657220746f2062652076616c696461746f72207460408201526e1c99585cdd5c9e481858d8dbdd5b9d608a1b606082015260800190565b81516001600160401b0381111562008f725762008f7262008570565b62008f8a8162008f83845462008abb565b8462008bb8565b602080601f83116001811462008fbe576000841562008fa95750858301515b62008fb5858262008c02565b86555062002abc565b600085815260208120601f198616915b8281101562008fef5788860151825594840194600190910190840162008fce565b50858210156200900e5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b600060018060a01b0380881683528087166020840152808616604084015260a060608401526200905260a0840186620080b0565b91508084166080840152509695505050505050565b6101008101620090788285620084fb565b8260e08301529392505050565b634e487b7160e01b600052601260045260246000fd5b600082620090ad57620090ad62009085565b500490565b634e487b7160e01b600052603260045260246000fd5b818103620090d4575050565b620090e0825462008abb565b6001600160401b03811115620090fa57620090fa62008570565b6200910b8162008f83845462008abb565b6000601f8211600181146200913e5760008315620091295750848201545b62009135848262008c02565b85555062001c82565b600085815260209020601f19841690600086815260209020845b838110156200917a578286015482556001958601959091019060200162009158565b50858310156200900e5793015460001960f8600387901b161c19169092555050600190811b01905550565b600060018201620091ba57620091ba62008af7565b5060010190565b8082028115828204841417620012fc57620012fc62008af7565b60018060a01b0383168152606060208201526000606082015260806040820152600062006d896080830184620080b0565b60008451620092208184602089016200808a565b845190830190620092368183602089016200808a565b84519101906200924b8183602088016200808a565b0195945050505050565b8281526040810162009267836200818f565b8260208301529392505050565b60008262009286576200928662009085565b500690565b60ff8181168382160190811115620012fc57620012fc62008af7565b60008451620092bb8184602089016200808a565b845190830190620092d18183602089016200808a565b60609490941b6bffffffffffffffffffffffff19169301928352505060140192915050565b600060018060a01b038088168352808716602084015285604084015260a060608401526200905260a0840186620080b056fe608060405234801561001057600080fd5b506040516105c23803806105c283398101604081905261002f916101af565b806001600160a01b0316632f2c3f2e6040518163ffffffff1660e01b8152600401602060405180830381865afa15801561006d573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610091919061029f565b83111561009d57600080fd5b600c80546001600160a01b038088166001600160a01b031992831617909255600d805492871692909116919091179055600e8390556040516100e39083906020016102b8565b604051602081830303815290604052600a9081610100919061036e565b508160405160200161011291906102b8565b604051602081830303815290604052600b908161012f919061036e565b5050601080546001600160a01b031916331790555061042d92505050565b6001600160a01b038116811461016257600080fd5b50565b80516101708161014d565b919050565b634e487b7160e01b600052604160045260246000fd5b60005b838110156101a657818101518382015260200161018e565b50506000910152565b600080600080600060a086880312156101c757600080fd5b85516101d28161014d565b60208701519095506101e38161014d565b6040870151606088015191955093506001600160401b038082111561020757600080fd5b818801915088601f83011261021b57600080fd5b81518181111561022d5761022d610175565b604051601f8201601f19908116603f0116810190838211818310171561025557610255610175565b816040528281528b602084870101111561026e57600080fd5b61027f83602083016020880161018b565b809650505050505061029360808701610165565b90509295509295909350565b6000602082840312156102b157600080fd5b5051919050565b644c4e544e2d60d81b8152600082516102d881600585016020870161018b565b9190910160050192915050565b600181811c908216806102f957607f821691505b60208210810361031957634e487b7160e01b600052602260045260246000fd5b50919050565b601f82111561036957600081815260208120601f850160051c810160208610156103465750805b601f850160051c820191505b8181101561036557828155600101610352565b5050505b505050565b81516001600160401b0381111561038757610387610175565b61039b8161039584546102e5565b8461031f565b602080601f8311600181146103d057600084156103b85750858301515b600019600386901b1c1916600185901b178555610365565b600085815260208120601f198616915b828110156103ff578886015182559484019460019091019084016103e0565b508582101561041d5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b6101868061043c6000396000f3fe60806040523661001b57610019610014610022565b6100fd565b005b6100196100145b600080601060009054906101000a90046001600160a01b03166001600160a01b03166396f18a326040518163ffffffff1660e01b8152600401602060405180830381865afa158015610078573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061009c9190610120565b90506001600160a01b0381166100f85760405162461bcd60e51b815260206004820152601d60248201527f6c6971756964206c6f67696320636f6e7472616374206e6f7420736574000000604482015260640160405180910390fd5b919050565b3660008037600080366000845af43d6000803e8061011a573d6000fd5b503d6000f35b60006020828403121561013257600080fd5b81516001600160a01b038116811461014957600080fd5b939250505056fea2646970667358221220b2e2dcd6d0d78f9596fd256c4e804aebbbccf69378693f30233ea3e89b3b740864736f6c63430008150033a26469706673582212205742d3e49e4cc667594518df4a1d33de16e99f452878894fbb88acd7d00d293264736f6c63430008150033608060405234801561001057600080fd5b50601080546001600160a01b03191633179055611702806100326000396000f3fe6080604052600436106101855760003560e01c80633e4eb36c116100d1578063949813b81161008a578063a0ce552d11610064578063a0ce552d146104d5578063a9059cbb146104e8578063bd96102f14610508578063dd62ed3e1461051d5761018c565b8063949813b81461046b57806395d89b41146104a05780639dc29fac146104b55761018c565b80633e4eb36c1461038a57806340c10f191461039f57806359355736146103bf57806370a08231146103f55780637eee288d1461042b57806384955c881461044b5761018c565b80631eeffad01161013e5780632f2c3f2e116101185780632f2c3f2e14610325578063313ce5671461033b578063372500ab146103575780633b19e84a1461036c5761018c565b80631eeffad0146102d057806323b872dd146102e5578063282d3fdf146103055761018c565b806306fdde03146101ea578063095ea7b3146102155780631195e07e1461024557806318160ddd14610277578063187cf4d71461029657806319fac8fd146102ae5761018c565b3661018c57005b60405162461bcd60e51b815260206004820152602860248201527f66616c6c6261636b206e6f7420696d706c656d656e74656420666f72204c69716044820152677569644c6f67696360c01b60648201526084015b60405180910390fd5b3480156101f657600080fd5b506101ff610563565b60405161020c919061145c565b60405180910390f35b34801561022157600080fd5b506102356102303660046114c6565b6105f5565b604051901515815260200161020c565b34801561025157600080fd5b50600c546001600160a01b03165b6040516001600160a01b03909116815260200161020c565b34801561028357600080fd5b506003545b60405190815260200161020c565b3480156102a257600080fd5b50610288633b9aca0081565b3480156102ba57600080fd5b506102ce6102c93660046114f0565b61060c565b005b3480156102dc57600080fd5b50600f54610288565b3480156102f157600080fd5b50610235610300366004611509565b61063b565b34801561031157600080fd5b506102ce6103203660046114c6565b610730565b34801561033157600080fd5b5061028861271081565b34801561034757600080fd5b506040516012815260200161020c565b34801561036357600080fd5b506102ce610814565b34801561037857600080fd5b50600d546001600160a01b031661025f565b34801561039657600080fd5b50600e54610288565b3480156103ab57600080fd5b506102ce6103ba3660046114c6565b6109f5565b3480156103cb57600080fd5b506102886103da366004611545565b6001600160a01b031660009081526001602052604090205490565b34801561040157600080fd5b50610288610410366004611545565b6001600160a01b031660009081526020819052604090205490565b34801561043757600080fd5b506102ce6104463660046114c6565b610a5d565b34801561045757600080fd5b50610288610466366004611545565b610b23565b34801561047757600080fd5b5061048b610486366004611545565b610b50565b6040805192835260208301919091520161020c565b3480156104ac57600080fd5b506101ff610c08565b3480156104c157600080fd5b506102ce6104d03660046114c6565b610c17565b61048b6104e33660046114f0565b610c77565b3480156104f457600080fd5b506102356105033660046114c6565b610f6b565b34801561051457600080fd5b506102ce610fb8565b34801561052957600080fd5b50610288610538366004611560565b6001600160a01b03918216600090815260026020908152604080832093909416825291909152205490565b6060600a805461057290611593565b80601f016020809104026020016040519081016040528092919081815260200182805461059e90611593565b80156105eb5780601f106105c0576101008083540402835291602001916105eb565b820191906000526020600020905b8154815290600101906020018083116105ce57829003601f168201915b5050505050905090565b60006106023384846110bf565b5060015b92915050565b6010546001600160a01b031633146106365760405162461bcd60e51b81526004016101e1906115cd565b600e55565b6001600160a01b0383166000908152600260209081526040808320338452909152812054828110156106c05760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e74206578636565647320616044820152676c6c6f77616e636560c01b60648201526084016101e1565b6106d485336106cf868561162b565b6110bf565b6106de85846111e3565b6106e884846112d7565b836001600160a01b0316856001600160a01b03166000805160206116ad8339815191528560405161071b91815260200190565b60405180910390a360019150505b9392505050565b6010546001600160a01b0316331461075a5760405162461bcd60e51b81526004016101e1906115cd565b6001600160a01b038216600090815260016020908152604080832054918390529091205482916107899161162b565b10156107e35760405162461bcd60e51b8152602060048201526024808201527f63616e2774206c6f636b206d6f72652066756e6473207468616e20617661696c60448201526361626c6560e01b60648201526084016101e1565b6001600160a01b0382166000908152600160205260408120805483929061080b90849061163e565b90915550505050565b60008061082033611323565b33600090815260046020908152604080832083905560079091528120819055919350915081156109035760105460405163a9059cbb60e01b8152336004820152602481018490526001600160a01b039091169063a9059cbb906044016020604051808303816000875af115801561089b573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906108bf9190611651565b9050806109035760405162461bcd60e51b81526020600482015260126024820152712330b4b632b2103a379039b2b73210272a2760711b60448201526064016101e1565b333b1561096357336001600160a01b031663161605e3846040518263ffffffff1660e01b81526004016000604051808303818588803b15801561094557600080fd5b505af1158015610959573d6000803e3d6000fd5b5050505050505050565b60405133908490600081818185875af1925050503d80600081146109a3576040519150601f19603f3d011682016040523d82523d6000602084013e6109a8565b606091505b505080915050806109f05760405162461bcd60e51b81526020600482015260126024820152712330b4b632b2103a379039b2b7321020aa2760711b60448201526064016101e1565b505050565b6010546001600160a01b03163314610a1f5760405162461bcd60e51b81526004016101e1906115cd565b610a2982826112d7565b6040518181526001600160a01b038316906000906000805160206116ad833981519152906020015b60405180910390a35050565b6010546001600160a01b03163314610a875760405162461bcd60e51b81526004016101e1906115cd565b6001600160a01b038216600090815260016020526040902054811115610afb5760405162461bcd60e51b815260206004820152602360248201527f63616e277420756e6c6f636b206d6f72652066756e6473207468616e206c6f636044820152621ad95960ea1b60648201526084016101e1565b6001600160a01b0382166000908152600160205260408120805483929061080b90849061162b565b6001600160a01b03811660009081526001602090815260408083205491839052822054610606919061162b565b6001600160a01b0381166000908152602081815260408083205460065460059093529083205483928391610b8591849161141e565b6001600160a01b038616600090815260046020526040902054909150610bac90829061163e565b6009546001600160a01b03871660009081526008602052604081205492965091610bd791859161141e565b6001600160a01b038716600090815260076020526040902054909150610bfe90829061163e565b9350505050915091565b6060600b805461057290611593565b6010546001600160a01b03163314610c415760405162461bcd60e51b81526004016101e1906115cd565b610c4b82826111e3565b6040518181526000906001600160a01b038416906000805160206116ad83398151915290602001610a51565b60105460009081906001600160a01b03163314610ca65760405162461bcd60e51b81526004016101e1906115cd565b600e54349060009061271090610cbc9084611673565b610cc6919061168a565b905081811115610d185760405162461bcd60e51b815260206004820152601c60248201527f696e76616c69642061746e2076616c696461746f72207265776172640000000060448201526064016101e1565b610d22818361162b565b600d546040519193506000916001600160a01b03909116906108fc90849084818181858888f193505050503d8060008114610d79576040519150601f19603f3d011682016040523d82523d6000602084013e610d7e565b606091505b5090915050801515600003610da55781600f6000828254610d9f919061163e565b90915550505b6000612710600e5488610db89190611673565b610dc2919061168a565b905086811115610e145760405162461bcd60e51b815260206004820152601c60248201527f696e76616c6964206e746e2076616c696461746f72207265776172640000000060448201526064016101e1565b610e1e818861162b565b96508015610ea157601054600d5460405163a9059cbb60e01b81526001600160a01b0391821660048201526024810184905291169063a9059cbb906044016020604051808303816000875af1158015610e7b573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610e9f9190611651565b505b600354600090610eb5633b9aca0087611673565b610ebf919061168a565b905080600654610ecf919061163e565b600655600354600090610ee6633b9aca008b611673565b610ef0919061168a565b905080600954610f00919061163e565b600955600354600090633b9aca0090610f199085611673565b610f23919061168a565b90506000633b9aca0060035484610f3a9190611673565b610f44919061168a565b9050610f50828861163e565b610f5a828761163e565b995099505050505050505050915091565b6000610f7733836111e3565b610f8183836112d7565b6040518281526001600160a01b0384169033906000805160206116ad8339815191529060200160405180910390a350600192915050565b600d546001600160a01b0316331461101d5760405162461bcd60e51b815260206004820152602260248201527f6f6e6c792074726561737572792063616e20636c61696d2068697320726577616044820152611c9960f21b60648201526084016101e1565b600d54600f546040516000926001600160a01b031691908381818185875af1925050503d806000811461106c576040519150601f19603f3d011682016040523d82523d6000602084013e611071565b606091505b50509050806110b75760405162461bcd60e51b81526020600482015260126024820152713330b4b632b2103a379039b2b7321020aa2760711b60448201526064016101e1565b506000600f55565b6001600160a01b0383166111215760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f206164646044820152637265737360e01b60648201526084016101e1565b6001600160a01b0382166111825760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f206164647265604482015261737360f01b60648201526084016101e1565b6001600160a01b0383811660008181526002602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b6111ec82611323565b50506001600160a01b0382166000908152602081815260408083205460019092529091205461121b908261162b565b82111561126a5760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e7420756e6c6f636b65642066756e6473000000000060448201526064016101e1565b611274828261162b565b6001600160a01b0384166000908152602081905260409020558082036112bb576001600160a01b038316600090815260056020908152604080832083905560089091528120555b81600360008282546112cd919061162b565b9091555050505050565b6112e082611323565b50506001600160a01b0382166000908152602081905260408120805483929061130a90849061163e565b92505081905550806003600082825461080b919061163e565b6001600160a01b038116600090815260208181526040808320546006546005909352908320548392839161135891849161141e565b6001600160a01b03861660009081526004602052604090205490915061137f90829061163e565b6001600160a01b03861660009081526004602090815260408083208490556006546005835281842055600954600890925282205492965090916113c391859161141e565b6001600160a01b0387166000908152600760205260409020549091506113ea90829061163e565b6001600160a01b03909616600090815260076020908152604080832089905560095460089092529091205550929492505050565b60008360000361143057506000610729565b633b9aca0084611440848661162b565b61144a9190611673565b611454919061168a565b949350505050565b600060208083528351808285015260005b818110156114895785810183015185820160400152820161146d565b506000604082860101526040601f19601f8301168501019250505092915050565b80356001600160a01b03811681146114c157600080fd5b919050565b600080604083850312156114d957600080fd5b6114e2836114aa565b946020939093013593505050565b60006020828403121561150257600080fd5b5035919050565b60008060006060848603121561151e57600080fd5b611527846114aa565b9250611535602085016114aa565b9150604084013590509250925092565b60006020828403121561155757600080fd5b610729826114aa565b6000806040838503121561157357600080fd5b61157c836114aa565b915061158a602084016114aa565b90509250929050565b600181811c908216806115a757607f821691505b6020821081036115c757634e487b7160e01b600052602260045260246000fd5b50919050565b60208082526028908201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060408201526710dbdb9d1c9858dd60c21b606082015260800190565b634e487b7160e01b600052601160045260246000fd5b8181038181111561060657610606611615565b8082018082111561060657610606611615565b60006020828403121561166357600080fd5b8151801515811461072957600080fd5b808202811582820484141761060657610606611615565b6000826116a757634e487b7160e01b600052601260045260246000fd5b50049056feddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3efa264697066735822122049ab38a03c54dd40123164b831272e589f044764b7e3a41d2336c0e5de4d867b64736f6c63430008150033608060405234801561001057600080fd5b506040516105c23803806105c283398101604081905261002f916101af565b806001600160a01b0316632f2c3f2e6040518163ffffffff1660e01b8152600401602060405180830381865afa15801561006d573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610091919061029f565b83111561009d57600080fd5b600c80546001600160a01b038088166001600160a01b031992831617909255600d805492871692909116919091179055600e8390556040516100e39083906020016102b8565b604051602081830303815290604052600a9081610100919061036e565b508160405160200161011291906102b8565b604051602081830303815290604052600b908161012f919061036e565b5050601080546001600160a01b031916331790555061042d92505050565b6001600160a01b038116811461016257600080fd5b50565b80516101708161014d565b919050565b634e487b7160e01b600052604160045260246000fd5b60005b838110156101a657818101518382015260200161018e565b50506000910152565b600080600080600060a086880312156101c757600080fd5b85516101d28161014d565b60208701519095506101e38161014d565b6040870151606088015191955093506001600160401b038082111561020757600080fd5b818801915088601f83011261021b57600080fd5b81518181111561022d5761022d610175565b604051601f8201601f19908116603f0116810190838211818310171561025557610255610175565b816040528281528b602084870101111561026e57600080fd5b61027f83602083016020880161018b565b809650505050505061029360808701610165565b90509295509295909350565b6000602082840312156102b157600080fd5b5051919050565b644c4e544e2d60d81b8152600082516102d881600585016020870161018b565b9190910160050192915050565b600181811c908216806102f957607f821691505b60208210810361031957634e487b7160e01b600052602260045260246000fd5b50919050565b601f82111561036957600081815260208120601f850160051c810160208610156103465750805b601f850160051c820191505b8181101561036557828155600101610352565b5050505b505050565b81516001600160401b0381111561038757610387610175565b61039b8161039584546102e5565b8461031f565b602080601f8311600181146103d057600084156103b85750858301515b600019600386901b1c1916600185901b178555610365565b600085815260208120601f198616915b828110156103ff578886015182559484019460019091019084016103e0565b508582101561041d5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b6101868061043c6000396000f3fe60806040523661001b57610019610014610022565b6100fd565b005b6100196100145b600080601060009054906101000a90046001600160a01b03166001600160a01b03166396f18a326040518163ffffffff1660e01b8152600401602060405180830381865afa158015610078573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061009c9190610120565b90506001600160a01b0381166100f85760405162461bcd60e51b815260206004820152601d60248201527f6c6971756964206c6f67696320636f6e7472616374206e6f7420736574000000604482015260640160405180910390fd5b919050565b3660008037600080366000845af43d6000803e8061011a573d6000fd5b503d6000f35b60006020828403121561013257600080fd5b81516001600160a01b038116811461014957600080fd5b939250505056fea2646970667358221220b2e2dcd6d0d78f9596fd256c4e804aebbbccf69378693f30233ea3e89b3b740864736f6c63430008150033",
 }
 
 // AutonityABI is the input ABI used to generate the binding from.
@@ -2928,6 +2955,39 @@ func (_Autonity *AutonityCallerSession) GetCommitteeEnodes() ([]string, error) {
 	return _Autonity.Contract.GetCommitteeEnodes(&_Autonity.CallOpts)
 }
 
+// GetConsensusTimeouts is a free data retrieval call binding the contract method 0x6be0487b.
+//
+// Solidity: function getConsensusTimeouts() view returns((uint256,uint256,uint256,uint256,uint256,uint256,bool), (uint256,uint256,uint256,uint256,uint256,uint256,bool), uint256)
+func (_Autonity *AutonityCaller) GetConsensusTimeouts(opts *bind.CallOpts) (AutonityConsensusTimeouts, AutonityConsensusTimeouts, *big.Int, error) {
+	var out []interface{}
+	err := _Autonity.contract.Call(opts, &out, "getConsensusTimeouts")
+
+	if err != nil {
+		return *new(AutonityConsensusTimeouts), *new(AutonityConsensusTimeouts), *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(AutonityConsensusTimeouts)).(*AutonityConsensusTimeouts)
+	out1 := *abi.ConvertType(out[1], new(AutonityConsensusTimeouts)).(*AutonityConsensusTimeouts)
+	out2 := *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+
+	return out0, out1, out2, err
+
+}
+
+// GetConsensusTimeouts is a free data retrieval call binding the contract method 0x6be0487b.
+//
+// Solidity: function getConsensusTimeouts() view returns((uint256,uint256,uint256,uint256,uint256,uint256,bool), (uint256,uint256,uint256,uint256,uint256,uint256,bool), uint256)
+func (_Autonity *AutonitySession) GetConsensusTimeouts() (AutonityConsensusTimeouts, AutonityConsensusTimeouts, *big.Int, error) {
+	return _Autonity.Contract.GetConsensusTimeouts(&_Autonity.CallOpts)
+}
+
+// GetConsensusTimeouts is a free data retrieval call binding the contract method 0x6be0487b.
+//
+// Solidity: function getConsensusTimeouts() view returns((uint256,uint256,uint256,uint256,uint256,uint256,bool), (uint256,uint256,uint256,uint256,uint256,uint256,bool), uint256)
+func (_Autonity *AutonityCallerSession) GetConsensusTimeouts() (AutonityConsensusTimeouts, AutonityConsensusTimeouts, *big.Int, error) {
+	return _Autonity.Contract.GetConsensusTimeouts(&_Autonity.CallOpts)
+}
+
 // GetEpochByHeight is a free data retrieval call binding the contract method 0xaffb1cf1.
 //
 // Solidity: function getEpochByHeight(uint256 _height) view returns((address,uint256,bytes)[], uint256, uint256, uint256)
@@ -3431,6 +3491,37 @@ func (_Autonity *AutonityCallerSession) GetUnbondingReleaseState(_unbondingID *b
 	return _Autonity.Contract.GetUnbondingReleaseState(&_Autonity.CallOpts, _unbondingID)
 }
 
+// GetUnbondingRequest is a free data retrieval call binding the contract method 0x30bcb81c.
+//
+// Solidity: function getUnbondingRequest(uint256 _unbondingID) view returns((address,address,uint256,uint256,uint256,uint256,uint8,bool,bool))
+func (_Autonity *AutonityCaller) GetUnbondingRequest(opts *bind.CallOpts, _unbondingID *big.Int) (AutonityUnbondingRequest, error) {
+	var out []interface{}
+	err := _Autonity.contract.Call(opts, &out, "getUnbondingRequest", _unbondingID)
+
+	if err != nil {
+		return *new(AutonityUnbondingRequest), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(AutonityUnbondingRequest)).(*AutonityUnbondingRequest)
+
+	return out0, err
+
+}
+
+// GetUnbondingRequest is a free data retrieval call binding the contract method 0x30bcb81c.
+//
+// Solidity: function getUnbondingRequest(uint256 _unbondingID) view returns((address,address,uint256,uint256,uint256,uint256,uint8,bool,bool))
+func (_Autonity *AutonitySession) GetUnbondingRequest(_unbondingID *big.Int) (AutonityUnbondingRequest, error) {
+	return _Autonity.Contract.GetUnbondingRequest(&_Autonity.CallOpts, _unbondingID)
+}
+
+// GetUnbondingRequest is a free data retrieval call binding the contract method 0x30bcb81c.
+//
+// Solidity: function getUnbondingRequest(uint256 _unbondingID) view returns((address,address,uint256,uint256,uint256,uint256,uint8,bool,bool))
+func (_Autonity *AutonityCallerSession) GetUnbondingRequest(_unbondingID *big.Int) (AutonityUnbondingRequest, error) {
+	return _Autonity.Contract.GetUnbondingRequest(&_Autonity.CallOpts, _unbondingID)
+}
+
 // GetValidator is a free data retrieval call binding the contract method 0x1904bb2e.
 //
 // Solidity: function getValidator(address _addr) view returns((address,address,address,string,uint256,uint256,uint256,uint256,uint256,uint256,uint256,uint256,address,uint256,uint256,uint256,uint256,uint256,bytes,uint8))
@@ -4274,6 +4365,27 @@ func (_Autonity *AutonityTransactorSession) SetCommitteeSize(_size *big.Int) (*t
 	return _Autonity.Contract.SetCommitteeSize(&_Autonity.TransactOpts, _size)
 }
 
+// SetConsensusTimeouts is a paid mutator transaction binding the contract method 0x8d7835ea.
+//
+// Solidity: function setConsensusTimeouts((uint256,uint256,uint256,uint256,uint256,uint256,bool) _timeouts) returns()
+func (_Autonity *AutonityTransactor) SetConsensusTimeouts(opts *bind.TransactOpts, _timeouts AutonityConsensusTimeouts) (*types.Transaction, error) {
+	return _Autonity.contract.Transact(opts, "setConsensusTimeouts", _timeouts)
+}
+
+// SetConsensusTimeouts is a paid mutator transaction binding the contract method 0x8d7835ea.
+//
+// Solidity: function setConsensusTimeouts((uint256,uint256,uint256,uint256,uint256,uint256,bool) _timeouts) returns()
+func (_Autonity *AutonitySession) SetConsensusTimeouts(_timeouts AutonityConsensusTimeouts) (*types.Transaction, error) {
+	return _Autonity.Contract.SetConsensusTimeouts(&_Autonity.TransactOpts, _timeouts)
+}
+
+// SetConsensusTimeouts is a paid mutator transaction binding the contract method 0x8d7835ea.
+//
+// Solidity: function setConsensusTimeouts((uint256,uint256,uint256,uint256,uint256,uint256,bool) _timeouts) returns()
+func (_Autonity *AutonityTransactorSession) SetConsensusTimeouts(_timeouts AutonityConsensusTimeouts) (*types.Transaction, error) {
+	return _Autonity.Contract.SetConsensusTimeouts(&_Autonity.TransactOpts, _timeouts)
+}
+
 // SetEpochPeriod is a paid mutator transaction binding the contract method 0x6b5f444c.
 //
 // Solidity: function setEpochPeriod(uint256 _period) returns()
@@ -5997,6 +6109,141 @@ func (_Autonity *AutonityFilterer) ParseCommissionRateChange(log types.Log) (*Au
 	return event, nil
 }
 
+// AutonityConsensusTimeoutsUpdatedIterator is returned from FilterConsensusTimeoutsUpdated and is used to iterate over the raw logs and unpacked data for ConsensusTimeoutsUpdated events raised by the Autonity contract.
+type AutonityConsensusTimeoutsUpdatedIterator struct {
+	Event *AutonityConsensusTimeoutsUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AutonityConsensusTimeoutsUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AutonityConsensusTimeoutsUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AutonityConsensusTimeoutsUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AutonityConsensusTimeoutsUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AutonityConsensusTimeoutsUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AutonityConsensusTimeoutsUpdated represents a ConsensusTimeoutsUpdated event raised by the Autonity contract.
+type AutonityConsensusTimeoutsUpdated struct {
+	Timeouts           AutonityConsensusTimeouts
+	ToBeAppliedAtBlock *big.Int
+	Raw                types.Log // Blockchain specific contextual infos
+}
+
+// FilterConsensusTimeoutsUpdated is a free log retrieval operation binding the contract event 0xa4cc9b6d7e44f45ad3dfc950f52c5b7c915f5aaab5b2316be12db869407309f2.
+//
+// Solidity: event ConsensusTimeoutsUpdated((uint256,uint256,uint256,uint256,uint256,uint256,bool) timeouts, uint256 toBeAppliedAtBlock)
+func (_Autonity *AutonityFilterer) FilterConsensusTimeoutsUpdated(opts *bind.FilterOpts) (*AutonityConsensusTimeoutsUpdatedIterator, error) {
+
+	logs, sub, err := _Autonity.contract.FilterLogs(opts, "ConsensusTimeoutsUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &AutonityConsensusTimeoutsUpdatedIterator{contract: _Autonity.contract, event: "ConsensusTimeoutsUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchConsensusTimeoutsUpdated is a free log subscription operation binding the contract event 0xa4cc9b6d7e44f45ad3dfc950f52c5b7c915f5aaab5b2316be12db869407309f2.
+//
+// Solidity: event ConsensusTimeoutsUpdated((uint256,uint256,uint256,uint256,uint256,uint256,bool) timeouts, uint256 toBeAppliedAtBlock)
+func (_Autonity *AutonityFilterer) WatchConsensusTimeoutsUpdated(opts *bind.WatchOpts, sink chan<- *AutonityConsensusTimeoutsUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _Autonity.contract.WatchLogs(opts, "ConsensusTimeoutsUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AutonityConsensusTimeoutsUpdated)
+				if err := _Autonity.contract.UnpackLog(event, "ConsensusTimeoutsUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseConsensusTimeoutsUpdated is a log parse operation binding the contract event 0xa4cc9b6d7e44f45ad3dfc950f52c5b7c915f5aaab5b2316be12db869407309f2.
+//
+// Solidity: event ConsensusTimeoutsUpdated((uint256,uint256,uint256,uint256,uint256,uint256,bool) timeouts, uint256 toBeAppliedAtBlock)
+func (_Autonity *AutonityFilterer) ParseConsensusTimeoutsUpdated(log types.Log) (*AutonityConsensusTimeoutsUpdated, error) {
+	event := new(AutonityConsensusTimeoutsUpdated)
+	if err := _Autonity.contract.UnpackLog(event, "ConsensusTimeoutsUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // AutonityEpochPeriodUpdatedIterator is returned from FilterEpochPeriodUpdated and is used to iterate over the raw logs and unpacked data for EpochPeriodUpdated events raised by the Autonity contract.
 type AutonityEpochPeriodUpdatedIterator struct {
 	Event *AutonityEpochPeriodUpdated // Event containing the contract specifics and raw log
@@ -7893,7 +8140,7 @@ func (_Autonity *AutonityFilterer) ParseUnlockingScheduleFailed(log types.Log) (
 
 // AutonityUpgradeTestMetaData contains all meta data concerning the AutonityUpgradeTest contract.
 var AutonityUpgradeTestMetaData = &bind.MetaData{
-	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"treasury\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"effectiveBlock\",\"type\":\"uint256\"}],\"name\":\"ActivatedValidator\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"selfBonded\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"AppliedUnbondingReverted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"enumValidatorState\",\"name\":\"state\",\"type\":\"uint8\"}],\"name\":\"BondingRejected\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"BondingReverted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"BurnedStake\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"methodSignature\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"returnData\",\"type\":\"bytes\"}],\"name\":\"CallFailed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"rate\",\"type\":\"uint256\"}],\"name\":\"CommissionRateChange\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"period\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"toBeAppliedAtBlock\",\"type\":\"uint256\"}],\"name\":\"EpochPeriodUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"gasPrice\",\"type\":\"uint256\"}],\"name\":\"MinimumBaseFeeUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"MintedStake\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"selfBonded\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"NewBondingRequest\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"epoch\",\"type\":\"uint256\"}],\"name\":\"NewEpoch\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"selfBonded\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"NewUnbondingRequest\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"treasury\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"effectiveBlock\",\"type\":\"uint256\"}],\"name\":\"PausedValidator\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"treasury\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"oracleAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"enode\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"liquidStateContract\",\"type\":\"address\"}],\"name\":\"RegisteredValidator\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"selfBonded\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"ReleasedUnbondingReverted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"atnAmount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"ntnAmount\",\"type\":\"uint256\"}],\"name\":\"Rewarded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"selfBonded\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"UnbondingRejected\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"epochTime\",\"type\":\"uint256\"}],\"name\":\"UnlockingScheduleFailed\",\"type\":\"event\"},{\"stateMutability\":\"payable\",\"type\":\"fallback\"},{\"inputs\":[],\"name\":\"COMMISSION_RATE_PRECISION\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_contract\",\"type\":\"address\"}],\"name\":\"SetLiquidLogicContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"activateValidator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"atnTotalRedistributed\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"bond\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"burn\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_rate\",\"type\":\"uint256\"}],\"name\":\"changeCommissionRate\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"completeContractUpgrade\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"computeCommittee\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"},{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"config\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"treasuryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"minBaseFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"delegationRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingPeriod\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"initialInflationReserve\",\"type\":\"uint256\"},{\"internalType\":\"addresspayable\",\"name\":\"treasuryAccount\",\"type\":\"address\"}],\"internalType\":\"structAutonity.Policy\",\"name\":\"policy\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"contractIAccountability\",\"name\":\"accountabilityContract\",\"type\":\"address\"},{\"internalType\":\"contractIOracle\",\"name\":\"oracleContract\",\"type\":\"address\"},{\"internalType\":\"contractIACU\",\"name\":\"acuContract\",\"type\":\"address\"},{\"internalType\":\"contractISupplyControl\",\"name\":\"supplyControlContract\",\"type\":\"address\"},{\"internalType\":\"contractIStabilization\",\"name\":\"stabilizationContract\",\"type\":\"address\"},{\"internalType\":\"contractUpgradeManager\",\"name\":\"upgradeManagerContract\",\"type\":\"address\"},{\"internalType\":\"contractIInflationController\",\"name\":\"inflationControllerContract\",\"type\":\"address\"},{\"internalType\":\"contractINonStakableVestingVault\",\"name\":\"nonStakableVestingContract\",\"type\":\"address\"}],\"internalType\":\"structAutonity.Contracts\",\"name\":\"contracts\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"address\",\"name\":\"operatorAccount\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"epochPeriod\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"blockPeriod\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"committeeSize\",\"type\":\"uint256\"}],\"internalType\":\"structAutonity.Protocol\",\"name\":\"protocol\",\"type\":\"tuple\"},{\"internalType\":\"uint256\",\"name\":\"contractVersion\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"deployer\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"epochID\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"epochPeriodToBeApplied\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"epochReward\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"epochTotalBondedStake\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"finalize\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"},{\"components\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"votingPower\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"}],\"internalType\":\"structAutonity.CommitteeMember[]\",\"name\":\"\",\"type\":\"tuple[]\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"finalizeInitialization\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getBlockPeriod\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getCommittee\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"votingPower\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"}],\"internalType\":\"structAutonity.CommitteeMember[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getCommitteeEnodes\",\"outputs\":[{\"internalType\":\"string[]\",\"name\":\"\",\"type\":\"string[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_height\",\"type\":\"uint256\"}],\"name\":\"getEpochByHeight\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"votingPower\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"}],\"internalType\":\"structAutonity.CommitteeMember[]\",\"name\":\"\",\"type\":\"tuple[]\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_block\",\"type\":\"uint256\"}],\"name\":\"getEpochFromBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getEpochInfo\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"votingPower\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"}],\"internalType\":\"structAutonity.CommitteeMember[]\",\"name\":\"\",\"type\":\"tuple[]\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getEpochPeriod\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getLastEpochBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMaxCommitteeSize\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMinimumBaseFee\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getNewContract\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getNextEpochBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getOperator\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getOracle\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_unbondingID\",\"type\":\"uint256\"}],\"name\":\"getRevertingAmount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getTreasuryAccount\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getTreasuryFee\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getUnbondingPeriod\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_unbondingID\",\"type\":\"uint256\"}],\"name\":\"getUnbondingReleaseState\",\"outputs\":[{\"internalType\":\"enumAutonity.UnbondingReleaseState\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"getValidator\",\"outputs\":[{\"components\":[{\"internalType\":\"addresspayable\",\"name\":\"treasury\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"nodeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"oracleAddress\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"enode\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"commissionRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfBondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStakeLocked\",\"type\":\"uint256\"},{\"internalType\":\"addresspayable\",\"name\":\"liquidStateContract\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"liquidSupply\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"registrationBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"totalSlashed\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"jailReleaseBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"provableFaultCount\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"},{\"internalType\":\"enumValidatorState\",\"name\":\"state\",\"type\":\"uint8\"}],\"internalType\":\"structAutonity.Validator\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getValidators\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getVersion\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"inflationReserve\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"lastEpochTime\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"lastFinalizedBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"liquidLogicContract\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"maxBondAppliedGas\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"maxRewardsDistributionGas\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"maxUnbondAppliedGas\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"maxUnbondReleasedGas\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"mint\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"pauseValidator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"receiveATN\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_enode\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_oracleAddress\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"_consensusKey\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"_signatures\",\"type\":\"bytes\"}],\"name\":\"registerValidator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"resetContractUpgrade\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIAccountability\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setAccountabilityContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIACU\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setAcuContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_size\",\"type\":\"uint256\"}],\"name\":\"setCommitteeSize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_period\",\"type\":\"uint256\"}],\"name\":\"setEpochPeriod\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIInflationController\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setInflationControllerContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_gas\",\"type\":\"uint256\"}],\"name\":\"setMaxBondAppliedGas\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_gas\",\"type\":\"uint256\"}],\"name\":\"setMaxRewardsDistributionGas\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_gas\",\"type\":\"uint256\"}],\"name\":\"setMaxUnbondAppliedGas\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_gas\",\"type\":\"uint256\"}],\"name\":\"setMaxUnbondReleasedGas\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_price\",\"type\":\"uint256\"}],\"name\":\"setMinimumBaseFee\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractINonStakableVestingVault\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setNonStakableVestingContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"setOperatorAccount\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"addresspayable\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setOracleContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIStabilization\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setStabilizationContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_price\",\"type\":\"uint256\"}],\"name\":\"setStakingGasPrice\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractISupplyControl\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setSupplyControlContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"addresspayable\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"setTreasuryAccount\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_treasuryFee\",\"type\":\"uint256\"}],\"name\":\"setTreasuryFee\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_period\",\"type\":\"uint256\"}],\"name\":\"setUnbondingPeriod\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractUpgradeManager\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setUpgradeManagerContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"stakingGasPrice\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_sender\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"unbond\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_nodeAddress\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_enode\",\"type\":\"string\"}],\"name\":\"updateEnode\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"addresspayable\",\"name\":\"treasury\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"nodeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"oracleAddress\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"enode\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"commissionRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfBondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStakeLocked\",\"type\":\"uint256\"},{\"internalType\":\"addresspayable\",\"name\":\"liquidStateContract\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"liquidSupply\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"registrationBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"totalSlashed\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"jailReleaseBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"provableFaultCount\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"},{\"internalType\":\"enumValidatorState\",\"name\":\"state\",\"type\":\"uint8\"}],\"internalType\":\"structAutonity.Validator\",\"name\":\"_val\",\"type\":\"tuple\"}],\"name\":\"updateValidatorAndTransferSlashedFunds\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"_bytecode\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"_abi\",\"type\":\"string\"}],\"name\":\"upgradeContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"stateMutability\":\"payable\",\"type\":\"receive\"}]",
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"treasury\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"effectiveBlock\",\"type\":\"uint256\"}],\"name\":\"ActivatedValidator\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"selfBonded\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"AppliedUnbondingReverted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"enumValidatorState\",\"name\":\"state\",\"type\":\"uint8\"}],\"name\":\"BondingRejected\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"BondingReverted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"BurnedStake\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"methodSignature\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"returnData\",\"type\":\"bytes\"}],\"name\":\"CallFailed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"rate\",\"type\":\"uint256\"}],\"name\":\"CommissionRateChange\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"proposeInitial\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"proposeDelta\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"prevoteInitial\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"prevoteDelta\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"precommitInitial\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"precommitDelta\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"adaptive\",\"type\":\"bool\"}],\"indexed\":false,\"internalType\":\"structAutonity.ConsensusTimeouts\",\"name\":\"timeouts\",\"type\":\"tuple\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"toBeAppliedAtBlock\",\"type\":\"uint256\"}],\"name\":\"ConsensusTimeoutsUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"period\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"toBeAppliedAtBlock\",\"type\":\"uint256\"}],\"name\":\"EpochPeriodUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"gasPrice\",\"type\":\"uint256\"}],\"name\":\"MinimumBaseFeeUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"MintedStake\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"selfBonded\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"NewBondingRequest\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"epoch\",\"type\":\"uint256\"}],\"name\":\"NewEpoch\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"selfBonded\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"NewUnbondingRequest\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"treasury\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"effectiveBlock\",\"type\":\"uint256\"}],\"name\":\"PausedValidator\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"treasury\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"oracleAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"enode\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"liquidStateContract\",\"type\":\"address\"}],\"name\":\"RegisteredValidator\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"selfBonded\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"ReleasedUnbondingReverted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"atnAmount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"ntnAmount\",\"type\":\"uint256\"}],\"name\":\"Rewarded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"selfBonded\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"UnbondingRejected\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"epochTime\",\"type\":\"uint256\"}],\"name\":\"UnlockingScheduleFailed\",\"type\":\"event\"},{\"stateMutability\":\"payable\",\"type\":\"fallback\"},{\"inputs\":[],\"name\":\"COMMISSION_RATE_PRECISION\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_contract\",\"type\":\"address\"}],\"name\":\"SetLiquidLogicContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"activateValidator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"atnTotalRedistributed\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"bond\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"burn\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_rate\",\"type\":\"uint256\"}],\"name\":\"changeCommissionRate\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"completeContractUpgrade\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"computeCommittee\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"},{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"config\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"treasuryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"minBaseFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"delegationRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingPeriod\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"initialInflationReserve\",\"type\":\"uint256\"},{\"internalType\":\"addresspayable\",\"name\":\"treasuryAccount\",\"type\":\"address\"}],\"internalType\":\"structAutonity.Policy\",\"name\":\"policy\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"contractIAccountability\",\"name\":\"accountabilityContract\",\"type\":\"address\"},{\"internalType\":\"contractIOracle\",\"name\":\"oracleContract\",\"type\":\"address\"},{\"internalType\":\"contractIACU\",\"name\":\"acuContract\",\"type\":\"address\"},{\"internalType\":\"contractISupplyControl\",\"name\":\"supplyControlContract\",\"type\":\"address\"},{\"internalType\":\"contractIStabilization\",\"name\":\"stabilizationContract\",\"type\":\"address\"},{\"internalType\":\"contractUpgradeManager\",\"name\":\"upgradeManagerContract\",\"type\":\"address\"},{\"internalType\":\"contractIInflationController\",\"name\":\"inflationControllerContract\",\"type\":\"address\"},{\"internalType\":\"contractINonStakableVestingVault\",\"name\":\"nonStakableVestingContract\",\"type\":\"address\"}],\"internalType\":\"structAutonity.Contracts\",\"name\":\"contracts\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"address\",\"name\":\"operatorAccount\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"epochPeriod\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"blockPeriod\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"committeeSize\",\"type\":\"uint256\"}],\"internalType\":\"structAutonity.Protocol\",\"name\":\"protocol\",\"type\":\"tuple\"},{\"internalType\":\"uint256\",\"name\":\"contractVersion\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"deployer\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"epochID\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"epochPeriodToBeApplied\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"epochReward\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"epochTotalBondedStake\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"finalize\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"},{\"components\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"votingPower\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"}],\"internalType\":\"structAutonity.CommitteeMember[]\",\"name\":\"\",\"type\":\"tuple[]\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"finalizeInitialization\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getBlockPeriod\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getCommittee\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"votingPower\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"}],\"internalType\":\"structAutonity.CommitteeMember[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getCommitteeEnodes\",\"outputs\":[{\"internalType\":\"string[]\",\"name\":\"\",\"type\":\"string[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getConsensusTimeouts\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"proposeInitial\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"proposeDelta\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"prevoteInitial\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"prevoteDelta\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"precommitInitial\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"precommitDelta\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"adaptive\",\"type\":\"bool\"}],\"internalType\":\"structAutonity.ConsensusTimeouts\",\"name\":\"\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"proposeInitial\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"proposeDelta\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"prevoteInitial\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"prevoteDelta\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"precommitInitial\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"precommitDelta\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"adaptive\",\"type\":\"bool\"}],\"internalType\":\"structAutonity.ConsensusTimeouts\",\"name\":\"\",\"type\":\"tuple\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_height\",\"type\":\"uint256\"}],\"name\":\"getEpochByHeight\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"votingPower\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"}],\"internalType\":\"structAutonity.CommitteeMember[]\",\"name\":\"\",\"type\":\"tuple[]\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_block\",\"type\":\"uint256\"}],\"name\":\"getEpochFromBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getEpochInfo\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"votingPower\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"}],\"internalType\":\"structAutonity.CommitteeMember[]\",\"name\":\"\",\"type\":\"tuple[]\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getEpochPeriod\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getLastEpochBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMaxCommitteeSize\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMinimumBaseFee\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getNewContract\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getNextEpochBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getOperator\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getOracle\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_unbondingID\",\"type\":\"uint256\"}],\"name\":\"getRevertingAmount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getTreasuryAccount\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getTreasuryFee\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getUnbondingPeriod\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_unbondingID\",\"type\":\"uint256\"}],\"name\":\"getUnbondingReleaseState\",\"outputs\":[{\"internalType\":\"enumAutonity.UnbondingReleaseState\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_unbondingID\",\"type\":\"uint256\"}],\"name\":\"getUnbondingRequest\",\"outputs\":[{\"components\":[{\"internalType\":\"addresspayable\",\"name\":\"delegator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"delegatee\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingShare\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"requestBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"revertingAmount\",\"type\":\"uint256\"},{\"internalType\":\"enumAutonity.UnbondingReleaseState\",\"name\":\"state\",\"type\":\"uint8\"},{\"internalType\":\"bool\",\"name\":\"unlocked\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"selfDelegation\",\"type\":\"bool\"}],\"internalType\":\"structAutonity.UnbondingRequest\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"getValidator\",\"outputs\":[{\"components\":[{\"internalType\":\"addresspayable\",\"name\":\"treasury\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"nodeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"oracleAddress\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"enode\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"commissionRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfBondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStakeLocked\",\"type\":\"uint256\"},{\"internalType\":\"addresspayable\",\"name\":\"liquidStateContract\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"liquidSupply\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"registrationBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"totalSlashed\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"jailReleaseBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"provableFaultCount\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"},{\"internalType\":\"enumValidatorState\",\"name\":\"state\",\"type\":\"uint8\"}],\"internalType\":\"structAutonity.Validator\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getValidators\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getVersion\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"inflationReserve\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"lastEpochTime\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"lastFinalizedBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"liquidLogicContract\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"maxBondAppliedGas\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"maxRewardsDistributionGas\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"maxUnbondAppliedGas\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"maxUnbondReleasedGas\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"mint\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"pauseValidator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"receiveATN\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_enode\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_oracleAddress\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"_consensusKey\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"_signatures\",\"type\":\"bytes\"}],\"name\":\"registerValidator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"resetContractUpgrade\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIAccountability\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setAccountabilityContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIACU\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setAcuContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_size\",\"type\":\"uint256\"}],\"name\":\"setCommitteeSize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"proposeInitial\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"proposeDelta\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"prevoteInitial\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"prevoteDelta\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"precommitInitial\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"precommitDelta\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"adaptive\",\"type\":\"bool\"}],\"internalType\":\"structAutonity.ConsensusTimeouts\",\"name\":\"_timeouts\",\"type\":\"tuple\"}],\"name\":\"setConsensusTimeouts\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_period\",\"type\":\"uint256\"}],\"name\":\"setEpochPeriod\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIInflationController\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setInflationControllerContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_gas\",\"type\":\"uint256\"}],\"name\":\"setMaxBondAppliedGas\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_gas\",\"type\":\"uint256\"}],\"name\":\"setMaxRewardsDistributionGas\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_gas\",\"type\":\"uint256\"}],\"name\":\"setMaxUnbondAppliedGas\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_gas\",\"type\":\"uint256\"}],\"name\":\"setMaxUnbondReleasedGas\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_price\",\"type\":\"uint256\"}],\"name\":\"setMinimumBaseFee\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractINonStakableVestingVault\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setNonStakableVestingContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"setOperatorAccount\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"addresspayable\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setOracleContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIStabilization\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setStabilizationContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_price\",\"type\":\"uint256\"}],\"name\":\"setStakingGasPrice\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractISupplyControl\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setSupplyControlContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"addresspayable\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"setTreasuryAccount\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_treasuryFee\",\"type\":\"uint256\"}],\"name\":\"setTreasuryFee\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_period\",\"type\":\"uint256\"}],\"name\":\"setUnbondingPeriod\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractUpgradeManager\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setUpgradeManagerContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"stakingGasPrice\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_sender\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"unbond\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_nodeAddress\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_enode\",\"type\":\"string\"}],\"name\":\"updateEnode\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"addresspayable\",\"name\":\"treasury\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"nodeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"oracleAddress\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"enode\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"commissionRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfBondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStakeLocked\",\"type\":\"uint256\"},{\"internalType\":\"addresspayable\",\"name\":\"liquidStateContract\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"liquidSupply\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"registrationBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"totalSlashed\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"jailReleaseBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"provableFaultCount\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"},{\"internalType\":\"enumValidatorState\",\"name\":\"state\",\"type\":\"uint8\"}],\"internalType\":\"structAutonity.Validator\",\"name\":\"_val\",\"type\":\"tuple\"}],\"name\":\"updateValidatorAndTransferSlashedFunds\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"_bytecode\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"_abi\",\"type\":\"string\"}],\"name\":\"upgradeContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"stateMutability\":\"payable\",\"type\":\"receive\"}]",
 	Sigs: map[string]string{
 		"2f2c3f2e": "COMMISSION_RATE_PRECISION()",
 		"f1d592a7": "SetLiquidLogicContract(address)",
@@ -7919,6 +8166,7 @@ var AutonityUpgradeTestMetaData = &bind.MetaData{
 		"43645969": "getBlockPeriod()",
 		"ab8f6ffe": "getCommittee()",
 		"a8b2216e": "getCommitteeEnodes()",
+		"6be0487b": "getConsensusTimeouts()",
 		"affb1cf1": "getEpochByHeight(uint256)",
 		"96b477cb": "getEpochFromBlock(uint256)",
 		"a9fd1a8f": "getEpochInfo()",
@@ -7935,6 +8183,7 @@ var AutonityUpgradeTestMetaData = &bind.MetaData{
 		"29070c6d": "getTreasuryFee()",
 		"6fd2c80b": "getUnbondingPeriod()",
 		"a9af1059": "getUnbondingReleaseState(uint256)",
+		"30bcb81c": "getUnbondingRequest(uint256)",
 		"1904bb2e": "getValidator(address)",
 		"b7ab4db5": "getValidators()",
 		"0d8e6e2c": "getVersion()",
@@ -7955,6 +8204,7 @@ var AutonityUpgradeTestMetaData = &bind.MetaData{
 		"1250a28d": "setAccountabilityContract(address)",
 		"d372c07e": "setAcuContract(address)",
 		"8bac7dad": "setCommitteeSize(uint256)",
+		"8d7835ea": "setConsensusTimeouts((uint256,uint256,uint256,uint256,uint256,uint256,bool))",
 		"6b5f444c": "setEpochPeriod(uint256)",
 		"f03b959d": "setInflationControllerContract(address)",
 		"0fe50109": "setMaxBondAppliedGas(uint256)",
@@ -7982,7 +8232,7 @@ var AutonityUpgradeTestMetaData = &bind.MetaData{
 		"35be16e0": "updateValidatorAndTransferSlashedFunds((address,address,address,string,uint256,uint256,uint256,uint256,uint256,uint256,uint256,uint256,address,uint256,uint256,uint256,uint256,uint256,bytes,uint8))",
 		"b2ea9adb": "upgradeContract(bytes,string)",
 	},
-	Bin: "0x608060405261c35060045561c35060055561c350600655614e2060075560006010556000601155633b9aca006037553480156200003b57600080fd5b50604080516000808252602082019092529062000071565b6200005d62000fcf565b815260200190600190039081620000535790505b506040805161014081018252601254608080830191825260135460a08085019190915260145460c08086019190915260155460e080870191909152601654610100808801919091526017546001600160a01b0390811661012089015295875287519081018852601854861681526019548616602082810191909152601a548716828a0152601b548716606080840191909152601c54881683880152601d54881695830195909552601e54871693820193909352601f5486169181019190915281860152855192830186528054909316825260215492820192909252602254818501526023548183015292820192909252602454918101829052906000036200019157603d80546001600160a01b03191633179055620001918282620001ae565b5050602454600103620001a857620001a8620005f0565b620013fa565b80518051601255602080820151601355604080830151601455606080840151601555608080850151601681905560a095860151601780546001600160a01b03199081166001600160a01b03938416179091558689015180516018805484169185169190911790558088015160198054841691851691909117905580870151601a8054841691851691909117905580860151601b8054841691851691909117905593840151601c8054831691841691909117905596830151601d8054891691831691909117905560c0830151601e8054891691831691909117905560e090920151601f805488169184169190911790558387015180518654909716969092169590951784559283015160218190558383015160225592810151602355840151602455602891909155603c9190915551620002e7906200109f565b604051809103906000f08015801562000304573d6000803e3d6000fd5b50603e80546001600160a01b0319166001600160a01b039290921691909117905560005b8251811015620005eb5760008382815181106200034957620003496200114c565b602002602001015160a00151905060008483815181106200036e576200036e6200114c565b60200260200101516101a001818152505060008483815181106200039657620003966200114c565b602002602001015161018001906001600160a01b031690816001600160a01b0316815250506000848381518110620003d257620003d26200114c565b602002602001015160a00181815250506000848381518110620003f957620003f96200114c565b60209081029190910101516101c0015260145484518590849081106200042357620004236200114c565b6020026020010151608001818152505060008483815181106200044a576200044a6200114c565b6020026020010151610260019060038111156200046b576200046b62001162565b9081600381111562000481576200048162001162565b8152505060008483815181106200049c576200049c6200114c565b6020026020010151610160018181525050620004da848381518110620004c657620004c66200114c565b60200260200101516200076160201b60201c565b62000507848381518110620004f357620004f36200114c565b60200260200101516200089860201b60201c565b80603960008685815181106200052157620005216200114c565b6020026020010151600001516001600160a01b03166001600160a01b0316815260200190815260200160002060008282546200055e91906200118e565b9250508190555080603b60008282546200057991906200118e565b92505081905550620005d38483815181106200059957620005996200114c565b60200260200101516020015182868581518110620005bb57620005bb6200114c565b60200260200101516000015162000b4560201b60201c565b50508080620005e290620011aa565b91505062000328565b505050565b603d546001600160a01b031633146200065c5760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201526218dbdb60ea1b60648201526084015b60405180910390fd5b6002603a600060256001815481106200067957620006796200114c565b60009182526020808320909101546001600160a01b0316835282019290925260400181206005018054909190620006b2908490620011dc565b925050819055506002603a60006025600181548110620006d657620006d66200114c565b60009182526020808320909101546001600160a01b03168352820192909252604001812060080180549091906200070f908490620011dc565b90915550506002602455602080546001600160a01b031660009081526039909152604081206103e890556200074790600190620010ad565b6200075560026000620010ad565b6003805460ff19169055565b600062000778826060015162000d5c60201b60201c565b6001600160a01b03909116602084015290508015620007c85760405162461bcd60e51b815260206004820152600b60248201526a32b737b2329032b93937b960a91b604482015260640162000653565b6020808301516001600160a01b039081166000908152603a90925260409091206001015416156200083c5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c7265616479207265676973746572656400000000604482015260640162000653565b61271082608001511115620008945760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e2072617465000000000000000000604482015260640162000653565b5050565b6101808101516001600160a01b03166200099757603e546001600160a01b0316620009115760405162461bcd60e51b815260206004820152602260248201527f6c6971756964206c6f67696320636f6e7472616374206e6f74206465706c6f79604482015261195960f21b606482015260840162000653565b602554600090620009229062000daa565b905081602001518260000151836080015183603e60009054906101000a90046001600160a01b03166040516200095890620010ef565b62000968959493929190620011f3565b604051809103906000f08015801562000985573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051602580546001808201835560009283527f401968ff42a154441da5f6c4c935ac46b8671f0e062baaa62a7545ba53bb6e4c90910180546001600160a01b03199081166001600160a01b0395861617909155845184168352603a9095526040918290208651815487169085161781559351908401805486169184169190911790558401516002830180549094169116179091556060820151829190600382019062000a49908262001301565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c820180546001600160a01b0319166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f82015561020082015160108201556102208201516011820155610240820151601282019062000b13908262001301565b5061026082015160138201805460ff1916600183600381111562000b3b5762000b3b62001162565b0217905550505050565b600080831162000ba45760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201526269766560e81b606482015260840162000653565b6001600160a01b03821660009081526039602052604090205483111562000c0e5760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e63650000000000604482015260640162000653565b6001600160a01b0382166000908152603960205260408120805485929062000c38908490620013cd565b9091555050604080516080810182526001600160a01b03808516825286811660208084019182528385018881524360608601908152600a805460009081526008909452968320865181549087166001600160a01b03199182161782559451600182018054919097169516949094179094555160028301559151600390910155825491929062000cc783620011aa565b90915550506001600160a01b038581166000818152603a6020908152604091829020548251908516948816948514808252918101899052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d910160405180910390a3833b1562000d415762000d41848762000eca565b6001600a5462000d529190620013cd565b9695505050505050565b60008062000d69620010fd565b60008060ff9050604083875160208901845afa62000d8657600080fd5b505080516020909101516c0100000000000000000000000090910494909350915050565b60608160000362000dd25750506040805180820190915260018152600360fc1b602082015290565b8160005b811562000e02578062000de981620011aa565b915062000dfa9050600a83620011dc565b915062000dd6565b6000816001600160401b0381111562000e1f5762000e1f62001136565b6040519080825280601f01601f19166020018201604052801562000e4a576020820181803683370190505b5090505b841562000ec25762000e62600183620013cd565b915062000e71600a86620013e3565b62000e7e9060306200118e565b60f81b81838151811062000e965762000e966200114c565b60200101906001600160f81b031916908160001a90535062000eba600a86620011dc565b945062000e4e565b949350505050565b6001600160a01b038216600090815260346020526040812054900362000f48576001600160a01b03821660008181526034602052604081206001908190556035805491820181559091527fcfa4bec1d3298408bb5afcfcd9c430549c5b31f8aa5c5848151c0a55f473c34d0180546001600160a01b03191690911790555b6001600160a01b038083166000908152603260209081526040808320938516835292905290812054900362000894576001600160a01b03808316600081815260326020908152604080832094861680845294825280832060019081905593835260338252822080549384018155825290200180546001600160a01b03191690911790555050565b60405180610280016040528060006001600160a01b0316815260200160006001600160a01b0316815260200160006001600160a01b0316815260200160608152602001600081526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b03168152602001600081526020016000815260200160008152602001600081526020016000815260200160608152602001600060038111156200109a576200109a62001162565b905290565b611734806200a58d83390190565b508054620010bb9062001273565b6000825580601f10620010cc575050565b601f016020900490600052602060002090810190620010ec91906200111b565b50565b6105c2806200bcc183390190565b60405180604001604052806002906020820280368337509192915050565b5b808211156200113257600081556001016200111c565b5090565b634e487b7160e01b600052604160045260246000fd5b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052602160045260246000fd5b634e487b7160e01b600052601160045260246000fd5b80820180821115620011a457620011a462001178565b92915050565b600060018201620011bf57620011bf62001178565b5060010190565b634e487b7160e01b600052601260045260246000fd5b600082620011ee57620011ee620011c6565b500490565b600060018060a01b03808816835260208188168185015286604085015260a06060850152855191508160a085015260005b82811015620012425786810182015185820160c00152810162001224565b5050600060c0828501015260c0601f19601f83011684010191505062000d5260808301846001600160a01b03169052565b600181811c908216806200128857607f821691505b602082108103620012a957634e487b7160e01b600052602260045260246000fd5b50919050565b601f821115620005eb57600081815260208120601f850160051c81016020861015620012d85750805b601f850160051c820191505b81811015620012f957828155600101620012e4565b505050505050565b81516001600160401b038111156200131d576200131d62001136565b62001335816200132e845462001273565b84620012af565b602080601f8311600181146200136d5760008415620013545750858301515b600019600386901b1c1916600185901b178555620012f9565b600085815260208120601f198616915b828110156200139e578886015182559484019460019091019084016200137d565b5085821015620013bd5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b81810381811115620011a457620011a462001178565b600082620013f557620013f5620011c6565b500690565b619183806200140a6000396000f3fe608060405260043610620004ef5760003560e01c8063872cf0591162000287578063b46e5520116200015f578063d5f3948811620000cb578063e7f43c68116200008d578063e7f43c68146200117c578063e98712b2146200119c578063ee7ff28d14620011b4578063f03b959d14620011d9578063f1d592a714620011fe578063f7866ee3146200122357005b8063d5f3948814620010bb578063d861b0e814620010dd578063d886f8a214620010f5578063dd62ed3e146200111a578063dfb1a4d2146200116457005b8063cb696f541162000121578063cb696f541462000ff7578063ceaad455146200101c578063cef984501462001041578063cf9c57191462001059578063cfd19fb91462001071578063d372c07e146200109657005b8063b46e55201462000f46578063b66b3e791462000f6b578063b6ef8c671462000f93578063b7ab4db51462000fb8578063c9d97af41462000fdf57005b8063a5d059ca11620001ff578063ab8f6ffe11620001c1578063ab8f6ffe1462000e70578063ae1da0b51462000e97578063ae1f5fa01462000eaf578063affb1cf11462000ed7578063b2ea9adb1462000efc578063b3ecbadd1462000f2157005b8063a5d059ca1462000d9d578063a8b2216e1462000db4578063a9059cbb1462000ddb578063a9af10591462000e00578063a9fd1a8f1462000e4657005b806396f18a32116200024957806396f18a321462000d0f5780639ac310741462000d315780639c98e4711462000d495780639dc29fac1462000d61578063a515366a1462000d8657005b8063872cf0591462000c6757806389c614b81462000c7f5780638bac7dad1462000c9757806395d89b411462000cbc57806396b477cb1462000cea57005b806340c10f1911620003c757806370a08231116200033357806379502c5511620002f557806379502c5514620009e35780637e660ac91462000bad578063819b64631462000bd2578063833b1fce1462000be957806384467fdb1462000c1d578063852c48491462000c4257005b806370a08231146200091e57806371d1bc591462000958578063731b3a03146200097057806377e741c71462000999578063784304b514620009be57005b8063573963b81162000389578063573963b814620008755780635bdedd16146200088d5780636a929cef14620008a55780636b5f444c14620008ca5780636bb9cb0e14620008ef5780636fd2c80b146200090757005b806340c10f1914620007c45780634364596914620007e9578063496ccd9b14620008005780634bb278f31462000825578063520fdbbc146200085057005b8063161605e3116200046757806325ce1bb9116200042957806325ce1bb9146200071157806329070c6d146200073a5780632f2c3f2e1462000751578063313ce567146200076957806335be16e01462000787578063386a827b14620007ac57005b8063161605e314620004f757806318160ddd146200067c5780631904bb2e14620006935780631a0cf2e514620006c757806323b872dd14620006ec57005b80630fe5010911620004b15780630fe5010914620005de578063112206331462000603578063114eaf55146200061a5780631250a28d146200063f5780631604e416146200066457005b806306fdde0314620004f9578063095ea7b3146200053d5780630ae65e7a14620005735780630b21fb1d14620005985780630d8e6e2c14620005bd57005b36620004f757005b005b3480156200050657600080fd5b506040805180820190915260068152652732bbba37b760d11b60208201525b60405162000534919062007aeb565b60405180910390f35b3480156200054a57600080fd5b50620005626200055c36600462007b1d565b62001243565b604051901515815260200162000534565b3480156200058057600080fd5b50620004f76200059236600462007b4c565b6200125c565b348015620005a557600080fd5b50620004f7620005b736600462007b6c565b62001303565b348015620005ca57600080fd5b506024545b60405190815260200162000534565b348015620005eb57600080fd5b50620004f7620005fd36600462007b6c565b62001335565b3480156200061057600080fd5b50601354620005cf565b3480156200062757600080fd5b50620004f76200063936600462007b6c565b62001367565b3480156200064c57600080fd5b50620004f76200065e36600462007b4c565b62001399565b3480156200067157600080fd5b50620005cf602f5481565b3480156200068957600080fd5b50603b54620005cf565b348015620006a057600080fd5b50620006b8620006b236600462007b4c565b620013ea565b60405162000534919062007bca565b348015620006d457600080fd5b50620004f7620006e636600462007b4c565b62001698565b348015620006f957600080fd5b50620005626200070b36600462007d43565b620016e7565b3480156200071e57600080fd5b506026546000908152602c6020526040902060030154620005cf565b3480156200074757600080fd5b50601254620005cf565b3480156200075e57600080fd5b50620005cf61271081565b3480156200077657600080fd5b506040516012815260200162000534565b3480156200079457600080fd5b50620004f7620007a636600462007d89565b620017ec565b348015620007b957600080fd5b50620005cf60055481565b348015620007d157600080fd5b50620004f7620007e336600462007b1d565b620019c8565b348015620007f657600080fd5b50602254620005cf565b3480156200080d57600080fd5b50620004f76200081f36600462007b4c565b62001a05565b3480156200083257600080fd5b506200083d62001b0f565b6040516200053495949392919062007e4a565b3480156200085d57600080fd5b50620004f76200086f36600462007b4c565b6200233e565b3480156200088257600080fd5b50620005cf60285481565b3480156200089a57600080fd5b50620005cf60075481565b348015620008b257600080fd5b50620004f7620008c436600462007b6c565b62002539565b348015620008d757600080fd5b50620004f7620008e936600462007b6c565b6200256b565b348015620008fc57600080fd5b50620005cf60375481565b3480156200091457600080fd5b50601554620005cf565b3480156200092b57600080fd5b50620005cf6200093d36600462007b4c565b6001600160a01b031660009081526039602052604090205490565b3480156200096557600080fd5b50620005cf60065481565b3480156200097d57600080fd5b506026546000908152602c6020526040902060020154620005cf565b348015620009a657600080fd5b50620004f7620009b836600462007b6c565b620025f1565b348015620009cb57600080fd5b50620004f7620009dd36600462007f2e565b62002623565b348015620009f057600080fd5b506040805160c080820183526012548252601354602080840191909152601454838501526015546060808501919091526016546080808601919091526017546001600160a01b0390811660a08088019190915287516101008101895260185483168152601954831681870152601a548316818a0152601b54831681860152601c54831681850152601d54831691810191909152601e54821695810195909552601f54811660e086015286519182018752835416815260215492810192909252602254948201949094526023549381019390935260245462000acf939084565b6040805185518152602080870151818301528683015182840152606080880151818401526080808901518185015260a0988901516001600160a01b039081168a8601528851811660c080870191909152848a0151821660e0808801919091528a8801518316610100880152848b01518316610120880152928a015182166101408701529989015181166101608601529888015189166101808501529096015187166101a083015284519096166101c0820152948301516101e08601528201516102008501529101516102208301526102408201526102600162000534565b34801562000bba57600080fd5b50620004f762000bcc36600462007b6c565b620027ce565b34801562000bdf57600080fd5b50602354620005cf565b34801562000bf657600080fd5b506019546001600160a01b03165b6040516001600160a01b03909116815260200162000534565b34801562000c2a57600080fd5b50620004f762000c3c36600462007f84565b62002800565b34801562000c4f57600080fd5b50620004f762000c6136600462007b1d565b6200292f565b34801562000c7457600080fd5b50620004f762002ac0565b34801562000c8c57600080fd5b50620005cf602a5481565b34801562000ca457600080fd5b50620004f762000cb636600462007b6c565b62002afc565b34801562000cc957600080fd5b50604080518082019091526003815262272a2760e91b602082015262000525565b34801562000cf757600080fd5b50620005cf62000d0936600462007b6c565b62002b80565b34801562000d1c57600080fd5b50603e5462000c04906001600160a01b031681565b34801562000d3e57600080fd5b50620005cf603c5481565b34801562000d5657600080fd5b50620005cf602b5481565b34801562000d6e57600080fd5b50620004f762000d8036600462007b1d565b62002c18565b620005cf62000d9736600462007b1d565b62002d35565b620005cf62000dae36600462007b1d565b62002e52565b34801562000dc157600080fd5b5062000dcc62002f2b565b6040516200053491906200802d565b34801562000de857600080fd5b506200056262000dfa36600462007b1d565b6200300e565b34801562000e0d57600080fd5b5062000e3762000e1f36600462007b6c565b6000908152600b602052604090206006015460ff1690565b60405162000534919062008093565b34801562000e5357600080fd5b5062000e5e62003066565b604051620005349493929190620080a8565b34801562000e7d57600080fd5b5062000e88620031e3565b604051620005349190620080d9565b34801562000ea457600080fd5b50620005cf60295481565b34801562000ebc57600080fd5b5062000ec7620032f5565b6040516200053492919062008134565b34801562000ee457600080fd5b5062000e5e62000ef636600462007b6c565b620035c1565b34801562000f0957600080fd5b50620004f762000f1b36600462008166565b620037c8565b34801562000f2e57600080fd5b50620004f762000f4036600462007b4c565b6200380f565b34801562000f5357600080fd5b50620004f762000f6536600462007b4c565b6200385e565b34801562000f7857600080fd5b5062000f8362003aaf565b60405162000534929190620081c7565b34801562000fa057600080fd5b50620005cf62000fb236600462007b6c565b62003be6565b34801562000fc557600080fd5b5062000fd062003c78565b604051620005349190620081f0565b34801562000fec57600080fd5b50620005cf60265481565b3480156200100457600080fd5b50620004f76200101636600462007b6c565b62003cdc565b3480156200102957600080fd5b50620004f76200103b36600462007b4c565b62003d44565b3480156200104e57600080fd5b50620005cf60045481565b3480156200106657600080fd5b50620004f762003d93565b3480156200107e57600080fd5b50620004f76200109036600462007b4c565b62003de8565b348015620010a357600080fd5b50620004f7620010b536600462007b4c565b62003e37565b348015620010c857600080fd5b50603d5462000c04906001600160a01b031681565b348015620010ea57600080fd5b50620004f762003e86565b3480156200110257600080fd5b50620004f76200111436600462007b4c565b6200403b565b3480156200112757600080fd5b50620005cf6200113936600462008205565b6001600160a01b03918216600090815260316020908152604080832093909416825291909152205490565b3480156200117157600080fd5b50620005cf6200408a565b3480156200118957600080fd5b506020546001600160a01b031662000c04565b348015620011a957600080fd5b50620005cf602e5481565b348015620011c157600080fd5b50620004f7620011d336600462007b6c565b620040a7565b348015620011e657600080fd5b50620004f7620011f836600462007b4c565b620040d9565b3480156200120b57600080fd5b50620004f76200121d36600462007b4c565b62004128565b3480156200123057600080fd5b506017546001600160a01b031662000c04565b600062001252338484620041e1565b5060015b92915050565b620012666200430a565b6001600160a01b038082166000818152603a602052604090206001015490911614620012af5760405162461bcd60e51b8152600401620012a69062008243565b60405180910390fd5b6001600160a01b038181166000908152603a6020526040902054163314620012eb5760405162461bcd60e51b8152600401620012a6906200827a565b620012f68162004359565b6200130060008055565b50565b6020546001600160a01b03163314620013305760405162461bcd60e51b8152600401620012a690620082c6565b600555565b6020546001600160a01b03163314620013625760405162461bcd60e51b8152600401620012a690620082c6565b600455565b6020546001600160a01b03163314620013945760405162461bcd60e51b8152600401620012a690620082c6565b601555565b6020546001600160a01b03163314620013c65760405162461bcd60e51b8152600401620012a690620082c6565b601880546001600160a01b0319166001600160a01b0392909216919091179055565b565b620013f4620078a4565b6001600160a01b038083166000818152603a602052604090206001015490911614620014345760405162461bcd60e51b8152600401620012a690620082fd565b6001600160a01b038083166000908152603a60209081526040918290208251610280810184528154851681526001820154851692810192909252600281015490931691810191909152600382018054919291606084019190620014979062008334565b80601f0160208091040260200160405190810160405280929190818152602001828054620014c59062008334565b8015620015165780601f10620014ea5761010080835404028352916020019162001516565b820191906000526020600020905b815481529060010190602001808311620014f857829003601f168201915b505050918352505060048201546020820152600582015460408201526006820154606082015260078201546080820152600882015460a0820152600982015460c0820152600a82015460e0820152600b820154610100820152600c8201546001600160a01b0316610120820152600d820154610140820152600e820154610160820152600f82015461018082015260108201546101a082015260118201546101c08201526012820180546101e090920191620015d29062008334565b80601f0160208091040260200160405190810160405280929190818152602001828054620016009062008334565b8015620016515780601f10620016255761010080835404028352916020019162001651565b820191906000526020600020905b8154815290600101906020018083116200163357829003601f168201915b5050509183525050601382015460209091019060ff1660038111156200167b576200167b62007b86565b60038111156200168f576200168f62007b86565b90525092915050565b6020546001600160a01b03163314620016c55760405162461bcd60e51b8152600401620012a690620082c6565b601f80546001600160a01b0319166001600160a01b0392909216919091179055565b6001600160a01b0383166000908152603160209081526040808320338452909152812054828110156200176e5760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e74206578636565647320616044820152676c6c6f77616e636560c01b6064820152608401620012a6565b6200177b85858562004449565b6200179385336200178d868562008386565b620041e1565b836001600160a01b0316856001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef85604051620017d991815260200190565b60405180910390a3506001949350505050565b6018546001600160a01b03163314620018545760405162461bcd60e51b815260206004820152602360248201527f63616c6c6572206973206e6f742074686520736c617368696e6720636f6e74726044820152621858dd60ea1b6064820152608401620012a6565b6000610120820135603a8262001871604086016020870162007b4c565b6001600160a01b03166001600160a01b0316815260200190815260200160002060090154620018a1919062008386565b60c0830135603a6000620018bc604087016020880162007b4c565b6001600160a01b03166001600160a01b0316815260200190815260200160002060060154620018ec919062008386565b60a0840135603a600062001907604088016020890162007b4c565b6001600160a01b03166001600160a01b031681526020019081526020016000206005015462001937919062008386565b6200194391906200839c565b6200194f91906200839c565b6017546001600160a01b0316600090815260396020526040812080549293508392909190620019809084906200839c565b90915550829050603a60006200199d604084016020850162007b4c565b6001600160a01b031681526020810191909152604001600020620019c282826200858a565b50505050565b6020546001600160a01b03163314620019f55760405162461bcd60e51b8152600401620012a690620082c6565b62001a0182826200457b565b5050565b6020546001600160a01b0316331462001a325760405162461bcd60e51b8152600401620012a690620082c6565b601980546001600160a01b0319166001600160a01b03838116918217909255601a54604051637adbf97360e01b8152600481019290925290911690637adbf97390602401600060405180830381600087803b15801562001a9157600080fd5b505af115801562001aa6573d6000803e3d6000fd5b5050601c54604051637adbf97360e01b81526001600160a01b0385811660048301529091169250637adbf97391506024015b600060405180830381600087803b15801562001af357600080fd5b505af115801562001b08573d6000803e3d6000fd5b5050505050565b603d546000908190606090829081906001600160a01b0316331462001b485760405162461bcd60e51b8152600401620012a690620086e0565b62001b526200430a565b4360298190556026546000828152602760209081526040808320849055928252602c90528190206003015460185491516306c9789b60e41b815292101560048301819052916001600160a01b0390911690636c9789b090602401600060405180830381600087803b15801562001bc757600080fd5b505af115801562001bdc573d6000803e3d6000fd5b505050508015620020f657601e54603b54603c54602a546040516392eff3cd60e01b81526004810193909352602483019190915260448201524260648201526000916001600160a01b0316906392eff3cd90608401602060405180830381865afa15801562001c4f573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019062001c75919062008723565b905080603c54101562001c875750603c545b62001c9330826200457b565b80603c600082825462001ca7919062008386565b9091555050601f546040805163f968f49360e01b815281516001600160a01b039093169263f968f4939260048084019391929182900301816000875af192505050801562001d14575060408051601f3d908101601f1916820190925262001d11918101906200873d565b60015b62001d52576040514281527ff1217bb194383aa5c937c81cbbb445990a478c7511b080df329f9b0fb64233c99060200160405180910390a162001d85565b60175462001d6a906001600160a01b0316826200457b565b601f5462001d82906001600160a01b0316836200457b565b50505b3060009081526039602052604090205462001da290479062004601565b62001dac62004b48565b62001db662004e0b565b62001dc062004efa565b62001dca62004f99565b60008062001dd7620032f5565b60195460405163422811f960e11b81529294509092506001600160a01b03169063845023f29062001e0d908590600401620081f0565b600060405180830381600087803b15801562001e2857600080fd5b505af115801562001e3d573d6000803e3d6000fd5b505060185460405163e08b14ed60e01b81526001600160a01b03909116925063e08b14ed915062001e73908490600401620081f0565b600060405180830381600087803b15801562001e8e57600080fd5b505af115801562001ea3573d6000803e3d6000fd5b50506026546000908152602c6020526040902060020154602854602154919350148015915062001ed4575060285415155b1562001f42576028546021819055601854604051631ad7d11360e21b815260048101929092526001600160a01b031690636b5f444c90602401600060405180830381600087803b15801562001f2857600080fd5b505af115801562001f3d573d6000803e3d6000fd5b505050505b60215460009062001f5490436200839c565b905042602a8190555060016026600082825462001f7291906200839c565b909155505060265460408051602d805460a0602082028401810190945260808301818152620020b49594849391929060009085015b8282101562002095576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162001ffd9062008334565b80601f01602080910402602001604051908101604052809291908181526020018280546200202b9062008334565b80156200207c5780601f1062002050576101008083540402835291602001916200207c565b820191906000526020600020905b8154815290600101906020018083116200205e57829003601f168201915b5050505050815250508152602001906001019062001fa7565b50505050815260200185815260200143815260200184815250620050b4565b7febad8099c467528a56c98b63c8d476d251cf1ffb4c75db94b4d23fa2b6a1e335602654604051620020e891815260200190565b60405180910390a150505050505b60195460408051634bb278f360e01b815290516000926001600160a01b031691634bb278f3916004808301926020929190829003018187875af115801562002142573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019062002168919062008762565b90508015620021e457601a546040805163a2e6204560e01b815290516001600160a01b039092169163a2e620459160048082019260209290919082900301816000875af1925050508015620021dc575060408051601f3d908101601f19168201909252620021d99181019062008762565b60015b15620021e457505b600380546026546000908152602c602090815260408083206001810154950154602d805483518186028101860190945280845260ff909616968996919590949293928692919084015b828210156200231b576000848152602090819020604080516060810182526003860290920180546001600160a01b0316835260018101549383019390935260028301805492939291840191620022839062008334565b80601f0160208091040260200160405190810160405280929190818152602001828054620022b19062008334565b8015620023025780601f10620022d65761010080835404028352916020019162002302565b820191906000526020600020905b815481529060010190602001808311620022e457829003601f168201915b505050505081525050815260200190600101906200222d565b5050505092509650965096509650965050506200233760008055565b9091929394565b6020546001600160a01b031633146200236b5760405162461bcd60e51b8152600401620012a690620082c6565b602080546001600160a01b0319166001600160a01b0383811691821790925560195460405163b3ab15fb60e01b815260048101929092529091169063b3ab15fb90602401600060405180830381600087803b158015620023ca57600080fd5b505af1158015620023df573d6000803e3d6000fd5b5050601a5460405163b3ab15fb60e01b81526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b1580156200242b57600080fd5b505af115801562002440573d6000803e3d6000fd5b5050601b5460405163b3ab15fb60e01b81526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b1580156200248c57600080fd5b505af1158015620024a1573d6000803e3d6000fd5b5050601c5460405163b3ab15fb60e01b81526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b158015620024ed57600080fd5b505af115801562002502573d6000803e3d6000fd5b5050601d5460405163b3ab15fb60e01b81526001600160a01b038581166004830152909116925063b3ab15fb915060240162001ad8565b6020546001600160a01b03163314620025665760405162461bcd60e51b8152600401620012a690620082c6565b603755565b6020546001600160a01b03163314620025985760405162461bcd60e51b8152600401620012a690620082c6565b60288190556026546000908152602c6020908152604091829020600301548251848152918201819052917f2eea6438d890c8603d4df81ad1bad2a4ea45c02b4837165f461ff3c81603abc7910160405180910390a15050565b6020546001600160a01b031633146200261e5760405162461bcd60e51b8152600401620012a690620082c6565b601255565b6001600160a01b038083166000818152603a60205260409020600181015490921614620026645760405162461bcd60e51b8152600401620012a690620082fd565b80546001600160a01b03163314620026905760405162461bcd60e51b8152600401620012a69062008786565b6200269b8362005184565b15620026f55760405162461bcd60e51b815260206004820152602260248201527f76616c696461746f72206d757374206e6f7420626520696e20636f6d6d697474604482015261656560f01b6064820152608401620012a6565b6000806200270384620051f5565b925090508115620027455760405162461bcd60e51b815260206004820152600b60248201526a32b737b2329032b93937b960a91b6044820152606401620012a6565b60018301546001600160a01b03828116911614620027b65760405162461bcd60e51b815260206004820152602760248201527f76616c696461746f72206e6f646520616464726573732063616e2774206265206044820152661d5c19185d195960ca1b6064820152608401620012a6565b60038301620027c68582620087d5565b505050505050565b6020546001600160a01b03163314620027fb5760405162461bcd60e51b8152600401620012a690620082c6565b600755565b6000604051806102800160405280336001600160a01b0316815260200160006001600160a01b03168152602001856001600160a01b0316815260200186815260200160126000016002015481526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b031681526020016000815260200143815260200160008152602001600081526020016000815260200184815260200160006003811115620028cf57620028cf62007b86565b90529050620028df81836200523a565b60208101516101808201516040517f8ad8bd2eb6950e5f332fd3a6dca48cb358ecfe3057848902b98cbdfe455c915c926200292092339289918b916200889e565b60405180910390a15050505050565b6001600160a01b038083166000818152603a6020526040902060010154909116146200296f5760405162461bcd60e51b8152600401620012a69062008243565b6001600160a01b038281166000908152603a6020526040902054163314620029ab5760405162461bcd60e51b8152600401620012a6906200827a565b612710811115620029ff5760405162461bcd60e51b815260206004820152601f60248201527f7265717569726520636f727265637420636f6d6d697373696f6e2072617465006044820152606401620012a6565b604080516060810182526001600160a01b038481168252436020808401918252838501868152601180546000908152600f909352958220855181546001600160a01b03191695169490941784559151600180850191909155915160029093019290925583549293909290919062002a789084906200839c565b90915550506040518281526001600160a01b038416907f4fba51c92fa3d6ad8374d394f6cd5766857552e153d7384a8f23aa4ce9a8a7cf9060200160405180910390a2505050565b6020546001600160a01b0316331462002aed5760405162461bcd60e51b8152600401620012a690620082c6565b6003805460ff19166001179055565b6020546001600160a01b0316331462002b295760405162461bcd60e51b8152600401620012a690620082c6565b6000811162002b7b5760405162461bcd60e51b815260206004820152601960248201527f636f6d6d69747465652073697a652063616e27742062652030000000000000006044820152606401620012a6565b602355565b6000602954600162002b9391906200839c565b82111562002bf35760405162461bcd60e51b815260206004820152602660248201527f63616e6e6f74206765742065706f636820696420666f7220612066757475726560448201526520626c6f636b60d01b6064820152608401620012a6565b602954821162002c10575060009081526027602052604090205490565b505060265490565b6020546001600160a01b0316331462002c455760405162461bcd60e51b8152600401620012a690620082c6565b6001600160a01b03821660009081526039602052604090205481111562002ca85760405162461bcd60e51b8152602060048201526016602482015275416d6f756e7420657863656564732062616c616e636560501b6044820152606401620012a6565b6001600160a01b0382166000908152603960205260408120805483929062002cd290849062008386565b9250508190555080603b600082825462002ced919062008386565b90915550506040518181526001600160a01b038316907f5024dbeedf0c06664c9bd7be836915730c955e936972c020683dadf11d5488a3906020015b60405180910390a25050565b600062002d416200430a565b6001600160a01b038084166000818152603a60205260409020600101549091161462002d815760405162461bcd60e51b8152600401620012a690620082fd565b6001600160a01b0383166000908152603a602052604081206013015460ff16600381111562002db45762002db462007b86565b1462002e035760405162461bcd60e51b815260206004820152601b60248201527f76616c696461746f72206e65656420746f2062652061637469766500000000006044820152606401620012a6565b60375462002e129034620088fd565b336000908152603860205260408120805490919062002e339084906200839c565b9091555062002e46905083833362005694565b90506200125660008055565b600062002e5e6200430a565b6001600160a01b038084166000818152603a60205260409020600101549091161462002e9e5760405162461bcd60e51b8152600401620012a690620082fd565b6000821162002ee85760405162461bcd60e51b81526020600482015260156024820152740756e626f6e64696e6720616d6f756e74206973203605c1b6044820152606401620012a6565b60375462002ef79034620088fd565b336000908152603860205260408120805490919062002f189084906200839c565b9091555062002e469050838333620058ab565b60606030805480602002602001604051908101604052809291908181526020016000905b828210156200300557838290600052602060002001805462002f719062008334565b80601f016020809104026020016040519081016040528092919081815260200182805462002f9f9062008334565b801562002ff05780601f1062002fc45761010080835404028352916020019162002ff0565b820191906000526020600020905b81548152906001019060200180831162002fd257829003601f168201915b50505050508152602001906001019062002f4f565b50505050905090565b60006200301d33848462004449565b6040518281526001600160a01b0384169033907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef9060200160405180910390a350600192915050565b6026546000908152602c602090815260408083208151815460a094810282018501909352608081018381526060959485948594859493909284928491879085015b8282101562003195576000848152602090819020604080516060810182526003860290920180546001600160a01b0316835260018101549383019390935260028301805492939291840191620030fd9062008334565b80601f01602080910402602001604051908101604052809291908181526020018280546200312b9062008334565b80156200317c5780601f1062003150576101008083540402835291602001916200317c565b820191906000526020600020905b8154815290600101906020018083116200315e57829003601f168201915b50505050508152505081526020019060010190620030a7565b50505090825250600182015460208083019190915260028301546040808401919091526003909301546060928301528351908401519284015193909101519098919750919550909350915050565b6060602d805480602002602001604051908101604052809291908181526020016000905b8282101562003005576000848152602090819020604080516060810182526003860290920180546001600160a01b03168352600181015493830193909352600283018054929392918401916200325d9062008334565b80601f01602080910402602001604051908101604052809291908181526020018280546200328b9062008334565b8015620032dc5780601f10620032b057610100808354040283529160200191620032dc565b820191906000526020600020905b815481529060010190602001808311620032be57829003601f168201915b5050505050815250508152602001906001019062003207565b603d5460609081906001600160a01b03163314620033275760405162461bcd60e51b8152600401620012a690620086e0565b602554620033785760405162461bcd60e51b815260206004820152601860248201527f5468657265206d7573742062652076616c696461746f727300000000000000006044820152606401620012a6565b6200338262007974565b602354608082015260258152603a6020820152602d6040820152602b6060820152620033ae8162005c90565b620033bc6030600062007992565b602d5480620034035760405162461bcd60e51b8152602060048201526012602482015271636f6d6d697474656520697320656d70747960701b6044820152606401620012a6565b60008167ffffffffffffffff81111562003421576200342162007e83565b6040519080825280602002602001820160405280156200344b578160200160208202803683370190505b50905060008267ffffffffffffffff8111156200346c576200346c62007e83565b60405190808252806020026020018201604052801562003496578160200160208202803683370190505b50905060005b83811015620035b5576000603a6000602d8481548110620034c157620034c162008914565b60009182526020808320600392830201546001600160a01b031684528301939093526040909101812060308054600181018255925292507f6ff97a59c90d62cc7236ba3a37cd85351bf564556780cf8c1157a220f31f0cbb019062003529908301826200892a565b50600281015484516001600160a01b039091169085908490811062003552576200355262008914565b6001600160a01b0392831660209182029290920101526001820154845191169084908490811062003587576200358762008914565b6001600160a01b03909216602092830291909101909101525080620035ac8162008a08565b9150506200349c565b50909450925050509091565b606060008060006029546001620035d991906200839c565b851115620036365760405162461bcd60e51b815260206004820152602360248201527f63616e6e6f74206765742065706f636820666f7220612066757475726520626c6044820152626f636b60e81b6064820152608401620012a6565b60265460295486116200365457506000858152602760205260409020545b6000818152602c602090815260408083208151815460a09481028201850190935260808101838152909391928492849190879085015b8282101562003778576000848152602090819020604080516060810182526003860290920180546001600160a01b0316835260018101549383019390935260028301805492939291840191620036e09062008334565b80601f01602080910402602001604051908101604052809291908181526020018280546200370e9062008334565b80156200375f5780601f1062003733576101008083540402835291602001916200375f565b820191906000526020600020905b8154815290600101906020018083116200374157829003601f168201915b505050505081525050815260200190600101906200368a565b5050509082525060018201546020808301919091526002830154604080840191909152600390930154606092830152835190840151928401519390910151909a9199509197509095509350505050565b6020546001600160a01b03163314620037f55760405162461bcd60e51b8152600401620012a690620082c6565b6200380260018362005cb0565b62001a0160028262005cb0565b6020546001600160a01b031633146200383c5760405162461bcd60e51b8152600401620012a690620082c6565b601b80546001600160a01b0319166001600160a01b0392909216919091179055565b620038686200430a565b6001600160a01b038082166000818152603a602052604090206001015490911614620038a85760405162461bcd60e51b8152600401620012a69062008243565b6001600160a01b038082166000908152603a6020526040902080549091163314620038e75760405162461bcd60e51b8152600401620012a69062008786565b6000601382015460ff16600381111562003905576200390562007b86565b03620039545760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f7220616c72656164792061637469766500000000000000006044820152606401620012a6565b6002601382015460ff16600381111562003972576200397262007b86565b148015620039835750438160100154115b15620039d25760405162461bcd60e51b815260206004820152601760248201527f76616c696461746f72207374696c6c20696e206a61696c0000000000000000006044820152606401620012a6565b6003601382015460ff166003811115620039f057620039f062007b86565b0362003a3f5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206a61696c6564207065726d616e656e746c79000000006044820152606401620012a6565b60138101805460ff1916905580546026546000908152602c60209081526040918290206003015491519182526001600160a01b038581169316917f60fcbf2d07dc712a93e59fb28f1edb626d7c2497c57ba71a8c0b3999ecb9a3b5910160405180910390a3506200130060008055565b6060806001600281805462003ac49062008334565b80601f016020809104026020016040519081016040528092919081815260200182805462003af29062008334565b801562003b435780601f1062003b175761010080835404028352916020019162003b43565b820191906000526020600020905b81548152906001019060200180831162003b2557829003601f168201915b5050505050915080805462003b589062008334565b80601f016020809104026020016040519081016040528092919081815260200182805462003b869062008334565b801562003bd75780601f1062003bab5761010080835404028352916020019162003bd7565b820191906000526020600020905b81548152906001019060200180831162003bb957829003601f168201915b50505050509050915091509091565b600060036000838152600b602052604090206006015460ff16600381111562003c135762003c1362007b86565b1462003c625760405162461bcd60e51b815260206004820152601e60248201527f756e626f6e64696e672072656c65617365206e6f7420726576657274656400006044820152606401620012a6565b506000908152600b602052604090206005015490565b6060602580548060200260200160405190810160405280929190818152602001828054801562003cd257602002820191906000526020600020905b81546001600160a01b0316815260019091019060200180831162003cb3575b5050505050905090565b6020546001600160a01b0316331462003d095760405162461bcd60e51b8152600401620012a690620082c6565b60138190556040518181527f1f4d2fc7529047a5bd96d3229bfea127fd18b7748f13586e097c69fccd3891289060200160405180910390a150565b6020546001600160a01b0316331462003d715760405162461bcd60e51b8152600401620012a690620082c6565b601d80546001600160a01b0319166001600160a01b0392909216919091179055565b6020546001600160a01b0316331462003dc05760405162461bcd60e51b8152600401620012a690620082c6565b62003dce60016000620079b2565b62003ddc60026000620079b2565b6003805460ff19169055565b6020546001600160a01b0316331462003e155760405162461bcd60e51b8152600401620012a690620082c6565b601c80546001600160a01b0319166001600160a01b0392909216919091179055565b6020546001600160a01b0316331462003e645760405162461bcd60e51b8152600401620012a690620082c6565b601a80546001600160a01b0319166001600160a01b0392909216919091179055565b603d546001600160a01b0316331462003eb35760405162461bcd60e51b8152600401620012a690620086e0565b62003ebd6200430a565b62003ec762004e0b565b62003ed1620032f5565b505042602a5543602981905560009081526027602090815260408083208390556026548151602d805460a095810283018601909452608082018481526200403196939592948594919390919085015b828210156200400e576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162003f769062008334565b80601f016020809104026020016040519081016040528092919081815260200182805462003fa49062008334565b801562003ff55780601f1062003fc95761010080835404028352916020019162003ff5565b820191906000526020600020905b81548152906001019060200180831162003fd757829003601f168201915b5050505050815250508152602001906001019062003f20565b5050509082525060006020820152436040820152602154606090910152620050b4565b620013e860008055565b6020546001600160a01b03163314620040685760405162461bcd60e51b8152600401620012a690620082c6565b601780546001600160a01b0319166001600160a01b0392909216919091179055565b60285460215460009114620040a0575060285490565b5060215490565b6020546001600160a01b03163314620040d45760405162461bcd60e51b8152600401620012a690620082c6565b600655565b6020546001600160a01b03163314620041065760405162461bcd60e51b8152600401620012a690620082c6565b601e80546001600160a01b0319166001600160a01b0392909216919091179055565b6020546001600160a01b03163314620041555760405162461bcd60e51b8152600401620012a690620082c6565b6001600160a01b038116620041bf5760405162461bcd60e51b815260206004820152602960248201527f696e76616c696420636f6e7472616374206164647265737320666f72206c6971604482015268756964206c6f67696360b81b6064820152608401620012a6565b603e80546001600160a01b0319166001600160a01b0392909216919091179055565b6001600160a01b038316620042455760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f206164646044820152637265737360e01b6064820152608401620012a6565b6001600160a01b038216620042a85760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f206164647265604482015261737360f01b6064820152608401620012a6565b6001600160a01b0383811660008181526031602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92591015b60405180910390a3505050565b60005415620043525760405162461bcd60e51b81526020600482015260136024820152721c99595b9d1c985b98de4819195d1958dd1959606a1b6044820152606401620012a6565b6001600055565b6001600160a01b0381166000908152603a6020526040812090601382015460ff1660038111156200438e576200438e62007b86565b14620043dd5760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206d7573742062652061637469766500000000000000006044820152606401620012a6565b60138101805460ff1916600117905580546026546000908152602c60209081526040918290206003015491519182526001600160a01b038581169316917f75bdcdbe540758778e669d108fbcb7ede734f27f46e4e5525eeb8ecf91849a9c910160405180910390a35050565b6001600160a01b038316600090815260396020526040902054811115620044ac5760405162461bcd60e51b8152602060048201526016602482015275616d6f756e7420657863656564732062616c616e636560501b6044820152606401620012a6565b6001600160a01b03831660009081526039602052604081208054839290620044d690849062008386565b9250508190555080603b6000828254620044f191906200839c565b9091555062004504905081600262008a24565b6001600160a01b038316600090815260396020526040812080549091906200452e9084906200839c565b92505081905550816001600160a01b0316836001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef83604051620042fd91815260200190565b6001600160a01b03821660009081526039602052604081208054839290620045a59084906200839c565b9250508190555080603b6000828254620045c091906200839c565b90915550506040518181526001600160a01b038316907f48490b4407bb949b708ec5f514b4167f08f4969baaf78d53b05028adf369bfcf9060200162002d29565b811580156200460e575080155b1562004618575050565b601254600090670de0b6b3a7640000906200463590859062008a24565b620046419190620088fd565b90508015620046bf576017546040516000916001600160a01b03169083908381818185875af1925050503d806000811462004699576040519150601f19603f3d011682016040523d82523d6000602084013e6200469e565b606091505b5090915050801515600103620046bd57620046ba828562008386565b93505b505b82602e6000828254620046d391906200839c565b90915550600090505b602d54811015620019c2576000603a6000602d848154811062004703576200470362008914565b600091825260208083206003909202909101546001600160a01b031683528201929092526040018120602b54602d80549294509091889190869081106200474e576200474e62008914565b9060005260206000209060030201600101546200476c919062008a24565b620047789190620088fd565b90506000602b5486602d868154811062004796576200479662008914565b906000526020600020906003020160010154620047b4919062008a24565b620047c09190620088fd565b90506000821180620047d25750600081115b1562004b2f576002601384015460ff166003811115620047f657620047f662007b86565b14806200481e57506003601384015460ff1660038111156200481c576200481c62007b86565b145b15620048e8576018546200483e9030906001600160a01b03168362004449565b601854602d80546001600160a01b039092169163a8031a1d918591889081106200486c576200486c62008914565b600091825260209091206003909102015460405160e084901b6001600160e01b03191681526001600160a01b039091166004820152602481018590526044016000604051808303818588803b158015620048c557600080fd5b505af1158015620048da573d6000803e3d6000fd5b505050505050505062004b33565b6000836005015483856008015462004901919062008a24565b6200490d9190620088fd565b90508015620049cf57835460405160009182916001600160a01b03909116906108fc90859084818181858888f193505050503d80600081146200496d576040519150601f19603f3d011682016040523d82523d6000602084013e62004972565b606091505b509092509050811515600003620049cc5785546040517f1137d8c966ce69b9630fb2294be011f3d64cc56e91fad7d375f0662568e9d35291620049c3916001600160a01b0390911690849062008a3e565b60405180910390a15b50505b60008460050154838660080154620049e8919062008a24565b620049f49190620088fd565b9050801562004a1657845462004a169030906001600160a01b03168362004449565b600062004a24828562008386565b9050600062004a34848762008386565b9050600081118062004a465750600082115b1562004ae057600c87015462004a689030906001600160a01b03168462004449565b600c87015460405163a0ce552d60e01b8152600481018490526001600160a01b039091169063a0ce552d908390602401604080518083038185885af115801562004ab6573d6000803e3d6000fd5b50505050506040513d601f19601f8201168201806040525081019062004add91906200873d565b50505b600187015460408051888152602081018890526001600160a01b03909216917f291e8ba3c0f4b0bd86e6e2346fcee1e7ca0975b1cc1886bfbc722d34f3f24d91910160405180910390a2505050505b5050505b8062004b3f8162008a08565b915050620046dc565b60355460005b8181101562001a015760006035828154811062004b6f5762004b6f62008914565b60009182526020808320909101546001600160a01b03168083526033825260408084208054825181860281018601909352808352929550909290919083018282801562004be657602002820191906000526020600020905b81546001600160a01b0316815260019091019060200180831162004bc7575b5050505050905060005b815181101562004c6e576001600160a01b0383166000908152603260205260408120835190919084908490811062004c2c5762004c2c62008914565b60200260200101516001600160a01b03166001600160a01b0316815260200190815260200160002060009055808062004c659062008a08565b91505062004bf0565b506001600160a01b038216600090815260336020526040812062004c9291620079f1565b6001600160a01b038216600090815260386020526040902054815160075462004cbc919062008a24565b81111562004cd857815160075462004cd5919062008a24565b90505b60005a9050836001600160a01b031663d18736ab83856040518363ffffffff1660e01b815260040162004d0c9190620081f0565b600060405180830381600088803b15801562004d2757600080fd5b5087f19350505050801562004d3a575060015b62004d70575a62004d4c908262008386565b6001600160a01b038516600090815260366020526040902060019055905062004d80565b5a62004d7d908262008386565b90505b6001600160a01b03841660009081526038602052604090205481101562004dd7576001600160a01b0384166000908152603860205260408120805483929062004dcb90849062008386565b9091555062004df19050565b6001600160a01b0384166000908152603860205260408120555b50505050808062004e029062008a08565b91505062004b4e565b6009545b600a5481101562004e385762004e328162004e2a8162008a08565b925062005e03565b62004e0f565b50600a54600955600d54600c540362004e4d57565b600e545b600d5481101562004e7a5762004e748162004e6c8162008a08565b9250620060a4565b62004e51565b50600d54600e55600c54805b600d5481101562004ef4576015546000828152600b6020526040902060040154439162004eb3916200839c565b1162004ed95762004ec48162006440565b62004ed16001836200839c565b915062004edf565b62004ef4565b8062004eeb8162008a08565b91505062004e86565b50600c55565b60355460005b8181101562004f8a5760006035828154811062004f215762004f2162008914565b60009182526020808320909101546001600160a01b0316808352603482526040808420849055603690925291205490915060010362004f74576001600160a01b0381166000908152603660205260408120555b508062004f818162008a08565b91505062004f00565b506200130060356000620079f1565b6011546010541015620013e8576010546000908152600f602052604090206015546001820154439162004fcc916200839c565b111562004fd65750565b80546001600160a01b039081166000908152603a60205260409081902060028401546004808301829055600c83015493516319fac8fd60e01b8152929493909316926319fac8fd926200502e92910190815260200190565b600060405180830381600087803b1580156200504957600080fd5b505af11580156200505e573d6000803e3d6000fd5b5050601080546000908152600f6020526040812080546001600160a01b031916815560018082018390556002909101829055825490945091925090620050a69084906200839c565b9091555062004f9992505050565b6000828152602c60209081526040808320918401516001830155830151600282015560608301516003820155905b825151811015620019c257825180518391908390811062005107576200510762008914565b602090810291909101810151825460018082018555600094855293839020825160039092020180546001600160a01b0319166001600160a01b039092169190911781559181015192820192909255604082015160028201906200516b9082620087d5565b50505080806200517b9062008a08565b915050620050e2565b6000805b602d54811015620051ec57602d8181548110620051a957620051a962008914565b60009182526020909120600390910201546001600160a01b0390811690841603620051d75750600192915050565b80620051e38162008a08565b91505062005188565b50600092915050565b6000806200520262007a11565b60008060ff9050604083875160208901845afa6200521f57600080fd5b50508051602090910151600160601b90910494909350915050565b60e2815114620052845760405162461bcd60e51b8152602060048201526014602482015273092dcecc2d8d2c840e0e4dedecc40d8cadccee8d60631b6044820152606401620012a6565b60308261024001515114620052dc5760405162461bcd60e51b815260206004820152601c60248201527f496e76616c696420636f6e73656e737573206b6579206c656e677468000000006044820152606401620012a6565b620052e782620065e9565b604080518082018252601a81527f19457468657265756d205369676e6564204d6573736167653a0a0000000000006020808301919091528451925191926000926200534a920160609190911b6bffffffffffffffffffffffff1916815260140190565b60405160208183030381529060405290506000826200536a835162006716565b836040516020016200537f9392919062008a6f565b60408051601f1981840301815282825280516020918201206002808552606085018452909450600093929091830190803683370190505090506000808080620053d78982620053d16041600262008a24565b62006837565b90506000620053f68a620053ee6041600262008a24565b606062006837565b905060205b8251811015620054c75762005411838262006950565b6040805160008152602081018083528d905260ff8316918101919091526060810184905260808101839052929850909650945060019060a0016020604051602081039080840390855afa1580156200546d573d6000803e3d6000fd5b5050604051601f19015190508762005487604184620088fd565b815181106200549a576200549a62008914565b6001600160a01b0390921660209283029190910190910152620054bf6041826200839c565b9050620053fb565b508a602001516001600160a01b031686600081518110620054ec57620054ec62008914565b60200260200101516001600160a01b0316146200555e5760405162461bcd60e51b815260206004820152602960248201527f496e76616c6964206e6f6465206b6579206f776e6572736869702070726f6f66604482015268081c1c9bdd9a59195960ba1b6064820152608401620012a6565b8a604001516001600160a01b03168660018151811062005582576200558262008914565b60200260200101516001600160a01b031614620055f65760405162461bcd60e51b815260206004820152602b60248201527f496e76616c6964206f7261636c65206b6579206f776e6572736869702070726f60448201526a1bd9881c1c9bdd9a59195960aa1b6064820152608401620012a6565b60016200560e8c6102400151838e6000015162006987565b146200567c5760405162461bcd60e51b815260206004820152603660248201527f496e76616c696420636f6e73656e737573206b6579206f776e65727368697020604482015275383937b7b3103337b9103932b3b4b9ba3930ba34b7b760511b6064820152608401620012a6565b620056878b620069f6565b5050505050505050505050565b6000808311620056f35760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201526269766560e81b6064820152608401620012a6565b6001600160a01b0382166000908152603960205260409020548311156200575d5760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e636500000000006044820152606401620012a6565b6001600160a01b038216600090815260396020526040812080548592906200578790849062008386565b9091555050604080516080810182526001600160a01b03808516825286811660208084019182528385018881524360608601908152600a805460009081526008909452968320865181549087166001600160a01b031991821617825594516001820180549190971695169490941790945551600283015591516003909101558254919290620058168362008a08565b90915550506001600160a01b038581166000818152603a6020908152604091829020548251908516948816948514808252918101899052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d910160405180910390a3833b15620058905762005890848762006ca3565b6001600a54620058a1919062008386565b9695505050505050565b6001600160a01b038084166000908152603a602052604081208054919290918482169116148062005a2357600c820154604051631092ab9160e31b81526001600160a01b03868116600483015260009216906384955c8890602401602060405180830381865afa15801562005924573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906200594a919062008723565b905085811015620059b25760405162461bcd60e51b815260206004820152602b60248201527f696e73756666696369656e7420756e6c6f636b6564204c6971756964204e657760448201526a746f6e2062616c616e636560a81b6064820152608401620012a6565b600c83015460405163282d3fdf60e01b81526001600160a01b038781166004830152602482018990529091169063282d3fdf90604401600060405180830381600087803b15801562005a0357600080fd5b505af115801562005a18573d6000803e3d6000fd5b505050505062005ab6565b8482600b0154836008015462005a3a919062008386565b101562005a9a5760405162461bcd60e51b815260206004820152602760248201527f696e73756666696369656e742073656c6620626f6e646564206e6577746f6e2060448201526662616c616e636560c81b6064820152608401620012a6565b8482600b01600082825462005ab091906200839c565b90915550505b604051806101200160405280856001600160a01b03168152602001876001600160a01b0316815260200186815260200160008152602001438152602001600081526020016000600381111562005b105762005b1062007b86565b815260006020808301829052841515604093840152600d548252600b815290829020835181546001600160a01b039182166001600160a01b03199182161783559285015160018381018054929093169190941617905591830151600283015560608301516003808401919091556080840151600484015560a0840151600584015560c08401516006840180549193909260ff1990921691849081111562005bbb5762005bbb62007b86565b021790555060e082015160069091018054610100938401511515620100000262ff0000199315159094029290921662ffff001990921691909117919091179055600d805490600062005c0d8362008a08565b9190505550836001600160a01b0316866001600160a01b03167f63f8870909f7c59c9c4932bf98dbd491647c8d2e89ca0a032aacdd943a13e2fc838860405162005c639291909115158252602082015260400190565b60405180910390a3833b1562005c7f5762005c7f848762006ca3565b6001600d54620058a1919062008386565b60fa60a06000808285855af462005cab573d6000803e3d6000fd5b505050565b81546002600180831615610100020382160482518082016020811060208410016002811462005d5f576001811462005d85578660005260208404602060002001600160028402018855602085068060200390508088018589016001836101000a0392508282511684540184556001840193506020820191505b8082101562005d48578151845560018401935060208201915062005d29565b815191036101000a90819004029091555062005dfa565b60028302826020036101000a846020036101000a60208901510402018501875562005dfa565b8660005260208404602060002001600160028402018855846020038088018589016001836101000a0392508282511660ff198a160184556020820191506001840193505b8082101562005de8578151845560018401935060208201915062005dc9565b815191036101000a9081900402909155505b50505050505050565b600081815260086020908152604080832060018101546001600160a01b03168452603a90925282209091601382015460ff16600381111562005e495762005e4962007b86565b14158062005e70575081546001600160a01b03166000908152603660205260409020546001145b1562005f3057600282015482546001600160a01b03166000908152603960205260408120805490919062005ea69084906200839c565b909155505081546001830154600284015460138401546040516001600160a01b0394851694909316927f1ff2b052afa4bb37ce30d9aaccde416a700b97e632d089111749af937f8783429262005f0492909160ff9091169062008ab8565b60405180910390a38054825462005cab9185916000916001600160a01b03918216911614600162006da8565b805482546001600160a01b0390811691161462006054576000808260080154836005015462005f60919062008386565b90508060000362005f78578360020154915062005f9e565b80846002015484600d015462005f8f919062008a24565b62005f9b9190620088fd565b91505b600c83015484546040516340c10f1960e01b81526001600160a01b039182166004820152602481018590529116906340c10f1990604401600060405180830381600087803b15801562005ff057600080fd5b505af115801562006005573d6000803e3d6000fd5b505050508183600d0160008282546200601f91906200839c565b909155505060028401546005840180546000906200603f9084906200839c565b9091555062001b089050858360008062006da8565b81600201548160080160008282546200606e91906200839c565b909155505060028201546005820180546000906200608e9084906200839c565b9091555062005cab905083600060018162006da8565b6000818152600b6020908152604080832080546001600160a01b0316845260369092529091205460010362006146578054600182015460068301546002840154604080516201000090930460ff161515835260208301919091526001600160a01b0393841693909216917fec3ccfdbfc982a0ea188f4fd85459da1d6a7b332ddc4ca6b7a774c1abfc75866910160405180910390a362001a0182600162006f31565b60018101546001600160a01b03166000908152603a60205260408120600683015490919062010000900460ff166200632e576002830154600c8301548454604051637eee288d60e01b81526001600160a01b03918216600482015260248101849052911690637eee288d90604401600060405180830381600087803b158015620061cf57600080fd5b505af1158015620061e4573d6000803e3d6000fd5b50505050600c8301548454604051632770a7eb60e21b81526001600160a01b03918216600482015260248101849052911690639dc29fac90604401600060405180830381600087803b1580156200623a57600080fd5b505af11580156200624f573d6000803e3d6000fd5b505050506000836008015484600501546200626b919062008386565b600d8501549091506200627f828462008a24565b6200628b9190620088fd565b92508184600d016000828254620062a3919062008386565b90915550506006840154600003620062c25760038501839055620062ea565b60068401546007850154620062d8908562008a24565b620062e49190620088fd565b60038601555b828460060160008282546200630091906200839c565b90915550506003850154600785018054600090620063209084906200839c565b909155506200640392505050565b506002820154600882015481111562006348575060088101545b81600901546000036200636257600383018190556200638a565b6009820154600a83015462006378908362008a24565b620063849190620088fd565b60038401555b80826009016000828254620063a091906200839c565b90915550506003830154600a83018054600090620063c09084906200839c565b9250508190555080826008016000828254620063dd919062008386565b90915550506002830154600b83018054600090620063fd90849062008386565b90915550505b60068301805461ff0019166101001790556005820180548291906000906200642d90849062008386565b90915550620019c2905084600062006f31565b6000818152600b602052604090206006810154610100900460ff166200647e5760068101805460ff1916600217905562001a018260006001620070a4565b60068101805460ff191660011790556003810154600003620064a85762001a0182600080620070a4565b60018101546001600160a01b03166000908152603a60205260408120600683015490919062010000900460ff166200654557816007015482600601548460030154620064f5919062008a24565b620065019190620088fd565b90508082600601600082825462006519919062008386565b909155505060038301546007830180546000906200653990849062008386565b90915550620065aa9050565b81600a01548260090154846003015462006560919062008a24565b6200656c9190620088fd565b90508082600901600082825462006584919062008386565b90915550506003830154600a83018054600090620065a490849062008386565b90915550505b82546001600160a01b031660009081526039602052604081208054839290620065d59084906200839c565b90915550620019c2905084826000620070a4565b6000620065fa8260600151620051f5565b6001600160a01b039091166020840152905080156200664a5760405162461bcd60e51b815260206004820152600b60248201526a32b737b2329032b93937b960a91b6044820152606401620012a6565b6020808301516001600160a01b039081166000908152603a9092526040909120600101541615620066be5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c72656164792072656769737465726564000000006044820152606401620012a6565b6127108260800151111562001a015760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e20726174650000000000000000006044820152606401620012a6565b6060816000036200673e5750506040805180820190915260018152600360fc1b602082015290565b8160005b81156200676e5780620067558162008a08565b9150620067669050600a83620088fd565b915062006742565b60008167ffffffffffffffff8111156200678c576200678c62007e83565b6040519080825280601f01601f191660200182016040528015620067b7576020820181803683370190505b5090505b84156200682f57620067cf60018362008386565b9150620067de600a8662008ad7565b620067eb9060306200839c565b60f81b81838151811062006803576200680362008914565b60200101906001600160f81b031916908160001a90535062006827600a86620088fd565b9450620067bb565b949350505050565b6060816200684781601f6200839c565b1015620068885760405162461bcd60e51b815260206004820152600e60248201526d736c6963655f6f766572666c6f7760901b6044820152606401620012a6565b6200689482846200839c565b84511015620068da5760405162461bcd60e51b8152602060048201526011602482015270736c6963655f6f75744f66426f756e647360781b6044820152606401620012a6565b606082158015620068fb576040519150600082526020820160405262006947565b6040519150601f8416801560200281840101858101878315602002848b0101015b81831015620069365780518352602092830192016200691c565b5050858452601f01601f1916604052505b50949350505050565b8181018051602082015160409092015190919060001a601b81101562006980576200697d601b8262008aee565b90505b9250925092565b60006200699362007a2f565b6000858585604051602001620069ac9392919062008b0a565b6040516020818303038152906040529050600060fb9050600082516020620069d591906200839c565b90506020848285855afa620069e957600080fd5b5050905195945050505050565b6101808101516001600160a01b031662006af557603e546001600160a01b031662006a6f5760405162461bcd60e51b815260206004820152602260248201527f6c6971756964206c6f67696320636f6e7472616374206e6f74206465706c6f79604482015261195960f21b6064820152608401620012a6565b60255460009062006a809062006716565b905081602001518260000151836080015183603e60009054906101000a90046001600160a01b031660405162006ab69062007a4d565b62006ac695949392919062008b59565b604051809103906000f08015801562006ae3573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051602580546001808201835560009283527f401968ff42a154441da5f6c4c935ac46b8671f0e062baaa62a7545ba53bb6e4c90910180546001600160a01b03199081166001600160a01b0395861617909155845184168352603a9095526040918290208651815487169085161781559351908401805486169184169190911790558401516002830180549094169116179091556060820151829190600382019062006ba79082620087d5565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c820180546001600160a01b0319166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f82015561020082015160108201556102208201516011820155610240820151601282019062006c719082620087d5565b5061026082015160138201805460ff1916600183600381111562006c995762006c9962007b86565b0217905550505050565b6001600160a01b038216600090815260346020526040812054900362006d21576001600160a01b03821660008181526034602052604081206001908190556035805491820181559091527fcfa4bec1d3298408bb5afcfcd9c430549c5b31f8aa5c5848151c0a55f473c34d0180546001600160a01b03191690911790555b6001600160a01b038083166000908152603260209081526040808320938516835292905290812054900362001a01576001600160a01b03808316600081815260326020908152604080832094861680845294825280832060019081905593835260338252822080549384018155825290200180546001600160a01b03191690911790555050565b600084815260086020526040902080546001600160a01b0316803b62006dd0575050620019c2565b6001600160a01b03811660009081526038602052604090205460045481111562006df957506004545b60005a6001850154604051634efe8dc760e11b8152600481018b90526001600160a01b039182166024820152604481018a905288151560648201528715156084820152919250841690639dfd1b8e90849060a401600060405180830381600088803b15801562006e6857600080fd5b5087f19350505050801562006e7b575060015b62006ea6575a62006e8d908262008386565b90508462006ea05762006ea08862007214565b62006eb6565b5a62006eb3908262008386565b90505b6001600160a01b03831660009081526038602052604090205481101562006f0d576001600160a01b0383166000908152603860205260408120805483929062006f0190849062008386565b9091555062006f279050565b6001600160a01b0383166000908152603860205260408120555b5050505050505050565b6000828152600b6020526040902080546001600160a01b0316803b62006f575750505050565b6001600160a01b03811660009081526038602052604090205460055481111562006f8057506005545b60005a600185015460405163a892024160e01b8152600481018990526001600160a01b039182166024820152871515604482015291925084169063a8920241908490606401600060405180830381600088803b15801562006fe057600080fd5b5087f19350505050801562006ff3575060015b6200701e575a62007005908262008386565b90508462007018576200701886620073db565b6200702e565b5a6200702b908262008386565b90505b6001600160a01b03831660009081526038602052604090205481101562007085576001600160a01b038316600090815260386020526040812080548392906200707990849062008386565b90915550620027c69050565b50506001600160a01b0316600090815260386020526040812055505050565b6000838152600b6020526040902080546001600160a01b0316803b620070cb575050505050565b6001600160a01b038116600090815260386020526040902054600654811115620070f457506006545b60005a6040516303c54c2960e41b8152600481018990526024810188905286151560448201529091506001600160a01b03841690633c54c290908490606401600060405180830381600088803b1580156200714e57600080fd5b5087f19350505050801562007161575060015b6200718d575a62007173908262008386565b905084620071875762007187878762007631565b6200719d565b5a6200719a908262008386565b90505b6001600160a01b038316600090815260386020526040902054811015620071f4576001600160a01b03831660009081526038602052604081208054839290620071e890849062008386565b9091555062005dfa9050565b50506001600160a01b031660009081526038602052604081205550505050565b600081815260086020908152604080832060018101546001600160a01b039081168552603a9093529220805483549192918216911614620073195760008160080154826005015462007267919062008386565b836002015483600d01546200727d919062008a24565b620072899190620088fd565b600c8301548454604051632770a7eb60e21b81526001600160a01b039182166004820152602481018490529293501690639dc29fac90604401600060405180830381600087803b158015620072dd57600080fd5b505af1158015620072f2573d6000803e3d6000fd5b505050508082600d0160008282546200730c919062008386565b9091555062007339915050565b816002015481600801600082825462007333919062008386565b90915550505b600282015482546001600160a01b031660009081526039602052604081208054909190620073699084906200839c565b909155505060028201546005820180546000906200738990849062008386565b90915550508154600183015460028401546040519081526001600160a01b0392831692909116907f2d2530c45d577d38d6fc598e41ade6fb0bfbde20d09244c88348d3a2797a9d9090602001620042fd565b6000818152600b6020908152604080832060018101546001600160a01b03168452603a909252822060068201549192909162010000900460ff1662007511576002830154600c83015484546040516340c10f1960e01b81526001600160a01b039182166004820152602481018490529116906340c10f1990604401600060405180830381600087803b1580156200747157600080fd5b505af115801562007486573d6000803e3d6000fd5b505050508083600d016000828254620074a091906200839c565b9091555050600783015460068401546003860154620074c0919062008a24565b620074cc9190620088fd565b915081836006016000828254620074e4919062008386565b909155505060038401546007840180546000906200750490849062008386565b9091555062007593915050565b81600a0154826009015484600301546200752c919062008a24565b620075389190620088fd565b90508082600901600082825462007550919062008386565b90915550506003830154600a830180546000906200757090849062008386565b92505081905550808260080160008282546200758d91906200839c565b90915550505b60006003840181905560068401805461ff0019169055600583018054839290620075bf9084906200839c565b90915550508254600184015460068501546002860154604080516201000090930460ff161515835260208301919091526001600160a01b0393841693909216917f52c1b482483796c697edd43958bc63e332069083ea38782d960693145db2cf0c91015b60405180910390a350505050565b6000828152600b6020526040812090829003620076b557600681018054600360ff19909116179081905581546001830154604080516201000090940460ff1615158452602084018690526001600160a01b039283169392909116917f0a4bfc4c4e35975ab1fe5fd21d963e8bb8d483f04aebdbc0a059b246bec3f5fc9101620042fd565b60018101546001600160a01b03166000908152603a60205260409020600682015462010000900460ff16620077c55760008082600801548360050154620076fd919062008386565b905080600003620077115784915062007733565b808584600d015462007724919062008a24565b620077309190620088fd565b91505b600c83015484546040516340c10f1960e01b81526001600160a01b039182166004820152602481018590529116906340c10f1990604401600060405180830381600087803b1580156200778557600080fd5b505af11580156200779a573d6000803e3d6000fd5b505050508183600d016000828254620077b491906200839c565b9091555050506005830155620077ea565b82826005018190555082816008016000828254620077e491906200839c565b90915550505b60068201805460ff1916600317905581546001600160a01b0316600090815260396020526040812080548592906200782490849062008386565b92505081905550828160050160008282546200784191906200839c565b9091555050815460018301546006840154604080516201000090920460ff1615158252602082018790526001600160a01b0393841693909216917f0a4bfc4c4e35975ab1fe5fd21d963e8bb8d483f04aebdbc0a059b246bec3f5fc910162007623565b60405180610280016040528060006001600160a01b0316815260200160006001600160a01b0316815260200160006001600160a01b0316815260200160608152602001600081526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b03168152602001600081526020016000815260200160008152602001600081526020016000815260200160608152602001600060038111156200796f576200796f62007b86565b905290565b6040518060a001604052806005906020820280368337509192915050565b508054600082559060005260206000209081019062001300919062007a5b565b508054620079c09062008334565b6000825580601f10620079d1575050565b601f01602090049060005260206000209081019062001300919062007a80565b508054600082559060005260206000209081019062001300919062007a80565b60405180604001604052806002906020820280368337509192915050565b60405180602001604052806001906020820280368337509192915050565b6105c28062008b8c83390190565b8082111562007a7c57600062007a728282620079b2565b5060010162007a5b565b5090565b5b8082111562007a7c576000815560010162007a81565b60005b8381101562007ab457818101518382015260200162007a9a565b50506000910152565b6000815180845262007ad781602086016020860162007a97565b601f01601f19169290920160200192915050565b60208152600062007b00602083018462007abd565b9392505050565b6001600160a01b03811681146200130057600080fd5b6000806040838503121562007b3157600080fd5b823562007b3e8162007b07565b946020939093013593505050565b60006020828403121562007b5f57600080fd5b813562007b008162007b07565b60006020828403121562007b7f57600080fd5b5035919050565b634e487b7160e01b600052602160045260246000fd5b600481106200130057634e487b7160e01b600052602160045260246000fd5b62007bc68162007b9c565b9052565b6020815262007be56020820183516001600160a01b03169052565b6000602083015162007c0260408401826001600160a01b03169052565b5060408301516001600160a01b038116606084015250606083015161028080608085015262007c366102a085018362007abd565b9150608085015160a085015260a085015160c085015260c085015160e085015260e08501516101008181870152808701519150506101208181870152808701519150506101408181870152808701519150506101608181870152808701519150506101808181870152808701519150506101a062007cbe818701836001600160a01b03169052565b8601516101c0868101919091528601516101e080870191909152860151610200808701919091528601516102208087019190915286015161024080870191909152860151858403601f19016102608088019190915290915062007d22848362007abd565b93508087015191505062007d398286018262007bbb565b5090949350505050565b60008060006060848603121562007d5957600080fd5b833562007d668162007b07565b9250602084013562007d788162007b07565b929592945050506040919091013590565b60006020828403121562007d9c57600080fd5b813567ffffffffffffffff81111562007db457600080fd5b8201610280818503121562007b0057600080fd5b600081518084526020808501808196508360051b8101915082860160005b8581101562007e3d578284038952815180516001600160a01b03168552858101518686015260409081015160609186018290529062007e288187018362007abd565b9a87019a955050509084019060010162007de6565b5091979650505050505050565b8515158152841515602082015260a06040820152600062007e6f60a083018662007dc8565b606083019490945250608001529392505050565b634e487b7160e01b600052604160045260246000fd5b600082601f83011262007eab57600080fd5b813567ffffffffffffffff8082111562007ec95762007ec962007e83565b604051601f8301601f19908116603f0116810190828211818310171562007ef45762007ef462007e83565b8160405283815286602085880101111562007f0e57600080fd5b836020870160208301376000602085830101528094505050505092915050565b6000806040838503121562007f4257600080fd5b823562007f4f8162007b07565b9150602083013567ffffffffffffffff81111562007f6c57600080fd5b62007f7a8582860162007e99565b9150509250929050565b6000806000806080858703121562007f9b57600080fd5b843567ffffffffffffffff8082111562007fb457600080fd5b62007fc28883890162007e99565b95506020870135915062007fd68262007b07565b9093506040860135908082111562007fed57600080fd5b62007ffb8883890162007e99565b935060608701359150808211156200801257600080fd5b50620080218782880162007e99565b91505092959194509250565b6000602080830181845280855180835260408601915060408160051b870101925083870160005b828110156200808657603f198886030184526200807385835162007abd565b9450928501929085019060010162008054565b5092979650505050505050565b60208101620080a28362007b9c565b91905290565b608081526000620080bd608083018762007dc8565b6020830195909552506040810192909252606090910152919050565b60208152600062007b00602083018462007dc8565b600081518084526020808501945080840160005b83811015620081295781516001600160a01b03168752958201959082019060010162008102565b509495945050505050565b604081526000620081496040830185620080ee565b82810360208401526200815d8185620080ee565b95945050505050565b600080604083850312156200817a57600080fd5b823567ffffffffffffffff808211156200819357600080fd5b620081a18683870162007e99565b93506020850135915080821115620081b857600080fd5b5062007f7a8582860162007e99565b604081526000620081dc604083018562007abd565b82810360208401526200815d818562007abd565b60208152600062007b006020830184620080ee565b600080604083850312156200821957600080fd5b8235620082268162007b07565b91506020830135620082388162007b07565b809150509250929050565b6020808252601c908201527f76616c696461746f72206d757374206265207265676973746572656400000000604082015260600190565b6020808252602c908201527f726571756972652063616c6c657220746f2062652076616c696461746f72206160408201526b191b5a5b881858d8dbdd5b9d60a21b606082015260800190565b6020808252601a908201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604082015260600190565b60208082526018908201527f76616c696461746f72206e6f7420726567697374657265640000000000000000604082015260600190565b600181811c908216806200834957607f821691505b6020821081036200836a57634e487b7160e01b600052602260045260246000fd5b50919050565b634e487b7160e01b600052601160045260246000fd5b8181038181111562001256576200125662008370565b8082018082111562001256576200125662008370565b60008135620012568162007b07565b80546001600160a01b0319166001600160a01b0392909216919091179055565b6000808335601e19843603018112620083f957600080fd5b83018035915067ffffffffffffffff8211156200841557600080fd5b6020019150368190038213156200842b57600080fd5b9250929050565b601f82111562005cab57600081815260208120601f850160051c810160208610156200845b5750805b601f850160051c820191505b81811015620027c65782815560010162008467565b600019600383901b1c191660019190911b1790565b67ffffffffffffffff831115620084ac57620084ac62007e83565b620084c483620084bd835462008334565b8362008432565b6000601f841160018114620084f75760008515620084e25750838201355b620084ee86826200847c565b84555062001b08565b600083815260209020601f19861690835b828110156200852a578685013582556020948501946001909201910162008508565b5086821015620085485760001960f88860031b161c19848701351681555b505060018560011b0183555050505050565b60008135600481106200125657600080fd5b620085778262007b9c565b60ff1981541660ff831681178255505050565b620085a06200859983620083b2565b82620083c1565b620085bc620085b260208401620083b2565b60018301620083c1565b620085d8620085ce60408401620083b2565b60028301620083c1565b620085e76060830183620083e1565b620085f781836003860162008491565b50506080820135600482015560a0820135600582015560c0820135600682015560e0820135600782015561010082013560088201556101208201356009820155610140820135600a820155610160820135600b8201556200866a620086606101808401620083b2565b600c8301620083c1565b6101a0820135600d8201556101c0820135600e8201556101e0820135600f82015561020082013560108201556102208201356011820155620086b1610240830183620083e1565b620086c181836012860162008491565b505062001a01620086d661026084016200855a565b601383016200856c565b60208082526023908201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60408201526218dbdb60ea1b606082015260800190565b6000602082840312156200873657600080fd5b5051919050565b600080604083850312156200875157600080fd5b505080516020909101519092909150565b6000602082840312156200877557600080fd5b8151801515811462007b0057600080fd5b6020808252602f908201527f726571756972652063616c6c657220746f2062652076616c696461746f72207460408201526e1c99585cdd5c9e481858d8dbdd5b9d608a1b606082015260800190565b815167ffffffffffffffff811115620087f257620087f262007e83565b6200880a8162008803845462008334565b8462008432565b602080601f8311600181146200883e5760008415620088295750858301515b6200883585826200847c565b865550620027c6565b600085815260208120601f198616915b828110156200886f578886015182559484019460019091019084016200884e565b50858210156200888e5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b600060018060a01b0380881683528087166020840152808616604084015260a06060840152620088d260a084018662007abd565b91508084166080840152509695505050505050565b634e487b7160e01b600052601260045260246000fd5b6000826200890f576200890f620088e7565b500490565b634e487b7160e01b600052603260045260246000fd5b81810362008936575050565b62008942825462008334565b67ffffffffffffffff8111156200895d576200895d62007e83565b6200896e8162008803845462008334565b6000601f821160018114620089a157600083156200898c5750848201545b6200899884826200847c565b85555062001b08565b600085815260209020601f19841690600086815260209020845b83811015620089dd5782860154825560019586019590910190602001620089bb565b50858310156200888e5793015460001960f8600387901b161c19169092555050600190811b01905550565b60006001820162008a1d5762008a1d62008370565b5060010190565b808202811582820484141762001256576200125662008370565b60018060a01b038316815260606020820152600060608201526080604082015260006200682f608083018462007abd565b6000845162008a8381846020890162007a97565b84519083019062008a9981836020890162007a97565b845191019062008aae81836020880162007a97565b0195945050505050565b8281526040810162008aca8362007b9c565b8260208301529392505050565b60008262008ae95762008ae9620088e7565b500690565b60ff818116838216019081111562001256576200125662008370565b6000845162008b1e81846020890162007a97565b84519083019062008b3481836020890162007a97565b60609490941b6bffffffffffffffffffffffff19169301928352505060140192915050565b600060018060a01b038088168352808716602084015285604084015260a06060840152620088d260a084018662007abd56fe608060405234801561001057600080fd5b506040516105c23803806105c283398101604081905261002f916101af565b806001600160a01b0316632f2c3f2e6040518163ffffffff1660e01b8152600401602060405180830381865afa15801561006d573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610091919061029f565b83111561009d57600080fd5b600c80546001600160a01b038088166001600160a01b031992831617909255600d805492871692909116919091179055600e8390556040516100e39083906020016102b8565b604051602081830303815290604052600a9081610100919061036e565b508160405160200161011291906102b8565b604051602081830303815290604052600b908161012f919061036e565b5050601080546001600160a01b031916331790555061042d92505050565b6001600160a01b038116811461016257600080fd5b50565b80516101708161014d565b919050565b634e487b7160e01b600052604160045260246000fd5b60005b838110156101a657818101518382015260200161018e565b50506000910152565b600080600080600060a086880312156101c757600080fd5b85516101d28161014d565b60208701519095506101e38161014d565b6040870151606088015191955093506001600160401b038082111561020757600080fd5b818801915088601f83011261021b57600080fd5b81518181111561022d5761022d610175565b604051601f8201601f19908116603f0116810190838211818310171561025557610255610175565b816040528281528b602084870101111561026e57600080fd5b61027f83602083016020880161018b565b809650505050505061029360808701610165565b90509295509295909350565b6000602082840312156102b157600080fd5b5051919050565b644c4e544e2d60d81b8152600082516102d881600585016020870161018b565b9190910160050192915050565b600181811c908216806102f957607f821691505b60208210810361031957634e487b7160e01b600052602260045260246000fd5b50919050565b601f82111561036957600081815260208120601f850160051c810160208610156103465750805b601f850160051c820191505b8181101561036557828155600101610352565b5050505b505050565b81516001600160401b0381111561038757610387610175565b61039b8161039584546102e5565b8461031f565b602080601f8311600181146103d057600084156103b85750858301515b600019600386901b1c1916600185901b178555610365565b600085815260208120601f198616915b828110156103ff578886015182559484019460019091019084016103e0565b508582101561041d5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b6101868061043c6000396000f3fe60806040523661001b57610019610014610022565b6100fd565b005b6100196100145b600080601060009054906101000a90046001600160a01b03166001600160a01b03166396f18a326040518163ffffffff1660e01b8152600401602060405180830381865afa158015610078573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061009c9190610120565b90506001600160a01b0381166100f85760405162461bcd60e51b815260206004820152601d60248201527f6c6971756964206c6f67696320636f6e7472616374206e6f7420736574000000604482015260640160405180910390fd5b919050565b3660008037600080366000845af43d6000803e8061011a573d6000fd5b503d6000f35b60006020828403121561013257600080fd5b81516001600160a01b038116811461014957600080fd5b939250505056fea2646970667358221220c84647d3d92939f0d0c4b8cb8870025e67a96efe3618fd5883fa09e82290991164736f6c63430008150033a2646970667358221220a5731d83ab94d278759ead53bfeffe61b084ad097b07b24dd04dd6d619d6f23964736f6c63430008150033608060405234801561001057600080fd5b50601080546001600160a01b03191633179055611702806100326000396000f3fe6080604052600436106101855760003560e01c80633e4eb36c116100d1578063949813b81161008a578063a0ce552d11610064578063a0ce552d146104d5578063a9059cbb146104e8578063bd96102f14610508578063dd62ed3e1461051d5761018c565b8063949813b81461046b57806395d89b41146104a05780639dc29fac146104b55761018c565b80633e4eb36c1461038a57806340c10f191461039f57806359355736146103bf57806370a08231146103f55780637eee288d1461042b57806384955c881461044b5761018c565b80631eeffad01161013e5780632f2c3f2e116101185780632f2c3f2e14610325578063313ce5671461033b578063372500ab146103575780633b19e84a1461036c5761018c565b80631eeffad0146102d057806323b872dd146102e5578063282d3fdf146103055761018c565b806306fdde03146101ea578063095ea7b3146102155780631195e07e1461024557806318160ddd14610277578063187cf4d71461029657806319fac8fd146102ae5761018c565b3661018c57005b60405162461bcd60e51b815260206004820152602860248201527f66616c6c6261636b206e6f7420696d706c656d656e74656420666f72204c69716044820152677569644c6f67696360c01b60648201526084015b60405180910390fd5b3480156101f657600080fd5b506101ff610563565b60405161020c919061145c565b60405180910390f35b34801561022157600080fd5b506102356102303660046114c6565b6105f5565b604051901515815260200161020c565b34801561025157600080fd5b50600c546001600160a01b03165b6040516001600160a01b03909116815260200161020c565b34801561028357600080fd5b506003545b60405190815260200161020c565b3480156102a257600080fd5b50610288633b9aca0081565b3480156102ba57600080fd5b506102ce6102c93660046114f0565b61060c565b005b3480156102dc57600080fd5b50600f54610288565b3480156102f157600080fd5b50610235610300366004611509565b61063b565b34801561031157600080fd5b506102ce6103203660046114c6565b610730565b34801561033157600080fd5b5061028861271081565b34801561034757600080fd5b506040516012815260200161020c565b34801561036357600080fd5b506102ce610814565b34801561037857600080fd5b50600d546001600160a01b031661025f565b34801561039657600080fd5b50600e54610288565b3480156103ab57600080fd5b506102ce6103ba3660046114c6565b6109f5565b3480156103cb57600080fd5b506102886103da366004611545565b6001600160a01b031660009081526001602052604090205490565b34801561040157600080fd5b50610288610410366004611545565b6001600160a01b031660009081526020819052604090205490565b34801561043757600080fd5b506102ce6104463660046114c6565b610a5d565b34801561045757600080fd5b50610288610466366004611545565b610b23565b34801561047757600080fd5b5061048b610486366004611545565b610b50565b6040805192835260208301919091520161020c565b3480156104ac57600080fd5b506101ff610c08565b3480156104c157600080fd5b506102ce6104d03660046114c6565b610c17565b61048b6104e33660046114f0565b610c77565b3480156104f457600080fd5b506102356105033660046114c6565b610f6b565b34801561051457600080fd5b506102ce610fb8565b34801561052957600080fd5b50610288610538366004611560565b6001600160a01b03918216600090815260026020908152604080832093909416825291909152205490565b6060600a805461057290611593565b80601f016020809104026020016040519081016040528092919081815260200182805461059e90611593565b80156105eb5780601f106105c0576101008083540402835291602001916105eb565b820191906000526020600020905b8154815290600101906020018083116105ce57829003601f168201915b5050505050905090565b60006106023384846110bf565b5060015b92915050565b6010546001600160a01b031633146106365760405162461bcd60e51b81526004016101e1906115cd565b600e55565b6001600160a01b0383166000908152600260209081526040808320338452909152812054828110156106c05760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e74206578636565647320616044820152676c6c6f77616e636560c01b60648201526084016101e1565b6106d485336106cf868561162b565b6110bf565b6106de85846111e3565b6106e884846112d7565b836001600160a01b0316856001600160a01b03166000805160206116ad8339815191528560405161071b91815260200190565b60405180910390a360019150505b9392505050565b6010546001600160a01b0316331461075a5760405162461bcd60e51b81526004016101e1906115cd565b6001600160a01b038216600090815260016020908152604080832054918390529091205482916107899161162b565b10156107e35760405162461bcd60e51b8152602060048201526024808201527f63616e2774206c6f636b206d6f72652066756e6473207468616e20617661696c60448201526361626c6560e01b60648201526084016101e1565b6001600160a01b0382166000908152600160205260408120805483929061080b90849061163e565b90915550505050565b60008061082033611323565b33600090815260046020908152604080832083905560079091528120819055919350915081156109035760105460405163a9059cbb60e01b8152336004820152602481018490526001600160a01b039091169063a9059cbb906044016020604051808303816000875af115801561089b573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906108bf9190611651565b9050806109035760405162461bcd60e51b81526020600482015260126024820152712330b4b632b2103a379039b2b73210272a2760711b60448201526064016101e1565b333b1561096357336001600160a01b031663161605e3846040518263ffffffff1660e01b81526004016000604051808303818588803b15801561094557600080fd5b505af1158015610959573d6000803e3d6000fd5b5050505050505050565b60405133908490600081818185875af1925050503d80600081146109a3576040519150601f19603f3d011682016040523d82523d6000602084013e6109a8565b606091505b505080915050806109f05760405162461bcd60e51b81526020600482015260126024820152712330b4b632b2103a379039b2b7321020aa2760711b60448201526064016101e1565b505050565b6010546001600160a01b03163314610a1f5760405162461bcd60e51b81526004016101e1906115cd565b610a2982826112d7565b6040518181526001600160a01b038316906000906000805160206116ad833981519152906020015b60405180910390a35050565b6010546001600160a01b03163314610a875760405162461bcd60e51b81526004016101e1906115cd565b6001600160a01b038216600090815260016020526040902054811115610afb5760405162461bcd60e51b815260206004820152602360248201527f63616e277420756e6c6f636b206d6f72652066756e6473207468616e206c6f636044820152621ad95960ea1b60648201526084016101e1565b6001600160a01b0382166000908152600160205260408120805483929061080b90849061162b565b6001600160a01b03811660009081526001602090815260408083205491839052822054610606919061162b565b6001600160a01b0381166000908152602081815260408083205460065460059093529083205483928391610b8591849161141e565b6001600160a01b038616600090815260046020526040902054909150610bac90829061163e565b6009546001600160a01b03871660009081526008602052604081205492965091610bd791859161141e565b6001600160a01b038716600090815260076020526040902054909150610bfe90829061163e565b9350505050915091565b6060600b805461057290611593565b6010546001600160a01b03163314610c415760405162461bcd60e51b81526004016101e1906115cd565b610c4b82826111e3565b6040518181526000906001600160a01b038416906000805160206116ad83398151915290602001610a51565b60105460009081906001600160a01b03163314610ca65760405162461bcd60e51b81526004016101e1906115cd565b600e54349060009061271090610cbc9084611673565b610cc6919061168a565b905081811115610d185760405162461bcd60e51b815260206004820152601c60248201527f696e76616c69642061746e2076616c696461746f72207265776172640000000060448201526064016101e1565b610d22818361162b565b600d546040519193506000916001600160a01b03909116906108fc90849084818181858888f193505050503d8060008114610d79576040519150601f19603f3d011682016040523d82523d6000602084013e610d7e565b606091505b5090915050801515600003610da55781600f6000828254610d9f919061163e565b90915550505b6000612710600e5488610db89190611673565b610dc2919061168a565b905086811115610e145760405162461bcd60e51b815260206004820152601c60248201527f696e76616c6964206e746e2076616c696461746f72207265776172640000000060448201526064016101e1565b610e1e818861162b565b96508015610ea157601054600d5460405163a9059cbb60e01b81526001600160a01b0391821660048201526024810184905291169063a9059cbb906044016020604051808303816000875af1158015610e7b573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610e9f9190611651565b505b600354600090610eb5633b9aca0087611673565b610ebf919061168a565b905080600654610ecf919061163e565b600655600354600090610ee6633b9aca008b611673565b610ef0919061168a565b905080600954610f00919061163e565b600955600354600090633b9aca0090610f199085611673565b610f23919061168a565b90506000633b9aca0060035484610f3a9190611673565b610f44919061168a565b9050610f50828861163e565b610f5a828761163e565b995099505050505050505050915091565b6000610f7733836111e3565b610f8183836112d7565b6040518281526001600160a01b0384169033906000805160206116ad8339815191529060200160405180910390a350600192915050565b600d546001600160a01b0316331461101d5760405162461bcd60e51b815260206004820152602260248201527f6f6e6c792074726561737572792063616e20636c61696d2068697320726577616044820152611c9960f21b60648201526084016101e1565b600d54600f546040516000926001600160a01b031691908381818185875af1925050503d806000811461106c576040519150601f19603f3d011682016040523d82523d6000602084013e611071565b606091505b50509050806110b75760405162461bcd60e51b81526020600482015260126024820152713330b4b632b2103a379039b2b7321020aa2760711b60448201526064016101e1565b506000600f55565b6001600160a01b0383166111215760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f206164646044820152637265737360e01b60648201526084016101e1565b6001600160a01b0382166111825760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f206164647265604482015261737360f01b60648201526084016101e1565b6001600160a01b0383811660008181526002602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b6111ec82611323565b50506001600160a01b0382166000908152602081815260408083205460019092529091205461121b908261162b565b82111561126a5760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e7420756e6c6f636b65642066756e6473000000000060448201526064016101e1565b611274828261162b565b6001600160a01b0384166000908152602081905260409020558082036112bb576001600160a01b038316600090815260056020908152604080832083905560089091528120555b81600360008282546112cd919061162b565b9091555050505050565b6112e082611323565b50506001600160a01b0382166000908152602081905260408120805483929061130a90849061163e565b92505081905550806003600082825461080b919061163e565b6001600160a01b038116600090815260208181526040808320546006546005909352908320548392839161135891849161141e565b6001600160a01b03861660009081526004602052604090205490915061137f90829061163e565b6001600160a01b03861660009081526004602090815260408083208490556006546005835281842055600954600890925282205492965090916113c391859161141e565b6001600160a01b0387166000908152600760205260409020549091506113ea90829061163e565b6001600160a01b03909616600090815260076020908152604080832089905560095460089092529091205550929492505050565b60008360000361143057506000610729565b633b9aca0084611440848661162b565b61144a9190611673565b611454919061168a565b949350505050565b600060208083528351808285015260005b818110156114895785810183015185820160400152820161146d565b506000604082860101526040601f19601f8301168501019250505092915050565b80356001600160a01b03811681146114c157600080fd5b919050565b600080604083850312156114d957600080fd5b6114e2836114aa565b946020939093013593505050565b60006020828403121561150257600080fd5b5035919050565b60008060006060848603121561151e57600080fd5b611527846114aa565b9250611535602085016114aa565b9150604084013590509250925092565b60006020828403121561155757600080fd5b610729826114aa565b6000806040838503121561157357600080fd5b61157c836114aa565b915061158a602084016114aa565b90509250929050565b600181811c908216806115a757607f821691505b6020821081036115c757634e487b7160e01b600052602260045260246000fd5b50919050565b60208082526028908201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060408201526710dbdb9d1c9858dd60c21b606082015260800190565b634e487b7160e01b600052601160045260246000fd5b8181038181111561060657610606611615565b8082018082111561060657610606611615565b60006020828403121561166357600080fd5b8151801515811461072957600080fd5b808202811582820484141761060657610606611615565b6000826116a757634e487b7160e01b600052601260045260246000fd5b50049056feddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3efa264697066735822122048794c7bb1d2b72d7f520e80a6d347ea4d627cc772c643cc5a10c495e833a56d64736f6c63430008150033608060405234801561001057600080fd5b506040516105c23803806105c283398101604081905261002f916101af565b806001600160a01b0316632f2c3f2e6040518163ffffffff1660e01b8152600401602060405180830381865afa15801561006d573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610091919061029f565b83111561009d57600080fd5b600c80546001600160a01b038088166001600160a01b031992831617909255600d805492871692909116919091179055600e8390556040516100e39083906020016102b8565b604051602081830303815290604052600a9081610100919061036e565b508160405160200161011291906102b8565b604051602081830303815290604052600b908161012f919061036e565b5050601080546001600160a01b031916331790555061042d92505050565b6001600160a01b038116811461016257600080fd5b50565b80516101708161014d565b919050565b634e487b7160e01b600052604160045260246000fd5b60005b838110156101a657818101518382015260200161018e565b50506000910152565b600080600080600060a086880312156101c757600080fd5b85516101d28161014d565b60208701519095506101e38161014d565b6040870151606088015191955093506001600160401b038082111561020757600080fd5b818801915088601f83011261021b57600080fd5b81518181111561022d5761022d610175565b604051601f8201601f19908116603f0116810190838211818310171561025557610255610175565b816040528281528b602084870101111561026e57600080fd5b61027f83602083016020880161018b565b809650505050505061029360808701610165565b90509295509295909350565b6000602082840312156102b157600080fd5b5051919050565b644c4e544e2d60d81b8152600082516102d881600585016020870161018b565b9190910160050192915050565b600181811c908216806102f957607f821691505b60208210810361031957634e487b7160e01b600052602260045260246000fd5b50919050565b601f82111561036957600081815260208120601f850160051c810160208610156103465750805b601f850160051c820191505b8181101561036557828155600101610352565b5050505b505050565b81516001600160401b0381111561038757610387610175565b61039b8161039584546102e5565b8461031f565b602080601f8311600181146103d057600084156103b85750858301515b600019600386901b1c1916600185901b178555610365565b600085815260208120601f198616915b828110156103ff578886015182559484019460019091019084016103e0565b508582101561041d5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b6101868061043c6000396000f3fe60806040523661001b57610019610014610022565b6100fd565b005b6100196100145b600080601060009054906101000a90046001600160a01b03166001600160a01b03166396f18a326040518163ffffffff1660e01b8152600401602060405180830381865afa158015610078573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061009c9190610120565b90506001600160a01b0381166100f85760405162461bcd60e51b815260206004820152601d60248201527f6c6971756964206c6f67696320636f6e7472616374206e6f7420736574000000604482015260640160405180910390fd5b919050565b3660008037600080366000845af43d6000803e8061011a573d6000fd5b503d6000f35b60006020828403121561013257600080fd5b81516001600160a01b038116811461014957600080fd5b939250505056fea2646970667358221220c84647d3d92939f0d0c4b8cb8870025e67a96efe3618fd5883fa09e82290991164736f6c63430008150033",
+	Bin: "0x608060405261c35060045561c35060055561c350600655614e2060075560006010556000601155633b9aca006037553480156200003b57600080fd5b50604080516000808252602082019092529062000071565b6200005d62001046565b815260200190600190039081620000535790505b506040805161014081018252601254608080830191825260135460a08085019190915260145460c08086019190915260155460e080870191909152601654610100808801919091526017546001600160a01b0390811661012089015295875287519081018852601854861681526019548616602082810191909152601a548716828a0152601b548716606080840191909152601c54881683880152601d54881695830195909552601e54871693820193909352601f5486169181019190915281860152855192830186528054909316825260215492820192909252602254818501526023548183015292820192909252602454918101829052906000036200019157603d80546001600160a01b03191633179055620001918282620001ae565b5050602454600103620001a857620001a862000667565b62001471565b80518051601255602080820151601355604080830151601455606080840151601555608080850151601681905560a095860151601780546001600160a01b03199081166001600160a01b03938416179091558689015180516018805484169185169190911790558088015160198054841691851691909117905580870151601a8054841691851691909117905580860151601b8054841691851691909117905593840151601c8054831691841691909117905596830151601d8054891691831691909117905560c0830151601e8054891691831691909117905560e090920151601f805488169184169190911790558387015180518654909716969092169590951784559283015160218190558383015160225592810151602355840151602455602891909155603c9190915551620002e79062001116565b604051809103906000f08015801562000304573d6000803e3d6000fd5b50603e80546001600160a01b0319166001600160a01b039290921691909117905560005b825181101562000662576000838281518110620003495762000349620011c3565b602002602001015160a001519050604e8483815181106200036e576200036e620011c3565b6020908102919091018101516102600151825460018101845560009384529282902091830490910180549192909160ff601f9092166101000a918202191690836003811115620003c257620003c2620011d9565b02179055506000848381518110620003de57620003de620011c3565b60200260200101516101a00181815250506000848381518110620004065762000406620011c3565b602002602001015161018001906001600160a01b031690816001600160a01b0316815250506000848381518110620004425762000442620011c3565b602002602001015160a00181815250506000848381518110620004695762000469620011c3565b60209081029190910101516101c001526014548451859084908110620004935762000493620011c3565b602002602001015160800181815250506000848381518110620004ba57620004ba620011c3565b602002602001015161026001906003811115620004db57620004db620011d9565b90816003811115620004f157620004f1620011d9565b8152505060008483815181106200050c576200050c620011c3565b60200260200101516101600181815250506200054a848381518110620005365762000536620011c3565b6020026020010151620007d860201b60201c565b62000577848381518110620005635762000563620011c3565b60200260200101516200090f60201b60201c565b80156200064c578060396000868581518110620005985762000598620011c3565b6020026020010151600001516001600160a01b03166001600160a01b031681526020019081526020016000206000828254620005d5919062001205565b9250508190555080603b6000828254620005f0919062001205565b925050819055506200064a848381518110620006105762000610620011c3565b60200260200101516020015182868581518110620006325762000632620011c3565b60200260200101516000015162000bbc60201b60201c565b505b5080620006598162001221565b91505062000328565b505050565b603d546001600160a01b03163314620006d35760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201526218dbdb60ea1b60648201526084015b60405180910390fd5b6002603a60006025600181548110620006f057620006f0620011c3565b60009182526020808320909101546001600160a01b03168352820192909252604001812060050180549091906200072990849062001253565b925050819055506002603a600060256001815481106200074d576200074d620011c3565b60009182526020808320909101546001600160a01b03168352820192909252604001812060080180549091906200078690849062001253565b90915550506002602455602080546001600160a01b031660009081526039909152604081206103e89055620007be9060019062001124565b620007cc6002600062001124565b6003805460ff19169055565b6000620007ef826060015162000dd360201b60201c565b6001600160a01b039091166020840152905080156200083f5760405162461bcd60e51b815260206004820152600b60248201526a32b737b2329032b93937b960a91b6044820152606401620006ca565b6020808301516001600160a01b039081166000908152603a9092526040909120600101541615620008b35760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c72656164792072656769737465726564000000006044820152606401620006ca565b612710826080015111156200090b5760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e20726174650000000000000000006044820152606401620006ca565b5050565b6101808101516001600160a01b031662000a0e57603e546001600160a01b0316620009885760405162461bcd60e51b815260206004820152602260248201527f6c6971756964206c6f67696320636f6e7472616374206e6f74206465706c6f79604482015261195960f21b6064820152608401620006ca565b602554600090620009999062000e21565b905081602001518260000151836080015183603e60009054906101000a90046001600160a01b0316604051620009cf9062001166565b620009df9594939291906200126a565b604051809103906000f080158015620009fc573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051602580546001808201835560009283527f401968ff42a154441da5f6c4c935ac46b8671f0e062baaa62a7545ba53bb6e4c90910180546001600160a01b03199081166001600160a01b0395861617909155845184168352603a9095526040918290208651815487169085161781559351908401805486169184169190911790558401516002830180549094169116179091556060820151829190600382019062000ac0908262001378565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c820180546001600160a01b0319166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f82015561020082015160108201556102208201516011820155610240820151601282019062000b8a908262001378565b5061026082015160138201805460ff1916600183600381111562000bb25762000bb2620011d9565b0217905550505050565b600080831162000c1b5760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201526269766560e81b6064820152608401620006ca565b6001600160a01b03821660009081526039602052604090205483111562000c855760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e636500000000006044820152606401620006ca565b6001600160a01b0382166000908152603960205260408120805485929062000caf90849062001444565b9091555050604080516080810182526001600160a01b03808516825286811660208084019182528385018881524360608601908152600a805460009081526008909452968320865181549087166001600160a01b03199182161782559451600182018054919097169516949094179094555160028301559151600390910155825491929062000d3e8362001221565b90915550506001600160a01b038581166000818152603a6020908152604091829020548251908516948816948514808252918101899052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d910160405180910390a3833b1562000db85762000db8848762000f41565b6001600a5462000dc9919062001444565b9695505050505050565b60008062000de062001174565b60008060ff9050604083875160208901845afa62000dfd57600080fd5b505080516020909101516c0100000000000000000000000090910494909350915050565b60608160000362000e495750506040805180820190915260018152600360fc1b602082015290565b8160005b811562000e79578062000e608162001221565b915062000e719050600a8362001253565b915062000e4d565b6000816001600160401b0381111562000e965762000e96620011ad565b6040519080825280601f01601f19166020018201604052801562000ec1576020820181803683370190505b5090505b841562000f395762000ed960018362001444565b915062000ee8600a866200145a565b62000ef590603062001205565b60f81b81838151811062000f0d5762000f0d620011c3565b60200101906001600160f81b031916908160001a90535062000f31600a8662001253565b945062000ec5565b949350505050565b6001600160a01b038216600090815260346020526040812054900362000fbf576001600160a01b03821660008181526034602052604081206001908190556035805491820181559091527fcfa4bec1d3298408bb5afcfcd9c430549c5b31f8aa5c5848151c0a55f473c34d0180546001600160a01b03191690911790555b6001600160a01b03808316600090815260326020908152604080832093851683529290529081205490036200090b576001600160a01b03808316600081815260326020908152604080832094861680845294825280832060019081905593835260338252822080549384018155825290200180546001600160a01b03191690911790555050565b60405180610280016040528060006001600160a01b0316815260200160006001600160a01b0316815260200160006001600160a01b0316815260200160608152602001600081526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b0316815260200160008152602001600081526020016000815260200160008152602001600081526020016060815260200160006003811115620011115762001111620011d9565b905290565b611734806200ae0d83390190565b5080546200113290620012ea565b6000825580601f1062001143575050565b601f01602090049060005260206000209081019062001163919062001192565b50565b6105c2806200c54183390190565b60405180604001604052806002906020820280368337509192915050565b5b80821115620011a9576000815560010162001193565b5090565b634e487b7160e01b600052604160045260246000fd5b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052602160045260246000fd5b634e487b7160e01b600052601160045260246000fd5b808201808211156200121b576200121b620011ef565b92915050565b600060018201620012365762001236620011ef565b5060010190565b634e487b7160e01b600052601260045260246000fd5b6000826200126557620012656200123d565b500490565b600060018060a01b03808816835260208188168185015286604085015260a06060850152855191508160a085015260005b82811015620012b95786810182015185820160c0015281016200129b565b5050600060c0828501015260c0601f19601f83011684010191505062000dc960808301846001600160a01b03169052565b600181811c90821680620012ff57607f821691505b6020821081036200132057634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156200066257600081815260208120601f850160051c810160208610156200134f5750805b601f850160051c820191505b8181101562001370578281556001016200135b565b505050505050565b81516001600160401b03811115620013945762001394620011ad565b620013ac81620013a58454620012ea565b8462001326565b602080601f831160018114620013e45760008415620013cb5750858301515b600019600386901b1c1916600185901b17855562001370565b600085815260208120601f198616915b828110156200141557888601518255948401946001909101908401620013f4565b5085821015620014345787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b818103818111156200121b576200121b620011ef565b6000826200146c576200146c6200123d565b500690565b61998c80620014816000396000f3fe608060405260043610620005135760003560e01c8063852c4849116200029f578063b3ecbadd116200016b578063d372c07e11620000d7578063e7f43c68116200008d578063e7f43c681462001222578063e98712b21462001242578063ee7ff28d146200125a578063f03b959d146200127f578063f1d592a714620012a4578063f7866ee314620012c957005b8063d372c07e146200113c578063d5f394881462001161578063d861b0e81462001183578063d886f8a2146200119b578063dd62ed3e14620011c0578063dfb1a4d2146200120a57005b8063c9d97af4116200012d578063c9d97af41462001085578063cb696f54146200109d578063ceaad45514620010c2578063cef9845014620010e7578063cf9c571914620010ff578063cfd19fb9146200111757005b8063b3ecbadd1462000fc7578063b46e55201462000fec578063b66b3e791462001011578063b6ef8c671462001039578063b7ab4db5146200105e57005b8063a515366a116200020b578063a9fd1a8f11620001cd578063a9fd1a8f1462000eec578063ab8f6ffe1462000f16578063ae1da0b51462000f3d578063ae1f5fa01462000f55578063affb1cf11462000f7d578063b2ea9adb1462000fa257005b8063a515366a1462000e2c578063a5d059ca1462000e43578063a8b2216e1462000e5a578063a9059cbb1462000e81578063a9af10591462000ea657005b806395d89b41116200026157806395d89b411462000d6257806396b477cb1462000d9057806396f18a321462000db55780639ac310741462000dd75780639c98e4711462000def5780639dc29fac1462000e0757005b8063852c48491462000cc3578063872cf0591462000ce857806389c614b81462000d005780638bac7dad1462000d185780638d7835ea1462000d3d57005b806340c10f1911620003df5780636fd2c80b116200034b578063784304b5116200030d578063784304b51462000a3f57806379502c551462000a645780637e660ac91462000c2e578063819b64631462000c53578063833b1fce1462000c6a57806384467fdb1462000c9e57005b80636fd2c80b146200098857806370a08231146200099f57806371d1bc5914620009d9578063731b3a0314620009f157806377e741c71462000a1a57005b8063573963b811620003a1578063573963b814620008cd5780635bdedd1614620008e55780636a929cef14620008fd5780636b5f444c14620009225780636bb9cb0e14620009475780636be0487b146200095f57005b806340c10f19146200081c578063436459691462000841578063496ccd9b14620008585780634bb278f3146200087d578063520fdbbc14620008a857005b806318160ddd116200047f57806329070c6d116200044157806329070c6d146200075e5780632f2c3f2e146200077557806330bcb81c146200078d578063313ce56714620007c157806335be16e014620007df578063386a827b146200080457005b806318160ddd14620006a05780631904bb2e14620006b75780631a0cf2e514620006eb57806323b872dd146200071057806325ce1bb9146200073557005b80630fe5010911620004d55780630fe501091462000602578063112206331462000627578063114eaf55146200063e5780631250a28d14620006635780631604e4161462000688578063161605e3146200051b57005b806306fdde03146200051d578063095ea7b314620005615780630ae65e7a14620005975780630b21fb1d14620005bc5780630d8e6e2c14620005e157005b366200051b57005b005b3480156200052a57600080fd5b506040805180820190915260068152652732bbba37b760d11b60208201525b6040516200055891906200814a565b60405180910390f35b3480156200056e57600080fd5b5062000586620005803660046200817c565b620012e9565b604051901515815260200162000558565b348015620005a457600080fd5b506200051b620005b6366004620081ab565b62001302565b348015620005c957600080fd5b506200051b620005db366004620081cb565b620013a9565b348015620005ee57600080fd5b506024545b60405190815260200162000558565b3480156200060f57600080fd5b506200051b62000621366004620081cb565b620013db565b3480156200063457600080fd5b50601354620005f3565b3480156200064b57600080fd5b506200051b6200065d366004620081cb565b6200140d565b3480156200067057600080fd5b506200051b62000682366004620081ab565b6200143f565b3480156200069557600080fd5b50620005f3602f5481565b348015620006ad57600080fd5b50603b54620005f3565b348015620006c457600080fd5b50620006dc620006d6366004620081ab565b62001490565b60405162000558919062008229565b348015620006f857600080fd5b506200051b6200070a366004620081ab565b6200173e565b3480156200071d57600080fd5b50620005866200072f366004620083a2565b6200178d565b3480156200074257600080fd5b506026546000908152602c6020526040902060030154620005f3565b3480156200076b57600080fd5b50601254620005f3565b3480156200078257600080fd5b50620005f361271081565b3480156200079a57600080fd5b50620007b2620007ac366004620081cb565b62001892565b604051620005589190620083e8565b348015620007ce57600080fd5b506040516012815260200162000558565b348015620007ec57600080fd5b506200051b620007fe3660046200846e565b62001966565b3480156200081157600080fd5b50620005f360055481565b3480156200082957600080fd5b506200051b6200083b3660046200817c565b62001b42565b3480156200084e57600080fd5b50602254620005f3565b3480156200086557600080fd5b506200051b62000877366004620081ab565b62001b7f565b3480156200088a57600080fd5b506200089562001c89565b604051620005589594939291906200852e565b348015620008b557600080fd5b506200051b620008c7366004620081ab565b62002503565b348015620008da57600080fd5b50620005f360285481565b348015620008f257600080fd5b50620005f360075481565b3480156200090a57600080fd5b506200051b6200091c366004620081cb565b620026fe565b3480156200092f57600080fd5b506200051b62000941366004620081cb565b62002730565b3480156200095457600080fd5b50620005f360375481565b3480156200096c57600080fd5b5062000977620027b6565b6040516200055893929190620085ad565b3480156200099557600080fd5b50601554620005f3565b348015620009ac57600080fd5b50620005f3620009be366004620081ab565b6001600160a01b031660009081526039602052604090205490565b348015620009e657600080fd5b50620005f360065481565b348015620009fe57600080fd5b506026546000908152602c6020526040902060020154620005f3565b34801562000a2757600080fd5b506200051b62000a39366004620081cb565b620028e7565b34801562000a4c57600080fd5b506200051b62000a5e36600462008686565b62002919565b34801562000a7157600080fd5b506040805160c080820183526012548252601354602080840191909152601454838501526015546060808501919091526016546080808601919091526017546001600160a01b0390811660a08088019190915287516101008101895260185483168152601954831681870152601a548316818a0152601b54831681860152601c54831681850152601d54831691810191909152601e54821695810195909552601f54811660e086015286519182018752835416815260215492810192909252602254948201949094526023549381019390935260245462000b50939084565b6040805185518152602080870151818301528683015182840152606080880151818401526080808901518185015260a0988901516001600160a01b039081168a8601528851811660c080870191909152848a0151821660e0808801919091528a8801518316610100880152848b01518316610120880152928a015182166101408701529989015181166101608601529888015189166101808501529096015187166101a083015284519096166101c0820152948301516101e08601528201516102008501529101516102208301526102408201526102600162000558565b34801562000c3b57600080fd5b506200051b62000c4d366004620081cb565b62002ac4565b34801562000c6057600080fd5b50602354620005f3565b34801562000c7757600080fd5b506019546001600160a01b03165b6040516001600160a01b03909116815260200162000558565b34801562000cab57600080fd5b506200051b62000cbd366004620086db565b62002af6565b34801562000cd057600080fd5b506200051b62000ce23660046200817c565b62002c25565b34801562000cf557600080fd5b506200051b62002db6565b34801562000d0d57600080fd5b50620005f3602a5481565b34801562000d2557600080fd5b506200051b62000d37366004620081cb565b62002df2565b34801562000d4a57600080fd5b506200051b62000d5c36600462008792565b62002e76565b34801562000d6f57600080fd5b50604080518082019091526003815262272a2760e91b602082015262000549565b34801562000d9d57600080fd5b50620005f362000daf366004620081cb565b62003053565b34801562000dc257600080fd5b50603e5462000c85906001600160a01b031681565b34801562000de457600080fd5b50620005f3603c5481565b34801562000dfc57600080fd5b50620005f3602b5481565b34801562000e1457600080fd5b506200051b62000e263660046200817c565b620030eb565b620005f362000e3d3660046200817c565b62003208565b620005f362000e543660046200817c565b62003325565b34801562000e6757600080fd5b5062000e72620033fe565b60405162000558919062008821565b34801562000e8e57600080fd5b506200058662000ea03660046200817c565b620034e1565b34801562000eb357600080fd5b5062000edd62000ec5366004620081cb565b6000908152600b602052604090206006015460ff1690565b60405162000558919062008887565b34801562000ef957600080fd5b5062000f0462003539565b6040516200055894939291906200889c565b34801562000f2357600080fd5b5062000f2e620036b6565b604051620005589190620088cd565b34801562000f4a57600080fd5b50620005f360295481565b34801562000f6257600080fd5b5062000f6d620037c8565b6040516200055892919062008928565b34801562000f8a57600080fd5b5062000f0462000f9c366004620081cb565b62003a92565b34801562000faf57600080fd5b506200051b62000fc13660046200895a565b62003c99565b34801562000fd457600080fd5b506200051b62000fe6366004620081ab565b62003ce0565b34801562000ff957600080fd5b506200051b6200100b366004620081ab565b62003d2f565b3480156200101e57600080fd5b506200102962003f80565b60405162000558929190620089ba565b3480156200104657600080fd5b50620005f362001058366004620081cb565b620040b7565b3480156200106b57600080fd5b506200107662004149565b604051620005589190620089e3565b3480156200109257600080fd5b50620005f360265481565b348015620010aa57600080fd5b506200051b620010bc366004620081cb565b620041ad565b348015620010cf57600080fd5b506200051b620010e1366004620081ab565b62004210565b348015620010f457600080fd5b50620005f360045481565b3480156200110c57600080fd5b506200051b6200425f565b3480156200112457600080fd5b506200051b62001136366004620081ab565b620042b4565b3480156200114957600080fd5b506200051b6200115b366004620081ab565b62004303565b3480156200116e57600080fd5b50603d5462000c85906001600160a01b031681565b3480156200119057600080fd5b506200051b62004352565b348015620011a857600080fd5b506200051b620011ba366004620081ab565b620045d2565b348015620011cd57600080fd5b50620005f3620011df366004620089f8565b6001600160a01b03918216600090815260316020908152604080832093909416825291909152205490565b3480156200121757600080fd5b50620005f362004621565b3480156200122f57600080fd5b506020546001600160a01b031662000c85565b3480156200124f57600080fd5b50620005f3602e5481565b3480156200126757600080fd5b506200051b62001279366004620081cb565b6200463e565b3480156200128c57600080fd5b506200051b6200129e366004620081ab565b62004670565b348015620012b157600080fd5b506200051b620012c3366004620081ab565b620046bf565b348015620012d657600080fd5b506017546001600160a01b031662000c85565b6000620012f833848462004778565b5060015b92915050565b6200130c620048a1565b6001600160a01b038082166000818152603a602052604090206001015490911614620013555760405162461bcd60e51b81526004016200134c9062008a36565b60405180910390fd5b6001600160a01b038181166000908152603a6020526040902054163314620013915760405162461bcd60e51b81526004016200134c9062008a6d565b6200139c81620048f0565b620013a660008055565b50565b6020546001600160a01b03163314620013d65760405162461bcd60e51b81526004016200134c9062008ab9565b600555565b6020546001600160a01b03163314620014085760405162461bcd60e51b81526004016200134c9062008ab9565b600455565b6020546001600160a01b031633146200143a5760405162461bcd60e51b81526004016200134c9062008ab9565b601555565b6020546001600160a01b031633146200146c5760405162461bcd60e51b81526004016200134c9062008ab9565b601880546001600160a01b0319166001600160a01b0392909216919091179055565b565b6200149a62007e6a565b6001600160a01b038083166000818152603a602052604090206001015490911614620014da5760405162461bcd60e51b81526004016200134c9062008af0565b6001600160a01b038083166000908152603a602090815260409182902082516102808101845281548516815260018201548516928101929092526002810154909316918101919091526003820180549192916060840191906200153d9062008b27565b80601f01602080910402602001604051908101604052809291908181526020018280546200156b9062008b27565b8015620015bc5780601f106200159057610100808354040283529160200191620015bc565b820191906000526020600020905b8154815290600101906020018083116200159e57829003601f168201915b505050918352505060048201546020820152600582015460408201526006820154606082015260078201546080820152600882015460a0820152600982015460c0820152600a82015460e0820152600b820154610100820152600c8201546001600160a01b0316610120820152600d820154610140820152600e820154610160820152600f82015461018082015260108201546101a082015260118201546101c08201526012820180546101e090920191620016789062008b27565b80601f0160208091040260200160405190810160405280929190818152602001828054620016a69062008b27565b8015620016f75780601f10620016cb57610100808354040283529160200191620016f7565b820191906000526020600020905b815481529060010190602001808311620016d957829003601f168201915b5050509183525050601382015460209091019060ff166003811115620017215762001721620081e5565b6003811115620017355762001735620081e5565b90525092915050565b6020546001600160a01b031633146200176b5760405162461bcd60e51b81526004016200134c9062008ab9565b601f80546001600160a01b0319166001600160a01b0392909216919091179055565b6001600160a01b038316600090815260316020908152604080832033845290915281205482811015620018145760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e74206578636565647320616044820152676c6c6f77616e636560c01b60648201526084016200134c565b62001821858585620049e0565b62001839853362001833868562008b79565b62004778565b836001600160a01b0316856001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef856040516200187f91815260200190565b60405180910390a3506001949350505050565b6200189c62007f3a565b6000828152600b602090815260409182902082516101208101845281546001600160a01b03908116825260018301541692810192909252600281015492820192909252600380830154606083015260048301546080830152600583015460a0830152600683015491929160c084019160ff90911690811115620019235762001923620081e5565b6003811115620019375762001937620081e5565b81526006919091015460ff61010082048116151560208401526201000090910416151560409091015292915050565b6018546001600160a01b03163314620019ce5760405162461bcd60e51b815260206004820152602360248201527f63616c6c6572206973206e6f742074686520736c617368696e6720636f6e74726044820152621858dd60ea1b60648201526084016200134c565b6000610120820135603a82620019eb6040860160208701620081ab565b6001600160a01b03166001600160a01b031681526020019081526020016000206009015462001a1b919062008b79565b60c0830135603a600062001a366040870160208801620081ab565b6001600160a01b03166001600160a01b031681526020019081526020016000206006015462001a66919062008b79565b60a0840135603a600062001a816040880160208901620081ab565b6001600160a01b03166001600160a01b031681526020019081526020016000206005015462001ab1919062008b79565b62001abd919062008b8f565b62001ac9919062008b8f565b6017546001600160a01b031660009081526039602052604081208054929350839290919062001afa90849062008b8f565b90915550829050603a600062001b176040840160208501620081ab565b6001600160a01b03168152602081019190915260400160002062001b3c828262008d7b565b50505050565b6020546001600160a01b0316331462001b6f5760405162461bcd60e51b81526004016200134c9062008ab9565b62001b7b828262004b12565b5050565b6020546001600160a01b0316331462001bac5760405162461bcd60e51b81526004016200134c9062008ab9565b601980546001600160a01b0319166001600160a01b03838116918217909255601a54604051637adbf97360e01b8152600481019290925290911690637adbf97390602401600060405180830381600087803b15801562001c0b57600080fd5b505af115801562001c20573d6000803e3d6000fd5b5050601c54604051637adbf97360e01b81526001600160a01b0385811660048301529091169250637adbf97391506024015b600060405180830381600087803b15801562001c6d57600080fd5b505af115801562001c82573d6000803e3d6000fd5b5050505050565b603d546000908190606090829081906001600160a01b0316331462001cc25760405162461bcd60e51b81526004016200134c9062008ed1565b62001ccc620048a1565b4360298190556026546000828152602760209081526040808320849055928252602c90528190206003015460185491516306c9789b60e41b815292101560048301819052916001600160a01b0390911690636c9789b090602401600060405180830381600087803b15801562001d4157600080fd5b505af115801562001d56573d6000803e3d6000fd5b505050508015620022bb57601e54603b54603c54602a546040516392eff3cd60e01b81526004810193909352602483019190915260448201524260648201526000916001600160a01b0316906392eff3cd90608401602060405180830381865afa15801562001dc9573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019062001def919062008f14565b905080603c54101562001e015750603c545b62001e0d308262004b12565b80603c600082825462001e21919062008b79565b9091555050601f546040805163f968f49360e01b815281516001600160a01b039093169263f968f4939260048084019391929182900301816000875af192505050801562001e8e575060408051601f3d908101601f1916820190925262001e8b9181019062008f2e565b60015b62001ecc576040514281527ff1217bb194383aa5c937c81cbbb445990a478c7511b080df329f9b0fb64233c99060200160405180910390a162001eff565b60175462001ee4906001600160a01b03168262004b12565b601f5462001efc906001600160a01b03168362004b12565b50505b3060009081526039602052604090205462001f1c90479062004b98565b62001f26620050df565b62001f30620053a2565b62001f3a62005491565b62001f4462005530565b60008062001f51620037c8565b60195460405163422811f960e11b81529294509092506001600160a01b03169063845023f29062001f87908590600401620089e3565b600060405180830381600087803b15801562001fa257600080fd5b505af115801562001fb7573d6000803e3d6000fd5b505060185460405163e08b14ed60e01b81526001600160a01b03909116925063e08b14ed915062001fed908490600401620089e3565b600060405180830381600087803b1580156200200857600080fd5b505af11580156200201d573d6000803e3d6000fd5b50506026546000908152602c602052604090206002015460285460215491935014801591506200204e575060285415155b15620020bc576028546021819055601854604051631ad7d11360e21b815260048101929092526001600160a01b031690636b5f444c90602401600060405180830381600087803b158015620020a257600080fd5b505af1158015620020b7573d6000803e3d6000fd5b505050505b604d54156200210757604654603f55604754604055604854604155604954604255604a54604355604b54604455604c546045805460ff191660ff90921615159190911790556000604d555b60215460009062002119904362008b8f565b905042602a8190555060016026600082825462002137919062008b8f565b909155505060265460408051602d805460a0602082028401810190945260808301818152620022799594849391929060009085015b828210156200225a576000848152602090819020604080516060810182526003860290920180546001600160a01b0316835260018101549383019390935260028301805492939291840191620021c29062008b27565b80601f0160208091040260200160405190810160405280929190818152602001828054620021f09062008b27565b8015620022415780601f10620022155761010080835404028352916020019162002241565b820191906000526020600020905b8154815290600101906020018083116200222357829003601f168201915b505050505081525050815260200190600101906200216c565b505050508152602001858152602001438152602001848152506200564b565b7febad8099c467528a56c98b63c8d476d251cf1ffb4c75db94b4d23fa2b6a1e335602654604051620022ad91815260200190565b60405180910390a150505050505b60195460408051634bb278f360e01b815290516000926001600160a01b031691634bb278f3916004808301926020929190829003018187875af115801562002307573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906200232d919062008f53565b90508015620023a957601a546040805163a2e6204560e01b815290516001600160a01b039092169163a2e620459160048082019260209290919082900301816000875af1925050508015620023a1575060408051601f3d908101601f191682019092526200239e9181019062008f53565b60015b15620023a957505b600380546026546000908152602c602090815260408083206001810154950154602d805483518186028101860190945280845260ff909616968996919590949293928692919084015b82821015620024e0576000848152602090819020604080516060810182526003860290920180546001600160a01b0316835260018101549383019390935260028301805492939291840191620024489062008b27565b80601f0160208091040260200160405190810160405280929190818152602001828054620024769062008b27565b8015620024c75780601f106200249b57610100808354040283529160200191620024c7565b820191906000526020600020905b815481529060010190602001808311620024a957829003601f168201915b50505050508152505081526020019060010190620023f2565b505050509250965096509650965096505050620024fc60008055565b9091929394565b6020546001600160a01b03163314620025305760405162461bcd60e51b81526004016200134c9062008ab9565b602080546001600160a01b0319166001600160a01b0383811691821790925560195460405163b3ab15fb60e01b815260048101929092529091169063b3ab15fb90602401600060405180830381600087803b1580156200258f57600080fd5b505af1158015620025a4573d6000803e3d6000fd5b5050601a5460405163b3ab15fb60e01b81526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b158015620025f057600080fd5b505af115801562002605573d6000803e3d6000fd5b5050601b5460405163b3ab15fb60e01b81526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b1580156200265157600080fd5b505af115801562002666573d6000803e3d6000fd5b5050601c5460405163b3ab15fb60e01b81526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b158015620026b257600080fd5b505af1158015620026c7573d6000803e3d6000fd5b5050601d5460405163b3ab15fb60e01b81526001600160a01b038581166004830152909116925063b3ab15fb915060240162001c52565b6020546001600160a01b031633146200272b5760405162461bcd60e51b81526004016200134c9062008ab9565b603755565b6020546001600160a01b031633146200275d5760405162461bcd60e51b81526004016200134c9062008ab9565b60288190556026546000908152602c6020908152604091829020600301548251848152918201819052917f2eea6438d890c8603d4df81ad1bad2a4ea45c02b4837165f461ff3c81603abc7910160405180910390a15050565b620027f96040518060e001604052806000815260200160008152602001600081526020016000815260200160008152602001600081526020016000151581525090565b6200283c6040518060e001604052806000815260200160008152602001600081526020016000815260200160008152602001600081526020016000151581525090565b5050604d546040805160e08082018352603f54825282546020808401919091526041548385015260425460608085019190915260435460808086019190915260445460a08087019190915260455460ff908116151560c080890191909152885196870189526046548752604754958701959095526048549786019790975260495492850192909252604a5490840152604b5490830152604c5490931615159281019290925292909190565b6020546001600160a01b03163314620029145760405162461bcd60e51b81526004016200134c9062008ab9565b601255565b6001600160a01b038083166000818152603a602052604090206001810154909216146200295a5760405162461bcd60e51b81526004016200134c9062008af0565b80546001600160a01b03163314620029865760405162461bcd60e51b81526004016200134c9062008f73565b62002991836200571b565b15620029eb5760405162461bcd60e51b815260206004820152602260248201527f76616c696461746f72206d757374206e6f7420626520696e20636f6d6d697474604482015261656560f01b60648201526084016200134c565b600080620029f9846200578c565b92509050811562002a3b5760405162461bcd60e51b815260206004820152600b60248201526a32b737b2329032b93937b960a91b60448201526064016200134c565b60018301546001600160a01b0382811691161462002aac5760405162461bcd60e51b815260206004820152602760248201527f76616c696461746f72206e6f646520616464726573732063616e2774206265206044820152661d5c19185d195960ca1b60648201526084016200134c565b6003830162002abc858262008fc2565b505050505050565b6020546001600160a01b0316331462002af15760405162461bcd60e51b81526004016200134c9062008ab9565b600755565b6000604051806102800160405280336001600160a01b0316815260200160006001600160a01b03168152602001856001600160a01b0316815260200186815260200160126000016002015481526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b03168152602001600081526020014381526020016000815260200160008152602001600081526020018481526020016000600381111562002bc55762002bc5620081e5565b9052905062002bd58183620057d1565b60208101516101808201516040517f8ad8bd2eb6950e5f332fd3a6dca48cb358ecfe3057848902b98cbdfe455c915c9262002c1692339289918b916200908a565b60405180910390a15050505050565b6001600160a01b038083166000818152603a60205260409020600101549091161462002c655760405162461bcd60e51b81526004016200134c9062008a36565b6001600160a01b038281166000908152603a602052604090205416331462002ca15760405162461bcd60e51b81526004016200134c9062008a6d565b61271081111562002cf55760405162461bcd60e51b815260206004820152601f60248201527f7265717569726520636f727265637420636f6d6d697373696f6e20726174650060448201526064016200134c565b604080516060810182526001600160a01b038481168252436020808401918252838501868152601180546000908152600f909352958220855181546001600160a01b03191695169490941784559151600180850191909155915160029093019290925583549293909290919062002d6e90849062008b8f565b90915550506040518281526001600160a01b038416907f4fba51c92fa3d6ad8374d394f6cd5766857552e153d7384a8f23aa4ce9a8a7cf9060200160405180910390a2505050565b6020546001600160a01b0316331462002de35760405162461bcd60e51b81526004016200134c9062008ab9565b6003805460ff19166001179055565b6020546001600160a01b0316331462002e1f5760405162461bcd60e51b81526004016200134c9062008ab9565b6000811162002e715760405162461bcd60e51b815260206004820152601960248201527f636f6d6d69747465652073697a652063616e277420626520300000000000000060448201526064016200134c565b602355565b6020546001600160a01b0316331462002ea35760405162461bcd60e51b81526004016200134c9062008ab9565b805162002eb09062005c2b565b801562002ec7575062002ec7816040015162005c2b565b801562002ede575062002ede816080015162005c2b565b62002f2c5760405162461bcd60e51b815260206004820152601d60248201527f696e697469616c2074696d656f7574206f7574206f6620626f756e647300000060448201526064016200134c565b62002f3b816020015162005c43565b801562002f52575062002f52816060015162005c43565b801562002f69575062002f698160a0015162005c43565b62002fb75760405162461bcd60e51b815260206004820152601b60248201527f74696d656f75742064656c7461206f7574206f6620626f756e6473000000000060448201526064016200134c565b805160465560208082015160475560408083015160485560608301516049556080830151604a5560a0830151604b5560c0830151604c805460ff19169115159190911790556026546000908152602c9092529081902060030154604d81905590517fa4cc9b6d7e44f45ad3dfc950f52c5b7c915f5aaab5b2316be12db869407309f2916200304891849190620090d3565b60405180910390a150565b6000602954600162003066919062008b8f565b821115620030c65760405162461bcd60e51b815260206004820152602660248201527f63616e6e6f74206765742065706f636820696420666f7220612066757475726560448201526520626c6f636b60d01b60648201526084016200134c565b6029548211620030e3575060009081526027602052604090205490565b505060265490565b6020546001600160a01b03163314620031185760405162461bcd60e51b81526004016200134c9062008ab9565b6001600160a01b0382166000908152603960205260409020548111156200317b5760405162461bcd60e51b8152602060048201526016602482015275416d6f756e7420657863656564732062616c616e636560501b60448201526064016200134c565b6001600160a01b03821660009081526039602052604081208054839290620031a590849062008b79565b9250508190555080603b6000828254620031c0919062008b79565b90915550506040518181526001600160a01b038316907f5024dbeedf0c06664c9bd7be836915730c955e936972c020683dadf11d5488a3906020015b60405180910390a25050565b600062003214620048a1565b6001600160a01b038084166000818152603a602052604090206001015490911614620032545760405162461bcd60e51b81526004016200134c9062008af0565b6001600160a01b0383166000908152603a602052604081206013015460ff166003811115620032875762003287620081e5565b14620032d65760405162461bcd60e51b815260206004820152601b60248201527f76616c696461746f72206e65656420746f20626520616374697665000000000060448201526064016200134c565b603754620032e5903462009107565b33600090815260386020526040812080549091906200330690849062008b8f565b9091555062003319905083833362005c5b565b9050620012fc60008055565b600062003331620048a1565b6001600160a01b038084166000818152603a602052604090206001015490911614620033715760405162461bcd60e51b81526004016200134c9062008af0565b60008211620033bb5760405162461bcd60e51b81526020600482015260156024820152740756e626f6e64696e6720616d6f756e74206973203605c1b60448201526064016200134c565b603754620033ca903462009107565b3360009081526038602052604081208054909190620033eb90849062008b8f565b9091555062003319905083833362005e72565b60606030805480602002602001604051908101604052809291908181526020016000905b82821015620034d8578382906000526020600020018054620034449062008b27565b80601f0160208091040260200160405190810160405280929190818152602001828054620034729062008b27565b8015620034c35780601f106200349757610100808354040283529160200191620034c3565b820191906000526020600020905b815481529060010190602001808311620034a557829003601f168201915b50505050508152602001906001019062003422565b50505050905090565b6000620034f0338484620049e0565b6040518281526001600160a01b0384169033907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef9060200160405180910390a350600192915050565b6026546000908152602c602090815260408083208151815460a094810282018501909352608081018381526060959485948594859493909284928491879085015b8282101562003668576000848152602090819020604080516060810182526003860290920180546001600160a01b0316835260018101549383019390935260028301805492939291840191620035d09062008b27565b80601f0160208091040260200160405190810160405280929190818152602001828054620035fe9062008b27565b80156200364f5780601f1062003623576101008083540402835291602001916200364f565b820191906000526020600020905b8154815290600101906020018083116200363157829003601f168201915b505050505081525050815260200190600101906200357a565b50505090825250600182015460208083019190915260028301546040808401919091526003909301546060928301528351908401519284015193909101519098919750919550909350915050565b6060602d805480602002602001604051908101604052809291908181526020016000905b82821015620034d8576000848152602090819020604080516060810182526003860290920180546001600160a01b0316835260018101549383019390935260028301805492939291840191620037309062008b27565b80601f01602080910402602001604051908101604052809291908181526020018280546200375e9062008b27565b8015620037af5780601f106200378357610100808354040283529160200191620037af565b820191906000526020600020905b8154815290600101906020018083116200379157829003601f168201915b50505050508152505081526020019060010190620036da565b603d5460609081906001600160a01b03163314620037fa5760405162461bcd60e51b81526004016200134c9062008ed1565b6025546200384b5760405162461bcd60e51b815260206004820152601860248201527f5468657265206d7573742062652076616c696461746f7273000000000000000060448201526064016200134c565b6200385562007fac565b602354608082015260258152603a6020820152602d6040820152602b6060820152620038818162006257565b6200388f6030600062007fca565b602d5480620038d65760405162461bcd60e51b8152602060048201526012602482015271636f6d6d697474656520697320656d70747960701b60448201526064016200134c565b6000816001600160401b03811115620038f357620038f3620085dc565b6040519080825280602002602001820160405280156200391d578160200160208202803683370190505b5090506000826001600160401b038111156200393d576200393d620085dc565b60405190808252806020026020018201604052801562003967578160200160208202803683370190505b50905060005b8381101562003a86576000603a6000602d84815481106200399257620039926200911e565b60009182526020808320600392830201546001600160a01b031684528301939093526040909101812060308054600181018255925292507f6ff97a59c90d62cc7236ba3a37cd85351bf564556780cf8c1157a220f31f0cbb0190620039fa9083018262009134565b50600281015484516001600160a01b039091169085908490811062003a235762003a236200911e565b6001600160a01b0392831660209182029290920101526001820154845191169084908490811062003a585762003a586200911e565b6001600160a01b0390921660209283029190910190910152508062003a7d8162009211565b9150506200396d565b50909450925050509091565b60606000806000602954600162003aaa919062008b8f565b85111562003b075760405162461bcd60e51b815260206004820152602360248201527f63616e6e6f74206765742065706f636820666f7220612066757475726520626c6044820152626f636b60e81b60648201526084016200134c565b602654602954861162003b2557506000858152602760205260409020545b6000818152602c602090815260408083208151815460a09481028201850190935260808101838152909391928492849190879085015b8282101562003c49576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162003bb19062008b27565b80601f016020809104026020016040519081016040528092919081815260200182805462003bdf9062008b27565b801562003c305780601f1062003c045761010080835404028352916020019162003c30565b820191906000526020600020905b81548152906001019060200180831162003c1257829003601f168201915b5050505050815250508152602001906001019062003b5b565b5050509082525060018201546020808301919091526002830154604080840191909152600390930154606092830152835190840151928401519390910151909a9199509197509095509350505050565b6020546001600160a01b0316331462003cc65760405162461bcd60e51b81526004016200134c9062008ab9565b62003cd360018362006277565b62001b7b60028262006277565b6020546001600160a01b0316331462003d0d5760405162461bcd60e51b81526004016200134c9062008ab9565b601b80546001600160a01b0319166001600160a01b0392909216919091179055565b62003d39620048a1565b6001600160a01b038082166000818152603a60205260409020600101549091161462003d795760405162461bcd60e51b81526004016200134c9062008a36565b6001600160a01b038082166000908152603a602052604090208054909116331462003db85760405162461bcd60e51b81526004016200134c9062008f73565b6000601382015460ff16600381111562003dd65762003dd6620081e5565b0362003e255760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f7220616c726561647920616374697665000000000000000060448201526064016200134c565b6002601382015460ff16600381111562003e435762003e43620081e5565b14801562003e545750438160100154115b1562003ea35760405162461bcd60e51b815260206004820152601760248201527f76616c696461746f72207374696c6c20696e206a61696c00000000000000000060448201526064016200134c565b6003601382015460ff16600381111562003ec15762003ec1620081e5565b0362003f105760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206a61696c6564207065726d616e656e746c790000000060448201526064016200134c565b60138101805460ff1916905580546026546000908152602c60209081526040918290206003015491519182526001600160a01b038581169316917f60fcbf2d07dc712a93e59fb28f1edb626d7c2497c57ba71a8c0b3999ecb9a3b5910160405180910390a350620013a660008055565b6060806001600281805462003f959062008b27565b80601f016020809104026020016040519081016040528092919081815260200182805462003fc39062008b27565b8015620040145780601f1062003fe85761010080835404028352916020019162004014565b820191906000526020600020905b81548152906001019060200180831162003ff657829003601f168201915b50505050509150808054620040299062008b27565b80601f0160208091040260200160405190810160405280929190818152602001828054620040579062008b27565b8015620040a85780601f106200407c57610100808354040283529160200191620040a8565b820191906000526020600020905b8154815290600101906020018083116200408a57829003601f168201915b50505050509050915091509091565b600060036000838152600b602052604090206006015460ff166003811115620040e457620040e4620081e5565b14620041335760405162461bcd60e51b815260206004820152601e60248201527f756e626f6e64696e672072656c65617365206e6f74207265766572746564000060448201526064016200134c565b506000908152600b602052604090206005015490565b60606025805480602002602001604051908101604052809291908181526020018280548015620041a357602002820191906000526020600020905b81546001600160a01b0316815260019091019060200180831162004184575b5050505050905090565b6020546001600160a01b03163314620041da5760405162461bcd60e51b81526004016200134c9062008ab9565b60138190556040518181527f1f4d2fc7529047a5bd96d3229bfea127fd18b7748f13586e097c69fccd3891289060200162003048565b6020546001600160a01b031633146200423d5760405162461bcd60e51b81526004016200134c9062008ab9565b601d80546001600160a01b0319166001600160a01b0392909216919091179055565b6020546001600160a01b031633146200428c5760405162461bcd60e51b81526004016200134c9062008ab9565b6200429a6001600062007fea565b620042a86002600062007fea565b6003805460ff19169055565b6020546001600160a01b03163314620042e15760405162461bcd60e51b81526004016200134c9062008ab9565b601c80546001600160a01b0319166001600160a01b0392909216919091179055565b6020546001600160a01b03163314620043305760405162461bcd60e51b81526004016200134c9062008ab9565b601a80546001600160a01b0319166001600160a01b0392909216919091179055565b603d546001600160a01b031633146200437f5760405162461bcd60e51b81526004016200134c9062008ed1565b62004389620048a1565b62004393620053a2565b60005b604e548110156200444f57604e8181548110620043b757620043b76200911e565b90600052602060002090602091828204019190069054906101000a900460ff16603a600060258481548110620043f157620043f16200911e565b60009182526020808320909101546001600160a01b031683528201929092526040019020601301805460ff19166001836003811115620044355762004435620081e5565b021790555080620044468162009211565b91505062004396565b506200445e604e600062008029565b62004468620037c8565b505042602a5543602981905560009081526027602090815260408083208390556026548151602d805460a09581028301860190945260808201848152620045c896939592948594919390919085015b82821015620045a5576000848152602090819020604080516060810182526003860290920180546001600160a01b03168352600181015493830193909352600283018054929392918401916200450d9062008b27565b80601f01602080910402602001604051908101604052809291908181526020018280546200453b9062008b27565b80156200458c5780601f1062004560576101008083540402835291602001916200458c565b820191906000526020600020905b8154815290600101906020018083116200456e57829003601f168201915b50505050508152505081526020019060010190620044b7565b50505090825250600060208201524360408201526021546060909101526200564b565b6200148e60008055565b6020546001600160a01b03163314620045ff5760405162461bcd60e51b81526004016200134c9062008ab9565b601780546001600160a01b0319166001600160a01b0392909216919091179055565b6028546021546000911462004637575060285490565b5060215490565b6020546001600160a01b031633146200466b5760405162461bcd60e51b81526004016200134c9062008ab9565b600655565b6020546001600160a01b031633146200469d5760405162461bcd60e51b81526004016200134c9062008ab9565b601e80546001600160a01b0319166001600160a01b0392909216919091179055565b6020546001600160a01b03163314620046ec5760405162461bcd60e51b81526004016200134c9062008ab9565b6001600160a01b038116620047565760405162461bcd60e51b815260206004820152602960248201527f696e76616c696420636f6e7472616374206164647265737320666f72206c6971604482015268756964206c6f67696360b81b60648201526084016200134c565b603e80546001600160a01b0319166001600160a01b0392909216919091179055565b6001600160a01b038316620047dc5760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f206164646044820152637265737360e01b60648201526084016200134c565b6001600160a01b0382166200483f5760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f206164647265604482015261737360f01b60648201526084016200134c565b6001600160a01b0383811660008181526031602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92591015b60405180910390a3505050565b60005415620048e95760405162461bcd60e51b81526020600482015260136024820152721c99595b9d1c985b98de4819195d1958dd1959606a1b60448201526064016200134c565b6001600055565b6001600160a01b0381166000908152603a6020526040812090601382015460ff166003811115620049255762004925620081e5565b14620049745760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206d75737420626520616374697665000000000000000060448201526064016200134c565b60138101805460ff1916600117905580546026546000908152602c60209081526040918290206003015491519182526001600160a01b038581169316917f75bdcdbe540758778e669d108fbcb7ede734f27f46e4e5525eeb8ecf91849a9c910160405180910390a35050565b6001600160a01b03831660009081526039602052604090205481111562004a435760405162461bcd60e51b8152602060048201526016602482015275616d6f756e7420657863656564732062616c616e636560501b60448201526064016200134c565b6001600160a01b0383166000908152603960205260408120805483929062004a6d90849062008b79565b9250508190555080603b600082825462004a88919062008b8f565b9091555062004a9b90508160026200922d565b6001600160a01b0383166000908152603960205260408120805490919062004ac590849062008b8f565b92505081905550816001600160a01b0316836001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef836040516200489491815260200190565b6001600160a01b0382166000908152603960205260408120805483929062004b3c90849062008b8f565b9250508190555080603b600082825462004b57919062008b8f565b90915550506040518181526001600160a01b038316907f48490b4407bb949b708ec5f514b4167f08f4969baaf78d53b05028adf369bfcf90602001620031fc565b8115801562004ba5575080155b1562004baf575050565b601254600090670de0b6b3a76400009062004bcc9085906200922d565b62004bd8919062009107565b9050801562004c56576017546040516000916001600160a01b03169083908381818185875af1925050503d806000811462004c30576040519150601f19603f3d011682016040523d82523d6000602084013e62004c35565b606091505b509091505080151560010362004c545762004c51828562008b79565b93505b505b82602e600082825462004c6a919062008b8f565b90915550600090505b602d5481101562001b3c576000603a6000602d848154811062004c9a5762004c9a6200911e565b600091825260208083206003909202909101546001600160a01b031683528201929092526040018120602b54602d805492945090918891908690811062004ce55762004ce56200911e565b90600052602060002090600302016001015462004d0391906200922d565b62004d0f919062009107565b90506000602b5486602d868154811062004d2d5762004d2d6200911e565b90600052602060002090600302016001015462004d4b91906200922d565b62004d57919062009107565b9050600082118062004d695750600081115b15620050c6576002601384015460ff16600381111562004d8d5762004d8d620081e5565b148062004db557506003601384015460ff16600381111562004db35762004db3620081e5565b145b1562004e7f5760185462004dd59030906001600160a01b031683620049e0565b601854602d80546001600160a01b039092169163a8031a1d9185918890811062004e035762004e036200911e565b600091825260209091206003909102015460405160e084901b6001600160e01b03191681526001600160a01b039091166004820152602481018590526044016000604051808303818588803b15801562004e5c57600080fd5b505af115801562004e71573d6000803e3d6000fd5b5050505050505050620050ca565b6000836005015483856008015462004e9891906200922d565b62004ea4919062009107565b9050801562004f6657835460405160009182916001600160a01b03909116906108fc90859084818181858888f193505050503d806000811462004f04576040519150601f19603f3d011682016040523d82523d6000602084013e62004f09565b606091505b50909250905081151560000362004f635785546040517f1137d8c966ce69b9630fb2294be011f3d64cc56e91fad7d375f0662568e9d3529162004f5a916001600160a01b0390911690849062009247565b60405180910390a15b50505b6000846005015483866008015462004f7f91906200922d565b62004f8b919062009107565b9050801562004fad57845462004fad9030906001600160a01b031683620049e0565b600062004fbb828562008b79565b9050600062004fcb848762008b79565b9050600081118062004fdd5750600082115b156200507757600c87015462004fff9030906001600160a01b031684620049e0565b600c87015460405163a0ce552d60e01b8152600481018490526001600160a01b039091169063a0ce552d908390602401604080518083038185885af11580156200504d573d6000803e3d6000fd5b50505050506040513d601f19601f8201168201806040525081019062005074919062008f2e565b50505b600187015460408051888152602081018890526001600160a01b03909216917f291e8ba3c0f4b0bd86e6e2346fcee1e7ca0975b1cc1886bfbc722d34f3f24d91910160405180910390a2505050505b5050505b80620050d68162009211565b91505062004c73565b60355460005b8181101562001b7b576000603582815481106200510657620051066200911e565b60009182526020808320909101546001600160a01b0316808352603382526040808420805482518186028101860190935280835292955090929091908301828280156200517d57602002820191906000526020600020905b81546001600160a01b031681526001909101906020018083116200515e575b5050505050905060005b815181101562005205576001600160a01b03831660009081526032602052604081208351909190849084908110620051c357620051c36200911e565b60200260200101516001600160a01b03166001600160a01b03168152602001908152602001600020600090558080620051fc9062009211565b91505062005187565b506001600160a01b0382166000908152603360205260408120620052299162008050565b6001600160a01b03821660009081526038602052604090205481516007546200525391906200922d565b8111156200526f5781516007546200526c91906200922d565b90505b60005a9050836001600160a01b031663d18736ab83856040518363ffffffff1660e01b8152600401620052a39190620089e3565b600060405180830381600088803b158015620052be57600080fd5b5087f193505050508015620052d1575060015b62005307575a620052e3908262008b79565b6001600160a01b038516600090815260366020526040902060019055905062005317565b5a62005314908262008b79565b90505b6001600160a01b0384166000908152603860205260409020548110156200536e576001600160a01b038416600090815260386020526040812080548392906200536290849062008b79565b90915550620053889050565b6001600160a01b0384166000908152603860205260408120555b505050508080620053999062009211565b915050620050e5565b6009545b600a54811015620053cf57620053c981620053c18162009211565b9250620063ca565b620053a6565b50600a54600955600d54600c5403620053e457565b600e545b600d5481101562005411576200540b81620054038162009211565b92506200666b565b620053e8565b50600d54600e55600c54805b600d548110156200548b576015546000828152600b602052604090206004015443916200544a9162008b8f565b1162005470576200545b8162006a07565b6200546860018362008b8f565b915062005476565b6200548b565b80620054828162009211565b9150506200541d565b50600c55565b60355460005b818110156200552157600060358281548110620054b857620054b86200911e565b60009182526020808320909101546001600160a01b031680835260348252604080842084905560369092529120549091506001036200550b576001600160a01b0381166000908152603660205260408120555b5080620055188162009211565b91505062005497565b50620013a66035600062008050565b60115460105410156200148e576010546000908152600f6020526040902060155460018201544391620055639162008b8f565b11156200556d5750565b80546001600160a01b039081166000908152603a60205260409081902060028401546004808301829055600c83015493516319fac8fd60e01b8152929493909316926319fac8fd92620055c592910190815260200190565b600060405180830381600087803b158015620055e057600080fd5b505af1158015620055f5573d6000803e3d6000fd5b5050601080546000908152600f6020526040812080546001600160a01b0319168155600180820183905560029091018290558254909450919250906200563d90849062008b8f565b909155506200553092505050565b6000828152602c60209081526040808320918401516001830155830151600282015560608301516003820155905b82515181101562001b3c5782518051839190839081106200569e576200569e6200911e565b602090810291909101810151825460018082018555600094855293839020825160039092020180546001600160a01b0319166001600160a01b0390921691909117815591810151928201929092556040820151600282019062005702908262008fc2565b5050508080620057129062009211565b91505062005679565b6000805b602d548110156200578357602d81815481106200574057620057406200911e565b60009182526020909120600390910201546001600160a01b03908116908416036200576e5750600192915050565b806200577a8162009211565b9150506200571f565b50600092915050565b6000806200579962008070565b60008060ff9050604083875160208901845afa620057b657600080fd5b50508051602090910151600160601b90910494909350915050565b60e28151146200581b5760405162461bcd60e51b8152602060048201526014602482015273092dcecc2d8d2c840e0e4dedecc40d8cadccee8d60631b60448201526064016200134c565b60308261024001515114620058735760405162461bcd60e51b815260206004820152601c60248201527f496e76616c696420636f6e73656e737573206b6579206c656e6774680000000060448201526064016200134c565b6200587e8262006bb0565b604080518082018252601a81527f19457468657265756d205369676e6564204d6573736167653a0a000000000000602080830191909152845192519192600092620058e1920160609190911b6bffffffffffffffffffffffff1916815260140190565b604051602081830303815290604052905060008262005901835162006cdd565b83604051602001620059169392919062009278565b60408051601f19818403018152828252805160209182012060028085526060850184529094506000939290918301908036833701905050905060008080806200596e898262005968604160026200922d565b62006dfd565b905060006200598d8a62005985604160026200922d565b606062006dfd565b905060205b825181101562005a5e57620059a8838262006f16565b6040805160008152602081018083528d905260ff8316918101919091526060810184905260808101839052929850909650945060019060a0016020604051602081039080840390855afa15801562005a04573d6000803e3d6000fd5b5050604051601f19015190508762005a1e60418462009107565b8151811062005a315762005a316200911e565b6001600160a01b039092166020928302919091019091015262005a5660418262008b8f565b905062005992565b508a602001516001600160a01b03168660008151811062005a835762005a836200911e565b60200260200101516001600160a01b03161462005af55760405162461bcd60e51b815260206004820152602960248201527f496e76616c6964206e6f6465206b6579206f776e6572736869702070726f6f66604482015268081c1c9bdd9a59195960ba1b60648201526084016200134c565b8a604001516001600160a01b03168660018151811062005b195762005b196200911e565b60200260200101516001600160a01b03161462005b8d5760405162461bcd60e51b815260206004820152602b60248201527f496e76616c6964206f7261636c65206b6579206f776e6572736869702070726f60448201526a1bd9881c1c9bdd9a59195960aa1b60648201526084016200134c565b600162005ba58c6102400151838e6000015162006f4d565b1462005c135760405162461bcd60e51b815260206004820152603660248201527f496e76616c696420636f6e73656e737573206b6579206f776e65727368697020604482015275383937b7b3103337b9103932b3b4b9ba3930ba34b7b760511b60648201526084016200134c565b62005c1e8b62006fbc565b5050505050505050505050565b600060648210158015620012fc575050617530101590565b6000600a8210158015620012fc575050612710101590565b600080831162005cba5760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201526269766560e81b60648201526084016200134c565b6001600160a01b03821660009081526039602052604090205483111562005d245760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e6365000000000060448201526064016200134c565b6001600160a01b0382166000908152603960205260408120805485929062005d4e90849062008b79565b9091555050604080516080810182526001600160a01b03808516825286811660208084019182528385018881524360608601908152600a805460009081526008909452968320865181549087166001600160a01b03199182161782559451600182018054919097169516949094179094555160028301559151600390910155825491929062005ddd8362009211565b90915550506001600160a01b038581166000818152603a6020908152604091829020548251908516948816948514808252918101899052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d910160405180910390a3833b1562005e575762005e57848762007269565b6001600a5462005e68919062008b79565b9695505050505050565b6001600160a01b038084166000908152603a602052604081208054919290918482169116148062005fea57600c820154604051631092ab9160e31b81526001600160a01b03868116600483015260009216906384955c8890602401602060405180830381865afa15801562005eeb573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019062005f11919062008f14565b90508581101562005f795760405162461bcd60e51b815260206004820152602b60248201527f696e73756666696369656e7420756e6c6f636b6564204c6971756964204e657760448201526a746f6e2062616c616e636560a81b60648201526084016200134c565b600c83015460405163282d3fdf60e01b81526001600160a01b038781166004830152602482018990529091169063282d3fdf90604401600060405180830381600087803b15801562005fca57600080fd5b505af115801562005fdf573d6000803e3d6000fd5b50505050506200607d565b8482600b0154836008015462006001919062008b79565b1015620060615760405162461bcd60e51b815260206004820152602760248201527f696e73756666696369656e742073656c6620626f6e646564206e6577746f6e2060448201526662616c616e636560c81b60648201526084016200134c565b8482600b01600082825462006077919062008b8f565b90915550505b604051806101200160405280856001600160a01b03168152602001876001600160a01b03168152602001868152602001600081526020014381526020016000815260200160006003811115620060d757620060d7620081e5565b815260006020808301829052841515604093840152600d548252600b815290829020835181546001600160a01b039182166001600160a01b03199182161783559285015160018381018054929093169190941617905591830151600283015560608301516003808401919091556080840151600484015560a0840151600584015560c08401516006840180549193909260ff19909216918490811115620061825762006182620081e5565b021790555060e082015160069091018054610100938401511515620100000262ff0000199315159094029290921662ffff001990921691909117919091179055600d8054906000620061d48362009211565b9190505550836001600160a01b0316866001600160a01b03167f63f8870909f7c59c9c4932bf98dbd491647c8d2e89ca0a032aacdd943a13e2fc83886040516200622a9291909115158252602082015260400190565b60405180910390a3833b15620062465762006246848762007269565b6001600d5462005e68919062008b79565b60fa60a06000808285855af462006272573d6000803e3d6000fd5b505050565b8154600260018083161561010002038216048251808201602081106020841001600281146200632657600181146200634c578660005260208404602060002001600160028402018855602085068060200390508088018589016001836101000a0392508282511684540184556001840193506020820191505b808210156200630f5781518455600184019350602082019150620062f0565b815191036101000a908190040290915550620063c1565b60028302826020036101000a846020036101000a602089015104020185018755620063c1565b8660005260208404602060002001600160028402018855846020038088018589016001836101000a0392508282511660ff198a160184556020820191506001840193505b80821015620063af578151845560018401935060208201915062006390565b815191036101000a9081900402909155505b50505050505050565b600081815260086020908152604080832060018101546001600160a01b03168452603a90925282209091601382015460ff166003811115620064105762006410620081e5565b14158062006437575081546001600160a01b03166000908152603660205260409020546001145b15620064f757600282015482546001600160a01b0316600090815260396020526040812080549091906200646d90849062008b8f565b909155505081546001830154600284015460138401546040516001600160a01b0394851694909316927f1ff2b052afa4bb37ce30d9aaccde416a700b97e632d089111749af937f87834292620064cb92909160ff90911690620092c1565b60405180910390a380548254620062729185916000916001600160a01b0391821691161460016200736e565b805482546001600160a01b039081169116146200661b576000808260080154836005015462006527919062008b79565b9050806000036200653f578360020154915062006565565b80846002015484600d01546200655691906200922d565b62006562919062009107565b91505b600c83015484546040516340c10f1960e01b81526001600160a01b039182166004820152602481018590529116906340c10f1990604401600060405180830381600087803b158015620065b757600080fd5b505af1158015620065cc573d6000803e3d6000fd5b505050508183600d016000828254620065e6919062008b8f565b909155505060028401546005840180546000906200660690849062008b8f565b9091555062001c82905085836000806200736e565b816002015481600801600082825462006635919062008b8f565b909155505060028201546005820180546000906200665590849062008b8f565b909155506200627290508360006001816200736e565b6000818152600b6020908152604080832080546001600160a01b031684526036909252909120546001036200670d578054600182015460068301546002840154604080516201000090930460ff161515835260208301919091526001600160a01b0393841693909216917fec3ccfdbfc982a0ea188f4fd85459da1d6a7b332ddc4ca6b7a774c1abfc75866910160405180910390a362001b7b826001620074f7565b60018101546001600160a01b03166000908152603a60205260408120600683015490919062010000900460ff16620068f5576002830154600c8301548454604051637eee288d60e01b81526001600160a01b03918216600482015260248101849052911690637eee288d90604401600060405180830381600087803b1580156200679657600080fd5b505af1158015620067ab573d6000803e3d6000fd5b50505050600c8301548454604051632770a7eb60e21b81526001600160a01b03918216600482015260248101849052911690639dc29fac90604401600060405180830381600087803b1580156200680157600080fd5b505af115801562006816573d6000803e3d6000fd5b5050505060008360080154846005015462006832919062008b79565b600d8501549091506200684682846200922d565b62006852919062009107565b92508184600d0160008282546200686a919062008b79565b90915550506006840154600003620068895760038501839055620068b1565b600684015460078501546200689f90856200922d565b620068ab919062009107565b60038601555b82846006016000828254620068c7919062008b8f565b90915550506003850154600785018054600090620068e790849062008b8f565b90915550620069ca92505050565b50600282015460088201548111156200690f575060088101545b816009015460000362006929576003830181905562006951565b6009820154600a8301546200693f90836200922d565b6200694b919062009107565b60038401555b8082600901600082825462006967919062008b8f565b90915550506003830154600a830180546000906200698790849062008b8f565b9250508190555080826008016000828254620069a4919062008b79565b90915550506002830154600b83018054600090620069c490849062008b79565b90915550505b60068301805461ff001916610100179055600582018054829190600090620069f490849062008b79565b9091555062001b3c9050846000620074f7565b6000818152600b602052604090206006810154610100900460ff1662006a455760068101805460ff1916600217905562001b7b82600060016200766a565b60068101805460ff19166001179055600381015460000362006a6f5762001b7b826000806200766a565b60018101546001600160a01b03166000908152603a60205260408120600683015490919062010000900460ff1662006b0c5781600701548260060154846003015462006abc91906200922d565b62006ac8919062009107565b90508082600601600082825462006ae0919062008b79565b9091555050600383015460078301805460009062006b0090849062008b79565b9091555062006b719050565b81600a01548260090154846003015462006b2791906200922d565b62006b33919062009107565b90508082600901600082825462006b4b919062008b79565b90915550506003830154600a8301805460009062006b6b90849062008b79565b90915550505b82546001600160a01b03166000908152603960205260408120805483929062006b9c90849062008b8f565b9091555062001b3c9050848260006200766a565b600062006bc182606001516200578c565b6001600160a01b0390911660208401529050801562006c115760405162461bcd60e51b815260206004820152600b60248201526a32b737b2329032b93937b960a91b60448201526064016200134c565b6020808301516001600160a01b039081166000908152603a909252604090912060010154161562006c855760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c726561647920726567697374657265640000000060448201526064016200134c565b6127108260800151111562001b7b5760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e207261746500000000000000000060448201526064016200134c565b60608160000362006d055750506040805180820190915260018152600360fc1b602082015290565b8160005b811562006d35578062006d1c8162009211565b915062006d2d9050600a8362009107565b915062006d09565b6000816001600160401b0381111562006d525762006d52620085dc565b6040519080825280601f01601f19166020018201604052801562006d7d576020820181803683370190505b5090505b841562006df55762006d9560018362008b79565b915062006da4600a86620092e0565b62006db190603062008b8f565b60f81b81838151811062006dc95762006dc96200911e565b60200101906001600160f81b031916908160001a90535062006ded600a8662009107565b945062006d81565b949350505050565b60608162006e0d81601f62008b8f565b101562006e4e5760405162461bcd60e51b815260206004820152600e60248201526d736c6963655f6f766572666c6f7760901b60448201526064016200134c565b62006e5a828462008b8f565b8451101562006ea05760405162461bcd60e51b8152602060048201526011602482015270736c6963655f6f75744f66426f756e647360781b60448201526064016200134c565b60608215801562006ec1576040519150600082526020820160405262006f0d565b6040519150601f8416801560200281840101858101878315602002848b0101015b8183101562006efc57805183526020928301920162006ee2565b5050858452601f01601f1916604052505b50949350505050565b8181018051602082015160409092015190919060001a601b81101562006f465762006f43601b82620092f7565b90505b9250925092565b600062006f596200808e565b600085858560405160200162006f729392919062009313565b6040516020818303038152906040529050600060fb905060008251602062006f9b919062008b8f565b90506020848285855afa62006faf57600080fd5b5050905195945050505050565b6101808101516001600160a01b0316620070bb57603e546001600160a01b0316620070355760405162461bcd60e51b815260206004820152602260248201527f6c6971756964206c6f67696320636f6e7472616374206e6f74206465706c6f79604482015261195960f21b60648201526084016200134c565b602554600090620070469062006cdd565b905081602001518260000151836080015183603e60009054906101000a90046001600160a01b03166040516200707c90620080ac565b6200708c95949392919062009362565b604051809103906000f080158015620070a9573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051602580546001808201835560009283527f401968ff42a154441da5f6c4c935ac46b8671f0e062baaa62a7545ba53bb6e4c90910180546001600160a01b03199081166001600160a01b0395861617909155845184168352603a909552604091829020865181548716908516178155935190840180548616918416919091179055840151600283018054909416911617909155606082015182919060038201906200716d908262008fc2565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c820180546001600160a01b0319166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f82015561020082015160108201556102208201516011820155610240820151601282019062007237908262008fc2565b5061026082015160138201805460ff191660018360038111156200725f576200725f620081e5565b0217905550505050565b6001600160a01b0382166000908152603460205260408120549003620072e7576001600160a01b03821660008181526034602052604081206001908190556035805491820181559091527fcfa4bec1d3298408bb5afcfcd9c430549c5b31f8aa5c5848151c0a55f473c34d0180546001600160a01b03191690911790555b6001600160a01b038083166000908152603260209081526040808320938516835292905290812054900362001b7b576001600160a01b03808316600081815260326020908152604080832094861680845294825280832060019081905593835260338252822080549384018155825290200180546001600160a01b03191690911790555050565b600084815260086020526040902080546001600160a01b0316803b6200739657505062001b3c565b6001600160a01b038116600090815260386020526040902054600454811115620073bf57506004545b60005a6001850154604051634efe8dc760e11b8152600481018b90526001600160a01b039182166024820152604481018a905288151560648201528715156084820152919250841690639dfd1b8e90849060a401600060405180830381600088803b1580156200742e57600080fd5b5087f19350505050801562007441575060015b6200746c575a62007453908262008b79565b90508462007466576200746688620077da565b6200747c565b5a62007479908262008b79565b90505b6001600160a01b038316600090815260386020526040902054811015620074d3576001600160a01b03831660009081526038602052604081208054839290620074c790849062008b79565b90915550620074ed9050565b6001600160a01b0383166000908152603860205260408120555b5050505050505050565b6000828152600b6020526040902080546001600160a01b0316803b6200751d5750505050565b6001600160a01b0381166000908152603860205260409020546005548111156200754657506005545b60005a600185015460405163a892024160e01b8152600481018990526001600160a01b039182166024820152871515604482015291925084169063a8920241908490606401600060405180830381600088803b158015620075a657600080fd5b5087f193505050508015620075b9575060015b620075e4575a620075cb908262008b79565b905084620075de57620075de86620079a1565b620075f4565b5a620075f1908262008b79565b90505b6001600160a01b0383166000908152603860205260409020548110156200764b576001600160a01b038316600090815260386020526040812080548392906200763f90849062008b79565b9091555062002abc9050565b50506001600160a01b0316600090815260386020526040812055505050565b6000838152600b6020526040902080546001600160a01b0316803b62007691575050505050565b6001600160a01b038116600090815260386020526040902054600654811115620076ba57506006545b60005a6040516303c54c2960e41b8152600481018990526024810188905286151560448201529091506001600160a01b03841690633c54c290908490606401600060405180830381600088803b1580156200771457600080fd5b5087f19350505050801562007727575060015b62007753575a62007739908262008b79565b9050846200774d576200774d878762007bf7565b62007763565b5a62007760908262008b79565b90505b6001600160a01b038316600090815260386020526040902054811015620077ba576001600160a01b03831660009081526038602052604081208054839290620077ae90849062008b79565b90915550620063c19050565b50506001600160a01b031660009081526038602052604081205550505050565b600081815260086020908152604080832060018101546001600160a01b039081168552603a9093529220805483549192918216911614620078df576000816008015482600501546200782d919062008b79565b836002015483600d01546200784391906200922d565b6200784f919062009107565b600c8301548454604051632770a7eb60e21b81526001600160a01b039182166004820152602481018490529293501690639dc29fac90604401600060405180830381600087803b158015620078a357600080fd5b505af1158015620078b8573d6000803e3d6000fd5b505050508082600d016000828254620078d2919062008b79565b90915550620078ff915050565b8160020154816008016000828254620078f9919062008b79565b90915550505b600282015482546001600160a01b0316600090815260396020526040812080549091906200792f90849062008b8f565b909155505060028201546005820180546000906200794f90849062008b79565b90915550508154600183015460028401546040519081526001600160a01b0392831692909116907f2d2530c45d577d38d6fc598e41ade6fb0bfbde20d09244c88348d3a2797a9d909060200162004894565b6000818152600b6020908152604080832060018101546001600160a01b03168452603a909252822060068201549192909162010000900460ff1662007ad7576002830154600c83015484546040516340c10f1960e01b81526001600160a01b039182166004820152602481018490529116906340c10f1990604401600060405180830381600087803b15801562007a3757600080fd5b505af115801562007a4c573d6000803e3d6000fd5b505050508083600d01600082825462007a66919062008b8f565b909155505060078301546006840154600386015462007a8691906200922d565b62007a92919062009107565b91508183600601600082825462007aaa919062008b79565b9091555050600384015460078401805460009062007aca90849062008b79565b9091555062007b59915050565b81600a01548260090154846003015462007af291906200922d565b62007afe919062009107565b90508082600901600082825462007b16919062008b79565b90915550506003830154600a8301805460009062007b3690849062008b79565b925050819055508082600801600082825462007b53919062008b8f565b90915550505b60006003840181905560068401805461ff001916905560058301805483929062007b8590849062008b8f565b90915550508254600184015460068501546002860154604080516201000090930460ff161515835260208301919091526001600160a01b0393841693909216917f52c1b482483796c697edd43958bc63e332069083ea38782d960693145db2cf0c91015b60405180910390a350505050565b6000828152600b602052604081209082900362007c7b57600681018054600360ff19909116179081905581546001830154604080516201000090940460ff1615158452602084018690526001600160a01b039283169392909116917f0a4bfc4c4e35975ab1fe5fd21d963e8bb8d483f04aebdbc0a059b246bec3f5fc910162004894565b60018101546001600160a01b03166000908152603a60205260409020600682015462010000900460ff1662007d8b576000808260080154836005015462007cc3919062008b79565b90508060000362007cd75784915062007cf9565b808584600d015462007cea91906200922d565b62007cf6919062009107565b91505b600c83015484546040516340c10f1960e01b81526001600160a01b039182166004820152602481018590529116906340c10f1990604401600060405180830381600087803b15801562007d4b57600080fd5b505af115801562007d60573d6000803e3d6000fd5b505050508183600d01600082825462007d7a919062008b8f565b909155505050600583015562007db0565b8282600501819055508281600801600082825462007daa919062008b8f565b90915550505b60068201805460ff1916600317905581546001600160a01b03166000908152603960205260408120805485929062007dea90849062008b79565b925050819055508281600501600082825462007e07919062008b8f565b9091555050815460018301546006840154604080516201000090920460ff1615158252602082018790526001600160a01b0393841693909216917f0a4bfc4c4e35975ab1fe5fd21d963e8bb8d483f04aebdbc0a059b246bec3f5fc910162007be9565b60405180610280016040528060006001600160a01b0316815260200160006001600160a01b0316815260200160006001600160a01b0316815260200160608152602001600081526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b031681526020016000815260200160008152602001600081526020016000815260200160008152602001606081526020016000600381111562007f355762007f35620081e5565b905290565b60405180610120016040528060006001600160a01b0316815260200160006001600160a01b03168152602001600081526020016000815260200160008152602001600081526020016000600381111562007f985762007f98620081e5565b815260006020820181905260409091015290565b6040518060a001604052806005906020820280368337509192915050565b5080546000825590600052602060002090810190620013a69190620080ba565b50805462007ff89062008b27565b6000825580601f1062008009575050565b601f016020900490600052602060002090810190620013a69190620080df565b50805460008255601f016020900490600052602060002090810190620013a69190620080df565b5080546000825590600052602060002090810190620013a69190620080df565b60405180604001604052806002906020820280368337509192915050565b60405180602001604052806001906020820280368337509192915050565b6105c2806200939583390190565b80821115620080db576000620080d1828262007fea565b50600101620080ba565b5090565b5b80821115620080db5760008155600101620080e0565b60005b8381101562008113578181015183820152602001620080f9565b50506000910152565b6000815180845262008136816020860160208601620080f6565b601f01601f19169290920160200192915050565b6020815260006200815f60208301846200811c565b9392505050565b6001600160a01b0381168114620013a657600080fd5b600080604083850312156200819057600080fd5b82356200819d8162008166565b946020939093013593505050565b600060208284031215620081be57600080fd5b81356200815f8162008166565b600060208284031215620081de57600080fd5b5035919050565b634e487b7160e01b600052602160045260246000fd5b60048110620013a657634e487b7160e01b600052602160045260246000fd5b6200822581620081fb565b9052565b60208152620082446020820183516001600160a01b03169052565b600060208301516200826160408401826001600160a01b03169052565b5060408301516001600160a01b0381166060840152506060830151610280806080850152620082956102a08501836200811c565b9150608085015160a085015260a085015160c085015260c085015160e085015260e08501516101008181870152808701519150506101208181870152808701519150506101408181870152808701519150506101608181870152808701519150506101808181870152808701519150506101a06200831d818701836001600160a01b03169052565b8601516101c0868101919091528601516101e080870191909152860151610200808701919091528601516102208087019190915286015161024080870191909152860151858403601f1901610260808801919091529091506200838184836200811c565b93508087015191505062008398828601826200821a565b5090949350505050565b600080600060608486031215620083b857600080fd5b8335620083c58162008166565b92506020840135620083d78162008166565b929592945050506040919091013590565b60006101208201905060018060a01b038084511683528060208501511660208401525060408301516040830152606083015160608301526080830151608083015260a083015160a083015260c08301516200844760c08401826200821a565b5060e08301516200845c60e084018215159052565b50610100928301511515919092015290565b6000602082840312156200848157600080fd5b81356001600160401b038111156200849857600080fd5b820161028081850312156200815f57600080fd5b600081518084526020808501808196508360051b8101915082860160005b8581101562008521578284038952815180516001600160a01b0316855285810151868601526040908101516060918601829052906200850c818701836200811c565b9a87019a9550505090840190600101620084ca565b5091979650505050505050565b8515158152841515602082015260a0604082015260006200855360a0830186620084ac565b606083019490945250608001529392505050565b805182526020810151602083015260408101516040830152606081015160608301526080810151608083015260a081015160a083015260c0810151151560c08301525050565b6101e08101620085be828662008567565b620085cd60e083018562008567565b826101c0830152949350505050565b634e487b7160e01b600052604160045260246000fd5b600082601f8301126200860457600080fd5b81356001600160401b0380821115620086215762008621620085dc565b604051601f8301601f19908116603f011681019082821181831017156200864c576200864c620085dc565b816040528381528660208588010111156200866657600080fd5b836020870160208301376000602085830101528094505050505092915050565b600080604083850312156200869a57600080fd5b8235620086a78162008166565b915060208301356001600160401b03811115620086c357600080fd5b620086d185828601620085f2565b9150509250929050565b60008060008060808587031215620086f257600080fd5b84356001600160401b03808211156200870a57600080fd5b6200871888838901620085f2565b9550602087013591506200872c8262008166565b909350604086013590808211156200874357600080fd5b6200875188838901620085f2565b935060608701359150808211156200876857600080fd5b506200877787828801620085f2565b91505092959194509250565b8015158114620013a657600080fd5b600060e08284031215620087a557600080fd5b60405160e081018181106001600160401b0382111715620087ca57620087ca620085dc565b8060405250823581526020830135602082015260408301356040820152606083013560608201526080830135608082015260a083013560a082015260c0830135620088158162008783565b60c08201529392505050565b6000602080830181845280855180835260408601915060408160051b870101925083870160005b828110156200887a57603f19888603018452620088678583516200811c565b9450928501929085019060010162008848565b5092979650505050505050565b602081016200889683620081fb565b91905290565b608081526000620088b16080830187620084ac565b6020830195909552506040810192909252606090910152919050565b6020815260006200815f6020830184620084ac565b600081518084526020808501945080840160005b838110156200891d5781516001600160a01b031687529582019590820190600101620088f6565b509495945050505050565b6040815260006200893d6040830185620088e2565b8281036020840152620089518185620088e2565b95945050505050565b600080604083850312156200896e57600080fd5b82356001600160401b03808211156200898657600080fd5b6200899486838701620085f2565b93506020850135915080821115620089ab57600080fd5b50620086d185828601620085f2565b604081526000620089cf60408301856200811c565b82810360208401526200895181856200811c565b6020815260006200815f6020830184620088e2565b6000806040838503121562008a0c57600080fd5b823562008a198162008166565b9150602083013562008a2b8162008166565b809150509250929050565b6020808252601c908201527f76616c696461746f72206d757374206265207265676973746572656400000000604082015260600190565b6020808252602c908201527f726571756972652063616c6c657220746f2062652076616c696461746f72206160408201526b191b5a5b881858d8dbdd5b9d60a21b606082015260800190565b6020808252601a908201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604082015260600190565b60208082526018908201527f76616c696461746f72206e6f7420726567697374657265640000000000000000604082015260600190565b600181811c9082168062008b3c57607f821691505b60208210810362008b5d57634e487b7160e01b600052602260045260246000fd5b50919050565b634e487b7160e01b600052601160045260246000fd5b81810381811115620012fc57620012fc62008b63565b80820180821115620012fc57620012fc62008b63565b60008135620012fc8162008166565b80546001600160a01b0319166001600160a01b0392909216919091179055565b6000808335601e1984360301811262008bec57600080fd5b8301803591506001600160401b0382111562008c0757600080fd5b60200191503681900382131562008c1d57600080fd5b9250929050565b601f8211156200627257600081815260208120601f850160051c8101602086101562008c4d5750805b601f850160051c820191505b8181101562002abc5782815560010162008c59565b600019600383901b1c191660019190911b1790565b6001600160401b0383111562008c9d5762008c9d620085dc565b62008cb58362008cae835462008b27565b8362008c24565b6000601f84116001811462008ce8576000851562008cd35750838201355b62008cdf868262008c6e565b84555062001c82565b600083815260209020601f19861690835b8281101562008d1b578685013582556020948501946001909201910162008cf9565b508682101562008d395760001960f88860031b161c19848701351681555b505060018560011b0183555050505050565b6000813560048110620012fc57600080fd5b62008d6882620081fb565b60ff1981541660ff831681178255505050565b62008d9162008d8a8362008ba5565b8262008bb4565b62008dad62008da36020840162008ba5565b6001830162008bb4565b62008dc962008dbf6040840162008ba5565b6002830162008bb4565b62008dd8606083018362008bd4565b62008de881836003860162008c83565b50506080820135600482015560a0820135600582015560c0820135600682015560e0820135600782015561010082013560088201556101208201356009820155610140820135600a820155610160820135600b82015562008e5b62008e51610180840162008ba5565b600c830162008bb4565b6101a0820135600d8201556101c0820135600e8201556101e0820135600f8201556102008201356010820155610220820135601182015562008ea261024083018362008bd4565b62008eb281836012860162008c83565b505062001b7b62008ec7610260840162008d4b565b6013830162008d5d565b60208082526023908201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60408201526218dbdb60ea1b606082015260800190565b60006020828403121562008f2757600080fd5b5051919050565b6000806040838503121562008f4257600080fd5b505080516020909101519092909150565b60006020828403121562008f6657600080fd5b81516200815f8162008783565b6020808252602f908201527f726571756972652063616c6c657220746f2062652076616c696461746f72207460408201526e1c99585cdd5c9e481858d8dbdd5b9d608a1b606082015260800190565b81516001600160401b0381111562008fde5762008fde620085dc565b62008ff68162008fef845462008b27565b8462008c24565b602080601f8311600181146200902a5760008415620090155750858301515b62009021858262008c6e565b86555062002abc565b600085815260208120601f198616915b828110156200905b578886015182559484019460019091019084016200903a565b50858210156200907a5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b600060018060a01b0380881683528087166020840152808616604084015260a06060840152620090be60a08401866200811c565b91508084166080840152509695505050505050565b6101008101620090e4828562008567565b8260e08301529392505050565b634e487b7160e01b600052601260045260246000fd5b600082620091195762009119620090f1565b500490565b634e487b7160e01b600052603260045260246000fd5b81810362009140575050565b6200914c825462008b27565b6001600160401b03811115620091665762009166620085dc565b620091778162008fef845462008b27565b6000601f821160018114620091aa5760008315620091955750848201545b620091a1848262008c6e565b85555062001c82565b600085815260209020601f19841690600086815260209020845b83811015620091e65782860154825560019586019590910190602001620091c4565b50858310156200907a5793015460001960f8600387901b161c19169092555050600190811b01905550565b60006001820162009226576200922662008b63565b5060010190565b8082028115828204841417620012fc57620012fc62008b63565b60018060a01b0383168152606060208201526000606082015260806040820152600062006df560808301846200811c565b600084516200928c818460208901620080f6565b845190830190620092a2818360208901620080f6565b8451910190620092b7818360208801620080f6565b0195945050505050565b82815260408101620092d383620081fb565b8260208301529392505050565b600082620092f257620092f2620090f1565b500690565b60ff8181168382160190811115620012fc57620012fc62008b63565b6000845162009327818460208901620080f6565b8451908301906200933d818360208901620080f6565b60609490941b6bffffffffffffffffffffffff19169301928352505060140192915050565b600060018060a01b038088168352808716602084015285604084015260a06060840152620090be60a08401866200811c56fe608060405234801561001057600080fd5b506040516105c23803806105c283398101604081905261002f916101af565b806001600160a01b0316632f2c3f2e6040518163ffffffff1660e01b8152600401602060405180830381865afa15801561006d573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610091919061029f565b83111561009d57600080fd5b600c80546001600160a01b038088166001600160a01b031992831617909255600d805492871692909116919091179055600e8390556040516100e39083906020016102b8565b604051602081830303815290604052600a9081610100919061036e565b508160405160200161011291906102b8565b604051602081830303815290604052600b908161012f919061036e565b5050601080546001600160a01b031916331790555061042d92505050565b6001600160a01b038116811461016257600080fd5b50565b80516101708161014d565b919050565b634e487b7160e01b600052604160045260246000fd5b60005b838110156101a657818101518382015260200161018e565b50506000910152565b600080600080600060a086880312156101c757600080fd5b85516101d28161014d565b60208701519095506101e38161014d565b6040870151606088015191955093506001600160401b038082111561020757600080fd5b818801915088601f83011261021b57600080fd5b81518181111561022d5761022d610175565b604051601f8201601f19908116603f0116810190838211818310171561025557610255610175565b816040528281528b602084870101111561026e57600080fd5b61027f83602083016020880161018b565b809650505050505061029360808701610165565b90509295509295909350565b6000602082840312156102b157600080fd5b5051919050565b644c4e544e2d60d81b8152600082516102d881600585016020870161018b565b9190910160050192915050565b600181811c908216806102f957607f821691505b60208210810361031957634e487b7160e01b600052602260045260246000fd5b50919050565b601f82111561036957600081815260208120601f850160051c810160208610156103465750805b601f850160051c820191505b8181101561036557828155600101610352565b5050505b505050565b81516001600160401b0381111561038757610387610175565b61039b8161039584546102e5565b8461031f565b602080601f8311600181146103d057600084156103b85750858301515b600019600386901b1c1916600185901b178555610365565b600085815260208120601f198616915b828110156103ff578886015182559484019460019091019084016103e0565b508582101561041d5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b6101868061043c6000396000f3fe60806040523661001b57610019610014610022565b6100fd565b005b6100196100145b600080601060009054906101000a90046001600160a01b03166001600160a01b03166396f18a326040518163ffffffff1660e01b8152600401602060405180830381865afa158015610078573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061009c9190610120565b90506001600160a01b0381166100f85760405162461bcd60e51b815260206004820152601d60248201527f6c6971756964206c6f67696320636f6e7472616374206e6f7420736574000000604482015260640160405180910390fd5b919050565b3660008037600080366000845af43d6000803e8061011a573d6000fd5b503d6000f35b60006020828403121561013257600080fd5b81516001600160a01b038116811461014957600080fd5b939250505056fea2646970667358221220b2e2dcd6d0d78f9596fd256c4e804aebbbccf69378693f30233ea3e89b3b740864736f6c63430008150033a2646970667358221220221a6a5e5e21073287988fcfbeacf07b94f4fb4b1ba0b42b4ca3961cdd21af5a64736f6c63430008150033608060405234801561001057600080fd5b50601080546001600160a01b03191633179055611702806100326000396000f3fe6080604052600436106101855760003560e01c80633e4eb36c116100d1578063949813b81161008a578063a0ce552d11610064578063a0ce552d146104d5578063a9059cbb146104e8578063bd96102f14610508578063dd62ed3e1461051d5761018c565b8063949813b81461046b57806395d89b41146104a05780639dc29fac146104b55761018c565b80633e4eb36c1461038a57806340c10f191461039f57806359355736146103bf57806370a08231146103f55780637eee288d1461042b57806384955c881461044b5761018c565b80631eeffad01161013e5780632f2c3f2e116101185780632f2c3f2e14610325578063313ce5671461033b578063372500ab146103575780633b19e84a1461036c5761018c565b80631eeffad0146102d057806323b872dd146102e5578063282d3fdf146103055761018c565b806306fdde03146101ea578063095ea7b3146102155780631195e07e1461024557806318160ddd14610277578063187cf4d71461029657806319fac8fd146102ae5761018c565b3661018c57005b60405162461bcd60e51b815260206004820152602860248201527f66616c6c6261636b206e6f7420696d706c656d656e74656420666f72204c69716044820152677569644c6f67696360c01b60648201526084015b60405180910390fd5b3480156101f657600080fd5b506101ff610563565b60405161020c919061145c565b60405180910390f35b34801561022157600080fd5b506102356102303660046114c6565b6105f5565b604051901515815260200161020c565b34801561025157600080fd5b50600c546001600160a01b03165b6040516001600160a01b03909116815260200161020c565b34801561028357600080fd5b506003545b60405190815260200161020c565b3480156102a257600080fd5b50610288633b9aca0081565b3480156102ba57600080fd5b506102ce6102c93660046114f0565b61060c565b005b3480156102dc57600080fd5b50600f54610288565b3480156102f157600080fd5b50610235610300366004611509565b61063b565b34801561031157600080fd5b506102ce6103203660046114c6565b610730565b34801561033157600080fd5b5061028861271081565b34801561034757600080fd5b506040516012815260200161020c565b34801561036357600080fd5b506102ce610814565b34801561037857600080fd5b50600d546001600160a01b031661025f565b34801561039657600080fd5b50600e54610288565b3480156103ab57600080fd5b506102ce6103ba3660046114c6565b6109f5565b3480156103cb57600080fd5b506102886103da366004611545565b6001600160a01b031660009081526001602052604090205490565b34801561040157600080fd5b50610288610410366004611545565b6001600160a01b031660009081526020819052604090205490565b34801561043757600080fd5b506102ce6104463660046114c6565b610a5d565b34801561045757600080fd5b50610288610466366004611545565b610b23565b34801561047757600080fd5b5061048b610486366004611545565b610b50565b6040805192835260208301919091520161020c565b3480156104ac57600080fd5b506101ff610c08565b3480156104c157600080fd5b506102ce6104d03660046114c6565b610c17565b61048b6104e33660046114f0565b610c77565b3480156104f457600080fd5b506102356105033660046114c6565b610f6b565b34801561051457600080fd5b506102ce610fb8565b34801561052957600080fd5b50610288610538366004611560565b6001600160a01b03918216600090815260026020908152604080832093909416825291909152205490565b6060600a805461057290611593565b80601f016020809104026020016040519081016040528092919081815260200182805461059e90611593565b80156105eb5780601f106105c0576101008083540402835291602001916105eb565b820191906000526020600020905b8154815290600101906020018083116105ce57829003601f168201915b5050505050905090565b60006106023384846110bf565b5060015b92915050565b6010546001600160a01b031633146106365760405162461bcd60e51b81526004016101e1906115cd565b600e55565b6001600160a01b0383166000908152600260209081526040808320338452909152812054828110156106c05760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e74206578636565647320616044820152676c6c6f77616e636560c01b60648201526084016101e1565b6106d485336106cf868561162b565b6110bf565b6106de85846111e3565b6106e884846112d7565b836001600160a01b0316856001600160a01b03166000805160206116ad8339815191528560405161071b91815260200190565b60405180910390a360019150505b9392505050565b6010546001600160a01b0316331461075a5760405162461bcd60e51b81526004016101e1906115cd565b6001600160a01b038216600090815260016020908152604080832054918390529091205482916107899161162b565b10156107e35760405162461bcd60e51b8152602060048201526024808201527f63616e2774206c6f636b206d6f72652066756e6473207468616e20617661696c60448201526361626c6560e01b60648201526084016101e1565b6001600160a01b0382166000908152600160205260408120805483929061080b90849061163e565b90915550505050565b60008061082033611323565b33600090815260046020908152604080832083905560079091528120819055919350915081156109035760105460405163a9059cbb60e01b8152336004820152602481018490526001600160a01b039091169063a9059cbb906044016020604051808303816000875af115801561089b573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906108bf9190611651565b9050806109035760405162461bcd60e51b81526020600482015260126024820152712330b4b632b2103a379039b2b73210272a2760711b60448201526064016101e1565b333b1561096357336001600160a01b031663161605e3846040518263ffffffff1660e01b81526004016000604051808303818588803b15801561094557600080fd5b505af1158015610959573d6000803e3d6000fd5b5050505050505050565b60405133908490600081818185875af1925050503d80600081146109a3576040519150601f19603f3d011682016040523d82523d6000602084013e6109a8565b606091505b505080915050806109f05760405162461bcd60e51b81526020600482015260126024820152712330b4b632b2103a379039b2b7321020aa2760711b60448201526064016101e1565b505050565b6010546001600160a01b03163314610a1f5760405162461bcd60e51b81526004016101e1906115cd565b610a2982826112d7565b6040518181526001600160a01b038316906000906000805160206116ad833981519152906020015b60405180910390a35050565b6010546001600160a01b03163314610a875760405162461bcd60e51b81526004016101e1906115cd565b6001600160a01b038216600090815260016020526040902054811115610afb5760405162461bcd60e51b815260206004820152602360248201527f63616e277420756e6c6f636b206d6f72652066756e6473207468616e206c6f636044820152621ad95960ea1b60648201526084016101e1565b6001600160a01b0382166000908152600160205260408120805483929061080b90849061162b565b6001600160a01b03811660009081526001602090815260408083205491839052822054610606919061162b565b6001600160a01b0381166000908152602081815260408083205460065460059093529083205483928391610b8591849161141e565b6001600160a01b038616600090815260046020526040902054909150610bac90829061163e565b6009546001600160a01b03871660009081526008602052604081205492965091610bd791859161141e565b6001600160a01b038716600090815260076020526040902054909150610bfe90829061163e565b9350505050915091565b6060600b805461057290611593565b6010546001600160a01b03163314610c415760405162461bcd60e51b81526004016101e1906115cd565b610c4b82826111e3565b6040518181526000906001600160a01b038416906000805160206116ad83398151915290602001610a51565b60105460009081906001600160a01b03163314610ca65760405162461bcd60e51b81526004016101e1906115cd565b600e54349060009061271090610cbc9084611673565b610cc6919061168a565b905081811115610d185760405162461bcd60e51b815260206004820152601c60248201527f696e76616c69642061746e2076616c696461746f72207265776172640000000060448201526064016101e1565b610d22818361162b565b600d546040519193506000916001600160a01b03909116906108fc90849084818181858888f193505050503d8060008114610d79576040519150601f19603f3d011682016040523d82523d6000602084013e610d7e565b606091505b5090915050801515600003610da55781600f6000828254610d9f919061163e565b90915550505b6000612710600e5488610db89190611673565b610dc2919061168a565b905086811115610e145760405162461bcd60e51b815260206004820152601c60248201527f696e76616c6964206e746e2076616c696461746f72207265776172640000000060448201526064016101e1565b610e1e818861162b565b96508015610ea157601054600d5460405163a9059cbb60e01b81526001600160a01b0391821660048201526024810184905291169063a9059cbb906044016020604051808303816000875af1158015610e7b573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610e9f9190611651565b505b600354600090610eb5633b9aca0087611673565b610ebf919061168a565b905080600654610ecf919061163e565b600655600354600090610ee6633b9aca008b611673565b610ef0919061168a565b905080600954610f00919061163e565b600955600354600090633b9aca0090610f199085611673565b610f23919061168a565b90506000633b9aca0060035484610f3a9190611673565b610f44919061168a565b9050610f50828861163e565b610f5a828761163e565b995099505050505050505050915091565b6000610f7733836111e3565b610f8183836112d7565b6040518281526001600160a01b0384169033906000805160206116ad8339815191529060200160405180910390a350600192915050565b600d546001600160a01b0316331461101d5760405162461bcd60e51b815260206004820152602260248201527f6f6e6c792074726561737572792063616e20636c61696d2068697320726577616044820152611c9960f21b60648201526084016101e1565b600d54600f546040516000926001600160a01b031691908381818185875af1925050503d806000811461106c576040519150601f19603f3d011682016040523d82523d6000602084013e611071565b606091505b50509050806110b75760405162461bcd60e51b81526020600482015260126024820152713330b4b632b2103a379039b2b7321020aa2760711b60448201526064016101e1565b506000600f55565b6001600160a01b0383166111215760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f206164646044820152637265737360e01b60648201526084016101e1565b6001600160a01b0382166111825760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f206164647265604482015261737360f01b60648201526084016101e1565b6001600160a01b0383811660008181526002602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b6111ec82611323565b50506001600160a01b0382166000908152602081815260408083205460019092529091205461121b908261162b565b82111561126a5760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e7420756e6c6f636b65642066756e6473000000000060448201526064016101e1565b611274828261162b565b6001600160a01b0384166000908152602081905260409020558082036112bb576001600160a01b038316600090815260056020908152604080832083905560089091528120555b81600360008282546112cd919061162b565b9091555050505050565b6112e082611323565b50506001600160a01b0382166000908152602081905260408120805483929061130a90849061163e565b92505081905550806003600082825461080b919061163e565b6001600160a01b038116600090815260208181526040808320546006546005909352908320548392839161135891849161141e565b6001600160a01b03861660009081526004602052604090205490915061137f90829061163e565b6001600160a01b03861660009081526004602090815260408083208490556006546005835281842055600954600890925282205492965090916113c391859161141e565b6001600160a01b0387166000908152600760205260409020549091506113ea90829061163e565b6001600160a01b03909616600090815260076020908152604080832089905560095460089092529091205550929492505050565b60008360000361143057506000610729565b633b9aca0084611440848661162b565b61144a9190611673565b611454919061168a565b949350505050565b600060208083528351808285015260005b818110156114895785810183015185820160400152820161146d565b506000604082860101526040601f19601f8301168501019250505092915050565b80356001600160a01b03811681146114c157600080fd5b919050565b600080604083850312156114d957600080fd5b6114e2836114aa565b946020939093013593505050565b60006020828403121561150257600080fd5b5035919050565b60008060006060848603121561151e57600080fd5b611527846114aa565b9250611535602085016114aa565b9150604084013590509250925092565b60006020828403121561155757600080fd5b610729826114aa565b6000806040838503121561157357600080fd5b61157c836114aa565b915061158a602084016114aa565b90509250929050565b600181811c908216806115a757607f821691505b6020821081036115c757634e487b7160e01b600052602260045260246000fd5b50919050565b60208082526028908201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060408201526710dbdb9d1c9858dd60c21b606082015260800190565b634e487b7160e01b600052601160045260246000fd5b8181038181111561060657610606611615565b8082018082111561060657610606611615565b60006020828403121561166357600080fd5b8151801515811461072957600080fd5b808202811582820484141761060657610606611615565b6000826116a757634e487b7160e01b600052601260045260246000fd5b50049056feddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3efa264697066735822122049ab38a03c54dd40123164b831272e589f044764b7e3a41d2336c0e5de4d867b64736f6c63430008150033608060405234801561001057600080fd5b506040516105c23803806105c283398101604081905261002f916101af565b806001600160a01b0316632f2c3f2e6040518163ffffffff1660e01b8152600401602060405180830381865afa15801561006d573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610091919061029f565b83111561009d57600080fd5b600c80546001600160a01b038088166001600160a01b031992831617909255600d805492871692909116919091179055600e8390556040516100e39083906020016102b8565b604051602081830303815290604052600a9081610100919061036e565b508160405160200161011291906102b8565b604051602081830303815290604052600b908161012f919061036e565b5050601080546001600160a01b031916331790555061042d92505050565b6001600160a01b038116811461016257600080fd5b50565b80516101708161014d565b919050565b634e487b7160e01b600052604160045260246000fd5b60005b838110156101a657818101518382015260200161018e565b50506000910152565b600080600080600060a086880312156101c757600080fd5b85516101d28161014d565b60208701519095506101e38161014d565b6040870151606088015191955093506001600160401b038082111561020757600080fd5b818801915088601f83011261021b57600080fd5b81518181111561022d5761022d610175565b604051601f8201601f19908116603f0116810190838211818310171561025557610255610175565b816040528281528b602084870101111561026e57600080fd5b61027f83602083016020880161018b565b809650505050505061029360808701610165565b90509295509295909350565b6000602082840312156102b157600080fd5b5051919050565b644c4e544e2d60d81b8152600082516102d881600585016020870161018b565b9190910160050192915050565b600181811c908216806102f957607f821691505b60208210810361031957634e487b7160e01b600052602260045260246000fd5b50919050565b601f82111561036957600081815260208120601f850160051c810160208610156103465750805b601f850160051c820191505b8181101561036557828155600101610352565b5050505b505050565b81516001600160401b0381111561038757610387610175565b61039b8161039584546102e5565b8461031f565b602080601f8311600181146103d057600084156103b85750858301515b600019600386901b1c1916600185901b178555610365565b600085815260208120601f198616915b828110156103ff578886015182559484019460019091019084016103e0565b508582101561041d5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b6101868061043c6000396000f3fe60806040523661001b57610019610014610022565b6100fd565b005b6100196100145b600080601060009054906101000a90046001600160a01b03166001600160a01b03166396f18a326040518163ffffffff1660e01b8152600401602060405180830381865afa158015610078573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061009c9190610120565b90506001600160a01b0381166100f85760405162461bcd60e51b815260206004820152601d60248201527f6c6971756964206c6f67696320636f6e7472616374206e6f7420736574000000604482015260640160405180910390fd5b919050565b3660008037600080366000845af43d6000803e8061011a573d6000fd5b503d6000f35b60006020828403121561013257600080fd5b81516001600160a01b038116811461014957600080fd5b939250505056fea2646970667358221220b2e2dcd6d0d78f9596fd256c4e804aebbbccf69378693f30233ea3e89b3b740864736f6c63430008150033",
 }
 
 // AutonityUpgradeTestABI is the input ABI used to generate the binding from.
@@ -8614,6 +8864,39 @@ func (_AutonityUpgradeTest *AutonityUpgradeTestCallerSession) GetCommitteeEnodes
 	return _AutonityUpgradeTest.Contract.GetCommitteeEnodes(&_AutonityUpgradeTest.CallOpts)
 }
 
+// GetConsensusTimeouts is a free data retrieval call binding the contract method 0x6be0487b.
+//
+// Solidity: function getConsensusTimeouts() view returns((uint256,uint256,uint256,uint256,uint256,uint256,bool), (uint256,uint256,uint256,uint256,uint256,uint256,bool), uint256)
+func (_AutonityUpgradeTest *AutonityUpgradeTestCaller) GetConsensusTimeouts(opts *bind.CallOpts) (AutonityConsensusTimeouts, AutonityConsensusTimeouts, *big.Int, error) {
+	var out []interface{}
+	err := _AutonityUpgradeTest.contract.Call(opts, &out, "getConsensusTimeouts")
+
+	if err != nil {
+		return *new(AutonityConsensusTimeouts), *new(AutonityConsensusTimeouts), *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(AutonityConsensusTimeouts)).(*AutonityConsensusTimeouts)
+	out1 := *abi.ConvertType(out[1], new(AutonityConsensusTimeouts)).(*AutonityConsensusTimeouts)
+	out2 := *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+
+	return out0, out1, out2, err
+
+}
+
+// GetConsensusTimeouts is a free data retrieval call binding the contract method 0x6be0487b.
+//
+// Solidity: function getConsensusTimeouts() view returns((uint256,uint256,uint256,uint256,uint256,uint256,bool), (uint256,uint256,uint256,uint256,uint256,uint256,bool), uint256)
+func (_AutonityUpgradeTest *AutonityUpgradeTestSession) GetConsensusTimeouts() (AutonityConsensusTimeouts, AutonityConsensusTimeouts, *big.Int, error) {
+	return _AutonityUpgradeTest.Contract.GetConsensusTimeouts(&_AutonityUpgradeTest.CallOpts)
+}
+
+// GetConsensusTimeouts is a free data retrieval call binding the contract method 0x6be0487b.
+//
+// Solidity: function getConsensusTimeouts() view returns((uint256,uint256,uint256,uint256,uint256,uint256,bool), (uint256,uint256,uint256,uint256,uint256,uint256,bool), uint256)
+func (_AutonityUpgradeTest *AutonityUpgradeTestCallerSession) GetConsensusTimeouts() (AutonityConsensusTimeouts, AutonityConsensusTimeouts, *big.Int, error) {
+	return _AutonityUpgradeTest.Contract.GetConsensusTimeouts(&_AutonityUpgradeTest.CallOpts)
+}
+
 // GetEpochByHeight is a free data retrieval call binding the contract method 0xaffb1cf1.
 //
 // Solidity: function getEpochByHeight(uint256 _height) view returns((address,uint256,bytes)[], uint256, uint256, uint256)
@@ -9117,6 +9400,37 @@ func (_AutonityUpgradeTest *AutonityUpgradeTestCallerSession) GetUnbondingReleas
 	return _AutonityUpgradeTest.Contract.GetUnbondingReleaseState(&_AutonityUpgradeTest.CallOpts, _unbondingID)
 }
 
+// GetUnbondingRequest is a free data retrieval call binding the contract method 0x30bcb81c.
+//
+// Solidity: function getUnbondingRequest(uint256 _unbondingID) view returns((address,address,uint256,uint256,uint256,uint256,uint8,bool,bool))
+func (_AutonityUpgradeTest *AutonityUpgradeTestCaller) GetUnbondingRequest(opts *bind.CallOpts, _unbondingID *big.Int) (AutonityUnbondingRequest, error) {
+	var out []interface{}
+	err := _AutonityUpgradeTest.contract.Call(opts, &out, "getUnbondingRequest", _unbondingID)
+
+	if err != nil {
+		return *new(AutonityUnbondingRequest), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(AutonityUnbondingRequest)).(*AutonityUnbondingRequest)
+
+	return out0, err
+
+}
+
+// GetUnbondingRequest is a free data retrieval call binding the contract method 0x30bcb81c.
+//
+// Solidity: function getUnbondingRequest(uint256 _unbondingID) view returns((address,address,uint256,uint256,uint256,uint256,uint8,bool,bool))
+func (_AutonityUpgradeTest *AutonityUpgradeTestSession) GetUnbondingRequest(_unbondingID *big.Int) (AutonityUnbondingRequest, error) {
+	return _AutonityUpgradeTest.Contract.GetUnbondingRequest(&_AutonityUpgradeTest.CallOpts, _unbondingID)
+}
+
+// GetUnbondingRequest is a free data retrieval call binding the contract method 0x30bcb81c.
+//
+// Solidity: function getUnbondingRequest(uint256 _unbondingID) view returns((address,address,uint256,uint256,uint256,uint256,uint8,bool,bool))
+func (_AutonityUpgradeTest *AutonityUpgradeTestCallerSession) GetUnbondingRequest(_unbondingID *big.Int) (AutonityUnbondingRequest, error) {
+	return _AutonityUpgradeTest.Contract.GetUnbondingRequest(&_AutonityUpgradeTest.CallOpts, _unbondingID)
+}
+
 // GetValidator is a free data retrieval call binding the contract method 0x1904bb2e.
 //
 // Solidity: function getValidator(address _addr) view returns((address,address,address,string,uint256,uint256,uint256,uint256,uint256,uint256,uint256,uint256,address,uint256,uint256,uint256,uint256,uint256,bytes,uint8))
@@ -9960,6 +10274,27 @@ func (_AutonityUpgradeTest *AutonityUpgradeTestTransactorSession) SetCommitteeSi
 	return _AutonityUpgradeTest.Contract.SetCommitteeSize(&_AutonityUpgradeTest.TransactOpts, _size)
 }
 
+// SetConsensusTimeouts is a paid mutator transaction binding the contract method 0x8d7835ea.
+//
+// Solidity: function setConsensusTimeouts((uint256,uint256,uint256,uint256,uint256,uint256,bool) _timeouts) returns()
+func (_AutonityUpgradeTest *AutonityUpgradeTestTransactor) SetConsensusTimeouts(opts *bind.TransactOpts, _timeouts AutonityConsensusTimeouts) (*types.Transaction, error) {
+	return _AutonityUpgradeTest.contract.Transact(opts, "setConsensusTimeouts", _timeouts)
+}
+
+// SetConsensusTimeouts is a paid mutator transaction binding the contract method 0x8d7835ea.
+//
+// Solidity: function setConsensusTimeouts((uint256,uint256,uint256,uint256,uint256,uint256,bool) _timeouts) returns()
+func (_AutonityUpgradeTest *AutonityUpgradeTestSession) SetConsensusTimeouts(_timeouts AutonityConsensusTimeouts) (*types.Transaction, error) {
+	return _AutonityUpgradeTest.Contract.SetConsensusTimeouts(&_AutonityUpgradeTest.TransactOpts, _timeouts)
+}
+
+// SetConsensusTimeouts is a paid mutator transaction binding the contract method 0x8d7835ea.
+//
+// Solidity: function setConsensusTimeouts((uint256,uint256,uint256,uint256,uint256,uint256,bool) _timeouts) returns()
+func (_AutonityUpgradeTest *AutonityUpgradeTestTransactorSession) SetConsensusTimeouts(_timeouts AutonityConsensusTimeouts) (*types.Transaction, error) {
+	return _AutonityUpgradeTest.Contract.SetConsensusTimeouts(&_AutonityUpgradeTest.TransactOpts, _timeouts)
+}
+
 // SetEpochPeriod is a paid mutator transaction binding the contract method 0x6b5f444c.
 //
 // Solidity: function setEpochPeriod(uint256 _period) returns()
@@ -11683,6 +12018,141 @@ func (_AutonityUpgradeTest *AutonityUpgradeTestFilterer) ParseCommissionRateChan
 	return event, nil
 }
 
+// AutonityUpgradeTestConsensusTimeoutsUpdatedIterator is returned from FilterConsensusTimeoutsUpdated and is used to iterate over the raw logs and unpacked data for ConsensusTimeoutsUpdated events raised by the AutonityUpgradeTest contract.
+type AutonityUpgradeTestConsensusTimeoutsUpdatedIterator struct {
+	Event *AutonityUpgradeTestConsensusTimeoutsUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AutonityUpgradeTestConsensusTimeoutsUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AutonityUpgradeTestConsensusTimeoutsUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AutonityUpgradeTestConsensusTimeoutsUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AutonityUpgradeTestConsensusTimeoutsUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AutonityUpgradeTestConsensusTimeoutsUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AutonityUpgradeTestConsensusTimeoutsUpdated represents a ConsensusTimeoutsUpdated event raised by the AutonityUpgradeTest contract.
+type AutonityUpgradeTestConsensusTimeoutsUpdated struct {
+	Timeouts           AutonityConsensusTimeouts
+	ToBeAppliedAtBlock *big.Int
+	Raw                types.Log // Blockchain specific contextual infos
+}
+
+// FilterConsensusTimeoutsUpdated is a free log retrieval operation binding the contract event 0xa4cc9b6d7e44f45ad3dfc950f52c5b7c915f5aaab5b2316be12db869407309f2.
+//
+// Solidity: event ConsensusTimeoutsUpdated((uint256,uint256,uint256,uint256,uint256,uint256,bool) timeouts, uint256 toBeAppliedAtBlock)
+func (_AutonityUpgradeTest *AutonityUpgradeTestFilterer) FilterConsensusTimeoutsUpdated(opts *bind.FilterOpts) (*AutonityUpgradeTestConsensusTimeoutsUpdatedIterator, error) {
+
+	logs, sub, err := _AutonityUpgradeTest.contract.FilterLogs(opts, "ConsensusTimeoutsUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &AutonityUpgradeTestConsensusTimeoutsUpdatedIterator{contract: _AutonityUpgradeTest.contract, event: "ConsensusTimeoutsUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchConsensusTimeoutsUpdated is a free log subscription operation binding the contract event 0xa4cc9b6d7e44f45ad3dfc950f52c5b7c915f5aaab5b2316be12db869407309f2.
+//
+// Solidity: event ConsensusTimeoutsUpdated((uint256,uint256,uint256,uint256,uint256,uint256,bool) timeouts, uint256 toBeAppliedAtBlock)
+func (_AutonityUpgradeTest *AutonityUpgradeTestFilterer) WatchConsensusTimeoutsUpdated(opts *bind.WatchOpts, sink chan<- *AutonityUpgradeTestConsensusTimeoutsUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _AutonityUpgradeTest.contract.WatchLogs(opts, "ConsensusTimeoutsUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AutonityUpgradeTestConsensusTimeoutsUpdated)
+				if err := _AutonityUpgradeTest.contract.UnpackLog(event, "ConsensusTimeoutsUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseConsensusTimeoutsUpdated is a log parse operation binding the contract event 0xa4cc9b6d7e44f45ad3dfc950f52c5b7c915f5aaab5b2316be12db869407309f2.
+//
+// Solidity: event ConsensusTimeoutsUpdated((uint256,uint256,uint256,uint256,uint256,uint256,bool) timeouts, uint256 toBeAppliedAtBlock)
+func (_AutonityUpgradeTest *AutonityUpgradeTestFilterer) ParseConsensusTimeoutsUpdated(log types.Log) (*AutonityUpgradeTestConsensusTimeoutsUpdated, error) {
+	event := new(AutonityUpgradeTestConsensusTimeoutsUpdated)
+	if err := _AutonityUpgradeTest.contract.UnpackLog(event, "ConsensusTimeoutsUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // AutonityUpgradeTestEpochPeriodUpdatedIterator is returned from FilterEpochPeriodUpdated and is used to iterate over the raw logs and unpacked data for EpochPeriodUpdated events raised by the AutonityUpgradeTest contract.
 type AutonityUpgradeTestEpochPeriodUpdatedIterator struct {
 	Event *AutonityUpgradeTestEpochPeriodUpdated // Event containing the contract specifics and raw log
@@ -13759,7 +14229,7 @@ var ContractBaseMetaData = &bind.MetaData{
 		"213fe2b7": "getContracts(address)",
 		"635bf933": "totalContracts(address)",
 	},
-	Bin: "0x608060405234801561001057600080fd5b5060405161067938038061067983398101604081905261002f91610078565b600280546001600160a01b039384166001600160a01b031991821617909155600380549290931691161790556100b2565b6001600160a01b038116811461007557600080fd5b50565b6000806040838503121561008b57600080fd5b825161009681610060565b60208401519092506100a781610060565b809150509250929050565b6105b8806100c16000396000f3fe608060405234801561001057600080fd5b506004361061004c5760003560e01c8063213fe2b7146100515780632afbbacb1461007a578063635bf9331461009d578063aad55726146100d4575b600080fd5b61006461005f366004610419565b6100f4565b604051610071919061043b565b60405180910390f35b61008d6100883660046104c0565b610268565b6040519015158152602001610071565b6100c66100ab366004610419565b6001600160a01b031660009081526020819052604090205490565b604051908152602001610071565b6100e76100e23660046104c0565b6102a6565b60405161007191906104ea565b6001600160a01b038116600090815260208190526040812080546060929067ffffffffffffffff81111561012a5761012a61052f565b60405190808252806020026020018201604052801561019657816020015b6101836040518060c0016040528060008152602001600081526020016000815260200160008152602001600081526020016000151581525090565b8152602001906001900390816101485790505b50905060005b81518110156102605760018382815481106101b9576101b9610545565b9060005260206000200154815481106101d4576101d4610545565b60009182526020918290206040805160c081018252600690930290910180548352600181015493830193909352600283015490820152600382015460608201526004820154608082015260059091015460ff16151560a0820152825183908390811061024257610242610545565b602002602001018190525080806102589061055b565b91505061019c565b509392505050565b60006001610276848461035f565b8154811061028657610286610545565b600091825260209091206005600690920201015460ff1690505b92915050565b6102e16040518060c0016040528060008152602001600081526020016000815260200160008152602001600081526020016000151581525090565b60016102ed848461035f565b815481106102fd576102fd610545565b60009182526020918290206040805160c081018252600690930290910180548352600181015493830193909352600283015490820152600382015460608201526004820154608082015260059091015460ff16151560a0820152905092915050565b6001600160a01b03821660009081526020819052604081205482106103c05760405162461bcd60e51b81526020600482015260136024820152721a5b9d985b1a590818dbdb9d1c9858dd081a59606a1b604482015260640160405180910390fd5b6001600160a01b03831660009081526020819052604090208054839081106103ea576103ea610545565b9060005260206000200154905092915050565b80356001600160a01b038116811461041457600080fd5b919050565b60006020828403121561042b57600080fd5b610434826103fd565b9392505050565b6020808252825182820181905260009190848201906040850190845b818110156104b4576104a1838551805182526020810151602083015260408101516040830152606081015160608301526080810151608083015260a0810151151560a08301525050565b9284019260c09290920191600101610457565b50909695505050505050565b600080604083850312156104d357600080fd5b6104dc836103fd565b946020939093013593505050565b60c081016102a08284805182526020810151602083015260408101516040830152606081015160608301526080810151608083015260a0810151151560a08301525050565b634e487b7160e01b600052604160045260246000fd5b634e487b7160e01b600052603260045260246000fd5b60006001820161057b57634e487b7160e01b600052601160045260246000fd5b506001019056fea26469706673582212207cd654fc7b8f0980d407a46841584e8c2d628ed43720434609b27a9405e5ab3364736f6c63430008150033",
+	Bin: "0x608060405234801561001057600080fd5b5060405161067938038061067983398101604081905261002f91610078565b600280546001600160a01b039384166001600160a01b031991821617909155600380549290931691161790556100b2565b6001600160a01b038116811461007557600080fd5b50565b6000806040838503121561008b57600080fd5b825161009681610060565b60208401519092506100a781610060565b809150509250929050565b6105b8806100c16000396000f3fe608060405234801561001057600080fd5b506004361061004c5760003560e01c8063213fe2b7146100515780632afbbacb1461007a578063635bf9331461009d578063aad55726146100d4575b600080fd5b61006461005f366004610419565b6100f4565b604051610071919061043b565b60405180910390f35b61008d6100883660046104c0565b610268565b6040519015158152602001610071565b6100c66100ab366004610419565b6001600160a01b031660009081526020819052604090205490565b604051908152602001610071565b6100e76100e23660046104c0565b6102a6565b60405161007191906104ea565b6001600160a01b038116600090815260208190526040812080546060929067ffffffffffffffff81111561012a5761012a61052f565b60405190808252806020026020018201604052801561019657816020015b6101836040518060c0016040528060008152602001600081526020016000815260200160008152602001600081526020016000151581525090565b8152602001906001900390816101485790505b50905060005b81518110156102605760018382815481106101b9576101b9610545565b9060005260206000200154815481106101d4576101d4610545565b60009182526020918290206040805160c081018252600690930290910180548352600181015493830193909352600283015490820152600382015460608201526004820154608082015260059091015460ff16151560a0820152825183908390811061024257610242610545565b602002602001018190525080806102589061055b565b91505061019c565b509392505050565b60006001610276848461035f565b8154811061028657610286610545565b600091825260209091206005600690920201015460ff1690505b92915050565b6102e16040518060c0016040528060008152602001600081526020016000815260200160008152602001600081526020016000151581525090565b60016102ed848461035f565b815481106102fd576102fd610545565b60009182526020918290206040805160c081018252600690930290910180548352600181015493830193909352600283015490820152600382015460608201526004820154608082015260059091015460ff16151560a0820152905092915050565b6001600160a01b03821660009081526020819052604081205482106103c05760405162461bcd60e51b81526020600482015260136024820152721a5b9d985b1a590818dbdb9d1c9858dd081a59606a1b604482015260640160405180910390fd5b6001600160a01b03831660009081526020819052604090208054839081106103ea576103ea610545565b9060005260206000200154905092915050565b80356001600160a01b038116811461041457600080fd5b919050565b60006020828403121561042b57600080fd5b610434826103fd565b9392505050565b6020808252825182820181905260009190848201906040850190845b818110156104b4576104a1838551805182526020810151602083015260408101516040830152606081015160608301526080810151608083015260a0810151151560a08301525050565b9284019260c09290920191600101610457565b50909695505050505050565b600080604083850312156104d357600080fd5b6104dc836103fd565b946020939093013593505050565b60c081016102a08284805182526020810151602083015260408101516040830152606081015160608301526080810151608083015260a0810151151560a08301525050565b634e487b7160e01b600052604160045260246000fd5b634e487b7160e01b600052603260045260246000fd5b60006001820161057b57634e487b7160e01b600052601160045260246000fd5b506001019056fea2646970667358221220e48aaf9d0dd5b12f41790f52785b04b091aef89bcf8bb7852692733160bc6df164736f6c63430008150033",
 }
 
 // ContractBaseABI is the input ABI used to generate the binding from.
@@ -19915,7 +20385,7 @@ var LiquidLogicMetaData = &bind.MetaData{
 		"7eee288d": "unlock(address,uint256)",
 		"84955c88": "unlockedBalanceOf(address)",
 	},
-	Bin: "0x608060405234801561001057600080fd5b50601080546001600160a01b03191633179055611702806100326000396000f3fe6080604052600436106101855760003560e01c80633e4eb36c116100d1578063949813b81161008a578063a0ce552d11610064578063a0ce552d146104d5578063a9059cbb146104e8578063bd96102f14610508578063dd62ed3e1461051d5761018c565b8063949813b81461046b57806395d89b41146104a05780639dc29fac146104b55761018c565b80633e4eb36c1461038a57806340c10f191461039f57806359355736146103bf57806370a08231146103f55780637eee288d1461042b57806384955c881461044b5761018c565b80631eeffad01161013e5780632f2c3f2e116101185780632f2c3f2e14610325578063313ce5671461033b578063372500ab146103575780633b19e84a1461036c5761018c565b80631eeffad0146102d057806323b872dd146102e5578063282d3fdf146103055761018c565b806306fdde03146101ea578063095ea7b3146102155780631195e07e1461024557806318160ddd14610277578063187cf4d71461029657806319fac8fd146102ae5761018c565b3661018c57005b60405162461bcd60e51b815260206004820152602860248201527f66616c6c6261636b206e6f7420696d706c656d656e74656420666f72204c69716044820152677569644c6f67696360c01b60648201526084015b60405180910390fd5b3480156101f657600080fd5b506101ff610563565b60405161020c919061145c565b60405180910390f35b34801561022157600080fd5b506102356102303660046114c6565b6105f5565b604051901515815260200161020c565b34801561025157600080fd5b50600c546001600160a01b03165b6040516001600160a01b03909116815260200161020c565b34801561028357600080fd5b506003545b60405190815260200161020c565b3480156102a257600080fd5b50610288633b9aca0081565b3480156102ba57600080fd5b506102ce6102c93660046114f0565b61060c565b005b3480156102dc57600080fd5b50600f54610288565b3480156102f157600080fd5b50610235610300366004611509565b61063b565b34801561031157600080fd5b506102ce6103203660046114c6565b610730565b34801561033157600080fd5b5061028861271081565b34801561034757600080fd5b506040516012815260200161020c565b34801561036357600080fd5b506102ce610814565b34801561037857600080fd5b50600d546001600160a01b031661025f565b34801561039657600080fd5b50600e54610288565b3480156103ab57600080fd5b506102ce6103ba3660046114c6565b6109f5565b3480156103cb57600080fd5b506102886103da366004611545565b6001600160a01b031660009081526001602052604090205490565b34801561040157600080fd5b50610288610410366004611545565b6001600160a01b031660009081526020819052604090205490565b34801561043757600080fd5b506102ce6104463660046114c6565b610a5d565b34801561045757600080fd5b50610288610466366004611545565b610b23565b34801561047757600080fd5b5061048b610486366004611545565b610b50565b6040805192835260208301919091520161020c565b3480156104ac57600080fd5b506101ff610c08565b3480156104c157600080fd5b506102ce6104d03660046114c6565b610c17565b61048b6104e33660046114f0565b610c77565b3480156104f457600080fd5b506102356105033660046114c6565b610f6b565b34801561051457600080fd5b506102ce610fb8565b34801561052957600080fd5b50610288610538366004611560565b6001600160a01b03918216600090815260026020908152604080832093909416825291909152205490565b6060600a805461057290611593565b80601f016020809104026020016040519081016040528092919081815260200182805461059e90611593565b80156105eb5780601f106105c0576101008083540402835291602001916105eb565b820191906000526020600020905b8154815290600101906020018083116105ce57829003601f168201915b5050505050905090565b60006106023384846110bf565b5060015b92915050565b6010546001600160a01b031633146106365760405162461bcd60e51b81526004016101e1906115cd565b600e55565b6001600160a01b0383166000908152600260209081526040808320338452909152812054828110156106c05760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e74206578636565647320616044820152676c6c6f77616e636560c01b60648201526084016101e1565b6106d485336106cf868561162b565b6110bf565b6106de85846111e3565b6106e884846112d7565b836001600160a01b0316856001600160a01b03166000805160206116ad8339815191528560405161071b91815260200190565b60405180910390a360019150505b9392505050565b6010546001600160a01b0316331461075a5760405162461bcd60e51b81526004016101e1906115cd565b6001600160a01b038216600090815260016020908152604080832054918390529091205482916107899161162b565b10156107e35760405162461bcd60e51b8152602060048201526024808201527f63616e2774206c6f636b206d6f72652066756e6473207468616e20617661696c60448201526361626c6560e01b60648201526084016101e1565b6001600160a01b0382166000908152600160205260408120805483929061080b90849061163e565b90915550505050565b60008061082033611323565b33600090815260046020908152604080832083905560079091528120819055919350915081156109035760105460405163a9059cbb60e01b8152336004820152602481018490526001600160a01b039091169063a9059cbb906044016020604051808303816000875af115801561089b573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906108bf9190611651565b9050806109035760405162461bcd60e51b81526020600482015260126024820152712330b4b632b2103a379039b2b73210272a2760711b60448201526064016101e1565b333b1561096357336001600160a01b031663161605e3846040518263ffffffff1660e01b81526004016000604051808303818588803b15801561094557600080fd5b505af1158015610959573d6000803e3d6000fd5b5050505050505050565b60405133908490600081818185875af1925050503d80600081146109a3576040519150601f19603f3d011682016040523d82523d6000602084013e6109a8565b606091505b505080915050806109f05760405162461bcd60e51b81526020600482015260126024820152712330b4b632b2103a379039b2b7321020aa2760711b60448201526064016101e1565b505050565b6010546001600160a01b03163314610a1f5760405162461bcd60e51b81526004016101e1906115cd565b610a2982826112d7565b6040518181526001600160a01b038316906000906000805160206116ad833981519152906020015b60405180910390a35050565b6010546001600160a01b03163314610a875760405162461bcd60e51b81526004016101e1906115cd565b6001600160a01b038216600090815260016020526040902054811115610afb5760405162461bcd60e51b815260206004820152602360248201527f63616e277420756e6c6f636b206d6f72652066756e6473207468616e206c6f636044820152621ad95960ea1b60648201526084016101e1565b6001600160a01b0382166000908152600160205260408120805483929061080b90849061162b565b6001600160a01b03811660009081526001602090815260408083205491839052822054610606919061162b565b6001600160a01b0381166000908152602081815260408083205460065460059093529083205483928391610b8591849161141e565b6001600160a01b038616600090815260046020526040902054909150610bac90829061163e565b6009546001600160a01b03871660009081526008602052604081205492965091610bd791859161141e565b6001600160a01b038716600090815260076020526040902054909150610bfe90829061163e565b9350505050915091565b6060600b805461057290611593565b6010546001600160a01b03163314610c415760405162461bcd60e51b81526004016101e1906115cd565b610c4b82826111e3565b6040518181526000906001600160a01b038416906000805160206116ad83398151915290602001610a51565b60105460009081906001600160a01b03163314610ca65760405162461bcd60e51b81526004016101e1906115cd565b600e54349060009061271090610cbc9084611673565b610cc6919061168a565b905081811115610d185760405162461bcd60e51b815260206004820152601c60248201527f696e76616c69642061746e2076616c696461746f72207265776172640000000060448201526064016101e1565b610d22818361162b565b600d546040519193506000916001600160a01b03909116906108fc90849084818181858888f193505050503d8060008114610d79576040519150601f19603f3d011682016040523d82523d6000602084013e610d7e565b606091505b5090915050801515600003610da55781600f6000828254610d9f919061163e565b90915550505b6000612710600e5488610db89190611673565b610dc2919061168a565b905086811115610e145760405162461bcd60e51b815260206004820152601c60248201527f696e76616c6964206e746e2076616c696461746f72207265776172640000000060448201526064016101e1565b610e1e818861162b565b96508015610ea157601054600d5460405163a9059cbb60e01b81526001600160a01b0391821660048201526024810184905291169063a9059cbb906044016020604051808303816000875af1158015610e7b573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610e9f9190611651565b505b600354600090610eb5633b9aca0087611673565b610ebf919061168a565b905080600654610ecf919061163e565b600655600354600090610ee6633b9aca008b611673565b610ef0919061168a565b905080600954610f00919061163e565b600955600354600090633b9aca0090610f199085611673565b610f23919061168a565b90506000633b9aca0060035484610f3a9190611673565b610f44919061168a565b9050610f50828861163e565b610f5a828761163e565b995099505050505050505050915091565b6000610f7733836111e3565b610f8183836112d7565b6040518281526001600160a01b0384169033906000805160206116ad8339815191529060200160405180910390a350600192915050565b600d546001600160a01b0316331461101d5760405162461bcd60e51b815260206004820152602260248201527f6f6e6c792074726561737572792063616e20636c61696d2068697320726577616044820152611c9960f21b60648201526084016101e1565b600d54600f546040516000926001600160a01b031691908381818185875af1925050503d806000811461106c576040519150601f19603f3d011682016040523d82523d6000602084013e611071565b606091505b50509050806110b75760405162461bcd60e51b81526020600482015260126024820152713330b4b632b2103a379039b2b7321020aa2760711b60448201526064016101e1565b506000600f55565b6001600160a01b0383166111215760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f206164646044820152637265737360e01b60648201526084016101e1565b6001600160a01b0382166111825760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f206164647265604482015261737360f01b60648201526084016101e1565b6001600160a01b0383811660008181526002602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b6111ec82611323565b50506001600160a01b0382166000908152602081815260408083205460019092529091205461121b908261162b565b82111561126a5760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e7420756e6c6f636b65642066756e6473000000000060448201526064016101e1565b611274828261162b565b6001600160a01b0384166000908152602081905260409020558082036112bb576001600160a01b038316600090815260056020908152604080832083905560089091528120555b81600360008282546112cd919061162b565b9091555050505050565b6112e082611323565b50506001600160a01b0382166000908152602081905260408120805483929061130a90849061163e565b92505081905550806003600082825461080b919061163e565b6001600160a01b038116600090815260208181526040808320546006546005909352908320548392839161135891849161141e565b6001600160a01b03861660009081526004602052604090205490915061137f90829061163e565b6001600160a01b03861660009081526004602090815260408083208490556006546005835281842055600954600890925282205492965090916113c391859161141e565b6001600160a01b0387166000908152600760205260409020549091506113ea90829061163e565b6001600160a01b03909616600090815260076020908152604080832089905560095460089092529091205550929492505050565b60008360000361143057506000610729565b633b9aca0084611440848661162b565b61144a9190611673565b611454919061168a565b949350505050565b600060208083528351808285015260005b818110156114895785810183015185820160400152820161146d565b506000604082860101526040601f19601f8301168501019250505092915050565b80356001600160a01b03811681146114c157600080fd5b919050565b600080604083850312156114d957600080fd5b6114e2836114aa565b946020939093013593505050565b60006020828403121561150257600080fd5b5035919050565b60008060006060848603121561151e57600080fd5b611527846114aa565b9250611535602085016114aa565b9150604084013590509250925092565b60006020828403121561155757600080fd5b610729826114aa565b6000806040838503121561157357600080fd5b61157c836114aa565b915061158a602084016114aa565b90509250929050565b600181811c908216806115a757607f821691505b6020821081036115c757634e487b7160e01b600052602260045260246000fd5b50919050565b60208082526028908201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060408201526710dbdb9d1c9858dd60c21b606082015260800190565b634e487b7160e01b600052601160045260246000fd5b8181038181111561060657610606611615565b8082018082111561060657610606611615565b60006020828403121561166357600080fd5b8151801515811461072957600080fd5b808202811582820484141761060657610606611615565b6000826116a757634e487b7160e01b600052601260045260246000fd5b50049056feddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3efa264697066735822122048794c7bb1d2b72d7f520e80a6d347ea4d627cc772c643cc5a10c495e833a56d64736f6c63430008150033",
+	Bin: "0x608060405234801561001057600080fd5b50601080546001600160a01b03191633179055611702806100326000396000f3fe6080604052600436106101855760003560e01c80633e4eb36c116100d1578063949813b81161008a578063a0ce552d11610064578063a0ce552d146104d5578063a9059cbb146104e8578063bd96102f14610508578063dd62ed3e1461051d5761018c565b8063949813b81461046b57806395d89b41146104a05780639dc29fac146104b55761018c565b80633e4eb36c1461038a57806340c10f191461039f57806359355736146103bf57806370a08231146103f55780637eee288d1461042b57806384955c881461044b5761018c565b80631eeffad01161013e5780632f2c3f2e116101185780632f2c3f2e14610325578063313ce5671461033b578063372500ab146103575780633b19e84a1461036c5761018c565b80631eeffad0146102d057806323b872dd146102e5578063282d3fdf146103055761018c565b806306fdde03146101ea578063095ea7b3146102155780631195e07e1461024557806318160ddd14610277578063187cf4d71461029657806319fac8fd146102ae5761018c565b3661018c57005b60405162461bcd60e51b815260206004820152602860248201527f66616c6c6261636b206e6f7420696d706c656d656e74656420666f72204c69716044820152677569644c6f67696360c01b60648201526084015b60405180910390fd5b3480156101f657600080fd5b506101ff610563565b60405161020c919061145c565b60405180910390f35b34801561022157600080fd5b506102356102303660046114c6565b6105f5565b604051901515815260200161020c565b34801561025157600080fd5b50600c546001600160a01b03165b6040516001600160a01b03909116815260200161020c565b34801561028357600080fd5b506003545b60405190815260200161020c565b3480156102a257600080fd5b50610288633b9aca0081565b3480156102ba57600080fd5b506102ce6102c93660046114f0565b61060c565b005b3480156102dc57600080fd5b50600f54610288565b3480156102f157600080fd5b50610235610300366004611509565b61063b565b34801561031157600080fd5b506102ce6103203660046114c6565b610730565b34801561033157600080fd5b5061028861271081565b34801561034757600080fd5b506040516012815260200161020c565b34801561036357600080fd5b506102ce610814565b34801561037857600080fd5b50600d546001600160a01b031661025f565b34801561039657600080fd5b50600e54610288565b3480156103ab57600080fd5b506102ce6103ba3660046114c6565b6109f5565b3480156103cb57600080fd5b506102886103da366004611545565b6001600160a01b031660009081526001602052604090205490565b34801561040157600080fd5b50610288610410366004611545565b6001600160a01b031660009081526020819052604090205490565b34801561043757600080fd5b506102ce6104463660046114c6565b610a5d565b34801561045757600080fd5b50610288610466366004611545565b610b23565b34801561047757600080fd5b5061048b610486366004611545565b610b50565b6040805192835260208301919091520161020c565b3480156104ac57600080fd5b506101ff610c08565b3480156104c157600080fd5b506102ce6104d03660046114c6565b610c17565b61048b6104e33660046114f0565b610c77565b3480156104f457600080fd5b506102356105033660046114c6565b610f6b565b34801561051457600080fd5b506102ce610fb8565b34801561052957600080fd5b50610288610538366004611560565b6001600160a01b03918216600090815260026020908152604080832093909416825291909152205490565b6060600a805461057290611593565b80601f016020809104026020016040519081016040528092919081815260200182805461059e90611593565b80156105eb5780601f106105c0576101008083540402835291602001916105eb565b820191906000526020600020905b8154815290600101906020018083116105ce57829003601f168201915b5050505050905090565b60006106023384846110bf565b5060015b92915050565b6010546001600160a01b031633146106365760405162461bcd60e51b81526004016101e1906115cd565b600e55565b6001600160a01b0383166000908152600260209081526040808320338452909152812054828110156106c05760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e74206578636565647320616044820152676c6c6f77616e636560c01b60648201526084016101e1565b6106d485336106cf868561162b565b6110bf565b6106de85846111e3565b6106e884846112d7565b836001600160a01b0316856001600160a01b03166000805160206116ad8339815191528560405161071b91815260200190565b60405180910390a360019150505b9392505050565b6010546001600160a01b0316331461075a5760405162461bcd60e51b81526004016101e1906115cd565b6001600160a01b038216600090815260016020908152604080832054918390529091205482916107899161162b565b10156107e35760405162461bcd60e51b8152602060048201526024808201527f63616e2774206c6f636b206d6f72652066756e6473207468616e20617661696c60448201526361626c6560e01b60648201526084016101e1565b6001600160a01b0382166000908152600160205260408120805483929061080b90849061163e565b90915550505050565b60008061082033611323565b33600090815260046020908152604080832083905560079091528120819055919350915081156109035760105460405163a9059cbb60e01b8152336004820152602481018490526001600160a01b039091169063a9059cbb906044016020604051808303816000875af115801561089b573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906108bf9190611651565b9050806109035760405162461bcd60e51b81526020600482015260126024820152712330b4b632b2103a379039b2b73210272a2760711b60448201526064016101e1565b333b1561096357336001600160a01b031663161605e3846040518263ffffffff1660e01b81526004016000604051808303818588803b15801561094557600080fd5b505af1158015610959573d6000803e3d6000fd5b5050505050505050565b60405133908490600081818185875af1925050503d80600081146109a3576040519150601f19603f3d011682016040523d82523d6000602084013e6109a8565b606091505b505080915050806109f05760405162461bcd60e51b81526020600482015260126024820152712330b4b632b2103a379039b2b7321020aa2760711b60448201526064016101e1565b505050565b6010546001600160a01b03163314610a1f5760405162461bcd60e51b81526004016101e1906115cd565b610a2982826112d7565b6040518181526001600160a01b038316906000906000805160206116ad833981519152906020015b60405180910390a35050565b6010546001600160a01b03163314610a875760405162461bcd60e51b81526004016101e1906115cd565b6001600160a01b038216600090815260016020526040902054811115610afb5760405162461bcd60e51b815260206004820152602360248201527f63616e277420756e6c6f636b206d6f72652066756e6473207468616e206c6f636044820152621ad95960ea1b60648201526084016101e1565b6001600160a01b0382166000908152600160205260408120805483929061080b90849061162b565b6001600160a01b03811660009081526001602090815260408083205491839052822054610606919061162b565b6001600160a01b0381166000908152602081815260408083205460065460059093529083205483928391610b8591849161141e565b6001600160a01b038616600090815260046020526040902054909150610bac90829061163e565b6009546001600160a01b03871660009081526008602052604081205492965091610bd791859161141e565b6001600160a01b038716600090815260076020526040902054909150610bfe90829061163e565b9350505050915091565b6060600b805461057290611593565b6010546001600160a01b03163314610c415760405162461bcd60e51b81526004016101e1906115cd565b610c4b82826111e3565b6040518181526000906001600160a01b038416906000805160206116ad83398151915290602001610a51565b60105460009081906001600160a01b03163314610ca65760405162461bcd60e51b81526004016101e1906115cd565b600e54349060009061271090610cbc9084611673565b610cc6919061168a565b905081811115610d185760405162461bcd60e51b815260206004820152601c60248201527f696e76616c69642061746e2076616c696461746f72207265776172640000000060448201526064016101e1565b610d22818361162b565b600d546040519193506000916001600160a01b03909116906108fc90849084818181858888f193505050503d8060008114610d79576040519150601f19603f3d011682016040523d82523d6000602084013e610d7e565b606091505b5090915050801515600003610da55781600f6000828254610d9f919061163e565b90915550505b6000612710600e5488610db89190611673565b610dc2919061168a565b905086811115610e145760405162461bcd60e51b815260206004820152601c60248201527f696e76616c6964206e746e2076616c696461746f72207265776172640000000060448201526064016101e1565b610e1e818861162b565b96508015610ea157601054600d5460405163a9059cbb60e01b81526001600160a01b0391821660048201526024810184905291169063a9059cbb906044016020604051808303816000875af1158015610e7b573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610e9f9190611651565b505b600354600090610eb5633b9aca0087611673565b610ebf919061168a565b905080600654610ecf919061163e565b600655600354600090610ee6633b9aca008b611673565b610ef0919061168a565b905080600954610f00919061163e565b600955600354600090633b9aca0090610f199085611673565b610f23919061168a565b90506000633b9aca0060035484610f3a9190611673565b610f44919061168a565b9050610f50828861163e565b610f5a828761163e565b995099505050505050505050915091565b6000610f7733836111e3565b610f8183836112d7565b6040518281526001600160a01b0384169033906000805160206116ad8339815191529060200160405180910390a350600192915050565b600d546001600160a01b0316331461101d5760405162461bcd60e51b815260206004820152602260248201527f6f6e6c792074726561737572792063616e20636c61696d2068697320726577616044820152611c9960f21b60648201526084016101e1565b600d54600f546040516000926001600160a01b031691908381818185875af1925050503d806000811461106c576040519150601f19603f3d011682016040523d82523d6000602084013e611071565b606091505b50509050806110b75760405162461bcd60e51b81526020600482015260126024820152713330b4b632b2103a379039b2b7321020aa2760711b60448201526064016101e1565b506000600f55565b6001600160a01b0383166111215760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f206164646044820152637265737360e01b60648201526084016101e1565b6001600160a01b0382166111825760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f206164647265604482015261737360f01b60648201526084016101e1565b6001600160a01b0383811660008181526002602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b6111ec82611323565b50506001600160a01b0382166000908152602081815260408083205460019092529091205461121b908261162b565b82111561126a5760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e7420756e6c6f636b65642066756e6473000000000060448201526064016101e1565b611274828261162b565b6001600160a01b0384166000908152602081905260409020558082036112bb576001600160a01b038316600090815260056020908152604080832083905560089091528120555b81600360008282546112cd919061162b565b9091555050505050565b6112e082611323565b50506001600160a01b0382166000908152602081905260408120805483929061130a90849061163e565b92505081905550806003600082825461080b919061163e565b6001600160a01b038116600090815260208181526040808320546006546005909352908320548392839161135891849161141e565b6001600160a01b03861660009081526004602052604090205490915061137f90829061163e565b6001600160a01b03861660009081526004602090815260408083208490556006546005835281842055600954600890925282205492965090916113c391859161141e565b6001600160a01b0387166000908152600760205260409020549091506113ea90829061163e565b6001600160a01b03909616600090815260076020908152604080832089905560095460089092529091205550929492505050565b60008360000361143057506000610729565b633b9aca0084611440848661162b565b61144a9190611673565b611454919061168a565b949350505050565b600060208083528351808285015260005b818110156114895785810183015185820160400152820161146d565b506000604082860101526040601f19601f8301168501019250505092915050565b80356001600160a01b03811681146114c157600080fd5b919050565b600080604083850312156114d957600080fd5b6114e2836114aa565b946020939093013593505050565b60006020828403121561150257600080fd5b5035919050565b60008060006060848603121561151e57600080fd5b611527846114aa565b9250611535602085016114aa565b9150604084013590509250925092565b60006020828403121561155757600080fd5b610729826114aa565b6000806040838503121561157357600080fd5b61157c836114aa565b915061158a602084016114aa565b90509250929050565b600181811c908216806115a757607f821691505b6020821081036115c757634e487b7160e01b600052602260045260246000fd5b50919050565b60208082526028908201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060408201526710dbdb9d1c9858dd60c21b606082015260800190565b634e487b7160e01b600052601160045260246000fd5b8181038181111561060657610606611615565b8082018082111561060657610606611615565b60006020828403121561166357600080fd5b8151801515811461072957600080fd5b808202811582820484141761060657610606611615565b6000826116a757634e487b7160e01b600052601260045260246000fd5b50049056feddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3efa264697066735822122049ab38a03c54dd40123164b831272e589f044764b7e3a41d2336c0e5de4d867b64736f6c63430008150033",
 }
 
 // LiquidLogicABI is the input ABI used to generate the binding from.
@@ -21155,7 +21625,7 @@ var LiquidRewardManagerMetaData = &bind.MetaData{
 	Sigs: map[string]string{
 		"187cf4d7": "FEE_FACTOR_UNIT_RECIP()",
 	},
-	Bin: "0x608060405234801561001057600080fd5b5060405161011138038061011183398101604081905261002f91610054565b600180546001600160a01b0319166001600160a01b0392909216919091179055610084565b60006020828403121561006657600080fd5b81516001600160a01b038116811461007d57600080fd5b9392505050565b607f806100926000396000f3fe6080604052348015600f57600080fd5b506004361060285760003560e01c8063187cf4d714602d575b600080fd5b6037633b9aca0081565b60405190815260200160405180910390f3fea2646970667358221220e4fc8b51e2c726ea27101d115522f8f981fcefe86cf441ee514cfbf272792c1064736f6c63430008150033",
+	Bin: "0x608060405234801561001057600080fd5b5060405161011138038061011183398101604081905261002f91610054565b600180546001600160a01b0319166001600160a01b0392909216919091179055610084565b60006020828403121561006657600080fd5b81516001600160a01b038116811461007d57600080fd5b9392505050565b607f806100926000396000f3fe6080604052348015600f57600080fd5b506004361060285760003560e01c8063187cf4d714602d575b600080fd5b6037633b9aca0081565b60405190815260200160405180910390f3fea2646970667358221220b751b357c04338cc2dbf02e80a79b39c5ce72f6182f00ea612c8a5fe6d2d2e8e64736f6c63430008150033",
 }
 
 // LiquidRewardManagerABI is the input ABI used to generate the binding from.
@@ -21363,7 +21833,7 @@ func (_LiquidRewardManager *LiquidRewardManagerCallerSession) FEEFACTORUNITRECIP
 // LiquidStateMetaData contains all meta data concerning the LiquidState contract.
 var LiquidStateMetaData = &bind.MetaData{
 	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"},{\"internalType\":\"addresspayable\",\"name\":\"_treasury\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_commissionRate\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"_index\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_liquidLogicAddress\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"stateMutability\":\"payable\",\"type\":\"fallback\"},{\"stateMutability\":\"payable\",\"type\":\"receive\"}]",
-	Bin: "0x608060405234801561001057600080fd5b506040516105c23803806105c283398101604081905261002f916101af565b806001600160a01b0316632f2c3f2e6040518163ffffffff1660e01b8152600401602060405180830381865afa15801561006d573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610091919061029f565b83111561009d57600080fd5b600c80546001600160a01b038088166001600160a01b031992831617909255600d805492871692909116919091179055600e8390556040516100e39083906020016102b8565b604051602081830303815290604052600a9081610100919061036e565b508160405160200161011291906102b8565b604051602081830303815290604052600b908161012f919061036e565b5050601080546001600160a01b031916331790555061042d92505050565b6001600160a01b038116811461016257600080fd5b50565b80516101708161014d565b919050565b634e487b7160e01b600052604160045260246000fd5b60005b838110156101a657818101518382015260200161018e565b50506000910152565b600080600080600060a086880312156101c757600080fd5b85516101d28161014d565b60208701519095506101e38161014d565b6040870151606088015191955093506001600160401b038082111561020757600080fd5b818801915088601f83011261021b57600080fd5b81518181111561022d5761022d610175565b604051601f8201601f19908116603f0116810190838211818310171561025557610255610175565b816040528281528b602084870101111561026e57600080fd5b61027f83602083016020880161018b565b809650505050505061029360808701610165565b90509295509295909350565b6000602082840312156102b157600080fd5b5051919050565b644c4e544e2d60d81b8152600082516102d881600585016020870161018b565b9190910160050192915050565b600181811c908216806102f957607f821691505b60208210810361031957634e487b7160e01b600052602260045260246000fd5b50919050565b601f82111561036957600081815260208120601f850160051c810160208610156103465750805b601f850160051c820191505b8181101561036557828155600101610352565b5050505b505050565b81516001600160401b0381111561038757610387610175565b61039b8161039584546102e5565b8461031f565b602080601f8311600181146103d057600084156103b85750858301515b600019600386901b1c1916600185901b178555610365565b600085815260208120601f198616915b828110156103ff578886015182559484019460019091019084016103e0565b508582101561041d5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b6101868061043c6000396000f3fe60806040523661001b57610019610014610022565b6100fd565b005b6100196100145b600080601060009054906101000a90046001600160a01b03166001600160a01b03166396f18a326040518163ffffffff1660e01b8152600401602060405180830381865afa158015610078573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061009c9190610120565b90506001600160a01b0381166100f85760405162461bcd60e51b815260206004820152601d60248201527f6c6971756964206c6f67696320636f6e7472616374206e6f7420736574000000604482015260640160405180910390fd5b919050565b3660008037600080366000845af43d6000803e8061011a573d6000fd5b503d6000f35b60006020828403121561013257600080fd5b81516001600160a01b038116811461014957600080fd5b939250505056fea2646970667358221220c84647d3d92939f0d0c4b8cb8870025e67a96efe3618fd5883fa09e82290991164736f6c63430008150033",
+	Bin: "0x608060405234801561001057600080fd5b506040516105c23803806105c283398101604081905261002f916101af565b806001600160a01b0316632f2c3f2e6040518163ffffffff1660e01b8152600401602060405180830381865afa15801561006d573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610091919061029f565b83111561009d57600080fd5b600c80546001600160a01b038088166001600160a01b031992831617909255600d805492871692909116919091179055600e8390556040516100e39083906020016102b8565b604051602081830303815290604052600a9081610100919061036e565b508160405160200161011291906102b8565b604051602081830303815290604052600b908161012f919061036e565b5050601080546001600160a01b031916331790555061042d92505050565b6001600160a01b038116811461016257600080fd5b50565b80516101708161014d565b919050565b634e487b7160e01b600052604160045260246000fd5b60005b838110156101a657818101518382015260200161018e565b50506000910152565b600080600080600060a086880312156101c757600080fd5b85516101d28161014d565b60208701519095506101e38161014d565b6040870151606088015191955093506001600160401b038082111561020757600080fd5b818801915088601f83011261021b57600080fd5b81518181111561022d5761022d610175565b604051601f8201601f19908116603f0116810190838211818310171561025557610255610175565b816040528281528b602084870101111561026e57600080fd5b61027f83602083016020880161018b565b809650505050505061029360808701610165565b90509295509295909350565b6000602082840312156102b157600080fd5b5051919050565b644c4e544e2d60d81b8152600082516102d881600585016020870161018b565b9190910160050192915050565b600181811c908216806102f957607f821691505b60208210810361031957634e487b7160e01b600052602260045260246000fd5b50919050565b601f82111561036957600081815260208120601f850160051c810160208610156103465750805b601f850160051c820191505b8181101561036557828155600101610352565b5050505b505050565b81516001600160401b0381111561038757610387610175565b61039b8161039584546102e5565b8461031f565b602080601f8311600181146103d057600084156103b85750858301515b600019600386901b1c1916600185901b178555610365565b600085815260208120601f198616915b828110156103ff578886015182559484019460019091019084016103e0565b508582101561041d5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b6101868061043c6000396000f3fe60806040523661001b57610019610014610022565b6100fd565b005b6100196100145b600080601060009054906101000a90046001600160a01b03166001600160a01b03166396f18a326040518163ffffffff1660e01b8152600401602060405180830381865afa158015610078573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061009c9190610120565b90506001600160a01b0381166100f85760405162461bcd60e51b815260206004820152601d60248201527f6c6971756964206c6f67696320636f6e7472616374206e6f7420736574000000604482015260640160405180910390fd5b919050565b3660008037600080366000845af43d6000803e8061011a573d6000fd5b503d6000f35b60006020828403121561013257600080fd5b81516001600160a01b038116811461014957600080fd5b939250505056fea2646970667358221220b2e2dcd6d0d78f9596fd256c4e804aebbbccf69378693f30233ea3e89b3b740864736f6c63430008150033",
 }
 
 // LiquidStateABI is the input ABI used to generate the binding from.
@@ -21581,7 +22051,7 @@ var LiquidStateTestMetaData = &bind.MetaData{
 	Sigs: map[string]string{
 		"96f18a32": "liquidLogicContract()",
 	},
-	Bin: "0x608060405234801561001057600080fd5b5060405161062e38038061062e83398101604081905261002f916101b9565b8484848484806001600160a01b0316632f2c3f2e6040518163ffffffff1660e01b8152600401602060405180830381865afa158015610072573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061009691906102a9565b8311156100a257600080fd5b600c80546001600160a01b038088166001600160a01b031992831617909255600d805492871692909116919091179055600e8390556040516100e89083906020016102c2565b604051602081830303815290604052600a90816101059190610378565b508160405160200161011791906102c2565b604051602081830303815290604052600b90816101349190610378565b5050601080546001600160a01b0319163317905550610437975050505050505050565b6001600160a01b038116811461016c57600080fd5b50565b805161017a81610157565b919050565b634e487b7160e01b600052604160045260246000fd5b60005b838110156101b0578181015183820152602001610198565b50506000910152565b600080600080600060a086880312156101d157600080fd5b85516101dc81610157565b60208701519095506101ed81610157565b6040870151606088015191955093506001600160401b038082111561021157600080fd5b818801915088601f83011261022557600080fd5b8151818111156102375761023761017f565b604051601f8201601f19908116603f0116810190838211818310171561025f5761025f61017f565b816040528281528b602084870101111561027857600080fd5b610289836020830160208801610195565b809650505050505061029d6080870161016f565b90509295509295909350565b6000602082840312156102bb57600080fd5b5051919050565b644c4e544e2d60d81b8152600082516102e2816005850160208701610195565b9190910160050192915050565b600181811c9082168061030357607f821691505b60208210810361032357634e487b7160e01b600052602260045260246000fd5b50919050565b601f82111561037357600081815260208120601f850160051c810160208610156103505750805b601f850160051c820191505b8181101561036f5782815560010161035c565b5050505b505050565b81516001600160401b038111156103915761039161017f565b6103a58161039f84546102ef565b84610329565b602080601f8311600181146103da57600084156103c25750858301515b600019600386901b1c1916600185901b17855561036f565b600085815260208120601f198616915b82811015610409578886015182559484019460019091019084016103ea565b50858210156104275787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b6101e8806104466000396000f3fe6080604052600436106100225760003560e01c806396f18a321461004457610039565b3661003957610037610032610075565b610150565b005b610037610032610075565b34801561005057600080fd5b50610059610173565b6040516001600160a01b03909116815260200160405180910390f35b600080601060009054906101000a90046001600160a01b03166001600160a01b03166396f18a326040518163ffffffff1660e01b8152600401602060405180830381865afa1580156100cb573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906100ef9190610182565b90506001600160a01b03811661014b5760405162461bcd60e51b815260206004820152601d60248201527f6c6971756964206c6f67696320636f6e7472616374206e6f7420736574000000604482015260640160405180910390fd5b919050565b3660008037600080366000845af43d6000803e8061016d573d6000fd5b503d6000f35b600061017d610075565b905090565b60006020828403121561019457600080fd5b81516001600160a01b03811681146101ab57600080fd5b939250505056fea2646970667358221220baa5e27e79de56e72d019dd5a6227258d9ea885710934cc41c08bacd553047c164736f6c63430008150033",
+	Bin: "0x608060405234801561001057600080fd5b5060405161062e38038061062e83398101604081905261002f916101b9565b8484848484806001600160a01b0316632f2c3f2e6040518163ffffffff1660e01b8152600401602060405180830381865afa158015610072573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061009691906102a9565b8311156100a257600080fd5b600c80546001600160a01b038088166001600160a01b031992831617909255600d805492871692909116919091179055600e8390556040516100e89083906020016102c2565b604051602081830303815290604052600a90816101059190610378565b508160405160200161011791906102c2565b604051602081830303815290604052600b90816101349190610378565b5050601080546001600160a01b0319163317905550610437975050505050505050565b6001600160a01b038116811461016c57600080fd5b50565b805161017a81610157565b919050565b634e487b7160e01b600052604160045260246000fd5b60005b838110156101b0578181015183820152602001610198565b50506000910152565b600080600080600060a086880312156101d157600080fd5b85516101dc81610157565b60208701519095506101ed81610157565b6040870151606088015191955093506001600160401b038082111561021157600080fd5b818801915088601f83011261022557600080fd5b8151818111156102375761023761017f565b604051601f8201601f19908116603f0116810190838211818310171561025f5761025f61017f565b816040528281528b602084870101111561027857600080fd5b610289836020830160208801610195565b809650505050505061029d6080870161016f565b90509295509295909350565b6000602082840312156102bb57600080fd5b5051919050565b644c4e544e2d60d81b8152600082516102e2816005850160208701610195565b9190910160050192915050565b600181811c9082168061030357607f821691505b60208210810361032357634e487b7160e01b600052602260045260246000fd5b50919050565b601f82111561037357600081815260208120601f850160051c810160208610156103505750805b601f850160051c820191505b8181101561036f5782815560010161035c565b5050505b505050565b81516001600160401b038111156103915761039161017f565b6103a58161039f84546102ef565b84610329565b602080601f8311600181146103da57600084156103c25750858301515b600019600386901b1c1916600185901b17855561036f565b600085815260208120601f198616915b82811015610409578886015182559484019460019091019084016103ea565b50858210156104275787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b6101e8806104466000396000f3fe6080604052600436106100225760003560e01c806396f18a321461004457610039565b3661003957610037610032610075565b610150565b005b610037610032610075565b34801561005057600080fd5b50610059610173565b6040516001600160a01b03909116815260200160405180910390f35b600080601060009054906101000a90046001600160a01b03166001600160a01b03166396f18a326040518163ffffffff1660e01b8152600401602060405180830381865afa1580156100cb573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906100ef9190610182565b90506001600160a01b03811661014b5760405162461bcd60e51b815260206004820152601d60248201527f6c6971756964206c6f67696320636f6e7472616374206e6f7420736574000000604482015260640160405180910390fd5b919050565b3660008037600080366000845af43d6000803e8061016d573d6000fd5b503d6000f35b600061017d610075565b905090565b60006020828403121561019457600080fd5b81516001600160a01b03811681146101ab57600080fd5b939250505056fea2646970667358221220150ffa6c832b489816f0573330a4171a8c5fec0b05990bb5125718b3be2dacc664736f6c63430008150033",
 }
 
 // LiquidStateTestABI is the input ABI used to generate the binding from.
@@ -21831,7 +22301,7 @@ func (_LiquidStateTest *LiquidStateTestTransactorSession) Receive() (*types.Tran
 // LiquidStorageMetaData contains all meta data concerning the LiquidStorage contract.
 var LiquidStorageMetaData = &bind.MetaData{
 	ABI: "[]",
-	Bin: "0x6080604052348015600f57600080fd5b50603f80601d6000396000f3fe6080604052600080fdfea2646970667358221220b6e24485e9528fb536afd3a46d2f1887941da0d5ef3ab3d458eaf10ff6a3f62364736f6c63430008150033",
+	Bin: "0x6080604052348015600f57600080fd5b50603f80601d6000396000f3fe6080604052600080fdfea264697066735822122052f242012fe8e1eb622dcafa87683fc9ad48995bc6f849fa4aa6aed4217769a464736f6c63430008150033",
 }
 
 // LiquidStorageABI is the input ABI used to generate the binding from.
@@ -22022,7 +22492,7 @@ var NonStakableVestingMetaData = &bind.MetaData{
 		"f968f493": "unlockTokens()",
 		"21ec4487": "unlockedFunds(address,uint256)",
 	},
-	Bin: "0x60806040523480156200001157600080fd5b50604051620019623803806200196283398101604081905262000034916200007f565b600280546001600160a01b039384166001600160a01b03199182161790915560038054929093169116179055620000be565b6001600160a01b03811681146200007c57600080fd5b50565b600080604083850312156200009357600080fd5b8251620000a08162000066565b6020840151909250620000b38162000066565b809150509250929050565b61189480620000ce6000396000f3fe608060405234801561001057600080fd5b50600436106101005760003560e01c80637b8d474411610097578063b0c9300911610066578063b0c9300914610242578063c5ca93a71461024b578063d934047d146102bf578063f968f493146102c857600080fd5b80637b8d4744146101e9578063995e21a4146101fc578063a9f45b621461020f578063aad557261461022257600080fd5b806321ec4487116100d357806321ec4487146101695780632afbbacb1461018a5780634e974657146101ad578063635bf933146101c057600080fd5b806307ae499f146101055780630a30959d1461011a5780630b5d0e421461012d578063213fe2b714610140575b600080fd5b610118610113366004611544565b6102e5565b005b610118610128366004611566565b610360565b61011861013b36600461157f565b610385565b61015361014e3660046115cd565b610623565b60405161016091906115e8565b60405180910390f35b61017c61017736600461166d565b610797565b604051908152602001610160565b61019d61019836600461166d565b6107b4565b6040519015158152602001610160565b6101186101bb366004611566565b6107f0565b61017c6101ce3660046115cd565b6001600160a01b031660009081526020819052604090205490565b6101186101f7366004611697565b61081f565b61011861020a366004611566565b610a36565b61011861021d3660046116ca565b610a65565b61023561023036600461166d565b610aa3565b6040516101609190611706565b61017c60045481565b61025e610259366004611566565b610b5c565b6040516101609190600061010082019050825182526020830151602083015260408301516040830152606083015160608301526080830151608083015260a083015160a083015260c083015160c083015260e083015160e083015292915050565b61017c60055481565b6102d0610c79565b60408051928352602083019190915201610160565b60006102f13384610e1e565b90506102fc81610eb8565b8211156103505760405162461bcd60e51b815260206004820152601960248201527f6e6f7420656e6f75676820756e6c6f636b65642066756e64730000000000000060448201526064015b60405180910390fd5b61035a8183610f02565b50505050565b600061036c3383610e1e565b90506103808161037b83610eb8565b610f02565b505050565b6003546001600160a01b031633146103af5760405162461bcd60e51b81526004016103479061174b565b8360045410156104135760405162461bcd60e51b815260206004820152602960248201527f6e6f7420656e6f7567682066756e647320746f206372656174652061206e6577604482015268207363686564756c6560b81b6064820152608401610347565b8060055410156104825760405162461bcd60e51b815260206004820152603460248201527f7363686564756c6520746f74616c206475726174696f6e20657863656564732060448201527336b0bc1030b63637bbb2b210323ab930ba34b7b760611b6064820152608401610347565b6040805161010081018252848152602081018481529181018381526060820187815260808301888152600060a0850181815260c0860182815260e087018381526006805460018101825590855297517ff652222313e28459528d920b65115c16c04f3efc82aaedc97be59f3f377c0d3f60089099029889015597517ff652222313e28459528d920b65115c16c04f3efc82aaedc97be59f3f377c0d4088015594517ff652222313e28459528d920b65115c16c04f3efc82aaedc97be59f3f377c0d4187015592517ff652222313e28459528d920b65115c16c04f3efc82aaedc97be59f3f377c0d4286015590517ff652222313e28459528d920b65115c16c04f3efc82aaedc97be59f3f377c0d4385015590517ff652222313e28459528d920b65115c16c04f3efc82aaedc97be59f3f377c0d4484015590517ff652222313e28459528d920b65115c16c04f3efc82aaedc97be59f3f377c0d4583015591517ff652222313e28459528d920b65115c16c04f3efc82aaedc97be59f3f377c0d469091015560048054869290610618908490611798565b909155505050505050565b6001600160a01b038116600090815260208190526040812080546060929067ffffffffffffffff811115610659576106596117ab565b6040519080825280602002602001820160405280156106c557816020015b6106b26040518060c0016040528060008152602001600081526020016000815260200160008152602001600081526020016000151581525090565b8152602001906001900390816106775790505b50905060005b815181101561078f5760018382815481106106e8576106e86117c1565b906000526020600020015481548110610703576107036117c1565b60009182526020918290206040805160c081018252600690930290910180548352600181015493830193909352600283015490820152600382015460608201526004820154608082015260059091015460ff16151560a08201528251839083908110610771576107716117c1565b60200260200101819052508080610787906117d7565b9150506106cb565b509392505050565b60006107ab6107a68484610e1e565b610eb8565b90505b92915050565b600060016107c28484610e1e565b815481106107d2576107d26117c1565b600091825260209091206006909102016005015460ff169392505050565b6003546001600160a01b0316331461081a5760405162461bcd60e51b81526004016103479061174b565b600555565b6003546001600160a01b031633146108495760405162461bcd60e51b81526004016103479061174b565b60065481106108905760405162461bcd60e51b81526020600482015260136024820152721a5b9d985b1a59081cd8da19591d5b19481251606a1b6044820152606401610347565b6000600682815481106108a5576108a56117c1565b90600052602060002090600802019050828160040154101561092f5760405162461bcd60e51b815260206004820152603860248201527f6e6f7420656e6f7567682066756e647320746f206372656174652061206e657760448201527f20636f6e747261637420756e646572207363686564756c6500000000000000006064820152608401610347565b600061094c85858460000154856001015486600201546000610f6e565b600081815260076020526040902084905560018301548354919250610970916117f0565b826007015410610a165760006001828154811061098f5761098f6117c1565b9060005260206000209060060201905060008360040154846006015483600001546109ba9190611803565b6109c4919061181a565b9050808460060160008282546109da9190611798565b90915550508154819083906000906109f3908490611798565b9250508190555080826001016000828254610a0e91906117f0565b909155505050505b83826004016000828254610a2a9190611798565b90915550505050505050565b6003546001600160a01b03163314610a605760405162461bcd60e51b81526004016103479061174b565b600455565b6003546001600160a01b03163314610a8f5760405162461bcd60e51b81526004016103479061174b565b610380610a9c8484610e1e565b8483611123565b610ade6040518060c0016040528060008152602001600081526020016000815260200160008152602001600081526020016000151581525090565b6001610aea8484610e1e565b81548110610afa57610afa6117c1565b60009182526020918290206040805160c081018252600690930290910180548352600181015493830193909352600283015490820152600382015460608201526004820154608082015260059091015460ff16151560a0820152905092915050565b610ba460405180610100016040528060008152602001600081526020016000815260200160008152602001600081526020016000815260200160008152602001600081525090565b6006548210610bf55760405162461bcd60e51b815260206004820152601760248201527f7363686564756c6520646f6573206e6f742065786973740000000000000000006044820152606401610347565b60068281548110610c0857610c086117c1565b906000526020600020906008020160405180610100016040529081600082015481526020016001820154815260200160028201548152602001600382015481526020016004820154815260200160058201548152602001600682015481526020016007820154815250509050919050565b60025460009081906001600160a01b03163314610ce95760405162461bcd60e51b815260206004820152602860248201527f66756e6374696f6e207265737472696374656420746f204175746f6e6974792060448201526718dbdb9d1c9858dd60c21b6064820152608401610347565b426000805b600654811015610e0b57600060068281548110610d0d57610d0d6117c1565b906000526020600020906008020190508381600001548260010154610d3291906117f0565b1180610d45575080600501548160030154145b15610d505750610df9565b8381600701819055506000610d7382600001548360020154878560030154611273565b90508160050154811015610d88575060058101545b6005820154610d979082611798565b610da190856117f0565b60058301829055825460028401546004850154929650610dc2928890611273565b90508160060154811015610dd7575060068101545b6006820154610de69082611798565b610df090876117f0565b60069092015593505b80610e03816117d7565b915050610cee565b50610e168382611798565b935050509091565b6001600160a01b0382166000908152602081905260408120548210610e7b5760405162461bcd60e51b81526020600482015260136024820152721a5b9d985b1a590818dbdb9d1c9858dd081a59606a1b6044820152606401610347565b6001600160a01b0383166000908152602081905260409020805483908110610ea557610ea56117c1565b9060005260206000200154905092915050565b60006107ae82610ec7846112b6565b600085815260076020526040902054600680549091908110610eeb57610eeb6117c1565b9060005260206000209060080201600701546112f7565b60008060018481548110610f1857610f186117c1565b906000526020600020906006020190508060000154831115610f56578054610f409084611798565b9150610f51843383600001546113c8565b610f67565b8215610f6757610f678433856113c8565b5092915050565b6000838311610fbf5760405162461bcd60e51b815260206004820152601e60248201527f656e64206d7573742062652067726561746572207468616e20636c69666600006044820152606401610347565b50600180546040805160c08101825297885260006020808a018281528a8401998a5260608b0198895260808b0197885295151560a08b01908152848601865585835299517fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf6600686029081019190915595517fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf787015597517fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf886015595517fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf985015593517fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cfa84015595517fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cfb909201805460ff1916921515929092179091556001600160a01b0395909516825281835281208054948501815581522090910181905590565b6001600160a01b0382166000908152602081905260408120805490919061114c90600190611798565b67ffffffffffffffff811115611164576111646117ab565b60405190808252806020026020018201604052801561118d578160200160208202803683370190505b5090506000805b835481101561121b57868482815481106111b0576111b06117c1565b90600052602060002001540315611209578381815481106111d3576111d36117c1565b90600052602060002001548383806111ea906117d7565b9450815181106111fc576111fc6117c1565b6020026020010181815250505b80611213816117d7565b915050611194565b506001600160a01b0385166000908152602081815260409091208351611243928501906114e4565b505050506001600160a01b0316600090815260208181526040822080546001810182559083529120019190915550565b600061127f85856117f0565b831061128c5750806112ae565b836112978685611798565b6112a19084611803565b6112ab919061181a565b90505b949350505050565b600080600183815481106112cc576112cc6117c1565b90600052602060002090600602019050806001015481600001546112f091906117f0565b9392505050565b6000806001858154811061130d5761130d6117c1565b906000526020600020906006020190508060030154816002015461133191906117f0565b8310156113805760405162461bcd60e51b815260206004820152601c60248201527f636c69666620706572696f64206e6f74207265616368656420796574000000006044820152606401610347565b6000611396826002015483600401548688611273565b905081600101548111156113bc5760018201546113b39082611798565b925050506112f0565b50600095945050505050565b6000600184815481106113dd576113dd6117c1565b90600052602060002090600602019050818160000160008282546114019190611798565b925050819055508181600101600082825461141c91906117f0565b9091555061035a9050838360025460405163a9059cbb60e01b81526001600160a01b03848116600483015260248201849052600092169063a9059cbb906044016020604051808303816000875af115801561147b573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061149f919061183c565b9050806103805760405162461bcd60e51b8152602060048201526013602482015272139513881b9bdd081d1c985b9cd9995c9c9959606a1b6044820152606401610347565b82805482825590600052602060002090810192821561151f579160200282015b8281111561151f578251825591602001919060010190611504565b5061152b92915061152f565b5090565b5b8082111561152b5760008155600101611530565b6000806040838503121561155757600080fd5b50508035926020909101359150565b60006020828403121561157857600080fd5b5035919050565b6000806000806080858703121561159557600080fd5b5050823594602084013594506040840135936060013592509050565b80356001600160a01b03811681146115c857600080fd5b919050565b6000602082840312156115df57600080fd5b6107ab826115b1565b6020808252825182820181905260009190848201906040850190845b818110156116615761164e838551805182526020810151602083015260408101516040830152606081015160608301526080810151608083015260a0810151151560a08301525050565b9284019260c09290920191600101611604565b50909695505050505050565b6000806040838503121561168057600080fd5b611689836115b1565b946020939093013593505050565b6000806000606084860312156116ac57600080fd5b6116b5846115b1565b95602085013595506040909401359392505050565b6000806000606084860312156116df57600080fd5b6116e8846115b1565b9250602084013591506116fd604085016115b1565b90509250925092565b60c081016107ae8284805182526020810151602083015260408101516040830152606081015160608301526080810151608083015260a0810151151560a08301525050565b6020808252601a908201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604082015260600190565b634e487b7160e01b600052601160045260246000fd5b818103818111156107ae576107ae611782565b634e487b7160e01b600052604160045260246000fd5b634e487b7160e01b600052603260045260246000fd5b6000600182016117e9576117e9611782565b5060010190565b808201808211156107ae576107ae611782565b80820281158282048414176107ae576107ae611782565b60008261183757634e487b7160e01b600052601260045260246000fd5b500490565b60006020828403121561184e57600080fd5b815180151581146112f057600080fdfea2646970667358221220dab3c75ef882cfda021171cf9aef6d562849d6bae46711d6ebb6ef30a0126cd864736f6c63430008150033",
+	Bin: "0x60806040523480156200001157600080fd5b50604051620019623803806200196283398101604081905262000034916200007f565b600280546001600160a01b039384166001600160a01b03199182161790915560038054929093169116179055620000be565b6001600160a01b03811681146200007c57600080fd5b50565b600080604083850312156200009357600080fd5b8251620000a08162000066565b6020840151909250620000b38162000066565b809150509250929050565b61189480620000ce6000396000f3fe608060405234801561001057600080fd5b50600436106101005760003560e01c80637b8d474411610097578063b0c9300911610066578063b0c9300914610242578063c5ca93a71461024b578063d934047d146102bf578063f968f493146102c857600080fd5b80637b8d4744146101e9578063995e21a4146101fc578063a9f45b621461020f578063aad557261461022257600080fd5b806321ec4487116100d357806321ec4487146101695780632afbbacb1461018a5780634e974657146101ad578063635bf933146101c057600080fd5b806307ae499f146101055780630a30959d1461011a5780630b5d0e421461012d578063213fe2b714610140575b600080fd5b610118610113366004611544565b6102e5565b005b610118610128366004611566565b610360565b61011861013b36600461157f565b610385565b61015361014e3660046115cd565b610623565b60405161016091906115e8565b60405180910390f35b61017c61017736600461166d565b610797565b604051908152602001610160565b61019d61019836600461166d565b6107b4565b6040519015158152602001610160565b6101186101bb366004611566565b6107f0565b61017c6101ce3660046115cd565b6001600160a01b031660009081526020819052604090205490565b6101186101f7366004611697565b61081f565b61011861020a366004611566565b610a36565b61011861021d3660046116ca565b610a65565b61023561023036600461166d565b610aa3565b6040516101609190611706565b61017c60045481565b61025e610259366004611566565b610b5c565b6040516101609190600061010082019050825182526020830151602083015260408301516040830152606083015160608301526080830151608083015260a083015160a083015260c083015160c083015260e083015160e083015292915050565b61017c60055481565b6102d0610c79565b60408051928352602083019190915201610160565b60006102f13384610e1e565b90506102fc81610eb8565b8211156103505760405162461bcd60e51b815260206004820152601960248201527f6e6f7420656e6f75676820756e6c6f636b65642066756e64730000000000000060448201526064015b60405180910390fd5b61035a8183610f02565b50505050565b600061036c3383610e1e565b90506103808161037b83610eb8565b610f02565b505050565b6003546001600160a01b031633146103af5760405162461bcd60e51b81526004016103479061174b565b8360045410156104135760405162461bcd60e51b815260206004820152602960248201527f6e6f7420656e6f7567682066756e647320746f206372656174652061206e6577604482015268207363686564756c6560b81b6064820152608401610347565b8060055410156104825760405162461bcd60e51b815260206004820152603460248201527f7363686564756c6520746f74616c206475726174696f6e20657863656564732060448201527336b0bc1030b63637bbb2b210323ab930ba34b7b760611b6064820152608401610347565b6040805161010081018252848152602081018481529181018381526060820187815260808301888152600060a0850181815260c0860182815260e087018381526006805460018101825590855297517ff652222313e28459528d920b65115c16c04f3efc82aaedc97be59f3f377c0d3f60089099029889015597517ff652222313e28459528d920b65115c16c04f3efc82aaedc97be59f3f377c0d4088015594517ff652222313e28459528d920b65115c16c04f3efc82aaedc97be59f3f377c0d4187015592517ff652222313e28459528d920b65115c16c04f3efc82aaedc97be59f3f377c0d4286015590517ff652222313e28459528d920b65115c16c04f3efc82aaedc97be59f3f377c0d4385015590517ff652222313e28459528d920b65115c16c04f3efc82aaedc97be59f3f377c0d4484015590517ff652222313e28459528d920b65115c16c04f3efc82aaedc97be59f3f377c0d4583015591517ff652222313e28459528d920b65115c16c04f3efc82aaedc97be59f3f377c0d469091015560048054869290610618908490611798565b909155505050505050565b6001600160a01b038116600090815260208190526040812080546060929067ffffffffffffffff811115610659576106596117ab565b6040519080825280602002602001820160405280156106c557816020015b6106b26040518060c0016040528060008152602001600081526020016000815260200160008152602001600081526020016000151581525090565b8152602001906001900390816106775790505b50905060005b815181101561078f5760018382815481106106e8576106e86117c1565b906000526020600020015481548110610703576107036117c1565b60009182526020918290206040805160c081018252600690930290910180548352600181015493830193909352600283015490820152600382015460608201526004820154608082015260059091015460ff16151560a08201528251839083908110610771576107716117c1565b60200260200101819052508080610787906117d7565b9150506106cb565b509392505050565b60006107ab6107a68484610e1e565b610eb8565b90505b92915050565b600060016107c28484610e1e565b815481106107d2576107d26117c1565b600091825260209091206006909102016005015460ff169392505050565b6003546001600160a01b0316331461081a5760405162461bcd60e51b81526004016103479061174b565b600555565b6003546001600160a01b031633146108495760405162461bcd60e51b81526004016103479061174b565b60065481106108905760405162461bcd60e51b81526020600482015260136024820152721a5b9d985b1a59081cd8da19591d5b19481251606a1b6044820152606401610347565b6000600682815481106108a5576108a56117c1565b90600052602060002090600802019050828160040154101561092f5760405162461bcd60e51b815260206004820152603860248201527f6e6f7420656e6f7567682066756e647320746f206372656174652061206e657760448201527f20636f6e747261637420756e646572207363686564756c6500000000000000006064820152608401610347565b600061094c85858460000154856001015486600201546000610f6e565b600081815260076020526040902084905560018301548354919250610970916117f0565b826007015410610a165760006001828154811061098f5761098f6117c1565b9060005260206000209060060201905060008360040154846006015483600001546109ba9190611803565b6109c4919061181a565b9050808460060160008282546109da9190611798565b90915550508154819083906000906109f3908490611798565b9250508190555080826001016000828254610a0e91906117f0565b909155505050505b83826004016000828254610a2a9190611798565b90915550505050505050565b6003546001600160a01b03163314610a605760405162461bcd60e51b81526004016103479061174b565b600455565b6003546001600160a01b03163314610a8f5760405162461bcd60e51b81526004016103479061174b565b610380610a9c8484610e1e565b8483611123565b610ade6040518060c0016040528060008152602001600081526020016000815260200160008152602001600081526020016000151581525090565b6001610aea8484610e1e565b81548110610afa57610afa6117c1565b60009182526020918290206040805160c081018252600690930290910180548352600181015493830193909352600283015490820152600382015460608201526004820154608082015260059091015460ff16151560a0820152905092915050565b610ba460405180610100016040528060008152602001600081526020016000815260200160008152602001600081526020016000815260200160008152602001600081525090565b6006548210610bf55760405162461bcd60e51b815260206004820152601760248201527f7363686564756c6520646f6573206e6f742065786973740000000000000000006044820152606401610347565b60068281548110610c0857610c086117c1565b906000526020600020906008020160405180610100016040529081600082015481526020016001820154815260200160028201548152602001600382015481526020016004820154815260200160058201548152602001600682015481526020016007820154815250509050919050565b60025460009081906001600160a01b03163314610ce95760405162461bcd60e51b815260206004820152602860248201527f66756e6374696f6e207265737472696374656420746f204175746f6e6974792060448201526718dbdb9d1c9858dd60c21b6064820152608401610347565b426000805b600654811015610e0b57600060068281548110610d0d57610d0d6117c1565b906000526020600020906008020190508381600001548260010154610d3291906117f0565b1180610d45575080600501548160030154145b15610d505750610df9565b8381600701819055506000610d7382600001548360020154878560030154611273565b90508160050154811015610d88575060058101545b6005820154610d979082611798565b610da190856117f0565b60058301829055825460028401546004850154929650610dc2928890611273565b90508160060154811015610dd7575060068101545b6006820154610de69082611798565b610df090876117f0565b60069092015593505b80610e03816117d7565b915050610cee565b50610e168382611798565b935050509091565b6001600160a01b0382166000908152602081905260408120548210610e7b5760405162461bcd60e51b81526020600482015260136024820152721a5b9d985b1a590818dbdb9d1c9858dd081a59606a1b6044820152606401610347565b6001600160a01b0383166000908152602081905260409020805483908110610ea557610ea56117c1565b9060005260206000200154905092915050565b60006107ae82610ec7846112b6565b600085815260076020526040902054600680549091908110610eeb57610eeb6117c1565b9060005260206000209060080201600701546112f7565b60008060018481548110610f1857610f186117c1565b906000526020600020906006020190508060000154831115610f56578054610f409084611798565b9150610f51843383600001546113c8565b610f67565b8215610f6757610f678433856113c8565b5092915050565b6000838311610fbf5760405162461bcd60e51b815260206004820152601e60248201527f656e64206d7573742062652067726561746572207468616e20636c69666600006044820152606401610347565b50600180546040805160c08101825297885260006020808a018281528a8401998a5260608b0198895260808b0197885295151560a08b01908152848601865585835299517fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf6600686029081019190915595517fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf787015597517fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf886015595517fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf985015593517fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cfa84015595517fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cfb909201805460ff1916921515929092179091556001600160a01b0395909516825281835281208054948501815581522090910181905590565b6001600160a01b0382166000908152602081905260408120805490919061114c90600190611798565b67ffffffffffffffff811115611164576111646117ab565b60405190808252806020026020018201604052801561118d578160200160208202803683370190505b5090506000805b835481101561121b57868482815481106111b0576111b06117c1565b90600052602060002001540315611209578381815481106111d3576111d36117c1565b90600052602060002001548383806111ea906117d7565b9450815181106111fc576111fc6117c1565b6020026020010181815250505b80611213816117d7565b915050611194565b506001600160a01b0385166000908152602081815260409091208351611243928501906114e4565b505050506001600160a01b0316600090815260208181526040822080546001810182559083529120019190915550565b600061127f85856117f0565b831061128c5750806112ae565b836112978685611798565b6112a19084611803565b6112ab919061181a565b90505b949350505050565b600080600183815481106112cc576112cc6117c1565b90600052602060002090600602019050806001015481600001546112f091906117f0565b9392505050565b6000806001858154811061130d5761130d6117c1565b906000526020600020906006020190508060030154816002015461133191906117f0565b8310156113805760405162461bcd60e51b815260206004820152601c60248201527f636c69666620706572696f64206e6f74207265616368656420796574000000006044820152606401610347565b6000611396826002015483600401548688611273565b905081600101548111156113bc5760018201546113b39082611798565b925050506112f0565b50600095945050505050565b6000600184815481106113dd576113dd6117c1565b90600052602060002090600602019050818160000160008282546114019190611798565b925050819055508181600101600082825461141c91906117f0565b9091555061035a9050838360025460405163a9059cbb60e01b81526001600160a01b03848116600483015260248201849052600092169063a9059cbb906044016020604051808303816000875af115801561147b573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061149f919061183c565b9050806103805760405162461bcd60e51b8152602060048201526013602482015272139513881b9bdd081d1c985b9cd9995c9c9959606a1b6044820152606401610347565b82805482825590600052602060002090810192821561151f579160200282015b8281111561151f578251825591602001919060010190611504565b5061152b92915061152f565b5090565b5b8082111561152b5760008155600101611530565b6000806040838503121561155757600080fd5b50508035926020909101359150565b60006020828403121561157857600080fd5b5035919050565b6000806000806080858703121561159557600080fd5b5050823594602084013594506040840135936060013592509050565b80356001600160a01b03811681146115c857600080fd5b919050565b6000602082840312156115df57600080fd5b6107ab826115b1565b6020808252825182820181905260009190848201906040850190845b818110156116615761164e838551805182526020810151602083015260408101516040830152606081015160608301526080810151608083015260a0810151151560a08301525050565b9284019260c09290920191600101611604565b50909695505050505050565b6000806040838503121561168057600080fd5b611689836115b1565b946020939093013593505050565b6000806000606084860312156116ac57600080fd5b6116b5846115b1565b95602085013595506040909401359392505050565b6000806000606084860312156116df57600080fd5b6116e8846115b1565b9250602084013591506116fd604085016115b1565b90509250925092565b60c081016107ae8284805182526020810151602083015260408101516040830152606081015160608301526080810151608083015260a0810151151560a08301525050565b6020808252601a908201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604082015260600190565b634e487b7160e01b600052601160045260246000fd5b818103818111156107ae576107ae611782565b634e487b7160e01b600052604160045260246000fd5b634e487b7160e01b600052603260045260246000fd5b6000600182016117e9576117e9611782565b5060010190565b808201808211156107ae576107ae611782565b80820281158282048414176107ae576107ae611782565b60008261183757634e487b7160e01b600052601260045260246000fd5b500490565b60006020828403121561184e57600080fd5b815180151581146112f057600080fdfea264697066735822122065c99f8dc5f0aaa6a685d1dc6f0bdb37af0d86282049d7d415475eed2f72431d64736f6c63430008150033",
 }
 
 // NonStakableVestingABI is the input ABI used to generate the binding from.
@@ -26168,7 +26638,7 @@ var StakableVestingMetaData = &bind.MetaData{
 		"f73b3da1": "unlockedLiquidBalanceOf(address,uint256,address)",
 		"0ba6d271": "updateFunds(address,uint256)",
 	},
-	Bin: "0x60806040526001600a5561c350600b5561c350600c553480156200002257600080fd5b50604051620048a0380380620048a08339810160408190526200004591620000a0565b600280546001600160a01b039384166001600160a01b0319918216811790925560038054949093169381169390931790915560058054909216179055620000df565b6001600160a01b03811681146200009d57600080fd5b50565b60008060408385031215620000b457600080fd5b8251620000c18162000087565b6020840151909250620000d48162000087565b809150509250929050565b6147b180620000ef6000396000f3fe6080604052600436106102305760003560e01c80636c7b69cb1161012e578063b0c93009116100ab578063d18736ab1161006f578063d18736ab14610675578063e69ecd2a14610695578063ec85303d146106b5578063f73b3da1146106d5578063f8bbfcf3146106f557600080fd5b8063b0c9300914610604578063b3d3fe8c1461061a578063ba19a4ae1461062f578063c0d3895e14610642578063c8daa85d1461065557600080fd5b80639dfd1b8e116100f25780639dfd1b8e14610561578063a0a8e46014610581578063a892024114610597578063a9f45b62146105b7578063aad55726146105d757600080fd5b80636c7b69cb146104c157806381170628146104e157806385e01abe14610501578063949813b814610521578063995e21a41461054157600080fd5b80633577a8f8116101bc5780634d037fb6116101805780634d037fb6146104015780634d68282f1461041657806353deba2d14610436578063635bf9331461046b5780636a94b441146104a157600080fd5b80633577a8f81461035f578063372500ab1461037f5780633c54c29014610394578063447ff061146103b45780634b468d4c146103d457600080fd5b8063213fe2b711610203578063213fe2b7146102a257806321ec4487146102cf5780632afbbacb146102ef5780632d8d17c61461031f5780632ebc06601461033f57600080fd5b80630962ef79146102355780630ba6d27114610257578063161605e314610255578063187cf4d714610277575b600080fd5b34801561024157600080fd5b50610255610250366004613ee4565b610715565b005b34801561026357600080fd5b50610255610272366004613f12565b610743565b34801561028357600080fd5b5061028f633b9aca0081565b6040519081526020015b60405180910390f35b3480156102ae57600080fd5b506102c26102bd366004613f3e565b610759565b6040516102999190613f5b565b3480156102db57600080fd5b5061028f6102ea366004613f12565b6108cd565b3480156102fb57600080fd5b5061030f61030a366004613f12565b6108ea565b6040519015158152602001610299565b34801561032b57600080fd5b5061025561033a366004613fe0565b610926565b34801561034b57600080fd5b5061025561035a366004613ee4565b610ac3565b34801561036b57600080fd5b5061025561037a366004613ee4565b610af2565b34801561038b57600080fd5b50610255610b20565b3480156103a057600080fd5b506102556103af366004614032565b610c04565b3480156103c057600080fd5b5061028f6103cf36600461406b565b610c39565b3480156103e057600080fd5b506103f46103ef366004613f12565b610c58565b60405161029991906140a2565b34801561040d57600080fd5b5061028f610c6c565b34801561042257600080fd5b50610255610431366004613ee4565b610cec565b34801561044257600080fd5b5061045661045136600461406b565b610d30565b60408051928352602083019190915201610299565b34801561047757600080fd5b5061028f610486366004613f3e565b6001600160a01b031660009081526020819052604090205490565b3480156104ad57600080fd5b506102556104bc366004613ee4565b610d58565b3480156104cd57600080fd5b506102556104dc3660046140e3565b610d87565b3480156104ed57600080fd5b506102556104fc366004614113565b610db7565b34801561050d57600080fd5b5061028f61051c36600461406b565b610e2c565b34801561052d57600080fd5b5061045661053c366004613f3e565b610e41565b34801561054d57600080fd5b5061025561055c366004613ee4565b610ed9565b34801561056d57600080fd5b5061025561057c366004614135565b610f08565b34801561058d57600080fd5b5061028f600a5481565b3480156105a357600080fd5b506102556105b2366004614194565b610f3f565b3480156105c357600080fd5b506102556105d236600461406b565b610f74565b3480156105e357600080fd5b506105f76105f2366004613f12565b61102b565b60405161029991906141cb565b34801561061057600080fd5b5061028f600d5481565b34801561062657600080fd5b5061028f6110e4565b61028f61063d366004614210565b61115f565b61028f610650366004614210565b611382565b34801561066157600080fd5b50610456610670366004613f12565b611644565b34801561068157600080fd5b506102556106903660046142b9565b61166a565b3480156106a157600080fd5b506102556106b0366004613ee4565b6116a0565b3480156106c157600080fd5b506102556106d0366004614210565b6116d3565b3480156106e157600080fd5b5061028f6106f036600461406b565b611863565b34801561070157600080fd5b5061028f610710366004613f12565b611878565b6000610721338361188c565b905060008061072f83611926565b9150915061073d82826119f3565b50505050565b610755610750838361188c565b611b39565b5050565b6001600160a01b038116600090815260208190526040812080546060929067ffffffffffffffff81111561078f5761078f614248565b6040519080825280602002602001820160405280156107fb57816020015b6107e86040518060c0016040528060008152602001600081526020016000815260200160008152602001600081526020016000151581525090565b8152602001906001900390816107ad5790505b50905060005b81518110156108c557600183828154811061081e5761081e61436b565b9060005260206000200154815481106108395761083961436b565b60009182526020918290206040805160c081018252600690930290910180548352600181015493830193909352600283015490820152600382015460608201526004820154608082015260059091015460ff16151560a082015282518390839081106108a7576108a761436b565b602002602001018190525080806108bd90614397565b915050610801565b509392505050565b60006108e16108dc848461188c565b611b4b565b90505b92915050565b600060016108f8848461188c565b815481106109085761090861436b565b600091825260209091206006909102016005015460ff169392505050565b6003546001600160a01b031633146109595760405162461bcd60e51b8152600401610950906143b0565b60405180910390fd5b600260009054906101000a90046001600160a01b03166001600160a01b03166389c614b86040518163ffffffff1660e01b8152600401602060405180830381865afa1580156109ac573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906109d091906143e7565b6109da8385614400565b1015610a285760405162461bcd60e51b815260206004820152601f60248201527f636f6e747261637420636c696666206475726174696f6e2069732070617374006044820152606401610950565b83600d541015610a955760405162461bcd60e51b815260206004820152603260248201527f6e6f7420656e6f756768207374616b6520726573657276656420746f20637265604482015271185d194818481b995dc818dbdb9d1c9858dd60721b6064820152608401610950565b610aa485858585856001611bd6565b5083600d6000828254610ab79190614413565b90915550505050505050565b6003546001600160a01b03163314610aed5760405162461bcd60e51b8152600401610950906143b0565b600c55565b6000610afe338361188c565b9050610b0981611d8b565b610b1b81610b1683611b4b565b611da6565b505050565b336000908152602081815260408083206016835281842080546017909452918420805492859055849055925b8354811015610bf957610b7a848281548110610b6a57610b6a61436b565b9060005260206000200154611b39565b600080610ba2868481548110610b9257610b9261436b565b9060005260206000200154611926565b9092509050610bb18286614400565b9450610bbd8185614400565b9350610be4868481548110610bd457610bd461436b565b9060005260206000200154611e12565b50508080610bf190614397565b915050610b4c565b50610b1b82826119f3565b6002546001600160a01b03163314610c2e5760405162461bcd60e51b815260040161095090614426565b610b1b838383611f57565b6000610c4e610c48858561188c565b83611fb9565b90505b9392505050565b60606108e1610c67848461188c565b612008565b600254604080516335dce58760e11b815290516000926001600160a01b031691636bb9cb0e9160048083019260209291908290030181865afa158015610cb6573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610cda91906143e7565b600c54610ce7919061446e565b905090565b6000610cf8338361188c565b9050610d0381611b39565b6000610d0e82611b4b565b9050610d1a8282611da6565b9050610d268282612074565b50610b1b82611e12565b6000806000610d3f868661188c565b9050610d4b8185612229565b9097909650945050505050565b6003546001600160a01b03163314610d825760405162461bcd60e51b8152600401610950906143b0565b600b55565b6000610d93338461188c565b9050600080610da28385612330565b91509150610db082826119f3565b5050505050565b6000610dc3338461188c565b9050610dce81611d8b565b610dd781611b4b565b821115610e225760405162461bcd60e51b81526020600482015260196024820152786e6f7420656e6f75676820756e6c6f636b65642066756e647360381b6044820152606401610950565b61073d8183611da6565b6000610c4e610e3b858561188c565b836123a1565b6001600160a01b03811660009081526016602090815260408083205460178352818420549284905290832090925b8154811015610ed257600080610ea0848481548110610e9057610e9061436b565b90600052602060002001546123e1565b9092509050610eaf8287614400565b9550610ebb8186614400565b945050508080610eca90614397565b915050610e6f565b5050915091565b6003546001600160a01b03163314610f035760405162461bcd60e51b8152600401610950906143b0565b600d55565b6002546001600160a01b03163314610f325760405162461bcd60e51b815260040161095090614426565b610db085858585856124ae565b6002546001600160a01b03163314610f695760405162461bcd60e51b815260040161095090614426565b610b1b838383612597565b6003546001600160a01b03163314610f9e5760405162461bcd60e51b8152600401610950906143b0565b6000610faa848461188c565b9050600080610fb883611926565b6001600160a01b038816600090815260166020526040812080549395509193508492610fe5908490614400565b90915550506001600160a01b03861660009081526017602052604081208054839290611012908490614400565b909155506110239050838786612612565b505050505050565b6110666040518060c0016040528060008152602001600081526020016000815260200160008152602001600081526020016000151581525090565b6001611072848461188c565b815481106110825761108261436b565b60009182526020918290206040805160c081018252600690930290910180548352600181015493830193909352600283015490820152600382015460608201526004820154608082015260059091015460ff16151560a0820152905092915050565b600254604080516335dce58760e11b815290516000926001600160a01b031691636bb9cb0e9160048083019260209291908290030181865afa15801561112e573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061115291906143e7565b600b54610ce7919061446e565b6000611169610c6c565b3410156111d35760405162461bcd60e51b815260206004820152603260248201527f6e6f7420656e6f7567682067617320676976656e20666f72206e6f74696669636044820152716174696f6e206f6e20756e626f6e64696e6760701b6064820152608401610950565b60006111df338661188c565b90506111ea81611d8b565b6111f5818585612762565b6002546040516352e82ce560e11b81526001600160a01b03868116600483015260248201869052600092169063a5d059ca90349060440160206040518083038185885af115801561124a573d6000803e3d6000fd5b50505050506040513d601f19601f8201168201806040525081019061126f91906143e7565b905061127c826001614400565b60126000838152602001908152602001600020819055506040518060a001604052808581526020016112ac61280d565b81526001600160a01b03808816602080840191909152600060408085018290526060948501829052868252600f835280822086518155868401516001828101919091558783015160029092018054978901516080909901511515600160a81b0260ff60a81b19991515600160a01b026001600160a81b031990991693909716929092179690961796909616939093179094558584526015815281842054601382528285208186529091529220839055611366908290614400565b6000938452601560205260409093209290925595945050505050565b600061138c6110e4565b3410156113f45760405162461bcd60e51b815260206004820152603060248201527f6e6f7420656e6f7567682067617320676976656e20666f72206e6f746966696360448201526f6174696f6e206f6e20626f6e64696e6760801b6064820152608401610950565b6000611400338661188c565b905061140b81611b39565b6000600182815481106114205761142061436b565b9060005260206000209060060201905042816002015411156114845760405162461bcd60e51b815260206004820152601860248201527f636f6e7472616374206e6f7420737461727465642079657400000000000000006044820152606401610950565b80548411156114c95760405162461bcd60e51b81526020600482015260116024820152706e6f7420656e6f75676820746f6b656e7360781b6044820152606401610950565b60025460405163528a9b3560e11b81526001600160a01b03878116600483015260248201879052600092169063a515366a90349060440160206040518083038185885af115801561151e573d6000803e3d6000fd5b50505050506040513d601f19601f8201168201806040525081019061154391906143e7565b9050848260000160008282546115599190614413565b909155505060008381526011602090815260408220805460018181018355918452919092200182905561158d908490614400565b601060008381526020019081526020016000208190555060405180608001604052808681526020016115bd61280d565b81526001600160a01b0380891660208084019190915260006040938401819052858152600e82528390208451815590840151600182015591830151600290920180546060909401511515600160a01b026001600160a81b03199094169290911691909117919091179055611631838761287b565b61163a83611e12565b9695505050505050565b6000806000611653858561188c565b905061165e816123e1565b90969095509350505050565b6002546001600160a01b031633146116945760405162461bcd60e51b815260040161095090614426565b61169d8161297c565b50565b60006116ac338361188c565b90506116b781611b39565b6116c9816116c483611b4b565b612074565b5061075581611e12565b6000811161172f5760405162461bcd60e51b815260206004820152602360248201527f7265717569726520706f73697469766520616d6f756e7420746f207472616e736044820152623332b960e91b6064820152608401610950565b600061173b338561188c565b905061174681611b39565b600061175282856129bc565b9050828110156117a45760405162461bcd60e51b815260206004820152601860248201527f6e6f7420656e6f75676820756e6c6f636b6564204c4e544e00000000000000006044820152606401610950565b60006117b085856129fc565b90506117bb83611b4b565b8111156118065760405162461bcd60e51b81526020600482015260196024820152786e6f7420656e6f75676820756e6c6f636b65642066756e647360381b6044820152606401610950565b60006001848154811061181b5761181b61436b565b906000526020600020906006020190508181600101600082825461183f9190614400565b90915550611851905084338789612aab565b61185a84611e12565b50505050505050565b6000610c4e611872858561188c565b836129bc565b60006108e1611887848461188c565b612aca565b6001600160a01b03821660009081526020819052604081205482106118e95760405162461bcd60e51b81526020600482015260136024820152721a5b9d985b1a590818dbdb9d1c9858dd081a59606a1b6044820152606401610950565b6001600160a01b03831660009081526020819052604090208054839081106119135761191361436b565b9060005260206000200154905092915050565b600081815260076020908152604080832080548251818502810185019093528083528493849392919083018282801561198857602002820191906000526020600020905b81546001600160a01b0316815260019091019060200180831161196a575b5050505050905060005b8151811015610ed2576000806119c1878585815181106119b4576119b461436b565b6020026020010151612330565b90925090506119d08287614400565b95506119dc8186614400565b9450505080806119eb90614397565b915050611992565b604051600090339084908381818185875af1925050503d8060008114611a35576040519150601f19603f3d011682016040523d82523d6000602084013e611a3a565b606091505b5050905080611a805760405162461bcd60e51b815260206004820152601260248201527111985a5b1959081d1bc81cd95b990810555560721b6044820152606401610950565b60025460405163a9059cbb60e01b8152336004820152602481018490526001600160a01b039091169063a9059cbb906044016020604051808303816000875af1158015611ad1573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611af59190614485565b905080610b1b5760405162461bcd60e51b81526020600482015260126024820152712330b4b632b2103a379039b2b73210272a2760711b6044820152606401610950565b611b4281612ba3565b61169d81612d36565b60006108e482611b5a84612aca565b600260009054906101000a90046001600160a01b03166001600160a01b03166389c614b86040518163ffffffff1660e01b8152600401602060405180830381865afa158015611bad573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611bd191906143e7565b613119565b6000838311611c275760405162461bcd60e51b815260206004820152601e60248201527f656e64206d7573742062652067726561746572207468616e20636c69666600006044820152606401610950565b50600180546040805160c08101825297885260006020808a018281528a8401998a5260608b0198895260808b0197885295151560a08b01908152848601865585835299517fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf6600686029081019190915595517fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf787015597517fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf886015595517fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf985015593517fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cfa84015595517fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cfb909201805460ff1916921515929092179091556001600160a01b0395909516825281835281208054948501815581522090910181905590565b611d9481612ba3565b611d9d81612d36565b61169d81611e12565b60008060018481548110611dbc57611dbc61436b565b906000526020600020906006020190508060000154831115611dfa578054611de49084614413565b9150611df5843383600001546131ea565b611e0b565b8215611e0b57611e0b8433856131ea565b5092915050565b600081815260076020526040812090805b825481101561073d57600960008581526020019081526020016000206000848381548110611e5357611e5361436b565b60009182526020808320909101546001600160a01b03168352820192909252604001902091505b6006820154610100900460ff16158015611e95575081546001145b8015611ea5575060018260020154145b8015611eb5575060018260040154145b15611f4557611eea84848381548110611ed057611ed061436b565b6000918252602090912001546001600160a01b031661324e565b8254811015611f4557600960008581526020019081526020016000206000848381548110611f1a57611f1a61436b565b60009182526020808320909101546001600160a01b0316835282019290925260400190209150611e7a565b80611f4f81614397565b915050611e23565b600083815260126020526040812054611f7290600190614413565b90508115611f805750505050565b600060018281548110611f9557611f9561436b565b9060005260206000209060060201905083816000016000828254610ab79190614400565b60008281526009602090815260408083206001600160a01b03851684529091528120600681015460ff1615611ffe578054611ff690600190614413565b9150506108e4565b5060009392505050565b60008181526007602090815260409182902080548351818402810184019094528084526060939283018282801561206857602002820191906000526020600020905b81546001600160a01b0316815260019091019060200180831161204a575b50505050509050919050565b80600061208084612008565b905060005b8151811080156120955750600083115b156121d65760006120bf868484815181106120b2576120b261436b565b60200260200101516129bc565b9050806000036120cf57506121c4565b60006120f48484815181106120e6576120e661436b565b6020026020010151836129fc565b9050808510612133576121078186614413565b945061212e8733848787815181106121215761212161436b565b6020026020010151612aab565b6121c1565b600061215885858151811061214a5761214a61436b565b602002602001015187613401565b9050828111156121a35760405162461bcd60e51b8152602060048201526016602482015275636f6e76657273696f6e206e6f7420776f726b696e6760501b6044820152606401610950565b600095506121bf8833838888815181106121215761212161436b565b505b50505b806121ce81614397565b915050612085565b506000600185815481106121ec576121ec61436b565b9060005260206000209060060201905082846122089190614413565b81600101600082825461221b9190614400565b909155509295945050505050565b60008281526009602090815260408083206001600160a01b03851684529091528120600681015482919060ff161515820361226b576000809250925050612329565b6000806122778661349e565b6001600160a01b03881660009081526006602052604090208054929450909250906122a29084614400565b92508060020154826122b49190614400565b91506000600185600001546122c99190614413565b90506122da8186600301548661361b565b600186600201546122eb9190614413565b6122f59190614400565b96506123068186600501548561361b565b600186600401546123179190614413565b6123219190614400565b955050505050505b9250929050565b60008281526009602090815260408083206001600160a01b03851684529091528120600681015482919060ff1615158203612372576000809250925050612329565b61237b84613651565b6123858585613773565b6001600284018190556004909301929092559590945092505050565b60008281526009602090815260408083206001600160a01b03851684529091528120600681015460ff1615611ffe5760018160010154611ff69190614413565b600081815260076020908152604080832080548251818502810185019093528083528493849392919083018282801561244357602002820191906000526020600020905b81546001600160a01b03168152600190910190602001808311612425575b5050505050905060005b8151811015610ed25760008061247c8785858151811061246f5761246f61436b565b6020026020010151612229565b909250905061248b8287614400565b95506124978186614400565b9450505080806124a690614397565b91505061244d565b81156124fc5760405162461bcd60e51b815260206004820152601b60248201527f626f6e64696e672073686f756c642062652064656c65676174656400000000006044820152606401610950565b60008581526010602052604081205461251790600190614413565b6000878152600e6020526040902060028101805460ff60a01b1916600160a01b179055909150821561258c576000600183815481106125585761255861436b565b9060005260206000209060060201905081600001548160000160008282546125809190614400565b9091555061185a915050565b61185a82878761385c565b6000838152601260205260408120546125b290600190614413565b6000858152600f602052604090208054919250906125d183868361389e565b83156125f25750600201805460ff60a01b1916600160a01b17905550505050565b60028201805460ff60a81b1916600160a81b1790556110238386836138d5565b6001600160a01b0382166000908152602081905260408120805490919061263b90600190614413565b67ffffffffffffffff81111561265357612653614248565b60405190808252806020026020018201604052801561267c578160200160208202803683370190505b5090506000805b835481101561270a578684828154811061269f5761269f61436b565b906000526020600020015403156126f8578381815481106126c2576126c261436b565b90600052602060002001548383806126d990614397565b9450815181106126eb576126eb61436b565b6020026020010181815250505b8061270281614397565b915050612683565b506001600160a01b038516600090815260208181526040909120835161273292850190613e6a565b505050506001600160a01b0316600090815260208181526040822080546001810182559083529120019190915550565b60008381526009602090815260408083206001600160a01b0386168452909152902060018101548154839161279691614413565b10156127ee5760405162461bcd60e51b815260206004820152602160248201527f6e6f7420656e6f75676820756e6c6f636b6564206c697175696420746f6b656e6044820152607360f81b6064820152608401610950565b818160010160008282546128029190614400565b909155505050505050565b600254604080516332765ebd60e21b815290516000926001600160a01b03169163c9d97af49160048083019260209291908290030181865afa158015612857573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610ce791906143e7565b6128858282613917565b60008281526009602090815260408083206001600160a01b0385168452909152902060068101805461ff001981166101001790915560ff16156128c757505050565b5060408051610100808201835260018083526020808401828152848601838152606086018481526080870185815260a0880186815260c0890187815260e08a0188815260009d8e52600988528b8e206001600160a01b039d909d168e529b90965298909a209651875591519386019390935591516002850155905160038401559451600483015591516005820155925160069093018054925161ffff1990931693151561ff0019169390931791151502179055565b60005b8151811015610755576129aa82828151811061299d5761299d61436b565b60200260200101516139af565b806129b481614397565b91505061297f565b60008281526009602090815260408083206001600160a01b03851684529091528120600681015460ff1615611ffe5760018101548154611ff69190614413565b600254604051630c825d9760e11b81526001600160a01b0384811660048301526000928392911690631904bb2e90602401600060405180830381865afa158015612a4a573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f19168201604052612a729190810190614552565b9050806101a001518161010001518260a00151612a8f9190614413565b612a99908561446e565b612aa391906146d0565b949350505050565b612ab4816139af565b612abf8482846138d5565b61073d838383613b6c565b60008060018381548110612ae057612ae061436b565b90600052602060002090600602019050600081600101548260000154612b069190614400565b90506000612b1385612008565b905060005b8151811015612b99576000612b4687848481518110612b3957612b3961436b565b6020026020010151611fb9565b905080600003612b565750612b87565b612b79838381518110612b6b57612b6b61436b565b6020026020010151826129fc565b612b839085614400565b9350505b80612b9181614397565b915050612b18565b5090949350505050565b600081815260116020526040812080549091819003612bc157505050565b600080600080612bcf61280d565b905060005b85811015612cc557868181548110612bee57612bee61436b565b90600052602060002001549450600e6000868152602001908152602001600020935081846001015403612c25575050505050505050565b600284015460008981526009602090815260408083206001600160a01b03909416835292905220600601805461ff00191690556002840154600160a01b900460ff161515600003612c7f578354612c7c9084614400565b92505b6000858152600e602090815260408083208381556001810184905560020180546001600160a81b0319169055601090915281205580612cbd81614397565b915050612bd4565b506000878152601160205260408120612cdd91613eb5565b81600003612cee5750505050505050565b600060018881548110612d0357612d0361436b565b9060005260206000209060060201905082816000016000828254612d279190614400565b90915550505050505050505050565b600081815260136020908152604080832060158352818420546014909352908320548392905b818110156131005760008181526020849052604080822054600254915163a9af105960e01b8152600481018290529097506001600160a01b039091169063a9af105990602401602060405180830381865afa158015612dbf573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190612de391906146f2565b90506000816003811115612df957612df961470f565b03612e045750613100565b60008281526020858152604080832083905588835260129091528120556001816003811115612e3557612e3561470f565b03612e6a57506000858152600f60205260408120818155600181019190915560020180546001600160b01b03191690556130ee565b6000868152600f6020526040902060028101549095506001600160a01b03166003826003811115612e9d57612e9d61470f565b03612f9e576002860154600160a81b900460ff16612f185760405162461bcd60e51b815260206004820152603260248201527f756e626f6e64696e67207761732072656c656173656420627574206e6f74206160448201527170706c6965642073756363657366756c6c7960701b6064820152608401610950565b612f21816139af565b60025460405163b6ef8c6760e01b815260048101899052612f99918a9184916001600160a01b03169063b6ef8c6790602401602060405180830381865afa158015612f70573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190612f9491906143e7565b61385c565b6130c1565b6002826003811115612fb257612fb261470f565b03613060576002860154600160a81b900460ff16156130395760405162461bcd60e51b815260206004820152603b60248201527f756e626f6e64696e6720776173206170706c696564207375636365737366756c60448201527f6c79206275742072656c65617365207761732072656a656374656400000000006064820152608401610950565b6002860154600160a01b900460ff161515600003612f9957612f998882886000015461389e565b60405162461bcd60e51b815260206004820152603060248201527f756e6b6e6f776e20556e626f6e64696e6752656c6561736553746174652c206e60448201526f195959081d1bc81a5b5c1b195b595b9d60821b6064820152608401610950565b50506000858152600f60205260408120818155600181019190915560020180546001600160b01b03191690555b806130f881614397565b915050612d5c565b6000958652601460205260409095209490945550505050565b6000806001858154811061312f5761312f61436b565b90600052602060002090600602019050806003015481600201546131539190614400565b8310156131a25760405162461bcd60e51b815260206004820152601c60248201527f636c69666620706572696f64206e6f74207265616368656420796574000000006044820152606401610950565b60006131b8826002015483600401548688613c6d565b905081600101548111156131de5760018201546131d59082614413565b92505050610c51565b50600095945050505050565b6000600184815481106131ff576131ff61436b565b90600052602060002090600602019050818160000160008282546132239190614413565b925050819055508181600101600082825461323e9190614400565b9091555061073d90508383613cae565b6000828152600760209081526040808320600883528184206001600160a01b038616855290925282205490919061328790600190614413565b8254909150613297826001614400565b036132f757818054806132ac576132ac614725565b60008281526020808220830160001990810180546001600160a01b03191690559092019092559481526008855260408082206001600160a01b03959095168252939094525050812055565b8154600090839061330a90600190614413565b8154811061331a5761331a61436b565b9060005260206000200160009054906101000a90046001600160a01b031690508083838154811061334d5761334d61436b565b600091825260209091200180546001600160a01b0319166001600160a01b0392909216919091179055613381826001614400565b60008681526008602090815260408083206001600160a01b038616845290915290205582548390806133b5576133b5614725565b60008281526020808220830160001990810180546001600160a01b03191690559092019092559581526008865260408082206001600160a01b0396909616825294909552505050812055565b600254604051630c825d9760e11b81526001600160a01b0384811660048301526000928392911690631904bb2e90602401600060405180830381865afa15801561344f573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f191682016040526134779190810190614552565b90508061010001518160a0015161348e9190614413565b6101a0820151612a99908561446e565b6001600160a01b0381811660009081526006602052604080822060048082015492516370a0823160e01b815230918101919091529293849391921690839082906370a0823190602401602060405180830381865afa158015613504573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061352891906143e7565b9050801561361357604051631293027760e31b815230600482015260009081906001600160a01b0385169063949813b8906024016040805180830381865afa158015613578573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061359c919061473b565b9150915082633b9aca0086600101546001856135b89190614400565b6135c29190614413565b6135cc919061446e565b6135d691906146d0565b965082633b9aca0086600301546001846135f09190614400565b6135fa9190614413565b613604919061446e565b61360e91906146d0565b955050505b505050915091565b60008360000361362d57506000610c51565b633b9aca008461363d8585614413565b613647919061446e565b610c4e91906146d0565b6001600160a01b0381166000908152600660205260409020613672826139af565b60018181018190556003820155600480820154604080519283526024830181526020830180516001600160e01b031663372500ab60e01b179052516000926001600160a01b03909216916136c59161475f565b6000604051808303816000865af19150503d8060008114613702576040519150601f19603f3d011682016040523d82523d6000602084013e613707565b606091505b5050905080610b1b5760405162461bcd60e51b815260206004820152603260248201527f636c61696d5265776172647328292066726f6d206c69717569642073746174656044820152710818dbdb9d1c9858dd081c995d995c9d195960721b6064820152608401610950565b6001600160a01b0381166000818152600660209081526040808320548684526009835281842094845293909152812080549192839290919083906137b990600190614413565b90506137ca8183600301548561361b565b82600201546137d99190614400565b600283018190556003830184905594506137f4600186614413565b6001600160a01b0387166000908152600660205260409020600201546005840154919650906138259083908361361b565b83600401546138349190614400565b6004840181905560058401829055945061384f600186614413565b9450505050509250929050565b6138668383613773565b505060008381526009602090815260408083206001600160a01b03861684529091528120805490918391839190612802908490614400565b60008381526009602090815260408083206001600160a01b0386168452909152812060018101805491928492612802908490614413565b6138df8383613773565b505060008381526009602090815260408083206001600160a01b03861684529091528120805490918391839190612802908490614413565b60008281526008602090815260408083206001600160a01b038516845290915290205415613943575050565b600082815260076020908152604080832080546001810182558185528385200180546001600160a01b0319166001600160a01b0387811691821790925582548887526008865284872091875290855283862055600690935292206004015416610b1b57610b1b82613d6b565b6001600160a01b0381811660009081526006602052604080822060048082015492516370a0823160e01b81523091810191909152909391909116919082906370a0823190602401602060405180830381865afa158015613a13573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190613a3791906143e7565b905080600003613a475750505050565b604051631293027760e31b815230600482015260009081906001600160a01b0385169063949813b8906024016040805180830381865afa158015613a8f573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190613ab3919061473b565b9092509050613ac3600183614400565b915082633b9aca00866001015484613adb9190614413565b613ae5919061446e565b613aef91906146d0565b856000016000828254613b029190614400565b90915550506001808601839055613b199082614400565b905082633b9aca00866003015483613b319190614413565b613b3b919061446e565b613b4591906146d0565b856002016000828254613b589190614400565b909155505060039094019390935550505050565b6001600160a01b038082166000908152600660205260408120600401549091166040516001600160a01b03868116602483015260448201869052919091169060640160408051601f198184030181529181526020820180516001600160e01b031663a9059cbb60e01b17905251613be3919061475f565b6000604051808303816000865af19150503d8060008114613c20576040519150601f19603f3d011682016040523d82523d6000602084013e613c25565b606091505b505090508061073d5760405162461bcd60e51b815260206004820152601460248201527313139513881d1c985b9cd9995c8819985a5b195960621b6044820152606401610950565b6000613c798585614400565b8310613c86575080612aa3565b83613c918685614413565b613c9b908461446e565b613ca591906146d0565b95945050505050565b60025460405163a9059cbb60e01b81526001600160a01b03848116600483015260248201849052600092169063a9059cbb906044016020604051808303816000875af1158015613d02573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190613d269190614485565b905080610b1b5760405162461bcd60e51b8152602060048201526013602482015272139513881b9bdd081d1c985b9cd9995c9c9959606a1b6044820152606401610950565b6040805160a08101825260018082526020820181905281830181905260608201526005549151630c825d9760e11b81526001600160a01b038481166004830152919260808401921690631904bb2e90602401600060405180830381865afa158015613dda573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f19168201604052613e029190810190614552565b61018001516001600160a01b039081169091529182166000908152600660209081526040918290208351815590830151600182015590820151600282015560608201516003820155608090910151600490910180546001600160a01b03191691909216179055565b828054828255906000526020600020908101928215613ea5579160200282015b82811115613ea5578251825591602001919060010190613e8a565b50613eb1929150613ecf565b5090565b508054600082559060005260206000209081019061169d91905b5b80821115613eb15760008155600101613ed0565b600060208284031215613ef657600080fd5b5035919050565b6001600160a01b038116811461169d57600080fd5b60008060408385031215613f2557600080fd5b8235613f3081613efd565b946020939093013593505050565b600060208284031215613f5057600080fd5b8135610c5181613efd565b6020808252825182820181905260009190848201906040850190845b81811015613fd457613fc1838551805182526020810151602083015260408101516040830152606081015160608301526080810151608083015260a0810151151560a08301525050565b9284019260c09290920191600101613f77565b50909695505050505050565b600080600080600060a08688031215613ff857600080fd5b853561400381613efd565b97602087013597506040870135966060810135965060800135945092505050565b801515811461169d57600080fd5b60008060006060848603121561404757600080fd5b8335925060208401359150604084013561406081614024565b809150509250925092565b60008060006060848603121561408057600080fd5b833561408b81613efd565b925060208401359150604084013561406081613efd565b6020808252825182820181905260009190848201906040850190845b81811015613fd45783516001600160a01b0316835292840192918401916001016140be565b600080604083850312156140f657600080fd5b82359150602083013561410881613efd565b809150509250929050565b6000806040838503121561412657600080fd5b50508035926020909101359150565b600080600080600060a0868803121561414d57600080fd5b85359450602086013561415f81613efd565b935060408601359250606086013561417681614024565b9150608086013561418681614024565b809150509295509295909350565b6000806000606084860312156141a957600080fd5b8335925060208401356141bb81613efd565b9150604084013561406081614024565b60c081016108e48284805182526020810151602083015260408101516040830152606081015160608301526080810151608083015260a0810151151560a08301525050565b60008060006060848603121561422557600080fd5b83359250602084013561423781613efd565b929592945050506040919091013590565b634e487b7160e01b600052604160045260246000fd5b604051610280810167ffffffffffffffff8111828210171561428257614282614248565b60405290565b604051601f8201601f1916810167ffffffffffffffff811182821017156142b1576142b1614248565b604052919050565b600060208083850312156142cc57600080fd5b823567ffffffffffffffff808211156142e457600080fd5b818501915085601f8301126142f857600080fd5b81358181111561430a5761430a614248565b8060051b915061431b848301614288565b818152918301840191848101908884111561433557600080fd5b938501935b8385101561435f578435925061434f83613efd565b828252938501939085019061433a565b98975050505050505050565b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052601160045260246000fd5b6000600182016143a9576143a9614381565b5060010190565b6020808252601a908201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604082015260600190565b6000602082840312156143f957600080fd5b5051919050565b808201808211156108e4576108e4614381565b818103818111156108e4576108e4614381565b60208082526028908201527f66756e6374696f6e207265737472696374656420746f204175746f6e6974792060408201526718dbdb9d1c9858dd60c21b606082015260800190565b80820281158282048414176108e4576108e4614381565b60006020828403121561449757600080fd5b8151610c5181614024565b80516144ad81613efd565b919050565b60005b838110156144cd5781810151838201526020016144b5565b50506000910152565b600082601f8301126144e757600080fd5b815167ffffffffffffffff81111561450157614501614248565b614514601f8201601f1916602001614288565b81815284602083860101111561452957600080fd5b612aa38260208301602087016144b2565b6004811061169d57600080fd5b80516144ad8161453a565b60006020828403121561456457600080fd5b815167ffffffffffffffff8082111561457c57600080fd5b90830190610280828603121561459157600080fd5b61459961425e565b6145a2836144a2565b81526145b0602084016144a2565b60208201526145c1604084016144a2565b60408201526060830151828111156145d857600080fd5b6145e4878286016144d6565b6060830152506080830151608082015260a083015160a082015260c083015160c082015260e083015160e08201526101008084015181830152506101208084015181830152506101408084015181830152506101608084015181830152506101806146508185016144a2565b908201526101a083810151908201526101c080840151908201526101e0808401519082015261020080840151908201526102208084015190820152610240808401518381111561469f57600080fd5b6146ab888287016144d6565b82840152505061026091506146c1828401614547565b91810191909152949350505050565b6000826146ed57634e487b7160e01b600052601260045260246000fd5b500490565b60006020828403121561470457600080fd5b8151610c518161453a565b634e487b7160e01b600052602160045260246000fd5b634e487b7160e01b600052603160045260246000fd5b6000806040838503121561474e57600080fd5b505080516020909101519092909150565b600082516147718184602087016144b2565b919091019291505056fea2646970667358221220bf2a060aca3dbda302024c515fac0dac7ecde491aa82ebe28b1a858f029878bc64736f6c63430008150033",
+	Bin: "0x60806040526001600a5561c350600b5561c350600c553480156200002257600080fd5b50604051620048a0380380620048a08339810160408190526200004591620000a0565b600280546001600160a01b039384166001600160a01b0319918216811790925560038054949093169381169390931790915560058054909216179055620000df565b6001600160a01b03811681146200009d57600080fd5b50565b60008060408385031215620000b457600080fd5b8251620000c18162000087565b6020840151909250620000d48162000087565b809150509250929050565b6147b180620000ef6000396000f3fe6080604052600436106102305760003560e01c80636c7b69cb1161012e578063b0c93009116100ab578063d18736ab1161006f578063d18736ab14610675578063e69ecd2a14610695578063ec85303d146106b5578063f73b3da1146106d5578063f8bbfcf3146106f557600080fd5b8063b0c9300914610604578063b3d3fe8c1461061a578063ba19a4ae1461062f578063c0d3895e14610642578063c8daa85d1461065557600080fd5b80639dfd1b8e116100f25780639dfd1b8e14610561578063a0a8e46014610581578063a892024114610597578063a9f45b62146105b7578063aad55726146105d757600080fd5b80636c7b69cb146104c157806381170628146104e157806385e01abe14610501578063949813b814610521578063995e21a41461054157600080fd5b80633577a8f8116101bc5780634d037fb6116101805780634d037fb6146104015780634d68282f1461041657806353deba2d14610436578063635bf9331461046b5780636a94b441146104a157600080fd5b80633577a8f81461035f578063372500ab1461037f5780633c54c29014610394578063447ff061146103b45780634b468d4c146103d457600080fd5b8063213fe2b711610203578063213fe2b7146102a257806321ec4487146102cf5780632afbbacb146102ef5780632d8d17c61461031f5780632ebc06601461033f57600080fd5b80630962ef79146102355780630ba6d27114610257578063161605e314610255578063187cf4d714610277575b600080fd5b34801561024157600080fd5b50610255610250366004613ee4565b610715565b005b34801561026357600080fd5b50610255610272366004613f12565b610743565b34801561028357600080fd5b5061028f633b9aca0081565b6040519081526020015b60405180910390f35b3480156102ae57600080fd5b506102c26102bd366004613f3e565b610759565b6040516102999190613f5b565b3480156102db57600080fd5b5061028f6102ea366004613f12565b6108cd565b3480156102fb57600080fd5b5061030f61030a366004613f12565b6108ea565b6040519015158152602001610299565b34801561032b57600080fd5b5061025561033a366004613fe0565b610926565b34801561034b57600080fd5b5061025561035a366004613ee4565b610ac3565b34801561036b57600080fd5b5061025561037a366004613ee4565b610af2565b34801561038b57600080fd5b50610255610b20565b3480156103a057600080fd5b506102556103af366004614032565b610c04565b3480156103c057600080fd5b5061028f6103cf36600461406b565b610c39565b3480156103e057600080fd5b506103f46103ef366004613f12565b610c58565b60405161029991906140a2565b34801561040d57600080fd5b5061028f610c6c565b34801561042257600080fd5b50610255610431366004613ee4565b610cec565b34801561044257600080fd5b5061045661045136600461406b565b610d30565b60408051928352602083019190915201610299565b34801561047757600080fd5b5061028f610486366004613f3e565b6001600160a01b031660009081526020819052604090205490565b3480156104ad57600080fd5b506102556104bc366004613ee4565b610d58565b3480156104cd57600080fd5b506102556104dc3660046140e3565b610d87565b3480156104ed57600080fd5b506102556104fc366004614113565b610db7565b34801561050d57600080fd5b5061028f61051c36600461406b565b610e2c565b34801561052d57600080fd5b5061045661053c366004613f3e565b610e41565b34801561054d57600080fd5b5061025561055c366004613ee4565b610ed9565b34801561056d57600080fd5b5061025561057c366004614135565b610f08565b34801561058d57600080fd5b5061028f600a5481565b3480156105a357600080fd5b506102556105b2366004614194565b610f3f565b3480156105c357600080fd5b506102556105d236600461406b565b610f74565b3480156105e357600080fd5b506105f76105f2366004613f12565b61102b565b60405161029991906141cb565b34801561061057600080fd5b5061028f600d5481565b34801561062657600080fd5b5061028f6110e4565b61028f61063d366004614210565b61115f565b61028f610650366004614210565b611382565b34801561066157600080fd5b50610456610670366004613f12565b611644565b34801561068157600080fd5b506102556106903660046142b9565b61166a565b3480156106a157600080fd5b506102556106b0366004613ee4565b6116a0565b3480156106c157600080fd5b506102556106d0366004614210565b6116d3565b3480156106e157600080fd5b5061028f6106f036600461406b565b611863565b34801561070157600080fd5b5061028f610710366004613f12565b611878565b6000610721338361188c565b905060008061072f83611926565b9150915061073d82826119f3565b50505050565b610755610750838361188c565b611b39565b5050565b6001600160a01b038116600090815260208190526040812080546060929067ffffffffffffffff81111561078f5761078f614248565b6040519080825280602002602001820160405280156107fb57816020015b6107e86040518060c0016040528060008152602001600081526020016000815260200160008152602001600081526020016000151581525090565b8152602001906001900390816107ad5790505b50905060005b81518110156108c557600183828154811061081e5761081e61436b565b9060005260206000200154815481106108395761083961436b565b60009182526020918290206040805160c081018252600690930290910180548352600181015493830193909352600283015490820152600382015460608201526004820154608082015260059091015460ff16151560a082015282518390839081106108a7576108a761436b565b602002602001018190525080806108bd90614397565b915050610801565b509392505050565b60006108e16108dc848461188c565b611b4b565b90505b92915050565b600060016108f8848461188c565b815481106109085761090861436b565b600091825260209091206006909102016005015460ff169392505050565b6003546001600160a01b031633146109595760405162461bcd60e51b8152600401610950906143b0565b60405180910390fd5b600260009054906101000a90046001600160a01b03166001600160a01b03166389c614b86040518163ffffffff1660e01b8152600401602060405180830381865afa1580156109ac573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906109d091906143e7565b6109da8385614400565b1015610a285760405162461bcd60e51b815260206004820152601f60248201527f636f6e747261637420636c696666206475726174696f6e2069732070617374006044820152606401610950565b83600d541015610a955760405162461bcd60e51b815260206004820152603260248201527f6e6f7420656e6f756768207374616b6520726573657276656420746f20637265604482015271185d194818481b995dc818dbdb9d1c9858dd60721b6064820152608401610950565b610aa485858585856001611bd6565b5083600d6000828254610ab79190614413565b90915550505050505050565b6003546001600160a01b03163314610aed5760405162461bcd60e51b8152600401610950906143b0565b600c55565b6000610afe338361188c565b9050610b0981611d8b565b610b1b81610b1683611b4b565b611da6565b505050565b336000908152602081815260408083206016835281842080546017909452918420805492859055849055925b8354811015610bf957610b7a848281548110610b6a57610b6a61436b565b9060005260206000200154611b39565b600080610ba2868481548110610b9257610b9261436b565b9060005260206000200154611926565b9092509050610bb18286614400565b9450610bbd8185614400565b9350610be4868481548110610bd457610bd461436b565b9060005260206000200154611e12565b50508080610bf190614397565b915050610b4c565b50610b1b82826119f3565b6002546001600160a01b03163314610c2e5760405162461bcd60e51b815260040161095090614426565b610b1b838383611f57565b6000610c4e610c48858561188c565b83611fb9565b90505b9392505050565b60606108e1610c67848461188c565b612008565b600254604080516335dce58760e11b815290516000926001600160a01b031691636bb9cb0e9160048083019260209291908290030181865afa158015610cb6573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610cda91906143e7565b600c54610ce7919061446e565b905090565b6000610cf8338361188c565b9050610d0381611b39565b6000610d0e82611b4b565b9050610d1a8282611da6565b9050610d268282612074565b50610b1b82611e12565b6000806000610d3f868661188c565b9050610d4b8185612229565b9097909650945050505050565b6003546001600160a01b03163314610d825760405162461bcd60e51b8152600401610950906143b0565b600b55565b6000610d93338461188c565b9050600080610da28385612330565b91509150610db082826119f3565b5050505050565b6000610dc3338461188c565b9050610dce81611d8b565b610dd781611b4b565b821115610e225760405162461bcd60e51b81526020600482015260196024820152786e6f7420656e6f75676820756e6c6f636b65642066756e647360381b6044820152606401610950565b61073d8183611da6565b6000610c4e610e3b858561188c565b836123a1565b6001600160a01b03811660009081526016602090815260408083205460178352818420549284905290832090925b8154811015610ed257600080610ea0848481548110610e9057610e9061436b565b90600052602060002001546123e1565b9092509050610eaf8287614400565b9550610ebb8186614400565b945050508080610eca90614397565b915050610e6f565b5050915091565b6003546001600160a01b03163314610f035760405162461bcd60e51b8152600401610950906143b0565b600d55565b6002546001600160a01b03163314610f325760405162461bcd60e51b815260040161095090614426565b610db085858585856124ae565b6002546001600160a01b03163314610f695760405162461bcd60e51b815260040161095090614426565b610b1b838383612597565b6003546001600160a01b03163314610f9e5760405162461bcd60e51b8152600401610950906143b0565b6000610faa848461188c565b9050600080610fb883611926565b6001600160a01b038816600090815260166020526040812080549395509193508492610fe5908490614400565b90915550506001600160a01b03861660009081526017602052604081208054839290611012908490614400565b909155506110239050838786612612565b505050505050565b6110666040518060c0016040528060008152602001600081526020016000815260200160008152602001600081526020016000151581525090565b6001611072848461188c565b815481106110825761108261436b565b60009182526020918290206040805160c081018252600690930290910180548352600181015493830193909352600283015490820152600382015460608201526004820154608082015260059091015460ff16151560a0820152905092915050565b600254604080516335dce58760e11b815290516000926001600160a01b031691636bb9cb0e9160048083019260209291908290030181865afa15801561112e573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061115291906143e7565b600b54610ce7919061446e565b6000611169610c6c565b3410156111d35760405162461bcd60e51b815260206004820152603260248201527f6e6f7420656e6f7567682067617320676976656e20666f72206e6f74696669636044820152716174696f6e206f6e20756e626f6e64696e6760701b6064820152608401610950565b60006111df338661188c565b90506111ea81611d8b565b6111f5818585612762565b6002546040516352e82ce560e11b81526001600160a01b03868116600483015260248201869052600092169063a5d059ca90349060440160206040518083038185885af115801561124a573d6000803e3d6000fd5b50505050506040513d601f19601f8201168201806040525081019061126f91906143e7565b905061127c826001614400565b60126000838152602001908152602001600020819055506040518060a001604052808581526020016112ac61280d565b81526001600160a01b03808816602080840191909152600060408085018290526060948501829052868252600f835280822086518155868401516001828101919091558783015160029092018054978901516080909901511515600160a81b0260ff60a81b19991515600160a01b026001600160a81b031990991693909716929092179690961796909616939093179094558584526015815281842054601382528285208186529091529220839055611366908290614400565b6000938452601560205260409093209290925595945050505050565b600061138c6110e4565b3410156113f45760405162461bcd60e51b815260206004820152603060248201527f6e6f7420656e6f7567682067617320676976656e20666f72206e6f746966696360448201526f6174696f6e206f6e20626f6e64696e6760801b6064820152608401610950565b6000611400338661188c565b905061140b81611b39565b6000600182815481106114205761142061436b565b9060005260206000209060060201905042816002015411156114845760405162461bcd60e51b815260206004820152601860248201527f636f6e7472616374206e6f7420737461727465642079657400000000000000006044820152606401610950565b80548411156114c95760405162461bcd60e51b81526020600482015260116024820152706e6f7420656e6f75676820746f6b656e7360781b6044820152606401610950565b60025460405163528a9b3560e11b81526001600160a01b03878116600483015260248201879052600092169063a515366a90349060440160206040518083038185885af115801561151e573d6000803e3d6000fd5b50505050506040513d601f19601f8201168201806040525081019061154391906143e7565b9050848260000160008282546115599190614413565b909155505060008381526011602090815260408220805460018181018355918452919092200182905561158d908490614400565b601060008381526020019081526020016000208190555060405180608001604052808681526020016115bd61280d565b81526001600160a01b0380891660208084019190915260006040938401819052858152600e82528390208451815590840151600182015591830151600290920180546060909401511515600160a01b026001600160a81b03199094169290911691909117919091179055611631838761287b565b61163a83611e12565b9695505050505050565b6000806000611653858561188c565b905061165e816123e1565b90969095509350505050565b6002546001600160a01b031633146116945760405162461bcd60e51b815260040161095090614426565b61169d8161297c565b50565b60006116ac338361188c565b90506116b781611b39565b6116c9816116c483611b4b565b612074565b5061075581611e12565b6000811161172f5760405162461bcd60e51b815260206004820152602360248201527f7265717569726520706f73697469766520616d6f756e7420746f207472616e736044820152623332b960e91b6064820152608401610950565b600061173b338561188c565b905061174681611b39565b600061175282856129bc565b9050828110156117a45760405162461bcd60e51b815260206004820152601860248201527f6e6f7420656e6f75676820756e6c6f636b6564204c4e544e00000000000000006044820152606401610950565b60006117b085856129fc565b90506117bb83611b4b565b8111156118065760405162461bcd60e51b81526020600482015260196024820152786e6f7420656e6f75676820756e6c6f636b65642066756e647360381b6044820152606401610950565b60006001848154811061181b5761181b61436b565b906000526020600020906006020190508181600101600082825461183f9190614400565b90915550611851905084338789612aab565b61185a84611e12565b50505050505050565b6000610c4e611872858561188c565b836129bc565b60006108e1611887848461188c565b612aca565b6001600160a01b03821660009081526020819052604081205482106118e95760405162461bcd60e51b81526020600482015260136024820152721a5b9d985b1a590818dbdb9d1c9858dd081a59606a1b6044820152606401610950565b6001600160a01b03831660009081526020819052604090208054839081106119135761191361436b565b9060005260206000200154905092915050565b600081815260076020908152604080832080548251818502810185019093528083528493849392919083018282801561198857602002820191906000526020600020905b81546001600160a01b0316815260019091019060200180831161196a575b5050505050905060005b8151811015610ed2576000806119c1878585815181106119b4576119b461436b565b6020026020010151612330565b90925090506119d08287614400565b95506119dc8186614400565b9450505080806119eb90614397565b915050611992565b604051600090339084908381818185875af1925050503d8060008114611a35576040519150601f19603f3d011682016040523d82523d6000602084013e611a3a565b606091505b5050905080611a805760405162461bcd60e51b815260206004820152601260248201527111985a5b1959081d1bc81cd95b990810555560721b6044820152606401610950565b60025460405163a9059cbb60e01b8152336004820152602481018490526001600160a01b039091169063a9059cbb906044016020604051808303816000875af1158015611ad1573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611af59190614485565b905080610b1b5760405162461bcd60e51b81526020600482015260126024820152712330b4b632b2103a379039b2b73210272a2760711b6044820152606401610950565b611b4281612ba3565b61169d81612d36565b60006108e482611b5a84612aca565b600260009054906101000a90046001600160a01b03166001600160a01b03166389c614b86040518163ffffffff1660e01b8152600401602060405180830381865afa158015611bad573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611bd191906143e7565b613119565b6000838311611c275760405162461bcd60e51b815260206004820152601e60248201527f656e64206d7573742062652067726561746572207468616e20636c69666600006044820152606401610950565b50600180546040805160c08101825297885260006020808a018281528a8401998a5260608b0198895260808b0197885295151560a08b01908152848601865585835299517fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf6600686029081019190915595517fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf787015597517fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf886015595517fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf985015593517fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cfa84015595517fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cfb909201805460ff1916921515929092179091556001600160a01b0395909516825281835281208054948501815581522090910181905590565b611d9481612ba3565b611d9d81612d36565b61169d81611e12565b60008060018481548110611dbc57611dbc61436b565b906000526020600020906006020190508060000154831115611dfa578054611de49084614413565b9150611df5843383600001546131ea565b611e0b565b8215611e0b57611e0b8433856131ea565b5092915050565b600081815260076020526040812090805b825481101561073d57600960008581526020019081526020016000206000848381548110611e5357611e5361436b565b60009182526020808320909101546001600160a01b03168352820192909252604001902091505b6006820154610100900460ff16158015611e95575081546001145b8015611ea5575060018260020154145b8015611eb5575060018260040154145b15611f4557611eea84848381548110611ed057611ed061436b565b6000918252602090912001546001600160a01b031661324e565b8254811015611f4557600960008581526020019081526020016000206000848381548110611f1a57611f1a61436b565b60009182526020808320909101546001600160a01b0316835282019290925260400190209150611e7a565b80611f4f81614397565b915050611e23565b600083815260126020526040812054611f7290600190614413565b90508115611f805750505050565b600060018281548110611f9557611f9561436b565b9060005260206000209060060201905083816000016000828254610ab79190614400565b60008281526009602090815260408083206001600160a01b03851684529091528120600681015460ff1615611ffe578054611ff690600190614413565b9150506108e4565b5060009392505050565b60008181526007602090815260409182902080548351818402810184019094528084526060939283018282801561206857602002820191906000526020600020905b81546001600160a01b0316815260019091019060200180831161204a575b50505050509050919050565b80600061208084612008565b905060005b8151811080156120955750600083115b156121d65760006120bf868484815181106120b2576120b261436b565b60200260200101516129bc565b9050806000036120cf57506121c4565b60006120f48484815181106120e6576120e661436b565b6020026020010151836129fc565b9050808510612133576121078186614413565b945061212e8733848787815181106121215761212161436b565b6020026020010151612aab565b6121c1565b600061215885858151811061214a5761214a61436b565b602002602001015187613401565b9050828111156121a35760405162461bcd60e51b8152602060048201526016602482015275636f6e76657273696f6e206e6f7420776f726b696e6760501b6044820152606401610950565b600095506121bf8833838888815181106121215761212161436b565b505b50505b806121ce81614397565b915050612085565b506000600185815481106121ec576121ec61436b565b9060005260206000209060060201905082846122089190614413565b81600101600082825461221b9190614400565b909155509295945050505050565b60008281526009602090815260408083206001600160a01b03851684529091528120600681015482919060ff161515820361226b576000809250925050612329565b6000806122778661349e565b6001600160a01b03881660009081526006602052604090208054929450909250906122a29084614400565b92508060020154826122b49190614400565b91506000600185600001546122c99190614413565b90506122da8186600301548661361b565b600186600201546122eb9190614413565b6122f59190614400565b96506123068186600501548561361b565b600186600401546123179190614413565b6123219190614400565b955050505050505b9250929050565b60008281526009602090815260408083206001600160a01b03851684529091528120600681015482919060ff1615158203612372576000809250925050612329565b61237b84613651565b6123858585613773565b6001600284018190556004909301929092559590945092505050565b60008281526009602090815260408083206001600160a01b03851684529091528120600681015460ff1615611ffe5760018160010154611ff69190614413565b600081815260076020908152604080832080548251818502810185019093528083528493849392919083018282801561244357602002820191906000526020600020905b81546001600160a01b03168152600190910190602001808311612425575b5050505050905060005b8151811015610ed25760008061247c8785858151811061246f5761246f61436b565b6020026020010151612229565b909250905061248b8287614400565b95506124978186614400565b9450505080806124a690614397565b91505061244d565b81156124fc5760405162461bcd60e51b815260206004820152601b60248201527f626f6e64696e672073686f756c642062652064656c65676174656400000000006044820152606401610950565b60008581526010602052604081205461251790600190614413565b6000878152600e6020526040902060028101805460ff60a01b1916600160a01b179055909150821561258c576000600183815481106125585761255861436b565b9060005260206000209060060201905081600001548160000160008282546125809190614400565b9091555061185a915050565b61185a82878761385c565b6000838152601260205260408120546125b290600190614413565b6000858152600f602052604090208054919250906125d183868361389e565b83156125f25750600201805460ff60a01b1916600160a01b17905550505050565b60028201805460ff60a81b1916600160a81b1790556110238386836138d5565b6001600160a01b0382166000908152602081905260408120805490919061263b90600190614413565b67ffffffffffffffff81111561265357612653614248565b60405190808252806020026020018201604052801561267c578160200160208202803683370190505b5090506000805b835481101561270a578684828154811061269f5761269f61436b565b906000526020600020015403156126f8578381815481106126c2576126c261436b565b90600052602060002001548383806126d990614397565b9450815181106126eb576126eb61436b565b6020026020010181815250505b8061270281614397565b915050612683565b506001600160a01b038516600090815260208181526040909120835161273292850190613e6a565b505050506001600160a01b0316600090815260208181526040822080546001810182559083529120019190915550565b60008381526009602090815260408083206001600160a01b0386168452909152902060018101548154839161279691614413565b10156127ee5760405162461bcd60e51b815260206004820152602160248201527f6e6f7420656e6f75676820756e6c6f636b6564206c697175696420746f6b656e6044820152607360f81b6064820152608401610950565b818160010160008282546128029190614400565b909155505050505050565b600254604080516332765ebd60e21b815290516000926001600160a01b03169163c9d97af49160048083019260209291908290030181865afa158015612857573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610ce791906143e7565b6128858282613917565b60008281526009602090815260408083206001600160a01b0385168452909152902060068101805461ff001981166101001790915560ff16156128c757505050565b5060408051610100808201835260018083526020808401828152848601838152606086018481526080870185815260a0880186815260c0890187815260e08a0188815260009d8e52600988528b8e206001600160a01b039d909d168e529b90965298909a209651875591519386019390935591516002850155905160038401559451600483015591516005820155925160069093018054925161ffff1990931693151561ff0019169390931791151502179055565b60005b8151811015610755576129aa82828151811061299d5761299d61436b565b60200260200101516139af565b806129b481614397565b91505061297f565b60008281526009602090815260408083206001600160a01b03851684529091528120600681015460ff1615611ffe5760018101548154611ff69190614413565b600254604051630c825d9760e11b81526001600160a01b0384811660048301526000928392911690631904bb2e90602401600060405180830381865afa158015612a4a573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f19168201604052612a729190810190614552565b9050806101a001518161010001518260a00151612a8f9190614413565b612a99908561446e565b612aa391906146d0565b949350505050565b612ab4816139af565b612abf8482846138d5565b61073d838383613b6c565b60008060018381548110612ae057612ae061436b565b90600052602060002090600602019050600081600101548260000154612b069190614400565b90506000612b1385612008565b905060005b8151811015612b99576000612b4687848481518110612b3957612b3961436b565b6020026020010151611fb9565b905080600003612b565750612b87565b612b79838381518110612b6b57612b6b61436b565b6020026020010151826129fc565b612b839085614400565b9350505b80612b9181614397565b915050612b18565b5090949350505050565b600081815260116020526040812080549091819003612bc157505050565b600080600080612bcf61280d565b905060005b85811015612cc557868181548110612bee57612bee61436b565b90600052602060002001549450600e6000868152602001908152602001600020935081846001015403612c25575050505050505050565b600284015460008981526009602090815260408083206001600160a01b03909416835292905220600601805461ff00191690556002840154600160a01b900460ff161515600003612c7f578354612c7c9084614400565b92505b6000858152600e602090815260408083208381556001810184905560020180546001600160a81b0319169055601090915281205580612cbd81614397565b915050612bd4565b506000878152601160205260408120612cdd91613eb5565b81600003612cee5750505050505050565b600060018881548110612d0357612d0361436b565b9060005260206000209060060201905082816000016000828254612d279190614400565b90915550505050505050505050565b600081815260136020908152604080832060158352818420546014909352908320548392905b818110156131005760008181526020849052604080822054600254915163a9af105960e01b8152600481018290529097506001600160a01b039091169063a9af105990602401602060405180830381865afa158015612dbf573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190612de391906146f2565b90506000816003811115612df957612df961470f565b03612e045750613100565b60008281526020858152604080832083905588835260129091528120556001816003811115612e3557612e3561470f565b03612e6a57506000858152600f60205260408120818155600181019190915560020180546001600160b01b03191690556130ee565b6000868152600f6020526040902060028101549095506001600160a01b03166003826003811115612e9d57612e9d61470f565b03612f9e576002860154600160a81b900460ff16612f185760405162461bcd60e51b815260206004820152603260248201527f756e626f6e64696e67207761732072656c656173656420627574206e6f74206160448201527170706c6965642073756363657366756c6c7960701b6064820152608401610950565b612f21816139af565b60025460405163b6ef8c6760e01b815260048101899052612f99918a9184916001600160a01b03169063b6ef8c6790602401602060405180830381865afa158015612f70573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190612f9491906143e7565b61385c565b6130c1565b6002826003811115612fb257612fb261470f565b03613060576002860154600160a81b900460ff16156130395760405162461bcd60e51b815260206004820152603b60248201527f756e626f6e64696e6720776173206170706c696564207375636365737366756c60448201527f6c79206275742072656c65617365207761732072656a656374656400000000006064820152608401610950565b6002860154600160a01b900460ff161515600003612f9957612f998882886000015461389e565b60405162461bcd60e51b815260206004820152603060248201527f756e6b6e6f776e20556e626f6e64696e6752656c6561736553746174652c206e60448201526f195959081d1bc81a5b5c1b195b595b9d60821b6064820152608401610950565b50506000858152600f60205260408120818155600181019190915560020180546001600160b01b03191690555b806130f881614397565b915050612d5c565b6000958652601460205260409095209490945550505050565b6000806001858154811061312f5761312f61436b565b90600052602060002090600602019050806003015481600201546131539190614400565b8310156131a25760405162461bcd60e51b815260206004820152601c60248201527f636c69666620706572696f64206e6f74207265616368656420796574000000006044820152606401610950565b60006131b8826002015483600401548688613c6d565b905081600101548111156131de5760018201546131d59082614413565b92505050610c51565b50600095945050505050565b6000600184815481106131ff576131ff61436b565b90600052602060002090600602019050818160000160008282546132239190614413565b925050819055508181600101600082825461323e9190614400565b9091555061073d90508383613cae565b6000828152600760209081526040808320600883528184206001600160a01b038616855290925282205490919061328790600190614413565b8254909150613297826001614400565b036132f757818054806132ac576132ac614725565b60008281526020808220830160001990810180546001600160a01b03191690559092019092559481526008855260408082206001600160a01b03959095168252939094525050812055565b8154600090839061330a90600190614413565b8154811061331a5761331a61436b565b9060005260206000200160009054906101000a90046001600160a01b031690508083838154811061334d5761334d61436b565b600091825260209091200180546001600160a01b0319166001600160a01b0392909216919091179055613381826001614400565b60008681526008602090815260408083206001600160a01b038616845290915290205582548390806133b5576133b5614725565b60008281526020808220830160001990810180546001600160a01b03191690559092019092559581526008865260408082206001600160a01b0396909616825294909552505050812055565b600254604051630c825d9760e11b81526001600160a01b0384811660048301526000928392911690631904bb2e90602401600060405180830381865afa15801561344f573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f191682016040526134779190810190614552565b90508061010001518160a0015161348e9190614413565b6101a0820151612a99908561446e565b6001600160a01b0381811660009081526006602052604080822060048082015492516370a0823160e01b815230918101919091529293849391921690839082906370a0823190602401602060405180830381865afa158015613504573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061352891906143e7565b9050801561361357604051631293027760e31b815230600482015260009081906001600160a01b0385169063949813b8906024016040805180830381865afa158015613578573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061359c919061473b565b9150915082633b9aca0086600101546001856135b89190614400565b6135c29190614413565b6135cc919061446e565b6135d691906146d0565b965082633b9aca0086600301546001846135f09190614400565b6135fa9190614413565b613604919061446e565b61360e91906146d0565b955050505b505050915091565b60008360000361362d57506000610c51565b633b9aca008461363d8585614413565b613647919061446e565b610c4e91906146d0565b6001600160a01b0381166000908152600660205260409020613672826139af565b60018181018190556003820155600480820154604080519283526024830181526020830180516001600160e01b031663372500ab60e01b179052516000926001600160a01b03909216916136c59161475f565b6000604051808303816000865af19150503d8060008114613702576040519150601f19603f3d011682016040523d82523d6000602084013e613707565b606091505b5050905080610b1b5760405162461bcd60e51b815260206004820152603260248201527f636c61696d5265776172647328292066726f6d206c69717569642073746174656044820152710818dbdb9d1c9858dd081c995d995c9d195960721b6064820152608401610950565b6001600160a01b0381166000818152600660209081526040808320548684526009835281842094845293909152812080549192839290919083906137b990600190614413565b90506137ca8183600301548561361b565b82600201546137d99190614400565b600283018190556003830184905594506137f4600186614413565b6001600160a01b0387166000908152600660205260409020600201546005840154919650906138259083908361361b565b83600401546138349190614400565b6004840181905560058401829055945061384f600186614413565b9450505050509250929050565b6138668383613773565b505060008381526009602090815260408083206001600160a01b03861684529091528120805490918391839190612802908490614400565b60008381526009602090815260408083206001600160a01b0386168452909152812060018101805491928492612802908490614413565b6138df8383613773565b505060008381526009602090815260408083206001600160a01b03861684529091528120805490918391839190612802908490614413565b60008281526008602090815260408083206001600160a01b038516845290915290205415613943575050565b600082815260076020908152604080832080546001810182558185528385200180546001600160a01b0319166001600160a01b0387811691821790925582548887526008865284872091875290855283862055600690935292206004015416610b1b57610b1b82613d6b565b6001600160a01b0381811660009081526006602052604080822060048082015492516370a0823160e01b81523091810191909152909391909116919082906370a0823190602401602060405180830381865afa158015613a13573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190613a3791906143e7565b905080600003613a475750505050565b604051631293027760e31b815230600482015260009081906001600160a01b0385169063949813b8906024016040805180830381865afa158015613a8f573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190613ab3919061473b565b9092509050613ac3600183614400565b915082633b9aca00866001015484613adb9190614413565b613ae5919061446e565b613aef91906146d0565b856000016000828254613b029190614400565b90915550506001808601839055613b199082614400565b905082633b9aca00866003015483613b319190614413565b613b3b919061446e565b613b4591906146d0565b856002016000828254613b589190614400565b909155505060039094019390935550505050565b6001600160a01b038082166000908152600660205260408120600401549091166040516001600160a01b03868116602483015260448201869052919091169060640160408051601f198184030181529181526020820180516001600160e01b031663a9059cbb60e01b17905251613be3919061475f565b6000604051808303816000865af19150503d8060008114613c20576040519150601f19603f3d011682016040523d82523d6000602084013e613c25565b606091505b505090508061073d5760405162461bcd60e51b815260206004820152601460248201527313139513881d1c985b9cd9995c8819985a5b195960621b6044820152606401610950565b6000613c798585614400565b8310613c86575080612aa3565b83613c918685614413565b613c9b908461446e565b613ca591906146d0565b95945050505050565b60025460405163a9059cbb60e01b81526001600160a01b03848116600483015260248201849052600092169063a9059cbb906044016020604051808303816000875af1158015613d02573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190613d269190614485565b905080610b1b5760405162461bcd60e51b8152602060048201526013602482015272139513881b9bdd081d1c985b9cd9995c9c9959606a1b6044820152606401610950565b6040805160a08101825260018082526020820181905281830181905260608201526005549151630c825d9760e11b81526001600160a01b038481166004830152919260808401921690631904bb2e90602401600060405180830381865afa158015613dda573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f19168201604052613e029190810190614552565b61018001516001600160a01b039081169091529182166000908152600660209081526040918290208351815590830151600182015590820151600282015560608201516003820155608090910151600490910180546001600160a01b03191691909216179055565b828054828255906000526020600020908101928215613ea5579160200282015b82811115613ea5578251825591602001919060010190613e8a565b50613eb1929150613ecf565b5090565b508054600082559060005260206000209081019061169d91905b5b80821115613eb15760008155600101613ed0565b600060208284031215613ef657600080fd5b5035919050565b6001600160a01b038116811461169d57600080fd5b60008060408385031215613f2557600080fd5b8235613f3081613efd565b946020939093013593505050565b600060208284031215613f5057600080fd5b8135610c5181613efd565b6020808252825182820181905260009190848201906040850190845b81811015613fd457613fc1838551805182526020810151602083015260408101516040830152606081015160608301526080810151608083015260a0810151151560a08301525050565b9284019260c09290920191600101613f77565b50909695505050505050565b600080600080600060a08688031215613ff857600080fd5b853561400381613efd565b97602087013597506040870135966060810135965060800135945092505050565b801515811461169d57600080fd5b60008060006060848603121561404757600080fd5b8335925060208401359150604084013561406081614024565b809150509250925092565b60008060006060848603121561408057600080fd5b833561408b81613efd565b925060208401359150604084013561406081613efd565b6020808252825182820181905260009190848201906040850190845b81811015613fd45783516001600160a01b0316835292840192918401916001016140be565b600080604083850312156140f657600080fd5b82359150602083013561410881613efd565b809150509250929050565b6000806040838503121561412657600080fd5b50508035926020909101359150565b600080600080600060a0868803121561414d57600080fd5b85359450602086013561415f81613efd565b935060408601359250606086013561417681614024565b9150608086013561418681614024565b809150509295509295909350565b6000806000606084860312156141a957600080fd5b8335925060208401356141bb81613efd565b9150604084013561406081614024565b60c081016108e48284805182526020810151602083015260408101516040830152606081015160608301526080810151608083015260a0810151151560a08301525050565b60008060006060848603121561422557600080fd5b83359250602084013561423781613efd565b929592945050506040919091013590565b634e487b7160e01b600052604160045260246000fd5b604051610280810167ffffffffffffffff8111828210171561428257614282614248565b60405290565b604051601f8201601f1916810167ffffffffffffffff811182821017156142b1576142b1614248565b604052919050565b600060208083850312156142cc57600080fd5b823567ffffffffffffffff808211156142e457600080fd5b818501915085601f8301126142f857600080fd5b81358181111561430a5761430a614248565b8060051b915061431b848301614288565b818152918301840191848101908884111561433557600080fd5b938501935b8385101561435f578435925061434f83613efd565b828252938501939085019061433a565b98975050505050505050565b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052601160045260246000fd5b6000600182016143a9576143a9614381565b5060010190565b6020808252601a908201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604082015260600190565b6000602082840312156143f957600080fd5b5051919050565b808201808211156108e4576108e4614381565b818103818111156108e4576108e4614381565b60208082526028908201527f66756e6374696f6e207265737472696374656420746f204175746f6e6974792060408201526718dbdb9d1c9858dd60c21b606082015260800190565b80820281158282048414176108e4576108e4614381565b60006020828403121561449757600080fd5b8151610c5181614024565b80516144ad81613efd565b919050565b60005b838110156144cd5781810151838201526020016144b5565b50506000910152565b600082601f8301126144e757600080fd5b815167ffffffffffffffff81111561450157614501614248565b614514601f8201601f1916602001614288565b81815284602083860101111561452957600080fd5b612aa38260208301602087016144b2565b6004811061169d57600080fd5b80516144ad8161453a565b60006020828403121561456457600080fd5b815167ffffffffffffffff8082111561457c57600080fd5b90830190610280828603121561459157600080fd5b61459961425e565b6145a2836144a2565b81526145b0602084016144a2565b60208201526145c1604084016144a2565b60408201526060830151828111156145d857600080fd5b6145e4878286016144d6565b6060830152506080830151608082015260a083015160a082015260c083015160c082015260e083015160e08201526101008084015181830152506101208084015181830152506101408084015181830152506101608084015181830152506101806146508185016144a2565b908201526101a083810151908201526101c080840151908201526101e0808401519082015261020080840151908201526102208084015190820152610240808401518381111561469f57600080fd5b6146ab888287016144d6565b82840152505061026091506146c1828401614547565b91810191909152949350505050565b6000826146ed57634e487b7160e01b600052601260045260246000fd5b500490565b60006020828403121561470457600080fd5b8151610c518161453a565b634e487b7160e01b600052602160045260246000fd5b634e487b7160e01b600052603160045260246000fd5b6000806040838503121561474e57600080fd5b505080516020909101519092909150565b600082516147718184602087016144b2565b919091019291505056fea2646970667358221220162417d8fb607e0d85df489c83fcfa96ac468831e23491d17b4e590316a51a2a64736f6c63430008150033",
 }
 
 // StakableVestingABI is the input ABI used to generate the binding from.
@@ -28021,7 +28491,7 @@ var TestBaseMetaData = &bind.MetaData{
 	Sigs: map[string]string{
 		"bfb4ebcf": "Foo()",
 	},
-	Bin: "0x608060405234801561001057600080fd5b5060405161041238038061041283398101604081905261002f91610058565b600061003b82826101aa565b5050610269565b634e487b7160e01b600052604160045260246000fd5b6000602080838503121561006b57600080fd5b82516001600160401b038082111561008257600080fd5b818501915085601f83011261009657600080fd5b8151818111156100a8576100a8610042565b604051601f8201601f19908116603f011681019083821181831017156100d0576100d0610042565b8160405282815288868487010111156100e857600080fd5b600093505b8284101561010a57848401860151818501870152928501926100ed565b600086848301015280965050505050505092915050565b600181811c9082168061013557607f821691505b60208210810361015557634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156101a557600081815260208120601f850160051c810160208610156101825750805b601f850160051c820191505b818110156101a15782815560010161018e565b5050505b505050565b81516001600160401b038111156101c3576101c3610042565b6101d7816101d18454610121565b8461015b565b602080601f83116001811461020c57600084156101f45750858301515b600019600386901b1c1916600185901b1785556101a1565b600085815260208120601f198616915b8281101561023b5788860151825594840194600190910190840161021c565b50858210156102595787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b61019a806102786000396000f3fe608060405234801561001057600080fd5b506004361061002b5760003560e01c8063bfb4ebcf14610030575b600080fd5b61003861004e565b60405161004591906100dc565b60405180910390f35b6000805461005b9061012a565b80601f01602080910402602001604051908101604052809291908181526020018280546100879061012a565b80156100d45780601f106100a9576101008083540402835291602001916100d4565b820191906000526020600020905b8154815290600101906020018083116100b757829003601f168201915b505050505081565b600060208083528351808285015260005b81811015610109578581018301518582016040015282016100ed565b506000604082860101526040601f19601f8301168501019250505092915050565b600181811c9082168061013e57607f821691505b60208210810361015e57634e487b7160e01b600052602260045260246000fd5b5091905056fea2646970667358221220a74a8fb8d7b9e15e45c287a97c6e288a3f01b2de97026de3e9720d9a6af910b664736f6c63430008150033",
+	Bin: "0x608060405234801561001057600080fd5b5060405161041238038061041283398101604081905261002f91610058565b600061003b82826101aa565b5050610269565b634e487b7160e01b600052604160045260246000fd5b6000602080838503121561006b57600080fd5b82516001600160401b038082111561008257600080fd5b818501915085601f83011261009657600080fd5b8151818111156100a8576100a8610042565b604051601f8201601f19908116603f011681019083821181831017156100d0576100d0610042565b8160405282815288868487010111156100e857600080fd5b600093505b8284101561010a57848401860151818501870152928501926100ed565b600086848301015280965050505050505092915050565b600181811c9082168061013557607f821691505b60208210810361015557634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156101a557600081815260208120601f850160051c810160208610156101825750805b601f850160051c820191505b818110156101a15782815560010161018e565b5050505b505050565b81516001600160401b038111156101c3576101c3610042565b6101d7816101d18454610121565b8461015b565b602080601f83116001811461020c57600084156101f45750858301515b600019600386901b1c1916600185901b1785556101a1565b600085815260208120601f198616915b8281101561023b5788860151825594840194600190910190840161021c565b50858210156102595787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b61019a806102786000396000f3fe608060405234801561001057600080fd5b506004361061002b5760003560e01c8063bfb4ebcf14610030575b600080fd5b61003861004e565b60405161004591906100dc565b60405180910390f35b6000805461005b9061012a565b80601f01602080910402602001604051908101604052809291908181526020018280546100879061012a565b80156100d45780601f106100a9576101008083540402835291602001916100d4565b820191906000526020600020905b8154815290600101906020018083116100b757829003601f168201915b505050505081565b600060208083528351808285015260005b81811015610109578581018301518582016040015282016100ed565b506000604082860101526040601f19601f8301168501019250505092915050565b600181811c9082168061013e57607f821691505b60208210810361015e57634e487b7160e01b600052602260045260246000fd5b5091905056fea2646970667358221220f6effd133e0ba47f32828a240b668f655a74f96019f5b8ab5cb42a394e8eee0864736f6c63430008150033",
 }
 
 // TestBaseABI is the input ABI used to generate the binding from.
@@ -28234,7 +28704,7 @@ var TestUpgradedMetaData = &bind.MetaData{
 		"bfb4ebcf": "Foo()",
 		"1e4f3395": "FooBar(string)",
 	},
-	Bin: "0x608060405234801561001057600080fd5b5060405161068838038061068883398101604081905261002f9161010e565b80600061003c82826101fa565b506001905061004b83826101fa565b5050506102b9565b634e487b7160e01b600052604160045260246000fd5b600082601f83011261007a57600080fd5b81516001600160401b038082111561009457610094610053565b604051601f8301601f19908116603f011681019082821181831017156100bc576100bc610053565b816040528381526020925086838588010111156100d857600080fd5b600091505b838210156100fa57858201830151818301840152908201906100dd565b600093810190920192909252949350505050565b6000806040838503121561012157600080fd5b82516001600160401b038082111561013857600080fd5b61014486838701610069565b9350602085015191508082111561015a57600080fd5b5061016785828601610069565b9150509250929050565b600181811c9082168061018557607f821691505b6020821081036101a557634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156101f557600081815260208120601f850160051c810160208610156101d25750805b601f850160051c820191505b818110156101f1578281556001016101de565b5050505b505050565b81516001600160401b0381111561021357610213610053565b610227816102218454610171565b846101ab565b602080601f83116001811461025c57600084156102445750858301515b600019600386901b1c1916600185901b1785556101f1565b600085815260208120601f198616915b8281101561028b5788860151825594840194600190910190840161026c565b50858210156102a95787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b6103c0806102c86000396000f3fe608060405234801561001057600080fd5b50600436106100415760003560e01c80631e4f339514610046578063b0a378b01461005b578063bfb4ebcf14610079575b600080fd5b610059610054366004610142565b610081565b005b610063610091565b60405161007091906101f3565b60405180910390f35b61006361011f565b600061008d82826102ca565b5050565b6001805461009e90610241565b80601f01602080910402602001604051908101604052809291908181526020018280546100ca90610241565b80156101175780601f106100ec57610100808354040283529160200191610117565b820191906000526020600020905b8154815290600101906020018083116100fa57829003601f168201915b505050505081565b6000805461009e90610241565b634e487b7160e01b600052604160045260246000fd5b60006020828403121561015457600080fd5b813567ffffffffffffffff8082111561016c57600080fd5b818401915084601f83011261018057600080fd5b8135818111156101925761019261012c565b604051601f8201601f19908116603f011681019083821181831017156101ba576101ba61012c565b816040528281528760208487010111156101d357600080fd5b826020860160208301376000928101602001929092525095945050505050565b600060208083528351808285015260005b8181101561022057858101830151858201604001528201610204565b506000604082860101526040601f19601f8301168501019250505092915050565b600181811c9082168061025557607f821691505b60208210810361027557634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156102c557600081815260208120601f850160051c810160208610156102a25750805b601f850160051c820191505b818110156102c1578281556001016102ae565b5050505b505050565b815167ffffffffffffffff8111156102e4576102e461012c565b6102f8816102f28454610241565b8461027b565b602080601f83116001811461032d57600084156103155750858301515b600019600386901b1c1916600185901b1785556102c1565b600085815260208120601f198616915b8281101561035c5788860151825594840194600190910190840161033d565b508582101561037a5787850151600019600388901b60f8161c191681555b5050505050600190811b0190555056fea26469706673582212200b46b1939b8dff7a6da947ceabbd6f342e1ab810c761cdc9a18e3e640ac21ba664736f6c63430008150033",
+	Bin: "0x608060405234801561001057600080fd5b5060405161068838038061068883398101604081905261002f9161010e565b80600061003c82826101fa565b506001905061004b83826101fa565b5050506102b9565b634e487b7160e01b600052604160045260246000fd5b600082601f83011261007a57600080fd5b81516001600160401b038082111561009457610094610053565b604051601f8301601f19908116603f011681019082821181831017156100bc576100bc610053565b816040528381526020925086838588010111156100d857600080fd5b600091505b838210156100fa57858201830151818301840152908201906100dd565b600093810190920192909252949350505050565b6000806040838503121561012157600080fd5b82516001600160401b038082111561013857600080fd5b61014486838701610069565b9350602085015191508082111561015a57600080fd5b5061016785828601610069565b9150509250929050565b600181811c9082168061018557607f821691505b6020821081036101a557634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156101f557600081815260208120601f850160051c810160208610156101d25750805b601f850160051c820191505b818110156101f1578281556001016101de565b5050505b505050565b81516001600160401b0381111561021357610213610053565b610227816102218454610171565b846101ab565b602080601f83116001811461025c57600084156102445750858301515b600019600386901b1c1916600185901b1785556101f1565b600085815260208120601f198616915b8281101561028b5788860151825594840194600190910190840161026c565b50858210156102a95787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b6103c0806102c86000396000f3fe608060405234801561001057600080fd5b50600436106100415760003560e01c80631e4f339514610046578063b0a378b01461005b578063bfb4ebcf14610079575b600080fd5b610059610054366004610142565b610081565b005b610063610091565b60405161007091906101f3565b60405180910390f35b61006361011f565b600061008d82826102ca565b5050565b6001805461009e90610241565b80601f01602080910402602001604051908101604052809291908181526020018280546100ca90610241565b80156101175780601f106100ec57610100808354040283529160200191610117565b820191906000526020600020905b8154815290600101906020018083116100fa57829003601f168201915b505050505081565b6000805461009e90610241565b634e487b7160e01b600052604160045260246000fd5b60006020828403121561015457600080fd5b813567ffffffffffffffff8082111561016c57600080fd5b818401915084601f83011261018057600080fd5b8135818111156101925761019261012c565b604051601f8201601f19908116603f011681019083821181831017156101ba576101ba61012c565b816040528281528760208487010111156101d357600080fd5b826020860160208301376000928101602001929092525095945050505050565b600060208083528351808285015260005b8181101561022057858101830151858201604001528201610204565b506000604082860101526040601f19601f8301168501019250505092915050565b600181811c9082168061025557607f821691505b60208210810361027557634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156102c557600081815260208120601f850160051c810160208610156102a25750805b601f850160051c820191505b818110156102c1578281556001016102ae565b5050505b505050565b815167ffffffffffffffff8111156102e4576102e461012c565b6102f8816102f28454610241565b8461027b565b602080601f83116001811461032d57600084156103155750858301515b600019600386901b1c1916600185901b1785556102c1565b600085815260208120601f198616915b8281101561035c5788860151825594840194600190910190840161033d565b508582101561037a5787850151600019600388901b60f8161c191681555b5050505050600190811b0190555056fea26469706673582212208620dea37abc07ed001ccd454109f4529e6e9696dec08242a5cfb17a41fef97464736f6c63430008150033",
 }
 
 // TestUpgradedABI is the input ABI used to generate the binding from.
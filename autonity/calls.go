@@ -639,6 +639,16 @@ func (c *AutonityContract) callGetEpochPeriod(state vm.StateDB, header *types.He
 	return epochPeriod, nil
 }
 
+func (c *AutonityContract) callGetConsensusTimeouts(state vm.StateDB, header *types.Header) (*AutonityConsensusTimeouts, *AutonityConsensusTimeouts, *big.Int, error) {
+	current := new(AutonityConsensusTimeouts)
+	next := new(AutonityConsensusTimeouts)
+	nextBlock := new(big.Int)
+	if err := c.AutonityContractCall(state, header, "getConsensusTimeouts", &[]any{current, next, &nextBlock}); err != nil {
+		return nil, nil, nil, err
+	}
+	return current, next, nextBlock, nil
+}
+
 func (c *AutonityContract) callFinalize(state vm.StateDB, header *types.Header) (bool, *types.Epoch, error) {
 	var updateReady bool
 	var epochEnded bool
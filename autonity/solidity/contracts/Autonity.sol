@@ -159,6 +159,20 @@ contract Autonity is IAutonity, IERC20, ReentrancyGuard, Upgradeable {
         uint256 contractVersion;
     }
 
+    /**
+     * @notice Tendermint consensus timeouts, in milliseconds. The timeout of a step at round r is its initial timeout
+     * plus r times its delta. If all the fields are zero, the clients use their default timeouts.
+     */
+    struct ConsensusTimeouts {
+        uint256 proposeInitial;
+        uint256 proposeDelta;
+        uint256 prevoteInitial;
+        uint256 prevoteDelta;
+        uint256 precommitInitial;
+        uint256 precommitDelta;
+        bool adaptive;
+    }
+
     struct EpochInfo {
         CommitteeMember[] committee;
         uint256 previousEpochBlock;
@@ -230,6 +244,14 @@ contract Autonity is IAutonity, IERC20, ReentrancyGuard, Upgradeable {
      */
     address public liquidLogicContract;
 
+    // consensus timeouts, new timeouts are applied to the protocol right after the end of current epoch.
+    ConsensusTimeouts internal consensusTimeouts;
+    ConsensusTimeouts internal consensusTimeoutsToBeApplied;
+    uint256 internal consensusTimeoutsToBeAppliedAtBlock;
+
+    // states of the genesis validators, set once the genesis bonding requests are applied.
+    ValidatorState[] internal genesisStates;
+
     /* Events */
     event MintedStake(address indexed addr, uint256 amount);
     event BurnedStake(address indexed addr, uint256 amount);
@@ -267,6 +289,7 @@ contract Autonity is IAutonity, IERC20, ReentrancyGuard, Upgradeable {
     event ActivatedValidator(address indexed treasury, address indexed addr, uint256 effectiveBlock);
     event Rewarded(address indexed addr, uint256 atnAmount, uint256 ntnAmount);
     event EpochPeriodUpdated(uint256 period, uint256 toBeAppliedAtBlock);
+    event ConsensusTimeoutsUpdated(ConsensusTimeouts timeouts, uint256 toBeAppliedAtBlock);
     event NewEpoch(uint256 epoch);
 
     /**
@@ -311,6 +334,8 @@ contract Autonity is IAutonity, IERC20, ReentrancyGuard, Upgradeable {
         liquidLogicContract = address(new LiquidLogic());
         for (uint256 i = 0; i < _validators.length; i++) {
             uint256 _bondedStake = _validators[i].bondedStake;
+            // The validators are active until the genesis bonding requests are applied.
+            genesisStates.push(_validators[i].state);
 
             // Sanitize the validator fields for a fresh new deployment.
             _validators[i].liquidSupply = 0;
@@ -324,14 +349,21 @@ contract Autonity is IAutonity, IERC20, ReentrancyGuard, Upgradeable {
             _verifyEnode(_validators[i]);
             _deployLiquidStateContract(_validators[i]);
 
-            accounts[_validators[i].treasury] += _bondedStake;
-            stakeSupply += _bondedStake;
-            _bond(_validators[i].nodeAddress, _bondedStake, payable(_validators[i].treasury));
+            // A validator may be registered with delegated stake only.
+            if (_bondedStake > 0) {
+                accounts[_validators[i].treasury] += _bondedStake;
+                stakeSupply += _bondedStake;
+                _bond(_validators[i].nodeAddress, _bondedStake, payable(_validators[i].treasury));
+            }
         }
     }
 
     function finalizeInitialization() onlyProtocol nonReentrant public {
         _stakingOperations();
+        for (uint256 i = 0; i < genesisStates.length; i++) {
+            validators[validatorList[i]].state = genesisStates[i];
+        }
+        delete genesisStates;
         computeCommittee();
         lastEpochTime = block.timestamp;
         lastFinalizedBlock = block.number;
@@ -595,6 +627,30 @@ contract Autonity is IAutonity, IERC20, ReentrancyGuard, Upgradeable {
         emit EpochPeriodUpdated(_period, toBeAppliedAtBlock);
     }
 
+    /*
+    * @notice Set the consensus timeouts, in milliseconds. Restricted to the Operator account.
+    * The initial timeouts must be within [100, 30000] and the deltas within [10, 10000].
+    * The new timeouts are applied at the end of the current epoch.
+    * @dev Emit a {ConsensusTimeoutsUpdated} event.
+    */
+    function setConsensusTimeouts(ConsensusTimeouts memory _timeouts) public virtual onlyOperator {
+        require(
+            _validInitialTimeout(_timeouts.proposeInitial) &&
+            _validInitialTimeout(_timeouts.prevoteInitial) &&
+            _validInitialTimeout(_timeouts.precommitInitial),
+            "initial timeout out of bounds"
+        );
+        require(
+            _validTimeoutDelta(_timeouts.proposeDelta) &&
+            _validTimeoutDelta(_timeouts.prevoteDelta) &&
+            _validTimeoutDelta(_timeouts.precommitDelta),
+            "timeout delta out of bounds"
+        );
+        consensusTimeoutsToBeApplied = _timeouts;
+        consensusTimeoutsToBeAppliedAtBlock = epochInfos[epochID].nextEpochBlock;
+        emit ConsensusTimeoutsUpdated(_timeouts, consensusTimeoutsToBeAppliedAtBlock);
+    }
+
     /*
     * @notice Set the Operator account. Restricted to the Operator account.
     * @param _account the new operator account.
@@ -847,6 +903,11 @@ contract Autonity is IAutonity, IERC20, ReentrancyGuard, Upgradeable {
                 config.protocol.epochPeriod = epochPeriodToBeApplied;
                 config.contracts.accountabilityContract.setEpochPeriod(epochPeriodToBeApplied);
             }
+            // apply new consensus timeouts.
+            if (consensusTimeoutsToBeAppliedAtBlock != 0) {
+                consensusTimeouts = consensusTimeoutsToBeApplied;
+                consensusTimeoutsToBeAppliedAtBlock = 0;
+            }
             uint256 nextEpochBlock = block.number + config.protocol.epochPeriod;
             lastEpochTime = block.timestamp;
             epochID += 1;
@@ -918,6 +979,13 @@ contract Autonity is IAutonity, IERC20, ReentrancyGuard, Upgradeable {
         return unbondingMap[_unbondingID].revertingAmount;
     }
 
+    /**
+     * @notice Returns the unbonding request of the given ID.
+     */
+    function getUnbondingRequest(uint256 _unbondingID) external view returns (UnbondingRequest memory) {
+        return unbondingMap[_unbondingID];
+    }
+
     /**
     * @notice Returns the epoch period.
     */
@@ -930,6 +998,14 @@ contract Autonity is IAutonity, IERC20, ReentrancyGuard, Upgradeable {
         return config.protocol.epochPeriod;
     }
 
+    /**
+    * @notice Returns the consensus timeouts currently applied, along with the timeouts to be applied at the end of
+    * the current epoch and the block at which they are applied. The block is zero if no new timeouts are pending.
+    */
+    function getConsensusTimeouts() external view virtual returns (ConsensusTimeouts memory, ConsensusTimeouts memory, uint256) {
+        return (consensusTimeouts, consensusTimeoutsToBeApplied, consensusTimeoutsToBeAppliedAtBlock);
+    }
+
     /**
     * @notice Returns the block period.
     */
@@ -1225,6 +1301,15 @@ contract Autonity is IAutonity, IERC20, ReentrancyGuard, Upgradeable {
         emit MintedStake(_addr, _amount);
     }
 
+    // @dev the bounds of the consensus timeouts, in milliseconds, must match the ones enforced by the client.
+    function _validInitialTimeout(uint256 _timeout) internal pure returns (bool) {
+        return _timeout >= 100 && _timeout <= 30000;
+    }
+
+    function _validTimeoutDelta(uint256 _delta) internal pure returns (bool) {
+        return _delta >= 10 && _delta <= 10000;
+    }
+
     /**
      * @dev Sets `amount` as the allowance of `spender` over the `owner` s tokens.
      *
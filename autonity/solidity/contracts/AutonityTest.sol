@@ -37,10 +37,6 @@ contract AutonityTest is Autonity {
         return bondingMap[_id];
    }
 
-   function getUnbondingRequest(uint256 _id) public view returns (UnbondingRequest memory) {
-        return unbondingMap[_id];
-   }
-
     function getHeadBondingID() external view returns (uint256) {
         return headBondingID;
     }
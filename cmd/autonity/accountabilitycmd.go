@@ -0,0 +1,270 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"gopkg.in/urfave/cli.v1"
+
+	"github.com/autonity/autonity/accounts/abi"
+	autonitybind "github.com/autonity/autonity/autonity"
+	"github.com/autonity/autonity/cmd/utils"
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/common/hexutil"
+	"github.com/autonity/autonity/consensus/tendermint/accountability"
+	"github.com/autonity/autonity/core"
+	"github.com/autonity/autonity/core/rawdb"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/core/vm"
+	"github.com/autonity/autonity/ethdb"
+	"github.com/autonity/autonity/params"
+	"github.com/autonity/autonity/params/generated"
+)
+
+var (
+	proofFileFlag = cli.StringFlag{
+		Name:  "file",
+		Usage: "File containing the raw RLP proof, either binary or hex encoded",
+	}
+	proofTxFlag = cli.StringFlag{
+		Name:  "tx",
+		Usage: "Hash of the Accountability contract transaction which submitted the proof, looked up in the chain database",
+	}
+	committeeFileFlag = cli.StringFlag{
+		Name:  "committee",
+		Usage: "JSON file of the committee of the proof's height, as returned by tendermint_getCommittee (default: read from the chain database)",
+	}
+	proofBlockFlag = cli.Uint64Flag{
+		Name:  "block",
+		Usage: "Block number at which the proof is submitted, used to check the timing of accusations (default: the block of the transaction, or the block after the chain head)",
+	}
+	jsonOutputFlag = cli.BoolFlag{
+		Name:  "json",
+		Usage: "Print the verification report in JSON",
+	}
+
+	accountabilityCommand = cli.Command{
+		Name:     "accountability",
+		Usage:    "A set of commands to inspect accountability proofs",
+		Category: "MISCELLANEOUS COMMANDS",
+		Subcommands: []cli.Command{
+			{
+				Action:    utils.MigrateFlags(verifyProof),
+				Name:      "verify",
+				Usage:     "Verify an accountability proof offline",
+				ArgsUsage: "[<hex proof>]",
+				Flags: []cli.Flag{
+					proofFileFlag,
+					proofTxFlag,
+					committeeFileFlag,
+					proofBlockFlag,
+					jsonOutputFlag,
+					utils.DataDirFlag,
+					utils.SyncModeFlag,
+					utils.PiccadillyFlag,
+					utils.BakerlooFlag,
+				},
+				Description: `
+    autonity accountability verify [--file <proof file> | --tx <tx hash> | <hex proof>]
+
+Decodes a raw RLP accountability proof and verifies it the same way as the
+MisbehaviourVerifier, AccusationVerifier and InnocenceVerifier precompiles do.
+It prints the decoded proof, the rule it claims, the outcome of each signature
+check and the verdict of each verifier.
+
+The proof is given in hex as argument, read from the file given by --file, or
+extracted from the Accountability contract transaction given by --tx.
+The committee of the proof's height is read from the JSON file given by
+--committee, otherwise it is resolved from the chain database. The checks of the
+AccusationVerifier depending on the chain are only performed when the chain
+database is used, or for the timing of the accusation when --block is set.`,
+			},
+		},
+	}
+)
+
+func verifyProof(ctx *cli.Context) error {
+	sources := 0
+	for _, set := range []bool{ctx.IsSet(proofFileFlag.Name), ctx.IsSet(proofTxFlag.Name), ctx.NArg() > 0} {
+		if set {
+			sources++
+		}
+	}
+	if sources != 1 {
+		utils.Fatalf("Exactly one of --%s, --%s or a hex proof argument is required", proofFileFlag.Name, proofTxFlag.Name)
+	}
+
+	var (
+		rawProof    []byte
+		blockNumber = ctx.Uint64(proofBlockFlag.Name)
+		committee   *types.Committee
+		chain       *core.BlockChain
+		chainDb     ethdb.Database
+		config      *params.ChainConfig
+		getHash     vm.GetHashFunc
+		err         error
+	)
+	// the chain database is needed to resolve the transaction or the committee
+	if ctx.IsSet(proofTxFlag.Name) || !ctx.IsSet(committeeFileFlag.Name) {
+		stack, _ := makeConfigNode(ctx)
+		defer stack.Close()
+		chain, chainDb = utils.MakeChain(ctx, stack)
+		defer chain.Stop()
+		config = chain.Config()
+		getHash = chain.GetCanonicalHash
+		if !ctx.IsSet(proofBlockFlag.Name) {
+			blockNumber = chain.CurrentBlock().NumberU64() + 1
+		}
+	}
+
+	switch {
+	case ctx.IsSet(proofFileFlag.Name):
+		if rawProof, err = readRawProof(ctx.String(proofFileFlag.Name)); err != nil {
+			return err
+		}
+	case ctx.IsSet(proofTxFlag.Name):
+		var txBlock uint64
+		if rawProof, txBlock, err = readProofTransaction(chainDb, common.HexToHash(ctx.String(proofTxFlag.Name))); err != nil {
+			return err
+		}
+		if !ctx.IsSet(proofBlockFlag.Name) {
+			blockNumber = txBlock
+		}
+	default:
+		if rawProof, err = hexutil.Decode(ctx.Args().First()); err != nil {
+			return fmt.Errorf("invalid hex proof: %v", err)
+		}
+	}
+
+	proof, err := accountability.DecodeRawProof(rawProof)
+	if err != nil {
+		return fmt.Errorf("invalid proof: %v", err)
+	}
+	if ctx.IsSet(committeeFileFlag.Name) {
+		if committee, err = readCommittee(ctx.String(committeeFileFlag.Name)); err != nil {
+			return err
+		}
+	} else if committee, err = chain.CommitteeOfHeight(proof.Message.H()); err != nil {
+		return fmt.Errorf("failed to resolve the committee of height %d: %v", proof.Message.H(), err)
+	}
+
+	if config == nil {
+		if genesis := utils.MakeGenesis(ctx); genesis != nil {
+			config = genesis.Config
+		}
+	}
+	report, err := accountability.VerifyProof(rawProof, committee, config, blockNumber, getHash)
+	if err != nil {
+		return err
+	}
+	if ctx.Bool(jsonOutputFlag.Name) {
+		out, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(out))
+		return nil
+	}
+	printVerificationReport(report, blockNumber)
+	return nil
+}
+
+// readRawProof reads a raw proof from file, either stored in binary or in hex.
+func readRawProof(file string) ([]byte, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	if trimmed := bytes.TrimSpace(content); bytes.HasPrefix(trimmed, []byte("0x")) {
+		return hexutil.Decode(string(trimmed))
+	}
+	return content, nil
+}
+
+// readProofTransaction extracts the raw proof submitted by an Accountability contract transaction, along with the
+// number of the block including it.
+func readProofTransaction(db ethdb.Reader, hash common.Hash) ([]byte, uint64, error) {
+	tx, _, blockNumber, _ := rawdb.ReadTransaction(db, hash)
+	if tx == nil {
+		return nil, 0, fmt.Errorf("transaction %v not found", hash)
+	}
+	if tx.To() == nil || *tx.To() != params.AccountabilityContractAddress || len(tx.Data()) < 4 {
+		return nil, 0, fmt.Errorf("transaction %v is not an Accountability contract call", hash)
+	}
+	method, err := generated.AccountabilityAbi.MethodById(tx.Data()[:4])
+	if err != nil {
+		return nil, 0, err
+	}
+	switch method.Name {
+	case "handleMisbehaviour", "handleAccusation", "handleInnocenceProof":
+	default:
+		return nil, 0, fmt.Errorf("transaction %v calls %s, which does not submit a proof", hash, method.Name)
+	}
+	args, err := method.Inputs.Unpack(tx.Data()[4:])
+	if err != nil {
+		return nil, 0, err
+	}
+	event := abi.ConvertType(args[0], new(autonitybind.AccountabilityEvent)).(*autonitybind.AccountabilityEvent)
+	return event.RawProof, blockNumber, nil
+}
+
+// readCommittee reads a committee stored in JSON.
+func readCommittee(file string) (*types.Committee, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	committee := new(types.Committee)
+	if err := json.Unmarshal(content, committee); err != nil {
+		return nil, fmt.Errorf("invalid committee file: %v", err)
+	}
+	if committee.Len() == 0 {
+		return nil, fmt.Errorf("empty committee in %s", file)
+	}
+	if err := committee.Enrich(); err != nil {
+		return nil, err
+	}
+	return committee, nil
+}
+
+func printVerificationReport(report *accountability.VerificationReport, blockNumber uint64) {
+	proof := report.Proof
+	fmt.Printf("Type:     %s\n", proof.Type)
+	fmt.Printf("Rule:     %s\n", proof.Rule)
+	if proof.Offender != nil {
+		fmt.Printf("Offender: %v (index %d)\n", *proof.Offender, proof.OffenderIndex)
+	} else {
+		fmt.Printf("Offender: unknown (index %d)\n", proof.OffenderIndex)
+	}
+	fmt.Printf("Height:   %d\n", proof.Message.Height)
+	fmt.Printf("Round:    %d\n", proof.Message.Round)
+	if blockNumber == 0 {
+		fmt.Println("Block:    unknown, the timing of accusations is not checked")
+	} else {
+		fmt.Printf("Block:    %d\n", blockNumber)
+	}
+
+	decoded, _ := json.MarshalIndent(proof, "", "  ")
+	fmt.Printf("\nDecoded proof:\n%s\n", decoded)
+
+	fmt.Println("\nSignature checks:")
+	for _, check := range report.Signatures {
+		if check.Passed {
+			fmt.Printf("  [ok]     %s\n", check.Name)
+		} else {
+			fmt.Printf("  [failed] %s: %s\n", check.Name, check.Error)
+		}
+	}
+
+	fmt.Println("\nVerdicts:")
+	for _, verdict := range report.Verdicts {
+		if verdict.Valid {
+			fmt.Printf("  %-22s valid: offender %v, rule %s, block %d, message %v\n", verdict.Verifier,
+				*verdict.Offender, verdict.Rule, verdict.Block, *verdict.MessageHash)
+		} else {
+			fmt.Printf("  %-22s rejected: %s\n", verdict.Verifier, verdict.Reason)
+		}
+	}
+}
@@ -150,6 +150,33 @@ It's deprecated, please use "geth db export" instead.
 This command dumps out the state for a given block (or latest, if none provided).
 `,
 	}
+	exportGenesisBlockFlag = cli.Uint64Flag{
+		Name:  "block",
+		Usage: "Number of the block whose state is exported (default: the head block)",
+	}
+	exportGenesisCommand = cli.Command{
+		Action:    utils.MigrateFlags(exportGenesis),
+		Name:      "export-genesis",
+		Usage:     "Export the state of a block into a new genesis file",
+		ArgsUsage: "[<filename>]",
+		Flags: []cli.Flag{
+			utils.DataDirFlag,
+			utils.CacheFlag,
+			utils.SyncModeFlag,
+			exportGenesisBlockFlag,
+		},
+		Category: "BLOCKCHAIN COMMANDS",
+		Description: `
+The export-genesis command builds a genesis relaunching the network from the state of
+a block, written to the given file or to the standard output. The accounts are carried
+over, while the configuration of the protocol contracts is reconstructed from their
+state so that the new chain starts with the same balances, validators, bonds and
+vesting contracts.
+
+The addresses of the accounts are read from the preimages, the chain must have been
+processed with --cache.preimages or in archive mode. The block should be an epoch
+header: the staking operations pending at the block are not carried over.`,
+	}
 )
 
 // initGenesis will initialise the given JSON format genesis file and writes it as
@@ -442,6 +469,35 @@ func dump(ctx *cli.Context) error {
 	return nil
 }
 
+func exportGenesis(ctx *cli.Context) error {
+	if len(ctx.Args()) > 1 {
+		utils.Fatalf("This command accepts at most one argument.")
+	}
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	chain, _ := utils.MakeChain(ctx, stack)
+	defer chain.Stop()
+
+	number := chain.CurrentBlock().NumberU64()
+	if ctx.IsSet(exportGenesisBlockFlag.Name) {
+		number = ctx.Uint64(exportGenesisBlockFlag.Name)
+	}
+	genesis, err := core.ExportGenesis(chain, number)
+	if err != nil {
+		utils.Fatalf("Export error: %v", err)
+	}
+	out, err := json.MarshalIndent(genesis, "", "  ")
+	if err != nil {
+		return err
+	}
+	if fp := ctx.Args().First(); fp != "" {
+		return os.WriteFile(fp, out, 0644)
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
 // hashish returns true for strings that look like hashes.
 func hashish(x string) bool {
 	_, err := strconv.Atoi(x)
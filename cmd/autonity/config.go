@@ -225,7 +225,10 @@ func makeFullNode(ctx *cli.Context) (*node.Node, ethapi.Backend) {
 		cfg.Eth.OverrideTerminalTotalDifficulty = new(big.Int).SetUint64(ctx.GlobalUint64(utils.OverrideTerminalTotalDifficulty.Name))
 	}
 	backend, ethBackend := utils.RegisterEthService(stack, &cfg.Eth)
-	utils.RegisterConsensusService(stack, ethBackend, cfg.Eth.NetworkID)
+	// Light clients only verify the headers, they do not take part in the consensus network.
+	if ethBackend != nil {
+		utils.RegisterConsensusService(stack, ethBackend, cfg.Eth.NetworkID)
+	}
 
 	// Configure GraphQL if requested
 	if ctx.GlobalIsSet(utils.GraphQLEnabledFlag.Name) {
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"gopkg.in/urfave/cli.v1"
+
+	"github.com/autonity/autonity/cmd/utils"
+	tendermintCore "github.com/autonity/autonity/consensus/tendermint/core"
+	"github.com/autonity/autonity/log"
+)
+
+var (
+	replayVerboseFlag = cli.BoolFlag{
+		Name:  "verbose",
+		Usage: "Print the recorded and replayed actions, not only the divergences",
+	}
+
+	consensusCommand = cli.Command{
+		Name:     "consensus",
+		Usage:    "A set of commands to inspect the consensus of a validator",
+		Category: "MISCELLANEOUS COMMANDS",
+		Subcommands: []cli.Command{
+			{
+				Action:    utils.MigrateFlags(replayWAL),
+				Name:      "replay",
+				Usage:     "Replay the consensus write-ahead log",
+				ArgsUsage: "[<WAL file or directory>...]",
+				Flags: []cli.Flag{
+					replayVerboseFlag,
+					jsonOutputFlag,
+					utils.DataDirFlag,
+					utils.PiccadillyFlag,
+					utils.BakerlooFlag,
+				},
+				Description: `
+    autonity consensus replay [<WAL file or directory>...]
+
+Feeds the consensus write-ahead log, recorded by a validator started with
+--consensus.wal, back into a consensus core wired to a mock backend, and reports
+where the broadcasts and decisions of the replay diverge from the recorded ones.
+The outcomes of the proposal verifications are replayed from the log, as well as
+the timeouts, so that a height replays the same way as long as the consensus
+logic behaves the same.
+
+The log is rotated per height. The files to replay are given as arguments,
+directories being expanded to the files they contain. By default, the log of the
+data directory is replayed. The command exits with an error if any height
+diverged.`,
+			},
+		},
+	}
+)
+
+// replayJSON is the report of a replayed height, in JSON.
+type replayJSON struct {
+	File        string                         `json:"file"`
+	Height      uint64                         `json:"height"`
+	Start       time.Time                      `json:"start"`
+	Entries     int                            `json:"entries"`
+	Recorded    []*tendermintCore.ReplayAction `json:"recorded,omitempty"`
+	Replayed    []*tendermintCore.ReplayAction `json:"replayed,omitempty"`
+	Divergences []*tendermintCore.Divergence   `json:"divergences"`
+}
+
+func replayWAL(ctx *cli.Context) error {
+	paths := ctx.Args()
+	if len(paths) == 0 {
+		paths = []string{filepath.Join(utils.MakeDataDir(ctx), clientIdentifier, tendermintCore.WALDir)}
+	}
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+		dirFiles, err := tendermintCore.WALFiles(path)
+		if err != nil {
+			return err
+		}
+		files = append(files, dirFiles...)
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no consensus WAL file found in %v", paths)
+	}
+
+	// the replayed core logs as much as the recorded one, only the report is of interest
+	logger := log.New()
+	logger.SetHandler(log.DiscardHandler())
+
+	var (
+		reports  []*replayJSON
+		diverged int
+	)
+	for _, file := range files {
+		report, err := tendermintCore.ReplayWAL(file, logger)
+		if err != nil {
+			return fmt.Errorf("failed to replay %s: %v", file, err)
+		}
+		for _, session := range report.Sessions {
+			if len(session.Divergences) > 0 {
+				diverged++
+			}
+			out := &replayJSON{
+				File:        file,
+				Height:      session.Height,
+				Start:       session.Start,
+				Entries:     session.Entries,
+				Divergences: make([]*tendermintCore.Divergence, 0, len(session.Divergences)),
+			}
+			out.Divergences = append(out.Divergences, session.Divergences...)
+			if ctx.Bool(replayVerboseFlag.Name) {
+				out.Recorded, out.Replayed = session.Recorded, session.Replayed
+			}
+			reports = append(reports, out)
+		}
+	}
+
+	if ctx.Bool(jsonOutputFlag.Name) {
+		out, err := json.MarshalIndent(reports, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(out))
+	} else {
+		printReplay(reports)
+	}
+	if diverged > 0 {
+		return fmt.Errorf("%d of %d replayed heights diverged", diverged, len(reports))
+	}
+	return nil
+}
+
+func printReplay(reports []*replayJSON) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Height", "Started", "Entries", "Entry", "Time", "Recorded", "Replayed"})
+	for _, report := range reports {
+		row := []string{fmt.Sprintf("%d", report.Height), report.Start.Format(time.RFC3339Nano), fmt.Sprintf("%d", report.Entries)}
+		if len(report.Divergences) == 0 {
+			table.Append(append(row, "", "", "ok", "ok"))
+		}
+		for _, divergence := range report.Divergences {
+			at := divergence.Recorded
+			if at == nil || (divergence.Replayed != nil && divergence.Replayed.Entry < at.Entry) {
+				at = divergence.Replayed
+			}
+			table.Append(append(row, fmt.Sprintf("%d", at.Entry), at.Time.Format(time.RFC3339Nano),
+				divergence.Recorded.String(), divergence.Replayed.String()))
+		}
+	}
+	table.Render()
+
+	for _, report := range reports {
+		if len(report.Recorded) == 0 && len(report.Replayed) == 0 {
+			continue
+		}
+		fmt.Printf("\nHeight %d (%s)\n", report.Height, report.File)
+		fmt.Println("  Recorded:")
+		for _, action := range report.Recorded {
+			fmt.Printf("    entry %-5d %s\n", action.Entry, action)
+		}
+		fmt.Println("  Replayed:")
+		for _, action := range report.Replayed {
+			fmt.Printf("    entry %-5d %s\n", action.Entry, action)
+		}
+	}
+}
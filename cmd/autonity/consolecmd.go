@@ -121,17 +121,7 @@ func remoteConsole(ctx *cli.Context) error {
 	// Attach to a remotely running autonity instance and start the JavaScript console
 	endpoint := ctx.Args().First()
 	if endpoint == "" {
-		path := node.DefaultDataDir()
-		if ctx.GlobalIsSet(utils.DataDirFlag.Name) {
-			path = ctx.GlobalString(utils.DataDirFlag.Name)
-		}
-		if path != "" && ctx.GlobalBool(utils.PiccadillyFlag.Name) {
-			path = filepath.Join(path, "piccadilly")
-		}
-		if path != "" && ctx.GlobalBool(utils.BakerlooFlag.Name) {
-			path = filepath.Join(path, "bakerloo")
-		}
-		endpoint = fmt.Sprintf("%s/autonity.ipc", path)
+		endpoint = defaultIPCEndpoint(ctx)
 	}
 	client, err := dialRPC(endpoint)
 	if err != nil {
@@ -160,6 +150,21 @@ func remoteConsole(ctx *cli.Context) error {
 	return nil
 }
 
+// defaultIPCEndpoint returns the IPC endpoint of the node running on the data directory.
+func defaultIPCEndpoint(ctx *cli.Context) string {
+	path := node.DefaultDataDir()
+	if ctx.GlobalIsSet(utils.DataDirFlag.Name) {
+		path = ctx.GlobalString(utils.DataDirFlag.Name)
+	}
+	if path != "" && ctx.GlobalBool(utils.PiccadillyFlag.Name) {
+		path = filepath.Join(path, "piccadilly")
+	}
+	if path != "" && ctx.GlobalBool(utils.BakerlooFlag.Name) {
+		path = filepath.Join(path, "bakerloo")
+	}
+	return fmt.Sprintf("%s/autonity.ipc", path)
+}
+
 // dialRPC returns a RPC client which connects to the given endpoint.
 // The check for empty endpoint implements the defaulting logic
 // for "geth attach" with no argument.
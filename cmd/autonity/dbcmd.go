@@ -32,9 +32,12 @@ import (
 	"github.com/autonity/autonity/cmd/utils"
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/common/hexutil"
+	tendermintCore "github.com/autonity/autonity/consensus/tendermint/core"
+	"github.com/autonity/autonity/consensus/tendermint/core/message"
 	"github.com/autonity/autonity/console/prompt"
 	"github.com/autonity/autonity/core/rawdb"
 	"github.com/autonity/autonity/core/state/snapshot"
+	"github.com/autonity/autonity/core/types"
 	"github.com/autonity/autonity/ethdb"
 	"github.com/autonity/autonity/log"
 	"github.com/autonity/autonity/trie"
@@ -72,6 +75,7 @@ Remove blockchain and state databases`,
 			dbImportCmd,
 			dbExportCmd,
 			dbMetadataCmd,
+			dbSignJournalCmd,
 		},
 	}
 	dbInspectCmd = cli.Command{
@@ -220,6 +224,47 @@ WARNING: This is a low-level operation which may cause database corruption!`,
 		},
 		Description: "Shows metadata about the chain status.",
 	}
+	dbSignJournalCmd = cli.Command{
+		Name:      "signjournal",
+		Usage:     "Inspect or reset the consensus sign journal",
+		ArgsUsage: "",
+		Subcommands: []cli.Command{
+			{
+				Action: utils.MigrateFlags(inspectSignJournal),
+				Name:   "inspect",
+				Usage:  "Shows the last consensus message signed by the validator",
+				Flags: []cli.Flag{
+					utils.DataDirFlag,
+					utils.SyncModeFlag,
+					utils.PiccadillyFlag,
+					utils.BakerlooFlag,
+				},
+				Description: `
+Shows the last consensus message signed by the validator, along with its locked
+and valid round and value at the time of signing.`,
+			},
+			{
+				Action: utils.MigrateFlags(resetSignJournal),
+				Name:   "reset",
+				Usage:  "Deletes the consensus sign journal",
+				Flags: []cli.Flag{
+					utils.DataDirFlag,
+					utils.SyncModeFlag,
+					utils.PiccadillyFlag,
+					utils.BakerlooFlag,
+				},
+				Description: `
+Deletes the consensus sign journal. The validator will then sign any consensus
+message at the height being decided, including messages conflicting with those
+sent before. This must only be done if the validator will not rejoin consensus
+at the journaled height, e.g. after the chain moved past it.`,
+			},
+		},
+		Description: `
+The sign journal records the last consensus message signed by the validator. It
+prevents a restarted validator from signing messages equivocating with the ones
+it sent before the restart.`,
+	}
 )
 
 func removeDB(ctx *cli.Context) error {
@@ -719,3 +764,74 @@ func showMetaData(ctx *cli.Context) error {
 	table.Render()
 	return nil
 }
+
+func inspectSignJournal(ctx *cli.Context) error {
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	db := utils.MakeChainDatabase(ctx, stack, true)
+	defer db.Close()
+
+	journal, err := tendermintCore.ReadSignJournal(db)
+	if err != nil {
+		return fmt.Errorf("failed to read the sign journal: %v", err)
+	}
+	if journal == nil {
+		fmt.Println("The sign journal is empty")
+		return nil
+	}
+	hash := func(block *types.Block) string {
+		if block == nil {
+			return "<nil>"
+		}
+		return block.Hash().String()
+	}
+	data := [][]string{
+		{"height", fmt.Sprintf("%d", journal.LastSigned.Height)},
+		{"round", fmt.Sprintf("%d", journal.LastSigned.Round)},
+		{"step", journal.LastSigned.Step()},
+		{"value", journal.LastSigned.Value.String()},
+	}
+	if journal.LastSigned.Code == message.ProposalCode {
+		data = append(data, []string{"proposalValidRound", fmt.Sprintf("%d", journal.LastSigned.ValidRound)})
+	}
+	data = append(data, [][]string{
+		{"lockedRound", fmt.Sprintf("%d", journal.LockedRound)},
+		{"lockedValue", hash(journal.LockedValue)},
+		{"validRound", fmt.Sprintf("%d", journal.ValidRound)},
+		{"validValue", hash(journal.ValidValue)},
+	}...)
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Field", "Value"})
+	table.AppendBulk(data)
+	table.Render()
+	return nil
+}
+
+func resetSignJournal(ctx *cli.Context) error {
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	db := utils.MakeChainDatabase(ctx, stack, false)
+	defer db.Close()
+
+	journal, err := tendermintCore.ReadSignJournal(db)
+	if err == nil && journal == nil {
+		fmt.Println("The sign journal is empty")
+		return nil
+	}
+	if err == nil {
+		fmt.Printf("Last signed %s at height %d, round %d\n", journal.LastSigned.Step(), journal.LastSigned.Height, journal.LastSigned.Round)
+	}
+	confirm, err := prompt.Stdin.PromptConfirm("Reset the sign journal? The validator may then sign conflicting messages at the journaled height.")
+	switch {
+	case err != nil:
+		return err
+	case !confirm:
+		log.Info("Sign journal reset skipped")
+	default:
+		rawdb.DeleteSignJournal(db)
+		log.Info("Sign journal reset")
+	}
+	return nil
+}
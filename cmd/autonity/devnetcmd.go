@@ -0,0 +1,130 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"gopkg.in/urfave/cli.v1"
+
+	"github.com/autonity/autonity/cmd/devnet"
+	"github.com/autonity/autonity/cmd/utils"
+	"github.com/autonity/autonity/common/hexutil"
+	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/log"
+)
+
+var (
+	devnetValidatorsFlag = cli.IntFlag{
+		Name:  "validators",
+		Usage: "Number of validators of the devnet",
+		Value: 4,
+	}
+	devnetAccountsFlag = cli.IntFlag{
+		Name:  "accounts",
+		Usage: "Number of pre-funded accounts",
+		Value: 10,
+	}
+	devnetDataDirFlag = cli.StringFlag{
+		Name:  "datadir",
+		Usage: "Directory of the data directories of the nodes (default = temporary directory removed on exit)",
+	}
+	devnetHTTPAddrFlag = cli.StringFlag{
+		Name:  "http.addr",
+		Usage: "HTTP-RPC and WS-RPC server listening interface of the nodes and of the control server",
+		Value: "127.0.0.1",
+	}
+	devnetHTTPPortFlag = cli.IntFlag{
+		Name:  "http.port",
+		Usage: "HTTP-RPC and WS-RPC server listening port of the first node, the n-th node listening on the port + n",
+		Value: 8545,
+	}
+	devnetControlPortFlag = cli.IntFlag{
+		Name:  "control.port",
+		Usage: "Listening port of the devnet control HTTP-RPC server",
+		Value: 8544,
+	}
+
+	devnetCommand = cli.Command{
+		Action:   utils.MigrateFlags(runDevnet),
+		Name:     "devnet",
+		Usage:    "Run a local network of validators in a single process",
+		Category: "MISCELLANEOUS COMMANDS",
+		Flags: []cli.Flag{
+			devnetValidatorsFlag,
+			devnetAccountsFlag,
+			devnetDataDirFlag,
+			devnetHTTPAddrFlag,
+			devnetHTTPPortFlag,
+			devnetControlPortFlag,
+		},
+		Description: `
+    autonity devnet --validators 4
+
+Starts a network of --validators validators in this process, the nodes being
+connected through in-memory pipes rather than the network. Each node serves
+HTTP-RPC and WS-RPC on its own port, starting from --http.port. The genesis
+pre-funds --accounts accounts with Auton and Newton. Their keys are the same
+from one run to another and are printed on start, the first account being the
+operator of the protocol contracts.
+
+The control server, on --control.port, serves the devnet API over HTTP-RPC:
+
+    devnet_nodes()                  state and endpoint of each node
+    devnet_accounts()               pre-funded accounts and their private keys
+    devnet_pause(index)             stop a node, keeping its data
+    devnet_resume(index)            start a paused node again
+    devnet_restart(index)           stop then start a node
+    devnet_partition(groups)        split the network, e.g. [[3]] isolates node 3
+    devnet_heal()                   remove the partition
+
+The network is whole again once the partitioned nodes redial each other, which
+takes up to a minute after devnet_heal.`,
+	}
+)
+
+// runDevnet starts a devnet and runs it until interrupted.
+func runDevnet(ctx *cli.Context) error {
+	dataDir := ctx.String(devnetDataDirFlag.Name)
+	if dataDir == "" {
+		tmp, err := os.MkdirTemp("", "autonity-devnet")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(tmp)
+		dataDir = tmp
+	}
+	network, err := devnet.New(devnet.Config{
+		Validators:  ctx.Int(devnetValidatorsFlag.Name),
+		Accounts:    ctx.Int(devnetAccountsFlag.Name),
+		DataDir:     dataDir,
+		HTTPHost:    ctx.String(devnetHTTPAddrFlag.Name),
+		HTTPPort:    ctx.Int(devnetHTTPPortFlag.Name),
+		ControlPort: ctx.Int(devnetControlPortFlag.Name),
+	})
+	if err != nil {
+		return err
+	}
+	if err := network.Start(); err != nil {
+		return err
+	}
+
+	fmt.Printf("Devnet of chain ID %v, data in %s\n\n", network.Genesis().Config.ChainID, dataDir)
+	fmt.Println("Validators:")
+	for _, n := range network.Nodes() {
+		fmt.Printf("  %d  %s  %s\n", n.Index, n.Address, n.HTTPEndpoint())
+	}
+	fmt.Println("\nAccounts:")
+	for i, account := range network.Accounts() {
+		fmt.Printf("  %d  %s  %s\n", i, account.Address, hexutil.Encode(crypto.FromECDSA(account.Key)))
+	}
+	fmt.Printf("\nControl: %s\n\n", network.ControlEndpoint())
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(interrupt)
+	<-interrupt
+	log.Info("Got interrupt, shutting down...")
+	return network.Stop()
+}
@@ -71,6 +71,8 @@ var (
 		utils.MinFreeDiskSpaceFlag,
 		utils.KeyStoreDirFlag,
 		utils.ExternalSignerFlag,
+		utils.ConsensusSignerFlag,
+		utils.ConsensusSignerAllowKeyMismatchFlag,
 		utils.NoUSBFlag,
 		utils.USBFlag,
 		utils.SmartCardDaemonPathFlag,
@@ -86,6 +88,9 @@ var (
 		utils.TxPoolGlobalQueueFlag,
 		utils.TxPoolLifetimeFlag,
 		utils.SyncModeFlag,
+		utils.EpochSyncFlag,
+		utils.EpochCheckpointFlag,
+		utils.EpochCheckpointCommitteeFlag,
 		utils.ExitWhenSyncedFlag,
 		utils.GCModeFlag,
 		utils.SnapshotFlag,
@@ -120,6 +125,7 @@ var (
 		utils.NetrestrictFlag,
 		utils.AutonityKeysFileFlag,
 		utils.AutonityKeysHexFlag,
+		utils.AutonityKeysPasswordFlag,
 		utils.OracleKeyFileFlag,
 		utils.OracleKeyHexFlag,
 		utils.WriteAddrFlag,
@@ -140,6 +146,11 @@ var (
 		utils.BakerlooFlag,
 		utils.ConsensusListenPortFlag,
 		utils.ConsensusNATFlag,
+		utils.ConsensusPreconnectFlag,
+		utils.ConsensusSentriesFlag,
+		utils.ConsensusSentryOfFlag,
+		utils.ConsensusWALFlag,
+		utils.ConsensusWALHeightsFlag,
 		utils.NoGossip,
 		configFileFlag,
 	}
@@ -201,6 +212,7 @@ func init() {
 		exportPreimagesCommand,
 		removedbCommand,
 		dumpCommand,
+		exportGenesisCommand,
 		// See accountcmd.go:
 		accountCommand,
 		// See consolecmd.go:
@@ -212,8 +224,15 @@ func init() {
 		licenseCommand,
 		ownershipProofCommand,
 		genAutonityKeysCommand,
+		encryptAutonityKeysCommand,
 		// See config.go
 		dumpConfigCommand,
+		// See accountabilitycmd.go
+		accountabilityCommand,
+		consensusCommand,
+		validatorCommand,
+		// See devnetcmd.go
+		devnetCommand,
 		// see dbcmd.go
 		dbCommand,
 		// See cmd/utils/flags_legacy.go
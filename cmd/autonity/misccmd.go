@@ -19,6 +19,8 @@ package main
 import (
 	"crypto/ecdsa"
 	"fmt"
+	"github.com/autonity/autonity/accounts/external"
+	"github.com/autonity/autonity/accounts/keystore"
 	"github.com/autonity/autonity/common/hexutil"
 	"github.com/autonity/autonity/crypto"
 	"github.com/autonity/autonity/crypto/blst"
@@ -61,8 +63,10 @@ The output of this command is supposed to be machine-readable.
 		Flags: []cli.Flag{
 			utils.AutonityKeysFileFlag,
 			utils.AutonityKeysHexFlag,
+			utils.AutonityKeysPasswordFlag,
 			utils.OracleKeyFileFlag,
 			utils.OracleKeyHexFlag,
+			utils.ConsensusSignerFlag,
 		},
 		Description: `
     	autonity genOwnershipProof
@@ -78,7 +82,9 @@ The output of this command is supposed to be machine-readable.
 			2. --autonitykeyshex <node keys in hex>
 		Similarly there are two ways to pass oracle private key:
 			1. --oraclekey <oracle key file name>
-			2. --oraclekeyhex <oracle key in hex>`,
+			2. --oraclekeyhex <oracle key in hex>
+		If the consensus key is held by an external signer, use --consensus.signer
+		<signer url> to have the proof of possession of the consensus key signed by it.`,
 		ArgsUsage: "<treasury>",
 		Category:  "MISCELLANEOUS COMMANDS",
 	}
@@ -89,15 +95,38 @@ The output of this command is supposed to be machine-readable.
 		Usage:  "Generate autonity keys",
 		Flags: []cli.Flag{
 			utils.WriteAddrFlag,
+			utils.EncryptAutonityKeysFlag,
+			utils.AutonityKeysPasswordFlag,
+			utils.LightKDFFlag,
 		},
 		Description: `
     	autonity genAutonityKeys <outkeyfile>
 		Generate node key and its consensus key to the given file. Write out the
 		node address, node public key of enode URL and the consensus key of registering
-		a validator on stdout using	flag --writeaddress`,
+		a validator on stdout using	flag --writeaddress. The keys are encrypted with a
+		password when using flag --encrypt, the password is then prompted for or read
+		from the file given by --autonitykeys.password`,
 		ArgsUsage: "<outkeyfile>",
 		Category:  "MISCELLANEOUS COMMANDS",
 	}
+
+	encryptAutonityKeysCommand = cli.Command{
+		Action: utils.MigrateFlags(encryptAutonityKeys),
+		Name:   "encryptAutonityKeys",
+		Usage:  "Encrypt a plain text autonity keys file in place",
+		Flags: []cli.Flag{
+			utils.AutonityKeysPasswordFlag,
+			utils.LightKDFFlag,
+		},
+		Description: `
+    	autonity encryptAutonityKeys <keyfile>
+		Convert an autonity keys file stored in plain text, as generated by
+		genAutonityKeys without --encrypt, into the password encrypted format.
+		The file is replaced in place. The password is prompted for or read from
+		the file given by --autonitykeys.password`,
+		ArgsUsage: "<keyfile>",
+		Category:  "MISCELLANEOUS COMMANDS",
+	}
 )
 
 // makecache generates an ethash verification cache into the provided folder.
@@ -206,7 +235,7 @@ func genOwnershipProof(ctx *cli.Context) error {
 		// parse and load node key and consensus key.
 		if s.Size() >= crypto.AutonityKeysLenInChar {
 			// load key from the node key file
-			nodePrivateKey, consensusKey, err = crypto.LoadAutonityKeys(nodeKeyFile)
+			nodePrivateKey, consensusKey, err = utils.LoadAutonityKeys(ctx, nodeKeyFile)
 			if err != nil {
 				utils.Fatalf("Failed to load the node private key: %v", err)
 			}
@@ -237,7 +266,18 @@ func genOwnershipProof(ctx *cli.Context) error {
 	}
 
 	treasury := args[0]
-	signatures, err := crypto.AutonityPOPProof(nodePrivateKey, oraclePrivateKey, treasury, consensusKey)
+	var signatures []byte
+	if endpoint := ctx.GlobalString(utils.ConsensusSignerFlag.Name); endpoint != "" {
+		// the consensus key is held by the external signer, the one of the key file is not used.
+		signer, err := external.NewConsensusSigner(endpoint)
+		if err != nil {
+			utils.Fatalf("Failed to connect to the consensus signer: %v", err)
+		}
+		defer signer.Close()
+		signatures, err = crypto.AutonityPOPProofWithSigner(nodePrivateKey, oraclePrivateKey, treasury, signer.SignOwnershipProof)
+	} else {
+		signatures, err = crypto.AutonityPOPProof(nodePrivateKey, oraclePrivateKey, treasury, consensusKey)
+	}
 	if err != nil {
 		if err == hexutil.ErrMissingPrefix {
 			utils.Fatalf("Failed to decode: hex string without 0x prefix")
@@ -261,7 +301,14 @@ func genAutonityKeys(ctx *cli.Context) error {
 		utils.Fatalf("could not generate node key %v", err)
 	}
 
-	if err = crypto.SaveAutonityKeys(outKeyFile, nodeKey, consensusKey); err != nil {
+	if ctx.Bool(utils.EncryptAutonityKeysFlag.Name) {
+		scryptN, scryptP := autonityKeysScryptParams(ctx)
+		password := utils.AutonityKeysPassword(ctx, "Your new autonity keys are locked with a password. Please give a password. Do not forget this password.", true)
+		err = keystore.StoreAutonityKeys(outKeyFile, nodeKey, consensusKey, password, scryptN, scryptP)
+	} else {
+		err = crypto.SaveAutonityKeys(outKeyFile, nodeKey, consensusKey)
+	}
+	if err != nil {
 		utils.Fatalf("could not save key %v", err)
 	}
 
@@ -273,3 +320,34 @@ func genAutonityKeys(ctx *cli.Context) error {
 	}
 	return nil
 }
+
+// encryptAutonityKeys converts a plain text autonity keys file into the password encrypted format.
+func encryptAutonityKeys(ctx *cli.Context) error {
+	keyFile := ctx.Args().First()
+	if len(keyFile) == 0 {
+		utils.Fatalf("Key file must be provided!! Usage: autonity encryptAutonityKeys <keyfile> [options]")
+	}
+	encrypted, err := keystore.IsEncryptedAutonityKeys(keyFile)
+	if err != nil {
+		utils.Fatalf("could not read key file %v", err)
+	}
+	if encrypted {
+		utils.Fatalf("Key file %s is already encrypted", keyFile)
+	}
+
+	scryptN, scryptP := autonityKeysScryptParams(ctx)
+	password := utils.AutonityKeysPassword(ctx, "Your autonity keys will be locked with a password. Please give a password. Do not forget this password.", true)
+	if err := keystore.EncryptAutonityKeysFile(keyFile, password, scryptN, scryptP); err != nil {
+		utils.Fatalf("could not encrypt key file %v", err)
+	}
+	fmt.Printf("Autonity keys file %s is now encrypted\n", keyFile)
+	return nil
+}
+
+// autonityKeysScryptParams returns the scrypt parameters used to encrypt autonity keys files.
+func autonityKeysScryptParams(ctx *cli.Context) (int, int) {
+	if ctx.GlobalBool(utils.LightKDFFlag.Name) {
+		return keystore.LightScryptN, keystore.LightScryptP
+	}
+	return keystore.StandardScryptN, keystore.StandardScryptP
+}
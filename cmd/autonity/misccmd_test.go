@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"github.com/autonity/autonity/accounts/keystore"
 	"github.com/autonity/autonity/common/hexutil"
 	"github.com/autonity/autonity/crypto"
 	"github.com/stretchr/testify/require"
@@ -209,3 +210,40 @@ func genNodeKeyWithExpect(t *testing.T, fileName string, writeAddr bool) {
 
 	defer geth.ExpectExit()
 }
+
+func TestEncryptedAutonityKeys(t *testing.T) {
+	dir := tmpdir(t)
+	passwordFile := filepath.Join(dir, "password")
+	require.NoError(t, ioutil.WriteFile(passwordFile, []byte("foo\n"), 0600))
+
+	t.Run("generate encrypted keys", func(t *testing.T) {
+		keyfile := filepath.Join(dir, "encrypted")
+		geth := runAutonity(t, "genAutonityKeys", keyfile, "--encrypt", "--autonitykeys.password", passwordFile, "--lightkdf")
+		geth.ExpectExit()
+
+		_, _, err := crypto.LoadAutonityKeys(keyfile)
+		require.Error(t, err)
+		_, _, err = keystore.LoadAutonityKeys(keyfile, "foo")
+		require.NoError(t, err)
+	})
+
+	t.Run("encrypt plain text keys in place", func(t *testing.T) {
+		keyfile := filepath.Join(dir, "plain")
+		nodeKey, consensusKey, err := crypto.GenAutonityKeys()
+		require.NoError(t, err)
+		require.NoError(t, crypto.SaveAutonityKeys(keyfile, nodeKey, consensusKey))
+
+		geth := runAutonity(t, "encryptAutonityKeys", keyfile, "--autonitykeys.password", passwordFile, "--lightkdf")
+		geth.Expect("Autonity keys file " + keyfile + " is now encrypted\n")
+		geth.ExpectExit()
+
+		loadedNodeKey, loadedConsensusKey, err := keystore.LoadAutonityKeys(keyfile, "foo")
+		require.NoError(t, err)
+		require.Equal(t, crypto.FromECDSA(nodeKey), crypto.FromECDSA(loadedNodeKey))
+		require.Equal(t, consensusKey.Marshal(), loadedConsensusKey.Marshal())
+
+		geth = runAutonity(t, "encryptAutonityKeys", keyfile, "--autonitykeys.password", passwordFile)
+		geth.ExpectRegexp("Fatal: Key file .* is already encrypted")
+		geth.ExpectExit()
+	})
+}
@@ -44,6 +44,9 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 			utils.SmartCardDaemonPathFlag,
 			utils.NetworkIdFlag,
 			utils.SyncModeFlag,
+			utils.EpochSyncFlag,
+			utils.EpochCheckpointFlag,
+			utils.EpochCheckpointCommitteeFlag,
 			utils.ExitWhenSyncedFlag,
 			utils.GCModeFlag,
 			utils.PiccadillyFlag,
@@ -91,6 +94,8 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 			utils.UnlockedAccountFlag,
 			utils.PasswordFileFlag,
 			utils.ExternalSignerFlag,
+			utils.ConsensusSignerFlag,
+			utils.ConsensusSignerAllowKeyMismatchFlag,
 			utils.InsecureUnlockAllowedFlag,
 		},
 	},
@@ -138,10 +143,16 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 			utils.NetrestrictFlag,
 			utils.AutonityKeysFileFlag,
 			utils.AutonityKeysHexFlag,
+			utils.AutonityKeysPasswordFlag,
 			utils.OracleKeyFileFlag,
 			utils.OracleKeyHexFlag,
 			utils.ConsensusListenPortFlag,
 			utils.ConsensusNATFlag,
+			utils.ConsensusPreconnectFlag,
+			utils.ConsensusSentriesFlag,
+			utils.ConsensusSentryOfFlag,
+			utils.ConsensusWALFlag,
+			utils.ConsensusWALHeightsFlag,
 			utils.NoGossip,
 		},
 	},
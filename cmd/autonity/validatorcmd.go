@@ -0,0 +1,562 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/olekukonko/tablewriter"
+	"gopkg.in/urfave/cli.v1"
+
+	ethereum "github.com/autonity/autonity"
+	"github.com/autonity/autonity/accounts"
+	"github.com/autonity/autonity/accounts/abi"
+	"github.com/autonity/autonity/accounts/abi/bind"
+	"github.com/autonity/autonity/accounts/external"
+	"github.com/autonity/autonity/accounts/keystore"
+	autonityBindings "github.com/autonity/autonity/autonity"
+	"github.com/autonity/autonity/cmd/utils"
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/common/hexutil"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/ethclient"
+	"github.com/autonity/autonity/ethclient/autclient"
+	"github.com/autonity/autonity/node"
+	"github.com/autonity/autonity/params"
+	"github.com/autonity/autonity/rpc"
+)
+
+const (
+	// newtonDecimals is the number of decimals of the Newton and Liquid Newton amounts.
+	newtonDecimals = 18
+	// commissionDecimals is the number of decimals of a commission rate given in percent, the contract
+	// expressing the rates in basis points.
+	commissionDecimals = 2
+)
+
+var (
+	validatorEndpointFlag = cli.StringFlag{
+		Name:  "endpoint",
+		Usage: "IPC or HTTP/WS endpoint of the node to talk to (default = IPC endpoint of the data directory)",
+	}
+	validatorFromFlag = cli.StringFlag{
+		Name:  "from",
+		Usage: "Address of the account sending the transaction, the treasury for the validator operations",
+	}
+	validatorDryRunFlag = cli.BoolFlag{
+		Name:  "dry-run",
+		Usage: "Estimate the gas of the transaction without signing nor sending it",
+	}
+	validatorJSONFlag = cli.BoolFlag{
+		Name:  "json",
+		Usage: "Print the validators in JSON",
+	}
+
+	validatorTxFlags = []cli.Flag{
+		validatorEndpointFlag,
+		validatorFromFlag,
+		validatorDryRunFlag,
+		utils.DataDirFlag,
+		utils.KeyStoreDirFlag,
+		utils.PasswordFileFlag,
+		utils.ExternalSignerFlag,
+		utils.PiccadillyFlag,
+		utils.BakerlooFlag,
+	}
+	validatorCallFlags = []cli.Flag{
+		validatorEndpointFlag,
+		validatorJSONFlag,
+		utils.DataDirFlag,
+		utils.PiccadillyFlag,
+		utils.BakerlooFlag,
+	}
+
+	validatorCommand = cli.Command{
+		Name:     "validator",
+		Usage:    "A set of commands to operate a validator through the Autonity contract",
+		Category: "MISCELLANEOUS COMMANDS",
+		Description: `
+The validator commands send the transactions of the validator life cycle to the
+Autonity contract, through the node given by --endpoint. The transactions are
+sent from the --from account, signed either by the keystore of the data
+directory (or --keystore), unlocked with --password if given, or by the external
+signer given by --signer.
+
+The gas of each transaction is estimated first and printed. With --dry-run the
+command stops there, nothing being signed nor sent. When the contract rejects
+the transaction, either at estimation or once mined, the revert reason is
+printed.
+
+Newton amounts are given in NTN, with up to 18 decimals. Commission rates are
+given in percent, with up to 2 decimals.`,
+		Subcommands: []cli.Command{
+			{
+				Action:    utils.MigrateFlags(registerValidator),
+				Name:      "register",
+				Usage:     "Register a validator",
+				ArgsUsage: "<enode> <oracle address> <consensus key> <ownership proof>",
+				Flags:     validatorTxFlags,
+				Description: `
+    autonity validator register <enode> <oracle address> <consensus key> <ownership proof>
+
+Registers the validator of the given enode, the --from account becoming its
+treasury. The ownership proof is the one printed by "autonity genOwnershipProof"
+for the treasury.`,
+			},
+			{
+				Action:    utils.MigrateFlags(bondValidator),
+				Name:      "bond",
+				Usage:     "Bond Newton to a validator",
+				ArgsUsage: "<validator> <amount>",
+				Flags:     validatorTxFlags,
+			},
+			{
+				Action:    utils.MigrateFlags(unbondValidator),
+				Name:      "unbond",
+				Usage:     "Unbond Liquid Newton, or Newton if self-bonded, from a validator",
+				ArgsUsage: "<validator> <amount>",
+				Flags:     validatorTxFlags,
+			},
+			{
+				Action:    utils.MigrateFlags(pauseValidator),
+				Name:      "pause",
+				Usage:     "Pause a validator, removing it from the next committees",
+				ArgsUsage: "<validator>",
+				Flags:     validatorTxFlags,
+			},
+			{
+				Action:    utils.MigrateFlags(activateValidator),
+				Name:      "activate",
+				Usage:     "Activate a paused validator",
+				ArgsUsage: "<validator>",
+				Flags:     validatorTxFlags,
+			},
+			{
+				Action:    utils.MigrateFlags(updateValidatorEnode),
+				Name:      "update-enode",
+				Usage:     "Update the enode of a validator",
+				ArgsUsage: "<validator> <enode>",
+				Flags:     validatorTxFlags,
+			},
+			{
+				Action:    utils.MigrateFlags(setValidatorCommission),
+				Name:      "set-commission",
+				Usage:     "Change the commission rate of a validator",
+				ArgsUsage: "<validator> <rate in percent>",
+				Flags:     validatorTxFlags,
+			},
+			{
+				Action:    utils.MigrateFlags(validatorInfo),
+				Name:      "info",
+				Usage:     "Print a validator",
+				ArgsUsage: "<validator>",
+				Flags:     validatorCallFlags,
+			},
+			{
+				Action: utils.MigrateFlags(listValidators),
+				Name:   "list",
+				Usage:  "Print the registered validators",
+				Flags:  validatorCallFlags,
+			},
+		},
+	}
+)
+
+// validatorState names the states of autonity.AutonityValidator.
+var validatorState = []string{"active", "paused", "jailed", "jailbound"}
+
+// validatorJSON is a validator of the Autonity contract, in JSON.
+type validatorJSON struct {
+	NodeAddress         common.Address `json:"nodeAddress"`
+	Treasury            common.Address `json:"treasury"`
+	OracleAddress       common.Address `json:"oracleAddress"`
+	Enode               string         `json:"enode"`
+	State               string         `json:"state"`
+	CommissionRate      *big.Int       `json:"commissionRate"`
+	BondedStake         *big.Int       `json:"bondedStake"`
+	SelfBondedStake     *big.Int       `json:"selfBondedStake"`
+	UnbondingStake      *big.Int       `json:"unbondingStake"`
+	LiquidStateContract common.Address `json:"liquidStateContract"`
+	LiquidSupply        *big.Int       `json:"liquidSupply"`
+	RegistrationBlock   *big.Int       `json:"registrationBlock"`
+	JailReleaseBlock    *big.Int       `json:"jailReleaseBlock"`
+	TotalSlashed        *big.Int       `json:"totalSlashed"`
+	ProvableFaultCount  *big.Int       `json:"provableFaultCount"`
+	ConsensusKey        hexutil.Bytes  `json:"consensusKey"`
+}
+
+func newValidatorJSON(val *autonityBindings.AutonityValidator) *validatorJSON {
+	state := fmt.Sprintf("unknown (%d)", val.State)
+	if int(val.State) < len(validatorState) {
+		state = validatorState[val.State]
+	}
+	return &validatorJSON{
+		NodeAddress:         val.NodeAddress,
+		Treasury:            val.Treasury,
+		OracleAddress:       val.OracleAddress,
+		Enode:               val.Enode,
+		State:               state,
+		CommissionRate:      val.CommissionRate,
+		BondedStake:         val.BondedStake,
+		SelfBondedStake:     val.SelfBondedStake,
+		UnbondingStake:      val.UnbondingStake,
+		LiquidStateContract: val.LiquidStateContract,
+		LiquidSupply:        val.LiquidSupply,
+		RegistrationBlock:   val.RegistrationBlock,
+		JailReleaseBlock:    val.JailReleaseBlock,
+		TotalSlashed:        val.TotalSlashed,
+		ProvableFaultCount:  val.ProvableFaultCount,
+		ConsensusKey:        val.ConsensusKey,
+	}
+}
+
+func registerValidator(ctx *cli.Context) error {
+	if ctx.NArg() != 4 {
+		return errors.New("expected the enode, the oracle address, the consensus key and the ownership proof")
+	}
+	oracle, err := parseAddress(ctx.Args().Get(1))
+	if err != nil {
+		return err
+	}
+	consensusKey, err := hexutil.Decode(ctx.Args().Get(2))
+	if err != nil {
+		return fmt.Errorf("invalid consensus key: %w", err)
+	}
+	proof, err := hexutil.Decode(ctx.Args().Get(3))
+	if err != nil {
+		return fmt.Errorf("invalid ownership proof: %w", err)
+	}
+	return sendValidatorTx(ctx, "registerValidator", ctx.Args().Get(0), oracle, consensusKey, proof)
+}
+
+func bondValidator(ctx *cli.Context) error {
+	validator, amount, err := validatorAmountArgs(ctx)
+	if err != nil {
+		return err
+	}
+	return sendValidatorTx(ctx, "bond", validator, amount)
+}
+
+func unbondValidator(ctx *cli.Context) error {
+	validator, amount, err := validatorAmountArgs(ctx)
+	if err != nil {
+		return err
+	}
+	return sendValidatorTx(ctx, "unbond", validator, amount)
+}
+
+func pauseValidator(ctx *cli.Context) error {
+	validator, err := validatorArg(ctx, 1)
+	if err != nil {
+		return err
+	}
+	return sendValidatorTx(ctx, "pauseValidator", validator)
+}
+
+func activateValidator(ctx *cli.Context) error {
+	validator, err := validatorArg(ctx, 1)
+	if err != nil {
+		return err
+	}
+	return sendValidatorTx(ctx, "activateValidator", validator)
+}
+
+func updateValidatorEnode(ctx *cli.Context) error {
+	validator, err := validatorArg(ctx, 2)
+	if err != nil {
+		return err
+	}
+	return sendValidatorTx(ctx, "updateEnode", validator, ctx.Args().Get(1))
+}
+
+func setValidatorCommission(ctx *cli.Context) error {
+	validator, err := validatorArg(ctx, 2)
+	if err != nil {
+		return err
+	}
+	rate, err := parseUnits(ctx.Args().Get(1), commissionDecimals)
+	if err != nil {
+		return fmt.Errorf("invalid commission rate: %w", err)
+	}
+	return sendValidatorTx(ctx, "changeCommissionRate", validator, rate)
+}
+
+func validatorInfo(ctx *cli.Context) error {
+	address, err := validatorArg(ctx, 1)
+	if err != nil {
+		return err
+	}
+	client, err := dialValidatorEndpoint(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	val, err := autclient.New(client).Validator(context.Background(), address, nil)
+	if err != nil {
+		return revertReason(err)
+	}
+	if ctx.Bool(validatorJSONFlag.Name) {
+		return printJSON(newValidatorJSON(val))
+	}
+	printValidators([]*autonityBindings.AutonityValidator{val})
+	return nil
+}
+
+func listValidators(ctx *cli.Context) error {
+	client, err := dialValidatorEndpoint(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	autClient := autclient.New(client)
+	addresses, err := autClient.Validators(context.Background(), nil)
+	if err != nil {
+		return err
+	}
+	validators := make([]*autonityBindings.AutonityValidator, 0, len(addresses))
+	for _, address := range addresses {
+		val, err := autClient.Validator(context.Background(), address, nil)
+		if err != nil {
+			return err
+		}
+		validators = append(validators, val)
+	}
+	if ctx.Bool(validatorJSONFlag.Name) {
+		out := make([]*validatorJSON, 0, len(validators))
+		for _, val := range validators {
+			out = append(out, newValidatorJSON(val))
+		}
+		return printJSON(out)
+	}
+	printValidators(validators)
+	return nil
+}
+
+func printJSON(v interface{}) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+func printValidators(validators []*autonityBindings.AutonityValidator) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Validator", "Treasury", "State", "Bonded (NTN)", "Self-bonded (NTN)", "Commission (%)"})
+	for _, val := range validators {
+		v := newValidatorJSON(val)
+		table.Append([]string{
+			v.NodeAddress.Hex(),
+			v.Treasury.Hex(),
+			v.State,
+			formatUnits(v.BondedStake, newtonDecimals),
+			formatUnits(v.SelfBondedStake, newtonDecimals),
+			formatUnits(v.CommissionRate, commissionDecimals),
+		})
+	}
+	table.Render()
+}
+
+// sendValidatorTx estimates, signs and sends a call of the given method of the Autonity contract, then waits for
+// it to be mined.
+func sendValidatorTx(ctx *cli.Context, method string, args ...interface{}) error {
+	if !ctx.IsSet(validatorFromFlag.Name) {
+		return fmt.Errorf("--%s is required", validatorFromFlag.Name)
+	}
+	from, err := parseAddress(ctx.String(validatorFromFlag.Name))
+	if err != nil {
+		return err
+	}
+	contractABI, err := autonityBindings.AutonityMetaData.GetAbi()
+	if err != nil {
+		return err
+	}
+	data, err := contractABI.Pack(method, args...)
+	if err != nil {
+		return err
+	}
+	rpcClient, err := dialValidatorEndpoint(ctx)
+	if err != nil {
+		return err
+	}
+	defer rpcClient.Close()
+	client := ethclient.NewClient(rpcClient)
+
+	background := context.Background()
+	msg := ethereum.CallMsg{From: from, To: &params.AutonityContractAddress, Data: data}
+	gas, err := client.EstimateGas(background, msg)
+	if err != nil {
+		return revertReason(err)
+	}
+	tip, err := client.SuggestGasTipCap(background)
+	if err != nil {
+		return err
+	}
+	head, err := client.HeaderByNumber(background, nil)
+	if err != nil {
+		return err
+	}
+	feeCap := new(big.Int).Add(tip, new(big.Int).Mul(head.BaseFee, big.NewInt(2)))
+	maxFee := new(big.Int).Mul(feeCap, new(big.Int).SetUint64(gas))
+	fmt.Printf("Estimated gas: %d (max fee %s ATN)\n", gas, formatUnits(maxFee, 18))
+	if ctx.Bool(validatorDryRunFlag.Name) {
+		return nil
+	}
+
+	chainID, err := client.ChainID(background)
+	if err != nil {
+		return err
+	}
+	nonce, err := client.PendingNonceAt(background, from)
+	if err != nil {
+		return err
+	}
+	tx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     nonce,
+		GasTipCap: tip,
+		GasFeeCap: feeCap,
+		Gas:       gas,
+		To:        &params.AutonityContractAddress,
+		Data:      data,
+	})
+	signed, err := signValidatorTx(ctx, from, tx, chainID)
+	if err != nil {
+		return err
+	}
+	if err := client.SendTransaction(background, signed); err != nil {
+		return revertReason(err)
+	}
+	fmt.Printf("Transaction sent: %s\n", signed.Hash().Hex())
+	receipt, err := bind.WaitMined(background, client, signed)
+	if err != nil {
+		return err
+	}
+	if receipt.Status == types.ReceiptStatusFailed {
+		// replay the call on the state the transaction was executed on to retrieve the revert reason
+		parent := new(big.Int).Sub(receipt.BlockNumber, common.Big1)
+		if _, err := client.CallContract(background, msg, parent); err != nil {
+			return fmt.Errorf("transaction failed in block %d: %w", receipt.BlockNumber, revertReason(err))
+		}
+		return fmt.Errorf("transaction failed in block %d", receipt.BlockNumber)
+	}
+	fmt.Printf("Transaction mined in block %d, gas used %d\n", receipt.BlockNumber, receipt.GasUsed)
+	return nil
+}
+
+// signValidatorTx signs the transaction with the external signer if one is configured, with the keystore
+// otherwise.
+func signValidatorTx(ctx *cli.Context, from common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	account := accounts.Account{Address: from}
+	if endpoint := ctx.GlobalString(utils.ExternalSignerFlag.Name); endpoint != "" {
+		signer, err := external.NewExternalSigner(endpoint)
+		if err != nil {
+			return nil, err
+		}
+		return signer.SignTx(account, tx, chainID)
+	}
+	config := node.Config{
+		DataDir:     utils.MakeDataDir(ctx),
+		KeyStoreDir: ctx.GlobalString(utils.KeyStoreDirFlag.Name),
+	}
+	keydir, err := config.KeyDirConfig()
+	if err != nil {
+		return nil, err
+	}
+	ks := keystore.NewKeyStore(keydir, keystore.StandardScryptN, keystore.StandardScryptP)
+	account, _ = unlockAccount(ks, from.Hex(), 0, utils.MakePasswordList(ctx))
+	return ks.SignTx(account, tx, chainID)
+}
+
+// dialValidatorEndpoint connects to the node given by --endpoint, defaulting to the IPC endpoint of the data
+// directory.
+func dialValidatorEndpoint(ctx *cli.Context) (*rpc.Client, error) {
+	endpoint := ctx.String(validatorEndpointFlag.Name)
+	if endpoint == "" {
+		endpoint = defaultIPCEndpoint(ctx)
+	}
+	client, err := dialRPC(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to %s: %w", endpoint, err)
+	}
+	return client, nil
+}
+
+// revertReason decodes the reason of a call reverted by the contract, as carried by the error data.
+func revertReason(err error) error {
+	var dataErr rpc.DataError
+	if !errors.As(err, &dataErr) {
+		return err
+	}
+	data, ok := dataErr.ErrorData().(string)
+	if !ok {
+		return err
+	}
+	raw, decodeErr := hexutil.Decode(data)
+	if decodeErr != nil {
+		return err
+	}
+	reason, unpackErr := abi.UnpackRevert(raw)
+	if unpackErr != nil {
+		return err
+	}
+	return fmt.Errorf("reverted by the Autonity contract: %s", reason)
+}
+
+func validatorArg(ctx *cli.Context, nargs int) (common.Address, error) {
+	if ctx.NArg() != nargs {
+		return common.Address{}, fmt.Errorf("expected %d arguments, got %d", nargs, ctx.NArg())
+	}
+	return parseAddress(ctx.Args().First())
+}
+
+func validatorAmountArgs(ctx *cli.Context) (common.Address, *big.Int, error) {
+	validator, err := validatorArg(ctx, 2)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	amount, err := parseUnits(ctx.Args().Get(1), newtonDecimals)
+	if err != nil {
+		return common.Address{}, nil, fmt.Errorf("invalid amount: %w", err)
+	}
+	return validator, amount, nil
+}
+
+func parseAddress(s string) (common.Address, error) {
+	if !common.IsHexAddress(s) {
+		return common.Address{}, fmt.Errorf("invalid address %q", s)
+	}
+	return common.HexToAddress(s), nil
+}
+
+// parseUnits parses a non-negative decimal amount into its integer representation with the given number of
+// decimals, "1.5" being 15 with one decimal.
+func parseUnits(s string, decimals int) (*big.Int, error) {
+	whole, fraction, _ := strings.Cut(s, ".")
+	if len(fraction) > decimals {
+		return nil, fmt.Errorf("%q has more than %d decimals", s, decimals)
+	}
+	value, ok := new(big.Int).SetString(whole+fraction+strings.Repeat("0", decimals-len(fraction)), 10)
+	if !ok || whole+fraction == "" || strings.ContainsAny(whole+fraction, "+-") {
+		return nil, fmt.Errorf("%q is not a positive decimal number", s)
+	}
+	return value, nil
+}
+
+// formatUnits is the inverse of parseUnits, trailing zero decimals being trimmed.
+func formatUnits(value *big.Int, decimals int) string {
+	if value == nil {
+		return ""
+	}
+	digits := value.String()
+	if len(digits) <= decimals {
+		digits = strings.Repeat("0", decimals-len(digits)+1) + digits
+	}
+	whole, fraction := digits[:len(digits)-decimals], strings.TrimRight(digits[len(digits)-decimals:], "0")
+	if fraction == "" {
+		return whole
+	}
+	return whole + "." + fraction
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/autonity/autonity/accounts/keystore"
+	"github.com/autonity/autonity/crypto"
+	e2e "github.com/autonity/autonity/e2e_test"
+	"github.com/autonity/autonity/ethclient/autclient"
+)
+
+func TestParseUnits(t *testing.T) {
+	tests := []struct {
+		input    string
+		decimals int
+		value    int64
+		valid    bool
+	}{
+		{"1", 2, 100, true},
+		{"12.5", 2, 1250, true},
+		{".05", 2, 5, true},
+		{"0", 18, 0, true},
+		{"1.234", 2, 0, false},
+		{"-1", 2, 0, false},
+		{"+1", 2, 0, false},
+		{"", 2, 0, false},
+		{"1e3", 2, 0, false},
+	}
+	for _, test := range tests {
+		value, err := parseUnits(test.input, test.decimals)
+		if !test.valid {
+			require.Error(t, err, test.input)
+			continue
+		}
+		require.NoError(t, err, test.input)
+		require.Equal(t, test.value, value.Int64(), test.input)
+		require.Equal(t, value.Int64(), mustParseUnits(t, formatUnits(value, test.decimals), test.decimals).Int64())
+	}
+	require.Equal(t, "1.5", formatUnits(big.NewInt(15), 1))
+	require.Equal(t, "0.05", formatUnits(big.NewInt(5), 2))
+}
+
+func mustParseUnits(t *testing.T, s string, decimals int) *big.Int {
+	value, err := parseUnits(s, decimals)
+	require.NoError(t, err)
+	return value
+}
+
+func TestValidatorCommands(t *testing.T) {
+	vals, err := e2e.Validators(t, 4, "10e18,v,10000,127.0.0.1:%s,%s,%s,%s")
+	require.NoError(t, err)
+	network, err := e2e.NewInMemoryNetwork(t, vals, true)
+	require.NoError(t, err)
+	defer network.Shutdown(t)
+	require.NoError(t, network.WaitToMineNBlocks(2, 60, false))
+
+	endpoint := network[0].WSEndpoint()
+	validator := network[1].Address.Hex()
+	treasury := vals[1].TreasuryKey
+
+	// the treasury of the second validator signs with a keystore
+	dir := tmpdir(t)
+	key := &keystore.Key{Id: uuid.New(), Address: crypto.PubkeyToAddress(treasury.PublicKey), PrivateKey: treasury}
+	keyJSON, err := keystore.EncryptKey(key, "secret", keystore.LightScryptN, keystore.LightScryptP)
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "keystore"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "keystore", "treasury.json"), keyJSON, 0600))
+	passwordFile := filepath.Join(dir, "password")
+	require.NoError(t, os.WriteFile(passwordFile, []byte("secret"), 0600))
+	txFlags := []string{"--endpoint", endpoint, "--datadir", dir, "--password", passwordFile, "--from", key.Address.Hex()}
+
+	t.Run("list", func(t *testing.T) {
+		autonity := runAutonity(t, "validator", "list", "--json", "--endpoint", endpoint)
+		output := autonity.Output()
+		autonity.WaitExit()
+		require.Equal(t, 0, autonity.ExitStatus(), autonity.StderrText())
+		var listed []*validatorJSON
+		require.NoError(t, json.Unmarshal(output, &listed))
+		require.Len(t, listed, 4)
+		require.Equal(t, "active", listed[1].State)
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		args := append([]string{"validator", "set-commission", "--dry-run"}, txFlags...)
+		autonity := runAutonity(t, append(args, validator, "12.5")...)
+		autonity.ExpectRegexp(`Estimated gas: \d+ \(max fee [0-9.]+ ATN\)\n`)
+		autonity.ExpectExit()
+	})
+
+	t.Run("revert reason", func(t *testing.T) {
+		args := append([]string{"validator", "pause"}, txFlags...)
+		autonity := runAutonity(t, append(args, network[0].Address.Hex())...)
+		autonity.WaitExit()
+		require.NotEqual(t, 0, autonity.ExitStatus())
+		require.Contains(t, autonity.StderrText(), "reverted by the Autonity contract: require caller to be validator admin account")
+	})
+
+	t.Run("pause", func(t *testing.T) {
+		args := append([]string{"validator", "pause"}, txFlags...)
+		autonity := runAutonity(t, append(args, validator)...)
+		autonity.WaitExit()
+		require.Equal(t, 0, autonity.ExitStatus(), autonity.StderrText())
+
+		rpcClient, err := network[0].Attach()
+		require.NoError(t, err)
+		client := autclient.New(rpcClient)
+		defer client.Close()
+		paused, err := client.Validator(context.Background(), network[1].Address, nil)
+		require.NoError(t, err)
+		require.Equal(t, uint8(1), paused.State)
+	})
+}
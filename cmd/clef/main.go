@@ -41,8 +41,10 @@ import (
 	"github.com/autonity/autonity/cmd/utils"
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/common/hexutil"
+	"github.com/autonity/autonity/core/rawdb"
 	"github.com/autonity/autonity/core/types"
 	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/crypto/blst"
 	"github.com/autonity/autonity/internal/ethapi"
 	"github.com/autonity/autonity/log"
 	"github.com/autonity/autonity/node"
@@ -52,6 +54,7 @@ import (
 	"github.com/autonity/autonity/signer/core/apitypes"
 	"github.com/autonity/autonity/signer/fourbyte"
 	"github.com/autonity/autonity/signer/rules"
+	"github.com/autonity/autonity/signer/slashing"
 	"github.com/autonity/autonity/signer/storage"
 	"github.com/mattn/go-colorable"
 	"github.com/mattn/go-isatty"
@@ -134,6 +137,14 @@ var (
 		Name:  "stdio-ui-test",
 		Usage: "Mechanism to test interface between Clef and UI. Requires 'stdio-ui'.",
 	}
+	autonityKeysFlag = cli.StringFlag{
+		Name:  "autonitykeys",
+		Usage: "Autonity keys file holding the consensus key used to sign consensus messages",
+	}
+	slashingDBFlag = cli.StringFlag{
+		Name:  "slashingdb",
+		Usage: "Directory of the slashing protection database for consensus messages (default = inside the configdir)",
+	}
 	app         = cli.NewApp()
 	initCommand = cli.Command{
 		Action:    utils.MigrateFlags(initializeSecrets),
@@ -242,6 +253,8 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 			testFlag,
 			advancedMode,
 			acceptFlag,
+			autonityKeysFlag,
+			slashingDBFlag,
 		},
 	},
 }
@@ -271,6 +284,8 @@ func init() {
 		testFlag,
 		advancedMode,
 		acceptFlag,
+		autonityKeysFlag,
+		slashingDBFlag,
 	}
 	app.Action = signer
 	app.Commands = []cli.Command{initCommand,
@@ -627,6 +642,26 @@ func signer(c *cli.Context) error {
 	am := core.StartClefAccountManager(ksLoc, nousb, lightKdf, scpath)
 	apiImpl := core.NewSignerAPI(am, chainId, nousb, ui, db, advanced, pwStorage)
 
+	// Consensus signing
+	if keyfile := c.GlobalString(autonityKeysFlag.Name); keyfile != "" {
+		consensusKey, err := loadConsensusKey(keyfile, ui)
+		if err != nil {
+			utils.Fatalf("Failed to load consensus key: %v", err)
+		}
+		slashingDBPath := c.GlobalString(slashingDBFlag.Name)
+		if slashingDBPath == "" {
+			slashingDBPath = filepath.Join(configDir, "slashingprotection")
+		}
+		slashingDB, err := rawdb.NewLevelDBDatabase(slashingDBPath, 16, 16, "", false)
+		if err != nil {
+			utils.Fatalf("Failed to open slashing protection database: %v", err)
+		}
+		protector := slashing.New(slashingDB)
+		defer protector.Close()
+		apiImpl.EnableConsensusSigning(consensusKey, protector)
+		log.Info("Consensus signing enabled", "key", consensusKey.PublicKey().Hex(), "slashingdb", slashingDBPath)
+	}
+
 	// Establish the bidirectional communication, by creating a new UI backend and registering
 	// it with the UI.
 	ui.RegisterUIServer(core.NewUIServerAPI(apiImpl))
@@ -716,6 +751,30 @@ func signer(c *cli.Context) error {
 	return nil
 }
 
+// loadConsensusKey loads the consensus key from an autonity keys file, asking the password through the UI if the
+// file is encrypted.
+func loadConsensusKey(keyfile string, ui core.UIClientAPI) (blst.SecretKey, error) {
+	encrypted, err := keystore.IsEncryptedAutonityKeys(keyfile)
+	if err != nil {
+		return nil, err
+	}
+	if !encrypted {
+		log.Warn("Autonity keys file is not encrypted", "file", keyfile)
+		_, consensusKey, err := crypto.LoadAutonityKeys(keyfile)
+		return consensusKey, err
+	}
+	resp, err := ui.OnInputRequired(core.UserInputRequest{
+		Title:      "Autonity keys",
+		Prompt:     fmt.Sprintf("Please enter the password to unlock the autonity keys file %s", keyfile),
+		IsPassword: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	_, consensusKey, err := keystore.LoadAutonityKeys(keyfile, resp.Text)
+	return consensusKey, err
+}
+
 // DefaultConfigDir is the default config directory to use for the vaults and other
 // persistence requirements.
 func DefaultConfigDir() string {
@@ -0,0 +1,94 @@
+package devnet
+
+import (
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/common/hexutil"
+	"github.com/autonity/autonity/crypto"
+)
+
+// API is the control API of a devnet, served under the devnet namespace.
+type API struct {
+	network *Network
+}
+
+// NodeInfo describes a node of the devnet.
+type NodeInfo struct {
+	Index   int            `json:"index"`
+	Address common.Address `json:"address"`
+	Running bool           `json:"running"`
+	// HTTP is the HTTP-RPC endpoint of the node, the WS-RPC endpoint being on the same port.
+	HTTP   string         `json:"http"`
+	Height hexutil.Uint64 `json:"height"`
+	// Group is the partition group of the node, zero for the nodes left out of the groups and
+	// when the network is whole.
+	Group int `json:"group"`
+}
+
+// AccountInfo describes a pre-funded account of the devnet.
+type AccountInfo struct {
+	Address    common.Address `json:"address"`
+	PrivateKey hexutil.Bytes  `json:"privateKey"`
+}
+
+// Nodes returns the state of the nodes.
+func (api *API) Nodes() []NodeInfo {
+	infos := make([]NodeInfo, len(api.network.nodes))
+	for i, n := range api.network.nodes {
+		infos[i] = NodeInfo{
+			Index:   n.Index,
+			Address: n.Address,
+			Running: n.Running(),
+			HTTP:    n.HTTPEndpoint(),
+			Height:  hexutil.Uint64(n.Height()),
+			Group:   api.network.group(n),
+		}
+	}
+	return infos
+}
+
+// Accounts returns the pre-funded accounts along with their private keys.
+func (api *API) Accounts() []AccountInfo {
+	infos := make([]AccountInfo, len(api.network.accounts))
+	for i, account := range api.network.accounts {
+		infos[i] = AccountInfo{Address: account.Address, PrivateKey: crypto.FromECDSA(account.Key)}
+	}
+	return infos
+}
+
+// Pause stops the node of the given index, which keeps its data until resumed.
+func (api *API) Pause(index int) error {
+	n, err := api.network.Node(index)
+	if err != nil {
+		return err
+	}
+	return n.Stop()
+}
+
+// Resume starts again the node of the given index.
+func (api *API) Resume(index int) error {
+	n, err := api.network.Node(index)
+	if err != nil {
+		return err
+	}
+	return n.Start()
+}
+
+// Restart stops then starts the node of the given index.
+func (api *API) Restart(index int) error {
+	n, err := api.network.Node(index)
+	if err != nil {
+		return err
+	}
+	return n.Restart()
+}
+
+// Partition splits the network into the given groups of node indices, the nodes left out
+// forming a last group together, e.g. [[3]] isolates the node 3 from the others.
+func (api *API) Partition(groups [][]int) error {
+	return api.network.Partition(groups)
+}
+
+// Heal removes the partition of the network.
+func (api *API) Heal() {
+	api.network.Heal()
+}
@@ -0,0 +1,320 @@
+// Package devnet runs a network of Autonity validators in a single process. The
+// nodes are connected through in-memory pipes rather than the network, which
+// lets the network be partitioned and the validators be paused and restarted
+// individually from the control API.
+package devnet
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/autonity/autonity/cmd/gengen/gengen"
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/core"
+	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/log"
+	"github.com/autonity/autonity/p2p/enode"
+	"github.com/autonity/autonity/params"
+	"github.com/autonity/autonity/rpc"
+)
+
+var (
+	// accountBalance is the amount of Auton and of Newton each pre-funded account holds at genesis.
+	accountBalance = new(big.Int).Mul(big.NewInt(1_000_000), big.NewInt(params.Ether))
+	// validatorBalance is the amount of Auton given at genesis to the node and treasury accounts of each validator.
+	validatorBalance = new(big.Int).Mul(big.NewInt(1_000_000), big.NewInt(params.Ether))
+)
+
+const (
+	// validatorStake is the amount of Newton self-bonded by each validator at genesis.
+	validatorStake = params.Ether
+)
+
+var (
+	errNoValidators   = errors.New("at least one validator is required")
+	errUnknownNode    = errors.New("unknown node")
+	errNodeStopped    = errors.New("node is stopped")
+	errPartitioned    = errors.New("node is in another partition")
+	errAlreadyStarted = errors.New("devnet already started")
+)
+
+// Config holds the parameters of a devnet.
+type Config struct {
+	// Validators is the number of validators of the network, each one running its own node.
+	Validators int
+	// Accounts is the number of pre-funded accounts. Their keys are derived from their index,
+	// so that they are identical from one run to another.
+	Accounts int
+	// DataDir is the directory holding the data directory of each node. When empty the nodes
+	// keep their data in memory, and a restarted node syncs its chain again from the others.
+	DataDir string
+	// HTTPHost is the interface the HTTP-RPC and WS-RPC servers of the nodes and the control
+	// server listen on.
+	HTTPHost string
+	// HTTPPort is the HTTP-RPC and WS-RPC port of the first node, the n-th node listening on
+	// HTTPPort+n. When zero each node listens on a random port.
+	HTTPPort int
+	// ControlPort is the port of the control HTTP-RPC server, zero meaning a random port.
+	ControlPort int
+}
+
+// Account is a pre-funded account of the devnet.
+type Account struct {
+	Address common.Address
+	Key     *ecdsa.PrivateKey
+}
+
+// Network is a devnet, a set of validator nodes connected through in-memory pipes.
+type Network struct {
+	config   Config
+	genesis  *core.Genesis
+	nodes    []*Node
+	byID     map[enode.ID]*Node
+	accounts []Account
+
+	partitionLock sync.RWMutex
+	partition     map[int]int // node index -> partition group, nil when the network is whole
+
+	control         *http.Server
+	controlEndpoint string
+}
+
+// DevAccount returns the index-th pre-funded account of a devnet.
+func DevAccount(index int) Account {
+	key, err := crypto.ToECDSA(crypto.Keccak256([]byte("autonity devnet account " + strconv.Itoa(index))))
+	if err != nil {
+		// the hash of a fixed input is a valid key, barring an astronomically unlikely collision
+		panic(err)
+	}
+	return Account{Address: crypto.PubkeyToAddress(key.PublicKey), Key: key}
+}
+
+// New creates the genesis and the nodes of a devnet, which are started by Start. The first
+// pre-funded account, if any, is the operator of the protocol contracts.
+func New(config Config) (*Network, error) {
+	if config.Validators < 1 {
+		return nil, errNoValidators
+	}
+	validators := make([]*gengen.Validator, config.Validators)
+	for i := range validators {
+		nodeKey, consensusKey, err := crypto.GenAutonityKeys()
+		if err != nil {
+			return nil, err
+		}
+		oracleKey, err := crypto.GenerateKey()
+		if err != nil {
+			return nil, err
+		}
+		treasuryKey, err := crypto.GenerateKey()
+		if err != nil {
+			return nil, err
+		}
+		// the nodes never listen, the ports only make the enodes of the genesis valid
+		validators[i] = &gengen.Validator{
+			InitialEth:      validatorBalance,
+			Stake:           validatorStake,
+			SelfBondedStake: validatorStake,
+			NodeIP:          net.IPv4(127, 0, 0, 1),
+			NodePort:        30303 + i,
+			AcnIP:           net.IPv4(127, 0, 0, 1),
+			AcnPort:         20203 + i,
+			NodeKey:         nodeKey,
+			ConsensusKey:    consensusKey,
+			OracleKey:       oracleKey,
+			TreasuryKey:     treasuryKey,
+		}
+	}
+	accounts := make([]Account, config.Accounts)
+	for i := range accounts {
+		accounts[i] = DevAccount(i)
+	}
+	genesis, err := gengen.NewGenesis(validators, fundAccounts(accounts))
+	if err != nil {
+		return nil, err
+	}
+	if err := genesis.Config.AutonityContractConfig.Prepare(); err != nil {
+		return nil, err
+	}
+
+	network := &Network{
+		config:   config,
+		genesis:  genesis,
+		nodes:    make([]*Node, len(validators)),
+		byID:     make(map[enode.ID]*Node, len(validators)),
+		accounts: accounts,
+	}
+	for i, validator := range validators {
+		var dataDir string
+		if config.DataDir != "" {
+			dataDir = filepath.Join(config.DataDir, "node"+strconv.Itoa(i))
+		}
+		httpPort := 0
+		if config.HTTPPort != 0 {
+			httpPort = config.HTTPPort + i
+		}
+		n := newNode(network, i, validator, genesis, dataDir, config.HTTPHost, httpPort)
+		network.nodes[i] = n
+		network.byID[n.ID] = n
+	}
+	return network, nil
+}
+
+// fundAccounts returns the genesis option pre-funding the accounts with Auton and Newton,
+// and making the first one the operator.
+func fundAccounts(accounts []Account) gengen.GenesisOption {
+	return func(genesis *core.Genesis) {
+		for _, account := range accounts {
+			genesis.Alloc[account.Address] = core.GenesisAccount{
+				Balance:       new(big.Int).Set(accountBalance),
+				NewtonBalance: new(big.Int).Set(accountBalance),
+			}
+		}
+		if len(accounts) > 0 {
+			genesis.Config.AutonityContractConfig.Operator = accounts[0].Address
+		}
+	}
+}
+
+// Start starts all the nodes, then the control server.
+func (nw *Network) Start() error {
+	if nw.control != nil {
+		return errAlreadyStarted
+	}
+	var (
+		wg   sync.WaitGroup
+		errs = make([]error, len(nw.nodes))
+	)
+	for i, n := range nw.nodes {
+		wg.Add(1)
+		go func(i int, n *Node) {
+			defer wg.Done()
+			errs[i] = n.Start()
+		}(i, n)
+	}
+	wg.Wait()
+	if err := errors.Join(errs...); err != nil {
+		nw.stopNodes()
+		return err
+	}
+
+	server := rpc.NewServer()
+	if err := server.RegisterName("devnet", &API{network: nw}); err != nil {
+		nw.stopNodes()
+		return err
+	}
+	listener, err := net.Listen("tcp", net.JoinHostPort(nw.config.HTTPHost, strconv.Itoa(nw.config.ControlPort)))
+	if err != nil {
+		nw.stopNodes()
+		return fmt.Errorf("cannot start the control server: %w", err)
+	}
+	nw.control = &http.Server{Handler: server, ReadHeaderTimeout: 5 * time.Second}
+	nw.controlEndpoint = "http://" + listener.Addr().String()
+	go nw.control.Serve(listener)
+	log.Info("Devnet control server started", "endpoint", nw.controlEndpoint)
+	return nil
+}
+
+// Stop stops the control server and all the nodes.
+func (nw *Network) Stop() error {
+	if nw.control != nil {
+		nw.control.Close()
+	}
+	return nw.stopNodes()
+}
+
+func (nw *Network) stopNodes() error {
+	var errs []error
+	for _, n := range nw.nodes {
+		errs = append(errs, n.Stop())
+	}
+	return errors.Join(errs...)
+}
+
+// Genesis returns the genesis the devnet was launched with.
+func (nw *Network) Genesis() *core.Genesis {
+	return nw.genesis
+}
+
+// Nodes returns the nodes of the devnet, in the order of the validators of the genesis.
+func (nw *Network) Nodes() []*Node {
+	return nw.nodes
+}
+
+// Accounts returns the pre-funded accounts.
+func (nw *Network) Accounts() []Account {
+	return nw.accounts
+}
+
+// ControlEndpoint returns the HTTP-RPC endpoint of the control server, once started.
+func (nw *Network) ControlEndpoint() string {
+	return nw.controlEndpoint
+}
+
+// Node returns the node of the given index.
+func (nw *Network) Node(index int) (*Node, error) {
+	if index < 0 || index >= len(nw.nodes) {
+		return nil, fmt.Errorf("no node %d, the devnet has %d nodes", index, len(nw.nodes))
+	}
+	return nw.nodes[index], nil
+}
+
+// Partition splits the network into the given groups of node indices, the nodes left out
+// forming a last group together. The connections across groups are dropped and refused
+// until Heal is called.
+func (nw *Network) Partition(groups [][]int) error {
+	partition := make(map[int]int, len(nw.nodes))
+	for g, group := range groups {
+		for _, index := range group {
+			if _, err := nw.Node(index); err != nil {
+				return err
+			}
+			if _, ok := partition[index]; ok {
+				return fmt.Errorf("node %d is in several groups", index)
+			}
+			// group 0 is the one of the nodes left out
+			partition[index] = g + 1
+		}
+	}
+	nw.partitionLock.Lock()
+	nw.partition = partition
+	nw.partitionLock.Unlock()
+
+	for _, n := range nw.nodes {
+		n.dropPeers(func(peer *Node) bool {
+			return !nw.connected(n, peer)
+		})
+	}
+	log.Info("Devnet partitioned", "groups", groups)
+	return nil
+}
+
+// Heal removes the partition of the network. The nodes reconnect the next time their p2p
+// servers dial each other.
+func (nw *Network) Heal() {
+	nw.partitionLock.Lock()
+	nw.partition = nil
+	nw.partitionLock.Unlock()
+	log.Info("Devnet partition healed")
+}
+
+// connected tells whether the partition lets the two nodes connect.
+func (nw *Network) connected(a, b *Node) bool {
+	nw.partitionLock.RLock()
+	defer nw.partitionLock.RUnlock()
+	return nw.partition == nil || nw.partition[a.Index] == nw.partition[b.Index]
+}
+
+// group returns the partition group of the node, zero when the network is whole.
+func (nw *Network) group(n *Node) int {
+	nw.partitionLock.RLock()
+	defer nw.partitionLock.RUnlock()
+	return nw.partition[n.Index]
+}
@@ -0,0 +1,74 @@
+package devnet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/autonity/autonity/ethclient"
+	"github.com/autonity/autonity/rpc"
+)
+
+func waitForHeight(t *testing.T, n *Node, height uint64) {
+	require.Eventually(t, func() bool { return n.Height() >= height }, time.Minute, 100*time.Millisecond, "node %d stuck at %d", n.Index, n.Height())
+}
+
+func TestDevnet(t *testing.T) {
+	network, err := New(Config{Validators: 4, Accounts: 2, DataDir: t.TempDir(), HTTPHost: "127.0.0.1"})
+	require.NoError(t, err)
+	require.NoError(t, network.Start())
+	t.Cleanup(func() { require.NoError(t, network.Stop()) })
+
+	control, err := rpc.Dial(network.ControlEndpoint())
+	require.NoError(t, err)
+	defer control.Close()
+
+	var accounts []AccountInfo
+	require.NoError(t, control.Call(&accounts, "devnet_accounts"))
+	require.Len(t, accounts, 2)
+	require.Equal(t, DevAccount(1).Address, accounts[1].Address)
+	require.Equal(t, accounts[0].Address, network.Genesis().Config.AutonityContractConfig.Operator)
+
+	var nodes []NodeInfo
+	require.NoError(t, control.Call(&nodes, "devnet_nodes"))
+	require.Len(t, nodes, 4)
+	client, err := ethclient.Dial(nodes[3].HTTP)
+	require.NoError(t, err)
+	defer client.Close()
+	balance, err := client.BalanceAt(context.Background(), accounts[0].Address, nil)
+	require.NoError(t, err)
+	require.Equal(t, accountBalance, balance)
+	for _, n := range network.Nodes() {
+		waitForHeight(t, n, 3)
+	}
+
+	// the isolated node stalls while the others keep the quorum
+	require.NoError(t, control.Call(nil, "devnet_partition", [][]int{{3}}))
+	isolated := network.Nodes()[3]
+	stalled := isolated.Height()
+	waitForHeight(t, network.Nodes()[0], stalled+3)
+	require.LessOrEqual(t, isolated.Height(), stalled+1)
+	require.NoError(t, control.Call(&nodes, "devnet_nodes"))
+	require.Equal(t, []int{0, 0, 0, 1}, []int{nodes[0].Group, nodes[1].Group, nodes[2].Group, nodes[3].Group})
+
+	require.NoError(t, control.Call(nil, "devnet_heal"))
+	waitForHeight(t, isolated, network.Nodes()[0].Height())
+
+	// a paused node picks up its chain when resumed
+	require.NoError(t, control.Call(nil, "devnet_pause", 2))
+	require.NoError(t, control.Call(&nodes, "devnet_nodes"))
+	require.False(t, nodes[2].Running)
+	require.Empty(t, nodes[2].HTTP)
+	height := uint64(nodes[0].Height)
+	require.NoError(t, control.Call(nil, "devnet_resume", 2))
+	require.GreaterOrEqual(t, network.Nodes()[2].Height(), height)
+	waitForHeight(t, network.Nodes()[2], height+3)
+
+	require.NoError(t, control.Call(nil, "devnet_restart", 1))
+	waitForHeight(t, network.Nodes()[1], height+5)
+
+	require.Error(t, control.Call(nil, "devnet_pause", 4))
+	require.Error(t, control.Call(nil, "devnet_partition", [][]int{{1}, {1, 2}}))
+}
@@ -0,0 +1,214 @@
+package devnet
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/autonity/autonity/cmd/gengen/gengen"
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/consensus/acn"
+	"github.com/autonity/autonity/core"
+	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/eth"
+	"github.com/autonity/autonity/eth/downloader"
+	"github.com/autonity/autonity/eth/ethconfig"
+	"github.com/autonity/autonity/log"
+	"github.com/autonity/autonity/node"
+	"github.com/autonity/autonity/p2p"
+	"github.com/autonity/autonity/p2p/enode"
+	"github.com/autonity/autonity/params"
+)
+
+// rpcModules are the APIs served over HTTP-RPC and WS-RPC by the nodes.
+var rpcModules = []string{"eth", "net", "web3", "aut", "tendermint", "txpool"}
+
+// Node is a validator node of a devnet, which can be stopped and started again.
+type Node struct {
+	Index   int
+	ID      enode.ID
+	Address common.Address
+
+	network    *Network
+	nodeConfig *node.Config
+	ethConfig  *ethconfig.Config
+
+	lock  sync.RWMutex
+	stack *node.Node
+	eth   *eth.Ethereum
+}
+
+func newNode(network *Network, index int, validator *gengen.Validator, genesis *core.Genesis, dataDir, httpHost string, httpPort int) *Node {
+	n := &Node{
+		Index:   index,
+		ID:      enode.PubkeyToIDV4(&validator.NodeKey.PublicKey),
+		Address: crypto.PubkeyToAddress(validator.NodeKey.PublicKey),
+		network: network,
+	}
+	// The servers neither listen nor discover, the committee members dialing each other
+	// through the pipe dialers.
+	n.nodeConfig = &node.Config{
+		Name:         "autonity",
+		Version:      params.Version,
+		DataDir:      dataDir,
+		ConsensusKey: validator.ConsensusKey,
+		ExecutionP2P: p2p.Config{
+			PrivateKey:  validator.NodeKey,
+			MaxPeers:    50,
+			NoDiscovery: true,
+			Dialer:      &p2p.PipeDialer{Server: n.remoteServer(p2p.Execution)},
+		},
+		ConsensusP2P: p2p.Config{
+			PrivateKey:  validator.NodeKey,
+			MaxPeers:    100000,
+			NoDiscovery: true,
+			Dialer:      &p2p.PipeDialer{Server: n.remoteServer(p2p.Consensus)},
+		},
+		CommitteePreconnect: node.DefaultCommitteePreconnect,
+		HTTPHost:            httpHost,
+		HTTPPort:            httpPort,
+		HTTPModules:         rpcModules,
+		HTTPCors:            []string{"*"},
+		HTTPVirtualHosts:    []string{"*"},
+		WSHost:              httpHost,
+		WSPort:              httpPort,
+		WSModules:           rpcModules,
+		WSOrigins:           []string{"*"},
+		Logger:              log.New("node", index),
+	}
+
+	ethConfig := ethconfig.Defaults
+	ethConfig.SyncMode = downloader.FullSync
+	ethConfig.NetworkID = genesis.Config.ChainID.Uint64()
+	ethConfig.Genesis = genesis
+	ethConfig.Miner.Recommit = time.Second
+	// Without the min base fee as gas price the miner would drop the transactions paying it.
+	ethConfig.Miner.GasPrice = new(big.Int).SetUint64(genesis.Config.AutonityContractConfig.MinBaseFee)
+	ethConfig.Miner.Etherbase = n.Address
+	n.ethConfig = &ethConfig
+	return n
+}
+
+// Start starts the node, unless already running. The node picks up the chain where its
+// data directory left it.
+func (n *Node) Start() error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	if n.stack != nil {
+		return nil
+	}
+	stack, err := node.New(n.nodeConfig)
+	if err != nil {
+		return fmt.Errorf("node %d: %w", n.Index, err)
+	}
+	backend, err := eth.New(stack, n.ethConfig)
+	if err != nil {
+		stack.Close()
+		return fmt.Errorf("node %d: cannot create the eth service: %w", n.Index, err)
+	}
+	acn.New(stack, backend, n.ethConfig.NetworkID)
+	if err := stack.Start(); err != nil {
+		stack.Close()
+		return fmt.Errorf("node %d: %w", n.Index, err)
+	}
+	n.stack, n.eth = stack, backend
+	log.Info("Devnet node started", "index", n.Index, "address", n.Address, "http", stack.HTTPEndpoint())
+	return nil
+}
+
+// Stop stops the node, unless already stopped. Its data directory is kept for the next start.
+func (n *Node) Stop() error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	if n.stack == nil {
+		return nil
+	}
+	err := n.stack.Close()
+	n.stack, n.eth = nil, nil
+	log.Info("Devnet node stopped", "index", n.Index, "address", n.Address)
+	return err
+}
+
+// Restart stops then starts the node.
+func (n *Node) Restart() error {
+	if err := n.Stop(); err != nil {
+		return err
+	}
+	return n.Start()
+}
+
+// Running tells whether the node is started.
+func (n *Node) Running() bool {
+	n.lock.RLock()
+	defer n.lock.RUnlock()
+	return n.stack != nil
+}
+
+// HTTPEndpoint returns the HTTP-RPC endpoint of the node, empty when stopped. The WS-RPC
+// endpoint is served on the same port.
+func (n *Node) HTTPEndpoint() string {
+	n.lock.RLock()
+	defer n.lock.RUnlock()
+	if n.stack == nil {
+		return ""
+	}
+	return n.stack.HTTPEndpoint()
+}
+
+// Height returns the number of the head block of the node, zero when stopped.
+func (n *Node) Height() uint64 {
+	n.lock.RLock()
+	defer n.lock.RUnlock()
+	if n.eth == nil {
+		return 0
+	}
+	return n.eth.BlockChain().CurrentHeader().Number.Uint64()
+}
+
+// server returns the p2p server of the given network, nil when the node is stopped.
+func (n *Node) server(network p2p.Network) *p2p.Server {
+	n.lock.RLock()
+	defer n.lock.RUnlock()
+	if n.stack == nil {
+		return nil
+	}
+	if network == p2p.Consensus {
+		return n.stack.ConsensusServer()
+	}
+	return n.stack.ExecutionServer()
+}
+
+// dropPeers disconnects the peers of the node matching drop, on both networks.
+func (n *Node) dropPeers(drop func(peer *Node) bool) {
+	for _, network := range []p2p.Network{p2p.Execution, p2p.Consensus} {
+		server := n.server(network)
+		if server == nil {
+			continue
+		}
+		for _, peer := range server.Peers() {
+			if remote, ok := n.network.byID[peer.ID()]; ok && drop(remote) {
+				peer.Disconnect(p2p.DiscRequested)
+			}
+		}
+	}
+}
+
+// remoteServer returns the function resolving the servers of the network net the node
+// dials through in-memory pipes, unless the remote nodes are stopped or partitioned away.
+func (n *Node) remoteServer(net p2p.Network) func(*enode.Node) (*p2p.Server, error) {
+	return func(dest *enode.Node) (*p2p.Server, error) {
+		remote, ok := n.network.byID[dest.ID()]
+		if !ok {
+			return nil, errUnknownNode
+		}
+		if !n.network.connected(n, remote) {
+			return nil, errPartitioned
+		}
+		server := remote.server(net)
+		if server == nil {
+			return nil, errNodeStopped
+		}
+		return server, nil
+	}
+}
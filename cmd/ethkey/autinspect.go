@@ -1,14 +1,17 @@
 package main
 
 import (
+	"crypto/ecdsa"
 	"encoding/hex"
 	"fmt"
 
 	"gopkg.in/urfave/cli.v1"
 
+	"github.com/autonity/autonity/accounts/keystore"
 	"github.com/autonity/autonity/cmd/utils"
 	"github.com/autonity/autonity/common/hexutil"
 	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/crypto/blst"
 )
 
 type outputAutInspect struct {
@@ -29,6 +32,7 @@ Print various information about the autonity keyfile.
 Private key information can be printed by using the --private flag;
 make sure to use this feature with great caution!`,
 	Flags: []cli.Flag{
+		passphraseFlag,
 		jsonFlag,
 		cli.BoolFlag{
 			Name:  "private",
@@ -40,7 +44,20 @@ make sure to use this feature with great caution!`,
 			fmt.Println("Incorrect number of arguments, 1 expected.")
 			cli.ShowCommandHelpAndExit(ctx, "autinspect", 1)
 		}
-		nodeKey, consensusKey, err := crypto.LoadAutonityKeys(ctx.Args().Get(0))
+		keyfile := ctx.Args().Get(0)
+		encrypted, err := keystore.IsEncryptedAutonityKeys(keyfile)
+		if err != nil {
+			utils.Fatalf("error opening key file %v", err)
+		}
+		var (
+			nodeKey      *ecdsa.PrivateKey
+			consensusKey blst.SecretKey
+		)
+		if encrypted {
+			nodeKey, consensusKey, err = keystore.LoadAutonityKeys(keyfile, getPassphrase(ctx, false))
+		} else {
+			nodeKey, consensusKey, err = crypto.LoadAutonityKeys(keyfile)
+		}
 		if err != nil {
 			utils.Fatalf("error opening key file %v", err)
 		}
@@ -212,6 +212,18 @@ var (
 		Usage: `Blockchain sync mode ("snap", "full" or "light")`,
 		Value: &defaultSyncMode,
 	}
+	EpochSyncFlag = cli.BoolFlag{
+		Name:  "epochsync",
+		Usage: "Retrieve the headers epoch by epoch, verifying the chain of epoch headers before filling the epochs concurrently (light clients skip the headers of the past epochs)",
+	}
+	EpochCheckpointFlag = cli.StringFlag{
+		Name:  "checkpoint",
+		Usage: "Snap sync from a finalized epoch header instead of genesis: the header hash, or a JSON file holding the header and the committee which signed it",
+	}
+	EpochCheckpointCommitteeFlag = cli.StringFlag{
+		Name:  "checkpoint.committee",
+		Usage: "JSON file holding the committee trusted to have signed the checkpoint header (required with a checkpoint hash)",
+	}
 	GCModeFlag = cli.StringFlag{
 		Name:  "gcmode",
 		Usage: `Blockchain garbage collection mode ("full", "archive")`,
@@ -460,6 +472,15 @@ var (
 		Usage: "External signer (url or path to ipc file)",
 		Value: "",
 	}
+	ConsensusSignerFlag = cli.StringFlag{
+		Name:  "consensus.signer",
+		Usage: "External signer holding the consensus key (url or path to ipc file)",
+		Value: "",
+	}
+	ConsensusSignerAllowKeyMismatchFlag = cli.BoolFlag{
+		Name:  "consensus.signer.allowkeymismatch",
+		Usage: "Allow the external consensus signer to hold another key than the local consensus key",
+	}
 	VMEnableDebugFlag = cli.BoolFlag{
 		Name:  "vmdebug",
 		Usage: "Record information useful for VM and contract debugging",
@@ -610,6 +631,30 @@ var (
 		Usage: "NAT port mapping mechanism for consensus channel (any|none|upnp|pmp|extip:<IP>)",
 		Value: "any",
 	}
+	ConsensusPreconnectFlag = cli.Uint64Flag{
+		Name:  "consensus.preconnect",
+		Usage: "Number of blocks before the end of an epoch from which to connect to the next committee (0 = disabled)",
+		Value: node.DefaultConfig.CommitteePreconnect,
+	}
+	ConsensusSentriesFlag = cli.StringFlag{
+		Name:  "consensus.sentries",
+		Usage: "Comma separated enode URLs of the sentry nodes the validator joins the consensus network through, on their private endpoint. The validator does not accept inbound consensus connections",
+		Value: "",
+	}
+	ConsensusSentryOfFlag = cli.StringFlag{
+		Name:  "consensus.sentryof",
+		Usage: "Enode URL or ID of the validator this sentry node joins the consensus network on behalf of",
+		Value: "",
+	}
+	ConsensusWALFlag = cli.BoolFlag{
+		Name:  "consensus.wal",
+		Usage: "Record the events delivered to the consensus core to a write-ahead log, to replay them with `autonity consensus replay`",
+	}
+	ConsensusWALHeightsFlag = cli.Uint64Flag{
+		Name:  "consensus.wal.heights",
+		Usage: "Number of latest heights whose consensus write-ahead log is retained",
+		Value: node.DefaultConfig.ConsensusWALHeights,
+	}
 	// Network Settings
 	MaxPeersFlag = cli.IntFlag{
 		Name:  "maxpeers",
@@ -644,6 +689,14 @@ var (
 		Name:  "autonitykeyshex",
 		Usage: "Autonity keys as hex (for testing)",
 	}
+	AutonityKeysPasswordFlag = cli.StringFlag{
+		Name:  "autonitykeys.password",
+		Usage: "Password file to use for unlocking an encrypted autonity keys file",
+	}
+	EncryptAutonityKeysFlag = cli.BoolFlag{
+		Name:  "encrypt",
+		Usage: "Encrypt the autonity keys file with a password",
+	}
 	OracleKeyFileFlag = cli.StringFlag{
 		Name:  "oraclekey",
 		Usage: "oracle account key file",
@@ -816,7 +869,7 @@ func setAutonityKeys(ctx *cli.Context, cfg *node.Config) {
 	case file != "" && hex != "":
 		Fatalf("Options %q and %q are mutually exclusive", AutonityKeysFileFlag.Name, AutonityKeysHexFlag.Name)
 	case file != "":
-		if key, consensusKey, err = crypto.LoadAutonityKeys(file); err != nil {
+		if key, consensusKey, err = LoadAutonityKeys(ctx, file); err != nil {
 			Fatalf("Option %q: %v", AutonityKeysFileFlag.Name, err)
 		}
 		cfg.ConsensusKey = consensusKey
@@ -829,7 +882,37 @@ func setAutonityKeys(ctx *cli.Context, cfg *node.Config) {
 		cfg.ConsensusKey = consensusKey
 		cfg.ExecutionP2P.PrivateKey = key
 		cfg.ConsensusP2P.PrivateKey = key
+	case ctx.GlobalIsSet(AutonityKeysPasswordFlag.Name):
+		// the keys will be loaded from the data directory, they might be encrypted.
+		cfg.AutonityKeysPassword = AutonityKeysPassword(ctx, "", false)
+	}
+}
+
+// AutonityKeysPassword retrieves the password protecting the autonity keys file, either read from the file given by
+// the --autonitykeys.password flag or requested interactively from the user.
+func AutonityKeysPassword(ctx *cli.Context, text string, confirmation bool) string {
+	if path := ctx.GlobalString(AutonityKeysPasswordFlag.Name); path != "" {
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			Fatalf("Failed to read autonity keys password file: %v", err)
+		}
+		return strings.TrimRight(strings.SplitN(string(content), "\n", 2)[0], "\r")
+	}
+	return GetPassPhrase(text, confirmation)
+}
+
+// LoadAutonityKeys loads the node key and the consensus key from an autonity keys file. If the file is encrypted,
+// the password is retrieved with AutonityKeysPassword.
+func LoadAutonityKeys(ctx *cli.Context, file string) (*ecdsa.PrivateKey, blst.SecretKey, error) {
+	encrypted, err := keystore.IsEncryptedAutonityKeys(file)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !encrypted {
+		return crypto.LoadAutonityKeys(file)
 	}
+	password := AutonityKeysPassword(ctx, fmt.Sprintf("Unlocking autonity keys file %s", file), false)
+	return keystore.LoadAutonityKeys(file, password)
 }
 
 // setNodeUserIdent creates the user identifier from CLI flags.
@@ -867,6 +950,31 @@ func setBootstrapNodes(ctx *cli.Context, cfg *p2p.Config) {
 	}
 }
 
+// setSentries applies the sentry node architecture flags: a validator declares its sentry nodes, a sentry node the
+// validator it protects.
+func setSentries(ctx *cli.Context, cfg *node.Config) {
+	if ctx.GlobalIsSet(ConsensusSentriesFlag.Name) && ctx.GlobalIsSet(ConsensusSentryOfFlag.Name) {
+		Fatalf("Options %q and %q are mutually exclusive", ConsensusSentriesFlag.Name, ConsensusSentryOfFlag.Name)
+	}
+	if ctx.GlobalIsSet(ConsensusSentriesFlag.Name) {
+		cfg.ConsensusSentries = nil
+		for _, url := range SplitAndTrim(ctx.GlobalString(ConsensusSentriesFlag.Name)) {
+			sentry, err := enode.Parse(enode.ValidSchemes, url)
+			if err != nil {
+				Fatalf("Option %q: %v", ConsensusSentriesFlag.Name, err)
+			}
+			cfg.ConsensusSentries = append(cfg.ConsensusSentries, sentry)
+		}
+	}
+	if ctx.GlobalIsSet(ConsensusSentryOfFlag.Name) {
+		validator, err := enode.Parse(enode.ValidSchemes, ctx.GlobalString(ConsensusSentryOfFlag.Name))
+		if err != nil {
+			Fatalf("Option %q: %v", ConsensusSentryOfFlag.Name, err)
+		}
+		cfg.ConsensusSentryOf = validator
+	}
+}
+
 // setBootstrapNodesV5 creates a list of bootstrap nodes from the command line
 // flags, reverting to pre-configured ones if none have been specified.
 func setBootstrapNodesV5(ctx *cli.Context, cfg *p2p.Config) {
@@ -1120,9 +1228,6 @@ func setP2PConfig(ctx *cli.Context, cfg *p2p.Config) {
 	//setBootstrapNodesV5(ctx, cfg)
 
 	lightClient := ctx.GlobalString(SyncModeFlag.Name) == "light"
-	if lightClient {
-		Fatalf("light client currently unsupported")
-	}
 	lightServer := (ctx.GlobalInt(LightServeFlag.Name) != 0)
 
 	lightPeers := ctx.GlobalInt(LightMaxPeersFlag.Name)
@@ -1237,9 +1342,25 @@ func SetNodeConfig(ctx *cli.Context, cfg *node.Config) {
 	if ctx.GlobalIsSet(NoGossip.Name) {
 		cfg.NoGossip = ctx.GlobalBool(NoGossip.Name)
 	}
+	if ctx.GlobalIsSet(ConsensusPreconnectFlag.Name) {
+		cfg.CommitteePreconnect = ctx.GlobalUint64(ConsensusPreconnectFlag.Name)
+	}
+	setSentries(ctx, cfg)
+	if ctx.GlobalIsSet(ConsensusWALFlag.Name) {
+		cfg.ConsensusWAL = ctx.GlobalBool(ConsensusWALFlag.Name)
+	}
+	if ctx.GlobalIsSet(ConsensusWALHeightsFlag.Name) {
+		cfg.ConsensusWALHeights = ctx.GlobalUint64(ConsensusWALHeightsFlag.Name)
+	}
 	if ctx.GlobalIsSet(ExternalSignerFlag.Name) {
 		cfg.ExternalSigner = ctx.GlobalString(ExternalSignerFlag.Name)
 	}
+	if ctx.GlobalIsSet(ConsensusSignerFlag.Name) {
+		cfg.ConsensusSigner = ctx.GlobalString(ConsensusSignerFlag.Name)
+	}
+	if ctx.GlobalIsSet(ConsensusSignerAllowKeyMismatchFlag.Name) {
+		cfg.ConsensusSignerAllowKeyMismatch = ctx.GlobalBool(ConsensusSignerAllowKeyMismatchFlag.Name)
+	}
 	if ctx.GlobalIsSet(KeyStoreDirFlag.Name) {
 		cfg.KeyStoreDir = ctx.GlobalString(KeyStoreDirFlag.Name)
 	}
@@ -1492,6 +1613,16 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *ethconfig.Config) {
 	if ctx.GlobalIsSet(SyncModeFlag.Name) {
 		cfg.SyncMode = *GlobalTextMarshaler(ctx, SyncModeFlag.Name).(*downloader.SyncMode)
 	}
+	if ctx.GlobalIsSet(EpochSyncFlag.Name) {
+		cfg.EpochSync = ctx.GlobalBool(EpochSyncFlag.Name)
+	}
+	if ctx.GlobalIsSet(EpochCheckpointFlag.Name) {
+		checkpoint, err := core.LoadEpochCheckpoint(ctx.GlobalString(EpochCheckpointFlag.Name), ctx.GlobalString(EpochCheckpointCommitteeFlag.Name))
+		if err != nil {
+			Fatalf("Invalid epoch checkpoint: %v", err)
+		}
+		cfg.EpochCheckpoint = checkpoint
+	}
 	if ctx.GlobalIsSet(NetworkIdFlag.Name) {
 		cfg.NetworkID = ctx.GlobalUint64(NetworkIdFlag.Name)
 	}
@@ -17,8 +17,10 @@
 package acn
 
 import (
+	"bytes"
 	"context"
 	"math"
+	"slices"
 	"sync"
 
 	"github.com/autonity/autonity/consensus/acn/protocol"
@@ -33,6 +35,7 @@ import (
 	"github.com/autonity/autonity/node"
 	"github.com/autonity/autonity/p2p"
 	"github.com/autonity/autonity/p2p/enode"
+	"github.com/autonity/autonity/rpc"
 )
 
 type ACN struct {
@@ -44,11 +47,31 @@ type ACN struct {
 	server     *p2p.Server
 	log        log.Logger
 	address    common.Address
+	member     common.Address // committee member the local node connects as: itself, or the validator of a sentry
 	cancel     context.CancelFunc
+
+	// committees the local node connects to, the next one only close to the end of the epoch
+	current          *committeeEnodes
+	next             *committeeEnodes
+	nextEpochBlock   uint64
+	preconnectBlocks uint64           // blocks before the end of the epoch from which to connect to the next committee
+	dialed           []common.Address // members connected directly for the off-chain accountability messages
+	committeesLock   sync.RWMutex
+	validating       bool
+
+	// sentry node architecture: a validator joins the consensus network through its sentries, which relay the
+	// consensus messages between the validator and the committee.
+	sentries        []*enode.Node                       // sentries of the local validator
+	sentryAddresses []common.Address                    // addresses of the sentries of the local validator
+	sentryOf        *enode.Node                         // validator the local sentry acts on behalf of
+	relay           *relay                              // relays the messages of the validator, on a sentry
+	relays          map[common.Address][]common.Address // sentries of the committee members, by member
+	relaysLock      sync.RWMutex
 }
 
 func New(stack *node.Node, backend *eth.Ethereum, netID uint64) {
 	nodeKey, _ := stack.Config().AutonityKeys()
+	address := crypto.PubkeyToAddress(nodeKey.PublicKey)
 	acn := &ACN{
 		peers:      newPeerSet(),
 		chain:      backend.BlockChain(),
@@ -56,12 +79,30 @@ func New(stack *node.Node, backend *eth.Ethereum, netID uint64) {
 		forkFilter: forkid.NewFilter(backend.BlockChain()),
 		server:     stack.ConsensusServer(),
 		log:        log.New(),
-		address:    crypto.PubkeyToAddress(nodeKey.PublicKey),
+		address:    address,
+		member:     address,
+
+		preconnectBlocks: stack.Config().CommitteePreconnect,
+		sentries:         stack.Config().ConsensusSentries,
+		sentryOf:         stack.Config().ConsensusSentryOf,
+	}
+	for _, sentry := range acn.sentries {
+		acn.sentryAddresses = append(acn.sentryAddresses, crypto.PubkeyToAddress(*sentry.Pubkey()))
+	}
+	if acn.sentryOf != nil {
+		acn.member = crypto.PubkeyToAddress(*acn.sentryOf.Pubkey())
+		acn.relay = newRelay(acn, acn.member)
 	}
 
 	acn.server.MaxPeers = math.MaxInt
 	stack.RegisterConsensusProtocols(acn.Protocols())
 	stack.RegisterLifecycle(acn)
+	stack.RegisterAPIs([]rpc.API{{
+		Namespace: "tendermint",
+		Version:   "1.0",
+		Service:   &API{acn},
+		Public:    true,
+	}})
 	if handler, ok := acn.chain.Engine().(consensus.Handler); ok {
 		handler.SetBroadcaster(acn)
 	}
@@ -82,11 +123,78 @@ func (acn *ACN) Protocols() []p2p.Protocol {
 }
 
 func (acn *ACN) FindPeers(targets []common.Address) map[common.Address]consensus.Peer {
-	return acn.peers.find(targets)
+	m := make(map[common.Address]consensus.Peer)
+	for _, target := range targets {
+		if p, ok := acn.findPeer(target); ok {
+			m[target] = p
+		}
+	}
+	return m
 }
 
 func (acn *ACN) FindPeer(target common.Address) (consensus.Peer, bool) {
-	return acn.peers.peer(target)
+	p, ok := acn.findPeer(target)
+	if !ok {
+		return nil, false
+	}
+	if len(acn.sentryAddresses) > 0 && p.Address() != target {
+		return &sentryPeer{Peer: p, target: target}, true
+	}
+	return p, true
+}
+
+// findPeer retrieves the peer the messages to the target are sent to: the target itself if connected, otherwise
+// one of the sentries it joins the consensus network through. A validator behind sentries reaches everyone through
+// its sentries.
+func (acn *ACN) findPeer(target common.Address) (*protocol.Peer, bool) {
+	if p, ok := acn.peers.peer(target); ok {
+		return p, true
+	}
+	relays := acn.sentryAddresses
+	if len(relays) == 0 {
+		acn.relaysLock.RLock()
+		relays = acn.relays[target]
+		acn.relaysLock.RUnlock()
+	}
+	for _, relay := range relays {
+		if p, ok := acn.peers.peer(relay); ok {
+			return p, true
+		}
+	}
+	return nil, false
+}
+
+// memberOf returns the committee member the peer relays the messages of, the peer itself unless it is the sentry of
+// a single member.
+func (acn *ACN) memberOf(peer common.Address) common.Address {
+	acn.relaysLock.RLock()
+	defer acn.relaysLock.RUnlock()
+	member, found := peer, 0
+	for address, sentries := range acn.relays {
+		if slices.Contains(sentries, peer) {
+			member = address
+			found++
+		}
+	}
+	if found != 1 {
+		return peer
+	}
+	return member
+}
+
+// Origin implements protocol.Backend.Origin. The sentries of the local validator wrap the accountability messages
+// along with the member they come from, the ones of other members send them as is.
+func (acn *ACN) Origin(peer *protocol.Peer, msg p2p.Msg) (common.Address, p2p.Msg, error) {
+	if !slices.Contains(acn.sentryAddresses, peer.Address()) {
+		return acn.memberOf(peer.Address()), msg, nil
+	}
+	var relayed relayedMsg
+	if err := msg.Decode(&relayed); err != nil {
+		return common.Address{}, msg, err
+	}
+	msg.Size = uint32(len(relayed.Payload))
+	msg.Payload = bytes.NewReader(relayed.Payload)
+	return relayed.Peer, msg, nil
 }
 
 // runConsensusPeer registers a `consensus` peer into the consensus peerset and
@@ -102,12 +210,21 @@ func (acn *ACN) runConsensusPeer(peer *protocol.Peer, handler protocol.HandlerFu
 		return err
 	}
 
+	if len(acn.sentries) > 0 && peer.Inbound() {
+		peer.Log().Debug("Consensus peer rejected", "err", errInboundPeer)
+		return errInboundPeer
+	}
+
 	if err := acn.peers.register(peer); err != nil {
 		peer.Log().Error("peer registration failed", "err", err)
 		return err
 	}
 	defer acn.peers.unregister(peer)
 
+	// a sentry relays the consensus msgs instead of processing them
+	if acn.relay != nil {
+		return acn.relay.run(peer)
+	}
 	// read consensus msgs from wire and process them
 	return handler(peer)
 }
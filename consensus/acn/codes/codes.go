@@ -0,0 +1,14 @@
+// Package codes defines the codes of the messages exchanged on the consensus network. It is shared by the consensus
+// engine and the handlers of the network protocol, without dependencies on either.
+package codes
+
+const (
+	ProposeNetworkMsg        uint64 = 0x11
+	PrevoteNetworkMsg        uint64 = 0x12
+	PrecommitNetworkMsg      uint64 = 0x13
+	SyncNetworkMsg           uint64 = 0x14
+	AccountabilityNetworkMsg uint64 = 0x15
+	CompactProposeNetworkMsg uint64 = 0x16
+	GetProposalTxsNetworkMsg uint64 = 0x17
+	ProposalTxsNetworkMsg    uint64 = 0x18
+)
@@ -2,11 +2,37 @@ package acn
 
 import (
 	"context"
+	"slices"
 
+	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/core"
 	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/p2p/enode"
+	"github.com/autonity/autonity/p2p/topology"
 )
 
+// committeeEnodes is a committee along with the enodes of its members, and their sentries, in committee order.
+type committeeEnodes struct {
+	epochBlock uint64 // block from which the committee is active
+	committee  *types.Committee
+	enodes     []*enode.Node
+	sentries   [][]*enode.Node
+}
+
+func newCommitteeEnodes(epochBlock uint64, committee *types.Committee, nodes *types.Nodes) *committeeEnodes {
+	return &committeeEnodes{epochBlock: epochBlock, committee: committee, enodes: nodes.List, sentries: nodes.Sentries}
+}
+
+// memberSentries returns the sentries of the committee member at the given index, none if it connects directly.
+func (c *committeeEnodes) memberSentries(i int) []*enode.Node {
+	// the enodes are listed in committee order
+	if len(c.enodes) != c.committee.Len() || len(c.sentries) != len(c.enodes) {
+		return nil
+	}
+	return c.sentries[i]
+}
+
 func (acn *ACN) watchCommittee(ctx context.Context) {
 	acn.wg.Add(1)
 
@@ -16,22 +42,6 @@ func (acn *ACN) watchCommittee(ctx context.Context) {
 	epochHeadCh := make(chan core.EpochHeadEvent)
 	epochHeadSub := acn.chain.SubscribeEpochHeadEvent(epochHeadCh)
 
-	updateConsensusEnodes := func(header *types.Header) {
-		state, err := acn.chain.StateAt(header.Root)
-		if err != nil {
-			acn.log.Error("Could not retrieve state at head block", "err", err)
-			return
-		}
-		enodesList, err := acn.chain.ProtocolContracts().CommitteeEnodes(header, state, true)
-		if err != nil {
-			acn.log.Error("Could not retrieve consensus whitelist at head block", "err", err)
-			return
-		}
-		acn.server.UpdateConsensusEnodes(enodesList.List, enodesList.List)
-	}
-
-	wasValidating := false
-
 	// read the committee base on latest state.
 	currentHead := acn.chain.CurrentHeader()
 	currentState, err := acn.chain.StateAt(currentHead.Root)
@@ -42,12 +52,9 @@ func (acn *ACN) watchCommittee(ctx context.Context) {
 	if err != nil {
 		panic(err)
 	}
-
-	committee := epoch.Committee
-	if committee.MemberByAddress(acn.address) != nil { //nolint
-		updateConsensusEnodes(currentHead)
-		wasValidating = true
-	}
+	acn.setCommittee(currentHead, epoch.EpochBlock.Uint64(), epoch.Committee, epoch.NextEpochBlock.Uint64())
+	// the node may start within the pre-connection range
+	acn.preconnect(currentHead)
 
 	go func() {
 		defer acn.wg.Done()
@@ -57,21 +64,10 @@ func (acn *ACN) watchCommittee(ctx context.Context) {
 			select {
 			case ev := <-chainHeadCh:
 				acn.server.SetCurrentBlockNumber(ev.Block.NumberU64())
+				acn.preconnect(ev.Block.Header())
+				acn.updateConnectivityMetrics()
 			case ev := <-epochHeadCh:
-				committee = ev.Header.Epoch.Committee
-				// check if the local node belongs to the consensus committee.
-				if committee.MemberByAddress(acn.address) == nil {
-					// if the local node was part of the committee set for the previous block
-					// there is no longer the need to retain the full connections and the
-					// consensus engine enabled.
-					if wasValidating {
-						acn.server.UpdateConsensusEnodes(nil, nil)
-						wasValidating = false
-					}
-					continue
-				}
-				updateConsensusEnodes(ev.Header)
-				wasValidating = true
+				acn.setCommittee(ev.Header, ev.Header.Number.Uint64(), ev.Header.Epoch.Committee, ev.Header.Epoch.NextEpochBlock.Uint64())
 			// Err() channel will be closed when unsubscribing.
 			case <-epochHeadSub.Err():
 				return
@@ -83,3 +79,207 @@ func (acn *ACN) watchCommittee(ctx context.Context) {
 		}
 	}()
 }
+
+// setCommittee switches to the committee of the epoch started at epochBlock, which drops the connections to the
+// members of the previous committees which left.
+func (acn *ACN) setCommittee(header *types.Header, epochBlock uint64, committee *types.Committee, nextEpochBlock uint64) {
+	state, err := acn.chain.StateAt(header.Root)
+	if err != nil {
+		acn.log.Error("Could not retrieve state at head block", "err", err)
+		return
+	}
+	enodesList, err := acn.chain.ProtocolContracts().CommitteeEnodes(header, state, true)
+	if err != nil {
+		acn.log.Error("Could not retrieve consensus whitelist at head block", "err", err)
+		return
+	}
+
+	acn.committeesLock.Lock()
+	acn.current = newCommitteeEnodes(epochBlock, committee, enodesList)
+	acn.next = nil
+	acn.dialed = nil
+	acn.nextEpochBlock = nextEpochBlock
+	acn.committeesLock.Unlock()
+
+	acn.connect()
+}
+
+// preconnect previews the next committee once the chain head is within the pre-connection range of the epoch end,
+// and connects to its members ahead of the epoch switch. The preview runs the epoch finalization on a copy of the
+// state, it is computed once per epoch: the staking operations submitted afterwards are accounted for at the epoch
+// switch.
+func (acn *ACN) preconnect(header *types.Header) {
+	number := header.Number.Uint64()
+	acn.committeesLock.RLock()
+	nextEpochBlock := acn.nextEpochBlock
+	inRange := acn.current != nil && number < nextEpochBlock && number+acn.preconnectBlocks >= nextEpochBlock
+	previewed := acn.next != nil && acn.next.epochBlock == nextEpochBlock
+	acn.committeesLock.RUnlock()
+	if acn.preconnectBlocks == 0 || !inRange || previewed {
+		return
+	}
+
+	state, err := acn.chain.StateAt(header.Root)
+	if err != nil {
+		acn.log.Error("Could not retrieve state at head block", "err", err)
+		return
+	}
+	committee, enodesList, err := acn.chain.ProtocolContracts().NextCommittee(header, state, nextEpochBlock, true)
+	if err != nil {
+		acn.log.Debug("Could not preview the next committee", "number", number, "err", err)
+		return
+	}
+
+	acn.committeesLock.Lock()
+	if acn.nextEpochBlock != nextEpochBlock {
+		// the epoch switched meanwhile
+		acn.committeesLock.Unlock()
+		return
+	}
+	acn.next = newCommitteeEnodes(nextEpochBlock, committee, enodesList)
+	acn.committeesLock.Unlock()
+
+	acn.log.Debug("Connecting to the next committee", "epochBlock", nextEpochBlock, "members", committee.Len())
+	acn.connect()
+}
+
+// connect updates the consensus connections to the members of the committees the local node belongs to: the
+// current committee and, close to the end of the epoch, the next one. The members of both committees are allowed to
+// connect, so that the incoming members connect to the current committee ahead of the epoch switch. The members
+// which declared sentries are reached through them.
+func (acn *ACN) connect() {
+	acn.committeesLock.RLock()
+	committees := []*committeeEnodes{acn.current}
+	if acn.next != nil {
+		committees = append(committees, acn.next)
+	}
+	acn.committeesLock.RUnlock()
+
+	var subset, allowed []*enode.Node
+	relays := make(map[common.Address][]common.Address)
+	for _, c := range committees {
+		subset = appendEnodes(subset, acn.committeeSubset(c)...)
+		allowed = appendEnodes(allowed, c.enodes...)
+		for i, member := range c.committee.Members {
+			for _, sentry := range c.memberSentries(i) {
+				allowed = appendEnodes(allowed, sentry)
+				if address := crypto.PubkeyToAddress(*sentry.Pubkey()); !slices.Contains(relays[member.Address], address) {
+					relays[member.Address] = append(relays[member.Address], address)
+				}
+			}
+		}
+	}
+	acn.relaysLock.Lock()
+	acn.relays = relays
+	acn.relaysLock.Unlock()
+
+	if len(acn.sentries) > 0 {
+		// the validator only connects to its sentries, which connect to the committees on its behalf
+		acn.server.UpdateConsensusEnodes(acn.sentries, acn.sentries)
+		return
+	}
+	var private []*enode.Node
+	if acn.sentryOf != nil {
+		// the sentry keeps accepting the connection of its validator
+		private = append(private, acn.sentryOf)
+	}
+	if len(subset) == 0 {
+		// the local node does not belong to the committees, there is no longer the need to retain the connections
+		if acn.validating {
+			acn.server.UpdateConsensusEnodes(nil, private)
+			acn.validating = false
+		}
+		return
+	}
+	acn.server.UpdateConsensusEnodes(subset, appendEnodes(allowed, private...))
+	acn.validating = true
+}
+
+// committeeSubset returns the enodes of the committee members the local node connects to, none if it is not a
+// member. The members which declared sentries are replaced by their sentries.
+func (acn *ACN) committeeSubset(c *committeeEnodes) []*enode.Node {
+	// the enodes are listed in committee order
+	if len(c.enodes) != c.committee.Len() {
+		if c.committee.MemberByAddress(acn.member) == nil {
+			return nil
+		}
+		return c.enodes
+	}
+	indexes := acn.peerIndexes(c.committee)
+	subset := make([]*enode.Node, 0, len(indexes))
+	for _, i := range indexes {
+		if sentries := c.memberSentries(i); len(sentries) > 0 {
+			subset = appendEnodes(subset, sentries...)
+			continue
+		}
+		subset = append(subset, c.enodes[i])
+	}
+	return subset
+}
+
+// peerIndexes returns the indexes of the committee members the local node connects to, none if it is not a member.
+// In relay mode, the consensus messages only flow between neighbours of the committee graph, so that the connections
+// are limited to them, along with the members dialed for the off-chain accountability messages. Otherwise, the
+// committee is fully connected.
+func (acn *ACN) peerIndexes(committee *types.Committee) []int {
+	for self, member := range committee.Members {
+		if member.Address != acn.member {
+			continue
+		}
+		if acn.chain.Config().RelayConsensusGossip(committee.Len()) {
+			indexes := topology.Adjacent(self, committee.Len())
+			acn.committeesLock.RLock()
+			defer acn.committeesLock.RUnlock()
+			for i, other := range committee.Members {
+				if i != self && slices.Contains(acn.dialed, other.Address) && !slices.Contains(indexes, i) {
+					indexes = append(indexes, i)
+				}
+			}
+			return indexes
+		}
+		indexes := make([]int, 0, committee.Len()-1)
+		for i := range committee.Members {
+			if i != self {
+				indexes = append(indexes, i)
+			}
+		}
+		return indexes
+	}
+	return nil
+}
+
+// Dial connects directly to the committee member, for the off-chain accountability messages which are only meant for
+// it. In relay mode, the members which are not neighbours in the committee graph are not peers otherwise. The
+// connection is kept until the end of the epoch. A validator behind sentries relies on them instead.
+func (acn *ACN) Dial(member common.Address) {
+	if len(acn.sentries) > 0 {
+		return
+	}
+	acn.committeesLock.Lock()
+	if slices.Contains(acn.dialed, member) {
+		acn.committeesLock.Unlock()
+		return
+	}
+	acn.dialed = append(acn.dialed, member)
+	acn.committeesLock.Unlock()
+
+	acn.log.Debug("Connecting directly to committee member", "address", member)
+	acn.connect()
+}
+
+// appendEnodes appends the enodes not listed yet.
+func appendEnodes(list []*enode.Node, enodes ...*enode.Node) []*enode.Node {
+	for _, node := range enodes {
+		found := false
+		for _, listed := range list {
+			if listed.ID() == node.ID() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			list = append(list, node)
+		}
+	}
+	return list
+}
@@ -0,0 +1,114 @@
+package acn
+
+import (
+	"math/big"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/common/hexutil"
+	"github.com/autonity/autonity/metrics"
+)
+
+var (
+	currentMembersGauge   = metrics.NewRegisteredGauge("acn/committee/current/members", nil)
+	currentConnectedGauge = metrics.NewRegisteredGauge("acn/committee/current/connected", nil)
+	currentPowerGauge     = metrics.NewRegisteredGaugeFloat64("acn/committee/current/power", nil)
+	nextMembersGauge      = metrics.NewRegisteredGauge("acn/committee/next/members", nil)
+	nextConnectedGauge    = metrics.NewRegisteredGauge("acn/committee/next/connected", nil)
+	nextPowerGauge        = metrics.NewRegisteredGaugeFloat64("acn/committee/next/power", nil)
+)
+
+// Connectivity is the connectivity of the local node to the members of a committee. Only the members the local node
+// connects to are expected, none if the local node is not a member.
+type Connectivity struct {
+	EpochBlock     uint64           `json:"epochBlock"`
+	Member         bool             `json:"member"`
+	Expected       int              `json:"expected"`
+	Connected      int              `json:"connected"`
+	ExpectedPower  *hexutil.Big     `json:"expectedPower"`
+	ConnectedPower *hexutil.Big     `json:"connectedPower"`
+	Disconnected   []common.Address `json:"disconnected"`
+}
+
+// ratio returns the share of the expected voting power connected.
+func (c *Connectivity) ratio() float64 {
+	if c.ExpectedPower.ToInt().Sign() == 0 {
+		return 1
+	}
+	ratio, _ := new(big.Rat).SetFrac(c.ConnectedPower.ToInt(), c.ExpectedPower.ToInt()).Float64()
+	return ratio
+}
+
+// CommitteeConnectivity is the connectivity of the local node to the current committee and, once it connects to
+// it ahead of the epoch switch, to the next committee.
+type CommitteeConnectivity struct {
+	Current *Connectivity `json:"current"`
+	Next    *Connectivity `json:"next"`
+}
+
+func (acn *ACN) committeeConnectivity() *CommitteeConnectivity {
+	acn.committeesLock.RLock()
+	current, next := acn.current, acn.next
+	acn.committeesLock.RUnlock()
+
+	connectivity := new(CommitteeConnectivity)
+	if current != nil {
+		connectivity.Current = acn.connectivity(current)
+	}
+	if next != nil {
+		connectivity.Next = acn.connectivity(next)
+	}
+	return connectivity
+}
+
+func (acn *ACN) connectivity(c *committeeEnodes) *Connectivity {
+	connectivity := &Connectivity{
+		EpochBlock:   c.epochBlock,
+		Member:       c.committee.MemberByAddress(acn.member) != nil,
+		Disconnected: make([]common.Address, 0),
+	}
+	expectedPower, connectedPower := new(big.Int), new(big.Int)
+	for _, i := range acn.peerIndexes(c.committee) {
+		member := c.committee.Members[i]
+		connectivity.Expected++
+		expectedPower.Add(expectedPower, member.VotingPower)
+		if _, ok := acn.findPeer(member.Address); ok {
+			connectivity.Connected++
+			connectedPower.Add(connectedPower, member.VotingPower)
+		} else {
+			connectivity.Disconnected = append(connectivity.Disconnected, member.Address)
+		}
+	}
+	connectivity.ExpectedPower = (*hexutil.Big)(expectedPower)
+	connectivity.ConnectedPower = (*hexutil.Big)(connectedPower)
+	return connectivity
+}
+
+func (acn *ACN) updateConnectivityMetrics() {
+	if !metrics.Enabled {
+		return
+	}
+	connectivity := acn.committeeConnectivity()
+	if current := connectivity.Current; current != nil {
+		currentMembersGauge.Update(int64(current.Expected))
+		currentConnectedGauge.Update(int64(current.Connected))
+		currentPowerGauge.Update(current.ratio())
+	}
+	next := connectivity.Next
+	if next == nil {
+		next = &Connectivity{ExpectedPower: new(hexutil.Big), ConnectedPower: new(hexutil.Big)}
+	}
+	nextMembersGauge.Update(int64(next.Expected))
+	nextConnectedGauge.Update(int64(next.Connected))
+	nextPowerGauge.Update(next.ratio())
+}
+
+// API exposes the connectivity of the local node to the consensus committees.
+type API struct {
+	acn *ACN
+}
+
+// GetCommitteeConnectivity returns the connectivity of the local node to the current committee and, close to the
+// end of the epoch, to the next committee.
+func (api *API) GetCommitteeConnectivity() *CommitteeConnectivity {
+	return api.acn.committeeConnectivity()
+}
@@ -20,7 +20,6 @@ import (
 	"errors"
 	"sync"
 
-	"github.com/autonity/autonity/consensus"
 	"github.com/autonity/autonity/consensus/acn/protocol"
 	"github.com/autonity/autonity/p2p/enode"
 
@@ -91,17 +90,15 @@ func (ps *peerSet) unregister(peer *protocol.Peer) error {
 	return nil
 }
 
-// find retrieves the map of registered peer with the given map of ids.
-func (ps *peerSet) find(targets []common.Address) map[common.Address]consensus.Peer {
+// list retrieves all the registered peers.
+func (ps *peerSet) list() []*protocol.Peer {
 	ps.RLock()
 	defer ps.RUnlock()
-	m := make(map[common.Address]consensus.Peer)
-	for _, addr := range targets {
-		if p, ok := ps.peers[addr]; ok {
-			m[addr] = p
-		}
+	list := make([]*protocol.Peer, 0, len(ps.peers))
+	for _, p := range ps.peers {
+		list = append(list, p)
 	}
-	return m
+	return list
 }
 
 // peer retrieves the registered peer with the given id.
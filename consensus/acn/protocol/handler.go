@@ -2,10 +2,12 @@ package protocol
 
 import (
 	"fmt"
+	"io"
 	"math/big"
 
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/consensus"
+	"github.com/autonity/autonity/consensus/acn/codes"
 	"github.com/autonity/autonity/core"
 	"github.com/autonity/autonity/p2p"
 	"github.com/autonity/autonity/p2p/enode"
@@ -30,6 +32,10 @@ type Backend interface {
 
 	// PeerInfo retrieves all known `acn` information about a peer.
 	PeerInfo(id enode.ID) interface{}
+
+	// Origin retrieves the committee member an accountability message received from the peer comes from, along
+	// with the message as sent by the member. They differ from the peer and its message when relayed by a sentry.
+	Origin(peer *Peer, msg p2p.Msg) (common.Address, p2p.Msg, error)
 }
 
 // NodeInfo represents a short summary of the `ACN` protocol metadata
@@ -118,10 +124,38 @@ func handleMessage(backend Backend, peer *Peer, errCh chan<- error) error {
 		return fmt.Errorf("%w: %v > %v", errMsgTooLarge, msg.Size, MaxMessageSize)
 	}
 
+	sender := peer.address
+	if msg.Code == codes.AccountabilityNetworkMsg {
+		if sender, msg, err = backend.Origin(peer, msg); err != nil {
+			return fmt.Errorf("%w: %v", errDecode, err)
+		}
+		// the connection to a sentry is not dropped for the messages it relays, the rate limits apply to their origin
+		if sender != peer.address {
+			errCh = nil
+		}
+	}
 	if handler, ok := backend.Chain().Engine().(consensus.Handler); ok {
-		if handled, err := handler.HandleMsg(peer.address, msg, errCh); handled {
+		if handled, err := handler.HandleMsg(sender, msg, errCh); handled {
 			return err
 		}
 	}
 	return fmt.Errorf("%w: %v", errInvalidMsgCode, msg.Code)
 }
+
+// ReadRaw reads the next message from the remote peer without handling it, and returns its code along with its
+// payload. It is used to relay the consensus messages as received.
+func ReadRaw(peer *Peer) (uint64, []byte, error) {
+	msg, err := peer.rw.ReadMsg()
+	if err != nil {
+		return 0, nil, err
+	}
+	defer msg.Discard()
+	if msg.Size > MaxMessageSize {
+		return 0, nil, fmt.Errorf("%w: %v > %v", errMsgTooLarge, msg.Size, MaxMessageSize)
+	}
+	payload, err := io.ReadAll(msg.Payload)
+	if err != nil {
+		return 0, nil, err
+	}
+	return msg.Code, payload, nil
+}
@@ -102,6 +102,11 @@ func getWriteMetric(msgCode uint64) metrics.BufferedGauge {
 	return DefaultWriteBg
 }
 
+// Supports tells whether the message code is part of the `acn` protocol version negotiated with the peer.
+func (p *Peer) Supports(msgcode uint64) bool {
+	return msgcode < protocolLengths[p.version]
+}
+
 // Version retrieves the peer's negoatiated `acn` protocol version.
 func (p *Peer) Version() uint {
 	return p.version
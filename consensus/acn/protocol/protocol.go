@@ -10,6 +10,7 @@ import (
 // Constants to match up protocol versions and messages
 const (
 	ACNv1 = 1
+	ACNv2 = 2 // compact proposals
 )
 
 // ProtocolName is the official short name of the autonity consensus network protocol used during
@@ -18,13 +19,13 @@ const ProtocolName = "acn"
 
 // ProtocolVersions are the supported versions of the `snap` protocol (first
 // is primary).
-var ProtocolVersions = []uint{ACNv1}
+var ProtocolVersions = []uint{ACNv2, ACNv1}
 
 // todo(piyush): length for ACN should be 6 because of 1 status message(0x00) and
 // and 5 protocol message which have legacy codes(staring from 0x11) i.e. length 22 for now.
 // protocolLengths are the number of implemented message corresponding to
 // different protocol versions.
-var protocolLengths = map[uint]uint64{ACNv1: 22}
+var protocolLengths = map[uint]uint64{ACNv2: 25, ACNv1: 22}
 
 // MaxMessageSize is the maximum cap on the size of a consensus protocol message.
 const MaxMessageSize = 10 * 1024 * 1024
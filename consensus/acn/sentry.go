@@ -0,0 +1,239 @@
+package acn
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/common/fixsizecache"
+	"github.com/autonity/autonity/consensus/acn/codes"
+	"github.com/autonity/autonity/consensus/acn/protocol"
+	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/rlp"
+)
+
+const (
+	// number of buckets and entries of the caches of the compact proposals relayed
+	relayBuckets = 31
+	relayEntries = 8
+)
+
+var (
+	errInboundPeer        = errors.New("validator behind sentries does not accept inbound peers")
+	errInvalidRelayedCode = errors.New("invalid relayed message code")
+)
+
+// relay forwards the consensus messages between the validator a sentry node protects and the committee, as they
+// are received: the messages of the validator to the committee peers, the ones of the committee peers to the
+// validator. The messages are deduplicated per peer, as done by the gossiper. The requests for the transactions of
+// the compact proposals are routed to the peer which relayed the compact proposal, and the responses back to the
+// requesters. The off-chain accountability messages are only meant for a single member: between the sentry and the
+// validator, they are wrapped along with the member they come from, or are sent to.
+type relay struct {
+	acn       *ACN
+	validator common.Address
+
+	origins        *fixsizecache.Cache[common.Hash, common.Address]   // committee peers which sent compact proposals
+	requesters     *fixsizecache.Cache[common.Hash, []common.Address] // committee peers which requested transactions
+	requestersLock sync.Mutex
+}
+
+func newRelay(acn *ACN, validator common.Address) *relay {
+	return &relay{
+		acn:        acn,
+		validator:  validator,
+		origins:    fixsizecache.New[common.Hash, common.Address](relayBuckets, relayEntries, fixsizecache.HashKey[common.Hash]),
+		requesters: fixsizecache.New[common.Hash, []common.Address](relayBuckets, relayEntries, fixsizecache.HashKey[common.Hash]),
+	}
+}
+
+// run relays the messages received from the peer until the connection is torn down.
+func (r *relay) run(peer *protocol.Peer) error {
+	for {
+		code, payload, err := protocol.ReadRaw(peer)
+		if err == nil {
+			err = r.forward(peer, code, payload)
+		}
+		if err != nil {
+			peer.Log().Debug("Message relaying failed in `acn`", "err", err)
+			return err
+		}
+	}
+}
+
+func (r *relay) forward(from *protocol.Peer, code uint64, payload []byte) error {
+	if code < codes.ProposeNetworkMsg || code > codes.ProposalTxsNetworkMsg {
+		return fmt.Errorf("%w: %v", errInvalidRelayedCode, code)
+	}
+	fromValidator := from.Address() == r.validator
+	switch code {
+	case codes.AccountabilityNetworkMsg:
+		if !fromValidator {
+			// the validator handles the message as sent by the member, which is checked to be a single proof
+			if err := rlp.DecodeBytes(payload, new([]byte)); err != nil {
+				return err
+			}
+			relayed, err := rlp.EncodeToBytes(&relayedMsg{Peer: r.acn.memberOf(from.Address()), Payload: payload})
+			if err != nil {
+				return err
+			}
+			r.send(r.validatorPeer(), code, relayed, nil)
+			return nil
+		}
+		var relayed relayedMsg
+		if err := rlp.DecodeBytes(payload, &relayed); err != nil {
+			return err
+		}
+		if peer, ok := r.acn.findPeer(relayed.Peer); ok {
+			r.send([]*protocol.Peer{peer}, code, relayed.Payload, nil)
+		} else {
+			// the member is reachable for the next messages
+			r.acn.Dial(relayed.Peer)
+		}
+		return nil
+	case codes.GetProposalTxsNetworkMsg:
+		hash, err := proposalHash(payload)
+		if err != nil {
+			return err
+		}
+		if !fromValidator {
+			r.addRequester(hash, from.Address())
+			r.send(r.validatorPeer(), code, payload, nil)
+			return nil
+		}
+		if origin, ok := r.origins.Get(hash); ok {
+			r.send(r.peers(origin.(common.Address)), code, payload, nil)
+		}
+		return nil
+	case codes.ProposalTxsNetworkMsg:
+		hash, err := proposalHash(payload)
+		if err != nil {
+			return err
+		}
+		if !fromValidator {
+			r.send(r.validatorPeer(), code, payload, nil)
+			return nil
+		}
+		r.send(r.peers(r.takeRequesters(hash)...), code, payload, nil)
+		return nil
+	}
+
+	// the sync requests carry no payload, they are not deduplicated
+	var hash *common.Hash
+	if code != codes.SyncNetworkMsg {
+		h := crypto.Hash(payload)
+		hash = &h
+		from.Cache().Add(h, true)
+	}
+	if !fromValidator {
+		if code == codes.CompactProposeNetworkMsg {
+			r.origins.Add(*hash, from.Address())
+		}
+		r.send(r.validatorPeer(), code, payload, hash)
+		return nil
+	}
+	var committee []*protocol.Peer
+	for _, peer := range r.acn.peers.list() {
+		if peer.Address() != r.validator {
+			committee = append(committee, peer)
+		}
+	}
+	r.send(committee, code, payload, hash)
+	return nil
+}
+
+// relayedMsg is an accountability message exchanged between a validator and its sentries, along with the committee
+// member it comes from, or is sent to.
+type relayedMsg struct {
+	Peer    common.Address
+	Payload rlp.RawValue
+}
+
+// sentryPeer is a sentry of the local validator, through which the messages to the target are sent.
+type sentryPeer struct {
+	*protocol.Peer
+	target common.Address
+}
+
+func (p *sentryPeer) Send(msgcode uint64, data interface{}) error {
+	if msgcode != codes.AccountabilityNetworkMsg {
+		return p.Peer.Send(msgcode, data)
+	}
+	payload, err := rlp.EncodeToBytes(data)
+	if err != nil {
+		return err
+	}
+	return p.SendRaw(msgcode, payload)
+}
+
+func (p *sentryPeer) SendRaw(msgcode uint64, data []byte) error {
+	if msgcode != codes.AccountabilityNetworkMsg {
+		return p.Peer.SendRaw(msgcode, data)
+	}
+	return p.Peer.Send(msgcode, &relayedMsg{Peer: p.target, Payload: data})
+}
+
+// send sends the message to the peers which support it and, if hash is given, do not know it yet.
+func (r *relay) send(peers []*protocol.Peer, code uint64, payload []byte, hash *common.Hash) {
+	for _, peer := range peers {
+		if !peer.Supports(code) {
+			continue
+		}
+		if hash != nil {
+			if peer.Cache().Contains(*hash) {
+				continue
+			}
+			peer.Cache().Add(*hash, true)
+		}
+		go peer.SendRaw(code, payload) //nolint
+	}
+}
+
+func (r *relay) validatorPeer() []*protocol.Peer {
+	return r.peers(r.validator)
+}
+
+func (r *relay) peers(addresses ...common.Address) []*protocol.Peer {
+	peers := make([]*protocol.Peer, 0, len(addresses))
+	for _, address := range addresses {
+		if peer, ok := r.acn.peers.peer(address); ok {
+			peers = append(peers, peer)
+		}
+	}
+	return peers
+}
+
+func (r *relay) addRequester(hash common.Hash, requester common.Address) {
+	r.requestersLock.Lock()
+	defer r.requestersLock.Unlock()
+	var requesters []common.Address
+	if cached, ok := r.requesters.Get(hash); ok {
+		requesters = cached.([]common.Address)
+	}
+	r.requesters.Add(hash, append(requesters, requester))
+}
+
+func (r *relay) takeRequesters(hash common.Hash) []common.Address {
+	r.requestersLock.Lock()
+	defer r.requestersLock.Unlock()
+	cached, ok := r.requesters.Get(hash)
+	if !ok {
+		return nil
+	}
+	r.requesters.Remove(hash)
+	return cached.([]common.Address)
+}
+
+// proposalHash decodes the hash of the compact proposal a request for transactions, or its response, refers to.
+// Both are lists starting with the hash.
+func proposalHash(payload []byte) (common.Hash, error) {
+	var hash common.Hash
+	s := rlp.NewStream(bytes.NewReader(payload), uint64(len(payload)))
+	if _, err := s.List(); err != nil {
+		return hash, err
+	}
+	err := s.Decode(&hash)
+	return hash, err
+}
@@ -0,0 +1,110 @@
+package acn
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/consensus/acn/codes"
+	"github.com/autonity/autonity/consensus/acn/protocol"
+	"github.com/autonity/autonity/p2p"
+	"github.com/autonity/autonity/p2p/enode"
+	"github.com/autonity/autonity/rlp"
+)
+
+// newTestPeer returns a peer of the local node along with the end of the connection of the remote node.
+func newTestPeer(t *testing.T, peers *peerSet) (*protocol.Peer, *p2p.MsgPipeRW) {
+	local, remote := p2p.MsgPipe()
+	t.Cleanup(func() { local.Close() })
+	peer := protocol.NewPeer(protocol.ACNv2, p2p.NewPeer(enode.ID{}, "test", nil), local)
+	require.NoError(t, peers.register(peer))
+	return peer, remote
+}
+
+func readAccountabilityMsg(t *testing.T, rw *p2p.MsgPipeRW) p2p.Msg {
+	msg, err := rw.ReadMsg()
+	require.NoError(t, err)
+	require.Equal(t, codes.AccountabilityNetworkMsg, msg.Code)
+	return msg
+}
+
+func TestSentryRelaysAccountabilityMsgs(t *testing.T) {
+	proof, err := rlp.EncodeToBytes([]byte("proof"))
+	require.NoError(t, err)
+
+	// the sentry is connected to its validator, to a member and to the sentry of another member
+	sentry := &ACN{peers: newPeerSet()}
+	validator, validatorRemote := newTestPeer(t, sentry.peers)
+	member, memberRemote := newTestPeer(t, sentry.peers)
+	otherSentry, otherSentryRemote := newTestPeer(t, sentry.peers)
+	otherMember := common.HexToAddress("0x0123")
+	sentry.relays = map[common.Address][]common.Address{otherMember: {otherSentry.Address()}}
+	r := newRelay(sentry, validator.Address())
+
+	t.Run("messages of the committee are relayed to the validator along with their member", func(t *testing.T) {
+		for _, tc := range []struct {
+			from   *protocol.Peer
+			origin common.Address
+		}{{member, member.Address()}, {otherSentry, otherMember}} {
+			require.NoError(t, r.forward(tc.from, codes.AccountabilityNetworkMsg, proof))
+			var relayed relayedMsg
+			require.NoError(t, readAccountabilityMsg(t, validatorRemote).Decode(&relayed))
+			require.Equal(t, tc.origin, relayed.Peer)
+			require.Equal(t, proof, []byte(relayed.Payload))
+		}
+	})
+
+	t.Run("messages of the validator are relayed to their member as sent", func(t *testing.T) {
+		for _, tc := range []struct {
+			target common.Address
+			remote *p2p.MsgPipeRW
+		}{{member.Address(), memberRemote}, {otherMember, otherSentryRemote}} {
+			relayed, err := rlp.EncodeToBytes(&relayedMsg{Peer: tc.target, Payload: proof})
+			require.NoError(t, err)
+			require.NoError(t, r.forward(validator, codes.AccountabilityNetworkMsg, relayed))
+			var payload []byte
+			require.NoError(t, readAccountabilityMsg(t, tc.remote).Decode(&payload))
+			require.Equal(t, []byte("proof"), payload)
+		}
+	})
+
+	t.Run("malformed messages are not relayed", func(t *testing.T) {
+		require.Error(t, r.forward(member, codes.AccountabilityNetworkMsg, []byte{0xc1}))
+		require.Error(t, r.forward(validator, codes.AccountabilityNetworkMsg, proof))
+	})
+}
+
+func TestValidatorAccountabilityMsgsThroughSentry(t *testing.T) {
+	validator := &ACN{peers: newPeerSet()}
+	sentry, sentryRemote := newTestPeer(t, validator.peers)
+	validator.sentryAddresses = []common.Address{sentry.Address()}
+	member := common.HexToAddress("0x0123")
+
+	t.Run("messages to a member are sent through the sentry along with the member", func(t *testing.T) {
+		peer, ok := validator.FindPeer(member)
+		require.True(t, ok)
+		go peer.Send(codes.AccountabilityNetworkMsg, []byte("proof")) //nolint
+		var relayed relayedMsg
+		require.NoError(t, readAccountabilityMsg(t, sentryRemote).Decode(&relayed))
+		require.Equal(t, member, relayed.Peer)
+		var payload []byte
+		require.NoError(t, rlp.DecodeBytes(relayed.Payload, &payload))
+		require.Equal(t, []byte("proof"), payload)
+	})
+
+	t.Run("messages relayed by the sentry come from their member", func(t *testing.T) {
+		proof, err := rlp.EncodeToBytes([]byte("proof"))
+		require.NoError(t, err)
+		relayed, err := rlp.EncodeToBytes(&relayedMsg{Peer: member, Payload: proof})
+		require.NoError(t, err)
+		msg := p2p.Msg{Code: codes.AccountabilityNetworkMsg, Size: uint32(len(relayed)), Payload: bytes.NewReader(relayed)}
+		origin, msg, err := validator.Origin(sentry, msg)
+		require.NoError(t, err)
+		require.Equal(t, member, origin)
+		var payload []byte
+		require.NoError(t, msg.Decode(&payload))
+		require.Equal(t, []byte("proof"), payload)
+	})
+}
@@ -167,6 +167,13 @@ type BFT interface {
 	Start(ctx context.Context) error
 }
 
+// CertifiedHeaderVerifier is implemented by the engines able to verify a batch of headers whose seals were already
+// verified by the caller, such as the headers retrieved by the epoch sync.
+type CertifiedHeaderVerifier interface {
+	// VerifyCertifiedHeaders is similar to VerifyHeaders, but skips the verification of the seals of the headers.
+	VerifyCertifiedHeaders(chain ChainHeaderReader, headers []*types.Header) (chan<- struct{}, <-chan error)
+}
+
 type Syncer interface {
 	SyncPeer(address common.Address)
 }
@@ -184,6 +191,12 @@ type Broadcaster interface {
 	FindPeer(common.Address) (Peer, bool)
 }
 
+// Dialer is implemented by the broadcasters which are not connected to every committee member, to connect directly
+// to a member on demand.
+type Dialer interface {
+	Dial(common.Address)
+}
+
 // Peer defines the interface to communicate with peer
 type Peer interface {
 	// Send sends the message to this peer
@@ -192,4 +205,7 @@ type Peer interface {
 	SendRaw(msgcode uint64, data []byte) error
 
 	Cache() *fixsizecache.Cache[common.Hash, bool]
+
+	// Version returns the negotiated version of the consensus protocol
+	Version() uint
 }
@@ -1194,6 +1194,20 @@ func (mr *MockPeerMockRecorder) SendRaw(msgcode, data any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendRaw", reflect.TypeOf((*MockPeer)(nil).SendRaw), msgcode, data)
 }
 
+// Version mocks base method.
+func (m *MockPeer) Version() uint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Version")
+	ret0, _ := ret[0].(uint)
+	return ret0
+}
+
+// Version indicates an expected call of Version.
+func (mr *MockPeerMockRecorder) Version() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Version", reflect.TypeOf((*MockPeer)(nil).Version))
+}
+
 // EpochOfHeight mocks base method
 func (m *MockChainHeaderReader) EpochOfHeight(height uint64) (*types.EpochInfo, error) {
 	m.ctrl.T.Helper()
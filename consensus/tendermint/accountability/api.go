@@ -0,0 +1,561 @@
+package accountability
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/autonity/autonity/accounts/abi"
+	"github.com/autonity/autonity/accounts/abi/bind"
+	"github.com/autonity/autonity/autonity"
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/common/hexutil"
+	"github.com/autonity/autonity/consensus/tendermint/core/message"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/core/vm"
+	"github.com/autonity/autonity/params/generated"
+	"github.com/autonity/autonity/rlp"
+)
+
+// EventStatus is the outcome of an accountability event recorded by the Accountability contract.
+type EventStatus string
+
+const (
+	// StatusPendingAccusation is an accusation still waiting for a proof of innocence.
+	StatusPendingAccusation EventStatus = "pendingAccusation"
+	// StatusMisbehaviour is a fault proof, or an accusation promoted to misbehaviour, waiting for the slashing
+	// performed at the end of the epoch.
+	StatusMisbehaviour EventStatus = "misbehaviour"
+	// StatusInnocenceProven is an accusation withdrawn by a proof of innocence.
+	StatusInnocenceProven EventStatus = "innocenceProven"
+	// StatusSlashed is a misbehaviour for which the offender got slashed.
+	StatusSlashed EventStatus = "slashed"
+	// StatusDropped is an expired accusation which was not promoted, as the offender was already slashed at the
+	// accusation's epoch for the same or a higher severity.
+	StatusDropped EventStatus = "dropped"
+)
+
+// EventFilter selects the on-chain accountability events returned by the API. Unset fields match any event.
+type EventFilter struct {
+	Offender *common.Address `json:"offender"`
+	Rule     *string         `json:"rule"`
+	Epoch    *uint64         `json:"epoch"`
+	Status   *EventStatus    `json:"status"`
+}
+
+// RPCEvent is the JSON representation of an accountability event.
+type RPCEvent struct {
+	ID             *uint64        `json:"id,omitempty"` // nil for events not submitted on-chain yet
+	Type           string         `json:"type"`
+	Rule           string         `json:"rule"`
+	Reporter       common.Address `json:"reporter"`
+	Offender       common.Address `json:"offender"`
+	Block          uint64         `json:"block"`
+	Epoch          uint64         `json:"epoch"`
+	ReportingBlock uint64         `json:"reportingBlock"`
+	MessageHash    common.Hash    `json:"messageHash"`
+	Status         EventStatus    `json:"status,omitempty"`
+	Proof          *RPCProof      `json:"proof,omitempty"`
+	RawProof       hexutil.Bytes  `json:"rawProof"`
+}
+
+// RPCProof is the JSON representation of a decoded accountability proof.
+type RPCProof struct {
+	Type               string                  `json:"type"`
+	Rule               string                  `json:"rule"`
+	OffenderIndex      int                     `json:"offenderIndex"`
+	Offender           *common.Address         `json:"offender,omitempty"`
+	Message            *RPCMessage             `json:"message"`
+	Evidences          []*RPCMessage           `json:"evidences"`
+	DistinctPrecommits *RPCAggregatedPrecommit `json:"distinctPrecommits,omitempty"`
+	// Error reports why the signatures of the proof could not be verified against the committee of its height.
+	Error string `json:"error,omitempty"`
+}
+
+// RPCMessage is the JSON representation of a consensus message carried by a proof.
+type RPCMessage struct {
+	Type       string           `json:"type"`
+	Height     uint64           `json:"height"`
+	Round      int64            `json:"round"`
+	ValidRound *int64           `json:"validRound,omitempty"`
+	Value      common.Hash      `json:"value"`
+	Hash       common.Hash      `json:"hash"`
+	Signers    []common.Address `json:"signers,omitempty"`
+	Signature  hexutil.Bytes    `json:"signature"`
+}
+
+// RPCAggregatedPrecommit is the JSON representation of a HighlyAggregatedPrecommit.
+type RPCAggregatedPrecommit struct {
+	Height     uint64           `json:"height"`
+	MsgSigners []*RPCMsgSigners `json:"msgSigners"`
+	Signature  hexutil.Bytes    `json:"signature"`
+}
+
+// RPCMsgSigners is the JSON representation of the signers of the precommits for a given round and value.
+type RPCMsgSigners struct {
+	Round   int64            `json:"round"`
+	Value   common.Hash      `json:"value"`
+	Signers []common.Address `json:"signers"`
+}
+
+// LocalEvents are the accountability events detected by the local fault detector which are not on-chain yet.
+type LocalEvents struct {
+	// Pending are the events waiting for the reporting slot of the local validator.
+	Pending []*RPCEvent `json:"pending"`
+	// OffChainAccusations are the accusations sent to their offender, which will be escalated on-chain if no proof of
+	// innocence is received in time.
+	OffChainAccusations []*RPCProof `json:"offChainAccusations"`
+}
+
+// API is the accountability explorer. It lists the accountability events recorded by the Accountability contract
+// along with their decoded proof, and the ones detected locally but not submitted yet.
+type API struct {
+	fd *FaultDetector
+
+	eventsMu     sync.Mutex
+	events       []*autonity.AccountabilityEvent // on-chain events scanned so far, never modified by the contract
+	scanned      bool
+	scannedBlock uint64 // head block of the last scan
+
+	resolver *statusResolver // follows the logs of the Accountability contract up to the latest head
+}
+
+func NewAPI(fd *FaultDetector) *API {
+	api := &API{fd: fd}
+	api.resolver = newStatusResolver(api)
+	return api
+}
+
+// GetEvents returns the on-chain accountability events matching the filter, ordered by id.
+func (api *API) GetEvents(ctx context.Context, filter *EventFilter) ([]*RPCEvent, error) {
+	if filter == nil {
+		filter = new(EventFilter)
+	}
+	events, err := api.onChainEvents(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resolver, err := api.statusResolver(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result []*RPCEvent
+	for _, ev := range events {
+		if filter.Offender != nil && ev.Offender != *filter.Offender {
+			continue
+		}
+		if filter.Rule != nil && !strings.EqualFold(autonity.Rule(ev.Rule).String(), *filter.Rule) {
+			continue
+		}
+		if filter.Epoch != nil && ev.Epoch.Uint64() != *filter.Epoch {
+			continue
+		}
+		status, err := resolver.status(ctx, ev)
+		if err != nil {
+			return nil, err
+		}
+		if filter.Status != nil && status != *filter.Status {
+			continue
+		}
+		rpcEvent := api.newRPCEvent(ev)
+		rpcEvent.Status = status
+		result = append(result, rpcEvent)
+	}
+	return result, nil
+}
+
+// GetEvent returns the on-chain accountability event with the given id.
+func (api *API) GetEvent(ctx context.Context, id uint64) (*RPCEvent, error) {
+	ev, err := api.fd.protocolContracts.Events(&bind.CallOpts{Context: ctx}, new(big.Int).SetUint64(id))
+	if err != nil {
+		return nil, fmt.Errorf("unknown accountability event %d: %w", id, err)
+	}
+	event := autonity.AccountabilityEvent(ev)
+	resolver, err := api.statusResolver(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rpcEvent := api.newRPCEvent(&event)
+	if rpcEvent.Status, err = resolver.status(ctx, &event); err != nil {
+		return nil, err
+	}
+	return rpcEvent, nil
+}
+
+// GetLocalEvents returns the accountability events detected by the local fault detector which were not submitted
+// on-chain yet.
+func (api *API) GetLocalEvents() *LocalEvents {
+	local := &LocalEvents{
+		Pending:             make([]*RPCEvent, 0),
+		OffChainAccusations: make([]*RPCProof, 0),
+	}
+	for _, ev := range api.fd.PendingEvents() {
+		local.Pending = append(local.Pending, api.newRPCEvent(ev))
+	}
+	for _, proof := range api.fd.OffChainAccusations() {
+		// work on a copy, the verification of the signatures would otherwise race with the fault detector
+		raw, err := rlp.EncodeToBytes(proof)
+		if err != nil {
+			continue
+		}
+		decoded, err := decodeRawProof(raw)
+		if err != nil {
+			continue
+		}
+		local.OffChainAccusations = append(local.OffChainAccusations, api.newRPCProof(decoded))
+	}
+	return local
+}
+
+// DecodeProof decodes a raw accountability proof, as submitted to the Accountability contract.
+func (api *API) DecodeProof(rawProof hexutil.Bytes) (*RPCProof, error) {
+	proof, err := decodeRawProof(rawProof)
+	if err != nil {
+		return nil, err
+	}
+	return api.newRPCProof(proof), nil
+}
+
+// onChainEvents retrieves all the events stored in the Accountability contract at the latest block. The events being
+// only ever appended, the ones already retrieved are cached and only the new ones are scanned.
+func (api *API) onChainEvents(ctx context.Context) ([]*autonity.AccountabilityEvent, error) {
+	api.eventsMu.Lock()
+	defer api.eventsMu.Unlock()
+	head := api.fd.blockchain.CurrentBlock().NumberU64()
+	if !api.scanned || head != api.scannedBlock {
+		opts := &bind.CallOpts{Context: ctx}
+		for id := int64(len(api.events)); ; id++ {
+			ev, err := api.fd.protocolContracts.Events(opts, big.NewInt(id))
+			if isRevert(err) {
+				// the events getter reverts once past the end of the array
+				break
+			}
+			if err != nil {
+				return nil, fmt.Errorf("cannot retrieve accountability event %d: %w", id, err)
+			}
+			event := autonity.AccountabilityEvent(ev)
+			api.events = append(api.events, &event)
+		}
+		api.scanned, api.scannedBlock = true, head
+	}
+	return append([]*autonity.AccountabilityEvent(nil), api.events...), nil
+}
+
+// isRevert tells whether err is the revert of a contract call.
+func isRevert(err error) bool {
+	if err == nil {
+		return false
+	}
+	var rpcErr interface{ ErrorCode() int }
+	return errors.Is(err, vm.ErrExecutionReverted) || (errors.As(err, &rpcErr) && rpcErr.ErrorCode() == 3)
+}
+
+func (api *API) newRPCEvent(ev *autonity.AccountabilityEvent) *RPCEvent {
+	rpcEvent := &RPCEvent{
+		Type:           autonity.AccountabilityEventType(ev.EventType).String(),
+		Rule:           autonity.Rule(ev.Rule).String(),
+		Reporter:       ev.Reporter,
+		Offender:       ev.Offender,
+		Block:          ev.Block.Uint64(),
+		Epoch:          ev.Epoch.Uint64(),
+		ReportingBlock: ev.ReportingBlock.Uint64(),
+		MessageHash:    common.BigToHash(ev.MessageHash),
+		RawProof:       ev.RawProof,
+	}
+	// the contract assigns ids to submitted events only
+	if ev.ReportingBlock.Sign() > 0 {
+		id := ev.Id.Uint64()
+		rpcEvent.ID = &id
+	}
+	if proof, err := decodeRawProof(ev.RawProof); err == nil {
+		rpcEvent.Proof = api.newRPCProof(proof)
+	}
+	return rpcEvent
+}
+
+func (api *API) newRPCProof(proof *Proof) *RPCProof {
+	committee, err := api.fd.blockchain.CommitteeOfHeight(proof.Message.H())
+	if err != nil {
+		committee = nil
+	}
+	return NewRPCProof(proof, committee)
+}
+
+// accusationKey identifies the accusation an innocence proof answers, the Accountability contract matching both on the
+// rule, the height and the hash of the accused message.
+type accusationKey struct {
+	offender common.Address
+	rule     autonity.Rule
+	height   uint64
+	round    int64
+	hash     common.Hash
+}
+
+func newAccusationKey(offender common.Address, rawProof []byte) (accusationKey, error) {
+	proof, err := decodeRawProof(rawProof)
+	if err != nil {
+		return accusationKey{}, err
+	}
+	return accusationKey{
+		offender: offender,
+		rule:     proof.Rule,
+		height:   proof.Message.H(),
+		round:    proof.Message.R(),
+		hash:     proof.Message.Hash(),
+	}, nil
+}
+
+// statusResolver computes the status of on-chain events from the logs emitted by the Accountability contract. The
+// logs are scanned incrementally, the blocks already scanned being final.
+type statusResolver struct {
+	api *API
+
+	mu         sync.RWMutex
+	next       uint64 // first block not scanned yet
+	slashed    map[uint64]bool
+	promoted   map[uint64]bool
+	innocences map[accusationKey][]uint64 // blocks at which a proof of innocence was accepted for each accusation
+	window     uint64                     // innocence proof submission window
+}
+
+func newStatusResolver(api *API) *statusResolver {
+	return &statusResolver{
+		api:        api,
+		slashed:    make(map[uint64]bool),
+		promoted:   make(map[uint64]bool),
+		innocences: make(map[accusationKey][]uint64),
+	}
+}
+
+// statusResolver returns the status resolver of the API, up to date with the latest head.
+func (api *API) statusResolver(ctx context.Context) (*statusResolver, error) {
+	if err := api.resolver.update(ctx, api.fd.blockchain.CurrentBlock().NumberU64()); err != nil {
+		return nil, err
+	}
+	return api.resolver, nil
+}
+
+// update scans the logs of the blocks up to head which were not scanned yet.
+func (r *statusResolver) update(ctx context.Context, head uint64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	contract := r.api.fd.protocolContracts.Accountability
+	config, err := contract.Config(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return err
+	}
+	r.window = config.InnocenceProofSubmissionWindow.Uint64()
+	if head < r.next {
+		return nil
+	}
+	opts := &bind.FilterOpts{Context: ctx, Start: r.next, End: &head}
+
+	slashings, err := contract.FilterSlashingEvent(opts)
+	if err != nil {
+		return err
+	}
+	defer slashings.Close()
+	slashed := make(map[uint64]bool)
+	for slashings.Next() {
+		slashed[slashings.Event.EventId.Uint64()] = true
+	}
+	faults, err := contract.FilterNewFaultProof(opts, nil)
+	if err != nil {
+		return err
+	}
+	defer faults.Close()
+	promoted := make(map[uint64]bool)
+	for faults.Next() {
+		promoted[faults.Event.Id.Uint64()] = true
+	}
+	innocences, err := contract.FilterInnocenceProven(opts, nil)
+	if err != nil {
+		return err
+	}
+	defer innocences.Close()
+	proven := make(map[accusationKey][]uint64)
+	for innocences.Next() {
+		// the log does not identify the accusation, which is found from the proof submitted by the transaction
+		key, err := r.api.innocenceProofKey(innocences.Event.Offender, innocences.Event.Raw)
+		if err != nil {
+			r.api.fd.logger.Warn("Cannot identify the accusation answered by an innocence proof", "offender", innocences.Event.Offender,
+				"tx", innocences.Event.Raw.TxHash, "err", err)
+			continue
+		}
+		proven[key] = append(proven[key], innocences.Event.Raw.BlockNumber)
+	}
+
+	// the range is recorded only once entirely scanned
+	for id := range slashed {
+		r.slashed[id] = true
+	}
+	for id := range promoted {
+		r.promoted[id] = true
+	}
+	for key, blocks := range proven {
+		r.innocences[key] = append(r.innocences[key], blocks...)
+	}
+	r.next = head + 1
+	return nil
+}
+
+// innocenceProofKey returns the key of the accusation answered by the innocence proof submitted by the transaction of
+// the log.
+func (api *API) innocenceProofKey(offender common.Address, log types.Log) (accusationKey, error) {
+	block := api.fd.blockchain.GetBlock(log.BlockHash, log.BlockNumber)
+	if block == nil {
+		return accusationKey{}, fmt.Errorf("unknown block %d", log.BlockNumber)
+	}
+	tx := block.Transaction(log.TxHash)
+	if tx == nil {
+		return accusationKey{}, fmt.Errorf("unknown transaction %v", log.TxHash)
+	}
+	method := generated.AccountabilityAbi.Methods["handleInnocenceProof"]
+	data := tx.Data()
+	if len(data) < 4 || !bytes.Equal(data[:4], method.ID) {
+		return accusationKey{}, errors.New("not an innocence proof submission")
+	}
+	args, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		return accusationKey{}, err
+	}
+	ev := abi.ConvertType(args[0], new(autonity.AccountabilityEvent)).(*autonity.AccountabilityEvent)
+	return newAccusationKey(offender, ev.RawProof)
+}
+
+func (r *statusResolver) status(ctx context.Context, ev *autonity.AccountabilityEvent) (EventStatus, error) {
+	id := ev.Id.Uint64()
+	r.mu.RLock()
+	slashed, promoted := r.slashed[id], r.promoted[id]
+	r.mu.RUnlock()
+	if slashed {
+		return StatusSlashed, nil
+	}
+	if autonity.AccountabilityEventType(ev.EventType) != autonity.Accusation || promoted {
+		return StatusMisbehaviour, nil
+	}
+	pending, err := r.api.fd.protocolContracts.GetValidatorAccusation(&bind.CallOpts{Context: ctx}, ev.Offender)
+	if err == nil && pending.ReportingBlock.Sign() > 0 && pending.Id.Uint64() == id {
+		return StatusPendingAccusation, nil
+	}
+	if key, err := newAccusationKey(ev.Offender, ev.RawProof); err == nil && r.innocenceProven(key, ev.ReportingBlock.Uint64()) {
+		return StatusInnocenceProven, nil
+	}
+	return StatusDropped, nil
+}
+
+// innocenceProven tells whether a proof of innocence answering the accusation reported at reportingBlock was accepted
+// within the innocence proof submission window.
+func (r *statusResolver) innocenceProven(key accusationKey, reportingBlock uint64) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, block := range r.innocences[key] {
+		if block >= reportingBlock && block < reportingBlock+r.window {
+			return true
+		}
+	}
+	return false
+}
+
+// NewRPCProof builds the JSON representation of proof. The signers of the messages are resolved, and the signatures
+// verified, against committee, which is the committee of the proof's height. The signers are omitted if committee is
+// nil.
+func NewRPCProof(proof *Proof, committee *types.Committee) *RPCProof {
+	rpcProof := &RPCProof{
+		Type:          proof.Type.String(),
+		Rule:          proof.Rule.String(),
+		OffenderIndex: proof.OffenderIndex,
+		Evidences:     make([]*RPCMessage, 0, len(proof.Evidences)),
+	}
+	if committee == nil {
+		rpcProof.Error = "unknown committee"
+	} else if err := verifyProofSignatures(committee, proof); err != nil {
+		rpcProof.Error = err.Error()
+	}
+	if committee != nil && proof.OffenderIndex >= 0 && proof.OffenderIndex < committee.Len() {
+		offender := committee.Members[proof.OffenderIndex].Address
+		rpcProof.Offender = &offender
+	}
+	rpcProof.Message = newRPCMessage(proof.Message, committee)
+	for _, m := range proof.Evidences {
+		rpcProof.Evidences = append(rpcProof.Evidences, newRPCMessage(m, committee))
+	}
+	if proof.DistinctPrecommits.Len() > 0 {
+		aggregate := &RPCAggregatedPrecommit{
+			Height:    proof.DistinctPrecommits.Height,
+			Signature: proof.DistinctPrecommits.Signature,
+		}
+		for _, s := range proof.DistinctPrecommits.MsgSigners {
+			aggregate.MsgSigners = append(aggregate.MsgSigners, &RPCMsgSigners{
+				Round:   s.Round,
+				Value:   s.Value,
+				Signers: signerAddresses(s.Signers, committee),
+			})
+		}
+		rpcProof.DistinctPrecommits = aggregate
+	}
+	return rpcProof
+}
+
+func newRPCMessage(m message.Msg, committee *types.Committee) *RPCMessage {
+	rpcMessage := &RPCMessage{
+		Height: m.H(),
+		Round:  m.R(),
+		Value:  m.Value(),
+		Hash:   m.Hash(),
+	}
+	if m.Signature() != nil {
+		rpcMessage.Signature = m.Signature().Marshal()
+	}
+	switch msg := m.(type) {
+	case *message.LightProposal:
+		rpcMessage.Type = "proposal"
+		validRound := msg.ValidRound()
+		rpcMessage.ValidRound = &validRound
+		rpcMessage.Signers = []common.Address{msg.Signer()}
+	case *message.Prevote:
+		rpcMessage.Type = "prevote"
+		if msg.PreVerified() && committee != nil {
+			rpcMessage.Signers = signerAddresses(msg.Signers().FlattenUniq(), committee)
+		}
+	case *message.Precommit:
+		rpcMessage.Type = "precommit"
+		if msg.PreVerified() && committee != nil {
+			rpcMessage.Signers = signerAddresses(msg.Signers().FlattenUniq(), committee)
+		}
+	}
+	return rpcMessage
+}
+
+func signerAddresses(indexes []int, committee *types.Committee) []common.Address {
+	if committee == nil {
+		return nil
+	}
+	addresses := make([]common.Address, 0, len(indexes))
+	for _, i := range indexes {
+		if i < 0 || i >= committee.Len() {
+			continue
+		}
+		addresses = append(addresses, committee.Members[i].Address)
+	}
+	return addresses
+}
+
+// PendingEvents returns the accountability events waiting to be reported on-chain by the local validator.
+func (fd *FaultDetector) PendingEvents() []*autonity.AccountabilityEvent {
+	fd.pendingEventsMu.RLock()
+	defer fd.pendingEventsMu.RUnlock()
+	return append([]*autonity.AccountabilityEvent(nil), fd.pendingEvents...)
+}
+
+// OffChainAccusations returns the accusations raised off-chain by the local validator which are still waiting for a
+// proof of innocence.
+func (fd *FaultDetector) OffChainAccusations() []*Proof {
+	fd.offChainAccusationsMu.RLock()
+	defer fd.offChainAccusationsMu.RUnlock()
+	return append([]*Proof(nil), fd.offChainAccusations...)
+}
@@ -0,0 +1,96 @@
+package accountability
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/autonity/autonity/autonity"
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/consensus/tendermint/core/message"
+	"github.com/autonity/autonity/core/vm"
+	"github.com/autonity/autonity/rlp"
+)
+
+func TestNewRPCProof(t *testing.T) {
+	proof := Proof{
+		Type:          autonity.Misbehaviour,
+		Rule:          autonity.PN,
+		Message:       defLightNewProposal,
+		OffenderIndex: proposerIdx,
+		Evidences:     []message.Msg{aggPrevote, aggPrecommit},
+	}
+	decode := func(p *Proof) *Proof {
+		raw, err := rlp.EncodeToBytes(p)
+		require.NoError(t, err)
+		decoded, err := decodeRawProof(raw)
+		require.NoError(t, err)
+		return decoded
+	}
+
+	t.Run("valid proof", func(t *testing.T) {
+		rpcProof := NewRPCProof(decode(&proof), committee)
+		require.Empty(t, rpcProof.Error)
+		require.Equal(t, autonity.Misbehaviour.String(), rpcProof.Type)
+		require.Equal(t, "PN", rpcProof.Rule)
+		require.Equal(t, committee.Members[proposerIdx].Address, *rpcProof.Offender)
+		require.Equal(t, "proposal", rpcProof.Message.Type)
+		require.Equal(t, defLightNewProposal.Value(), rpcProof.Message.Value)
+		require.Equal(t, int64(-1), *rpcProof.Message.ValidRound)
+		require.Len(t, rpcProof.Evidences, 2)
+		require.Equal(t, "prevote", rpcProof.Evidences[0].Type)
+		require.Equal(t, "precommit", rpcProof.Evidences[1].Type)
+		require.ElementsMatch(t, []common.Address{committee.Members[0].Address, committee.Members[1].Address}, rpcProof.Evidences[0].Signers)
+		require.Nil(t, rpcProof.DistinctPrecommits)
+	})
+
+	t.Run("unknown committee", func(t *testing.T) {
+		rpcProof := NewRPCProof(decode(&proof), nil)
+		require.NotEmpty(t, rpcProof.Error)
+		require.Nil(t, rpcProof.Offender)
+		require.Empty(t, rpcProof.Evidences[0].Signers)
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		invalid := proof
+		invalid.Evidences = []message.Msg{invalidPrecommit}
+		rpcProof := NewRPCProof(decode(&invalid), committee)
+		require.Equal(t, message.ErrBadSignature.Error(), rpcProof.Error)
+	})
+}
+
+func TestStatusResolverInnocenceProven(t *testing.T) {
+	offender := committee.Members[proposerIdx].Address
+	accusation := func(rule autonity.Rule, msg message.Msg) accusationKey {
+		raw, err := rlp.EncodeToBytes(&Proof{Type: autonity.Accusation, Rule: rule, Message: msg, OffenderIndex: proposerIdx})
+		require.NoError(t, err)
+		key, err := newAccusationKey(offender, raw)
+		require.NoError(t, err)
+		return key
+	}
+	pvn := accusation(autonity.PVN, prevote1)
+	require.Equal(t, accusationKey{offender, autonity.PVN, height, defRound, prevote1.Hash()}, pvn)
+
+	r := &statusResolver{innocences: map[accusationKey][]uint64{pvn: {110}}, window: 20}
+	require.True(t, r.innocenceProven(pvn, 100))
+	// the proof must have been accepted within the window of the accusation
+	require.False(t, r.innocenceProven(pvn, 111))
+	require.False(t, r.innocenceProven(pvn, 90))
+	// another accusation against the same offender is not cleared by the proof
+	require.False(t, r.innocenceProven(accusation(autonity.PVO, prevote1), 100))
+	require.False(t, r.innocenceProven(accusation(autonity.C1, precommit1), 100))
+}
+
+type rpcError struct{ code int }
+
+func (e rpcError) Error() string  { return "rpc error" }
+func (e rpcError) ErrorCode() int { return e.code }
+
+func TestIsRevert(t *testing.T) {
+	require.True(t, isRevert(vm.ErrExecutionReverted))
+	require.True(t, isRevert(fmt.Errorf("call: %w", rpcError{code: 3})))
+	require.False(t, isRevert(rpcError{code: -32000}))
+	require.False(t, isRevert(fmt.Errorf("connection refused")))
+	require.False(t, isRevert(nil))
+}
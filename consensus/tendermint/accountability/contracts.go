@@ -1,6 +1,7 @@
 package accountability
 
 import (
+	"bytes"
 	"errors"
 	"github.com/autonity/autonity/params/generated"
 	"math"
@@ -13,6 +14,7 @@ import (
 	"github.com/autonity/autonity/autonity"
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/consensus/tendermint/bft"
+	tdmcommittee "github.com/autonity/autonity/consensus/tendermint/core/committee"
 	"github.com/autonity/autonity/consensus/tendermint/core/message"
 	"github.com/autonity/autonity/core/vm"
 	"github.com/autonity/autonity/params"
@@ -35,6 +37,8 @@ var (
 	errProofOffender       = errors.New("accountability proof contains invalid offender")
 	errProofMsgCode        = errors.New("accountability proof contains invalid msg code")
 	errMaxEvidences        = errors.New("above max evidence threshold")
+	errCommittedValue      = errors.New("accused message is for the committed value")
+	errRuleNotProven       = errors.New("proof does not prove its rule")
 )
 
 const KB = 1024
@@ -117,14 +121,21 @@ func (a *AccusationVerifier) Run(input []byte, blockNumber uint64, e *vm.EVM, _
 		return failureReturn, err
 	}
 
-	if err = verifyProofSignatures(committee, p); err != nil {
-		return failureReturn, nil
+	result, _ := a.validateAccusation(p, committee)
+	return result, nil
+}
+
+// validateAccusation checks the signatures and the rule of an accusation against the committee of its height. The
+// error explains why the accusation is rejected.
+func (a *AccusationVerifier) validateAccusation(p *Proof, committee *types.Committee) ([]byte, error) {
+	if err := verifyProofSignatures(committee, p); err != nil {
+		return failureReturn, err
 	}
-	if verifyAccusation(p, committee) {
-		// the proof carry valid info.
-		return validReturn(p.Message, committee.Members[p.OffenderIndex].Address, p.Rule), nil
+	if !verifyAccusation(p, committee) {
+		return failureReturn, errRuleNotProven
 	}
-	return failureReturn, nil
+	// the proof carry valid info.
+	return validReturn(p.Message, committee.Members[p.OffenderIndex].Address, p.Rule), nil
 }
 
 // validate the submitted accusation by the contract call.
@@ -240,14 +251,26 @@ func (c *MisbehaviourVerifier) Run(input []byte, _ uint64, e *vm.EVM, _ common.A
 		return failureReturn, err
 	}
 
-	if err = verifyProofSignatures(committee, p); err != nil {
-		return failureReturn, nil
+	result, _ := c.validateMisbehaviour(p, committee, e.ChainConfig())
+	return result, nil
+}
+
+// validateMisbehaviour checks the signatures and the rule of a misbehaviour proof against the committee of its
+// height. The error explains why the proof is rejected.
+func (c *MisbehaviourVerifier) validateMisbehaviour(p *Proof, committee *types.Committee, config *params.ChainConfig) ([]byte, error) {
+	if err := verifyProofSignatures(committee, p); err != nil {
+		return failureReturn, err
+	}
+	result := c.validateFault(p, committee, config)
+	if !bytes.Equal(result, failureReturn) {
+		return result, nil
 	}
-	return c.validateFault(p, committee), nil
+	return result, errRuleNotProven
 }
 
-// validate a misbehavior proof, doesn't check the proof signatures.
-func (c *MisbehaviourVerifier) validateFault(p *Proof, committee *types.Committee) []byte {
+// validate a misbehavior proof, doesn't check the proof signatures. The chain config selects the proposer election
+// strategy.
+func (c *MisbehaviourVerifier) validateFault(p *Proof, committee *types.Committee, config *params.ChainConfig) []byte {
 	valid := false
 	switch p.Rule {
 	case autonity.PN:
@@ -264,8 +287,8 @@ func (c *MisbehaviourVerifier) validateFault(p *Proof, committee *types.Committe
 		valid = c.validMisbehaviourOfC(p, committee)
 	case autonity.InvalidProposer:
 		if lightProposal, ok := p.Message.(*message.LightProposal); ok {
-			proposer := committee.Proposer(lightProposal.H()-1, lightProposal.R())
-			valid = (proposer != lightProposal.Signer()) && (committee.Members[p.OffenderIndex].Address == lightProposal.Signer())
+			proposer, err := tdmcommittee.Proposer(config, committee, lightProposal.H()-1, lightProposal.R())
+			valid = err == nil && (proposer != lightProposal.Signer()) && (committee.Members[p.OffenderIndex].Address == lightProposal.Signer())
 
 		}
 	case autonity.Equivocation:
@@ -651,12 +674,18 @@ func (c *InnocenceVerifier) Run(input []byte, blockNumber uint64, e *vm.EVM, _ c
 		return failureReturn, err
 	}
 
-	if err = verifyProofSignatures(committee, p); err != nil {
-		return failureReturn, nil
-	}
+	result, _ := c.validateInnocence(p, committee)
+	return result, nil
+}
 
+// validateInnocence checks the signatures and the rule of a proof of innocence against the committee of its height.
+// The error explains why the proof is rejected.
+func (c *InnocenceVerifier) validateInnocence(p *Proof, committee *types.Committee) ([]byte, error) {
+	if err := verifyProofSignatures(committee, p); err != nil {
+		return failureReturn, err
+	}
 	if !verifyInnocenceProof(p, committee) {
-		return failureReturn, nil
+		return failureReturn, errRuleNotProven
 	}
 	return validReturn(p.Message, committee.Members[p.OffenderIndex].Address, p.Rule), nil
 }
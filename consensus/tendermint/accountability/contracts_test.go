@@ -1164,7 +1164,7 @@ func TestMisbehaviourVerifier(t *testing.T) {
 	mv := MisbehaviourVerifier{}
 	for _, tc := range tests {
 		proof := tc.proof
-		ret := mv.validateFault(&proof, committee)
+		ret := mv.validateFault(&proof, committee, nil)
 		if !bytes.Equal(tc.outCome, ret) {
 			t.Log("TestMisbehaviourVerifier", "case", tc.index, "config", tc, "expected", tc.outCome, "actual", ret)
 		}
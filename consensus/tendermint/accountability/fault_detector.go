@@ -14,8 +14,10 @@ import (
 	"github.com/autonity/autonity/autonity"
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/consensus"
+	"github.com/autonity/autonity/consensus/tendermint/alerts"
 	"github.com/autonity/autonity/consensus/tendermint/bft"
 	engineCore "github.com/autonity/autonity/consensus/tendermint/core"
+	tdmcommittee "github.com/autonity/autonity/consensus/tendermint/core/committee"
 	"github.com/autonity/autonity/consensus/tendermint/core/message"
 	"github.com/autonity/autonity/consensus/tendermint/events"
 	"github.com/autonity/autonity/core"
@@ -99,12 +101,15 @@ type FaultDetector struct {
 	chainEventSub event.Subscription
 
 	misbehaviourProofCh chan *autonity.AccountabilityEvent
+	pendingEventsMu     sync.RWMutex                    // guards pendingEvents writes, which happen in the rule engine only.
 	pendingEvents       []*autonity.AccountabilityEvent // accountability event buffer.
 
 	offChainAccusationsMu sync.RWMutex
-	offChainAccusations   []*Proof // off chain accusations list, ordered in chain height from low to high.
+	offChainAccusations   []*Proof            // off chain accusations list, ordered in chain height from low to high.
+	undelivered           map[*Proof]struct{} // off chain accusations waiting for a connection to their suspect.
 	broadcaster           consensus.Broadcaster
 
+	alerts *alerts.Notifier // notifies the operator of the accusations against the local node, nil if disabled
 	logger log.Logger
 }
 
@@ -158,6 +163,11 @@ func NewFaultDetector(
 	return fd
 }
 
+// SetAlerts sets the notifier of the operator alerts. It must be called before the fault detector is started.
+func (fd *FaultDetector) SetAlerts(notifier *alerts.Notifier) {
+	fd.alerts = notifier
+}
+
 // Start listen for new block events from blockchain, do the tasks like take challenge and provide Proof for innocent, the
 // Fault Detector rule engine could also trigger from here to scan those msgs of msg store by applying rules.
 func (fd *FaultDetector) Start() {
@@ -275,17 +285,21 @@ loop:
 				break loop
 			}
 
-			// try to escalate expired off chain accusation on chain.
+			// try to deliver the pending off chain accusations, then to escalate the expired ones on chain.
+			fd.resendOffChainAccusations()
 			fd.escalateExpiredAccusations(ev.Block.NumberU64())
 
 			// run rule engine over a specific height.
 			if ev.Block.NumberU64() > uint64(DeltaBlocks) {
 				checkpoint := ev.Block.NumberU64() - uint64(DeltaBlocks)
 				if events := fd.runRuleEngine(checkpoint); len(events) > 0 {
-					fd.pendingEvents = append(fd.pendingEvents, events...)
+					fd.addPendingEvents(events...)
 				}
 				if len(fd.pendingEvents) != 0 && fd.canReport(checkpoint) {
-					fd.pendingEvents = fd.reportEvents(fd.pendingEvents)
+					remaining := fd.reportEvents(fd.pendingEvents)
+					fd.pendingEventsMu.Lock()
+					fd.pendingEvents = remaining
+					fd.pendingEventsMu.Unlock()
 				}
 			}
 			// msg store delete msgs out of buffering window on every 60 blocks.
@@ -297,27 +311,26 @@ loop:
 				// this should never happen
 				fd.logger.Crit("Can't retrieve accountability event", "id", accusation.Id.Uint64())
 			}
-			decodedProof, err := decodeRawProof(accusationEvent.RawProof)
-			if err != nil {
-				fd.logger.Error("Can't decode accusation", "err", err)
-				break
+			// the operator is alerted of the accusation even if it cannot be processed
+			innocenceProof, err := fd.accusationInnocenceProof(accusationEvent.RawProof)
+			details := map[string]interface{}{
+				"eventId":             accusation.Id.Uint64(),
+				"rule":                autonity.Rule(accusationEvent.Rule).String(),
+				"block":               accusationEvent.Block.Uint64(),
+				"epoch":               accusationEvent.Epoch.Uint64(),
+				"severity":            accusation.Severity,
+				"innocenceProofFound": err == nil && innocenceProof != nil,
 			}
-
-			h := decodedProof.Message.H()
-			committee, err := fd.blockchain.CommitteeOfHeight(h)
 			if err != nil {
-				fd.logger.Error("Can't retrieve committee for message", "err", err, "height", h)
-				break
-			}
-
-			// The signatures must be valid at this stage, however we have to recover the original
-			// senders, hence the following call.
-			if err = verifyProofSignatures(committee, decodedProof); err != nil {
-				fd.logger.Error("Can't verify proof signatures", "err", err)
-				break
+				details["error"] = err.Error()
 			}
-
-			innocenceProof, err := fd.innocenceProof(decodedProof, committee)
+			fd.alerts.Notify(&alerts.Alert{
+				Kind:      alerts.Accusation,
+				Validator: fd.address,
+				Block:     accusation.Raw.BlockNumber,
+				Message:   "Your validator has been accused of consensus misbehaviour: " + autonity.Rule(accusationEvent.Rule).Explanation(),
+				Details:   details,
+			})
 			if err == nil && innocenceProof != nil {
 				// send on chain innocence proof ASAP since the client is on challenge that requires the proof to be
 				// provided before the client get slashed.
@@ -338,7 +351,7 @@ loop:
 			if !ok {
 				break loop
 			}
-			fd.pendingEvents = append(fd.pendingEvents, m)
+			fd.addPendingEvents(m)
 		case err, ok := <-fd.ruleEngineBlockSub.Err():
 			if ok {
 				// youssef: how can that happen?
@@ -349,6 +362,34 @@ loop:
 	}
 }
 
+// accusationInnocenceProof retrieves the innocence proof of the local node for the raw proof of an accusation.
+func (fd *FaultDetector) accusationInnocenceProof(rawProof []byte) (*autonity.AccountabilityEvent, error) {
+	decodedProof, err := decodeRawProof(rawProof)
+	if err != nil {
+		return nil, fmt.Errorf("can't decode accusation: %w", err)
+	}
+
+	h := decodedProof.Message.H()
+	committee, err := fd.blockchain.CommitteeOfHeight(h)
+	if err != nil {
+		return nil, fmt.Errorf("can't retrieve committee for height %d: %w", h, err)
+	}
+
+	// The signatures must be valid at this stage, however we have to recover the original
+	// senders, hence the following call.
+	if err = verifyProofSignatures(committee, decodedProof); err != nil {
+		return nil, fmt.Errorf("can't verify proof signatures: %w", err)
+	}
+	return fd.innocenceProof(decodedProof, committee)
+}
+
+// addPendingEvents buffers events until the reporting slot of the local validator.
+func (fd *FaultDetector) addPendingEvents(events ...*autonity.AccountabilityEvent) {
+	fd.pendingEventsMu.Lock()
+	defer fd.pendingEventsMu.Unlock()
+	fd.pendingEvents = append(fd.pendingEvents, events...)
+}
+
 // canReport assign the validator a dedicated time-window to submit the accountability event
 // todo(youssef): this needs to be thoroughly verified accounting for edge cases scenarios at
 // the epoch limit. Also the contract side enforcement is missing.
@@ -1413,7 +1454,11 @@ func isProposerValid(chain ChainContext, m message.Msg) bool {
 	if err != nil {
 		panic(fmt.Sprintf("cannot get committee of height: %d", m.H()))
 	}
-	proposer := chain.ProtocolContracts().Proposer(committee, nil, m.H()-1, m.R())
+	elect := tdmcommittee.StrategyAt(chain.Config(), m.H()).Proposer
+	proposer, err := chain.ProtocolContracts().Proposer(committee, m.H()-1, m.R(), elect)
+	if err != nil {
+		return false
+	}
 	signer := m.(*message.Propose).Signer()
 	return signer == proposer
 }
@@ -3,13 +3,15 @@ package accountability
 import (
 	"errors"
 	"fmt"
+	"slices"
 
+	"github.com/autonity/autonity/consensus/acn/codes"
 	"github.com/autonity/autonity/core/types"
 	"github.com/autonity/autonity/rlp"
 
 	"github.com/autonity/autonity/autonity"
 	"github.com/autonity/autonity/common"
-	"github.com/autonity/autonity/consensus/tendermint/backend"
+	"github.com/autonity/autonity/consensus"
 	"github.com/autonity/autonity/crypto"
 	"github.com/autonity/autonity/eth/protocols/eth"
 )
@@ -300,6 +302,7 @@ func (fd *FaultDetector) removeOffChainAccusation(innocenceProof *Proof) {
 		if fd.offChainAccusations[i].Rule == innocenceProof.Rule && fd.offChainAccusations[i].Type == autonity.Accusation &&
 			fd.offChainAccusations[i].Message.Hash() == innocenceProof.Message.Hash() {
 			// release the event's memory
+			delete(fd.undelivered, fd.offChainAccusations[i])
 			fd.offChainAccusations[i] = nil
 			find = true
 			break
@@ -338,7 +341,7 @@ func (fd *FaultDetector) escalateExpiredAccusations(currentChainHeight uint64) {
 		fd.removeOffChainAccusation(accusation)
 		p := fd.eventFromProof(accusation, offender)
 		// push it to the on chain accountability event list
-		fd.pendingEvents = append(fd.pendingEvents, p)
+		fd.addPendingEvents(p)
 	}
 }
 
@@ -353,10 +356,18 @@ func (fd *FaultDetector) sendOffChainAccusationMsg(accusation *Proof, committee
 	target := committee.Members[accusation.OffenderIndex].Address
 	peer, ok := fd.broadcaster.FindPeer(target)
 	if !ok {
-		//todo: if we need to gossip this message in case of there are no direct peer connection.
+		// in relay mode, the suspect is not a peer unless it is a neighbour in the committee graph, the accusation is
+		// sent again once connected to it directly.
+		if dialer, ok := fd.broadcaster.(consensus.Dialer); ok {
+			fd.logger.Debug("Connecting directly to suspect", "suspect", target)
+			fd.setUndelivered(accusation, true)
+			dialer.Dial(target)
+			return
+		}
 		fd.logger.Debug("No direct p2p connection with suspect")
 		return
 	}
+	fd.setUndelivered(accusation, false)
 
 	rProof, err := rlp.EncodeToBytes(accusation)
 	if err != nil {
@@ -365,7 +376,43 @@ func (fd *FaultDetector) sendOffChainAccusationMsg(accusation *Proof, committee
 	}
 
 	fd.logger.Info("Attempting direct p2p resolution..", "suspect", target)
-	go peer.Send(backend.AccountabilityNetworkMsg, rProof) //nolint
+	go peer.Send(codes.AccountabilityNetworkMsg, rProof) //nolint
+}
+
+// setUndelivered flags the off chain accusation as waiting for a connection to its suspect, or not. The accusations
+// already resolved or escalated are not flagged.
+func (fd *FaultDetector) setUndelivered(accusation *Proof, undelivered bool) {
+	fd.offChainAccusationsMu.Lock()
+	defer fd.offChainAccusationsMu.Unlock()
+	if !undelivered {
+		delete(fd.undelivered, accusation)
+		return
+	}
+	if !slices.Contains(fd.offChainAccusations, accusation) {
+		return
+	}
+	if fd.undelivered == nil {
+		fd.undelivered = make(map[*Proof]struct{})
+	}
+	fd.undelivered[accusation] = struct{}{}
+}
+
+// resendOffChainAccusations sends again the off chain accusations which could not be delivered to their suspects.
+func (fd *FaultDetector) resendOffChainAccusations() {
+	fd.offChainAccusationsMu.RLock()
+	undelivered := make([]*Proof, 0, len(fd.undelivered))
+	for accusation := range fd.undelivered {
+		undelivered = append(undelivered, accusation)
+	}
+	fd.offChainAccusationsMu.RUnlock()
+	for _, accusation := range undelivered {
+		committee, err := fd.blockchain.CommitteeOfHeight(accusation.Message.H())
+		if err != nil {
+			fd.logger.Debug("Cannot get committee of accusation", "height", accusation.Message.H(), "err", err)
+			continue
+		}
+		fd.sendOffChainAccusationMsg(accusation, committee)
+	}
 }
 
 // sendOffChainInnocenceProof, send an innocence proof to receiver peer.
@@ -382,5 +429,5 @@ func (fd *FaultDetector) sendOffChainInnocenceProof(receiver common.Address, pay
 	}
 
 	fd.logger.Info("Sending requested innocence proof", "addr", receiver)
-	go peer.Send(backend.AccountabilityNetworkMsg, payload) //nolint
+	go peer.Send(codes.AccountabilityNetworkMsg, payload) //nolint
 }
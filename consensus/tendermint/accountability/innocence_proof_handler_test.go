@@ -12,7 +12,7 @@ import (
 	"github.com/autonity/autonity/autonity"
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/consensus"
-	"github.com/autonity/autonity/consensus/tendermint/backend"
+	"github.com/autonity/autonity/consensus/acn/codes"
 	"github.com/autonity/autonity/consensus/tendermint/core"
 	"github.com/autonity/autonity/consensus/tendermint/core/message"
 	ccore "github.com/autonity/autonity/core"
@@ -138,7 +138,7 @@ func TestFaultDetector_sendOffChainInnocenceProof(t *testing.T) {
 	payload := make([]byte, 128)
 
 	mockedPeer := consensus.NewMockPeer(ctrl)
-	mockedPeer.EXPECT().Send(backend.AccountabilityNetworkMsg, payload).MaxTimes(1)
+	mockedPeer.EXPECT().Send(codes.AccountabilityNetworkMsg, payload).MaxTimes(1)
 	peers := make(map[common.Address]consensus.Peer)
 	peers[remotePeer] = mockedPeer
 	broadcasterMock.EXPECT().FindPeer(remotePeer).Return(mockedPeer, true)
@@ -175,7 +175,7 @@ func TestFaultDetector_sendOffChainAccusationMsg(t *testing.T) {
 	require.NoError(t, err)
 
 	mockedPeer := consensus.NewMockPeer(ctrl)
-	mockedPeer.EXPECT().Send(backend.AccountabilityNetworkMsg, payload).MaxTimes(1)
+	mockedPeer.EXPECT().Send(codes.AccountabilityNetworkMsg, payload).MaxTimes(1)
 	peers := make(map[common.Address]consensus.Peer)
 	peers[remotePeer] = mockedPeer
 	broadcasterMock.EXPECT().FindPeer(remotePeer).Return(mockedPeer, true)
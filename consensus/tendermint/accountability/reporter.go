@@ -4,6 +4,7 @@ import (
 	"errors"
 	"github.com/autonity/autonity/autonity"
 	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/consensus/tendermint/alerts"
 	"github.com/autonity/autonity/core/types"
 )
 
@@ -104,6 +105,18 @@ func (fd *FaultDetector) eventReporter() {
 
 		if err == nil {
 			fd.logger.Info("Accountability transaction sent", "tx", tx.Hash(), "gas", tx.Gas(), "size", tx.Size())
+			if event.EventType == uint8(autonity.Innocence) {
+				fd.alerts.Notify(&alerts.Alert{
+					Kind:      alerts.InnocenceProofSubmitted,
+					Validator: fd.address,
+					Block:     fd.blockchain.CurrentBlock().NumberU64(),
+					Message:   "An innocence proof answering the accusation against your validator has been submitted",
+					Details: map[string]interface{}{
+						"rule":        autonity.Rule(event.Rule).String(),
+						"transaction": tx.Hash(),
+					},
+				})
+			}
 		} else {
 			fd.logger.Error("Cannot submit accountability transaction", "err", err)
 		}
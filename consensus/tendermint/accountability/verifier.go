@@ -0,0 +1,177 @@
+package accountability
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/autonity/autonity/autonity"
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/consensus/tendermint/core/message"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/core/vm"
+	"github.com/autonity/autonity/params"
+)
+
+// SignatureCheck is the outcome of one of the signature checks performed on a proof before it gets verified.
+type SignatureCheck struct {
+	Name   string `json:"name"`
+	Passed bool   `json:"passed"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Verdict is the result that one of the verifier precompiles would return for a proof.
+type Verdict struct {
+	Verifier    string          `json:"verifier"`
+	Valid       bool            `json:"valid"`
+	Offender    *common.Address `json:"offender,omitempty"`
+	Rule        string          `json:"rule,omitempty"`
+	Block       uint64          `json:"block,omitempty"`
+	MessageHash *common.Hash    `json:"messageHash,omitempty"`
+	Reason      string          `json:"reason,omitempty"`
+}
+
+// VerificationReport is the outcome of the offline verification of a raw accountability proof.
+type VerificationReport struct {
+	Proof      *RPCProof        `json:"proof"`
+	Signatures []SignatureCheck `json:"signatures"`
+	Verdicts   []Verdict        `json:"verdicts"`
+}
+
+// DecodeRawProof decodes a raw accountability proof, as submitted to the Accountability contract.
+func DecodeRawProof(rawProof []byte) (*Proof, error) {
+	return decodeRawProof(rawProof)
+}
+
+// VerifyProof verifies a raw accountability proof outside the EVM, with the same checks as the MisbehaviourVerifier,
+// AccusationVerifier and InnocenceVerifier precompiles. The committee is the one of the proof's height. The
+// blockNumber is the block in which the proof is submitted and getHash resolves the canonical block hashes, they are
+// used by the checks of the AccusationVerifier which depend on the chain: these checks are skipped if blockNumber is
+// 0 or getHash is nil respectively. The chain config selects the proposer election strategy, the default one is used if
+// it is nil.
+func VerifyProof(rawProof []byte, committee *types.Committee, config *params.ChainConfig, blockNumber uint64, getHash vm.GetHashFunc) (*VerificationReport, error) {
+	// each check works on its own copy of the proof, as the messages cache the outcome of their validation.
+	decode := func() *Proof {
+		p, _ := decodeRawProof(rawProof)
+		return p
+	}
+	if _, err := decodeRawProof(rawProof); err != nil {
+		return nil, fmt.Errorf("invalid proof: %w", err)
+	}
+	if committee == nil {
+		return nil, errors.New("missing committee")
+	}
+	report := &VerificationReport{
+		Proof:      NewRPCProof(decode(), committee),
+		Signatures: signatureChecks(decode(), committee),
+	}
+
+	misbehaviour, err := new(MisbehaviourVerifier).validateMisbehaviour(decode(), committee, config)
+	report.Verdicts = append(report.Verdicts, newVerdict("MisbehaviourVerifier", misbehaviour, err))
+
+	accusation, err := verifyAccusationOffline(decode(), committee, blockNumber, getHash)
+	report.Verdicts = append(report.Verdicts, newVerdict("AccusationVerifier", accusation, err))
+
+	innocence, err := new(InnocenceVerifier).validateInnocence(decode(), committee)
+	report.Verdicts = append(report.Verdicts, newVerdict("InnocenceVerifier", innocence, err))
+	return report, nil
+}
+
+// verifyAccusationOffline runs the checks of AccusationVerifier.Run which can be resolved without the EVM.
+func verifyAccusationOffline(p *Proof, committee *types.Committee, blockNumber uint64, getHash vm.GetHashFunc) ([]byte, error) {
+	if blockNumber != 0 {
+		if err := preVerifyAccusation(p.Message, blockNumber); err != nil {
+			return failureReturn, err
+		}
+	}
+	if getHash != nil && getHash(p.Message.H()) == p.Message.Value() {
+		return failureReturn, errCommittedValue
+	}
+	return new(AccusationVerifier).validateAccusation(p, committee)
+}
+
+func newVerdict(verifier string, result []byte, err error) Verdict {
+	verdict := Verdict{Verifier: verifier}
+	if err != nil || !bytes.Equal(result[:32], successResult) {
+		if err == nil {
+			err = errRuleNotProven
+		}
+		verdict.Reason = err.Error()
+		return verdict
+	}
+	offender := common.BytesToAddress(result[32:64])
+	messageHash := common.BytesToHash(result[128:160])
+	verdict.Valid = true
+	verdict.Offender = &offender
+	verdict.Rule = autonity.Rule(new(big.Int).SetBytes(result[64:96]).Uint64()).String()
+	verdict.Block = new(big.Int).SetBytes(result[96:128]).Uint64()
+	verdict.MessageHash = &messageHash
+	return verdict
+}
+
+// signatureChecks performs the checks of verifyProofSignatures one by one, without stopping at the first failure.
+func signatureChecks(p *Proof, committee *types.Committee) []SignatureCheck {
+	var checks []SignatureCheck
+	check := func(name string, err error) {
+		c := SignatureCheck{Name: name, Passed: err == nil}
+		if err != nil {
+			c.Error = err.Error()
+		}
+		checks = append(checks, c)
+	}
+	validate := func(m message.Msg) error {
+		if err := m.PreValidate(committee); err != nil {
+			return err
+		}
+		return m.Validate()
+	}
+
+	validOffender := p.OffenderIndex >= 0 && p.OffenderIndex < committee.Len()
+	if !validOffender {
+		check("offender index", errInvalidOffenderIdx)
+	} else {
+		check("offender index", nil)
+	}
+	messageErr := validate(p.Message)
+	check("message", messageErr)
+
+	if len(p.Evidences) > maxEvidenceMessages(committee.Len()) {
+		check("number of evidences", errMaxEvidences)
+	}
+	for i, m := range p.Evidences {
+		name := fmt.Sprintf("evidence %d", i)
+		if m.H() != p.Message.H() {
+			check(name, errBadHeight)
+			continue
+		}
+		check(name, validate(m))
+	}
+	if p.DistinctPrecommits.Len() > 0 {
+		err := p.DistinctPrecommits.PreValidate(committee, p.Message.H())
+		if err == nil {
+			err = p.DistinctPrecommits.Validate()
+		}
+		check("distinct precommits", err)
+	}
+
+	// the signers of a message are only known once it is pre-validated
+	if !validOffender || messageErr != nil {
+		return checks
+	}
+	var err error
+	switch m := p.Message.(type) {
+	case *message.LightProposal:
+		if committee.Members[p.OffenderIndex].Address != m.Signer() {
+			err = errProofOffender
+		}
+	case *message.Prevote, *message.Precommit:
+		if !p.Message.(message.Vote).Signers().Contains(p.OffenderIndex) {
+			err = errProofOffender
+		}
+	default:
+		err = errProofMsgCode
+	}
+	check("offender signed message", err)
+	return checks
+}
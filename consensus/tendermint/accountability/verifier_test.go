@@ -0,0 +1,93 @@
+package accountability
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/autonity/autonity/autonity"
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/consensus/tendermint/core/message"
+	"github.com/autonity/autonity/rlp"
+)
+
+func TestVerifyProof(t *testing.T) {
+	equivocatedPrevote := newValidatedPrevote(defRound, height, noneNilValue, signer, self, cSize)
+
+	t.Run("valid misbehaviour", func(t *testing.T) {
+		raw, err := rlp.EncodeToBytes(&Proof{
+			Type:          autonity.Misbehaviour,
+			Rule:          autonity.Equivocation,
+			Message:       prevote1,
+			OffenderIndex: proposerIdx,
+			Evidences:     []message.Msg{equivocatedPrevote},
+		})
+		require.NoError(t, err)
+
+		report, err := VerifyProof(raw, committee, nil, 0, nil)
+		require.NoError(t, err)
+		require.Empty(t, report.Proof.Error)
+		for _, check := range report.Signatures {
+			require.True(t, check.Passed, check.Name)
+		}
+		require.Len(t, report.Verdicts, 3)
+		misbehaviour := report.Verdicts[0]
+		require.Equal(t, "MisbehaviourVerifier", misbehaviour.Verifier)
+		require.True(t, misbehaviour.Valid)
+		require.Equal(t, committee.Members[proposerIdx].Address, *misbehaviour.Offender)
+		require.Equal(t, autonity.Equivocation.String(), misbehaviour.Rule)
+		require.Equal(t, height, misbehaviour.Block)
+		require.Equal(t, prevote1.Hash(), *misbehaviour.MessageHash)
+		require.False(t, report.Verdicts[1].Valid)
+		require.False(t, report.Verdicts[2].Valid)
+	})
+
+	t.Run("accusation timing and committed value", func(t *testing.T) {
+		raw, err := rlp.EncodeToBytes(&Proof{
+			Type:          autonity.Accusation,
+			Rule:          autonity.PVN,
+			Message:       prevote1,
+			OffenderIndex: proposerIdx,
+		})
+		require.NoError(t, err)
+
+		report, err := VerifyProof(raw, committee, nil, 0, nil)
+		require.NoError(t, err)
+		require.True(t, report.Verdicts[1].Valid)
+
+		report, err = VerifyProof(raw, committee, nil, height+1, nil)
+		require.NoError(t, err)
+		require.Equal(t, errTooRecentAccusation.Error(), report.Verdicts[1].Reason)
+
+		committed := func(uint64) common.Hash { return prevote1.Value() }
+		report, err = VerifyProof(raw, committee, nil, 0, committed)
+		require.NoError(t, err)
+		require.Equal(t, errCommittedValue.Error(), report.Verdicts[1].Reason)
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		raw, err := rlp.EncodeToBytes(&Proof{
+			Type:          autonity.Misbehaviour,
+			Rule:          autonity.Equivocation,
+			Message:       prevote1,
+			OffenderIndex: proposerIdx,
+			Evidences:     []message.Msg{invalidPrecommit},
+		})
+		require.NoError(t, err)
+
+		report, err := VerifyProof(raw, committee, nil, 0, nil)
+		require.NoError(t, err)
+		require.True(t, report.Signatures[1].Passed)
+		require.Equal(t, "evidence 0", report.Signatures[2].Name)
+		require.False(t, report.Signatures[2].Passed)
+		for _, verdict := range report.Verdicts {
+			require.False(t, verdict.Valid)
+			require.Equal(t, message.ErrBadSignature.Error(), verdict.Reason)
+		}
+	})
+
+	t.Run("malformed proof", func(t *testing.T) {
+		_, err := VerifyProof([]byte{0x01, 0x02}, committee, nil, 0, nil)
+		require.Error(t, err)
+	})
+}
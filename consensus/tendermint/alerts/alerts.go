@@ -0,0 +1,154 @@
+// Package alerts notifies the operator of a validator of the events which need their attention: accusations and
+// fault proofs against the validator, slashing and jailing, and the validator falling out of the committee. The
+// alerts are delivered to the sinks configured in the node TOML: JSON webhooks, local commands and files.
+package alerts
+
+import (
+	"time"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/log"
+)
+
+// Kind is the kind of an alert.
+type Kind string
+
+const (
+	// Accusation is fired when the validator is accused on-chain, the accusation having to be answered with an
+	// innocence proof before the validator is slashed.
+	Accusation Kind = "accusation"
+	// InnocenceProofSubmitted is fired when the validator submitted an innocence proof answering an accusation.
+	InnocenceProofSubmitted Kind = "innocence-proof-submitted"
+	// FaultProofFinalized is fired when a fault proof against the validator is finalized on-chain.
+	FaultProofFinalized Kind = "fault-proof-finalized"
+	// Slashed is fired when the validator is slashed, with the amount slashed and the jail release block.
+	Slashed Kind = "slashed"
+	// JailReleased is fired when the jail release block of the validator is reached.
+	JailReleased Kind = "jail-released"
+	// CommitteeLeft is fired when the validator falls out of the committee of a new epoch.
+	CommitteeLeft Kind = "committee-left"
+)
+
+// Alert is an alert, as delivered to the sinks in JSON. Details holds the fields specific to the kind of alert.
+type Alert struct {
+	Kind      Kind                   `json:"kind"`
+	Validator common.Address         `json:"validator"`
+	Block     uint64                 `json:"block"`
+	Time      time.Time              `json:"time"`
+	Message   string                 `json:"message"`
+	Details   map[string]interface{} `json:"details,omitempty"`
+}
+
+// Sink delivers the alerts to the operator.
+type Sink interface {
+	Notify(alert *Alert) error
+	String() string
+}
+
+// Config configures the sinks the alerts are delivered to.
+type Config struct {
+	// Webhooks are the URLs the alerts are POSTed to, in JSON.
+	Webhooks []string `toml:",omitempty"`
+
+	// Exec are the commands run for each alert, the alert being written in JSON to their standard input.
+	Exec []string `toml:",omitempty"`
+
+	// Files are the files the alerts are appended to, one JSON object per line.
+	Files []string `toml:",omitempty"`
+}
+
+// Sinks returns the sinks of the configuration.
+func (c *Config) Sinks() []Sink {
+	var sinks []Sink
+	for _, url := range c.Webhooks {
+		sinks = append(sinks, NewWebhookSink(url))
+	}
+	for _, command := range c.Exec {
+		sinks = append(sinks, NewExecSink(command))
+	}
+	for _, path := range c.Files {
+		sinks = append(sinks, NewFileSink(path))
+	}
+	return sinks
+}
+
+// queueSize is the number of alerts waiting to be delivered beyond which new alerts are dropped.
+const queueSize = 64
+
+// Notifier delivers the alerts to the sinks, in the background so that a slow sink does not hold the caller. A nil
+// Notifier drops the alerts, so that the components firing alerts do not have to check whether alerting is enabled.
+type Notifier struct {
+	sinks  []Sink
+	queue  chan *Alert
+	quit   chan struct{}
+	done   chan struct{}
+	logger log.Logger
+}
+
+// New creates a notifier delivering the alerts to the given sinks. It returns nil if there is no sink.
+func New(sinks []Sink, logger log.Logger) *Notifier {
+	if len(sinks) == 0 {
+		return nil
+	}
+	n := &Notifier{
+		sinks:  sinks,
+		queue:  make(chan *Alert, queueSize),
+		quit:   make(chan struct{}),
+		done:   make(chan struct{}),
+		logger: logger,
+	}
+	go n.loop()
+	return n
+}
+
+// Notify queues an alert of the given validator for delivery. The time of the alert is set if missing.
+func (n *Notifier) Notify(alert *Alert) {
+	if n == nil {
+		return
+	}
+	if alert.Time.IsZero() {
+		alert.Time = time.Now()
+	}
+	select {
+	case n.queue <- alert:
+	case <-n.quit:
+	default:
+		n.logger.Warn("Alert queue full, dropping alert", "kind", alert.Kind, "block", alert.Block)
+	}
+}
+
+// Close stops the notifier once the queued alerts are delivered.
+func (n *Notifier) Close() {
+	if n == nil {
+		return
+	}
+	close(n.quit)
+	<-n.done
+}
+
+func (n *Notifier) loop() {
+	defer close(n.done)
+	for {
+		select {
+		case alert := <-n.queue:
+			n.deliver(alert)
+		case <-n.quit:
+			for {
+				select {
+				case alert := <-n.queue:
+					n.deliver(alert)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (n *Notifier) deliver(alert *Alert) {
+	for _, sink := range n.sinks {
+		if err := sink.Notify(alert); err != nil {
+			n.logger.Warn("Failed to deliver alert", "sink", sink, "kind", alert.Kind, "err", err)
+		}
+	}
+}
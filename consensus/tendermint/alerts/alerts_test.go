@@ -0,0 +1,91 @@
+package alerts
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/log"
+)
+
+func TestNotifier(t *testing.T) {
+	validator := common.HexToAddress("0x1")
+	received := make(chan *Alert, 2)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		alert := new(Alert)
+		if err := json.NewDecoder(r.Body).Decode(alert); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received <- alert
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	file := filepath.Join(dir, "alerts.jsonl")
+	config := Config{Webhooks: []string{server.URL}, Files: []string{file}}
+	if runtime.GOOS != "windows" {
+		config.Exec = []string{"cat > " + filepath.Join(dir, "exec.json") + " && echo $ALERT_KIND > " + filepath.Join(dir, "kind")}
+	}
+	notifier := New(config.Sinks(), log.Root())
+	require.NotNil(t, notifier)
+
+	notifier.Notify(&Alert{Kind: Slashed, Validator: validator, Block: 10, Details: map[string]interface{}{"jailReleaseBlock": 20}})
+	notifier.Notify(&Alert{Kind: JailReleased, Validator: validator, Block: 21})
+	notifier.Close()
+
+	// the queued alerts are delivered before the notifier is closed
+	require.Len(t, received, 2)
+	first := <-received
+	require.Equal(t, Slashed, first.Kind)
+	require.Equal(t, validator, first.Validator)
+	require.False(t, first.Time.IsZero())
+	require.Equal(t, float64(20), first.Details["jailReleaseBlock"])
+
+	f, err := os.Open(file)
+	require.NoError(t, err)
+	defer f.Close()
+	var kinds []Kind
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		alert := new(Alert)
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), alert))
+		kinds = append(kinds, alert.Kind)
+	}
+	require.Equal(t, []Kind{Slashed, JailReleased}, kinds)
+
+	if runtime.GOOS != "windows" {
+		kind, err := os.ReadFile(filepath.Join(dir, "kind"))
+		require.NoError(t, err)
+		require.Equal(t, string(JailReleased)+"\n", string(kind))
+		last := new(Alert)
+		raw, err := os.ReadFile(filepath.Join(dir, "exec.json"))
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(raw, last))
+		require.Equal(t, uint64(21), last.Block)
+	}
+}
+
+func TestNotifierDisabled(t *testing.T) {
+	notifier := New(nil, log.Root())
+	require.Nil(t, notifier)
+	// a nil notifier drops the alerts
+	notifier.Notify(&Alert{Kind: Accusation})
+	notifier.Close()
+}
+
+func TestWebhookError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+	require.Error(t, NewWebhookSink(server.URL).Notify(&Alert{Kind: CommitteeLeft}))
+}
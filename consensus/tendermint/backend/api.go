@@ -17,17 +17,25 @@
 package backend
 
 import (
+	"context"
 	"fmt"
 	"github.com/autonity/autonity/accounts/abi"
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/consensus"
 	"github.com/autonity/autonity/consensus/tendermint/core/interfaces"
+	"github.com/autonity/autonity/consensus/tendermint/events"
+	"github.com/autonity/autonity/consensus/tendermint/finality"
 	"github.com/autonity/autonity/core/types"
 	"github.com/autonity/autonity/params"
 	"github.com/autonity/autonity/rpc"
 	"math/big"
+	"slices"
 )
 
+// number of core events buffered per subscription. The core never waits for a subscriber: the events streamed while
+// the buffer of a lagging subscriber is full are dropped.
+const coreEventsBuffer = 256
+
 // API is a user facing RPC API to dump BFT state
 type API struct {
 	chain      consensus.ChainReader
@@ -78,3 +86,123 @@ func (api *API) GetCommitteeEnodes() []string {
 func (api *API) GetCoreState() interfaces.CoreState {
 	return api.tendermint.CoreState()
 }
+
+// CoreEventFilter selects the consensus events streamed by CoreEvents: the events of the listed types, all of them if
+// none is listed, from FromHeight and up to ToHeight when set.
+type CoreEventFilter struct {
+	Types      []events.CoreEventType `json:"types"`
+	FromHeight *uint64                `json:"fromHeight"`
+	ToHeight   *uint64                `json:"toHeight"`
+}
+
+func (f *CoreEventFilter) validate() error {
+	for _, t := range f.Types {
+		if !slices.Contains(events.CoreEventTypes, t) {
+			return fmt.Errorf("unknown event type %q", t)
+		}
+	}
+	if f.FromHeight != nil && f.ToHeight != nil && *f.FromHeight > *f.ToHeight {
+		return fmt.Errorf("from height %d is above to height %d", *f.FromHeight, *f.ToHeight)
+	}
+	return nil
+}
+
+func (f *CoreEventFilter) matches(ev events.CoreEvent) bool {
+	if len(f.Types) > 0 && !slices.Contains(f.Types, ev.Type) {
+		return false
+	}
+	if f.FromHeight != nil && ev.Height < *f.FromHeight {
+		return false
+	}
+	return f.ToHeight == nil || ev.Height <= *f.ToHeight
+}
+
+// CoreEvents streams the state changes of the tendermint core matching the filter, as they happen: step
+// transitions, round changes, timeouts, proposals verified, quorums reached and commits.
+func (api *API) CoreEvents(ctx context.Context, filter *CoreEventFilter) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+	if filter == nil {
+		filter = new(CoreEventFilter)
+	}
+	if err := filter.validate(); err != nil {
+		return nil, err
+	}
+
+	rpcSub := notifier.CreateSubscription()
+	coreEvents := make(chan events.CoreEvent, coreEventsBuffer)
+	coreEventsSub := api.tendermint.SubscribeCoreEvents(coreEvents)
+
+	go func() {
+		defer coreEventsSub.Unsubscribe()
+		for {
+			select {
+			case ev := <-coreEvents:
+				if filter.matches(ev) {
+					notifier.Notify(rpcSub.ID, ev) //nolint
+				}
+			case <-rpcSub.Err(): // client send an unsubscribe request
+				return
+			case <-notifier.Closed(): // connection dropped
+				return
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
+
+// GetFinalityProof builds a proof that the block at the given height is final. The proof starts from the epoch
+// header at the trusted height, which defaults to the genesis block, and can be checked with finality.Proof.Verify.
+func (api *API) GetFinalityProof(number rpc.BlockNumber, trusted *rpc.BlockNumber) (*finality.Proof, error) {
+	var height uint64
+	switch {
+	case number == rpc.PendingBlockNumber || number == rpc.LatestBlockNumber:
+		height = api.chain.CurrentHeader().Number.Uint64()
+	case number < 0:
+		return nil, fmt.Errorf("unsupported block number %d", number)
+	default:
+		height = uint64(number)
+	}
+	trustedHeight := uint64(0)
+	if trusted != nil {
+		if *trusted < 0 {
+			return nil, fmt.Errorf("unsupported trusted block number %d", *trusted)
+		}
+		trustedHeight = uint64(*trusted)
+	}
+	if height <= trustedHeight {
+		return nil, fmt.Errorf("block %d is not after trusted epoch block %d", height, trustedHeight)
+	}
+
+	header := api.chain.GetHeaderByNumber(height)
+	if header == nil {
+		return nil, errUnknownBlock
+	}
+	if trustedHeader := api.chain.GetHeaderByNumber(trustedHeight); trustedHeader == nil || !trustedHeader.IsEpochHeader() {
+		return nil, fmt.Errorf("block %d is not an epoch block", trustedHeight)
+	}
+	epoch, err := api.chain.EpochOfHeight(height)
+	if err != nil {
+		return nil, err
+	}
+
+	// walk back the epoch headers until reaching the trusted one
+	var epochHeaders []*types.Header
+	for cur := epoch.EpochBlock.Uint64(); cur != trustedHeight; {
+		if cur < trustedHeight {
+			return nil, fmt.Errorf("block %d is not an epoch block", trustedHeight)
+		}
+		epochHeader := api.chain.GetHeaderByNumber(cur)
+		if epochHeader == nil || !epochHeader.IsEpochHeader() {
+			return nil, fmt.Errorf("missing epoch header %d", cur)
+		}
+		epochHeaders = append(epochHeaders, epochHeader)
+		cur = epochHeader.Epoch.PreviousEpochBlock.Uint64()
+	}
+	slices.Reverse(epochHeaders)
+
+	return &finality.Proof{EpochHeaders: epochHeaders, Header: header}, nil
+}
@@ -1,14 +1,21 @@
 package backend
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/consensus/tendermint/core/interfaces"
+	"github.com/autonity/autonity/consensus/tendermint/events"
 	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/event"
 	"github.com/autonity/autonity/params"
 	"github.com/autonity/autonity/params/generated"
 	"github.com/autonity/autonity/rpc"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 )
 
 func TestGetCommittee(t *testing.T) {
@@ -79,3 +86,84 @@ func TestAPIGetContractAddress(t *testing.T) {
 	got := API.GetContractAddress()
 	assert.Equal(t, want, got)
 }
+
+func TestAPIGetFinalityProof(t *testing.T) {
+	chain, engine := newBlockChain(1)
+	block, err := makeBlock(chain, engine, chain.Genesis())
+	require.NoError(t, err)
+	_, err = chain.InsertChain(types.Blocks{block})
+	require.NoError(t, err)
+	api := &API{
+		chain:      chain,
+		tendermint: engine,
+	}
+
+	latest := rpc.LatestBlockNumber
+	proof, err := api.GetFinalityProof(latest, nil)
+	require.NoError(t, err)
+	require.Empty(t, proof.EpochHeaders)
+	header, err := proof.Verify(chain.Genesis().Header())
+	require.NoError(t, err)
+	require.Equal(t, block.Hash(), header.Hash())
+
+	_, err = api.GetFinalityProof(rpc.BlockNumber(10), nil)
+	require.Equal(t, errUnknownBlock, err)
+
+	genesis := rpc.BlockNumber(0)
+	_, err = api.GetFinalityProof(genesis, &genesis)
+	require.Error(t, err)
+
+	trusted := rpc.BlockNumber(1)
+	_, err = api.GetFinalityProof(latest, &trusted)
+	require.Error(t, err)
+}
+
+func TestAPICoreEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var feed event.Feed
+	core := interfaces.NewMockCore(ctrl)
+	core.EXPECT().SubscribeEvents(gomock.Any()).DoAndReturn(func(ch chan<- events.CoreEvent) event.Subscription {
+		return feed.Subscribe(ch)
+	}).AnyTimes()
+	server := rpc.NewServer()
+	defer server.Stop()
+	require.NoError(t, server.RegisterName("tendermint", &API{tendermint: &Backend{core: core}}))
+	client := rpc.DialInProc(server)
+	defer client.Close()
+
+	t.Run("unknown event type, error returned", func(t *testing.T) {
+		filter := &CoreEventFilter{Types: []events.CoreEventType{"vote"}}
+		_, err := client.Subscribe(context.Background(), "tendermint", make(chan events.CoreEvent), "coreEvents", filter)
+		require.Error(t, err)
+	})
+
+	t.Run("matching events streamed", func(t *testing.T) {
+		from := uint64(5)
+		filter := &CoreEventFilter{Types: []events.CoreEventType{events.CoreStep, events.CoreCommit}, FromHeight: &from}
+		received := make(chan events.CoreEvent, 4)
+		sub, err := client.Subscribe(context.Background(), "tendermint", received, "coreEvents", filter)
+		require.NoError(t, err)
+		defer sub.Unsubscribe()
+
+		require.Eventually(t, func() bool {
+			return feed.Send(events.CoreEvent{Type: events.CoreStep, Height: 4, Step: "prevote"}) > 0
+		}, time.Second, 10*time.Millisecond)
+		value := common.HexToHash("0x01")
+		feed.Send(events.CoreEvent{Type: events.CoreRound, Height: 5})
+		feed.Send(events.CoreEvent{Type: events.CoreCommit, Height: 5, Value: &value, Signers: []common.Address{{1}}})
+
+		select {
+		case ev := <-received:
+			require.Equal(t, events.CoreCommit, ev.Type)
+			require.Equal(t, uint64(5), ev.Height)
+			require.Equal(t, value, *ev.Value)
+			require.Equal(t, []common.Address{{1}}, ev.Signers)
+		case err := <-sub.Err():
+			t.Fatal(err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("commit event not streamed")
+		}
+	})
+}
@@ -1,8 +1,10 @@
 package backend
 
 import (
+	"bytes"
 	"crypto/ecdsa"
 	"errors"
+	"fmt"
 	"math"
 	"sync"
 	"sync/atomic"
@@ -15,6 +17,7 @@ import (
 	"github.com/autonity/autonity/common/fixsizecache"
 	"github.com/autonity/autonity/consensus"
 	"github.com/autonity/autonity/consensus/misc"
+	"github.com/autonity/autonity/consensus/tendermint/alerts"
 	tendermintCore "github.com/autonity/autonity/consensus/tendermint/core"
 	"github.com/autonity/autonity/consensus/tendermint/core/constants"
 	"github.com/autonity/autonity/consensus/tendermint/core/interfaces"
@@ -47,6 +50,8 @@ const (
 var (
 	// ErrStoppedEngine is returned if the engine is stopped
 	ErrStoppedEngine = errors.New("stopped engine")
+	// ErrConsensusKeyMismatch is returned if the external consensus signer holds another key than the local one
+	ErrConsensusKeyMismatch = errors.New("external signer consensus key differs from the local consensus key")
 )
 
 // New creates an Ethereum Backend for BFT core engine.
@@ -73,6 +78,7 @@ func New(nodeKey *ecdsa.PrivateKey,
 		jailed:          make(map[common.Address]uint64),
 		future:          make(map[uint64][]*events.UnverifiedMessageEvent),
 		futureMinHeight: math.MaxUint64,
+		pendingCompact:  make(map[common.Hash]*pendingCompactProposal),
 	}
 
 	backend.pendingMessages.SetCapacity(ringCapacity)
@@ -93,6 +99,13 @@ func New(nodeKey *ecdsa.PrivateKey,
 
 // ----------------------------------------------------------------------------
 
+// ConsensusSigner signs the consensus messages of the local validator with a consensus key held outside of the node,
+// such as in clef.
+type ConsensusSigner interface {
+	PublicKey() blst.PublicKey
+	SignConsensusMessage(request *message.SignatureRequest) (blst.Signature, error)
+}
+
 type Backend struct {
 	eventMux     *event.TypeMuxSilent
 	nodeKey      *ecdsa.PrivateKey
@@ -103,6 +116,15 @@ type Backend struct {
 	currentBlock func() *types.Block
 	hasBadBlock  func(hash common.Hash) bool
 
+	// if set, consensus messages are signed by the external signer instead of with consensusKey
+	consensusSigner ConsensusSigner
+
+	// write-ahead log of the consensus core, nil if disabled
+	wal *tendermintCore.WAL
+
+	// notifies the operator of the accountability events concerning the validator, nil if disabled
+	alerts *alerts.Notifier
+
 	// the channels for tendermint engine notifications
 	commitCh          chan<- *types.Block
 	messageCh         chan events.UnverifiedMessageEvent // to send events to the aggregator
@@ -140,6 +162,15 @@ type Backend struct {
 	futureMaxHeight uint64
 	futureSize      uint64
 	futureLock      sync.RWMutex
+
+	// transaction pool and compact proposals waiting for their missing transactions
+	txPool             TxPool
+	pendingCompact     map[common.Hash]*pendingCompactProposal
+	pendingCompactLock sync.Mutex
+
+	// graph neighbours the consensus messages are sent to in relay mode, for the last committee gossiped to
+	relay     relayTargets
+	relayLock sync.Mutex
 }
 
 func (sb *Backend) BlockChain() *core.BlockChain {
@@ -177,7 +208,7 @@ func (sb *Backend) AskSync(committee *types.Committee) {
 
 // Gossip implements tendermint.Backend.Gossip
 func (sb *Backend) Gossip(committee *types.Committee, msg message.Msg) {
-	sb.gossiper.Gossip(committee, msg)
+	sb.gossiper.Gossip(sb.gossipTargets(committee), msg)
 }
 
 // UpdateStopChannel implements tendermint.Backend.Gossip
@@ -358,6 +389,48 @@ func (sb *Backend) Sign(data common.Hash) blst.Signature {
 	return signature
 }
 
+// SignMessage implements interfaces.MessageSigner. Messages are signed by the consensus signer if one is set, with the
+// local consensus key otherwise.
+func (sb *Backend) SignMessage(request *message.SignatureRequest) (blst.Signature, error) {
+	if sb.consensusSigner != nil {
+		return sb.consensusSigner.SignConsensusMessage(request)
+	}
+	signatureInput, err := request.SignatureInput()
+	if err != nil {
+		return nil, err
+	}
+	return sb.Sign(signatureInput), nil
+}
+
+// SetConsensusSigner delegates the signature of consensus messages to an external signer. The signer must hold the
+// local consensus key, the one the validator is registered with, unless allowKeyMismatch is set.
+func (sb *Backend) SetConsensusSigner(signer ConsensusSigner, allowKeyMismatch bool) error {
+	if !bytes.Equal(signer.PublicKey().Marshal(), sb.consensusKey.PublicKey().Marshal()) {
+		if !allowKeyMismatch {
+			return fmt.Errorf("%w: signer key %s, local key %s", ErrConsensusKeyMismatch, signer.PublicKey().Hex(), sb.consensusKey.PublicKey().Hex())
+		}
+		sb.logger.Warn("Local consensus key differs from the external signer one, using the external signer", "key", signer.PublicKey().Hex())
+	}
+	sb.consensusSigner = signer
+	return nil
+}
+
+// SetWAL enables the write-ahead log of the consensus core. It must be called before the engine is started.
+func (sb *Backend) SetWAL(wal *tendermintCore.WAL) {
+	core, ok := sb.core.(*tendermintCore.Core)
+	if !ok {
+		sb.logger.Warn("Consensus WAL not supported by the consensus core")
+		return
+	}
+	sb.wal = wal
+	core.SetWAL(wal)
+}
+
+// SetAlerts sets the notifier of the operator alerts. It must be called before the engine is started.
+func (sb *Backend) SetAlerts(notifier *alerts.Notifier) {
+	sb.alerts = notifier
+}
+
 func (sb *Backend) HeadBlock() *types.Block {
 	return sb.currentBlock()
 }
@@ -378,6 +451,11 @@ func (sb *Backend) CoreState() interfaces.CoreState {
 	return sb.core.CoreState()
 }
 
+// SubscribeCoreEvents registers a subscription to the state changes of the tendermint core.
+func (sb *Backend) SubscribeCoreEvents(ch chan<- events.CoreEvent) event.Subscription {
+	return sb.core.SubscribeEvents(ch)
+}
+
 // CommitteeEnodes retrieve the list of validators enodes for the current block
 func (sb *Backend) CommitteeEnodes() []string {
 	header := sb.blockchain.CurrentBlock().Header()
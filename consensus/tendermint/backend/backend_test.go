@@ -20,6 +20,7 @@ import (
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/common/fixsizecache"
 	"github.com/autonity/autonity/consensus"
+	"github.com/autonity/autonity/consensus/acn/codes"
 	"github.com/autonity/autonity/consensus/misc"
 	tdmcore "github.com/autonity/autonity/consensus/tendermint/core"
 	"github.com/autonity/autonity/consensus/tendermint/core/interfaces"
@@ -91,7 +92,7 @@ func TestAskSync(t *testing.T) {
 	for _, val := range committee.Members {
 		addresses = append(addresses, val.Address)
 		mockedPeer := consensus.NewMockPeer(ctrl)
-		mockedPeer.EXPECT().Send(SyncNetworkMsg, gomock.Eq([]byte{})).Do(func(_, _ interface{}) {
+		mockedPeer.EXPECT().Send(codes.SyncNetworkMsg, gomock.Eq([]byte{})).Do(func(_, _ interface{}) {
 			atomic.AddUint64(&counter, 1)
 		}).MaxTimes(1)
 		peers[val.Address] = mockedPeer
@@ -186,7 +187,7 @@ func TestGossip(t *testing.T) {
 		} else {
 			mockedPeer.EXPECT().SendRaw(gomock.Any(), gomock.Any()).Do(func(msgCode, data interface{}) {
 				// We want to make sure the payload is correct AND that no other messages is sent.
-				if msgCode == PrevoteNetworkMsg && reflect.DeepEqual(data, msg.Payload()) {
+				if msgCode == codes.PrevoteNetworkMsg && reflect.DeepEqual(data, msg.Payload()) {
 					atomic.AddUint64(&counter, 1)
 				}
 			}).Times(1)
@@ -292,6 +293,37 @@ func TestSign(t *testing.T) {
 	require.True(t, valid)
 }
 
+type testConsensusSigner struct {
+	key blst.SecretKey
+}
+
+func (s *testConsensusSigner) PublicKey() blst.PublicKey {
+	return s.key.PublicKey()
+}
+
+func (s *testConsensusSigner) SignConsensusMessage(request *message.SignatureRequest) (blst.Signature, error) {
+	input, err := request.SignatureInput()
+	if err != nil {
+		return nil, err
+	}
+	return s.key.Sign(input[:]), nil
+}
+
+func TestSetConsensusSigner(t *testing.T) {
+	_, b := newBlockChain(1)
+	otherKey, err := blst.RandKey()
+	require.NoError(t, err)
+
+	require.ErrorIs(t, b.SetConsensusSigner(&testConsensusSigner{key: otherKey}, false), ErrConsensusKeyMismatch)
+	require.Nil(t, b.consensusSigner)
+
+	require.NoError(t, b.SetConsensusSigner(&testConsensusSigner{key: b.consensusKey}, false))
+	require.NotNil(t, b.consensusSigner)
+
+	// the operator can opt in for a signer holding another key
+	require.NoError(t, b.SetConsensusSigner(&testConsensusSigner{key: otherKey}, true))
+}
+
 func TestCommit(t *testing.T) {
 	t.Run("Broadcaster is not set", func(t *testing.T) {
 		chain, backend := newBlockChain(4)
@@ -424,7 +456,7 @@ func TestSyncPeer(t *testing.T) {
 		payload := messages[0].Payload()
 
 		peer1Mock := consensus.NewMockPeer(ctrl)
-		peer1Mock.EXPECT().SendRaw(PrevoteNetworkMsg, payload)
+		peer1Mock.EXPECT().SendRaw(codes.PrevoteNetworkMsg, payload)
 
 		peers := make(map[common.Address]consensus.Peer)
 		peers[peerAddr1] = peer1Mock
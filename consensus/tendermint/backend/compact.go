@@ -0,0 +1,290 @@
+package backend
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/consensus/acn/codes"
+	"github.com/autonity/autonity/consensus/tendermint/core/message"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/metrics"
+	"github.com/autonity/autonity/p2p"
+)
+
+const (
+	// minimum version of the acn protocol supporting compact proposals
+	compactProposalVersion = 2
+	// maximum number of compact proposals waiting for their missing transactions
+	maxPendingCompactProposals = 64
+	// number of buckets and entries of the cache of the proposals served to the peers
+	proposalBuckets = 31
+	proposalEntries = 4
+)
+
+var (
+	errInvalidProposalTxsRequest = errors.New("invalid proposal transactions request")
+
+	CompactProposalReconstructedMeter = metrics.NewRegisteredMeter("acn/proposal/compact/reconstructed", nil) // reconstructed out of the local pool
+	CompactProposalTxsRequestMeter    = metrics.NewRegisteredMeter("acn/proposal/compact/txrequest", nil)     // missing transactions requested
+	CompactProposalFallbackMeter      = metrics.NewRegisteredMeter("acn/proposal/compact/fallback", nil)      // full proposal requested
+)
+
+// TxPool is the transaction pool out of which the compact proposals are reconstructed.
+type TxPool interface {
+	Get(hash common.Hash) *types.Transaction
+}
+
+// proposalTxsRequest asks the sender of a compact proposal for the transactions at the given indexes in the proposed
+// block. A request without indexes asks for the full proposal instead.
+type proposalTxsRequest struct {
+	Hash    common.Hash // hash of the compact proposal
+	Indexes []uint64
+}
+
+// proposalTxsResponse carries the transactions requested by a proposalTxsRequest, in the order of the request.
+type proposalTxsResponse struct {
+	Hash common.Hash
+	Txs  []*types.Transaction
+}
+
+// pendingCompactProposal is a compact proposal waiting for the transactions missing from the local pool.
+type pendingCompactProposal struct {
+	compact *message.CompactProposal
+	txs     []*types.Transaction
+	missing []uint64
+	sender  common.Address
+	errCh   chan<- error
+}
+
+// SetTxPool sets the transaction pool used to reconstruct the compact proposals. Without it, the transactions of
+// the compact proposals are always requested from their sender.
+func (sb *Backend) SetTxPool(pool TxPool) {
+	sb.txPool = pool
+}
+
+func (sb *Backend) handleCompactProposal(sender common.Address, p2pMsg p2p.Msg, errCh chan<- error) (bool, error) {
+	bReader := p2pMsg.Payload.(*bytes.Reader)
+	hash, err := crypto.HashFromReader(bReader)
+	if err != nil {
+		sb.logger.Error("Failed to hash payload", "error", err)
+		return true, err
+	}
+	TotalMessageReceivedBg.Mark(1)
+	if sb.knownMessages.Contains(hash) {
+		return true, nil
+	}
+	MessageProcessedBg.Mark(1)
+	bReader.Seek(0, io.SeekStart)
+	p2pMsg.Payload = bReader
+	if !sb.coreRunning.Load() {
+		sb.pendingMessages.Enqueue(UnhandledMsg{addr: sender, msg: p2pMsg})
+		return true, nil // return nil to avoid shutting down connection during block sync.
+	}
+
+	peer, ok := sb.Broadcaster.FindPeer(sender)
+	if !ok {
+		sb.logger.Error("message received from unknown peer", "sender", sender)
+		return false, nil
+	}
+	if !peer.Cache().Contains(hash) {
+		peer.Cache().Add(hash, true)
+	}
+
+	sb.knownMessages.Add(hash, true)
+	compact := new(message.CompactProposal)
+	if err := p2pMsg.Decode(compact); err != nil {
+		sb.logger.Error("Error decoding compact proposal", "err", err)
+		return true, err
+	}
+	go sb.reconstructProposal(compact, sender, errCh)
+	return true, nil
+}
+
+// reconstructProposal looks up the transactions of a compact proposal in the local pool. The missing ones are
+// requested from the sender of the compact proposal, once its signature is verified.
+func (sb *Backend) reconstructProposal(compact *message.CompactProposal, sender common.Address, errCh chan<- error) {
+	txHashes := compact.TxHashes()
+	txs := make([]*types.Transaction, len(txHashes))
+	var missing []uint64
+	for i, hash := range txHashes {
+		if sb.txPool != nil {
+			txs[i] = sb.txPool.Get(hash)
+		}
+		if txs[i] == nil {
+			missing = append(missing, uint64(i))
+		}
+	}
+	if len(missing) == 0 {
+		sb.completeProposal(compact, txs, sender, errCh)
+		return
+	}
+
+	committee, err := sb.BlockChain().CommitteeOfHeight(compact.H())
+	if err != nil {
+		// the committee of a future epoch is not known yet, the full proposal is verified once its height is reached
+		sb.logger.Debug("Unknown committee of compact proposal, requesting full proposal", "height", compact.H(), "err", err)
+		sb.requestProposalTxs(sender, compact.Hash(), nil)
+		return
+	}
+	if err := compact.Verify(committee); err != nil {
+		sb.logger.Debug("Invalid compact proposal", "height", compact.H(), "round", compact.R(), "sender", sender, "err", err)
+		if errCh != nil {
+			select {
+			case errCh <- err:
+			default:
+			}
+		}
+		return
+	}
+
+	sb.logger.Debug("Requesting missing transactions of compact proposal", "height", compact.H(), "round", compact.R(), "missing", len(missing), "txs", len(txs))
+	CompactProposalTxsRequestMeter.Mark(1)
+	sb.pendingCompactLock.Lock()
+	if len(sb.pendingCompact) >= maxPendingCompactProposals {
+		// drop the compact proposal of the lowest height
+		var oldest common.Hash
+		for hash, pending := range sb.pendingCompact {
+			if oldest == (common.Hash{}) || pending.compact.H() < sb.pendingCompact[oldest].compact.H() {
+				oldest = hash
+			}
+		}
+		delete(sb.pendingCompact, oldest)
+	}
+	sb.pendingCompact[compact.Hash()] = &pendingCompactProposal{
+		compact: compact,
+		txs:     txs,
+		missing: missing,
+		sender:  sender,
+		errCh:   errCh,
+	}
+	sb.pendingCompactLock.Unlock()
+	sb.requestProposalTxs(sender, compact.Hash(), missing)
+}
+
+// expireCompactProposals drops the compact proposals of the heights below the given one which are still waiting for
+// their missing transactions.
+func (sb *Backend) expireCompactProposals(height uint64) {
+	sb.pendingCompactLock.Lock()
+	defer sb.pendingCompactLock.Unlock()
+	for hash, pending := range sb.pendingCompact {
+		if pending.compact.H() < height {
+			delete(sb.pendingCompact, hash)
+		}
+	}
+}
+
+// completeProposal reconstructs the proposal once all of its transactions are known and handles it as if received
+// in full. If the transactions do not match the proposed block, the full proposal is requested.
+func (sb *Backend) completeProposal(compact *message.CompactProposal, txs []*types.Transaction, sender common.Address, errCh chan<- error) {
+	proposal, err := compact.Reconstruct(txs)
+	if err != nil {
+		sb.logger.Debug("Failed to reconstruct compact proposal, requesting full proposal", "height", compact.H(), "round", compact.R(), "err", err)
+		sb.requestProposalTxs(sender, compact.Hash(), nil)
+		return
+	}
+	CompactProposalReconstructedMeter.Mark(1)
+
+	hash := proposal.Hash()
+	if sb.knownMessages.Contains(hash) {
+		return
+	}
+	sb.knownMessages.Add(hash, true)
+	if peer, ok := sb.Broadcaster.FindPeer(sender); ok && !peer.Cache().Contains(hash) {
+		peer.Cache().Add(hash, true)
+	}
+	if proposal.H() > sb.core.Height().Uint64() {
+		sb.saveFutureMsg(proposal, errCh, sender)
+		return
+	}
+	if _, err := sb.handleDecodedMsg(proposal, errCh, sender); err != nil && errCh != nil {
+		select {
+		case errCh <- err:
+		default:
+		}
+	}
+}
+
+func (sb *Backend) requestProposalTxs(sender common.Address, hash common.Hash, indexes []uint64) {
+	if len(indexes) == 0 {
+		CompactProposalFallbackMeter.Mark(1)
+	}
+	peer, ok := sb.Broadcaster.FindPeer(sender)
+	if !ok {
+		sb.logger.Debug("Sender of compact proposal not connected anymore", "sender", sender)
+		return
+	}
+	go peer.Send(codes.GetProposalTxsNetworkMsg, &proposalTxsRequest{Hash: hash, Indexes: indexes}) //nolint
+}
+
+// handleProposalTxsRequest serves the transactions of a compact proposal gossiped to a peer, or the full proposal. The
+// requests for out of range or repeated indexes are rejected, so that a response is never larger than the block, and
+// the peer disconnected.
+func (sb *Backend) handleProposalTxsRequest(sender common.Address, msg p2p.Msg) (bool, error) {
+	var request proposalTxsRequest
+	if err := msg.Decode(&request); err != nil {
+		return true, errDecodeFailed
+	}
+	proposal, ok := sb.gossiper.Proposal(request.Hash)
+	if !ok {
+		sb.logger.Debug("Requested proposal not found", "hash", request.Hash, "from", sender)
+		return true, nil
+	}
+	peer, ok := sb.Broadcaster.FindPeer(sender)
+	if !ok {
+		return true, nil
+	}
+	if len(request.Indexes) == 0 {
+		go peer.SendRaw(codes.ProposeNetworkMsg, proposal.Payload()) //nolint
+		return true, nil
+	}
+	blockTxs := proposal.Block().Transactions()
+	if len(request.Indexes) > len(blockTxs) {
+		return true, errInvalidProposalTxsRequest
+	}
+	txs := make([]*types.Transaction, len(request.Indexes))
+	requested := make(map[uint64]struct{}, len(request.Indexes))
+	for i, index := range request.Indexes {
+		if _, ok := requested[index]; ok || index >= uint64(len(blockTxs)) {
+			return true, errInvalidProposalTxsRequest
+		}
+		requested[index] = struct{}{}
+		txs[i] = blockTxs[index]
+	}
+	go peer.Send(codes.ProposalTxsNetworkMsg, &proposalTxsResponse{Hash: request.Hash, Txs: txs}) //nolint
+	return true, nil
+}
+
+// handleProposalTxsResponse completes a pending compact proposal with the transactions received from its sender.
+func (sb *Backend) handleProposalTxsResponse(sender common.Address, msg p2p.Msg) (bool, error) {
+	var response proposalTxsResponse
+	if err := msg.Decode(&response); err != nil {
+		return true, errDecodeFailed
+	}
+	sb.pendingCompactLock.Lock()
+	pending, ok := sb.pendingCompact[response.Hash]
+	if !ok || pending.sender != sender {
+		sb.pendingCompactLock.Unlock()
+		return true, nil
+	}
+	delete(sb.pendingCompact, response.Hash)
+	sb.pendingCompactLock.Unlock()
+
+	txHashes := pending.compact.TxHashes()
+	if len(response.Txs) != len(pending.missing) {
+		sb.requestProposalTxs(sender, response.Hash, nil)
+		return true, nil
+	}
+	for i, index := range pending.missing {
+		tx := response.Txs[i]
+		if tx.Hash() != txHashes[index] {
+			sb.requestProposalTxs(sender, response.Hash, nil)
+			return true, nil
+		}
+		pending.txs[index] = tx
+	}
+	go sb.completeProposal(pending.compact, pending.txs, sender, pending.errCh)
+	return true, nil
+}
@@ -0,0 +1,218 @@
+package backend
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/common/fixsizecache"
+	"github.com/autonity/autonity/consensus"
+	"github.com/autonity/autonity/consensus/acn/codes"
+	"github.com/autonity/autonity/consensus/tendermint/core/interfaces"
+	"github.com/autonity/autonity/consensus/tendermint/core/message"
+	"github.com/autonity/autonity/consensus/tendermint/events"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/log"
+	"github.com/autonity/autonity/p2p"
+	"github.com/autonity/autonity/trie"
+)
+
+type testTxPool struct {
+	txs types.Transactions
+}
+
+func (p *testTxPool) Get(hash common.Hash) *types.Transaction {
+	for _, tx := range p.txs {
+		if tx.Hash() == hash {
+			return tx
+		}
+	}
+	return nil
+}
+
+// newCompactTestPeer mocks a peer recording the messages sent to it
+func newCompactTestPeer(ctrl *gomock.Controller, version uint) (*consensus.MockPeer, chan p2p.Msg) {
+	sent := make(chan p2p.Msg, 10)
+	peer := consensus.NewMockPeer(ctrl)
+	peer.EXPECT().Version().Return(version).AnyTimes()
+	peer.EXPECT().Cache().Return(fixsizecache.New[common.Hash, bool](11, 10, fixsizecache.HashKey[common.Hash])).AnyTimes()
+	peer.EXPECT().SendRaw(gomock.Any(), gomock.Any()).Do(func(code uint64, payload []byte) {
+		sent <- p2p.Msg{Code: code, Size: uint32(len(payload)), Payload: bytes.NewReader(payload)}
+	}).AnyTimes()
+	peer.EXPECT().Send(gomock.Any(), gomock.Any()).Do(func(code uint64, data interface{}) {
+		sent <- makeMsg(code, data)
+	}).AnyTimes()
+	return peer, sent
+}
+
+func receive(t *testing.T, sent chan p2p.Msg, code uint64) p2p.Msg {
+	select {
+	case msg := <-sent:
+		require.Equal(t, code, msg.Code)
+		return msg
+	case <-time.After(5 * time.Second):
+		t.Fatalf("message %#x not sent", code)
+	}
+	return p2p.Msg{}
+}
+
+func newCompactTestBackend(ctrl *gomock.Controller, address common.Address, peerAddress common.Address, peer consensus.Peer) *Backend {
+	knownMessages := fixsizecache.New[common.Hash, bool](numBuckets, numEntries, fixsizecache.HashKey[common.Hash])
+	broadcaster := consensus.NewMockBroadcaster(ctrl)
+	broadcaster.EXPECT().FindPeer(peerAddress).Return(peer, true).AnyTimes()
+	core := interfaces.NewMockCore(ctrl)
+	core.EXPECT().Height().Return(common.Big0).AnyTimes()
+	b := &Backend{
+		logger:         log.New(),
+		knownMessages:  knownMessages,
+		core:           core,
+		future:         make(map[uint64][]*events.UnverifiedMessageEvent),
+		pendingCompact: make(map[common.Hash]*pendingCompactProposal),
+		gossiper:       NewGossiper(knownMessages, address, log.New(), make(chan struct{})),
+	}
+	b.SetBroadcaster(broadcaster)
+	b.coreRunning.Store(true)
+	return b
+}
+
+func TestCompactProposal(t *testing.T) {
+	var txs []*types.Transaction
+	for i := uint64(0); i < 4; i++ {
+		txs = append(txs, types.NewTx(&types.LegacyTx{Nonce: i, GasPrice: big.NewInt(1), Gas: 50000, To: &common.Address{1}, Data: make([]byte, 100)}))
+	}
+	block := types.NewBlock(&types.Header{Number: big.NewInt(5)}, txs, nil, nil, trie.NewStackTrie(nil))
+	// the compact proposals are verified against the committee of the chain of the receiver
+	chain, chainBackend := newBlockChain(1)
+	chainCommittee, err := chain.CommitteeOfHeight(5)
+	require.NoError(t, err)
+	proposal := message.NewPropose(1, 5, -1, block, makeSigner(chainBackend.consensusKey), &chainCommittee.Members[0])
+	proposer, receiver := chainCommittee.Members[0].Address, common.Address{0xbb}
+	committee := &types.Committee{Members: []types.CommitteeMember{{Address: proposer}, {Address: receiver}}}
+
+	gossip := func(t *testing.T, proposal *message.Propose, pool []*types.Transaction, version uint) (*Backend, *Backend, chan p2p.Msg, chan p2p.Msg) {
+		ctrl := gomock.NewController(t)
+		toReceiver, sentToReceiver := newCompactTestPeer(ctrl, version)
+		toProposer, sentToProposer := newCompactTestPeer(ctrl, version)
+		proposerBackend := newCompactTestBackend(ctrl, proposer, receiver, toReceiver)
+		receiverBackend := newCompactTestBackend(ctrl, receiver, proposer, toProposer)
+		receiverBackend.blockchain = chain
+		receiverBackend.SetTxPool(&testTxPool{txs: pool})
+		proposerBackend.Gossip(committee, proposal)
+		return proposerBackend, receiverBackend, sentToReceiver, sentToProposer
+	}
+	run := func(t *testing.T, pool []*types.Transaction, version uint) (*Backend, *Backend, chan p2p.Msg, chan p2p.Msg) {
+		return gossip(t, proposal, pool, version)
+	}
+	reconstructed := func(t *testing.T, b *Backend) {
+		require.Eventually(t, func() bool {
+			b.futureLock.RLock()
+			defer b.futureLock.RUnlock()
+			return len(b.future[5]) == 1
+		}, 5*time.Second, 10*time.Millisecond)
+		require.Equal(t, proposal.Hash(), b.future[5][0].Message.Hash())
+		require.True(t, b.knownMessages.Contains(proposal.Hash()))
+	}
+
+	t.Run("compact proposal is reconstructed from the pool", func(t *testing.T) {
+		_, receiverBackend, sentToReceiver, _ := run(t, txs, compactProposalVersion)
+		compact := receive(t, sentToReceiver, codes.CompactProposeNetworkMsg)
+		require.Less(t, compact.Size, uint32(len(proposal.Payload())))
+		_, err := receiverBackend.HandleMsg(proposer, compact, nil)
+		require.NoError(t, err)
+		reconstructed(t, receiverBackend)
+	})
+
+	t.Run("missing transactions are requested from the sender", func(t *testing.T) {
+		proposerBackend, receiverBackend, sentToReceiver, sentToProposer := run(t, txs[1:3], compactProposalVersion)
+		_, err := receiverBackend.HandleMsg(proposer, receive(t, sentToReceiver, codes.CompactProposeNetworkMsg), nil)
+		require.NoError(t, err)
+
+		request := receive(t, sentToProposer, codes.GetProposalTxsNetworkMsg)
+		var decoded proposalTxsRequest
+		require.NoError(t, request.Decode(&decoded))
+		require.Equal(t, []uint64{0, 3}, decoded.Indexes)
+		request.Payload.(*bytes.Reader).Seek(0, 0)
+
+		_, err = proposerBackend.HandleMsg(receiver, request, nil)
+		require.NoError(t, err)
+		_, err = receiverBackend.HandleMsg(proposer, receive(t, sentToReceiver, codes.ProposalTxsNetworkMsg), nil)
+		require.NoError(t, err)
+		reconstructed(t, receiverBackend)
+	})
+
+	t.Run("missing transactions are not requested for invalid signatures", func(t *testing.T) {
+		forged := message.NewPropose(1, 5, -1, block, testSigner, &chainCommittee.Members[0])
+		_, receiverBackend, sentToReceiver, sentToProposer := gossip(t, forged, nil, compactProposalVersion)
+		errCh := make(chan error, 1)
+		_, err := receiverBackend.HandleMsg(proposer, receive(t, sentToReceiver, codes.CompactProposeNetworkMsg), errCh)
+		require.NoError(t, err)
+		select {
+		case err := <-errCh:
+			require.ErrorIs(t, err, message.ErrBadSignature)
+		case <-time.After(5 * time.Second):
+			t.Fatal("invalid compact proposal not reported")
+		}
+		require.Empty(t, sentToProposer)
+		require.Empty(t, receiverBackend.pendingCompact)
+	})
+
+	t.Run("pending compact proposals expire with their height", func(t *testing.T) {
+		_, receiverBackend, sentToReceiver, sentToProposer := run(t, nil, compactProposalVersion)
+		_, err := receiverBackend.HandleMsg(proposer, receive(t, sentToReceiver, codes.CompactProposeNetworkMsg), nil)
+		require.NoError(t, err)
+		receive(t, sentToProposer, codes.GetProposalTxsNetworkMsg)
+		require.Len(t, receiverBackend.pendingCompact, 1)
+		receiverBackend.ProcessFutureMsgs(5)
+		require.Len(t, receiverBackend.pendingCompact, 1)
+		receiverBackend.ProcessFutureMsgs(6)
+		require.Empty(t, receiverBackend.pendingCompact)
+	})
+
+	t.Run("full proposal is requested if the transactions do not match", func(t *testing.T) {
+		proposerBackend, receiverBackend, sentToReceiver, sentToProposer := run(t, nil, compactProposalVersion)
+		_, err := receiverBackend.HandleMsg(proposer, receive(t, sentToReceiver, codes.CompactProposeNetworkMsg), nil)
+		require.NoError(t, err)
+		hash := message.NewCompactProposal(proposal).Hash()
+		receive(t, sentToProposer, codes.GetProposalTxsNetworkMsg)
+
+		// a response carrying other transactions than the requested ones
+		_, err = receiverBackend.HandleMsg(proposer, makeMsg(codes.ProposalTxsNetworkMsg, &proposalTxsResponse{Hash: hash, Txs: []*types.Transaction{txs[1], txs[0], txs[2], txs[3]}}), nil)
+		require.NoError(t, err)
+		fallback := receive(t, sentToProposer, codes.GetProposalTxsNetworkMsg)
+		var decoded proposalTxsRequest
+		require.NoError(t, fallback.Decode(&decoded))
+		require.Empty(t, decoded.Indexes)
+		fallback.Payload.(*bytes.Reader).Seek(0, 0)
+
+		_, err = proposerBackend.HandleMsg(receiver, fallback, nil)
+		require.NoError(t, err)
+		full := receive(t, sentToReceiver, codes.ProposeNetworkMsg)
+		require.Equal(t, uint32(len(proposal.Payload())), full.Size)
+	})
+
+	t.Run("full proposal is sent to peers not supporting compact proposals", func(t *testing.T) {
+		_, _, sentToReceiver, _ := run(t, txs, compactProposalVersion-1)
+		receive(t, sentToReceiver, codes.ProposeNetworkMsg)
+	})
+
+	t.Run("out of range transactions requests are rejected", func(t *testing.T) {
+		proposerBackend, _, _, _ := run(t, txs, compactProposalVersion)
+		hash := message.NewCompactProposal(proposal).Hash()
+		_, err := proposerBackend.HandleMsg(receiver, makeMsg(codes.GetProposalTxsNetworkMsg, &proposalTxsRequest{Hash: hash, Indexes: []uint64{4}}), nil)
+		require.ErrorIs(t, err, errInvalidProposalTxsRequest)
+	})
+
+	t.Run("repeated or excess transactions requests are rejected", func(t *testing.T) {
+		proposerBackend, _, _, _ := run(t, txs, compactProposalVersion)
+		hash := message.NewCompactProposal(proposal).Hash()
+		for _, indexes := range [][]uint64{{1, 2, 1}, {0, 1, 2, 3, 0}} {
+			_, err := proposerBackend.HandleMsg(receiver, makeMsg(codes.GetProposalTxsNetworkMsg, &proposalTxsRequest{Hash: hash, Indexes: indexes}), nil)
+			require.ErrorIs(t, err, errInvalidProposalTxsRequest, "indexes %v", indexes)
+		}
+	})
+}
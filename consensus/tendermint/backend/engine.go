@@ -11,15 +11,14 @@ import (
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/consensus"
 	"github.com/autonity/autonity/consensus/misc"
-	"github.com/autonity/autonity/consensus/tendermint/bft"
+	"github.com/autonity/autonity/consensus/tendermint/alerts"
 	"github.com/autonity/autonity/consensus/tendermint/core/constants"
-	"github.com/autonity/autonity/consensus/tendermint/core/message"
 	"github.com/autonity/autonity/consensus/tendermint/events"
+	"github.com/autonity/autonity/consensus/tendermint/finality"
 	"github.com/autonity/autonity/core"
 	"github.com/autonity/autonity/core/state"
 	"github.com/autonity/autonity/core/types"
 	"github.com/autonity/autonity/crypto"
-	"github.com/autonity/autonity/crypto/blst"
 	"github.com/autonity/autonity/event"
 	"github.com/autonity/autonity/metrics"
 	"github.com/autonity/autonity/params"
@@ -62,6 +61,9 @@ var (
 	sealDelayBg                   = metrics.NewRegisteredBufferedGauge("work/seal/delay", nil, nil) // injected sleep delay before producing new candidate block
 )
 
+// jailedState is the state of a validator jailed until its release block in the Autonity contract.
+const jailedState uint8 = 2
+
 // Author retrieves the Ethereum address of the account that minted the given
 // block.
 func (sb *Backend) Author(header *types.Header) (common.Address, error) {
@@ -88,13 +90,14 @@ func (sb *Backend) VerifyHeader(chain consensus.ChainHeaderReader, header *types
 		return err
 	}
 
-	return sb.verifyHeader(chain, header, parent, epoch.Committee, epoch.EpochBlock.Uint64(), epoch.NextEpochBlock.Uint64())
+	return sb.verifyHeader(chain, header, parent, epoch.Committee, epoch.EpochBlock.Uint64(), epoch.NextEpochBlock.Uint64(), true)
 }
 
 // verifyHeader checks whether a header conforms to the consensus rules. It expects the parent header
-// to be provided unless header is the genesis header.
+// to be provided unless header is the genesis header. The signer and the quorum certificate of the header are
+// only verified if seal is set.
 func (sb *Backend) verifyHeader(chain consensus.ChainHeaderReader, header, parent *types.Header,
-	committee *types.Committee, curEpochHead uint64, nextEpochHead uint64) error {
+	committee *types.Committee, curEpochHead uint64, nextEpochHead uint64, seal bool) error {
 	if header.Round > constants.MaxRound {
 		return errInvalidRound
 	}
@@ -149,7 +152,7 @@ func (sb *Backend) verifyHeader(chain consensus.ChainHeaderReader, header, paren
 	// Re-injecting historical blocks, as epoch info is a factor to compute the hash, thus we don't need to check epoch
 	// , just double check header against its parent and the quorum certificates of this height.
 	if chain.GetHeader(header.Hash(), header.Number.Uint64()) != nil {
-		return sb.verifyHeaderAgainstLastView(header, parent, committee)
+		return sb.verifyHeaderAgainstLastView(header, parent, committee, seal)
 	}
 
 	// for unknown headers, header number should pass the corresponding epoch boundary check.
@@ -167,12 +170,12 @@ func (sb *Backend) verifyHeader(chain consensus.ChainHeaderReader, header, paren
 	}
 
 	// check quorum certificates of consensus participants
-	return sb.verifyHeaderAgainstLastView(header, parent, committee)
+	return sb.verifyHeaderAgainstLastView(header, parent, committee, seal)
 }
 
 // verifyHeaderAgainstLastView verifies that the given header is valid with respect to its parent block and the
 // corresponding epoch's committee.
-func (sb *Backend) verifyHeaderAgainstLastView(header, parent *types.Header, committee *types.Committee) error {
+func (sb *Backend) verifyHeaderAgainstLastView(header, parent *types.Header, committee *types.Committee, seal bool) error {
 	if parent.Number.Uint64() != header.Number.Uint64()-1 || parent.Hash() != header.ParentHash {
 		return consensus.ErrUnknownAncestor
 	}
@@ -180,6 +183,9 @@ func (sb *Backend) verifyHeaderAgainstLastView(header, parent *types.Header, com
 	if parent.Time+1 > header.Time { // Todo : fetch block period from contract
 		return errInvalidTimestamp
 	}
+	if !seal {
+		return nil
+	}
 
 	if err := sb.verifySigner(header, committee); err != nil {
 		return err
@@ -193,12 +199,32 @@ func (sb *Backend) verifyHeaderAgainstLastView(header, parent *types.Header, com
 // a results channel to retrieve the async verifications (the order is that of
 // the input slice).
 func (sb *Backend) VerifyHeaders(chain consensus.ChainHeaderReader, headers []*types.Header, _ []bool) (chan<- struct{}, <-chan error) {
+	return sb.verifyHeaders(chain, headers, true)
+}
+
+// VerifyCertifiedHeaders implements consensus.CertifiedHeaderVerifier. The signer and the quorum certificate of the
+// headers are skipped, as already verified by the epoch sync against the committee of their epoch. Epoch headers
+// are always fully verified as they carry the committee the following headers are verified against.
+func (sb *Backend) VerifyCertifiedHeaders(chain consensus.ChainHeaderReader, headers []*types.Header) (chan<- struct{}, <-chan error) {
+	return sb.verifyHeaders(chain, headers, false)
+}
+
+// verifyHeaders verifies a batch of headers, along with their signer and quorum certificate if seal is set.
+func (sb *Backend) verifyHeaders(chain consensus.ChainHeaderReader, headers []*types.Header, seal bool) (chan<- struct{}, <-chan error) {
 	abort := make(chan struct{}, 1)
 	results := make(chan error, len(headers))
 
 	go func() {
 		firstHead := headers[0].Number.Uint64()
-		epoch, err := chain.EpochOfHeight(firstHead)
+		// the epoch of the 1st header is carried by its parent if it is an epoch header, which spares the lookup of
+		// the epoch in the state when the header chain is ahead of it, as during the epoch sync.
+		var epoch *types.EpochInfo
+		var err error
+		if parent := chain.GetHeaderByHash(headers[0].ParentHash); parent != nil && parent.IsEpochHeader() {
+			epoch = &types.EpochInfo{EpochBlock: new(big.Int).Set(parent.Number), Epoch: *parent.Epoch.Copy()}
+		} else {
+			epoch, err = chain.EpochOfHeight(firstHead)
+		}
 		// short circuit, if we cannot find the correct epoch for the 1st header, we quit this batch of verification.
 		if err != nil {
 			sb.logger.Error("VerifyHeaders", "cannot find epoch for the 1st header of the batch: ", err.Error(), "height", firstHead)
@@ -222,7 +248,7 @@ func (sb *Backend) VerifyHeaders(chain consensus.ChainHeaderReader, headers []*t
 				sb.logger.Error("VerifyHeaders", "cannot find parent header", header.ParentHash)
 				err = consensus.ErrUnknownAncestor
 			} else {
-				err = sb.verifyHeader(chain, header, parent, committee, curEpochBlock, nextEpochBlock)
+				err = sb.verifyHeader(chain, header, parent, committee, curEpochBlock, nextEpochBlock, seal || header.IsEpochHeader())
 			}
 
 			// cross epoch header check, update the committee and epoch boundary if current header is an epoch head.
@@ -273,46 +299,11 @@ func (sb *Backend) verifySigner(header *types.Header, committee *types.Committee
 // verifyQuorumCertificate validates that the quorum certificate for header come from
 // committee members and that the voting power constitute a quorum.
 func (sb *Backend) verifyQuorumCertificate(header *types.Header, committee *types.Committee) error {
-	// un-finalized proposals will have these fields set to nil
-	if header.QuorumCertificate.Signature == nil || header.QuorumCertificate.Signers == nil {
-		return types.ErrEmptyQuorumCertificate
-	}
-	quorumCertificate := header.QuorumCertificate.Copy() // copy so that we do not modify the header when doing Signers.Validate()
-	if err := quorumCertificate.Signers.Validate(committee.Len()); err != nil {
-		return fmt.Errorf("Invalid quorum certificate signers information: %w", err)
+	err := finality.VerifyQuorumCertificate(header, committee)
+	if errors.Is(err, types.ErrInvalidQuorumCertificate) {
+		sb.logger.Error("block had invalid committed seal", "err", err)
 	}
-
-	// The data that was signed over for this block
-	headerSeal := message.PrepareCommittedSeal(header.Hash(), int64(header.Round), header.Number)
-
-	// Total Voting power for this block
-	power := new(big.Int)
-	for _, index := range quorumCertificate.Signers.FlattenUniq() {
-		power.Add(power, committee.Members[index].VotingPower)
-	}
-
-	// verify signature
-	var keys []blst.PublicKey //nolint
-	for _, index := range quorumCertificate.Signers.Flatten() {
-		keys = append(keys, committee.Members[index].ConsensusKey)
-	}
-	aggregatedKey, err := blst.AggregatePublicKeys(keys)
-	if err != nil {
-		sb.logger.Error("Failed to aggregate keys from committee members", "err", err)
-		return err
-	}
-	valid := quorumCertificate.Signature.Verify(aggregatedKey, headerSeal[:])
-	if !valid {
-		sb.logger.Error("block had invalid committed seal")
-		return types.ErrInvalidQuorumCertificate
-	}
-
-	// We need at least a quorum for the block to be considered valid
-	if power.Cmp(bft.Quorum(committee.TotalVotingPower())) < 0 {
-		return types.ErrInvalidQuorumCertificate
-	}
-
-	return nil
+	return err
 }
 
 // Prepare initializes the consensus fields of a block header according to the
@@ -526,6 +517,11 @@ func (sb *Backend) Close() error {
 	sb.aggregator.stop()
 	sb.core.Stop()
 	sb.wg.Wait()
+	if sb.wal != nil {
+		if err := sb.wal.Close(); err != nil {
+			sb.logger.Warn("Failed to close the consensus WAL", "err", err)
+		}
+	}
 	sb.coreStarting.CompareAndSwap(true, false)
 	return nil
 }
@@ -558,6 +554,16 @@ func (sb *Backend) faultyValidatorsWatcher(ctx context.Context) {
 		sb.wg.Done()
 	}()
 
+	// the jail release block of our own validator, zero if it is not jailed, and whether it is a member of the
+	// committee of the current epoch, to alert the operator when these change. The release block is read from the
+	// state, at start and at the head following a slashing of our own validator.
+	ownRelease := sb.ownJailRelease(sb.blockchain.CurrentHeader())
+	refreshRelease := false
+	inCommittee := false
+	if epoch, err := sb.blockchain.LatestEpoch(); err == nil {
+		inCommittee = epoch.Committee.MemberByAddress(sb.address) != nil
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -580,6 +586,21 @@ func (sb *Backend) faultyValidatorsWatcher(ctx context.Context) {
 				explanation := autonity.Rule(event.Rule).Explanation()
 				sb.logger.Warn("Your validator has been found guilty of consensus misbehaviour", "address", event.Offender, "event id", ev.Id.Uint64(), "event type", eventType, "rule", rule, "block", event.Block.Uint64(), "epoch", event.Epoch.Uint64(), "faulty message hash", common.BigToHash(event.MessageHash))
 				sb.logger.Warn(explanation)
+				sb.alerts.Notify(&alerts.Alert{
+					Kind:      alerts.FaultProofFinalized,
+					Validator: sb.address,
+					Block:     ev.Raw.BlockNumber,
+					Message:   "Your validator has been found guilty of consensus misbehaviour: " + explanation,
+					Details: map[string]interface{}{
+						"eventId":     ev.Id.Uint64(),
+						"eventType":   eventType,
+						"rule":        rule,
+						"block":       event.Block.Uint64(),
+						"epoch":       event.Epoch.Uint64(),
+						"messageHash": common.BigToHash(event.MessageHash),
+						"severity":    ev.Severity,
+					},
+				})
 			}
 			sb.jailedLock.Lock()
 			// a 0 value means that the validator is in a perpetual jailed state
@@ -591,6 +612,19 @@ func (sb *Backend) faultyValidatorsWatcher(ctx context.Context) {
 			// local node got slashed, print out information about the slashing that can be correlated with the information about the fault proof above.
 			if ev.Validator == sb.address {
 				sb.logger.Warn("Your validator has been slashed", "amount", ev.Amount.Uint64(), "jail release block", ev.ReleaseBlock.Uint64(), "jailbound", ev.IsJailbound, "event id", ev.EventId.Uint64())
+				sb.alerts.Notify(&alerts.Alert{
+					Kind:      alerts.Slashed,
+					Validator: sb.address,
+					Block:     ev.Raw.BlockNumber,
+					Message:   "Your validator has been slashed",
+					Details: map[string]interface{}{
+						"amount":           ev.Amount,
+						"jailReleaseBlock": ev.ReleaseBlock.Uint64(),
+						"jailbound":        ev.IsJailbound,
+						"eventId":          ev.EventId.Uint64(),
+					},
+				})
+				refreshRelease = true
 			}
 			sb.jailedLock.Lock()
 			if ev.IsJailbound {
@@ -602,6 +636,34 @@ func (sb *Backend) faultyValidatorsWatcher(ctx context.Context) {
 			}
 			sb.jailedLock.Unlock()
 		case ev := <-chainHeadCh:
+			number := ev.Block.NumberU64()
+			if refreshRelease {
+				ownRelease = sb.ownJailRelease(ev.Block.Header())
+				refreshRelease = false
+			}
+			if ownRelease != 0 && ownRelease < number {
+				sb.alerts.Notify(&alerts.Alert{
+					Kind:      alerts.JailReleased,
+					Validator: sb.address,
+					Block:     number,
+					Message:   "The jail release block of your validator has been reached, it can be re-activated",
+					Details:   map[string]interface{}{"jailReleaseBlock": ownRelease},
+				})
+				ownRelease = 0
+			}
+			if header := ev.Block.Header(); header.IsEpochHeader() {
+				member := header.Epoch.Committee.MemberByAddress(sb.address) != nil
+				if inCommittee && !member {
+					sb.alerts.Notify(&alerts.Alert{
+						Kind:      alerts.CommitteeLeft,
+						Validator: sb.address,
+						Block:     number,
+						Message:   "Your validator is not a member of the committee of the new epoch",
+						Details:   map[string]interface{}{"nextEpochBlock": header.Epoch.NextEpochBlock},
+					})
+				}
+				inCommittee = member
+			}
 			sb.jailedLock.Lock()
 			for k, v := range sb.jailed {
 				if v < ev.Block.NumberU64() && v != 0 {
@@ -613,6 +675,37 @@ func (sb *Backend) faultyValidatorsWatcher(ctx context.Context) {
 	}
 }
 
+// ownJailRelease reads the jail release block of the local validator from the state at the given header, zero if it
+// is not jailed or jailed permanently. It is only needed for the operator alerts, hence skipped when disabled.
+func (sb *Backend) ownJailRelease(header *types.Header) uint64 {
+	if sb.alerts == nil {
+		return 0
+	}
+	release, err := sb.jailRelease(header, sb.address)
+	if err != nil {
+		sb.logger.Debug("Can't read the jail release block of the local validator", "block", header.Number, "err", err)
+	}
+	return release
+}
+
+// jailRelease reads the jail release block of the validator from the state at the given header, zero if it is not
+// jailed or jailed permanently.
+func (sb *Backend) jailRelease(header *types.Header, address common.Address) (uint64, error) {
+	state, err := sb.blockchain.StateAt(header.Root)
+	if err != nil {
+		return 0, err
+	}
+	// a single struct output is unpacked into the first field of the result
+	var result struct{ Validator autonity.AutonityValidator }
+	if err := sb.blockchain.ProtocolContracts().AutonityContractCall(state, header, "getValidator", &result, address); err != nil {
+		return 0, err
+	}
+	if result.Validator.State != jailedState {
+		return 0, nil
+	}
+	return result.Validator.JailReleaseBlock.Uint64(), nil
+}
+
 func (sb *Backend) IsJailed(address common.Address) bool {
 	sb.jailedLock.RLock()
 	defer sb.jailedLock.RUnlock()
@@ -201,6 +201,50 @@ OUT1:
 	}
 }
 
+func TestVerifyHeadersSeals(t *testing.T) {
+	chain, engine := newBlockChain(1)
+
+	// the headers carry no quorum certificate, so that they only pass if their quorum certificate is skipped.
+	var headers []*types.Header
+	parent := chain.Genesis()
+	size := 10
+	for i := 0; i < size; i++ {
+		b, err := makeBlockWithoutSeal(chain, engine, parent)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, _ = engine.AddSeal(b)
+		headers = append(headers, b.Header())
+		parent = b
+	}
+	now = func() time.Time {
+		return time.Unix(int64(headers[size-1].Time), 0)
+	}
+
+	check := func(results <-chan error, want error) {
+		for i := 0; i < size; i++ {
+			select {
+			case err := <-results:
+				if !errors.Is(err, want) {
+					t.Errorf("header %d: error mismatch: have %v, want %v", i, err, want)
+				}
+			case <-time.After(2 * time.Second):
+				t.Fatal("verification timed out")
+			}
+		}
+	}
+
+	// the quorum certificate of every header is verified, whatever the seals requested
+	seals := make([]bool, size)
+	seals[4], seals[size-1] = true, true
+	_, results := engine.VerifyHeaders(chain, headers, seals)
+	check(results, types.ErrEmptyQuorumCertificate)
+
+	// unless already verified by the caller
+	_, results = engine.VerifyCertifiedHeaders(chain, headers)
+	check(results, nil)
+}
+
 // The logic of this needs to change with respect of Autonity contact
 func TestVerifyHeadersAbortValidation(t *testing.T) {
 	chain, engine := newBlockChain(1)
@@ -672,3 +716,19 @@ func TestBackendSealHash(t *testing.T) {
 		t.Fatalf("expected not empty string")
 	}
 }
+
+func TestJailRelease(t *testing.T) {
+	chain, engine := newBlockChain(1)
+	head := chain.CurrentHeader()
+
+	release, err := engine.jailRelease(head, engine.address)
+	if err != nil {
+		t.Fatalf("error mismatch: have %v, want nil", err)
+	}
+	if release != 0 {
+		t.Fatalf("release block mismatch: have %d, want 0 for an active validator", release)
+	}
+	if _, err := engine.jailRelease(head, common.Address{1}); err == nil {
+		t.Fatal("expected an error for an unregistered validator")
+	}
+}
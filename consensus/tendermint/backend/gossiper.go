@@ -7,6 +7,7 @@ import (
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/common/fixsizecache"
 	"github.com/autonity/autonity/consensus"
+	"github.com/autonity/autonity/consensus/acn/codes"
 	"github.com/autonity/autonity/consensus/tendermint/bft"
 	"github.com/autonity/autonity/consensus/tendermint/core/message"
 	"github.com/autonity/autonity/core/types"
@@ -14,8 +15,9 @@ import (
 )
 
 type Gossiper struct {
-	knownMessages      *fixsizecache.Cache[common.Hash, bool] // the cache of self messages
-	address            common.Address                         // address of the local peer
+	knownMessages      *fixsizecache.Cache[common.Hash, bool]             // the cache of self messages
+	proposals          *fixsizecache.Cache[common.Hash, *message.Propose] // the proposals gossiped, by compact proposal hash
+	address            common.Address                                     // address of the local peer
 	broadcaster        consensus.Broadcaster
 	logger             log.Logger
 	stopped            chan struct{}
@@ -25,6 +27,7 @@ type Gossiper struct {
 func NewGossiper(knownMessages *fixsizecache.Cache[common.Hash, bool], address common.Address, logger log.Logger, stopped chan struct{}) *Gossiper {
 	return &Gossiper{
 		knownMessages:      knownMessages,
+		proposals:          fixsizecache.New[common.Hash, *message.Propose](proposalBuckets, proposalEntries, fixsizecache.HashKey[common.Hash]),
 		address:            address,
 		logger:             logger,
 		stopped:            stopped,
@@ -52,16 +55,32 @@ func (g *Gossiper) UpdateStopChannel(stopCh chan struct{}) {
 	g.stopped = stopCh
 }
 
-func (g *Gossiper) Gossip(committee *types.Committee, message message.Msg) {
-	hash := message.Hash()
+func (g *Gossiper) Proposal(compactHash common.Hash) (*message.Propose, bool) {
+	proposal, ok := g.proposals.Get(compactHash)
+	if !ok {
+		return nil, false
+	}
+	return proposal.(*message.Propose), true
+}
+
+func (g *Gossiper) Gossip(committee *types.Committee, msg message.Msg) {
+	hash := msg.Hash()
 	if !g.knownMessages.Contains(hash) {
 		g.knownMessages.Add(hash, true)
 	}
 	if g.broadcaster == nil {
 		return
 	}
-	code := NetworkCodes[message.Code()]
-	payload := message.Payload()
+	code := NetworkCodes[msg.Code()]
+	payload := msg.Payload()
+
+	// proposals are sent in their compact form to the peers supporting it, the full proposal is kept to serve the
+	// peers which can't reconstruct it.
+	var compact *message.CompactProposal
+	if proposal, ok := msg.(*message.Propose); ok && len(proposal.Block().Transactions()) > 0 {
+		compact = message.NewCompactProposal(proposal)
+		g.proposals.Add(compact.Hash(), proposal)
+	}
 	for _, val := range committee.Members {
 		if val.Address == g.address {
 			continue
@@ -72,6 +91,10 @@ func (g *Gossiper) Gossip(committee *types.Committee, message message.Msg) {
 				continue
 			}
 			p.Cache().Add(hash, true)
+			code, payload := code, payload
+			if compact != nil && p.Version() >= compactProposalVersion {
+				code, payload = codes.CompactProposeNetworkMsg, compact.Payload()
+			}
 			g.concurrencyLimiter <- struct{}{}
 			go func() {
 				defer func() {
@@ -107,13 +130,18 @@ func (g *Gossiper) AskSync(committee *types.Committee) {
 				}
 			}
 			count := new(big.Int)
+			asked := make(map[consensus.Peer]bool, len(ps))
 			for addr, p := range ps {
 				//ask to a quorum nodes to sync, 1 must then be honest and updated
 				if count.Cmp(bft.Quorum(committee.TotalVotingPower())) >= 0 {
 					break
 				}
-				g.logger.Debug("Asking sync to", "addr", addr)
-				go p.Send(SyncNetworkMsg, []byte{}) //nolint
+				// members behind sentries are reached through them, a sentry is asked only once
+				if !asked[p] {
+					asked[p] = true
+					g.logger.Debug("Asking sync to", "addr", addr)
+					go p.Send(codes.SyncNetworkMsg, []byte{}) //nolint
+				}
 
 				member := committee.MemberByAddress(addr)
 				if member == nil {
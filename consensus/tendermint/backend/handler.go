@@ -9,6 +9,7 @@ import (
 
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/consensus"
+	"github.com/autonity/autonity/consensus/acn/codes"
 	"github.com/autonity/autonity/consensus/tendermint/core/message"
 	"github.com/autonity/autonity/consensus/tendermint/events"
 	"github.com/autonity/autonity/crypto"
@@ -17,14 +18,6 @@ import (
 	"github.com/autonity/autonity/p2p"
 )
 
-const (
-	ProposeNetworkMsg        uint64 = 0x11
-	PrevoteNetworkMsg        uint64 = 0x12
-	PrecommitNetworkMsg      uint64 = 0x13
-	SyncNetworkMsg           uint64 = 0x14
-	AccountabilityNetworkMsg uint64 = 0x15
-)
-
 type UnhandledMsg struct {
 	addr common.Address
 	msg  p2p.Msg
@@ -36,9 +29,9 @@ var (
 	// errDecodeFailed is returned when a consensus message is discarded because the signer is jailed
 	ErrJailed    = errors.New("signer is jailed")
 	NetworkCodes = map[uint8]uint64{
-		message.ProposalCode:  ProposeNetworkMsg,
-		message.PrevoteCode:   PrevoteNetworkMsg,
-		message.PrecommitCode: PrecommitNetworkMsg,
+		message.ProposalCode:  codes.ProposeNetworkMsg,
+		message.PrevoteCode:   codes.PrevoteNetworkMsg,
+		message.PrecommitCode: codes.PrecommitNetworkMsg,
 	}
 	ProposalProcessBg  = metrics.NewRegisteredBufferedGauge("acn/proposal/process", nil, nil)                          // time between round start and proposal sent
 	PrevoteProcessBg   = metrics.NewRegisteredBufferedGauge("acn/prevote/process", nil, metrics.GetIntPointer(1024))   // time between round start and proposal receiv
@@ -63,7 +56,7 @@ func getProcessMetric(msgCode uint64) metrics.BufferedGauge {
 
 // Protocol implements consensus.Handler.Protocol
 func (sb *Backend) Protocol() (protocolName string, extraMsgCodes uint64) {
-	return "tendermint", 5 //nolint
+	return "tendermint", 8 //nolint
 }
 
 func (sb *Backend) HandleUnhandledMsgs(ctx context.Context) {
@@ -85,25 +78,31 @@ func (sb *Backend) HandleUnhandledMsgs(ctx context.Context) {
 
 // HandleMsg implements consensus.Handler.HandleMsg
 func (sb *Backend) HandleMsg(sender common.Address, msg p2p.Msg, errCh chan<- error) (bool, error) {
-	if msg.Code < ProposeNetworkMsg || msg.Code > AccountabilityNetworkMsg {
+	if msg.Code < codes.ProposeNetworkMsg || msg.Code > codes.ProposalTxsNetworkMsg {
 		return false, nil
 	}
 
 	switch msg.Code {
-	case ProposeNetworkMsg:
+	case codes.ProposeNetworkMsg:
 		return handleConsensusMsg[message.Propose](sb, sender, msg, errCh)
-	case PrevoteNetworkMsg:
+	case codes.PrevoteNetworkMsg:
 		return handleConsensusMsg[message.Prevote](sb, sender, msg, errCh)
-	case PrecommitNetworkMsg:
+	case codes.PrecommitNetworkMsg:
 		return handleConsensusMsg[message.Precommit](sb, sender, msg, errCh)
-	case SyncNetworkMsg:
+	case codes.CompactProposeNetworkMsg:
+		return sb.handleCompactProposal(sender, msg, errCh)
+	case codes.GetProposalTxsNetworkMsg:
+		return sb.handleProposalTxsRequest(sender, msg)
+	case codes.ProposalTxsNetworkMsg:
+		return sb.handleProposalTxsResponse(sender, msg)
+	case codes.SyncNetworkMsg:
 		if !sb.coreRunning.Load() {
 			sb.logger.Debug("Sync message received but core not running")
 			return true, nil // we return nil as we don't want to shut down the connection if core is stopped
 		}
 		sb.logger.Debug("Received sync message", "from", sender)
 		go sb.Post(events.SyncEvent{Addr: sender})
-	case AccountabilityNetworkMsg:
+	case codes.AccountabilityNetworkMsg:
 		if !sb.coreRunning.Load() {
 			sb.logger.Debug("Accountability Msg received but core not running")
 			return true, nil // we return nil as we don't want to shut down the connection if core is stopped
@@ -270,6 +269,8 @@ func (sb *Backend) saveFutureMsg(msg message.Msg, errCh chan<- error, sender com
 
 // re-inject future height messages
 func (sb *Backend) ProcessFutureMsgs(height uint64) {
+	sb.expireCompactProposals(height)
+
 	sb.futureLock.Lock()
 	defer sb.futureLock.Unlock()
 
@@ -14,6 +14,7 @@ import (
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/common/fixsizecache"
 	"github.com/autonity/autonity/consensus"
+	"github.com/autonity/autonity/consensus/acn/codes"
 	"github.com/autonity/autonity/consensus/tendermint/core/interfaces"
 	"github.com/autonity/autonity/consensus/tendermint/core/message"
 	"github.com/autonity/autonity/consensus/tendermint/events"
@@ -43,7 +44,7 @@ func TestTendermintMessage(t *testing.T) {
 	_, backend := newBlockChain(1)
 	// generate one msg
 	data := message.NewPrevote(1, 2, common.Hash{}, testSigner, testCommitteeMember, 1)
-	msg := p2p.Msg{Code: PrevoteNetworkMsg, Size: uint32(len(data.Payload())), Payload: bytes.NewReader(data.Payload())}
+	msg := p2p.Msg{Code: codes.PrevoteNetworkMsg, Size: uint32(len(data.Payload())), Payload: bytes.NewReader(data.Payload())}
 
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -89,7 +90,7 @@ func TestSynchronisationMessage(t *testing.T) {
 			logger:   log.New("backend", "test", "id", 0),
 			eventMux: eventMux,
 		}
-		msg := makeMsg(SyncNetworkMsg, []byte{})
+		msg := makeMsg(codes.SyncNetworkMsg, []byte{})
 		addr := common.BytesToAddress([]byte("address"))
 		errCh := make(chan error, 1)
 		if res, err := b.HandleMsg(addr, msg, errCh); !res || err != nil {
@@ -112,7 +113,7 @@ func TestSynchronisationMessage(t *testing.T) {
 		}
 		b.coreStarting.Store(true)
 		b.coreRunning.Store(true)
-		msg := makeMsg(SyncNetworkMsg, []byte{})
+		msg := makeMsg(codes.SyncNetworkMsg, []byte{})
 		addr := common.BytesToAddress([]byte("address"))
 		errCh := make(chan error, 1)
 		if res, err := b.HandleMsg(addr, msg, errCh); !res || err != nil {
@@ -180,7 +181,7 @@ func TestSignerJailed(t *testing.T) {
 
 	// generate one msg
 	data := message.NewPrevote(0, 1, common.Hash{}, testSigner, &member, 1)
-	msg := p2p.Msg{Code: PrevoteNetworkMsg, Size: uint32(len(data.Payload())), Payload: bytes.NewReader(data.Payload())}
+	msg := p2p.Msg{Code: codes.PrevoteNetworkMsg, Size: uint32(len(data.Payload())), Payload: bytes.NewReader(data.Payload())}
 
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -198,7 +199,7 @@ func TestSignerJailed(t *testing.T) {
 
 	data = message.NewPrevote(0, 1, common.Hash{0xca, 0xfe}, testSigner, &member, 2)
 	data.Signers().Increment(makeBogusMember(1))
-	msg = p2p.Msg{Code: PrevoteNetworkMsg, Size: uint32(len(data.Payload())), Payload: bytes.NewReader(data.Payload())}
+	msg = p2p.Msg{Code: codes.PrevoteNetworkMsg, Size: uint32(len(data.Payload())), Payload: bytes.NewReader(data.Payload())}
 	errCh = make(chan error, 1)
 	_, err = backend.HandleMsg(testAddress, msg, errCh)
 	require.Equal(t, ErrJailed, err)
@@ -213,7 +214,7 @@ func TestFutureHeightMessage(t *testing.T) {
 		// generate one msg
 		futureHeight := uint64(20)
 		data := message.NewPrevote(0, futureHeight, common.Hash{}, testSigner, &member, 1)
-		msg := p2p.Msg{Code: PrevoteNetworkMsg, Size: uint32(len(data.Payload())), Payload: bytes.NewReader(data.Payload())}
+		msg := p2p.Msg{Code: codes.PrevoteNetworkMsg, Size: uint32(len(data.Payload())), Payload: bytes.NewReader(data.Payload())}
 
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -241,7 +242,7 @@ func TestFutureHeightMessage(t *testing.T) {
 
 		for h := maxFutureMsgs + 100; h > 0; h-- {
 			data := message.NewPrevote(0, uint64(h), common.Hash{}, testSigner, &member, 1)
-			msg := p2p.Msg{Code: PrevoteNetworkMsg, Size: uint32(len(data.Payload())), Payload: bytes.NewReader(data.Payload())}
+			msg := p2p.Msg{Code: codes.PrevoteNetworkMsg, Size: uint32(len(data.Payload())), Payload: bytes.NewReader(data.Payload())}
 			errCh := make(chan error, 1)
 			_, err := backend.HandleMsg(testAddress, msg, errCh)
 			require.NoError(t, err)
@@ -0,0 +1,46 @@
+package backend
+
+import (
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/p2p/topology"
+)
+
+type relayTargets struct {
+	committee *types.Committee
+	targets   *types.Committee
+}
+
+// gossipTargets returns the committee members the consensus messages are sent to. In relay mode, the members of
+// large committees are arranged in the deterministic graph of the topology package, by committee index: a member
+// only sends to its neighbours, which relay the messages they had not seen to their own neighbours once handled.
+// Nodes out of the committee send to every member.
+func (sb *Backend) gossipTargets(committee *types.Committee) *types.Committee {
+	if sb.blockchain == nil || !sb.blockchain.Config().RelayConsensusGossip(committee.Len()) {
+		return committee
+	}
+	sb.relayLock.Lock()
+	defer sb.relayLock.Unlock()
+	if sb.relay.committee == committee {
+		return sb.relay.targets
+	}
+	targets := adjacentMembers(committee, sb.address)
+	sb.relay = relayTargets{committee: committee, targets: targets}
+	return targets
+}
+
+// adjacentMembers returns the neighbours of the given member in the committee graph, or the whole committee if the
+// address is not a member.
+func adjacentMembers(committee *types.Committee, address common.Address) *types.Committee {
+	for i, member := range committee.Members {
+		if member.Address != address {
+			continue
+		}
+		adjacent := new(types.Committee)
+		for _, j := range topology.Adjacent(i, committee.Len()) {
+			adjacent.Members = append(adjacent.Members, committee.Members[j])
+		}
+		return adjacent
+	}
+	return committee
+}
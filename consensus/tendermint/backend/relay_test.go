@@ -0,0 +1,38 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/autonity/autonity/common"
+)
+
+func TestAdjacentMembers(t *testing.T) {
+	committee, _ := committeeAndBlsKeys(40)
+
+	t.Run("non members send to the whole committee", func(t *testing.T) {
+		require.Equal(t, committee, adjacentMembers(committee, common.Address{}))
+	})
+
+	t.Run("relayed messages reach the whole committee", func(t *testing.T) {
+		for i, member := range committee.Members {
+			adjacent := adjacentMembers(committee, member.Address)
+			require.Less(t, adjacent.Len(), committee.Len()-1)
+
+			reached := map[common.Address]struct{}{member.Address: {}}
+			relays := []common.Address{member.Address}
+			for len(relays) > 0 {
+				relay := relays[0]
+				relays = relays[1:]
+				for _, neighbour := range adjacentMembers(committee, relay).Members {
+					if _, ok := reached[neighbour.Address]; !ok {
+						reached[neighbour.Address] = struct{}{}
+						relays = append(relays, neighbour.Address)
+					}
+				}
+			}
+			require.Len(t, reached, committee.Len(), "member %d", i)
+		}
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/autonity/autonity/consensus/tendermint/bft"
 	"github.com/autonity/autonity/core/types"
 	"github.com/autonity/autonity/log"
+	"github.com/autonity/autonity/params"
 )
 
 var ErrEmptyCommitteeSet = errors.New("committee set can't be empty")
@@ -126,35 +127,39 @@ func getMemberIndex(committee *types.Committee, memberAddr common.Address) int64
 	return int64(index)
 }
 
-type WeightedRandomSamplingCommittee struct {
+// ProtocolCommittee is the committee of the protocol, its proposers are elected with the strategy configured in the
+// chain config for each height.
+type ProtocolCommittee struct {
 	committee        *types.Committee
 	previousHeader   *types.Header
 	autonityContract *autonity.ProtocolContracts
+	config           *params.ChainConfig
 }
 
-func NewWeightedRandomSamplingCommittee(previousHeader *types.Header, committee *types.Committee, autonityContract *autonity.ProtocolContracts) *WeightedRandomSamplingCommittee {
-	return &WeightedRandomSamplingCommittee{
+func NewProtocolCommittee(previousHeader *types.Header, committee *types.Committee, autonityContract *autonity.ProtocolContracts, config *params.ChainConfig) *ProtocolCommittee {
+	return &ProtocolCommittee{
 		committee:        committee,
 		previousHeader:   previousHeader,
 		autonityContract: autonityContract,
+		config:           config,
 	}
 }
 
-func (w *WeightedRandomSamplingCommittee) SetCommittee(committee *types.Committee) {
+func (w *ProtocolCommittee) SetCommittee(committee *types.Committee) {
 	w.committee = committee
 }
 
 // Return the underlying types.Committee
-func (w *WeightedRandomSamplingCommittee) Committee() *types.Committee {
+func (w *ProtocolCommittee) Committee() *types.Committee {
 	return w.committee
 }
 
-func (w *WeightedRandomSamplingCommittee) SetLastHeader(header *types.Header) {
+func (w *ProtocolCommittee) SetLastHeader(header *types.Header) {
 	w.previousHeader = header
 }
 
 // Get validator by index
-func (w *WeightedRandomSamplingCommittee) MemberByIndex(i int) (*types.CommitteeMember, error) {
+func (w *ProtocolCommittee) MemberByIndex(i int) (*types.CommitteeMember, error) {
 	m := w.committee.MemberByIndex(i)
 	if m == nil {
 		return nil, consensus.ErrCommitteeMemberNotFound
@@ -163,7 +168,7 @@ func (w *WeightedRandomSamplingCommittee) MemberByIndex(i int) (*types.Committee
 }
 
 // MemberByAddress Get validator by given address
-func (w *WeightedRandomSamplingCommittee) MemberByAddress(addr common.Address) (*types.CommitteeMember, error) {
+func (w *ProtocolCommittee) MemberByAddress(addr common.Address) (*types.CommitteeMember, error) {
 	m := w.committee.MemberByAddress(addr)
 	if m == nil {
 		return nil, consensus.ErrCommitteeMemberNotFound
@@ -173,8 +178,12 @@ func (w *WeightedRandomSamplingCommittee) MemberByAddress(addr common.Address) (
 }
 
 // Get the round proposer
-func (w *WeightedRandomSamplingCommittee) GetProposer(round int64) *types.CommitteeMember {
-	proposer := w.autonityContract.Proposer(w.committee, nil, w.previousHeader.Number.Uint64(), round)
+func (w *ProtocolCommittee) GetProposer(round int64) *types.CommitteeMember {
+	height := w.previousHeader.Number.Uint64()
+	proposer, err := w.autonityContract.Proposer(w.committee, height, round, StrategyAt(w.config, height+1).Proposer)
+	if err != nil {
+		log.Crit("Cannot elect the round proposer", "err", err)
+	}
 	member := w.committee.MemberByAddress(proposer)
 	if member == nil {
 		log.Crit("Cannot find elected proposer from current committee")
@@ -183,10 +192,10 @@ func (w *WeightedRandomSamplingCommittee) GetProposer(round int64) *types.Commit
 }
 
 // Get the optimal quorum size
-func (w *WeightedRandomSamplingCommittee) Quorum() *big.Int {
+func (w *ProtocolCommittee) Quorum() *big.Int {
 	return bft.Quorum(w.committee.TotalVotingPower())
 }
 
-func (w *WeightedRandomSamplingCommittee) F() *big.Int {
+func (w *ProtocolCommittee) F() *big.Int {
 	return bft.F(w.committee.TotalVotingPower())
 }
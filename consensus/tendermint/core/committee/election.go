@@ -0,0 +1,279 @@
+package committee
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/common/lru"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/params"
+)
+
+const (
+	// WeightedRandomSampling elects proposers by sampling the committee weighted by voting power, it is the default
+	// strategy.
+	WeightedRandomSampling = "weightedRandomSampling"
+	// RoundRobin elects the committee members in turn, regardless of their voting power.
+	RoundRobin = "roundRobin"
+	// StakeWeightedRoundRobin elects proposers in turn, in proportion to their voting power, using the accumulated
+	// proposer priorities of Tendermint.
+	StakeWeightedRoundRobin = "stakeWeightedRoundRobin"
+)
+
+const (
+	// priorityWindow is the number of heights after which the proposer priorities of StakeWeightedRoundRobin are
+	// reset.
+	priorityWindow = 1024
+	// electionCacheSize is the number of elections, and of heights' priorities, memoized by StakeWeightedRoundRobin.
+	// Core, accountability and the participation tracker elect proposers at different heights and rounds.
+	electionCacheSize = 64
+)
+
+// ErrEmptyCommittee is returned when electing a proposer from a committee without members.
+var ErrEmptyCommittee = errors.New("cannot elect a proposer from an empty committee")
+
+// ElectionStrategy elects the proposer of each round among the members of a committee. Implementations must be
+// deterministic, as every node of the network elects proposers on its own.
+type ElectionStrategy interface {
+	// Name is the name the strategy is selected with in the chain config.
+	Name() string
+	// Proposer returns the proposer of round at the height following parentHeight. It returns ErrEmptyCommittee if
+	// the committee has no member.
+	Proposer(committee *types.Committee, parentHeight uint64, round int64) (common.Address, error)
+}
+
+var (
+	strategiesMu sync.RWMutex
+	strategies   = make(map[string]ElectionStrategy)
+)
+
+func init() {
+	RegisterStrategy(weightedRandomSampling{})
+	RegisterStrategy(roundRobin{})
+	RegisterStrategy(newStakeWeightedRoundRobin())
+}
+
+// RegisterStrategy makes an election strategy available to the chain config. It panics if a strategy is already
+// registered with the same name.
+func RegisterStrategy(strategy ElectionStrategy) {
+	strategiesMu.Lock()
+	defer strategiesMu.Unlock()
+	if _, ok := strategies[strategy.Name()]; ok {
+		panic("proposer election strategy registered twice: " + strategy.Name())
+	}
+	strategies[strategy.Name()] = strategy
+}
+
+// Strategy returns the election strategy registered with name.
+func Strategy(name string) (ElectionStrategy, error) {
+	strategiesMu.RLock()
+	defer strategiesMu.RUnlock()
+	strategy, ok := strategies[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown proposer election strategy %q", name)
+	}
+	return strategy, nil
+}
+
+// CheckConfig returns an error if the chain config refers to an election strategy which is not registered.
+func CheckConfig(config *params.ChainConfig) error {
+	for _, fork := range config.ProposerElection {
+		if _, err := Strategy(fork.Strategy); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StrategyAt returns the election strategy configured for the given height. It falls back to the default strategy
+// if config is nil.
+func StrategyAt(config *params.ChainConfig, height uint64) ElectionStrategy {
+	name := WeightedRandomSampling
+	if config != nil {
+		if configured := config.ProposerElectionStrategy(height); configured != "" {
+			name = configured
+		}
+	}
+	strategy, err := Strategy(name)
+	if err != nil {
+		// the chain config is checked when the consensus engine is created
+		panic(err)
+	}
+	return strategy
+}
+
+// Proposer elects the proposer of round at the height following parentHeight, using the strategy configured for
+// that height.
+func Proposer(config *params.ChainConfig, committee *types.Committee, parentHeight uint64, round int64) (common.Address, error) {
+	return StrategyAt(config, parentHeight+1).Proposer(committee, parentHeight, round)
+}
+
+type weightedRandomSampling struct{}
+
+func (weightedRandomSampling) Name() string {
+	return WeightedRandomSampling
+}
+
+func (weightedRandomSampling) Proposer(committee *types.Committee, parentHeight uint64, round int64) (common.Address, error) {
+	if committee.Len() == 0 {
+		return common.Address{}, ErrEmptyCommittee
+	}
+	return committee.Proposer(parentHeight, round), nil
+}
+
+type roundRobin struct{}
+
+func (roundRobin) Name() string {
+	return RoundRobin
+}
+
+func (roundRobin) Proposer(committee *types.Committee, parentHeight uint64, round int64) (common.Address, error) {
+	if committee.Len() == 0 {
+		return common.Address{}, ErrEmptyCommittee
+	}
+	size := uint64(committee.Len())
+	return committee.Members[(parentHeight%size+uint64(round)%size)%size].Address, nil
+}
+
+// stakeWeightedRoundRobin implements the proposer priorities of Tendermint: at each step, the priority of every
+// member grows by its voting power, then the member with the highest priority is elected and its priority decreases
+// by the total voting power. Over a sequence of steps, members are elected in proportion to their voting power.
+//
+// The priorities start from zero every priorityWindow heights, and each height advances them by one step. Rounds
+// advance the priorities of their height further, without carrying over to the next height, so that proposers are
+// resolved from the committee and the height only.
+type stakeWeightedRoundRobin struct {
+	mu         sync.Mutex
+	elected    lru.BasicLRU[election, elected]
+	priorities lru.BasicLRU[uint64, *priorities] // priorities at round 0 of the heights recently elected, by parent height
+}
+
+type election struct {
+	parentHeight uint64
+	round        int64
+}
+
+type elected struct {
+	committee *types.Committee
+	proposer  common.Address
+}
+
+type priorities struct {
+	committee *types.Committee
+	total     *big.Int
+	start     uint64 // first height of the window
+	step      uint64
+	values    []*big.Int
+}
+
+func newStakeWeightedRoundRobin() *stakeWeightedRoundRobin {
+	return &stakeWeightedRoundRobin{
+		elected:    lru.NewBasicLRU[election, elected](electionCacheSize),
+		priorities: lru.NewBasicLRU[uint64, *priorities](electionCacheSize),
+	}
+}
+
+func (s *stakeWeightedRoundRobin) Name() string {
+	return StakeWeightedRoundRobin
+}
+
+func (s *stakeWeightedRoundRobin) Proposer(committee *types.Committee, parentHeight uint64, round int64) (common.Address, error) {
+	if committee.Len() == 0 {
+		return common.Address{}, ErrEmptyCommittee
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := election{parentHeight: parentHeight, round: round}
+	if e, ok := s.elected.Get(key); ok && sameCommittee(e.committee, committee) {
+		return e.proposer, nil
+	}
+
+	current := s.heightPriorities(committee, parentHeight).copy()
+	var proposer int
+	for r := int64(0); r <= round; r++ {
+		proposer = current.advance()
+	}
+	address := committee.Members[proposer].Address
+	s.elected.Add(key, elected{committee: committee, proposer: address})
+	return address, nil
+}
+
+// heightPriorities returns the priorities at round 0 of the height following parentHeight. They are advanced from the
+// closest lower height memoized in the same window, if any. The returned priorities must not be modified.
+func (s *stakeWeightedRoundRobin) heightPriorities(committee *types.Committee, parentHeight uint64) *priorities {
+	start := parentHeight - parentHeight%priorityWindow
+	step := parentHeight - start
+	if p, ok := s.priorities.Get(parentHeight); ok && sameCommittee(p.committee, committee) {
+		return p
+	}
+	var closest *priorities
+	for _, height := range s.priorities.Keys() {
+		p, _ := s.priorities.Peek(height)
+		if p.start != start || p.step > step || !sameCommittee(p.committee, committee) {
+			continue
+		}
+		if closest == nil || p.step > closest.step {
+			closest = p
+		}
+	}
+	var p *priorities
+	if closest != nil {
+		p = closest.copy()
+	} else {
+		p = &priorities{
+			committee: committee,
+			total:     committee.TotalVotingPower(),
+			start:     start,
+			values:    make([]*big.Int, committee.Len()),
+		}
+		for i := range p.values {
+			p.values[i] = new(big.Int)
+		}
+	}
+	for p.step < step {
+		p.advance()
+	}
+	s.priorities.Add(parentHeight, p)
+	return p
+}
+
+// advance increments the priorities by one step and returns the index of the elected member.
+func (p *priorities) advance() int {
+	elected := 0
+	for i := range p.values {
+		p.values[i].Add(p.values[i], p.committee.Members[i].VotingPower)
+		// ties are broken in favour of the lowest index
+		if p.values[i].Cmp(p.values[elected]) > 0 {
+			elected = i
+		}
+	}
+	p.values[elected].Sub(p.values[elected], p.total)
+	p.step++
+	return elected
+}
+
+func (p *priorities) copy() *priorities {
+	cpy := &priorities{committee: p.committee, total: p.total, start: p.start, step: p.step, values: make([]*big.Int, len(p.values))}
+	for i, v := range p.values {
+		cpy.values[i] = new(big.Int).Set(v)
+	}
+	return cpy
+}
+
+func sameCommittee(a, b *types.Committee) bool {
+	if a == b {
+		return true
+	}
+	if a.Len() != b.Len() {
+		return false
+	}
+	for i := range a.Members {
+		if a.Members[i].Address != b.Members[i].Address || a.Members[i].VotingPower.Cmp(b.Members[i].VotingPower) != 0 {
+			return false
+		}
+	}
+	return true
+}
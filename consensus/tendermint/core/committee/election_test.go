@@ -0,0 +1,114 @@
+package committee
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/params"
+)
+
+func committeeWithPowers(powers ...int64) *types.Committee {
+	committee := new(types.Committee)
+	for i, power := range powers {
+		committee.Members = append(committee.Members, types.CommitteeMember{
+			Address:     common.BigToAddress(big.NewInt(int64(i + 1))),
+			VotingPower: big.NewInt(power),
+		})
+	}
+	return committee
+}
+
+func elect(t *testing.T, strategy ElectionStrategy, committee *types.Committee, parentHeight uint64, round int64) common.Address {
+	proposer, err := strategy.Proposer(committee, parentHeight, round)
+	require.NoError(t, err)
+	return proposer
+}
+
+func TestEmptyCommittee(t *testing.T) {
+	for _, name := range []string{WeightedRandomSampling, RoundRobin, StakeWeightedRoundRobin} {
+		strategy, err := Strategy(name)
+		require.NoError(t, err)
+		_, err = strategy.Proposer(new(types.Committee), 10, 0)
+		require.ErrorIs(t, err, ErrEmptyCommittee, name)
+	}
+}
+
+func TestRoundRobin(t *testing.T) {
+	c := committeeWithPowers(10, 1, 1)
+	strategy, err := Strategy(RoundRobin)
+	require.NoError(t, err)
+	require.Equal(t, c.Members[0].Address, elect(t, strategy, c, 0, 0))
+	require.Equal(t, c.Members[1].Address, elect(t, strategy, c, 0, 1))
+	require.Equal(t, c.Members[2].Address, elect(t, strategy, c, 1, 1))
+	require.Equal(t, c.Members[0].Address, elect(t, strategy, c, 1, 2))
+}
+
+func TestStakeWeightedRoundRobin(t *testing.T) {
+	c := committeeWithPowers(5, 3, 2)
+
+	t.Run("proposers are elected in proportion to their voting power", func(t *testing.T) {
+		strategy := newStakeWeightedRoundRobin()
+		counts := make(map[common.Address]int)
+		for h := uint64(0); h < 100; h++ {
+			counts[elect(t, strategy, c, h, 0)]++
+		}
+		require.Equal(t, 50, counts[c.Members[0].Address])
+		require.Equal(t, 30, counts[c.Members[1].Address])
+		require.Equal(t, 20, counts[c.Members[2].Address])
+	})
+
+	t.Run("proposers do not depend on the order of elections", func(t *testing.T) {
+		sequential := newStakeWeightedRoundRobin()
+		expected := make(map[[2]uint64]common.Address)
+		for h := uint64(0); h < 2*priorityWindow+10; h += 7 {
+			for r := int64(0); r < 3; r++ {
+				expected[[2]uint64{h, uint64(r)}] = elect(t, sequential, c, h, r)
+			}
+		}
+		shuffled := newStakeWeightedRoundRobin()
+		for key, proposer := range expected {
+			require.Equal(t, proposer, elect(t, shuffled, c, key[0], int64(key[1])), "height %d round %d", key[0], key[1])
+			// a fresh strategy has no memoized priorities
+			require.Equal(t, proposer, elect(t, newStakeWeightedRoundRobin(), c, key[0], int64(key[1])))
+		}
+	})
+
+	t.Run("committee change resets the priorities", func(t *testing.T) {
+		strategy := newStakeWeightedRoundRobin()
+		other := committeeWithPowers(1, 1, 8)
+		elect(t, strategy, c, 10, 0)
+		require.Equal(t, elect(t, newStakeWeightedRoundRobin(), other, 11, 0), elect(t, strategy, other, 11, 0))
+	})
+
+	t.Run("interleaved heights keep their priorities", func(t *testing.T) {
+		strategy := newStakeWeightedRoundRobin()
+		for _, h := range []uint64{500, 100, 501, 101, 500} {
+			require.Equal(t, elect(t, newStakeWeightedRoundRobin(), c, h, 1), elect(t, strategy, c, h, 1))
+		}
+		require.ElementsMatch(t, []uint64{100, 101, 500, 501}, strategy.priorities.Keys())
+		// the following height is advanced from the priorities of the closest lower height
+		p := strategy.heightPriorities(c, 102)
+		require.Equal(t, uint64(102), p.step)
+	})
+}
+
+func TestStrategyAt(t *testing.T) {
+	config := &params.ChainConfig{ProposerElection: []params.ProposerElectionFork{
+		{Block: big.NewInt(10), Strategy: RoundRobin},
+		{Block: big.NewInt(20), Strategy: StakeWeightedRoundRobin},
+	}}
+	require.Equal(t, WeightedRandomSampling, StrategyAt(nil, 100).Name())
+	require.Equal(t, WeightedRandomSampling, StrategyAt(config, 9).Name())
+	require.Equal(t, RoundRobin, StrategyAt(config, 10).Name())
+	require.Equal(t, RoundRobin, StrategyAt(config, 19).Name())
+	require.Equal(t, StakeWeightedRoundRobin, StrategyAt(config, 20).Name())
+	require.NoError(t, CheckConfig(config))
+
+	config.ProposerElection = append(config.ProposerElection, params.ProposerElectionFork{Block: big.NewInt(30), Strategy: "unknown"})
+	require.Error(t, CheckConfig(config))
+	require.Panics(t, func() { RegisterStrategy(roundRobin{}) })
+}
@@ -27,4 +27,6 @@ var (
 	ErrNilPrecommitSent = errors.New("timer expired and nil precommit sent")
 	// ErrMovedToNewRound is returned when timer could not be stopped in time
 	ErrMovedToNewRound = errors.New("timer expired and new round started")
+	// ErrDoubleSign is returned when signing a message which may conflict with one recorded in the sign journal.
+	ErrDoubleSign = errors.New("message conflicts with the sign journal")
 )
@@ -4,6 +4,7 @@ import (
 	"context"
 	"math/big"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/autonity/autonity/autonity"
@@ -14,6 +15,7 @@ import (
 	"github.com/autonity/autonity/consensus/tendermint/events"
 	"github.com/autonity/autonity/core/types"
 	"github.com/autonity/autonity/crypto/blst"
+	"github.com/autonity/autonity/ethdb"
 	"github.com/autonity/autonity/event"
 	"github.com/autonity/autonity/log"
 	"github.com/autonity/autonity/metrics"
@@ -119,9 +121,14 @@ type Core struct {
 	proposeTimeout   *Timeout
 	prevoteTimeout   *Timeout
 	precommitTimeout *Timeout
+	timeouts         atomic.Pointer[timeouts]
 
 	// End of Tendermint FSM fields
 
+	// last signed message, persisted to signJournalDB. Both are nil if the journal is disabled.
+	signJournal   *SignJournal
+	signJournalDB ethdb.KeyValueStore
+
 	protocolContracts *autonity.ProtocolContracts
 
 	// tendermint behaviour interfaces, can be used in customizing the behaviours
@@ -136,6 +143,13 @@ type Core struct {
 	newRound           time.Time
 	currBlockTimeStamp time.Time
 	noGossip           bool
+
+	// subscribers to the stream of the state changes
+	coreEventSubs     []*coreEventSub
+	coreEventSubsLock sync.RWMutex
+
+	// write-ahead log of the events delivered to the core, nil if disabled
+	wal *WAL
 }
 
 func (c *Core) Prevoter() interfaces.Prevoter {
@@ -251,10 +265,86 @@ func (c *Core) PrecommitTimeout() *Timeout {
 	return c.precommitTimeout
 }
 
+// Signer returns the signer of the consensus message described by request. It fails if the message may equivocate
+// with one recorded in the sign journal. If the backend implements interfaces.MessageSigner, the message is signed
+// upfront so that a refusal can be handled before the message is built.
+func (c *Core) Signer(request *message.SignatureRequest) (message.Signer, error) {
+	if err := c.checkSignJournal(request); err != nil {
+		return nil, err
+	}
+	signer := c.backend.Sign
+	if messageSigner, ok := c.backend.(interfaces.MessageSigner); ok {
+		signature, err := messageSigner.SignMessage(request)
+		if err != nil {
+			return nil, err
+		}
+		signer = func(_ common.Hash) blst.Signature {
+			return signature
+		}
+	}
+	// the journal must be persisted before the message gets broadcast
+	if err := c.recordSignJournal(request); err != nil {
+		return nil, err
+	}
+	return signer, nil
+}
+
 func (c *Core) Broadcaster() interfaces.Broadcaster {
 	return c.broadcaster
 }
 
+// coreEventSub is a subscription to the state changes of the core, along with the number of events dropped because
+// its channel was full.
+type coreEventSub struct {
+	ch      chan<- events.CoreEvent
+	dropped atomic.Uint64
+}
+
+// SubscribeEvents registers a subscription to the state changes of the core. The events are delivered without
+// blocking the core: those which do not fit in the channel of the subscriber are dropped.
+func (c *Core) SubscribeEvents(ch chan<- events.CoreEvent) event.Subscription {
+	sub := &coreEventSub{ch: ch}
+	c.coreEventSubsLock.Lock()
+	c.coreEventSubs = append(c.coreEventSubs, sub)
+	c.coreEventSubsLock.Unlock()
+
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		<-quit
+		c.coreEventSubsLock.Lock()
+		for i, s := range c.coreEventSubs {
+			if s == sub {
+				c.coreEventSubs = append(c.coreEventSubs[:i], c.coreEventSubs[i+1:]...)
+				break
+			}
+		}
+		c.coreEventSubsLock.Unlock()
+		if dropped := sub.dropped.Load(); dropped > 0 {
+			c.logger.Debug("Consensus event subscriber lagged behind", "dropped", dropped)
+		}
+		return nil
+	})
+}
+
+// emit streams the state change to the consensus event subscribers. The event is only built if there are any.
+func (c *Core) emit(build func() events.CoreEvent) {
+	c.coreEventSubsLock.RLock()
+	defer c.coreEventSubsLock.RUnlock()
+	if len(c.coreEventSubs) == 0 {
+		return
+	}
+	ev := build()
+	ev.Time = time.Now()
+	for _, sub := range c.coreEventSubs {
+		select {
+		case sub.ch <- ev:
+		default:
+			if sub.dropped.Add(1) == 1 {
+				c.logger.Warn("Consensus event subscriber lagging behind, dropping events")
+			}
+		}
+	}
+}
+
 func (c *Core) Commit(ctx context.Context, round int64, messages *message.RoundMessages) {
 	c.SetStep(ctx, PrecommitDone)
 	// for metrics
@@ -271,10 +361,20 @@ func (c *Core) Commit(ctx context.Context, round int64, messages *message.RoundM
 	precommitWithQuorum := messages.PrecommitFor(proposalHash)
 	quorumCertificate := types.NewAggregateSignature(precommitWithQuorum.Signature().(*blst.BlsSignature), precommitWithQuorum.Signers())
 
+	c.walRecord(WALDecision, &walDecision{Height: proposal.H(), Round: uint64(round), Value: proposalHash})
 	if err := c.backend.Commit(proposal.Block(), round, quorumCertificate); err != nil {
 		c.logger.Error("failed to commit a block", "err", err)
 		return
 	}
+	c.emit(func() events.CoreEvent {
+		var signers []common.Address
+		for i, member := range c.CommitteeSet().Committee().Members {
+			if quorumCertificate.Signers.Bits.Get(i) > 0 {
+				signers = append(signers, member.Address)
+			}
+		}
+		return events.CoreEvent{Type: events.CoreCommit, Height: proposal.H(), Round: round, Value: &proposalHash, Signers: signers}
+	})
 	if metrics.Enabled {
 		now := time.Now()
 		CommitTimer.Update(now.Sub(start))
@@ -331,6 +431,13 @@ func (c *Core) StartRound(ctx context.Context, round int64) {
 	c.measureHeightRoundMetrics(round)
 	// Set initial FSM state
 	c.setInitialState(round)
+	if round == 0 {
+		c.updateTimeouts(previousRound)
+		c.walStartHeight()
+	}
+	c.emit(func() events.CoreEvent {
+		return events.CoreEvent{Type: events.CoreRound, Height: c.Height().Uint64(), Round: round}
+	})
 	c.SetStep(ctx, Propose)
 	c.logger.Debug("Starting new Round", "Height", c.Height(), "Round", round)
 
@@ -386,6 +493,7 @@ func (c *Core) setInitialState(r int64) {
 		c.lockedValue = nil
 		c.validRound = -1
 		c.validValue = nil
+		c.restoreSignJournal()
 		c.messages.Reset()
 		c.futureRoundLock.Lock()
 		c.futureRound = make(map[int64][]message.Msg)
@@ -463,6 +571,9 @@ func (c *Core) SetStep(ctx context.Context, step Step) {
 	c.logger.Debug("Step change", "from", c.step.String(), "to", step.String(), "round", c.Round())
 	c.step = step
 	c.stepChange = now
+	c.emit(func() events.CoreEvent {
+		return events.CoreEvent{Type: events.CoreStep, Height: c.Height().Uint64(), Round: c.Round(), Step: step.String()}
+	})
 
 	// stop consensus timeouts
 	c.stopAllTimeouts()
@@ -629,6 +740,7 @@ func (c *Core) IsProposer() bool {
 }
 
 func (c *Core) BroadcastAll(msg message.Msg) {
+	c.walRecordMessage(WALBroadcast, msg)
 	c.Backend().Broadcast(c.CommitteeSet().Committee(), msg)
 }
 
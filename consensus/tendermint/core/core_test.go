@@ -13,6 +13,7 @@ import (
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/consensus/tendermint/core/interfaces"
 	"github.com/autonity/autonity/consensus/tendermint/core/message"
+	"github.com/autonity/autonity/consensus/tendermint/events"
 	"github.com/autonity/autonity/core/types"
 	"github.com/autonity/autonity/log"
 	"github.com/autonity/autonity/metrics"
@@ -255,3 +256,42 @@ func TestProcessFuture(t *testing.T) {
 		c.processFuture(3, 0)
 	})
 }
+
+func TestCoreEventsSubscriberNeverReading(t *testing.T) {
+	c := &Core{logger: log.New("Core", "test", "id", 0)}
+	stuck := make(chan events.CoreEvent, 1)
+	stuckSub := c.SubscribeEvents(stuck)
+	defer stuckSub.Unsubscribe()
+	reader := make(chan events.CoreEvent, 10)
+	readerSub := c.SubscribeEvents(reader)
+	defer readerSub.Unsubscribe()
+
+	// the core keeps streaming once the channel of the subscriber which never reads is full
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < 10; i++ {
+			round := int64(i)
+			c.emit(func() events.CoreEvent { return events.CoreEvent{Type: events.CoreRound, Round: round} })
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("core blocked by a subscriber")
+	}
+	require.Len(t, reader, 10)
+	require.Len(t, stuck, 1)
+	require.Equal(t, uint64(9), c.coreEventSubs[0].dropped.Load())
+
+	// an unsubscribed subscriber is not streamed to anymore
+	stuckSub.Unsubscribe()
+	require.Len(t, c.coreEventSubs, 1)
+	<-stuck
+	for len(reader) > 0 {
+		<-reader
+	}
+	c.emit(func() events.CoreEvent { return events.CoreEvent{Type: events.CoreRound} })
+	require.Len(t, reader, 1)
+	require.Len(t, stuck, 0)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/autonity/autonity/consensus/tendermint/core/message"
 	"github.com/autonity/autonity/consensus/tendermint/events"
 	"github.com/autonity/autonity/metrics"
+	"github.com/autonity/autonity/params"
 )
 
 // todo: resolve proper tendermint state synchronization timeout from block period.
@@ -28,8 +29,16 @@ func (c *Core) Start(ctx context.Context, contract *autonity.ProtocolContracts)
 	}
 	c.epoch = epoch
 	c.protocolContracts = contract
-	committeeSet := com.NewWeightedRandomSamplingCommittee(chainHead, epoch.Committee, c.protocolContracts)
+	var config *params.ChainConfig
+	bc := c.backend.BlockChain()
+	if bc != nil {
+		config = bc.Config()
+	}
+	committeeSet := com.NewProtocolCommittee(chainHead, epoch.Committee, c.protocolContracts, config)
 	c.setCommitteeSet(committeeSet)
+	if bc != nil {
+		c.loadSignJournal(bc.StateCache().TrieDB().DiskDB())
+	}
 
 	ctx, c.cancel = context.WithCancel(ctx)
 	c.subscribeEvents()
@@ -157,6 +166,7 @@ eventLoop:
 			}
 			newCandidateBlockEvent := ev
 			pb := &newCandidateBlockEvent.NewCandidateBlock
+			c.walRecord(WALCandidate, pb)
 			c.proposer.HandleNewCandidateBlockMsg(ctx, pb)
 			if metrics.Enabled && c.IsProposer() {
 				CandidateBlockDelayBg.Add(time.Since(newCandidateBlockEvent.CreatedAt).Nanoseconds())
@@ -165,80 +175,20 @@ eventLoop:
 			if !ok {
 				break eventLoop
 			}
-			start := time.Now()
 			// An event arrived, process content
 			switch e := ev.Data.(type) {
 			case events.MessageEvent:
 				if metrics.Enabled {
 					AggregatorCoreTransitBg.Add(time.Since(e.Posted).Nanoseconds())
 				}
-				msg := e.Message
-
-				var hadQuorum bool
-				if !c.noGossip {
-					// check if we have quorum for message type for this round
-					hadQuorum = c.quorumFor(msg.Code(), msg.R(), msg.Value())
-				}
-
-				if err := c.handleMsg(ctx, msg); err != nil {
-					c.logger.Debug("MessageEvent payload failed", "err", err)
-					// filter errors which needs remote peer disconnection
-					if shouldDisconnectSender(err) {
-						tryDisconnect(e.ErrCh, err)
-					}
-					break
-				}
-
-				if !c.noGossip {
-					if !hadQuorum {
-						// if we did not have quorum and we reached it now
-						// gossip the (complex) aggregate with quorum to everyone instead of the current message
-						hasQuorum := c.quorumFor(msg.Code(), msg.R(), msg.Value())
-						if hasQuorum {
-							c.GossipComplexAggregate(msg.Code(), msg.R(), msg.Value())
-							recordMessageProcessingTime(msg.Code(), start)
-							break // do not gossip single message, only complex aggregate
-						}
-					}
-
-					// gossip message. We should arrive here only if we did not already gossip a complex aggregate
-					go c.backend.Gossip(c.CommitteeSet().Committee(), msg)
-					recordMessageProcessingTime(msg.Code(), start)
-				}
+				c.walRecordMessage(WALMessage, e.Message)
+				c.handleMessageEvent(ctx, e.Message, e.ErrCh)
 			case backlogMessageEvent:
 				// TODO: should we check for disconnection also here for future round msgs?
 				// need probably to store the errCh? verify if possible.
-
-				msg := e.msg
-
-				var hadQuorum bool
-				if !c.noGossip {
-					// check if we have quorum for message type for this round
-					hadQuorum = c.quorumFor(msg.Code(), msg.R(), msg.Value())
-				}
-
 				c.logger.Debug("Handling consensus backlog event")
-				if err := c.handleMsg(ctx, msg); err != nil {
-					c.logger.Debug("BacklogEvent message handling failed", "err", err)
-					continue
-				}
-
-				if !c.noGossip {
-					if !hadQuorum {
-						// if we did not have quorum and we reached it now
-						// gossip the (complex) aggregate with quorum to everyone instead of the current message
-						hasQuorum := c.quorumFor(msg.Code(), msg.R(), msg.Value())
-						if hasQuorum {
-							c.GossipComplexAggregate(msg.Code(), msg.R(), msg.Value())
-							recordMessageProcessingTime(msg.Code(), start)
-							break // do not gossip single message, only complex aggregate
-						}
-					}
-
-					// gossip message. We should arrive here only if we did not already gossip a complex aggregate
-					go c.backend.Gossip(c.CommitteeSet().Committee(), msg)
-					recordMessageProcessingTime(msg.Code(), start)
-				}
+				c.walRecordMessage(WALBacklog, e.msg)
+				c.handleMessageEvent(ctx, e.msg, nil)
 			case StateRequestEvent:
 				// Process Tendermint state dump request.
 				c.handleStateDump(e)
@@ -248,24 +198,14 @@ eventLoop:
 				break eventLoop
 			}
 			if timeoutE, ok := ev.Data.(TimeoutEvent); ok {
-				// if we already decided on this height block, ignore the timeout. It is useless by now.
-				if c.step == PrecommitDone {
-					c.logTimeoutEvent("Timer expired while at PrecommitDone step, ignoring", "", timeoutE)
-					continue
-				}
-				switch timeoutE.Step {
-				case Propose:
-					c.handleTimeoutPropose(ctx, timeoutE)
-				case Prevote:
-					c.handleTimeoutPrevote(ctx, timeoutE)
-				case Precommit:
-					c.handleTimeoutPrecommit(ctx, timeoutE)
-				}
+				c.walRecordTimeout(timeoutE)
+				c.handleTimeoutEvent(ctx, timeoutE)
 			}
 		case _, ok := <-c.committedCh:
 			if !ok {
 				break eventLoop
 			}
+			c.walRecord(WALCommitted, c.Height().Uint64())
 			c.precommiter.HandleCommit(ctx)
 		case <-ctx.Done():
 			c.logger.Debug("Tendermint core main loop stopped", "event", ctx.Err())
@@ -275,6 +215,60 @@ eventLoop:
 	c.stopped <- struct{}{}
 }
 
+// handleMessageEvent processes a message delivered by the aggregator, or re-injected from the backlog, then gossips
+// it. Once a quorum is reached, the (complex) aggregate carrying the quorum is gossiped instead of the message.
+func (c *Core) handleMessageEvent(ctx context.Context, msg message.Msg, errCh chan<- error) {
+	start := time.Now()
+
+	var hadQuorum bool
+	if !c.noGossip {
+		// check if we have quorum for message type for this round
+		hadQuorum = c.quorumFor(msg.Code(), msg.R(), msg.Value())
+	}
+
+	if err := c.handleMsg(ctx, msg); err != nil {
+		c.logger.Debug("Consensus message handling failed", "err", err)
+		// filter errors which needs remote peer disconnection
+		if shouldDisconnectSender(err) {
+			tryDisconnect(errCh, err)
+		}
+		return
+	}
+
+	if c.noGossip {
+		return
+	}
+	if !hadQuorum {
+		// if we did not have quorum and we reached it now
+		// gossip the (complex) aggregate with quorum to everyone instead of the current message
+		if c.quorumFor(msg.Code(), msg.R(), msg.Value()) {
+			c.GossipComplexAggregate(msg.Code(), msg.R(), msg.Value())
+			recordMessageProcessingTime(msg.Code(), start)
+			return // do not gossip single message, only complex aggregate
+		}
+	}
+
+	// gossip message. We should arrive here only if we did not already gossip a complex aggregate
+	go c.backend.Gossip(c.CommitteeSet().Committee(), msg)
+	recordMessageProcessingTime(msg.Code(), start)
+}
+
+func (c *Core) handleTimeoutEvent(ctx context.Context, timeout TimeoutEvent) {
+	// if we already decided on this height block, ignore the timeout. It is useless by now.
+	if c.step == PrecommitDone {
+		c.logTimeoutEvent("Timer expired while at PrecommitDone step, ignoring", "", timeout)
+		return
+	}
+	switch timeout.Step {
+	case Propose:
+		c.handleTimeoutPropose(ctx, timeout)
+	case Prevote:
+		c.handleTimeoutPrevote(ctx, timeout)
+	case Precommit:
+		c.handleTimeoutPrecommit(ctx, timeout)
+	}
+}
+
 func (c *Core) syncLoop(ctx context.Context) {
 	/*
 		this method is responsible for asking the network to send us the current consensus state
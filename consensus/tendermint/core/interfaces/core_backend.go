@@ -88,10 +88,19 @@ type Backend interface {
 	MessageCh() <-chan events.UnverifiedMessageEvent
 }
 
+// MessageSigner can be implemented by a Backend to sign the consensus messages of the local validator from their
+// content rather than from their signature input only. This is required to delegate signatures to an external signer
+// enforcing slashing protection, which may refuse to sign.
+type MessageSigner interface {
+	SignMessage(request *message.SignatureRequest) (blst.Signature, error)
+}
+
 type Core interface {
 	Start(ctx context.Context, contract *autonity.ProtocolContracts)
 	Stop()
 	CoreState() CoreState
+	// SubscribeEvents registers a subscription to the state changes of the core
+	SubscribeEvents(ch chan<- events.CoreEvent) event.Subscription
 	Broadcaster() Broadcaster
 	Proposer() Proposer
 	Prevoter() Prevoter
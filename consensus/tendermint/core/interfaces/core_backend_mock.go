@@ -562,6 +562,20 @@ func (mr *MockCoreMockRecorder) Stop() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockCore)(nil).Stop))
 }
 
+// SubscribeEvents mocks base method.
+func (m *MockCore) SubscribeEvents(ch chan<- events.CoreEvent) event.Subscription {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeEvents", ch)
+	ret0, _ := ret[0].(event.Subscription)
+	return ret0
+}
+
+// SubscribeEvents indicates an expected call of SubscribeEvents.
+func (mr *MockCoreMockRecorder) SubscribeEvents(ch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeEvents", reflect.TypeOf((*MockCore)(nil).SubscribeEvents), ch)
+}
+
 // VotesPower mocks base method.
 func (m *MockCore) VotesPower(h uint64, r int64, code uint8) *message.AggregatedPower {
 	m.ctrl.T.Helper()
@@ -16,4 +16,6 @@ type Gossiper interface {
 	KnownMessages() *fixsizecache.Cache[common.Hash, bool]
 	Address() common.Address
 	UpdateStopChannel(chan struct{})
+	// Proposal returns a proposal recently gossiped in its compact form, given the hash of the compact proposal
+	Proposal(compactHash common.Hash) (*message.Propose, bool)
 }
@@ -108,6 +108,21 @@ func (mr *MockGossiperMockRecorder) KnownMessages() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KnownMessages", reflect.TypeOf((*MockGossiper)(nil).KnownMessages))
 }
 
+// Proposal mocks base method.
+func (m *MockGossiper) Proposal(compactHash common.Hash) (*message.Propose, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Proposal", compactHash)
+	ret0, _ := ret[0].(*message.Propose)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Proposal indicates an expected call of Proposal.
+func (mr *MockGossiperMockRecorder) Proposal(compactHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Proposal", reflect.TypeOf((*MockGossiper)(nil).Proposal), compactHash)
+}
+
 // SetBroadcaster mocks base method.
 func (m *MockGossiper) SetBroadcaster(broadcaster consensus.Broadcaster) {
 	m.ctrl.T.Helper()
@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"math/big"
+	"time"
 
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/consensus/tendermint/core/message"
@@ -66,6 +67,10 @@ type CoreState struct {
 	ProposeTimerStarted   bool
 	PrevoteTimerStarted   bool
 	PrecommitTimerStarted bool
+	// timeouts of the current round
+	ProposeTimeout   time.Duration
+	PrevoteTimeout   time.Duration
+	PrecommitTimeout time.Duration
 
 	// current height messages.
 	CurHeightMessages []*MsgForDump
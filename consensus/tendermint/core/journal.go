@@ -0,0 +1,286 @@
+package core
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/consensus/tendermint/core/constants"
+	"github.com/autonity/autonity/consensus/tendermint/core/message"
+	"github.com/autonity/autonity/core/rawdb"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/ethdb"
+	"github.com/autonity/autonity/rlp"
+)
+
+// SignJournal is the durable record of the last consensus message signed by the local validator, along with the
+// locked and valid round and value at the time of signing. It is persisted before the message is broadcast so that a
+// restarted validator does not sign a message equivocating with one sent before the restart.
+type SignJournal struct {
+	LastSigned  message.SignatureRequest
+	LockedRound int64
+	LockedValue *types.Block
+	ValidRound  int64
+	ValidValue  *types.Block
+
+	// hashes of the locked and valid values, set when decoding
+	lockedHash common.Hash
+	validHash  common.Hash
+}
+
+// extSignJournal is the RLP encoding of SignJournal, rounds being encoded the same way as in proposals. The locked and
+// valid values are always referenced by hash, the blocks themselves being left out of the journal stored in the
+// database, where each block is stored once under its own key.
+type extSignJournal struct {
+	Code                  uint8
+	Height                uint64
+	Round                 uint64
+	SignedValidRound      uint64
+	IsSignedValidRoundNil bool
+	Value                 common.Hash
+	LockedRound           uint64
+	IsLockedRoundNil      bool
+	LockedHash            common.Hash
+	LockedValue           *types.Block `rlp:"nil"`
+	ValidRound            uint64
+	IsValidRoundNil       bool
+	ValidHash             common.Hash
+	ValidValue            *types.Block `rlp:"nil"`
+}
+
+func encodeRound(r int64) (uint64, bool) {
+	if r < 0 {
+		return 0, true
+	}
+	return uint64(r), false
+}
+
+func decodeRound(r uint64, isNil bool) int64 {
+	if isNil {
+		return -1
+	}
+	return int64(r)
+}
+
+func blockHash(block *types.Block) common.Hash {
+	if block == nil {
+		return common.Hash{}
+	}
+	return block.Hash()
+}
+
+// ext returns the RLP encoding of the journal, with the locked and valid blocks if withValues is set.
+func (j *SignJournal) ext(withValues bool) *extSignJournal {
+	ext := &extSignJournal{
+		Code:       j.LastSigned.Code,
+		Height:     j.LastSigned.Height,
+		Round:      uint64(j.LastSigned.Round),
+		Value:      j.LastSigned.Value,
+		LockedHash: blockHash(j.LockedValue),
+		ValidHash:  blockHash(j.ValidValue),
+	}
+	if withValues {
+		ext.LockedValue = j.LockedValue
+		ext.ValidValue = j.ValidValue
+	}
+	ext.SignedValidRound, ext.IsSignedValidRoundNil = encodeRound(j.LastSigned.ValidRound)
+	ext.LockedRound, ext.IsLockedRoundNil = encodeRound(j.LockedRound)
+	ext.ValidRound, ext.IsValidRoundNil = encodeRound(j.ValidRound)
+	return ext
+}
+
+// values returns the distinct blocks referenced by the journal.
+func (j *SignJournal) values() map[common.Hash]*types.Block {
+	values := make(map[common.Hash]*types.Block, 2)
+	for _, block := range []*types.Block{j.LockedValue, j.ValidValue} {
+		if block != nil {
+			values[block.Hash()] = block
+		}
+	}
+	return values
+}
+
+// EncodeRLP encodes the journal along with its locked and valid blocks.
+func (j *SignJournal) EncodeRLP(w io.Writer) error {
+	return rlp.Encode(w, j.ext(true))
+}
+
+func (j *SignJournal) DecodeRLP(s *rlp.Stream) error {
+	ext := new(extSignJournal)
+	if err := s.Decode(ext); err != nil {
+		return err
+	}
+	j.LastSigned = message.SignatureRequest{
+		Code:       ext.Code,
+		Height:     ext.Height,
+		Round:      int64(ext.Round),
+		ValidRound: decodeRound(ext.SignedValidRound, ext.IsSignedValidRoundNil),
+		Value:      ext.Value,
+	}
+	j.LockedRound = decodeRound(ext.LockedRound, ext.IsLockedRoundNil)
+	j.LockedValue = ext.LockedValue
+	j.lockedHash = ext.LockedHash
+	j.ValidRound = decodeRound(ext.ValidRound, ext.IsValidRoundNil)
+	j.ValidValue = ext.ValidValue
+	j.validHash = ext.ValidHash
+	return nil
+}
+
+// Check returns constants.ErrDoubleSign if the message described by request may equivocate with the last signed
+// message. Messages are only allowed at a later height, round or step than the last signed one, or if they are
+// identical to it.
+func (j *SignJournal) Check(request *message.SignatureRequest) error {
+	last := j.LastSigned
+	switch {
+	case request.Height != last.Height:
+		if request.Height < last.Height {
+			return fmt.Errorf("%w: height %d is below last signed height %d", constants.ErrDoubleSign, request.Height, last.Height)
+		}
+	case request.Round != last.Round:
+		if request.Round < last.Round {
+			return fmt.Errorf("%w: round %d is below last signed round %d", constants.ErrDoubleSign, request.Round, last.Round)
+		}
+	case request.Code != last.Code:
+		if request.Code < last.Code {
+			return fmt.Errorf("%w: %s step is before last signed %s step", constants.ErrDoubleSign, request.Step(), last.Step())
+		}
+	case *request != last:
+		return fmt.Errorf("%w: %s for %v conflicts with last signed %s for %v", constants.ErrDoubleSign, request.Step(), request.Value, last.Step(), last.Value)
+	}
+	return nil
+}
+
+// ReadSignJournal retrieves the sign journal from db. It returns nil if no message was ever journaled.
+func ReadSignJournal(db ethdb.KeyValueReader) (*SignJournal, error) {
+	data := rawdb.ReadSignJournal(db)
+	if len(data) == 0 {
+		return nil, nil
+	}
+	journal := new(SignJournal)
+	if err := rlp.DecodeBytes(data, journal); err != nil {
+		return nil, err
+	}
+	readBlock := func(hash common.Hash) (*types.Block, error) {
+		if hash == (common.Hash{}) {
+			return nil, nil
+		}
+		data := rawdb.ReadSignJournalBlock(db, hash)
+		if len(data) == 0 {
+			return nil, fmt.Errorf("missing sign journal block %v", hash)
+		}
+		block := new(types.Block)
+		if err := rlp.DecodeBytes(data, block); err != nil {
+			return nil, err
+		}
+		return block, nil
+	}
+	var err error
+	if journal.LockedValue, err = readBlock(journal.lockedHash); err != nil {
+		return nil, err
+	}
+	if journal.ValidValue, err = readBlock(journal.validHash); err != nil {
+		return nil, err
+	}
+	return journal, nil
+}
+
+// WriteSignJournal atomically persists the sign journal to db. The locked and valid blocks are written only if they
+// are not already referenced by the previous journal, whose blocks no longer referenced get deleted.
+func WriteSignJournal(db ethdb.KeyValueStore, journal, previous *SignJournal) error {
+	batch := db.NewBatch()
+	stored := make(map[common.Hash]*types.Block)
+	if previous != nil {
+		stored = previous.values()
+	}
+	values := journal.values()
+	for hash, block := range values {
+		if _, ok := stored[hash]; ok {
+			continue
+		}
+		data, err := rlp.EncodeToBytes(block)
+		if err != nil {
+			return err
+		}
+		if err := rawdb.WriteSignJournalBlock(batch, hash, data); err != nil {
+			return err
+		}
+	}
+	data, err := rlp.EncodeToBytes(journal.ext(false))
+	if err != nil {
+		return err
+	}
+	if err := rawdb.WriteSignJournal(batch, data); err != nil {
+		return err
+	}
+	for hash := range stored {
+		if _, ok := values[hash]; !ok {
+			if err := rawdb.DeleteSignJournalBlock(batch, hash); err != nil {
+				return err
+			}
+		}
+	}
+	return batch.Write()
+}
+
+// loadSignJournal loads the sign journal from db. From then on, every message signed by the core is checked against
+// and recorded into the journal.
+func (c *Core) loadSignJournal(db ethdb.KeyValueStore) {
+	journal, err := ReadSignJournal(db)
+	if err != nil {
+		// without the journal we cannot guarantee that we won't equivocate
+		c.logger.Crit("Failed to read the sign journal, it can be reset with `autonity db signjournal reset`", "err", err)
+	}
+	c.signJournalDB = db
+	c.signJournal = journal
+	if journal != nil {
+		c.logger.Info("Loaded sign journal", "height", journal.LastSigned.Height, "round", journal.LastSigned.Round,
+			"step", journal.LastSigned.Step(), "value", journal.LastSigned.Value)
+	}
+}
+
+// restoreSignJournal restores the locked and valid round and value recorded in the sign journal, if they belong to
+// the current height.
+func (c *Core) restoreSignJournal() {
+	journal := c.signJournal
+	if journal == nil || journal.LastSigned.Height != c.Height().Uint64() {
+		return
+	}
+	if journal.LockedRound != -1 && journal.LockedValue != nil {
+		c.lockedRound = journal.LockedRound
+		c.lockedValue = journal.LockedValue
+	}
+	if journal.ValidRound != -1 && journal.ValidValue != nil {
+		c.validRound = journal.ValidRound
+		c.validValue = journal.ValidValue
+	}
+	c.logger.Info("Restored consensus state from the sign journal", "height", journal.LastSigned.Height,
+		"lockedRound", c.lockedRound, "validRound", c.validRound)
+}
+
+// checkSignJournal returns an error if the message described by request may equivocate with an already signed one.
+func (c *Core) checkSignJournal(request *message.SignatureRequest) error {
+	if c.signJournal == nil {
+		return nil
+	}
+	return c.signJournal.Check(request)
+}
+
+// recordSignJournal persists the message described by request as the last signed one, along with the current locked
+// and valid round and value.
+func (c *Core) recordSignJournal(request *message.SignatureRequest) error {
+	if c.signJournalDB == nil {
+		return nil
+	}
+	journal := &SignJournal{
+		LastSigned:  *request,
+		LockedRound: c.lockedRound,
+		LockedValue: c.lockedValue,
+		ValidRound:  c.validRound,
+		ValidValue:  c.validValue,
+	}
+	if err := WriteSignJournal(c.signJournalDB, journal, c.signJournal); err != nil {
+		return err
+	}
+	c.signJournal = journal
+	return nil
+}
@@ -0,0 +1,188 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/consensus/tendermint/core/constants"
+	"github.com/autonity/autonity/consensus/tendermint/core/interfaces"
+	"github.com/autonity/autonity/consensus/tendermint/core/message"
+	"github.com/autonity/autonity/core/rawdb"
+	"github.com/autonity/autonity/log"
+	"github.com/autonity/autonity/rlp"
+)
+
+func TestSignJournalCheck(t *testing.T) {
+	value := common.HexToHash("0x01")
+	journal := &SignJournal{
+		LastSigned:  message.SignatureRequest{Code: message.PrevoteCode, Height: 10, Round: 2, ValidRound: -1, Value: value},
+		LockedRound: -1,
+		ValidRound:  -1,
+	}
+	tests := []struct {
+		name    string
+		request message.SignatureRequest
+		allowed bool
+	}{
+		{"identical message", journal.LastSigned, true},
+		{"conflicting value", message.SignatureRequest{Code: message.PrevoteCode, Height: 10, Round: 2, ValidRound: -1}, false},
+		{"earlier step", message.SignatureRequest{Code: message.ProposalCode, Height: 10, Round: 2, ValidRound: -1, Value: value}, false},
+		{"later step", message.SignatureRequest{Code: message.PrecommitCode, Height: 10, Round: 2, ValidRound: -1}, true},
+		{"earlier round", message.SignatureRequest{Code: message.PrecommitCode, Height: 10, Round: 1, ValidRound: -1, Value: value}, false},
+		{"later round", message.SignatureRequest{Code: message.ProposalCode, Height: 10, Round: 3, ValidRound: 2, Value: value}, true},
+		{"earlier height", message.SignatureRequest{Code: message.PrecommitCode, Height: 9, Round: 5, ValidRound: -1, Value: value}, false},
+		{"later height", message.SignatureRequest{Code: message.ProposalCode, Height: 11, Round: 0, ValidRound: -1}, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := journal.Check(&test.request)
+			if test.allowed {
+				require.NoError(t, err)
+			} else {
+				require.True(t, errors.Is(err, constants.ErrDoubleSign))
+			}
+		})
+	}
+}
+
+func TestSignJournalEncoding(t *testing.T) {
+	block := generateBlock(big.NewInt(10))
+	journal := &SignJournal{
+		LastSigned:  message.SignatureRequest{Code: message.ProposalCode, Height: 10, Round: 3, ValidRound: -1, Value: block.Hash()},
+		LockedRound: 1,
+		LockedValue: block,
+		ValidRound:  -1,
+	}
+	encoded, err := rlp.EncodeToBytes(journal)
+	require.NoError(t, err)
+	decoded := new(SignJournal)
+	require.NoError(t, rlp.DecodeBytes(encoded, decoded))
+	require.Equal(t, journal.LastSigned, decoded.LastSigned)
+	require.Equal(t, int64(1), decoded.LockedRound)
+	require.Equal(t, block.Hash(), decoded.LockedValue.Hash())
+	require.Equal(t, int64(-1), decoded.ValidRound)
+	require.Nil(t, decoded.ValidValue)
+}
+
+func TestSignJournalStorage(t *testing.T) {
+	db := rawdb.NewMemoryDatabase()
+	countBlocks := func() int {
+		it := db.NewIterator([]byte("TendermintSignJournalBlock"), nil)
+		defer it.Release()
+		count := 0
+		for it.Next() {
+			count++
+		}
+		return count
+	}
+	first, second := generateBlock(big.NewInt(10)), generateBlock(big.NewInt(10))
+
+	// a block both locked and valid is stored once
+	journal := &SignJournal{
+		LastSigned:  message.SignatureRequest{Code: message.PrecommitCode, Height: 10, Round: 1, ValidRound: -1, Value: first.Hash()},
+		LockedRound: 1,
+		LockedValue: first,
+		ValidRound:  1,
+		ValidValue:  first,
+	}
+	require.NoError(t, WriteSignJournal(db, journal, nil))
+	require.Equal(t, 1, countBlocks())
+	read, err := ReadSignJournal(db)
+	require.NoError(t, err)
+	require.Equal(t, journal.LastSigned, read.LastSigned)
+	require.Equal(t, first.Hash(), read.LockedValue.Hash())
+	require.Equal(t, first.Hash(), read.ValidValue.Hash())
+
+	// the journal itself only references the blocks
+	require.Less(t, len(rawdb.ReadSignJournal(db)), 200)
+
+	// the blocks no longer referenced are deleted
+	next := &SignJournal{
+		LastSigned:  message.SignatureRequest{Code: message.PrevoteCode, Height: 10, Round: 2, ValidRound: -1, Value: second.Hash()},
+		LockedRound: 1,
+		LockedValue: first,
+		ValidRound:  2,
+		ValidValue:  second,
+	}
+	require.NoError(t, WriteSignJournal(db, next, read))
+	require.Equal(t, 2, countBlocks())
+	last := &SignJournal{
+		LastSigned:  message.SignatureRequest{Code: message.ProposalCode, Height: 11, Round: 0, ValidRound: -1},
+		LockedRound: -1,
+		ValidRound:  -1,
+	}
+	require.NoError(t, WriteSignJournal(db, last, next))
+	require.Equal(t, 0, countBlocks())
+	read, err = ReadSignJournal(db)
+	require.NoError(t, err)
+	require.Nil(t, read.LockedValue)
+	require.Nil(t, read.ValidValue)
+
+	rawdb.DeleteSignJournal(db)
+	read, err = ReadSignJournal(db)
+	require.NoError(t, err)
+	require.Nil(t, read)
+}
+
+func TestSignJournalAcrossRestart(t *testing.T) {
+	db := rawdb.NewMemoryDatabase()
+	committeeSet, keys := NewTestCommitteeSetWithKeys(4)
+	member := committeeSet.Committee().Members[0]
+	signer := makeSigner(keys[member.Address].consensus)
+
+	newCore := func(backend interfaces.Backend) *Core {
+		messages := message.NewMap()
+		c := &Core{
+			logger:           log.New("backend", "test", "id", 0),
+			backend:          backend,
+			address:          member.Address,
+			messages:         messages,
+			curRoundMessages: messages.GetOrCreate(1),
+			committee:        committeeSet,
+			round:            1,
+			height:           big.NewInt(5),
+			lockedRound:      -1,
+			validRound:       -1,
+		}
+		c.SetDefaultHandlers()
+		c.loadSignJournal(db)
+		return c
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	backendMock := interfaces.NewMockBackend(ctrl)
+	backendMock.EXPECT().Sign(gomock.Any()).DoAndReturn(signer).Times(1)
+	backendMock.EXPECT().Broadcast(gomock.Any(), gomock.Any()).Times(1)
+
+	// lock on a value and precommit it
+	c := newCore(backendMock)
+	locked := generateBlock(big.NewInt(5))
+	c.lockedRound = 1
+	c.lockedValue = locked
+	c.curRoundMessages.SetProposal(generateBlockProposal(1, big.NewInt(5), -1, false, signer, &member), true)
+	c.precommiter.SendPrecommit(context.Background(), false)
+	require.True(t, c.sentPrecommit)
+
+	journal, err := ReadSignJournal(db)
+	require.NoError(t, err)
+	require.Equal(t, message.PrecommitCode, journal.LastSigned.Code)
+	require.Equal(t, locked.Hash(), journal.LockedValue.Hash())
+
+	// after a restart, the lock is restored and a conflicting precommit is not signed nor broadcast
+	restarted := newCore(backendMock)
+	restarted.restoreSignJournal()
+	require.Equal(t, int64(1), restarted.lockedRound)
+	require.Equal(t, locked.Hash(), restarted.lockedValue.Hash())
+
+	restarted.precommiter.SendPrecommit(context.Background(), true)
+	require.False(t, restarted.sentPrecommit)
+	_, err = restarted.Signer(&message.SignatureRequest{Code: message.PrevoteCode, Height: 5, Round: 1, ValidRound: -1})
+	require.True(t, errors.Is(err, constants.ErrDoubleSign))
+}
@@ -0,0 +1,212 @@
+package message
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/consensus/tendermint/core/constants"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/crypto/blst"
+	"github.com/autonity/autonity/rlp"
+	"github.com/autonity/autonity/trie"
+)
+
+var ErrTxRootMismatch = errors.New("reconstructed transactions do not match the transaction root")
+
+// CompactProposal is the form in which proposals are gossiped. It carries the header of the proposed block and the
+// hashes of its transactions, from which the receivers reconstruct the block out of their transaction pool. Since the proposal signature only covers the block hash, the reconstructed proposal is identical to the
+// original one, signature and payload included.
+type CompactProposal struct {
+	header     *types.Header
+	txHashes   []common.Hash
+	validRound uint64
+	isVRNil    bool
+	round      uint64
+	signer     common.Address
+	signature  *blst.BlsSignature
+	payload    []byte
+	hash       common.Hash
+}
+
+type extCompactProposal struct {
+	Code            uint8
+	Round           uint64
+	Height          uint64
+	ValidRound      uint64
+	IsValidRoundNil bool
+	Header          *types.Header
+	TxHashes        []common.Hash
+	Signer          common.Address
+	Signature       *blst.BlsSignature
+}
+
+// NewCompactProposal creates the compact form of a proposal.
+func NewCompactProposal(proposal *Propose) *CompactProposal {
+	block := proposal.block
+	txHashes := make([]common.Hash, len(block.Transactions()))
+	for i, tx := range block.Transactions() {
+		txHashes[i] = tx.Hash()
+	}
+	ext := extCompactProposal{
+		Code:            CompactProposalCode,
+		Round:           uint64(proposal.round),
+		Height:          proposal.height,
+		IsValidRoundNil: proposal.validRound == -1,
+		Header:          block.Header(),
+		TxHashes:        txHashes,
+		Signer:          proposal.signer,
+		Signature:       proposal.signature.(*blst.BlsSignature),
+	}
+	if !ext.IsValidRoundNil {
+		ext.ValidRound = uint64(proposal.validRound)
+	}
+	payload, _ := rlp.EncodeToBytes(&ext)
+	return &CompactProposal{
+		header:     ext.Header,
+		txHashes:   txHashes,
+		validRound: ext.ValidRound,
+		isVRNil:    ext.IsValidRoundNil,
+		round:      ext.Round,
+		signer:     ext.Signer,
+		signature:  ext.Signature,
+		payload:    payload,
+		hash:       crypto.Hash(payload),
+	}
+}
+
+func (c *CompactProposal) Code() uint8 {
+	return CompactProposalCode
+}
+
+func (c *CompactProposal) H() uint64 {
+	return c.header.Number.Uint64()
+}
+
+func (c *CompactProposal) R() int64 {
+	return int64(c.round)
+}
+
+// BlockHash returns the hash of the proposed block.
+func (c *CompactProposal) BlockHash() common.Hash {
+	return c.header.Hash()
+}
+
+// TxHashes returns the hashes of the transactions of the proposed block, in the block order.
+func (c *CompactProposal) TxHashes() []common.Hash {
+	return c.txHashes
+}
+
+func (c *CompactProposal) Signer() common.Address {
+	return c.signer
+}
+
+func (c *CompactProposal) Payload() []byte {
+	return c.payload
+}
+
+func (c *CompactProposal) Hash() common.Hash {
+	return c.hash
+}
+
+func (c *CompactProposal) String() string {
+	return fmt.Sprintf("{code: %v, h: %v, r: %v, hash: %v, BlockHash: %v, txs: %v, signer: %v}",
+		c.Code(), c.H(), c.round, c.hash, c.BlockHash(), len(c.txHashes), c.signer)
+}
+
+// Verify checks that the compact proposal is signed by its signer, a member of the committee of its height. The
+// reconstructed proposal is verified again as any other proposal.
+func (c *CompactProposal) Verify(committee *types.Committee) error {
+	validator := committee.MemberByAddress(c.signer)
+	if validator == nil {
+		return ErrUnauthorizedAddress
+	}
+	validRound := int64(-1)
+	if !c.isVRNil {
+		validRound = int64(c.validRound)
+	}
+	signatureInput := ProposalSignatureInput(c.H(), c.R(), validRound, c.BlockHash())
+	if !c.signature.Verify(validator.ConsensusKey, signatureInput[:]) {
+		return ErrBadSignature
+	}
+	return nil
+}
+
+// Reconstruct rebuilds the original proposal out of the transactions of the proposed block, which are checked
+// against the transaction root of the header. The proposal still needs to go through the usual verification steps.
+func (c *CompactProposal) Reconstruct(txs []*types.Transaction) (*Propose, error) {
+	if len(txs) != len(c.txHashes) {
+		return nil, fmt.Errorf("%w: %d transactions for %d hashes", constants.ErrInvalidMessage, len(txs), len(c.txHashes))
+	}
+	if types.DeriveSha(types.Transactions(txs), trie.NewStackTrie(nil)) != c.header.TxHash {
+		return nil, ErrTxRootMismatch
+	}
+	payload, err := rlp.EncodeToBytes(&extPropose{
+		Code:            ProposalCode,
+		Round:           c.round,
+		Height:          c.H(),
+		ValidRound:      c.validRound,
+		IsValidRoundNil: c.isVRNil,
+		ProposalBlock:   types.NewBlockWithHeader(c.header).WithBody(txs, nil),
+		Signer:          c.signer,
+		Signature:       c.signature,
+	})
+	if err != nil {
+		return nil, err
+	}
+	proposal := new(Propose)
+	if err := rlp.DecodeBytes(payload, proposal); err != nil {
+		return nil, err
+	}
+	return proposal, nil
+}
+
+func (c *CompactProposal) DecodeRLP(s *rlp.Stream) error {
+	payload, err := s.Raw()
+	if err != nil {
+		return err
+	}
+	ext := &extCompactProposal{}
+	if err := rlp.DecodeBytes(payload, ext); err != nil {
+		return err
+	}
+	if ext.Code != CompactProposalCode {
+		return constants.ErrInvalidMessage
+	}
+	if ext.Header == nil || ext.Header.Number == nil {
+		return constants.ErrInvalidMessage
+	}
+	if ext.Signature == nil {
+		return constants.ErrInvalidMessage
+	}
+	if ext.Round > constants.MaxRound || ext.ValidRound > constants.MaxRound {
+		return constants.ErrInvalidMessage
+	}
+	if ext.Height == 0 || ext.Height != ext.Header.Number.Uint64() {
+		return constants.ErrInvalidMessage
+	}
+	if ext.IsValidRoundNil {
+		if ext.ValidRound != 0 {
+			return constants.ErrInvalidMessage
+		}
+	} else if ext.ValidRound >= ext.Round {
+		return constants.ErrInvalidMessage
+	}
+	c.header = ext.Header
+	c.txHashes = ext.TxHashes
+	c.validRound = ext.ValidRound
+	c.isVRNil = ext.IsValidRoundNil
+	c.round = ext.Round
+	c.signer = ext.Signer
+	c.signature = ext.Signature
+	c.payload = payload
+	c.hash = crypto.Hash(payload)
+	return nil
+}
+
+func (c *CompactProposal) EncodeRLP(w io.Writer) error {
+	_, err := w.Write(c.payload)
+	return err
+}
@@ -0,0 +1,79 @@
+package message
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/crypto/blst"
+	"github.com/autonity/autonity/rlp"
+	"github.com/autonity/autonity/trie"
+)
+
+func TestCompactProposal(t *testing.T) {
+	var txs []*types.Transaction
+	for i := uint64(0); i < 5; i++ {
+		txs = append(txs, types.NewTx(&types.LegacyTx{Nonce: i, GasPrice: big.NewInt(1), Gas: 50000, To: &common.Address{1}, Data: make([]byte, 100)}))
+	}
+	header := &types.Header{Number: common.Big2}
+	block := types.NewBlock(header, txs, nil, nil, trie.NewStackTrie(nil))
+	proposal := NewPropose(3, 2, 1, block, defaultSigner, testCommitteeMember)
+
+	compact := &CompactProposal{}
+	require.NoError(t, rlp.DecodeBytes(NewCompactProposal(proposal).Payload(), compact))
+	require.Equal(t, uint64(2), compact.H())
+	require.Equal(t, int64(3), compact.R())
+	require.Equal(t, block.Hash(), compact.BlockHash())
+	require.Equal(t, testCommitteeMember.Address, compact.Signer())
+	require.Len(t, compact.TxHashes(), len(txs))
+	for i, tx := range txs {
+		require.Equal(t, tx.Hash(), compact.TxHashes()[i])
+	}
+	require.Less(t, len(compact.Payload()), len(proposal.Payload()))
+
+	t.Run("signature is verified against the committee", func(t *testing.T) {
+		committee := &types.Committee{Members: []types.CommitteeMember{*testCommitteeMember}}
+		require.NoError(t, compact.Verify(committee))
+
+		other, err := blst.RandKey()
+		require.NoError(t, err)
+		member := *testCommitteeMember
+		member.ConsensusKey = other.PublicKey()
+		require.ErrorIs(t, compact.Verify(&types.Committee{Members: []types.CommitteeMember{member}}), ErrBadSignature)
+		member.Address = common.Address{0xff}
+		require.ErrorIs(t, compact.Verify(&types.Committee{Members: []types.CommitteeMember{member}}), ErrUnauthorizedAddress)
+	})
+
+	t.Run("reconstruction yields the original proposal", func(t *testing.T) {
+		reconstructed, err := compact.Reconstruct(txs)
+		require.NoError(t, err)
+		require.Equal(t, proposal.Payload(), reconstructed.Payload())
+		require.Equal(t, proposal.Hash(), reconstructed.Hash())
+		require.Equal(t, proposal.SignatureInput(), reconstructed.SignatureInput())
+		require.Equal(t, proposal.ValidRound(), reconstructed.ValidRound())
+		require.False(t, reconstructed.Verified())
+	})
+
+	t.Run("reconstruction checks the transaction root", func(t *testing.T) {
+		reordered := []*types.Transaction{txs[1], txs[0], txs[2], txs[3], txs[4]}
+		_, err := compact.Reconstruct(reordered)
+		require.ErrorIs(t, err, ErrTxRootMismatch)
+		_, err = compact.Reconstruct(txs[:4])
+		require.Error(t, err)
+	})
+
+	t.Run("malformed compact proposals are rejected", func(t *testing.T) {
+		payload, err := rlp.EncodeToBytes(&extCompactProposal{
+			Code:      CompactProposalCode,
+			Round:     1,
+			Height:    3,
+			Header:    header,
+			Signature: proposal.signature.(*blst.BlsSignature),
+		})
+		require.NoError(t, err)
+		require.Error(t, rlp.DecodeBytes(payload, &CompactProposal{}))
+	})
+}
@@ -3,6 +3,8 @@
 // In addition to that, we have a special type, the "Light Proposal" which is being used for
 // accountability purposes. Light proposals are never directly brodcasted
 // over the network but always part of a proof object, defined in the accountability package.
+// Proposals are gossiped in their compact form, the "Compact Proposal", which carries the
+// block header and short transaction identifiers instead of the full block.
 // There are three ways that a consensus message can be instantiated:
 //   - using a "New" constructor, e.g. NewPrevote :
 //     The object is fully created, with signature and final payload already pre-computed. Ready for use.
@@ -42,10 +44,45 @@ const (
 	PrevoteCode
 	PrecommitCode
 	LightProposalCode
+	CompactProposalCode
 )
 
 type Signer func(hash common.Hash) blst.Signature
 
+// SignatureRequest holds the fields of a consensus message which are covered by its signature. It allows an external
+// signer to check what it is signing, e.g. against a slashing protection database, before signing the signature input.
+type SignatureRequest struct {
+	Code       uint8       `json:"code"`
+	Height     uint64      `json:"height"`
+	Round      int64       `json:"round"`
+	ValidRound int64       `json:"validRound"` // only relevant for proposals, -1 stands for a nil valid round
+	Value      common.Hash `json:"value"`
+}
+
+// SignatureInput returns the hash which is signed for the message described by the request.
+func (r *SignatureRequest) SignatureInput() (common.Hash, error) {
+	switch r.Code {
+	case ProposalCode:
+		return ProposalSignatureInput(r.Height, r.Round, r.ValidRound, r.Value), nil
+	case PrevoteCode, PrecommitCode:
+		return VoteSignatureInput(r.Height, uint64(r.Round), r.Code, r.Value), nil
+	}
+	return common.Hash{}, fmt.Errorf("invalid message code %d", r.Code)
+}
+
+// Step returns the name of the consensus step the request is signing a message for.
+func (r *SignatureRequest) Step() string {
+	switch r.Code {
+	case ProposalCode:
+		return "propose"
+	case PrevoteCode:
+		return "prevote"
+	case PrecommitCode:
+		return "precommit"
+	}
+	return "unknown"
+}
+
 // TODO: To save space we could send only the signer index instead of the signer address
 type Propose struct {
 	block      *types.Block
@@ -115,8 +152,7 @@ func NewPropose(r int64, h uint64, vr int64, block *types.Block, signer Signer,
 	}
 
 	// Calculate signature first
-	signaturePayload, _ := rlp.EncodeToBytes([]any{ProposalCode, uint64(r), h, validRound, isValidRoundNil, block.Hash()})
-	signatureInput := crypto.Hash(signaturePayload)
+	signatureInput := ProposalSignatureInput(h, r, vr, block.Hash())
 	signature := signer(signatureInput)
 
 	validator := self.Address
@@ -511,8 +547,7 @@ func newVote[
 	code := PE(new(E)).Code()
 
 	// Pay attention that we're adding the message Code to the signature input data.
-	signaturePayload, _ := rlp.EncodeToBytes([]any{code, uint64(r), h, value})
-	signatureInput := crypto.Hash(signaturePayload)
+	signatureInput := VoteSignatureInput(h, uint64(r), code, value)
 	signature := signer(signatureInput)
 
 	signers := types.NewSigners(csize)
@@ -845,6 +880,19 @@ func VoteSignatureInput(h uint64, r uint64, code uint8, v common.Hash) common.Ha
 	return crypto.Hash(signaturePayload)
 }
 
+// ProposalSignatureInput returns the signature input of a proposal, a valid round of -1 standing for nil.
+func ProposalSignatureInput(h uint64, r int64, vr int64, v common.Hash) common.Hash {
+	isValidRoundNil := false
+	validRound := uint64(0)
+	if vr == -1 {
+		isValidRoundNil = true
+	} else {
+		validRound = uint64(vr)
+	}
+	signaturePayload, _ := rlp.EncodeToBytes([]any{ProposalCode, uint64(r), h, validRound, isValidRoundNil, v})
+	return crypto.Hash(signaturePayload)
+}
+
 // PrepareCommittedSeal returns the input data to compute the committed seal for a given block hash.
 func PrepareCommittedSeal(hash common.Hash, round int64, height *big.Int) common.Hash {
 	// this is matching the signature input that we get from the committed messages.
@@ -825,3 +825,26 @@ func newHeader(number uint64, c *types.Committee) *types.Header {
 
 	return header
 }
+
+func TestSignatureRequest(t *testing.T) {
+	header := &types.Header{Number: common.Big2}
+	block := types.NewBlockWithHeader(header)
+	value := common.HexToHash("0x1227")
+	tests := []struct {
+		msg     Msg
+		request SignatureRequest
+	}{
+		{NewPropose(1, 2, -1, block, defaultSigner, testCommitteeMember), SignatureRequest{Code: ProposalCode, Height: 2, Round: 1, ValidRound: -1, Value: block.Hash()}},
+		{NewPropose(3, 2, 1, block, defaultSigner, testCommitteeMember), SignatureRequest{Code: ProposalCode, Height: 2, Round: 3, ValidRound: 1, Value: block.Hash()}},
+		{NewPrevote(1, 2, value, defaultSigner, testCommitteeMember, 1), SignatureRequest{Code: PrevoteCode, Height: 2, Round: 1, ValidRound: -1, Value: value}},
+		{NewPrecommit(1, 2, value, defaultSigner, testCommitteeMember, 1), SignatureRequest{Code: PrecommitCode, Height: 2, Round: 1, ValidRound: -1, Value: value}},
+	}
+	for _, test := range tests {
+		signatureInput, err := test.request.SignatureInput()
+		require.NoError(t, err)
+		require.Equal(t, test.msg.SignatureInput(), signatureInput, test.request.Step())
+	}
+
+	_, err := (&SignatureRequest{Code: LightProposalCode}).SignatureInput()
+	require.Error(t, err)
+}
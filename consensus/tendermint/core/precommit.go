@@ -39,7 +39,12 @@ func (c *Precommiter) SendPrecommit(_ context.Context, isNil bool) {
 		c.logger.Error("Validator is no longer in current committee", "err", err, "validator", c.address.String())
 		return
 	}
-	precommit := message.NewPrecommit(c.Round(), c.Height().Uint64(), value, c.backend.Sign, self, c.CommitteeSet().Committee().Len())
+	signer, err := c.Signer(&message.SignatureRequest{Code: message.PrecommitCode, Height: c.Height().Uint64(), Round: c.Round(), ValidRound: -1, Value: value})
+	if err != nil {
+		c.logger.Error("Failed to sign precommit", "err", err, "round", c.Round(), "height", c.Height().Uint64())
+		return
+	}
+	precommit := message.NewPrecommit(c.Round(), c.Height().Uint64(), value, signer, self, c.CommitteeSet().Committee().Len())
 	c.LogPrecommitMessageEvent("Precommit sent", precommit)
 	c.sentPrecommit = true
 	c.Broadcaster().Broadcast(precommit)
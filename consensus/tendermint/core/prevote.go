@@ -38,7 +38,12 @@ func (c *Prevoter) SendPrevote(ctx context.Context, isNil bool) {
 		c.logger.Error("Validator is no longer in current committee", "err", err, "validator", c.address.String())
 		return
 	}
-	prevote := message.NewPrevote(c.Round(), c.Height().Uint64(), value, c.backend.Sign, self, c.CommitteeSet().Committee().Len())
+	signer, err := c.Signer(&message.SignatureRequest{Code: message.PrevoteCode, Height: c.Height().Uint64(), Round: c.Round(), ValidRound: -1, Value: value})
+	if err != nil {
+		c.logger.Error("Failed to sign prevote", "err", err, "round", c.Round(), "height", c.Height().Uint64())
+		return
+	}
+	prevote := message.NewPrevote(c.Round(), c.Height().Uint64(), value, signer, self, c.CommitteeSet().Committee().Len())
 	c.LogPrevoteMessageEvent("MessageEvent(Prevote): Sent", prevote)
 	c.sentPrevote = true
 	c.Broadcaster().Broadcast(prevote)
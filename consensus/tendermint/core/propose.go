@@ -9,6 +9,7 @@ import (
 	"github.com/autonity/autonity/consensus"
 	"github.com/autonity/autonity/consensus/tendermint/core/constants"
 	"github.com/autonity/autonity/consensus/tendermint/core/message"
+	"github.com/autonity/autonity/consensus/tendermint/events"
 	"github.com/autonity/autonity/core"
 	"github.com/autonity/autonity/core/types"
 	"github.com/autonity/autonity/log"
@@ -40,7 +41,12 @@ func (c *Proposer) SendProposal(_ context.Context, block *types.Block) {
 		return
 	}
 
-	proposal := message.NewPropose(c.Round(), c.Height().Uint64(), c.validRound, block, c.backend.Sign, self)
+	signer, err := c.Signer(&message.SignatureRequest{Code: message.ProposalCode, Height: c.Height().Uint64(), Round: c.Round(), ValidRound: c.validRound, Value: block.Hash()})
+	if err != nil {
+		c.logger.Error("Failed to sign proposal", "err", err, "round", c.Round(), "height", c.Height().Uint64())
+		return
+	}
+	proposal := message.NewPropose(c.Round(), c.Height().Uint64(), c.validRound, block, signer, self)
 	c.sentProposal = true
 	c.backend.SetProposedBlockHash(block.Hash())
 	c.LogProposalMessageEvent("MessageEvent(Proposal): Sent", proposal)
@@ -106,13 +112,14 @@ func (c *Proposer) HandleProposal(ctx context.Context, proposal *message.Propose
 
 	// Verify the proposal we received
 	start := time.Now()
-	duration, err := c.backend.VerifyProposal(proposal.Block()) // youssef: can we skip the verification for our own proposal?
+	duration, err := c.verifyProposal(proposal.Block()) // youssef: can we skip the verification for our own proposal?
+	verification := time.Since(start)
 
 	if metrics.Enabled {
-		now := time.Now()
-		ProposalVerifiedTimer.Update(now.Sub(start))
-		ProposalVerifiedBg.Add(now.Sub(start).Nanoseconds())
+		ProposalVerifiedTimer.Update(verification)
+		ProposalVerifiedBg.Add(verification.Nanoseconds())
 	}
+	c.emitProposal(proposal, verification, err)
 
 	if err != nil {
 		// if it's a future block, we will handle it again after the duration
@@ -155,6 +162,24 @@ func (c *Proposer) HandleProposal(ctx context.Context, proposal *message.Propose
 	return nil
 }
 
+func (c *Proposer) emitProposal(proposal *message.Propose, verification time.Duration, err error) {
+	c.emit(func() events.CoreEvent {
+		value, proposer := proposal.Value(), proposal.Signer()
+		ev := events.CoreEvent{
+			Type:           events.CoreProposal,
+			Height:         proposal.H(),
+			Round:          proposal.R(),
+			Value:          &value,
+			Proposer:       &proposer,
+			VerifyDuration: verification,
+		}
+		if err != nil {
+			ev.Error = err.Error()
+		}
+		return ev
+	})
+}
+
 func (c *Proposer) HandleNewCandidateBlockMsg(ctx context.Context, candidateBlock *types.Block) {
 	if candidateBlock == nil {
 		return
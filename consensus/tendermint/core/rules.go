@@ -9,6 +9,7 @@ import (
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/consensus/tendermint/core/constants"
 	"github.com/autonity/autonity/consensus/tendermint/core/message"
+	"github.com/autonity/autonity/consensus/tendermint/events"
 	"github.com/autonity/autonity/core"
 	"github.com/autonity/autonity/metrics"
 )
@@ -80,15 +81,17 @@ func (c *Core) quorumPrevotesCheck(ctx context.Context, proposal *message.Propos
 		if metrics.Enabled {
 			PrevoteQuorumBlockTSDeltaBg.Add(time.Since(c.currBlockTimeStamp).Nanoseconds())
 		}
+		c.emitQuorum(Prevote, c.Round(), proposal.Block().Hash())
+		// the valid value is updated before the precommit gets signed, for the sign journal to record it
+		c.validValue = proposal.Block()
+		c.validRound = c.Round()
+		c.setValidRoundAndValue = true
 		if c.step == Prevote {
 			c.lockedValue = proposal.Block()
 			c.lockedRound = c.Round()
 			c.precommiter.SendPrecommit(ctx, false)
 			c.SetStep(ctx, Precommit)
 		}
-		c.validValue = proposal.Block()
-		c.validRound = c.Round()
-		c.setValidRoundAndValue = true
 	}
 }
 
@@ -102,6 +105,7 @@ func (c *Core) quorumPrevotesNilCheck(ctx context.Context) {
 		if metrics.Enabled {
 			PrevoteQuorumBlockTSDeltaBg.Add(time.Since(c.currBlockTimeStamp).Nanoseconds())
 		}
+		c.emitQuorum(Prevote, c.Round(), common.Hash{})
 		c.precommiter.SendPrecommit(ctx, true)
 		c.SetStep(ctx, Precommit)
 	}
@@ -133,10 +137,11 @@ func (c *Core) quorumPrecommitsCheck(ctx context.Context, proposal *message.Prop
 	if metrics.Enabled {
 		PrecommitQuorumBlockTSDeltaBg.Add(time.Since(c.currBlockTimeStamp).Nanoseconds())
 	}
+	c.emitQuorum(Precommit, proposal.R(), hash)
 
 	// if there is a quorum, verify the proposal if needed
 	if !verified {
-		if _, err := c.backend.VerifyProposal(proposal.Block()); err != nil {
+		if _, err := c.verifyProposal(proposal.Block()); err != nil {
 			// This can happen if while we are processing the proposal,
 			// we actually receive the finalized proposed block from p2p block propagation (other peers already reached quorum on it)
 			// In this case we can just consider the proposal as committed.
@@ -250,3 +255,10 @@ func (c *Core) currentPrecommitChecks(ctx context.Context) {
 	// check if we need to schedule the precommit timeout
 	c.precommitTimeoutCheck()
 }
+
+// emitQuorum streams the quorum of votes reached for value to the consensus event subscribers.
+func (c *Core) emitQuorum(vote Step, round int64, value common.Hash) {
+	c.emit(func() events.CoreEvent {
+		return events.CoreEvent{Type: events.CoreQuorum, Height: c.Height().Uint64(), Round: round, Code: vote.String(), Value: &value}
+	})
+}
@@ -56,6 +56,9 @@ func (c *Core) handleStateDump(e StateRequestEvent) {
 		ProposeTimerStarted:   c.proposeTimeout.TimerStarted(),
 		PrevoteTimerStarted:   c.prevoteTimeout.TimerStarted(),
 		PrecommitTimerStarted: c.precommitTimeout.TimerStarted(),
+		ProposeTimeout:        c.timeoutPropose(c.Round()),
+		PrevoteTimeout:        c.timeoutPrevote(c.Round()),
+		PrecommitTimeout:      c.timeoutPrecommit(c.Round()),
 		// known msgs in case of gossiping.
 		KnownMsgHash: c.backend.KnownMsgHash(),
 	}
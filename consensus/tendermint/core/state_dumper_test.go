@@ -4,6 +4,7 @@ import (
 	"context"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -138,6 +139,8 @@ func TestGetCoreState(t *testing.T) {
 	assert.False(t, state.ProposeTimerStarted)
 	assert.False(t, state.PrevoteTimerStarted)
 	assert.False(t, state.PrecommitTimerStarted)
+	assert.Equal(t, InitialPrevoteTimeout+time.Duration(rounds[1])*PrevoteTimeoutDelta, state.PrevoteTimeout)
+	assert.Equal(t, InitialPrecommitTimeout+time.Duration(rounds[1])*PrecommitTimeoutDelta, state.PrecommitTimeout)
 	assert.Equal(t, knownMsgHash, state.KnownMsgHash)
 
 	// expect 2 rounds of vote states.
@@ -6,11 +6,14 @@ import (
 	"sync"
 	"time"
 
+	"github.com/autonity/autonity/autonity"
 	"github.com/autonity/autonity/consensus/tendermint/core/constants"
+	"github.com/autonity/autonity/consensus/tendermint/events"
 	"github.com/autonity/autonity/log"
 	"github.com/autonity/autonity/metrics"
 )
 
+// Default timeouts, used until the Autonity contract sets the consensus timeouts.
 const (
 	InitialProposeTimeout   = 500 * time.Millisecond
 	ProposeTimeoutDelta     = 200 * time.Millisecond
@@ -20,6 +23,98 @@ const (
 	PrecommitTimeoutDelta   = 200 * time.Millisecond
 )
 
+// Bounds of the consensus timeouts set by the Autonity contract, they match the ones enforced by the contract.
+const (
+	MinInitialTimeout = 100 * time.Millisecond
+	MaxInitialTimeout = 30 * time.Second
+	MinTimeoutDelta   = 10 * time.Millisecond
+	MaxTimeoutDelta   = 10 * time.Second
+)
+
+// In adaptive mode, the timeout deltas are scaled by a factor, in percent, which grows on each round timing out
+// and shrinks back on each height decided in its first round.
+const (
+	minDeltaFactor    = 100
+	maxDeltaFactor    = 400
+	deltaFactorGrowth = 50
+	deltaFactorShrink = 25
+)
+
+// timeouts are the initial timeouts and deltas of each step. The timeout of a step at round r is its initial
+// timeout plus r times its delta.
+type timeouts struct {
+	proposeInitial   time.Duration
+	proposeDelta     time.Duration
+	prevoteInitial   time.Duration
+	prevoteDelta     time.Duration
+	precommitInitial time.Duration
+	precommitDelta   time.Duration
+	adaptive         bool
+	deltaFactor      int64 // in percent, only used in adaptive mode
+}
+
+var defaultTimeouts = &timeouts{
+	proposeInitial:   InitialProposeTimeout,
+	proposeDelta:     ProposeTimeoutDelta,
+	prevoteInitial:   InitialPrevoteTimeout,
+	prevoteDelta:     PrevoteTimeoutDelta,
+	precommitInitial: InitialPrecommitTimeout,
+	precommitDelta:   PrecommitTimeoutDelta,
+	deltaFactor:      minDeltaFactor,
+}
+
+// newTimeouts converts the consensus timeouts of the Autonity contract, in milliseconds, bounding them to the
+// accepted ranges.
+func newTimeouts(t autonity.AutonityConsensusTimeouts) *timeouts {
+	initial := func(ms *big.Int) time.Duration {
+		return boundTimeout(ms, MinInitialTimeout, MaxInitialTimeout)
+	}
+	delta := func(ms *big.Int) time.Duration {
+		return boundTimeout(ms, MinTimeoutDelta, MaxTimeoutDelta)
+	}
+	return &timeouts{
+		proposeInitial:   initial(t.ProposeInitial),
+		proposeDelta:     delta(t.ProposeDelta),
+		prevoteInitial:   initial(t.PrevoteInitial),
+		prevoteDelta:     delta(t.PrevoteDelta),
+		precommitInitial: initial(t.PrecommitInitial),
+		precommitDelta:   delta(t.PrecommitDelta),
+		adaptive:         t.Adaptive,
+		deltaFactor:      minDeltaFactor,
+	}
+}
+
+func boundTimeout(ms *big.Int, min, max time.Duration) time.Duration {
+	if ms == nil || !ms.IsInt64() || ms.Int64() < min.Milliseconds() {
+		return min
+	}
+	if ms.Int64() > max.Milliseconds() {
+		return max
+	}
+	return time.Duration(ms.Int64()) * time.Millisecond
+}
+
+// withDeltaFactor returns a copy of the timeouts with the given delta factor, bounded to the accepted range.
+func (t *timeouts) withDeltaFactor(factor int64) *timeouts {
+	cpy := *t
+	cpy.deltaFactor = factor
+	if cpy.deltaFactor < minDeltaFactor {
+		cpy.deltaFactor = minDeltaFactor
+	}
+	if cpy.deltaFactor > maxDeltaFactor {
+		cpy.deltaFactor = maxDeltaFactor
+	}
+	return &cpy
+}
+
+// timeout returns the timeout of a step at the given round.
+func (t *timeouts) timeout(initial, delta time.Duration, round int64) time.Duration {
+	if t.adaptive {
+		delta = delta * time.Duration(t.deltaFactor) / 100
+	}
+	return initial + time.Duration(round)*delta
+}
+
 type TimeoutEvent struct {
 	RoundWhenCalled  int64
 	HeightWhenCalled *big.Int
@@ -139,6 +234,9 @@ func (c *Core) onTimeoutPropose(r int64, h *big.Int) {
 		HeightWhenCalled: h,
 		Step:             Propose,
 	}
+	c.emit(func() events.CoreEvent {
+		return events.CoreEvent{Type: events.CoreTimeout, Height: h.Uint64(), Round: r, Step: Propose.String()}
+	})
 	// It's unsafe to call logTimeoutEvent here !
 	c.logger.Debug("TimeoutEvent(Propose): Sent", "round", r, "height", h)
 	if metrics.Enabled {
@@ -153,6 +251,9 @@ func (c *Core) onTimeoutPrevote(r int64, h *big.Int) {
 		HeightWhenCalled: h,
 		Step:             Prevote,
 	}
+	c.emit(func() events.CoreEvent {
+		return events.CoreEvent{Type: events.CoreTimeout, Height: h.Uint64(), Round: r, Step: Prevote.String()}
+	})
 	c.logger.Debug("TimeoutEvent(Prevote): Sent", "round", r, "height", h)
 	if metrics.Enabled {
 		c.measureMetricsOnTimeOut(msg.Step, r)
@@ -166,6 +267,9 @@ func (c *Core) onTimeoutPrecommit(r int64, h *big.Int) {
 		HeightWhenCalled: h,
 		Step:             Precommit,
 	}
+	c.emit(func() events.CoreEvent {
+		return events.CoreEvent{Type: events.CoreTimeout, Height: h.Uint64(), Round: r, Step: Precommit.String()}
+	})
 	c.logger.Debug("TimeoutEvent(Precommit): Sent", "round", r, "height", h)
 	if metrics.Enabled {
 		c.measureMetricsOnTimeOut(msg.Step, r)
@@ -193,6 +297,9 @@ func (c *Core) handleTimeoutPrevote(ctx context.Context, msg TimeoutEvent) {
 func (c *Core) handleTimeoutPrecommit(ctx context.Context, msg TimeoutEvent) {
 	if msg.HeightWhenCalled.Cmp(c.Height()) == 0 && msg.RoundWhenCalled == c.Round() {
 		c.logTimeoutEvent("TimeoutEvent(Precommit): Received", "Precommit", msg)
+		if t := c.currentTimeouts(); t.adaptive {
+			c.timeouts.Store(t.withDeltaFactor(t.deltaFactor + deltaFactorGrowth))
+		}
 		c.StartRound(ctx, c.Round()+1)
 	}
 }
@@ -200,15 +307,46 @@ func (c *Core) handleTimeoutPrecommit(ctx context.Context, msg TimeoutEvent) {
 // ///////////// Calculate Timeout Duration Functions ///////////////
 // The Timeout may need to be changed depending on the Step
 func (c *Core) timeoutPropose(round int64) time.Duration {
-	return InitialProposeTimeout + time.Duration(c.blockPeriod)*time.Second + time.Duration(round)*ProposeTimeoutDelta
+	t := c.currentTimeouts()
+	return t.timeout(t.proposeInitial, t.proposeDelta, round) + time.Duration(c.blockPeriod)*time.Second
 }
 
 func (c *Core) timeoutPrevote(round int64) time.Duration {
-	return InitialPrevoteTimeout + time.Duration(round)*PrevoteTimeoutDelta
+	t := c.currentTimeouts()
+	return t.timeout(t.prevoteInitial, t.prevoteDelta, round)
 }
 
 func (c *Core) timeoutPrecommit(round int64) time.Duration {
-	return InitialPrecommitTimeout + time.Duration(round)*PrecommitTimeoutDelta
+	t := c.currentTimeouts()
+	return t.timeout(t.precommitInitial, t.precommitDelta, round)
+}
+
+// currentTimeouts returns the timeouts of the current height. They are read by the timers as well, hence stored
+// atomically.
+func (c *Core) currentTimeouts() *timeouts {
+	if t := c.timeouts.Load(); t != nil {
+		return t
+	}
+	return defaultTimeouts
+}
+
+// updateTimeouts refreshes the timeouts on a new height from the consensus timeouts of the Autonity contract. In
+// adaptive mode, the delta factor shrinks back if the previous height was decided in its first round.
+func (c *Core) updateTimeouts(previousRound int64) {
+	next := defaultTimeouts
+	if c.protocolContracts != nil && c.protocolContracts.Cache != nil {
+		if governed, ok := c.protocolContracts.ConsensusTimeouts(c.Height().Uint64()); ok {
+			next = newTimeouts(governed)
+		}
+	}
+	if next.adaptive {
+		factor := c.currentTimeouts().deltaFactor
+		if previousRound == 0 {
+			factor -= deltaFactorShrink
+		}
+		next = next.withDeltaFactor(factor)
+	}
+	c.timeouts.Store(next)
 }
 
 func (c *Core) logTimeoutEvent(message string, msgType string, timeout TimeoutEvent) {
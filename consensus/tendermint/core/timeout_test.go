@@ -7,11 +7,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	"github.com/autonity/autonity/autonity"
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/consensus/tendermint/core/interfaces"
 	"github.com/autonity/autonity/consensus/tendermint/core/message"
+	"github.com/autonity/autonity/consensus/tendermint/events"
 	"github.com/autonity/autonity/core/types"
 	"github.com/autonity/autonity/log"
 	"github.com/autonity/autonity/metrics"
@@ -226,3 +229,80 @@ func TestOnTimeoutPrecommit(t *testing.T) {
 	})
 	engine.onTimeoutPrecommit(2, big.NewInt(4))
 }
+
+func TestGovernedTimeouts(t *testing.T) {
+	governed := autonity.AutonityConsensusTimeouts{
+		ProposeInitial:   big.NewInt(1000),
+		ProposeDelta:     big.NewInt(1),
+		PrevoteInitial:   big.NewInt(60000),
+		PrevoteDelta:     big.NewInt(300),
+		PrecommitInitial: big.NewInt(700),
+		PrecommitDelta:   big.NewInt(20000),
+	}
+
+	t.Run("timeouts are bounded", func(t *testing.T) {
+		timeouts := newTimeouts(governed)
+		require.Equal(t, time.Second, timeouts.proposeInitial)
+		require.Equal(t, MinTimeoutDelta, timeouts.proposeDelta)
+		require.Equal(t, MaxInitialTimeout, timeouts.prevoteInitial)
+		require.Equal(t, 300*time.Millisecond, timeouts.prevoteDelta)
+		require.Equal(t, MaxTimeoutDelta, timeouts.precommitDelta)
+		require.Equal(t, 300*time.Millisecond*2+MaxInitialTimeout, timeouts.timeout(timeouts.prevoteInitial, timeouts.prevoteDelta, 2))
+	})
+
+	t.Run("default timeouts apply without a governed value", func(t *testing.T) {
+		c := &Core{}
+		require.Equal(t, InitialPrevoteTimeout+3*PrevoteTimeoutDelta, c.timeoutPrevote(3))
+		c.timeouts.Store(newTimeouts(governed))
+		require.Equal(t, 700*time.Millisecond+MaxTimeoutDelta, c.timeoutPrecommit(1))
+	})
+
+	t.Run("adaptive deltas are scaled within bounds", func(t *testing.T) {
+		adaptive := governed
+		adaptive.Adaptive = true
+		timeouts := newTimeouts(adaptive)
+		require.Equal(t, MaxInitialTimeout+300*time.Millisecond, timeouts.timeout(timeouts.prevoteInitial, timeouts.prevoteDelta, 1))
+
+		for i := 0; i < 10; i++ {
+			timeouts = timeouts.withDeltaFactor(timeouts.deltaFactor + deltaFactorGrowth)
+		}
+		require.Equal(t, int64(maxDeltaFactor), timeouts.deltaFactor)
+		require.Equal(t, MaxInitialTimeout+4*300*time.Millisecond, timeouts.timeout(timeouts.prevoteInitial, timeouts.prevoteDelta, 1))
+
+		timeouts = timeouts.withDeltaFactor(timeouts.deltaFactor - deltaFactorShrink)
+		require.Equal(t, MaxInitialTimeout+1125*time.Millisecond, timeouts.timeout(timeouts.prevoteInitial, timeouts.prevoteDelta, 1))
+		require.Equal(t, int64(minDeltaFactor), timeouts.withDeltaFactor(0).deltaFactor)
+	})
+}
+
+func TestOnTimeoutStreamsCoreEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBackend := interfaces.NewMockBackend(ctrl)
+	mockBackend.EXPECT().Post(gomock.Any()).Times(1)
+	engine := Core{
+		backend: mockBackend,
+		logger:  log.New("backend", "test", "id", 0),
+		round:   2,
+		height:  big.NewInt(4),
+		step:    Propose,
+	}
+	engine.SetDefaultHandlers()
+
+	coreEvents := make(chan events.CoreEvent, 1)
+	sub := engine.SubscribeEvents(coreEvents)
+	defer sub.Unsubscribe()
+	engine.onTimeoutPropose(2, big.NewInt(4))
+
+	select {
+	case ev := <-coreEvents:
+		require.Equal(t, events.CoreTimeout, ev.Type)
+		require.Equal(t, uint64(4), ev.Height)
+		require.Equal(t, int64(2), ev.Round)
+		require.Equal(t, Propose.String(), ev.Step)
+		require.False(t, ev.Time.IsZero())
+	case <-time.After(time.Second):
+		t.Fatal("timeout event not streamed")
+	}
+}
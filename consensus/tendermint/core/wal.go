@@ -0,0 +1,345 @@
+package core
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/autonity/autonity/common"
+	com "github.com/autonity/autonity/consensus/tendermint/core/committee"
+	"github.com/autonity/autonity/consensus/tendermint/core/message"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/log"
+	"github.com/autonity/autonity/params"
+	"github.com/autonity/autonity/rlp"
+)
+
+const (
+	// WALDir is the directory of the WAL, relative to the instance directory of the node.
+	WALDir = "consensuswal"
+
+	walFileExt = ".wal"
+)
+
+// WALEntryKind is the kind of a WAL entry.
+type WALEntryKind uint8
+
+const (
+	WALHeight       WALEntryKind = iota // start of a height, along with the state needed to replay it
+	WALMessage                          // message delivered to the core by the aggregator
+	WALBacklog                          // message delivered to the core from the backlog
+	WALTimeout                          // timeout event delivered to the core
+	WALCandidate                        // candidate block delivered to the core
+	WALCommitted                        // commit event delivered to the core, the decided block was inserted
+	WALVerification                     // outcome of the verification of a proposal
+	WALBroadcast                        // message broadcast by the local validator
+	WALDecision                         // proposal committed by the local validator
+)
+
+func (k WALEntryKind) String() string {
+	switch k {
+	case WALHeight:
+		return "height"
+	case WALMessage:
+		return "message"
+	case WALBacklog:
+		return "backlog"
+	case WALTimeout:
+		return "timeout"
+	case WALCandidate:
+		return "candidate"
+	case WALCommitted:
+		return "committed"
+	case WALVerification:
+		return "verification"
+	case WALBroadcast:
+		return "broadcast"
+	case WALDecision:
+		return "decision"
+	default:
+		return "unknown"
+	}
+}
+
+func (k WALEntryKind) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+// WALEntry is a record of the WAL. Data is the RLP encoding of the record specific to the kind of entry.
+type WALEntry struct {
+	Kind WALEntryKind
+	Time uint64 // unix time in nanoseconds
+	Data []byte
+}
+
+// Timestamp returns the time the entry was recorded.
+func (e *WALEntry) Timestamp() time.Time {
+	return time.Unix(0, int64(e.Time))
+}
+
+// walHeight is the state the core starts a height with.
+type walHeight struct {
+	Height    uint64
+	Address   common.Address
+	Parent    *types.Header
+	Committee *types.Committee
+	Strategy  string       // proposer election strategy of the height
+	Journal   *SignJournal `rlp:"nil"` // sign journal the height was started with, restored on restarts
+	Candidate *types.Block `rlp:"nil"` // candidate block received before the height was started
+}
+
+// walMessage is a consensus message, either delivered to the core or broadcast by it.
+type walMessage struct {
+	Code    uint8
+	Payload []byte
+}
+
+type walTimeout struct {
+	Step   uint64
+	Height uint64
+	Round  uint64
+}
+
+type walVerification struct {
+	Hash  common.Hash
+	Error string // empty if the proposal is valid
+}
+
+type walDecision struct {
+	Height uint64
+	Round  uint64
+	Value  common.Hash
+}
+
+// WAL is the write-ahead log of the consensus core. It records, with the time they happened, the events delivered to
+// the core, the outcome of the proposal verifications, the messages broadcast and the decisions of the local
+// validator, so that the heights which took many rounds can be replayed deterministically with ReplayWAL. The log is
+// rotated per height: each height is written to its own file, and only the files of the latest heights are retained.
+//
+// The entries are flushed as they are written, but only synced to disk at the height rotation: the WAL is meant for
+// diagnosis, it is not needed for the safety of the validator.
+type WAL struct {
+	dir    string
+	retain uint64
+	logger log.Logger
+
+	mu     sync.Mutex
+	file   *os.File
+	writer *bufio.Writer
+	failed bool // the WAL stops recording after a write failure, until the next height
+}
+
+// OpenWAL opens the WAL in dir, retaining the files of the last retain heights.
+func OpenWAL(dir string, retain uint64, logger log.Logger) (*WAL, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	if retain == 0 {
+		retain = 1
+	}
+	return &WAL{dir: dir, retain: retain, logger: logger}, nil
+}
+
+// WALFile returns the path of the WAL file of height in dir.
+func WALFile(dir string, height uint64) string {
+	return filepath.Join(dir, fmt.Sprintf("%020d%s", height, walFileExt))
+}
+
+// WALFiles lists the WAL files in dir, in height order.
+func WALFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, entry := range entries {
+		if _, ok := walFileHeight(entry.Name()); ok && !entry.IsDir() {
+			files = append(files, filepath.Join(dir, entry.Name()))
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+func walFileHeight(name string) (uint64, bool) {
+	if !strings.HasSuffix(name, walFileExt) {
+		return 0, false
+	}
+	height, err := strconv.ParseUint(strings.TrimSuffix(name, walFileExt), 10, 64)
+	return height, err == nil
+}
+
+// startHeight rotates the WAL to the file of the given height and records the state the height starts with. The
+// file is appended to, if the height was started already before a restart.
+func (w *WAL) startHeight(start *walHeight) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if err := w.closeFile(); err != nil {
+		w.logger.Warn("Failed to close the consensus WAL file", "err", err)
+	}
+	file, err := os.OpenFile(WALFile(w.dir, start.Height), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		w.logger.Error("Failed to open the consensus WAL file", "height", start.Height, "err", err)
+		w.failed = true
+		return
+	}
+	w.file, w.writer, w.failed = file, bufio.NewWriter(file), false
+	w.prune(start.Height)
+	w.write(WALHeight, start)
+}
+
+// record appends an entry to the file of the current height.
+func (w *WAL) record(kind WALEntryKind, data any) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.write(kind, data)
+}
+
+func (w *WAL) write(kind WALEntryKind, data any) {
+	if w.file == nil || w.failed {
+		return
+	}
+	encoded, err := rlp.EncodeToBytes(data)
+	if err == nil {
+		err = rlp.Encode(w.writer, &WALEntry{Kind: kind, Time: uint64(time.Now().UnixNano()), Data: encoded})
+	}
+	if err == nil {
+		err = w.writer.Flush()
+	}
+	if err != nil {
+		w.logger.Error("Failed to write to the consensus WAL, recording stopped until the next height", "kind", kind, "err", err)
+		w.failed = true
+	}
+}
+
+// prune deletes the files of the heights older than the retained ones.
+func (w *WAL) prune(height uint64) {
+	if height < w.retain {
+		return
+	}
+	files, err := WALFiles(w.dir)
+	if err != nil {
+		w.logger.Warn("Failed to list the consensus WAL files", "err", err)
+		return
+	}
+	for _, file := range files {
+		fileHeight, _ := walFileHeight(filepath.Base(file))
+		if fileHeight > height-w.retain {
+			break
+		}
+		if err := os.Remove(file); err != nil {
+			w.logger.Warn("Failed to delete a consensus WAL file", "file", file, "err", err)
+		}
+	}
+}
+
+func (w *WAL) closeFile() error {
+	if w.file == nil {
+		return nil
+	}
+	file := w.file
+	w.file, w.writer = nil, nil
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// Close syncs and closes the file of the current height.
+func (w *WAL) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.closeFile()
+}
+
+// ReadWAL reads the entries of a WAL file. The entries read before a truncated or corrupted entry are returned
+// along with the error.
+func ReadWAL(path string) ([]*WALEntry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var entries []*WALEntry
+	stream := rlp.NewStream(bufio.NewReader(file), 0)
+	for {
+		entry := new(WALEntry)
+		if err := stream.Decode(entry); err != nil {
+			if errors.Is(err, io.EOF) {
+				return entries, nil
+			}
+			return entries, fmt.Errorf("entry %d: %w", len(entries), err)
+		}
+		entries = append(entries, entry)
+	}
+}
+
+// SetWAL enables the recording of the core events to wal.
+func (c *Core) SetWAL(wal *WAL) {
+	c.wal = wal
+}
+
+func (c *Core) walStartHeight() {
+	if c.wal == nil {
+		return
+	}
+	var config *params.ChainConfig
+	if bc := c.backend.BlockChain(); bc != nil {
+		config = bc.Config()
+	}
+	height := c.Height().Uint64()
+	c.wal.startHeight(&walHeight{
+		Height:    height,
+		Address:   c.address,
+		Parent:    c.backend.HeadBlock().Header(),
+		Committee: c.CommitteeSet().Committee(),
+		Strategy:  com.StrategyAt(config, height).Name(),
+		Journal:   c.signJournal,
+		Candidate: c.pendingCandidateBlocks[height],
+	})
+}
+
+func (c *Core) walRecordMessage(kind WALEntryKind, msg message.Msg) {
+	if c.wal == nil {
+		return
+	}
+	c.wal.record(kind, &walMessage{Code: msg.Code(), Payload: msg.Payload()})
+}
+
+func (c *Core) walRecordTimeout(timeout TimeoutEvent) {
+	if c.wal == nil {
+		return
+	}
+	c.wal.record(WALTimeout, &walTimeout{Step: uint64(timeout.Step), Height: timeout.HeightWhenCalled.Uint64(), Round: uint64(timeout.RoundWhenCalled)})
+}
+
+func (c *Core) walRecord(kind WALEntryKind, data any) {
+	if c.wal == nil {
+		return
+	}
+	c.wal.record(kind, data)
+}
+
+// verifyProposal verifies the proposal with the backend, recording the outcome to the WAL so that it can be
+// replayed without the chain state the proposal was verified against.
+func (c *Core) verifyProposal(block *types.Block) (time.Duration, error) {
+	duration, err := c.backend.VerifyProposal(block)
+	if c.wal != nil {
+		verification := &walVerification{Hash: block.Hash()}
+		if err != nil {
+			verification.Error = err.Error()
+		}
+		c.wal.record(WALVerification, verification)
+	}
+	return duration, err
+}
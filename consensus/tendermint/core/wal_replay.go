@@ -0,0 +1,424 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/autonity/autonity/accounts/abi"
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/consensus"
+	com "github.com/autonity/autonity/consensus/tendermint/core/committee"
+	"github.com/autonity/autonity/consensus/tendermint/core/constants"
+	"github.com/autonity/autonity/consensus/tendermint/core/interfaces"
+	"github.com/autonity/autonity/consensus/tendermint/core/message"
+	"github.com/autonity/autonity/consensus/tendermint/events"
+	ethcore "github.com/autonity/autonity/core"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/crypto/blst"
+	"github.com/autonity/autonity/event"
+	"github.com/autonity/autonity/log"
+	"github.com/autonity/autonity/rlp"
+)
+
+var errNoWALHeight = errors.New("WAL does not start with a height entry")
+
+// ReplayAction is a message broadcast or a decision taken by the local validator, either recorded in the WAL or
+// taken again during the replay.
+type ReplayAction struct {
+	Kind   WALEntryKind `json:"kind"` // WALBroadcast or WALDecision
+	Code   uint8        `json:"code"` // message code of the broadcast, message.PrecommitCode for a decision
+	Height uint64       `json:"height"`
+	Round  int64        `json:"round"`
+	Value  common.Hash  `json:"value"`
+	Entry  int          `json:"entry"` // index of the WAL entry recording the action, or being replayed when it was taken again
+	Time   time.Time    `json:"time"`  // time the WAL entry was recorded
+}
+
+func (a *ReplayAction) String() string {
+	if a == nil {
+		return "none"
+	}
+	if a.Kind == WALDecision {
+		return fmt.Sprintf("decision h: %d, r: %d, value: %v", a.Height, a.Round, a.Value)
+	}
+	step := (&message.SignatureRequest{Code: a.Code}).Step()
+	return fmt.Sprintf("%s h: %d, r: %d, value: %v", step, a.Height, a.Round, a.Value)
+}
+
+func (a *ReplayAction) equal(other *ReplayAction) bool {
+	return a.Kind == other.Kind && a.Code == other.Code && a.Height == other.Height && a.Round == other.Round && a.Value == other.Value
+}
+
+// Divergence is the first action of the replay which differs from the recorded one. Either may be nil if the replay
+// took more, or fewer, actions than recorded.
+type Divergence struct {
+	Recorded *ReplayAction `json:"recorded"`
+	Replayed *ReplayAction `json:"replayed"`
+}
+
+// Entry returns the index of the WAL entry the divergence was detected at.
+func (d *Divergence) Entry() int {
+	switch {
+	case d.Recorded == nil:
+		return d.Replayed.Entry
+	case d.Replayed == nil:
+		return d.Recorded.Entry
+	default:
+		return min(d.Recorded.Entry, d.Replayed.Entry)
+	}
+}
+
+// ReplaySession is the replay of the entries recorded from the start of a height, until the height was committed
+// or the validator stopped. A WAL file holds several sessions if the validator restarted during the height.
+type ReplaySession struct {
+	Height      uint64
+	Start       time.Time // time the height was started
+	Entries     int       // entries of the session, the height entry included
+	Recorded    []*ReplayAction
+	Replayed    []*ReplayAction
+	Divergences []*Divergence // first diverging broadcast and first diverging decision, if any
+}
+
+// ReplayReport is the result of the replay of a WAL file.
+type ReplayReport struct {
+	Path     string
+	Sessions []*ReplaySession
+}
+
+// Diverged returns whether the replayed decisions or broadcasts differ from the recorded ones.
+func (r *ReplayReport) Diverged() bool {
+	for _, session := range r.Sessions {
+		if len(session.Divergences) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// ReplayWAL feeds the entries of a WAL file back into a Core wired to a backend returning the recorded outcomes of
+// the proposal verifications, and reports where the broadcasts and decisions of the replay diverge from the recorded
+// ones. Timers are not replayed from the clock, the recorded timeout events are replayed instead.
+func ReplayWAL(path string, logger log.Logger) (*ReplayReport, error) {
+	entries, err := ReadWAL(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 || entries[0].Kind != WALHeight {
+		return nil, errNoWALHeight
+	}
+	report := &ReplayReport{Path: path}
+	for start := 0; start < len(entries); {
+		end := start + 1
+		for end < len(entries) && entries[end].Kind != WALHeight {
+			end++
+		}
+		session, err := replaySession(entries, start, end, logger)
+		if err != nil {
+			return nil, fmt.Errorf("entry %d: %w", start, err)
+		}
+		report.Sessions = append(report.Sessions, session)
+		start = end
+	}
+	return report, nil
+}
+
+func replaySession(entries []*WALEntry, start, end int, logger log.Logger) (*ReplaySession, error) {
+	height := new(walHeight)
+	if err := rlp.DecodeBytes(entries[start].Data, height); err != nil {
+		return nil, err
+	}
+	if err := height.Committee.Enrich(); err != nil {
+		return nil, err
+	}
+	strategy, err := com.Strategy(height.Strategy)
+	if err != nil {
+		return nil, err
+	}
+	session := &ReplaySession{Height: height.Height, Start: entries[start].Timestamp(), Entries: end - start}
+
+	backend, err := newReplayBackend(height, logger)
+	if err != nil {
+		return nil, err
+	}
+	// the recorded actions, and the outcomes of the verifications to serve to the replay
+	for i := start + 1; i < end; i++ {
+		entry := entries[i]
+		switch entry.Kind {
+		case WALVerification:
+			verification := new(walVerification)
+			if err := rlp.DecodeBytes(entry.Data, verification); err != nil {
+				return nil, fmt.Errorf("entry %d: %w", i, err)
+			}
+			backend.verifications[verification.Hash] = append(backend.verifications[verification.Hash], verification.Error)
+		case WALBroadcast:
+			msg, err := decodeWALMessage(entry.Data)
+			if err != nil {
+				return nil, fmt.Errorf("entry %d: %w", i, err)
+			}
+			session.Recorded = append(session.Recorded, broadcastAction(msg, i, entry.Timestamp()))
+		case WALDecision:
+			decision := new(walDecision)
+			if err := rlp.DecodeBytes(entry.Data, decision); err != nil {
+				return nil, fmt.Errorf("entry %d: %w", i, err)
+			}
+			session.Recorded = append(session.Recorded, &ReplayAction{Kind: WALDecision, Code: message.PrecommitCode,
+				Height: decision.Height, Round: int64(decision.Round), Value: decision.Value, Entry: i, Time: entry.Timestamp()})
+		}
+	}
+
+	c := New(backend, nil, height.Address, logger, false)
+	c.epoch = backend.epoch
+	c.setCommitteeSet(&replayCommittee{ProtocolCommittee: com.NewProtocolCommittee(height.Parent, height.Committee, nil, nil), strategy: strategy, parent: height.Parent.Number.Uint64()})
+	c.signJournal = height.Journal
+	if height.Candidate != nil {
+		c.pendingCandidateBlocks[height.Height] = height.Candidate
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer func() {
+		cancel()
+		c.stopAllTimeouts()
+		c.proposer.StopFutureProposalTimer()
+	}()
+
+	backend.entry, backend.time = start, entries[start].Timestamp()
+	c.StartRound(ctx, 0)
+replay:
+	for i := start + 1; i < end; i++ {
+		entry := entries[i]
+		backend.entry, backend.time = i, entry.Timestamp()
+		switch entry.Kind {
+		case WALMessage, WALBacklog:
+			msg, err := decodeWALMessage(entry.Data)
+			if err != nil {
+				return nil, fmt.Errorf("entry %d: %w", i, err)
+			}
+			// the core only handles messages verified by the aggregator
+			if err := msg.PreValidate(height.Committee); err != nil {
+				return nil, fmt.Errorf("entry %d: %w", i, err)
+			}
+			if err := msg.Validate(); err != nil {
+				return nil, fmt.Errorf("entry %d: %w", i, err)
+			}
+			// a diverging replay may have moved past the height of the message
+			if msg.H() > c.Height().Uint64() {
+				continue
+			}
+			c.handleMessageEvent(ctx, msg, nil)
+		case WALTimeout:
+			timeout := new(walTimeout)
+			if err := rlp.DecodeBytes(entry.Data, timeout); err != nil {
+				return nil, fmt.Errorf("entry %d: %w", i, err)
+			}
+			c.handleTimeoutEvent(ctx, TimeoutEvent{RoundWhenCalled: int64(timeout.Round), HeightWhenCalled: new(big.Int).SetUint64(timeout.Height), Step: Step(timeout.Step)})
+		case WALCandidate:
+			block := new(types.Block)
+			if err := rlp.DecodeBytes(entry.Data, block); err != nil {
+				return nil, fmt.Errorf("entry %d: %w", i, err)
+			}
+			c.proposer.HandleNewCandidateBlockMsg(ctx, block)
+		case WALCommitted:
+			// the following height is recorded in the next file
+			break replay
+		}
+	}
+	session.Replayed = backend.actions
+	session.Divergences = divergences(session.Recorded, session.Replayed)
+	return session, nil
+}
+
+// divergences compares the recorded and replayed broadcasts, then the recorded and replayed decisions, and returns
+// the first difference of each.
+func divergences(recorded, replayed []*ReplayAction) []*Divergence {
+	var found []*Divergence
+	for _, kind := range []WALEntryKind{WALBroadcast, WALDecision} {
+		expected, actual := filterActions(recorded, kind), filterActions(replayed, kind)
+		for i := 0; i < max(len(expected), len(actual)); i++ {
+			divergence := new(Divergence)
+			if i < len(expected) {
+				divergence.Recorded = expected[i]
+			}
+			if i < len(actual) {
+				divergence.Replayed = actual[i]
+			}
+			if divergence.Recorded == nil || divergence.Replayed == nil || !divergence.Recorded.equal(divergence.Replayed) {
+				found = append(found, divergence)
+				break
+			}
+		}
+	}
+	return found
+}
+
+func filterActions(actions []*ReplayAction, kind WALEntryKind) []*ReplayAction {
+	var filtered []*ReplayAction
+	for _, action := range actions {
+		if action.Kind == kind {
+			filtered = append(filtered, action)
+		}
+	}
+	return filtered
+}
+
+func broadcastAction(msg message.Msg, entry int, time time.Time) *ReplayAction {
+	return &ReplayAction{Kind: WALBroadcast, Code: msg.Code(), Height: msg.H(), Round: msg.R(), Value: msg.Value(), Entry: entry, Time: time}
+}
+
+func decodeWALMessage(data []byte) (message.Msg, error) {
+	recorded := new(walMessage)
+	if err := rlp.DecodeBytes(data, recorded); err != nil {
+		return nil, err
+	}
+	var msg message.Msg
+	switch recorded.Code {
+	case message.ProposalCode:
+		msg = new(message.Propose)
+	case message.PrevoteCode:
+		msg = new(message.Prevote)
+	case message.PrecommitCode:
+		msg = new(message.Precommit)
+	default:
+		return nil, fmt.Errorf("unexpected message code %d", recorded.Code)
+	}
+	if err := rlp.DecodeBytes(recorded.Payload, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// replayCommittee elects the proposers with the strategy recorded in the WAL, rather than from the chain config.
+type replayCommittee struct {
+	*com.ProtocolCommittee
+	strategy com.ElectionStrategy
+	parent   uint64
+}
+
+func (r *replayCommittee) SetLastHeader(header *types.Header) {
+	r.ProtocolCommittee.SetLastHeader(header)
+	r.parent = header.Number.Uint64()
+}
+
+func (r *replayCommittee) GetProposer(round int64) *types.CommitteeMember {
+	proposer, err := r.strategy.Proposer(r.Committee(), r.parent, round)
+	if err != nil {
+		return nil
+	}
+	return r.Committee().MemberByAddress(proposer)
+}
+
+// replayError is a recorded verification error. It unwraps to the sentinel error the core handles specifically,
+// if the recorded error was one.
+type replayError struct {
+	msg      string
+	sentinel error
+}
+
+func (e *replayError) Error() string { return e.msg }
+func (e *replayError) Unwrap() error { return e.sentinel }
+
+var replaySentinels = []error{consensus.ErrFutureTimestampBlock, ethcore.ErrKnownBlock, constants.ErrAlreadyHaveBlock}
+
+func newReplayError(msg string) error {
+	err := &replayError{msg: msg}
+	for _, sentinel := range replaySentinels {
+		if strings.Contains(msg, sentinel.Error()) {
+			err.sentinel = sentinel
+			break
+		}
+	}
+	return err
+}
+
+// replayBackend is the backend of a replayed Core. It serves the recorded verification outcomes and records the
+// broadcasts and decisions of the core. The events the core posts are dropped, the recorded ones are replayed
+// instead.
+type replayBackend struct {
+	address       common.Address
+	parent        *types.Block
+	epoch         *types.EpochInfo
+	key           blst.SecretKey
+	logger        log.Logger
+	verifications map[common.Hash][]string
+
+	entry   int // index of the entry being replayed
+	time    time.Time
+	actions []*ReplayAction
+}
+
+var _ interfaces.Backend = (*replayBackend)(nil)
+
+func newReplayBackend(height *walHeight, logger log.Logger) (*replayBackend, error) {
+	// the replayed messages are compared on their content, they are signed with a throwaway key
+	key, err := blst.RandKey()
+	if err != nil {
+		return nil, err
+	}
+	return &replayBackend{
+		address:       height.Address,
+		parent:        types.NewBlockWithHeader(height.Parent),
+		epoch:         &types.EpochInfo{Epoch: types.Epoch{Committee: height.Committee}, EpochBlock: new(big.Int)},
+		key:           key,
+		logger:        logger,
+		verifications: make(map[common.Hash][]string),
+	}, nil
+}
+
+func (b *replayBackend) Address() common.Address                          { return b.address }
+func (b *replayBackend) AddSeal(block *types.Block) (*types.Block, error) { return block, nil }
+func (b *replayBackend) AskSync(*types.Committee)                         {}
+
+func (b *replayBackend) Broadcast(_ *types.Committee, msg message.Msg) {
+	b.actions = append(b.actions, broadcastAction(msg, b.entry, b.time))
+}
+
+func (b *replayBackend) Commit(block *types.Block, round int64, _ types.AggregateSignature) error {
+	b.actions = append(b.actions, &ReplayAction{Kind: WALDecision, Code: message.PrecommitCode, Height: block.NumberU64(),
+		Round: round, Value: block.Hash(), Entry: b.entry, Time: b.time})
+	return nil
+}
+
+func (b *replayBackend) GetContractABI() *abi.ABI             { return nil }
+func (b *replayBackend) Gossip(*types.Committee, message.Msg) {}
+func (b *replayBackend) KnownMsgHash() []common.Hash          { return nil }
+func (b *replayBackend) HandleUnhandledMsgs(context.Context)  {}
+func (b *replayBackend) HeadBlock() *types.Block              { return b.parent }
+func (b *replayBackend) Post(any)                             {}
+func (b *replayBackend) SetProposedBlockHash(common.Hash)     {}
+func (b *replayBackend) Sign(hash common.Hash) blst.Signature { return b.key.Sign(hash[:]) }
+func (b *replayBackend) Subscribe(types ...any) *event.TypeMuxSubscription {
+	return new(event.TypeMux).Subscribe(types...)
+}
+func (b *replayBackend) SyncPeer(common.Address) {}
+
+// VerifyProposal returns the outcome recorded for the verification of the proposal. A proposal verified during the
+// replay only is considered valid.
+func (b *replayBackend) VerifyProposal(block *types.Block) (time.Duration, error) {
+	outcomes := b.verifications[block.Hash()]
+	if len(outcomes) == 0 {
+		b.logger.Warn("Replayed verification of a proposal not verified in the WAL", "hash", block.Hash(), "entry", b.entry)
+		return 0, nil
+	}
+	outcome := outcomes[0]
+	if len(outcomes) > 1 {
+		b.verifications[block.Hash()] = outcomes[1:]
+	}
+	if outcome == "" {
+		return 0, nil
+	}
+	return 0, newReplayError(outcome)
+}
+
+func (b *replayBackend) BlockChain() *ethcore.BlockChain { return nil }
+func (b *replayBackend) EpochOfHeight(uint64) (*types.EpochInfo, error) {
+	return b.epoch, nil
+}
+func (b *replayBackend) SetBlockchain(*ethcore.BlockChain)               {}
+func (b *replayBackend) Logger() log.Logger                              { return b.logger }
+func (b *replayBackend) IsJailed(common.Address) bool                    { return false }
+func (b *replayBackend) Gossiper() interfaces.Gossiper                   { return nil }
+func (b *replayBackend) ProcessFutureMsgs(uint64)                        {}
+func (b *replayBackend) FutureMsgs() []message.Msg                       { return nil }
+func (b *replayBackend) MessageCh() <-chan events.UnverifiedMessageEvent { return nil }
@@ -0,0 +1,204 @@
+package core
+
+import (
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/autonity/autonity/common"
+	tdmcommittee "github.com/autonity/autonity/consensus/tendermint/core/committee"
+	"github.com/autonity/autonity/consensus/tendermint/core/message"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/log"
+	"github.com/autonity/autonity/rlp"
+)
+
+func TestWALRotation(t *testing.T) {
+	dir := t.TempDir()
+	wal, err := OpenWAL(dir, 2, log.Root())
+	require.NoError(t, err)
+	defer wal.Close()
+
+	committee, _ := GenerateCommittee(4)
+	for height := uint64(1); height <= 4; height++ {
+		parent := &types.Header{Number: new(big.Int).SetUint64(height - 1)}
+		wal.startHeight(&walHeight{Height: height, Parent: parent, Committee: committee, Strategy: tdmcommittee.RoundRobin})
+		wal.record(WALTimeout, &walTimeout{Step: uint64(Propose), Height: height})
+		wal.record(WALVerification, &walVerification{Hash: common.Hash{byte(height)}, Error: "invalid"})
+	}
+
+	// only the files of the last two heights are retained
+	files, err := WALFiles(dir)
+	require.NoError(t, err)
+	require.Equal(t, []string{WALFile(dir, 3), WALFile(dir, 4)}, files)
+
+	entries, err := ReadWAL(WALFile(dir, 4))
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	require.Equal(t, WALHeight, entries[0].Kind)
+	require.Equal(t, WALTimeout, entries[1].Kind)
+	require.Equal(t, WALVerification, entries[2].Kind)
+	require.False(t, entries[2].Timestamp().Before(entries[0].Timestamp()))
+
+	start := new(walHeight)
+	require.NoError(t, rlp.DecodeBytes(entries[0].Data, start))
+	require.Equal(t, uint64(4), start.Height)
+	require.Equal(t, committee.Len(), start.Committee.Len())
+	require.Nil(t, start.Journal)
+	require.Nil(t, start.Candidate)
+	verification := new(walVerification)
+	require.NoError(t, rlp.DecodeBytes(entries[2].Data, verification))
+	require.Equal(t, walVerification{Hash: common.Hash{4}, Error: "invalid"}, *verification)
+
+	// a truncated entry is reported along with the entries preceding it
+	require.NoError(t, wal.Close())
+	file := WALFile(dir, 4)
+	info, err := os.Stat(file)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(file, info.Size()-1))
+	entries, err = ReadWAL(file)
+	require.Error(t, err)
+	require.Len(t, entries, 2)
+
+	require.Equal(t, filepath.Join(dir, "00000000000000000004.wal"), file)
+}
+
+// walScenario records to a WAL the height in which the local validator, which is not the proposer, decides the
+// proposal of round 0 of a committee of four members.
+type walScenario struct {
+	dir       string
+	wal       *WAL
+	committee *types.Committee
+	keys      AddressKeyMap
+	local     *types.CommitteeMember
+	proposal  *message.Propose
+}
+
+func newWALScenario(t *testing.T) *walScenario {
+	dir := t.TempDir()
+	wal, err := OpenWAL(dir, 1, log.Root())
+	require.NoError(t, err)
+	t.Cleanup(func() { wal.Close() })
+
+	committee, keys := GenerateCommittee(4)
+	parent := &types.Header{Number: big.NewInt(9)}
+	elected, err := tdmcommittee.StrategyAt(nil, 10).Proposer(committee, 9, 0)
+	require.NoError(t, err)
+	proposer := committee.MemberByAddress(elected)
+	local := &committee.Members[(proposer.Index+1)%4]
+	block := generateBlock(big.NewInt(10))
+	s := &walScenario{
+		dir:       dir,
+		wal:       wal,
+		committee: committee,
+		keys:      keys,
+		local:     local,
+		proposal:  message.NewPropose(0, 10, -1, block, makeSigner(keys[proposer.Address].consensus), proposer),
+	}
+	wal.startHeight(&walHeight{Height: 10, Address: local.Address, Parent: parent, Committee: committee, Strategy: tdmcommittee.WeightedRandomSampling})
+	return s
+}
+
+func (s *walScenario) record(kind WALEntryKind, msg message.Msg) {
+	s.wal.record(kind, &walMessage{Code: msg.Code(), Payload: msg.Payload()})
+}
+
+// votes records the votes of the members other than the local validator.
+func (s *walScenario) votes(code uint8, value common.Hash) {
+	for i := range s.committee.Members {
+		member := &s.committee.Members[i]
+		if member.Address == s.local.Address {
+			continue
+		}
+		signer := makeSigner(s.keys[member.Address].consensus)
+		if code == message.PrevoteCode {
+			s.record(WALMessage, message.NewPrevote(0, 10, value, signer, member, s.committee.Len()))
+		} else {
+			s.record(WALMessage, message.NewPrecommit(0, 10, value, signer, member, s.committee.Len()))
+		}
+	}
+}
+
+func (s *walScenario) localVote(code uint8, value common.Hash) {
+	signer := makeSigner(s.keys[s.local.Address].consensus)
+	if code == message.PrevoteCode {
+		s.record(WALBroadcast, message.NewPrevote(0, 10, value, signer, s.local, s.committee.Len()))
+	} else {
+		s.record(WALBroadcast, message.NewPrecommit(0, 10, value, signer, s.local, s.committee.Len()))
+	}
+}
+
+func (s *walScenario) replay(t *testing.T) *ReplaySession {
+	require.NoError(t, s.wal.Close())
+	report, err := ReplayWAL(WALFile(s.dir, 10), log.Root())
+	require.NoError(t, err)
+	require.Len(t, report.Sessions, 1)
+	return report.Sessions[0]
+}
+
+func TestReplayWAL(t *testing.T) {
+	t.Run("replayed decision matches the recorded one", func(t *testing.T) {
+		s := newWALScenario(t)
+		value := s.proposal.Value()
+		s.record(WALMessage, s.proposal)
+		s.wal.record(WALVerification, &walVerification{Hash: value})
+		s.localVote(message.PrevoteCode, value)
+		s.votes(message.PrevoteCode, value)
+		s.localVote(message.PrecommitCode, value)
+		s.votes(message.PrecommitCode, value)
+		s.wal.record(WALDecision, &walDecision{Height: 10, Round: 0, Value: value})
+
+		session := s.replay(t)
+		require.Equal(t, uint64(10), session.Height)
+		require.Len(t, session.Recorded, 3)
+		require.Len(t, session.Replayed, 3)
+		require.Empty(t, session.Divergences)
+		require.Equal(t, WALDecision, session.Replayed[2].Kind)
+		require.Equal(t, value, session.Replayed[2].Value)
+	})
+
+	t.Run("replay diverges from a recording with a different verification outcome", func(t *testing.T) {
+		s := newWALScenario(t)
+		value := s.proposal.Value()
+		s.record(WALMessage, s.proposal)
+		// the proposal was recorded valid, yet the validator prevoted nil
+		s.wal.record(WALVerification, &walVerification{Hash: value})
+		s.localVote(message.PrevoteCode, common.Hash{})
+		s.votes(message.PrevoteCode, value)
+
+		session := s.replay(t)
+		require.Len(t, session.Divergences, 1)
+		divergence := session.Divergences[0]
+		require.Equal(t, common.Hash{}, divergence.Recorded.Value)
+		require.Equal(t, value, divergence.Replayed.Value)
+		require.Equal(t, 1, divergence.Replayed.Entry)
+		require.Equal(t, 1, divergence.Entry())
+		// the replay carried on with a precommit, which is not compared past the first divergence
+		require.Len(t, session.Replayed, 2)
+		require.Equal(t, uint8(message.PrecommitCode), session.Replayed[1].Code)
+	})
+
+	t.Run("recorded verification errors are replayed", func(t *testing.T) {
+		s := newWALScenario(t)
+		s.record(WALMessage, s.proposal)
+		s.wal.record(WALVerification, &walVerification{Hash: s.proposal.Value(), Error: "invalid block"})
+		s.localVote(message.PrevoteCode, common.Hash{})
+
+		session := s.replay(t)
+		require.Empty(t, session.Divergences)
+		require.Len(t, session.Replayed, 1)
+	})
+
+	t.Run("WAL without height entry", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "00000000000000000001.wal")
+		data, err := rlp.EncodeToBytes(&WALEntry{Kind: WALTimeout})
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(path, data, 0600))
+		_, err = ReplayWAL(path, log.Root())
+		require.True(t, errors.Is(err, errNoWALHeight))
+	})
+}
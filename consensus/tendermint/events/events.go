@@ -86,3 +86,35 @@ type AccountabilityEvent struct {
 	Payload []byte
 	ErrCh   chan<- error
 }
+
+// CoreEventType is the type of a CoreEvent.
+type CoreEventType string
+
+const (
+	CoreStep     CoreEventType = "step"     // step transition
+	CoreRound    CoreEventType = "round"    // round started, round 0 starting a new height
+	CoreTimeout  CoreEventType = "timeout"  // step timeout fired
+	CoreProposal CoreEventType = "proposal" // current round proposal received and verified
+	CoreQuorum   CoreEventType = "quorum"   // quorum of prevotes or precommits reached for a value
+	CoreCommit   CoreEventType = "commit"   // proposal committed
+)
+
+// CoreEventTypes lists the types of the core events.
+var CoreEventTypes = []CoreEventType{CoreStep, CoreRound, CoreTimeout, CoreProposal, CoreQuorum, CoreCommit}
+
+// CoreEvent is a state change of the tendermint core, streamed to the consensus event subscribers. The fields
+// beyond the type, height, round and time are only set for the event types they relate to.
+type CoreEvent struct {
+	Type   CoreEventType `json:"type"`
+	Height uint64        `json:"height"`
+	Round  int64         `json:"round"`
+	Time   time.Time     `json:"time"`
+
+	Step           string           `json:"step,omitempty"`           // step entered, or step which timed out
+	Code           string           `json:"code,omitempty"`           // vote type of a quorum
+	Value          *common.Hash     `json:"value,omitempty"`          // value proposed, voted or committed, zero for nil
+	Proposer       *common.Address  `json:"proposer,omitempty"`       // proposer of a proposal
+	VerifyDuration time.Duration    `json:"verifyDuration,omitempty"` // time spent verifying a proposal, in nanoseconds
+	Error          string           `json:"error,omitempty"`          // reason a proposal failed verification
+	Signers        []common.Address `json:"signers,omitempty"`        // signers of the quorum certificate of a commit
+}
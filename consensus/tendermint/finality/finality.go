@@ -0,0 +1,131 @@
+// Package finality implements compact finality proofs for Autonity blocks. A
+// proof is a chain of epoch headers linking a trusted epoch header to the epoch
+// governing the target block, followed by the target header itself. Every
+// header of the chain carries a quorum certificate that is verified against the
+// committee of the epoch it belongs to, thus a verifier only needs to trust a
+// single epoch header to be convinced that the target block is final.
+package finality
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/autonity/autonity/consensus/tendermint/bft"
+	"github.com/autonity/autonity/consensus/tendermint/core/message"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/crypto/blst"
+	"github.com/autonity/autonity/log"
+)
+
+var (
+	// ErrNotEpochHeader is returned if a header expected to be an epoch header does not carry epoch information.
+	ErrNotEpochHeader = errors.New("not an epoch header")
+	// ErrMissingHeader is returned if the proof does not contain the target header.
+	ErrMissingHeader = errors.New("missing target header")
+	// ErrBrokenEpochChain is returned if two consecutive epoch headers of the proof are not linked together.
+	ErrBrokenEpochChain = errors.New("broken epoch header chain")
+	// ErrOutOfEpochRange is returned if the target header is not part of the epoch the proof leads to.
+	ErrOutOfEpochRange = errors.New("header out of epoch range")
+	// ErrInsufficientPower is returned if the signers of a quorum certificate do not reach quorum.
+	ErrInsufficientPower = errors.New("quorum certificate signers do not reach quorum")
+)
+
+// Proof is a finality proof of Header. EpochHeaders are ordered by height and
+// must start with the epoch header following the trusted one. The last epoch
+// header is the one governing Header, i.e. the epoch whose committee signed it.
+type Proof struct {
+	EpochHeaders []*types.Header `json:"epochHeaders"`
+	Header       *types.Header   `json:"header"`
+}
+
+// Verify checks that the proof links the trusted epoch header to the target
+// header and that every quorum certificate on the path reaches quorum in the
+// committee of its epoch. It returns the verified target header.
+func (p *Proof) Verify(trusted *types.Header) (*types.Header, error) {
+	if trusted == nil || !trusted.IsEpochHeader() {
+		return nil, fmt.Errorf("trusted header: %w", ErrNotEpochHeader)
+	}
+	if p.Header == nil {
+		return nil, ErrMissingHeader
+	}
+
+	current := trusted
+	for _, header := range p.EpochHeaders {
+		if header == nil || !header.IsEpochHeader() {
+			return nil, ErrNotEpochHeader
+		}
+		if err := VerifyEpochLink(current, header); err != nil {
+			return nil, err
+		}
+		if err := VerifyQuorumCertificate(header, current.Epoch.Committee); err != nil {
+			return nil, fmt.Errorf("epoch header %d: %w", header.Number.Uint64(), err)
+		}
+		current = header
+	}
+
+	number := p.Header.Number
+	if number.Cmp(current.Number) <= 0 || number.Cmp(current.Epoch.NextEpochBlock) > 0 {
+		return nil, ErrOutOfEpochRange
+	}
+	if p.Header.IsEpochHeader() {
+		if err := VerifyEpochLink(current, p.Header); err != nil {
+			return nil, err
+		}
+	}
+	if err := VerifyQuorumCertificate(p.Header, current.Epoch.Committee); err != nil {
+		return nil, fmt.Errorf("header %d: %w", number.Uint64(), err)
+	}
+	return p.Header, nil
+}
+
+// VerifyEpochLink checks that next is the epoch header directly following the epoch header current.
+func VerifyEpochLink(current, next *types.Header) error {
+	if next.Number.Cmp(current.Epoch.NextEpochBlock) != 0 || next.Epoch.PreviousEpochBlock.Cmp(current.Number) != 0 {
+		return fmt.Errorf("%w: epoch %d does not follow epoch %d", ErrBrokenEpochChain, next.Number.Uint64(), current.Number.Uint64())
+	}
+	return nil
+}
+
+// VerifyQuorumCertificate validates that the quorum certificate of header was
+// aggregated from precommits of committee members for this header and that
+// their voting power constitutes a quorum.
+func VerifyQuorumCertificate(header *types.Header, committee *types.Committee) error {
+	// un-finalized proposals will have these fields set to nil
+	if header.QuorumCertificate.Signature == nil || header.QuorumCertificate.Signers == nil {
+		return types.ErrEmptyQuorumCertificate
+	}
+	quorumCertificate := header.QuorumCertificate.Copy() // copy so that we do not modify the header when doing Signers.Validate()
+	if err := quorumCertificate.Signers.Validate(committee.Len()); err != nil {
+		return fmt.Errorf("Invalid quorum certificate signers information: %w", err)
+	}
+
+	// The data that was signed over for this block
+	headerSeal := message.PrepareCommittedSeal(header.Hash(), int64(header.Round), header.Number)
+
+	// Total Voting power for this block
+	power := new(big.Int)
+	for _, index := range quorumCertificate.Signers.FlattenUniq() {
+		power.Add(power, committee.Members[index].VotingPower)
+	}
+
+	// verify signature
+	var keys []blst.PublicKey //nolint
+	for _, index := range quorumCertificate.Signers.Flatten() {
+		keys = append(keys, committee.Members[index].ConsensusKey)
+	}
+	aggregatedKey, err := blst.AggregatePublicKeys(keys)
+	if err != nil {
+		log.Debug("Failed to aggregate keys from committee members", "number", header.Number, "signers", quorumCertificate.Signers.String(), "err", err)
+		return fmt.Errorf("failed to aggregate keys from committee members: %w", err)
+	}
+	if !quorumCertificate.Signature.Verify(aggregatedKey, headerSeal[:]) {
+		return types.ErrInvalidQuorumCertificate
+	}
+
+	// We need at least a quorum for the block to be considered valid
+	if power.Cmp(bft.Quorum(committee.TotalVotingPower())) < 0 {
+		return fmt.Errorf("%w: %w", types.ErrInvalidQuorumCertificate, ErrInsufficientPower)
+	}
+	return nil
+}
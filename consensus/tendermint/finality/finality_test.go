@@ -0,0 +1,196 @@
+package finality
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/consensus/tendermint/core/message"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/crypto/blst"
+	"github.com/autonity/autonity/rlp"
+)
+
+const epochPeriod = 10
+
+type testEpoch struct {
+	header    *types.Header
+	committee *types.Committee
+	keys      []blst.SecretKey
+}
+
+func newCommittee(t *testing.T, size int) (*types.Committee, []blst.SecretKey) {
+	committee := new(types.Committee)
+	keys := make([]blst.SecretKey, size)
+	for i := 0; i < size; i++ {
+		nodeKey, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		keys[i], err = blst.RandKey()
+		require.NoError(t, err)
+		committee.Members = append(committee.Members, types.CommitteeMember{
+			Address:           crypto.PubkeyToAddress(nodeKey.PublicKey),
+			VotingPower:       big.NewInt(1),
+			ConsensusKeyBytes: keys[i].PublicKey().Marshal(),
+			ConsensusKey:      keys[i].PublicKey(),
+			Index:             uint64(i),
+		})
+	}
+	return committee, keys
+}
+
+func newHeader(number uint64, parent common.Hash) *types.Header {
+	return &types.Header{
+		ParentHash: parent,
+		Number:     new(big.Int).SetUint64(number),
+		Difficulty: big.NewInt(1),
+		MixDigest:  types.BFTDigest,
+		Time:       number,
+	}
+}
+
+// sign aggregates the precommits of the given committee members for header into its quorum certificate.
+func sign(header *types.Header, committee *types.Committee, keys []blst.SecretKey, signers ...int) {
+	seal := message.PrepareCommittedSeal(header.Hash(), int64(header.Round), header.Number)
+	bitmap := types.NewSigners(committee.Len())
+	var signatures []blst.Signature
+	for _, i := range signers {
+		signatures = append(signatures, keys[i].Sign(seal[:]))
+		bitmap.Increment(&committee.Members[i])
+	}
+	header.QuorumCertificate = types.NewAggregateSignature(blst.AggregateSignatures(signatures).(*blst.BlsSignature), bitmap)
+}
+
+// newEpochChain generates count epochs of epochPeriod blocks, each epoch header being signed by the committee of
+// the previous epoch. The first epoch header acts as genesis and is not signed.
+func newEpochChain(t *testing.T, count int, committeeSize int) []testEpoch {
+	var epochs []testEpoch
+	for i := 0; i < count; i++ {
+		committee, keys := newCommittee(t, committeeSize)
+		number := uint64(i * epochPeriod)
+		header := newHeader(number, common.Hash{})
+		header.Epoch = &types.Epoch{
+			PreviousEpochBlock: new(big.Int).SetUint64(number),
+			NextEpochBlock:     new(big.Int).SetUint64(number + epochPeriod),
+			Committee:          committee,
+		}
+		if i > 0 {
+			previous := epochs[i-1]
+			header.Epoch.PreviousEpochBlock = new(big.Int).Set(previous.header.Number)
+			sign(header, previous.committee, previous.keys, allSigners(committeeSize)...)
+		}
+		epochs = append(epochs, testEpoch{header: header, committee: committee, keys: keys})
+	}
+	return epochs
+}
+
+func allSigners(size int) []int {
+	signers := make([]int, size)
+	for i := range signers {
+		signers[i] = i
+	}
+	return signers
+}
+
+func epochHeaders(epochs []testEpoch) []*types.Header {
+	var headers []*types.Header
+	for _, epoch := range epochs {
+		headers = append(headers, epoch.header)
+	}
+	return headers
+}
+
+func TestProofVerify(t *testing.T) {
+	epochs := newEpochChain(t, 4, 4)
+	last := epochs[len(epochs)-1]
+	target := newHeader(last.header.Number.Uint64()+3, common.Hash{})
+	sign(target, last.committee, last.keys, 0, 1, 2)
+
+	t.Run("valid proof from genesis", func(t *testing.T) {
+		proof := &Proof{EpochHeaders: epochHeaders(epochs[1:]), Header: target}
+		verified, err := proof.Verify(epochs[0].header)
+		require.NoError(t, err)
+		require.Equal(t, target.Hash(), verified.Hash())
+	})
+
+	t.Run("valid proof from an intermediate trusted epoch", func(t *testing.T) {
+		proof := &Proof{EpochHeaders: epochHeaders(epochs[3:]), Header: target}
+		_, err := proof.Verify(epochs[2].header)
+		require.NoError(t, err)
+	})
+
+	t.Run("target is the epoch header following the last proven epoch", func(t *testing.T) {
+		proof := &Proof{EpochHeaders: epochHeaders(epochs[1:3]), Header: epochs[3].header}
+		_, err := proof.Verify(epochs[0].header)
+		require.NoError(t, err)
+	})
+
+	t.Run("proof survives rlp and json encoding", func(t *testing.T) {
+		proof := &Proof{EpochHeaders: epochHeaders(epochs[1:]), Header: target}
+
+		encoded, err := rlp.EncodeToBytes(proof)
+		require.NoError(t, err)
+		decoded := new(Proof)
+		require.NoError(t, rlp.DecodeBytes(encoded, decoded))
+		_, err = decoded.Verify(epochs[0].header)
+		require.NoError(t, err)
+
+		encoded, err = json.Marshal(proof)
+		require.NoError(t, err)
+		decoded = new(Proof)
+		require.NoError(t, json.Unmarshal(encoded, decoded))
+		_, err = decoded.Verify(epochs[0].header)
+		require.NoError(t, err)
+	})
+
+	t.Run("missing epoch header breaks the chain", func(t *testing.T) {
+		proof := &Proof{EpochHeaders: []*types.Header{epochs[1].header, epochs[3].header}, Header: target}
+		_, err := proof.Verify(epochs[0].header)
+		require.True(t, errors.Is(err, ErrBrokenEpochChain))
+	})
+
+	t.Run("target outside of the last epoch", func(t *testing.T) {
+		proof := &Proof{EpochHeaders: epochHeaders(epochs[1:3]), Header: target}
+		_, err := proof.Verify(epochs[0].header)
+		require.True(t, errors.Is(err, ErrOutOfEpochRange))
+	})
+
+	t.Run("untrusted genesis", func(t *testing.T) {
+		fake := newEpochChain(t, 1, 4)[0]
+		proof := &Proof{EpochHeaders: epochHeaders(epochs[1:]), Header: target}
+		_, err := proof.Verify(fake.header)
+		require.True(t, errors.Is(err, types.ErrInvalidQuorumCertificate))
+	})
+
+	t.Run("insufficient voting power", func(t *testing.T) {
+		weak := newHeader(target.Number.Uint64(), common.Hash{})
+		sign(weak, last.committee, last.keys, 0, 1)
+		proof := &Proof{EpochHeaders: epochHeaders(epochs[1:]), Header: weak}
+		_, err := proof.Verify(epochs[0].header)
+		require.True(t, errors.Is(err, ErrInsufficientPower))
+	})
+
+	t.Run("tampered header", func(t *testing.T) {
+		tampered := types.CopyHeader(target)
+		tampered.Root = common.HexToHash("0x01")
+		proof := &Proof{EpochHeaders: epochHeaders(epochs[1:]), Header: tampered}
+		_, err := proof.Verify(epochs[0].header)
+		require.True(t, errors.Is(err, types.ErrInvalidQuorumCertificate))
+	})
+
+	t.Run("unsigned header", func(t *testing.T) {
+		proof := &Proof{EpochHeaders: epochHeaders(epochs[1:]), Header: newHeader(target.Number.Uint64(), common.Hash{})}
+		_, err := proof.Verify(epochs[0].header)
+		require.True(t, errors.Is(err, types.ErrEmptyQuorumCertificate))
+	})
+
+	t.Run("trusted header must be an epoch header", func(t *testing.T) {
+		proof := &Proof{EpochHeaders: epochHeaders(epochs[1:]), Header: target}
+		_, err := proof.Verify(target)
+		require.True(t, errors.Is(err, ErrNotEpochHeader))
+	})
+}
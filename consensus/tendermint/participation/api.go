@@ -0,0 +1,68 @@
+package participation
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/autonity/autonity/common"
+)
+
+// maxEpochRange bounds the number of epochs queried at once.
+const maxEpochRange = 1024
+
+var errNotTracking = errors.New("validator participation tracking not started")
+
+// ValidatorParticipation is the participation of a validator during an epoch it was a committee member of.
+type ValidatorParticipation struct {
+	Epoch      uint64 `json:"epoch"`
+	EpochBlock uint64 `json:"epochBlock"`
+	LastBlock  uint64 `json:"lastBlock"`
+	Participation
+}
+
+// API exposes the participation of the validators to the consensus, under the tendermint namespace.
+type API struct {
+	tracker *Tracker
+}
+
+func NewAPI(tracker *Tracker) *API {
+	return &API{tracker: tracker}
+}
+
+// GetValidatorParticipation returns the participation of a validator for the epochs in the range [fromEpoch,
+// toEpoch] it was a committee member of. The range ends at the current epoch if toEpoch is omitted. Epochs preceding
+// the start of the tracking are not reported.
+func (api *API) GetValidatorParticipation(address common.Address, fromEpoch uint64, toEpoch *uint64) ([]*ValidatorParticipation, error) {
+	current, ok := api.tracker.CurrentEpoch()
+	if !ok {
+		return nil, errNotTracking
+	}
+	to := current
+	if toEpoch != nil && *toEpoch < current {
+		to = *toEpoch
+	}
+	if fromEpoch > to {
+		return nil, fmt.Errorf("invalid epoch range [%d, %d], current epoch is %d", fromEpoch, to, current)
+	}
+	if to-fromEpoch >= maxEpochRange {
+		return nil, fmt.Errorf("epoch range larger than %d epochs", maxEpochRange)
+	}
+	result := make([]*ValidatorParticipation, 0)
+	for epoch := fromEpoch; epoch <= to; epoch++ {
+		participation := api.tracker.Epoch(epoch)
+		if participation == nil {
+			continue
+		}
+		member := participation.Member(address)
+		if member == nil {
+			continue
+		}
+		result = append(result, &ValidatorParticipation{
+			Epoch:         participation.Epoch,
+			EpochBlock:    participation.EpochBlock,
+			LastBlock:     participation.LastBlock,
+			Participation: *member,
+		})
+	}
+	return result, nil
+}
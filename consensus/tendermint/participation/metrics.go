@@ -0,0 +1,55 @@
+package participation
+
+import (
+	"fmt"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/metrics"
+)
+
+// memberMetrics exposes the participation of the members of the current epoch, as gauges labelled by validator.
+type memberMetrics struct {
+	gauges map[common.Address][]metrics.Gauge
+}
+
+var metricNames = []string{"blocks", "signed", "proposed", "missed", "lastseen"}
+
+func newMemberMetrics() *memberMetrics {
+	return &memberMetrics{gauges: make(map[common.Address][]metrics.Gauge)}
+}
+
+func metricName(name string, address common.Address) string {
+	return fmt.Sprintf("tendermint/participation/%s{validator=%q}", name, address.Hex())
+}
+
+// update reports the given participation, and drops the gauges of the validators which are no longer members.
+func (m *memberMetrics) update(participation *EpochParticipation) {
+	if !metrics.Enabled {
+		return
+	}
+	members := make(map[common.Address]struct{}, len(participation.Members))
+	for _, member := range participation.Members {
+		members[member.Address] = struct{}{}
+		gauges, ok := m.gauges[member.Address]
+		if !ok {
+			for _, name := range metricNames {
+				gauges = append(gauges, metrics.GetOrRegisterGauge(metricName(name, member.Address), nil))
+			}
+			m.gauges[member.Address] = gauges
+		}
+		gauges[0].Update(int64(member.Blocks))
+		gauges[1].Update(int64(member.BlocksSigned))
+		gauges[2].Update(int64(member.ProposalsMade))
+		gauges[3].Update(int64(member.RoundsMissed))
+		gauges[4].Update(int64(member.LastSeen))
+	}
+	for address := range m.gauges {
+		if _, ok := members[address]; ok {
+			continue
+		}
+		for _, name := range metricNames {
+			metrics.DefaultRegistry.Unregister(metricName(name, address))
+		}
+		delete(m.gauges, address)
+	}
+}
@@ -0,0 +1,329 @@
+// Package participation tracks the participation of the committee members to the consensus. It follows the
+// committed headers: the quorum certificate of each header tells which members signed the block, its coinbase which
+// member proposed it and its round how many rounds were needed to decide it.
+package participation
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/autonity/autonity/common"
+	tdmcommittee "github.com/autonity/autonity/consensus/tendermint/core/committee"
+	"github.com/autonity/autonity/core"
+	"github.com/autonity/autonity/core/rawdb"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/ethdb"
+	"github.com/autonity/autonity/event"
+	"github.com/autonity/autonity/log"
+	"github.com/autonity/autonity/params"
+	"github.com/autonity/autonity/rlp"
+)
+
+var errMissingHeader = errors.New("missing header")
+
+// Chain is the subset of the blockchain the tracker follows.
+type Chain interface {
+	Config() *params.ChainConfig
+	CurrentHeader() *types.Header
+	GetHeaderByNumber(number uint64) *types.Header
+	LatestEpoch() (*types.EpochInfo, error)
+	SubscribeChainHeadEvent(ch chan<- core.ChainHeadEvent) event.Subscription
+}
+
+// Participation is the participation of a committee member to the consensus during an epoch.
+type Participation struct {
+	Address       common.Address `json:"address"`
+	Blocks        uint64         `json:"blocks"`        // blocks decided by the committee
+	BlocksSigned  uint64         `json:"blocksSigned"`  // blocks whose quorum certificate includes the member
+	ProposalsMade uint64         `json:"proposalsMade"` // blocks proposed by the member
+	RoundsMissed  uint64         `json:"roundsMissed"`  // rounds elected to propose, but not decided
+	LastSeen      uint64         `json:"lastSeen"`      // last block signed by the member, zero if none
+}
+
+// EpochParticipation is the participation of the committee members of an epoch. EpochBlock is the block which
+// started the epoch, LastBlock the last block of the epoch processed so far.
+type EpochParticipation struct {
+	Epoch      uint64          `json:"epoch"`
+	EpochBlock uint64          `json:"epochBlock"`
+	LastBlock  uint64          `json:"lastBlock"`
+	Members    []Participation `json:"members"`
+}
+
+// Member returns the participation of the committee member with the given address, or nil if it is not a member.
+func (e *EpochParticipation) Member(address common.Address) *Participation {
+	for i := range e.Members {
+		if e.Members[i].Address == address {
+			return &e.Members[i]
+		}
+	}
+	return nil
+}
+
+func (e *EpochParticipation) copy() *EpochParticipation {
+	cpy := *e
+	cpy.Members = make([]Participation, len(e.Members))
+	copy(cpy.Members, e.Members)
+	return &cpy
+}
+
+// Tracker maintains the participation of the committee members per epoch, from the committed headers. The
+// participation is persisted, so that tracking resumes where it stopped across restarts.
+type Tracker struct {
+	chain  Chain
+	db     ethdb.KeyValueStore
+	logger log.Logger
+
+	mu        sync.RWMutex
+	current   *EpochParticipation // participation of the epoch of the last block processed
+	committee *types.Committee    // committee of the current epoch
+	metrics   *memberMetrics
+
+	started atomic.Bool
+	quit    chan struct{}
+	done    chan struct{}
+}
+
+// NewTracker creates a participation tracker following chain, which persists the participation in db.
+func NewTracker(chain Chain, db ethdb.KeyValueStore, logger log.Logger) *Tracker {
+	return &Tracker{
+		chain:   chain,
+		db:      db,
+		logger:  logger,
+		metrics: newMemberMetrics(),
+		quit:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+}
+
+// Start resumes the tracking where it stopped, or from the beginning of the latest epoch on the first start, then
+// follows the chain head until stopped.
+func (t *Tracker) Start() {
+	t.started.Store(true)
+	go t.loop()
+}
+
+func (t *Tracker) loop() {
+	defer close(t.done)
+
+	headCh := make(chan core.ChainHeadEvent, 16)
+	sub := t.chain.SubscribeChainHeadEvent(headCh)
+	defer sub.Unsubscribe()
+
+	if err := t.load(); err != nil {
+		t.logger.Error("Failed to start the participation tracker", "err", err)
+		return
+	}
+	t.catchUp()
+	for {
+		select {
+		case <-headCh:
+			t.catchUp()
+		case <-sub.Err():
+			return
+		case <-t.quit:
+			return
+		}
+	}
+}
+
+// Stop stops following the chain.
+func (t *Tracker) Stop() {
+	close(t.quit)
+	if t.started.Load() {
+		<-t.done
+	}
+}
+
+// Epoch returns the participation of the committee members during the given epoch, or nil if it was not tracked.
+func (t *Tracker) Epoch(epoch uint64) *EpochParticipation {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if t.current != nil && t.current.Epoch == epoch {
+		return t.current.copy()
+	}
+	participation, err := readParticipation(t.db, epoch)
+	if err != nil {
+		return nil
+	}
+	return participation
+}
+
+// CurrentEpoch returns the epoch of the last block processed.
+func (t *Tracker) CurrentEpoch() (uint64, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if t.current == nil {
+		return 0, false
+	}
+	return t.current.Epoch, true
+}
+
+// load restores the participation of the epoch where the tracking stopped, or starts tracking the latest epoch.
+func (t *Tracker) load() error {
+	var current *EpochParticipation
+	if data := rawdb.ReadParticipationHead(t.db); len(data) > 0 {
+		var epoch uint64
+		if err := rlp.DecodeBytes(data, &epoch); err != nil {
+			return err
+		}
+		participation, err := readParticipation(t.db, epoch)
+		if err != nil {
+			return err
+		}
+		current = participation
+	} else {
+		latest, err := t.chain.LatestEpoch()
+		if err != nil {
+			return err
+		}
+		epoch, err := t.epochID(latest.EpochBlock.Uint64())
+		if err != nil {
+			return err
+		}
+		current = newEpochParticipation(epoch, latest.EpochBlock.Uint64(), latest.Committee)
+	}
+	epochHeader := t.chain.GetHeaderByNumber(current.EpochBlock)
+	if epochHeader == nil || !epochHeader.IsEpochHeader() {
+		return fmt.Errorf("%w: epoch block %d", errMissingHeader, current.EpochBlock)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.current = current
+	t.committee = epochHeader.Epoch.Committee
+	t.metrics.update(current)
+	return nil
+}
+
+// epochID counts the epochs preceding the given epoch block, following the epoch headers back to the genesis.
+func (t *Tracker) epochID(epochBlock uint64) (uint64, error) {
+	id := uint64(0)
+	for epochBlock != 0 {
+		header := t.chain.GetHeaderByNumber(epochBlock)
+		if header == nil || !header.IsEpochHeader() {
+			return 0, fmt.Errorf("%w: epoch block %d", errMissingHeader, epochBlock)
+		}
+		epochBlock = header.Epoch.PreviousEpochBlock.Uint64()
+		id++
+	}
+	return id, nil
+}
+
+// catchUp processes the blocks committed since the last one processed, and persists the participation.
+func (t *Tracker) catchUp() {
+	head := t.chain.CurrentHeader().Number.Uint64()
+	t.mu.RLock()
+	next := t.current.LastBlock + 1
+	if t.current.LastBlock < t.current.EpochBlock {
+		next = t.current.EpochBlock + 1
+	}
+	t.mu.RUnlock()
+
+	batch := t.db.NewBatch()
+	for ; next <= head; next++ {
+		select {
+		case <-t.quit:
+			return
+		default:
+		}
+		header := t.chain.GetHeaderByNumber(next)
+		if header == nil {
+			t.logger.Warn("Participation tracker missing header", "number", next)
+			break
+		}
+		if ended := t.process(header); ended != nil {
+			writeParticipation(batch, ended)
+		}
+	}
+	t.mu.RLock()
+	writeParticipation(batch, t.current)
+	epoch, _ := rlp.EncodeToBytes(t.current.Epoch)
+	t.metrics.update(t.current)
+	t.mu.RUnlock()
+	rawdb.WriteParticipationHead(batch, epoch)
+	if err := batch.Write(); err != nil {
+		t.logger.Error("Failed to persist the validator participation", "err", err)
+	}
+}
+
+// process accounts the participation to the given header. If the header ends the current epoch, the participation
+// of the ended epoch is returned.
+func (t *Tracker) process(header *types.Header) *EpochParticipation {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	number := header.Number.Uint64()
+	current := t.current
+	current.LastBlock = number
+
+	var signers *types.Signers
+	if header.QuorumCertificate.Signers != nil && header.QuorumCertificate.Signers.Bits.Valid(t.committee.Len()) {
+		signers = header.QuorumCertificate.Signers
+	}
+	for i := range current.Members {
+		member := &current.Members[i]
+		member.Blocks++
+		if signers != nil && signers.Bits.Get(i) > 0 {
+			member.BlocksSigned++
+			member.LastSeen = number
+		}
+		if member.Address == header.Coinbase {
+			member.ProposalsMade++
+		}
+	}
+	// the proposers of the rounds preceding the decision round did not get their proposal decided
+	config := t.chain.Config()
+	for r := int64(0); r < int64(header.Round); r++ {
+		proposer, err := tdmcommittee.Proposer(config, t.committee, number-1, r)
+		if err != nil {
+			// no member to account the missed rounds to
+			break
+		}
+		if member := current.Member(proposer); member != nil {
+			member.RoundsMissed++
+		}
+	}
+
+	if !header.IsEpochHeader() {
+		return nil
+	}
+	ended := current
+	t.current = newEpochParticipation(current.Epoch+1, number, header.Epoch.Committee)
+	t.committee = header.Epoch.Committee
+	return ended
+}
+
+func newEpochParticipation(epoch uint64, epochBlock uint64, committee *types.Committee) *EpochParticipation {
+	participation := &EpochParticipation{
+		Epoch:      epoch,
+		EpochBlock: epochBlock,
+		LastBlock:  epochBlock,
+		Members:    make([]Participation, committee.Len()),
+	}
+	for i, member := range committee.Members {
+		participation.Members[i].Address = member.Address
+	}
+	return participation
+}
+
+func readParticipation(db ethdb.KeyValueReader, epoch uint64) (*EpochParticipation, error) {
+	data := rawdb.ReadParticipation(db, epoch)
+	if len(data) == 0 {
+		return nil, fmt.Errorf("participation of epoch %d not found", epoch)
+	}
+	participation := new(EpochParticipation)
+	if err := rlp.DecodeBytes(data, participation); err != nil {
+		return nil, err
+	}
+	return participation, nil
+}
+
+func writeParticipation(db ethdb.KeyValueWriter, participation *EpochParticipation) {
+	data, err := rlp.EncodeToBytes(participation)
+	if err != nil {
+		log.Crit("Failed to encode the validator participation", "err", err)
+	}
+	rawdb.WriteParticipation(db, participation.Epoch, data)
+}
@@ -0,0 +1,135 @@
+package participation
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/autonity/autonity/common"
+	tdmcommittee "github.com/autonity/autonity/consensus/tendermint/core/committee"
+	"github.com/autonity/autonity/core"
+	"github.com/autonity/autonity/core/rawdb"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/event"
+	"github.com/autonity/autonity/log"
+	"github.com/autonity/autonity/params"
+)
+
+type testChain struct {
+	config  *params.ChainConfig
+	headers []*types.Header
+	feed    event.Feed
+}
+
+func (c *testChain) Config() *params.ChainConfig  { return c.config }
+func (c *testChain) CurrentHeader() *types.Header { return c.headers[len(c.headers)-1] }
+
+func (c *testChain) GetHeaderByNumber(number uint64) *types.Header {
+	if number >= uint64(len(c.headers)) {
+		return nil
+	}
+	return c.headers[number]
+}
+
+func (c *testChain) LatestEpoch() (*types.EpochInfo, error) {
+	for i := len(c.headers) - 1; ; i-- {
+		if c.headers[i].IsEpochHeader() {
+			return &types.EpochInfo{Epoch: *c.headers[i].Epoch, EpochBlock: c.headers[i].Number}, nil
+		}
+	}
+}
+
+func (c *testChain) SubscribeChainHeadEvent(ch chan<- core.ChainHeadEvent) event.Subscription {
+	return c.feed.Subscribe(ch)
+}
+
+// add appends a header decided at round by the given proposer, signed by the members at the given indexes.
+func (c *testChain) add(committee *types.Committee, round uint64, proposer common.Address, signers ...int) *types.Header {
+	header := &types.Header{Number: big.NewInt(int64(len(c.headers))), Round: round, Coinbase: proposer}
+	if signers != nil {
+		header.QuorumCertificate.Signers = types.NewSigners(committee.Len())
+		for _, i := range signers {
+			header.QuorumCertificate.Signers.Bits.Set(i, 1)
+		}
+	}
+	c.headers = append(c.headers, header)
+	return header
+}
+
+func testCommittee(addresses ...common.Address) *types.Committee {
+	committee := new(types.Committee)
+	for i, address := range addresses {
+		committee.Members = append(committee.Members, types.CommitteeMember{
+			Address:     address,
+			VotingPower: common.Big1,
+			Index:       uint64(i),
+		})
+	}
+	return committee
+}
+
+func TestTracker(t *testing.T) {
+	v1, v2, v3, v4 := common.Address{1}, common.Address{2}, common.Address{3}, common.Address{4}
+	first := testCommittee(v1, v2, v3)
+	second := testCommittee(v2, v3, v4)
+	chain := &testChain{config: &params.ChainConfig{ProposerElection: []params.ProposerElectionFork{
+		{Block: common.Big0, Strategy: tdmcommittee.RoundRobin},
+	}}}
+	genesis := chain.add(first, 0, common.Address{})
+	genesis.Epoch = &types.Epoch{PreviousEpochBlock: common.Big0, NextEpochBlock: big.NewInt(4), Committee: first}
+	chain.add(first, 0, v2, 0, 1)
+	// the proposers of rounds 0 and 1 at height 2 are the members following the parent height
+	chain.add(first, 2, v1, 0, 1, 2)
+	// malformed quorum certificate
+	chain.add(testCommittee(v1, v2, v3, v4, common.Address{5}), 0, v3, 0)
+
+	db := rawdb.NewMemoryDatabase()
+	tracker := NewTracker(chain, db, log.Root())
+	require.NoError(t, tracker.load())
+	tracker.catchUp()
+
+	epoch := tracker.Epoch(0)
+	require.NotNil(t, epoch)
+	require.Equal(t, uint64(3), epoch.LastBlock)
+	require.Equal(t, Participation{Address: v1, Blocks: 3, BlocksSigned: 2, ProposalsMade: 1, LastSeen: 2}, *epoch.Member(v1))
+	require.Equal(t, Participation{Address: v2, Blocks: 3, BlocksSigned: 2, ProposalsMade: 1, RoundsMissed: 1, LastSeen: 2}, *epoch.Member(v2))
+	require.Equal(t, Participation{Address: v3, Blocks: 3, BlocksSigned: 1, ProposalsMade: 1, RoundsMissed: 1, LastSeen: 2}, *epoch.Member(v3))
+
+	epochHeader := chain.add(first, 0, v2, 0, 1, 2)
+	epochHeader.Epoch = &types.Epoch{PreviousEpochBlock: common.Big0, NextEpochBlock: big.NewInt(8), Committee: second}
+	chain.add(second, 0, v4, 2)
+	tracker.catchUp()
+
+	// the tracking resumes from the persisted participation
+	chain.add(second, 0, v4, 1, 2)
+	restarted := NewTracker(chain, db, log.Root())
+	require.NoError(t, restarted.load())
+	restarted.catchUp()
+
+	ended := restarted.Epoch(0)
+	require.Equal(t, uint64(4), ended.LastBlock)
+	require.Equal(t, uint64(4), ended.Member(v1).Blocks)
+	require.Equal(t, uint64(4), ended.Member(v1).LastSeen)
+	current := restarted.Epoch(1)
+	require.Equal(t, uint64(4), current.EpochBlock)
+	require.Equal(t, uint64(6), current.LastBlock)
+	require.Nil(t, current.Member(v1))
+	require.Equal(t, Participation{Address: v4, Blocks: 2, BlocksSigned: 2, ProposalsMade: 2, LastSeen: 6}, *current.Member(v4))
+	require.Equal(t, Participation{Address: v3, Blocks: 2, BlocksSigned: 1, LastSeen: 6}, *current.Member(v3))
+
+	api := NewAPI(restarted)
+	result, err := api.GetValidatorParticipation(v1, 0, nil)
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	require.Equal(t, uint64(0), result[0].Epoch)
+	result, err = api.GetValidatorParticipation(v2, 0, nil)
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	toEpoch := uint64(0)
+	result, err = api.GetValidatorParticipation(v4, 0, &toEpoch)
+	require.NoError(t, err)
+	require.Empty(t, result)
+	_, err = api.GetValidatorParticipation(v4, 2, nil)
+	require.Error(t, err)
+}
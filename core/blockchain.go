@@ -2300,21 +2300,27 @@ func (bc *BlockChain) maintainTxIndex(ancients uint64) {
 	indexBlocks := func(tail *uint64, head uint64, done chan struct{}) {
 		defer func() { done <- struct{}{} }()
 
+		// The blocks before the history tail, e.g. before the epoch checkpoint the
+		// chain was synced from, were never retrieved.
+		var first uint64
+		if historyTail := rawdb.ReadHistoryTail(bc.db); historyTail != nil {
+			first = *historyTail
+		}
 		// If the user just upgraded Geth to a new version which supports transaction
 		// index pruning, write the new tail and remove anything older.
 		if tail == nil {
 			if bc.txLookupLimit == 0 || head < bc.txLookupLimit {
 				// Nothing to delete, write the tail and return
-				rawdb.WriteTxIndexTail(bc.db, 0)
+				rawdb.WriteTxIndexTail(bc.db, first)
 			} else {
 				// Prune all stale tx indices and record the tx index tail
-				rawdb.UnindexTransactions(bc.db, 0, head-bc.txLookupLimit+1, bc.quit)
+				rawdb.UnindexTransactions(bc.db, first, head-bc.txLookupLimit+1, bc.quit)
 			}
 			return
 		}
 		// If a previous indexing existed, make sure that we fill in any missing entries
 		if bc.txLookupLimit == 0 || head < bc.txLookupLimit {
-			if *tail > 0 {
+			if *tail > first {
 				// It can happen when chain is rewound to a historical point which
 				// is even lower than the indexes tail, recap the indexing target
 				// to new head to avoid reading non-existent block bodies.
@@ -2322,14 +2328,18 @@ func (bc *BlockChain) maintainTxIndex(ancients uint64) {
 				if end > head+1 {
 					end = head + 1
 				}
-				rawdb.IndexTransactions(bc.db, 0, end, bc.quit)
+				rawdb.IndexTransactions(bc.db, first, end, bc.quit)
 			}
 			return
 		}
 		// Update the transaction index to the new chain state
 		if head-bc.txLookupLimit+1 < *tail {
 			// Reindex a part of missing indices and rewind index tail to HEAD-limit
-			rawdb.IndexTransactions(bc.db, head-bc.txLookupLimit+1, *tail, bc.quit)
+			from := head - bc.txLookupLimit + 1
+			if from < first {
+				from = first
+			}
+			rawdb.IndexTransactions(bc.db, from, *tail, bc.quit)
 		} else {
 			// Unindex a part of stale indices and forward index tail to HEAD-limit
 			rawdb.UnindexTransactions(bc.db, *tail, head-bc.txLookupLimit+1, bc.quit)
@@ -0,0 +1,116 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/common/hexutil"
+	"github.com/autonity/autonity/core/rawdb"
+	"github.com/autonity/autonity/core/types"
+)
+
+var (
+	// ErrCheckpointNotEpochHeader is returned if the header of an epoch checkpoint does not carry epoch information.
+	ErrCheckpointNotEpochHeader = errors.New("checkpoint header is not an epoch header")
+
+	// ErrCheckpointChainNotEmpty is returned if an epoch checkpoint is inserted into a chain going past genesis.
+	ErrCheckpointChainNotEmpty = errors.New("chain already goes past genesis")
+)
+
+// EpochCheckpoint is a finalized epoch header a node is synced from instead of the genesis block. Autonity having
+// deterministic finality, the header and its whole history are final once its quorum certificate is verified
+// against the committee trusted to have signed it, i.e. the committee of the epoch preceding the header.
+type EpochCheckpoint struct {
+	Hash      common.Hash      `json:"hash"`
+	Header    *types.Header    `json:"header,omitempty"` // retrieved from the peers if missing
+	Committee *types.Committee `json:"committee"`
+}
+
+// LoadEpochCheckpoint creates an epoch checkpoint from either the hash of the epoch header or the path of a JSON file
+// holding the header and the committee which signed it. committeeFile is the path of a JSON file holding the trusted
+// committee, it is required for a checkpoint given by hash and overrides the committee of a checkpoint file.
+func LoadEpochCheckpoint(checkpoint string, committeeFile string) (*EpochCheckpoint, error) {
+	cp := new(EpochCheckpoint)
+	if hash, err := hexutil.Decode(checkpoint); err == nil && len(hash) == common.HashLength {
+		cp.Hash = common.BytesToHash(hash)
+	} else {
+		if err := readJSONFile(checkpoint, cp); err != nil {
+			return nil, fmt.Errorf("invalid checkpoint file: %w", err)
+		}
+		if cp.Header == nil {
+			return nil, errors.New("invalid checkpoint file: missing header")
+		}
+		if cp.Hash != (common.Hash{}) && cp.Hash != cp.Header.Hash() {
+			return nil, fmt.Errorf("invalid checkpoint file: hash %s does not match header %s", cp.Hash, cp.Header.Hash())
+		}
+		cp.Hash = cp.Header.Hash()
+		if !cp.Header.IsEpochHeader() {
+			return nil, ErrCheckpointNotEpochHeader
+		}
+	}
+	if committeeFile != "" {
+		cp.Committee = new(types.Committee)
+		if err := readJSONFile(committeeFile, cp.Committee); err != nil {
+			return nil, fmt.Errorf("invalid checkpoint committee file: %w", err)
+		}
+	}
+	if cp.Committee == nil || cp.Committee.Len() == 0 {
+		return nil, errors.New("missing trusted checkpoint committee")
+	}
+	if err := cp.Committee.Enrich(); err != nil {
+		return nil, fmt.Errorf("invalid checkpoint committee: %w", err)
+	}
+	return cp, nil
+}
+
+func readJSONFile(path string, v interface{}) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// InsertEpochCheckpoint writes the header of an epoch checkpoint as the head header of a chain which does not go past
+// the genesis block yet, so that the chain is synced from the checkpoint. The quorum certificate of the header must
+// have been verified by the caller. The history before the checkpoint is never retrieved, the block following it
+// being recorded as the history tail the ancient store and the transaction index start from.
+func (bc *BlockChain) InsertEpochCheckpoint(header *types.Header) error {
+	if !header.IsEpochHeader() {
+		return ErrCheckpointNotEpochHeader
+	}
+	if !bc.chainmu.TryLock() {
+		return errChainStopped
+	}
+	defer bc.chainmu.Unlock()
+
+	if bc.CurrentHeader().Number.Sign() != 0 || bc.CurrentFastBlock().NumberU64() != 0 {
+		return ErrCheckpointChainNotEmpty
+	}
+	// The difficulty of the blocks is constant, thus the total difficulty of the checkpoint is the one the nodes
+	// which went through the whole history have.
+	number := header.Number.Uint64()
+	td := new(big.Int).Mul(header.Difficulty, header.Number)
+	td.Add(td, bc.genesisBlock.Difficulty())
+
+	batch := bc.db.NewBatch()
+	rawdb.WriteHeader(batch, header)
+	rawdb.WriteTd(batch, header.Hash(), number, td)
+	rawdb.WriteCanonicalHash(batch, header.Hash(), number)
+	rawdb.WriteHeadHeaderHash(batch, header.Hash())
+	rawdb.WriteEpochHeaderHash(batch, header.Hash())
+	rawdb.WriteHistoryTail(batch, number+1)
+	rawdb.WriteTxIndexTail(batch, number+1)
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	bc.hc.SetCurrentHeader(header)
+	bc.hc.SetCurrentHeadEpochHeader(header)
+	headEpochHeaderGauge.Update(header.Number.Int64())
+	bc.log.Info("Inserted epoch checkpoint", "number", number, "hash", header.Hash(), "nextEpochBlock", header.Epoch.NextEpochBlock)
+	return nil
+}
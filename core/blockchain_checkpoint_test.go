@@ -0,0 +1,74 @@
+package core
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/crypto/blst"
+)
+
+func TestLoadEpochCheckpoint(t *testing.T) {
+	key, err := blst.RandKey()
+	require.NoError(t, err)
+	committee := &types.Committee{Members: []types.CommitteeMember{{
+		Address:           common.HexToAddress("0x01"),
+		VotingPower:       big.NewInt(1),
+		ConsensusKeyBytes: key.PublicKey().Marshal(),
+	}}}
+	header := &types.Header{
+		Number:     big.NewInt(30),
+		Difficulty: big.NewInt(1),
+		Epoch: &types.Epoch{
+			PreviousEpochBlock: big.NewInt(20),
+			NextEpochBlock:     big.NewInt(40),
+			Committee:          committee,
+		},
+	}
+
+	dir := t.TempDir()
+	writeJSON := func(name string, v interface{}) string {
+		data, err := json.Marshal(v)
+		require.NoError(t, err)
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, data, 0600))
+		return path
+	}
+	committeeFile := writeJSON("committee.json", committee)
+	checkpointFile := writeJSON("checkpoint.json", &EpochCheckpoint{Header: header, Committee: committee})
+
+	t.Run("by hash", func(t *testing.T) {
+		cp, err := LoadEpochCheckpoint(header.Hash().Hex(), committeeFile)
+		require.NoError(t, err)
+		require.Equal(t, header.Hash(), cp.Hash)
+		require.Nil(t, cp.Header)
+		require.NotNil(t, cp.Committee.Members[0].ConsensusKey)
+	})
+	t.Run("by hash without committee", func(t *testing.T) {
+		_, err := LoadEpochCheckpoint(header.Hash().Hex(), "")
+		require.Error(t, err)
+	})
+	t.Run("from file", func(t *testing.T) {
+		cp, err := LoadEpochCheckpoint(checkpointFile, "")
+		require.NoError(t, err)
+		require.Equal(t, header.Hash(), cp.Hash)
+		require.Equal(t, header.Hash(), cp.Header.Hash())
+		require.NotNil(t, cp.Committee.Members[0].ConsensusKey)
+	})
+	t.Run("from file with hash mismatch", func(t *testing.T) {
+		path := writeJSON("mismatch.json", &EpochCheckpoint{Hash: common.HexToHash("0x01"), Header: header, Committee: committee})
+		_, err := LoadEpochCheckpoint(path, "")
+		require.Error(t, err)
+	})
+	t.Run("not an epoch header", func(t *testing.T) {
+		path := writeJSON("noepoch.json", &EpochCheckpoint{Header: &types.Header{Number: big.NewInt(31), Difficulty: big.NewInt(1)}, Committee: committee})
+		_, err := LoadEpochCheckpoint(path, "")
+		require.ErrorIs(t, err, ErrCheckpointNotEpochHeader)
+	})
+}
@@ -33,8 +33,8 @@ import (
 )
 
 // CommitteeOfHeight resolve committee of height, it is used by consensus engine and AFD modules only.
-// It get committee from LRU cache first, otherwise it trys to search backward epoch with limited hops, if the
-// committee of the height cannot be find still, then it try to query it from the state DB.
+// It get committee from LRU cache first, otherwise it trys to search backward epoch with limited hops in the
+// header chain, if the committee of the height cannot be find still, then it try to query it from the state DB.
 func (bc *BlockChain) CommitteeOfHeight(height uint64) (*types.Committee, error) {
 	epoch, err := bc.EpochOfHeight(height)
 	if err != nil {
@@ -71,6 +71,13 @@ func (bc *BlockChain) EpochOfHeight(height uint64) (*types.EpochInfo, error) {
 		return epoch, nil
 	}
 
+	// then search backward the epoch headers of the header chain, which might be ahead of the state or start from an
+	// epoch checkpoint without the previous history.
+	if epoch := bc.epochOfHeaderChain(height); epoch != nil {
+		bc.epochCache.Add(height, epoch)
+		return epoch, nil
+	}
+
 	// otherwise try to get committee from state db of the height.
 	// snap sync/fast sync will go here to fetch committee from a downloaded state db.
 	currentHeader := bc.CurrentHeader()
@@ -104,8 +111,11 @@ func (bc *BlockChain) LatestEpoch() (*types.EpochInfo, error) {
 	// For snap sync or fast sync case we need to get epoch info from state DB:
 	// as snap sync/fast sync mode might miss the latest epoch block before the
 	// pivot block, thus, for header verification after pivot block, we can load
-	// it from state db.
+	// it from state db, unless the epoch header is known from the header chain.
 	currentBlock := bc.CurrentBlock()
+	if epoch := bc.epochOfHeaderChain(currentBlock.NumberU64() + 1); epoch != nil {
+		return epoch, nil
+	}
 	st, err := bc.StateAt(currentBlock.Header().Root)
 	if err != nil {
 		return nil, err
@@ -113,6 +123,32 @@ func (bc *BlockChain) LatestEpoch() (*types.EpochInfo, error) {
 	return bc.protocolContracts.EpochInfo(currentBlock.Header(), st)
 }
 
+// maxEpochHops is the number of epoch headers walked backward in the header chain to resolve the epoch of a height.
+const maxEpochHops = 16
+
+// epochOfHeaderChain searches the epoch of the height backward from the latest epoch header of the header chain, with
+// a limited number of hops. It returns nil if the epoch is not found, e.g. if the epoch headers are not all known.
+func (bc *BlockChain) epochOfHeaderChain(height uint64) *types.EpochInfo {
+	header := bc.hc.CurrentHeadEpochHeader()
+	for hops := 0; header != nil && header.IsEpochHeader() && hops < maxEpochHops; hops++ {
+		number := header.Number.Uint64()
+		if height > header.Epoch.NextEpochBlock.Uint64() {
+			return nil
+		}
+		if height > number {
+			return &types.EpochInfo{
+				Epoch:      *header.Epoch.Copy(),
+				EpochBlock: new(big.Int).Set(header.Number),
+			}
+		}
+		if number == 0 {
+			return nil
+		}
+		header = bc.GetHeaderByNumber(header.Epoch.PreviousEpochBlock.Uint64())
+	}
+	return nil
+}
+
 // CurrentHeader retrieves the current head header of the canonical chain. The
 // header is retrieved from the HeaderChain's internal cache.
 func (bc *BlockChain) CurrentHeader() *types.Header {
@@ -0,0 +1,726 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+
+	ethereum "github.com/autonity/autonity"
+	"github.com/autonity/autonity/accounts/abi/bind"
+	"github.com/autonity/autonity/autonity"
+	"github.com/autonity/autonity/common"
+	hexmath "github.com/autonity/autonity/common/math"
+	"github.com/autonity/autonity/core/state"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/core/vm"
+	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/log"
+	"github.com/autonity/autonity/params"
+	"github.com/autonity/autonity/trie"
+)
+
+// unbondingNotReleased is the state of the unbonding requests whose stake is not released yet.
+const unbondingNotReleased = 0
+
+// ErrMissingPreimage is returned if the address of an account or a storage key of the exported state is unknown.
+var ErrMissingPreimage = errors.New("missing account preimages, the chain must be processed with --cache.preimages")
+
+// ExportGenesis builds a genesis which relaunches the network from the state of block number. The accounts are
+// carried over with their balance, code and storage, while the protocol contracts are deployed again from a
+// configuration reconstructed from their live state:
+//   - the validators are registered with their self-bonded stake and state and the delegated stake is bonded from
+//     the delegators' accounts, the unclaimed staking rewards and the stake being unbonded being credited to them,
+//   - the open CDPs are closed, their collateral being credited to their owner,
+//   - the remaining value of the vesting contracts vests along the curve of the original contract, the contracts
+//     over at the time of block number being credited to their beneficiary.
+//
+// The commission rates are not carried over, neither are the staking operations pending at block number, which
+// should thus be an epoch header.
+func ExportGenesis(chain *BlockChain, number uint64) (*Genesis, error) {
+	header := chain.GetHeaderByNumber(number)
+	if header == nil {
+		return nil, fmt.Errorf("block %d not found", number)
+	}
+	// the preimages are read from the disk whether the chain records them or not
+	statedb, err := state.New(header.Root, state.NewDatabaseWithConfig(chain.db, &trie.Config{Preimages: true}), nil)
+	if err != nil {
+		return nil, fmt.Errorf("state of block %d not available: %w", number, err)
+	}
+	if !header.IsEpochHeader() {
+		log.Warn("Exported block is not an epoch header, pending staking operations are lost", "number", number)
+	}
+
+	config := *chain.Config()
+	e := &genesisExporter{
+		header: header,
+		caller: &stateCaller{header: header, statedb: statedb.Copy(), evmProvider: GetDefaultEVM(chain)},
+		config: &config,
+		alloc:  make(map[common.Address]*GenesisAccount),
+		excluded: map[common.Address]bool{
+			params.DeployerAddress:                    true,
+			params.AutonityContractAddress:            true,
+			params.AccountabilityContractAddress:      true,
+			params.OracleContractAddress:              true,
+			params.ACUContractAddress:                 true,
+			params.SupplyControlContractAddress:       true,
+			params.StabilizationContractAddress:       true,
+			params.UpgradeManagerContractAddress:      true,
+			params.InflationControllerContractAddress: true,
+			params.StakableVestingContractAddress:     true,
+			params.NonStakableVestingContractAddress:  true,
+		},
+	}
+	if err := e.exportValidators(); err != nil {
+		return nil, fmt.Errorf("cannot export the validators: %w", err)
+	}
+	if err := e.collectAccounts(chain, statedb); err != nil {
+		return nil, err
+	}
+	e.exportProtocolFunds(statedb)
+	if err := e.exportStake(); err != nil {
+		return nil, fmt.Errorf("cannot export the stake: %w", err)
+	}
+	if err := e.exportContractConfigs(); err != nil {
+		return nil, fmt.Errorf("cannot export the protocol contracts configuration: %w", err)
+	}
+	if err := e.exportStakableVesting(); err != nil {
+		return nil, fmt.Errorf("cannot export the stakable vesting contracts: %w", err)
+	}
+	if err := e.exportNonStakableVesting(); err != nil {
+		return nil, fmt.Errorf("cannot export the non-stakable vesting contracts: %w", err)
+	}
+
+	genesis := &Genesis{
+		Config:     e.config,
+		Timestamp:  header.Time,
+		GasLimit:   header.GasLimit,
+		Difficulty: new(big.Int),
+		Mixhash:    header.MixDigest, // the BFT digest, the headers carry their epoch
+		BaseFee:    header.BaseFee,
+		Alloc:      make(GenesisAlloc, len(e.alloc)),
+	}
+	for address, account := range e.alloc {
+		if account.Balance.Sign() == 0 && account.NewtonBalance == nil && len(account.Bonds) == 0 &&
+			account.Nonce == 0 && len(account.Code) == 0 && len(account.Storage) == 0 {
+			continue
+		}
+		genesis.Alloc[address] = *account
+	}
+	return genesis, nil
+}
+
+// stateCaller executes the calls of the protocol contract bindings against the state of a block.
+type stateCaller struct {
+	header      *types.Header
+	statedb     *state.StateDB
+	evmProvider func(header *types.Header, origin common.Address, statedb vm.StateDB) *vm.EVM
+}
+
+func (c *stateCaller) CodeAt(_ context.Context, contract common.Address, _ *big.Int) ([]byte, error) {
+	return c.statedb.GetCode(contract), nil
+}
+
+func (c *stateCaller) CallContract(_ context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	evm := c.evmProvider(c.header, call.From, c.statedb)
+	ret, _, err := evm.Call(vm.AccountRef(call.From), *call.To, call.Data, math.MaxUint64, new(big.Int))
+	return ret, err
+}
+
+// genesisExporter reconstructs the genesis configuration and allocation from the state of a block.
+type genesisExporter struct {
+	header *types.Header
+	caller bind.ContractCaller
+	config *params.ChainConfig
+	alloc  map[common.Address]*GenesisAccount
+
+	excluded   map[common.Address]bool // contracts deployed again at genesis
+	validators []autonity.AutonityValidator
+	dropped    []autonity.AutonityValidator // validators without bonded stake, not registered again
+	accounts   []common.Address             // accounts possibly holding funds, sorted
+}
+
+// exportValidators registers the validators with their self-bonded stake, state and jail period.
+func (e *genesisExporter) exportValidators() error {
+	contract, err := autonity.NewAutonityCaller(params.AutonityContractAddress, e.caller)
+	if err != nil {
+		return err
+	}
+	config, err := contract.Config(nil)
+	if err != nil {
+		return err
+	}
+	reserve, err := contract.InflationReserve(nil)
+	if err != nil {
+		return err
+	}
+	liquidLogic, err := contract.LiquidLogicContract(nil)
+	if err != nil {
+		return err
+	}
+	e.excluded[liquidLogic] = true
+	e.config.AutonityContractConfig = &params.AutonityContractGenesis{
+		MinBaseFee:              config.Policy.MinBaseFee.Uint64(),
+		EpochPeriod:             config.Protocol.EpochPeriod.Uint64(),
+		UnbondingPeriod:         config.Policy.UnbondingPeriod.Uint64(),
+		BlockPeriod:             config.Protocol.BlockPeriod.Uint64(),
+		MaxCommitteeSize:        config.Protocol.CommitteeSize.Uint64(),
+		Operator:                config.Protocol.OperatorAccount,
+		Treasury:                config.Policy.TreasuryAccount,
+		TreasuryFee:             config.Policy.TreasuryFee.Uint64(),
+		DelegationRate:          config.Policy.DelegationRate.Uint64(),
+		InitialInflationReserve: (*hexmath.HexOrDecimal256)(reserve),
+	}
+
+	addresses, err := contract.GetValidators(nil)
+	if err != nil {
+		return err
+	}
+	for _, address := range addresses {
+		validator, err := contract.GetValidator(nil, address)
+		if err != nil {
+			return err
+		}
+		// the liquid state contracts are deployed again when the validators are registered
+		e.excluded[validator.LiquidStateContract] = true
+		if validator.BondedStake.Sign() == 0 {
+			log.Warn("Dropping validator without bonded stake", "address", address)
+			e.dropped = append(e.dropped, validator)
+			continue
+		}
+		// the jail period goes on from genesis, the exported block
+		jailReleaseBlock := new(big.Int).Sub(validator.JailReleaseBlock, e.header.Number)
+		if jailReleaseBlock.Sign() < 0 {
+			jailReleaseBlock.SetUint64(0)
+		}
+		nodeAddress := validator.NodeAddress
+		state := validator.State
+		e.config.AutonityContractConfig.Validators = append(e.config.AutonityContractConfig.Validators, &params.Validator{
+			Treasury:         validator.Treasury,
+			NodeAddress:      &nodeAddress,
+			OracleAddress:    validator.OracleAddress,
+			Enode:            validator.Enode,
+			BondedStake:      new(big.Int).Set(validator.SelfBondedStake),
+			ConsensusKey:     validator.ConsensusKey,
+			JailReleaseBlock: jailReleaseBlock,
+			State:            &state,
+		})
+		e.validators = append(e.validators, validator)
+	}
+	if len(e.validators) == 0 {
+		return errors.New("no validator with bonded stake")
+	}
+	return nil
+}
+
+// collectAccounts carries over the accounts of the state and lists the accounts possibly holding funds in the
+// protocol contracts. The accounts holding Newton or Liquid Newton only are not part of the state, they are found
+// among the addresses indexed by the events of the protocol contracts.
+func (e *genesisExporter) collectAccounts(chain *BlockChain, statedb *state.StateDB) error {
+	collector := &allocCollector{statedb: statedb, alloc: e.alloc, excluded: e.excluded}
+	statedb.DumpToCollector(collector, nil)
+	if collector.missing > 0 || collector.missingStorage > 0 {
+		return fmt.Errorf("%w: %d accounts, %d storage keys", ErrMissingPreimage, collector.missing, collector.missingStorage)
+	}
+
+	candidates := make(map[common.Address]bool)
+	for address := range e.alloc {
+		candidates[address] = true
+	}
+	for number := uint64(1); number <= e.header.Number.Uint64(); number++ {
+		for _, receipt := range chain.GetReceiptsByHash(chain.GetCanonicalHash(number)) {
+			for _, l := range receipt.Logs {
+				if !e.excluded[l.Address] {
+					continue
+				}
+				for i := 1; i < len(l.Topics); i++ {
+					if common.BytesToHash(l.Topics[i][common.HashLength-common.AddressLength:]) == l.Topics[i] {
+						candidates[common.BytesToAddress(l.Topics[i][:])] = true
+					}
+				}
+			}
+		}
+	}
+	// the genesis allocations of the vesting contracts and of the validators do not emit events
+	config := chain.Config()
+	if config.AutonityContractConfig != nil {
+		candidates[config.AutonityContractConfig.Treasury] = true
+		candidates[config.AutonityContractConfig.Operator] = true
+		for _, validator := range config.AutonityContractConfig.Validators {
+			candidates[validator.Treasury] = true
+		}
+	}
+	if config.StakableVestingConfig != nil {
+		for _, contract := range config.StakableVestingConfig.StakableContracts {
+			candidates[contract.Beneficiary] = true
+		}
+	}
+	if config.NonStakableVestingConfig != nil {
+		for _, contract := range config.NonStakableVestingConfig.NonStakableContracts {
+			candidates[contract.Beneficiary] = true
+		}
+	}
+	for _, validator := range e.validators {
+		candidates[validator.Treasury] = true
+	}
+	for _, validator := range e.dropped {
+		candidates[validator.Treasury] = true
+	}
+
+	for address := range candidates {
+		if address != (common.Address{}) && !e.excluded[address] {
+			e.accounts = append(e.accounts, address)
+		}
+	}
+	sort.Slice(e.accounts, func(i, j int) bool {
+		return bytes.Compare(e.accounts[i][:], e.accounts[j][:]) < 0
+	})
+	return nil
+}
+
+// exportProtocolFunds credits the Auton held by the protocol contracts, such as the fees and the CDP interests, to
+// the treasury. The Auton of the supply control and liquid contracts is carried over by the ASM configuration and
+// the staking rewards, while the balance of the deployer, the zero address, is burnt.
+func (e *genesisExporter) exportProtocolFunds(statedb *state.StateDB) {
+	carried := map[common.Address]bool{
+		params.DeployerAddress:              true,
+		params.SupplyControlContractAddress: true,
+	}
+	for _, validator := range append(append([]autonity.AutonityValidator{}, e.validators...), e.dropped...) {
+		carried[validator.LiquidStateContract] = true
+	}
+	for address := range e.excluded {
+		if !carried[address] {
+			e.addAuton(e.config.AutonityContractConfig.Treasury, statedb.GetBalance(address))
+		}
+	}
+}
+
+// exportStake carries over the Newton balances, the delegated stake, the stake being unbonded and the unclaimed
+// staking rewards, those held by the liquid contracts of the dropped validators included.
+func (e *genesisExporter) exportStake() error {
+	contract, err := autonity.NewAutonityCaller(params.AutonityContractAddress, e.caller)
+	if err != nil {
+		return err
+	}
+	for _, account := range e.accounts {
+		balance, err := contract.BalanceOf(nil, account)
+		if err != nil {
+			return err
+		}
+		e.addNewton(account, balance)
+	}
+	validators := append(append([]autonity.AutonityValidator{}, e.validators...), e.dropped...)
+	for _, validator := range validators {
+		// the stake of the treasury being unbonded is released to the treasury
+		e.addNewton(validator.Treasury, validator.SelfUnbondingStake)
+
+		liquid, err := autonity.NewLiquidLogicCaller(validator.LiquidStateContract, e.caller)
+		if err != nil {
+			return err
+		}
+		treasuryRewards, err := liquid.GetTreasuryUnclaimedATN(nil)
+		if err != nil {
+			return err
+		}
+		e.addAuton(validator.Treasury, treasuryRewards)
+
+		delegatedStake := new(big.Int).Sub(validator.BondedStake, validator.SelfBondedStake)
+		for _, account := range e.accounts {
+			rewards, err := liquid.UnclaimedRewards(nil, account)
+			if err != nil {
+				return err
+			}
+			e.addAuton(account, rewards.UnclaimedATN)
+			e.addNewton(account, rewards.UnclaimedNTN)
+
+			balance, err := liquid.BalanceOf(nil, account)
+			if err != nil {
+				return err
+			}
+			if balance.Sign() == 0 || validator.LiquidSupply.Sign() == 0 {
+				continue
+			}
+			stake := new(big.Int).Mul(balance, delegatedStake)
+			e.addBond(account, validator.NodeAddress, stake.Div(stake, validator.LiquidSupply))
+		}
+	}
+	return e.exportUnbondingStake(contract, validators)
+}
+
+// exportUnbondingStake releases the delegated stake being unbonded to the delegators, pro rata of their unbonding
+// shares. The stake unbonded by the protocol contracts, which are deployed again, is released to the treasury.
+func (e *genesisExporter) exportUnbondingStake(contract *autonity.AutonityCaller, validators []autonity.AutonityValidator) error {
+	byAddress := make(map[common.Address]autonity.AutonityValidator, len(validators))
+	for _, validator := range validators {
+		byAddress[validator.NodeAddress] = validator
+	}
+	// the requests are never deleted, the one past the last request is empty
+	for id := int64(0); ; id++ {
+		request, err := contract.GetUnbondingRequest(nil, big.NewInt(id))
+		if err != nil {
+			return err
+		}
+		if request.Delegator == (common.Address{}) {
+			return nil
+		}
+		// the self-bonded stake being unbonded is released to the treasury as a whole, a request is released once
+		if request.SelfDelegation || !request.Unlocked || request.State != unbondingNotReleased || request.UnbondingShare.Sign() == 0 {
+			continue
+		}
+		validator, ok := byAddress[request.Delegatee]
+		if !ok || validator.UnbondingShares.Sign() == 0 {
+			continue
+		}
+		stake := new(big.Int).Mul(request.UnbondingShare, validator.UnbondingStake)
+		stake.Div(stake, validator.UnbondingShares)
+		delegator := request.Delegator
+		if e.excluded[delegator] {
+			delegator = e.config.AutonityContractConfig.Treasury
+		}
+		e.addNewton(delegator, stake)
+	}
+}
+
+// exportContractConfigs reconstructs the configuration of the oracle, accountability, ASM and inflation controller
+// contracts. The open CDPs are closed.
+func (e *genesisExporter) exportContractConfigs() error {
+	oracle, err := autonity.NewOracleCaller(params.OracleContractAddress, e.caller)
+	if err != nil {
+		return err
+	}
+	symbols, err := oracle.GetSymbols(nil)
+	if err != nil {
+		return err
+	}
+	votePeriod, err := oracle.GetVotePeriod(nil)
+	if err != nil {
+		return err
+	}
+	e.config.OracleContractConfig = &params.OracleContractGenesis{Symbols: symbols, VotePeriod: votePeriod.Uint64()}
+
+	accountability, err := autonity.NewAccountabilityCaller(params.AccountabilityContractAddress, e.caller)
+	if err != nil {
+		return err
+	}
+	accountabilityConfig, err := accountability.Config(nil)
+	if err != nil {
+		return err
+	}
+	e.config.AccountabilityConfig = &params.AccountabilityGenesis{
+		InnocenceProofSubmissionWindow: accountabilityConfig.InnocenceProofSubmissionWindow.Uint64(),
+		BaseSlashingRateLow:            accountabilityConfig.BaseSlashingRateLow.Uint64(),
+		BaseSlashingRateMid:            accountabilityConfig.BaseSlashingRateMid.Uint64(),
+		CollusionFactor:                accountabilityConfig.CollusionFactor.Uint64(),
+		HistoryFactor:                  accountabilityConfig.HistoryFactor.Uint64(),
+		JailFactor:                     accountabilityConfig.JailFactor.Uint64(),
+		SlashingRatePrecision:          accountabilityConfig.SlashingRatePrecision.Uint64(),
+	}
+
+	acu, err := autonity.NewACUCaller(params.ACUContractAddress, e.caller)
+	if err != nil {
+		return err
+	}
+	acuSymbols, err := acu.Symbols(nil)
+	if err != nil {
+		return err
+	}
+	quantities, err := acu.Quantities(nil)
+	if err != nil {
+		return err
+	}
+	scale, err := acu.Scale(nil)
+	if err != nil {
+		return err
+	}
+	acuConfig := &params.AcuContractGenesis{Symbols: acuSymbols, Quantities: make([]uint64, len(quantities)), Scale: scale.Uint64()}
+	for i, quantity := range quantities {
+		acuConfig.Quantities[i] = quantity.Uint64()
+	}
+
+	stabilization, err := autonity.NewStabilizationCaller(params.StabilizationContractAddress, e.caller)
+	if err != nil {
+		return err
+	}
+	stabilizationConfig, err := stabilization.Config(nil)
+	if err != nil {
+		return err
+	}
+	// the CDPs are closed, their owners get their collateral back and keep the Auton borrowed
+	owners, err := stabilization.Accounts(nil)
+	if err != nil {
+		return err
+	}
+	for _, owner := range owners {
+		cdp, err := stabilization.Cdps(nil, owner)
+		if err != nil {
+			return err
+		}
+		e.addNewton(owner, cdp.Collateral)
+	}
+
+	supplyControl, err := autonity.NewSupplyControlCaller(params.SupplyControlContractAddress, e.caller)
+	if err != nil {
+		return err
+	}
+	supply, err := supplyControl.AvailableSupply(nil)
+	if err != nil {
+		return err
+	}
+	e.config.ASM = params.AsmConfig{
+		ACUContractConfig: acuConfig,
+		StabilizationContractConfig: &params.StabilizationContractGenesis{
+			BorrowInterestRate:        (*hexmath.HexOrDecimal256)(stabilizationConfig.BorrowInterestRate),
+			LiquidationRatio:          (*hexmath.HexOrDecimal256)(stabilizationConfig.LiquidationRatio),
+			MinCollateralizationRatio: (*hexmath.HexOrDecimal256)(stabilizationConfig.MinCollateralizationRatio),
+			MinDebtRequirement:        (*hexmath.HexOrDecimal256)(stabilizationConfig.MinDebtRequirement),
+			TargetPrice:               (*hexmath.HexOrDecimal256)(stabilizationConfig.TargetPrice),
+		},
+		SupplyControlConfig: &params.SupplyControlGenesis{InitialAllocation: (*hexmath.HexOrDecimal256)(supply)},
+	}
+
+	inflation, err := autonity.NewInflationControllerCaller(params.InflationControllerContractAddress, e.caller)
+	if err != nil {
+		return err
+	}
+	inflationParams, err := inflation.Params(nil)
+	if err != nil {
+		return err
+	}
+	e.config.InflationContractConfig = &params.InflationControllerGenesis{
+		InflationRateInitial:      (*hexmath.HexOrDecimal256)(inflationParams.InflationRateInitial),
+		InflationRateTransition:   (*hexmath.HexOrDecimal256)(inflationParams.InflationRateTransition),
+		InflationReserveDecayRate: (*hexmath.HexOrDecimal256)(inflationParams.InflationReserveDecayRate),
+		InflationTransitionPeriod: (*hexmath.HexOrDecimal256)(inflationParams.InflationTransitionPeriod),
+		InflationCurveConvexity:   (*hexmath.HexOrDecimal256)(inflationParams.InflationCurveConvexity),
+	}
+	return nil
+}
+
+// exportStakableVesting creates a stakable vesting contract for the remaining value, bonded stake included, of
+// every live contract.
+func (e *genesisExporter) exportStakableVesting() error {
+	contract, err := autonity.NewStakableVestingCaller(params.StakableVestingContractAddress, e.caller)
+	if err != nil {
+		return err
+	}
+	nominal, err := contract.TotalNominal(nil)
+	if err != nil {
+		return err
+	}
+	config := &params.StakableVestingGenesis{TotalNominal: new(big.Int).Set(nominal)}
+	for _, account := range e.accounts {
+		contracts, err := contract.GetContracts(nil, account)
+		if err != nil {
+			return err
+		}
+		for id, vesting := range contracts {
+			value, err := contract.ContractTotalValue(nil, account, big.NewInt(int64(id)))
+			if err != nil {
+				return err
+			}
+			remaining := new(big.Int).Sub(value, vesting.WithdrawnValue)
+			if remaining.Sign() <= 0 {
+				continue
+			}
+			schedule := vestingCurve(vesting, remaining, e.header.Time)
+			if schedule == nil {
+				e.addNewton(account, remaining)
+				continue
+			}
+			config.TotalNominal.Add(config.TotalNominal, remaining)
+			config.StakableContracts = append(config.StakableContracts, params.StakableVestingData{
+				Beneficiary:   account,
+				Amount:        remaining,
+				Start:         schedule.Start,
+				CliffDuration: schedule.CliffDuration,
+				TotalDuration: schedule.TotalDuration,
+			})
+		}
+	}
+	e.config.StakableVestingConfig = config
+	return nil
+}
+
+// exportNonStakableVesting creates a schedule and a non-stakable vesting contract subscribed to it for the remaining
+// value of every live contract. The funds of the schedules no contract is subscribed to return to the nominal.
+func (e *genesisExporter) exportNonStakableVesting() error {
+	contract, err := autonity.NewNonStakableVestingCaller(params.NonStakableVestingContractAddress, e.caller)
+	if err != nil {
+		return err
+	}
+	nominal, err := contract.TotalNominal(nil)
+	if err != nil {
+		return err
+	}
+	maxDuration, err := contract.MaxAllowedDuration(nil)
+	if err != nil {
+		return err
+	}
+	config := &params.NonStakableVestingGenesis{
+		TotalNominal:       new(big.Int).Set(nominal),
+		MaxAllowedDuration: new(big.Int).Set(maxDuration),
+	}
+	// getSchedule reverts past the last schedule
+	for id := int64(0); ; id++ {
+		schedule, err := contract.GetSchedule(nil, big.NewInt(id))
+		if errors.Is(err, vm.ErrExecutionReverted) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		config.TotalNominal.Add(config.TotalNominal, schedule.UnsubscribedAmount)
+		config.TotalNominal.Sub(config.TotalNominal, schedule.TotalUnlockedUnsubscribed)
+	}
+	for _, account := range e.accounts {
+		contracts, err := contract.GetContracts(nil, account)
+		if err != nil {
+			return err
+		}
+		for _, vesting := range contracts {
+			remaining := vesting.CurrentNTNAmount
+			if remaining.Sign() == 0 {
+				continue
+			}
+			schedule := vestingCurve(vesting, remaining, e.header.Time)
+			if schedule == nil {
+				e.addNewton(account, remaining)
+				continue
+			}
+			config.TotalNominal.Add(config.TotalNominal, remaining)
+			config.NonStakableContracts = append(config.NonStakableContracts, params.NonStakableVestingData{
+				Beneficiary: account,
+				Amount:      remaining,
+				ScheduleID:  big.NewInt(int64(len(config.NonStakableSchedules))),
+			})
+			config.NonStakableSchedules = append(config.NonStakableSchedules, *schedule)
+		}
+	}
+	e.config.NonStakableVestingConfig = config
+	return nil
+}
+
+// vestingCurve returns the schedule vesting the remaining value of a contract along its original curve, i.e. from
+// now on unlocking the funds at the same pace and until the same time as the contract. The curve of the contract
+// being linear over its whole value, remaining and withdrawn funds, the schedule starts later and is shorter. Its
+// cliff does not end before genesisTime. It returns nil if the contract is over at genesisTime.
+func vestingCurve(contract autonity.ContractBaseContract, remaining *big.Int, genesisTime uint64) *params.NonStakableSchedule {
+	now := new(big.Int).SetUint64(genesisTime)
+	end := new(big.Int).Add(contract.Start, contract.TotalDuration)
+	if end.Cmp(now) <= 0 {
+		return nil
+	}
+	value := new(big.Int).Add(remaining, contract.WithdrawnValue)
+	duration := new(big.Int).Mul(contract.TotalDuration, remaining)
+	duration.Div(duration, value)
+	if duration.Sign() == 0 {
+		return nil
+	}
+	start := new(big.Int).Sub(end, duration)
+	cliff := new(big.Int).Add(contract.Start, contract.CliffDuration)
+	if cliff.Cmp(now) < 0 {
+		cliff = now
+	}
+	cliff.Sub(cliff, start)
+	if cliff.Sign() < 0 {
+		cliff = new(big.Int)
+	}
+	return &params.NonStakableSchedule{
+		Start:         start,
+		CliffDuration: cliff,
+		TotalDuration: duration,
+		Amount:        new(big.Int).Set(remaining),
+	}
+}
+
+// account returns the genesis account of address, creating it if the address is not part of the state.
+func (e *genesisExporter) account(address common.Address) *GenesisAccount {
+	account, ok := e.alloc[address]
+	if !ok {
+		account = &GenesisAccount{Balance: new(big.Int)}
+		e.alloc[address] = account
+	}
+	return account
+}
+
+func (e *genesisExporter) addAuton(address common.Address, amount *big.Int) {
+	if amount.Sign() == 0 {
+		return
+	}
+	account := e.account(address)
+	account.Balance = new(big.Int).Add(account.Balance, amount)
+}
+
+func (e *genesisExporter) addNewton(address common.Address, amount *big.Int) {
+	if amount.Sign() == 0 {
+		return
+	}
+	account := e.account(address)
+	if account.NewtonBalance == nil {
+		account.NewtonBalance = new(big.Int)
+	}
+	account.NewtonBalance.Add(account.NewtonBalance, amount)
+}
+
+func (e *genesisExporter) addBond(address common.Address, validator common.Address, amount *big.Int) {
+	if amount.Sign() == 0 {
+		return
+	}
+	account := e.account(address)
+	if account.Bonds == nil {
+		account.Bonds = make(map[common.Address]*big.Int)
+	}
+	if bonded, ok := account.Bonds[validator]; ok {
+		amount = new(big.Int).Add(bonded, amount)
+	}
+	account.Bonds[validator] = amount
+}
+
+// allocCollector collects the accounts of a state dump into a genesis allocation.
+type allocCollector struct {
+	statedb        *state.StateDB
+	alloc          map[common.Address]*GenesisAccount
+	excluded       map[common.Address]bool
+	missing        int
+	missingStorage int
+}
+
+func (c *allocCollector) OnRoot(common.Hash) {}
+
+func (c *allocCollector) OnAccount(address common.Address, account state.DumpAccount) {
+	// the address of an account whose preimage is missing is left empty
+	if address == (common.Address{}) && !bytes.Equal(account.SecureKey, crypto.Keccak256(address[:])) {
+		c.missing++
+		return
+	}
+	if c.excluded[address] {
+		return
+	}
+	balance, _ := new(big.Int).SetString(account.Balance, 10)
+	genesisAccount := &GenesisAccount{
+		Balance: balance,
+		Nonce:   account.Nonce,
+		Code:    account.Code,
+	}
+	if len(account.Storage) > 0 {
+		genesisAccount.Storage = make(map[common.Hash]common.Hash, len(account.Storage))
+		for key, value := range account.Storage {
+			genesisAccount.Storage[key] = common.HexToHash(value)
+		}
+	}
+	// the storage keys whose preimage is missing are all dumped as the zero key
+	if _, ok := account.Storage[common.Hash{}]; ok {
+		storage := c.statedb.StorageTrie(address)
+		it := trie.NewIterator(storage.NodeIterator(nil))
+		for it.Next() {
+			if storage.GetKey(it.Key) == nil {
+				c.missingStorage++
+			}
+		}
+	}
+	c.alloc[address] = genesisAccount
+}
@@ -0,0 +1,174 @@
+package core
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/autonity/autonity/autonity"
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/consensus/ethash"
+	"github.com/autonity/autonity/core/rawdb"
+	"github.com/autonity/autonity/core/state"
+	"github.com/autonity/autonity/core/vm"
+	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/log"
+	"github.com/autonity/autonity/params"
+	"github.com/autonity/autonity/trie"
+)
+
+func newExportTestChain(t *testing.T, genesis *Genesis) *BlockChain {
+	db := rawdb.NewMemoryDatabase()
+	genesis.MustCommit(db)
+	chain, err := NewBlockChain(db, nil, genesis.Config, ethash.NewFaker(), vm.Config{}, nil, NewTxSenderCacher(), nil, FakeContractBackendProvider(t), log.Root())
+	require.NoError(t, err)
+	t.Cleanup(chain.Stop)
+	return chain
+}
+
+func TestExportGenesis(t *testing.T) {
+	var (
+		delegator   = common.HexToAddress("0xde1e9a70")
+		beneficiary = common.HexToAddress("0xbe9ef1c1a4")
+		contract    = common.HexToAddress("0xc0de")
+	)
+	config := *params.TestChainConfig
+	autonityConfig := *params.TestAutonityContractConfig
+	config.AutonityContractConfig = &autonityConfig
+	config.StakableVestingConfig = &params.StakableVestingGenesis{
+		TotalNominal: big.NewInt(1000),
+		StakableContracts: []params.StakableVestingData{
+			{Beneficiary: beneficiary, Amount: big.NewInt(500), Start: big.NewInt(100), CliffDuration: big.NewInt(50), TotalDuration: big.NewInt(1000)},
+		},
+	}
+	config.NonStakableVestingConfig = &params.NonStakableVestingGenesis{
+		TotalNominal:       big.NewInt(1000),
+		MaxAllowedDuration: big.NewInt(2000),
+		NonStakableSchedules: []params.NonStakableSchedule{
+			{Start: big.NewInt(100), CliffDuration: big.NewInt(50), TotalDuration: big.NewInt(1000), Amount: big.NewInt(700)},
+		},
+		NonStakableContracts: []params.NonStakableVestingData{
+			{Beneficiary: beneficiary, Amount: big.NewInt(300), ScheduleID: big.NewInt(0)},
+		},
+	}
+	validator := *autonityConfig.Validators[0].NodeAddress
+	genesis := &Genesis{
+		Config:  &config,
+		BaseFee: big.NewInt(params.InitialBaseFee),
+		Alloc: GenesisAlloc{
+			delegator: {
+				Balance:       big.NewInt(params.Ether),
+				NewtonBalance: big.NewInt(1000),
+				Bonds:         map[common.Address]*big.Int{validator: big.NewInt(5000)},
+			},
+			contract: {
+				Balance: big.NewInt(1),
+				Nonce:   1,
+				Code:    []byte{0x60, 0x00},
+				Storage: map[common.Hash]common.Hash{{0x01}: {0x02}},
+			},
+		},
+	}
+
+	exported, err := ExportGenesis(newExportTestChain(t, genesis), 0)
+	require.NoError(t, err)
+
+	validators := exported.Config.AutonityContractConfig.Validators
+	require.Len(t, validators, len(autonityConfig.Validators))
+	for i, v := range validators {
+		require.Equal(t, autonityConfig.Validators[i].NodeAddress, v.NodeAddress)
+		require.Equal(t, autonityConfig.Validators[i].ConsensusKey, v.ConsensusKey)
+		require.Equal(t, autonityConfig.Validators[i].BondedStake, v.BondedStake)
+	}
+	require.Equal(t, autonityConfig.EpochPeriod, exported.Config.AutonityContractConfig.EpochPeriod)
+	require.Equal(t, autonityConfig.Operator, exported.Config.AutonityContractConfig.Operator)
+	require.Equal(t, *params.TestAccountabilityConfig, *exported.Config.AccountabilityConfig)
+
+	require.Equal(t, big.NewInt(params.Ether), exported.Alloc[delegator].Balance)
+	require.Equal(t, big.NewInt(1000), exported.Alloc[delegator].NewtonBalance)
+	require.Equal(t, map[common.Address]*big.Int{validator: big.NewInt(5000)}, exported.Alloc[delegator].Bonds)
+	require.Equal(t, genesis.Alloc[contract].Code, exported.Alloc[contract].Code)
+	require.Equal(t, genesis.Alloc[contract].Storage, exported.Alloc[contract].Storage)
+	require.Equal(t, uint64(1), exported.Alloc[contract].Nonce)
+	for _, address := range []common.Address{params.DeployerAddress, params.AutonityContractAddress, params.StakableVestingContractAddress} {
+		require.NotContains(t, exported.Alloc, address)
+	}
+
+	require.Equal(t, big.NewInt(1000), exported.Config.StakableVestingConfig.TotalNominal)
+	require.Equal(t, config.StakableVestingConfig.StakableContracts, exported.Config.StakableVestingConfig.StakableContracts)
+	// the unsubscribed funds of the schedule return to the nominal
+	require.Equal(t, big.NewInt(1000), exported.Config.NonStakableVestingConfig.TotalNominal)
+	require.Equal(t, []params.NonStakableSchedule{
+		{Start: big.NewInt(100), CliffDuration: big.NewInt(50), TotalDuration: big.NewInt(1000), Amount: big.NewInt(300)},
+	}, exported.Config.NonStakableVestingConfig.NonStakableSchedules)
+	require.Equal(t, config.NonStakableVestingConfig.NonStakableContracts, exported.Config.NonStakableVestingConfig.NonStakableContracts)
+
+	// the chain launched from the exported genesis is exported identically
+	data, err := json.Marshal(exported)
+	require.NoError(t, err)
+	relaunched := new(Genesis)
+	require.NoError(t, json.Unmarshal(data, relaunched))
+	reexported, err := ExportGenesis(newExportTestChain(t, relaunched), 0)
+	require.NoError(t, err)
+	redata, err := json.Marshal(reexported)
+	require.NoError(t, err)
+	require.JSONEq(t, string(data), string(redata))
+}
+
+func TestAllocCollectorMissingStoragePreimage(t *testing.T) {
+	contract := common.HexToAddress("0xc0de")
+	db := rawdb.NewMemoryDatabase()
+	// the state is committed without recording the preimages
+	statedb, err := state.New(common.Hash{}, state.NewDatabaseWithConfig(db, &trie.Config{}), nil)
+	require.NoError(t, err)
+	statedb.SetNonce(contract, 1)
+	statedb.SetState(contract, common.Hash{0x01}, common.Hash{0x02})
+	statedb.SetState(contract, common.Hash{0x03}, common.Hash{0x04})
+	root, err := statedb.Commit(false)
+	require.NoError(t, err)
+	require.NoError(t, statedb.Database().TrieDB().Commit(root, false, nil))
+	rawdb.WritePreimages(db, map[common.Hash][]byte{crypto.Keccak256Hash(contract[:]): contract[:]})
+
+	statedb, err = state.New(root, state.NewDatabaseWithConfig(db, &trie.Config{Preimages: true}), nil)
+	require.NoError(t, err)
+	collector := &allocCollector{statedb: statedb, alloc: make(map[common.Address]*GenesisAccount)}
+	statedb.DumpToCollector(collector, nil)
+	require.Equal(t, 0, collector.missing)
+	require.Equal(t, 2, collector.missingStorage)
+}
+
+func TestVestingCurve(t *testing.T) {
+	contract := autonity.ContractBaseContract{
+		WithdrawnValue: big.NewInt(300),
+		Start:          big.NewInt(100),
+		CliffDuration:  big.NewInt(50),
+		TotalDuration:  big.NewInt(1000),
+	}
+	remaining := big.NewInt(700)
+
+	schedule := vestingCurve(contract, remaining, 500)
+	require.Equal(t, &params.NonStakableSchedule{
+		Start:         big.NewInt(400),
+		CliffDuration: big.NewInt(100),
+		TotalDuration: big.NewInt(700),
+		Amount:        big.NewInt(700),
+	}, schedule)
+	// the schedule unlocks what the contract would have unlocked, minus the withdrawn funds
+	unlocked := func(start, duration, amount, now int64) int64 {
+		return amount * (now - start) / duration
+	}
+	for _, now := range []int64{600, 900, 1100} {
+		require.Equal(t, unlocked(100, 1000, 1000, now)-300, unlocked(400, 700, 700, now))
+	}
+
+	// the cliff of the schedule ends with the cliff of the contract, unless the schedule starts later
+	schedule = vestingCurve(contract, remaining, 120)
+	require.Equal(t, big.NewInt(0), schedule.CliffDuration)
+	contract.CliffDuration = big.NewInt(400)
+	schedule = vestingCurve(contract, remaining, 120)
+	require.Equal(t, big.NewInt(100), schedule.CliffDuration)
+
+	require.Nil(t, vestingCurve(contract, remaining, 1100))
+}
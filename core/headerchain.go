@@ -356,6 +356,10 @@ func (hc *HeaderChain) writeHeadersAndSetHead(headers []*types.Header, forker *F
 	return result, nil
 }
 
+// CertifiedCheckFreq is the seal check frequency of the header chains whose seals were already verified by the
+// caller. The engines which cannot skip them verify all the seals.
+const CertifiedCheckFreq = -1
+
 func (hc *HeaderChain) ValidateHeaderChain(chain []*types.Header, checkFreq int) (int, error) {
 	// Do a sanity check that the provided chain is actually ordered and linked
 	for i := 1; i < len(chain); i++ {
@@ -381,7 +385,11 @@ func (hc *HeaderChain) ValidateHeaderChain(chain []*types.Header, checkFreq int)
 
 	// Generate the list of seal verification requests, and start the parallel verifier
 	seals := make([]bool, len(chain))
-	if checkFreq != 0 {
+	verifier, certified := hc.engine.(consensus.CertifiedHeaderVerifier)
+	if checkFreq == CertifiedCheckFreq && !certified {
+		checkFreq = 1
+	}
+	if checkFreq > 0 {
 		// In case of checkFreq == 0 all seals are left false.
 		for i := 0; i <= len(seals)/checkFreq; i++ {
 			index := i*checkFreq + hc.rand.Intn(checkFreq)
@@ -394,7 +402,15 @@ func (hc *HeaderChain) ValidateHeaderChain(chain []*types.Header, checkFreq int)
 		seals[len(seals)-1] = true
 	}
 
-	abort, results := hc.engine.VerifyHeaders(hc, chain, seals)
+	var (
+		abort   chan<- struct{}
+		results <-chan error
+	)
+	if checkFreq == CertifiedCheckFreq {
+		abort, results = verifier.VerifyCertifiedHeaders(hc, chain)
+	} else {
+		abort, results = hc.engine.VerifyHeaders(hc, chain, seals)
+	}
 	defer close(abort)
 
 	// Iterate over the headers and ensure they all check out
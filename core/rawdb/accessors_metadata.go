@@ -174,3 +174,73 @@ func WriteTransitionStatus(db ethdb.KeyValueWriter, data []byte) {
 		log.Crit("Failed to store the eth2 transition status", "err", err)
 	}
 }
+
+// ReadSignJournal retrieves the serialized tendermint sign journal of the local validator.
+func ReadSignJournal(db ethdb.KeyValueReader) []byte {
+	data, _ := db.Get(signJournalKey)
+	return data
+}
+
+// WriteSignJournal stores the serialized tendermint sign journal of the local validator.
+func WriteSignJournal(db ethdb.KeyValueWriter, journal []byte) error {
+	return db.Put(signJournalKey, journal)
+}
+
+// DeleteSignJournal deletes the tendermint sign journal of the local validator, along with its blocks.
+func DeleteSignJournal(db ethdb.KeyValueStore) {
+	it := db.NewIterator(signJournalBlockPrefix, nil)
+	defer it.Release()
+	for it.Next() {
+		if len(it.Key()) != len(signJournalBlockPrefix)+common.HashLength {
+			continue
+		}
+		if err := db.Delete(it.Key()); err != nil {
+			log.Crit("Failed to remove a sign journal block", "err", err)
+		}
+	}
+	if err := db.Delete(signJournalKey); err != nil {
+		log.Crit("Failed to remove the sign journal", "err", err)
+	}
+}
+
+// ReadSignJournalBlock retrieves a serialized block locked or valid in the tendermint sign journal.
+func ReadSignJournalBlock(db ethdb.KeyValueReader, hash common.Hash) []byte {
+	data, _ := db.Get(signJournalBlockKey(hash))
+	return data
+}
+
+// WriteSignJournalBlock stores a serialized block locked or valid in the tendermint sign journal.
+func WriteSignJournalBlock(db ethdb.KeyValueWriter, hash common.Hash, block []byte) error {
+	return db.Put(signJournalBlockKey(hash), block)
+}
+
+// DeleteSignJournalBlock deletes a block no longer referenced by the tendermint sign journal.
+func DeleteSignJournalBlock(db ethdb.KeyValueWriter, hash common.Hash) error {
+	return db.Delete(signJournalBlockKey(hash))
+}
+
+// ReadParticipationHead retrieves the serialized progress of the validator participation tracker.
+func ReadParticipationHead(db ethdb.KeyValueReader) []byte {
+	data, _ := db.Get(participationHeadKey)
+	return data
+}
+
+// WriteParticipationHead stores the serialized progress of the validator participation tracker.
+func WriteParticipationHead(db ethdb.KeyValueWriter, head []byte) {
+	if err := db.Put(participationHeadKey, head); err != nil {
+		log.Crit("Failed to store the participation head", "err", err)
+	}
+}
+
+// ReadParticipation retrieves the serialized validator participation of an epoch.
+func ReadParticipation(db ethdb.KeyValueReader, epoch uint64) []byte {
+	data, _ := db.Get(participationKey(epoch))
+	return data
+}
+
+// WriteParticipation stores the serialized validator participation of an epoch.
+func WriteParticipation(db ethdb.KeyValueWriter, epoch uint64, participation []byte) {
+	if err := db.Put(participationKey(epoch), participation); err != nil {
+		log.Crit("Failed to store the validator participation", "err", err)
+	}
+}
@@ -289,6 +289,10 @@ func (f *freezer) TruncateAncients(items uint64) error {
 	f.writeLock.Lock()
 	defer f.writeLock.Unlock()
 
+	// The items before the tail are never stored
+	if tail := f.tail(); items < tail {
+		items = tail
+	}
 	if atomic.LoadUint64(&f.frozen) <= items {
 		return nil
 	}
@@ -301,6 +305,35 @@ func (f *freezer) TruncateAncients(items uint64) error {
 	return nil
 }
 
+// resetTail discards all the frozen items, the next item frozen being the given tail,
+// e.g. the first block following the epoch checkpoint a node was synced from.
+func (f *freezer) resetTail(tail uint64) error {
+	if f.readonly {
+		return errReadOnly
+	}
+	f.writeLock.Lock()
+	defer f.writeLock.Unlock()
+
+	for _, table := range f.tables {
+		if err := table.resetTail(tail); err != nil {
+			return err
+		}
+	}
+	atomic.StoreUint64(&f.frozen, tail)
+	return nil
+}
+
+// tail returns the number of the first item of the tables, the items before it
+// being never stored.
+func (f *freezer) tail() uint64 {
+	for _, table := range f.tables {
+		table.lock.RLock()
+		defer table.lock.RUnlock()
+		return uint64(table.itemOffset)
+	}
+	return 0
+}
+
 // Sync flushes all data tables to disk.
 func (f *freezer) Sync() error {
 	var errs []error
@@ -394,6 +427,14 @@ func (f *freezer) freeze(db ethdb.KeyValueStore) {
 				return
 			}
 		}
+		// Start from the history tail if the blocks before it were never retrieved
+		if tail := ReadHistoryTail(nfdb); tail != nil && *tail > f.frozen {
+			if err := f.resetTail(*tail); err != nil {
+				log.Error("Failed to reset the ancient tail", "tail", *tail, "err", err)
+				backoff = true
+				continue
+			}
+		}
 		// Retrieve the freezing threshold.
 		hash := ReadHeadBlockHash(nfdb)
 		if hash == (common.Hash{}) {
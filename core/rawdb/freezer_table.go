@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"os"
 	"path/filepath"
 	"sync"
@@ -263,6 +264,10 @@ func (t *freezerTable) repair() error {
 
 	t.index.ReadAt(buffer, offsetsSize-indexEntrySize)
 	lastIndex.unmarshalBinary(buffer)
+	if offsetsSize == indexEntrySize {
+		// The first index entry carries the item offset, not the end of an item
+		lastIndex.offset = 0
+	}
 	if t.readonly {
 		t.head, err = t.openFile(lastIndex.filenum, openFreezerFileForReadOnly)
 	} else {
@@ -298,6 +303,9 @@ func (t *freezerTable) repair() error {
 			t.index.ReadAt(buffer, offsetsSize-indexEntrySize)
 			var newLastIndex indexEntry
 			newLastIndex.unmarshalBinary(buffer)
+			if offsetsSize == indexEntrySize {
+				newLastIndex.offset = 0
+			}
 			// We might have slipped back into an earlier head-file here
 			if newLastIndex.filenum != lastIndex.filenum {
 				// Release earlier opened file
@@ -366,6 +374,10 @@ func (t *freezerTable) truncate(items uint64) error {
 	t.lock.Lock()
 	defer t.lock.Unlock()
 
+	// The items before the tail are discarded already
+	if items < uint64(t.itemOffset) {
+		items = uint64(t.itemOffset)
+	}
 	// If our item count is correct, don't do anything
 	existing := atomic.LoadUint64(&t.items)
 	if existing <= items {
@@ -382,16 +394,20 @@ func (t *freezerTable) truncate(items uint64) error {
 		log = t.logger.Warn // Only loud warn if we delete multiple items
 	}
 	log("Truncating freezer table", "items", existing, "limit", items)
-	if err := truncateFreezerFile(t.index, int64(items+1)*indexEntrySize); err != nil {
+	index := items - uint64(t.itemOffset)
+	if err := truncateFreezerFile(t.index, int64(index+1)*indexEntrySize); err != nil {
 		return err
 	}
 	// Calculate the new expected size of the data file and truncate it
 	buffer := make([]byte, indexEntrySize)
-	if _, err := t.index.ReadAt(buffer, int64(items*indexEntrySize)); err != nil {
+	if _, err := t.index.ReadAt(buffer, int64(index*indexEntrySize)); err != nil {
 		return err
 	}
 	var expected indexEntry
 	expected.unmarshalBinary(buffer)
+	if index == 0 {
+		expected.offset = 0
+	}
 
 	// We might need to truncate back to older files
 	if expected.filenum != t.headId {
@@ -425,6 +441,51 @@ func (t *freezerTable) truncate(items uint64) error {
 	return nil
 }
 
+// resetTail discards all the items of the table, the next item appended being the
+// given tail. The items before the tail are never stored, e.g. the history before
+// the checkpoint a node was synced from.
+func (t *freezerTable) resetTail(tail uint64) error {
+	if tail > math.MaxUint32 {
+		return fmt.Errorf("freezer table tail %d out of range", tail)
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	oldSize, err := t.sizeNolock()
+	if err != nil {
+		return err
+	}
+	t.logger.Info("Resetting freezer table tail", "items", atomic.LoadUint64(&t.items), "tail", tail)
+
+	// Keep the earliest data file only, emptied, along with the index entry of the tail
+	t.releaseFilesAfter(t.tailId, true)
+	t.releaseFile(t.tailId)
+	head, err := t.openFile(t.tailId, openFreezerFileForAppend)
+	if err != nil {
+		return err
+	}
+	if err := truncateFreezerFile(head, 0); err != nil {
+		return err
+	}
+	if err := truncateFreezerFile(t.index, 0); err != nil {
+		return err
+	}
+	entry := indexEntry{filenum: t.tailId, offset: uint32(tail)}
+	if _, err := t.index.Write(entry.append(nil)); err != nil {
+		return err
+	}
+	t.head, t.headId, t.headBytes = head, t.tailId, 0
+	t.itemOffset = uint32(tail)
+	atomic.StoreUint64(&t.items, tail)
+
+	newSize, err := t.sizeNolock()
+	if err != nil {
+		return err
+	}
+	t.sizeGauge.Dec(int64(oldSize - newSize))
+	return nil
+}
+
 // Close closes all opened files.
 func (t *freezerTable) Close() error {
 	t.lock.Lock()
@@ -673,7 +734,9 @@ func (t *freezerTable) retrieveItems(start, count, maxBytes uint64) ([]byte, []i
 // has returns an indicator whether the specified number data
 // exists in the freezer table.
 func (t *freezerTable) has(number uint64) bool {
-	return atomic.LoadUint64(&t.items) > number
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return atomic.LoadUint64(&t.items) > number && uint64(t.itemOffset) <= number
 }
 
 // size returns the total data size in the freezer table.
@@ -659,6 +659,74 @@ func TestFreezerOffset(t *testing.T) {
 	}
 }
 
+// TestFreezerResetTail tests that a table reset to a tail stores the items from
+// the tail on, across restarts and truncations.
+func TestFreezerResetTail(t *testing.T) {
+	t.Parallel()
+	rm, wm, sg := metrics.NewMeter(), metrics.NewMeter(), metrics.NewGauge()
+	fname := fmt.Sprintf("resettail-%d", rand.Uint64())
+
+	// Fill the table over several files then reset it
+	{
+		f, err := newTable(os.TempDir(), fname, rm, wm, sg, 40, true, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		writeChunks(t, f, 5, 20)
+		require.NoError(t, f.resetTail(1000))
+		require.Equal(t, uint64(1000), f.items)
+
+		batch := f.newBatch()
+		require.Error(t, batch.AppendRaw(5, getChunk(20, 0xFF)))
+		require.NoError(t, batch.AppendRaw(1000, getChunk(20, 0xEE)))
+		require.NoError(t, batch.AppendRaw(1001, getChunk(20, 0xDD)))
+		require.NoError(t, batch.AppendRaw(1002, getChunk(20, 0xCC)))
+		require.NoError(t, batch.commit())
+		f.Close()
+	}
+	// The items before the tail are gone after a restart
+	{
+		f, err := newTable(os.TempDir(), fname, rm, wm, sg, 40, true, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		require.Equal(t, uint64(1003), f.items)
+		require.False(t, f.has(0))
+		require.True(t, f.has(1000))
+		checkRetrieveError(t, f, map[uint64]error{
+			0:    errOutOfBounds,
+			4:    errOutOfBounds,
+			999:  errOutOfBounds,
+			1003: errOutOfBounds,
+		})
+		checkRetrieve(t, f, map[uint64][]byte{
+			1000: getChunk(20, 0xEE),
+			1001: getChunk(20, 0xDD),
+			1002: getChunk(20, 0xCC),
+		})
+
+		// Truncating below the tail empties the table, keeping the tail
+		require.NoError(t, f.truncate(1001))
+		checkRetrieve(t, f, map[uint64][]byte{1000: getChunk(20, 0xEE)})
+		require.NoError(t, f.truncate(0))
+		require.Equal(t, uint64(1000), f.items)
+		f.Close()
+	}
+	// An empty table keeps its tail across restarts
+	{
+		f, err := newTable(os.TempDir(), fname, rm, wm, sg, 40, true, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		require.Equal(t, uint64(1000), f.items)
+		batch := f.newBatch()
+		require.NoError(t, batch.AppendRaw(1000, getChunk(20, 0xBB)))
+		require.NoError(t, batch.commit())
+		checkRetrieve(t, f, map[uint64][]byte{1000: getChunk(20, 0xBB)})
+	}
+}
+
 func checkRetrieve(t *testing.T, f *freezerTable, items map[uint64][]byte) {
 	t.Helper()
 
@@ -72,6 +72,9 @@ var (
 	// txIndexTailKey tracks the oldest block whose transactions have been indexed.
 	txIndexTailKey = []byte("TransactionIndexTail")
 
+	// historyTailKey tracks the oldest block of the chain history, the blocks before it being never retrieved.
+	historyTailKey = []byte("HistoryTail")
+
 	// fastTxLookupLimitKey tracks the transaction lookup limit during fast sync.
 	fastTxLookupLimitKey = []byte("FastTransactionLookupLimit")
 
@@ -84,6 +87,15 @@ var (
 	// transitionStatusKey tracks the eth2 transition status.
 	transitionStatusKey = []byte("eth2-transition")
 
+	// signJournalKey tracks the last consensus message signed by the local validator across restarts.
+	signJournalKey = []byte("TendermintSignJournal")
+
+	// signJournalBlockPrefix + hash -> block locked or valid in the sign journal of the local validator.
+	signJournalBlockPrefix = []byte("TendermintSignJournalBlock")
+
+	// participationHeadKey tracks the last block processed by the validator participation tracker.
+	participationHeadKey = []byte("TendermintParticipationHead")
+
 	// Data item prefixes (use single byte to avoid mixing data types, avoid `i`, used for indexes).
 	headerPrefix       = []byte("h") // headerPrefix + num (uint64 big endian) + hash -> header
 	headerTDSuffix     = []byte("t") // headerPrefix + num (uint64 big endian) + hash + headerTDSuffix -> td
@@ -102,6 +114,8 @@ var (
 	PreimagePrefix = []byte("secure-key-")      // PreimagePrefix + hash -> preimage
 	configPrefix   = []byte("ethereum-config-") // config prefix for the db
 
+	participationPrefix = []byte("tendermint-participation-") // participationPrefix + epoch (uint64 big endian) -> validator participation
+
 	// Chain index prefixes (use `i` + single byte to avoid mixing data types).
 	BloomBitsIndexPrefix = []byte("iB") // BloomBitsIndexPrefix is the data table of a chain indexer to track its progress
 
@@ -157,6 +171,11 @@ func headerKeyPrefix(number uint64) []byte {
 }
 
 // headerKey = headerPrefix + num (uint64 big endian) + hash
+// signJournalBlockKey = signJournalBlockPrefix + hash
+func signJournalBlockKey(hash common.Hash) []byte {
+	return append(append([]byte{}, signJournalBlockPrefix...), hash.Bytes()...)
+}
+
 func headerKey(number uint64, hash common.Hash) []byte {
 	return append(append(headerPrefix, encodeBlockNumber(number)...), hash.Bytes()...)
 }
@@ -235,6 +254,11 @@ func IsCodeKey(key []byte) (bool, []byte) {
 	return false, nil
 }
 
+// participationKey = participationPrefix + epoch (uint64 big endian)
+func participationKey(epoch uint64) []byte {
+	return append(participationPrefix, encodeBlockNumber(epoch)...)
+}
+
 // configKey = configPrefix + hash
 func configKey(hash common.Hash) []byte {
 	return append(configPrefix, hash.Bytes()...)
@@ -11,11 +11,14 @@ import (
 type Nodes struct {
 	List    []*enode.Node
 	StrList []string
+	// Sentries are the sentry nodes declared by the consensus endpoints, which join the consensus network on behalf
+	// of the nodes. Only parsed for the consensus endpoints, nil otherwise.
+	Sentries [][]*enode.Node
 }
 
 func NewNodes(strList []string, asACN bool) *Nodes {
 	wg := sync.WaitGroup{}
-	errCh := make(chan error, len(strList))
+	errCh := make(chan error, 2*len(strList))
 	var parser func(string) (*enode.Node, error)
 	if asACN {
 		parser = enode.ParseACNV4
@@ -24,8 +27,11 @@ func NewNodes(strList []string, asACN bool) *Nodes {
 	}
 
 	n := &Nodes{
-		make([]*enode.Node, len(strList)),
-		make([]string, len(strList)),
+		List:    make([]*enode.Node, len(strList)),
+		StrList: make([]string, len(strList)),
+	}
+	if asACN {
+		n.Sentries = make([][]*enode.Node, len(strList))
 	}
 
 	for i, enodeStr := range strList {
@@ -42,6 +48,14 @@ func NewNodes(strList []string, asACN bool) *Nodes {
 
 			n.List[idx] = newEnode
 			n.StrList[idx] = enodeStr
+			if asACN && newEnode != nil {
+				// a node with invalid sentries is still reachable on its own endpoint
+				sentries, err := enode.ParseACNSentries(enodeStr)
+				if err != nil {
+					errCh <- err
+				}
+				n.Sentries[idx] = sentries
+			}
 
 			wg.Done()
 		}(enodeStr)
@@ -70,14 +84,20 @@ func NewNodes(strList []string, asACN bool) *Nodes {
 
 func filterNodes(n *Nodes) *Nodes {
 	filtered := &Nodes{
-		make([]*enode.Node, 0, len(n.List)),
-		make([]string, 0, len(n.StrList)),
+		List:    make([]*enode.Node, 0, len(n.List)),
+		StrList: make([]string, 0, len(n.StrList)),
+	}
+	if n.Sentries != nil {
+		filtered.Sentries = make([][]*enode.Node, 0, len(n.Sentries))
 	}
 
 	for i, node := range n.List {
 		if node != nil {
 			filtered.List = append(filtered.List, node)
 			filtered.StrList = append(filtered.StrList, n.StrList[i])
+			if n.Sentries != nil {
+				filtered.Sentries = append(filtered.Sentries, n.Sentries[i])
+			}
 		}
 	}
 
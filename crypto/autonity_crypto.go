@@ -176,6 +176,14 @@ func BLSPOPVerify(pubKey blst.PublicKey, sig blst.Signature, treasury []byte) er
 }
 
 func AutonityPOPProof(nodeKey, oracleKey *ecdsa.PrivateKey, treasuryHex string, consensusKey blst.SecretKey) ([]byte, error) {
+	return AutonityPOPProofWithSigner(nodeKey, oracleKey, treasuryHex, func(treasury []byte) ([]byte, error) {
+		return BLSPOPProof(consensusKey, treasury)
+	})
+}
+
+// AutonityPOPProofWithSigner generates the ownership proof like AutonityPOPProof, except that the BLS POP of the
+// consensus key is produced by popSigner. It allows the consensus key to be held by an external signer.
+func AutonityPOPProofWithSigner(nodeKey, oracleKey *ecdsa.PrivateKey, treasuryHex string, popSigner func(treasury []byte) ([]byte, error)) ([]byte, error) {
 	treasury, err := hexutil.Decode(treasuryHex)
 	if err != nil {
 		return nil, err
@@ -193,7 +201,7 @@ func AutonityPOPProof(nodeKey, oracleKey *ecdsa.PrivateKey, treasuryHex string,
 	}
 
 	// generate the BLS POP
-	blsPOPProof, err := BLSPOPProof(consensusKey, treasury)
+	blsPOPProof, err := popSigner(treasury)
 	if err != nil {
 		return nil, err
 	}
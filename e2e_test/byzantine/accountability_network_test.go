@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/autonity/autonity/autonity"
+	"github.com/autonity/autonity/consensus/acn/codes"
 	"github.com/autonity/autonity/consensus/tendermint/accountability"
 	bk "github.com/autonity/autonity/consensus/tendermint/backend"
 	"github.com/autonity/autonity/consensus/tendermint/core"
@@ -177,7 +178,7 @@ func (s *OffChainAccusationFuzzer) Broadcast(msg message.Msg) {
 		}
 		if ok {
 			// send fuzzed accusation msg.
-			go peer.Send(bk.AccountabilityNetworkMsg, proof) // nolint
+			go peer.Send(codes.AccountabilityNetworkMsg, proof) // nolint
 			s.Logger().Info("Off chain Accusation garbage accusation is simulated")
 		}
 	}
@@ -230,8 +231,8 @@ func (s *OffChainDuplicatedAccusationBroadcaster) Broadcast(msg message.Msg) {
 					panic("cannot encode accusation at e2e test for off chain accusation protocol")
 				}
 				// send duplicated msg.
-				go peer.Send(bk.AccountabilityNetworkMsg, rProof) // nolint
-				go peer.Send(bk.AccountabilityNetworkMsg, rProof) // nolint
+				go peer.Send(codes.AccountabilityNetworkMsg, rProof) // nolint
+				go peer.Send(codes.AccountabilityNetworkMsg, rProof) // nolint
 				s.Logger().Info("Off chain Accusation duplicated accusation is simulated")
 			}
 		}
@@ -286,7 +287,7 @@ func (s *OverRatedOffChainAccusation) Broadcast(msg message.Msg) {
 						panic("cannot encode accusation at e2e test for off chain accusation protocol")
 					}
 					// send msg.
-					go peer.Send(bk.AccountabilityNetworkMsg, rProof) // nolint
+					go peer.Send(codes.AccountabilityNetworkMsg, rProof) // nolint
 					s.Logger().Info("Off chain Accusation over rated accusation is simulated")
 				}
 			}
@@ -11,8 +11,10 @@ import (
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/consensus/tendermint/bft"
 	"github.com/autonity/autonity/consensus/tendermint/core"
+	tdmcommittee "github.com/autonity/autonity/consensus/tendermint/core/committee"
 	"github.com/autonity/autonity/consensus/tendermint/core/interfaces"
 	"github.com/autonity/autonity/consensus/tendermint/core/message"
+	ethcore "github.com/autonity/autonity/core"
 	"github.com/autonity/autonity/core/types"
 	e2e "github.com/autonity/autonity/e2e_test"
 )
@@ -114,8 +116,10 @@ func initCollusion(vals []*gengen.Validator, rule autonity.Rule, planer collusio
 	collusions[rule] = b
 }
 
-func validProposer(address common.Address, h uint64, r int64, contract *autonity.ProtocolContracts, committee *types.Committee) bool {
-	return address == contract.Proposer(committee, nil, h-1, r)
+func validProposer(address common.Address, h uint64, r int64, chain *ethcore.BlockChain, committee *types.Committee) bool {
+	elect := tdmcommittee.StrategyAt(chain.Config(), h).Proposer
+	proposer, err := chain.ProtocolContracts().Proposer(committee, h-1, r, elect)
+	return err == nil && address == proposer
 }
 
 func sendPrevote(c *core.Core, rule autonity.Rule) {
@@ -181,15 +185,15 @@ func setupCollusionContext(c faultyBroadcaster, rule autonity.Rule) {
 	round := int64(0)
 	epoch, _ := c.Backend().BlockChain().LatestEpoch()
 
-	contract := c.Backend().BlockChain().ProtocolContracts()
+	chain := c.Backend().BlockChain()
 	for ; ; round++ {
 		// select a none proposer to propose faulty value in PVN context.
-		if rule == autonity.PVN && !validProposer(leader, futureHeight, round, contract, epoch.Committee) {
+		if rule == autonity.PVN && !validProposer(leader, futureHeight, round, chain, epoch.Committee) {
 			break
 		}
 
 		// select a proposer to propose faulty value in PVO and C1 context
-		if round != 0 && rule != autonity.PVN && validProposer(leader, futureHeight, round, contract, epoch.Committee) {
+		if round != 0 && rule != autonity.PVN && validProposer(leader, futureHeight, round, chain, epoch.Committee) {
 			break
 		}
 	}
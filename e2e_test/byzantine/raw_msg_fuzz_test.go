@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/consensus/acn/codes"
 	"github.com/autonity/autonity/consensus/tendermint/backend"
 	"github.com/autonity/autonity/consensus/tendermint/bft"
 	"github.com/autonity/autonity/consensus/tendermint/core/interfaces"
@@ -58,9 +59,9 @@ func (fg *rawMSGFuzzer) Gossip(committee *types.Committee, msg message.Msg) {
 		// send fuzzed raw msg with recognisable msg code.
 		go p.SendRaw(backend.NetworkCodes[msg.Code()], randBytes) // nolint
 		// send fuzzed raw AskSync message to committee
-		go p.SendRaw(backend.SyncNetworkMsg, randBytes) // nolint
+		go p.SendRaw(codes.SyncNetworkMsg, randBytes) // nolint
 		// send fuzzed raw accusation message to committee
-		go p.SendRaw(backend.AccountabilityNetworkMsg, randBytes) // nolint
+		go p.SendRaw(codes.AccountabilityNetworkMsg, randBytes) // nolint
 		// send random msg code with fuzzed raw msg.
 		go p.SendRaw(rand.Uint64(), randBytes) // nolint
 	}
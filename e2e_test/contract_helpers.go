@@ -217,6 +217,12 @@ func (t *transactor) setEpochPeriod(period *big.Int) (*types.Transaction, error)
 	})
 }
 
+func (t *transactor) setConsensusTimeouts(timeouts autonity.AutonityConsensusTimeouts) (*types.Transaction, error) {
+	return t.execute(func(instance *autonity.Autonity, opts *bind.TransactOpts) (*types.Transaction, error) {
+		return instance.SetConsensusTimeouts(opts, timeouts)
+	})
+}
+
 func (t *transactor) setOperator(address common.Address) (*types.Transaction, error) {
 	return t.execute(func(instance *autonity.Autonity, opts *bind.TransactOpts) (*types.Transaction, error) {
 		return instance.SetOperatorAccount(opts, address)
@@ -427,6 +433,17 @@ func (c *Caller) GetEpochPeriod() (*big.Int, error) {
 	return period, err
 }
 
+func (c *Caller) GetConsensusTimeouts() (autonity.AutonityConsensusTimeouts, autonity.AutonityConsensusTimeouts, *big.Int, error) {
+	var current, next autonity.AutonityConsensusTimeouts
+	var nextBlock *big.Int
+	err := c.execute(func(instance *autonity.Autonity, opts *bind.CallOpts) error {
+		var err error
+		current, next, nextBlock, err = instance.GetConsensusTimeouts(opts)
+		return err
+	})
+	return current, next, nextBlock, err
+}
+
 func (c *Caller) GetTreasuryFee() (*big.Int, error) {
 	var fee *big.Int
 	err := c.execute(func(instance *autonity.Autonity, opts *bind.CallOpts) error {
@@ -565,6 +582,13 @@ func (n *Node) AwaitSetEpochPeriod(optKey *ecdsa.PrivateKey, newPeriod *big.Int,
 	return n.AwaitContractTXN(txFunc, tm)
 }
 
+func (n *Node) AwaitSetConsensusTimeouts(optKey *ecdsa.PrivateKey, timeouts autonity.AutonityConsensusTimeouts, tm time.Duration) error {
+	txFunc := func(ctx context.Context, client *Interactor) (*types.Transaction, error) {
+		return client.TX(ctx, optKey).setConsensusTimeouts(timeouts)
+	}
+	return n.AwaitContractTXN(txFunc, tm)
+}
+
 func (n *Node) AwaitSetTreasuryAccount(optKey *ecdsa.PrivateKey, newAccount common.Address, tm time.Duration) error {
 	txFunc := func(ctx context.Context, client *Interactor) (*types.Transaction, error) {
 		return client.TX(ctx, optKey).setTreasuryAccount(newAccount)
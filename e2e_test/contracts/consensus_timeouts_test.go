@@ -0,0 +1,59 @@
+package contracts
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/autonity/autonity/autonity"
+	"github.com/autonity/autonity/consensus/tendermint/backend"
+	"github.com/autonity/autonity/consensus/tendermint/core"
+	e2e "github.com/autonity/autonity/e2e_test"
+)
+
+// The consensus timeouts set by the operator are applied by the consensus engine of the validators at the end of
+// the epoch.
+func TestConsensusTimeouts(t *testing.T) {
+	network, err := e2e.NewNetwork(t, 4, "10e18,v,1,0.0.0.0:%s,%s,%s,%s")
+	require.NoError(t, err)
+	defer network.Shutdown(t)
+	require.NoError(t, network.WaitForHeight(2, 60))
+
+	prevoteTimeout := func(n *e2e.Node) (time.Duration, int64) {
+		state := n.Eth.Engine().(*backend.Backend).CoreState()
+		return state.PrevoteTimeout, state.Round
+	}
+	for _, n := range network {
+		timeout, round := prevoteTimeout(n)
+		require.Equal(t, core.InitialPrevoteTimeout+time.Duration(round)*core.PrevoteTimeoutDelta, timeout)
+	}
+
+	timeouts := autonity.AutonityConsensusTimeouts{
+		ProposeInitial:   big.NewInt(1500),
+		ProposeDelta:     big.NewInt(300),
+		PrevoteInitial:   big.NewInt(1200),
+		PrevoteDelta:     big.NewInt(200),
+		PrecommitInitial: big.NewInt(1100),
+		PrecommitDelta:   big.NewInt(100),
+	}
+	client := network[0]
+	require.NoError(t, client.AwaitSetConsensusTimeouts(client.Key, timeouts, 5*time.Second))
+	_, next, nextBlock, err := client.Interactor.Call(nil).GetConsensusTimeouts()
+	require.NoError(t, err)
+	require.Equal(t, timeouts, next)
+	require.NotZero(t, nextBlock.Sign())
+
+	// the timeouts are applied once the epoch ended
+	require.NoError(t, network.WaitForHeight(nextBlock.Uint64()+1, 60))
+	current, _, _, err := client.Interactor.Call(nil).GetConsensusTimeouts()
+	require.NoError(t, err)
+	require.Equal(t, timeouts, current)
+	for _, n := range network {
+		require.Eventually(t, func() bool {
+			timeout, round := prevoteTimeout(n)
+			return timeout == 1200*time.Millisecond+time.Duration(round)*200*time.Millisecond
+		}, 10*time.Second, 100*time.Millisecond, "validator %v did not apply the consensus timeouts", n.Address)
+	}
+}
@@ -0,0 +1,335 @@
+package contracts
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/autonity/autonity/accounts/abi/bind"
+	"github.com/autonity/autonity/autonity"
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/core"
+	"github.com/autonity/autonity/crypto"
+	e2e "github.com/autonity/autonity/e2e_test"
+	"github.com/autonity/autonity/ethclient"
+	"github.com/autonity/autonity/params"
+)
+
+// holdings returns the Auton and Newton an account owns at a block, the unclaimed staking rewards included.
+func holdings(t *testing.T, client *ethclient.Client, account common.Address, block *big.Int) (*big.Int, *big.Int) {
+	opts := &bind.CallOpts{BlockNumber: block}
+	contract, err := autonity.NewAutonity(params.AutonityContractAddress, client)
+	require.NoError(t, err)
+	atn, err := client.BalanceAt(context.Background(), account, block)
+	require.NoError(t, err)
+	ntn, err := contract.BalanceOf(opts, account)
+	require.NoError(t, err)
+	validators, err := contract.GetValidators(opts)
+	require.NoError(t, err)
+	for _, address := range validators {
+		validator, err := contract.GetValidator(opts, address)
+		require.NoError(t, err)
+		liquid, err := autonity.NewLiquidLogic(validator.LiquidStateContract, client)
+		require.NoError(t, err)
+		rewards, err := liquid.UnclaimedRewards(opts, account)
+		require.NoError(t, err)
+		atn.Add(atn, rewards.UnclaimedATN)
+		ntn.Add(ntn, rewards.UnclaimedNTN)
+		if validator.Treasury == account {
+			treasuryRewards, err := liquid.GetTreasuryUnclaimedATN(opts)
+			require.NoError(t, err)
+			atn.Add(atn, treasuryRewards)
+		}
+	}
+	return atn, ntn
+}
+
+// liquidBalance returns the Liquid Newton of an account for a validator at a block.
+func liquidBalance(t *testing.T, client *ethclient.Client, validator, account common.Address, block *big.Int) *big.Int {
+	opts := &bind.CallOpts{BlockNumber: block}
+	contract, err := autonity.NewAutonity(params.AutonityContractAddress, client)
+	require.NoError(t, err)
+	info, err := contract.GetValidator(opts, validator)
+	require.NoError(t, err)
+	liquid, err := autonity.NewLiquidLogic(info.LiquidStateContract, client)
+	require.NoError(t, err)
+	balance, err := liquid.BalanceOf(opts, account)
+	require.NoError(t, err)
+	return balance
+}
+
+func TestExportGenesisAdvancedChain(t *testing.T) {
+	const epochPeriod = 10
+	var (
+		delegatorKey, _   = crypto.GenerateKey()
+		beneficiaryKey, _ = crypto.GenerateKey()
+		delegator         = crypto.PubkeyToAddress(delegatorKey.PublicKey)
+		beneficiary       = crypto.PubkeyToAddress(beneficiaryKey.PublicKey)
+		recipient         = common.HexToAddress("0x4ec1e1e47")
+		bonded            = big.NewInt(500)
+		transferred       = big.NewInt(100)
+	)
+	vals, err := e2e.Validators(t, 4, "10e18,v,10000,0.0.0.0:%s,%s,%s,%s")
+	require.NoError(t, err)
+	network, err := e2e.NewNetworkFromValidators(t, vals, false, func(genesis *core.Genesis) {
+		config := *genesis.Config
+		autonityConfig := *config.AutonityContractConfig
+		autonityConfig.EpochPeriod = epochPeriod
+		config.AutonityContractConfig = &autonityConfig
+		// the contract started before genesis, its funds are partly unlocked once the cliff ends after genesis
+		config.StakableVestingConfig = &params.StakableVestingGenesis{
+			TotalNominal: big.NewInt(10_000),
+			StakableContracts: []params.StakableVestingData{{
+				Beneficiary:   beneficiary,
+				Amount:        big.NewInt(10_000),
+				Start:         new(big.Int).SetUint64(genesis.Timestamp - 1000),
+				CliffDuration: big.NewInt(1001),
+				TotalDuration: big.NewInt(10_000),
+			}},
+		}
+		genesis.Config = &config
+		genesis.Alloc[delegator] = core.GenesisAccount{Balance: big.NewInt(params.Ether), NewtonBalance: big.NewInt(1000)}
+		genesis.Alloc[beneficiary] = core.GenesisAccount{Balance: big.NewInt(params.Ether)}
+	})
+	require.NoError(t, err)
+	defer network.Shutdown(t)
+	// the exported state must be on disk with its preimages
+	for _, n := range network {
+		n.EthConfig.NoPruning = true
+		n.EthConfig.Preimages = true
+		require.NoError(t, n.Start())
+	}
+	require.NoError(t, network.WaitToMineNBlocks(2, 30, false))
+
+	n := network[0]
+	chainID := n.EthConfig.Genesis.Config.ChainID
+	validator := n.EthConfig.Genesis.Config.AutonityContractConfig.Validators[0].NodeAddress
+	autonityContract, err := autonity.NewAutonity(params.AutonityContractAddress, n.WsClient)
+	require.NoError(t, err)
+	vestingContract, err := autonity.NewStakableVesting(params.StakableVestingContractAddress, n.WsClient)
+	require.NoError(t, err)
+	delegatorOpts, err := bind.NewKeyedTransactorWithChainID(delegatorKey, chainID)
+	require.NoError(t, err)
+	beneficiaryOpts, err := bind.NewKeyedTransactorWithChainID(beneficiaryKey, chainID)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+	bondTx, err := autonityContract.Bond(delegatorOpts, *validator, bonded)
+	require.NoError(t, err)
+	require.NoError(t, n.AwaitTransactions(ctx, bondTx))
+	// the stake is bonded and the cliff of the vesting contract is over at the end of the epoch
+	next, err := autonityContract.GetNextEpochBlock(nil)
+	require.NoError(t, err)
+	require.NoError(t, network.WaitForHeight(next.Uint64()+1, 30))
+
+	// the recipient holds Newton only, it is known from the events of the Autonity contract
+	transferTx, err := autonityContract.Transfer(delegatorOpts, recipient, transferred)
+	require.NoError(t, err)
+	releaseTx, err := vestingContract.ReleaseFunds(beneficiaryOpts, common.Big0)
+	require.NoError(t, err)
+	feeTx, err := n.SendAUT(ctx, beneficiary, 1)
+	require.NoError(t, err)
+	require.NoError(t, n.AwaitTransactions(ctx, transferTx, releaseTx, feeTx))
+	// the delegator accrues rewards at the end of the epoch
+	next, err = autonityContract.GetNextEpochBlock(nil)
+	require.NoError(t, err)
+	require.NoError(t, network.WaitForHeight(next.Uint64()+1, 30))
+	number := new(big.Int).Set(next)
+
+	exportedGenesis, err := core.ExportGenesis(n.Eth.BlockChain(), number.Uint64())
+	require.NoError(t, err)
+	data, err := json.Marshal(exportedGenesis)
+	require.NoError(t, err)
+	exported := new(core.Genesis)
+	require.NoError(t, json.Unmarshal(data, exported))
+
+	// the state of the exported block, read before the network is shut down
+	opts := &bind.CallOpts{BlockNumber: number}
+	accounts := []common.Address{delegator, beneficiary, recipient}
+	for _, v := range vals {
+		accounts = append(accounts, crypto.PubkeyToAddress(v.TreasuryKey.PublicKey))
+	}
+	atn := make(map[common.Address]*big.Int)
+	ntn := make(map[common.Address]*big.Int)
+	for _, account := range accounts {
+		atn[account], ntn[account] = holdings(t, n.WsClient, account, number)
+	}
+	delegatorBalance, err := n.WsClient.BalanceAt(ctx, delegator, number)
+	require.NoError(t, err)
+	require.Equal(t, 1, atn[delegator].Cmp(delegatorBalance), "the delegator has no reward to carry over")
+	delegated := liquidBalance(t, n.WsClient, *validator, delegator, number)
+	require.Equal(t, bonded, delegated)
+	vesting, err := vestingContract.GetContracts(opts, beneficiary)
+	require.NoError(t, err)
+	require.Len(t, vesting, 1)
+	require.Positive(t, vesting[0].WithdrawnValue.Sign(), "nothing withdrawn from the vesting contract")
+	vestingValue, err := vestingContract.ContractTotalValue(opts, beneficiary, common.Big0)
+	require.NoError(t, err)
+	remaining := new(big.Int).Sub(vestingValue, vesting[0].WithdrawnValue)
+	require.Zero(t, atn[recipient].Sign())
+	require.Equal(t, transferred, ntn[recipient])
+	network.Shutdown(t)
+
+	relaunched := make(e2e.Network, len(vals))
+	for i, v := range vals {
+		relaunched[i], err = e2e.NewValidatorNode(v, exported, i)
+		require.NoError(t, err)
+		require.NoError(t, relaunched[i].Start())
+	}
+	defer relaunched.Shutdown(t)
+	require.NoError(t, relaunched.WaitToMineNBlocks(2, 30, false))
+
+	client := relaunched[0].WsClient
+	genesisBlock := common.Big0
+	for _, account := range accounts {
+		relaunchedATN, relaunchedNTN := holdings(t, client, account, genesisBlock)
+		require.Equal(t, atn[account], relaunchedATN, "auton of %s", account)
+		require.Equal(t, ntn[account], relaunchedNTN, "newton of %s", account)
+	}
+	require.Equal(t, delegated, liquidBalance(t, client, *validator, delegator, genesisBlock))
+
+	relaunchedVesting, err := autonity.NewStakableVesting(params.StakableVestingContractAddress, client)
+	require.NoError(t, err)
+	contracts, err := relaunchedVesting.GetContracts(&bind.CallOpts{BlockNumber: genesisBlock}, beneficiary)
+	require.NoError(t, err)
+	require.Len(t, contracts, 1)
+	require.Equal(t, remaining, contracts[0].CurrentNTNAmount)
+	require.Equal(t, new(big.Int).Add(vesting[0].Start, vesting[0].TotalDuration), new(big.Int).Add(contracts[0].Start, contracts[0].TotalDuration))
+}
+
+func TestExportGenesisValidatorStates(t *testing.T) {
+	const (
+		epochPeriod      = 10
+		jailReleaseBlock = 1000
+		jailed           = uint8(2)
+	)
+	var (
+		delegatorKey, _ = crypto.GenerateKey()
+		delegator       = crypto.PubkeyToAddress(delegatorKey.PublicKey)
+		delegated       = big.NewInt(500)
+		unbonded        = big.NewInt(200)
+		collateral      = big.NewInt(300)
+	)
+	vals, err := e2e.Validators(t, 5, "10e18,v,10000,0.0.0.0:%s,%s,%s,%s")
+	require.NoError(t, err)
+	// the fourth validator is jailed and the fifth one has delegated stake only
+	var jailedValidator, delegatedValidator common.Address
+	network, err := e2e.NewNetworkFromValidators(t, vals, false, func(genesis *core.Genesis) {
+		config := *genesis.Config
+		autonityConfig := *config.AutonityContractConfig
+		autonityConfig.EpochPeriod = epochPeriod
+		autonityConfig.UnbondingPeriod = 1000
+		state := jailed
+		autonityConfig.Validators[3].State = &state
+		autonityConfig.Validators[3].JailReleaseBlock = big.NewInt(jailReleaseBlock)
+		autonityConfig.Validators[4].BondedStake = new(big.Int)
+		autonityConfig.Validators[4].SelfBondedStake = new(big.Int)
+		jailedValidator = *autonityConfig.Validators[3].NodeAddress
+		delegatedValidator = *autonityConfig.Validators[4].NodeAddress
+		config.AutonityContractConfig = &autonityConfig
+		genesis.Config = &config
+		genesis.Alloc[delegator] = core.GenesisAccount{
+			Balance:       big.NewInt(params.Ether),
+			NewtonBalance: big.NewInt(1000),
+			Bonds:         map[common.Address]*big.Int{delegatedValidator: delegated},
+		}
+	})
+	require.NoError(t, err)
+	defer network.Shutdown(t)
+	for _, n := range network {
+		n.EthConfig.NoPruning = true
+		n.EthConfig.Preimages = true
+		require.NoError(t, n.Start())
+	}
+	// the node of the jailed validator is not part of the committee
+	committee := e2e.Network{network[0], network[1], network[2], network[4]}
+	require.NoError(t, committee.WaitToMineNBlocks(2, 30, false))
+
+	n := network[0]
+	autonityContract, err := autonity.NewAutonity(params.AutonityContractAddress, n.WsClient)
+	require.NoError(t, err)
+	stabilization, err := autonity.NewStabilization(params.StabilizationContractAddress, n.WsClient)
+	require.NoError(t, err)
+	// the genesis registers the validators in their state
+	genesisOpts := &bind.CallOpts{BlockNumber: common.Big0}
+	validator, err := autonityContract.GetValidator(genesisOpts, jailedValidator)
+	require.NoError(t, err)
+	require.Equal(t, jailed, validator.State)
+	validator, err = autonityContract.GetValidator(genesisOpts, delegatedValidator)
+	require.NoError(t, err)
+	require.Zero(t, validator.SelfBondedStake.Sign())
+	require.Equal(t, delegated, validator.BondedStake)
+
+	delegatorOpts, err := bind.NewKeyedTransactorWithChainID(delegatorKey, n.EthConfig.Genesis.Config.ChainID)
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+	unbondTx, err := autonityContract.Unbond(delegatorOpts, delegatedValidator, unbonded)
+	require.NoError(t, err)
+	approveTx, err := autonityContract.Approve(delegatorOpts, params.StabilizationContractAddress, collateral)
+	require.NoError(t, err)
+	require.NoError(t, n.AwaitTransactions(ctx, unbondTx, approveTx))
+	depositTx, err := stabilization.Deposit(delegatorOpts, collateral)
+	require.NoError(t, err)
+	require.NoError(t, n.AwaitTransactions(ctx, depositTx))
+	// the unbonding request is applied at the end of the epoch
+	next, err := autonityContract.GetNextEpochBlock(nil)
+	require.NoError(t, err)
+	require.NoError(t, committee.WaitForHeight(next.Uint64()+1, 30))
+	number := new(big.Int).Set(next)
+
+	exportedGenesis, err := core.ExportGenesis(n.Eth.BlockChain(), number.Uint64())
+	require.NoError(t, err)
+	data, err := json.Marshal(exportedGenesis)
+	require.NoError(t, err)
+	exported := new(core.Genesis)
+	require.NoError(t, json.Unmarshal(data, exported))
+
+	opts := &bind.CallOpts{BlockNumber: number}
+	_, ntn := holdings(t, n.WsClient, delegator, number)
+	validator, err = autonityContract.GetValidator(opts, delegatedValidator)
+	require.NoError(t, err)
+	require.Equal(t, unbonded, validator.UnbondingStake)
+	bonded := new(big.Int).Set(validator.BondedStake)
+	cdp, err := stabilization.Cdps(opts, delegator)
+	require.NoError(t, err)
+	require.Equal(t, collateral, cdp.Collateral)
+	network.Shutdown(t)
+
+	relaunched := make(e2e.Network, len(vals))
+	for i, v := range vals {
+		relaunched[i], err = e2e.NewValidatorNode(v, exported, i)
+		require.NoError(t, err)
+		require.NoError(t, relaunched[i].Start())
+	}
+	defer relaunched.Shutdown(t)
+	relaunchedCommittee := e2e.Network{relaunched[0], relaunched[1], relaunched[2], relaunched[4]}
+	require.NoError(t, relaunchedCommittee.WaitToMineNBlocks(2, 30, false))
+
+	client := relaunched[0].WsClient
+	relaunchedContract, err := autonity.NewAutonity(params.AutonityContractAddress, client)
+	require.NoError(t, err)
+	validator, err = relaunchedContract.GetValidator(genesisOpts, jailedValidator)
+	require.NoError(t, err)
+	require.Equal(t, jailed, validator.State)
+	require.Equal(t, big.NewInt(jailReleaseBlock-number.Int64()), validator.JailReleaseBlock)
+	members, err := relaunchedContract.GetCommittee(genesisOpts)
+	require.NoError(t, err)
+	for _, member := range members {
+		require.NotEqual(t, jailedValidator, member.Addr, "jailed validator in the committee")
+	}
+	validator, err = relaunchedContract.GetValidator(genesisOpts, delegatedValidator)
+	require.NoError(t, err)
+	require.Zero(t, validator.SelfBondedStake.Sign())
+	require.Equal(t, bonded, validator.BondedStake)
+	require.Equal(t, bonded, liquidBalance(t, client, delegatedValidator, delegator, common.Big0))
+	// the stake being unbonded and the collateral are credited to the delegator
+	_, relaunchedNTN := holdings(t, client, delegator, common.Big0)
+	expected := new(big.Int).Add(ntn, unbonded)
+	require.Equal(t, expected.Add(expected, collateral), relaunchedNTN)
+}
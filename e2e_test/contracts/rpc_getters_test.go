@@ -68,6 +68,56 @@ func TestACGetterRPCs(t *testing.T) {
 	}
 }
 
+// This test checks that the getter functions accept an optional block number or hash
+// selecting the state they are executed against.
+func TestACGetterRPCsAtBlock(t *testing.T) {
+	network, err := e2e.NewNetwork(t, 1, "10e18,v,1,0.0.0.0:%s,%s,%s,%s")
+	require.NoError(t, err)
+	defer network.Shutdown(t)
+
+	ep := network[0].HTTPEndpoint()
+
+	for _, params := range []interface{}{
+		[]interface{}{"0x0"},
+		[]interface{}{"latest"},
+		[]interface{}{map[string]interface{}{"blockNumber": "0x0"}},
+		[]interface{}{map[string]interface{}{"blockHash": network[0].Eth.BlockChain().Genesis().Hash()}},
+	} {
+		payload, err := json.Marshal(&rpcCall{Method: "aut_getEpochPeriod", Jsonrpc: "2.0", Id: 1, Params: params})
+		require.NoError(t, err)
+		responseMap := make(map[string]interface{})
+		require.NoError(t, json.Unmarshal(callRPC(t, ep, payload), &responseMap))
+		require.NotNil(t, responseMap["result"])
+		require.Nil(t, responseMap["error"])
+	}
+
+	// the other protocol contracts are exposed under their own namespace
+	client, err := network[0].Attach()
+	require.NoError(t, err)
+	defer client.Close()
+	for _, method := range []string{
+		"accountability_epochPeriod",
+		"oracle_getRound",
+		"acu_scale",
+		"supplycontrol_availableSupply",
+		"stabilization_config",
+		"inflationcontroller_params",
+		"stakablevesting_totalNominal",
+		"nonstakablevesting_totalNominal",
+	} {
+		var result interface{}
+		require.NoError(t, client.Call(&result, method, "0x0"), method)
+		require.NotNil(t, result, method)
+	}
+
+	// a block in the future has no state
+	payload, err := json.Marshal(&rpcCall{Method: "aut_getEpochPeriod", Jsonrpc: "2.0", Id: 1, Params: []interface{}{"0xffffff"}})
+	require.NoError(t, err)
+	responseMap := make(map[string]interface{})
+	require.NoError(t, json.Unmarshal(callRPC(t, ep, payload), &responseMap))
+	require.NotNil(t, responseMap["error"])
+}
+
 type rpcCall struct {
 	Jsonrpc string      `json:"jsonrpc,omitempty"` // nolint
 	Method  string      `json:"method,omitempty"`
@@ -21,13 +21,17 @@ import (
 	"github.com/autonity/autonity/accounts/abi/bind"
 	"github.com/autonity/autonity/autonity"
 	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/consensus/acn"
+	"github.com/autonity/autonity/consensus/tendermint/backend"
 	"github.com/autonity/autonity/consensus/tendermint/core"
 	"github.com/autonity/autonity/consensus/tendermint/core/constants"
 	"github.com/autonity/autonity/consensus/tendermint/core/interfaces"
 	"github.com/autonity/autonity/consensus/tendermint/core/message"
+	"github.com/autonity/autonity/consensus/tendermint/events"
 	ccore "github.com/autonity/autonity/core"
 	"github.com/autonity/autonity/core/types"
 	"github.com/autonity/autonity/crypto/blst"
+	"github.com/autonity/autonity/eth/downloader"
 	"github.com/autonity/autonity/log"
 	"github.com/autonity/autonity/p2p/enode"
 	"github.com/autonity/autonity/params"
@@ -753,6 +757,208 @@ loop2:
 	require.True(t, fullyConnected)
 }
 
+// the validators connect to the members of the next committee ahead of the epoch switch, and drop the members which
+// left once switched.
+func TestCommitteePreconnect(t *testing.T) {
+	vals, err := Validators(t, 4, "10e18,v,10000,127.0.0.1:%s,%s,%s,%s")
+	require.NoError(t, err)
+	network, err := NewInMemoryNetwork(t, vals, true, func(genesis *ccore.Genesis) {
+		genesis.Config.AutonityContractConfig.EpochPeriod = 20
+	})
+	require.NoError(t, err)
+	defer network.Shutdown(t)
+
+	n := network[3]
+	autonityContract, err := autonity.NewAutonity(params.AutonityContractAddress, network[0].WsClient)
+	require.NoError(t, err)
+	transactor, err := bind.NewKeyedTransactorWithChainID(vals[3].TreasuryKey, params.TestChainConfig.ChainID)
+	require.NoError(t, err)
+
+	inCommittee := func() bool {
+		epoch, err := network[0].Eth.BlockChain().LatestEpoch()
+		require.NoError(t, err)
+		return epoch.Committee.MemberByAddress(n.Address) != nil
+	}
+	// the paused validator leaves the committee at the end of the epoch, and gets disconnected
+	_, err = autonityContract.PauseValidator(transactor, n.Address)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return !inCommittee() }, 120*time.Second, time.Second)
+	require.Eventually(t, func() bool { return network[0].ConsensusServer().PeerCount() == 2 }, 10*time.Second, 100*time.Millisecond)
+	current := committeeConnectivity(t, network[0]).Current
+	require.Equal(t, 2, current.Expected)
+	require.Equal(t, current.ExpectedPower, current.ConnectedPower)
+
+	// once re-activated, it connects to the committee before joining it. Simultaneous dials between two peers may
+	// both fail and get retried later on, hence the members are not expected to be all connected before the switch.
+	_, err = autonityContract.ActivateValidator(transactor, n.Address)
+	require.NoError(t, err)
+	preconnected := false
+	require.Eventually(t, func() bool {
+		c := committeeConnectivity(t, n)
+		if c.Next == nil || !c.Next.Member || c.Next.Connected == 0 {
+			return false
+		}
+		preconnected = !c.Current.Member
+		return true
+	}, 120*time.Second, 500*time.Millisecond)
+	require.True(t, preconnected)
+
+	// once joined, it is connected to all the members
+	require.Eventually(t, inCommittee, 60*time.Second, time.Second)
+	require.Eventually(t, func() bool {
+		current := committeeConnectivity(t, n).Current
+		return current.Member && current.Connected == 3
+	}, 120*time.Second, time.Second)
+}
+
+// a validator behind a sentry takes part in the consensus, while connected to its sentry only.
+func TestSentry(t *testing.T) {
+	vals, err := Validators(t, 4, "10e18,v,10000,127.0.0.1:%s,%s,%s,%s")
+	require.NoError(t, err)
+	identities, err := Validators(t, 1, "10e18,v,10000,127.0.0.1:%s,%s,%s,%s")
+	require.NoError(t, err)
+	sentry := enode.NewV4(&identities[0].NodeKey.PublicKey, identities[0].AcnIP, identities[0].AcnPort, identities[0].AcnPort)
+
+	// the validator declares the sentry in its enode
+	network, err := NewNetworkFromValidators(t, vals, false, func(genesis *ccore.Genesis) {
+		validator := genesis.Config.AutonityContractConfig.Validators[3]
+		validator.Enode = enode.AppendSentry(sentry, validator.Enode)
+	})
+	require.NoError(t, err)
+	defer network.Shutdown(t)
+	validator := network[3]
+	validator.Config.ConsensusSentries = []*enode.Node{sentry}
+
+	sentryNode, err := NewNoneValidatorNode(identities[0], network[0].EthConfig.Genesis, len(network), downloader.FullSync)
+	require.NoError(t, err)
+	sentryNode.Config.ConsensusSentryOf = enode.NewV4(&validator.Key.PublicKey, nil, 0, 0)
+	require.NoError(t, sentryNode.Start())
+	defer sentryNode.Close(true) //nolint
+	for _, n := range network {
+		require.NoError(t, n.Start())
+	}
+	require.NoError(t, network.WaitToMineNBlocks(10, 90, false))
+
+	// the validator dialed its sentry only
+	peers := validator.ConsensusServer().Peers()
+	require.Len(t, peers, 1)
+	require.Equal(t, sentry.ID(), peers[0].ID())
+	require.False(t, peers[0].Inbound())
+
+	// its votes reach the committee through the sentry
+	require.Eventually(t, func() bool {
+		chain := network[0].Eth.BlockChain()
+		header := chain.CurrentHeader()
+		committee, err := chain.CommitteeOfHeight(header.Number.Uint64())
+		if err != nil {
+			return false
+		}
+		member := committee.MemberByAddress(validator.Address)
+		signers := header.QuorumCertificate.Signers
+		return member != nil && signers != nil && signers.Bits.Valid(committee.Len()) && signers.Bits.Get(int(member.Index)) > 0
+	}, 60*time.Second, 500*time.Millisecond)
+
+	// both the validator and its sentry report the connectivity of the member to the rest of the committee
+	for _, n := range []*Node{validator, sentryNode} {
+		require.Eventually(t, func() bool {
+			current := committeeConnectivity(t, n).Current
+			return current != nil && current.Member && current.Expected == 3 && current.Connected == 3
+		}, 30*time.Second, 500*time.Millisecond)
+	}
+}
+
+// committeeConnectivity returns the connectivity of the node to the consensus committees.
+func committeeConnectivity(t *testing.T, node *Node) *acn.CommitteeConnectivity {
+	client, err := node.Attach()
+	require.NoError(t, err)
+	defer client.Close()
+	result := new(acn.CommitteeConnectivity)
+	require.NoError(t, client.Call(result, "tendermint_getCommitteeConnectivity"))
+	return result
+}
+
+// the consensus events of a validator are streamed to its subscribers as the network decides blocks.
+func TestCoreEvents(t *testing.T) {
+	vals, err := Validators(t, 4, "10e18,v,10000,127.0.0.1:%s,%s,%s,%s")
+	require.NoError(t, err)
+	network, err := NewInMemoryNetwork(t, vals, true)
+	require.NoError(t, err)
+	defer network.Shutdown(t)
+
+	client, err := network[0].Attach()
+	require.NoError(t, err)
+	defer client.Close()
+	coreEvents := make(chan events.CoreEvent, 256)
+	filter := &backend.CoreEventFilter{Types: []events.CoreEventType{events.CoreProposal, events.CoreQuorum, events.CoreCommit}}
+	sub, err := client.Subscribe(context.Background(), "tendermint", coreEvents, "coreEvents", filter)
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	// a commit follows the proposal and the quorums of the decided value
+	seen := make(map[uint64]map[events.CoreEventType]bool)
+	timeout := time.After(60 * time.Second)
+	for {
+		select {
+		case ev := <-coreEvents:
+			require.Contains(t, filter.Types, ev.Type)
+			if seen[ev.Height] == nil {
+				seen[ev.Height] = make(map[events.CoreEventType]bool)
+			}
+			seen[ev.Height][ev.Type] = true
+			if ev.Type != events.CoreCommit || !seen[ev.Height][events.CoreProposal] {
+				continue
+			}
+			require.True(t, seen[ev.Height][events.CoreQuorum])
+			require.GreaterOrEqual(t, len(ev.Signers), 3)
+			header := network[0].Eth.BlockChain().GetHeaderByNumber(ev.Height)
+			require.NotNil(t, header)
+			require.Equal(t, header.Hash(), *ev.Value)
+			return
+		case err := <-sub.Err():
+			t.Fatal(err)
+		case <-timeout:
+			t.Fatal("no commit streamed")
+		}
+	}
+}
+
+// the heights recorded to the consensus WAL of a validator replay without diverging from the recorded decisions.
+func TestConsensusWAL(t *testing.T) {
+	vals, err := Validators(t, 4, "10e18,v,10000,127.0.0.1:%s,%s,%s,%s")
+	require.NoError(t, err)
+	network, err := NewNetworkFromValidators(t, vals, false)
+	require.NoError(t, err)
+	defer network.Shutdown(t)
+	network[0].Config.DataDir = t.TempDir()
+	network[0].Config.ConsensusWAL = true
+	network[0].Config.ConsensusWALHeights = 5
+	for _, n := range network {
+		require.NoError(t, n.Start())
+	}
+	require.NoError(t, network.WaitToMineNBlocks(10, 90, false))
+	dir := network[0].Config.ResolvePath(core.WALDir)
+	require.NoError(t, network[0].Close(false))
+
+	files, err := core.WALFiles(dir)
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+	require.LessOrEqual(t, len(files), 5)
+	decisions := 0
+	for _, file := range files {
+		report, err := core.ReplayWAL(file, log.Root())
+		require.NoError(t, err)
+		for _, session := range report.Sessions {
+			require.Empty(t, session.Divergences, "height %d of %s diverged", session.Height, file)
+			for _, action := range session.Replayed {
+				if action.Kind == core.WALDecision {
+					decisions++
+				}
+			}
+		}
+	}
+	require.Positive(t, decisions)
+}
+
 /*
 // UNSUPPORTED ON NON UNIX DEV ENV
 func updateRlimit() {
@@ -29,6 +29,7 @@ import (
 	"github.com/autonity/autonity/eth/downloader"
 	"github.com/autonity/autonity/eth/ethconfig"
 	"github.com/autonity/autonity/ethclient"
+	"github.com/autonity/autonity/les"
 	"github.com/autonity/autonity/log"
 	"github.com/autonity/autonity/node"
 	"github.com/autonity/autonity/p2p"
@@ -51,8 +52,9 @@ var (
 		ConsensusP2P: p2p.Config{
 			MaxPeers: 100000,
 		},
-		HTTPHost: localhost,
-		WSHost:   localhost,
+		CommitteePreconnect: node.DefaultCommitteePreconnect,
+		HTTPHost:            localhost,
+		WSHost:              localhost,
 	}
 
 	terminalColors = []struct {
@@ -83,6 +85,7 @@ type Node struct {
 	isRunning bool
 	Config    *node.Config
 	Eth       *eth.Ethereum
+	Les       *les.LightEthereum // light client, set instead of Eth for the nodes in light sync mode
 	EthConfig *ethconfig.Config
 	WsClient  *ethclient.Client
 
@@ -267,19 +270,32 @@ func (n *Node) Start() error {
 	if n.Node, err = node.New(n.Config); err != nil {
 		return err
 	}
-	if n.Eth, err = eth.New(n.Node, n.EthConfig); err != nil {
-		return fmt.Errorf("cannot create new eth: %w", err)
+	if n.EthConfig.SyncMode == downloader.LightSync {
+		if n.Les, err = les.New(n.Node, n.EthConfig); err != nil {
+			return fmt.Errorf("cannot create new light client: %w", err)
+		}
+	} else {
+		if n.Eth, err = eth.New(n.Node, n.EthConfig); err != nil {
+			return fmt.Errorf("cannot create new eth: %w", err)
+		}
+		if n.EthConfig.LightServ > 0 {
+			if _, err = les.NewLesServer(n.Node, n.Eth, n.EthConfig); err != nil {
+				return fmt.Errorf("cannot create new les server: %w", err)
+			}
+		}
+		acn.New(n.Node, n.Eth, ethconfig.Defaults.NetworkID)
 	}
-
-	acn.New(n.Node, n.Eth, ethconfig.Defaults.NetworkID)
 	if err = n.Node.Start(); err != nil {
 		return fmt.Errorf("failed to start a node: %w", err)
 	}
 	if n.WsClient, err = ethclient.Dial(n.WSEndpoint()); err != nil {
 		return err
 	}
-	if n.Nonce, err = n.WsClient.PendingNonceAt(context.Background(), n.Address); err != nil {
-		return err
+	// The pending nonce of a light client would be retrieved from the servers.
+	if n.Les == nil {
+		if n.Nonce, err = n.WsClient.PendingNonceAt(context.Background(), n.Address); err != nil {
+			return err
+		}
 	}
 
 	n.Interactor = Interact(n.HTTPEndpoint())
@@ -379,10 +395,17 @@ func (n *Node) TxFee(ctx context.Context, tx *types.Transaction) (*big.Int, erro
 }
 
 func (n *Node) GetChainHeight() uint64 {
+	if n.Les != nil {
+		return n.Les.BlockChain().CurrentHeader().Number.Uint64()
+	}
 	return n.Eth.BlockChain().CurrentHeader().Number.Uint64()
 }
 
 func (n *Node) IsSyncComplete() bool {
+	if n.Les != nil {
+		syncResult := n.Les.ApiBackend.SyncProgress()
+		return syncResult.CurrentBlock >= syncResult.HighestBlock
+	}
 	syncResult := n.Eth.APIBackend.SyncProgress()
 	return syncResult.CurrentBlock >= syncResult.HighestBlock
 }
@@ -680,51 +703,31 @@ type pipeManager struct {
 	nodes   sync.Map
 	network p2p.Network
 }
-type pipeDialer struct {
-	node    *Node
-	manager *pipeManager
-	count   uint64
-	fail    uint64
-}
 
 func newPipeManager(net p2p.Network) *pipeManager {
 	return &pipeManager{network: net}
 }
 
-func (pm *pipeManager) createPipeDialer(node *Node) *pipeDialer {
-	return &pipeDialer{
-		node:    node,
-		manager: pm,
-	}
+// createPipeDialer returns a dialer connecting to the nodes of the network using
+// in-memory net.Pipes
+func (pm *pipeManager) createPipeDialer() *p2p.PipeDialer {
+	return &p2p.PipeDialer{Server: pm.server}
 }
 
-// Dial implements the p2p.NodeDialer interface by connecting to the node using
-// an in-memory net.Pipe
-func (p *pipeDialer) Dial(_ context.Context, dest *enode.Node) (conn net.Conn, err error) {
-	p.count++
-	if p.node.ID == 0 {
-		fmt.Println("attempt", "cs", p.count, "f", p.fail, "type", p.manager.network)
-	}
-	n, ok := p.manager.nodes.Load(dest.ID())
+func (pm *pipeManager) server(dest *enode.Node) (*p2p.Server, error) {
+	n, ok := pm.nodes.Load(dest.ID())
 	if !ok || !n.(*Node).Running() {
 		// try again a bit later, the node may not have started yet
 		<-time.After(10 * time.Millisecond)
-		n, ok = p.manager.nodes.Load(dest.ID())
+		n, ok = pm.nodes.Load(dest.ID())
 		if !ok || !n.(*Node).Running() {
-			p.fail++
 			return nil, fmt.Errorf("node not running: %s", dest.ID())
 		}
 	}
-	pipe1, pipe2 := net.Pipe()
-	go func() {
-		switch p.manager.network {
-		case p2p.Execution:
-			n.(*Node).Node.ExecutionServer().SetupConn(pipe1, 4, nil)
-		case p2p.Consensus:
-			n.(*Node).Node.ConsensusServer().SetupConn(pipe1, 4, nil)
-		}
-	}()
-	return pipe2, nil
+	if pm.network == p2p.Consensus {
+		return n.(*Node).Node.ConsensusServer(), nil
+	}
+	return n.(*Node).Node.ExecutionServer(), nil
 }
 
 func NewInMemoryNetwork(t *testing.T, validators []*gengen.Validator, start bool, options ...gengen.GenesisOption) (Network, error) {
@@ -747,8 +750,8 @@ func NewInMemoryNetwork(t *testing.T, validators []*gengen.Validator, start bool
 				n.Config.WSPort = freeport.GetOne(t)
 			}
 			nodeID := enode.PubkeyToIDV4(&val.NodeKey.PublicKey)
-			n.Config.ConsensusP2P.Dialer = consensusManager.createPipeDialer(n)
-			n.Config.ExecutionP2P.Dialer = executionManager.createPipeDialer(n)
+			n.Config.ConsensusP2P.Dialer = consensusManager.createPipeDialer()
+			n.Config.ExecutionP2P.Dialer = executionManager.createPipeDialer()
 			executionManager.nodes.Store(nodeID, n)
 			consensusManager.nodes.Store(nodeID, n)
 			network[id] = n
@@ -1029,6 +1032,11 @@ func copyNodeConfig(source *node.Config) *node.Config {
 			InRate:           source.ConsensusP2P.InRate,
 			OutRate:          source.ConsensusP2P.OutRate,
 		},
+		CommitteePreconnect:   source.CommitteePreconnect,
+		ConsensusSentries:     source.ConsensusSentries,
+		ConsensusSentryOf:     source.ConsensusSentryOf,
+		ConsensusWAL:          source.ConsensusWAL,
+		ConsensusWALHeights:   source.ConsensusWALHeights,
 		KeyStoreDir:           source.KeyStoreDir,
 		ExternalSigner:        source.ExternalSigner,
 		UseLightweightKDF:     source.UseLightweightKDF,
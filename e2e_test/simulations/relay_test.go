@@ -0,0 +1,107 @@
+package simulations
+
+import (
+	"math/big"
+	"math/rand"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/autonity/autonity/autonity"
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/consensus/tendermint/accountability"
+	"github.com/autonity/autonity/consensus/tendermint/backend"
+	"github.com/autonity/autonity/consensus/tendermint/core/message"
+	"github.com/autonity/autonity/core"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/crypto/blst"
+	e2e "github.com/autonity/autonity/e2e_test"
+	"github.com/autonity/autonity/p2p/topology"
+	"github.com/autonity/autonity/params"
+)
+
+// the committee is large enough to be relayed, each validator is connected to and gossips to its graph neighbours only
+func TestRelayGossip(t *testing.T) {
+	vals, err := e2e.Validators(t, 10, "10e18,v,100,127.0.0.1:%s,%s,%s,%s")
+	require.NoError(t, err)
+
+	relay := func(genesis *core.Genesis) {
+		genesis.Config.ConsensusGossip = &params.ConsensusGossipConfig{Mode: params.RelayGossipMode, MinCommitteeSize: 4}
+	}
+	network, err := e2e.NewInMemoryNetwork(t, vals, true, relay)
+	require.NoError(t, err)
+	defer network.Shutdown(t)
+
+	// the relays add hops, the network should be able to mine blocks nonetheless
+	err = network.WaitToMineNBlocks(10, 90, false)
+	require.NoError(t, err)
+	for _, n := range network {
+		require.Less(t, n.ConsensusServer().PeerCount(), len(vals)-1)
+	}
+}
+
+// in relay mode, an off-chain accusation against a member which is not a neighbour in the committee graph is resolved
+// over a direct connection, instead of being escalated on-chain.
+func TestRelayOffChainAccusation(t *testing.T) {
+	vals, err := e2e.Validators(t, 10, "10e18,v,100,127.0.0.1:%s,%s,%s,%s")
+	require.NoError(t, err)
+
+	relay := func(genesis *core.Genesis) {
+		genesis.Config.ConsensusGossip = &params.ConsensusGossipConfig{Mode: params.RelayGossipMode, MinCommitteeSize: 4}
+	}
+	network, err := e2e.NewInMemoryNetwork(t, vals, true, relay)
+	require.NoError(t, err)
+	defer network.Shutdown(t)
+	require.NoError(t, network.WaitToMineNBlocks(5, 90, false))
+
+	accuser := network[0]
+	height := accuser.Eth.BlockChain().CurrentBlock().NumberU64()
+	committee, err := accuser.Eth.BlockChain().CommitteeOfHeight(height)
+	require.NoError(t, err)
+	self := slices.IndexFunc(committee.Members, func(m types.CommitteeMember) bool { return m.Address == accuser.Address })
+	neighbours := topology.Adjacent(self, committee.Len())
+	var suspect *e2e.Node
+	for i, member := range committee.Members {
+		if i == self || slices.Contains(neighbours, i) {
+			continue
+		}
+		for _, n := range network {
+			if n.Address == member.Address {
+				suspect = n
+			}
+		}
+		break
+	}
+	require.NotNil(t, suspect, "every member is a neighbour")
+
+	// the suspect prevotes at a later round for a value the accuser never received the proposal of, which raises a PVN
+	// accusation once the height is scanned. The suspect holds the proposal to prove its innocence.
+	member := committee.MemberByAddress(suspect.Address)
+	sign := func(hash common.Hash) blst.Signature {
+		return suspect.ConsensusKey.Sign(hash.Bytes())
+	}
+	const round = 5
+	block := types.NewBlockWithHeader(&types.Header{Number: new(big.Int).SetUint64(height), Nonce: types.EncodeNonce(rand.Uint64())})
+	proposal := message.NewPropose(round, height, -1, block, sign, member)
+	prevote := message.NewPrevote(round, height, block.Hash(), sign, member, committee.Len())
+	accuser.Eth.Engine().(*backend.Backend).MsgStore.Save(prevote)
+	suspectStore := suspect.Eth.Engine().(*backend.Backend).MsgStore
+	suspectStore.Save(proposal)
+	suspectStore.Save(prevote)
+
+	// the accusation is raised, then withdrawn once the proof of innocence is received
+	fd := accuser.Eth.FD()
+	require.NoError(t, network.WaitForHeight(height+accountability.DeltaBlocks, 60))
+	require.Eventually(t, func() bool { return len(fd.OffChainAccusations()) > 0 }, 30*time.Second, 10*time.Millisecond)
+	require.Eventually(t, func() bool { return len(fd.OffChainAccusations()) == 0 }, 30*time.Second, 100*time.Millisecond)
+
+	// the accusation was not escalated instead
+	require.NoError(t, network.WaitToMineNBlocks(2, 30, false))
+	for _, event := range fd.PendingEvents() {
+		require.NotEqual(t, suspect.Address, event.Offender, "the accusation is escalated on-chain")
+	}
+	err = e2e.AccountabilityEventDetected(t, suspect.Address, autonity.Accusation, autonity.PVN, network)
+	require.ErrorIs(t, err, e2e.ErrAccountabilityEventMissing)
+}
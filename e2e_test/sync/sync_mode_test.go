@@ -2,8 +2,15 @@ package sync
 
 import (
 	"context"
+	"path/filepath"
+	"sync"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/core"
+	"github.com/autonity/autonity/core/rawdb"
 	e2e "github.com/autonity/autonity/e2e_test"
 	"github.com/autonity/autonity/eth/downloader"
+	"github.com/autonity/autonity/log"
 	"github.com/stretchr/testify/require"
 	"testing"
 	"time"
@@ -50,3 +57,186 @@ func testSyncMode(t *testing.T, mode downloader.SyncMode) {
 	err = syncNode.Close(true)
 	require.NoError(t, err)
 }
+
+func TestEpochSyncMode(t *testing.T) {
+	// short epochs, so that the epoch sync goes through several epochs before filling them.
+	validators, err := e2e.Validators(t, 4, "10e18,v,1,0.0.0.0:%s,%s,%s,%s")
+	require.NoError(t, err)
+	network, err := e2e.NewNetworkFromValidators(t, validators, true, func(genesis *core.Genesis) {
+		genesis.Config.AutonityContractConfig.EpochPeriod = 10
+	})
+	require.NoError(t, err)
+	defer network.Shutdown(t)
+
+	_ = network.WaitToMineNBlocks(100, 100, false)
+
+	identities, err := e2e.Validators(t, 1, "10e18,v,10000,0.0.0.0:%s,%s,%s,%s")
+	require.NoError(t, err)
+	syncNode, err := e2e.NewNoneValidatorNode(identities[0], network[0].EthConfig.Genesis, len(network), downloader.SnapSync)
+	require.NoError(t, err)
+	syncNode.EthConfig.EpochSync = true
+	err = syncNode.Start()
+	require.NoError(t, err)
+
+	_ = network.WaitToMineNBlocks(60, 100, false)
+	require.True(t, syncNode.IsSyncComplete())
+	require.Greater(t, syncNode.GetChainHeight(), uint64(100))
+	// the epoch headers retrieved by the epoch sync are the ones of the network
+	for _, number := range []uint64{10, 50, 100} {
+		require.Equal(t, network[0].Eth.BlockChain().GetHeaderByNumber(number).Hash(), syncNode.Eth.BlockChain().GetHeaderByNumber(number).Hash())
+	}
+	epoch, err := syncNode.Eth.BlockChain().LatestEpoch()
+	require.NoError(t, err)
+	require.Greater(t, epoch.EpochBlock.Uint64(), uint64(100))
+
+	err = syncNode.Close(true)
+	require.NoError(t, err)
+}
+
+func TestCheckpointSync(t *testing.T) {
+	validators, err := e2e.Validators(t, 4, "10e18,v,1,0.0.0.0:%s,%s,%s,%s")
+	require.NoError(t, err)
+	network, err := e2e.NewNetworkFromValidators(t, validators, true, func(genesis *core.Genesis) {
+		genesis.Config.AutonityContractConfig.EpochPeriod = 10
+	})
+	require.NoError(t, err)
+	defer network.Shutdown(t)
+
+	_ = network.WaitToMineNBlocks(100, 100, false)
+
+	// the epoch header of block 30 is signed by the committee of the epoch it closes.
+	chain := network[0].Eth.BlockChain()
+	checkpoint := chain.GetHeaderByNumber(30)
+	require.True(t, checkpoint.IsEpochHeader())
+	epoch, err := chain.EpochOfHeight(30)
+	require.NoError(t, err)
+
+	identities, err := e2e.Validators(t, 1, "10e18,v,10000,0.0.0.0:%s,%s,%s,%s")
+	require.NoError(t, err)
+	syncNode, err := e2e.NewNoneValidatorNode(identities[0], network[0].EthConfig.Genesis, len(network), downloader.SnapSync)
+	require.NoError(t, err)
+	syncNode.EthConfig.EpochCheckpoint = &core.EpochCheckpoint{Hash: checkpoint.Hash(), Committee: epoch.Committee.Copy()}
+	// an on-disk database, so that the chain goes through the freezer.
+	syncNode.Config.DataDir = t.TempDir()
+
+	// the freezer and the transaction indexer must not go through the history before the checkpoint.
+	var (
+		historyErrorsLock sync.Mutex
+		historyErrors     []string
+	)
+	rootHandler := log.Root().GetHandler()
+	log.Root().SetHandler(log.MultiHandler(rootHandler, log.FuncHandler(func(r *log.Record) error {
+		switch r.Msg {
+		case "Error in block freeze operation", "Failed to reset the ancient tail", "Failed to decode block body":
+			historyErrorsLock.Lock()
+			historyErrors = append(historyErrors, r.Msg)
+			historyErrorsLock.Unlock()
+		}
+		return nil
+	})))
+	defer log.Root().SetHandler(rootHandler)
+
+	err = syncNode.Start()
+	require.NoError(t, err)
+
+	_ = network.WaitToMineNBlocks(60, 100, false)
+	require.True(t, syncNode.IsSyncComplete())
+	require.Greater(t, syncNode.GetChainHeight(), uint64(100))
+	syncChain := syncNode.Eth.BlockChain()
+	require.Equal(t, checkpoint.Hash(), syncChain.GetHeaderByNumber(30).Hash())
+	// the history before the checkpoint is never retrieved.
+	require.Nil(t, syncChain.GetHeaderByNumber(10))
+
+	latest, err := syncChain.LatestEpoch()
+	require.NoError(t, err)
+	require.Greater(t, latest.EpochBlock.Uint64(), uint64(100))
+	committee, err := syncChain.CommitteeOfHeight(syncChain.CurrentHeader().Number.Uint64())
+	require.NoError(t, err)
+	require.Equal(t, latest.Committee.Len(), committee.Len())
+
+	// the ancient store and the transaction index start from the block following the checkpoint.
+	require.Equal(t, uint64(31), *rawdb.ReadHistoryTail(syncNode.Eth.ChainDb()))
+	require.Equal(t, uint64(31), *rawdb.ReadTxIndexTail(syncNode.Eth.ChainDb()))
+	err = syncNode.Close(false)
+	require.NoError(t, err)
+
+	path := syncNode.Config.ResolvePath("chaindata")
+	db, err := rawdb.NewLevelDBDatabaseWithFreezer(path, 16, 16, filepath.Join(path, "ancient"), "", false)
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, db.(interface{ Freeze(threshold uint64) error }).Freeze(16))
+	frozen, err := db.Ancients()
+	require.NoError(t, err)
+	require.Greater(t, frozen, uint64(31))
+	for _, number := range []uint64{31, frozen - 1} {
+		hash := rawdb.ReadCanonicalHash(db, number)
+		require.Equal(t, chain.GetHeaderByNumber(number).Hash(), hash)
+		require.NotNil(t, rawdb.ReadBlock(db, hash, number))
+	}
+	require.Equal(t, checkpoint.Hash(), rawdb.ReadCanonicalHash(db, 30))
+	require.Equal(t, common.Hash{}, rawdb.ReadCanonicalHash(db, 10))
+	historyErrorsLock.Lock()
+	require.Empty(t, historyErrors)
+	historyErrorsLock.Unlock()
+}
+
+func TestLightSyncMode(t *testing.T) {
+	t.Run("header by header", func(t *testing.T) {
+		testLightSyncMode(t, false)
+	})
+	t.Run("epoch sync", func(t *testing.T) {
+		testLightSyncMode(t, true)
+	})
+}
+
+func testLightSyncMode(t *testing.T, epochSync bool) {
+	// short epochs, so that the light client goes through several epochs.
+	validators, err := e2e.Validators(t, 4, "10e18,v,1,0.0.0.0:%s,%s,%s,%s")
+	require.NoError(t, err)
+	network, err := e2e.NewNetworkFromValidators(t, validators, false, func(genesis *core.Genesis) {
+		genesis.Config.AutonityContractConfig.EpochPeriod = 10
+	})
+	require.NoError(t, err)
+	defer network.Shutdown(t)
+	for _, n := range network {
+		n.EthConfig.LightServ = 100
+		n.EthConfig.LightPeers = 10
+		require.NoError(t, n.Start())
+	}
+
+	_ = network.WaitToMineNBlocks(60, 100, false)
+
+	identities, err := e2e.Validators(t, 1, "10e18,v,10000,0.0.0.0:%s,%s,%s,%s")
+	require.NoError(t, err)
+	lightNode, err := e2e.NewNoneValidatorNode(identities[0], network[0].EthConfig.Genesis, len(network), downloader.LightSync)
+	require.NoError(t, err)
+	lightNode.EthConfig.EpochSync = epochSync
+	err = lightNode.Start()
+	require.NoError(t, err)
+
+	_ = network.WaitToMineNBlocks(30, 100, false)
+	require.True(t, lightNode.IsSyncComplete())
+	require.Greater(t, lightNode.GetChainHeight(), uint64(60))
+
+	// the epoch headers of the light client are the ones of the network
+	chain := network[0].Eth.BlockChain()
+	lightChain := lightNode.Les.BlockChain()
+	for _, number := range []uint64{10, 30, 50} {
+		require.Equal(t, chain.GetHeaderByNumber(number).Hash(), lightChain.GetHeaderByNumber(number).Hash())
+	}
+	head := lightChain.CurrentHeader()
+	require.Equal(t, chain.GetHeaderByNumber(head.Number.Uint64()).Hash(), head.Hash())
+	if epochSync {
+		// the headers between the epoch headers are skipped
+		require.Nil(t, lightChain.GetHeaderByNumber(15))
+	} else {
+		require.Equal(t, chain.GetHeaderByNumber(15).Hash(), lightChain.GetHeaderByNumber(15).Hash())
+	}
+	epoch, err := lightChain.EpochOfHeight(head.Number.Uint64())
+	require.NoError(t, err)
+	require.Greater(t, epoch.EpochBlock.Uint64(), uint64(50))
+	require.Equal(t, chain.GetHeaderByNumber(epoch.EpochBlock.Uint64()).Hash(), lightChain.GetHeaderByNumber(epoch.EpochBlock.Uint64()).Hash())
+
+	err = lightNode.Close(true)
+	require.NoError(t, err)
+}
@@ -31,6 +31,7 @@ import (
 
 	"github.com/autonity/autonity/log"
 
+	"github.com/autonity/autonity/accounts/abi"
 	"github.com/autonity/autonity/autonity"
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/common/hexutil"
@@ -38,7 +39,10 @@ import (
 	"github.com/autonity/autonity/core/rawdb"
 	"github.com/autonity/autonity/core/state"
 	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/core/vm"
 	"github.com/autonity/autonity/internal/ethapi"
+	"github.com/autonity/autonity/params"
+	"github.com/autonity/autonity/params/generated"
 	"github.com/autonity/autonity/rlp"
 	"github.com/autonity/autonity/rpc"
 	"github.com/autonity/autonity/trie"
@@ -606,33 +610,54 @@ func (api *PrivateDebugAPI) GetAccessibleState(from, to rpc.BlockNumber) (uint64
 	return 0, fmt.Errorf("No state found")
 }
 
-// AutonityContractAPI implements rpc.Methods to expose view functions of the
-// autonity contract through the rpc api. Note, although it looks like this
+// ContractStateBackend resolves the state and header a protocol contract view
+// function is executed against.
+type ContractStateBackend interface {
+	StateAndHeaderByNumberOrHash(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (*state.StateDB, *types.Header, error)
+}
+
+// ContractCaller executes a packed call against the contract deployed at
+// contractAddress using the given state and header.
+type ContractCaller func(statedb vm.StateDB, header *types.Header, contractAddress common.Address, packedArgs []byte) ([]byte, error)
+
+// ProtocolContractAPI implements rpc.Methods to expose view functions of a
+// protocol contract through the rpc api. Note, although it looks like this
 // struct would be better defined in the rpc package or in the autonity
 // package, circular dependencies make it infeasible.
-type AutonityContractAPI struct {
+type ProtocolContractAPI struct {
 	calls map[string]reflect.Value
 }
 
-// NewAutonityContractAPI builds a map of function name to method representing
-// the view functions of the autonity contract, the map is then used as the
-// return value for AllMethods. The methods are dynamically generated from the
-// autonity contract ABI and assume that they are called with an instance of
-// AutonityContractAPI as their method receiver, even though the functions
-// themselves make no use of the method receiver. This design is required to be
-// able to fit into the current approach taken for registering rpc services.
-// See rpc.Server.RegisterName().
-func NewAutonityContractAPI(bc *core.BlockChain, ac *autonity.ProtocolContracts) *AutonityContractAPI {
-	var contractABI = ac.ABI()
+// NewAutonityContractAPI builds the rpc api of the autonity contract using
+// the ABI currently in use by the protocol contracts.
+func NewAutonityContractAPI(backend ContractStateBackend, ac *autonity.ProtocolContracts) *ProtocolContractAPI {
+	return NewProtocolContractAPI(backend, ac.ABI(), params.AutonityContractAddress, protocolContractCaller(ac))
+}
+
+// NewProtocolContractAPI builds a map of function name to method representing
+// the view functions of the contract deployed at contractAddress, the map is
+// then used as the return value for AllMethods. The methods are dynamically
+// generated from the contract ABI and assume that they are called with an
+// instance of ProtocolContractAPI as their method receiver, even though the
+// functions themselves make no use of the method receiver. This design is
+// required to be able to fit into the current approach taken for registering
+// rpc services. See rpc.Server.RegisterName().
+//
+// Every generated method accepts an optional trailing rpc.BlockNumberOrHash
+// argument selecting the state the view function is executed against. The
+// latest block is used when it is omitted.
+func NewProtocolContractAPI(backend ContractStateBackend, contractABI *abi.ABI, contractAddress common.Address, caller ContractCaller) *ProtocolContractAPI {
 	var contractViewMethods = make(map[string]reflect.Value)
 
 	for n, m := range contractABI.Methods {
 		functionName := n
 		// Only expose read-only functions.
 		if m.IsConstant() {
-			// The RPC service expect the first argument of an API method to be the receiver object.
-			inArgs := []reflect.Type{reflect.TypeOf(&AutonityContractAPI{})}
+			// The RPC service expect the first argument of an API method to be the receiver object,
+			// followed by the request context. The block selector is always the last argument.
+			inArgs := []reflect.Type{reflect.TypeOf(&ProtocolContractAPI{}), reflect.TypeOf((*context.Context)(nil)).Elem()}
 			inArgs = append(inArgs, m.Inputs.Types()...)
+			inArgs = append(inArgs, reflect.TypeOf(&rpc.BlockNumberOrHash{}))
 			sig := reflect.FuncOf(inArgs, []reflect.Type{
 				reflect.TypeOf((*interface{})(nil)).Elem(),
 				reflect.TypeOf((*error)(nil)).Elem(),
@@ -644,16 +669,24 @@ func NewAutonityContractAPI(bc *core.BlockChain, ac *autonity.ProtocolContracts)
 					makereturn := func(res interface{}, err error) []reflect.Value {
 						return []reflect.Value{reflect.ValueOf(&res).Elem(), reflect.ValueOf(&err).Elem()}
 					}
-					stateDB, err := bc.State()
+					// args[0] is the reflect.Value of *ProtocolContractAPI and args[1] the context.
+					ctx := args[1].Interface().(context.Context)
+					blockNrOrHash := rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+					if last := args[len(args)-1]; !last.IsNil() {
+						blockNrOrHash = *last.Interface().(*rpc.BlockNumberOrHash)
+					}
+					stateDB, header, err := backend.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
 					if err != nil {
 						return makereturn(nil, err)
 					}
+					if stateDB == nil || header == nil {
+						return makereturn(nil, errors.New("state not found"))
+					}
 					var iargs []interface{}
-					// args[0] is the reflect.Value of *AutonityContractAPI.
-					for i, arg := range args[1:] {
+					for i, arg := range args[2 : len(args)-1] {
 						// If the argument is a pointer it is then an optional parameter for the RPC handler. The
 						// json unmarshalling function set it to nil if the argument isn't set in the RPC call.
-						// There are no optional parameters for the Autonity contract methods. Solidity doesn't
+						// There are no optional parameters for the protocol contract methods. Solidity doesn't
 						// even support them and the packing function will crash if nil is passed.
 						if arg.Kind() == reflect.Ptr && arg.IsNil() {
 							return makereturn(nil, fmt.Errorf("missing value for required argument %d", i))
@@ -666,7 +699,7 @@ func NewAutonityContractAPI(bc *core.BlockChain, ac *autonity.ProtocolContracts)
 					if err != nil {
 						return makereturn(nil, err)
 					}
-					packedResult, err := ac.CallContractFunc(stateDB, bc.CurrentHeader(), packedArgs)
+					packedResult, err := caller(stateDB, header, contractAddress, packedArgs)
 					if err != nil {
 						return makereturn(nil, err)
 					}
@@ -680,9 +713,53 @@ func NewAutonityContractAPI(bc *core.BlockChain, ac *autonity.ProtocolContracts)
 				})
 		}
 	}
-	return &AutonityContractAPI{calls: contractViewMethods}
+	return &ProtocolContractAPI{calls: contractViewMethods}
 }
 
-func (a *AutonityContractAPI) AllMethods() map[string]reflect.Value {
+func (a *ProtocolContractAPI) AllMethods() map[string]reflect.Value {
 	return a.calls
 }
+
+// protocolContractCaller executes view calls through the protocol contracts
+// EVM provider.
+func protocolContractCaller(ac *autonity.ProtocolContracts) ContractCaller {
+	return func(statedb vm.StateDB, header *types.Header, contractAddress common.Address, packedArgs []byte) ([]byte, error) {
+		packedResult, _, err := ac.EVMContract.CallContractFunc(statedb, header, contractAddress, packedArgs)
+		return packedResult, err
+	}
+}
+
+// protocolContractAPIs returns the rpc services exposing the view functions
+// of every protocol contract, each under its own namespace.
+func protocolContractAPIs(backend ContractStateBackend, ac *autonity.ProtocolContracts) []rpc.API {
+	caller := protocolContractCaller(ac)
+	contracts := []struct {
+		namespace string
+		abi       *abi.ABI
+		address   common.Address
+	}{
+		{"accountability", &generated.AccountabilityAbi, params.AccountabilityContractAddress},
+		{"oracle", &generated.OracleAbi, params.OracleContractAddress},
+		{"acu", &generated.ACUAbi, params.ACUContractAddress},
+		{"supplycontrol", &generated.SupplyControlAbi, params.SupplyControlContractAddress},
+		{"stabilization", &generated.StabilizationAbi, params.StabilizationContractAddress},
+		{"inflationcontroller", &generated.InflationControllerAbi, params.InflationControllerContractAddress},
+		{"stakablevesting", &generated.StakableVestingAbi, params.StakableVestingContractAddress},
+		{"nonstakablevesting", &generated.NonStakableVestingAbi, params.NonStakableVestingContractAddress},
+	}
+	apis := []rpc.API{{
+		Namespace: "aut",
+		Version:   params.Version,
+		Service:   NewAutonityContractAPI(backend, ac),
+		Public:    true,
+	}}
+	for _, c := range contracts {
+		apis = append(apis, rpc.API{
+			Namespace: c.namespace,
+			Version:   params.Version,
+			Service:   NewProtocolContractAPI(backend, c.abi, c.address, caller),
+			Public:    true,
+		})
+	}
+	return apis
+}
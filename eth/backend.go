@@ -33,8 +33,12 @@ import (
 	"github.com/autonity/autonity/common/hexutil"
 	"github.com/autonity/autonity/consensus"
 	"github.com/autonity/autonity/consensus/tendermint/accountability"
+	"github.com/autonity/autonity/consensus/tendermint/alerts"
 	tendermintcore "github.com/autonity/autonity/consensus/tendermint/core"
+	tdmcommittee "github.com/autonity/autonity/consensus/tendermint/core/committee"
 	"github.com/autonity/autonity/consensus/tendermint/events"
+	"github.com/autonity/autonity/consensus/tendermint/finality"
+	"github.com/autonity/autonity/consensus/tendermint/participation"
 	"github.com/autonity/autonity/core"
 	"github.com/autonity/autonity/core/bloombits"
 	"github.com/autonity/autonity/core/rawdb"
@@ -110,6 +114,8 @@ type Ethereum struct {
 	shutdownTracker *shutdowncheck.ShutdownTracker // Tracks if and when the node has shutdown ungracefully
 
 	accountability *accountability.FaultDetector
+	participation  *participation.Tracker
+	alerts         *alerts.Notifier
 }
 
 // New creates a new Ethereum object (including the
@@ -123,6 +129,16 @@ func New(stack *node.Node, config *Config) (*Ethereum, error) {
 	if !config.SyncMode.IsValid() {
 		return nil, fmt.Errorf("invalid sync mode %d", config.SyncMode)
 	}
+	if checkpoint := config.EpochCheckpoint; checkpoint != nil {
+		if config.SyncMode != downloader.SnapSync {
+			return nil, errors.New("an epoch checkpoint can only be synced from in snap sync mode")
+		}
+		if checkpoint.Header != nil {
+			if err := finality.VerifyQuorumCertificate(checkpoint.Header, checkpoint.Committee); err != nil {
+				return nil, fmt.Errorf("invalid epoch checkpoint: %w", err)
+			}
+		}
+	}
 	if config.Miner.GasPrice == nil || config.Miner.GasPrice.Cmp(common.Big0) <= 0 {
 		stack.Logger().Warn("Sanitizing invalid miner gas price", "provided", config.Miner.GasPrice, "updated", ethconfig.Defaults.Miner.GasPrice)
 		config.Miner.GasPrice = new(big.Int).Set(ethconfig.Defaults.Miner.GasPrice)
@@ -168,6 +184,9 @@ func New(stack *node.Node, config *Config) (*Ethereum, error) {
 		}
 	)
 	stack.Logger().Info("Initialised chain configuration", "config", chainConfig)
+	if err := tdmcommittee.CheckConfig(chainConfig); err != nil {
+		return nil, err
+	}
 
 	if err := pruner.RecoverPruning(stack.ResolvePath(""), chainDb, stack.ResolvePath(config.TrieCleanCacheJournal)); err != nil {
 		stack.Logger().Error("Failed to recover state", "error", err)
@@ -246,6 +265,11 @@ func New(stack *node.Node, config *Config) (*Ethereum, error) {
 		config.TxPool.Journal = stack.ResolvePath(config.TxPool.Journal)
 	}
 	eth.txPool = core.NewTxPool(config.TxPool, chainConfig, eth.blockchain, senderCacher)
+	if be, ok := consensusEngine.(interface {
+		SetTxPool(backend.TxPool)
+	}); ok {
+		be.SetTxPool(eth.txPool)
+	}
 	*txSender = eth.txPool.AddLocal
 	// Permit the downloader to use the trie cache allowance during fast sync
 	cacheLimit := cacheConfig.TrieCleanLimit + cacheConfig.TrieDirtyLimit + cacheConfig.SnapshotLimit
@@ -259,10 +283,13 @@ func New(stack *node.Node, config *Config) (*Ethereum, error) {
 		TxPool:         eth.txPool,
 		Network:        config.NetworkID,
 		Sync:           config.SyncMode,
+		EpochSync:      config.EpochSync,
 		BloomCache:     uint64(cacheLimit),
 		EventMux:       eth.eventMux,
 		Checkpoint:     checkpoint,
 		RequiredBlocks: config.RequiredBlocks,
+
+		EpochCheckpoint: config.EpochCheckpoint,
 	}); err != nil {
 		return nil, err
 	}
@@ -292,6 +319,22 @@ func New(stack *node.Node, config *Config) (*Ethereum, error) {
 		msgStore, eth.txPool, eth.APIBackend, nodeKey,
 		eth.blockchain.ProtocolContracts(),
 		eth.log)
+	eth.participation = participation.NewTracker(eth.blockchain, chainDb, eth.log)
+
+	// Deliver the alerts concerning the local validator to the sinks of the node configuration.
+	alertsConfig := stack.Config().Alerts
+	files := make([]string, len(alertsConfig.Files))
+	for i, file := range alertsConfig.Files {
+		files[i] = stack.ResolvePath(file)
+	}
+	alertsConfig.Files = files
+	eth.alerts = alerts.New(alertsConfig.Sinks(), eth.log)
+	eth.accountability.SetAlerts(eth.alerts)
+	if be, ok := consensusEngine.(interface {
+		SetAlerts(*alerts.Notifier)
+	}); ok {
+		be.SetAlerts(eth.alerts)
+	}
 
 	// Setup DNS discovery iterators.
 	dnsclient := dnsdisc.NewClient(dnsdisc.Config{})
@@ -344,10 +387,16 @@ func (s *Ethereum) APIs() []rpc.API {
 	apis = append(apis, s.engine.APIs(s.BlockChain())...)
 
 	if _, ok := s.engine.(consensus.BFT); ok {
+		apis = append(apis, protocolContractAPIs(s.APIBackend, s.BlockChain().ProtocolContracts())...)
 		apis = append(apis, rpc.API{
-			Namespace: "aut",
-			Version:   params.Version,
-			Service:   NewAutonityContractAPI(s.BlockChain(), s.BlockChain().ProtocolContracts()),
+			Namespace: "accountability",
+			Version:   "1.0",
+			Service:   accountability.NewAPI(s.accountability),
+			Public:    true,
+		}, rpc.API{
+			Namespace: "tendermint",
+			Version:   "1.0",
+			Service:   participation.NewAPI(s.participation),
 			Public:    true,
 		})
 	}
@@ -560,6 +609,7 @@ func (s *Ethereum) Start() error {
 	case *backend.Backend:
 		s.log.Info("starting sub modules for Tendermint BFT engine")
 		go s.accountability.Start()
+		s.participation.Start()
 		go func() {
 			header := s.blockchain.CurrentHeader()
 			if header.Number.BitLen() == 0 && header.Time > uint64(time.Now().Unix()) {
@@ -677,7 +727,9 @@ func (s *Ethereum) validatorController() {
 func (s *Ethereum) Stop() error {
 	// Stop AFD first,
 	s.accountability.Stop()
+	s.participation.Stop()
 	s.engine.Close()
+	s.alerts.Close()
 	// Stop all the peer-related stuff then.
 	s.ethDialCandidates.Close()
 	s.snapDialCandidates.Close()
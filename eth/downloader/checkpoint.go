@@ -0,0 +1,75 @@
+package downloader
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/autonity/autonity/consensus/tendermint/finality"
+	"github.com/autonity/autonity/core"
+	"github.com/autonity/autonity/core/types"
+)
+
+// errCheckpointPivot is returned if the epoch checkpoint is not below the pivot of the snap sync, the state of the
+// chain being only available from the pivot.
+var errCheckpointPivot = errors.New("epoch checkpoint not below the snap sync pivot")
+
+// checkpointOrigin returns the origin of a snap sync starting from the epoch checkpoint instead of the genesis block.
+// If the local chain does not go past the genesis block, the header of the checkpoint, retrieved from the peer if it
+// was only configured by hash, is verified against the trusted committee and inserted into the local chain. The
+// origin is left as is if the local chain was synced without the checkpoint.
+func (d *Downloader) checkpointOrigin(p *peerConnection, origin uint64, pivot *types.Header) (uint64, error) {
+	checkpoint := d.epochCheckpoint
+	header := d.lightchain.GetHeaderByHash(checkpoint.Hash)
+	inserted := header != nil
+	if !inserted {
+		if origin != 0 {
+			return origin, nil
+		}
+		if header = checkpoint.Header; header == nil {
+			p.log.Debug("Fetching epoch checkpoint header", "hash", checkpoint.Hash)
+			headers, _, err := d.fetchHeadersByHash(p, checkpoint.Hash, 1, 0, false)
+			if err != nil {
+				if errors.Is(err, errCanceled) {
+					return 0, err
+				}
+				return 0, fmt.Errorf("%w: checkpoint header request failed: %v", errBadPeer, err)
+			}
+			if len(headers) != 1 {
+				return 0, fmt.Errorf("%w: withheld checkpoint header %s", errStallingPeer, checkpoint.Hash)
+			}
+			header = headers[0]
+		}
+		if err := verifyEpochCheckpoint(checkpoint, header); err != nil {
+			return 0, fmt.Errorf("%w: %v", errInvalidChain, err)
+		}
+	}
+	number := header.Number.Uint64()
+	if origin >= number {
+		return origin, nil
+	}
+	if number >= pivot.Number.Uint64() {
+		return 0, fmt.Errorf("%w: checkpoint %d, pivot %d", errCheckpointPivot, number, pivot.Number.Uint64())
+	}
+	if !inserted {
+		if err := d.blockchain.InsertEpochCheckpoint(header); err != nil {
+			return 0, err
+		}
+	}
+	p.log.Debug("Syncing from epoch checkpoint", "number", number, "hash", checkpoint.Hash)
+	return number, nil
+}
+
+// verifyEpochCheckpoint checks that the header is the epoch header of the checkpoint and that its quorum certificate
+// was signed by the trusted committee.
+func verifyEpochCheckpoint(checkpoint *core.EpochCheckpoint, header *types.Header) error {
+	if header.Hash() != checkpoint.Hash {
+		return fmt.Errorf("checkpoint header hash mismatch: have %s, want %s", header.Hash(), checkpoint.Hash)
+	}
+	if !header.IsEpochHeader() {
+		return core.ErrCheckpointNotEpochHeader
+	}
+	if err := finality.VerifyQuorumCertificate(header, checkpoint.Committee); err != nil {
+		return fmt.Errorf("checkpoint quorum certificate: %w", err)
+	}
+	return nil
+}
@@ -27,6 +27,7 @@ import (
 
 	"github.com/autonity/autonity"
 	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/core"
 	"github.com/autonity/autonity/core/rawdb"
 	"github.com/autonity/autonity/core/state/snapshot"
 	"github.com/autonity/autonity/core/types"
@@ -87,18 +88,22 @@ type peerDropFn func(id string)
 // headerTask is a set of downloaded headers to queue along with their precomputed
 // hashes to avoid constant rehashing.
 type headerTask struct {
-	headers []*types.Header
-	hashes  []common.Hash
+	headers  []*types.Header
+	hashes   []common.Hash
+	verified bool // Whether the quorum certificates of the headers were already verified by the epoch sync
 }
 
 type Downloader struct {
 	mode uint32         // Synchronisation mode defining the strategy used (per sync cycle), use d.getMode() to get the SyncMode
 	mux  *event.TypeMux // Event multiplexer to announce sync operation events
 
-	checkpoint uint64   // Checkpoint block number to enforce head against (e.g. snap sync)
-	genesis    uint64   // Genesis block number to limit sync to (e.g. light client CHT)
-	queue      *queue   // Scheduler for selecting the hashes to download
-	peers      *peerSet // Set of active peers from which download can proceed
+	checkpoint uint64 // Checkpoint block number to enforce head against (e.g. snap sync)
+	epochSync  bool   // Whether to retrieve the headers epoch by epoch, verifying the epoch headers first
+
+	epochCheckpoint *core.EpochCheckpoint // Finalized epoch header to snap sync from instead of genesis (nil = disabled)
+	genesis         uint64                // Genesis block number to limit sync to (e.g. light client CHT)
+	queue           *queue                // Scheduler for selecting the hashes to download
+	peers           *peerSet              // Set of active peers from which download can proceed
 
 	stateDB ethdb.Database // Database to state sync into (and deduplicate via)
 
@@ -198,26 +203,31 @@ type BlockChain interface {
 
 	// Snapshots returns the blockchain snapshot tree to paused it during sync.
 	Snapshots() *snapshot.Tree
+
+	// InsertEpochCheckpoint inserts a verified epoch header as the head header of an empty chain.
+	InsertEpochCheckpoint(*types.Header) error
 }
 
 // New creates a new downloader to fetch hashes and blocks from remote peers.
-func New(checkpoint uint64, stateDb ethdb.Database, mux *event.TypeMux, chain BlockChain, lightchain LightChain, dropPeer peerDropFn) *Downloader {
+func New(checkpoint uint64, epochSync bool, epochCheckpoint *core.EpochCheckpoint, stateDb ethdb.Database, mux *event.TypeMux, chain BlockChain, lightchain LightChain, dropPeer peerDropFn) *Downloader {
 	if lightchain == nil {
 		lightchain = chain
 	}
 	dl := &Downloader{
-		stateDB:        stateDb,
-		mux:            mux,
-		checkpoint:     checkpoint,
-		queue:          newQueue(blockCacheMaxItems, blockCacheInitialItems),
-		peers:          newPeerSet(),
-		blockchain:     chain,
-		lightchain:     lightchain,
-		dropPeer:       dropPeer,
-		headerProcCh:   make(chan *headerTask, 1),
-		quitCh:         make(chan struct{}),
-		SnapSyncer:     snap.NewSyncer(stateDb),
-		stateSyncStart: make(chan *stateSync),
+		stateDB:         stateDb,
+		mux:             mux,
+		checkpoint:      checkpoint,
+		epochSync:       epochSync,
+		epochCheckpoint: epochCheckpoint,
+		queue:           newQueue(blockCacheMaxItems, blockCacheInitialItems),
+		peers:           newPeerSet(),
+		blockchain:      chain,
+		lightchain:      lightchain,
+		dropPeer:        dropPeer,
+		headerProcCh:    make(chan *headerTask, 1),
+		quitCh:          make(chan struct{}),
+		SnapSyncer:      snap.NewSyncer(stateDb),
+		stateSyncStart:  make(chan *stateSync),
 	}
 	go dl.stateFetcher()
 	return dl
@@ -475,6 +485,14 @@ func (d *Downloader) syncWithPeer(p *peerConnection, hash common.Hash, td *big.I
 			rawdb.WriteLastPivotNumber(d.stateDB, pivotNumber)
 		}
 	}
+	// Start from the epoch checkpoint instead of genesis if one is configured
+	var checkpointed bool
+	if mode == SnapSync && d.epochCheckpoint != nil {
+		if origin, err = d.checkpointOrigin(p, origin, pivot); err != nil {
+			return err
+		}
+		checkpointed = d.lightchain.GetHeaderByHash(d.epochCheckpoint.Hash) != nil
+	}
 	d.committed = 1
 	if mode == SnapSync && pivot.Number.Uint64() != 0 {
 		d.committed = 0
@@ -508,6 +526,11 @@ func (d *Downloader) syncWithPeer(p *peerConnection, hash common.Hash, td *big.I
 		if origin >= frozen && frozen != 0 {
 			d.ancientLimit = 0
 			log.Info("Disabling direct-ancient mode", "origin", origin, "ancient", frozen-1)
+		} else if checkpointed {
+			// The blocks following the checkpoint are moved to the ancient store by the freezer, starting from the
+			// history tail, rather than written directly
+			d.ancientLimit = 0
+			log.Info("Disabling direct-ancient mode", "origin", origin, "checkpoint", d.epochCheckpoint.Hash)
 		} else if d.ancientLimit > 0 {
 			log.Debug("Enabling direct-ancient mode", "ancient", d.ancientLimit)
 		}
@@ -529,6 +552,9 @@ func (d *Downloader) syncWithPeer(p *peerConnection, hash common.Hash, td *big.I
 		func() error { return d.fetchReceipts(origin + 1) },                         // Receipts are retrieved during snap sync
 		func() error { return d.processHeaders(origin+1, td) },
 	}
+	if d.epochSync {
+		fetchers[0] = func() error { return d.fetchHeadersByEpoch(p, origin, latest.Number.Uint64()) }
+	}
 	if mode == SnapSync {
 		d.pivotLock.Lock()
 		d.pivotHeader = pivot
@@ -1249,7 +1275,7 @@ func (d *Downloader) processHeaders(origin uint64, td *big.Int) error {
 				return nil
 			}
 			// Otherwise split the chunk of headers into batches and process them
-			headers, hashes := task.headers, task.hashes
+			headers, hashes, verified := task.headers, task.hashes, task.verified
 
 			gotHeaders = true
 			for len(headers) > 0 {
@@ -1283,6 +1309,10 @@ func (d *Downloader) processHeaders(origin uint64, td *big.Int) error {
 					if chunkHeaders[len(chunkHeaders)-1].Number.Uint64()+uint64(fsHeaderForceVerify) > pivot {
 						frequency = 1
 					}
+					// The quorum certificates of the headers retrieved by the epoch sync are already verified
+					if verified {
+						frequency = core.CertifiedCheckFreq
+					}
 					if n, err := d.lightchain.InsertHeaderChain(chunkHeaders, frequency); err != nil {
 						rollbackErr = err
 
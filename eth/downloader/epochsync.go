@@ -0,0 +1,246 @@
+package downloader
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/consensus/tendermint/finality"
+	"github.com/autonity/autonity/core/types"
+)
+
+// epochFillConcurrency is the number of epochs whose headers are retrieved and verified concurrently by the
+// epoch sync.
+const epochFillConcurrency = 8
+
+var errNoEpochChain = errors.New("local chain does not expose its epochs")
+
+// epochChain is the part of the local chain the epoch sync starts from.
+type epochChain interface {
+	GetHeaderByNumber(number uint64) *types.Header
+	EpochOfHeight(height uint64) (*types.EpochInfo, error)
+}
+
+// fetchHeadersByEpoch is the header fetcher of the epoch sync. It first retrieves from the master peer the epoch
+// headers following the local epoch of origin, each of them carrying the committee of the next epoch and being
+// verified against the committee of the previous one, up to the last epoch header below head. The headers of
+// these epochs are then retrieved concurrently from all the peers, an epoch being verified against its already
+// trusted committee, and scheduled for import in order. The headers past the last epoch header are then retrieved
+// as in the regular sync.
+func (d *Downloader) fetchHeadersByEpoch(p *peerConnection, origin uint64, head uint64) error {
+	parent, trusted, err := d.trustedEpochHeader(origin)
+	if err != nil {
+		p.log.Warn("Epoch sync unavailable, syncing headers sequentially", "err", err)
+		return d.fetchHeaders(p, origin+1, head)
+	}
+	epochs, err := d.fetchEpochHeaders(p, trusted, head)
+	if err != nil {
+		return err
+	}
+	if len(epochs) > 0 {
+		p.log.Info("Retrieved epoch headers", "count", len(epochs), "from", epochs[0].Number, "to", epochs[len(epochs)-1].Number)
+		if err := d.fillEpochs(parent, trusted, epochs); err != nil {
+			return err
+		}
+		origin = epochs[len(epochs)-1].Number.Uint64()
+	}
+	return d.fetchHeaders(p, origin+1, head)
+}
+
+// trustedEpochHeader returns the local header at origin and the epoch header governing the block following it.
+func (d *Downloader) trustedEpochHeader(origin uint64) (*types.Header, *types.Header, error) {
+	chain, ok := d.lightchain.(epochChain)
+	if !ok {
+		return nil, nil, errNoEpochChain
+	}
+	parent := chain.GetHeaderByNumber(origin)
+	if parent == nil {
+		return nil, nil, fmt.Errorf("missing header %d", origin)
+	}
+	if parent.IsEpochHeader() {
+		return parent, parent, nil
+	}
+	epoch, err := chain.EpochOfHeight(origin)
+	if err != nil {
+		return nil, nil, err
+	}
+	trusted := chain.GetHeaderByNumber(epoch.EpochBlock.Uint64())
+	if trusted == nil || !trusted.IsEpochHeader() {
+		return nil, nil, fmt.Errorf("missing epoch header %d", epoch.EpochBlock.Uint64())
+	}
+	return parent, trusted, nil
+}
+
+// fetchEpochHeaders retrieves and verifies the chain of epoch headers following the trusted one, up to head. The
+// epoch headers are requested in batches, assuming that the epoch period does not change; when it does, the
+// retrieval continues from the first epoch header of the new period.
+func (d *Downloader) fetchEpochHeaders(p *peerConnection, trusted *types.Header, head uint64) ([]*types.Header, error) {
+	var epochs []*types.Header
+	current := trusted
+	for next := current.Epoch.NextEpochBlock.Uint64(); next <= head; next = current.Epoch.NextEpochBlock.Uint64() {
+		period := next - current.Number.Uint64()
+		amount := MaxHeaderFetch
+		if remaining := (head-next)/period + 1; remaining < uint64(amount) {
+			amount = int(remaining)
+		}
+		p.log.Trace("Fetching epoch headers", "count", amount, "from", next, "period", period)
+		headers, _, err := d.fetchHeadersByNumber(p, next, amount, int(period-1), false)
+		if err != nil {
+			if errors.Is(err, errCanceled) {
+				return nil, err
+			}
+			return nil, fmt.Errorf("%w: epoch header request failed: %v", errBadPeer, err)
+		}
+		if len(headers) == 0 {
+			return nil, fmt.Errorf("%w: withheld epoch header %d", errStallingPeer, next)
+		}
+		last := current
+		for _, header := range headers {
+			if header.Number.Uint64() != current.Epoch.NextEpochBlock.Uint64() {
+				// the epoch period changed, request the next batch from the actual next epoch header
+				break
+			}
+			if !header.IsEpochHeader() {
+				return nil, fmt.Errorf("%w: header %d: %v", errInvalidChain, header.Number.Uint64(), finality.ErrNotEpochHeader)
+			}
+			if err := finality.VerifyEpochLink(current, header); err != nil {
+				return nil, fmt.Errorf("%w: %v", errInvalidChain, err)
+			}
+			if err := finality.VerifyQuorumCertificate(header, current.Epoch.Committee); err != nil {
+				return nil, fmt.Errorf("%w: epoch header %d: %v", errInvalidChain, header.Number.Uint64(), err)
+			}
+			epochs = append(epochs, header)
+			current = header
+		}
+		if current == last {
+			// the first header of the batch is not the next epoch header
+			return nil, fmt.Errorf("%w: missing epoch header %d", errInvalidChain, next)
+		}
+	}
+	return epochs, nil
+}
+
+// epochFill is the retrieval of the headers of an epoch, from the header following the previous epoch header up
+// to the epoch header itself.
+type epochFill struct {
+	headers []*types.Header
+	hashes  []common.Hash
+	err     error
+	done    chan struct{}
+}
+
+// fillEpochs retrieves the headers of the verified epochs concurrently and schedules them for import in order.
+// parent is the last local header, trusted the epoch header governing the first epoch to fill.
+func (d *Downloader) fillEpochs(parent, trusted *types.Header, epochs []*types.Header) error {
+	fills := make([]*epochFill, len(epochs))
+	for i := range fills {
+		fills[i] = &epochFill{done: make(chan struct{})}
+	}
+	var peer uint32
+	slots := make(chan struct{}, epochFillConcurrency)
+	go func() {
+		for i, epoch := range epochs {
+			select {
+			case slots <- struct{}{}:
+			case <-d.cancelCh:
+				return
+			}
+			last, committee := parent, trusted.Epoch.Committee
+			if i > 0 {
+				last, committee = epochs[i-1], epochs[i-1].Epoch.Committee
+			}
+			go func(fill *epochFill, last, epoch *types.Header, committee *types.Committee) {
+				defer close(fill.done)
+				fill.headers, fill.hashes, fill.err = d.fillEpoch(&peer, last, epoch, committee)
+			}(fills[i], last, epoch, committee)
+		}
+	}()
+
+	for _, fill := range fills {
+		select {
+		case <-fill.done:
+		case <-d.cancelCh:
+			return errCanceled
+		}
+		if fill.err != nil {
+			return fill.err
+		}
+		select {
+		case d.headerProcCh <- &headerTask{headers: fill.headers, hashes: fill.hashes, verified: true}:
+		case <-d.cancelCh:
+			return errCanceled
+		}
+		<-slots
+	}
+	return nil
+}
+
+// fillEpoch retrieves the headers between the parent header and the epoch header and verifies them against the
+// committee of the epoch, rotating over the peers for each request. The returned headers end with the epoch header.
+func (d *Downloader) fillEpoch(next *uint32, parent, epoch *types.Header, committee *types.Committee) ([]*types.Header, []common.Hash, error) {
+	var (
+		from    = parent.Number.Uint64() + 1
+		to      = epoch.Number.Uint64()
+		headers = make([]*types.Header, 0, to-from+1)
+		hashes  = make([]common.Hash, 0, to-from+1)
+		last    = parent
+		failed  = 0
+	)
+	for from < to {
+		peers := d.peers.AllPeers()
+		if len(peers) == 0 || failed >= len(peers) {
+			return nil, nil, fmt.Errorf("%w: epoch %d: no peer delivered headers %d-%d", errBadPeer, to, from, to-1)
+		}
+		p := peers[int(atomic.AddUint32(next, 1))%len(peers)]
+		amount := MaxHeaderFetch
+		if remaining := to - from; remaining < uint64(amount) {
+			amount = int(remaining)
+		}
+		batch, batchHashes, err := d.fetchHeadersByNumber(p, from, amount, 0, false)
+		if errors.Is(err, errCanceled) {
+			return nil, nil, err
+		}
+		if err == nil {
+			err = verifyEpochBatch(last, batch, committee)
+		}
+		if err != nil {
+			p.log.Debug("Epoch headers retrieval failed", "epoch", to, "from", from, "err", err)
+			if errors.Is(err, errInvalidChain) {
+				d.dropPeer(p.id)
+			}
+			failed++
+			continue
+		}
+		failed = 0
+		headers = append(headers, batch...)
+		hashes = append(hashes, batchHashes...)
+		last = batch[len(batch)-1]
+		from += uint64(len(batch))
+	}
+	if epoch.ParentHash != last.Hash() {
+		return nil, nil, fmt.Errorf("%w: epoch header %d does not link to its parent", errInvalidChain, to)
+	}
+	return append(headers, epoch), append(hashes, epoch.Hash()), nil
+}
+
+// verifyEpochBatch checks that the headers follow the last verified header and that their quorum certificate was
+// signed by the committee of their epoch.
+func verifyEpochBatch(last *types.Header, headers []*types.Header, committee *types.Committee) error {
+	if len(headers) == 0 {
+		return fmt.Errorf("%w: no headers delivered", errStallingPeer)
+	}
+	for _, header := range headers {
+		if header.Number.Uint64() != last.Number.Uint64()+1 || header.ParentHash != last.Hash() {
+			return fmt.Errorf("%w: header %d does not link to its parent", errInvalidChain, last.Number.Uint64()+1)
+		}
+		if header.IsEpochHeader() {
+			return fmt.Errorf("%w: unexpected epoch header %d", errInvalidChain, header.Number.Uint64())
+		}
+		if err := finality.VerifyQuorumCertificate(header, committee); err != nil {
+			return fmt.Errorf("%w: header %d: %v", errInvalidChain, header.Number.Uint64(), err)
+		}
+		last = header
+	}
+	return nil
+}
@@ -30,6 +30,7 @@ import (
 	"runtime"
 	"time"
 
+	"github.com/autonity/autonity/accounts/external"
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/consensus"
 	"github.com/autonity/autonity/consensus/ethash"
@@ -129,6 +130,7 @@ type Config struct {
 	// Protocol options
 	NetworkID uint64 // Network ID to use for selecting peers to connect to
 	SyncMode  downloader.SyncMode
+	EpochSync bool `toml:",omitempty"` // Whether to retrieve the headers epoch by epoch, verifying the epoch headers first
 
 	// This can be set to list of enrtree:// URLs which will be queried for
 	// for nodes to connect to.
@@ -143,6 +145,9 @@ type Config struct {
 	// map of required blocks (block numbers -> hash values) to accept
 	RequiredBlocks map[uint64]common.Hash `toml:"-"`
 
+	// finalized epoch header to snap sync from instead of the genesis block
+	EpochCheckpoint *core.EpochCheckpoint `toml:"-"`
+
 	// Light client options
 	LightServ          int  `toml:",omitempty"` // Maximum percentage of time allowed for serving LES requests
 	LightIngress       int  `toml:",omitempty"` // Incoming bandwidth limit for light servers
@@ -242,5 +247,29 @@ func CreateConsensusEngine(ctx *node.Node, chainConfig *params.ChainConfig, conf
 
 	nodeKey, consensusKey := ctx.Config().AutonityKeys()
 	noGossip := ctx.Config().NoGossip
-	return tendermintBackend.New(nodeKey, consensusKey, vmConfig, ctx.Config().TendermintServices(), evMux, ms, ctx.Logger(), noGossip)
+	engine := tendermintBackend.New(nodeKey, consensusKey, vmConfig, ctx.Config().TendermintServices(), evMux, ms, ctx.Logger(), noGossip)
+	if endpoint := ctx.Config().ConsensusSigner; endpoint != "" {
+		signer, err := external.NewConsensusSigner(endpoint)
+		if err != nil {
+			log.Crit("Failed to connect to the consensus signer", "url", endpoint, "err", err)
+		}
+		log.Info("Using external consensus signer", "url", endpoint, "key", signer.PublicKey().Hex())
+		if err := engine.SetConsensusSigner(signer, ctx.Config().ConsensusSignerAllowKeyMismatch); err != nil {
+			log.Crit("Failed to set the consensus signer", "url", endpoint, "err", err)
+		}
+	}
+	if ctx.Config().ConsensusWAL {
+		dir := ctx.ResolvePath(tendermintcore.WALDir)
+		if dir == "" {
+			log.Warn("Consensus WAL disabled, it requires a data directory")
+			return engine
+		}
+		wal, err := tendermintcore.OpenWAL(dir, ctx.Config().ConsensusWALHeights, ctx.Logger())
+		if err != nil {
+			log.Crit("Failed to open the consensus WAL", "dir", dir, "err", err)
+		}
+		log.Info("Recording the consensus WAL", "dir", dir, "heights", ctx.Config().ConsensusWALHeights)
+		engine.SetWAL(wal)
+	}
+	return engine
 }
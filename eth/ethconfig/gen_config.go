@@ -21,12 +21,14 @@ func (c Config) MarshalTOML() (interface{}, error) {
 		Genesis                         *core.Genesis `toml:",omitempty"`
 		NetworkId                       uint64
 		SyncMode                        downloader.SyncMode
+		EpochSync                       bool `toml:",omitempty"`
 		EthDiscoveryURLs                []string
 		SnapDiscoveryURLs               []string
 		NoPruning                       bool
 		NoPrefetch                      bool
 		TxLookupLimit                   uint64                 `toml:",omitempty"`
 		RequiredBlocks                  map[uint64]common.Hash `toml:"-"`
+		EpochCheckpoint                 *core.EpochCheckpoint  `toml:"-"`
 		LightServ                       int                    `toml:",omitempty"`
 		LightIngress                    int                    `toml:",omitempty"`
 		LightEgress                     int                    `toml:",omitempty"`
@@ -66,12 +68,14 @@ func (c Config) MarshalTOML() (interface{}, error) {
 	enc.Genesis = c.Genesis
 	enc.NetworkId = c.NetworkID
 	enc.SyncMode = c.SyncMode
+	enc.EpochSync = c.EpochSync
 	enc.EthDiscoveryURLs = c.EthDiscoveryURLs
 	enc.SnapDiscoveryURLs = c.SnapDiscoveryURLs
 	enc.NoPruning = c.NoPruning
 	enc.NoPrefetch = c.NoPrefetch
 	enc.TxLookupLimit = c.TxLookupLimit
 	enc.RequiredBlocks = c.RequiredBlocks
+	enc.EpochCheckpoint = c.EpochCheckpoint
 	enc.LightServ = c.LightServ
 	enc.LightIngress = c.LightIngress
 	enc.LightEgress = c.LightEgress
@@ -115,12 +119,14 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 		Genesis                         *core.Genesis `toml:",omitempty"`
 		NetworkId                       *uint64
 		SyncMode                        *downloader.SyncMode
+		EpochSync                       *bool `toml:",omitempty"`
 		EthDiscoveryURLs                []string
 		SnapDiscoveryURLs               []string
 		NoPruning                       *bool
 		NoPrefetch                      *bool
 		TxLookupLimit                   *uint64                `toml:",omitempty"`
 		RequiredBlocks                  map[uint64]common.Hash `toml:"-"`
+		EpochCheckpoint                 *core.EpochCheckpoint  `toml:"-"`
 		LightServ                       *int                   `toml:",omitempty"`
 		LightIngress                    *int                   `toml:",omitempty"`
 		LightEgress                     *int                   `toml:",omitempty"`
@@ -169,6 +175,9 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	if dec.SyncMode != nil {
 		c.SyncMode = *dec.SyncMode
 	}
+	if dec.EpochSync != nil {
+		c.EpochSync = *dec.EpochSync
+	}
 	if dec.EthDiscoveryURLs != nil {
 		c.EthDiscoveryURLs = dec.EthDiscoveryURLs
 	}
@@ -187,6 +196,9 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	if dec.RequiredBlocks != nil {
 		c.RequiredBlocks = dec.RequiredBlocks
 	}
+	if dec.EpochCheckpoint != nil {
+		c.EpochCheckpoint = dec.EpochCheckpoint
+	}
 	if dec.LightServ != nil {
 		c.LightServ = *dec.LightServ
 	}
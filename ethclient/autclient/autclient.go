@@ -0,0 +1,322 @@
+// Package autclient provides an RPC client for the Autonity specific APIs: the tendermint consensus engine, the
+// accountability explorer and the view functions of the Autonity protocol contract.
+package autclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	ethereum "github.com/autonity/autonity"
+	"github.com/autonity/autonity/autonity"
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/common/hexutil"
+	"github.com/autonity/autonity/consensus/tendermint/accountability"
+	"github.com/autonity/autonity/consensus/tendermint/backend"
+	"github.com/autonity/autonity/consensus/tendermint/core/interfaces"
+	"github.com/autonity/autonity/consensus/tendermint/events"
+	"github.com/autonity/autonity/consensus/tendermint/finality"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/rpc"
+)
+
+// Client is a wrapper around rpc.Client that implements the Autonity specific functionality.
+//
+// If you want to use the standardized Ethereum RPC functionality, use ethclient.Client instead. The protocol
+// contracts can also be bound to an ethclient.Client with NewAutonity, NewAccountability, etc.
+type Client struct {
+	c *rpc.Client
+}
+
+// Dial connects a client to the given URL.
+func Dial(rawurl string) (*Client, error) {
+	return DialContext(context.Background(), rawurl)
+}
+
+// DialContext connects a client to the given URL with the given context.
+func DialContext(ctx context.Context, rawurl string) (*Client, error) {
+	c, err := rpc.DialContext(ctx, rawurl)
+	if err != nil {
+		return nil, err
+	}
+	return New(c), nil
+}
+
+// New creates a client that uses the given RPC client.
+func New(c *rpc.Client) *Client {
+	return &Client{c}
+}
+
+func (ac *Client) Close() {
+	ac.c.Close()
+}
+
+// Consensus engine
+
+// Committee returns the committee of the block with the given number. The latest block is used if the number is nil.
+func (ac *Client) Committee(ctx context.Context, number *big.Int) (*types.Committee, error) {
+	// tendermint_getCommittee only takes explicit block numbers
+	if number == nil {
+		var head hexutil.Uint64
+		if err := ac.c.CallContext(ctx, &head, "eth_blockNumber"); err != nil {
+			return nil, err
+		}
+		number = new(big.Int).SetUint64(uint64(head))
+	}
+	var committee *types.Committee
+	if err := ac.c.CallContext(ctx, &committee, "tendermint_getCommittee", toBlockNumArg(number)); err != nil {
+		return nil, err
+	}
+	return enrichCommittee(committee)
+}
+
+// CommitteeAtHash returns the committee of the block with the given hash.
+func (ac *Client) CommitteeAtHash(ctx context.Context, hash common.Hash) (*types.Committee, error) {
+	var committee *types.Committee
+	if err := ac.c.CallContext(ctx, &committee, "tendermint_getCommitteeAtHash", hash); err != nil {
+		return nil, err
+	}
+	return enrichCommittee(committee)
+}
+
+// CommitteeEnodes returns the enodes of the members of the current committee.
+func (ac *Client) CommitteeEnodes(ctx context.Context) ([]string, error) {
+	var enodes []string
+	err := ac.c.CallContext(ctx, &enodes, "tendermint_getCommitteeEnodes")
+	return enodes, err
+}
+
+// CoreMessage is a consensus message held by the tendermint core, as reported in its state.
+type CoreMessage struct {
+	Hash   common.Hash
+	Power  *big.Int
+	Height *big.Int
+	Round  int64
+}
+
+// CoreState is the state of the tendermint core of the node. The messages held by the core are reported by the
+// fields of CoreState, the ones of the embedded interfaces.CoreState are left empty.
+type CoreState struct {
+	interfaces.CoreState
+
+	CurHeightMessages    []*CoreMessage
+	FutureRoundMessages  []*CoreMessage
+	FutureHeightMessages []*CoreMessage
+}
+
+// CoreState returns the state of the tendermint core of the node.
+func (ac *Client) CoreState(ctx context.Context) (*CoreState, error) {
+	state := new(CoreState)
+	if err := ac.c.CallContext(ctx, state, "tendermint_getCoreState"); err != nil {
+		return nil, err
+	}
+	if err := state.Committee.Enrich(); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// SubscribeCoreEvents subscribes to the state changes of the tendermint core matching the filter.
+func (ac *Client) SubscribeCoreEvents(ctx context.Context, filter backend.CoreEventFilter, ch chan<- events.CoreEvent) (ethereum.Subscription, error) {
+	return ac.c.Subscribe(ctx, "tendermint", ch, "coreEvents", filter)
+}
+
+// FinalityProof returns a proof that the block with the given number is final, starting from the epoch block with
+// the trusted number. The latest block is used if the number is nil, the genesis block if the trusted one is nil.
+func (ac *Client) FinalityProof(ctx context.Context, number *big.Int, trusted *big.Int) (*finality.Proof, error) {
+	var trustedArg interface{}
+	if trusted != nil {
+		trustedArg = toBlockNumArg(trusted)
+	}
+	var proof *finality.Proof
+	if err := ac.c.CallContext(ctx, &proof, "tendermint_getFinalityProof", toBlockNumArg(number), trustedArg); err != nil {
+		return nil, err
+	}
+	if proof == nil {
+		return nil, ethereum.NotFound
+	}
+	return proof, nil
+}
+
+// Epochs
+
+// EpochID returns the identifier of the epoch at the given block number. The latest block is used if the number is
+// nil.
+func (ac *Client) EpochID(ctx context.Context, blockNumber *big.Int) (*big.Int, error) {
+	return ac.callBigInt(ctx, "aut_epochID", blockNumber)
+}
+
+// EpochInfo returns the epoch at the given block number. The latest block is used if the number is nil.
+func (ac *Client) EpochInfo(ctx context.Context, blockNumber *big.Int) (*types.EpochInfo, error) {
+	return ac.epochInfo(ctx, "aut_getEpochInfo", toBlockNumArg(blockNumber))
+}
+
+// EpochByHeight returns the epoch of the given height, as known at the given block number. The latest block is used
+// if the block number is nil.
+func (ac *Client) EpochByHeight(ctx context.Context, height *big.Int, blockNumber *big.Int) (*types.EpochInfo, error) {
+	return ac.epochInfo(ctx, "aut_getEpochByHeight", height, toBlockNumArg(blockNumber))
+}
+
+// EpochPeriod returns the epoch period, in blocks, at the given block number. The latest block is used if the number
+// is nil.
+func (ac *Client) EpochPeriod(ctx context.Context, blockNumber *big.Int) (*big.Int, error) {
+	return ac.callBigInt(ctx, "aut_getEpochPeriod", blockNumber)
+}
+
+// ConsensusTimeouts returns the consensus timeouts in use at the given block number, along with the timeouts to be
+// applied at the end of the epoch and the block at which they are applied, zero if none are pending. The latest block
+// is used if the number is nil.
+func (ac *Client) ConsensusTimeouts(ctx context.Context, blockNumber *big.Int) (current, next autonity.AutonityConsensusTimeouts, nextBlock *big.Int, err error) {
+	var result []json.RawMessage
+	if err = ac.c.CallContext(ctx, &result, "aut_getConsensusTimeouts", toBlockNumArg(blockNumber)); err != nil {
+		return current, next, nil, err
+	}
+	nextBlock = new(big.Int)
+	err = unmarshalResults(result, &current, &next, nextBlock)
+	return current, next, nextBlock, err
+}
+
+func (ac *Client) epochInfo(ctx context.Context, method string, args ...interface{}) (*types.EpochInfo, error) {
+	var result []json.RawMessage
+	if err := ac.c.CallContext(ctx, &result, method, args...); err != nil {
+		return nil, err
+	}
+	var (
+		members []autonity.AutonityCommitteeMember
+		epoch   = &types.EpochInfo{EpochBlock: new(big.Int)}
+	)
+	epoch.PreviousEpochBlock, epoch.NextEpochBlock = new(big.Int), new(big.Int)
+	if err := unmarshalResults(result, &members, epoch.PreviousEpochBlock, epoch.EpochBlock, epoch.NextEpochBlock); err != nil {
+		return nil, err
+	}
+	committee := &types.Committee{Members: make([]types.CommitteeMember, len(members))}
+	for i, m := range members {
+		committee.Members[i] = types.CommitteeMember{Address: m.Addr, VotingPower: m.VotingPower, ConsensusKeyBytes: m.ConsensusKey}
+	}
+	var err error
+	epoch.Committee, err = enrichCommittee(committee)
+	return epoch, err
+}
+
+// Validators and staking
+
+// Validators returns the addresses of the registered validators at the given block number. The latest block is used
+// if the number is nil.
+func (ac *Client) Validators(ctx context.Context, blockNumber *big.Int) ([]common.Address, error) {
+	var validators []common.Address
+	err := ac.c.CallContext(ctx, &validators, "aut_getValidators", toBlockNumArg(blockNumber))
+	return validators, err
+}
+
+// Validator returns the validator registered with the given node address at the given block number. The latest
+// block is used if the number is nil.
+func (ac *Client) Validator(ctx context.Context, address common.Address, blockNumber *big.Int) (*autonity.AutonityValidator, error) {
+	var validator *autonity.AutonityValidator
+	if err := ac.c.CallContext(ctx, &validator, "aut_getValidator", address, toBlockNumArg(blockNumber)); err != nil {
+		return nil, err
+	}
+	if validator == nil {
+		return nil, ethereum.NotFound
+	}
+	return validator, nil
+}
+
+// NewtonBalanceAt returns the Newton balance of the given account at the given block number. The latest block is
+// used if the number is nil.
+func (ac *Client) NewtonBalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return ac.callBigInt(ctx, "aut_balanceOf", blockNumber, account)
+}
+
+// NewtonTotalSupply returns the total supply of Newton at the given block number. The latest block is used if the
+// number is nil.
+func (ac *Client) NewtonTotalSupply(ctx context.Context, blockNumber *big.Int) (*big.Int, error) {
+	return ac.callBigInt(ctx, "aut_totalSupply", blockNumber)
+}
+
+// Accountability
+
+// AccountabilityEvents returns the on-chain accountability events matching the filter, ordered by id.
+func (ac *Client) AccountabilityEvents(ctx context.Context, filter accountability.EventFilter) ([]*accountability.RPCEvent, error) {
+	var events []*accountability.RPCEvent
+	err := ac.c.CallContext(ctx, &events, "accountability_getEvents", filter)
+	return events, err
+}
+
+// AccountabilityEvent returns the on-chain accountability event with the given id.
+func (ac *Client) AccountabilityEvent(ctx context.Context, id uint64) (*accountability.RPCEvent, error) {
+	var event *accountability.RPCEvent
+	if err := ac.c.CallContext(ctx, &event, "accountability_getEvent", id); err != nil {
+		return nil, err
+	}
+	if event == nil {
+		return nil, ethereum.NotFound
+	}
+	return event, nil
+}
+
+// LocalAccountabilityEvents returns the accountability events detected by the node which are not on-chain yet.
+func (ac *Client) LocalAccountabilityEvents(ctx context.Context) (*accountability.LocalEvents, error) {
+	events := new(accountability.LocalEvents)
+	if err := ac.c.CallContext(ctx, events, "accountability_getLocalEvents"); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// DecodeAccountabilityProof decodes the raw proof of an accountability event.
+func (ac *Client) DecodeAccountabilityProof(ctx context.Context, rawProof []byte) (*accountability.RPCProof, error) {
+	var proof *accountability.RPCProof
+	if err := ac.c.CallContext(ctx, &proof, "accountability_decodeProof", hexutil.Bytes(rawProof)); err != nil {
+		return nil, err
+	}
+	if proof == nil {
+		return nil, ethereum.NotFound
+	}
+	return proof, nil
+}
+
+// callBigInt calls a view function of the Autonity contract returning a single integer. The block number selecting
+// the state is passed after the arguments of the function.
+func (ac *Client) callBigInt(ctx context.Context, method string, blockNumber *big.Int, args ...interface{}) (*big.Int, error) {
+	result := new(big.Int)
+	if err := ac.c.CallContext(ctx, result, method, append(args, toBlockNumArg(blockNumber))...); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// unmarshalResults decodes the results of a view function returning several values.
+func unmarshalResults(results []json.RawMessage, values ...interface{}) error {
+	if len(results) != len(values) {
+		return fmt.Errorf("expected %d results, got %d", len(values), len(results))
+	}
+	for i, result := range results {
+		if err := json.Unmarshal(result, values[i]); err != nil {
+			return fmt.Errorf("result %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// enrichCommittee computes the consensus keys and indexes of the members of a committee decoded from JSON.
+func enrichCommittee(committee *types.Committee) (*types.Committee, error) {
+	if committee == nil {
+		return nil, ethereum.NotFound
+	}
+	if err := committee.Enrich(); err != nil {
+		return nil, err
+	}
+	return committee, nil
+}
+
+func toBlockNumArg(number *big.Int) string {
+	if number == nil {
+		return "latest"
+	}
+	pending := big.NewInt(-1)
+	if number.Cmp(pending) == 0 {
+		return "pending"
+	}
+	return hexutil.EncodeBig(number)
+}
@@ -0,0 +1,139 @@
+package autclient
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/autonity/autonity/accounts/abi/bind"
+	"github.com/autonity/autonity/consensus/tendermint/accountability"
+	"github.com/autonity/autonity/consensus/tendermint/backend"
+	"github.com/autonity/autonity/consensus/tendermint/events"
+	e2e "github.com/autonity/autonity/e2e_test"
+)
+
+func TestClient(t *testing.T) {
+	network, err := e2e.NewNetwork(t, 2, "10e18,v,1,0.0.0.0:%s,%s,%s,%s")
+	require.NoError(t, err)
+	defer network.Shutdown(t)
+	network.WaitToMineNBlocks(3, 20, false)
+
+	rpcClient, err := network[0].Attach()
+	require.NoError(t, err)
+	client := New(rpcClient)
+	defer client.Close()
+	ctx := context.Background()
+	genesis := network[0].Eth.BlockChain().Genesis()
+
+	t.Run("committee", func(t *testing.T) {
+		committee, err := client.Committee(ctx, nil)
+		require.NoError(t, err)
+		require.Equal(t, 2, committee.Len())
+		require.NotNil(t, committee.Members[1].ConsensusKey)
+		require.Equal(t, uint64(1), committee.Members[1].Index)
+
+		atHash, err := client.CommitteeAtHash(ctx, genesis.Hash())
+		require.NoError(t, err)
+		require.Equal(t, committee.Members, atHash.Members)
+
+		enodes, err := client.CommitteeEnodes(ctx)
+		require.NoError(t, err)
+		require.Len(t, enodes, 2)
+	})
+
+	t.Run("epochs", func(t *testing.T) {
+		committee, err := client.Committee(ctx, big.NewInt(0))
+		require.NoError(t, err)
+		epoch, err := client.EpochInfo(ctx, nil)
+		require.NoError(t, err)
+		require.Equal(t, int64(0), epoch.EpochBlock.Int64())
+		require.Equal(t, committee.Members, epoch.Committee.Members)
+
+		byHeight, err := client.EpochByHeight(ctx, big.NewInt(1), nil)
+		require.NoError(t, err)
+		require.Equal(t, epoch.NextEpochBlock, byHeight.NextEpochBlock)
+
+		period, err := client.EpochPeriod(ctx, nil)
+		require.NoError(t, err)
+		require.Equal(t, new(big.Int).Sub(epoch.NextEpochBlock, epoch.EpochBlock), period)
+
+		id, err := client.EpochID(ctx, big.NewInt(0))
+		require.NoError(t, err)
+		require.Zero(t, id.Sign())
+
+		// the genesis sets no consensus timeouts
+		current, _, nextBlock, err := client.ConsensusTimeouts(ctx, nil)
+		require.NoError(t, err)
+		require.Zero(t, current.ProposeInitial.Sign())
+		require.Zero(t, nextBlock.Sign())
+	})
+
+	t.Run("validators", func(t *testing.T) {
+		validators, err := client.Validators(ctx, nil)
+		require.NoError(t, err)
+		require.Len(t, validators, 2)
+
+		validator, err := client.Validator(ctx, network[0].Address, nil)
+		require.NoError(t, err)
+		require.Equal(t, network[0].Address, validator.NodeAddress)
+		require.NotEmpty(t, validator.ConsensusKey)
+
+		// the typed methods agree with the contract bindings
+		autonityContract, err := NewAutonity(network[0].WsClient)
+		require.NoError(t, err)
+		bound, err := autonityContract.GetValidator(&bind.CallOpts{}, network[0].Address)
+		require.NoError(t, err)
+		require.Equal(t, bound.LiquidStateContract, validator.LiquidStateContract)
+		require.Zero(t, bound.BondedStake.Cmp(validator.BondedStake))
+
+		liquid, err := NewLiquidNewton(validator.LiquidStateContract, network[0].WsClient)
+		require.NoError(t, err)
+		liquidSupply, err := liquid.TotalSupply(&bind.CallOpts{})
+		require.NoError(t, err)
+		require.Zero(t, validator.LiquidSupply.Cmp(liquidSupply))
+
+		supply, err := client.NewtonTotalSupply(ctx, nil)
+		require.NoError(t, err)
+		balance, err := client.NewtonBalanceAt(ctx, validator.Treasury, nil)
+		require.NoError(t, err)
+		require.True(t, balance.Cmp(supply) < 0)
+	})
+
+	t.Run("core", func(t *testing.T) {
+		state, err := client.CoreState(ctx)
+		require.NoError(t, err)
+		require.Equal(t, network[0].Address, state.Client)
+		require.True(t, state.Height.Sign() > 0)
+		require.Equal(t, 2, state.Committee.Len())
+
+		coreEvents := make(chan events.CoreEvent, 64)
+		sub, err := client.SubscribeCoreEvents(ctx, backend.CoreEventFilter{Types: []events.CoreEventType{events.CoreCommit}}, coreEvents)
+		require.NoError(t, err)
+		defer sub.Unsubscribe()
+		select {
+		case ev := <-coreEvents:
+			require.Equal(t, events.CoreCommit, ev.Type)
+		case <-time.After(20 * time.Second):
+			t.Fatal("no commit event received")
+		}
+
+		proof, err := client.FinalityProof(ctx, nil, nil)
+		require.NoError(t, err)
+		_, err = proof.Verify(genesis.Header())
+		require.NoError(t, err)
+	})
+
+	t.Run("accountability", func(t *testing.T) {
+		onChain, err := client.AccountabilityEvents(ctx, accountability.EventFilter{})
+		require.NoError(t, err)
+		require.Empty(t, onChain)
+		_, err = client.AccountabilityEvent(ctx, 0)
+		require.Error(t, err)
+		local, err := client.LocalAccountabilityEvents(ctx)
+		require.NoError(t, err)
+		require.Empty(t, local.Pending)
+	})
+}
@@ -0,0 +1,67 @@
+package autclient
+
+import (
+	"github.com/autonity/autonity/accounts/abi/bind"
+	"github.com/autonity/autonity/autonity"
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/params"
+)
+
+// The constructors below bind the generated wrappers of the protocol contracts to their canonical address. The
+// backend is usually an ethclient.Client connected to an Autonity node.
+
+// NewAutonity binds the Autonity contract.
+func NewAutonity(backend bind.ContractBackend) (*autonity.Autonity, error) {
+	return autonity.NewAutonity(params.AutonityContractAddress, backend)
+}
+
+// NewAccountability binds the Accountability contract.
+func NewAccountability(backend bind.ContractBackend) (*autonity.Accountability, error) {
+	return autonity.NewAccountability(params.AccountabilityContractAddress, backend)
+}
+
+// NewOracle binds the Oracle contract.
+func NewOracle(backend bind.ContractBackend) (*autonity.Oracle, error) {
+	return autonity.NewOracle(params.OracleContractAddress, backend)
+}
+
+// NewACU binds the ACU contract.
+func NewACU(backend bind.ContractBackend) (*autonity.ACU, error) {
+	return autonity.NewACU(params.ACUContractAddress, backend)
+}
+
+// NewSupplyControl binds the SupplyControl contract.
+func NewSupplyControl(backend bind.ContractBackend) (*autonity.SupplyControl, error) {
+	return autonity.NewSupplyControl(params.SupplyControlContractAddress, backend)
+}
+
+// NewStabilization binds the Stabilization contract.
+func NewStabilization(backend bind.ContractBackend) (*autonity.Stabilization, error) {
+	return autonity.NewStabilization(params.StabilizationContractAddress, backend)
+}
+
+// NewUpgradeManager binds the UpgradeManager contract.
+func NewUpgradeManager(backend bind.ContractBackend) (*autonity.UpgradeManager, error) {
+	return autonity.NewUpgradeManager(params.UpgradeManagerContractAddress, backend)
+}
+
+// NewInflationController binds the InflationController contract.
+func NewInflationController(backend bind.ContractBackend) (*autonity.InflationController, error) {
+	return autonity.NewInflationController(params.InflationControllerContractAddress, backend)
+}
+
+// NewStakableVesting binds the StakableVesting contract.
+func NewStakableVesting(backend bind.ContractBackend) (*autonity.StakableVesting, error) {
+	return autonity.NewStakableVesting(params.StakableVestingContractAddress, backend)
+}
+
+// NewNonStakableVesting binds the NonStakableVesting contract.
+func NewNonStakableVesting(backend bind.ContractBackend) (*autonity.NonStakableVesting, error) {
+	return autonity.NewNonStakableVesting(params.NonStakableVestingContractAddress, backend)
+}
+
+// NewLiquidNewton binds the Liquid Newton contract of a validator, as given by the LiquidStateContract field of
+// autonity.AutonityValidator.
+func NewLiquidNewton(liquidStateContract common.Address, backend bind.ContractBackend) (*autonity.ILiquidLogic, error) {
+	return autonity.NewILiquidLogic(liquidStateContract, backend)
+}
@@ -37,6 +37,7 @@ import (
 
 var (
 	errBlockInvariant = errors.New("block objects must be instantiated with at least one of num or hash")
+	errNoEpochs       = errors.New("epochs are not tracked by the consensus engine")
 )
 
 type Long int64
@@ -982,6 +983,206 @@ func (b *Block) EstimateGas(ctx context.Context, args struct {
 	return Long(gas), err
 }
 
+func (b *Block) Round(ctx context.Context) (Long, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return Long(header.Round), nil
+}
+
+func (b *Block) Proposer(ctx context.Context) (common.Address, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return header.Coinbase, nil
+}
+
+func (b *Block) ProposerSeal(ctx context.Context) (hexutil.Bytes, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return hexutil.Bytes{}, err
+	}
+	return header.ProposerSeal, nil
+}
+
+func (b *Block) QuorumCertificate(ctx context.Context) (*QuorumCertificate, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	certificate := header.QuorumCertificate
+	if certificate.Signature == nil || certificate.Signers == nil {
+		return nil, nil
+	}
+	epoch, err := epochOfHeight(b.backend, header.Number.Uint64())
+	if err != nil {
+		return nil, err
+	}
+	if !certificate.Signers.Bits.Valid(epoch.info.Committee.Len()) {
+		return nil, fmt.Errorf("quorum certificate of block %d does not match its committee", header.Number)
+	}
+	return &QuorumCertificate{certificate: certificate, committee: epoch.info.Committee}, nil
+}
+
+func (b *Block) Epoch(ctx context.Context) (*Epoch, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return epochOfHeight(b.backend, header.Number.Uint64())
+}
+
+func (b *Block) StartedEpoch(ctx context.Context) (*Epoch, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !header.IsEpochHeader() {
+		return nil, nil
+	}
+	return &Epoch{
+		backend: b.backend,
+		info:    &types.EpochInfo{Epoch: *header.Epoch.Copy(), EpochBlock: header.Number},
+	}, nil
+}
+
+// epochReader is implemented by the consensus engines keeping track of the epochs of the chain.
+type epochReader interface {
+	EpochOfHeight(height uint64) (*types.EpochInfo, error)
+}
+
+// epochOfHeight returns the epoch the given height belongs to, whose committee finalises the block at that height.
+func epochOfHeight(backend ethapi.Backend, height uint64) (*Epoch, error) {
+	reader, ok := backend.Engine().(epochReader)
+	if !ok {
+		return nil, errNoEpochs
+	}
+	info, err := reader.EpochOfHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	return &Epoch{backend: backend, info: info}, nil
+}
+
+// Epoch represents a range of blocks finalised by the same committee.
+type Epoch struct {
+	backend ethapi.Backend
+	info    *types.EpochInfo
+}
+
+func (e *Epoch) EpochBlock() Long {
+	return Long(e.info.EpochBlock.Uint64())
+}
+
+func (e *Epoch) PreviousEpochBlock() Long {
+	return Long(e.info.PreviousEpochBlock.Uint64())
+}
+
+func (e *Epoch) NextEpochBlock() Long {
+	return Long(e.info.NextEpochBlock.Uint64())
+}
+
+func (e *Epoch) Block(ctx context.Context) *Block {
+	numberOrHash := rpc.BlockNumberOrHashWithNumber(rpc.BlockNumber(e.info.EpochBlock.Int64()))
+	return &Block{
+		backend:      e.backend,
+		numberOrHash: &numberOrHash,
+	}
+}
+
+func (e *Epoch) Committee() []*CommitteeMember {
+	return committeeMembers(e.info.Committee, nil)
+}
+
+func (e *Epoch) TotalVotingPower() hexutil.Big {
+	return hexutil.Big(*e.info.Committee.TotalVotingPower())
+}
+
+func (e *Epoch) Blocks(ctx context.Context) ([]*Block, error) {
+	from := e.info.EpochBlock.Uint64() + 1
+	to := e.info.NextEpochBlock.Uint64()
+	if head := e.backend.CurrentHeader().Number.Uint64(); head < to {
+		to = head
+	}
+	if to < from {
+		return []*Block{}, nil
+	}
+	ret := make([]*Block, 0, to-from+1)
+	for i := from; i <= to; i++ {
+		numberOrHash := rpc.BlockNumberOrHashWithNumber(rpc.BlockNumber(i))
+		block := &Block{
+			backend:      e.backend,
+			numberOrHash: &numberOrHash,
+		}
+		if h, err := block.resolveHeader(ctx); err != nil {
+			return nil, err
+		} else if h == nil {
+			break
+		}
+		ret = append(ret, block)
+	}
+	return ret, nil
+}
+
+// CommitteeMember represents a member of the consensus committee of an epoch.
+type CommitteeMember struct {
+	index  int
+	member *types.CommitteeMember
+}
+
+// committeeMembers returns the members of the committee selected by the filter, all of them if it is nil.
+func committeeMembers(committee *types.Committee, filter func(index int) bool) []*CommitteeMember {
+	members := make([]*CommitteeMember, 0, committee.Len())
+	for i := range committee.Members {
+		if filter == nil || filter(i) {
+			members = append(members, &CommitteeMember{index: i, member: &committee.Members[i]})
+		}
+	}
+	return members
+}
+
+func (m *CommitteeMember) Index() int32 {
+	return int32(m.index)
+}
+
+func (m *CommitteeMember) Address() common.Address {
+	return m.member.Address
+}
+
+func (m *CommitteeMember) VotingPower() hexutil.Big {
+	return hexutil.Big(*m.member.VotingPower)
+}
+
+func (m *CommitteeMember) ConsensusKey() hexutil.Bytes {
+	return m.member.ConsensusKeyBytes
+}
+
+// QuorumCertificate represents the aggregated signature of the precommits which finalised a block.
+type QuorumCertificate struct {
+	certificate types.AggregateSignature
+	committee   *types.Committee
+}
+
+func (q *QuorumCertificate) Signature() hexutil.Bytes {
+	return q.certificate.Signature.Marshal()
+}
+
+func (q *QuorumCertificate) Signers() []*CommitteeMember {
+	return committeeMembers(q.committee, func(index int) bool {
+		return q.certificate.Signers.Bits.Get(index) > 0
+	})
+}
+
+func (q *QuorumCertificate) VotingPower() hexutil.Big {
+	power := new(big.Int)
+	for _, signer := range q.Signers() {
+		power.Add(power, signer.member.VotingPower)
+	}
+	return hexutil.Big(*power)
+}
+
 type Pending struct {
 	backend ethapi.Backend
 }
@@ -1223,6 +1424,26 @@ func (r *Resolver) ChainID(ctx context.Context) (hexutil.Big, error) {
 	return hexutil.Big(*r.backend.ChainConfig().ChainID), nil
 }
 
+func (r *Resolver) Epoch(ctx context.Context, args struct{ Number *Long }) (*Epoch, error) {
+	height := r.backend.CurrentHeader().Number.Uint64()
+	if args.Number != nil {
+		if *args.Number < 0 || uint64(*args.Number) > height {
+			return nil, nil
+		}
+		height = uint64(*args.Number)
+	}
+	return epochOfHeight(r.backend, height)
+}
+
+func (r *Resolver) Committee(ctx context.Context, args struct{ Block *Long }) (*[]*CommitteeMember, error) {
+	epoch, err := r.Epoch(ctx, struct{ Number *Long }{args.Block})
+	if err != nil || epoch == nil {
+		return nil, err
+	}
+	committee := epoch.Committee()
+	return &committee, nil
+}
+
 // SyncState represents the synchronisation status returned from the `syncing` accessor.
 type SyncState struct {
 	progress ethereum.SyncProgress
@@ -17,6 +17,8 @@
 package graphql
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"math/big"
@@ -26,17 +28,22 @@ import (
 	"time"
 
 	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/common/hexutil"
 	"github.com/autonity/autonity/consensus/ethash"
+	"github.com/autonity/autonity/consensus/tendermint/bft"
 	"github.com/autonity/autonity/core"
 	"github.com/autonity/autonity/core/types"
 	"github.com/autonity/autonity/core/vm"
 	"github.com/autonity/autonity/crypto"
+	e2e "github.com/autonity/autonity/e2e_test"
 	"github.com/autonity/autonity/eth"
 	"github.com/autonity/autonity/eth/ethconfig"
 	"github.com/autonity/autonity/node"
 	"github.com/autonity/autonity/params"
 
+	"github.com/graph-gophers/graphql-go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestBuildSchema(t *testing.T) {
@@ -357,3 +364,102 @@ func createGQLServiceWithTransactions(t *testing.T, stack *node.Node) {
 		t.Fatalf("could not create graphql service: %v", err)
 	}
 }
+
+// Tests that the epochs, committees and quorum certificates of a Tendermint chain are exposed.
+func TestGraphQLEpochs(t *testing.T) {
+	vals, err := e2e.Validators(t, 4, "10e18,v,10000,127.0.0.1:%s,%s,%s,%s")
+	require.NoError(t, err)
+	network, err := e2e.NewInMemoryNetwork(t, vals, true, func(genesis *core.Genesis) {
+		genesis.Config.AutonityContractConfig.EpochPeriod = 5
+	})
+	require.NoError(t, err)
+	defer network.Shutdown(t)
+	require.NoError(t, network.WaitToMineNBlocks(12, 60, false))
+
+	s, err := graphql.ParseSchema(schema, &Resolver{network[0].Eth.APIBackend})
+	require.NoError(t, err)
+	query := func(query string, result interface{}) {
+		response := s.Exec(context.Background(), query, "", nil)
+		require.Empty(t, response.Errors)
+		require.NoError(t, json.Unmarshal(response.Data, result))
+	}
+
+	type member struct {
+		Index        int
+		Address      common.Address
+		VotingPower  hexutil.Big
+		ConsensusKey hexutil.Bytes
+	}
+	var epochResult struct {
+		Epoch struct {
+			EpochBlock         int64
+			PreviousEpochBlock int64
+			NextEpochBlock     int64
+			TotalVotingPower   hexutil.Big
+			Committee          []member
+			Blocks             []struct {
+				Number            int64
+				Round             int64
+				Proposer          common.Address
+				QuorumCertificate struct {
+					Signers     []member
+					VotingPower hexutil.Big
+				}
+			}
+		}
+	}
+	query(`{ epoch(number: 7) { epochBlock previousEpochBlock nextEpochBlock totalVotingPower
+		committee { index address votingPower consensusKey }
+		blocks { number round proposer quorumCertificate { signers { address } votingPower } } } }`, &epochResult)
+	epoch := epochResult.Epoch
+	require.Equal(t, int64(5), epoch.EpochBlock)
+	require.Equal(t, int64(0), epoch.PreviousEpochBlock)
+	require.Equal(t, int64(10), epoch.NextEpochBlock)
+	require.Len(t, epoch.Committee, 4)
+	committee := network[0].Eth.BlockChain().GetHeaderByNumber(5).Epoch.Committee
+	for i, m := range epoch.Committee {
+		require.Equal(t, i, m.Index)
+		require.Equal(t, committee.Members[i].Address, m.Address)
+		require.Equal(t, committee.Members[i].ConsensusKeyBytes, []byte(m.ConsensusKey))
+	}
+	require.Equal(t, committee.TotalVotingPower(), epoch.TotalVotingPower.ToInt())
+	require.Len(t, epoch.Blocks, 5)
+	for i, block := range epoch.Blocks {
+		require.Equal(t, int64(6+i), block.Number)
+		require.NotEmpty(t, block.QuorumCertificate.Signers)
+		require.True(t, block.QuorumCertificate.VotingPower.ToInt().Cmp(bft.Quorum(committee.TotalVotingPower())) >= 0)
+		require.NotNil(t, committee.MemberByAddress(block.Proposer))
+	}
+
+	var blockResult struct {
+		Block struct {
+			Epoch             struct{ EpochBlock int64 }
+			StartedEpoch      *struct{ EpochBlock, NextEpochBlock int64 }
+			QuorumCertificate *struct{ Signature hexutil.Bytes }
+		}
+	}
+	query(`{ block(number: 10) { epoch { epochBlock } startedEpoch { epochBlock nextEpochBlock } quorumCertificate { signature } } }`, &blockResult)
+	require.Equal(t, int64(5), blockResult.Block.Epoch.EpochBlock)
+	require.Equal(t, int64(10), blockResult.Block.StartedEpoch.EpochBlock)
+	require.Equal(t, int64(15), blockResult.Block.StartedEpoch.NextEpochBlock)
+	require.NotEmpty(t, blockResult.Block.QuorumCertificate.Signature)
+
+	// only epoch blocks start an epoch, and the genesis block is not finalised by any quorum certificate
+	var genesisResult struct {
+		Block   struct{ StartedEpoch *struct{ EpochBlock int64 } }
+		Genesis struct {
+			QuorumCertificate *struct{ Signature hexutil.Bytes }
+		}
+	}
+	query(`{ block(number: 3) { startedEpoch { epochBlock } } genesis: block(number: 0) { quorumCertificate { signature } } }`, &genesisResult)
+	require.Nil(t, genesisResult.Block.StartedEpoch)
+	require.Nil(t, genesisResult.Genesis.QuorumCertificate)
+
+	var committeeResult struct {
+		Committee []member
+		Future    *struct{ EpochBlock int64 }
+	}
+	query(`{ committee(block: 3) { address } future: epoch(number: 1000000) { epochBlock } }`, &committeeResult)
+	require.Len(t, committeeResult.Committee, 4)
+	require.Nil(t, committeeResult.Future)
+}
@@ -231,6 +231,64 @@ const schema string = `
         # EstimateGas estimates the amount of gas that will be required for
         # successful execution of a transaction at the current block's state.
         estimateGas(data: CallData!): Long!
+        # Round is the consensus round in which this block was decided.
+        round: Long!
+        # Proposer is the committee member which proposed this block.
+        proposer: Address!
+        # ProposerSeal is the signature of the header by the proposer.
+        proposerSeal: Bytes!
+        # QuorumCertificate is the aggregated signature of the precommits which
+        # finalised this block. It is null for the genesis block.
+        quorumCertificate: QuorumCertificate
+        # Epoch is the epoch this block belongs to, whose committee finalised it.
+        epoch: Epoch!
+        # StartedEpoch is the epoch started by this block if it is an epoch
+        # block, null otherwise.
+        startedEpoch: Epoch
+    }
+
+    # CommitteeMember is a member of the consensus committee of an epoch.
+    type CommitteeMember {
+        # Index is the position of the member in the committee.
+        index: Int!
+        # Address is the node address of the member.
+        address: Address!
+        # VotingPower is the voting power of the member, its bonded stake.
+        votingPower: BigInt!
+        # ConsensusKey is the BLS public key the member signs consensus messages with.
+        consensusKey: Bytes!
+    }
+
+    # QuorumCertificate is the aggregated signature of the precommits for a block.
+    type QuorumCertificate {
+        # Signature is the aggregated BLS signature.
+        signature: Bytes!
+        # Signers are the committee members whose precommits are aggregated.
+        signers: [CommitteeMember!]!
+        # VotingPower is the voting power of the signers.
+        votingPower: BigInt!
+    }
+
+    # Epoch is a range of blocks finalised by the same committee. It starts
+    # after its epoch block, which holds its committee, and ends at the next
+    # epoch block.
+    type Epoch {
+        # EpochBlock is the number of the block which started this epoch.
+        epochBlock: Long!
+        # PreviousEpochBlock is the number of the epoch block of the previous epoch.
+        previousEpochBlock: Long!
+        # NextEpochBlock is the number of the last block of this epoch, which
+        # starts the next epoch.
+        nextEpochBlock: Long!
+        # Block is the block which started this epoch.
+        block: Block!
+        # Committee is the list of the committee members of this epoch.
+        committee: [CommitteeMember!]!
+        # TotalVotingPower is the voting power of the committee.
+        totalVotingPower: BigInt!
+        # Blocks is the list of the blocks of this epoch known to the node,
+        # from the block following the epoch block.
+        blocks: [Block!]!
     }
 
     # CallData represents the data associated with a local contract call.
@@ -337,6 +395,14 @@ const schema string = `
         syncing: SyncState
         # ChainID returns the current chain ID for transaction replay protection.
         chainID: BigInt!
+        # Epoch returns the epoch the block with the given number belongs to. If
+        # the number is not supplied, the epoch of the most recent known block
+        # is returned.
+        epoch(number: Long): Epoch
+        # Committee returns the committee which finalised the block with the
+        # given number. If the number is not supplied, the committee of the most
+        # recent known block is returned.
+        committee(block: Long): [CommitteeMember!]
     }
 
     type Mutation {
@@ -18,18 +18,19 @@
 package web3ext
 
 var Modules = map[string]string{
-	"admin":      AdminJs,
-	"ethash":     EthashJs,
-	"debug":      DebugJs,
-	"eth":        EthJs,
-	"miner":      MinerJs,
-	"net":        NetJs,
-	"personal":   PersonalJs,
-	"rpc":        RpcJs,
-	"txpool":     TxpoolJs,
-	"les":        LESJs,
-	"tendermint": TendermintJs,
-	"vflux":      VfluxJs,
+	"accountability": AccountabilityJs,
+	"admin":          AdminJs,
+	"ethash":         EthashJs,
+	"debug":          DebugJs,
+	"eth":            EthJs,
+	"miner":          MinerJs,
+	"net":            NetJs,
+	"personal":       PersonalJs,
+	"rpc":            RpcJs,
+	"txpool":         TxpoolJs,
+	"les":            LESJs,
+	"tendermint":     TendermintJs,
+	"vflux":          VfluxJs,
 }
 
 const EthashJs = `
@@ -794,6 +795,48 @@ web3._extend({
 			name: 'getCoreState',
 			call: 'tendermint_getCoreState',
 			params: 0
+		}),
+		new web3._extend.Method({
+			name: 'getFinalityProof',
+			call: 'tendermint_getFinalityProof',
+			params: 2,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter, null]
+		}),
+		new web3._extend.Method({
+			name: 'getValidatorParticipation',
+			call: 'tendermint_getValidatorParticipation',
+			params: 3,
+			inputFormatter: [web3._extend.formatters.inputAddressFormatter, null, null]
+		})
+	]
+});
+`
+
+const AccountabilityJs = `
+web3._extend({
+	property: 'accountability',
+	methods:
+	[
+		new web3._extend.Method({
+			name: 'getEvents',
+			call: 'accountability_getEvents',
+			params: 1,
+			inputFormatter: [null]
+		}),
+		new web3._extend.Method({
+			name: 'getEvent',
+			call: 'accountability_getEvent',
+			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'getLocalEvents',
+			call: 'accountability_getLocalEvents',
+			params: 0
+		}),
+		new web3._extend.Method({
+			name: 'decodeProof',
+			call: 'accountability_decodeProof',
+			params: 1
 		})
 	]
 });
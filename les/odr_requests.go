@@ -22,6 +22,7 @@ import (
 	"fmt"
 
 	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/consensus/tendermint/finality"
 	"github.com/autonity/autonity/core/rawdb"
 	"github.com/autonity/autonity/core/types"
 	"github.com/autonity/autonity/crypto"
@@ -68,6 +69,8 @@ func LesRequest(req light.OdrRequest) LesOdrRequest {
 		return (*BloomRequest)(r)
 	case *light.TxStatusRequest:
 		return (*TxStatusRequest)(r)
+	case *light.EpochHeadersRequest:
+		return (*EpochHeadersRequest)(r)
 	default:
 		return nil
 	}
@@ -513,6 +516,58 @@ func (r *TxStatusRequest) Validate(db ethdb.Database, msg *Msg) error {
 	return nil
 }
 
+// EpochHeadersRequest is the ODR request type for the chain of epoch headers
+type EpochHeadersRequest light.EpochHeadersRequest
+
+// GetCost returns the cost of the given ODR request according to the serving
+// peer's cost table (implementation of LesOdrRequest)
+func (r *EpochHeadersRequest) GetCost(peer *serverPeer) uint64 {
+	return peer.getRequestCost(GetEpochHeadersMsg, int(r.Amount))
+}
+
+// CanSend tells if a certain peer is suitable for serving the given request
+func (r *EpochHeadersRequest) CanSend(peer *serverPeer) bool {
+	return peer.version >= lpv5 && peer.HasBlock(common.Hash{}, r.Origin.Epoch.NextEpochBlock.Uint64(), false)
+}
+
+// Request sends an ODR request to the LES network (implementation of LesOdrRequest)
+func (r *EpochHeadersRequest) Request(reqID uint64, peer *serverPeer) error {
+	peer.Log().Debug("Requesting epoch headers", "origin", r.Origin.Number, "count", r.Amount)
+	return peer.requestEpochHeaders(reqID, r.Origin.Number.Uint64(), int(r.Amount))
+}
+
+// Validate processes an ODR request reply message from the LES network
+// returns true and stores results in memory if the message was a valid reply
+// to the request (implementation of LesOdrRequest)
+func (r *EpochHeadersRequest) Validate(db ethdb.Database, msg *Msg) error {
+	log.Debug("Validating epoch headers", "origin", r.Origin.Number, "count", r.Amount)
+
+	if msg.MsgType != MsgEpochHeaders {
+		return errInvalidMessageType
+	}
+	headers := msg.Obj.([]*types.Header)
+	if len(headers) == 0 || uint64(len(headers)) > r.Amount {
+		return errInvalidEntryCount
+	}
+	// Every epoch header is signed by the committee of the epoch it closes,
+	// which is carried by the previous epoch header of the chain.
+	current := r.Origin
+	for _, header := range headers {
+		if !header.IsEpochHeader() {
+			return finality.ErrNotEpochHeader
+		}
+		if err := finality.VerifyEpochLink(current, header); err != nil {
+			return err
+		}
+		if err := finality.VerifyQuorumCertificate(header, current.Epoch.Committee); err != nil {
+			return err
+		}
+		current = header
+	}
+	r.Headers = headers
+	return nil
+}
+
 // readTraceDB stores the keys of database reads. We use this to check that received node
 // sets contain only the trie nodes necessary to make proofs pass.
 type readTraceDB struct {
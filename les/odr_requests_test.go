@@ -0,0 +1,117 @@
+package les
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/consensus/tendermint/core/message"
+	"github.com/autonity/autonity/consensus/tendermint/finality"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/crypto/blst"
+)
+
+type testEpochHeader struct {
+	header *types.Header
+	keys   []blst.SecretKey
+}
+
+// newTestEpochHeaders creates a chain of count epoch headers of 10 blocks, each of
+// them being signed by the committee of the previous one, except the first one.
+func newTestEpochHeaders(t *testing.T, count int) []testEpochHeader {
+	var epochs []testEpochHeader
+	for i := 0; i < count; i++ {
+		number := uint64(i * 10)
+		committee := new(types.Committee)
+		keys := make([]blst.SecretKey, 4)
+		for j := range keys {
+			key, err := blst.RandKey()
+			if err != nil {
+				t.Fatal(err)
+			}
+			keys[j] = key
+			committee.Members = append(committee.Members, types.CommitteeMember{
+				Address:           common.Address{byte(i), byte(j)},
+				VotingPower:       big.NewInt(1),
+				ConsensusKeyBytes: key.PublicKey().Marshal(),
+				ConsensusKey:      key.PublicKey(),
+				Index:             uint64(j),
+			})
+		}
+		previous := number
+		if i > 0 {
+			previous = number - 10
+		}
+		header := &types.Header{
+			Number:     new(big.Int).SetUint64(number),
+			Difficulty: big.NewInt(1),
+			MixDigest:  types.BFTDigest,
+			Epoch: &types.Epoch{
+				PreviousEpochBlock: new(big.Int).SetUint64(previous),
+				NextEpochBlock:     new(big.Int).SetUint64(number + 10),
+				Committee:          committee,
+			},
+		}
+		if i > 0 {
+			signEpochHeader(header, epochs[i-1].header.Epoch.Committee, epochs[i-1].keys, 0, 1, 2)
+		}
+		epochs = append(epochs, testEpochHeader{header: header, keys: keys})
+	}
+	return epochs
+}
+
+// signEpochHeader aggregates the precommits of the given committee members into the quorum certificate of header.
+func signEpochHeader(header *types.Header, committee *types.Committee, keys []blst.SecretKey, signers ...int) {
+	seal := message.PrepareCommittedSeal(header.Hash(), int64(header.Round), header.Number)
+	bitmap := types.NewSigners(committee.Len())
+	var signatures []blst.Signature
+	for _, i := range signers {
+		signatures = append(signatures, keys[i].Sign(seal[:]))
+		bitmap.Increment(&committee.Members[i])
+	}
+	header.QuorumCertificate = types.NewAggregateSignature(blst.AggregateSignatures(signatures).(*blst.BlsSignature), bitmap)
+}
+
+func TestEpochHeadersRequestValidate(t *testing.T) {
+	epochs := newTestEpochHeaders(t, 4)
+	origin := epochs[0].header
+	headers := []*types.Header{epochs[1].header, epochs[2].header, epochs[3].header}
+
+	validate := func(amount uint64, msgType int, headers []*types.Header) (*EpochHeadersRequest, error) {
+		req := &EpochHeadersRequest{Origin: origin, Amount: amount}
+		return req, req.Validate(nil, &Msg{MsgType: msgType, Obj: headers})
+	}
+
+	req, err := validate(8, MsgEpochHeaders, headers)
+	if err != nil {
+		t.Fatalf("failed to validate epoch headers: %v", err)
+	}
+	if len(req.Headers) != len(headers) {
+		t.Fatalf("validated header count mismatch: have %d, want %d", len(req.Headers), len(headers))
+	}
+	if _, err := validate(8, MsgBlockHeaders, headers); err != errInvalidMessageType {
+		t.Fatalf("message type mismatch: have %v, want %v", err, errInvalidMessageType)
+	}
+	if _, err := validate(2, MsgEpochHeaders, headers); err != errInvalidEntryCount {
+		t.Fatalf("too many headers: have %v, want %v", err, errInvalidEntryCount)
+	}
+	if _, err := validate(8, MsgEpochHeaders, nil); err != errInvalidEntryCount {
+		t.Fatalf("no headers: have %v, want %v", err, errInvalidEntryCount)
+	}
+	// a missing epoch breaks the chain of committees
+	if _, err := validate(8, MsgEpochHeaders, []*types.Header{epochs[2].header}); !errors.Is(err, finality.ErrBrokenEpochChain) {
+		t.Fatalf("broken chain: have %v, want %v", err, finality.ErrBrokenEpochChain)
+	}
+	// the epoch header must be finalized by the committee of the previous epoch
+	forged := types.CopyHeader(epochs[2].header)
+	signEpochHeader(forged, epochs[2].header.Epoch.Committee, epochs[2].keys, 0, 1, 2)
+	if _, err := validate(8, MsgEpochHeaders, []*types.Header{epochs[1].header, forged}); !errors.Is(err, types.ErrInvalidQuorumCertificate) {
+		t.Fatalf("forged quorum certificate: have %v, want %v", err, types.ErrInvalidQuorumCertificate)
+	}
+	weak := types.CopyHeader(epochs[1].header)
+	signEpochHeader(weak, origin.Epoch.Committee, epochs[0].keys, 0)
+	if _, err := validate(8, MsgEpochHeaders, []*types.Header{weak}); !errors.Is(err, finality.ErrInsufficientPower) {
+		t.Fatalf("insufficient quorum: have %v, want %v", err, finality.ErrInsufficientPower)
+	}
+}
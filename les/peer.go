@@ -481,6 +481,12 @@ func (p *serverPeer) requestTxStatus(reqID uint64, txHashes []common.Hash) error
 	return p.sendRequest(GetTxStatusMsg, reqID, txHashes, len(txHashes))
 }
 
+// requestEpochHeaders fetches the chain of epoch headers following the given epoch header from a remote node.
+func (p *serverPeer) requestEpochHeaders(reqID uint64, origin uint64, amount int) error {
+	p.Log().Debug("Fetching batch of epoch headers", "origin", origin, "count", amount)
+	return p.sendRequest(GetEpochHeadersMsg, reqID, &GetEpochHeadersData{Origin: origin, Amount: uint64(amount)}, amount)
+}
+
 // sendTxs creates a reply with a batch of transactions to be added to the remote transaction pool.
 func (p *serverPeer) sendTxs(reqID uint64, amount int, txs rlp.RawValue) error {
 	p.Log().Debug("Sending batch of transactions", "amount", amount, "size", len(txs))
@@ -666,7 +672,8 @@ func (p *serverPeer) Handshake(genesis common.Hash, forkid forkid.ID, forkFilter
 
 		if !p.onlyAnnounce {
 			for msgCode := range reqAvgTimeCost {
-				if p.fcCosts[msgCode] == nil {
+				// Requests introduced by later protocol versions are not priced
+				if msgCode < ProtocolLengths[uint(p.version)] && p.fcCosts[msgCode] == nil {
 					return errResp(ErrUselessPeer, "peer does not support message %d", msgCode)
 				}
 			}
@@ -924,6 +931,12 @@ func (p *clientPeer) replyTxStatus(reqID uint64, stats []light.TxStatus) *reply
 	return &reply{p.rw, TxStatusMsg, reqID, data}
 }
 
+// replyEpochHeaders creates a reply with a chain of epoch headers, following the requested one.
+func (p *clientPeer) replyEpochHeaders(reqID uint64, headers []*types.Header) *reply {
+	data, _ := rlp.EncodeToBytes(headers)
+	return &reply{p.rw, EpochHeadersMsg, reqID, data}
+}
+
 // sendAnnounce announces the availability of a number of blocks through
 // a hash notification.
 func (p *clientPeer) sendAnnounce(request announceData) error {
@@ -50,6 +50,7 @@ const (
 	MaxHelperTrieProofsFetch = 64  // Amount of helper tries to be fetched per retrieval request
 	MaxTxSend                = 64  // Amount of transactions to be send per request
 	MaxTxStatus              = 256 // Amount of transactions to queried per request
+	MaxEpochHeaderFetch      = 64  // Amount of epoch headers to be fetched per retrieval request
 )
 
 var (
@@ -285,6 +286,9 @@ func (h *serverHandler) handleMsg(p *clientPeer, wg *sync.WaitGroup) error {
 	// Lookup the request handler table, ensure it's supported
 	// message type by the protocol.
 	req, ok := Les3[msg.Code]
+	if ok && msg.Code == GetEpochHeadersMsg && p.version < lpv5 {
+		ok = false
+	}
 	if !ok {
 		p.Log().Trace("Received invalid message", "code", msg.Code)
 		clientErrorMeter.Mark(1)
@@ -63,7 +63,8 @@ type RequestType struct {
 // by the protocol handler when calling the send function of the returned reply struct.
 type serveRequestFn func(backend serverBackend, peer *clientPeer, waitOrStop func() bool) *reply
 
-// Les3 contains the request types supported by les/2 and les/3
+// Les3 contains the request types supported by les/2 and les/3, along with the
+// epoch header request introduced in les/5
 var Les3 = map[uint64]RequestType{
 	GetBlockHeadersMsg: {
 		Name:             "block header request",
@@ -145,6 +146,16 @@ var Les3 = map[uint64]RequestType{
 		ServingTimeMeter: miscServingTimeTxStatusTimer,
 		Handle:           handleGetTxStatus,
 	},
+	GetEpochHeadersMsg: {
+		Name:             "epoch header request",
+		MaxCount:         MaxEpochHeaderFetch,
+		InPacketsMeter:   miscInEpochHeaderPacketsMeter,
+		InTrafficMeter:   miscInEpochHeaderTrafficMeter,
+		OutPacketsMeter:  miscOutEpochHeaderPacketsMeter,
+		OutTrafficMeter:  miscOutEpochHeaderTrafficMeter,
+		ServingTimeMeter: miscServingTimeEpochHeaderTimer,
+		Handle:           handleGetEpochHeaders,
+	},
 }
 
 // handleGetBlockHeaders handles a block header request
@@ -240,6 +251,38 @@ func handleGetBlockHeaders(msg Decoder) (serveRequestFn, uint64, uint64, error)
 	}, r.ReqID, r.Query.Amount, nil
 }
 
+// handleGetEpochHeaders handles a request for the chain of epoch headers
+// following the requested epoch header
+func handleGetEpochHeaders(msg Decoder) (serveRequestFn, uint64, uint64, error) {
+	var r GetEpochHeadersPacket
+	if err := msg.Decode(&r); err != nil {
+		return nil, 0, 0, err
+	}
+	return func(backend serverBackend, p *clientPeer, waitOrStop func() bool) *reply {
+		var (
+			bc      = backend.BlockChain()
+			current = bc.GetHeaderByNumber(r.Query.Origin)
+			bytes   common.StorageSize
+			headers []*types.Header
+		)
+		for current != nil && current.IsEpochHeader() && len(headers) < int(r.Query.Amount) && bytes < softResponseLimit {
+			if len(headers) != 0 && !waitOrStop() {
+				return nil
+			}
+			// The epoch header closing the current epoch is unknown until the
+			// epoch is over, thus the chain stops at the latest epoch header.
+			next := bc.GetHeaderByNumber(current.Epoch.NextEpochBlock.Uint64())
+			if next == nil || !next.IsEpochHeader() {
+				break
+			}
+			headers = append(headers, next)
+			bytes += next.Size()
+			current = next
+		}
+		return p.replyEpochHeaders(r.ReqID, headers)
+	}, r.ReqID, r.Query.Amount, nil
+}
+
 // handleGetBlockBodies handles a block body request
 func handleGetBlockBodies(msg Decoder) (serveRequestFn, uint64, uint64, error) {
 	var r GetBlockBodiesPacket
@@ -31,6 +31,9 @@ import (
 
 var errInvalidCheckpoint = errors.New("invalid advertised checkpoint")
 
+// epochSyncTimeout is the maximum time allowed to retrieve the chain of epoch headers.
+const epochSyncTimeout = time.Minute
+
 const (
 	// lightSync starts syncing from the current highest block.
 	// If the chain is empty, syncing the entire header chain.
@@ -192,6 +195,24 @@ func (h *clientHandler) synchronise(peer *serverPeer) {
 		}
 	}
 
+	// Skip the epochs the server is ahead of us by following the chain of epoch
+	// headers, each of them being finalized by the committee of the previous
+	// epoch. The headers of the last epoch are then verified one by one.
+	if h.backend.config.EpochSync && peer.version >= lpv5 {
+		ctx, cancel := context.WithTimeout(context.Background(), epochSyncTimeout)
+		defer cancel()
+		if err := h.backend.blockchain.SyncEpochHeaders(ctx, peer.headInfo.Number); err != nil {
+			log.Debug("Epoch header sync failed", "reason", err)
+			// The epoch headers might be retrieved from other servers, which are
+			// dropped by the retrieval if they serve invalid headers. The peer is
+			// kept on timeouts and retrieval failures.
+			if errors.Is(err, light.ErrInvalidEpochHeaders) {
+				h.removePeer(peer.id)
+			}
+			return
+		}
+	}
+
 	if h.syncStart != nil {
 		h.syncStart(h.backend.blockchain.CurrentHeader())
 	}
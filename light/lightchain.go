@@ -21,6 +21,7 @@ package light
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math/big"
 	"sync"
 	"sync/atomic"
@@ -28,6 +29,7 @@ import (
 
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/consensus"
+	"github.com/autonity/autonity/consensus/tendermint/finality"
 	"github.com/autonity/autonity/core"
 	"github.com/autonity/autonity/core/rawdb"
 	"github.com/autonity/autonity/core/state"
@@ -45,6 +47,14 @@ var (
 	blockCacheLimit = 256
 )
 
+// epochHeaderFetch is the number of epoch headers retrieved per request, matching
+// the limit of the servers.
+const epochHeaderFetch = 64
+
+// ErrInvalidEpochHeaders is returned if the retrieved epoch headers do not form
+// a chain of epochs following the latest known epoch header.
+var ErrInvalidEpochHeaders = errors.New("invalid epoch headers")
+
 // LightChain represents a canonical chain that by default only handles block
 // headers, downloading block bodies and receipts on demand through an ODR
 // interface. It only does header validation during chain insertion.
@@ -339,6 +349,8 @@ func (lc *LightChain) Stop() {
 	if !atomic.CompareAndSwapInt32(&lc.running, 0, 1) {
 		return
 	}
+	// Unsubscribe all subscriptions registered from the light chain
+	lc.scope.Close()
 	close(lc.quit)
 	lc.StopInsert()
 	lc.wg.Wait()
@@ -449,14 +461,16 @@ func (lc *LightChain) SetChainHead(header *types.Header) error {
 // chain, possibly creating a reorg. If an error is returned, it will return the
 // index number of the failing header as well an error describing what went wrong.
 //
-// The verify parameter can be used to fine tune whether nonce verification
-// should be done or not. The reason behind the optional check is because some
-// of the header retrieval mechanisms already need to verfy nonces, as well as
-// because nonces can be verified sparsely, not needing to check each.
+// The checkFreq parameter is ignored, the seal of every header is verified
+// unless the check frequency is disabled.
 //
 // In the case of a light chain, InsertHeaderChain also creates and posts light
 // chain events when necessary.
 func (lc *LightChain) InsertHeaderChain(chain []*types.Header, checkFreq int) (int, error) {
+	// The seal of every header is verified, unless the servers are trusted (ultra
+	// light client), since a light client cannot detect a forged header once it
+	// has been accepted. The BFT engine verifies every quorum certificate anyway.
+	checkFreq = 1
 	if atomic.LoadInt32(&lc.disableCheckFreq) == 1 {
 		checkFreq = 0
 	}
@@ -610,6 +624,97 @@ func (lc *LightChain) SyncCheckpoint(ctx context.Context, checkpoint *params.Tru
 	return false
 }
 
+// SyncEpochHeaders retrieves the chain of epoch headers following the latest
+// known epoch header up to the given head, each of them being verified to follow
+// the previous one and to be finalized by the committee of the previous epoch.
+// The head of the chain then jumps to the latest epoch header, the headers in
+// between being skipped. ErrInvalidEpochHeaders is returned if the headers
+// retrieved do not follow the previous ones, any other error being a failure
+// of the retrieval.
+func (lc *LightChain) SyncEpochHeaders(ctx context.Context, head uint64) error {
+	for {
+		origin := lc.hc.CurrentHeadEpochHeader()
+		if origin == nil {
+			return core.ErrMissingEpochHeader
+		}
+		if origin.Epoch.NextEpochBlock.Uint64() > head {
+			return nil
+		}
+		req := &EpochHeadersRequest{Origin: origin, Amount: epochHeaderFetch}
+		if err := lc.odr.Retrieve(ctx, req); err != nil {
+			return err
+		}
+		// The quorum certificates are verified by the ODR backend along with the
+		// links, the chain of epochs is checked again before being inserted.
+		current := origin
+		for _, header := range req.Headers {
+			if !header.IsEpochHeader() {
+				return fmt.Errorf("%w: header %d is not an epoch header", ErrInvalidEpochHeaders, header.Number)
+			}
+			if err := finality.VerifyEpochLink(current, header); err != nil {
+				return fmt.Errorf("%w: %v", ErrInvalidEpochHeaders, err)
+			}
+			current = header
+		}
+		if err := lc.insertEpochHeaders(origin, req.Headers); err != nil {
+			return err
+		}
+		if lc.hc.CurrentHeadEpochHeader().Hash() == origin.Hash() {
+			return nil
+		}
+	}
+}
+
+// insertEpochHeaders writes the verified epoch headers following the origin
+// epoch header and moves the head of the chain to the latest one.
+func (lc *LightChain) insertEpochHeaders(origin *types.Header, headers []*types.Header) error {
+	lc.chainmu.Lock()
+	defer lc.chainmu.Unlock()
+
+	lc.wg.Add(1)
+	defer lc.wg.Done()
+
+	// Ensure the chain didn't move past the origin while retrieving the headers
+	if lc.hc.CurrentHeadEpochHeader().Hash() != origin.Hash() {
+		return nil
+	}
+	current := lc.hc.CurrentHeader().Number.Uint64()
+	batch := lc.chainDb.NewBatch()
+	var head *types.Header
+	for _, header := range headers {
+		number := header.Number.Uint64()
+		if number <= current {
+			continue
+		}
+		// The difficulty of the blocks is constant, thus the total difficulty is
+		// derived from the number of the header.
+		td := new(big.Int).Mul(header.Difficulty, header.Number)
+		td.Add(td, lc.genesisBlock.Difficulty())
+
+		rawdb.WriteHeader(batch, header)
+		rawdb.WriteTd(batch, header.Hash(), number, td)
+		rawdb.WriteCanonicalHash(batch, header.Hash(), number)
+		head = header
+	}
+	if head == nil {
+		return nil
+	}
+	rawdb.WriteHeadHeaderHash(batch, head.Hash())
+	rawdb.WriteEpochHeaderHash(batch, head.Hash())
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	lc.hc.SetCurrentHeader(head)
+	lc.hc.SetCurrentHeadEpochHeader(head)
+
+	block := types.NewBlockWithHeader(head)
+	lc.chainFeed.Send(core.ChainEvent{Block: block, Hash: block.Hash()})
+	lc.chainHeadFeed.Send(core.ChainHeadEvent{Block: block})
+	log.Info("Updated latest header based on epoch headers", "number", head.Number, "hash", head.Hash(),
+		"count", len(headers), "age", common.PrettyAge(time.Unix(int64(head.Time), 0)))
+	return nil
+}
+
 // LockChain locks the chain mutex for reading so that multiple canonical hashes can be
 // retrieved while it is guaranteed that they belong to the same version of the chain
 func (lc *LightChain) LockChain() {
@@ -27,6 +27,7 @@ import (
 	"github.com/autonity/autonity/core"
 	"github.com/autonity/autonity/core/rawdb"
 	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/crypto/blst"
 	"github.com/autonity/autonity/ethdb"
 	"github.com/autonity/autonity/params"
 )
@@ -355,3 +356,105 @@ func TestReorgBadHeaderHashes(t *testing.T) {
 		t.Errorf("last header hash mismatch: have: %x, want %x", ncm.CurrentHeader().Hash(), headers[2].Hash())
 	}
 }
+
+// epochOdr serves the chain of epoch headers following the requested origin.
+type epochOdr struct {
+	dummyOdr
+	headers []*types.Header
+}
+
+func (odr *epochOdr) Retrieve(ctx context.Context, req OdrRequest) error {
+	r, ok := req.(*EpochHeadersRequest)
+	if !ok {
+		return nil
+	}
+	for _, header := range odr.headers {
+		if header.Number.Cmp(r.Origin.Number) > 0 && uint64(len(r.Headers)) < r.Amount {
+			r.Headers = append(r.Headers, header)
+		}
+	}
+	return nil
+}
+
+func newEpochHeader(t *testing.T, number uint64, period uint64) *types.Header {
+	key, err := blst.RandKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &types.Header{
+		Number:     new(big.Int).SetUint64(number),
+		Difficulty: big.NewInt(1),
+		Epoch: &types.Epoch{
+			PreviousEpochBlock: new(big.Int).SetUint64(number - period),
+			NextEpochBlock:     new(big.Int).SetUint64(number + period),
+			Committee: &types.Committee{Members: []types.CommitteeMember{{
+				Address:           common.Address{byte(number)},
+				VotingPower:       big.NewInt(1),
+				ConsensusKeyBytes: key.PublicKey().Marshal(),
+				ConsensusKey:      key.PublicKey(),
+			}}},
+		},
+	}
+}
+
+// Tests that the light chain jumps to the latest epoch header retrieved from the
+// chain of epoch headers, skipping the headers in between.
+func TestSyncEpochHeaders(t *testing.T) {
+	lc := newTestLightChain()
+	odr := &epochOdr{dummyOdr: dummyOdr{db: lc.chainDb}}
+	lc.odr = odr
+
+	origin := newEpochHeader(t, 0, 0)
+	origin.Epoch.NextEpochBlock = big.NewInt(10)
+	lc.hc.SetCurrentHeadEpochHeader(origin)
+	for number := uint64(10); number <= 30; number += 10 {
+		odr.headers = append(odr.headers, newEpochHeader(t, number, 10))
+	}
+
+	if err := lc.SyncEpochHeaders(context.Background(), 35); err != nil {
+		t.Fatalf("failed to sync epoch headers: %v", err)
+	}
+	if head := lc.CurrentHeader(); head.Hash() != odr.headers[2].Hash() {
+		t.Fatalf("head mismatch: have %d, want %d", head.Number, odr.headers[2].Number)
+	}
+	if header := lc.GetHeaderByNumber(20); header == nil || header.Hash() != odr.headers[1].Hash() {
+		t.Fatalf("epoch header 20 not canonical")
+	}
+	if header := lc.GetHeaderByNumber(15); header != nil {
+		t.Fatalf("skipped header 15 should be unknown")
+	}
+	epoch, err := lc.EpochOfHeight(35)
+	if err != nil {
+		t.Fatalf("failed to get the epoch of the head: %v", err)
+	}
+	if epoch.EpochBlock.Uint64() != 30 || epoch.NextEpochBlock.Uint64() != 40 {
+		t.Fatalf("epoch mismatch: have [%d, %d], want [30, 40]", epoch.EpochBlock, epoch.NextEpochBlock)
+	}
+	// The next epoch header is not final yet, the chain does not move.
+	if err := lc.SyncEpochHeaders(context.Background(), 39); err != nil {
+		t.Fatalf("failed to sync epoch headers: %v", err)
+	}
+	if head := lc.CurrentHeader(); head.Number.Uint64() != 30 {
+		t.Fatalf("head moved to %d", head.Number)
+	}
+}
+
+// Tests that epoch headers not following the latest known epoch header are
+// rejected without moving the chain.
+func TestSyncEpochHeadersBrokenChain(t *testing.T) {
+	lc := newTestLightChain()
+	odr := &epochOdr{dummyOdr: dummyOdr{db: lc.chainDb}}
+	lc.odr = odr
+
+	origin := newEpochHeader(t, 0, 0)
+	origin.Epoch.NextEpochBlock = big.NewInt(10)
+	lc.hc.SetCurrentHeadEpochHeader(origin)
+	odr.headers = []*types.Header{newEpochHeader(t, 10, 10), newEpochHeader(t, 30, 10)}
+
+	if err := lc.SyncEpochHeaders(context.Background(), 35); !errors.Is(err, ErrInvalidEpochHeaders) {
+		t.Fatalf("error mismatch: have %v, want %v", err, ErrInvalidEpochHeaders)
+	}
+	if head := lc.CurrentHeader(); head.Number.Uint64() != 0 {
+		t.Fatalf("head moved to %d", head.Number)
+	}
+}
@@ -176,6 +176,17 @@ func (req *BloomRequest) StoreResult(db ethdb.Database) {
 	}
 }
 
+// EpochHeadersRequest is the ODR request type for retrieving the chain of epoch
+// headers following a trusted epoch header
+type EpochHeadersRequest struct {
+	Origin  *types.Header   // Trusted epoch header the chain is retrieved from
+	Amount  uint64          // Maximum number of epoch headers to retrieve
+	Headers []*types.Header // Verified epoch headers following the origin
+}
+
+// StoreResult is a no-op, the epoch headers are inserted by the light chain
+func (req *EpochHeadersRequest) StoreResult(db ethdb.Database) {}
+
 // TxStatus describes the status of a transaction
 type TxStatus struct {
 	Status core.TxStatus
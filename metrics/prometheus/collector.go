@@ -36,7 +36,8 @@ var (
 // collector is a collection of byte buffers that aggregate Prometheus reports
 // for different metric types.
 type collector struct {
-	buff *bytes.Buffer
+	buff   *bytes.Buffer
+	family string // last labelled metric family written
 }
 
 // newCollector creates a new Prometheus metric aggregator.
@@ -98,10 +99,20 @@ func (c *collector) addResettingTimer(name string, m metrics.ResettingTimer) {
 	c.buff.WriteRune('\n')
 }
 
+// writeGaugeCounter writes a gauge sample. Metrics named with labels, such as
+// name{label="value"}, are written as samples of the same family, whose type is
+// only written before the first sample. The metrics are expected to be sorted.
 func (c *collector) writeGaugeCounter(name string, value interface{}) {
-	name = mutateKey(name)
-	c.buff.WriteString(fmt.Sprintf(typeGaugeTpl, name))
-	c.buff.WriteString(fmt.Sprintf(keyValueTpl, name, value))
+	family, labels := splitLabels(name)
+	family = mutateKey(family)
+	if labels == "" || family != c.family {
+		c.buff.WriteString(fmt.Sprintf(typeGaugeTpl, family))
+	}
+	c.family = ""
+	if labels != "" {
+		c.family = family
+	}
+	c.buff.WriteString(fmt.Sprintf(keyValueTpl, family+labels, value))
 }
 
 func (c *collector) writeSummaryCounter(name string, value interface{}) {
@@ -115,6 +126,14 @@ func (c *collector) writeSummaryPercentile(name, p string, value interface{}) {
 	c.buff.WriteString(fmt.Sprintf(keyQuantileTagValueTpl, name, p, value))
 }
 
+// splitLabels splits a metric name into its family name and its labels.
+func splitLabels(name string) (string, string) {
+	if i := strings.IndexByte(name, '{'); i > 0 && strings.HasSuffix(name, "}") {
+		return name[:i], name[i:]
+	}
+	return name, ""
+}
+
 func mutateKey(key string) string {
 	return strings.Replace(key, "/", "_", -1)
 }
@@ -24,6 +24,12 @@ func TestCollector(t *testing.T) {
 	gauge.Update(23456)
 	c.addGauge("test/gauge", gauge)
 
+	for _, label := range []string{"a", "b"} {
+		labelled := metrics.NewGauge()
+		labelled.Update(int64(len(label)))
+		c.addGauge("test/labelled{label=\""+label+"\"}", labelled)
+	}
+
 	gaugeFloat64 := metrics.NewGaugeFloat64()
 	gaugeFloat64.Update(34567.89)
 	c.addGaugeFloat64("test/gauge_float64", gaugeFloat64)
@@ -64,6 +70,11 @@ test_counter 12345
 # TYPE test_gauge gauge
 test_gauge 23456
 
+# TYPE test_labelled gauge
+test_labelled{label="a"} 1
+
+test_labelled{label="b"} 1
+
 # TYPE test_gauge_float64 gauge
 test_gauge_float64 34567.89
 
@@ -28,7 +28,9 @@ import (
 
 	"github.com/autonity/autonity/crypto/blst"
 
+	"github.com/autonity/autonity/accounts/keystore"
 	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/consensus/tendermint/alerts"
 	"github.com/autonity/autonity/consensus/tendermint/core/interfaces"
 	"github.com/autonity/autonity/crypto"
 	"github.com/autonity/autonity/log"
@@ -52,6 +54,9 @@ type Config struct {
 	// ConsensusKey, it is used by consensus engine.
 	ConsensusKey blst.SecretKey
 
+	// AutonityKeysPassword is used to decrypt the autonity keys file of the data directory when it is encrypted.
+	AutonityKeysPassword string `toml:"-"`
+
 	// Name sets the instance name of the node. It must not contain the / character and is
 	// used in the devp2p node identifier. The instance name of autonity is "autonity". If no
 	// value is specified, the basename of the current executable is used.
@@ -77,6 +82,31 @@ type Config struct {
 	// Configuration of peer-to-peer networking on consensus
 	ConsensusP2P p2p.Config
 
+	// CommitteePreconnect is the number of blocks before the end of an epoch from which the consensus network
+	// connects to the members of the next committee as well. Zero disables the pre-connection.
+	CommitteePreconnect uint64 `toml:",omitempty"`
+
+	// ConsensusSentries are the sentry nodes a validator joins the consensus network through, on their private
+	// endpoint. The validator then connects to its sentries only and does not accept inbound consensus connections.
+	// The public consensus endpoints of the sentries are declared in the enode registered in the Autonity contract.
+	ConsensusSentries []*enode.Node `toml:",omitempty"`
+
+	// ConsensusSentryOf is the validator a sentry node joins the consensus network on behalf of. The sentry relays
+	// the consensus messages between the validator and the committee.
+	ConsensusSentryOf *enode.Node `toml:",omitempty"`
+
+	// ConsensusWAL enables the write-ahead log of the consensus core, which records the events delivered to the
+	// core so that the heights can be replayed with `autonity consensus replay`.
+	ConsensusWAL bool `toml:",omitempty"`
+
+	// ConsensusWALHeights is the number of latest heights whose write-ahead log is retained.
+	ConsensusWALHeights uint64 `toml:",omitempty"`
+
+	// Alerts configures the sinks the alerts concerning the validator are delivered to: accusations and fault
+	// proofs against it, slashing and jailing, and falling out of the committee. Relative file paths are resolved
+	// against the data directory.
+	Alerts alerts.Config
+
 	// KeyStoreDir is the file system folder that contains private keys. The directory can
 	// be specified as a relative path, in which case it is resolved relative to the
 	// current directory.
@@ -89,6 +119,13 @@ type Config struct {
 	// ExternalSigner specifies an external URI for a clef-type signer
 	ExternalSigner string `toml:",omitempty"`
 
+	// ConsensusSigner specifies an external URI for a clef-type signer holding the consensus key. If set, consensus
+	// messages are signed by the external signer instead of with the local consensus key.
+	ConsensusSigner string `toml:",omitempty"`
+
+	// ConsensusSignerAllowKeyMismatch allows the consensus signer to hold another key than the local consensus key.
+	ConsensusSignerAllowKeyMismatch bool `toml:",omitempty"`
+
 	// UseLightweightKDF lowers the memory and CPU requirements of the key store
 	// scrypt KDF at the expense of security.
 	UseLightweightKDF bool `toml:",omitempty"`
@@ -423,6 +460,14 @@ func (c *Config) AutonityKeys() (*ecdsa.PrivateKey, blst.SecretKey) {
 	}
 
 	keyfile := c.ResolvePath(datadirPrivateKey)
+	// An encrypted key file must never be replaced by newly generated keys.
+	if encrypted, err := keystore.IsEncryptedAutonityKeys(keyfile); err == nil && encrypted {
+		key, consensusKey, err := keystore.LoadAutonityKeys(keyfile, c.AutonityKeysPassword)
+		if err != nil {
+			log.Crit("Failed to unlock encrypted autonity keys, provide the password with --autonitykeys.password", "file", keyfile, "err", err)
+		}
+		return key, consensusKey
+	}
 	if key, consensusKey, err := crypto.LoadAutonityKeys(keyfile); err == nil {
 		return key, consensusKey
 	}
@@ -39,6 +39,9 @@ const (
 	DefaultETHPortInt  = 30303
 	DefaultATCPort     = ":20203"
 	DefaultATCPortInt  = 20203
+
+	DefaultCommitteePreconnect = 10  // Default number of blocks before the epoch end to connect to the next committee
+	DefaultConsensusWALHeights = 100 // Default number of heights whose consensus write-ahead log is retained
 )
 
 // DefaultConfig contains reasonable default settings.
@@ -62,6 +65,8 @@ var DefaultConfig = Config{
 		MaxPendingPeers: 100,
 		NAT:             nat.Any(),
 	},
+	CommitteePreconnect: DefaultCommitteePreconnect,
+	ConsensusWALHeights: DefaultConsensusWALHeights,
 }
 
 // DefaultDataDir is the default data directory to use for the databases and other
@@ -144,6 +144,10 @@ func New(conf *Config) (*Node, error) {
 	node.consensusServer.Config.PrivateKey, _ = node.config.AutonityKeys()
 	node.consensusServer.Config.Name = node.config.NodeName()
 	node.consensusServer.Config.Logger = node.log
+	if len(conf.ConsensusSentries) > 0 {
+		// a validator behind sentries only dials its sentries
+		node.consensusServer.Config.ListenAddr = ""
+	}
 	// Check HTTP/WS prefixes are valid.
 	if err := validatePrefix("HTTP", conf.HTTPPathPrefix); err != nil {
 		return nil, err
@@ -54,6 +54,23 @@ type NodeDialer interface {
 	Dial(context.Context, *enode.Node) (net.Conn, error)
 }
 
+// PipeDialer is a NodeDialer connecting in-process servers through in-memory pipes,
+// the connections being accepted as inbound ones by the destination server. Server
+// returns the server of the destination node, or an error if it cannot be reached.
+type PipeDialer struct {
+	Server func(dest *enode.Node) (*Server, error)
+}
+
+func (d *PipeDialer) Dial(_ context.Context, dest *enode.Node) (net.Conn, error) {
+	server, err := d.Server(dest)
+	if err != nil {
+		return nil, err
+	}
+	local, remote := net.Pipe()
+	go server.SetupConn(remote, inboundConn, nil)
+	return local, nil
+}
+
 type nodeResolver interface {
 	Resolve(*enode.Node) *enode.Node
 }
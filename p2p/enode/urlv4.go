@@ -344,3 +344,35 @@ func AppendConsensusEndpoint(host, port string, ens string) string {
 	u.RawQuery = q.Encode()
 	return u.String()
 }
+
+// ParseACNSentries returns the sentry nodes declared by a node URL, which join the consensus network on behalf of
+// the node. Each sentry is given by a "sentry" query parameter, in the form <hex node id>@<host>:<acn port>.
+//
+//	enode://<hex node id>@10.3.58.6:30303?acn=10.3.58.6:20203&sentry=<hex node id>@10.3.58.7:20203
+func ParseACNSentries(rawurl string) ([]*Node, error) {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return nil, err
+	}
+	var sentries []*Node
+	for _, sentry := range u.Query()["sentry"] {
+		n, err := parseComplete("enode://"+sentry, V4ResolveFunc, ethProtoParams)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sentry %q: %w", sentry, err)
+		}
+		sentries = append(sentries, n)
+	}
+	return sentries, nil
+}
+
+// AppendSentry declares the consensus endpoint of a sentry node in a node URL.
+func AppendSentry(sentry *Node, ens string) string {
+	u, err := url.Parse(ens)
+	if err != nil {
+		return ens
+	}
+	q := u.Query()
+	q.Add("sentry", fmt.Sprintf("%x@%s", crypto.FromECDSAPub(sentry.Pubkey())[1:], (&net.TCPAddr{IP: sentry.IP(), Port: sentry.TCP()}).String()))
+	u.RawQuery = q.Encode()
+	return u.String()
+}
@@ -263,3 +263,25 @@ func TestParseACNNode(t *testing.T) {
 		assert.Equal(t, test.wantResult, n)
 	}
 }
+
+func TestParseACNSentries(t *testing.T) {
+	validator := "enode://1dd9d65c4552b5eb43d5ad55a2ee3f56c6cbc1c64a5c8d659f51fcd51bace24351232b8d7821617d2b29b54b81cdefb9b3e9c37d7fd5f63270bcc9e1a6f6a439@127.0.0.1:52150?acn=127.0.1.1:23210"
+	sentries, err := ParseACNSentries(validator)
+	assert.NoError(t, err)
+	assert.Empty(t, sentries)
+
+	key, _ := crypto.GenerateKey()
+	sentry := NewV4(&key.PublicKey, net.IP{10, 0, 0, 1}, 20203, 20203)
+	declared := AppendSentry(sentry, validator)
+	sentries, err = ParseACNSentries(declared)
+	assert.NoError(t, err)
+	assert.Equal(t, []*Node{sentry}, sentries)
+
+	// the consensus endpoint of the validator is unchanged
+	n, err := ParseACNV4(declared)
+	assert.NoError(t, err)
+	assert.Equal(t, 23210, n.TCP())
+
+	_, err = ParseACNSentries(validator + "&sentry=invalid")
+	assert.Error(t, err)
+}
@@ -588,3 +588,42 @@ func syncAddPeer(srv *Server, node *enode.Node) bool {
 		}
 	}
 }
+
+func TestServerPipeDialer(t *testing.T) {
+	srv2 := &Server{Config: Config{
+		PrivateKey:  newkey(),
+		MaxPeers:    1,
+		NoDiscovery: true,
+		NoDial:      true,
+		Logger:      testlog.Logger(t, log.LvlTrace).New("server", "2"),
+	}}
+	srv1 := &Server{Config: Config{
+		PrivateKey:  newkey(),
+		MaxPeers:    1,
+		NoDiscovery: true,
+		Dialer: &PipeDialer{Server: func(dest *enode.Node) (*Server, error) {
+			if dest.ID() != srv2.LocalNode().ID() {
+				return nil, errors.New("unknown node")
+			}
+			return srv2, nil
+		}},
+		Logger: testlog.Logger(t, log.LvlTrace).New("server", "1"),
+	}}
+	srv1.Start()
+	defer srv1.Stop()
+	srv2.Start()
+	defer srv2.Stop()
+
+	// the node is not dialed without a port, which the pipes do not use
+	node := enode.NewV4(&srv2.PrivateKey.PublicKey, net.IP{127, 0, 0, 1}, 30303, 0)
+	if !syncAddPeer(srv1, node) {
+		t.Fatal("peer not connected")
+	}
+	if peer := srv1.Peers()[0]; peer.Inbound() {
+		t.Error("dialed peer is inbound")
+	}
+	peers := srv2.Peers()
+	if len(peers) != 1 || !peers[0].Inbound() {
+		t.Errorf("dialing peer is not inbound: %v", peers)
+	}
+}
@@ -712,7 +712,7 @@ var (
 	//
 	// This configuration is intentionally not using keyed fields to force anyone
 	// adding flags to the config to also have to set these fields.
-	AllEthashProtocolChanges = &ChainConfig{big.NewInt(1337), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, nil, nil, nil, new(EthashConfig), nil, nil, nil, nil, AsmConfig{}, nil, nil, false}
+	AllEthashProtocolChanges = &ChainConfig{big.NewInt(1337), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, nil, nil, nil, new(EthashConfig), nil, nil, nil, nil, AsmConfig{}, nil, nil, nil, nil, false}
 
 	TestNodeKeys = []string{
 		"b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291",
@@ -785,6 +785,8 @@ var (
 		},
 		DefaultNonStakableVestingGenesis,
 		DefaultStakableVestingGenesis,
+		nil,
+		nil,
 		false,
 	}
 )
@@ -900,10 +902,41 @@ type ChainConfig struct {
 	NonStakableVestingConfig *NonStakableVestingGenesis  `json:"nonStakableVesting,omitempty"`
 	StakableVestingConfig    *StakableVestingGenesis     `json:"stakableVesting,omitempty"`
 
+	// ProposerElection schedules the switches of the proposer election strategy, ordered by block. The weighted
+	// random sampling strategy applies until the first of them.
+	ProposerElection []ProposerElectionFork `json:"proposerElection,omitempty"`
+
+	// ConsensusGossip selects how the consensus messages are disseminated in the committee. They are sent to every
+	// committee member when not set.
+	ConsensusGossip *ConsensusGossipConfig `json:"consensusGossip,omitempty"`
+
 	// true if run in testmode, false by default
 	TestMode bool `json:"testMode,omitempty"`
 }
 
+// ProposerElectionFork switches the proposer election strategy of the consensus engine from Block onwards. The
+// strategy is referred to by the name it is registered with by the consensus engine.
+type ProposerElectionFork struct {
+	Block    *big.Int `json:"block"`
+	Strategy string   `json:"strategy"`
+}
+
+const (
+	FullGossipMode  = "full"  // consensus messages are sent to every committee member
+	RelayGossipMode = "relay" // consensus messages are sent to a subset of the committee, which relays them
+
+	// DefaultRelayMinCommitteeSize is the committee size from which the relay mode applies, if not configured.
+	DefaultRelayMinCommitteeSize = 20
+)
+
+// ConsensusGossipConfig is the dissemination mode of the consensus messages. In relay mode, the committees of at
+// least MinCommitteeSize members are arranged in a deterministic graph: each member sends its messages to its
+// neighbours only, which relay the messages they had not seen to their own neighbours.
+type ConsensusGossipConfig struct {
+	Mode             string `json:"mode"`
+	MinCommitteeSize uint64 `json:"minCommitteeSize,omitempty"`
+}
+
 type AsmConfig struct {
 	ACUContractConfig           *AcuContractGenesis           `json:"acu,omitempty"`
 	StabilizationContractConfig *StabilizationContractGenesis `json:"stabilization,omitempty"`
@@ -1010,6 +1043,32 @@ func (c *ChainConfig) IsLondon(num *big.Int) bool {
 	return isForked(c.LondonBlock, num)
 }
 
+// RelayConsensusGossip returns whether the consensus messages of a committee of the given size are relayed rather
+// than sent to every member.
+func (c *ChainConfig) RelayConsensusGossip(committeeSize int) bool {
+	if c.ConsensusGossip == nil || c.ConsensusGossip.Mode != RelayGossipMode {
+		return false
+	}
+	minSize := c.ConsensusGossip.MinCommitteeSize
+	if minSize == 0 {
+		minSize = DefaultRelayMinCommitteeSize
+	}
+	return uint64(committeeSize) >= minSize
+}
+
+// ProposerElectionStrategy returns the name of the proposer election strategy active at block num, or an empty
+// string if the default strategy applies.
+func (c *ChainConfig) ProposerElectionStrategy(num uint64) string {
+	strategy := ""
+	for _, fork := range c.ProposerElection {
+		if !isForked(fork.Block, new(big.Int).SetUint64(num)) {
+			break
+		}
+		strategy = fork.Strategy
+	}
+	return strategy
+}
+
 // IsArrowGlacier returns whether num is either equal to the Arrow Glacier (EIP-4345) fork block or greater.
 func (c *ChainConfig) IsArrowGlacier(num *big.Int) bool {
 	return isForked(c.ArrowGlacierBlock, num)
@@ -1076,6 +1135,22 @@ func (c *ChainConfig) CheckConfigForkOrder() error {
 			lastFork = cur
 		}
 	}
+	for i, fork := range c.ProposerElection {
+		if fork.Block == nil {
+			return fmt.Errorf("unsupported proposer election fork: %v strategy has no block", fork.Strategy)
+		}
+		if i > 0 && c.ProposerElection[i-1].Block.Cmp(fork.Block) >= 0 {
+			return fmt.Errorf("unsupported proposer election fork ordering: %v enabled at %v, but %v enabled at %v",
+				c.ProposerElection[i-1].Strategy, c.ProposerElection[i-1].Block, fork.Strategy, fork.Block)
+		}
+	}
+	if c.ConsensusGossip != nil {
+		switch c.ConsensusGossip.Mode {
+		case FullGossipMode, RelayGossipMode:
+		default:
+			return fmt.Errorf("unsupported consensus gossip mode: %q", c.ConsensusGossip.Mode)
+		}
+	}
 	return nil
 }
 
@@ -1132,6 +1207,18 @@ func (c *ChainConfig) checkCompatible(newcfg *ChainConfig, head *big.Int) *Confi
 	if isForkIncompatible(c.MergeForkBlock, newcfg.MergeForkBlock, head) {
 		return newCompatError("Merge Start fork block", c.MergeForkBlock, newcfg.MergeForkBlock)
 	}
+	// the proposer election strategy cannot change for blocks already processed
+	for _, forks := range [][]ProposerElectionFork{c.ProposerElection, newcfg.ProposerElection} {
+		for _, fork := range forks {
+			if fork.Block == nil || !isForked(fork.Block, head) {
+				continue
+			}
+			block := fork.Block.Uint64()
+			if c.ProposerElectionStrategy(block) != newcfg.ProposerElectionStrategy(block) {
+				return newCompatError("proposer election fork block", fork.Block, fork.Block)
+			}
+		}
+	}
 	return nil
 }
 
@@ -1164,6 +1251,16 @@ func (c *ChainConfig) Copy() *ChainConfig {
 	if c.OracleContractConfig != nil {
 		cfg.OracleContractConfig = c.OracleContractConfig
 	}
+	for _, fork := range c.ProposerElection {
+		if fork.Block != nil {
+			fork.Block = new(big.Int).Set(fork.Block)
+		}
+		cfg.ProposerElection = append(cfg.ProposerElection, fork)
+	}
+	if c.ConsensusGossip != nil {
+		gossip := *c.ConsensusGossip
+		cfg.ConsensusGossip = &gossip
+	}
 	if c.ChainID != nil {
 		cfg.ChainID = big.NewInt(0).Set(c.ChainID)
 	}
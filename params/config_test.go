@@ -87,6 +87,23 @@ func TestCheckCompatible(t *testing.T) {
 				RewindTo:     30,
 			},
 		},
+		{
+			stored:  &ChainConfig{ProposerElection: []ProposerElectionFork{{Block: big.NewInt(10), Strategy: "roundRobin"}}},
+			new:     &ChainConfig{},
+			head:    9,
+			wantErr: nil,
+		},
+		{
+			stored: &ChainConfig{ProposerElection: []ProposerElectionFork{{Block: big.NewInt(10), Strategy: "roundRobin"}}},
+			new:    &ChainConfig{ProposerElection: []ProposerElectionFork{{Block: big.NewInt(10), Strategy: "stakeWeightedRoundRobin"}}},
+			head:   20,
+			wantErr: &ConfigCompatError{
+				What:         "proposer election fork block",
+				StoredConfig: big.NewInt(10),
+				NewConfig:    big.NewInt(10),
+				RewindTo:     9,
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -96,3 +113,29 @@ func TestCheckCompatible(t *testing.T) {
 		}
 	}
 }
+
+func TestRelayConsensusGossip(t *testing.T) {
+	config := &ChainConfig{}
+	if config.RelayConsensusGossip(100) {
+		t.Error("relay mode without consensus gossip config")
+	}
+	config.ConsensusGossip = &ConsensusGossipConfig{Mode: RelayGossipMode}
+	if config.RelayConsensusGossip(DefaultRelayMinCommitteeSize-1) || !config.RelayConsensusGossip(DefaultRelayMinCommitteeSize) {
+		t.Error("relay mode does not follow the default minimum committee size")
+	}
+	config.ConsensusGossip.MinCommitteeSize = 4
+	if config.RelayConsensusGossip(3) || !config.RelayConsensusGossip(4) {
+		t.Error("relay mode does not follow the minimum committee size")
+	}
+	config.ConsensusGossip.Mode = FullGossipMode
+	if config.RelayConsensusGossip(100) {
+		t.Error("relay mode in full gossip mode")
+	}
+	if err := config.CheckConfigForkOrder(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	config.ConsensusGossip.Mode = "epidemic"
+	if err := config.CheckConfigForkOrder(); err == nil {
+		t.Error("unsupported consensus gossip mode accepted")
+	}
+}
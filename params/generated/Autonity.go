@@ -4,7 +4,7 @@ import "strings"
 import "github.com/autonity/autonity/accounts/abi"
 import "github.com/autonity/autonity/common"
 
-var AutonityBytecode = common.Hex2Bytes("608060405261c35060045561c35060055561c350600655614e2060075560006010556000601155633b9aca006037553480156200003b57600080fd5b506040516200da303803806200da308339810160408190526200005e91620010f5565b6024546000036200008757603d80546001600160a01b031916331790556200008782826200008f565b5050620015bc565b80518051601255602080820151601355604080830151601455606080840151601555608080850151601681905560a095860151601780546001600160a01b03199081166001600160a01b03938416179091558689015180516018805484169185169190911790558088015160198054841691851691909117905580870151601a8054841691851691909117905580860151601b8054841691851691909117905593840151601c8054831691841691909117905596830151601d8054891691831691909117905560c0830151601e8054891691831691909117905560e090920151601f805488169184169190911790558387015180518654909716969092169590951784559283015160218190558383015160225592810151602355840151602455602891909155603c9190915551620001c89062000d43565b604051809103906000f080158015620001e5573d6000803e3d6000fd5b50603e80546001600160a01b0319166001600160a01b039290921691909117905560005b8251811015620004cc5760008382815181106200022a576200022a62001332565b602002602001015160a00151905060008483815181106200024f576200024f62001332565b60200260200101516101a0018181525050600084838151811062000277576200027762001332565b602002602001015161018001906001600160a01b031690816001600160a01b0316815250506000848381518110620002b357620002b362001332565b602002602001015160a00181815250506000848381518110620002da57620002da62001332565b60209081029190910101516101c00152601454845185908490811062000304576200030462001332565b6020026020010151608001818152505060008483815181106200032b576200032b62001332565b6020026020010151610260019060038111156200034c576200034c62001348565b9081600381111562000362576200036262001348565b8152505060008483815181106200037d576200037d62001332565b6020026020010151610160018181525050620003bb848381518110620003a757620003a762001332565b6020026020010151620004d160201b60201c565b620003e8848381518110620003d457620003d462001332565b60200260200101516200060c60201b60201c565b806039600086858151811062000402576200040262001332565b6020026020010151600001516001600160a01b03166001600160a01b0316815260200190815260200160002060008282546200043f919062001374565b9250508190555080603b60008282546200045a919062001374565b92505081905550620004b48483815181106200047a576200047a62001332565b602002602001015160200151828685815181106200049c576200049c62001332565b602002602001015160000151620008b960201b60201c565b50508080620004c39062001390565b91505062000209565b505050565b6000620004e8826060015162000ad060201b60201c565b6001600160a01b039091166020840152905080156200053c5760405162461bcd60e51b815260206004820152600b60248201526a32b737b2329032b93937b960a91b60448201526064015b60405180910390fd5b6020808301516001600160a01b039081166000908152603a9092526040909120600101541615620005b05760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c7265616479207265676973746572656400000000604482015260640162000533565b61271082608001511115620006085760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e2072617465000000000000000000604482015260640162000533565b5050565b6101808101516001600160a01b03166200070b57603e546001600160a01b0316620006855760405162461bcd60e51b815260206004820152602260248201527f6c6971756964206c6f67696320636f6e7472616374206e6f74206465706c6f79604482015261195960f21b606482015260840162000533565b602554600090620006969062000b1e565b905081602001518260000151836080015183603e60009054906101000a90046001600160a01b0316604051620006cc9062000d51565b620006dc959493929190620013ac565b604051809103906000f080158015620006f9573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051602580546001808201835560009283527f401968ff42a154441da5f6c4c935ac46b8671f0e062baaa62a7545ba53bb6e4c90910180546001600160a01b03199081166001600160a01b0395861617909155845184168352603a90955260409182902086518154871690851617815593519084018054861691841691909117905584015160028301805490941691161790915560608201518291906003820190620007bd908262001496565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c820180546001600160a01b0319166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f82015561020082015160108201556102208201516011820155610240820151601282019062000887908262001496565b5061026082015160138201805460ff19166001836003811115620008af57620008af62001348565b0217905550505050565b6000808311620009185760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201526269766560e81b606482015260840162000533565b6001600160a01b038216600090815260396020526040902054831115620009825760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e63650000000000604482015260640162000533565b6001600160a01b03821660009081526039602052604081208054859290620009ac90849062001562565b9091555050604080516080810182526001600160a01b03808516825286811660208084019182528385018881524360608601908152600a805460009081526008909452968320865181549087166001600160a01b03199182161782559451600182018054919097169516949094179094555160028301559151600390910155825491929062000a3b8362001390565b90915550506001600160a01b038581166000818152603a6020908152604091829020548251908516948816948514808252918101899052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d910160405180910390a3833b1562000ab55762000ab5848762000c3e565b6001600a5462000ac6919062001562565b9695505050505050565b60008062000add62000d5f565b60008060ff9050604083875160208901845afa62000afa57600080fd5b505080516020909101516c0100000000000000000000000090910494909350915050565b60608160000362000b465750506040805180820190915260018152600360fc1b602082015290565b8160005b811562000b76578062000b5d8162001390565b915062000b6e9050600a836200158e565b915062000b4a565b6000816001600160401b0381111562000b935762000b9362000d7d565b6040519080825280601f01601f19166020018201604052801562000bbe576020820181803683370190505b5090505b841562000c365762000bd660018362001562565b915062000be5600a86620015a5565b62000bf290603062001374565b60f81b81838151811062000c0a5762000c0a62001332565b60200101906001600160f81b031916908160001a90535062000c2e600a866200158e565b945062000bc2565b949350505050565b6001600160a01b038216600090815260346020526040812054900362000cbc576001600160a01b03821660008181526034602052604081206001908190556035805491820181559091527fcfa4bec1d3298408bb5afcfcd9c430549c5b31f8aa5c5848151c0a55f473c34d0180546001600160a01b03191690911790555b6001600160a01b038083166000908152603260209081526040808320938516835292905290812054900362000608576001600160a01b03808316600081815260326020908152604080832094861680845294825280832060019081905593835260338252822080549384018155825290200180546001600160a01b03191690911790555050565b611cf6806200b72a83390190565b610610806200d42083390190565b60405180604001604052806002906020820280368337509192915050565b634e487b7160e01b600052604160045260246000fd5b604051608081016001600160401b038111828210171562000db85762000db862000d7d565b60405290565b60405160c081016001600160401b038111828210171562000db85762000db862000d7d565b60405161028081016001600160401b038111828210171562000db85762000db862000d7d565b604051601f8201601f191681016001600160401b038111828210171562000e345762000e3462000d7d565b604052919050565b6001600160a01b038116811462000e5257600080fd5b50565b805162000e628162000e3c565b919050565b60005b8381101562000e8457818101518382015260200162000e6a565b50506000910152565b600082601f83011262000e9f57600080fd5b81516001600160401b0381111562000ebb5762000ebb62000d7d565b62000ed0601f8201601f191660200162000e09565b81815284602083860101111562000ee657600080fd5b62000c3682602083016020870162000e67565b80516004811062000e6257600080fd5b600061010080838503121562000f1e57600080fd5b604051908101906001600160401b038211818310171562000f435762000f4362000d7d565b816040528092508351915062000f598262000e3c565b81815262000f6a6020850162000e55565b602082015262000f7d6040850162000e55565b604082015262000f906060850162000e55565b606082015262000fa36080850162000e55565b608082015262000fb660a0850162000e55565b60a082015262000fc960c0850162000e55565b60c082015262000fdc60e0850162000e55565b60e0820152505092915050565b60006080828403121562000ffc57600080fd5b6200100662000d93565b90508151620010158162000e3c565b8082525060208201516020820152604082015160408201526060820151606082015292915050565b60008183036102608112156200105257600080fd5b6200105c62000d93565b915060c08112156200106d57600080fd5b506200107862000dbe565b825181526020830151602082015260408301516040820152606083015160608201526080830151608082015260a0830151620010b48162000e3c565b60a08201528152620010ca8360c0840162000f09565b6020820152620010df836101c0840162000fe9565b6040820152610240820151606082015292915050565b6000806102808084860312156200110b57600080fd5b83516001600160401b03808211156200112357600080fd5b818601915086601f8301126200113857600080fd5b81516020828211156200114f576200114f62000d7d565b8160051b6200116082820162000e09565b928352848101820192828101908b8511156200117b57600080fd5b83870192505b8483101562001310578251868111156200119a57600080fd5b8701808d03601f1901891315620011b057600080fd5b620011ba62000de3565b620011c786830162000e55565b8152620011d76040830162000e55565b86820152620011e96060830162000e55565b60408201526080820151888111156200120157600080fd5b620012118f888386010162000e8d565b60608301525060a0820151608082015260c082015160a082015260e082015160c082015261010082015160e08201526101208201516101008201526101408201516101208201526101608201516101408201526101808201516101608201526200127f6101a0830162000e55565b6101808201526101c08201516101a08201526101e08201516101c08201526102008201516101e082015261022082015161020082015261024082015161022082015261026082015188811115620012d557600080fd5b620012e58f888386010162000e8d565b61024083015250620012f98a830162000ef9565b610260820152835250918301919083019062001181565b8099505050506200132489828a016200103d565b955050505050509250929050565b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052602160045260246000fd5b634e487b7160e01b600052601160045260246000fd5b808201808211156200138a576200138a6200135e565b92915050565b600060018201620013a557620013a56200135e565b5060010190565b600060018060a01b038088168352808716602084015285604084015260a0606084015284518060a0850152620013ea8160c086016020890162000e67565b9316608083015250601f91909101601f19160160c001949350505050565b600181811c908216806200141d57607f821691505b6020821081036200143e57634e487b7160e01b600052602260045260246000fd5b50919050565b601f821115620004cc57600081815260208120601f850160051c810160208610156200146d5750805b601f850160051c820191505b818110156200148e5782815560010162001479565b505050505050565b81516001600160401b03811115620014b257620014b262000d7d565b620014ca81620014c3845462001408565b8462001444565b602080601f831160018114620015025760008415620014e95750858301515b600019600386901b1c1916600185901b1785556200148e565b600085815260208120601f198616915b82811015620015335788860151825594840194600190910190840162001512565b5085821015620015525787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b818103818111156200138a576200138a6200135e565b634e487b7160e01b600052601260045260246000fd5b600082620015a057620015a062001578565b500490565b600082620015b757620015b762001578565b500690565b61a15e80620015cc6000396000f3fe608060405260043610620005cf5760003560e01c8063872cf05911620002f7578063b46e55201162000197578063d5f3948811620000e7578063e7f43c68116200009b578063f03b959d1162000075578063f03b959d146200121e578063f1d592a71462001243578063f7866ee3146200126857005b8063e7f43c6814620011c1578063e98712b214620011e1578063ee7ff28d14620011f957005b8063d886f8a211620000cd578063d886f8a2146200113a578063dd62ed3e146200115f578063dfb1a4d214620011a957005b8063d5f394881462001100578063d861b0e8146200112257005b8063cb696f54116200014b578063cf9c57191162000125578063cf9c5719146200109e578063cfd19fb914620010b6578063d372c07e14620010db57005b8063cb696f54146200103c578063ceaad4551462001061578063cef98450146200108657005b8063b6ef8c67116200017d578063b6ef8c671462000fd8578063b7ab4db51462000ffd578063c9d97af4146200102457005b8063b46e55201462000f8b578063b66b3e791462000fb057005b8063a5d059ca1162000253578063ab8f6ffe1162000207578063affb1cf111620001e1578063affb1cf11462000f1c578063b2ea9adb1462000f41578063b3ecbadd1462000f6657005b8063ab8f6ffe1462000eb5578063ae1da0b51462000edc578063ae1f5fa01462000ef457005b8063a9059cbb1162000239578063a9059cbb1462000e20578063a9af10591462000e45578063a9fd1a8f1462000e8b57005b8063a5d059ca1462000de2578063a8b2216e1462000df957005b806396f18a3211620002ab5780639c98e47111620002915780639c98e4711462000d8e5780639dc29fac1462000da6578063a515366a1462000dcb57005b806396f18a321462000d545780639ac310741462000d7657005b80638bac7dad11620002dd5780638bac7dad1462000cc257806395d89b411462000ce757806396b477cb1462000d2f57005b8063872cf0591462000c9257806389c614b81462000caa57005b806340c10f19116200046f57806370a0823111620003bf57806379502c551162000373578063833b1fce116200034d578063833b1fce1462000c1457806384467fdb1462000c48578063852c48491462000c6d57005b806379502c551462000ada5780637e660ac91462000bd8578063819b64631462000bfd57005b8063731b3a0311620003a5578063731b3a031462000a6757806377e741c71462000a90578063784304b51462000ab557005b806370a082311462000a1557806371d1bc591462000a4f57005b8063573963b811620004235780636b5f444c11620003fd5780636b5f444c14620009c15780636bb9cb0e14620009e65780636fd2c80b14620009fe57005b8063573963b8146200096c5780635bdedd1614620009845780636a929cef146200099c57005b8063496ccd9b1162000455578063496ccd9b14620008f75780634bb278f3146200091c578063520fdbbc146200094757005b806340c10f1914620008bb5780634364596914620008e057005b8063161605e3116200052b57806325ce1bb911620004df578063313ce56711620004b9578063313ce567146200086057806335be16e0146200087e578063386a827b14620008a357005b806325ce1bb9146200080857806329070c6d14620008315780632f2c3f2e146200084857005b80631904bb2e11620005115780631904bb2e146200078a5780631a0cf2e514620007be57806323b872dd14620007e357005b8063161605e314620005d757806318160ddd146200077357005b80630fe501091162000583578063114eaf551162000569578063114eaf5514620007115780631250a28d14620007365780631604e416146200075b57005b80630fe5010914620006d55780631122063314620006fa57005b80630ae65e7a11620005b55780630ae65e7a146200066a5780630b21fb1d146200068f5780630d8e6e2c14620006b457005b806306fdde0314620005d9578063095ea7b3146200063457005b36620005d757005b005b348015620005e657600080fd5b5060408051808201909152600681527f4e6577746f6e000000000000000000000000000000000000000000000000000060208201525b6040516200062b919062008991565b60405180910390f35b3480156200064157600080fd5b506200065962000653366004620089c3565b62001288565b60405190151581526020016200062b565b3480156200067757600080fd5b50620005d762000689366004620089f2565b620012a1565b3480156200069c57600080fd5b50620005d7620006ae36600462008a12565b620013c7565b348015620006c157600080fd5b506024545b6040519081526020016200062b565b348015620006e257600080fd5b50620005d7620006f436600462008a12565b62001428565b3480156200070757600080fd5b50601354620006c6565b3480156200071e57600080fd5b50620005d76200073036600462008a12565b62001489565b3480156200074357600080fd5b50620005d762000755366004620089f2565b620014ea565b3480156200076857600080fd5b50620006c6602f5481565b3480156200078057600080fd5b50603b54620006c6565b3480156200079757600080fd5b50620007af620007a9366004620089f2565b62001577565b6040516200062b919062008aa2565b348015620007cb57600080fd5b50620005d7620007dd366004620089f2565b62001854565b348015620007f057600080fd5b50620006596200080236600462008c1b565b620018df565b3480156200081557600080fd5b506026546000908152602c6020526040902060030154620006c6565b3480156200083e57600080fd5b50601254620006c6565b3480156200085557600080fd5b50620006c661271081565b3480156200086d57600080fd5b50604051601281526020016200062b565b3480156200088b57600080fd5b50620005d76200089d36600462008c61565b620019f9565b348015620008b057600080fd5b50620006c660055481565b348015620008c857600080fd5b50620005d7620008da366004620089c3565b62001bef565b348015620008ed57600080fd5b50602254620006c6565b3480156200090457600080fd5b50620005d762000916366004620089f2565b62001c5b565b3480156200092957600080fd5b506200093462001dd3565b6040516200062b95949392919062008d22565b3480156200095457600080fd5b50620005d762000966366004620089f2565b6200271f565b3480156200097957600080fd5b50620006c660285481565b3480156200099157600080fd5b50620006c660075481565b348015620009a957600080fd5b50620005d7620009bb36600462008a12565b620029d3565b348015620009ce57600080fd5b50620005d7620009e036600462008a12565b62002a34565b348015620009f357600080fd5b50620006c660375481565b34801562000a0b57600080fd5b50601554620006c6565b34801562000a2257600080fd5b50620006c662000a34366004620089f2565b6001600160a01b031660009081526039602052604090205490565b34801562000a5c57600080fd5b50620006c660065481565b34801562000a7457600080fd5b506026546000908152602c6020526040902060020154620006c6565b34801562000a9d57600080fd5b50620005d762000aaf36600462008a12565b62002ae9565b34801562000ac257600080fd5b50620005d762000ad436600462008e1f565b62002b4a565b34801562000ae757600080fd5b506040805160c080820183526012548252601354602080840191909152601454838501526015546060808501919091526016546080808601919091526017546001600160a01b0390811660a08088019190915287516101008101895260185483168152601954831681870152601a548316818a0152601b54831681860152601c54831681850152601d54831691810191909152601e54821695810195909552601f54811660e086015286519182018752835416815260215492810192909252602254948201949094526023549381019390935260245462000bc6939084565b6040516200062b949392919062008e75565b34801562000be557600080fd5b50620005d762000bf736600462008a12565b62002dbc565b34801562000c0a57600080fd5b50602354620006c6565b34801562000c2157600080fd5b506019546001600160a01b03165b6040516001600160a01b0390911681526020016200062b565b34801562000c5557600080fd5b50620005d762000c6736600462008f77565b62002e1d565b34801562000c7a57600080fd5b50620005d762000c8c366004620089c3565b62002f4c565b34801562000c9f57600080fd5b50620005d76200316e565b34801562000cb757600080fd5b50620006c6602a5481565b34801562000ccf57600080fd5b50620005d762000ce136600462008a12565b620031d9565b34801562000cf457600080fd5b5060408051808201909152600381527f4e544e000000000000000000000000000000000000000000000000000000000060208201526200061c565b34801562000d3c57600080fd5b50620006c662000d4e36600462008a12565b6200328c565b34801562000d6157600080fd5b50603e5462000c2f906001600160a01b031681565b34801562000d8357600080fd5b50620006c6603c5481565b34801562000d9b57600080fd5b50620006c6602b5481565b34801562000db357600080fd5b50620005d762000dc5366004620089c3565b6200333b565b620006c662000ddc366004620089c3565b6200348e565b620006c662000df3366004620089c3565b620035da565b34801562000e0657600080fd5b5062000e11620036ea565b6040516200062b919062009020565b34801562000e2d57600080fd5b506200065962000e3f366004620089c3565b620037cd565b34801562000e5257600080fd5b5062000e7c62000e6436600462008a12565b6000908152600b602052604090206006015460ff1690565b6040516200062b9190620090a4565b34801562000e9857600080fd5b5062000ea362003825565b6040516200062b9493929190620090b9565b34801562000ec257600080fd5b5062000ecd620039a2565b6040516200062b9190620090ea565b34801562000ee957600080fd5b50620006c660295481565b34801562000f0157600080fd5b5062000f0c62003ab4565b6040516200062b92919062009145565b34801562000f2957600080fd5b5062000ea362000f3b36600462008a12565b62003de0565b34801562000f4e57600080fd5b50620005d762000f6036600462009177565b62004001565b34801562000f7357600080fd5b50620005d762000f85366004620089f2565b62004077565b34801562000f9857600080fd5b50620005d762000faa366004620089f2565b62004102565b34801562000fbd57600080fd5b5062000fc8620043d7565b6040516200062b929190620091d8565b34801562000fe557600080fd5b50620006c662000ff736600462008a12565b6200450e565b3480156200100a57600080fd5b5062001015620045a0565b6040516200062b919062009201565b3480156200103157600080fd5b50620006c660265481565b3480156200104957600080fd5b50620005d76200105b36600462008a12565b62004604565b3480156200106e57600080fd5b50620005d762001080366004620089f2565b6200469b565b3480156200109357600080fd5b50620006c660045481565b348015620010ab57600080fd5b50620005d762004726565b348015620010c357600080fd5b50620005d7620010d5366004620089f2565b620047aa565b348015620010e857600080fd5b50620005d7620010fa366004620089f2565b62004835565b3480156200110d57600080fd5b50603d5462000c2f906001600160a01b031681565b3480156200112f57600080fd5b50620005d7620048c0565b3480156200114757600080fd5b50620005d762001159366004620089f2565b62004aca565b3480156200116c57600080fd5b50620006c66200117e36600462009216565b6001600160a01b03918216600090815260316020908152604080832093909416825291909152205490565b348015620011b657600080fd5b50620006c662004b55565b348015620011ce57600080fd5b506020546001600160a01b031662000c2f565b348015620011ee57600080fd5b50620006c6602e5481565b3480156200120657600080fd5b50620005d76200121836600462008a12565b62004b72565b3480156200122b57600080fd5b50620005d76200123d366004620089f2565b62004bd3565b3480156200125057600080fd5b50620005d762001262366004620089f2565b62004c5e565b3480156200127557600080fd5b506017546001600160a01b031662000c2f565b60006200129733848462004d67565b5060015b92915050565b620012ab62004ec4565b6001600160a01b038082166000818152603a6020526040902060010154909116146200131e5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d75737420626520726567697374657265640000000060448201526064015b60405180910390fd5b6001600160a01b038181166000908152603a6020526040902054163314620013af5760405162461bcd60e51b815260206004820152602c60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72206160448201527f646d696e206163636f756e740000000000000000000000000000000000000000606482015260840162001315565b620013ba8162004f1d565b620013c460008055565b50565b6020546001600160a01b03163314620014235760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b600555565b6020546001600160a01b03163314620014845760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b600455565b6020546001600160a01b03163314620014e55760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b601555565b6020546001600160a01b03163314620015465760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b6018805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b565b620015816200874a565b6001600160a01b038083166000818152603a602052604090206001015490911614620015f05760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f7420726567697374657265640000000000000000604482015260640162001315565b6001600160a01b038083166000908152603a60209081526040918290208251610280810184528154851681526001820154851692810192909252600281015490931691810191909152600382018054919291606084019190620016539062009254565b80601f0160208091040260200160405190810160405280929190818152602001828054620016819062009254565b8015620016d25780601f10620016a657610100808354040283529160200191620016d2565b820191906000526020600020905b815481529060010190602001808311620016b457829003601f168201915b505050918352505060048201546020820152600582015460408201526006820154606082015260078201546080820152600882015460a0820152600982015460c0820152600a82015460e0820152600b820154610100820152600c8201546001600160a01b0316610120820152600d820154610140820152600e820154610160820152600f82015461018082015260108201546101a082015260118201546101c08201526012820180546101e0909201916200178e9062009254565b80601f0160208091040260200160405190810160405280929190818152602001828054620017bc9062009254565b80156200180d5780601f10620017e1576101008083540402835291602001916200180d565b820191906000526020600020905b815481529060010190602001808311620017ef57829003601f168201915b5050509183525050601382015460209091019060ff16600381111562001837576200183762008a2c565b60038111156200184b576200184b62008a2c565b90525092915050565b6020546001600160a01b03163314620018b05760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b601f805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6001600160a01b0383166000908152603160209081526040808320338452909152812054828110156200197b5760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e742065786365656473206160448201527f6c6c6f77616e6365000000000000000000000000000000000000000000000000606482015260840162001315565b620019888585856200500d565b620019a085336200199a8685620092d8565b62004d67565b836001600160a01b0316856001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef85604051620019e691815260200190565b60405180910390a3506001949350505050565b6018546001600160a01b0316331462001a7b5760405162461bcd60e51b815260206004820152602360248201527f63616c6c6572206973206e6f742074686520736c617368696e6720636f6e747260448201527f6163740000000000000000000000000000000000000000000000000000000000606482015260840162001315565b6000610120820135603a8262001a986040860160208701620089f2565b6001600160a01b03166001600160a01b031681526020019081526020016000206009015462001ac89190620092d8565b60c0830135603a600062001ae36040870160208801620089f2565b6001600160a01b03166001600160a01b031681526020019081526020016000206006015462001b139190620092d8565b60a0840135603a600062001b2e6040880160208901620089f2565b6001600160a01b03166001600160a01b031681526020019081526020016000206005015462001b5e9190620092d8565b62001b6a9190620092ee565b62001b769190620092ee565b6017546001600160a01b031660009081526039602052604081208054929350839290919062001ba7908490620092ee565b90915550829050603a600062001bc46040840160208501620089f2565b6001600160a01b03168152602081019190915260400160002062001be98282620094c9565b50505050565b6020546001600160a01b0316331462001c4b5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b62001c578282620050da565b5050565b6020546001600160a01b0316331462001cb75760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b6019805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b03838116918217909255601a546040517f7adbf973000000000000000000000000000000000000000000000000000000008152600481019290925290911690637adbf97390602401600060405180830381600087803b15801562001d3c57600080fd5b505af115801562001d51573d6000803e3d6000fd5b5050601c546040517f7adbf9730000000000000000000000000000000000000000000000000000000081526001600160a01b0385811660048301529091169250637adbf97391506024015b600060405180830381600087803b15801562001db757600080fd5b505af115801562001dcc573d6000803e3d6000fd5b5050505050565b603d546000908190606090829081906001600160a01b0316331462001e615760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c0000000000000000000000000000000000000000000000000000000000606482015260840162001315565b62001e6b62004ec4565b4360298190556026546000828152602760209081526040808320849055928252602c90528190206003015460185491517f6c9789b000000000000000000000000000000000000000000000000000000000815292101560048301819052916001600160a01b0390911690636c9789b090602401600060405180830381600087803b15801562001ef957600080fd5b505af115801562001f0e573d6000803e3d6000fd5b505050508015620024a557601e54603b54603c54602a546040517f92eff3cd0000000000000000000000000000000000000000000000000000000081526004810193909352602483019190915260448201524260648201526000916001600160a01b0316906392eff3cd90608401602060405180830381865afa15801562001f9a573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019062001fc09190620096ae565b905080603c54101562001fd25750603c545b62001fde3082620050da565b80603c600082825462001ff29190620092d8565b9091555050601f54604080517ff968f49300000000000000000000000000000000000000000000000000000000815281516001600160a01b039093169263f968f4939260048084019391929182900301816000875af192505050801562002078575060408051601f3d908101601f191682019092526200207591810190620096c8565b60015b620020b6576040514281527ff1217bb194383aa5c937c81cbbb445990a478c7511b080df329f9b0fb64233c99060200160405180910390a1620020e9565b601754620020ce906001600160a01b031682620050da565b601f54620020e6906001600160a01b031683620050da565b50505b306000908152603960205260409020546200210690479062005160565b62002110620056d8565b6200211a6200599b565b6200212462005a8a565b6200212e62005b29565b6000806200213b62003ab4565b6019546040517f845023f20000000000000000000000000000000000000000000000000000000081529294509092506001600160a01b03169063845023f2906200218a90859060040162009201565b600060405180830381600087803b158015620021a557600080fd5b505af1158015620021ba573d6000803e3d6000fd5b50506018546040517fe08b14ed0000000000000000000000000000000000000000000000000000000081526001600160a01b03909116925063e08b14ed91506200220990849060040162009201565b600060405180830381600087803b1580156200222457600080fd5b505af115801562002239573d6000803e3d6000fd5b50506026546000908152602c602052604090206002015460285460215491935014801591506200226a575060285415155b15620022f15760285460218190556018546040517f6b5f444c00000000000000000000000000000000000000000000000000000000815260048101929092526001600160a01b031690636b5f444c90602401600060405180830381600087803b158015620022d757600080fd5b505af1158015620022ec573d6000803e3d6000fd5b505050505b602154600090620023039043620092ee565b905042602a81905550600160266000828254620023219190620092ee565b909155505060265460408051602d805460a0602082028401810190945260808301818152620024639594849391929060009085015b8282101562002444576000848152602090819020604080516060810182526003860290920180546001600160a01b0316835260018101549383019390935260028301805492939291840191620023ac9062009254565b80601f0160208091040260200160405190810160405280929190818152602001828054620023da9062009254565b80156200242b5780601f10620023ff576101008083540402835291602001916200242b565b820191906000526020600020905b8154815290600101906020018083116200240d57829003601f168201915b5050505050815250508152602001906001019062002356565b5050505081526020018581526020014381526020018481525062005c6a565b7febad8099c467528a56c98b63c8d476d251cf1ffb4c75db94b4d23fa2b6a1e3356026546040516200249791815260200190565b60405180910390a150505050505b601954604080517f4bb278f300000000000000000000000000000000000000000000000000000000815290516000926001600160a01b031691634bb278f3916004808301926020929190829003018187875af11580156200250a573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190620025309190620096ed565b90508015620025c557601a54604080517fa2e6204500000000000000000000000000000000000000000000000000000000815290516001600160a01b039092169163a2e620459160048082019260209290919082900301816000875af1925050508015620025bd575060408051601f3d908101601f19168201909252620025ba91810190620096ed565b60015b15620025c557505b600380546026546000908152602c602090815260408083206001810154950154602d805483518186028101860190945280845260ff909616968996919590949293928692919084015b82821015620026fc576000848152602090819020604080516060810182526003860290920180546001600160a01b0316835260018101549383019390935260028301805492939291840191620026649062009254565b80601f0160208091040260200160405190810160405280929190818152602001828054620026929062009254565b8015620026e35780601f10620026b757610100808354040283529160200191620026e3565b820191906000526020600020905b815481529060010190602001808311620026c557829003601f168201915b505050505081525050815260200190600101906200260e565b5050505092509650965096509650965050506200271860008055565b9091929394565b6020546001600160a01b031633146200277b5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b6020805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b038381169182179092556019546040517fb3ab15fb00000000000000000000000000000000000000000000000000000000815260048101929092529091169063b3ab15fb90602401600060405180830381600087803b1580156200280057600080fd5b505af115801562002815573d6000803e3d6000fd5b5050601a546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b1580156200287a57600080fd5b505af11580156200288f573d6000803e3d6000fd5b5050601b546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b158015620028f457600080fd5b505af115801562002909573d6000803e3d6000fd5b5050601c546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b1580156200296e57600080fd5b505af115801562002983573d6000803e3d6000fd5b5050601d546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb915060240162001d9c565b6020546001600160a01b0316331462002a2f5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b603755565b6020546001600160a01b0316331462002a905760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b60288190556026546000908152602c6020908152604091829020600301548251848152918201819052917f2eea6438d890c8603d4df81ad1bad2a4ea45c02b4837165f461ff3c81603abc7910160405180910390a15050565b6020546001600160a01b0316331462002b455760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b601255565b6001600160a01b038083166000818152603a6020526040902060018101549092161462002bba5760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f7420726567697374657265640000000000000000604482015260640162001315565b80546001600160a01b0316331462002c3b5760405162461bcd60e51b815260206004820152602f60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72207460448201527f72656173757279206163636f756e740000000000000000000000000000000000606482015260840162001315565b62002c468362005d47565b1562002cbb5760405162461bcd60e51b815260206004820152602260248201527f76616c696461746f72206d757374206e6f7420626520696e20636f6d6d69747460448201527f6565000000000000000000000000000000000000000000000000000000000000606482015260840162001315565b60008062002cc98462005db8565b92509050811562002d1d5760405162461bcd60e51b815260206004820152600b60248201527f656e6f6465206572726f72000000000000000000000000000000000000000000604482015260640162001315565b60018301546001600160a01b0382811691161462002da45760405162461bcd60e51b815260206004820152602760248201527f76616c696461746f72206e6f646520616464726573732063616e27742062652060448201527f7570646174656400000000000000000000000000000000000000000000000000606482015260840162001315565b6003830162002db4858262009711565b505050505050565b6020546001600160a01b0316331462002e185760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b600755565b6000604051806102800160405280336001600160a01b0316815260200160006001600160a01b03168152602001856001600160a01b0316815260200186815260200160126000016002015481526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b03168152602001600081526020014381526020016000815260200160008152602001600081526020018481526020016000600381111562002eec5762002eec62008a2c565b9052905062002efc818362005e06565b60208101516101808201516040517f8ad8bd2eb6950e5f332fd3a6dca48cb358ecfe3057848902b98cbdfe455c915c9262002f3d92339289918b91620097de565b60405180910390a15050505050565b6001600160a01b038083166000818152603a60205260409020600101549091161462002fbb5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d757374206265207265676973746572656400000000604482015260640162001315565b6001600160a01b038281166000908152603a60205260409020541633146200304c5760405162461bcd60e51b815260206004820152602c60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72206160448201527f646d696e206163636f756e740000000000000000000000000000000000000000606482015260840162001315565b612710811115620030a05760405162461bcd60e51b815260206004820152601f60248201527f7265717569726520636f727265637420636f6d6d697373696f6e207261746500604482015260640162001315565b604080516060810182526001600160a01b038481168252436020808401918252838501868152601180546000908152600f9093529582208551815473ffffffffffffffffffffffffffffffffffffffff191695169490941784559151600180850191909155915160029093019290925583549293909290919062003126908490620092ee565b90915550506040518281526001600160a01b038416907f4fba51c92fa3d6ad8374d394f6cd5766857552e153d7384a8f23aa4ce9a8a7cf9060200160405180910390a2505050565b6020546001600160a01b03163314620031ca5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b6003805460ff19166001179055565b6020546001600160a01b03163314620032355760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b60008111620032875760405162461bcd60e51b815260206004820152601960248201527f636f6d6d69747465652073697a652063616e2774206265203000000000000000604482015260640162001315565b602355565b600060295460016200329f9190620092ee565b821115620033165760405162461bcd60e51b815260206004820152602660248201527f63616e6e6f74206765742065706f636820696420666f7220612066757475726560448201527f20626c6f636b0000000000000000000000000000000000000000000000000000606482015260840162001315565b602954821162003333575060009081526027602052604090205490565b505060265490565b6020546001600160a01b03163314620033975760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b6001600160a01b038216600090815260396020526040902054811115620034015760405162461bcd60e51b815260206004820152601660248201527f416d6f756e7420657863656564732062616c616e636500000000000000000000604482015260640162001315565b6001600160a01b038216600090815260396020526040812080548392906200342b908490620092d8565b9250508190555080603b6000828254620034469190620092d8565b90915550506040518181526001600160a01b038316907f5024dbeedf0c06664c9bd7be836915730c955e936972c020683dadf11d5488a3906020015b60405180910390a25050565b60006200349a62004ec4565b6001600160a01b038084166000818152603a602052604090206001015490911614620035095760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f7420726567697374657265640000000000000000604482015260640162001315565b6001600160a01b0383166000908152603a602052604081206013015460ff1660038111156200353c576200353c62008a2c565b146200358b5760405162461bcd60e51b815260206004820152601b60248201527f76616c696461746f72206e65656420746f206265206163746976650000000000604482015260640162001315565b6037546200359a903462009857565b3360009081526038602052604081208054909190620035bb908490620092ee565b90915550620035ce9050838333620062a9565b90506200129b60008055565b6000620035e662004ec4565b6001600160a01b038084166000818152603a602052604090206001015490911614620036555760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f7420726567697374657265640000000000000000604482015260640162001315565b60008211620036a75760405162461bcd60e51b815260206004820152601560248201527f756e626f6e64696e6720616d6f756e7420697320300000000000000000000000604482015260640162001315565b603754620036b6903462009857565b3360009081526038602052604081208054909190620036d7908490620092ee565b90915550620035ce9050838333620064e7565b60606030805480602002602001604051908101604052809291908181526020016000905b82821015620037c4578382906000526020600020018054620037309062009254565b80601f01602080910402602001604051908101604052809291908181526020018280546200375e9062009254565b8015620037af5780601f106200378357610100808354040283529160200191620037af565b820191906000526020600020905b8154815290600101906020018083116200379157829003601f168201915b5050505050815260200190600101906200370e565b50505050905090565b6000620037dc3384846200500d565b6040518281526001600160a01b0384169033907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef9060200160405180910390a350600192915050565b6026546000908152602c602090815260408083208151815460a094810282018501909352608081018381526060959485948594859493909284928491879085015b8282101562003954576000848152602090819020604080516060810182526003860290920180546001600160a01b0316835260018101549383019390935260028301805492939291840191620038bc9062009254565b80601f0160208091040260200160405190810160405280929190818152602001828054620038ea9062009254565b80156200393b5780601f106200390f576101008083540402835291602001916200393b565b820191906000526020600020905b8154815290600101906020018083116200391d57829003601f168201915b5050505050815250508152602001906001019062003866565b50505090825250600182015460208083019190915260028301546040808401919091526003909301546060928301528351908401519284015193909101519098919750919550909350915050565b6060602d805480602002602001604051908101604052809291908181526020016000905b82821015620037c4576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162003a1c9062009254565b80601f016020809104026020016040519081016040528092919081815260200182805462003a4a9062009254565b801562003a9b5780601f1062003a6f5761010080835404028352916020019162003a9b565b820191906000526020600020905b81548152906001019060200180831162003a7d57829003601f168201915b50505050508152505081526020019060010190620039c6565b603d5460609081906001600160a01b0316331462003b3b5760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c0000000000000000000000000000000000000000000000000000000000606482015260840162001315565b60255462003b8c5760405162461bcd60e51b815260206004820152601860248201527f5468657265206d7573742062652076616c696461746f72730000000000000000604482015260640162001315565b62003b966200881a565b602354608082015260258152603a6020820152602d6040820152602b606082015262003bc2816200696b565b62003bd06030600062008838565b602d548062003c225760405162461bcd60e51b815260206004820152601260248201527f636f6d6d697474656520697320656d7074790000000000000000000000000000604482015260640162001315565b60008167ffffffffffffffff81111562003c405762003c4062008d5b565b60405190808252806020026020018201604052801562003c6a578160200160208202803683370190505b50905060008267ffffffffffffffff81111562003c8b5762003c8b62008d5b565b60405190808252806020026020018201604052801562003cb5578160200160208202803683370190505b50905060005b8381101562003dd4576000603a6000602d848154811062003ce05762003ce06200986e565b60009182526020808320600392830201546001600160a01b031684528301939093526040909101812060308054600181018255925292507f6ff97a59c90d62cc7236ba3a37cd85351bf564556780cf8c1157a220f31f0cbb019062003d48908301826200989d565b50600281015484516001600160a01b039091169085908490811062003d715762003d716200986e565b6001600160a01b0392831660209182029290920101526001820154845191169084908490811062003da65762003da66200986e565b6001600160a01b0390921660209283029190910190910152508062003dcb816200997f565b91505062003cbb565b50909450925050509091565b60606000806000602954600162003df89190620092ee565b85111562003e6f5760405162461bcd60e51b815260206004820152602360248201527f63616e6e6f74206765742065706f636820666f7220612066757475726520626c60448201527f6f636b0000000000000000000000000000000000000000000000000000000000606482015260840162001315565b602654602954861162003e8d57506000858152602760205260409020545b6000818152602c602090815260408083208151815460a09481028201850190935260808101838152909391928492849190879085015b8282101562003fb1576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162003f199062009254565b80601f016020809104026020016040519081016040528092919081815260200182805462003f479062009254565b801562003f985780601f1062003f6c5761010080835404028352916020019162003f98565b820191906000526020600020905b81548152906001019060200180831162003f7a57829003601f168201915b5050505050815250508152602001906001019062003ec3565b5050509082525060018201546020808301919091526002830154604080840191909152600390930154606092830152835190840151928401519390910151909a9199509197509095509350505050565b6020546001600160a01b031633146200405d5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b6200406a6001836200698b565b62001c576002826200698b565b6020546001600160a01b03163314620040d35760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b601b805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6200410c62004ec4565b6001600160a01b038082166000818152603a6020526040902060010154909116146200417b5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d757374206265207265676973746572656400000000604482015260640162001315565b6001600160a01b038082166000908152603a60205260409020805490911633146200420f5760405162461bcd60e51b815260206004820152602f60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72207460448201527f72656173757279206163636f756e740000000000000000000000000000000000606482015260840162001315565b6000601382015460ff1660038111156200422d576200422d62008a2c565b036200427c5760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f7220616c7265616479206163746976650000000000000000604482015260640162001315565b6002601382015460ff1660038111156200429a576200429a62008a2c565b148015620042ab5750438160100154115b15620042fa5760405162461bcd60e51b815260206004820152601760248201527f76616c696461746f72207374696c6c20696e206a61696c000000000000000000604482015260640162001315565b6003601382015460ff16600381111562004318576200431862008a2c565b03620043675760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206a61696c6564207065726d616e656e746c7900000000604482015260640162001315565b60138101805460ff1916905580546026546000908152602c60209081526040918290206003015491519182526001600160a01b038581169316917f60fcbf2d07dc712a93e59fb28f1edb626d7c2497c57ba71a8c0b3999ecb9a3b5910160405180910390a350620013c460008055565b60608060016002818054620043ec9062009254565b80601f01602080910402602001604051908101604052809291908181526020018280546200441a9062009254565b80156200446b5780601f106200443f576101008083540402835291602001916200446b565b820191906000526020600020905b8154815290600101906020018083116200444d57829003601f168201915b50505050509150808054620044809062009254565b80601f0160208091040260200160405190810160405280929190818152602001828054620044ae9062009254565b8015620044ff5780601f10620044d357610100808354040283529160200191620044ff565b820191906000526020600020905b815481529060010190602001808311620044e157829003601f168201915b50505050509050915091509091565b600060036000838152600b602052604090206006015460ff1660038111156200453b576200453b62008a2c565b146200458a5760405162461bcd60e51b815260206004820152601e60248201527f756e626f6e64696e672072656c65617365206e6f742072657665727465640000604482015260640162001315565b506000908152600b602052604090206005015490565b60606025805480602002602001604051908101604052809291908181526020018280548015620045fa57602002820191906000526020600020905b81546001600160a01b03168152600190910190602001808311620045db575b5050505050905090565b6020546001600160a01b03163314620046605760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b60138190556040518181527f1f4d2fc7529047a5bd96d3229bfea127fd18b7748f13586e097c69fccd3891289060200160405180910390a150565b6020546001600160a01b03163314620046f75760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b601d805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6020546001600160a01b03163314620047825760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b620047906001600062008858565b6200479e6002600062008858565b6003805460ff19169055565b6020546001600160a01b03163314620048065760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b601c805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6020546001600160a01b03163314620048915760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b601a805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b603d546001600160a01b03163314620049425760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c0000000000000000000000000000000000000000000000000000000000606482015260840162001315565b6200494c62004ec4565b620049566200599b565b6200496062003ab4565b505042602a5543602981905560009081526027602090815260408083208390556026548151602d805460a0958102830186019094526080820184815262004ac096939592948594919390919085015b8282101562004a9d576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162004a059062009254565b80601f016020809104026020016040519081016040528092919081815260200182805462004a339062009254565b801562004a845780601f1062004a585761010080835404028352916020019162004a84565b820191906000526020600020905b81548152906001019060200180831162004a6657829003601f168201915b50505050508152505081526020019060010190620049af565b505050908252506000602082015243604082015260215460609091015262005c6a565b6200157560008055565b6020546001600160a01b0316331462004b265760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b6017805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6028546021546000911462004b6b575060285490565b5060215490565b6020546001600160a01b0316331462004bce5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b600655565b6020546001600160a01b0316331462004c2f5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b601e805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6020546001600160a01b0316331462004cba5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b6001600160a01b03811662004d385760405162461bcd60e51b815260206004820152602960248201527f696e76616c696420636f6e7472616374206164647265737320666f72206c697160448201527f756964206c6f6769630000000000000000000000000000000000000000000000606482015260840162001315565b603e805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6001600160a01b03831662004de45760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f2061646460448201527f7265737300000000000000000000000000000000000000000000000000000000606482015260840162001315565b6001600160a01b03821662004e625760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f20616464726560448201527f7373000000000000000000000000000000000000000000000000000000000000606482015260840162001315565b6001600160a01b0383811660008181526031602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92591015b60405180910390a3505050565b6000541562004f165760405162461bcd60e51b815260206004820152601360248201527f7265656e7472616e637920646574656374656400000000000000000000000000604482015260640162001315565b6001600055565b6001600160a01b0381166000908152603a6020526040812090601382015460ff16600381111562004f525762004f5262008a2c565b1462004fa15760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206d757374206265206163746976650000000000000000604482015260640162001315565b60138101805460ff1916600117905580546026546000908152602c60209081526040918290206003015491519182526001600160a01b038581169316917f75bdcdbe540758778e669d108fbcb7ede734f27f46e4e5525eeb8ecf91849a9c910160405180910390a35050565b6001600160a01b038316600090815260396020526040902054811115620050775760405162461bcd60e51b815260206004820152601660248201527f616d6f756e7420657863656564732062616c616e636500000000000000000000604482015260640162001315565b6001600160a01b03831660009081526039602052604081208054839290620050a1908490620092d8565b90915550506001600160a01b03821660009081526039602052604081208054839290620050d0908490620092ee565b9091555050505050565b6001600160a01b0382166000908152603960205260408120805483929062005104908490620092ee565b9250508190555080603b60008282546200511f9190620092ee565b90915550506040518181526001600160a01b038316907f48490b4407bb949b708ec5f514b4167f08f4969baaf78d53b05028adf369bfcf9060200162003482565b811580156200516d575080155b1562005177575050565b601254600090670de0b6b3a764000090620051949085906200999c565b620051a0919062009857565b905080156200521e576017546040516000916001600160a01b03169083908381818185875af1925050503d8060008114620051f8576040519150601f19603f3d011682016040523d82523d6000602084013e620051fd565b606091505b50909150508015156001036200521c57620052198285620092d8565b93505b505b82602e6000828254620052329190620092ee565b90915550600090505b602d5481101562001be9576000603a6000602d84815481106200526257620052626200986e565b600091825260208083206003909202909101546001600160a01b031683528201929092526040018120602b54602d8054929450909188919086908110620052ad57620052ad6200986e565b906000526020600020906003020160010154620052cb91906200999c565b620052d7919062009857565b90506000602b5486602d8681548110620052f557620052f56200986e565b9060005260206000209060030201600101546200531391906200999c565b6200531f919062009857565b90506000821180620053315750600081115b15620056bf576002601384015460ff16600381111562005355576200535562008a2c565b14806200537d57506003601384015460ff1660038111156200537b576200537b62008a2c565b145b156200545f576018546200539d9030906001600160a01b0316836200500d565b601854602d80546001600160a01b039092169163a8031a1d91859188908110620053cb57620053cb6200986e565b600091825260209091206003909102015460405160e084901b7fffffffff000000000000000000000000000000000000000000000000000000001681526001600160a01b039091166004820152602481018590526044016000604051808303818588803b1580156200543c57600080fd5b505af115801562005451573d6000803e3d6000fd5b5050505050505050620056c3565b600083600501548385600801546200547891906200999c565b62005484919062009857565b905080156200554657835460405160009182916001600160a01b03909116906108fc90859084818181858888f193505050503d8060008114620054e4576040519150601f19603f3d011682016040523d82523d6000602084013e620054e9565b606091505b509092509050811515600003620055435785546040517f1137d8c966ce69b9630fb2294be011f3d64cc56e91fad7d375f0662568e9d352916200553a916001600160a01b03909116908490620099b6565b60405180910390a15b50505b600084600501548386600801546200555f91906200999c565b6200556b919062009857565b905080156200558d5784546200558d9030906001600160a01b0316836200500d565b60006200559b8285620092d8565b90506000620055ab8487620092d8565b90506000811180620055bd5750600082115b156200567057600c870154620055df9030906001600160a01b0316846200500d565b600c8701546040517fa0ce552d000000000000000000000000000000000000000000000000000000008152600481018490526001600160a01b039091169063a0ce552d908390602401604080518083038185885af115801562005646573d6000803e3d6000fd5b50505050506040513d601f19601f820116820180604052508101906200566d9190620096c8565b50505b600187015460408051888152602081018890526001600160a01b03909216917f291e8ba3c0f4b0bd86e6e2346fcee1e7ca0975b1cc1886bfbc722d34f3f24d91910160405180910390a2505050505b5050505b80620056cf816200997f565b9150506200523b565b60355460005b8181101562001c5757600060358281548110620056ff57620056ff6200986e565b60009182526020808320909101546001600160a01b0316808352603382526040808420805482518186028101860190935280835292955090929091908301828280156200577657602002820191906000526020600020905b81546001600160a01b0316815260019091019060200180831162005757575b5050505050905060005b8151811015620057fe576001600160a01b03831660009081526032602052604081208351909190849084908110620057bc57620057bc6200986e565b60200260200101516001600160a01b03166001600160a01b03168152602001908152602001600020600090558080620057f5906200997f565b91505062005780565b506001600160a01b0382166000908152603360205260408120620058229162008897565b6001600160a01b03821660009081526038602052604090205481516007546200584c91906200999c565b811115620058685781516007546200586591906200999c565b90505b60005a9050836001600160a01b031663d18736ab83856040518363ffffffff1660e01b81526004016200589c919062009201565b600060405180830381600088803b158015620058b757600080fd5b5087f193505050508015620058ca575060015b62005900575a620058dc9082620092d8565b6001600160a01b038516600090815260366020526040902060019055905062005910565b5a6200590d9082620092d8565b90505b6001600160a01b03841660009081526038602052604090205481101562005967576001600160a01b038416600090815260386020526040812080548392906200595b908490620092d8565b90915550620059819050565b6001600160a01b0384166000908152603860205260408120555b50505050808062005992906200997f565b915050620056de565b6009545b600a54811015620059c857620059c281620059ba816200997f565b925062006ade565b6200599f565b50600a54600955600d54600c5403620059dd57565b600e545b600d5481101562005a0a5762005a0481620059fc816200997f565b925062006d98565b620059e1565b50600d54600e55600c54805b600d5481101562005a84576015546000828152600b6020526040902060040154439162005a4391620092ee565b1162005a695762005a548162007183565b62005a61600183620092ee565b915062005a6f565b62005a84565b8062005a7b816200997f565b91505062005a16565b50600c55565b60355460005b8181101562005b1a5760006035828154811062005ab15762005ab16200986e565b60009182526020808320909101546001600160a01b0316808352603482526040808420849055603690925291205490915060010362005b04576001600160a01b0381166000908152603660205260408120555b508062005b11816200997f565b91505062005a90565b50620013c46035600062008897565b601154601054101562001575576010546000908152600f602052604090206015546001820154439162005b5c91620092ee565b111562005b665750565b80546001600160a01b039081166000908152603a60205260409081902060028401546004808301829055600c83015493517f19fac8fd000000000000000000000000000000000000000000000000000000008152929493909316926319fac8fd9262005bd792910190815260200190565b600060405180830381600087803b15801562005bf257600080fd5b505af115801562005c07573d6000803e3d6000fd5b5050601080546000908152600f60205260408120805473ffffffffffffffffffffffffffffffffffffffff191681556001808201839055600290910182905582549094509192509062005c5c908490620092ee565b9091555062005b2992505050565b6000828152602c60209081526040808320918401516001830155830151600282015560608301516003820155905b82515181101562001be957825180518391908390811062005cbd5762005cbd6200986e565b6020908102919091018101518254600180820185556000948552938390208251600390920201805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0390921691909117815591810151928201929092556040820151600282019062005d2e908262009711565b505050808062005d3e906200997f565b91505062005c98565b6000805b602d5481101562005daf57602d818154811062005d6c5762005d6c6200986e565b60009182526020909120600390910201546001600160a01b039081169084160362005d9a5750600192915050565b8062005da6816200997f565b91505062005d4b565b50600092915050565b60008062005dc5620088b7565b60008060ff9050604083875160208901845afa62005de257600080fd5b505080516020909101516c0100000000000000000000000090910494909350915050565b60e281511462005e595760405162461bcd60e51b815260206004820152601460248201527f496e76616c69642070726f6f66206c656e677468000000000000000000000000604482015260640162001315565b6030826102400151511462005eb15760405162461bcd60e51b815260206004820152601c60248201527f496e76616c696420636f6e73656e737573206b6579206c656e67746800000000604482015260640162001315565b62005ebc826200732c565b604080518082018252601a81527f19457468657265756d205369676e6564204d6573736167653a0a00000000000060208083019190915284519251919260009262005f32920160609190911b7fffffffffffffffffffffffffffffffffffffffff00000000000000000000000016815260140190565b604051602081830303815290604052905060008262005f5283516200746b565b8360405160200162005f6793929190620099e8565b60408051601f198184030181528282528051602091820120600280855260608501845290945060009392909183019080368337019050509050600080808062005fbf898262005fb9604160026200999c565b620075c0565b9050600062005fde8a62005fd6604160026200999c565b6060620075c0565b905060205b8251811015620060af5762005ff98382620076f4565b6040805160008152602081018083528d905260ff8316918101919091526060810184905260808101839052929850909650945060019060a0016020604051602081039080840390855afa15801562006055573d6000803e3d6000fd5b5050604051601f1901519050876200606f60418462009857565b815181106200608257620060826200986e565b6001600160a01b0390921660209283029190910190910152620060a7604182620092ee565b905062005fe3565b508a602001516001600160a01b031686600081518110620060d457620060d46200986e565b60200260200101516001600160a01b0316146200615a5760405162461bcd60e51b815260206004820152602960248201527f496e76616c6964206e6f6465206b6579206f776e6572736869702070726f6f6660448201527f2070726f76696465640000000000000000000000000000000000000000000000606482015260840162001315565b8a604001516001600160a01b0316866001815181106200617e576200617e6200986e565b60200260200101516001600160a01b031614620062045760405162461bcd60e51b815260206004820152602b60248201527f496e76616c6964206f7261636c65206b6579206f776e6572736869702070726f60448201527f6f662070726f7669646564000000000000000000000000000000000000000000606482015260840162001315565b60016200621c8c6102400151838e600001516200772b565b14620062915760405162461bcd60e51b815260206004820152603660248201527f496e76616c696420636f6e73656e737573206b6579206f776e6572736869702060448201527f70726f6f6620666f7220726567697374726174696f6e00000000000000000000606482015260840162001315565b6200629c8b6200779a565b5050505050505050505050565b6000808311620063225760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201527f6976650000000000000000000000000000000000000000000000000000000000606482015260840162001315565b6001600160a01b0382166000908152603960205260409020548311156200638c5760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e63650000000000604482015260640162001315565b6001600160a01b03821660009081526039602052604081208054859290620063b6908490620092d8565b9091555050604080516080810182526001600160a01b03808516825286811660208084019182528385018881524360608601908152600a8054600090815260089094529683208651815490871673ffffffffffffffffffffffffffffffffffffffff199182161782559451600182018054919097169516949094179094555160028301559151600390910155825491929062006452836200997f565b90915550506001600160a01b038581166000818152603a6020908152604091829020548251908516948816948514808252918101899052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d910160405180910390a3833b15620064cc57620064cc848762007a7c565b6001600a54620064dd9190620092d8565b9695505050505050565b6001600160a01b038084166000908152603a6020526040812080549192909184821691161480620066a357600c8201546040517f84955c880000000000000000000000000000000000000000000000000000000081526001600160a01b03868116600483015260009216906384955c8890602401602060405180830381865afa15801562006579573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906200659f9190620096ae565b905085811015620066195760405162461bcd60e51b815260206004820152602b60248201527f696e73756666696369656e7420756e6c6f636b6564204c6971756964204e657760448201527f746f6e2062616c616e6365000000000000000000000000000000000000000000606482015260840162001315565b600c8301546040517f282d3fdf0000000000000000000000000000000000000000000000000000000081526001600160a01b038781166004830152602482018990529091169063282d3fdf90604401600060405180830381600087803b1580156200668357600080fd5b505af115801562006698573d6000803e3d6000fd5b50505050506200674c565b8482600b01548360080154620066ba9190620092d8565b1015620067305760405162461bcd60e51b815260206004820152602760248201527f696e73756666696369656e742073656c6620626f6e646564206e6577746f6e2060448201527f62616c616e636500000000000000000000000000000000000000000000000000606482015260840162001315565b8482600b016000828254620067469190620092ee565b90915550505b604051806101200160405280856001600160a01b03168152602001876001600160a01b03168152602001868152602001600081526020014381526020016000815260200160006003811115620067a657620067a662008a2c565b815260006020808301829052841515604093840152600d548252600b815290829020835181546001600160a01b0391821673ffffffffffffffffffffffffffffffffffffffff199182161783559285015160018381018054929093169190941617905591830151600283015560608301516003808401919091556080840151600484015560a0840151600584015560c08401516006840180549193909260ff199092169184908111156200685e576200685e62008a2c565b021790555060e08201516006909101805461010093840151151562010000027fffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ffff931515909402929092167fffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000ff90921691909117919091179055600d8054906000620068e8836200997f565b9190505550836001600160a01b0316866001600160a01b03167f63f8870909f7c59c9c4932bf98dbd491647c8d2e89ca0a032aacdd943a13e2fc83886040516200693e9291909115158252602082015260400190565b60405180910390a3833b156200695a576200695a848762007a7c565b6001600d54620064dd9190620092d8565b60fa60a06000808285855af462006986573d6000803e3d6000fd5b505050565b81546002600180831615610100020382160482518082016020811060208410016002811462006a3a576001811462006a60578660005260208404602060002001600160028402018855602085068060200390508088018589016001836101000a0392508282511684540184556001840193506020820191505b8082101562006a23578151845560018401935060208201915062006a04565b815191036101000a90819004029091555062006ad5565b60028302826020036101000a846020036101000a60208901510402018501875562006ad5565b8660005260208404602060002001600160028402018855846020038088018589016001836101000a0392508282511660ff198a160184556020820191506001840193505b8082101562006ac3578151845560018401935060208201915062006aa4565b815191036101000a9081900402909155505b50505050505050565b600081815260086020908152604080832060018101546001600160a01b03168452603a90925282209091601382015460ff16600381111562006b245762006b2462008a2c565b14158062006b4b575081546001600160a01b03166000908152603660205260409020546001145b1562006c0b57600282015482546001600160a01b03166000908152603960205260408120805490919062006b81908490620092ee565b909155505081546001830154600284015460138401546040516001600160a01b0394851694909316927f1ff2b052afa4bb37ce30d9aaccde416a700b97e632d089111749af937f8783429262006bdf92909160ff9091169062009a31565b60405180910390a380548254620069869185916000916001600160a01b03918216911614600162007b9b565b805482546001600160a01b0390811691161462006d48576000808260080154836005015462006c3b9190620092d8565b90508060000362006c53578360020154915062006c79565b80846002015484600d015462006c6a91906200999c565b62006c76919062009857565b91505b600c83015484546040517f40c10f190000000000000000000000000000000000000000000000000000000081526001600160a01b039182166004820152602481018590529116906340c10f1990604401600060405180830381600087803b15801562006ce457600080fd5b505af115801562006cf9573d6000803e3d6000fd5b505050508183600d01600082825462006d139190620092ee565b9091555050600284015460058401805460009062006d33908490620092ee565b9091555062001dcc9050858360008062007b9b565b816002015481600801600082825462006d629190620092ee565b9091555050600282015460058201805460009062006d82908490620092ee565b9091555062006986905083600060018162007b9b565b6000818152600b6020908152604080832080546001600160a01b0316845260369092529091205460010362006e3a578054600182015460068301546002840154604080516201000090930460ff161515835260208301919091526001600160a01b0393841693909216917fec3ccfdbfc982a0ea188f4fd85459da1d6a7b332ddc4ca6b7a774c1abfc75866910160405180910390a362001c5782600162007d3d565b60018101546001600160a01b03166000908152603a60205260408120600683015490919062010000900460ff1662007054576002830154600c83015484546040517f7eee288d0000000000000000000000000000000000000000000000000000000081526001600160a01b03918216600482015260248101849052911690637eee288d90604401600060405180830381600087803b15801562006edc57600080fd5b505af115801562006ef1573d6000803e3d6000fd5b50505050600c83015484546040517f9dc29fac0000000000000000000000000000000000000000000000000000000081526001600160a01b03918216600482015260248101849052911690639dc29fac90604401600060405180830381600087803b15801562006f6057600080fd5b505af115801562006f75573d6000803e3d6000fd5b5050505060008360080154846005015462006f919190620092d8565b600d85015490915062006fa582846200999c565b62006fb1919062009857565b92508184600d01600082825462006fc99190620092d8565b9091555050600684015460000362006fe8576003850183905562007010565b6006840154600785015462006ffe90856200999c565b6200700a919062009857565b60038601555b82846006016000828254620070269190620092ee565b9091555050600385015460078501805460009062007046908490620092ee565b909155506200712992505050565b50600282015460088201548111156200706e575060088101545b8160090154600003620070885760038301819055620070b0565b6009820154600a8301546200709e90836200999c565b620070aa919062009857565b60038401555b80826009016000828254620070c69190620092ee565b90915550506003830154600a83018054600090620070e6908490620092ee565b9250508190555080826008016000828254620071039190620092d8565b90915550506002830154600b8301805460009062007123908490620092d8565b90915550505b6006830180547fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ff1661010017905560058201805482919060009062007170908490620092d8565b9091555062001be9905084600062007d3d565b6000818152600b602052604090206006810154610100900460ff16620071c15760068101805460ff1916600217905562001c57826000600162007ec9565b60068101805460ff191660011790556003810154600003620071eb5762001c578260008062007ec9565b60018101546001600160a01b03166000908152603a60205260408120600683015490919062010000900460ff1662007288578160070154826006015484600301546200723891906200999c565b62007244919062009857565b9050808260060160008282546200725c9190620092d8565b909155505060038301546007830180546000906200727c908490620092d8565b90915550620072ed9050565b81600a015482600901548460030154620072a391906200999c565b620072af919062009857565b905080826009016000828254620072c79190620092d8565b90915550506003830154600a83018054600090620072e7908490620092d8565b90915550505b82546001600160a01b03166000908152603960205260408120805483929062007318908490620092ee565b9091555062001be990508482600062007ec9565b60006200733d826060015162005db8565b6001600160a01b039091166020840152905080156200739f5760405162461bcd60e51b815260206004820152600b60248201527f656e6f6465206572726f72000000000000000000000000000000000000000000604482015260640162001315565b6020808301516001600160a01b039081166000908152603a9092526040909120600101541615620074135760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c7265616479207265676973746572656400000000604482015260640162001315565b6127108260800151111562001c575760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e2072617465000000000000000000604482015260640162001315565b606081600003620074af57505060408051808201909152600181527f3000000000000000000000000000000000000000000000000000000000000000602082015290565b8160005b8115620074df5780620074c6816200997f565b9150620074d79050600a8362009857565b9150620074b3565b60008167ffffffffffffffff811115620074fd57620074fd62008d5b565b6040519080825280601f01601f19166020018201604052801562007528576020820181803683370190505b5090505b8415620075b85762007540600183620092d8565b91506200754f600a8662009a50565b6200755c906030620092ee565b60f81b8183815181106200757457620075746200986e565b60200101907effffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916908160001a905350620075b0600a8662009857565b94506200752c565b949350505050565b606081620075d081601f620092ee565b1015620076205760405162461bcd60e51b815260206004820152600e60248201527f736c6963655f6f766572666c6f77000000000000000000000000000000000000604482015260640162001315565b6200762c8284620092ee565b845110156200767e5760405162461bcd60e51b815260206004820152601160248201527f736c6963655f6f75744f66426f756e6473000000000000000000000000000000604482015260640162001315565b6060821580156200769f5760405191506000825260208201604052620076eb565b6040519150601f8416801560200281840101858101878315602002848b0101015b81831015620076da578051835260209283019201620076c0565b5050858452601f01601f1916604052505b50949350505050565b8181018051602082015160409092015190919060001a601b811015620077245762007721601b8262009a67565b90505b9250925092565b600062007737620088d5565b6000858585604051602001620077509392919062009a83565b6040516020818303038152906040529050600060fb9050600082516020620077799190620092ee565b90506020848285855afa6200778d57600080fd5b5050905195945050505050565b6101808101516001600160a01b0316620078b457603e546001600160a01b03166200782e5760405162461bcd60e51b815260206004820152602260248201527f6c6971756964206c6f67696320636f6e7472616374206e6f74206465706c6f7960448201527f6564000000000000000000000000000000000000000000000000000000000000606482015260840162001315565b6025546000906200783f906200746b565b905081602001518260000151836080015183603e60009054906101000a90046001600160a01b03166040516200787590620088f3565b6200788595949392919062009ae5565b604051809103906000f080158015620078a2573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051602580546001808201835560009283527f401968ff42a154441da5f6c4c935ac46b8671f0e062baaa62a7545ba53bb6e4c909101805473ffffffffffffffffffffffffffffffffffffffff199081166001600160a01b0395861617909155845184168352603a9095526040918290208651815487169085161781559351908401805486169184169190911790558401516002830180549094169116179091556060820151829190600382019062007973908262009711565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c8201805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f82015561020082015160108201556102208201516011820155610240820151601282019062007a4a908262009711565b5061026082015160138201805460ff1916600183600381111562007a725762007a7262008a2c565b0217905550505050565b6001600160a01b038216600090815260346020526040812054900362007b07576001600160a01b03821660008181526034602052604081206001908190556035805491820181559091527fcfa4bec1d3298408bb5afcfcd9c430549c5b31f8aa5c5848151c0a55f473c34d01805473ffffffffffffffffffffffffffffffffffffffff191690911790555b6001600160a01b038083166000908152603260209081526040808320938516835292905290812054900362001c57576001600160a01b038083166000818152603260209081526040808320948616808452948252808320600190819055938352603382528220805493840181558252902001805473ffffffffffffffffffffffffffffffffffffffff191690911790555050565b600084815260086020526040902080546001600160a01b0316803b62007bc357505062001be9565b6001600160a01b03811660009081526038602052604090205460045481111562007bec57506004545b60005a60018501546040517f9dfd1b8e000000000000000000000000000000000000000000000000000000008152600481018b90526001600160a01b039182166024820152604481018a905288151560648201528715156084820152919250841690639dfd1b8e90849060a401600060405180830381600088803b15801562007c7457600080fd5b5087f19350505050801562007c87575060015b62007cb2575a62007c999082620092d8565b90508462007cac5762007cac8862008052565b62007cc2565b5a62007cbf9082620092d8565b90505b6001600160a01b03831660009081526038602052604090205481101562007d19576001600160a01b0383166000908152603860205260408120805483929062007d0d908490620092d8565b9091555062007d339050565b6001600160a01b0383166000908152603860205260408120555b5050505050505050565b6000828152600b6020526040902080546001600160a01b0316803b62007d635750505050565b6001600160a01b03811660009081526038602052604090205460055481111562007d8c57506005545b60005a60018501546040517fa8920241000000000000000000000000000000000000000000000000000000008152600481018990526001600160a01b039182166024820152871515604482015291925084169063a8920241908490606401600060405180830381600088803b15801562007e0557600080fd5b5087f19350505050801562007e18575060015b62007e43575a62007e2a9082620092d8565b90508462007e3d5762007e3d8662008232565b62007e53565b5a62007e509082620092d8565b90505b6001600160a01b03831660009081526038602052604090205481101562007eaa576001600160a01b0383166000908152603860205260408120805483929062007e9e908490620092d8565b9091555062002db49050565b50506001600160a01b0316600090815260386020526040812055505050565b6000838152600b6020526040902080546001600160a01b0316803b62007ef0575050505050565b6001600160a01b03811660009081526038602052604090205460065481111562007f1957506006545b60005a6040517f3c54c290000000000000000000000000000000000000000000000000000000008152600481018990526024810188905286151560448201529091506001600160a01b03841690633c54c290908490606401600060405180830381600088803b15801562007f8c57600080fd5b5087f19350505050801562007f9f575060015b62007fcb575a62007fb19082620092d8565b90508462007fc55762007fc58787620084be565b62007fdb565b5a62007fd89082620092d8565b90505b6001600160a01b03831660009081526038602052604090205481101562008032576001600160a01b0383166000908152603860205260408120805483929062008026908490620092d8565b9091555062006ad59050565b50506001600160a01b031660009081526038602052604081205550505050565b600081815260086020908152604080832060018101546001600160a01b039081168552603a90935292208054835491929182169116146200817057600081600801548260050154620080a59190620092d8565b836002015483600d0154620080bb91906200999c565b620080c7919062009857565b600c83015484546040517f9dc29fac0000000000000000000000000000000000000000000000000000000081526001600160a01b039182166004820152602481018490529293501690639dc29fac90604401600060405180830381600087803b1580156200813457600080fd5b505af115801562008149573d6000803e3d6000fd5b505050508082600d016000828254620081639190620092d8565b9091555062008190915050565b81600201548160080160008282546200818a9190620092d8565b90915550505b600282015482546001600160a01b031660009081526039602052604081208054909190620081c0908490620092ee565b90915550506002820154600582018054600090620081e0908490620092d8565b90915550508154600183015460028401546040519081526001600160a01b0392831692909116907f2d2530c45d577d38d6fc598e41ade6fb0bfbde20d09244c88348d3a2797a9d909060200162004eb7565b6000818152600b6020908152604080832060018101546001600160a01b03168452603a909252822060068201549192909162010000900460ff1662008381576002830154600c83015484546040517f40c10f190000000000000000000000000000000000000000000000000000000081526001600160a01b039182166004820152602481018490529116906340c10f1990604401600060405180830381600087803b158015620082e157600080fd5b505af1158015620082f6573d6000803e3d6000fd5b505050508083600d016000828254620083109190620092ee565b90915550506007830154600684015460038601546200833091906200999c565b6200833c919062009857565b915081836006016000828254620083549190620092d8565b9091555050600384015460078401805460009062008374908490620092d8565b9091555062008403915050565b81600a0154826009015484600301546200839c91906200999c565b620083a8919062009857565b905080826009016000828254620083c09190620092d8565b90915550506003830154600a83018054600090620083e0908490620092d8565b9250508190555080826008016000828254620083fd9190620092ee565b90915550505b6000600384018190556006840180547fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ff1690556005830180548392906200844c908490620092ee565b90915550508254600184015460068501546002860154604080516201000090930460ff161515835260208301919091526001600160a01b0393841693909216917f52c1b482483796c697edd43958bc63e332069083ea38782d960693145db2cf0c91015b60405180910390a350505050565b6000828152600b60205260408120908290036200854257600681018054600360ff19909116179081905581546001830154604080516201000090940460ff1615158452602084018690526001600160a01b039283169392909116917f0a4bfc4c4e35975ab1fe5fd21d963e8bb8d483f04aebdbc0a059b246bec3f5fc910162004eb7565b60018101546001600160a01b03166000908152603a60205260409020600682015462010000900460ff166200866b57600080826008015483600501546200858a9190620092d8565b9050806000036200859e57849150620085c0565b808584600d0154620085b191906200999c565b620085bd919062009857565b91505b600c83015484546040517f40c10f190000000000000000000000000000000000000000000000000000000081526001600160a01b039182166004820152602481018590529116906340c10f1990604401600060405180830381600087803b1580156200862b57600080fd5b505af115801562008640573d6000803e3d6000fd5b505050508183600d0160008282546200865a9190620092ee565b909155505050600583015562008690565b828260050181905550828160080160008282546200868a9190620092ee565b90915550505b60068201805460ff1916600317905581546001600160a01b031660009081526039602052604081208054859290620086ca908490620092d8565b9250508190555082816005016000828254620086e79190620092ee565b9091555050815460018301546006840154604080516201000090920460ff1615158252602082018790526001600160a01b0393841693909216917f0a4bfc4c4e35975ab1fe5fd21d963e8bb8d483f04aebdbc0a059b246bec3f5fc9101620084b0565b60405180610280016040528060006001600160a01b0316815260200160006001600160a01b0316815260200160006001600160a01b0316815260200160608152602001600081526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b031681526020016000815260200160008152602001600081526020016000815260200160008152602001606081526020016000600381111562008815576200881562008a2c565b905290565b6040518060a001604052806005906020820280368337509192915050565b5080546000825590600052602060002090810190620013c4919062008901565b508054620088669062009254565b6000825580601f1062008877575050565b601f016020900490600052602060002090810190620013c4919062008926565b5080546000825590600052602060002090810190620013c4919062008926565b60405180604001604052806002906020820280368337509192915050565b60405180602001604052806001906020820280368337509192915050565b6106108062009b1983390190565b808211156200892257600062008918828262008858565b5060010162008901565b5090565b5b8082111562008922576000815560010162008927565b60005b838110156200895a57818101518382015260200162008940565b50506000910152565b600081518084526200897d8160208601602086016200893d565b601f01601f19169290920160200192915050565b602081526000620089a6602083018462008963565b9392505050565b6001600160a01b0381168114620013c457600080fd5b60008060408385031215620089d757600080fd5b8235620089e481620089ad565b946020939093013593505050565b60006020828403121562008a0557600080fd5b8135620089a681620089ad565b60006020828403121562008a2557600080fd5b5035919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b60048110620013c4577f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b62008a9e8162008a5b565b9052565b6020815262008abd6020820183516001600160a01b03169052565b6000602083015162008ada60408401826001600160a01b03169052565b5060408301516001600160a01b038116606084015250606083015161028080608085015262008b0e6102a085018362008963565b9150608085015160a085015260a085015160c085015260c085015160e085015260e08501516101008181870152808701519150506101208181870152808701519150506101408181870152808701519150506101608181870152808701519150506101808181870152808701519150506101a062008b96818701836001600160a01b03169052565b8601516101c0868101919091528601516101e080870191909152860151610200808701919091528601516102208087019190915286015161024080870191909152860151858403601f19016102608088019190915290915062008bfa848362008963565b93508087015191505062008c118286018262008a93565b5090949350505050565b60008060006060848603121562008c3157600080fd5b833562008c3e81620089ad565b9250602084013562008c5081620089ad565b929592945050506040919091013590565b60006020828403121562008c7457600080fd5b813567ffffffffffffffff81111562008c8c57600080fd5b82016102808185031215620089a657600080fd5b600081518084526020808501808196508360051b8101915082860160005b8581101562008d15578284038952815180516001600160a01b03168552858101518686015260409081015160609186018290529062008d008187018362008963565b9a87019a955050509084019060010162008cbe565b5091979650505050505050565b8515158152841515602082015260a06040820152600062008d4760a083018662008ca0565b606083019490945250608001529392505050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b600082601f83011262008d9c57600080fd5b813567ffffffffffffffff8082111562008dba5762008dba62008d5b565b604051601f8301601f19908116603f0116810190828211818310171562008de55762008de562008d5b565b8160405283815286602085880101111562008dff57600080fd5b836020870160208301376000602085830101528094505050505092915050565b6000806040838503121562008e3357600080fd5b823562008e4081620089ad565b9150602083013567ffffffffffffffff81111562008e5d57600080fd5b62008e6b8582860162008d8a565b9150509250929050565b600061026082019050855182526020860151602083015260408601516040830152606086015160608301526080860151608083015260a08601516001600160a01b0380821660a08501528087511660c08501528060208801511660e0850152806040880151166101008501525050606085015162008eff6101208401826001600160a01b03169052565b5060808501516001600160a01b0390811661014084015260a0860151811661016084015260c0860151811661018084015260e09095015185166101a083015283519094166101c082015260208301516101e0820152604083015161020082015260609092015161022083015261024090910152919050565b6000806000806080858703121562008f8e57600080fd5b843567ffffffffffffffff8082111562008fa757600080fd5b62008fb58883890162008d8a565b95506020870135915062008fc982620089ad565b9093506040860135908082111562008fe057600080fd5b62008fee8883890162008d8a565b935060608701359150808211156200900557600080fd5b50620090148782880162008d8a565b91505092959194509250565b6000602080830181845280855180835260408601915060408160051b870101925083870160005b8281101562009097577fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffc08886030184526200908485835162008963565b9450928501929085019060010162009047565b5092979650505050505050565b60208101620090b38362008a5b565b91905290565b608081526000620090ce608083018762008ca0565b6020830195909552506040810192909252606090910152919050565b602081526000620089a6602083018462008ca0565b600081518084526020808501945080840160005b838110156200913a5781516001600160a01b03168752958201959082019060010162009113565b509495945050505050565b6040815260006200915a6040830185620090ff565b82810360208401526200916e8185620090ff565b95945050505050565b600080604083850312156200918b57600080fd5b823567ffffffffffffffff80821115620091a457600080fd5b620091b28683870162008d8a565b93506020850135915080821115620091c957600080fd5b5062008e6b8582860162008d8a565b604081526000620091ed604083018562008963565b82810360208401526200916e818562008963565b602081526000620089a66020830184620090ff565b600080604083850312156200922a57600080fd5b82356200923781620089ad565b915060208301356200924981620089ad565b809150509250929050565b600181811c908216806200926957607f821691505b602082108103620092a3577f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b818103818111156200129b576200129b620092a9565b808201808211156200129b576200129b620092a9565b600081356200129b81620089ad565b60008083357fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe18436030181126200934957600080fd5b83018035915067ffffffffffffffff8211156200936557600080fd5b6020019150368190038213156200937b57600080fd5b9250929050565b601f8211156200698657600081815260208120601f850160051c81016020861015620093ab5750805b601f850160051c820191505b8181101562002db457828155600101620093b7565b67ffffffffffffffff831115620093e757620093e762008d5b565b620093ff83620093f8835462009254565b8362009382565b6000601f8411600181146200943657600085156200941d5750838201355b600019600387901b1c1916600186901b17835562001dcc565b600083815260209020601f19861690835b8281101562009469578685013582556020948501946001909201910162009447565b5086821015620094875760001960f88860031b161c19848701351681555b505060018560011b0183555050505050565b60008135600481106200129b57600080fd5b620094b68262008a5b565b60ff1981541660ff831681178255505050565b62009502620094d88362009304565b825473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0391909116178255565b62009542620095146020840162009304565b600183016001600160a01b03821673ffffffffffffffffffffffffffffffffffffffff198254161781555050565b62009582620095546040840162009304565b600283016001600160a01b03821673ffffffffffffffffffffffffffffffffffffffff198254161781555050565b62009591606083018362009313565b620095a1818360038601620093cc565b50506080820135600482015560a0820135600582015560c0820135600682015560e0820135600782015561010082013560088201556101208201356009820155610140820135600a820155610160820135600b820155620096386200960a610180840162009304565b600c83016001600160a01b03821673ffffffffffffffffffffffffffffffffffffffff198254161781555050565b6101a0820135600d8201556101c0820135600e8201556101e0820135600f820155610200820135601082015561022082013560118201556200967f61024083018362009313565b6200968f818360128601620093cc565b505062001c57620096a4610260840162009499565b60138301620094ab565b600060208284031215620096c157600080fd5b5051919050565b60008060408385031215620096dc57600080fd5b505080516020909101519092909150565b6000602082840312156200970057600080fd5b81518015158114620089a657600080fd5b815167ffffffffffffffff8111156200972e576200972e62008d5b565b62009746816200973f845462009254565b8462009382565b602080601f8311600181146200977e5760008415620097655750858301515b600019600386901b1c1916600185901b17855562002db4565b600085815260208120601f198616915b82811015620097af578886015182559484019460019091019084016200978e565b5085821015620097ce5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b60006001600160a01b0380881683528087166020840152808616604084015260a060608401526200981360a084018662008963565b91508084166080840152509695505050505050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b60008262009869576200986962009828565b500490565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052603260045260246000fd5b818103620098a9575050565b620098b5825462009254565b67ffffffffffffffff811115620098d057620098d062008d5b565b620098e1816200973f845462009254565b6000601f821160018114620099185760008315620098ff5750848201545b600019600385901b1c1916600184901b17845562001dcc565b600085815260209020601f19841690600086815260209020845b8381101562009954578286015482556001958601959091019060200162009932565b5085831015620097ce5793015460001960f8600387901b161c19169092555050600190811b01905550565b60006000198203620099955762009995620092a9565b5060010190565b80820281158282048414176200129b576200129b620092a9565b6001600160a01b03831681526060602082015260006060820152608060408201526000620075b8608083018462008963565b60008451620099fc8184602089016200893d565b84519083019062009a128183602089016200893d565b845191019062009a278183602088016200893d565b0195945050505050565b8281526040810162009a438362008a5b565b8260208301529392505050565b60008262009a625762009a6262009828565b500690565b60ff81811683821601908111156200129b576200129b620092a9565b6000845162009a978184602089016200893d565b84519083019062009aad8183602089016200893d565b60609490941b7fffffffffffffffffffffffffffffffffffffffff000000000000000000000000169301928352505060140192915050565b60006001600160a01b038088168352808716602084015285604084015260a060608401526200981360a08401866200896356fe608060405234801561001057600080fd5b5060405161061038038061061083398101604081905261002f916101af565b806001600160a01b0316632f2c3f2e6040518163ffffffff1660e01b8152600401602060405180830381865afa15801561006d573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610091919061029f565b83111561009d57600080fd5b600c80546001600160a01b038088166001600160a01b031992831617909255600d805492871692909116919091179055600e8390556040516100e39083906020016102b8565b604051602081830303815290604052600a9081610100919061036e565b508160405160200161011291906102b8565b604051602081830303815290604052600b908161012f919061036e565b5050601080546001600160a01b031916331790555061042d92505050565b6001600160a01b038116811461016257600080fd5b50565b80516101708161014d565b919050565b634e487b7160e01b600052604160045260246000fd5b60005b838110156101a657818101518382015260200161018e565b50506000910152565b600080600080600060a086880312156101c757600080fd5b85516101d28161014d565b60208701519095506101e38161014d565b6040870151606088015191955093506001600160401b038082111561020757600080fd5b818801915088601f83011261021b57600080fd5b81518181111561022d5761022d610175565b604051601f8201601f19908116603f0116810190838211818310171561025557610255610175565b816040528281528b602084870101111561026e57600080fd5b61027f83602083016020880161018b565b809650505050505061029360808701610165565b90509295509295909350565b6000602082840312156102b157600080fd5b5051919050565b644c4e544e2d60d81b8152600082516102d881600585016020870161018b565b9190910160050192915050565b600181811c908216806102f957607f821691505b60208210810361031957634e487b7160e01b600052602260045260246000fd5b50919050565b601f82111561036957600081815260208120601f850160051c810160208610156103465750805b601f850160051c820191505b8181101561036557828155600101610352565b5050505b505050565b81516001600160401b0381111561038757610387610175565b61039b8161039584546102e5565b8461031f565b602080601f8311600181146103d057600084156103b85750858301515b600019600386901b1c1916600185901b178555610365565b600085815260208120601f198616915b828110156103ff578886015182559484019460019091019084016103e0565b508582101561041d5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b6101d48061043c6000396000f3fe60806040523661001b57610019610014610022565b61013e565b005b6100196100145b600080601060009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff166396f18a326040518163ffffffff1660e01b8152600401602060405180830381865afa158015610092573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906100b69190610161565b905073ffffffffffffffffffffffffffffffffffffffff8116610139576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152601d60248201527f6c6971756964206c6f67696320636f6e7472616374206e6f7420736574000000604482015260640160405180910390fd5b919050565b3660008037600080366000845af43d6000803e8061015b573d6000fd5b503d6000f35b60006020828403121561017357600080fd5b815173ffffffffffffffffffffffffffffffffffffffff8116811461019757600080fd5b939250505056fea26469706673582212200db76088bf5d8acdca170dade31d69898e8f986dad784e43d3aefe7fdd43feff64736f6c63430008150033a26469706673582212203f570aaf3cafe035ea44b43a7f8a7d7e89b62588c389ca8c41cbf48e0f9491cc64736f6c63430008150033608060405234801561001057600080fd5b50601080546001600160a01b03191633179055611cc4806100326000396000f3fe6080604052600436106101a55760003560e01c80633e4eb36c116100e1578063949813b81161008a578063a0ce552d11610064578063a0ce552d1461054b578063a9059cbb1461055e578063bd96102f1461057e578063dd62ed3e14610593576101ac565b8063949813b8146104e157806395d89b41146105165780639dc29fac1461052b576101ac565b806370a08231116100bb57806370a082311461045e5780637eee288d146104a157806384955c88146104c1576101ac565b80633e4eb36c146103e657806340c10f19146103fb578063593557361461041b576101ac565b80631eeffad01161014e5780632f2c3f2e116101285780632f2c3f2e14610374578063313ce5671461038a578063372500ab146103a65780633b19e84a146103bb576101ac565b80631eeffad01461031f57806323b872dd14610334578063282d3fdf14610354576101ac565b806318160ddd1161017f57806318160ddd146102c6578063187cf4d7146102e557806319fac8fd146102fd576101ac565b806306fdde031461021f578063095ea7b31461024a5780631195e07e1461027a576101ac565b366101ac57005b60405162461bcd60e51b815260206004820152602860248201527f66616c6c6261636b206e6f7420696d706c656d656e74656420666f72204c697160448201527f7569644c6f67696300000000000000000000000000000000000000000000000060648201526084015b60405180910390fd5b34801561022b57600080fd5b506102346105e6565b6040516102419190611a10565b60405180910390f35b34801561025657600080fd5b5061026a610265366004611aa5565b610678565b6040519015158152602001610241565b34801561028657600080fd5b50600c5473ffffffffffffffffffffffffffffffffffffffff165b60405173ffffffffffffffffffffffffffffffffffffffff9091168152602001610241565b3480156102d257600080fd5b506003545b604051908152602001610241565b3480156102f157600080fd5b506102d7633b9aca0081565b34801561030957600080fd5b5061031d610318366004611acf565b61068f565b005b34801561032b57600080fd5b50600f546102d7565b34801561034057600080fd5b5061026a61034f366004611ae8565b610721565b34801561036057600080fd5b5061031d61036f366004611aa5565b610864565b34801561038057600080fd5b506102d761271081565b34801561039657600080fd5b5060405160128152602001610241565b3480156103b257600080fd5b5061031d6109de565b3480156103c757600080fd5b50600d5473ffffffffffffffffffffffffffffffffffffffff166102a1565b3480156103f257600080fd5b50600e546102d7565b34801561040757600080fd5b5061031d610416366004611aa5565b610c08565b34801561042757600080fd5b506102d7610436366004611b24565b73ffffffffffffffffffffffffffffffffffffffff1660009081526001602052604090205490565b34801561046a57600080fd5b506102d7610479366004611b24565b73ffffffffffffffffffffffffffffffffffffffff1660009081526020819052604090205490565b3480156104ad57600080fd5b5061031d6104bc366004611aa5565b610cf2565b3480156104cd57600080fd5b506102d76104dc366004611b24565b610e4f565b3480156104ed57600080fd5b506105016104fc366004611b24565b610e89565b60408051928352602083019190915201610241565b34801561052257600080fd5b50610234610f75565b34801561053757600080fd5b5061031d610546366004611aa5565b610f84565b610501610559366004611acf565b611066565b34801561056a57600080fd5b5061026a610579366004611aa5565b6113f0565b34801561058a57600080fd5b5061031d61145c565b34801561059f57600080fd5b506102d76105ae366004611b3f565b73ffffffffffffffffffffffffffffffffffffffff918216600090815260026020908152604080832093909416825291909152205490565b6060600a80546105f590611b72565b80601f016020809104026020016040519081016040528092919081815260200182805461062190611b72565b801561066e5780601f106106435761010080835404028352916020019161066e565b820191906000526020600020905b81548152906001019060200180831161065157829003601f168201915b5050505050905090565b60006106853384846115a3565b5060015b92915050565b60105473ffffffffffffffffffffffffffffffffffffffff16331461071c5760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610216565b600e55565b73ffffffffffffffffffffffffffffffffffffffff83166000908152600260209081526040808320338452909152812054828110156107c85760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e742065786365656473206160448201527f6c6c6f77616e63650000000000000000000000000000000000000000000000006064820152608401610216565b6107dc85336107d78685611bf4565b6115a3565b6107e68584611722565b6107f0848461183d565b8373ffffffffffffffffffffffffffffffffffffffff168573ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8560405161084f91815260200190565b60405180910390a360019150505b9392505050565b60105473ffffffffffffffffffffffffffffffffffffffff1633146108f15760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610216565b73ffffffffffffffffffffffffffffffffffffffff82166000908152600160209081526040808320549183905290912054829161092d91611bf4565b10156109a05760405162461bcd60e51b8152602060048201526024808201527f63616e2774206c6f636b206d6f72652066756e6473207468616e20617661696c60448201527f61626c65000000000000000000000000000000000000000000000000000000006064820152608401610216565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260016020526040812080548392906109d5908490611c07565b90915550505050565b6000806109ea33611896565b3360009081526004602090815260408083208390556007909152812081905591935091508115610afe576010546040517fa9059cbb0000000000000000000000000000000000000000000000000000000081523360048201526024810184905273ffffffffffffffffffffffffffffffffffffffff9091169063a9059cbb906044016020604051808303816000875af1158015610a8b573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610aaf9190611c1a565b905080610afe5760405162461bcd60e51b815260206004820152601260248201527f4661696c656420746f2073656e64204e544e00000000000000000000000000006044820152606401610216565b333b15610b6b573373ffffffffffffffffffffffffffffffffffffffff1663161605e3846040518263ffffffff1660e01b81526004016000604051808303818588803b158015610b4d57600080fd5b505af1158015610b61573d6000803e3d6000fd5b5050505050505050565b60405133908490600081818185875af1925050503d8060008114610bab576040519150601f19603f3d011682016040523d82523d6000602084013e610bb0565b606091505b50508091505080610c035760405162461bcd60e51b815260206004820152601260248201527f4661696c656420746f2073656e642041544e00000000000000000000000000006044820152606401610216565b505050565b60105473ffffffffffffffffffffffffffffffffffffffff163314610c955760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610216565b610c9f828261183d565b60405181815273ffffffffffffffffffffffffffffffffffffffff8316906000907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef906020015b60405180910390a35050565b60105473ffffffffffffffffffffffffffffffffffffffff163314610d7f5760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610216565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260016020526040902054811115610e1a5760405162461bcd60e51b815260206004820152602360248201527f63616e277420756e6c6f636b206d6f72652066756e6473207468616e206c6f6360448201527f6b656400000000000000000000000000000000000000000000000000000000006064820152608401610216565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260016020526040812080548392906109d5908490611bf4565b73ffffffffffffffffffffffffffffffffffffffff8116600090815260016020908152604080832054918390528220546106899190611bf4565b73ffffffffffffffffffffffffffffffffffffffff81166000908152602081815260408083205460065460059093529083205483928391610ecb9184916119d2565b73ffffffffffffffffffffffffffffffffffffffff8616600090815260046020526040902054909150610eff908290611c07565b60095473ffffffffffffffffffffffffffffffffffffffff871660009081526008602052604081205492965091610f379185916119d2565b73ffffffffffffffffffffffffffffffffffffffff8716600090815260076020526040902054909150610f6b908290611c07565b9350505050915091565b6060600b80546105f590611b72565b60105473ffffffffffffffffffffffffffffffffffffffff1633146110115760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610216565b61101b8282611722565b60405181815260009073ffffffffffffffffffffffffffffffffffffffff8416907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef90602001610ce6565b601054600090819073ffffffffffffffffffffffffffffffffffffffff1633146110f85760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610216565b600e5434906000906127109061110e9084611c3c565b6111189190611c53565b90508181111561116a5760405162461bcd60e51b815260206004820152601c60248201527f696e76616c69642061746e2076616c696461746f7220726577617264000000006044820152606401610216565b6111748183611bf4565b600d5460405191935060009173ffffffffffffffffffffffffffffffffffffffff909116906108fc90849084818181858888f193505050503d80600081146111d8576040519150601f19603f3d011682016040523d82523d6000602084013e6111dd565b606091505b50909150508015156000036112045781600f60008282546111fe9190611c07565b90915550505b6000612710600e54886112179190611c3c565b6112219190611c53565b9050868111156112735760405162461bcd60e51b815260206004820152601c60248201527f696e76616c6964206e746e2076616c696461746f7220726577617264000000006044820152606401610216565b61127d8188611bf4565b9650801561132657601054600d546040517fa9059cbb00000000000000000000000000000000000000000000000000000000815273ffffffffffffffffffffffffffffffffffffffff91821660048201526024810184905291169063a9059cbb906044016020604051808303816000875af1158015611300573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906113249190611c1a565b505b60035460009061133a633b9aca0087611c3c565b6113449190611c53565b9050806006546113549190611c07565b60065560035460009061136b633b9aca008b611c3c565b6113759190611c53565b9050806009546113859190611c07565b600955600354600090633b9aca009061139e9085611c3c565b6113a89190611c53565b90506000633b9aca00600354846113bf9190611c3c565b6113c99190611c53565b90506113d58288611c07565b6113df8287611c07565b995099505050505050505050915091565b60006113fc3383611722565b611406838361183d565b60405182815273ffffffffffffffffffffffffffffffffffffffff84169033907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef9060200160405180910390a350600192915050565b600d5473ffffffffffffffffffffffffffffffffffffffff1633146114e95760405162461bcd60e51b815260206004820152602260248201527f6f6e6c792074726561737572792063616e20636c61696d20686973207265776160448201527f72640000000000000000000000000000000000000000000000000000000000006064820152608401610216565b600d54600f5460405160009273ffffffffffffffffffffffffffffffffffffffff1691908381818185875af1925050503d8060008114611545576040519150601f19603f3d011682016040523d82523d6000602084013e61154a565b606091505b505090508061159b5760405162461bcd60e51b815260206004820152601260248201527f6661696c656420746f2073656e642041544e00000000000000000000000000006044820152606401610216565b506000600f55565b73ffffffffffffffffffffffffffffffffffffffff831661162b5760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f2061646460448201527f72657373000000000000000000000000000000000000000000000000000000006064820152608401610216565b73ffffffffffffffffffffffffffffffffffffffff82166116b45760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f20616464726560448201527f73730000000000000000000000000000000000000000000000000000000000006064820152608401610216565b73ffffffffffffffffffffffffffffffffffffffff83811660008181526002602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b61172b82611896565b505073ffffffffffffffffffffffffffffffffffffffff8216600090815260208181526040808320546001909252909120546117679082611bf4565b8211156117b65760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e7420756e6c6f636b65642066756e647300000000006044820152606401610216565b6117c08282611bf4565b73ffffffffffffffffffffffffffffffffffffffff84166000908152602081905260409020558082036118215773ffffffffffffffffffffffffffffffffffffffff8316600090815260056020908152604080832083905560089091528120555b81600360008282546118339190611bf4565b9091555050505050565b61184682611896565b505073ffffffffffffffffffffffffffffffffffffffff82166000908152602081905260408120805483929061187d908490611c07565b9250508190555080600360008282546109d59190611c07565b73ffffffffffffffffffffffffffffffffffffffff811660009081526020818152604080832054600654600590935290832054839283916118d89184916119d2565b73ffffffffffffffffffffffffffffffffffffffff861660009081526004602052604090205490915061190c908290611c07565b73ffffffffffffffffffffffffffffffffffffffff8616600090815260046020908152604080832084905560065460058352818420556009546008909252822054929650909161195d9185916119d2565b73ffffffffffffffffffffffffffffffffffffffff8716600090815260076020526040902054909150611991908290611c07565b73ffffffffffffffffffffffffffffffffffffffff909616600090815260076020908152604080832089905560095460089092529091205550929492505050565b6000836000036119e45750600061085d565b633b9aca00846119f48486611bf4565b6119fe9190611c3c565b611a089190611c53565b949350505050565b600060208083528351808285015260005b81811015611a3d57858101830151858201604001528201611a21565b5060006040828601015260407fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe0601f8301168501019250505092915050565b803573ffffffffffffffffffffffffffffffffffffffff81168114611aa057600080fd5b919050565b60008060408385031215611ab857600080fd5b611ac183611a7c565b946020939093013593505050565b600060208284031215611ae157600080fd5b5035919050565b600080600060608486031215611afd57600080fd5b611b0684611a7c565b9250611b1460208501611a7c565b9150604084013590509250925092565b600060208284031215611b3657600080fd5b61085d82611a7c565b60008060408385031215611b5257600080fd5b611b5b83611a7c565b9150611b6960208401611a7c565b90509250929050565b600181811c90821680611b8657607f821691505b602082108103611bbf577f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b8181038181111561068957610689611bc5565b8082018082111561068957610689611bc5565b600060208284031215611c2c57600080fd5b8151801515811461085d57600080fd5b808202811582820484141761068957610689611bc5565b600082611c89577f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b50049056fea264697066735822122048a7cbfe3833669d997bb4362e8661ceaea3c483f62fac7ae471e39149e5682964736f6c63430008150033608060405234801561001057600080fd5b5060405161061038038061061083398101604081905261002f916101af565b806001600160a01b0316632f2c3f2e6040518163ffffffff1660e01b8152600401602060405180830381865afa15801561006d573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610091919061029f565b83111561009d57600080fd5b600c80546001600160a01b038088166001600160a01b031992831617909255600d805492871692909116919091179055600e8390556040516100e39083906020016102b8565b604051602081830303815290604052600a9081610100919061036e565b508160405160200161011291906102b8565b604051602081830303815290604052600b908161012f919061036e565b5050601080546001600160a01b031916331790555061042d92505050565b6001600160a01b038116811461016257600080fd5b50565b80516101708161014d565b919050565b634e487b7160e01b600052604160045260246000fd5b60005b838110156101a657818101518382015260200161018e565b50506000910152565b600080600080600060a086880312156101c757600080fd5b85516101d28161014d565b60208701519095506101e38161014d565b6040870151606088015191955093506001600160401b038082111561020757600080fd5b818801915088601f83011261021b57600080fd5b81518181111561022d5761022d610175565b604051601f8201601f19908116603f0116810190838211818310171561025557610255610175565b816040528281528b602084870101111561026e57600080fd5b61027f83602083016020880161018b565b809650505050505061029360808701610165565b90509295509295909350565b6000602082840312156102b157600080fd5b5051919050565b644c4e544e2d60d81b8152600082516102d881600585016020870161018b565b9190910160050192915050565b600181811c908216806102f957607f821691505b60208210810361031957634e487b7160e01b600052602260045260246000fd5b50919050565b601f82111561036957600081815260208120601f850160051c810160208610156103465750805b601f850160051c820191505b8181101561036557828155600101610352565b5050505b505050565b81516001600160401b0381111561038757610387610175565b61039b8161039584546102e5565b8461031f565b602080601f8311600181146103d057600084156103b85750858301515b600019600386901b1c1916600185901b178555610365565b600085815260208120601f198616915b828110156103ff578886015182559484019460019091019084016103e0565b508582101561041d5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b6101d48061043c6000396000f3fe60806040523661001b57610019610014610022565b61013e565b005b6100196100145b600080601060009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff166396f18a326040518163ffffffff1660e01b8152600401602060405180830381865afa158015610092573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906100b69190610161565b905073ffffffffffffffffffffffffffffffffffffffff8116610139576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152601d60248201527f6c6971756964206c6f67696320636f6e7472616374206e6f7420736574000000604482015260640160405180910390fd5b919050565b3660008037600080366000845af43d6000803e8061015b573d6000fd5b503d6000f35b60006020828403121561017357600080fd5b815173ffffffffffffffffffffffffffffffffffffffff8116811461019757600080fd5b939250505056fea26469706673582212200db76088bf5d8acdca170dade31d69898e8f986dad784e43d3aefe7fdd43feff64736f6c63430008150033")
+var AutonityBytecode = common.Hex2Bytes("608060405261c35060045561c35060055561c350600655614e2060075560006010556000601155633b9aca006037553480156200003b57600080fd5b506040516200e35f3803806200e35f8339810160408190526200005e916200116c565b6024546000036200008757603d80546001600160a01b031916331790556200008782826200008f565b505062001633565b80518051601255602080820151601355604080830151601455606080840151601555608080850151601681905560a095860151601780546001600160a01b03199081166001600160a01b03938416179091558689015180516018805484169185169190911790558088015160198054841691851691909117905580870151601a8054841691851691909117905580860151601b8054841691851691909117905593840151601c8054831691841691909117905596830151601d8054891691831691909117905560c0830151601e8054891691831691909117905560e090920151601f805488169184169190911790558387015180518654909716969092169590951784559283015160218190558383015160225592810151602355840151602455602891909155603c9190915551620001c89062000dba565b604051809103906000f080158015620001e5573d6000803e3d6000fd5b50603e80546001600160a01b0319166001600160a01b039290921691909117905560005b8251811015620005435760008382815181106200022a576200022a620013a9565b602002602001015160a001519050604e8483815181106200024f576200024f620013a9565b6020908102919091018101516102600151825460018101845560009384529282902091830490910180549192909160ff601f9092166101000a918202191690836003811115620002a357620002a3620013bf565b02179055506000848381518110620002bf57620002bf620013a9565b60200260200101516101a00181815250506000848381518110620002e757620002e7620013a9565b602002602001015161018001906001600160a01b031690816001600160a01b0316815250506000848381518110620003235762000323620013a9565b602002602001015160a001818152505060008483815181106200034a576200034a620013a9565b60209081029190910101516101c001526014548451859084908110620003745762000374620013a9565b6020026020010151608001818152505060008483815181106200039b576200039b620013a9565b602002602001015161026001906003811115620003bc57620003bc620013bf565b90816003811115620003d257620003d2620013bf565b815250506000848381518110620003ed57620003ed620013a9565b60200260200101516101600181815250506200042b848381518110620004175762000417620013a9565b60200260200101516200054860201b60201c565b62000458848381518110620004445762000444620013a9565b60200260200101516200068360201b60201c565b80156200052d578060396000868581518110620004795762000479620013a9565b6020026020010151600001516001600160a01b03166001600160a01b031681526020019081526020016000206000828254620004b69190620013eb565b9250508190555080603b6000828254620004d19190620013eb565b925050819055506200052b848381518110620004f157620004f1620013a9565b60200260200101516020015182868581518110620005135762000513620013a9565b6020026020010151600001516200093060201b60201c565b505b50806200053a8162001407565b91505062000209565b505050565b60006200055f826060015162000b4760201b60201c565b6001600160a01b03909116602084015290508015620005b35760405162461bcd60e51b815260206004820152600b60248201526a32b737b2329032b93937b960a91b60448201526064015b60405180910390fd5b6020808301516001600160a01b039081166000908152603a9092526040909120600101541615620006275760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c72656164792072656769737465726564000000006044820152606401620005aa565b612710826080015111156200067f5760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e20726174650000000000000000006044820152606401620005aa565b5050565b6101808101516001600160a01b03166200078257603e546001600160a01b0316620006fc5760405162461bcd60e51b815260206004820152602260248201527f6c6971756964206c6f67696320636f6e7472616374206e6f74206465706c6f79604482015261195960f21b6064820152608401620005aa565b6025546000906200070d9062000b95565b905081602001518260000151836080015183603e60009054906101000a90046001600160a01b0316604051620007439062000dc8565b6200075395949392919062001423565b604051809103906000f08015801562000770573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051602580546001808201835560009283527f401968ff42a154441da5f6c4c935ac46b8671f0e062baaa62a7545ba53bb6e4c90910180546001600160a01b03199081166001600160a01b0395861617909155845184168352603a909552604091829020865181548716908516178155935190840180548616918416919091179055840151600283018054909416911617909155606082015182919060038201906200083490826200150d565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c820180546001600160a01b0319166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f820155610200820151601082015561022082015160118201556102408201516012820190620008fe90826200150d565b5061026082015160138201805460ff19166001836003811115620009265762000926620013bf565b0217905550505050565b60008083116200098f5760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201526269766560e81b6064820152608401620005aa565b6001600160a01b038216600090815260396020526040902054831115620009f95760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e636500000000006044820152606401620005aa565b6001600160a01b0382166000908152603960205260408120805485929062000a23908490620015d9565b9091555050604080516080810182526001600160a01b03808516825286811660208084019182528385018881524360608601908152600a805460009081526008909452968320865181549087166001600160a01b03199182161782559451600182018054919097169516949094179094555160028301559151600390910155825491929062000ab28362001407565b90915550506001600160a01b038581166000818152603a6020908152604091829020548251908516948816948514808252918101899052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d910160405180910390a3833b1562000b2c5762000b2c848762000cb5565b6001600a5462000b3d9190620015d9565b9695505050505050565b60008062000b5462000dd6565b60008060ff9050604083875160208901845afa62000b7157600080fd5b505080516020909101516c0100000000000000000000000090910494909350915050565b60608160000362000bbd5750506040805180820190915260018152600360fc1b602082015290565b8160005b811562000bed578062000bd48162001407565b915062000be59050600a8362001605565b915062000bc1565b6000816001600160401b0381111562000c0a5762000c0a62000df4565b6040519080825280601f01601f19166020018201604052801562000c35576020820181803683370190505b5090505b841562000cad5762000c4d600183620015d9565b915062000c5c600a866200161c565b62000c69906030620013eb565b60f81b81838151811062000c815762000c81620013a9565b60200101906001600160f81b031916908160001a90535062000ca5600a8662001605565b945062000c39565b949350505050565b6001600160a01b038216600090815260346020526040812054900362000d33576001600160a01b03821660008181526034602052604081206001908190556035805491820181559091527fcfa4bec1d3298408bb5afcfcd9c430549c5b31f8aa5c5848151c0a55f473c34d0180546001600160a01b03191690911790555b6001600160a01b03808316600090815260326020908152604080832093851683529290529081205490036200067f576001600160a01b03808316600081815260326020908152604080832094861680845294825280832060019081905593835260338252822080549384018155825290200180546001600160a01b03191690911790555050565b611cf6806200c05983390190565b610610806200dd4f83390190565b60405180604001604052806002906020820280368337509192915050565b634e487b7160e01b600052604160045260246000fd5b604051608081016001600160401b038111828210171562000e2f5762000e2f62000df4565b60405290565b60405160c081016001600160401b038111828210171562000e2f5762000e2f62000df4565b60405161028081016001600160401b038111828210171562000e2f5762000e2f62000df4565b604051601f8201601f191681016001600160401b038111828210171562000eab5762000eab62000df4565b604052919050565b6001600160a01b038116811462000ec957600080fd5b50565b805162000ed98162000eb3565b919050565b60005b8381101562000efb57818101518382015260200162000ee1565b50506000910152565b600082601f83011262000f1657600080fd5b81516001600160401b0381111562000f325762000f3262000df4565b62000f47601f8201601f191660200162000e80565b81815284602083860101111562000f5d57600080fd5b62000cad82602083016020870162000ede565b80516004811062000ed957600080fd5b600061010080838503121562000f9557600080fd5b604051908101906001600160401b038211818310171562000fba5762000fba62000df4565b816040528092508351915062000fd08262000eb3565b81815262000fe16020850162000ecc565b602082015262000ff46040850162000ecc565b6040820152620010076060850162000ecc565b60608201526200101a6080850162000ecc565b60808201526200102d60a0850162000ecc565b60a08201526200104060c0850162000ecc565b60c08201526200105360e0850162000ecc565b60e0820152505092915050565b6000608082840312156200107357600080fd5b6200107d62000e0a565b905081516200108c8162000eb3565b8082525060208201516020820152604082015160408201526060820151606082015292915050565b6000818303610260811215620010c957600080fd5b620010d362000e0a565b915060c0811215620010e457600080fd5b50620010ef62000e35565b825181526020830151602082015260408301516040820152606083015160608201526080830151608082015260a08301516200112b8162000eb3565b60a08201528152620011418360c0840162000f80565b602082015262001156836101c0840162001060565b6040820152610240820151606082015292915050565b6000806102808084860312156200118257600080fd5b83516001600160401b03808211156200119a57600080fd5b818601915086601f830112620011af57600080fd5b8151602082821115620011c657620011c662000df4565b8160051b620011d782820162000e80565b928352848101820192828101908b851115620011f257600080fd5b83870192505b8483101562001387578251868111156200121157600080fd5b8701808d03601f19018913156200122757600080fd5b6200123162000e5a565b6200123e86830162000ecc565b81526200124e6040830162000ecc565b86820152620012606060830162000ecc565b60408201526080820151888111156200127857600080fd5b620012888f888386010162000f04565b60608301525060a0820151608082015260c082015160a082015260e082015160c082015261010082015160e0820152610120820151610100820152610140820151610120820152610160820151610140820152610180820151610160820152620012f66101a0830162000ecc565b6101808201526101c08201516101a08201526101e08201516101c08201526102008201516101e0820152610220820151610200820152610240820151610220820152610260820151888111156200134c57600080fd5b6200135c8f888386010162000f04565b61024083015250620013708a830162000f70565b6102608201528352509183019190830190620011f8565b8099505050506200139b89828a01620010b4565b955050505050509250929050565b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052602160045260246000fd5b634e487b7160e01b600052601160045260246000fd5b80820180821115620014015762001401620013d5565b92915050565b6000600182016200141c576200141c620013d5565b5060010190565b600060018060a01b038088168352808716602084015285604084015260a0606084015284518060a0850152620014618160c086016020890162000ede565b9316608083015250601f91909101601f19160160c001949350505050565b600181811c908216806200149457607f821691505b602082108103620014b557634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156200054357600081815260208120601f850160051c81016020861015620014e45750805b601f850160051c820191505b818110156200150557828155600101620014f0565b505050505050565b81516001600160401b0381111562001529576200152962000df4565b62001541816200153a84546200147f565b84620014bb565b602080601f831160018114620015795760008415620015605750858301515b600019600386901b1c1916600185901b17855562001505565b600085815260208120601f198616915b82811015620015aa5788860151825594840194600190910190840162001589565b5085821015620015c95787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b81810381811115620014015762001401620013d5565b634e487b7160e01b600052601260045260246000fd5b600082620016175762001617620015ef565b500490565b6000826200162e576200162e620015ef565b500690565b61aa1680620016436000396000f3fe608060405260043610620005f35760003560e01c8063852c4849116200030f578063b3ecbadd11620001a3578063d372c07e11620000f3578063e7f43c68116200009b578063f03b959d1162000075578063f03b959d14620012c4578063f1d592a714620012e9578063f7866ee3146200130e57005b8063e7f43c681462001267578063e98712b21462001287578063ee7ff28d146200129f57005b8063d886f8a211620000cd578063d886f8a214620011e0578063dd62ed3e1462001205578063dfb1a4d2146200124f57005b8063d372c07e1462001181578063d5f3948814620011a6578063d861b0e814620011c857005b8063c9d97af41162000157578063cef984501162000131578063cef98450146200112c578063cf9c57191462001144578063cfd19fb9146200115c57005b8063c9d97af414620010ca578063cb696f5414620010e2578063ceaad455146200110757005b8063b66b3e791162000189578063b66b3e791462001056578063b6ef8c67146200107e578063b7ab4db514620010a357005b8063b3ecbadd146200100c578063b46e5520146200103157005b8063a515366a116200025f578063a9fd1a8f1162000213578063ae1f5fa011620001ed578063ae1f5fa01462000f9a578063affb1cf11462000fc2578063b2ea9adb1462000fe757005b8063a9fd1a8f1462000f31578063ab8f6ffe1462000f5b578063ae1da0b51462000f8257005b8063a8b2216e1162000245578063a8b2216e1462000e9f578063a9059cbb1462000ec6578063a9af10591462000eeb57005b8063a515366a1462000e71578063a5d059ca1462000e8857005b806395d89b4111620002c35780639ac31074116200029d5780639ac310741462000e1c5780639c98e4711462000e345780639dc29fac1462000e4c57005b806395d89b411462000d8d57806396b477cb1462000dd557806396f18a321462000dfa57005b806389c614b811620002f557806389c614b81462000d2b5780638bac7dad1462000d435780638d7835ea1462000d6857005b8063852c48491462000cee578063872cf0591462000d1357005b806340c10f1911620004875780636fd2c80b11620003d7578063784304b5116200038b578063819b64631162000365578063819b64631462000c7e578063833b1fce1462000c9557806384467fdb1462000cc957005b8063784304b51462000b3657806379502c551462000b5b5780637e660ac91462000c5957005b806371d1bc5911620003bd57806371d1bc591462000ad0578063731b3a031462000ae857806377e741c71462000b1157005b80636fd2c80b1462000a7f57806370a082311462000a9657005b8063573963b8116200043b5780636b5f444c11620004155780636b5f444c1462000a195780636bb9cb0e1462000a3e5780636be0487b1462000a5657005b8063573963b814620009c45780635bdedd1614620009dc5780636a929cef14620009f457005b8063496ccd9b116200046d578063496ccd9b146200094f5780634bb278f31462000974578063520fdbbc146200099f57005b806340c10f19146200091357806343645969146200093857005b806318160ddd116200054357806329070c6d11620004f7578063313ce56711620004d1578063313ce56714620008b857806335be16e014620008d6578063386a827b14620008fb57005b806329070c6d14620008555780632f2c3f2e146200086c57806330bcb81c146200088457005b80631a0cf2e511620005295780631a0cf2e514620007e257806323b872dd146200080757806325ce1bb9146200082c57005b806318160ddd14620007975780631904bb2e14620007ae57005b80630fe5010911620005a75780631250a28d11620005815780631250a28d146200075a5780631604e416146200077f578063161605e314620005fb57005b80630fe5010914620006f957806311220633146200071e578063114eaf55146200073557005b80630ae65e7a11620005d95780630ae65e7a146200068e5780630b21fb1d14620006b35780630d8e6e2c14620006d857005b806306fdde0314620005fd578063095ea7b3146200065857005b36620005fb57005b005b3480156200060a57600080fd5b5060408051808201909152600681527f4e6577746f6e000000000000000000000000000000000000000000000000000060208201525b6040516200064f919062009022565b60405180910390f35b3480156200066557600080fd5b506200067d6200067736600462009054565b6200132e565b60405190151581526020016200064f565b3480156200069b57600080fd5b50620005fb620006ad36600462009083565b62001347565b348015620006c057600080fd5b50620005fb620006d2366004620090a3565b6200146d565b348015620006e557600080fd5b506024545b6040519081526020016200064f565b3480156200070657600080fd5b50620005fb62000718366004620090a3565b620014ce565b3480156200072b57600080fd5b50601354620006ea565b3480156200074257600080fd5b50620005fb62000754366004620090a3565b6200152f565b3480156200076757600080fd5b50620005fb6200077936600462009083565b62001590565b3480156200078c57600080fd5b50620006ea602f5481565b348015620007a457600080fd5b50603b54620006ea565b348015620007bb57600080fd5b50620007d3620007cd36600462009083565b6200161d565b6040516200064f919062009133565b348015620007ef57600080fd5b50620005fb6200080136600462009083565b620018fa565b3480156200081457600080fd5b506200067d62000826366004620092ac565b62001985565b3480156200083957600080fd5b506026546000908152602c6020526040902060030154620006ea565b3480156200086257600080fd5b50601254620006ea565b3480156200087957600080fd5b50620006ea61271081565b3480156200089157600080fd5b50620008a9620008a3366004620090a3565b62001a9f565b6040516200064f9190620092f2565b348015620008c557600080fd5b50604051601281526020016200064f565b348015620008e357600080fd5b50620005fb620008f536600462009379565b62001b73565b3480156200090857600080fd5b50620006ea60055481565b3480156200092057600080fd5b50620005fb6200093236600462009054565b62001d69565b3480156200094557600080fd5b50602254620006ea565b3480156200095c57600080fd5b50620005fb6200096e36600462009083565b62001dd5565b3480156200098157600080fd5b506200098c62001f4d565b6040516200064f9594939291906200943a565b348015620009ac57600080fd5b50620005fb620009be36600462009083565b620028e4565b348015620009d157600080fd5b50620006ea60285481565b348015620009e957600080fd5b50620006ea60075481565b34801562000a0157600080fd5b50620005fb62000a13366004620090a3565b62002b98565b34801562000a2657600080fd5b50620005fb62000a38366004620090a3565b62002bf9565b34801562000a4b57600080fd5b50620006ea60375481565b34801562000a6357600080fd5b5062000a6e62002cae565b6040516200064f9392919062009473565b34801562000a8c57600080fd5b50601554620006ea565b34801562000aa357600080fd5b50620006ea62000ab536600462009083565b6001600160a01b031660009081526039602052604090205490565b34801562000add57600080fd5b50620006ea60065481565b34801562000af557600080fd5b506026546000908152602c6020526040902060020154620006ea565b34801562000b1e57600080fd5b50620005fb62000b30366004620090a3565b62002ddf565b34801562000b4357600080fd5b50620005fb62000b55366004620095de565b62002e40565b34801562000b6857600080fd5b506040805160c080820183526012548252601354602080840191909152601454838501526015546060808501919091526016546080808601919091526017546001600160a01b0390811660a08088019190915287516101008101895260185483168152601954831681870152601a548316818a0152601b54831681860152601c54831681850152601d54831691810191909152601e54821695810195909552601f54811660e086015286519182018752835416815260215492810192909252602254948201949094526023549381019390935260245462000c47939084565b6040516200064f949392919062009634565b34801562000c6657600080fd5b50620005fb62000c78366004620090a3565b620030b2565b34801562000c8b57600080fd5b50602354620006ea565b34801562000ca257600080fd5b506019546001600160a01b03165b6040516001600160a01b0390911681526020016200064f565b34801562000cd657600080fd5b50620005fb62000ce836600462009736565b62003113565b34801562000cfb57600080fd5b50620005fb62000d0d36600462009054565b62003242565b34801562000d2057600080fd5b50620005fb62003464565b34801562000d3857600080fd5b50620006ea602a5481565b34801562000d5057600080fd5b50620005fb62000d62366004620090a3565b620034cf565b34801562000d7557600080fd5b50620005fb62000d87366004620097ee565b62003582565b34801562000d9a57600080fd5b5060408051808201909152600381527f4e544e0000000000000000000000000000000000000000000000000000000000602082015262000640565b34801562000de257600080fd5b50620006ea62000df4366004620090a3565b6200378e565b34801562000e0757600080fd5b50603e5462000cb0906001600160a01b031681565b34801562000e2957600080fd5b50620006ea603c5481565b34801562000e4157600080fd5b50620006ea602b5481565b34801562000e5957600080fd5b50620005fb62000e6b36600462009054565b6200383d565b620006ea62000e8236600462009054565b62003990565b620006ea62000e9936600462009054565b62003adc565b34801562000eac57600080fd5b5062000eb762003bec565b6040516200064f91906200987e565b34801562000ed357600080fd5b506200067d62000ee536600462009054565b62003ccf565b34801562000ef857600080fd5b5062000f2262000f0a366004620090a3565b6000908152600b602052604090206006015460ff1690565b6040516200064f919062009902565b34801562000f3e57600080fd5b5062000f4962003d27565b6040516200064f949392919062009917565b34801562000f6857600080fd5b5062000f7362003ea4565b6040516200064f919062009948565b34801562000f8f57600080fd5b50620006ea60295481565b34801562000fa757600080fd5b5062000fb262003fb6565b6040516200064f929190620099a3565b34801562000fcf57600080fd5b5062000f4962000fe1366004620090a3565b620042e2565b34801562000ff457600080fd5b50620005fb62001006366004620099d5565b62004503565b3480156200101957600080fd5b50620005fb6200102b36600462009083565b62004579565b3480156200103e57600080fd5b50620005fb6200105036600462009083565b62004604565b3480156200106357600080fd5b506200106e620048d9565b6040516200064f92919062009a36565b3480156200108b57600080fd5b50620006ea6200109d366004620090a3565b62004a10565b348015620010b057600080fd5b50620010bb62004aa2565b6040516200064f919062009a5f565b348015620010d757600080fd5b50620006ea60265481565b348015620010ef57600080fd5b50620005fb62001101366004620090a3565b62004b06565b3480156200111457600080fd5b50620005fb6200112636600462009083565b62004b98565b3480156200113957600080fd5b50620006ea60045481565b3480156200115157600080fd5b50620005fb62004c23565b3480156200116957600080fd5b50620005fb6200117b36600462009083565b62004ca7565b3480156200118e57600080fd5b50620005fb620011a036600462009083565b62004d32565b348015620011b357600080fd5b50603d5462000cb0906001600160a01b031681565b348015620011d557600080fd5b50620005fb62004dbd565b348015620011ed57600080fd5b50620005fb620011ff36600462009083565b62005092565b3480156200121257600080fd5b50620006ea6200122436600462009a74565b6001600160a01b03918216600090815260316020908152604080832093909416825291909152205490565b3480156200125c57600080fd5b50620006ea6200511d565b3480156200127457600080fd5b506020546001600160a01b031662000cb0565b3480156200129457600080fd5b50620006ea602e5481565b348015620012ac57600080fd5b50620005fb620012be366004620090a3565b6200513a565b348015620012d157600080fd5b50620005fb620012e336600462009083565b6200519b565b348015620012f657600080fd5b50620005fb6200130836600462009083565b62005226565b3480156200131b57600080fd5b506017546001600160a01b031662000cb0565b60006200133d3384846200532f565b5060015b92915050565b620013516200548c565b6001600160a01b038082166000818152603a602052604090206001015490911614620013c45760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d75737420626520726567697374657265640000000060448201526064015b60405180910390fd5b6001600160a01b038181166000908152603a6020526040902054163314620014555760405162461bcd60e51b815260206004820152602c60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72206160448201527f646d696e206163636f756e7400000000000000000000000000000000000000006064820152608401620013bb565b6200146081620054e5565b6200146a60008055565b50565b6020546001600160a01b03163314620014c95760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b600555565b6020546001600160a01b031633146200152a5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b600455565b6020546001600160a01b031633146200158b5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b601555565b6020546001600160a01b03163314620015ec5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b6018805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b565b6200162762008d42565b6001600160a01b038083166000818152603a602052604090206001015490911614620016965760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f74207265676973746572656400000000000000006044820152606401620013bb565b6001600160a01b038083166000908152603a60209081526040918290208251610280810184528154851681526001820154851692810192909252600281015490931691810191909152600382018054919291606084019190620016f99062009ab2565b80601f0160208091040260200160405190810160405280929190818152602001828054620017279062009ab2565b8015620017785780601f106200174c5761010080835404028352916020019162001778565b820191906000526020600020905b8154815290600101906020018083116200175a57829003601f168201915b505050918352505060048201546020820152600582015460408201526006820154606082015260078201546080820152600882015460a0820152600982015460c0820152600a82015460e0820152600b820154610100820152600c8201546001600160a01b0316610120820152600d820154610140820152600e820154610160820152600f82015461018082015260108201546101a082015260118201546101c08201526012820180546101e090920191620018349062009ab2565b80601f0160208091040260200160405190810160405280929190818152602001828054620018629062009ab2565b8015620018b35780601f106200188757610100808354040283529160200191620018b3565b820191906000526020600020905b8154815290600101906020018083116200189557829003601f168201915b5050509183525050601382015460209091019060ff166003811115620018dd57620018dd620090bd565b6003811115620018f157620018f1620090bd565b90525092915050565b6020546001600160a01b03163314620019565760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b601f805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6001600160a01b03831660009081526031602090815260408083203384529091528120548281101562001a215760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e742065786365656473206160448201527f6c6c6f77616e63650000000000000000000000000000000000000000000000006064820152608401620013bb565b62001a2e858585620055d5565b62001a46853362001a40868562009b36565b6200532f565b836001600160a01b0316856001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8560405162001a8c91815260200190565b60405180910390a3506001949350505050565b62001aa962008e12565b6000828152600b602090815260409182902082516101208101845281546001600160a01b03908116825260018301541692810192909252600281015492820192909252600380830154606083015260048301546080830152600583015460a0830152600683015491929160c084019160ff9091169081111562001b305762001b30620090bd565b600381111562001b445762001b44620090bd565b81526006919091015460ff61010082048116151560208401526201000090910416151560409091015292915050565b6018546001600160a01b0316331462001bf55760405162461bcd60e51b815260206004820152602360248201527f63616c6c6572206973206e6f742074686520736c617368696e6720636f6e747260448201527f61637400000000000000000000000000000000000000000000000000000000006064820152608401620013bb565b6000610120820135603a8262001c12604086016020870162009083565b6001600160a01b03166001600160a01b031681526020019081526020016000206009015462001c42919062009b36565b60c0830135603a600062001c5d604087016020880162009083565b6001600160a01b03166001600160a01b031681526020019081526020016000206006015462001c8d919062009b36565b60a0840135603a600062001ca8604088016020890162009083565b6001600160a01b03166001600160a01b031681526020019081526020016000206005015462001cd8919062009b36565b62001ce4919062009b4c565b62001cf0919062009b4c565b6017546001600160a01b031660009081526039602052604081208054929350839290919062001d2190849062009b4c565b90915550829050603a600062001d3e604084016020850162009083565b6001600160a01b03168152602081019190915260400160002062001d63828262009d27565b50505050565b6020546001600160a01b0316331462001dc55760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b62001dd18282620056a2565b5050565b6020546001600160a01b0316331462001e315760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b6019805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b03838116918217909255601a546040517f7adbf973000000000000000000000000000000000000000000000000000000008152600481019290925290911690637adbf97390602401600060405180830381600087803b15801562001eb657600080fd5b505af115801562001ecb573d6000803e3d6000fd5b5050601c546040517f7adbf9730000000000000000000000000000000000000000000000000000000081526001600160a01b0385811660048301529091169250637adbf97391506024015b600060405180830381600087803b15801562001f3157600080fd5b505af115801562001f46573d6000803e3d6000fd5b5050505050565b603d546000908190606090829081906001600160a01b0316331462001fdb5760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c00000000000000000000000000000000000000000000000000000000006064820152608401620013bb565b62001fe56200548c565b4360298190556026546000828152602760209081526040808320849055928252602c90528190206003015460185491517f6c9789b000000000000000000000000000000000000000000000000000000000815292101560048301819052916001600160a01b0390911690636c9789b090602401600060405180830381600087803b1580156200207357600080fd5b505af115801562002088573d6000803e3d6000fd5b5050505080156200266a57601e54603b54603c54602a546040517f92eff3cd0000000000000000000000000000000000000000000000000000000081526004810193909352602483019190915260448201524260648201526000916001600160a01b0316906392eff3cd90608401602060405180830381865afa15801562002114573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906200213a919062009f0c565b905080603c5410156200214c5750603c545b620021583082620056a2565b80603c60008282546200216c919062009b36565b9091555050601f54604080517ff968f49300000000000000000000000000000000000000000000000000000000815281516001600160a01b039093169263f968f4939260048084019391929182900301816000875af1925050508015620021f2575060408051601f3d908101601f19168201909252620021ef9181019062009f26565b60015b62002230576040514281527ff1217bb194383aa5c937c81cbbb445990a478c7511b080df329f9b0fb64233c99060200160405180910390a162002263565b60175462002248906001600160a01b031682620056a2565b601f5462002260906001600160a01b031683620056a2565b50505b306000908152603960205260409020546200228090479062005728565b6200228a62005ca0565b6200229462005f63565b6200229e62006052565b620022a8620060f1565b600080620022b562003fb6565b6019546040517f845023f20000000000000000000000000000000000000000000000000000000081529294509092506001600160a01b03169063845023f2906200230490859060040162009a5f565b600060405180830381600087803b1580156200231f57600080fd5b505af115801562002334573d6000803e3d6000fd5b50506018546040517fe08b14ed0000000000000000000000000000000000000000000000000000000081526001600160a01b03909116925063e08b14ed91506200238390849060040162009a5f565b600060405180830381600087803b1580156200239e57600080fd5b505af1158015620023b3573d6000803e3d6000fd5b50506026546000908152602c60205260409020600201546028546021549193501480159150620023e4575060285415155b156200246b5760285460218190556018546040517f6b5f444c00000000000000000000000000000000000000000000000000000000815260048101929092526001600160a01b031690636b5f444c90602401600060405180830381600087803b1580156200245157600080fd5b505af115801562002466573d6000803e3d6000fd5b505050505b604d5415620024b657604654603f55604754604055604854604155604954604255604a54604355604b54604455604c546045805460ff191660ff90921615159190911790556000604d555b602154600090620024c8904362009b4c565b905042602a81905550600160266000828254620024e6919062009b4c565b909155505060265460408051602d805460a0602082028401810190945260808301818152620026289594849391929060009085015b8282101562002609576000848152602090819020604080516060810182526003860290920180546001600160a01b0316835260018101549383019390935260028301805492939291840191620025719062009ab2565b80601f01602080910402602001604051908101604052809291908181526020018280546200259f9062009ab2565b8015620025f05780601f10620025c457610100808354040283529160200191620025f0565b820191906000526020600020905b815481529060010190602001808311620025d257829003601f168201915b505050505081525050815260200190600101906200251b565b5050505081526020018581526020014381526020018481525062006232565b7febad8099c467528a56c98b63c8d476d251cf1ffb4c75db94b4d23fa2b6a1e3356026546040516200265c91815260200190565b60405180910390a150505050505b601954604080517f4bb278f300000000000000000000000000000000000000000000000000000000815290516000926001600160a01b031691634bb278f3916004808301926020929190829003018187875af1158015620026cf573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190620026f5919062009f4b565b905080156200278a57601a54604080517fa2e6204500000000000000000000000000000000000000000000000000000000815290516001600160a01b039092169163a2e620459160048082019260209290919082900301816000875af192505050801562002782575060408051601f3d908101601f191682019092526200277f9181019062009f4b565b60015b156200278a57505b600380546026546000908152602c602090815260408083206001810154950154602d805483518186028101860190945280845260ff909616968996919590949293928692919084015b82821015620028c1576000848152602090819020604080516060810182526003860290920180546001600160a01b0316835260018101549383019390935260028301805492939291840191620028299062009ab2565b80601f0160208091040260200160405190810160405280929190818152602001828054620028579062009ab2565b8015620028a85780601f106200287c57610100808354040283529160200191620028a8565b820191906000526020600020905b8154815290600101906020018083116200288a57829003601f168201915b50505050508152505081526020019060010190620027d3565b505050509250965096509650965096505050620028dd60008055565b9091929394565b6020546001600160a01b03163314620029405760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b6020805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b038381169182179092556019546040517fb3ab15fb00000000000000000000000000000000000000000000000000000000815260048101929092529091169063b3ab15fb90602401600060405180830381600087803b158015620029c557600080fd5b505af1158015620029da573d6000803e3d6000fd5b5050601a546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b15801562002a3f57600080fd5b505af115801562002a54573d6000803e3d6000fd5b5050601b546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b15801562002ab957600080fd5b505af115801562002ace573d6000803e3d6000fd5b5050601c546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b15801562002b3357600080fd5b505af115801562002b48573d6000803e3d6000fd5b5050601d546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb915060240162001f16565b6020546001600160a01b0316331462002bf45760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b603755565b6020546001600160a01b0316331462002c555760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b60288190556026546000908152602c6020908152604091829020600301548251848152918201819052917f2eea6438d890c8603d4df81ad1bad2a4ea45c02b4837165f461ff3c81603abc7910160405180910390a15050565b62002cf16040518060e001604052806000815260200160008152602001600081526020016000815260200160008152602001600081526020016000151581525090565b62002d346040518060e001604052806000815260200160008152602001600081526020016000815260200160008152602001600081526020016000151581525090565b5050604d546040805160e08082018352603f54825282546020808401919091526041548385015260425460608085019190915260435460808086019190915260445460a08087019190915260455460ff908116151560c080890191909152885196870189526046548752604754958701959095526048549786019790975260495492850192909252604a5490840152604b5490830152604c5490931615159281019290925292909190565b6020546001600160a01b0316331462002e3b5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b601255565b6001600160a01b038083166000818152603a6020526040902060018101549092161462002eb05760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f74207265676973746572656400000000000000006044820152606401620013bb565b80546001600160a01b0316331462002f315760405162461bcd60e51b815260206004820152602f60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72207460448201527f72656173757279206163636f756e7400000000000000000000000000000000006064820152608401620013bb565b62002f3c836200630f565b1562002fb15760405162461bcd60e51b815260206004820152602260248201527f76616c696461746f72206d757374206e6f7420626520696e20636f6d6d69747460448201527f65650000000000000000000000000000000000000000000000000000000000006064820152608401620013bb565b60008062002fbf8462006380565b925090508115620030135760405162461bcd60e51b815260206004820152600b60248201527f656e6f6465206572726f720000000000000000000000000000000000000000006044820152606401620013bb565b60018301546001600160a01b038281169116146200309a5760405162461bcd60e51b815260206004820152602760248201527f76616c696461746f72206e6f646520616464726573732063616e27742062652060448201527f75706461746564000000000000000000000000000000000000000000000000006064820152608401620013bb565b60038301620030aa858262009f6b565b505050505050565b6020546001600160a01b031633146200310e5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b600755565b6000604051806102800160405280336001600160a01b0316815260200160006001600160a01b03168152602001856001600160a01b0316815260200186815260200160126000016002015481526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b031681526020016000815260200143815260200160008152602001600081526020016000815260200184815260200160006003811115620031e257620031e2620090bd565b90529050620031f28183620063ce565b60208101516101808201516040517f8ad8bd2eb6950e5f332fd3a6dca48cb358ecfe3057848902b98cbdfe455c915c926200323392339289918b916200a038565b60405180910390a15050505050565b6001600160a01b038083166000818152603a602052604090206001015490911614620032b15760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d7573742062652072656769737465726564000000006044820152606401620013bb565b6001600160a01b038281166000908152603a6020526040902054163314620033425760405162461bcd60e51b815260206004820152602c60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72206160448201527f646d696e206163636f756e7400000000000000000000000000000000000000006064820152608401620013bb565b612710811115620033965760405162461bcd60e51b815260206004820152601f60248201527f7265717569726520636f727265637420636f6d6d697373696f6e2072617465006044820152606401620013bb565b604080516060810182526001600160a01b038481168252436020808401918252838501868152601180546000908152600f9093529582208551815473ffffffffffffffffffffffffffffffffffffffff19169516949094178455915160018085019190915591516002909301929092558354929390929091906200341c90849062009b4c565b90915550506040518281526001600160a01b038416907f4fba51c92fa3d6ad8374d394f6cd5766857552e153d7384a8f23aa4ce9a8a7cf9060200160405180910390a2505050565b6020546001600160a01b03163314620034c05760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b6003805460ff19166001179055565b6020546001600160a01b031633146200352b5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b600081116200357d5760405162461bcd60e51b815260206004820152601960248201527f636f6d6d69747465652073697a652063616e27742062652030000000000000006044820152606401620013bb565b602355565b6020546001600160a01b03163314620035de5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b8051620035eb9062006871565b801562003602575062003602816040015162006871565b801562003619575062003619816080015162006871565b620036675760405162461bcd60e51b815260206004820152601d60248201527f696e697469616c2074696d656f7574206f7574206f6620626f756e64730000006044820152606401620013bb565b62003676816020015162006889565b80156200368d57506200368d816060015162006889565b8015620036a45750620036a48160a0015162006889565b620036f25760405162461bcd60e51b815260206004820152601b60248201527f74696d656f75742064656c7461206f7574206f6620626f756e647300000000006044820152606401620013bb565b805160465560208082015160475560408083015160485560608301516049556080830151604a5560a0830151604b5560c0830151604c805460ff19169115159190911790556026546000908152602c9092529081902060030154604d81905590517fa4cc9b6d7e44f45ad3dfc950f52c5b7c915f5aaab5b2316be12db869407309f29162003783918491906200a082565b60405180910390a150565b60006029546001620037a1919062009b4c565b821115620038185760405162461bcd60e51b815260206004820152602660248201527f63616e6e6f74206765742065706f636820696420666f7220612066757475726560448201527f20626c6f636b00000000000000000000000000000000000000000000000000006064820152608401620013bb565b602954821162003835575060009081526027602052604090205490565b505060265490565b6020546001600160a01b03163314620038995760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b6001600160a01b038216600090815260396020526040902054811115620039035760405162461bcd60e51b815260206004820152601660248201527f416d6f756e7420657863656564732062616c616e6365000000000000000000006044820152606401620013bb565b6001600160a01b038216600090815260396020526040812080548392906200392d90849062009b36565b9250508190555080603b600082825462003948919062009b36565b90915550506040518181526001600160a01b038316907f5024dbeedf0c06664c9bd7be836915730c955e936972c020683dadf11d5488a3906020015b60405180910390a25050565b60006200399c6200548c565b6001600160a01b038084166000818152603a60205260409020600101549091161462003a0b5760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f74207265676973746572656400000000000000006044820152606401620013bb565b6001600160a01b0383166000908152603a602052604081206013015460ff16600381111562003a3e5762003a3e620090bd565b1462003a8d5760405162461bcd60e51b815260206004820152601b60248201527f76616c696461746f72206e65656420746f2062652061637469766500000000006044820152606401620013bb565b60375462003a9c90346200a10f565b336000908152603860205260408120805490919062003abd90849062009b4c565b9091555062003ad09050838333620068a1565b90506200134160008055565b600062003ae86200548c565b6001600160a01b038084166000818152603a60205260409020600101549091161462003b575760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f74207265676973746572656400000000000000006044820152606401620013bb565b6000821162003ba95760405162461bcd60e51b815260206004820152601560248201527f756e626f6e64696e6720616d6f756e74206973203000000000000000000000006044820152606401620013bb565b60375462003bb890346200a10f565b336000908152603860205260408120805490919062003bd990849062009b4c565b9091555062003ad0905083833362006adf565b60606030805480602002602001604051908101604052809291908181526020016000905b8282101562003cc657838290600052602060002001805462003c329062009ab2565b80601f016020809104026020016040519081016040528092919081815260200182805462003c609062009ab2565b801562003cb15780601f1062003c855761010080835404028352916020019162003cb1565b820191906000526020600020905b81548152906001019060200180831162003c9357829003601f168201915b50505050508152602001906001019062003c10565b50505050905090565b600062003cde338484620055d5565b6040518281526001600160a01b0384169033907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef9060200160405180910390a350600192915050565b6026546000908152602c602090815260408083208151815460a094810282018501909352608081018381526060959485948594859493909284928491879085015b8282101562003e56576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162003dbe9062009ab2565b80601f016020809104026020016040519081016040528092919081815260200182805462003dec9062009ab2565b801562003e3d5780601f1062003e115761010080835404028352916020019162003e3d565b820191906000526020600020905b81548152906001019060200180831162003e1f57829003601f168201915b5050505050815250508152602001906001019062003d68565b50505090825250600182015460208083019190915260028301546040808401919091526003909301546060928301528351908401519284015193909101519098919750919550909350915050565b6060602d805480602002602001604051908101604052809291908181526020016000905b8282101562003cc6576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162003f1e9062009ab2565b80601f016020809104026020016040519081016040528092919081815260200182805462003f4c9062009ab2565b801562003f9d5780601f1062003f715761010080835404028352916020019162003f9d565b820191906000526020600020905b81548152906001019060200180831162003f7f57829003601f168201915b5050505050815250508152602001906001019062003ec8565b603d5460609081906001600160a01b031633146200403d5760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c00000000000000000000000000000000000000000000000000000000006064820152608401620013bb565b6025546200408e5760405162461bcd60e51b815260206004820152601860248201527f5468657265206d7573742062652076616c696461746f727300000000000000006044820152606401620013bb565b6200409862008e84565b602354608082015260258152603a6020820152602d6040820152602b6060820152620040c48162006f63565b620040d26030600062008ea2565b602d5480620041245760405162461bcd60e51b815260206004820152601260248201527f636f6d6d697474656520697320656d70747900000000000000000000000000006044820152606401620013bb565b60008167ffffffffffffffff8111156200414257620041426200951a565b6040519080825280602002602001820160405280156200416c578160200160208202803683370190505b50905060008267ffffffffffffffff8111156200418d576200418d6200951a565b604051908082528060200260200182016040528015620041b7578160200160208202803683370190505b50905060005b83811015620042d6576000603a6000602d8481548110620041e257620041e26200a126565b60009182526020808320600392830201546001600160a01b031684528301939093526040909101812060308054600181018255925292507f6ff97a59c90d62cc7236ba3a37cd85351bf564556780cf8c1157a220f31f0cbb01906200424a908301826200a155565b50600281015484516001600160a01b03909116908590849081106200427357620042736200a126565b6001600160a01b03928316602091820292909201015260018201548451911690849084908110620042a857620042a86200a126565b6001600160a01b03909216602092830291909101909101525080620042cd816200a237565b915050620041bd565b50909450925050509091565b606060008060006029546001620042fa919062009b4c565b851115620043715760405162461bcd60e51b815260206004820152602360248201527f63616e6e6f74206765742065706f636820666f7220612066757475726520626c60448201527f6f636b00000000000000000000000000000000000000000000000000000000006064820152608401620013bb565b60265460295486116200438f57506000858152602760205260409020545b6000818152602c602090815260408083208151815460a09481028201850190935260808101838152909391928492849190879085015b82821015620044b3576000848152602090819020604080516060810182526003860290920180546001600160a01b03168352600181015493830193909352600283018054929392918401916200441b9062009ab2565b80601f0160208091040260200160405190810160405280929190818152602001828054620044499062009ab2565b80156200449a5780601f106200446e576101008083540402835291602001916200449a565b820191906000526020600020905b8154815290600101906020018083116200447c57829003601f168201915b50505050508152505081526020019060010190620043c5565b5050509082525060018201546020808301919091526002830154604080840191909152600390930154606092830152835190840151928401519390910151909a9199509197509095509350505050565b6020546001600160a01b031633146200455f5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b6200456c60018362006f83565b62001dd160028262006f83565b6020546001600160a01b03163314620045d55760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b601b805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6200460e6200548c565b6001600160a01b038082166000818152603a6020526040902060010154909116146200467d5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d7573742062652072656769737465726564000000006044820152606401620013bb565b6001600160a01b038082166000908152603a6020526040902080549091163314620047115760405162461bcd60e51b815260206004820152602f60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72207460448201527f72656173757279206163636f756e7400000000000000000000000000000000006064820152608401620013bb565b6000601382015460ff1660038111156200472f576200472f620090bd565b036200477e5760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f7220616c72656164792061637469766500000000000000006044820152606401620013bb565b6002601382015460ff1660038111156200479c576200479c620090bd565b148015620047ad5750438160100154115b15620047fc5760405162461bcd60e51b815260206004820152601760248201527f76616c696461746f72207374696c6c20696e206a61696c0000000000000000006044820152606401620013bb565b6003601382015460ff1660038111156200481a576200481a620090bd565b03620048695760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206a61696c6564207065726d616e656e746c79000000006044820152606401620013bb565b60138101805460ff1916905580546026546000908152602c60209081526040918290206003015491519182526001600160a01b038581169316917f60fcbf2d07dc712a93e59fb28f1edb626d7c2497c57ba71a8c0b3999ecb9a3b5910160405180910390a3506200146a60008055565b60608060016002818054620048ee9062009ab2565b80601f01602080910402602001604051908101604052809291908181526020018280546200491c9062009ab2565b80156200496d5780601f1062004941576101008083540402835291602001916200496d565b820191906000526020600020905b8154815290600101906020018083116200494f57829003601f168201915b50505050509150808054620049829062009ab2565b80601f0160208091040260200160405190810160405280929190818152602001828054620049b09062009ab2565b801562004a015780601f10620049d55761010080835404028352916020019162004a01565b820191906000526020600020905b815481529060010190602001808311620049e357829003601f168201915b50505050509050915091509091565b600060036000838152600b602052604090206006015460ff16600381111562004a3d5762004a3d620090bd565b1462004a8c5760405162461bcd60e51b815260206004820152601e60248201527f756e626f6e64696e672072656c65617365206e6f7420726576657274656400006044820152606401620013bb565b506000908152600b602052604090206005015490565b6060602580548060200260200160405190810160405280929190818152602001828054801562004afc57602002820191906000526020600020905b81546001600160a01b0316815260019091019060200180831162004add575b5050505050905090565b6020546001600160a01b0316331462004b625760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b60138190556040518181527f1f4d2fc7529047a5bd96d3229bfea127fd18b7748f13586e097c69fccd3891289060200162003783565b6020546001600160a01b0316331462004bf45760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b601d805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6020546001600160a01b0316331462004c7f5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b62004c8d6001600062008ec2565b62004c9b6002600062008ec2565b6003805460ff19169055565b6020546001600160a01b0316331462004d035760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b601c805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6020546001600160a01b0316331462004d8e5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b601a805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b603d546001600160a01b0316331462004e3f5760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c00000000000000000000000000000000000000000000000000000000006064820152608401620013bb565b62004e496200548c565b62004e5362005f63565b60005b604e5481101562004f0f57604e818154811062004e775762004e776200a126565b90600052602060002090602091828204019190069054906101000a900460ff16603a60006025848154811062004eb15762004eb16200a126565b60009182526020808320909101546001600160a01b031683528201929092526040019020601301805460ff1916600183600381111562004ef55762004ef5620090bd565b02179055508062004f06816200a237565b91505062004e56565b5062004f1e604e600062008f01565b62004f2862003fb6565b505042602a5543602981905560009081526027602090815260408083208390556026548151602d805460a095810283018601909452608082018481526200508896939592948594919390919085015b8282101562005065576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162004fcd9062009ab2565b80601f016020809104026020016040519081016040528092919081815260200182805462004ffb9062009ab2565b80156200504c5780601f1062005020576101008083540402835291602001916200504c565b820191906000526020600020905b8154815290600101906020018083116200502e57829003601f168201915b5050505050815250508152602001906001019062004f77565b505050908252506000602082015243604082015260215460609091015262006232565b6200161b60008055565b6020546001600160a01b03163314620050ee5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b6017805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6028546021546000911462005133575060285490565b5060215490565b6020546001600160a01b03163314620051965760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b600655565b6020546001600160a01b03163314620051f75760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b601e805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6020546001600160a01b03163314620052825760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b6001600160a01b038116620053005760405162461bcd60e51b815260206004820152602960248201527f696e76616c696420636f6e7472616374206164647265737320666f72206c697160448201527f756964206c6f67696300000000000000000000000000000000000000000000006064820152608401620013bb565b603e805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6001600160a01b038316620053ac5760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f2061646460448201527f72657373000000000000000000000000000000000000000000000000000000006064820152608401620013bb565b6001600160a01b0382166200542a5760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f20616464726560448201527f73730000000000000000000000000000000000000000000000000000000000006064820152608401620013bb565b6001600160a01b0383811660008181526031602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92591015b60405180910390a3505050565b60005415620054de5760405162461bcd60e51b815260206004820152601360248201527f7265656e7472616e6379206465746563746564000000000000000000000000006044820152606401620013bb565b6001600055565b6001600160a01b0381166000908152603a6020526040812090601382015460ff1660038111156200551a576200551a620090bd565b14620055695760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206d7573742062652061637469766500000000000000006044820152606401620013bb565b60138101805460ff1916600117905580546026546000908152602c60209081526040918290206003015491519182526001600160a01b038581169316917f75bdcdbe540758778e669d108fbcb7ede734f27f46e4e5525eeb8ecf91849a9c910160405180910390a35050565b6001600160a01b0383166000908152603960205260409020548111156200563f5760405162461bcd60e51b815260206004820152601660248201527f616d6f756e7420657863656564732062616c616e6365000000000000000000006044820152606401620013bb565b6001600160a01b038316600090815260396020526040812080548392906200566990849062009b36565b90915550506001600160a01b038216600090815260396020526040812080548392906200569890849062009b4c565b9091555050505050565b6001600160a01b03821660009081526039602052604081208054839290620056cc90849062009b4c565b9250508190555080603b6000828254620056e7919062009b4c565b90915550506040518181526001600160a01b038316907f48490b4407bb949b708ec5f514b4167f08f4969baaf78d53b05028adf369bfcf9060200162003984565b8115801562005735575080155b156200573f575050565b601254600090670de0b6b3a7640000906200575c9085906200a254565b6200576891906200a10f565b90508015620057e6576017546040516000916001600160a01b03169083908381818185875af1925050503d8060008114620057c0576040519150601f19603f3d011682016040523d82523d6000602084013e620057c5565b606091505b5090915050801515600103620057e457620057e1828562009b36565b93505b505b82602e6000828254620057fa919062009b4c565b90915550600090505b602d5481101562001d63576000603a6000602d84815481106200582a576200582a6200a126565b600091825260208083206003909202909101546001600160a01b031683528201929092526040018120602b54602d80549294509091889190869081106200587557620058756200a126565b9060005260206000209060030201600101546200589391906200a254565b6200589f91906200a10f565b90506000602b5486602d8681548110620058bd57620058bd6200a126565b906000526020600020906003020160010154620058db91906200a254565b620058e791906200a10f565b90506000821180620058f95750600081115b1562005c87576002601384015460ff1660038111156200591d576200591d620090bd565b14806200594557506003601384015460ff166003811115620059435762005943620090bd565b145b1562005a2757601854620059659030906001600160a01b031683620055d5565b601854602d80546001600160a01b039092169163a8031a1d918591889081106200599357620059936200a126565b600091825260209091206003909102015460405160e084901b7fffffffff000000000000000000000000000000000000000000000000000000001681526001600160a01b039091166004820152602481018590526044016000604051808303818588803b15801562005a0457600080fd5b505af115801562005a19573d6000803e3d6000fd5b505050505050505062005c8b565b6000836005015483856008015462005a4091906200a254565b62005a4c91906200a10f565b9050801562005b0e57835460405160009182916001600160a01b03909116906108fc90859084818181858888f193505050503d806000811462005aac576040519150601f19603f3d011682016040523d82523d6000602084013e62005ab1565b606091505b50909250905081151560000362005b0b5785546040517f1137d8c966ce69b9630fb2294be011f3d64cc56e91fad7d375f0662568e9d3529162005b02916001600160a01b039091169084906200a26e565b60405180910390a15b50505b6000846005015483866008015462005b2791906200a254565b62005b3391906200a10f565b9050801562005b5557845462005b559030906001600160a01b031683620055d5565b600062005b63828562009b36565b9050600062005b73848762009b36565b9050600081118062005b855750600082115b1562005c3857600c87015462005ba79030906001600160a01b031684620055d5565b600c8701546040517fa0ce552d000000000000000000000000000000000000000000000000000000008152600481018490526001600160a01b039091169063a0ce552d908390602401604080518083038185885af115801562005c0e573d6000803e3d6000fd5b50505050506040513d601f19601f8201168201806040525081019062005c35919062009f26565b50505b600187015460408051888152602081018890526001600160a01b03909216917f291e8ba3c0f4b0bd86e6e2346fcee1e7ca0975b1cc1886bfbc722d34f3f24d91910160405180910390a2505050505b5050505b8062005c97816200a237565b91505062005803565b60355460005b8181101562001dd15760006035828154811062005cc75762005cc76200a126565b60009182526020808320909101546001600160a01b03168083526033825260408084208054825181860281018601909352808352929550909290919083018282801562005d3e57602002820191906000526020600020905b81546001600160a01b0316815260019091019060200180831162005d1f575b5050505050905060005b815181101562005dc6576001600160a01b0383166000908152603260205260408120835190919084908490811062005d845762005d846200a126565b60200260200101516001600160a01b03166001600160a01b0316815260200190815260200160002060009055808062005dbd906200a237565b91505062005d48565b506001600160a01b038216600090815260336020526040812062005dea9162008f28565b6001600160a01b038216600090815260386020526040902054815160075462005e1491906200a254565b81111562005e3057815160075462005e2d91906200a254565b90505b60005a9050836001600160a01b031663d18736ab83856040518363ffffffff1660e01b815260040162005e64919062009a5f565b600060405180830381600088803b15801562005e7f57600080fd5b5087f19350505050801562005e92575060015b62005ec8575a62005ea4908262009b36565b6001600160a01b038516600090815260366020526040902060019055905062005ed8565b5a62005ed5908262009b36565b90505b6001600160a01b03841660009081526038602052604090205481101562005f2f576001600160a01b0384166000908152603860205260408120805483929062005f2390849062009b36565b9091555062005f499050565b6001600160a01b0384166000908152603860205260408120555b50505050808062005f5a906200a237565b91505062005ca6565b6009545b600a5481101562005f905762005f8a8162005f82816200a237565b9250620070d6565b62005f67565b50600a54600955600d54600c540362005fa557565b600e545b600d5481101562005fd25762005fcc8162005fc4816200a237565b925062007390565b62005fa9565b50600d54600e55600c54805b600d548110156200604c576015546000828152600b602052604090206004015443916200600b9162009b4c565b1162006031576200601c816200777b565b6200602960018362009b4c565b915062006037565b6200604c565b8062006043816200a237565b91505062005fde565b50600c55565b60355460005b81811015620060e2576000603582815481106200607957620060796200a126565b60009182526020808320909101546001600160a01b03168083526034825260408084208490556036909252912054909150600103620060cc576001600160a01b0381166000908152603660205260408120555b5080620060d9816200a237565b91505062006058565b506200146a6035600062008f28565b60115460105410156200161b576010546000908152600f6020526040902060155460018201544391620061249162009b4c565b11156200612e5750565b80546001600160a01b039081166000908152603a60205260409081902060028401546004808301829055600c83015493517f19fac8fd000000000000000000000000000000000000000000000000000000008152929493909316926319fac8fd926200619f92910190815260200190565b600060405180830381600087803b158015620061ba57600080fd5b505af1158015620061cf573d6000803e3d6000fd5b5050601080546000908152600f60205260408120805473ffffffffffffffffffffffffffffffffffffffff19168155600180820183905560029091018290558254909450919250906200622490849062009b4c565b90915550620060f192505050565b6000828152602c60209081526040808320918401516001830155830151600282015560608301516003820155905b82515181101562001d635782518051839190839081106200628557620062856200a126565b6020908102919091018101518254600180820185556000948552938390208251600390920201805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b03909216919091178155918101519282019290925560408201516002820190620062f6908262009f6b565b505050808062006306906200a237565b91505062006260565b6000805b602d548110156200637757602d81815481106200633457620063346200a126565b60009182526020909120600390910201546001600160a01b0390811690841603620063625750600192915050565b806200636e816200a237565b91505062006313565b50600092915050565b6000806200638d62008f48565b60008060ff9050604083875160208901845afa620063aa57600080fd5b505080516020909101516c0100000000000000000000000090910494909350915050565b60e2815114620064215760405162461bcd60e51b815260206004820152601460248201527f496e76616c69642070726f6f66206c656e6774680000000000000000000000006044820152606401620013bb565b60308261024001515114620064795760405162461bcd60e51b815260206004820152601c60248201527f496e76616c696420636f6e73656e737573206b6579206c656e677468000000006044820152606401620013bb565b620064848262007924565b604080518082018252601a81527f19457468657265756d205369676e6564204d6573736167653a0a000000000000602080830191909152845192519192600092620064fa920160609190911b7fffffffffffffffffffffffffffffffffffffffff00000000000000000000000016815260140190565b60405160208183030381529060405290506000826200651a835162007a63565b836040516020016200652f939291906200a2a0565b60408051601f198184030181528282528051602091820120600280855260608501845290945060009392909183019080368337019050509050600080808062006587898262006581604160026200a254565b62007bb8565b90506000620065a68a6200659e604160026200a254565b606062007bb8565b905060205b82518110156200667757620065c1838262007cec565b6040805160008152602081018083528d905260ff8316918101919091526060810184905260808101839052929850909650945060019060a0016020604051602081039080840390855afa1580156200661d573d6000803e3d6000fd5b5050604051601f190151905087620066376041846200a10f565b815181106200664a576200664a6200a126565b6001600160a01b03909216602092830291909101909101526200666f60418262009b4c565b9050620065ab565b508a602001516001600160a01b0316866000815181106200669c576200669c6200a126565b60200260200101516001600160a01b031614620067225760405162461bcd60e51b815260206004820152602960248201527f496e76616c6964206e6f6465206b6579206f776e6572736869702070726f6f6660448201527f2070726f766964656400000000000000000000000000000000000000000000006064820152608401620013bb565b8a604001516001600160a01b0316866001815181106200674657620067466200a126565b60200260200101516001600160a01b031614620067cc5760405162461bcd60e51b815260206004820152602b60248201527f496e76616c6964206f7261636c65206b6579206f776e6572736869702070726f60448201527f6f662070726f76696465640000000000000000000000000000000000000000006064820152608401620013bb565b6001620067e48c6102400151838e6000015162007d23565b14620068595760405162461bcd60e51b815260206004820152603660248201527f496e76616c696420636f6e73656e737573206b6579206f776e6572736869702060448201527f70726f6f6620666f7220726567697374726174696f6e000000000000000000006064820152608401620013bb565b620068648b62007d92565b5050505050505050505050565b60006064821015801562001341575050617530101590565b6000600a821015801562001341575050612710101590565b60008083116200691a5760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201527f69766500000000000000000000000000000000000000000000000000000000006064820152608401620013bb565b6001600160a01b038216600090815260396020526040902054831115620069845760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e636500000000006044820152606401620013bb565b6001600160a01b03821660009081526039602052604081208054859290620069ae90849062009b36565b9091555050604080516080810182526001600160a01b03808516825286811660208084019182528385018881524360608601908152600a8054600090815260089094529683208651815490871673ffffffffffffffffffffffffffffffffffffffff199182161782559451600182018054919097169516949094179094555160028301559151600390910155825491929062006a4a836200a237565b90915550506001600160a01b038581166000818152603a6020908152604091829020548251908516948816948514808252918101899052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d910160405180910390a3833b1562006ac45762006ac4848762008074565b6001600a5462006ad5919062009b36565b9695505050505050565b6001600160a01b038084166000908152603a602052604081208054919290918482169116148062006c9b57600c8201546040517f84955c880000000000000000000000000000000000000000000000000000000081526001600160a01b03868116600483015260009216906384955c8890602401602060405180830381865afa15801562006b71573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019062006b97919062009f0c565b90508581101562006c115760405162461bcd60e51b815260206004820152602b60248201527f696e73756666696369656e7420756e6c6f636b6564204c6971756964204e657760448201527f746f6e2062616c616e63650000000000000000000000000000000000000000006064820152608401620013bb565b600c8301546040517f282d3fdf0000000000000000000000000000000000000000000000000000000081526001600160a01b038781166004830152602482018990529091169063282d3fdf90604401600060405180830381600087803b15801562006c7b57600080fd5b505af115801562006c90573d6000803e3d6000fd5b505050505062006d44565b8482600b0154836008015462006cb2919062009b36565b101562006d285760405162461bcd60e51b815260206004820152602760248201527f696e73756666696369656e742073656c6620626f6e646564206e6577746f6e2060448201527f62616c616e6365000000000000000000000000000000000000000000000000006064820152608401620013bb565b8482600b01600082825462006d3e919062009b4c565b90915550505b604051806101200160405280856001600160a01b03168152602001876001600160a01b0316815260200186815260200160008152602001438152602001600081526020016000600381111562006d9e5762006d9e620090bd565b815260006020808301829052841515604093840152600d548252600b815290829020835181546001600160a01b0391821673ffffffffffffffffffffffffffffffffffffffff199182161783559285015160018381018054929093169190941617905591830151600283015560608301516003808401919091556080840151600484015560a0840151600584015560c08401516006840180549193909260ff1990921691849081111562006e565762006e56620090bd565b021790555060e08201516006909101805461010093840151151562010000027fffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ffff931515909402929092167fffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000ff90921691909117919091179055600d805490600062006ee0836200a237565b9190505550836001600160a01b0316866001600160a01b03167f63f8870909f7c59c9c4932bf98dbd491647c8d2e89ca0a032aacdd943a13e2fc838860405162006f369291909115158252602082015260400190565b60405180910390a3833b1562006f525762006f52848762008074565b6001600d5462006ad5919062009b36565b60fa60a06000808285855af462006f7e573d6000803e3d6000fd5b505050565b81546002600180831615610100020382160482518082016020811060208410016002811462007032576001811462007058578660005260208404602060002001600160028402018855602085068060200390508088018589016001836101000a0392508282511684540184556001840193506020820191505b808210156200701b578151845560018401935060208201915062006ffc565b815191036101000a908190040290915550620070cd565b60028302826020036101000a846020036101000a602089015104020185018755620070cd565b8660005260208404602060002001600160028402018855846020038088018589016001836101000a0392508282511660ff198a160184556020820191506001840193505b80821015620070bb57815184556001840193506020820191506200709c565b815191036101000a9081900402909155505b50505050505050565b600081815260086020908152604080832060018101546001600160a01b03168452603a90925282209091601382015460ff1660038111156200711c576200711c620090bd565b14158062007143575081546001600160a01b03166000908152603660205260409020546001145b156200720357600282015482546001600160a01b0316600090815260396020526040812080549091906200717990849062009b4c565b909155505081546001830154600284015460138401546040516001600160a01b0394851694909316927f1ff2b052afa4bb37ce30d9aaccde416a700b97e632d089111749af937f87834292620071d792909160ff909116906200a2e9565b60405180910390a38054825462006f7e9185916000916001600160a01b03918216911614600162008193565b805482546001600160a01b0390811691161462007340576000808260080154836005015462007233919062009b36565b9050806000036200724b578360020154915062007271565b80846002015484600d01546200726291906200a254565b6200726e91906200a10f565b91505b600c83015484546040517f40c10f190000000000000000000000000000000000000000000000000000000081526001600160a01b039182166004820152602481018590529116906340c10f1990604401600060405180830381600087803b158015620072dc57600080fd5b505af1158015620072f1573d6000803e3d6000fd5b505050508183600d0160008282546200730b919062009b4c565b909155505060028401546005840180546000906200732b90849062009b4c565b9091555062001f469050858360008062008193565b81600201548160080160008282546200735a919062009b4c565b909155505060028201546005820180546000906200737a90849062009b4c565b9091555062006f7e905083600060018162008193565b6000818152600b6020908152604080832080546001600160a01b0316845260369092529091205460010362007432578054600182015460068301546002840154604080516201000090930460ff161515835260208301919091526001600160a01b0393841693909216917fec3ccfdbfc982a0ea188f4fd85459da1d6a7b332ddc4ca6b7a774c1abfc75866910160405180910390a362001dd182600162008335565b60018101546001600160a01b03166000908152603a60205260408120600683015490919062010000900460ff166200764c576002830154600c83015484546040517f7eee288d0000000000000000000000000000000000000000000000000000000081526001600160a01b03918216600482015260248101849052911690637eee288d90604401600060405180830381600087803b158015620074d457600080fd5b505af1158015620074e9573d6000803e3d6000fd5b50505050600c83015484546040517f9dc29fac0000000000000000000000000000000000000000000000000000000081526001600160a01b03918216600482015260248101849052911690639dc29fac90604401600060405180830381600087803b1580156200755857600080fd5b505af11580156200756d573d6000803e3d6000fd5b5050505060008360080154846005015462007589919062009b36565b600d8501549091506200759d82846200a254565b620075a991906200a10f565b92508184600d016000828254620075c1919062009b36565b90915550506006840154600003620075e0576003850183905562007608565b60068401546007850154620075f690856200a254565b6200760291906200a10f565b60038601555b828460060160008282546200761e919062009b4c565b909155505060038501546007850180546000906200763e90849062009b4c565b909155506200772192505050565b506002820154600882015481111562007666575060088101545b8160090154600003620076805760038301819055620076a8565b6009820154600a8301546200769690836200a254565b620076a291906200a10f565b60038401555b80826009016000828254620076be919062009b4c565b90915550506003830154600a83018054600090620076de90849062009b4c565b9250508190555080826008016000828254620076fb919062009b36565b90915550506002830154600b830180546000906200771b90849062009b36565b90915550505b6006830180547fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ff166101001790556005820180548291906000906200776890849062009b36565b9091555062001d63905084600062008335565b6000818152600b602052604090206006810154610100900460ff16620077b95760068101805460ff1916600217905562001dd18260006001620084c1565b60068101805460ff191660011790556003810154600003620077e35762001dd182600080620084c1565b60018101546001600160a01b03166000908152603a60205260408120600683015490919062010000900460ff1662007880578160070154826006015484600301546200783091906200a254565b6200783c91906200a10f565b90508082600601600082825462007854919062009b36565b909155505060038301546007830180546000906200787490849062009b36565b90915550620078e59050565b81600a0154826009015484600301546200789b91906200a254565b620078a791906200a10f565b905080826009016000828254620078bf919062009b36565b90915550506003830154600a83018054600090620078df90849062009b36565b90915550505b82546001600160a01b0316600090815260396020526040812080548392906200791090849062009b4c565b9091555062001d63905084826000620084c1565b600062007935826060015162006380565b6001600160a01b03909116602084015290508015620079975760405162461bcd60e51b815260206004820152600b60248201527f656e6f6465206572726f720000000000000000000000000000000000000000006044820152606401620013bb565b6020808301516001600160a01b039081166000908152603a909252604090912060010154161562007a0b5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c72656164792072656769737465726564000000006044820152606401620013bb565b6127108260800151111562001dd15760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e20726174650000000000000000006044820152606401620013bb565b60608160000362007aa757505060408051808201909152600181527f3000000000000000000000000000000000000000000000000000000000000000602082015290565b8160005b811562007ad7578062007abe816200a237565b915062007acf9050600a836200a10f565b915062007aab565b60008167ffffffffffffffff81111562007af55762007af56200951a565b6040519080825280601f01601f19166020018201604052801562007b20576020820181803683370190505b5090505b841562007bb05762007b3860018362009b36565b915062007b47600a866200a308565b62007b5490603062009b4c565b60f81b81838151811062007b6c5762007b6c6200a126565b60200101907effffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916908160001a90535062007ba8600a866200a10f565b945062007b24565b949350505050565b60608162007bc881601f62009b4c565b101562007c185760405162461bcd60e51b815260206004820152600e60248201527f736c6963655f6f766572666c6f770000000000000000000000000000000000006044820152606401620013bb565b62007c24828462009b4c565b8451101562007c765760405162461bcd60e51b815260206004820152601160248201527f736c6963655f6f75744f66426f756e64730000000000000000000000000000006044820152606401620013bb565b60608215801562007c97576040519150600082526020820160405262007ce3565b6040519150601f8416801560200281840101858101878315602002848b0101015b8183101562007cd257805183526020928301920162007cb8565b5050858452601f01601f1916604052505b50949350505050565b8181018051602082015160409092015190919060001a601b81101562007d1c5762007d19601b826200a31f565b90505b9250925092565b600062007d2f62008f66565b600085858560405160200162007d48939291906200a33b565b6040516020818303038152906040529050600060fb905060008251602062007d71919062009b4c565b90506020848285855afa62007d8557600080fd5b5050905195945050505050565b6101808101516001600160a01b031662007eac57603e546001600160a01b031662007e265760405162461bcd60e51b815260206004820152602260248201527f6c6971756964206c6f67696320636f6e7472616374206e6f74206465706c6f7960448201527f65640000000000000000000000000000000000000000000000000000000000006064820152608401620013bb565b60255460009062007e379062007a63565b905081602001518260000151836080015183603e60009054906101000a90046001600160a01b031660405162007e6d9062008f84565b62007e7d9594939291906200a39d565b604051809103906000f08015801562007e9a573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051602580546001808201835560009283527f401968ff42a154441da5f6c4c935ac46b8671f0e062baaa62a7545ba53bb6e4c909101805473ffffffffffffffffffffffffffffffffffffffff199081166001600160a01b0395861617909155845184168352603a9095526040918290208651815487169085161781559351908401805486169184169190911790558401516002830180549094169116179091556060820151829190600382019062007f6b908262009f6b565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c8201805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f82015561020082015160108201556102208201516011820155610240820151601282019062008042908262009f6b565b5061026082015160138201805460ff191660018360038111156200806a576200806a620090bd565b0217905550505050565b6001600160a01b0382166000908152603460205260408120549003620080ff576001600160a01b03821660008181526034602052604081206001908190556035805491820181559091527fcfa4bec1d3298408bb5afcfcd9c430549c5b31f8aa5c5848151c0a55f473c34d01805473ffffffffffffffffffffffffffffffffffffffff191690911790555b6001600160a01b038083166000908152603260209081526040808320938516835292905290812054900362001dd1576001600160a01b038083166000818152603260209081526040808320948616808452948252808320600190819055938352603382528220805493840181558252902001805473ffffffffffffffffffffffffffffffffffffffff191690911790555050565b600084815260086020526040902080546001600160a01b0316803b620081bb57505062001d63565b6001600160a01b038116600090815260386020526040902054600454811115620081e457506004545b60005a60018501546040517f9dfd1b8e000000000000000000000000000000000000000000000000000000008152600481018b90526001600160a01b039182166024820152604481018a905288151560648201528715156084820152919250841690639dfd1b8e90849060a401600060405180830381600088803b1580156200826c57600080fd5b5087f1935050505080156200827f575060015b620082aa575a62008291908262009b36565b905084620082a457620082a4886200864a565b620082ba565b5a620082b7908262009b36565b90505b6001600160a01b03831660009081526038602052604090205481101562008311576001600160a01b038316600090815260386020526040812080548392906200830590849062009b36565b909155506200832b9050565b6001600160a01b0383166000908152603860205260408120555b5050505050505050565b6000828152600b6020526040902080546001600160a01b0316803b6200835b5750505050565b6001600160a01b0381166000908152603860205260409020546005548111156200838457506005545b60005a60018501546040517fa8920241000000000000000000000000000000000000000000000000000000008152600481018990526001600160a01b039182166024820152871515604482015291925084169063a8920241908490606401600060405180830381600088803b158015620083fd57600080fd5b5087f19350505050801562008410575060015b6200843b575a62008422908262009b36565b905084620084355762008435866200882a565b6200844b565b5a62008448908262009b36565b90505b6001600160a01b038316600090815260386020526040902054811015620084a2576001600160a01b038316600090815260386020526040812080548392906200849690849062009b36565b90915550620030aa9050565b50506001600160a01b0316600090815260386020526040812055505050565b6000838152600b6020526040902080546001600160a01b0316803b620084e8575050505050565b6001600160a01b0381166000908152603860205260409020546006548111156200851157506006545b60005a6040517f3c54c290000000000000000000000000000000000000000000000000000000008152600481018990526024810188905286151560448201529091506001600160a01b03841690633c54c290908490606401600060405180830381600088803b1580156200858457600080fd5b5087f19350505050801562008597575060015b620085c3575a620085a9908262009b36565b905084620085bd57620085bd878762008ab6565b620085d3565b5a620085d0908262009b36565b90505b6001600160a01b0383166000908152603860205260409020548110156200862a576001600160a01b038316600090815260386020526040812080548392906200861e90849062009b36565b90915550620070cd9050565b50506001600160a01b031660009081526038602052604081205550505050565b600081815260086020908152604080832060018101546001600160a01b039081168552603a909352922080548354919291821691161462008768576000816008015482600501546200869d919062009b36565b836002015483600d0154620086b391906200a254565b620086bf91906200a10f565b600c83015484546040517f9dc29fac0000000000000000000000000000000000000000000000000000000081526001600160a01b039182166004820152602481018490529293501690639dc29fac90604401600060405180830381600087803b1580156200872c57600080fd5b505af115801562008741573d6000803e3d6000fd5b505050508082600d0160008282546200875b919062009b36565b9091555062008788915050565b816002015481600801600082825462008782919062009b36565b90915550505b600282015482546001600160a01b031660009081526039602052604081208054909190620087b890849062009b4c565b90915550506002820154600582018054600090620087d890849062009b36565b90915550508154600183015460028401546040519081526001600160a01b0392831692909116907f2d2530c45d577d38d6fc598e41ade6fb0bfbde20d09244c88348d3a2797a9d90906020016200547f565b6000818152600b6020908152604080832060018101546001600160a01b03168452603a909252822060068201549192909162010000900460ff1662008979576002830154600c83015484546040517f40c10f190000000000000000000000000000000000000000000000000000000081526001600160a01b039182166004820152602481018490529116906340c10f1990604401600060405180830381600087803b158015620088d957600080fd5b505af1158015620088ee573d6000803e3d6000fd5b505050508083600d01600082825462008908919062009b4c565b90915550506007830154600684015460038601546200892891906200a254565b6200893491906200a10f565b9150818360060160008282546200894c919062009b36565b909155505060038401546007840180546000906200896c90849062009b36565b90915550620089fb915050565b81600a0154826009015484600301546200899491906200a254565b620089a091906200a10f565b905080826009016000828254620089b8919062009b36565b90915550506003830154600a83018054600090620089d890849062009b36565b9250508190555080826008016000828254620089f5919062009b4c565b90915550505b6000600384018190556006840180547fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ff16905560058301805483929062008a4490849062009b4c565b90915550508254600184015460068501546002860154604080516201000090930460ff161515835260208301919091526001600160a01b0393841693909216917f52c1b482483796c697edd43958bc63e332069083ea38782d960693145db2cf0c91015b60405180910390a350505050565b6000828152600b602052604081209082900362008b3a57600681018054600360ff19909116179081905581546001830154604080516201000090940460ff1615158452602084018690526001600160a01b039283169392909116917f0a4bfc4c4e35975ab1fe5fd21d963e8bb8d483f04aebdbc0a059b246bec3f5fc91016200547f565b60018101546001600160a01b03166000908152603a60205260409020600682015462010000900460ff1662008c63576000808260080154836005015462008b82919062009b36565b90508060000362008b965784915062008bb8565b808584600d015462008ba991906200a254565b62008bb591906200a10f565b91505b600c83015484546040517f40c10f190000000000000000000000000000000000000000000000000000000081526001600160a01b039182166004820152602481018590529116906340c10f1990604401600060405180830381600087803b15801562008c2357600080fd5b505af115801562008c38573d6000803e3d6000fd5b505050508183600d01600082825462008c52919062009b4c565b909155505050600583015562008c88565b8282600501819055508281600801600082825462008c82919062009b4c565b90915550505b60068201805460ff1916600317905581546001600160a01b03166000908152603960205260408120805485929062008cc290849062009b36565b925050819055508281600501600082825462008cdf919062009b4c565b9091555050815460018301546006840154604080516201000090920460ff1615158252602082018790526001600160a01b0393841693909216917f0a4bfc4c4e35975ab1fe5fd21d963e8bb8d483f04aebdbc0a059b246bec3f5fc910162008aa8565b60405180610280016040528060006001600160a01b0316815260200160006001600160a01b0316815260200160006001600160a01b0316815260200160608152602001600081526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b031681526020016000815260200160008152602001600081526020016000815260200160008152602001606081526020016000600381111562008e0d5762008e0d620090bd565b905290565b60405180610120016040528060006001600160a01b0316815260200160006001600160a01b03168152602001600081526020016000815260200160008152602001600081526020016000600381111562008e705762008e70620090bd565b815260006020820181905260409091015290565b6040518060a001604052806005906020820280368337509192915050565b50805460008255906000526020600020908101906200146a919062008f92565b50805462008ed09062009ab2565b6000825580601f1062008ee1575050565b601f0160209004906000526020600020908101906200146a919062008fb7565b50805460008255601f0160209004906000526020600020908101906200146a919062008fb7565b50805460008255906000526020600020908101906200146a919062008fb7565b60405180604001604052806002906020820280368337509192915050565b60405180602001604052806001906020820280368337509192915050565b610610806200a3d183390190565b8082111562008fb357600062008fa9828262008ec2565b5060010162008f92565b5090565b5b8082111562008fb3576000815560010162008fb8565b60005b8381101562008feb57818101518382015260200162008fd1565b50506000910152565b600081518084526200900e81602086016020860162008fce565b601f01601f19169290920160200192915050565b60208152600062009037602083018462008ff4565b9392505050565b6001600160a01b03811681146200146a57600080fd5b600080604083850312156200906857600080fd5b823562009075816200903e565b946020939093013593505050565b6000602082840312156200909657600080fd5b813562009037816200903e565b600060208284031215620090b657600080fd5b5035919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b600481106200146a577f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b6200912f81620090ec565b9052565b602081526200914e6020820183516001600160a01b03169052565b600060208301516200916b60408401826001600160a01b03169052565b5060408301516001600160a01b03811660608401525060608301516102808060808501526200919f6102a085018362008ff4565b9150608085015160a085015260a085015160c085015260c085015160e085015260e08501516101008181870152808701519150506101208181870152808701519150506101408181870152808701519150506101608181870152808701519150506101808181870152808701519150506101a062009227818701836001600160a01b03169052565b8601516101c0868101919091528601516101e080870191909152860151610200808701919091528601516102208087019190915286015161024080870191909152860151858403601f1901610260808801919091529091506200928b848362008ff4565b935080870151915050620092a28286018262009124565b5090949350505050565b600080600060608486031215620092c257600080fd5b8335620092cf816200903e565b92506020840135620092e1816200903e565b929592945050506040919091013590565b6000610120820190506001600160a01b038084511683528060208501511660208401525060408301516040830152606083015160608301526080830151608083015260a083015160a083015260c08301516200935260c084018262009124565b5060e08301516200936760e084018215159052565b50610100928301511515919092015290565b6000602082840312156200938c57600080fd5b813567ffffffffffffffff811115620093a457600080fd5b820161028081850312156200903757600080fd5b600081518084526020808501808196508360051b8101915082860160005b858110156200942d578284038952815180516001600160a01b031685528581015186860152604090810151606091860182905290620094188187018362008ff4565b9a87019a9550505090840190600101620093d6565b5091979650505050505050565b8515158152841515602082015260a0604082015260006200945f60a0830186620093b8565b606083019490945250608001529392505050565b6101e08101620094c48286805182526020810151602083015260408101516040830152606081015160608301526080810151608083015260a081015160a083015260c0810151151560c08301525050565b835160e0830152602084015161010083015260408401516101208301526060840151610140830152608084015161016083015260a084015161018083015260c09093015115156101a08201526101c00152919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b600082601f8301126200955b57600080fd5b813567ffffffffffffffff808211156200957957620095796200951a565b604051601f8301601f19908116603f01168101908282118183101715620095a457620095a46200951a565b81604052838152866020858801011115620095be57600080fd5b836020870160208301376000602085830101528094505050505092915050565b60008060408385031215620095f257600080fd5b8235620095ff816200903e565b9150602083013567ffffffffffffffff8111156200961c57600080fd5b6200962a8582860162009549565b9150509250929050565b600061026082019050855182526020860151602083015260408601516040830152606086015160608301526080860151608083015260a08601516001600160a01b0380821660a08501528087511660c08501528060208801511660e08501528060408801511661010085015250506060850151620096be6101208401826001600160a01b03169052565b5060808501516001600160a01b0390811661014084015260a0860151811661016084015260c0860151811661018084015260e09095015185166101a083015283519094166101c082015260208301516101e0820152604083015161020082015260609092015161022083015261024090910152919050565b600080600080608085870312156200974d57600080fd5b843567ffffffffffffffff808211156200976657600080fd5b620097748883890162009549565b95506020870135915062009788826200903e565b909350604086013590808211156200979f57600080fd5b620097ad8883890162009549565b93506060870135915080821115620097c457600080fd5b50620097d38782880162009549565b91505092959194509250565b80151581146200146a57600080fd5b600060e082840312156200980157600080fd5b60405160e0810181811067ffffffffffffffff821117156200982757620098276200951a565b8060405250823581526020830135602082015260408301356040820152606083013560608201526080830135608082015260a083013560a082015260c08301356200987281620097df565b60c08201529392505050565b6000602080830181845280855180835260408601915060408160051b870101925083870160005b82811015620098f5577fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffc0888603018452620098e285835162008ff4565b94509285019290850190600101620098a5565b5092979650505050505050565b602081016200991183620090ec565b91905290565b6080815260006200992c6080830187620093b8565b6020830195909552506040810192909252606090910152919050565b602081526000620090376020830184620093b8565b600081518084526020808501945080840160005b83811015620099985781516001600160a01b03168752958201959082019060010162009971565b509495945050505050565b604081526000620099b860408301856200995d565b8281036020840152620099cc81856200995d565b95945050505050565b60008060408385031215620099e957600080fd5b823567ffffffffffffffff8082111562009a0257600080fd5b62009a108683870162009549565b9350602085013591508082111562009a2757600080fd5b506200962a8582860162009549565b60408152600062009a4b604083018562008ff4565b8281036020840152620099cc818562008ff4565b6020815260006200903760208301846200995d565b6000806040838503121562009a8857600080fd5b823562009a95816200903e565b9150602083013562009aa7816200903e565b809150509250929050565b600181811c9082168062009ac757607f821691505b60208210810362009b01577f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b8181038181111562001341576200134162009b07565b8082018082111562001341576200134162009b07565b6000813562001341816200903e565b60008083357fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe184360301811262009ba757600080fd5b83018035915067ffffffffffffffff82111562009bc357600080fd5b60200191503681900382131562009bd957600080fd5b9250929050565b601f82111562006f7e57600081815260208120601f850160051c8101602086101562009c095750805b601f850160051c820191505b81811015620030aa5782815560010162009c15565b67ffffffffffffffff83111562009c455762009c456200951a565b62009c5d8362009c56835462009ab2565b8362009be0565b6000601f84116001811462009c94576000851562009c7b5750838201355b600019600387901b1c1916600186901b17835562001f46565b600083815260209020601f19861690835b8281101562009cc7578685013582556020948501946001909201910162009ca5565b508682101562009ce55760001960f88860031b161c19848701351681555b505060018560011b0183555050505050565b60008135600481106200134157600080fd5b62009d1482620090ec565b60ff1981541660ff831681178255505050565b62009d6062009d368362009b62565b825473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0391909116178255565b62009da062009d726020840162009b62565b600183016001600160a01b03821673ffffffffffffffffffffffffffffffffffffffff198254161781555050565b62009de062009db26040840162009b62565b600283016001600160a01b03821673ffffffffffffffffffffffffffffffffffffffff198254161781555050565b62009def606083018362009b71565b62009dff81836003860162009c2a565b50506080820135600482015560a0820135600582015560c0820135600682015560e0820135600782015561010082013560088201556101208201356009820155610140820135600a820155610160820135600b82015562009e9662009e68610180840162009b62565b600c83016001600160a01b03821673ffffffffffffffffffffffffffffffffffffffff198254161781555050565b6101a0820135600d8201556101c0820135600e8201556101e0820135600f8201556102008201356010820155610220820135601182015562009edd61024083018362009b71565b62009eed81836012860162009c2a565b505062001dd162009f02610260840162009cf7565b6013830162009d09565b60006020828403121562009f1f57600080fd5b5051919050565b6000806040838503121562009f3a57600080fd5b505080516020909101519092909150565b60006020828403121562009f5e57600080fd5b81516200903781620097df565b815167ffffffffffffffff81111562009f885762009f886200951a565b62009fa08162009f99845462009ab2565b8462009be0565b602080601f83116001811462009fd8576000841562009fbf5750858301515b600019600386901b1c1916600185901b178555620030aa565b600085815260208120601f198616915b828110156200a0095788860151825594840194600190910190840162009fe8565b50858210156200a0285787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b60006001600160a01b0380881683528087166020840152808616604084015260a060608401526200a06d60a084018662008ff4565b91508084166080840152509695505050505050565b61010081016200a0d38285805182526020810151602083015260408101516040830152606081015160608301526080810151608083015260a081015160a083015260c0810151151560c08301525050565b8260e08301529392505050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b6000826200a121576200a1216200a0e0565b500490565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052603260045260246000fd5b8181036200a161575050565b6200a16d825462009ab2565b67ffffffffffffffff8111156200a188576200a1886200951a565b6200a1998162009f99845462009ab2565b6000601f8211600181146200a1d057600083156200a1b75750848201545b600019600385901b1c1916600184901b17845562001f46565b600085815260209020601f19841690600086815260209020845b838110156200a20c57828601548255600195860195909101906020016200a1ea565b50858310156200a0285793015460001960f8600387901b161c19169092555050600190811b01905550565b600060001982036200a24d576200a24d62009b07565b5060010190565b808202811582820484141762001341576200134162009b07565b6001600160a01b0383168152606060208201526000606082015260806040820152600062007bb0608083018462008ff4565b600084516200a2b481846020890162008fce565b8451908301906200a2ca81836020890162008fce565b84519101906200a2df81836020880162008fce565b0195945050505050565b828152604081016200a2fb83620090ec565b8260208301529392505050565b6000826200a31a576200a31a6200a0e0565b500690565b60ff818116838216019081111562001341576200134162009b07565b600084516200a34f81846020890162008fce565b8451908301906200a36581836020890162008fce565b60609490941b7fffffffffffffffffffffffffffffffffffffffff000000000000000000000000169301928352505060140192915050565b60006001600160a01b038088168352808716602084015285604084015260a060608401526200a06d60a084018662008ff456fe608060405234801561001057600080fd5b5060405161061038038061061083398101604081905261002f916101af565b806001600160a01b0316632f2c3f2e6040518163ffffffff1660e01b8152600401602060405180830381865afa15801561006d573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610091919061029f565b83111561009d57600080fd5b600c80546001600160a01b038088166001600160a01b031992831617909255600d805492871692909116919091179055600e8390556040516100e39083906020016102b8565b604051602081830303815290604052600a9081610100919061036e565b508160405160200161011291906102b8565b604051602081830303815290604052600b908161012f919061036e565b5050601080546001600160a01b031916331790555061042d92505050565b6001600160a01b038116811461016257600080fd5b50565b80516101708161014d565b919050565b634e487b7160e01b600052604160045260246000fd5b60005b838110156101a657818101518382015260200161018e565b50506000910152565b600080600080600060a086880312156101c757600080fd5b85516101d28161014d565b60208701519095506101e38161014d565b6040870151606088015191955093506001600160401b038082111561020757600080fd5b818801915088601f83011261021b57600080fd5b81518181111561022d5761022d610175565b604051601f8201601f19908116603f0116810190838211818310171561025557610255610175565b816040528281528b602084870101111561026e57600080fd5b61027f83602083016020880161018b565b809650505050505061029360808701610165565b90509295509295909350565b6000602082840312156102b157600080fd5b5051919050565b644c4e544e2d60d81b8152600082516102d881600585016020870161018b565b9190910160050192915050565b600181811c908216806102f957607f821691505b60208210810361031957634e487b7160e01b600052602260045260246000fd5b50919050565b601f82111561036957600081815260208120601f850160051c810160208610156103465750805b601f850160051c820191505b8181101561036557828155600101610352565b5050505b505050565b81516001600160401b0381111561038757610387610175565b61039b8161039584546102e5565b8461031f565b602080601f8311600181146103d057600084156103b85750858301515b600019600386901b1c1916600185901b178555610365565b600085815260208120601f198616915b828110156103ff578886015182559484019460019091019084016103e0565b508582101561041d5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b6101d48061043c6000396000f3fe60806040523661001b57610019610014610022565b61013e565b005b6100196100145b600080601060009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff166396f18a326040518163ffffffff1660e01b8152600401602060405180830381865afa158015610092573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906100b69190610161565b905073ffffffffffffffffffffffffffffffffffffffff8116610139576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152601d60248201527f6c6971756964206c6f67696320636f6e7472616374206e6f7420736574000000604482015260640160405180910390fd5b919050565b3660008037600080366000845af43d6000803e8061015b573d6000fd5b503d6000f35b60006020828403121561017357600080fd5b815173ffffffffffffffffffffffffffffffffffffffff8116811461019757600080fd5b939250505056fea264697066735822122076b5b72323e3d9ea33f6c72d4f6af1b0947104c33397f7484304cd24721df42c64736f6c63430008150033a264697066735822122088f787f55082de0a25d375a2595ae0db8f3de78a8ed88b49df3e0c351f7a3e9f64736f6c63430008150033608060405234801561001057600080fd5b50601080546001600160a01b03191633179055611cc4806100326000396000f3fe6080604052600436106101a55760003560e01c80633e4eb36c116100e1578063949813b81161008a578063a0ce552d11610064578063a0ce552d1461054b578063a9059cbb1461055e578063bd96102f1461057e578063dd62ed3e14610593576101ac565b8063949813b8146104e157806395d89b41146105165780639dc29fac1461052b576101ac565b806370a08231116100bb57806370a082311461045e5780637eee288d146104a157806384955c88146104c1576101ac565b80633e4eb36c146103e657806340c10f19146103fb578063593557361461041b576101ac565b80631eeffad01161014e5780632f2c3f2e116101285780632f2c3f2e14610374578063313ce5671461038a578063372500ab146103a65780633b19e84a146103bb576101ac565b80631eeffad01461031f57806323b872dd14610334578063282d3fdf14610354576101ac565b806318160ddd1161017f57806318160ddd146102c6578063187cf4d7146102e557806319fac8fd146102fd576101ac565b806306fdde031461021f578063095ea7b31461024a5780631195e07e1461027a576101ac565b366101ac57005b60405162461bcd60e51b815260206004820152602860248201527f66616c6c6261636b206e6f7420696d706c656d656e74656420666f72204c697160448201527f7569644c6f67696300000000000000000000000000000000000000000000000060648201526084015b60405180910390fd5b34801561022b57600080fd5b506102346105e6565b6040516102419190611a10565b60405180910390f35b34801561025657600080fd5b5061026a610265366004611aa5565b610678565b6040519015158152602001610241565b34801561028657600080fd5b50600c5473ffffffffffffffffffffffffffffffffffffffff165b60405173ffffffffffffffffffffffffffffffffffffffff9091168152602001610241565b3480156102d257600080fd5b506003545b604051908152602001610241565b3480156102f157600080fd5b506102d7633b9aca0081565b34801561030957600080fd5b5061031d610318366004611acf565b61068f565b005b34801561032b57600080fd5b50600f546102d7565b34801561034057600080fd5b5061026a61034f366004611ae8565b610721565b34801561036057600080fd5b5061031d61036f366004611aa5565b610864565b34801561038057600080fd5b506102d761271081565b34801561039657600080fd5b5060405160128152602001610241565b3480156103b257600080fd5b5061031d6109de565b3480156103c757600080fd5b50600d5473ffffffffffffffffffffffffffffffffffffffff166102a1565b3480156103f257600080fd5b50600e546102d7565b34801561040757600080fd5b5061031d610416366004611aa5565b610c08565b34801561042757600080fd5b506102d7610436366004611b24565b73ffffffffffffffffffffffffffffffffffffffff1660009081526001602052604090205490565b34801561046a57600080fd5b506102d7610479366004611b24565b73ffffffffffffffffffffffffffffffffffffffff1660009081526020819052604090205490565b3480156104ad57600080fd5b5061031d6104bc366004611aa5565b610cf2565b3480156104cd57600080fd5b506102d76104dc366004611b24565b610e4f565b3480156104ed57600080fd5b506105016104fc366004611b24565b610e89565b60408051928352602083019190915201610241565b34801561052257600080fd5b50610234610f75565b34801561053757600080fd5b5061031d610546366004611aa5565b610f84565b610501610559366004611acf565b611066565b34801561056a57600080fd5b5061026a610579366004611aa5565b6113f0565b34801561058a57600080fd5b5061031d61145c565b34801561059f57600080fd5b506102d76105ae366004611b3f565b73ffffffffffffffffffffffffffffffffffffffff918216600090815260026020908152604080832093909416825291909152205490565b6060600a80546105f590611b72565b80601f016020809104026020016040519081016040528092919081815260200182805461062190611b72565b801561066e5780601f106106435761010080835404028352916020019161066e565b820191906000526020600020905b81548152906001019060200180831161065157829003601f168201915b5050505050905090565b60006106853384846115a3565b5060015b92915050565b60105473ffffffffffffffffffffffffffffffffffffffff16331461071c5760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610216565b600e55565b73ffffffffffffffffffffffffffffffffffffffff83166000908152600260209081526040808320338452909152812054828110156107c85760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e742065786365656473206160448201527f6c6c6f77616e63650000000000000000000000000000000000000000000000006064820152608401610216565b6107dc85336107d78685611bf4565b6115a3565b6107e68584611722565b6107f0848461183d565b8373ffffffffffffffffffffffffffffffffffffffff168573ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8560405161084f91815260200190565b60405180910390a360019150505b9392505050565b60105473ffffffffffffffffffffffffffffffffffffffff1633146108f15760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610216565b73ffffffffffffffffffffffffffffffffffffffff82166000908152600160209081526040808320549183905290912054829161092d91611bf4565b10156109a05760405162461bcd60e51b8152602060048201526024808201527f63616e2774206c6f636b206d6f72652066756e6473207468616e20617661696c60448201527f61626c65000000000000000000000000000000000000000000000000000000006064820152608401610216565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260016020526040812080548392906109d5908490611c07565b90915550505050565b6000806109ea33611896565b3360009081526004602090815260408083208390556007909152812081905591935091508115610afe576010546040517fa9059cbb0000000000000000000000000000000000000000000000000000000081523360048201526024810184905273ffffffffffffffffffffffffffffffffffffffff9091169063a9059cbb906044016020604051808303816000875af1158015610a8b573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610aaf9190611c1a565b905080610afe5760405162461bcd60e51b815260206004820152601260248201527f4661696c656420746f2073656e64204e544e00000000000000000000000000006044820152606401610216565b333b15610b6b573373ffffffffffffffffffffffffffffffffffffffff1663161605e3846040518263ffffffff1660e01b81526004016000604051808303818588803b158015610b4d57600080fd5b505af1158015610b61573d6000803e3d6000fd5b5050505050505050565b60405133908490600081818185875af1925050503d8060008114610bab576040519150601f19603f3d011682016040523d82523d6000602084013e610bb0565b606091505b50508091505080610c035760405162461bcd60e51b815260206004820152601260248201527f4661696c656420746f2073656e642041544e00000000000000000000000000006044820152606401610216565b505050565b60105473ffffffffffffffffffffffffffffffffffffffff163314610c955760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610216565b610c9f828261183d565b60405181815273ffffffffffffffffffffffffffffffffffffffff8316906000907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef906020015b60405180910390a35050565b60105473ffffffffffffffffffffffffffffffffffffffff163314610d7f5760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610216565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260016020526040902054811115610e1a5760405162461bcd60e51b815260206004820152602360248201527f63616e277420756e6c6f636b206d6f72652066756e6473207468616e206c6f6360448201527f6b656400000000000000000000000000000000000000000000000000000000006064820152608401610216565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260016020526040812080548392906109d5908490611bf4565b73ffffffffffffffffffffffffffffffffffffffff8116600090815260016020908152604080832054918390528220546106899190611bf4565b73ffffffffffffffffffffffffffffffffffffffff81166000908152602081815260408083205460065460059093529083205483928391610ecb9184916119d2565b73ffffffffffffffffffffffffffffffffffffffff8616600090815260046020526040902054909150610eff908290611c07565b60095473ffffffffffffffffffffffffffffffffffffffff871660009081526008602052604081205492965091610f379185916119d2565b73ffffffffffffffffffffffffffffffffffffffff8716600090815260076020526040902054909150610f6b908290611c07565b9350505050915091565b6060600b80546105f590611b72565b60105473ffffffffffffffffffffffffffffffffffffffff1633146110115760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610216565b61101b8282611722565b60405181815260009073ffffffffffffffffffffffffffffffffffffffff8416907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef90602001610ce6565b601054600090819073ffffffffffffffffffffffffffffffffffffffff1633146110f85760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610216565b600e5434906000906127109061110e9084611c3c565b6111189190611c53565b90508181111561116a5760405162461bcd60e51b815260206004820152601c60248201527f696e76616c69642061746e2076616c696461746f7220726577617264000000006044820152606401610216565b6111748183611bf4565b600d5460405191935060009173ffffffffffffffffffffffffffffffffffffffff909116906108fc90849084818181858888f193505050503d80600081146111d8576040519150601f19603f3d011682016040523d82523d6000602084013e6111dd565b606091505b50909150508015156000036112045781600f60008282546111fe9190611c07565b90915550505b6000612710600e54886112179190611c3c565b6112219190611c53565b9050868111156112735760405162461bcd60e51b815260206004820152601c60248201527f696e76616c6964206e746e2076616c696461746f7220726577617264000000006044820152606401610216565b61127d8188611bf4565b9650801561132657601054600d546040517fa9059cbb00000000000000000000000000000000000000000000000000000000815273ffffffffffffffffffffffffffffffffffffffff91821660048201526024810184905291169063a9059cbb906044016020604051808303816000875af1158015611300573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906113249190611c1a565b505b60035460009061133a633b9aca0087611c3c565b6113449190611c53565b9050806006546113549190611c07565b60065560035460009061136b633b9aca008b611c3c565b6113759190611c53565b9050806009546113859190611c07565b600955600354600090633b9aca009061139e9085611c3c565b6113a89190611c53565b90506000633b9aca00600354846113bf9190611c3c565b6113c99190611c53565b90506113d58288611c07565b6113df8287611c07565b995099505050505050505050915091565b60006113fc3383611722565b611406838361183d565b60405182815273ffffffffffffffffffffffffffffffffffffffff84169033907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef9060200160405180910390a350600192915050565b600d5473ffffffffffffffffffffffffffffffffffffffff1633146114e95760405162461bcd60e51b815260206004820152602260248201527f6f6e6c792074726561737572792063616e20636c61696d20686973207265776160448201527f72640000000000000000000000000000000000000000000000000000000000006064820152608401610216565b600d54600f5460405160009273ffffffffffffffffffffffffffffffffffffffff1691908381818185875af1925050503d8060008114611545576040519150601f19603f3d011682016040523d82523d6000602084013e61154a565b606091505b505090508061159b5760405162461bcd60e51b815260206004820152601260248201527f6661696c656420746f2073656e642041544e00000000000000000000000000006044820152606401610216565b506000600f55565b73ffffffffffffffffffffffffffffffffffffffff831661162b5760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f2061646460448201527f72657373000000000000000000000000000000000000000000000000000000006064820152608401610216565b73ffffffffffffffffffffffffffffffffffffffff82166116b45760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f20616464726560448201527f73730000000000000000000000000000000000000000000000000000000000006064820152608401610216565b73ffffffffffffffffffffffffffffffffffffffff83811660008181526002602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b61172b82611896565b505073ffffffffffffffffffffffffffffffffffffffff8216600090815260208181526040808320546001909252909120546117679082611bf4565b8211156117b65760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e7420756e6c6f636b65642066756e647300000000006044820152606401610216565b6117c08282611bf4565b73ffffffffffffffffffffffffffffffffffffffff84166000908152602081905260409020558082036118215773ffffffffffffffffffffffffffffffffffffffff8316600090815260056020908152604080832083905560089091528120555b81600360008282546118339190611bf4565b9091555050505050565b61184682611896565b505073ffffffffffffffffffffffffffffffffffffffff82166000908152602081905260408120805483929061187d908490611c07565b9250508190555080600360008282546109d59190611c07565b73ffffffffffffffffffffffffffffffffffffffff811660009081526020818152604080832054600654600590935290832054839283916118d89184916119d2565b73ffffffffffffffffffffffffffffffffffffffff861660009081526004602052604090205490915061190c908290611c07565b73ffffffffffffffffffffffffffffffffffffffff8616600090815260046020908152604080832084905560065460058352818420556009546008909252822054929650909161195d9185916119d2565b73ffffffffffffffffffffffffffffffffffffffff8716600090815260076020526040902054909150611991908290611c07565b73ffffffffffffffffffffffffffffffffffffffff909616600090815260076020908152604080832089905560095460089092529091205550929492505050565b6000836000036119e45750600061085d565b633b9aca00846119f48486611bf4565b6119fe9190611c3c565b611a089190611c53565b949350505050565b600060208083528351808285015260005b81811015611a3d57858101830151858201604001528201611a21565b5060006040828601015260407fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe0601f8301168501019250505092915050565b803573ffffffffffffffffffffffffffffffffffffffff81168114611aa057600080fd5b919050565b60008060408385031215611ab857600080fd5b611ac183611a7c565b946020939093013593505050565b600060208284031215611ae157600080fd5b5035919050565b600080600060608486031215611afd57600080fd5b611b0684611a7c565b9250611b1460208501611a7c565b9150604084013590509250925092565b600060208284031215611b3657600080fd5b61085d82611a7c565b60008060408385031215611b5257600080fd5b611b5b83611a7c565b9150611b6960208401611a7c565b90509250929050565b600181811c90821680611b8657607f821691505b602082108103611bbf577f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b8181038181111561068957610689611bc5565b8082018082111561068957610689611bc5565b600060208284031215611c2c57600080fd5b8151801515811461085d57600080fd5b808202811582820484141761068957610689611bc5565b600082611c89577f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b50049056fea2646970667358221220c40cfeb7839f47ede12ce7d3fcaa82248ce59a08c7888588cde0dd0aa0aae0aa64736f6c63430008150033608060405234801561001057600080fd5b5060405161061038038061061083398101604081905261002f916101af565b806001600160a01b0316632f2c3f2e6040518163ffffffff1660e01b8152600401602060405180830381865afa15801561006d573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610091919061029f565b83111561009d57600080fd5b600c80546001600160a01b038088166001600160a01b031992831617909255600d805492871692909116919091179055600e8390556040516100e39083906020016102b8565b604051602081830303815290604052600a9081610100919061036e565b508160405160200161011291906102b8565b604051602081830303815290604052600b908161012f919061036e565b5050601080546001600160a01b031916331790555061042d92505050565b6001600160a01b038116811461016257600080fd5b50565b80516101708161014d565b919050565b634e487b7160e01b600052604160045260246000fd5b60005b838110156101a657818101518382015260200161018e565b50506000910152565b600080600080600060a086880312156101c757600080fd5b85516101d28161014d565b60208701519095506101e38161014d565b6040870151606088015191955093506001600160401b038082111561020757600080fd5b818801915088601f83011261021b57600080fd5b81518181111561022d5761022d610175565b604051601f8201601f19908116603f0116810190838211818310171561025557610255610175565b816040528281528b602084870101111561026e57600080fd5b61027f83602083016020880161018b565b809650505050505061029360808701610165565b90509295509295909350565b6000602082840312156102b157600080fd5b5051919050565b644c4e544e2d60d81b8152600082516102d881600585016020870161018b565b9190910160050192915050565b600181811c908216806102f957607f821691505b60208210810361031957634e487b7160e01b600052602260045260246000fd5b50919050565b601f82111561036957600081815260208120601f850160051c810160208610156103465750805b601f850160051c820191505b8181101561036557828155600101610352565b5050505b505050565b81516001600160401b0381111561038757610387610175565b61039b8161039584546102e5565b8461031f565b602080601f8311600181146103d057600084156103b85750858301515b600019600386901b1c1916600185901b178555610365565b600085815260208120601f198616915b828110156103ff578886015182559484019460019091019084016103e0565b508582101561041d5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b6101d48061043c6000396000f3fe60806040523661001b57610019610014610022565b61013e565b005b6100196100145b600080601060009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff166396f18a326040518163ffffffff1660e01b8152600401602060405180830381865afa158015610092573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906100b69190610161565b905073ffffffffffffffffffffffffffffffffffffffff8116610139576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152601d60248201527f6c6971756964206c6f67696320636f6e7472616374206e6f7420736574000000604482015260640160405180910390fd5b919050565b3660008037600080366000845af43d6000803e8061015b573d6000fd5b503d6000f35b60006020828403121561017357600080fd5b815173ffffffffffffffffffffffffffffffffffffffff8116811461019757600080fd5b939250505056fea264697066735822122076b5b72323e3d9ea33f6c72d4f6af1b0947104c33397f7484304cd24721df42c64736f6c63430008150033")
 
 var AutonityAbi, _ = abi.JSON(strings.NewReader(`[
    {
@@ -443,6 +443,62 @@ var AutonityAbi, _ = abi.JSON(strings.NewReader(`[
       "name" : "CommissionRateChange",
       "type" : "event"
    },
+   {
+      "anonymous" : false,
+      "inputs" : [
+         {
+            "components" : [
+               {
+                  "internalType" : "uint256",
+                  "name" : "proposeInitial",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "proposeDelta",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "prevoteInitial",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "prevoteDelta",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "precommitInitial",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "precommitDelta",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "bool",
+                  "name" : "adaptive",
+                  "type" : "bool"
+               }
+            ],
+            "indexed" : false,
+            "internalType" : "struct Autonity.ConsensusTimeouts",
+            "name" : "timeouts",
+            "type" : "tuple"
+         },
+         {
+            "indexed" : false,
+            "internalType" : "uint256",
+            "name" : "toBeAppliedAtBlock",
+            "type" : "uint256"
+         }
+      ],
+      "name" : "ConsensusTimeoutsUpdated",
+      "type" : "event"
+   },
    {
       "anonymous" : false,
       "inputs" : [
@@ -1279,6 +1335,103 @@ var AutonityAbi, _ = abi.JSON(strings.NewReader(`[
       "stateMutability" : "view",
       "type" : "function"
    },
+   {
+      "inputs" : [],
+      "name" : "getConsensusTimeouts",
+      "outputs" : [
+         {
+            "components" : [
+               {
+                  "internalType" : "uint256",
+                  "name" : "proposeInitial",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "proposeDelta",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "prevoteInitial",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "prevoteDelta",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "precommitInitial",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "precommitDelta",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "bool",
+                  "name" : "adaptive",
+                  "type" : "bool"
+               }
+            ],
+            "internalType" : "struct Autonity.ConsensusTimeouts",
+            "name" : "",
+            "type" : "tuple"
+         },
+         {
+            "components" : [
+               {
+                  "internalType" : "uint256",
+                  "name" : "proposeInitial",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "proposeDelta",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "prevoteInitial",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "prevoteDelta",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "precommitInitial",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "precommitDelta",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "bool",
+                  "name" : "adaptive",
+                  "type" : "bool"
+               }
+            ],
+            "internalType" : "struct Autonity.ConsensusTimeouts",
+            "name" : "",
+            "type" : "tuple"
+         },
+         {
+            "internalType" : "uint256",
+            "name" : "",
+            "type" : "uint256"
+         }
+      ],
+      "stateMutability" : "view",
+      "type" : "function"
+   },
    {
       "inputs" : [
          {
@@ -1580,6 +1733,72 @@ var AutonityAbi, _ = abi.JSON(strings.NewReader(`[
       "stateMutability" : "view",
       "type" : "function"
    },
+   {
+      "inputs" : [
+         {
+            "internalType" : "uint256",
+            "name" : "_unbondingID",
+            "type" : "uint256"
+         }
+      ],
+      "name" : "getUnbondingRequest",
+      "outputs" : [
+         {
+            "components" : [
+               {
+                  "internalType" : "address payable",
+                  "name" : "delegator",
+                  "type" : "address"
+               },
+               {
+                  "internalType" : "address",
+                  "name" : "delegatee",
+                  "type" : "address"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "amount",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "unbondingShare",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "requestBlock",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "revertingAmount",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "enum Autonity.UnbondingReleaseState",
+                  "name" : "state",
+                  "type" : "uint8"
+               },
+               {
+                  "internalType" : "bool",
+                  "name" : "unlocked",
+                  "type" : "bool"
+               },
+               {
+                  "internalType" : "bool",
+                  "name" : "selfDelegation",
+                  "type" : "bool"
+               }
+            ],
+            "internalType" : "struct Autonity.UnbondingRequest",
+            "name" : "",
+            "type" : "tuple"
+         }
+      ],
+      "stateMutability" : "view",
+      "type" : "function"
+   },
    {
       "inputs" : [
          {
@@ -1956,6 +2175,56 @@ var AutonityAbi, _ = abi.JSON(strings.NewReader(`[
       "stateMutability" : "nonpayable",
       "type" : "function"
    },
+   {
+      "inputs" : [
+         {
+            "components" : [
+               {
+                  "internalType" : "uint256",
+                  "name" : "proposeInitial",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "proposeDelta",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "prevoteInitial",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "prevoteDelta",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "precommitInitial",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "precommitDelta",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "bool",
+                  "name" : "adaptive",
+                  "type" : "bool"
+               }
+            ],
+            "internalType" : "struct Autonity.ConsensusTimeouts",
+            "name" : "_timeouts",
+            "type" : "tuple"
+         }
+      ],
+      "name" : "setConsensusTimeouts",
+      "outputs" : [],
+      "stateMutability" : "nonpayable",
+      "type" : "function"
+   },
    {
       "inputs" : [
          {
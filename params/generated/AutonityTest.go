@@ -4,7 +4,7 @@ import "strings"
 import "github.com/autonity/autonity/accounts/abi"
 import "github.com/autonity/autonity/common"
 
-var AutonityTestBytecode = common.Hex2Bytes("608060405261c35060045561c35060055561c350600655614e2060075560006010556000601155633b9aca006037553480156200003b57600080fd5b506040516200e89a3803806200e89a8339810160408190526200005e91620010fb565b818160128001546000036200008b57603d80546001600160a01b031916331790556200008b828262000095565b50505050620015c2565b80518051601255602080820151601355604080830151601455606080840151601555608080850151601681905560a095860151601780546001600160a01b03199081166001600160a01b03938416179091558689015180516018805484169185169190911790558088015160198054841691851691909117905580870151601a8054841691851691909117905580860151601b8054841691851691909117905593840151601c8054831691841691909117905596830151601d8054891691831691909117905560c0830151601e8054891691831691909117905560e090920151601f805488169184169190911790558387015180518654909716969092169590951784559283015160218190558383015160225592810151602355840151602455602891909155603c9190915551620001ce9062000d49565b604051809103906000f080158015620001eb573d6000803e3d6000fd5b50603e80546001600160a01b0319166001600160a01b039290921691909117905560005b8251811015620004d257600083828151811062000230576200023062001338565b602002602001015160a001519050600084838151811062000255576200025562001338565b60200260200101516101a001818152505060008483815181106200027d576200027d62001338565b602002602001015161018001906001600160a01b031690816001600160a01b0316815250506000848381518110620002b957620002b962001338565b602002602001015160a00181815250506000848381518110620002e057620002e062001338565b60209081029190910101516101c0015260145484518590849081106200030a576200030a62001338565b60200260200101516080018181525050600084838151811062000331576200033162001338565b6020026020010151610260019060038111156200035257620003526200134e565b908160038111156200036857620003686200134e565b81525050600084838151811062000383576200038362001338565b6020026020010151610160018181525050620003c1848381518110620003ad57620003ad62001338565b6020026020010151620004d760201b60201c565b620003ee848381518110620003da57620003da62001338565b60200260200101516200061260201b60201c565b806039600086858151811062000408576200040862001338565b6020026020010151600001516001600160a01b03166001600160a01b0316815260200190815260200160002060008282546200044591906200137a565b9250508190555080603b60008282546200046091906200137a565b92505081905550620004ba84838151811062000480576200048062001338565b60200260200101516020015182868581518110620004a257620004a262001338565b602002602001015160000151620008bf60201b60201c565b50508080620004c99062001396565b9150506200020f565b505050565b6000620004ee826060015162000ad660201b60201c565b6001600160a01b03909116602084015290508015620005425760405162461bcd60e51b815260206004820152600b60248201526a32b737b2329032b93937b960a91b60448201526064015b60405180910390fd5b6020808301516001600160a01b039081166000908152603a9092526040909120600101541615620005b65760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c7265616479207265676973746572656400000000604482015260640162000539565b612710826080015111156200060e5760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e2072617465000000000000000000604482015260640162000539565b5050565b6101808101516001600160a01b03166200071157603e546001600160a01b03166200068b5760405162461bcd60e51b815260206004820152602260248201527f6c6971756964206c6f67696320636f6e7472616374206e6f74206465706c6f79604482015261195960f21b606482015260840162000539565b6025546000906200069c9062000b24565b905081602001518260000151836080015183603e60009054906101000a90046001600160a01b0316604051620006d29062000d57565b620006e2959493929190620013b2565b604051809103906000f080158015620006ff573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051602580546001808201835560009283527f401968ff42a154441da5f6c4c935ac46b8671f0e062baaa62a7545ba53bb6e4c90910180546001600160a01b03199081166001600160a01b0395861617909155845184168352603a90955260409182902086518154871690851617815593519084018054861691841691909117905584015160028301805490941691161790915560608201518291906003820190620007c390826200149c565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c820180546001600160a01b0319166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f8201556102008201516010820155610220820151601182015561024082015160128201906200088d90826200149c565b5061026082015160138201805460ff19166001836003811115620008b557620008b56200134e565b0217905550505050565b60008083116200091e5760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201526269766560e81b606482015260840162000539565b6001600160a01b038216600090815260396020526040902054831115620009885760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e63650000000000604482015260640162000539565b6001600160a01b03821660009081526039602052604081208054859290620009b290849062001568565b9091555050604080516080810182526001600160a01b03808516825286811660208084019182528385018881524360608601908152600a805460009081526008909452968320865181549087166001600160a01b03199182161782559451600182018054919097169516949094179094555160028301559151600390910155825491929062000a418362001396565b90915550506001600160a01b038581166000818152603a6020908152604091829020548251908516948816948514808252918101899052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d910160405180910390a3833b1562000abb5762000abb848762000c44565b6001600a5462000acc919062001568565b9695505050505050565b60008062000ae362000d65565b60008060ff9050604083875160208901845afa62000b0057600080fd5b505080516020909101516c0100000000000000000000000090910494909350915050565b60608160000362000b4c5750506040805180820190915260018152600360fc1b602082015290565b8160005b811562000b7c578062000b638162001396565b915062000b749050600a8362001594565b915062000b50565b6000816001600160401b0381111562000b995762000b9962000d83565b6040519080825280601f01601f19166020018201604052801562000bc4576020820181803683370190505b5090505b841562000c3c5762000bdc60018362001568565b915062000beb600a86620015ab565b62000bf89060306200137a565b60f81b81838151811062000c105762000c1062001338565b60200101906001600160f81b031916908160001a90535062000c34600a8662001594565b945062000bc8565b949350505050565b6001600160a01b038216600090815260346020526040812054900362000cc2576001600160a01b03821660008181526034602052604081206001908190556035805491820181559091527fcfa4bec1d3298408bb5afcfcd9c430549c5b31f8aa5c5848151c0a55f473c34d0180546001600160a01b03191690911790555b6001600160a01b03808316600090815260326020908152604080832093851683529290529081205490036200060e576001600160a01b03808316600081815260326020908152604080832094861680845294825280832060019081905593835260338252822080549384018155825290200180546001600160a01b03191690911790555050565b611cf6806200c59483390190565b610610806200e28a83390190565b60405180604001604052806002906020820280368337509192915050565b634e487b7160e01b600052604160045260246000fd5b604051608081016001600160401b038111828210171562000dbe5762000dbe62000d83565b60405290565b60405160c081016001600160401b038111828210171562000dbe5762000dbe62000d83565b60405161028081016001600160401b038111828210171562000dbe5762000dbe62000d83565b604051601f8201601f191681016001600160401b038111828210171562000e3a5762000e3a62000d83565b604052919050565b6001600160a01b038116811462000e5857600080fd5b50565b805162000e688162000e42565b919050565b60005b8381101562000e8a57818101518382015260200162000e70565b50506000910152565b600082601f83011262000ea557600080fd5b81516001600160401b0381111562000ec15762000ec162000d83565b62000ed6601f8201601f191660200162000e0f565b81815284602083860101111562000eec57600080fd5b62000c3c82602083016020870162000e6d565b80516004811062000e6857600080fd5b600061010080838503121562000f2457600080fd5b604051908101906001600160401b038211818310171562000f495762000f4962000d83565b816040528092508351915062000f5f8262000e42565b81815262000f706020850162000e5b565b602082015262000f836040850162000e5b565b604082015262000f966060850162000e5b565b606082015262000fa96080850162000e5b565b608082015262000fbc60a0850162000e5b565b60a082015262000fcf60c0850162000e5b565b60c082015262000fe260e0850162000e5b565b60e0820152505092915050565b6000608082840312156200100257600080fd5b6200100c62000d99565b905081516200101b8162000e42565b8082525060208201516020820152604082015160408201526060820151606082015292915050565b60008183036102608112156200105857600080fd5b6200106262000d99565b915060c08112156200107357600080fd5b506200107e62000dc4565b825181526020830151602082015260408301516040820152606083015160608201526080830151608082015260a0830151620010ba8162000e42565b60a08201528152620010d08360c0840162000f0f565b6020820152620010e5836101c0840162000fef565b6040820152610240820151606082015292915050565b6000806102808084860312156200111157600080fd5b83516001600160401b03808211156200112957600080fd5b818601915086601f8301126200113e57600080fd5b815160208282111562001155576200115562000d83565b8160051b6200116682820162000e0f565b928352848101820192828101908b8511156200118157600080fd5b83870192505b848310156200131657825186811115620011a057600080fd5b8701808d03601f1901891315620011b657600080fd5b620011c062000de9565b620011cd86830162000e5b565b8152620011dd6040830162000e5b565b86820152620011ef6060830162000e5b565b60408201526080820151888111156200120757600080fd5b620012178f888386010162000e93565b60608301525060a0820151608082015260c082015160a082015260e082015160c082015261010082015160e0820152610120820151610100820152610140820151610120820152610160820151610140820152610180820151610160820152620012856101a0830162000e5b565b6101808201526101c08201516101a08201526101e08201516101c08201526102008201516101e082015261022082015161020082015261024082015161022082015261026082015188811115620012db57600080fd5b620012eb8f888386010162000e93565b61024083015250620012ff8a830162000eff565b610260820152835250918301919083019062001187565b8099505050506200132a89828a0162001043565b955050505050509250929050565b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052602160045260246000fd5b634e487b7160e01b600052601160045260246000fd5b8082018082111562001390576200139062001364565b92915050565b600060018201620013ab57620013ab62001364565b5060010190565b600060018060a01b038088168352808716602084015285604084015260a0606084015284518060a0850152620013f08160c086016020890162000e6d565b9316608083015250601f91909101601f19160160c001949350505050565b600181811c908216806200142357607f821691505b6020821081036200144457634e487b7160e01b600052602260045260246000fd5b50919050565b601f821115620004d257600081815260208120601f850160051c81016020861015620014735750805b601f850160051c820191505b8181101562001494578281556001016200147f565b505050505050565b81516001600160401b03811115620014b857620014b862000d83565b620014d081620014c984546200140e565b846200144a565b602080601f831160018114620015085760008415620014ef5750858301515b600019600386901b1c1916600185901b17855562001494565b600085815260208120601f198616915b82811015620015395788860151825594840194600190910190840162001518565b5085821015620015585787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b8181038181111562001390576200139062001364565b634e487b7160e01b600052601260045260246000fd5b600082620015a657620015a66200157e565b500490565b600082620015bd57620015bd6200157e565b500690565b61afc280620015d26000396000f3fe608060405260043610620006535760003560e01c8063852c4849116200033f578063b66b3e7911620001bb578063d861b0e811620000ff578063e7f43c6811620000a7578063f03b959d1162000081578063f03b959d14620013b6578063f1d592a714620013db578063f5502f221462001400578063f7866ee314620014d457005b8063e7f43c681462001359578063e98712b21462001379578063ee7ff28d146200139157005b8063de7011b111620000d9578063de7011b11462001304578063dfb1a4d21462001329578063dfd4b688146200134157005b8063d861b0e8146200127d578063d886f8a21462001295578063dd62ed3e14620012ba57005b8063ceaad4551162000163578063cfd19fb9116200013d578063cfd19fb91462001211578063d372c07e1462001236578063d5f39488146200125b57005b8063ceaad45514620011bc578063cef9845014620011e1578063cf9c571914620011f957005b8063bb0db4281162000195578063bb0db4281462001168578063c9d97af4146200117f578063cb696f54146200119757005b8063b66b3e7914620010f4578063b6ef8c67146200111c578063b7ab4db5146200114157005b8063a5d059ca1162000283578063ae1da0b5116200022b578063b2ea9adb1162000205578063b2ea9adb1462001085578063b3ecbadd14620010aa578063b46e552014620010cf57005b8063ae1da0b51462001020578063ae1f5fa01462001038578063affb1cf1146200106057005b8063a9af1059116200025d578063a9af10591462000f89578063a9fd1a8f1462000fcf578063ab8f6ffe1462000ff957005b8063a5d059ca1462000f26578063a8b2216e1462000f3d578063a9059cbb1462000f6457005b806396f18a3211620002e75780639dc29fac11620002c15780639dc29fac1462000ed35780639efa9e7a1462000ef8578063a515366a1462000f0f57005b806396f18a321462000e815780639ac310741462000ea35780639c98e4711462000ebb57005b80638bac7dad11620003195780638bac7dad1462000def57806395d89b411462000e1457806396b477cb1462000e5c57005b8063852c48491462000d9a578063872cf0591462000dbf57806389c614b81462000dd757005b806340c10f1911620004cf5780636bb9cb0e1162000413578063784304b511620003bb578063819b64631162000395578063819b64631462000d2a578063833b1fce1462000d4157806384467fdb1462000d7557005b8063784304b51462000be257806379502c551462000c075780637e660ac91462000d0557005b806371d1bc5911620003ed57806371d1bc591462000b7c578063731b3a031462000b9457806377e741c71462000bbd57005b80636bb9cb0e1462000b135780636fd2c80b1462000b2b57806370a082311462000b4257005b8063520fdbbc1162000477578063676c24ab1162000451578063676c24ab1462000ab15780636a929cef1462000ac95780636b5f444c1462000aee57005b8063520fdbbc1462000a5c578063573963b81462000a815780635bdedd161462000a9957005b8063496ccd9b11620004a9578063496ccd9b14620009f55780634bb278f31462000a1a5780634efcd15f1462000a4557005b806340c10f1914620009a2578063427bc5de14620009c75780634364596914620009de57005b80631904bb2e11620005975780632f2c3f2e116200053f57806335be16e0116200051957806335be16e0146200094e578063386a827b14620009735780633d0ae216146200098b57005b80632f2c3f2e14620008e457806330bcb81c14620008fc578063313ce567146200093057005b806325ce1bb9116200057157806325ce1bb9146200088c5780632701849b14620008b557806329070c6d14620008cd57005b80631904bb2e146200080e5780631a0cf2e5146200084257806323b872dd146200086757005b80631122063311620005fb5780631604e41611620005d55780631604e41614620007df578063161605e3146200065b57806318160ddd14620007f757005b806311220633146200077e578063114eaf5514620007955780631250a28d14620007ba57005b80630b21fb1d116200062d5780630b21fb1d14620007135780630d8e6e2c14620007385780630fe50109146200075957005b806306fdde03146200065d578063095ea7b314620006b85780630ae65e7a14620006ee57005b366200065b57005b005b3480156200066a57600080fd5b5060408051808201909152600681527f4e6577746f6e000000000000000000000000000000000000000000000000000060208201525b604051620006af9190620096e5565b60405180910390f35b348015620006c557600080fd5b50620006dd620006d736600462009717565b620014f4565b6040519015158152602001620006af565b348015620006fb57600080fd5b506200065b6200070d36600462009746565b6200150d565b3480156200072057600080fd5b506200065b6200073236600462009766565b62001633565b3480156200074557600080fd5b506024545b604051908152602001620006af565b3480156200076657600080fd5b506200065b6200077836600462009766565b62001694565b3480156200078b57600080fd5b506013546200074a565b348015620007a257600080fd5b506200065b620007b436600462009766565b620016f5565b348015620007c757600080fd5b506200065b620007d936600462009746565b62001756565b348015620007ec57600080fd5b506200074a602f5481565b3480156200080457600080fd5b50603b546200074a565b3480156200081b57600080fd5b50620008336200082d36600462009746565b620017e3565b604051620006af9190620097f6565b3480156200084f57600080fd5b506200065b6200086136600462009746565b62001ac0565b3480156200087457600080fd5b50620006dd620008863660046200996f565b62001b4b565b3480156200089957600080fd5b506026546000908152602c60205260409020600301546200074a565b348015620008c257600080fd5b506200065b62001c65565b348015620008da57600080fd5b506012546200074a565b348015620008f157600080fd5b506200074a61271081565b3480156200090957600080fd5b50620009216200091b36600462009766565b62001cf1565b604051620006af9190620099b5565b3480156200093d57600080fd5b5060405160128152602001620006af565b3480156200095b57600080fd5b506200065b6200096d36600462009a3c565b62001dc5565b3480156200098057600080fd5b506200074a60055481565b3480156200099857600080fd5b50600e546200074a565b348015620009af57600080fd5b506200065b620009c136600462009717565b62001fbb565b348015620009d457600080fd5b50600a546200074a565b348015620009eb57600080fd5b506022546200074a565b34801562000a0257600080fd5b506200065b62000a1436600462009746565b62002027565b34801562000a2757600080fd5b5062000a326200219f565b604051620006af95949392919062009afd565b34801562000a5257600080fd5b50602b546200074a565b34801562000a6957600080fd5b506200065b62000a7b36600462009746565b62002aeb565b34801562000a8e57600080fd5b506200074a60285481565b34801562000aa657600080fd5b506200074a60075481565b34801562000abe57600080fd5b506200065b62002d9f565b34801562000ad657600080fd5b506200065b62000ae836600462009766565b6200362c565b34801562000afb57600080fd5b506200065b62000b0d36600462009766565b6200368d565b34801562000b2057600080fd5b506200074a60375481565b34801562000b3857600080fd5b506015546200074a565b34801562000b4f57600080fd5b506200074a62000b6136600462009746565b6001600160a01b031660009081526039602052604090205490565b34801562000b8957600080fd5b506200074a60065481565b34801562000ba157600080fd5b506026546000908152602c60205260409020600201546200074a565b34801562000bca57600080fd5b506200065b62000bdc36600462009766565b62003742565b34801562000bef57600080fd5b506200065b62000c0136600462009bfa565b620037a3565b34801562000c1457600080fd5b506040805160c080820183526012548252601354602080840191909152601454838501526015546060808501919091526016546080808601919091526017546001600160a01b0390811660a08088019190915287516101008101895260185483168152601954831681870152601a548316818a0152601b54831681860152601c54831681850152601d54831691810191909152601e54821695810195909552601f54811660e086015286519182018752835416815260215492810192909252602254948201949094526023549381019390935260245462000cf3939084565b604051620006af949392919062009c50565b34801562000d1257600080fd5b506200065b62000d2436600462009766565b62003a0d565b34801562000d3757600080fd5b506023546200074a565b34801562000d4e57600080fd5b506019546001600160a01b03165b6040516001600160a01b039091168152602001620006af565b34801562000d8257600080fd5b506200065b62000d9436600462009d52565b62003a6e565b34801562000da757600080fd5b506200065b62000db936600462009717565b62003b9d565b34801562000dcc57600080fd5b506200065b62003dbf565b34801562000de457600080fd5b506200074a602a5481565b34801562000dfc57600080fd5b506200065b62000e0e36600462009766565b62003e2a565b34801562000e2157600080fd5b5060408051808201909152600381527f4e544e00000000000000000000000000000000000000000000000000000000006020820152620006a0565b34801562000e6957600080fd5b506200074a62000e7b36600462009766565b62003edd565b34801562000e8e57600080fd5b50603e5462000d5c906001600160a01b031681565b34801562000eb057600080fd5b506200074a603c5481565b34801562000ec857600080fd5b506200074a602b5481565b34801562000ee057600080fd5b506200065b62000ef236600462009717565b62003f8c565b34801562000f0557600080fd5b50600d546200074a565b6200074a62000f2036600462009717565b620040df565b6200074a62000f3736600462009717565b6200422b565b34801562000f4a57600080fd5b5062000f556200433b565b604051620006af919062009dfb565b34801562000f7157600080fd5b50620006dd62000f8336600462009717565b6200441e565b34801562000f9657600080fd5b5062000fc062000fa836600462009766565b6000908152600b602052604090206006015460ff1690565b604051620006af919062009e7f565b34801562000fdc57600080fd5b5062000fe762004476565b604051620006af949392919062009e94565b3480156200100657600080fd5b5062001011620045f3565b604051620006af919062009ec5565b3480156200102d57600080fd5b506200074a60295481565b3480156200104557600080fd5b506200105062004705565b604051620006af92919062009f20565b3480156200106d57600080fd5b5062000fe76200107f36600462009766565b62004a31565b3480156200109257600080fd5b506200065b620010a436600462009f52565b62004c52565b348015620010b757600080fd5b506200065b620010c936600462009746565b62004cc8565b348015620010dc57600080fd5b506200065b620010ee36600462009746565b62004d53565b3480156200110157600080fd5b506200110c62005028565b604051620006af92919062009fb3565b3480156200112957600080fd5b506200074a6200113b36600462009766565b6200515f565b3480156200114e57600080fd5b5062001159620051f1565b604051620006af919062009fdc565b3480156200117557600080fd5b506009546200074a565b3480156200118c57600080fd5b506200074a60265481565b348015620011a457600080fd5b506200065b620011b636600462009766565b62005255565b348015620011c957600080fd5b506200065b620011db36600462009746565b620052ec565b348015620011ee57600080fd5b506200074a60045481565b3480156200120657600080fd5b506200065b62005377565b3480156200121e57600080fd5b506200065b6200123036600462009746565b620053fb565b3480156200124357600080fd5b506200065b6200125536600462009746565b62005486565b3480156200126857600080fd5b50603d5462000d5c906001600160a01b031681565b3480156200128a57600080fd5b506200065b62005511565b348015620012a257600080fd5b506200065b620012b436600462009746565b6200571b565b348015620012c757600080fd5b506200074a620012d936600462009ff1565b6001600160a01b03918216600090815260316020908152604080832093909416825291909152205490565b3480156200131157600080fd5b506200065b6200132336600462009766565b620057a6565b3480156200133657600080fd5b506200074a6200582d565b3480156200134e57600080fd5b506200065b6200584a565b3480156200136657600080fd5b506020546001600160a01b031662000d5c565b3480156200138657600080fd5b506200074a602e5481565b3480156200139e57600080fd5b506200065b620013b036600462009766565b62005854565b348015620013c357600080fd5b506200065b620013d536600462009746565b620058b5565b348015620013e857600080fd5b506200065b620013fa36600462009746565b62005940565b3480156200140d57600080fd5b506200148e6200141f36600462009766565b6040805160808082018352600080835260208084018290528385018290526060938401829052948152600885528390208351918201845280546001600160a01b039081168352600182015416948201949094526002840154928101929092526003909201549181019190915290565b604051620006af919060006080820190506001600160a01b0380845116835280602085015116602084015250604083015160408301526060830151606083015292915050565b348015620014e157600080fd5b506017546001600160a01b031662000d5c565b60006200150333848462005a49565b5060015b92915050565b6200151762005ba6565b6001600160a01b038082166000818152603a6020526040902060010154909116146200158a5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d75737420626520726567697374657265640000000060448201526064015b60405180910390fd5b6001600160a01b038181166000908152603a60205260409020541633146200161b5760405162461bcd60e51b815260206004820152602c60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72206160448201527f646d696e206163636f756e740000000000000000000000000000000000000000606482015260840162001581565b620016268162005bff565b6200163060008055565b50565b6020546001600160a01b031633146200168f5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001581565b600555565b6020546001600160a01b03163314620016f05760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001581565b600455565b6020546001600160a01b03163314620017515760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001581565b601555565b6020546001600160a01b03163314620017b25760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001581565b6018805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b565b620017ed6200942c565b6001600160a01b038083166000818152603a6020526040902060010154909116146200185c5760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f7420726567697374657265640000000000000000604482015260640162001581565b6001600160a01b038083166000908152603a60209081526040918290208251610280810184528154851681526001820154851692810192909252600281015490931691810191909152600382018054919291606084019190620018bf906200a02f565b80601f0160208091040260200160405190810160405280929190818152602001828054620018ed906200a02f565b80156200193e5780601f1062001912576101008083540402835291602001916200193e565b820191906000526020600020905b8154815290600101906020018083116200192057829003601f168201915b505050918352505060048201546020820152600582015460408201526006820154606082015260078201546080820152600882015460a0820152600982015460c0820152600a82015460e0820152600b820154610100820152600c8201546001600160a01b0316610120820152600d820154610140820152600e820154610160820152600f82015461018082015260108201546101a082015260118201546101c08201526012820180546101e090920191620019fa906200a02f565b80601f016020809104026020016040519081016040528092919081815260200182805462001a28906200a02f565b801562001a795780601f1062001a4d5761010080835404028352916020019162001a79565b820191906000526020600020905b81548152906001019060200180831162001a5b57829003601f168201915b5050509183525050601382015460209091019060ff16600381111562001aa35762001aa362009780565b600381111562001ab75762001ab762009780565b90525092915050565b6020546001600160a01b0316331462001b1c5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001581565b601f805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6001600160a01b03831660009081526031602090815260408083203384529091528120548281101562001be75760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e742065786365656473206160448201527f6c6c6f77616e6365000000000000000000000000000000000000000000000000606482015260840162001581565b62001bf485858562005cef565b62001c0c853362001c0686856200a0b3565b62005a49565b836001600160a01b0316856001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8560405162001c5291815260200190565b60405180910390a3506001949350505050565b603d546001600160a01b0316331462001ce75760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c0000000000000000000000000000000000000000000000000000000000606482015260840162001581565b620017e162005dbc565b62001cfb620094fc565b6000828152600b602090815260409182902082516101208101845281546001600160a01b03908116825260018301541692810192909252600281015492820192909252600380830154606083015260048301546080830152600583015460a0830152600683015491929160c084019160ff9091169081111562001d825762001d8262009780565b600381111562001d965762001d9662009780565b81526006919091015460ff61010082048116151560208401526201000090910416151560409091015292915050565b6018546001600160a01b0316331462001e475760405162461bcd60e51b815260206004820152602360248201527f63616c6c6572206973206e6f742074686520736c617368696e6720636f6e747260448201527f6163740000000000000000000000000000000000000000000000000000000000606482015260840162001581565b6000610120820135603a8262001e64604086016020870162009746565b6001600160a01b03166001600160a01b031681526020019081526020016000206009015462001e9491906200a0b3565b60c0830135603a600062001eaf604087016020880162009746565b6001600160a01b03166001600160a01b031681526020019081526020016000206006015462001edf91906200a0b3565b60a0840135603a600062001efa604088016020890162009746565b6001600160a01b03166001600160a01b031681526020019081526020016000206005015462001f2a91906200a0b3565b62001f3691906200a0c9565b62001f4291906200a0c9565b6017546001600160a01b031660009081526039602052604081208054929350839290919062001f739084906200a0c9565b90915550829050603a600062001f90604084016020850162009746565b6001600160a01b03168152602081019190915260400160002062001fb582826200a2a4565b50505050565b6020546001600160a01b03163314620020175760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001581565b62002023828262005efd565b5050565b6020546001600160a01b03163314620020835760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001581565b6019805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b03838116918217909255601a546040517f7adbf973000000000000000000000000000000000000000000000000000000008152600481019290925290911690637adbf97390602401600060405180830381600087803b1580156200210857600080fd5b505af11580156200211d573d6000803e3d6000fd5b5050601c546040517f7adbf9730000000000000000000000000000000000000000000000000000000081526001600160a01b0385811660048301529091169250637adbf97391506024015b600060405180830381600087803b1580156200218357600080fd5b505af115801562002198573d6000803e3d6000fd5b5050505050565b603d546000908190606090829081906001600160a01b031633146200222d5760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c0000000000000000000000000000000000000000000000000000000000606482015260840162001581565b6200223762005ba6565b4360298190556026546000828152602760209081526040808320849055928252602c90528190206003015460185491517f6c9789b000000000000000000000000000000000000000000000000000000000815292101560048301819052916001600160a01b0390911690636c9789b090602401600060405180830381600087803b158015620022c557600080fd5b505af1158015620022da573d6000803e3d6000fd5b5050505080156200287157601e54603b54603c54602a546040517f92eff3cd0000000000000000000000000000000000000000000000000000000081526004810193909352602483019190915260448201524260648201526000916001600160a01b0316906392eff3cd90608401602060405180830381865afa15801562002366573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906200238c91906200a489565b905080603c5410156200239e5750603c545b620023aa308262005efd565b80603c6000828254620023be91906200a0b3565b9091555050601f54604080517ff968f49300000000000000000000000000000000000000000000000000000000815281516001600160a01b039093169263f968f4939260048084019391929182900301816000875af192505050801562002444575060408051601f3d908101601f1916820190925262002441918101906200a4a3565b60015b62002482576040514281527ff1217bb194383aa5c937c81cbbb445990a478c7511b080df329f9b0fb64233c99060200160405180910390a1620024b5565b6017546200249a906001600160a01b03168262005efd565b601f54620024b2906001600160a01b03168362005efd565b50505b30600090815260396020526040902054620024d290479062005f83565b620024dc620064fb565b620024e6620067be565b620024f0620068ad565b620024fa62005dbc565b6000806200250762004705565b6019546040517f845023f20000000000000000000000000000000000000000000000000000000081529294509092506001600160a01b03169063845023f2906200255690859060040162009fdc565b600060405180830381600087803b1580156200257157600080fd5b505af115801562002586573d6000803e3d6000fd5b50506018546040517fe08b14ed0000000000000000000000000000000000000000000000000000000081526001600160a01b03909116925063e08b14ed9150620025d590849060040162009fdc565b600060405180830381600087803b158015620025f057600080fd5b505af115801562002605573d6000803e3d6000fd5b50506026546000908152602c6020526040902060020154602854602154919350148015915062002636575060285415155b15620026bd5760285460218190556018546040517f6b5f444c00000000000000000000000000000000000000000000000000000000815260048101929092526001600160a01b031690636b5f444c90602401600060405180830381600087803b158015620026a357600080fd5b505af1158015620026b8573d6000803e3d6000fd5b505050505b602154600090620026cf90436200a0c9565b905042602a81905550600160266000828254620026ed91906200a0c9565b909155505060265460408051602d805460a06020820284018101909452608083018181526200282f9594849391929060009085015b8282101562002810576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162002778906200a02f565b80601f0160208091040260200160405190810160405280929190818152602001828054620027a6906200a02f565b8015620027f75780601f10620027cb57610100808354040283529160200191620027f7565b820191906000526020600020905b815481529060010190602001808311620027d957829003601f168201915b5050505050815250508152602001906001019062002722565b505050508152602001858152602001438152602001848152506200694c565b7febad8099c467528a56c98b63c8d476d251cf1ffb4c75db94b4d23fa2b6a1e3356026546040516200286391815260200190565b60405180910390a150505050505b601954604080517f4bb278f300000000000000000000000000000000000000000000000000000000815290516000926001600160a01b031691634bb278f3916004808301926020929190829003018187875af1158015620028d6573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190620028fc91906200a4c8565b905080156200299157601a54604080517fa2e6204500000000000000000000000000000000000000000000000000000000815290516001600160a01b039092169163a2e620459160048082019260209290919082900301816000875af192505050801562002989575060408051601f3d908101601f1916820190925262002986918101906200a4c8565b60015b156200299157505b600380546026546000908152602c602090815260408083206001810154950154602d805483518186028101860190945280845260ff909616968996919590949293928692919084015b8282101562002ac8576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162002a30906200a02f565b80601f016020809104026020016040519081016040528092919081815260200182805462002a5e906200a02f565b801562002aaf5780601f1062002a835761010080835404028352916020019162002aaf565b820191906000526020600020905b81548152906001019060200180831162002a9157829003601f168201915b50505050508152505081526020019060010190620029da565b50505050925096509650965096509650505062002ae460008055565b9091929394565b6020546001600160a01b0316331462002b475760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001581565b6020805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b038381169182179092556019546040517fb3ab15fb00000000000000000000000000000000000000000000000000000000815260048101929092529091169063b3ab15fb90602401600060405180830381600087803b15801562002bcc57600080fd5b505af115801562002be1573d6000803e3d6000fd5b5050601a546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b15801562002c4657600080fd5b505af115801562002c5b573d6000803e3d6000fd5b5050601b546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b15801562002cc057600080fd5b505af115801562002cd5573d6000803e3d6000fd5b5050601c546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b15801562002d3a57600080fd5b505af115801562002d4f573d6000803e3d6000fd5b5050601d546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb915060240162002168565b62002da9620067be565b60008062002db662004705565b915091506000825167ffffffffffffffff81111562002dd95762002dd962009b36565b60405190808252806020026020018201604052801562002e03578160200160208202803683370190505b50602354602d549192506000918291101562002e885760405162461bcd60e51b815260206004820152602760248201527f636f6d6d69747465652073697a652065786365656473204d6178436f6d6d697460448201527f74656553697a6500000000000000000000000000000000000000000000000000606482015260840162001581565b60005b602d548110156200345d576000602d828154811062002eae5762002eae6200a4ec565b60009182526020909120600390910201546001600160a01b031690508062002f195760405162461bcd60e51b815260206004820152600f60248201527f696e76616c696420616464726573730000000000000000000000000000000000604482015260640162001581565b8085838151811062002f2f5762002f2f6200a4ec565b60200260200101906001600160a01b031690816001600160a01b0316815250506000602d838154811062002f675762002f676200a4ec565b90600052602060002090600302016001015490506000811162002fcd5760405162461bcd60e51b815260206004820152601460248201527f30207374616b6520696e20636f6d6d6974746565000000000000000000000000604482015260640162001581565b62002fd981866200a0c9565b94508215620030345780841015620030345760405162461bcd60e51b815260206004820152601c60248201527f636f6d6d6974746565206d656d62657273206e6f7420736f7274656400000000604482015260640162001581565b6001600160a01b038083166000818152603a602052604090206001810154939650869390921614620030a95760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f7220646f6573206e6f742065786973740000000000000000604482015260640162001581565b81816005015414620030fe5760405162461bcd60e51b815260206004820152600e60248201527f7374616b65206d69736d61746368000000000000000000000000000000000000604482015260640162001581565b8884815181106200311357620031136200a4ec565b602090810291909101015160028201546001600160a01b039081169116146200317f5760405162461bcd60e51b815260206004820152601760248201527f6f7261636c652061646472657373206d69736d61746368000000000000000000604482015260640162001581565b8784815181106200319457620031946200a4ec565b60200260200101516001600160a01b0316602d8581548110620031bb57620031bb6200a4ec565b60009182526020909120600390910201546001600160a01b0316146200324a5760405162461bcd60e51b815260206004820152602860248201527f6163636f756e746162696c697479207265706f7274657220616464726573732060448201527f6d69736d61746368000000000000000000000000000000000000000000000000606482015260840162001581565b6000601382015460ff16600381111562003268576200326862009780565b14620032b75760405162461bcd60e51b815260206004820152601460248201527f76616c696461746f72206e6f7420616374697665000000000000000000000000604482015260640162001581565b60308481548110620032cd57620032cd6200a4ec565b90600052602060002001604051602001620032e991906200a596565b60405160208183030381529060405280519060200120816003016040516020016200331591906200a596565b60405160208183030381529060405280519060200120146200337a5760405162461bcd60e51b815260206004820152600e60248201527f656e6f6465206d69736d61746368000000000000000000000000000000000000604482015260640162001581565b602d84815481106200339057620033906200a4ec565b9060005260206000209060030201600201604051602001620033b391906200a596565b6040516020818303038152906040528051906020012081601201604051602001620033df91906200a596565b6040516020818303038152906040528051906020012014620034445760405162461bcd60e51b815260206004820152601660248201527f636f6e73656e737573206b6579206d69736d6174636800000000000000000000604482015260640162001581565b505050808062003454906200a5a4565b91505062002e8b565b50602b548214620034b15760405162461bcd60e51b815260206004820152601460248201527f746f74616c207374616b65206d69736d61746368000000000000000000000000604482015260640162001581565b60005b60255481101562003624576000805b85518110156200354357858181518110620034e257620034e26200a4ec565b60200260200101516001600160a01b0316602584815481106200350957620035096200a4ec565b6000918252602090912001546001600160a01b0316036200352e576001915062003543565b806200353a816200a5a4565b915050620034c3565b506000603a6000602585815481106200356057620035606200a4ec565b60009182526020808320909101546001600160a01b031683528201929092526040018120915082151590036200360c5783816005015411156200360c5760405162461bcd60e51b815260206004820152602360248201527f68696768207374616b6520666f72206e6f6e2d636f6d6d6974746565206d656d60448201527f6265720000000000000000000000000000000000000000000000000000000000606482015260840162001581565b505080806200361b906200a5a4565b915050620034b4565b505050505050565b6020546001600160a01b03163314620036885760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001581565b603755565b6020546001600160a01b03163314620036e95760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001581565b60288190556026546000908152602c6020908152604091829020600301548251848152918201819052917f2eea6438d890c8603d4df81ad1bad2a4ea45c02b4837165f461ff3c81603abc7910160405180910390a15050565b6020546001600160a01b031633146200379e5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001581565b601255565b6001600160a01b038083166000818152603a60205260409020600181015490921614620038135760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f7420726567697374657265640000000000000000604482015260640162001581565b80546001600160a01b03163314620038945760405162461bcd60e51b815260206004820152602f60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72207460448201527f72656173757279206163636f756e740000000000000000000000000000000000606482015260840162001581565b6200389f8362006a29565b15620039145760405162461bcd60e51b815260206004820152602260248201527f76616c696461746f72206d757374206e6f7420626520696e20636f6d6d69747460448201527f6565000000000000000000000000000000000000000000000000000000000000606482015260840162001581565b600080620039228462006a9a565b925090508115620039765760405162461bcd60e51b815260206004820152600b60248201527f656e6f6465206572726f72000000000000000000000000000000000000000000604482015260640162001581565b60018301546001600160a01b03828116911614620039fd5760405162461bcd60e51b815260206004820152602760248201527f76616c696461746f72206e6f646520616464726573732063616e27742062652060448201527f7570646174656400000000000000000000000000000000000000000000000000606482015260840162001581565b600383016200362485826200a5c1565b6020546001600160a01b0316331462003a695760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001581565b600755565b6000604051806102800160405280336001600160a01b0316815260200160006001600160a01b03168152602001856001600160a01b0316815260200186815260200160126000016002015481526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b03168152602001600081526020014381526020016000815260200160008152602001600081526020018481526020016000600381111562003b3d5762003b3d62009780565b9052905062003b4d818362006ae8565b60208101516101808201516040517f8ad8bd2eb6950e5f332fd3a6dca48cb358ecfe3057848902b98cbdfe455c915c9262003b8e92339289918b916200a68e565b60405180910390a15050505050565b6001600160a01b038083166000818152603a60205260409020600101549091161462003c0c5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d757374206265207265676973746572656400000000604482015260640162001581565b6001600160a01b038281166000908152603a602052604090205416331462003c9d5760405162461bcd60e51b815260206004820152602c60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72206160448201527f646d696e206163636f756e740000000000000000000000000000000000000000606482015260840162001581565b61271081111562003cf15760405162461bcd60e51b815260206004820152601f60248201527f7265717569726520636f727265637420636f6d6d697373696f6e207261746500604482015260640162001581565b604080516060810182526001600160a01b038481168252436020808401918252838501868152601180546000908152600f9093529582208551815473ffffffffffffffffffffffffffffffffffffffff191695169490941784559151600180850191909155915160029093019290925583549293909290919062003d779084906200a0c9565b90915550506040518281526001600160a01b038416907f4fba51c92fa3d6ad8374d394f6cd5766857552e153d7384a8f23aa4ce9a8a7cf9060200160405180910390a2505050565b6020546001600160a01b0316331462003e1b5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001581565b6003805460ff19166001179055565b6020546001600160a01b0316331462003e865760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001581565b6000811162003ed85760405162461bcd60e51b815260206004820152601960248201527f636f6d6d69747465652073697a652063616e2774206265203000000000000000604482015260640162001581565b602355565b6000602954600162003ef091906200a0c9565b82111562003f675760405162461bcd60e51b815260206004820152602660248201527f63616e6e6f74206765742065706f636820696420666f7220612066757475726560448201527f20626c6f636b0000000000000000000000000000000000000000000000000000606482015260840162001581565b602954821162003f84575060009081526027602052604090205490565b505060265490565b6020546001600160a01b0316331462003fe85760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001581565b6001600160a01b038216600090815260396020526040902054811115620040525760405162461bcd60e51b815260206004820152601660248201527f416d6f756e7420657863656564732062616c616e636500000000000000000000604482015260640162001581565b6001600160a01b038216600090815260396020526040812080548392906200407c9084906200a0b3565b9250508190555080603b60008282546200409791906200a0b3565b90915550506040518181526001600160a01b038316907f5024dbeedf0c06664c9bd7be836915730c955e936972c020683dadf11d5488a3906020015b60405180910390a25050565b6000620040eb62005ba6565b6001600160a01b038084166000818152603a6020526040902060010154909116146200415a5760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f7420726567697374657265640000000000000000604482015260640162001581565b6001600160a01b0383166000908152603a602052604081206013015460ff1660038111156200418d576200418d62009780565b14620041dc5760405162461bcd60e51b815260206004820152601b60248201527f76616c696461746f72206e65656420746f206265206163746976650000000000604482015260640162001581565b603754620041eb90346200a707565b33600090815260386020526040812080549091906200420c9084906200a0c9565b909155506200421f905083833362006f8b565b90506200150760008055565b60006200423762005ba6565b6001600160a01b038084166000818152603a602052604090206001015490911614620042a65760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f7420726567697374657265640000000000000000604482015260640162001581565b60008211620042f85760405162461bcd60e51b815260206004820152601560248201527f756e626f6e64696e6720616d6f756e7420697320300000000000000000000000604482015260640162001581565b6037546200430790346200a707565b3360009081526038602052604081208054909190620043289084906200a0c9565b909155506200421f9050838333620071c9565b60606030805480602002602001604051908101604052809291908181526020016000905b828210156200441557838290600052602060002001805462004381906200a02f565b80601f0160208091040260200160405190810160405280929190818152602001828054620043af906200a02f565b8015620044005780601f10620043d45761010080835404028352916020019162004400565b820191906000526020600020905b815481529060010190602001808311620043e257829003601f168201915b5050505050815260200190600101906200435f565b50505050905090565b60006200442d33848462005cef565b6040518281526001600160a01b0384169033907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef9060200160405180910390a350600192915050565b6026546000908152602c602090815260408083208151815460a094810282018501909352608081018381526060959485948594859493909284928491879085015b82821015620045a5576000848152602090819020604080516060810182526003860290920180546001600160a01b03168352600181015493830193909352600283018054929392918401916200450d906200a02f565b80601f01602080910402602001604051908101604052809291908181526020018280546200453b906200a02f565b80156200458c5780601f1062004560576101008083540402835291602001916200458c565b820191906000526020600020905b8154815290600101906020018083116200456e57829003601f168201915b50505050508152505081526020019060010190620044b7565b50505090825250600182015460208083019190915260028301546040808401919091526003909301546060928301528351908401519284015193909101519098919750919550909350915050565b6060602d805480602002602001604051908101604052809291908181526020016000905b8282101562004415576000848152602090819020604080516060810182526003860290920180546001600160a01b03168352600181015493830193909352600283018054929392918401916200466d906200a02f565b80601f01602080910402602001604051908101604052809291908181526020018280546200469b906200a02f565b8015620046ec5780601f10620046c057610100808354040283529160200191620046ec565b820191906000526020600020905b815481529060010190602001808311620046ce57829003601f168201915b5050505050815250508152602001906001019062004617565b603d5460609081906001600160a01b031633146200478c5760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c0000000000000000000000000000000000000000000000000000000000606482015260840162001581565b602554620047dd5760405162461bcd60e51b815260206004820152601860248201527f5468657265206d7573742062652076616c696461746f72730000000000000000604482015260640162001581565b620047e76200956e565b602354608082015260258152603a6020820152602d6040820152602b606082015262004813816200764d565b62004821603060006200958c565b602d5480620048735760405162461bcd60e51b815260206004820152601260248201527f636f6d6d697474656520697320656d7074790000000000000000000000000000604482015260640162001581565b60008167ffffffffffffffff81111562004891576200489162009b36565b604051908082528060200260200182016040528015620048bb578160200160208202803683370190505b50905060008267ffffffffffffffff811115620048dc57620048dc62009b36565b60405190808252806020026020018201604052801562004906578160200160208202803683370190505b50905060005b8381101562004a25576000603a6000602d84815481106200493157620049316200a4ec565b60009182526020808320600392830201546001600160a01b031684528301939093526040909101812060308054600181018255925292507f6ff97a59c90d62cc7236ba3a37cd85351bf564556780cf8c1157a220f31f0cbb019062004999908301826200a71e565b50600281015484516001600160a01b0390911690859084908110620049c257620049c26200a4ec565b6001600160a01b03928316602091820292909201015260018201548451911690849084908110620049f757620049f76200a4ec565b6001600160a01b0390921660209283029190910190910152508062004a1c816200a5a4565b9150506200490c565b50909450925050509091565b60606000806000602954600162004a4991906200a0c9565b85111562004ac05760405162461bcd60e51b815260206004820152602360248201527f63616e6e6f74206765742065706f636820666f7220612066757475726520626c60448201527f6f636b0000000000000000000000000000000000000000000000000000000000606482015260840162001581565b602654602954861162004ade57506000858152602760205260409020545b6000818152602c602090815260408083208151815460a09481028201850190935260808101838152909391928492849190879085015b8282101562004c02576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162004b6a906200a02f565b80601f016020809104026020016040519081016040528092919081815260200182805462004b98906200a02f565b801562004be95780601f1062004bbd5761010080835404028352916020019162004be9565b820191906000526020600020905b81548152906001019060200180831162004bcb57829003601f168201915b5050505050815250508152602001906001019062004b14565b5050509082525060018201546020808301919091526002830154604080840191909152600390930154606092830152835190840151928401519390910151909a9199509197509095509350505050565b6020546001600160a01b0316331462004cae5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001581565b62004cbb6001836200766d565b620020236002826200766d565b6020546001600160a01b0316331462004d245760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001581565b601b805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b62004d5d62005ba6565b6001600160a01b038082166000818152603a60205260409020600101549091161462004dcc5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d757374206265207265676973746572656400000000604482015260640162001581565b6001600160a01b038082166000908152603a602052604090208054909116331462004e605760405162461bcd60e51b815260206004820152602f60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72207460448201527f72656173757279206163636f756e740000000000000000000000000000000000606482015260840162001581565b6000601382015460ff16600381111562004e7e5762004e7e62009780565b0362004ecd5760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f7220616c7265616479206163746976650000000000000000604482015260640162001581565b6002601382015460ff16600381111562004eeb5762004eeb62009780565b14801562004efc5750438160100154115b1562004f4b5760405162461bcd60e51b815260206004820152601760248201527f76616c696461746f72207374696c6c20696e206a61696c000000000000000000604482015260640162001581565b6003601382015460ff16600381111562004f695762004f6962009780565b0362004fb85760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206a61696c6564207065726d616e656e746c7900000000604482015260640162001581565b60138101805460ff1916905580546026546000908152602c60209081526040918290206003015491519182526001600160a01b038581169316917f60fcbf2d07dc712a93e59fb28f1edb626d7c2497c57ba71a8c0b3999ecb9a3b5910160405180910390a3506200163060008055565b606080600160028180546200503d906200a02f565b80601f01602080910402602001604051908101604052809291908181526020018280546200506b906200a02f565b8015620050bc5780601f106200509057610100808354040283529160200191620050bc565b820191906000526020600020905b8154815290600101906020018083116200509e57829003601f168201915b50505050509150808054620050d1906200a02f565b80601f0160208091040260200160405190810160405280929190818152602001828054620050ff906200a02f565b8015620051505780601f10620051245761010080835404028352916020019162005150565b820191906000526020600020905b8154815290600101906020018083116200513257829003601f168201915b50505050509050915091509091565b600060036000838152600b602052604090206006015460ff1660038111156200518c576200518c62009780565b14620051db5760405162461bcd60e51b815260206004820152601e60248201527f756e626f6e64696e672072656c65617365206e6f742072657665727465640000604482015260640162001581565b506000908152600b602052604090206005015490565b606060258054806020026020016040519081016040528092919081815260200182805480156200524b57602002820191906000526020600020905b81546001600160a01b031681526001909101906020018083116200522c575b5050505050905090565b6020546001600160a01b03163314620052b15760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001581565b60138190556040518181527f1f4d2fc7529047a5bd96d3229bfea127fd18b7748f13586e097c69fccd3891289060200160405180910390a150565b6020546001600160a01b03163314620053485760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001581565b601d805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6020546001600160a01b03163314620053d35760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001581565b620053e160016000620095ac565b620053ef60026000620095ac565b6003805460ff19169055565b6020546001600160a01b03163314620054575760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001581565b601c805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6020546001600160a01b03163314620054e25760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001581565b601a805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b603d546001600160a01b03163314620055935760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c0000000000000000000000000000000000000000000000000000000000606482015260840162001581565b6200559d62005ba6565b620055a7620067be565b620055b162004705565b505042602a5543602981905560009081526027602090815260408083208390556026548151602d805460a095810283018601909452608082018481526200571196939592948594919390919085015b82821015620056ee576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162005656906200a02f565b80601f016020809104026020016040519081016040528092919081815260200182805462005684906200a02f565b8015620056d55780601f10620056a957610100808354040283529160200191620056d5565b820191906000526020600020905b815481529060010190602001808311620056b757829003601f168201915b5050505050815250508152602001906001019062005600565b50505090825250600060208201524360408201526021546060909101526200694c565b620017e160008055565b6020546001600160a01b03163314620057775760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001581565b6017805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b603d546001600160a01b03163314620058285760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c0000000000000000000000000000000000000000000000000000000000606482015260840162001581565b602955565b6028546021546000911462005843575060285490565b5060215490565b620017e1620067be565b6020546001600160a01b03163314620058b05760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001581565b600655565b6020546001600160a01b03163314620059115760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001581565b601e805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6020546001600160a01b031633146200599c5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001581565b6001600160a01b03811662005a1a5760405162461bcd60e51b815260206004820152602960248201527f696e76616c696420636f6e7472616374206164647265737320666f72206c697160448201527f756964206c6f6769630000000000000000000000000000000000000000000000606482015260840162001581565b603e805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6001600160a01b03831662005ac65760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f2061646460448201527f7265737300000000000000000000000000000000000000000000000000000000606482015260840162001581565b6001600160a01b03821662005b445760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f20616464726560448201527f7373000000000000000000000000000000000000000000000000000000000000606482015260840162001581565b6001600160a01b0383811660008181526031602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92591015b60405180910390a3505050565b6000541562005bf85760405162461bcd60e51b815260206004820152601360248201527f7265656e7472616e637920646574656374656400000000000000000000000000604482015260640162001581565b6001600055565b6001600160a01b0381166000908152603a6020526040812090601382015460ff16600381111562005c345762005c3462009780565b1462005c835760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206d757374206265206163746976650000000000000000604482015260640162001581565b60138101805460ff1916600117905580546026546000908152602c60209081526040918290206003015491519182526001600160a01b038581169316917f75bdcdbe540758778e669d108fbcb7ede734f27f46e4e5525eeb8ecf91849a9c910160405180910390a35050565b6001600160a01b03831660009081526039602052604090205481111562005d595760405162461bcd60e51b815260206004820152601660248201527f616d6f756e7420657863656564732062616c616e636500000000000000000000604482015260640162001581565b6001600160a01b0383166000908152603960205260408120805483929062005d839084906200a0b3565b90915550506001600160a01b0382166000908152603960205260408120805483929062005db29084906200a0c9565b9091555050505050565b6011546010541015620017e1576010546000908152600f602052604090206015546001820154439162005def916200a0c9565b111562005df95750565b80546001600160a01b039081166000908152603a60205260409081902060028401546004808301829055600c83015493517f19fac8fd000000000000000000000000000000000000000000000000000000008152929493909316926319fac8fd9262005e6a92910190815260200190565b600060405180830381600087803b15801562005e8557600080fd5b505af115801562005e9a573d6000803e3d6000fd5b5050601080546000908152600f60205260408120805473ffffffffffffffffffffffffffffffffffffffff191681556001808201839055600290910182905582549094509192509062005eef9084906200a0c9565b9091555062005dbc92505050565b6001600160a01b0382166000908152603960205260408120805483929062005f279084906200a0c9565b9250508190555080603b600082825462005f4291906200a0c9565b90915550506040518181526001600160a01b038316907f48490b4407bb949b708ec5f514b4167f08f4969baaf78d53b05028adf369bfcf90602001620040d3565b8115801562005f90575080155b1562005f9a575050565b601254600090670de0b6b3a76400009062005fb79085906200a800565b62005fc391906200a707565b9050801562006041576017546040516000916001600160a01b03169083908381818185875af1925050503d80600081146200601b576040519150601f19603f3d011682016040523d82523d6000602084013e62006020565b606091505b50909150508015156001036200603f576200603c82856200a0b3565b93505b505b82602e60008282546200605591906200a0c9565b90915550600090505b602d5481101562001fb5576000603a6000602d84815481106200608557620060856200a4ec565b600091825260208083206003909202909101546001600160a01b031683528201929092526040018120602b54602d8054929450909188919086908110620060d057620060d06200a4ec565b906000526020600020906003020160010154620060ee91906200a800565b620060fa91906200a707565b90506000602b5486602d86815481106200611857620061186200a4ec565b9060005260206000209060030201600101546200613691906200a800565b6200614291906200a707565b90506000821180620061545750600081115b15620064e2576002601384015460ff16600381111562006178576200617862009780565b1480620061a057506003601384015460ff1660038111156200619e576200619e62009780565b145b156200628257601854620061c09030906001600160a01b03168362005cef565b601854602d80546001600160a01b039092169163a8031a1d91859188908110620061ee57620061ee6200a4ec565b600091825260209091206003909102015460405160e084901b7fffffffff000000000000000000000000000000000000000000000000000000001681526001600160a01b039091166004820152602481018590526044016000604051808303818588803b1580156200625f57600080fd5b505af115801562006274573d6000803e3d6000fd5b5050505050505050620064e6565b600083600501548385600801546200629b91906200a800565b620062a791906200a707565b905080156200636957835460405160009182916001600160a01b03909116906108fc90859084818181858888f193505050503d806000811462006307576040519150601f19603f3d011682016040523d82523d6000602084013e6200630c565b606091505b509092509050811515600003620063665785546040517f1137d8c966ce69b9630fb2294be011f3d64cc56e91fad7d375f0662568e9d352916200635d916001600160a01b039091169084906200a81a565b60405180910390a15b50505b600084600501548386600801546200638291906200a800565b6200638e91906200a707565b90508015620063b0578454620063b09030906001600160a01b03168362005cef565b6000620063be82856200a0b3565b90506000620063ce84876200a0b3565b90506000811180620063e05750600082115b156200649357600c870154620064029030906001600160a01b03168462005cef565b600c8701546040517fa0ce552d000000000000000000000000000000000000000000000000000000008152600481018490526001600160a01b039091169063a0ce552d908390602401604080518083038185885af115801562006469573d6000803e3d6000fd5b50505050506040513d601f19601f820116820180604052508101906200649091906200a4a3565b50505b600187015460408051888152602081018890526001600160a01b03909216917f291e8ba3c0f4b0bd86e6e2346fcee1e7ca0975b1cc1886bfbc722d34f3f24d91910160405180910390a2505050505b5050505b80620064f2816200a5a4565b9150506200605e565b60355460005b8181101562002023576000603582815481106200652257620065226200a4ec565b60009182526020808320909101546001600160a01b0316808352603382526040808420805482518186028101860190935280835292955090929091908301828280156200659957602002820191906000526020600020905b81546001600160a01b031681526001909101906020018083116200657a575b5050505050905060005b815181101562006621576001600160a01b03831660009081526032602052604081208351909190849084908110620065df57620065df6200a4ec565b60200260200101516001600160a01b03166001600160a01b0316815260200190815260200160002060009055808062006618906200a5a4565b915050620065a3565b506001600160a01b03821660009081526033602052604081206200664591620095eb565b6001600160a01b03821660009081526038602052604090205481516007546200666f91906200a800565b8111156200668b5781516007546200668891906200a800565b90505b60005a9050836001600160a01b031663d18736ab83856040518363ffffffff1660e01b8152600401620066bf919062009fdc565b600060405180830381600088803b158015620066da57600080fd5b5087f193505050508015620066ed575060015b62006723575a620066ff90826200a0b3565b6001600160a01b038516600090815260366020526040902060019055905062006733565b5a6200673090826200a0b3565b90505b6001600160a01b0384166000908152603860205260409020548110156200678a576001600160a01b038416600090815260386020526040812080548392906200677e9084906200a0b3565b90915550620067a49050565b6001600160a01b0384166000908152603860205260408120555b505050508080620067b5906200a5a4565b91505062006501565b6009545b600a54811015620067eb57620067e581620067dd816200a5a4565b9250620077c0565b620067c2565b50600a54600955600d54600c54036200680057565b600e545b600d548110156200682d5762006827816200681f816200a5a4565b925062007a7a565b62006804565b50600d54600e55600c54805b600d54811015620068a7576015546000828152600b6020526040902060040154439162006866916200a0c9565b116200688c57620068778162007e65565b620068846001836200a0c9565b915062006892565b620068a7565b806200689e816200a5a4565b91505062006839565b50600c55565b60355460005b818110156200693d57600060358281548110620068d457620068d46200a4ec565b60009182526020808320909101546001600160a01b0316808352603482526040808420849055603690925291205490915060010362006927576001600160a01b0381166000908152603660205260408120555b508062006934816200a5a4565b915050620068b3565b506200163060356000620095eb565b6000828152602c60209081526040808320918401516001830155830151600282015560608301516003820155905b82515181101562001fb55782518051839190839081106200699f576200699f6200a4ec565b6020908102919091018101518254600180820185556000948552938390208251600390920201805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0390921691909117815591810151928201929092556040820151600282019062006a1090826200a5c1565b505050808062006a20906200a5a4565b9150506200697a565b6000805b602d5481101562006a9157602d818154811062006a4e5762006a4e6200a4ec565b60009182526020909120600390910201546001600160a01b039081169084160362006a7c5750600192915050565b8062006a88816200a5a4565b91505062006a2d565b50600092915050565b60008062006aa76200960b565b60008060ff9050604083875160208901845afa62006ac457600080fd5b505080516020909101516c0100000000000000000000000090910494909350915050565b60e281511462006b3b5760405162461bcd60e51b815260206004820152601460248201527f496e76616c69642070726f6f66206c656e677468000000000000000000000000604482015260640162001581565b6030826102400151511462006b935760405162461bcd60e51b815260206004820152601c60248201527f496e76616c696420636f6e73656e737573206b6579206c656e67746800000000604482015260640162001581565b62006b9e826200800e565b604080518082018252601a81527f19457468657265756d205369676e6564204d6573736167653a0a00000000000060208083019190915284519251919260009262006c14920160609190911b7fffffffffffffffffffffffffffffffffffffffff00000000000000000000000016815260140190565b604051602081830303815290604052905060008262006c3483516200814d565b8360405160200162006c49939291906200a84c565b60408051601f198184030181528282528051602091820120600280855260608501845290945060009392909183019080368337019050509050600080808062006ca1898262006c9b604160026200a800565b620082a2565b9050600062006cc08a62006cb8604160026200a800565b6060620082a2565b905060205b825181101562006d915762006cdb8382620083d6565b6040805160008152602081018083528d905260ff8316918101919091526060810184905260808101839052929850909650945060019060a0016020604051602081039080840390855afa15801562006d37573d6000803e3d6000fd5b5050604051601f19015190508762006d516041846200a707565b8151811062006d645762006d646200a4ec565b6001600160a01b039092166020928302919091019091015262006d896041826200a0c9565b905062006cc5565b508a602001516001600160a01b03168660008151811062006db65762006db66200a4ec565b60200260200101516001600160a01b03161462006e3c5760405162461bcd60e51b815260206004820152602960248201527f496e76616c6964206e6f6465206b6579206f776e6572736869702070726f6f6660448201527f2070726f76696465640000000000000000000000000000000000000000000000606482015260840162001581565b8a604001516001600160a01b03168660018151811062006e605762006e606200a4ec565b60200260200101516001600160a01b03161462006ee65760405162461bcd60e51b815260206004820152602b60248201527f496e76616c6964206f7261636c65206b6579206f776e6572736869702070726f60448201527f6f662070726f7669646564000000000000000000000000000000000000000000606482015260840162001581565b600162006efe8c6102400151838e600001516200840d565b1462006f735760405162461bcd60e51b815260206004820152603660248201527f496e76616c696420636f6e73656e737573206b6579206f776e6572736869702060448201527f70726f6f6620666f7220726567697374726174696f6e00000000000000000000606482015260840162001581565b62006f7e8b6200847c565b5050505050505050505050565b6000808311620070045760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201527f6976650000000000000000000000000000000000000000000000000000000000606482015260840162001581565b6001600160a01b0382166000908152603960205260409020548311156200706e5760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e63650000000000604482015260640162001581565b6001600160a01b03821660009081526039602052604081208054859290620070989084906200a0b3565b9091555050604080516080810182526001600160a01b03808516825286811660208084019182528385018881524360608601908152600a8054600090815260089094529683208651815490871673ffffffffffffffffffffffffffffffffffffffff199182161782559451600182018054919097169516949094179094555160028301559151600390910155825491929062007134836200a5a4565b90915550506001600160a01b038581166000818152603a6020908152604091829020548251908516948816948514808252918101899052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d910160405180910390a3833b15620071ae57620071ae84876200875e565b6001600a54620071bf91906200a0b3565b9695505050505050565b6001600160a01b038084166000908152603a60205260408120805491929091848216911614806200738557600c8201546040517f84955c880000000000000000000000000000000000000000000000000000000081526001600160a01b03868116600483015260009216906384955c8890602401602060405180830381865afa1580156200725b573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906200728191906200a489565b905085811015620072fb5760405162461bcd60e51b815260206004820152602b60248201527f696e73756666696369656e7420756e6c6f636b6564204c6971756964204e657760448201527f746f6e2062616c616e6365000000000000000000000000000000000000000000606482015260840162001581565b600c8301546040517f282d3fdf0000000000000000000000000000000000000000000000000000000081526001600160a01b038781166004830152602482018990529091169063282d3fdf90604401600060405180830381600087803b1580156200736557600080fd5b505af11580156200737a573d6000803e3d6000fd5b50505050506200742e565b8482600b015483600801546200739c91906200a0b3565b1015620074125760405162461bcd60e51b815260206004820152602760248201527f696e73756666696369656e742073656c6620626f6e646564206e6577746f6e2060448201527f62616c616e636500000000000000000000000000000000000000000000000000606482015260840162001581565b8482600b0160008282546200742891906200a0c9565b90915550505b604051806101200160405280856001600160a01b03168152602001876001600160a01b0316815260200186815260200160008152602001438152602001600081526020016000600381111562007488576200748862009780565b815260006020808301829052841515604093840152600d548252600b815290829020835181546001600160a01b0391821673ffffffffffffffffffffffffffffffffffffffff199182161783559285015160018381018054929093169190941617905591830151600283015560608301516003808401919091556080840151600484015560a0840151600584015560c08401516006840180549193909260ff1990921691849081111562007540576200754062009780565b021790555060e08201516006909101805461010093840151151562010000027fffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ffff931515909402929092167fffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000ff90921691909117919091179055600d8054906000620075ca836200a5a4565b9190505550836001600160a01b0316866001600160a01b03167f63f8870909f7c59c9c4932bf98dbd491647c8d2e89ca0a032aacdd943a13e2fc8388604051620076209291909115158252602082015260400190565b60405180910390a3833b156200763c576200763c84876200875e565b6001600d54620071bf91906200a0b3565b60fa60a06000808285855af462007668573d6000803e3d6000fd5b505050565b8154600260018083161561010002038216048251808201602081106020841001600281146200771c576001811462007742578660005260208404602060002001600160028402018855602085068060200390508088018589016001836101000a0392508282511684540184556001840193506020820191505b80821015620077055781518455600184019350602082019150620076e6565b815191036101000a908190040290915550620077b7565b60028302826020036101000a846020036101000a602089015104020185018755620077b7565b8660005260208404602060002001600160028402018855846020038088018589016001836101000a0392508282511660ff198a160184556020820191506001840193505b80821015620077a5578151845560018401935060208201915062007786565b815191036101000a9081900402909155505b50505050505050565b600081815260086020908152604080832060018101546001600160a01b03168452603a90925282209091601382015460ff16600381111562007806576200780662009780565b1415806200782d575081546001600160a01b03166000908152603660205260409020546001145b15620078ed57600282015482546001600160a01b031660009081526039602052604081208054909190620078639084906200a0c9565b909155505081546001830154600284015460138401546040516001600160a01b0394851694909316927f1ff2b052afa4bb37ce30d9aaccde416a700b97e632d089111749af937f87834292620078c192909160ff909116906200a895565b60405180910390a380548254620076689185916000916001600160a01b0391821691161460016200887d565b805482546001600160a01b0390811691161462007a2a57600080826008015483600501546200791d91906200a0b3565b9050806000036200793557836002015491506200795b565b80846002015484600d01546200794c91906200a800565b6200795891906200a707565b91505b600c83015484546040517f40c10f190000000000000000000000000000000000000000000000000000000081526001600160a01b039182166004820152602481018590529116906340c10f1990604401600060405180830381600087803b158015620079c657600080fd5b505af1158015620079db573d6000803e3d6000fd5b505050508183600d016000828254620079f591906200a0c9565b9091555050600284015460058401805460009062007a159084906200a0c9565b9091555062002198905085836000806200887d565b816002015481600801600082825462007a4491906200a0c9565b9091555050600282015460058201805460009062007a649084906200a0c9565b909155506200766890508360006001816200887d565b6000818152600b6020908152604080832080546001600160a01b0316845260369092529091205460010362007b1c578054600182015460068301546002840154604080516201000090930460ff161515835260208301919091526001600160a01b0393841693909216917fec3ccfdbfc982a0ea188f4fd85459da1d6a7b332ddc4ca6b7a774c1abfc75866910160405180910390a36200202382600162008a1f565b60018101546001600160a01b03166000908152603a60205260408120600683015490919062010000900460ff1662007d36576002830154600c83015484546040517f7eee288d0000000000000000000000000000000000000000000000000000000081526001600160a01b03918216600482015260248101849052911690637eee288d90604401600060405180830381600087803b15801562007bbe57600080fd5b505af115801562007bd3573d6000803e3d6000fd5b50505050600c83015484546040517f9dc29fac0000000000000000000000000000000000000000000000000000000081526001600160a01b03918216600482015260248101849052911690639dc29fac90604401600060405180830381600087803b15801562007c4257600080fd5b505af115801562007c57573d6000803e3d6000fd5b5050505060008360080154846005015462007c7391906200a0b3565b600d85015490915062007c8782846200a800565b62007c9391906200a707565b92508184600d01600082825462007cab91906200a0b3565b9091555050600684015460000362007cca576003850183905562007cf2565b6006840154600785015462007ce090856200a800565b62007cec91906200a707565b60038601555b8284600601600082825462007d0891906200a0c9565b9091555050600385015460078501805460009062007d289084906200a0c9565b9091555062007e0b92505050565b506002820154600882015481111562007d50575060088101545b816009015460000362007d6a576003830181905562007d92565b6009820154600a83015462007d8090836200a800565b62007d8c91906200a707565b60038401555b8082600901600082825462007da891906200a0c9565b90915550506003830154600a8301805460009062007dc89084906200a0c9565b925050819055508082600801600082825462007de591906200a0b3565b90915550506002830154600b8301805460009062007e059084906200a0b3565b90915550505b6006830180547fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ff1661010017905560058201805482919060009062007e529084906200a0b3565b9091555062001fb5905084600062008a1f565b6000818152600b602052604090206006810154610100900460ff1662007ea35760068101805460ff1916600217905562002023826000600162008bab565b60068101805460ff19166001179055600381015460000362007ecd57620020238260008062008bab565b60018101546001600160a01b03166000908152603a60205260408120600683015490919062010000900460ff1662007f6a5781600701548260060154846003015462007f1a91906200a800565b62007f2691906200a707565b90508082600601600082825462007f3e91906200a0b3565b9091555050600383015460078301805460009062007f5e9084906200a0b3565b9091555062007fcf9050565b81600a01548260090154846003015462007f8591906200a800565b62007f9191906200a707565b90508082600901600082825462007fa991906200a0b3565b90915550506003830154600a8301805460009062007fc99084906200a0b3565b90915550505b82546001600160a01b03166000908152603960205260408120805483929062007ffa9084906200a0c9565b9091555062001fb590508482600062008bab565b60006200801f826060015162006a9a565b6001600160a01b03909116602084015290508015620080815760405162461bcd60e51b815260206004820152600b60248201527f656e6f6465206572726f72000000000000000000000000000000000000000000604482015260640162001581565b6020808301516001600160a01b039081166000908152603a9092526040909120600101541615620080f55760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c7265616479207265676973746572656400000000604482015260640162001581565b61271082608001511115620020235760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e2072617465000000000000000000604482015260640162001581565b6060816000036200819157505060408051808201909152600181527f3000000000000000000000000000000000000000000000000000000000000000602082015290565b8160005b8115620081c15780620081a8816200a5a4565b9150620081b99050600a836200a707565b915062008195565b60008167ffffffffffffffff811115620081df57620081df62009b36565b6040519080825280601f01601f1916602001820160405280156200820a576020820181803683370190505b5090505b84156200829a57620082226001836200a0b3565b915062008231600a866200a8b4565b6200823e9060306200a0c9565b60f81b8183815181106200825657620082566200a4ec565b60200101907effffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916908160001a90535062008292600a866200a707565b94506200820e565b949350505050565b606081620082b281601f6200a0c9565b1015620083025760405162461bcd60e51b815260206004820152600e60248201527f736c6963655f6f766572666c6f77000000000000000000000000000000000000604482015260640162001581565b6200830e82846200a0c9565b84511015620083605760405162461bcd60e51b815260206004820152601160248201527f736c6963655f6f75744f66426f756e6473000000000000000000000000000000604482015260640162001581565b606082158015620083815760405191506000825260208201604052620083cd565b6040519150601f8416801560200281840101858101878315602002848b0101015b81831015620083bc578051835260209283019201620083a2565b5050858452601f01601f1916604052505b50949350505050565b8181018051602082015160409092015190919060001a601b811015620084065762008403601b826200a8cb565b90505b9250925092565b60006200841962009629565b600085858560405160200162008432939291906200a8e7565b6040516020818303038152906040529050600060fb90506000825160206200845b91906200a0c9565b90506020848285855afa6200846f57600080fd5b5050905195945050505050565b6101808101516001600160a01b03166200859657603e546001600160a01b0316620085105760405162461bcd60e51b815260206004820152602260248201527f6c6971756964206c6f67696320636f6e7472616374206e6f74206465706c6f7960448201527f6564000000000000000000000000000000000000000000000000000000000000606482015260840162001581565b60255460009062008521906200814d565b905081602001518260000151836080015183603e60009054906101000a90046001600160a01b0316604051620085579062009647565b620085679594939291906200a949565b604051809103906000f08015801562008584573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051602580546001808201835560009283527f401968ff42a154441da5f6c4c935ac46b8671f0e062baaa62a7545ba53bb6e4c909101805473ffffffffffffffffffffffffffffffffffffffff199081166001600160a01b0395861617909155845184168352603a909552604091829020865181548716908516178155935190840180548616918416919091179055840151600283018054909416911617909155606082015182919060038201906200865590826200a5c1565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c8201805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f8201556102008201516010820155610220820151601182015561024082015160128201906200872c90826200a5c1565b5061026082015160138201805460ff1916600183600381111562008754576200875462009780565b0217905550505050565b6001600160a01b0382166000908152603460205260408120549003620087e9576001600160a01b03821660008181526034602052604081206001908190556035805491820181559091527fcfa4bec1d3298408bb5afcfcd9c430549c5b31f8aa5c5848151c0a55f473c34d01805473ffffffffffffffffffffffffffffffffffffffff191690911790555b6001600160a01b038083166000908152603260209081526040808320938516835292905290812054900362002023576001600160a01b038083166000818152603260209081526040808320948616808452948252808320600190819055938352603382528220805493840181558252902001805473ffffffffffffffffffffffffffffffffffffffff191690911790555050565b600084815260086020526040902080546001600160a01b0316803b620088a557505062001fb5565b6001600160a01b038116600090815260386020526040902054600454811115620088ce57506004545b60005a60018501546040517f9dfd1b8e000000000000000000000000000000000000000000000000000000008152600481018b90526001600160a01b039182166024820152604481018a905288151560648201528715156084820152919250841690639dfd1b8e90849060a401600060405180830381600088803b1580156200895657600080fd5b5087f19350505050801562008969575060015b62008994575a6200897b90826200a0b3565b9050846200898e576200898e8862008d34565b620089a4565b5a620089a190826200a0b3565b90505b6001600160a01b038316600090815260386020526040902054811015620089fb576001600160a01b03831660009081526038602052604081208054839290620089ef9084906200a0b3565b9091555062008a159050565b6001600160a01b0383166000908152603860205260408120555b5050505050505050565b6000828152600b6020526040902080546001600160a01b0316803b62008a455750505050565b6001600160a01b03811660009081526038602052604090205460055481111562008a6e57506005545b60005a60018501546040517fa8920241000000000000000000000000000000000000000000000000000000008152600481018990526001600160a01b039182166024820152871515604482015291925084169063a8920241908490606401600060405180830381600088803b15801562008ae757600080fd5b5087f19350505050801562008afa575060015b62008b25575a62008b0c90826200a0b3565b90508462008b1f5762008b1f8662008f14565b62008b35565b5a62008b3290826200a0b3565b90505b6001600160a01b03831660009081526038602052604090205481101562008b8c576001600160a01b0383166000908152603860205260408120805483929062008b809084906200a0b3565b90915550620036249050565b50506001600160a01b0316600090815260386020526040812055505050565b6000838152600b6020526040902080546001600160a01b0316803b62008bd2575050505050565b6001600160a01b03811660009081526038602052604090205460065481111562008bfb57506006545b60005a6040517f3c54c290000000000000000000000000000000000000000000000000000000008152600481018990526024810188905286151560448201529091506001600160a01b03841690633c54c290908490606401600060405180830381600088803b15801562008c6e57600080fd5b5087f19350505050801562008c81575060015b62008cad575a62008c9390826200a0b3565b90508462008ca75762008ca78787620091a0565b62008cbd565b5a62008cba90826200a0b3565b90505b6001600160a01b03831660009081526038602052604090205481101562008d14576001600160a01b0383166000908152603860205260408120805483929062008d089084906200a0b3565b90915550620077b79050565b50506001600160a01b031660009081526038602052604081205550505050565b600081815260086020908152604080832060018101546001600160a01b039081168552603a909352922080548354919291821691161462008e525760008160080154826005015462008d8791906200a0b3565b836002015483600d015462008d9d91906200a800565b62008da991906200a707565b600c83015484546040517f9dc29fac0000000000000000000000000000000000000000000000000000000081526001600160a01b039182166004820152602481018490529293501690639dc29fac90604401600060405180830381600087803b15801562008e1657600080fd5b505af115801562008e2b573d6000803e3d6000fd5b505050508082600d01600082825462008e4591906200a0b3565b9091555062008e72915050565b816002015481600801600082825462008e6c91906200a0b3565b90915550505b600282015482546001600160a01b03166000908152603960205260408120805490919062008ea29084906200a0c9565b9091555050600282015460058201805460009062008ec29084906200a0b3565b90915550508154600183015460028401546040519081526001600160a01b0392831692909116907f2d2530c45d577d38d6fc598e41ade6fb0bfbde20d09244c88348d3a2797a9d909060200162005b99565b6000818152600b6020908152604080832060018101546001600160a01b03168452603a909252822060068201549192909162010000900460ff1662009063576002830154600c83015484546040517f40c10f190000000000000000000000000000000000000000000000000000000081526001600160a01b039182166004820152602481018490529116906340c10f1990604401600060405180830381600087803b15801562008fc357600080fd5b505af115801562008fd8573d6000803e3d6000fd5b505050508083600d01600082825462008ff291906200a0c9565b90915550506007830154600684015460038601546200901291906200a800565b6200901e91906200a707565b9150818360060160008282546200903691906200a0b3565b90915550506003840154600784018054600090620090569084906200a0b3565b90915550620090e5915050565b81600a0154826009015484600301546200907e91906200a800565b6200908a91906200a707565b905080826009016000828254620090a291906200a0b3565b90915550506003830154600a83018054600090620090c29084906200a0b3565b9250508190555080826008016000828254620090df91906200a0c9565b90915550505b6000600384018190556006840180547fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ff1690556005830180548392906200912e9084906200a0c9565b90915550508254600184015460068501546002860154604080516201000090930460ff161515835260208301919091526001600160a01b0393841693909216917f52c1b482483796c697edd43958bc63e332069083ea38782d960693145db2cf0c91015b60405180910390a350505050565b6000828152600b60205260408120908290036200922457600681018054600360ff19909116179081905581546001830154604080516201000090940460ff1615158452602084018690526001600160a01b039283169392909116917f0a4bfc4c4e35975ab1fe5fd21d963e8bb8d483f04aebdbc0a059b246bec3f5fc910162005b99565b60018101546001600160a01b03166000908152603a60205260409020600682015462010000900460ff166200934d57600080826008015483600501546200926c91906200a0b3565b9050806000036200928057849150620092a2565b808584600d01546200929391906200a800565b6200929f91906200a707565b91505b600c83015484546040517f40c10f190000000000000000000000000000000000000000000000000000000081526001600160a01b039182166004820152602481018590529116906340c10f1990604401600060405180830381600087803b1580156200930d57600080fd5b505af115801562009322573d6000803e3d6000fd5b505050508183600d0160008282546200933c91906200a0c9565b909155505050600583015562009372565b828260050181905550828160080160008282546200936c91906200a0c9565b90915550505b60068201805460ff1916600317905581546001600160a01b031660009081526039602052604081208054859290620093ac9084906200a0b3565b9250508190555082816005016000828254620093c991906200a0c9565b9091555050815460018301546006840154604080516201000090920460ff1615158252602082018790526001600160a01b0393841693909216917f0a4bfc4c4e35975ab1fe5fd21d963e8bb8d483f04aebdbc0a059b246bec3f5fc910162009192565b60405180610280016040528060006001600160a01b0316815260200160006001600160a01b0316815260200160006001600160a01b0316815260200160608152602001600081526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b0316815260200160008152602001600081526020016000815260200160008152602001600081526020016060815260200160006003811115620094f757620094f762009780565b905290565b60405180610120016040528060006001600160a01b0316815260200160006001600160a01b0316815260200160008152602001600081526020016000815260200160008152602001600060038111156200955a576200955a62009780565b815260006020820181905260409091015290565b6040518060a001604052806005906020820280368337509192915050565b508054600082559060005260206000209081019062001630919062009655565b508054620095ba906200a02f565b6000825580601f10620095cb575050565b601f0160209004906000526020600020908101906200163091906200967a565b50805460008255906000526020600020908101906200163091906200967a565b60405180604001604052806002906020820280368337509192915050565b60405180602001604052806001906020820280368337509192915050565b610610806200a97d83390190565b80821115620096765760006200966c8282620095ac565b5060010162009655565b5090565b5b808211156200967657600081556001016200967b565b60005b83811015620096ae57818101518382015260200162009694565b50506000910152565b60008151808452620096d181602086016020860162009691565b601f01601f19169290920160200192915050565b602081526000620096fa6020830184620096b7565b9392505050565b6001600160a01b03811681146200163057600080fd5b600080604083850312156200972b57600080fd5b8235620097388162009701565b946020939093013593505050565b6000602082840312156200975957600080fd5b8135620096fa8162009701565b6000602082840312156200977957600080fd5b5035919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b6004811062001630577f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b620097f281620097af565b9052565b60208152620098116020820183516001600160a01b03169052565b600060208301516200982e60408401826001600160a01b03169052565b5060408301516001600160a01b0381166060840152506060830151610280806080850152620098626102a0850183620096b7565b9150608085015160a085015260a085015160c085015260c085015160e085015260e08501516101008181870152808701519150506101208181870152808701519150506101408181870152808701519150506101608181870152808701519150506101808181870152808701519150506101a0620098ea818701836001600160a01b03169052565b8601516101c0868101919091528601516101e080870191909152860151610200808701919091528601516102208087019190915286015161024080870191909152860151858403601f1901610260808801919091529091506200994e8483620096b7565b9350808701519150506200996582860182620097e7565b5090949350505050565b6000806000606084860312156200998557600080fd5b8335620099928162009701565b92506020840135620099a48162009701565b929592945050506040919091013590565b6000610120820190506001600160a01b038084511683528060208501511660208401525060408301516040830152606083015160608301526080830151608083015260a083015160a083015260c083015162009a1560c0840182620097e7565b5060e083015162009a2a60e084018215159052565b50610100928301511515919092015290565b60006020828403121562009a4f57600080fd5b813567ffffffffffffffff81111562009a6757600080fd5b82016102808185031215620096fa57600080fd5b600081518084526020808501808196508360051b8101915082860160005b8581101562009af0578284038952815180516001600160a01b03168552858101518686015260409081015160609186018290529062009adb81870183620096b7565b9a87019a955050509084019060010162009a99565b5091979650505050505050565b8515158152841515602082015260a06040820152600062009b2260a083018662009a7b565b606083019490945250608001529392505050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b600082601f83011262009b7757600080fd5b813567ffffffffffffffff8082111562009b955762009b9562009b36565b604051601f8301601f19908116603f0116810190828211818310171562009bc05762009bc062009b36565b8160405283815286602085880101111562009bda57600080fd5b836020870160208301376000602085830101528094505050505092915050565b6000806040838503121562009c0e57600080fd5b823562009c1b8162009701565b9150602083013567ffffffffffffffff81111562009c3857600080fd5b62009c468582860162009b65565b9150509250929050565b600061026082019050855182526020860151602083015260408601516040830152606086015160608301526080860151608083015260a08601516001600160a01b0380821660a08501528087511660c08501528060208801511660e0850152806040880151166101008501525050606085015162009cda6101208401826001600160a01b03169052565b5060808501516001600160a01b0390811661014084015260a0860151811661016084015260c0860151811661018084015260e09095015185166101a083015283519094166101c082015260208301516101e0820152604083015161020082015260609092015161022083015261024090910152919050565b6000806000806080858703121562009d6957600080fd5b843567ffffffffffffffff8082111562009d8257600080fd5b62009d908883890162009b65565b95506020870135915062009da48262009701565b9093506040860135908082111562009dbb57600080fd5b62009dc98883890162009b65565b9350606087013591508082111562009de057600080fd5b5062009def8782880162009b65565b91505092959194509250565b6000602080830181845280855180835260408601915060408160051b870101925083870160005b8281101562009e72577fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffc088860301845262009e5f858351620096b7565b9450928501929085019060010162009e22565b5092979650505050505050565b6020810162009e8e83620097af565b91905290565b60808152600062009ea9608083018762009a7b565b6020830195909552506040810192909252606090910152919050565b602081526000620096fa602083018462009a7b565b600081518084526020808501945080840160005b8381101562009f155781516001600160a01b03168752958201959082019060010162009eee565b509495945050505050565b60408152600062009f35604083018562009eda565b828103602084015262009f49818562009eda565b95945050505050565b6000806040838503121562009f6657600080fd5b823567ffffffffffffffff8082111562009f7f57600080fd5b62009f8d8683870162009b65565b9350602085013591508082111562009fa457600080fd5b5062009c468582860162009b65565b60408152600062009fc86040830185620096b7565b828103602084015262009f498185620096b7565b602081526000620096fa602083018462009eda565b600080604083850312156200a00557600080fd5b82356200a0128162009701565b915060208301356200a0248162009701565b809150509250929050565b600181811c908216806200a04457607f821691505b6020821081036200a07e577f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b818103818111156200150757620015076200a084565b808201808211156200150757620015076200a084565b60008135620015078162009701565b60008083357fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe18436030181126200a12457600080fd5b83018035915067ffffffffffffffff8211156200a14057600080fd5b6020019150368190038213156200a15657600080fd5b9250929050565b601f8211156200766857600081815260208120601f850160051c810160208610156200a1865750805b601f850160051c820191505b8181101562003624578281556001016200a192565b67ffffffffffffffff8311156200a1c2576200a1c262009b36565b6200a1da836200a1d383546200a02f565b836200a15d565b6000601f8411600181146200a21157600085156200a1f85750838201355b600019600387901b1c1916600186901b17835562002198565b600083815260209020601f19861690835b828110156200a24457868501358255602094850194600190920191016200a222565b50868210156200a2625760001960f88860031b161c19848701351681555b505060018560011b0183555050505050565b60008135600481106200150757600080fd5b6200a29182620097af565b60ff1981541660ff831681178255505050565b6200a2dd6200a2b3836200a0df565b825473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0391909116178255565b6200a31d6200a2ef602084016200a0df565b600183016001600160a01b03821673ffffffffffffffffffffffffffffffffffffffff198254161781555050565b6200a35d6200a32f604084016200a0df565b600283016001600160a01b03821673ffffffffffffffffffffffffffffffffffffffff198254161781555050565b6200a36c60608301836200a0ee565b6200a37c8183600386016200a1a7565b50506080820135600482015560a0820135600582015560c0820135600682015560e0820135600782015561010082013560088201556101208201356009820155610140820135600a820155610160820135600b8201556200a4136200a3e561018084016200a0df565b600c83016001600160a01b03821673ffffffffffffffffffffffffffffffffffffffff198254161781555050565b6101a0820135600d8201556101c0820135600e8201556101e0820135600f820155610200820135601082015561022082013560118201556200a45a6102408301836200a0ee565b6200a46a8183601286016200a1a7565b5050620020236200a47f61026084016200a274565b601383016200a286565b6000602082840312156200a49c57600080fd5b5051919050565b600080604083850312156200a4b757600080fd5b505080516020909101519092909150565b6000602082840312156200a4db57600080fd5b81518015158114620096fa57600080fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052603260045260246000fd5b600081546200a52a816200a02f565b600182811680156200a54557600181146200a55b576200a58c565b60ff19841687528215158302870194506200a58c565b8560005260208060002060005b858110156200a5835781548a8201529084019082016200a568565b50505082870194505b5050505092915050565b6000620096fa82846200a51b565b600060001982036200a5ba576200a5ba6200a084565b5060010190565b815167ffffffffffffffff8111156200a5de576200a5de62009b36565b6200a5f6816200a5ef84546200a02f565b846200a15d565b602080601f8311600181146200a62e57600084156200a6155750858301515b600019600386901b1c1916600185901b17855562003624565b600085815260208120601f198616915b828110156200a65f578886015182559484019460019091019084016200a63e565b50858210156200a67e5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b60006001600160a01b0380881683528087166020840152808616604084015260a060608401526200a6c360a0840186620096b7565b91508084166080840152509695505050505050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b6000826200a719576200a7196200a6d8565b500490565b8181036200a72a575050565b6200a73682546200a02f565b67ffffffffffffffff8111156200a751576200a75162009b36565b6200a762816200a5ef84546200a02f565b6000601f8211600181146200a79957600083156200a7805750848201545b600019600385901b1c1916600184901b17845562002198565b600085815260209020601f19841690600086815260209020845b838110156200a7d557828601548255600195860195909101906020016200a7b3565b50858310156200a67e5793015460001960f8600387901b161c19169092555050600190811b01905550565b80820281158282048414176200150757620015076200a084565b6001600160a01b038316815260606020820152600060608201526080604082015260006200829a6080830184620096b7565b600084516200a86081846020890162009691565b8451908301906200a87681836020890162009691565b84519101906200a88b81836020880162009691565b0195945050505050565b828152604081016200a8a783620097af565b8260208301529392505050565b6000826200a8c6576200a8c66200a6d8565b500690565b60ff81811683821601908111156200150757620015076200a084565b600084516200a8fb81846020890162009691565b8451908301906200a91181836020890162009691565b60609490941b7fffffffffffffffffffffffffffffffffffffffff000000000000000000000000169301928352505060140192915050565b60006001600160a01b038088168352808716602084015285604084015260a060608401526200a6c360a0840186620096b756fe608060405234801561001057600080fd5b5060405161061038038061061083398101604081905261002f916101af565b806001600160a01b0316632f2c3f2e6040518163ffffffff1660e01b8152600401602060405180830381865afa15801561006d573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610091919061029f565b83111561009d57600080fd5b600c80546001600160a01b038088166001600160a01b031992831617909255600d805492871692909116919091179055600e8390556040516100e39083906020016102b8565b604051602081830303815290604052600a9081610100919061036e565b508160405160200161011291906102b8565b604051602081830303815290604052600b908161012f919061036e565b5050601080546001600160a01b031916331790555061042d92505050565b6001600160a01b038116811461016257600080fd5b50565b80516101708161014d565b919050565b634e487b7160e01b600052604160045260246000fd5b60005b838110156101a657818101518382015260200161018e565b50506000910152565b600080600080600060a086880312156101c757600080fd5b85516101d28161014d565b60208701519095506101e38161014d565b6040870151606088015191955093506001600160401b038082111561020757600080fd5b818801915088601f83011261021b57600080fd5b81518181111561022d5761022d610175565b604051601f8201601f19908116603f0116810190838211818310171561025557610255610175565b816040528281528b602084870101111561026e57600080fd5b61027f83602083016020880161018b565b809650505050505061029360808701610165565b90509295509295909350565b6000602082840312156102b157600080fd5b5051919050565b644c4e544e2d60d81b8152600082516102d881600585016020870161018b565b9190910160050192915050565b600181811c908216806102f957607f821691505b60208210810361031957634e487b7160e01b600052602260045260246000fd5b50919050565b601f82111561036957600081815260208120601f850160051c810160208610156103465750805b601f850160051c820191505b8181101561036557828155600101610352565b5050505b505050565b81516001600160401b0381111561038757610387610175565b61039b8161039584546102e5565b8461031f565b602080601f8311600181146103d057600084156103b85750858301515b600019600386901b1c1916600185901b178555610365565b600085815260208120601f198616915b828110156103ff578886015182559484019460019091019084016103e0565b508582101561041d5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b6101d48061043c6000396000f3fe60806040523661001b57610019610014610022565b61013e565b005b6100196100145b600080601060009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff166396f18a326040518163ffffffff1660e01b8152600401602060405180830381865afa158015610092573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906100b69190610161565b905073ffffffffffffffffffffffffffffffffffffffff8116610139576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152601d60248201527f6c6971756964206c6f67696320636f6e7472616374206e6f7420736574000000604482015260640160405180910390fd5b919050565b3660008037600080366000845af43d6000803e8061015b573d6000fd5b503d6000f35b60006020828403121561017357600080fd5b815173ffffffffffffffffffffffffffffffffffffffff8116811461019757600080fd5b939250505056fea26469706673582212200db76088bf5d8acdca170dade31d69898e8f986dad784e43d3aefe7fdd43feff64736f6c63430008150033a26469706673582212201abb224ef70e2aa04e8deba226e62d2565b993b6bb6cf29bf4de0e0511ab096064736f6c63430008150033608060405234801561001057600080fd5b50601080546001600160a01b03191633179055611cc4806100326000396000f3fe6080604052600436106101a55760003560e01c80633e4eb36c116100e1578063949813b81161008a578063a0ce552d11610064578063a0ce552d1461054b578063a9059cbb1461055e578063bd96102f1461057e578063dd62ed3e14610593576101ac565b8063949813b8146104e157806395d89b41146105165780639dc29fac1461052b576101ac565b806370a08231116100bb57806370a082311461045e5780637eee288d146104a157806384955c88146104c1576101ac565b80633e4eb36c146103e657806340c10f19146103fb578063593557361461041b576101ac565b80631eeffad01161014e5780632f2c3f2e116101285780632f2c3f2e14610374578063313ce5671461038a578063372500ab146103a65780633b19e84a146103bb576101ac565b80631eeffad01461031f57806323b872dd14610334578063282d3fdf14610354576101ac565b806318160ddd1161017f57806318160ddd146102c6578063187cf4d7146102e557806319fac8fd146102fd576101ac565b806306fdde031461021f578063095ea7b31461024a5780631195e07e1461027a576101ac565b366101ac57005b60405162461bcd60e51b815260206004820152602860248201527f66616c6c6261636b206e6f7420696d706c656d656e74656420666f72204c697160448201527f7569644c6f67696300000000000000000000000000000000000000000000000060648201526084015b60405180910390fd5b34801561022b57600080fd5b506102346105e6565b6040516102419190611a10565b60405180910390f35b34801561025657600080fd5b5061026a610265366004611aa5565b610678565b6040519015158152602001610241565b34801561028657600080fd5b50600c5473ffffffffffffffffffffffffffffffffffffffff165b60405173ffffffffffffffffffffffffffffffffffffffff9091168152602001610241565b3480156102d257600080fd5b506003545b604051908152602001610241565b3480156102f157600080fd5b506102d7633b9aca0081565b34801561030957600080fd5b5061031d610318366004611acf565b61068f565b005b34801561032b57600080fd5b50600f546102d7565b34801561034057600080fd5b5061026a61034f366004611ae8565b610721565b34801561036057600080fd5b5061031d61036f366004611aa5565b610864565b34801561038057600080fd5b506102d761271081565b34801561039657600080fd5b5060405160128152602001610241565b3480156103b257600080fd5b5061031d6109de565b3480156103c757600080fd5b50600d5473ffffffffffffffffffffffffffffffffffffffff166102a1565b3480156103f257600080fd5b50600e546102d7565b34801561040757600080fd5b5061031d610416366004611aa5565b610c08565b34801561042757600080fd5b506102d7610436366004611b24565b73ffffffffffffffffffffffffffffffffffffffff1660009081526001602052604090205490565b34801561046a57600080fd5b506102d7610479366004611b24565b73ffffffffffffffffffffffffffffffffffffffff1660009081526020819052604090205490565b3480156104ad57600080fd5b5061031d6104bc366004611aa5565b610cf2565b3480156104cd57600080fd5b506102d76104dc366004611b24565b610e4f565b3480156104ed57600080fd5b506105016104fc366004611b24565b610e89565b60408051928352602083019190915201610241565b34801561052257600080fd5b50610234610f75565b34801561053757600080fd5b5061031d610546366004611aa5565b610f84565b610501610559366004611acf565b611066565b34801561056a57600080fd5b5061026a610579366004611aa5565b6113f0565b34801561058a57600080fd5b5061031d61145c565b34801561059f57600080fd5b506102d76105ae366004611b3f565b73ffffffffffffffffffffffffffffffffffffffff918216600090815260026020908152604080832093909416825291909152205490565b6060600a80546105f590611b72565b80601f016020809104026020016040519081016040528092919081815260200182805461062190611b72565b801561066e5780601f106106435761010080835404028352916020019161066e565b820191906000526020600020905b81548152906001019060200180831161065157829003601f168201915b5050505050905090565b60006106853384846115a3565b5060015b92915050565b60105473ffffffffffffffffffffffffffffffffffffffff16331461071c5760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610216565b600e55565b73ffffffffffffffffffffffffffffffffffffffff83166000908152600260209081526040808320338452909152812054828110156107c85760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e742065786365656473206160448201527f6c6c6f77616e63650000000000000000000000000000000000000000000000006064820152608401610216565b6107dc85336107d78685611bf4565b6115a3565b6107e68584611722565b6107f0848461183d565b8373ffffffffffffffffffffffffffffffffffffffff168573ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8560405161084f91815260200190565b60405180910390a360019150505b9392505050565b60105473ffffffffffffffffffffffffffffffffffffffff1633146108f15760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610216565b73ffffffffffffffffffffffffffffffffffffffff82166000908152600160209081526040808320549183905290912054829161092d91611bf4565b10156109a05760405162461bcd60e51b8152602060048201526024808201527f63616e2774206c6f636b206d6f72652066756e6473207468616e20617661696c60448201527f61626c65000000000000000000000000000000000000000000000000000000006064820152608401610216565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260016020526040812080548392906109d5908490611c07565b90915550505050565b6000806109ea33611896565b3360009081526004602090815260408083208390556007909152812081905591935091508115610afe576010546040517fa9059cbb0000000000000000000000000000000000000000000000000000000081523360048201526024810184905273ffffffffffffffffffffffffffffffffffffffff9091169063a9059cbb906044016020604051808303816000875af1158015610a8b573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610aaf9190611c1a565b905080610afe5760405162461bcd60e51b815260206004820152601260248201527f4661696c656420746f2073656e64204e544e00000000000000000000000000006044820152606401610216565b333b15610b6b573373ffffffffffffffffffffffffffffffffffffffff1663161605e3846040518263ffffffff1660e01b81526004016000604051808303818588803b158015610b4d57600080fd5b505af1158015610b61573d6000803e3d6000fd5b5050505050505050565b60405133908490600081818185875af1925050503d8060008114610bab576040519150601f19603f3d011682016040523d82523d6000602084013e610bb0565b606091505b50508091505080610c035760405162461bcd60e51b815260206004820152601260248201527f4661696c656420746f2073656e642041544e00000000000000000000000000006044820152606401610216565b505050565b60105473ffffffffffffffffffffffffffffffffffffffff163314610c955760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610216565b610c9f828261183d565b60405181815273ffffffffffffffffffffffffffffffffffffffff8316906000907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef906020015b60405180910390a35050565b60105473ffffffffffffffffffffffffffffffffffffffff163314610d7f5760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610216565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260016020526040902054811115610e1a5760405162461bcd60e51b815260206004820152602360248201527f63616e277420756e6c6f636b206d6f72652066756e6473207468616e206c6f6360448201527f6b656400000000000000000000000000000000000000000000000000000000006064820152608401610216565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260016020526040812080548392906109d5908490611bf4565b73ffffffffffffffffffffffffffffffffffffffff8116600090815260016020908152604080832054918390528220546106899190611bf4565b73ffffffffffffffffffffffffffffffffffffffff81166000908152602081815260408083205460065460059093529083205483928391610ecb9184916119d2565b73ffffffffffffffffffffffffffffffffffffffff8616600090815260046020526040902054909150610eff908290611c07565b60095473ffffffffffffffffffffffffffffffffffffffff871660009081526008602052604081205492965091610f379185916119d2565b73ffffffffffffffffffffffffffffffffffffffff8716600090815260076020526040902054909150610f6b908290611c07565b9350505050915091565b6060600b80546105f590611b72565b60105473ffffffffffffffffffffffffffffffffffffffff1633146110115760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610216565b61101b8282611722565b60405181815260009073ffffffffffffffffffffffffffffffffffffffff8416907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef90602001610ce6565b601054600090819073ffffffffffffffffffffffffffffffffffffffff1633146110f85760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610216565b600e5434906000906127109061110e9084611c3c565b6111189190611c53565b90508181111561116a5760405162461bcd60e51b815260206004820152601c60248201527f696e76616c69642061746e2076616c696461746f7220726577617264000000006044820152606401610216565b6111748183611bf4565b600d5460405191935060009173ffffffffffffffffffffffffffffffffffffffff909116906108fc90849084818181858888f193505050503d80600081146111d8576040519150601f19603f3d011682016040523d82523d6000602084013e6111dd565b606091505b50909150508015156000036112045781600f60008282546111fe9190611c07565b90915550505b6000612710600e54886112179190611c3c565b6112219190611c53565b9050868111156112735760405162461bcd60e51b815260206004820152601c60248201527f696e76616c6964206e746e2076616c696461746f7220726577617264000000006044820152606401610216565b61127d8188611bf4565b9650801561132657601054600d546040517fa9059cbb00000000000000000000000000000000000000000000000000000000815273ffffffffffffffffffffffffffffffffffffffff91821660048201526024810184905291169063a9059cbb906044016020604051808303816000875af1158015611300573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906113249190611c1a565b505b60035460009061133a633b9aca0087611c3c565b6113449190611c53565b9050806006546113549190611c07565b60065560035460009061136b633b9aca008b611c3c565b6113759190611c53565b9050806009546113859190611c07565b600955600354600090633b9aca009061139e9085611c3c565b6113a89190611c53565b90506000633b9aca00600354846113bf9190611c3c565b6113c99190611c53565b90506113d58288611c07565b6113df8287611c07565b995099505050505050505050915091565b60006113fc3383611722565b611406838361183d565b60405182815273ffffffffffffffffffffffffffffffffffffffff84169033907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef9060200160405180910390a350600192915050565b600d5473ffffffffffffffffffffffffffffffffffffffff1633146114e95760405162461bcd60e51b815260206004820152602260248201527f6f6e6c792074726561737572792063616e20636c61696d20686973207265776160448201527f72640000000000000000000000000000000000000000000000000000000000006064820152608401610216565b600d54600f5460405160009273ffffffffffffffffffffffffffffffffffffffff1691908381818185875af1925050503d8060008114611545576040519150601f19603f3d011682016040523d82523d6000602084013e61154a565b606091505b505090508061159b5760405162461bcd60e51b815260206004820152601260248201527f6661696c656420746f2073656e642041544e00000000000000000000000000006044820152606401610216565b506000600f55565b73ffffffffffffffffffffffffffffffffffffffff831661162b5760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f2061646460448201527f72657373000000000000000000000000000000000000000000000000000000006064820152608401610216565b73ffffffffffffffffffffffffffffffffffffffff82166116b45760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f20616464726560448201527f73730000000000000000000000000000000000000000000000000000000000006064820152608401610216565b73ffffffffffffffffffffffffffffffffffffffff83811660008181526002602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b61172b82611896565b505073ffffffffffffffffffffffffffffffffffffffff8216600090815260208181526040808320546001909252909120546117679082611bf4565b8211156117b65760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e7420756e6c6f636b65642066756e647300000000006044820152606401610216565b6117c08282611bf4565b73ffffffffffffffffffffffffffffffffffffffff84166000908152602081905260409020558082036118215773ffffffffffffffffffffffffffffffffffffffff8316600090815260056020908152604080832083905560089091528120555b81600360008282546118339190611bf4565b9091555050505050565b61184682611896565b505073ffffffffffffffffffffffffffffffffffffffff82166000908152602081905260408120805483929061187d908490611c07565b9250508190555080600360008282546109d59190611c07565b73ffffffffffffffffffffffffffffffffffffffff811660009081526020818152604080832054600654600590935290832054839283916118d89184916119d2565b73ffffffffffffffffffffffffffffffffffffffff861660009081526004602052604090205490915061190c908290611c07565b73ffffffffffffffffffffffffffffffffffffffff8616600090815260046020908152604080832084905560065460058352818420556009546008909252822054929650909161195d9185916119d2565b73ffffffffffffffffffffffffffffffffffffffff8716600090815260076020526040902054909150611991908290611c07565b73ffffffffffffffffffffffffffffffffffffffff909616600090815260076020908152604080832089905560095460089092529091205550929492505050565b6000836000036119e45750600061085d565b633b9aca00846119f48486611bf4565b6119fe9190611c3c565b611a089190611c53565b949350505050565b600060208083528351808285015260005b81811015611a3d57858101830151858201604001528201611a21565b5060006040828601015260407fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe0601f8301168501019250505092915050565b803573ffffffffffffffffffffffffffffffffffffffff81168114611aa057600080fd5b919050565b60008060408385031215611ab857600080fd5b611ac183611a7c565b946020939093013593505050565b600060208284031215611ae157600080fd5b5035919050565b600080600060608486031215611afd57600080fd5b611b0684611a7c565b9250611b1460208501611a7c565b9150604084013590509250925092565b600060208284031215611b3657600080fd5b61085d82611a7c565b60008060408385031215611b5257600080fd5b611b5b83611a7c565b9150611b6960208401611a7c565b90509250929050565b600181811c90821680611b8657607f821691505b602082108103611bbf577f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b8181038181111561068957610689611bc5565b8082018082111561068957610689611bc5565b600060208284031215611c2c57600080fd5b8151801515811461085d57600080fd5b808202811582820484141761068957610689611bc5565b600082611c89577f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b50049056fea264697066735822122048a7cbfe3833669d997bb4362e8661ceaea3c483f62fac7ae471e39149e5682964736f6c63430008150033608060405234801561001057600080fd5b5060405161061038038061061083398101604081905261002f916101af565b806001600160a01b0316632f2c3f2e6040518163ffffffff1660e01b8152600401602060405180830381865afa15801561006d573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610091919061029f565b83111561009d57600080fd5b600c80546001600160a01b038088166001600160a01b031992831617909255600d805492871692909116919091179055600e8390556040516100e39083906020016102b8565b604051602081830303815290604052600a9081610100919061036e565b508160405160200161011291906102b8565b604051602081830303815290604052600b908161012f919061036e565b5050601080546001600160a01b031916331790555061042d92505050565b6001600160a01b038116811461016257600080fd5b50565b80516101708161014d565b919050565b634e487b7160e01b600052604160045260246000fd5b60005b838110156101a657818101518382015260200161018e565b50506000910152565b600080600080600060a086880312156101c757600080fd5b85516101d28161014d565b60208701519095506101e38161014d565b6040870151606088015191955093506001600160401b038082111561020757600080fd5b818801915088601f83011261021b57600080fd5b81518181111561022d5761022d610175565b604051601f8201601f19908116603f0116810190838211818310171561025557610255610175565b816040528281528b602084870101111561026e57600080fd5b61027f83602083016020880161018b565b809650505050505061029360808701610165565b90509295509295909350565b6000602082840312156102b157600080fd5b5051919050565b644c4e544e2d60d81b8152600082516102d881600585016020870161018b565b9190910160050192915050565b600181811c908216806102f957607f821691505b60208210810361031957634e487b7160e01b600052602260045260246000fd5b50919050565b601f82111561036957600081815260208120601f850160051c810160208610156103465750805b601f850160051c820191505b8181101561036557828155600101610352565b5050505b505050565b81516001600160401b0381111561038757610387610175565b61039b8161039584546102e5565b8461031f565b602080601f8311600181146103d057600084156103b85750858301515b600019600386901b1c1916600185901b178555610365565b600085815260208120601f198616915b828110156103ff578886015182559484019460019091019084016103e0565b508582101561041d5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b6101d48061043c6000396000f3fe60806040523661001b57610019610014610022565b61013e565b005b6100196100145b600080601060009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff166396f18a326040518163ffffffff1660e01b8152600401602060405180830381865afa158015610092573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906100b69190610161565b905073ffffffffffffffffffffffffffffffffffffffff8116610139576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152601d60248201527f6c6971756964206c6f67696320636f6e7472616374206e6f7420736574000000604482015260640160405180910390fd5b919050565b3660008037600080366000845af43d6000803e8061015b573d6000fd5b503d6000f35b60006020828403121561017357600080fd5b815173ffffffffffffffffffffffffffffffffffffffff8116811461019757600080fd5b939250505056fea26469706673582212200db76088bf5d8acdca170dade31d69898e8f986dad784e43d3aefe7fdd43feff64736f6c63430008150033")
+var AutonityTestBytecode = common.Hex2Bytes("608060405261c35060045561c35060055561c350600655614e2060075560006010556000601155633b9aca006037553480156200003b57600080fd5b506040516200efbc3803806200efbc8339810160408190526200005e9162001172565b818160128001546000036200008b57603d80546001600160a01b031916331790556200008b828262000095565b5050505062001639565b80518051601255602080820151601355604080830151601455606080840151601555608080850151601681905560a095860151601780546001600160a01b03199081166001600160a01b03938416179091558689015180516018805484169185169190911790558088015160198054841691851691909117905580870151601a8054841691851691909117905580860151601b8054841691851691909117905593840151601c8054831691841691909117905596830151601d8054891691831691909117905560c0830151601e8054891691831691909117905560e090920151601f805488169184169190911790558387015180518654909716969092169590951784559283015160218190558383015160225592810151602355840151602455602891909155603c9190915551620001ce9062000dc0565b604051809103906000f080158015620001eb573d6000803e3d6000fd5b50603e80546001600160a01b0319166001600160a01b039290921691909117905560005b825181101562000549576000838281518110620002305762000230620013af565b602002602001015160a001519050604e848381518110620002555762000255620013af565b6020908102919091018101516102600151825460018101845560009384529282902091830490910180549192909160ff601f9092166101000a918202191690836003811115620002a957620002a9620013c5565b02179055506000848381518110620002c557620002c5620013af565b60200260200101516101a00181815250506000848381518110620002ed57620002ed620013af565b602002602001015161018001906001600160a01b031690816001600160a01b0316815250506000848381518110620003295762000329620013af565b602002602001015160a00181815250506000848381518110620003505762000350620013af565b60209081029190910101516101c0015260145484518590849081106200037a576200037a620013af565b602002602001015160800181815250506000848381518110620003a157620003a1620013af565b602002602001015161026001906003811115620003c257620003c2620013c5565b90816003811115620003d857620003d8620013c5565b815250506000848381518110620003f357620003f3620013af565b6020026020010151610160018181525050620004318483815181106200041d576200041d620013af565b60200260200101516200054e60201b60201c565b6200045e8483815181106200044a576200044a620013af565b60200260200101516200068960201b60201c565b8015620005335780603960008685815181106200047f576200047f620013af565b6020026020010151600001516001600160a01b03166001600160a01b031681526020019081526020016000206000828254620004bc9190620013f1565b9250508190555080603b6000828254620004d79190620013f1565b9250508190555062000531848381518110620004f757620004f7620013af565b60200260200101516020015182868581518110620005195762000519620013af565b6020026020010151600001516200093660201b60201c565b505b508062000540816200140d565b9150506200020f565b505050565b600062000565826060015162000b4d60201b60201c565b6001600160a01b03909116602084015290508015620005b95760405162461bcd60e51b815260206004820152600b60248201526a32b737b2329032b93937b960a91b60448201526064015b60405180910390fd5b6020808301516001600160a01b039081166000908152603a90925260409091206001015416156200062d5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c72656164792072656769737465726564000000006044820152606401620005b0565b61271082608001511115620006855760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e20726174650000000000000000006044820152606401620005b0565b5050565b6101808101516001600160a01b03166200078857603e546001600160a01b0316620007025760405162461bcd60e51b815260206004820152602260248201527f6c6971756964206c6f67696320636f6e7472616374206e6f74206465706c6f79604482015261195960f21b6064820152608401620005b0565b602554600090620007139062000b9b565b905081602001518260000151836080015183603e60009054906101000a90046001600160a01b0316604051620007499062000dce565b6200075995949392919062001429565b604051809103906000f08015801562000776573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051602580546001808201835560009283527f401968ff42a154441da5f6c4c935ac46b8671f0e062baaa62a7545ba53bb6e4c90910180546001600160a01b03199081166001600160a01b0395861617909155845184168352603a909552604091829020865181548716908516178155935190840180548616918416919091179055840151600283018054909416911617909155606082015182919060038201906200083a908262001513565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c820180546001600160a01b0319166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f82015561020082015160108201556102208201516011820155610240820151601282019062000904908262001513565b5061026082015160138201805460ff191660018360038111156200092c576200092c620013c5565b0217905550505050565b6000808311620009955760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201526269766560e81b6064820152608401620005b0565b6001600160a01b038216600090815260396020526040902054831115620009ff5760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e636500000000006044820152606401620005b0565b6001600160a01b0382166000908152603960205260408120805485929062000a29908490620015df565b9091555050604080516080810182526001600160a01b03808516825286811660208084019182528385018881524360608601908152600a805460009081526008909452968320865181549087166001600160a01b03199182161782559451600182018054919097169516949094179094555160028301559151600390910155825491929062000ab8836200140d565b90915550506001600160a01b038581166000818152603a6020908152604091829020548251908516948816948514808252918101899052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d910160405180910390a3833b1562000b325762000b32848762000cbb565b6001600a5462000b439190620015df565b9695505050505050565b60008062000b5a62000ddc565b60008060ff9050604083875160208901845afa62000b7757600080fd5b505080516020909101516c0100000000000000000000000090910494909350915050565b60608160000362000bc35750506040805180820190915260018152600360fc1b602082015290565b8160005b811562000bf3578062000bda816200140d565b915062000beb9050600a836200160b565b915062000bc7565b6000816001600160401b0381111562000c105762000c1062000dfa565b6040519080825280601f01601f19166020018201604052801562000c3b576020820181803683370190505b5090505b841562000cb35762000c53600183620015df565b915062000c62600a8662001622565b62000c6f906030620013f1565b60f81b81838151811062000c875762000c87620013af565b60200101906001600160f81b031916908160001a90535062000cab600a866200160b565b945062000c3f565b949350505050565b6001600160a01b038216600090815260346020526040812054900362000d39576001600160a01b03821660008181526034602052604081206001908190556035805491820181559091527fcfa4bec1d3298408bb5afcfcd9c430549c5b31f8aa5c5848151c0a55f473c34d0180546001600160a01b03191690911790555b6001600160a01b038083166000908152603260209081526040808320938516835292905290812054900362000685576001600160a01b03808316600081815260326020908152604080832094861680845294825280832060019081905593835260338252822080549384018155825290200180546001600160a01b03191690911790555050565b611cf6806200ccb683390190565b610610806200e9ac83390190565b60405180604001604052806002906020820280368337509192915050565b634e487b7160e01b600052604160045260246000fd5b604051608081016001600160401b038111828210171562000e355762000e3562000dfa565b60405290565b60405160c081016001600160401b038111828210171562000e355762000e3562000dfa565b60405161028081016001600160401b038111828210171562000e355762000e3562000dfa565b604051601f8201601f191681016001600160401b038111828210171562000eb15762000eb162000dfa565b604052919050565b6001600160a01b038116811462000ecf57600080fd5b50565b805162000edf8162000eb9565b919050565b60005b8381101562000f0157818101518382015260200162000ee7565b50506000910152565b600082601f83011262000f1c57600080fd5b81516001600160401b0381111562000f385762000f3862000dfa565b62000f4d601f8201601f191660200162000e86565b81815284602083860101111562000f6357600080fd5b62000cb382602083016020870162000ee4565b80516004811062000edf57600080fd5b600061010080838503121562000f9b57600080fd5b604051908101906001600160401b038211818310171562000fc05762000fc062000dfa565b816040528092508351915062000fd68262000eb9565b81815262000fe76020850162000ed2565b602082015262000ffa6040850162000ed2565b60408201526200100d6060850162000ed2565b6060820152620010206080850162000ed2565b60808201526200103360a0850162000ed2565b60a08201526200104660c0850162000ed2565b60c08201526200105960e0850162000ed2565b60e0820152505092915050565b6000608082840312156200107957600080fd5b6200108362000e10565b90508151620010928162000eb9565b8082525060208201516020820152604082015160408201526060820151606082015292915050565b6000818303610260811215620010cf57600080fd5b620010d962000e10565b915060c0811215620010ea57600080fd5b50620010f562000e3b565b825181526020830151602082015260408301516040820152606083015160608201526080830151608082015260a0830151620011318162000eb9565b60a08201528152620011478360c0840162000f86565b60208201526200115c836101c0840162001066565b6040820152610240820151606082015292915050565b6000806102808084860312156200118857600080fd5b83516001600160401b0380821115620011a057600080fd5b818601915086601f830112620011b557600080fd5b8151602082821115620011cc57620011cc62000dfa565b8160051b620011dd82820162000e86565b928352848101820192828101908b851115620011f857600080fd5b83870192505b848310156200138d578251868111156200121757600080fd5b8701808d03601f19018913156200122d57600080fd5b6200123762000e60565b6200124486830162000ed2565b8152620012546040830162000ed2565b86820152620012666060830162000ed2565b60408201526080820151888111156200127e57600080fd5b6200128e8f888386010162000f0a565b60608301525060a0820151608082015260c082015160a082015260e082015160c082015261010082015160e0820152610120820151610100820152610140820151610120820152610160820151610140820152610180820151610160820152620012fc6101a0830162000ed2565b6101808201526101c08201516101a08201526101e08201516101c08201526102008201516101e0820152610220820151610200820152610240820151610220820152610260820151888111156200135257600080fd5b620013628f888386010162000f0a565b61024083015250620013768a830162000f76565b6102608201528352509183019190830190620011fe565b809950505050620013a189828a01620010ba565b955050505050509250929050565b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052602160045260246000fd5b634e487b7160e01b600052601160045260246000fd5b80820180821115620014075762001407620013db565b92915050565b600060018201620014225762001422620013db565b5060010190565b600060018060a01b038088168352808716602084015285604084015260a0606084015284518060a0850152620014678160c086016020890162000ee4565b9316608083015250601f91909101601f19160160c001949350505050565b600181811c908216806200149a57607f821691505b602082108103620014bb57634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156200054957600081815260208120601f850160051c81016020861015620014ea5750805b601f850160051c820191505b818110156200150b57828155600101620014f6565b505050505050565b81516001600160401b038111156200152f576200152f62000dfa565b620015478162001540845462001485565b84620014c1565b602080601f8311600181146200157f5760008415620015665750858301515b600019600386901b1c1916600185901b1785556200150b565b600085815260208120601f198616915b82811015620015b0578886015182559484019460019091019084016200158f565b5085821015620015cf5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b81810381811115620014075762001407620013db565b634e487b7160e01b600052601260045260246000fd5b6000826200161d576200161d620015f5565b500490565b600082620016345762001634620015f5565b500690565b61b66d80620016496000396000f3fe6080604052600436106200066b5760003560e01c8063852c4849116200034b578063b66b3e7911620001bb578063d861b0e811620000ff578063e7f43c6811620000a7578063f03b959d1162000081578063f03b959d146200141c578063f1d592a71462001441578063f5502f221462001466578063f7866ee3146200153a57005b8063e7f43c6814620013bf578063e98712b214620013df578063ee7ff28d14620013f757005b8063de7011b111620000d9578063de7011b1146200136a578063dfb1a4d2146200138f578063dfd4b68814620013a757005b8063d861b0e814620012e3578063d886f8a214620012fb578063dd62ed3e146200132057005b8063ceaad4551162000163578063cfd19fb9116200013d578063cfd19fb91462001277578063d372c07e146200129c578063d5f3948814620012c157005b8063ceaad4551462001222578063cef984501462001247578063cf9c5719146200125f57005b8063bb0db4281162000195578063bb0db42814620011ce578063c9d97af414620011e5578063cb696f5414620011fd57005b8063b66b3e79146200115a578063b6ef8c671462001182578063b7ab4db514620011a757005b8063a515366a116200028f578063ab8f6ffe1162000237578063affb1cf11162000211578063affb1cf114620010c6578063b2ea9adb14620010eb578063b3ecbadd1462001110578063b46e5520146200113557005b8063ab8f6ffe146200105f578063ae1da0b51462001086578063ae1f5fa0146200109e57005b8063a9059cbb1162000269578063a9059cbb1462000fca578063a9af10591462000fef578063a9fd1a8f146200103557005b8063a515366a1462000f75578063a5d059ca1462000f8c578063a8b2216e1462000fa357005b806396b477cb11620002f35780639c98e47111620002cd5780639c98e4711462000f215780639dc29fac1462000f395780639efa9e7a1462000f5e57005b806396b477cb1462000ec257806396f18a321462000ee75780639ac310741462000f0957005b80638bac7dad11620003255780638bac7dad1462000e305780638d7835ea1462000e5557806395d89b411462000e7a57005b8063852c48491462000ddb578063872cf0591462000e0057806389c614b81462000e1857005b806340c10f1911620004e75780636bb9cb0e116200042b57806377e741c711620003d35780637e660ac911620003ad5780637e660ac91462000d46578063819b64631462000d6b578063833b1fce1462000d8257806384467fdb1462000db657005b806377e741c71462000bfe578063784304b51462000c2357806379502c551462000c4857005b806370a08231116200040557806370a082311462000b8357806371d1bc591462000bbd578063731b3a031462000bd557005b80636bb9cb0e1462000b2b5780636be0487b1462000b435780636fd2c80b1462000b6c57005b8063520fdbbc116200048f578063676c24ab1162000469578063676c24ab1462000ac95780636a929cef1462000ae15780636b5f444c1462000b0657005b8063520fdbbc1462000a74578063573963b81462000a995780635bdedd161462000ab157005b8063496ccd9b11620004c1578063496ccd9b1462000a0d5780634bb278f31462000a325780634efcd15f1462000a5d57005b806340c10f1914620009ba578063427bc5de14620009df5780634364596914620009f657005b80631904bb2e11620005af5780632f2c3f2e116200055757806335be16e0116200053157806335be16e01462000966578063386a827b146200098b5780633d0ae21614620009a357005b80632f2c3f2e14620008fc57806330bcb81c1462000914578063313ce567146200094857005b806325ce1bb9116200058957806325ce1bb914620008a45780632701849b14620008cd57806329070c6d14620008e557005b80631904bb2e14620008265780631a0cf2e5146200085a57806323b872dd146200087f57005b80631122063311620006135780631604e41611620005ed5780631604e41614620007f7578063161605e3146200067357806318160ddd146200080f57005b8063112206331462000796578063114eaf5514620007ad5780631250a28d14620007d257005b80630b21fb1d11620006455780630b21fb1d146200072b5780630d8e6e2c14620007505780630fe50109146200077157005b806306fdde031462000675578063095ea7b314620006d05780630ae65e7a146200070657005b366200067357005b005b3480156200068257600080fd5b5060408051808201909152600681527f4e6577746f6e000000000000000000000000000000000000000000000000000060208201525b604051620006c7919062009bf0565b60405180910390f35b348015620006dd57600080fd5b50620006f5620006ef36600462009c22565b6200155a565b6040519015158152602001620006c7565b3480156200071357600080fd5b50620006736200072536600462009c51565b62001573565b3480156200073857600080fd5b50620006736200074a36600462009c71565b62001699565b3480156200075d57600080fd5b506024545b604051908152602001620006c7565b3480156200077e57600080fd5b50620006736200079036600462009c71565b620016fa565b348015620007a357600080fd5b5060135462000762565b348015620007ba57600080fd5b5062000673620007cc36600462009c71565b6200175b565b348015620007df57600080fd5b5062000673620007f136600462009c51565b620017bc565b3480156200080457600080fd5b5062000762602f5481565b3480156200081c57600080fd5b50603b5462000762565b3480156200083357600080fd5b506200084b6200084536600462009c51565b62001849565b604051620006c7919062009d01565b3480156200086757600080fd5b50620006736200087936600462009c51565b62001b26565b3480156200088c57600080fd5b50620006f56200089e36600462009e7a565b62001bb1565b348015620008b157600080fd5b506026546000908152602c602052604090206003015462000762565b348015620008da57600080fd5b506200067362001ccb565b348015620008f257600080fd5b5060125462000762565b3480156200090957600080fd5b506200076261271081565b3480156200092157600080fd5b50620009396200093336600462009c71565b62001d57565b604051620006c7919062009ec0565b3480156200095557600080fd5b5060405160128152602001620006c7565b3480156200097357600080fd5b50620006736200098536600462009f47565b62001e2b565b3480156200099857600080fd5b506200076260055481565b348015620009b057600080fd5b50600e5462000762565b348015620009c757600080fd5b5062000673620009d936600462009c22565b62002021565b348015620009ec57600080fd5b50600a5462000762565b34801562000a0357600080fd5b5060225462000762565b34801562000a1a57600080fd5b506200067362000a2c36600462009c51565b6200208d565b34801562000a3f57600080fd5b5062000a4a62002205565b604051620006c79594939291906200a008565b34801562000a6a57600080fd5b50602b5462000762565b34801562000a8157600080fd5b506200067362000a9336600462009c51565b62002b9c565b34801562000aa657600080fd5b506200076260285481565b34801562000abe57600080fd5b506200076260075481565b34801562000ad657600080fd5b506200067362002e50565b34801562000aee57600080fd5b506200067362000b0036600462009c71565b620036dd565b34801562000b1357600080fd5b506200067362000b2536600462009c71565b6200373e565b34801562000b3857600080fd5b506200076260375481565b34801562000b5057600080fd5b5062000b5b620037f3565b604051620006c7939291906200a041565b34801562000b7957600080fd5b5060155462000762565b34801562000b9057600080fd5b506200076262000ba236600462009c51565b6001600160a01b031660009081526039602052604090205490565b34801562000bca57600080fd5b506200076260065481565b34801562000be257600080fd5b506026546000908152602c602052604090206002015462000762565b34801562000c0b57600080fd5b506200067362000c1d36600462009c71565b62003924565b34801562000c3057600080fd5b506200067362000c423660046200a1ac565b62003985565b34801562000c5557600080fd5b506040805160c080820183526012548252601354602080840191909152601454838501526015546060808501919091526016546080808601919091526017546001600160a01b0390811660a08088019190915287516101008101895260185483168152601954831681870152601a548316818a0152601b54831681860152601c54831681850152601d54831691810191909152601e54821695810195909552601f54811660e086015286519182018752835416815260215492810192909252602254948201949094526023549381019390935260245462000d34939084565b604051620006c794939291906200a202565b34801562000d5357600080fd5b506200067362000d6536600462009c71565b62003bef565b34801562000d7857600080fd5b5060235462000762565b34801562000d8f57600080fd5b506019546001600160a01b03165b6040516001600160a01b039091168152602001620006c7565b34801562000dc357600080fd5b506200067362000dd53660046200a304565b62003c50565b34801562000de857600080fd5b506200067362000dfa36600462009c22565b62003d7f565b34801562000e0d57600080fd5b506200067362003fa1565b34801562000e2557600080fd5b5062000762602a5481565b34801562000e3d57600080fd5b506200067362000e4f36600462009c71565b6200400c565b34801562000e6257600080fd5b506200067362000e743660046200a3bc565b620040bf565b34801562000e8757600080fd5b5060408051808201909152600381527f4e544e00000000000000000000000000000000000000000000000000000000006020820152620006b8565b34801562000ecf57600080fd5b506200076262000ee136600462009c71565b620042cb565b34801562000ef457600080fd5b50603e5462000d9d906001600160a01b031681565b34801562000f1657600080fd5b5062000762603c5481565b34801562000f2e57600080fd5b5062000762602b5481565b34801562000f4657600080fd5b506200067362000f5836600462009c22565b6200437a565b34801562000f6b57600080fd5b50600d5462000762565b6200076262000f8636600462009c22565b620044cd565b6200076262000f9d36600462009c22565b62004619565b34801562000fb057600080fd5b5062000fbb62004729565b604051620006c791906200a44c565b34801562000fd757600080fd5b50620006f562000fe936600462009c22565b6200480c565b34801562000ffc57600080fd5b50620010266200100e36600462009c71565b6000908152600b602052604090206006015460ff1690565b604051620006c791906200a4d0565b3480156200104257600080fd5b506200104d62004864565b604051620006c794939291906200a4e5565b3480156200106c57600080fd5b5062001077620049e1565b604051620006c791906200a516565b3480156200109357600080fd5b506200076260295481565b348015620010ab57600080fd5b50620010b662004af3565b604051620006c79291906200a571565b348015620010d357600080fd5b506200104d620010e536600462009c71565b62004e1f565b348015620010f857600080fd5b50620006736200110a3660046200a5a3565b62005040565b3480156200111d57600080fd5b50620006736200112f36600462009c51565b620050b6565b3480156200114257600080fd5b50620006736200115436600462009c51565b62005141565b3480156200116757600080fd5b506200117262005416565b604051620006c79291906200a604565b3480156200118f57600080fd5b5062000762620011a136600462009c71565b6200554d565b348015620011b457600080fd5b50620011bf620055df565b604051620006c791906200a62d565b348015620011db57600080fd5b5060095462000762565b348015620011f257600080fd5b506200076260265481565b3480156200120a57600080fd5b50620006736200121c36600462009c71565b62005643565b3480156200122f57600080fd5b50620006736200124136600462009c51565b620056d5565b3480156200125457600080fd5b506200076260045481565b3480156200126c57600080fd5b506200067362005760565b3480156200128457600080fd5b50620006736200129636600462009c51565b620057e4565b348015620012a957600080fd5b5062000673620012bb36600462009c51565b6200586f565b348015620012ce57600080fd5b50603d5462000d9d906001600160a01b031681565b348015620012f057600080fd5b5062000673620058fa565b3480156200130857600080fd5b50620006736200131a36600462009c51565b62005bcf565b3480156200132d57600080fd5b50620007626200133f3660046200a642565b6001600160a01b03918216600090815260316020908152604080832093909416825291909152205490565b3480156200137757600080fd5b50620006736200138936600462009c71565b62005c5a565b3480156200139c57600080fd5b506200076262005ce1565b348015620013b457600080fd5b506200067362005cfe565b348015620013cc57600080fd5b506020546001600160a01b031662000d9d565b348015620013ec57600080fd5b5062000762602e5481565b3480156200140457600080fd5b50620006736200141636600462009c71565b62005d08565b3480156200142957600080fd5b50620006736200143b36600462009c51565b62005d69565b3480156200144e57600080fd5b50620006736200146036600462009c51565b62005df4565b3480156200147357600080fd5b50620014f46200148536600462009c71565b6040805160808082018352600080835260208084018290528385018290526060938401829052948152600885528390208351918201845280546001600160a01b039081168352600182015416948201949094526002840154928101929092526003909201549181019190915290565b604051620006c7919060006080820190506001600160a01b0380845116835280602085015116602084015250604083015160408301526060830151606083015292915050565b3480156200154757600080fd5b506017546001600160a01b031662000d9d565b60006200156933848462005efd565b5060015b92915050565b6200157d6200605a565b6001600160a01b038082166000818152603a602052604090206001015490911614620015f05760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d75737420626520726567697374657265640000000060448201526064015b60405180910390fd5b6001600160a01b038181166000908152603a6020526040902054163314620016815760405162461bcd60e51b815260206004820152602c60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72206160448201527f646d696e206163636f756e7400000000000000000000000000000000000000006064820152608401620015e7565b6200168c81620060b3565b6200169660008055565b50565b6020546001600160a01b03163314620016f55760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620015e7565b600555565b6020546001600160a01b03163314620017565760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620015e7565b600455565b6020546001600160a01b03163314620017b75760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620015e7565b601555565b6020546001600160a01b03163314620018185760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620015e7565b6018805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b565b6200185362009910565b6001600160a01b038083166000818152603a602052604090206001015490911614620018c25760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f74207265676973746572656400000000000000006044820152606401620015e7565b6001600160a01b038083166000908152603a6020908152604091829020825161028081018452815485168152600182015485169281019290925260028101549093169181019190915260038201805491929160608401919062001925906200a680565b80601f016020809104026020016040519081016040528092919081815260200182805462001953906200a680565b8015620019a45780601f106200197857610100808354040283529160200191620019a4565b820191906000526020600020905b8154815290600101906020018083116200198657829003601f168201915b505050918352505060048201546020820152600582015460408201526006820154606082015260078201546080820152600882015460a0820152600982015460c0820152600a82015460e0820152600b820154610100820152600c8201546001600160a01b0316610120820152600d820154610140820152600e820154610160820152600f82015461018082015260108201546101a082015260118201546101c08201526012820180546101e09092019162001a60906200a680565b80601f016020809104026020016040519081016040528092919081815260200182805462001a8e906200a680565b801562001adf5780601f1062001ab35761010080835404028352916020019162001adf565b820191906000526020600020905b81548152906001019060200180831162001ac157829003601f168201915b5050509183525050601382015460209091019060ff16600381111562001b095762001b0962009c8b565b600381111562001b1d5762001b1d62009c8b565b90525092915050565b6020546001600160a01b0316331462001b825760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620015e7565b601f805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6001600160a01b03831660009081526031602090815260408083203384529091528120548281101562001c4d5760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e742065786365656473206160448201527f6c6c6f77616e63650000000000000000000000000000000000000000000000006064820152608401620015e7565b62001c5a858585620061a3565b62001c72853362001c6c86856200a704565b62005efd565b836001600160a01b0316856001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8560405162001cb891815260200190565b60405180910390a3506001949350505050565b603d546001600160a01b0316331462001d4d5760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c00000000000000000000000000000000000000000000000000000000006064820152608401620015e7565b6200184762006270565b62001d61620099e0565b6000828152600b602090815260409182902082516101208101845281546001600160a01b03908116825260018301541692810192909252600281015492820192909252600380830154606083015260048301546080830152600583015460a0830152600683015491929160c084019160ff9091169081111562001de85762001de862009c8b565b600381111562001dfc5762001dfc62009c8b565b81526006919091015460ff61010082048116151560208401526201000090910416151560409091015292915050565b6018546001600160a01b0316331462001ead5760405162461bcd60e51b815260206004820152602360248201527f63616c6c6572206973206e6f742074686520736c617368696e6720636f6e747260448201527f61637400000000000000000000000000000000000000000000000000000000006064820152608401620015e7565b6000610120820135603a8262001eca604086016020870162009c51565b6001600160a01b03166001600160a01b031681526020019081526020016000206009015462001efa91906200a704565b60c0830135603a600062001f15604087016020880162009c51565b6001600160a01b03166001600160a01b031681526020019081526020016000206006015462001f4591906200a704565b60a0840135603a600062001f60604088016020890162009c51565b6001600160a01b03166001600160a01b031681526020019081526020016000206005015462001f9091906200a704565b62001f9c91906200a71a565b62001fa891906200a71a565b6017546001600160a01b031660009081526039602052604081208054929350839290919062001fd99084906200a71a565b90915550829050603a600062001ff6604084016020850162009c51565b6001600160a01b0316815260208101919091526040016000206200201b82826200a8f5565b50505050565b6020546001600160a01b031633146200207d5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620015e7565b620020898282620063b1565b5050565b6020546001600160a01b03163314620020e95760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620015e7565b6019805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b03838116918217909255601a546040517f7adbf973000000000000000000000000000000000000000000000000000000008152600481019290925290911690637adbf97390602401600060405180830381600087803b1580156200216e57600080fd5b505af115801562002183573d6000803e3d6000fd5b5050601c546040517f7adbf9730000000000000000000000000000000000000000000000000000000081526001600160a01b0385811660048301529091169250637adbf97391506024015b600060405180830381600087803b158015620021e957600080fd5b505af1158015620021fe573d6000803e3d6000fd5b5050505050565b603d546000908190606090829081906001600160a01b03163314620022935760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c00000000000000000000000000000000000000000000000000000000006064820152608401620015e7565b6200229d6200605a565b4360298190556026546000828152602760209081526040808320849055928252602c90528190206003015460185491517f6c9789b000000000000000000000000000000000000000000000000000000000815292101560048301819052916001600160a01b0390911690636c9789b090602401600060405180830381600087803b1580156200232b57600080fd5b505af115801562002340573d6000803e3d6000fd5b5050505080156200292257601e54603b54603c54602a546040517f92eff3cd0000000000000000000000000000000000000000000000000000000081526004810193909352602483019190915260448201524260648201526000916001600160a01b0316906392eff3cd90608401602060405180830381865afa158015620023cc573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190620023f291906200aada565b905080603c541015620024045750603c545b620024103082620063b1565b80603c60008282546200242491906200a704565b9091555050601f54604080517ff968f49300000000000000000000000000000000000000000000000000000000815281516001600160a01b039093169263f968f4939260048084019391929182900301816000875af1925050508015620024aa575060408051601f3d908101601f19168201909252620024a7918101906200aaf4565b60015b620024e8576040514281527ff1217bb194383aa5c937c81cbbb445990a478c7511b080df329f9b0fb64233c99060200160405180910390a16200251b565b60175462002500906001600160a01b031682620063b1565b601f5462002518906001600160a01b031683620063b1565b50505b306000908152603960205260409020546200253890479062006437565b62002542620069af565b6200254c62006c72565b6200255662006d61565b6200256062006270565b6000806200256d62004af3565b6019546040517f845023f20000000000000000000000000000000000000000000000000000000081529294509092506001600160a01b03169063845023f290620025bc9085906004016200a62d565b600060405180830381600087803b158015620025d757600080fd5b505af1158015620025ec573d6000803e3d6000fd5b50506018546040517fe08b14ed0000000000000000000000000000000000000000000000000000000081526001600160a01b03909116925063e08b14ed91506200263b9084906004016200a62d565b600060405180830381600087803b1580156200265657600080fd5b505af11580156200266b573d6000803e3d6000fd5b50506026546000908152602c602052604090206002015460285460215491935014801591506200269c575060285415155b15620027235760285460218190556018546040517f6b5f444c00000000000000000000000000000000000000000000000000000000815260048101929092526001600160a01b031690636b5f444c90602401600060405180830381600087803b1580156200270957600080fd5b505af11580156200271e573d6000803e3d6000fd5b505050505b604d54156200276e57604654603f55604754604055604854604155604954604255604a54604355604b54604455604c546045805460ff191660ff90921615159190911790556000604d555b6021546000906200278090436200a71a565b905042602a819055506001602660008282546200279e91906200a71a565b909155505060265460408051602d805460a0602082028401810190945260808301818152620028e09594849391929060009085015b82821015620028c1576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162002829906200a680565b80601f016020809104026020016040519081016040528092919081815260200182805462002857906200a680565b8015620028a85780601f106200287c57610100808354040283529160200191620028a8565b820191906000526020600020905b8154815290600101906020018083116200288a57829003601f168201915b50505050508152505081526020019060010190620027d3565b5050505081526020018581526020014381526020018481525062006e00565b7febad8099c467528a56c98b63c8d476d251cf1ffb4c75db94b4d23fa2b6a1e3356026546040516200291491815260200190565b60405180910390a150505050505b601954604080517f4bb278f300000000000000000000000000000000000000000000000000000000815290516000926001600160a01b031691634bb278f3916004808301926020929190829003018187875af115801562002987573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190620029ad91906200ab19565b9050801562002a4257601a54604080517fa2e6204500000000000000000000000000000000000000000000000000000000815290516001600160a01b039092169163a2e620459160048082019260209290919082900301816000875af192505050801562002a3a575060408051601f3d908101601f1916820190925262002a37918101906200ab19565b60015b1562002a4257505b600380546026546000908152602c602090815260408083206001810154950154602d805483518186028101860190945280845260ff909616968996919590949293928692919084015b8282101562002b79576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162002ae1906200a680565b80601f016020809104026020016040519081016040528092919081815260200182805462002b0f906200a680565b801562002b605780601f1062002b345761010080835404028352916020019162002b60565b820191906000526020600020905b81548152906001019060200180831162002b4257829003601f168201915b5050505050815250508152602001906001019062002a8b565b50505050925096509650965096509650505062002b9560008055565b9091929394565b6020546001600160a01b0316331462002bf85760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620015e7565b6020805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b038381169182179092556019546040517fb3ab15fb00000000000000000000000000000000000000000000000000000000815260048101929092529091169063b3ab15fb90602401600060405180830381600087803b15801562002c7d57600080fd5b505af115801562002c92573d6000803e3d6000fd5b5050601a546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b15801562002cf757600080fd5b505af115801562002d0c573d6000803e3d6000fd5b5050601b546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b15801562002d7157600080fd5b505af115801562002d86573d6000803e3d6000fd5b5050601c546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b15801562002deb57600080fd5b505af115801562002e00573d6000803e3d6000fd5b5050601d546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401620021ce565b62002e5a62006c72565b60008062002e6762004af3565b915091506000825167ffffffffffffffff81111562002e8a5762002e8a6200a0e8565b60405190808252806020026020018201604052801562002eb4578160200160208202803683370190505b50602354602d549192506000918291101562002f395760405162461bcd60e51b815260206004820152602760248201527f636f6d6d69747465652073697a652065786365656473204d6178436f6d6d697460448201527f74656553697a65000000000000000000000000000000000000000000000000006064820152608401620015e7565b60005b602d548110156200350e576000602d828154811062002f5f5762002f5f6200ab39565b60009182526020909120600390910201546001600160a01b031690508062002fca5760405162461bcd60e51b815260206004820152600f60248201527f696e76616c6964206164647265737300000000000000000000000000000000006044820152606401620015e7565b8085838151811062002fe05762002fe06200ab39565b60200260200101906001600160a01b031690816001600160a01b0316815250506000602d83815481106200301857620030186200ab39565b9060005260206000209060030201600101549050600081116200307e5760405162461bcd60e51b815260206004820152601460248201527f30207374616b6520696e20636f6d6d69747465650000000000000000000000006044820152606401620015e7565b6200308a81866200a71a565b94508215620030e55780841015620030e55760405162461bcd60e51b815260206004820152601c60248201527f636f6d6d6974746565206d656d62657273206e6f7420736f72746564000000006044820152606401620015e7565b6001600160a01b038083166000818152603a6020526040902060018101549396508693909216146200315a5760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f7220646f6573206e6f7420657869737400000000000000006044820152606401620015e7565b81816005015414620031af5760405162461bcd60e51b815260206004820152600e60248201527f7374616b65206d69736d617463680000000000000000000000000000000000006044820152606401620015e7565b888481518110620031c457620031c46200ab39565b602090810291909101015160028201546001600160a01b03908116911614620032305760405162461bcd60e51b815260206004820152601760248201527f6f7261636c652061646472657373206d69736d617463680000000000000000006044820152606401620015e7565b8784815181106200324557620032456200ab39565b60200260200101516001600160a01b0316602d85815481106200326c576200326c6200ab39565b60009182526020909120600390910201546001600160a01b031614620032fb5760405162461bcd60e51b815260206004820152602860248201527f6163636f756e746162696c697479207265706f7274657220616464726573732060448201527f6d69736d617463680000000000000000000000000000000000000000000000006064820152608401620015e7565b6000601382015460ff16600381111562003319576200331962009c8b565b14620033685760405162461bcd60e51b815260206004820152601460248201527f76616c696461746f72206e6f74206163746976650000000000000000000000006044820152606401620015e7565b603084815481106200337e576200337e6200ab39565b906000526020600020016040516020016200339a91906200abe3565b6040516020818303038152906040528051906020012081600301604051602001620033c691906200abe3565b60405160208183030381529060405280519060200120146200342b5760405162461bcd60e51b815260206004820152600e60248201527f656e6f6465206d69736d617463680000000000000000000000000000000000006044820152606401620015e7565b602d84815481106200344157620034416200ab39565b90600052602060002090600302016002016040516020016200346491906200abe3565b60405160208183030381529060405280519060200120816012016040516020016200349091906200abe3565b6040516020818303038152906040528051906020012014620034f55760405162461bcd60e51b815260206004820152601660248201527f636f6e73656e737573206b6579206d69736d61746368000000000000000000006044820152606401620015e7565b505050808062003505906200abf1565b91505062002f3c565b50602b548214620035625760405162461bcd60e51b815260206004820152601460248201527f746f74616c207374616b65206d69736d617463680000000000000000000000006044820152606401620015e7565b60005b602554811015620036d5576000805b8551811015620035f4578581815181106200359357620035936200ab39565b60200260200101516001600160a01b031660258481548110620035ba57620035ba6200ab39565b6000918252602090912001546001600160a01b031603620035df5760019150620035f4565b80620035eb816200abf1565b91505062003574565b506000603a6000602585815481106200361157620036116200ab39565b60009182526020808320909101546001600160a01b03168352820192909252604001812091508215159003620036bd578381600501541115620036bd5760405162461bcd60e51b815260206004820152602360248201527f68696768207374616b6520666f72206e6f6e2d636f6d6d6974746565206d656d60448201527f62657200000000000000000000000000000000000000000000000000000000006064820152608401620015e7565b50508080620036cc906200abf1565b91505062003565565b505050505050565b6020546001600160a01b03163314620037395760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620015e7565b603755565b6020546001600160a01b031633146200379a5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620015e7565b60288190556026546000908152602c6020908152604091829020600301548251848152918201819052917f2eea6438d890c8603d4df81ad1bad2a4ea45c02b4837165f461ff3c81603abc7910160405180910390a15050565b620038366040518060e001604052806000815260200160008152602001600081526020016000815260200160008152602001600081526020016000151581525090565b620038796040518060e001604052806000815260200160008152602001600081526020016000815260200160008152602001600081526020016000151581525090565b5050604d546040805160e08082018352603f54825282546020808401919091526041548385015260425460608085019190915260435460808086019190915260445460a08087019190915260455460ff908116151560c080890191909152885196870189526046548752604754958701959095526048549786019790975260495492850192909252604a5490840152604b5490830152604c5490931615159281019290925292909190565b6020546001600160a01b03163314620039805760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620015e7565b601255565b6001600160a01b038083166000818152603a60205260409020600181015490921614620039f55760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f74207265676973746572656400000000000000006044820152606401620015e7565b80546001600160a01b0316331462003a765760405162461bcd60e51b815260206004820152602f60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72207460448201527f72656173757279206163636f756e7400000000000000000000000000000000006064820152608401620015e7565b62003a818362006edd565b1562003af65760405162461bcd60e51b815260206004820152602260248201527f76616c696461746f72206d757374206e6f7420626520696e20636f6d6d69747460448201527f65650000000000000000000000000000000000000000000000000000000000006064820152608401620015e7565b60008062003b048462006f4e565b92509050811562003b585760405162461bcd60e51b815260206004820152600b60248201527f656e6f6465206572726f720000000000000000000000000000000000000000006044820152606401620015e7565b60018301546001600160a01b0382811691161462003bdf5760405162461bcd60e51b815260206004820152602760248201527f76616c696461746f72206e6f646520616464726573732063616e27742062652060448201527f75706461746564000000000000000000000000000000000000000000000000006064820152608401620015e7565b60038301620036d585826200ac0e565b6020546001600160a01b0316331462003c4b5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620015e7565b600755565b6000604051806102800160405280336001600160a01b0316815260200160006001600160a01b03168152602001856001600160a01b0316815260200186815260200160126000016002015481526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b03168152602001600081526020014381526020016000815260200160008152602001600081526020018481526020016000600381111562003d1f5762003d1f62009c8b565b9052905062003d2f818362006f9c565b60208101516101808201516040517f8ad8bd2eb6950e5f332fd3a6dca48cb358ecfe3057848902b98cbdfe455c915c9262003d7092339289918b916200acdb565b60405180910390a15050505050565b6001600160a01b038083166000818152603a60205260409020600101549091161462003dee5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d7573742062652072656769737465726564000000006044820152606401620015e7565b6001600160a01b038281166000908152603a602052604090205416331462003e7f5760405162461bcd60e51b815260206004820152602c60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72206160448201527f646d696e206163636f756e7400000000000000000000000000000000000000006064820152608401620015e7565b61271081111562003ed35760405162461bcd60e51b815260206004820152601f60248201527f7265717569726520636f727265637420636f6d6d697373696f6e2072617465006044820152606401620015e7565b604080516060810182526001600160a01b038481168252436020808401918252838501868152601180546000908152600f9093529582208551815473ffffffffffffffffffffffffffffffffffffffff191695169490941784559151600180850191909155915160029093019290925583549293909290919062003f599084906200a71a565b90915550506040518281526001600160a01b038416907f4fba51c92fa3d6ad8374d394f6cd5766857552e153d7384a8f23aa4ce9a8a7cf9060200160405180910390a2505050565b6020546001600160a01b0316331462003ffd5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620015e7565b6003805460ff19166001179055565b6020546001600160a01b03163314620040685760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620015e7565b60008111620040ba5760405162461bcd60e51b815260206004820152601960248201527f636f6d6d69747465652073697a652063616e27742062652030000000000000006044820152606401620015e7565b602355565b6020546001600160a01b031633146200411b5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620015e7565b805162004128906200743f565b80156200413f57506200413f81604001516200743f565b80156200415657506200415681608001516200743f565b620041a45760405162461bcd60e51b815260206004820152601d60248201527f696e697469616c2074696d656f7574206f7574206f6620626f756e64730000006044820152606401620015e7565b620041b3816020015162007457565b8015620041ca5750620041ca816060015162007457565b8015620041e15750620041e18160a0015162007457565b6200422f5760405162461bcd60e51b815260206004820152601b60248201527f74696d656f75742064656c7461206f7574206f6620626f756e647300000000006044820152606401620015e7565b805160465560208082015160475560408083015160485560608301516049556080830151604a5560a0830151604b5560c0830151604c805460ff19169115159190911790556026546000908152602c9092529081902060030154604d81905590517fa4cc9b6d7e44f45ad3dfc950f52c5b7c915f5aaab5b2316be12db869407309f291620042c0918491906200ad25565b60405180910390a150565b60006029546001620042de91906200a71a565b821115620043555760405162461bcd60e51b815260206004820152602660248201527f63616e6e6f74206765742065706f636820696420666f7220612066757475726560448201527f20626c6f636b00000000000000000000000000000000000000000000000000006064820152608401620015e7565b602954821162004372575060009081526027602052604090205490565b505060265490565b6020546001600160a01b03163314620043d65760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620015e7565b6001600160a01b038216600090815260396020526040902054811115620044405760405162461bcd60e51b815260206004820152601660248201527f416d6f756e7420657863656564732062616c616e6365000000000000000000006044820152606401620015e7565b6001600160a01b038216600090815260396020526040812080548392906200446a9084906200a704565b9250508190555080603b60008282546200448591906200a704565b90915550506040518181526001600160a01b038316907f5024dbeedf0c06664c9bd7be836915730c955e936972c020683dadf11d5488a3906020015b60405180910390a25050565b6000620044d96200605a565b6001600160a01b038084166000818152603a602052604090206001015490911614620045485760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f74207265676973746572656400000000000000006044820152606401620015e7565b6001600160a01b0383166000908152603a602052604081206013015460ff1660038111156200457b576200457b62009c8b565b14620045ca5760405162461bcd60e51b815260206004820152601b60248201527f76616c696461746f72206e65656420746f2062652061637469766500000000006044820152606401620015e7565b603754620045d990346200adb2565b3360009081526038602052604081208054909190620045fa9084906200a71a565b909155506200460d90508383336200746f565b90506200156d60008055565b6000620046256200605a565b6001600160a01b038084166000818152603a602052604090206001015490911614620046945760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f74207265676973746572656400000000000000006044820152606401620015e7565b60008211620046e65760405162461bcd60e51b815260206004820152601560248201527f756e626f6e64696e6720616d6f756e74206973203000000000000000000000006044820152606401620015e7565b603754620046f590346200adb2565b3360009081526038602052604081208054909190620047169084906200a71a565b909155506200460d9050838333620076ad565b60606030805480602002602001604051908101604052809291908181526020016000905b82821015620048035783829060005260206000200180546200476f906200a680565b80601f01602080910402602001604051908101604052809291908181526020018280546200479d906200a680565b8015620047ee5780601f10620047c257610100808354040283529160200191620047ee565b820191906000526020600020905b815481529060010190602001808311620047d057829003601f168201915b5050505050815260200190600101906200474d565b50505050905090565b60006200481b338484620061a3565b6040518281526001600160a01b0384169033907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef9060200160405180910390a350600192915050565b6026546000908152602c602090815260408083208151815460a094810282018501909352608081018381526060959485948594859493909284928491879085015b8282101562004993576000848152602090819020604080516060810182526003860290920180546001600160a01b0316835260018101549383019390935260028301805492939291840191620048fb906200a680565b80601f016020809104026020016040519081016040528092919081815260200182805462004929906200a680565b80156200497a5780601f106200494e576101008083540402835291602001916200497a565b820191906000526020600020905b8154815290600101906020018083116200495c57829003601f168201915b50505050508152505081526020019060010190620048a5565b50505090825250600182015460208083019190915260028301546040808401919091526003909301546060928301528351908401519284015193909101519098919750919550909350915050565b6060602d805480602002602001604051908101604052809291908181526020016000905b8282101562004803576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162004a5b906200a680565b80601f016020809104026020016040519081016040528092919081815260200182805462004a89906200a680565b801562004ada5780601f1062004aae5761010080835404028352916020019162004ada565b820191906000526020600020905b81548152906001019060200180831162004abc57829003601f168201915b5050505050815250508152602001906001019062004a05565b603d5460609081906001600160a01b0316331462004b7a5760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c00000000000000000000000000000000000000000000000000000000006064820152608401620015e7565b60255462004bcb5760405162461bcd60e51b815260206004820152601860248201527f5468657265206d7573742062652076616c696461746f727300000000000000006044820152606401620015e7565b62004bd562009a52565b602354608082015260258152603a6020820152602d6040820152602b606082015262004c018162007b31565b62004c0f6030600062009a70565b602d548062004c615760405162461bcd60e51b815260206004820152601260248201527f636f6d6d697474656520697320656d70747900000000000000000000000000006044820152606401620015e7565b60008167ffffffffffffffff81111562004c7f5762004c7f6200a0e8565b60405190808252806020026020018201604052801562004ca9578160200160208202803683370190505b50905060008267ffffffffffffffff81111562004cca5762004cca6200a0e8565b60405190808252806020026020018201604052801562004cf4578160200160208202803683370190505b50905060005b8381101562004e13576000603a6000602d848154811062004d1f5762004d1f6200ab39565b60009182526020808320600392830201546001600160a01b031684528301939093526040909101812060308054600181018255925292507f6ff97a59c90d62cc7236ba3a37cd85351bf564556780cf8c1157a220f31f0cbb019062004d87908301826200adc9565b50600281015484516001600160a01b039091169085908490811062004db05762004db06200ab39565b6001600160a01b0392831660209182029290920101526001820154845191169084908490811062004de55762004de56200ab39565b6001600160a01b0390921660209283029190910190910152508062004e0a816200abf1565b91505062004cfa565b50909450925050509091565b60606000806000602954600162004e3791906200a71a565b85111562004eae5760405162461bcd60e51b815260206004820152602360248201527f63616e6e6f74206765742065706f636820666f7220612066757475726520626c60448201527f6f636b00000000000000000000000000000000000000000000000000000000006064820152608401620015e7565b602654602954861162004ecc57506000858152602760205260409020545b6000818152602c602090815260408083208151815460a09481028201850190935260808101838152909391928492849190879085015b8282101562004ff0576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162004f58906200a680565b80601f016020809104026020016040519081016040528092919081815260200182805462004f86906200a680565b801562004fd75780601f1062004fab5761010080835404028352916020019162004fd7565b820191906000526020600020905b81548152906001019060200180831162004fb957829003601f168201915b5050505050815250508152602001906001019062004f02565b5050509082525060018201546020808301919091526002830154604080840191909152600390930154606092830152835190840151928401519390910151909a9199509197509095509350505050565b6020546001600160a01b031633146200509c5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620015e7565b620050a960018362007b51565b6200208960028262007b51565b6020546001600160a01b03163314620051125760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620015e7565b601b805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6200514b6200605a565b6001600160a01b038082166000818152603a602052604090206001015490911614620051ba5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d7573742062652072656769737465726564000000006044820152606401620015e7565b6001600160a01b038082166000908152603a60205260409020805490911633146200524e5760405162461bcd60e51b815260206004820152602f60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72207460448201527f72656173757279206163636f756e7400000000000000000000000000000000006064820152608401620015e7565b6000601382015460ff1660038111156200526c576200526c62009c8b565b03620052bb5760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f7220616c72656164792061637469766500000000000000006044820152606401620015e7565b6002601382015460ff166003811115620052d957620052d962009c8b565b148015620052ea5750438160100154115b15620053395760405162461bcd60e51b815260206004820152601760248201527f76616c696461746f72207374696c6c20696e206a61696c0000000000000000006044820152606401620015e7565b6003601382015460ff16600381111562005357576200535762009c8b565b03620053a65760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206a61696c6564207065726d616e656e746c79000000006044820152606401620015e7565b60138101805460ff1916905580546026546000908152602c60209081526040918290206003015491519182526001600160a01b038581169316917f60fcbf2d07dc712a93e59fb28f1edb626d7c2497c57ba71a8c0b3999ecb9a3b5910160405180910390a3506200169660008055565b606080600160028180546200542b906200a680565b80601f016020809104026020016040519081016040528092919081815260200182805462005459906200a680565b8015620054aa5780601f106200547e57610100808354040283529160200191620054aa565b820191906000526020600020905b8154815290600101906020018083116200548c57829003601f168201915b50505050509150808054620054bf906200a680565b80601f0160208091040260200160405190810160405280929190818152602001828054620054ed906200a680565b80156200553e5780601f1062005512576101008083540402835291602001916200553e565b820191906000526020600020905b8154815290600101906020018083116200552057829003601f168201915b50505050509050915091509091565b600060036000838152600b602052604090206006015460ff1660038111156200557a576200557a62009c8b565b14620055c95760405162461bcd60e51b815260206004820152601e60248201527f756e626f6e64696e672072656c65617365206e6f7420726576657274656400006044820152606401620015e7565b506000908152600b602052604090206005015490565b606060258054806020026020016040519081016040528092919081815260200182805480156200563957602002820191906000526020600020905b81546001600160a01b031681526001909101906020018083116200561a575b5050505050905090565b6020546001600160a01b031633146200569f5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620015e7565b60138190556040518181527f1f4d2fc7529047a5bd96d3229bfea127fd18b7748f13586e097c69fccd38912890602001620042c0565b6020546001600160a01b03163314620057315760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620015e7565b601d805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6020546001600160a01b03163314620057bc5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620015e7565b620057ca6001600062009a90565b620057d86002600062009a90565b6003805460ff19169055565b6020546001600160a01b03163314620058405760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620015e7565b601c805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6020546001600160a01b03163314620058cb5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620015e7565b601a805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b603d546001600160a01b031633146200597c5760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c00000000000000000000000000000000000000000000000000000000006064820152608401620015e7565b620059866200605a565b6200599062006c72565b60005b604e5481101562005a4c57604e8181548110620059b457620059b46200ab39565b90600052602060002090602091828204019190069054906101000a900460ff16603a600060258481548110620059ee57620059ee6200ab39565b60009182526020808320909101546001600160a01b031683528201929092526040019020601301805460ff1916600183600381111562005a325762005a3262009c8b565b02179055508062005a43816200abf1565b91505062005993565b5062005a5b604e600062009acf565b62005a6562004af3565b505042602a5543602981905560009081526027602090815260408083208390556026548151602d805460a0958102830186019094526080820184815262005bc596939592948594919390919085015b8282101562005ba2576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162005b0a906200a680565b80601f016020809104026020016040519081016040528092919081815260200182805462005b38906200a680565b801562005b895780601f1062005b5d5761010080835404028352916020019162005b89565b820191906000526020600020905b81548152906001019060200180831162005b6b57829003601f168201915b5050505050815250508152602001906001019062005ab4565b505050908252506000602082015243604082015260215460609091015262006e00565b6200184760008055565b6020546001600160a01b0316331462005c2b5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620015e7565b6017805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b603d546001600160a01b0316331462005cdc5760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c00000000000000000000000000000000000000000000000000000000006064820152608401620015e7565b602955565b6028546021546000911462005cf7575060285490565b5060215490565b6200184762006c72565b6020546001600160a01b0316331462005d645760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620015e7565b600655565b6020546001600160a01b0316331462005dc55760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620015e7565b601e805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6020546001600160a01b0316331462005e505760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620015e7565b6001600160a01b03811662005ece5760405162461bcd60e51b815260206004820152602960248201527f696e76616c696420636f6e7472616374206164647265737320666f72206c697160448201527f756964206c6f67696300000000000000000000000000000000000000000000006064820152608401620015e7565b603e805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6001600160a01b03831662005f7a5760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f2061646460448201527f72657373000000000000000000000000000000000000000000000000000000006064820152608401620015e7565b6001600160a01b03821662005ff85760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f20616464726560448201527f73730000000000000000000000000000000000000000000000000000000000006064820152608401620015e7565b6001600160a01b0383811660008181526031602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92591015b60405180910390a3505050565b60005415620060ac5760405162461bcd60e51b815260206004820152601360248201527f7265656e7472616e6379206465746563746564000000000000000000000000006044820152606401620015e7565b6001600055565b6001600160a01b0381166000908152603a6020526040812090601382015460ff166003811115620060e857620060e862009c8b565b14620061375760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206d7573742062652061637469766500000000000000006044820152606401620015e7565b60138101805460ff1916600117905580546026546000908152602c60209081526040918290206003015491519182526001600160a01b038581169316917f75bdcdbe540758778e669d108fbcb7ede734f27f46e4e5525eeb8ecf91849a9c910160405180910390a35050565b6001600160a01b0383166000908152603960205260409020548111156200620d5760405162461bcd60e51b815260206004820152601660248201527f616d6f756e7420657863656564732062616c616e6365000000000000000000006044820152606401620015e7565b6001600160a01b03831660009081526039602052604081208054839290620062379084906200a704565b90915550506001600160a01b03821660009081526039602052604081208054839290620062669084906200a71a565b9091555050505050565b601154601054101562001847576010546000908152600f6020526040902060155460018201544391620062a3916200a71a565b1115620062ad5750565b80546001600160a01b039081166000908152603a60205260409081902060028401546004808301829055600c83015493517f19fac8fd000000000000000000000000000000000000000000000000000000008152929493909316926319fac8fd926200631e92910190815260200190565b600060405180830381600087803b1580156200633957600080fd5b505af11580156200634e573d6000803e3d6000fd5b5050601080546000908152600f60205260408120805473ffffffffffffffffffffffffffffffffffffffff1916815560018082018390556002909101829055825490945091925090620063a39084906200a71a565b909155506200627092505050565b6001600160a01b03821660009081526039602052604081208054839290620063db9084906200a71a565b9250508190555080603b6000828254620063f691906200a71a565b90915550506040518181526001600160a01b038316907f48490b4407bb949b708ec5f514b4167f08f4969baaf78d53b05028adf369bfcf90602001620044c1565b8115801562006444575080155b156200644e575050565b601254600090670de0b6b3a7640000906200646b9085906200aeab565b6200647791906200adb2565b90508015620064f5576017546040516000916001600160a01b03169083908381818185875af1925050503d8060008114620064cf576040519150601f19603f3d011682016040523d82523d6000602084013e620064d4565b606091505b5090915050801515600103620064f357620064f082856200a704565b93505b505b82602e60008282546200650991906200a71a565b90915550600090505b602d548110156200201b576000603a6000602d84815481106200653957620065396200ab39565b600091825260208083206003909202909101546001600160a01b031683528201929092526040018120602b54602d80549294509091889190869081106200658457620065846200ab39565b906000526020600020906003020160010154620065a291906200aeab565b620065ae91906200adb2565b90506000602b5486602d8681548110620065cc57620065cc6200ab39565b906000526020600020906003020160010154620065ea91906200aeab565b620065f691906200adb2565b90506000821180620066085750600081115b1562006996576002601384015460ff1660038111156200662c576200662c62009c8b565b14806200665457506003601384015460ff16600381111562006652576200665262009c8b565b145b156200673657601854620066749030906001600160a01b031683620061a3565b601854602d80546001600160a01b039092169163a8031a1d91859188908110620066a257620066a26200ab39565b600091825260209091206003909102015460405160e084901b7fffffffff000000000000000000000000000000000000000000000000000000001681526001600160a01b039091166004820152602481018590526044016000604051808303818588803b1580156200671357600080fd5b505af115801562006728573d6000803e3d6000fd5b50505050505050506200699a565b600083600501548385600801546200674f91906200aeab565b6200675b91906200adb2565b905080156200681d57835460405160009182916001600160a01b03909116906108fc90859084818181858888f193505050503d8060008114620067bb576040519150601f19603f3d011682016040523d82523d6000602084013e620067c0565b606091505b5090925090508115156000036200681a5785546040517f1137d8c966ce69b9630fb2294be011f3d64cc56e91fad7d375f0662568e9d3529162006811916001600160a01b039091169084906200aec5565b60405180910390a15b50505b600084600501548386600801546200683691906200aeab565b6200684291906200adb2565b9050801562006864578454620068649030906001600160a01b031683620061a3565b60006200687282856200a704565b905060006200688284876200a704565b90506000811180620068945750600082115b156200694757600c870154620068b69030906001600160a01b031684620061a3565b600c8701546040517fa0ce552d000000000000000000000000000000000000000000000000000000008152600481018490526001600160a01b039091169063a0ce552d908390602401604080518083038185885af11580156200691d573d6000803e3d6000fd5b50505050506040513d601f19601f820116820180604052508101906200694491906200aaf4565b50505b600187015460408051888152602081018890526001600160a01b03909216917f291e8ba3c0f4b0bd86e6e2346fcee1e7ca0975b1cc1886bfbc722d34f3f24d91910160405180910390a2505050505b5050505b80620069a6816200abf1565b91505062006512565b60355460005b818110156200208957600060358281548110620069d657620069d66200ab39565b60009182526020808320909101546001600160a01b03168083526033825260408084208054825181860281018601909352808352929550909290919083018282801562006a4d57602002820191906000526020600020905b81546001600160a01b0316815260019091019060200180831162006a2e575b5050505050905060005b815181101562006ad5576001600160a01b0383166000908152603260205260408120835190919084908490811062006a935762006a936200ab39565b60200260200101516001600160a01b03166001600160a01b0316815260200190815260200160002060009055808062006acc906200abf1565b91505062006a57565b506001600160a01b038216600090815260336020526040812062006af99162009af6565b6001600160a01b038216600090815260386020526040902054815160075462006b2391906200aeab565b81111562006b3f57815160075462006b3c91906200aeab565b90505b60005a9050836001600160a01b031663d18736ab83856040518363ffffffff1660e01b815260040162006b7391906200a62d565b600060405180830381600088803b15801562006b8e57600080fd5b5087f19350505050801562006ba1575060015b62006bd7575a62006bb390826200a704565b6001600160a01b038516600090815260366020526040902060019055905062006be7565b5a62006be490826200a704565b90505b6001600160a01b03841660009081526038602052604090205481101562006c3e576001600160a01b0384166000908152603860205260408120805483929062006c329084906200a704565b9091555062006c589050565b6001600160a01b0384166000908152603860205260408120555b50505050808062006c69906200abf1565b915050620069b5565b6009545b600a5481101562006c9f5762006c998162006c91816200abf1565b925062007ca4565b62006c76565b50600a54600955600d54600c540362006cb457565b600e545b600d5481101562006ce15762006cdb8162006cd3816200abf1565b925062007f5e565b62006cb8565b50600d54600e55600c54805b600d5481101562006d5b576015546000828152600b6020526040902060040154439162006d1a916200a71a565b1162006d405762006d2b8162008349565b62006d386001836200a71a565b915062006d46565b62006d5b565b8062006d52816200abf1565b91505062006ced565b50600c55565b60355460005b8181101562006df15760006035828154811062006d885762006d886200ab39565b60009182526020808320909101546001600160a01b0316808352603482526040808420849055603690925291205490915060010362006ddb576001600160a01b0381166000908152603660205260408120555b508062006de8816200abf1565b91505062006d67565b50620016966035600062009af6565b6000828152602c60209081526040808320918401516001830155830151600282015560608301516003820155905b8251518110156200201b57825180518391908390811062006e535762006e536200ab39565b6020908102919091018101518254600180820185556000948552938390208251600390920201805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0390921691909117815591810151928201929092556040820151600282019062006ec490826200ac0e565b505050808062006ed4906200abf1565b91505062006e2e565b6000805b602d5481101562006f4557602d818154811062006f025762006f026200ab39565b60009182526020909120600390910201546001600160a01b039081169084160362006f305750600192915050565b8062006f3c816200abf1565b91505062006ee1565b50600092915050565b60008062006f5b62009b16565b60008060ff9050604083875160208901845afa62006f7857600080fd5b505080516020909101516c0100000000000000000000000090910494909350915050565b60e281511462006fef5760405162461bcd60e51b815260206004820152601460248201527f496e76616c69642070726f6f66206c656e6774680000000000000000000000006044820152606401620015e7565b60308261024001515114620070475760405162461bcd60e51b815260206004820152601c60248201527f496e76616c696420636f6e73656e737573206b6579206c656e677468000000006044820152606401620015e7565b6200705282620084f2565b604080518082018252601a81527f19457468657265756d205369676e6564204d6573736167653a0a000000000000602080830191909152845192519192600092620070c8920160609190911b7fffffffffffffffffffffffffffffffffffffffff00000000000000000000000016815260140190565b6040516020818303038152906040529050600082620070e8835162008631565b83604051602001620070fd939291906200aef7565b60408051601f19818403018152828252805160209182012060028085526060850184529094506000939290918301908036833701905050905060008080806200715589826200714f604160026200aeab565b62008786565b90506000620071748a6200716c604160026200aeab565b606062008786565b905060205b825181101562007245576200718f8382620088ba565b6040805160008152602081018083528d905260ff8316918101919091526060810184905260808101839052929850909650945060019060a0016020604051602081039080840390855afa158015620071eb573d6000803e3d6000fd5b5050604051601f190151905087620072056041846200adb2565b815181106200721857620072186200ab39565b6001600160a01b03909216602092830291909101909101526200723d6041826200a71a565b905062007179565b508a602001516001600160a01b0316866000815181106200726a576200726a6200ab39565b60200260200101516001600160a01b031614620072f05760405162461bcd60e51b815260206004820152602960248201527f496e76616c6964206e6f6465206b6579206f776e6572736869702070726f6f6660448201527f2070726f766964656400000000000000000000000000000000000000000000006064820152608401620015e7565b8a604001516001600160a01b0316866001815181106200731457620073146200ab39565b60200260200101516001600160a01b0316146200739a5760405162461bcd60e51b815260206004820152602b60248201527f496e76616c6964206f7261636c65206b6579206f776e6572736869702070726f60448201527f6f662070726f76696465640000000000000000000000000000000000000000006064820152608401620015e7565b6001620073b28c6102400151838e60000151620088f1565b14620074275760405162461bcd60e51b815260206004820152603660248201527f496e76616c696420636f6e73656e737573206b6579206f776e6572736869702060448201527f70726f6f6620666f7220726567697374726174696f6e000000000000000000006064820152608401620015e7565b620074328b62008960565b5050505050505050505050565b6000606482101580156200156d575050617530101590565b6000600a82101580156200156d575050612710101590565b6000808311620074e85760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201527f69766500000000000000000000000000000000000000000000000000000000006064820152608401620015e7565b6001600160a01b038216600090815260396020526040902054831115620075525760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e636500000000006044820152606401620015e7565b6001600160a01b038216600090815260396020526040812080548592906200757c9084906200a704565b9091555050604080516080810182526001600160a01b03808516825286811660208084019182528385018881524360608601908152600a8054600090815260089094529683208651815490871673ffffffffffffffffffffffffffffffffffffffff199182161782559451600182018054919097169516949094179094555160028301559151600390910155825491929062007618836200abf1565b90915550506001600160a01b038581166000818152603a6020908152604091829020548251908516948816948514808252918101899052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d910160405180910390a3833b15620076925762007692848762008c42565b6001600a54620076a391906200a704565b9695505050505050565b6001600160a01b038084166000908152603a60205260408120805491929091848216911614806200786957600c8201546040517f84955c880000000000000000000000000000000000000000000000000000000081526001600160a01b03868116600483015260009216906384955c8890602401602060405180830381865afa1580156200773f573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906200776591906200aada565b905085811015620077df5760405162461bcd60e51b815260206004820152602b60248201527f696e73756666696369656e7420756e6c6f636b6564204c6971756964204e657760448201527f746f6e2062616c616e63650000000000000000000000000000000000000000006064820152608401620015e7565b600c8301546040517f282d3fdf0000000000000000000000000000000000000000000000000000000081526001600160a01b038781166004830152602482018990529091169063282d3fdf90604401600060405180830381600087803b1580156200784957600080fd5b505af11580156200785e573d6000803e3d6000fd5b505050505062007912565b8482600b015483600801546200788091906200a704565b1015620078f65760405162461bcd60e51b815260206004820152602760248201527f696e73756666696369656e742073656c6620626f6e646564206e6577746f6e2060448201527f62616c616e6365000000000000000000000000000000000000000000000000006064820152608401620015e7565b8482600b0160008282546200790c91906200a71a565b90915550505b604051806101200160405280856001600160a01b03168152602001876001600160a01b031681526020018681526020016000815260200143815260200160008152602001600060038111156200796c576200796c62009c8b565b815260006020808301829052841515604093840152600d548252600b815290829020835181546001600160a01b0391821673ffffffffffffffffffffffffffffffffffffffff199182161783559285015160018381018054929093169190941617905591830151600283015560608301516003808401919091556080840151600484015560a0840151600584015560c08401516006840180549193909260ff1990921691849081111562007a245762007a2462009c8b565b021790555060e08201516006909101805461010093840151151562010000027fffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ffff931515909402929092167fffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000ff90921691909117919091179055600d805490600062007aae836200abf1565b9190505550836001600160a01b0316866001600160a01b03167f63f8870909f7c59c9c4932bf98dbd491647c8d2e89ca0a032aacdd943a13e2fc838860405162007b049291909115158252602082015260400190565b60405180910390a3833b1562007b205762007b20848762008c42565b6001600d54620076a391906200a704565b60fa60a06000808285855af462007b4c573d6000803e3d6000fd5b505050565b81546002600180831615610100020382160482518082016020811060208410016002811462007c00576001811462007c26578660005260208404602060002001600160028402018855602085068060200390508088018589016001836101000a0392508282511684540184556001840193506020820191505b8082101562007be9578151845560018401935060208201915062007bca565b815191036101000a90819004029091555062007c9b565b60028302826020036101000a846020036101000a60208901510402018501875562007c9b565b8660005260208404602060002001600160028402018855846020038088018589016001836101000a0392508282511660ff198a160184556020820191506001840193505b8082101562007c89578151845560018401935060208201915062007c6a565b815191036101000a9081900402909155505b50505050505050565b600081815260086020908152604080832060018101546001600160a01b03168452603a90925282209091601382015460ff16600381111562007cea5762007cea62009c8b565b14158062007d11575081546001600160a01b03166000908152603660205260409020546001145b1562007dd157600282015482546001600160a01b03166000908152603960205260408120805490919062007d479084906200a71a565b909155505081546001830154600284015460138401546040516001600160a01b0394851694909316927f1ff2b052afa4bb37ce30d9aaccde416a700b97e632d089111749af937f8783429262007da592909160ff909116906200af40565b60405180910390a38054825462007b4c9185916000916001600160a01b03918216911614600162008d61565b805482546001600160a01b0390811691161462007f0e576000808260080154836005015462007e0191906200a704565b90508060000362007e19578360020154915062007e3f565b80846002015484600d015462007e3091906200aeab565b62007e3c91906200adb2565b91505b600c83015484546040517f40c10f190000000000000000000000000000000000000000000000000000000081526001600160a01b039182166004820152602481018590529116906340c10f1990604401600060405180830381600087803b15801562007eaa57600080fd5b505af115801562007ebf573d6000803e3d6000fd5b505050508183600d01600082825462007ed991906200a71a565b9091555050600284015460058401805460009062007ef99084906200a71a565b90915550620021fe9050858360008062008d61565b816002015481600801600082825462007f2891906200a71a565b9091555050600282015460058201805460009062007f489084906200a71a565b9091555062007b4c905083600060018162008d61565b6000818152600b6020908152604080832080546001600160a01b0316845260369092529091205460010362008000578054600182015460068301546002840154604080516201000090930460ff161515835260208301919091526001600160a01b0393841693909216917fec3ccfdbfc982a0ea188f4fd85459da1d6a7b332ddc4ca6b7a774c1abfc75866910160405180910390a36200208982600162008f03565b60018101546001600160a01b03166000908152603a60205260408120600683015490919062010000900460ff166200821a576002830154600c83015484546040517f7eee288d0000000000000000000000000000000000000000000000000000000081526001600160a01b03918216600482015260248101849052911690637eee288d90604401600060405180830381600087803b158015620080a257600080fd5b505af1158015620080b7573d6000803e3d6000fd5b50505050600c83015484546040517f9dc29fac0000000000000000000000000000000000000000000000000000000081526001600160a01b03918216600482015260248101849052911690639dc29fac90604401600060405180830381600087803b1580156200812657600080fd5b505af11580156200813b573d6000803e3d6000fd5b505050506000836008015484600501546200815791906200a704565b600d8501549091506200816b82846200aeab565b6200817791906200adb2565b92508184600d0160008282546200818f91906200a704565b90915550506006840154600003620081ae5760038501839055620081d6565b60068401546007850154620081c490856200aeab565b620081d091906200adb2565b60038601555b82846006016000828254620081ec91906200a71a565b909155505060038501546007850180546000906200820c9084906200a71a565b90915550620082ef92505050565b506002820154600882015481111562008234575060088101545b81600901546000036200824e576003830181905562008276565b6009820154600a8301546200826490836200aeab565b6200827091906200adb2565b60038401555b808260090160008282546200828c91906200a71a565b90915550506003830154600a83018054600090620082ac9084906200a71a565b9250508190555080826008016000828254620082c991906200a704565b90915550506002830154600b83018054600090620082e99084906200a704565b90915550505b6006830180547fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ff16610100179055600582018054829190600090620083369084906200a704565b909155506200201b905084600062008f03565b6000818152600b602052604090206006810154610100900460ff16620083875760068101805460ff191660021790556200208982600060016200908f565b60068101805460ff191660011790556003810154600003620083b15762002089826000806200908f565b60018101546001600160a01b03166000908152603a60205260408120600683015490919062010000900460ff166200844e57816007015482600601548460030154620083fe91906200aeab565b6200840a91906200adb2565b9050808260060160008282546200842291906200a704565b90915550506003830154600783018054600090620084429084906200a704565b90915550620084b39050565b81600a0154826009015484600301546200846991906200aeab565b6200847591906200adb2565b9050808260090160008282546200848d91906200a704565b90915550506003830154600a83018054600090620084ad9084906200a704565b90915550505b82546001600160a01b031660009081526039602052604081208054839290620084de9084906200a71a565b909155506200201b9050848260006200908f565b600062008503826060015162006f4e565b6001600160a01b03909116602084015290508015620085655760405162461bcd60e51b815260206004820152600b60248201527f656e6f6465206572726f720000000000000000000000000000000000000000006044820152606401620015e7565b6020808301516001600160a01b039081166000908152603a9092526040909120600101541615620085d95760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c72656164792072656769737465726564000000006044820152606401620015e7565b61271082608001511115620020895760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e20726174650000000000000000006044820152606401620015e7565b6060816000036200867557505060408051808201909152600181527f3000000000000000000000000000000000000000000000000000000000000000602082015290565b8160005b8115620086a557806200868c816200abf1565b91506200869d9050600a836200adb2565b915062008679565b60008167ffffffffffffffff811115620086c357620086c36200a0e8565b6040519080825280601f01601f191660200182016040528015620086ee576020820181803683370190505b5090505b84156200877e57620087066001836200a704565b915062008715600a866200af5f565b620087229060306200a71a565b60f81b8183815181106200873a576200873a6200ab39565b60200101907effffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916908160001a90535062008776600a866200adb2565b9450620086f2565b949350505050565b6060816200879681601f6200a71a565b1015620087e65760405162461bcd60e51b815260206004820152600e60248201527f736c6963655f6f766572666c6f770000000000000000000000000000000000006044820152606401620015e7565b620087f282846200a71a565b84511015620088445760405162461bcd60e51b815260206004820152601160248201527f736c6963655f6f75744f66426f756e64730000000000000000000000000000006044820152606401620015e7565b606082158015620088655760405191506000825260208201604052620088b1565b6040519150601f8416801560200281840101858101878315602002848b0101015b81831015620088a057805183526020928301920162008886565b5050858452601f01601f1916604052505b50949350505050565b8181018051602082015160409092015190919060001a601b811015620088ea57620088e7601b826200af76565b90505b9250925092565b6000620088fd62009b34565b600085858560405160200162008916939291906200af92565b6040516020818303038152906040529050600060fb90506000825160206200893f91906200a71a565b90506020848285855afa6200895357600080fd5b5050905195945050505050565b6101808101516001600160a01b031662008a7a57603e546001600160a01b0316620089f45760405162461bcd60e51b815260206004820152602260248201527f6c6971756964206c6f67696320636f6e7472616374206e6f74206465706c6f7960448201527f65640000000000000000000000000000000000000000000000000000000000006064820152608401620015e7565b60255460009062008a059062008631565b905081602001518260000151836080015183603e60009054906101000a90046001600160a01b031660405162008a3b9062009b52565b62008a4b9594939291906200aff4565b604051809103906000f08015801562008a68573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051602580546001808201835560009283527f401968ff42a154441da5f6c4c935ac46b8671f0e062baaa62a7545ba53bb6e4c909101805473ffffffffffffffffffffffffffffffffffffffff199081166001600160a01b0395861617909155845184168352603a9095526040918290208651815487169085161781559351908401805486169184169190911790558401516002830180549094169116179091556060820151829190600382019062008b3990826200ac0e565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c8201805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f82015561020082015160108201556102208201516011820155610240820151601282019062008c1090826200ac0e565b5061026082015160138201805460ff1916600183600381111562008c385762008c3862009c8b565b0217905550505050565b6001600160a01b038216600090815260346020526040812054900362008ccd576001600160a01b03821660008181526034602052604081206001908190556035805491820181559091527fcfa4bec1d3298408bb5afcfcd9c430549c5b31f8aa5c5848151c0a55f473c34d01805473ffffffffffffffffffffffffffffffffffffffff191690911790555b6001600160a01b038083166000908152603260209081526040808320938516835292905290812054900362002089576001600160a01b038083166000818152603260209081526040808320948616808452948252808320600190819055938352603382528220805493840181558252902001805473ffffffffffffffffffffffffffffffffffffffff191690911790555050565b600084815260086020526040902080546001600160a01b0316803b62008d895750506200201b565b6001600160a01b03811660009081526038602052604090205460045481111562008db257506004545b60005a60018501546040517f9dfd1b8e000000000000000000000000000000000000000000000000000000008152600481018b90526001600160a01b039182166024820152604481018a905288151560648201528715156084820152919250841690639dfd1b8e90849060a401600060405180830381600088803b15801562008e3a57600080fd5b5087f19350505050801562008e4d575060015b62008e78575a62008e5f90826200a704565b90508462008e725762008e728862009218565b62008e88565b5a62008e8590826200a704565b90505b6001600160a01b03831660009081526038602052604090205481101562008edf576001600160a01b0383166000908152603860205260408120805483929062008ed39084906200a704565b9091555062008ef99050565b6001600160a01b0383166000908152603860205260408120555b5050505050505050565b6000828152600b6020526040902080546001600160a01b0316803b62008f295750505050565b6001600160a01b03811660009081526038602052604090205460055481111562008f5257506005545b60005a60018501546040517fa8920241000000000000000000000000000000000000000000000000000000008152600481018990526001600160a01b039182166024820152871515604482015291925084169063a8920241908490606401600060405180830381600088803b15801562008fcb57600080fd5b5087f19350505050801562008fde575060015b62009009575a62008ff090826200a704565b90508462009003576200900386620093f8565b62009019565b5a6200901690826200a704565b90505b6001600160a01b03831660009081526038602052604090205481101562009070576001600160a01b03831660009081526038602052604081208054839290620090649084906200a704565b90915550620036d59050565b50506001600160a01b0316600090815260386020526040812055505050565b6000838152600b6020526040902080546001600160a01b0316803b620090b6575050505050565b6001600160a01b038116600090815260386020526040902054600654811115620090df57506006545b60005a6040517f3c54c290000000000000000000000000000000000000000000000000000000008152600481018990526024810188905286151560448201529091506001600160a01b03841690633c54c290908490606401600060405180830381600088803b1580156200915257600080fd5b5087f19350505050801562009165575060015b62009191575a6200917790826200a704565b9050846200918b576200918b878762009684565b620091a1565b5a6200919e90826200a704565b90505b6001600160a01b038316600090815260386020526040902054811015620091f8576001600160a01b03831660009081526038602052604081208054839290620091ec9084906200a704565b9091555062007c9b9050565b50506001600160a01b031660009081526038602052604081205550505050565b600081815260086020908152604080832060018101546001600160a01b039081168552603a909352922080548354919291821691161462009336576000816008015482600501546200926b91906200a704565b836002015483600d01546200928191906200aeab565b6200928d91906200adb2565b600c83015484546040517f9dc29fac0000000000000000000000000000000000000000000000000000000081526001600160a01b039182166004820152602481018490529293501690639dc29fac90604401600060405180830381600087803b158015620092fa57600080fd5b505af11580156200930f573d6000803e3d6000fd5b505050508082600d0160008282546200932991906200a704565b9091555062009356915050565b81600201548160080160008282546200935091906200a704565b90915550505b600282015482546001600160a01b031660009081526039602052604081208054909190620093869084906200a71a565b90915550506002820154600582018054600090620093a69084906200a704565b90915550508154600183015460028401546040519081526001600160a01b0392831692909116907f2d2530c45d577d38d6fc598e41ade6fb0bfbde20d09244c88348d3a2797a9d90906020016200604d565b6000818152600b6020908152604080832060018101546001600160a01b03168452603a909252822060068201549192909162010000900460ff1662009547576002830154600c83015484546040517f40c10f190000000000000000000000000000000000000000000000000000000081526001600160a01b039182166004820152602481018490529116906340c10f1990604401600060405180830381600087803b158015620094a757600080fd5b505af1158015620094bc573d6000803e3d6000fd5b505050508083600d016000828254620094d691906200a71a565b9091555050600783015460068401546003860154620094f691906200aeab565b6200950291906200adb2565b9150818360060160008282546200951a91906200a704565b909155505060038401546007840180546000906200953a9084906200a704565b90915550620095c9915050565b81600a0154826009015484600301546200956291906200aeab565b6200956e91906200adb2565b9050808260090160008282546200958691906200a704565b90915550506003830154600a83018054600090620095a69084906200a704565b9250508190555080826008016000828254620095c391906200a71a565b90915550505b6000600384018190556006840180547fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ff169055600583018054839290620096129084906200a71a565b90915550508254600184015460068501546002860154604080516201000090930460ff161515835260208301919091526001600160a01b0393841693909216917f52c1b482483796c697edd43958bc63e332069083ea38782d960693145db2cf0c91015b60405180910390a350505050565b6000828152600b60205260408120908290036200970857600681018054600360ff19909116179081905581546001830154604080516201000090940460ff1615158452602084018690526001600160a01b039283169392909116917f0a4bfc4c4e35975ab1fe5fd21d963e8bb8d483f04aebdbc0a059b246bec3f5fc91016200604d565b60018101546001600160a01b03166000908152603a60205260409020600682015462010000900460ff166200983157600080826008015483600501546200975091906200a704565b905080600003620097645784915062009786565b808584600d01546200977791906200aeab565b6200978391906200adb2565b91505b600c83015484546040517f40c10f190000000000000000000000000000000000000000000000000000000081526001600160a01b039182166004820152602481018590529116906340c10f1990604401600060405180830381600087803b158015620097f157600080fd5b505af115801562009806573d6000803e3d6000fd5b505050508183600d0160008282546200982091906200a71a565b909155505050600583015562009856565b828260050181905550828160080160008282546200985091906200a71a565b90915550505b60068201805460ff1916600317905581546001600160a01b031660009081526039602052604081208054859290620098909084906200a704565b9250508190555082816005016000828254620098ad91906200a71a565b9091555050815460018301546006840154604080516201000090920460ff1615158252602082018790526001600160a01b0393841693909216917f0a4bfc4c4e35975ab1fe5fd21d963e8bb8d483f04aebdbc0a059b246bec3f5fc910162009676565b60405180610280016040528060006001600160a01b0316815260200160006001600160a01b0316815260200160006001600160a01b0316815260200160608152602001600081526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b0316815260200160008152602001600081526020016000815260200160008152602001600081526020016060815260200160006003811115620099db57620099db62009c8b565b905290565b60405180610120016040528060006001600160a01b0316815260200160006001600160a01b03168152602001600081526020016000815260200160008152602001600081526020016000600381111562009a3e5762009a3e62009c8b565b815260006020820181905260409091015290565b6040518060a001604052806005906020820280368337509192915050565b508054600082559060005260206000209081019062001696919062009b60565b50805462009a9e906200a680565b6000825580601f1062009aaf575050565b601f01602090049060005260206000209081019062001696919062009b85565b50805460008255601f01602090049060005260206000209081019062001696919062009b85565b508054600082559060005260206000209081019062001696919062009b85565b60405180604001604052806002906020820280368337509192915050565b60405180602001604052806001906020820280368337509192915050565b610610806200b02883390190565b8082111562009b8157600062009b77828262009a90565b5060010162009b60565b5090565b5b8082111562009b81576000815560010162009b86565b60005b8381101562009bb957818101518382015260200162009b9f565b50506000910152565b6000815180845262009bdc81602086016020860162009b9c565b601f01601f19169290920160200192915050565b60208152600062009c05602083018462009bc2565b9392505050565b6001600160a01b03811681146200169657600080fd5b6000806040838503121562009c3657600080fd5b823562009c438162009c0c565b946020939093013593505050565b60006020828403121562009c6457600080fd5b813562009c058162009c0c565b60006020828403121562009c8457600080fd5b5035919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b6004811062001696577f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b62009cfd8162009cba565b9052565b6020815262009d1c6020820183516001600160a01b03169052565b6000602083015162009d3960408401826001600160a01b03169052565b5060408301516001600160a01b038116606084015250606083015161028080608085015262009d6d6102a085018362009bc2565b9150608085015160a085015260a085015160c085015260c085015160e085015260e08501516101008181870152808701519150506101208181870152808701519150506101408181870152808701519150506101608181870152808701519150506101808181870152808701519150506101a062009df5818701836001600160a01b03169052565b8601516101c0868101919091528601516101e080870191909152860151610200808701919091528601516102208087019190915286015161024080870191909152860151858403601f19016102608088019190915290915062009e59848362009bc2565b93508087015191505062009e708286018262009cf2565b5090949350505050565b60008060006060848603121562009e9057600080fd5b833562009e9d8162009c0c565b9250602084013562009eaf8162009c0c565b929592945050506040919091013590565b6000610120820190506001600160a01b038084511683528060208501511660208401525060408301516040830152606083015160608301526080830151608083015260a083015160a083015260c083015162009f2060c084018262009cf2565b5060e083015162009f3560e084018215159052565b50610100928301511515919092015290565b60006020828403121562009f5a57600080fd5b813567ffffffffffffffff81111562009f7257600080fd5b8201610280818503121562009c0557600080fd5b600081518084526020808501808196508360051b8101915082860160005b8581101562009ffb578284038952815180516001600160a01b03168552858101518686015260409081015160609186018290529062009fe68187018362009bc2565b9a87019a955050509084019060010162009fa4565b5091979650505050505050565b8515158152841515602082015260a0604082015260006200a02d60a083018662009f86565b606083019490945250608001529392505050565b6101e081016200a0928286805182526020810151602083015260408101516040830152606081015160608301526080810151608083015260a081015160a083015260c0810151151560c08301525050565b835160e0830152602084015161010083015260408401516101208301526060840151610140830152608084015161016083015260a084015161018083015260c09093015115156101a08201526101c00152919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b600082601f8301126200a12957600080fd5b813567ffffffffffffffff808211156200a147576200a1476200a0e8565b604051601f8301601f19908116603f011681019082821181831017156200a172576200a1726200a0e8565b816040528381528660208588010111156200a18c57600080fd5b836020870160208301376000602085830101528094505050505092915050565b600080604083850312156200a1c057600080fd5b82356200a1cd8162009c0c565b9150602083013567ffffffffffffffff8111156200a1ea57600080fd5b6200a1f8858286016200a117565b9150509250929050565b600061026082019050855182526020860151602083015260408601516040830152606086015160608301526080860151608083015260a08601516001600160a01b0380821660a08501528087511660c08501528060208801511660e085015280604088015116610100850152505060608501516200a28c6101208401826001600160a01b03169052565b5060808501516001600160a01b0390811661014084015260a0860151811661016084015260c0860151811661018084015260e09095015185166101a083015283519094166101c082015260208301516101e0820152604083015161020082015260609092015161022083015261024090910152919050565b600080600080608085870312156200a31b57600080fd5b843567ffffffffffffffff808211156200a33457600080fd5b6200a342888389016200a117565b9550602087013591506200a3568262009c0c565b909350604086013590808211156200a36d57600080fd5b6200a37b888389016200a117565b935060608701359150808211156200a39257600080fd5b506200a3a1878288016200a117565b91505092959194509250565b80151581146200169657600080fd5b600060e082840312156200a3cf57600080fd5b60405160e0810181811067ffffffffffffffff821117156200a3f5576200a3f56200a0e8565b8060405250823581526020830135602082015260408301356040820152606083013560608201526080830135608082015260a083013560a082015260c08301356200a440816200a3ad565b60c08201529392505050565b6000602080830181845280855180835260408601915060408160051b870101925083870160005b828110156200a4c3577fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffc08886030184526200a4b085835162009bc2565b945092850192908501906001016200a473565b5092979650505050505050565b602081016200a4df8362009cba565b91905290565b6080815260006200a4fa608083018762009f86565b6020830195909552506040810192909252606090910152919050565b60208152600062009c05602083018462009f86565b600081518084526020808501945080840160005b838110156200a5665781516001600160a01b0316875295820195908201906001016200a53f565b509495945050505050565b6040815260006200a58660408301856200a52b565b82810360208401526200a59a81856200a52b565b95945050505050565b600080604083850312156200a5b757600080fd5b823567ffffffffffffffff808211156200a5d057600080fd5b6200a5de868387016200a117565b935060208501359150808211156200a5f557600080fd5b506200a1f8858286016200a117565b6040815260006200a619604083018562009bc2565b82810360208401526200a59a818562009bc2565b60208152600062009c0560208301846200a52b565b600080604083850312156200a65657600080fd5b82356200a6638162009c0c565b915060208301356200a6758162009c0c565b809150509250929050565b600181811c908216806200a69557607f821691505b6020821081036200a6cf577f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b818103818111156200156d576200156d6200a6d5565b808201808211156200156d576200156d6200a6d5565b600081356200156d8162009c0c565b60008083357fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe18436030181126200a77557600080fd5b83018035915067ffffffffffffffff8211156200a79157600080fd5b6020019150368190038213156200a7a757600080fd5b9250929050565b601f82111562007b4c57600081815260208120601f850160051c810160208610156200a7d75750805b601f850160051c820191505b81811015620036d5578281556001016200a7e3565b67ffffffffffffffff8311156200a813576200a8136200a0e8565b6200a82b836200a82483546200a680565b836200a7ae565b6000601f8411600181146200a86257600085156200a8495750838201355b600019600387901b1c1916600186901b178355620021fe565b600083815260209020601f19861690835b828110156200a89557868501358255602094850194600190920191016200a873565b50868210156200a8b35760001960f88860031b161c19848701351681555b505060018560011b0183555050505050565b60008135600481106200156d57600080fd5b6200a8e28262009cba565b60ff1981541660ff831681178255505050565b6200a92e6200a904836200a730565b825473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0391909116178255565b6200a96e6200a940602084016200a730565b600183016001600160a01b03821673ffffffffffffffffffffffffffffffffffffffff198254161781555050565b6200a9ae6200a980604084016200a730565b600283016001600160a01b03821673ffffffffffffffffffffffffffffffffffffffff198254161781555050565b6200a9bd60608301836200a73f565b6200a9cd8183600386016200a7f8565b50506080820135600482015560a0820135600582015560c0820135600682015560e0820135600782015561010082013560088201556101208201356009820155610140820135600a820155610160820135600b8201556200aa646200aa3661018084016200a730565b600c83016001600160a01b03821673ffffffffffffffffffffffffffffffffffffffff198254161781555050565b6101a0820135600d8201556101c0820135600e8201556101e0820135600f820155610200820135601082015561022082013560118201556200aaab6102408301836200a73f565b6200aabb8183601286016200a7f8565b5050620020896200aad061026084016200a8c5565b601383016200a8d7565b6000602082840312156200aaed57600080fd5b5051919050565b600080604083850312156200ab0857600080fd5b505080516020909101519092909150565b6000602082840312156200ab2c57600080fd5b815162009c05816200a3ad565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052603260045260246000fd5b600081546200ab77816200a680565b600182811680156200ab9257600181146200aba8576200abd9565b60ff19841687528215158302870194506200abd9565b8560005260208060002060005b858110156200abd05781548a8201529084019082016200abb5565b50505082870194505b5050505092915050565b600062009c0582846200ab68565b600060001982036200ac07576200ac076200a6d5565b5060010190565b815167ffffffffffffffff8111156200ac2b576200ac2b6200a0e8565b6200ac43816200ac3c84546200a680565b846200a7ae565b602080601f8311600181146200ac7b57600084156200ac625750858301515b600019600386901b1c1916600185901b178555620036d5565b600085815260208120601f198616915b828110156200acac578886015182559484019460019091019084016200ac8b565b50858210156200accb5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b60006001600160a01b0380881683528087166020840152808616604084015260a060608401526200ad1060a084018662009bc2565b91508084166080840152509695505050505050565b61010081016200ad768285805182526020810151602083015260408101516040830152606081015160608301526080810151608083015260a081015160a083015260c0810151151560c08301525050565b8260e08301529392505050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b6000826200adc4576200adc46200ad83565b500490565b8181036200add5575050565b6200ade182546200a680565b67ffffffffffffffff8111156200adfc576200adfc6200a0e8565b6200ae0d816200ac3c84546200a680565b6000601f8211600181146200ae4457600083156200ae2b5750848201545b600019600385901b1c1916600184901b178455620021fe565b600085815260209020601f19841690600086815260209020845b838110156200ae8057828601548255600195860195909101906020016200ae5e565b50858310156200accb5793015460001960f8600387901b161c19169092555050600190811b01905550565b80820281158282048414176200156d576200156d6200a6d5565b6001600160a01b038316815260606020820152600060608201526080604082015260006200877e608083018462009bc2565b600084516200af0b81846020890162009b9c565b8451908301906200af2181836020890162009b9c565b84519101906200af3681836020880162009b9c565b0195945050505050565b828152604081016200af528362009cba565b8260208301529392505050565b6000826200af71576200af716200ad83565b500690565b60ff81811683821601908111156200156d576200156d6200a6d5565b600084516200afa681846020890162009b9c565b8451908301906200afbc81836020890162009b9c565b60609490941b7fffffffffffffffffffffffffffffffffffffffff000000000000000000000000169301928352505060140192915050565b60006001600160a01b038088168352808716602084015285604084015260a060608401526200ad1060a084018662009bc256fe608060405234801561001057600080fd5b5060405161061038038061061083398101604081905261002f916101af565b806001600160a01b0316632f2c3f2e6040518163ffffffff1660e01b8152600401602060405180830381865afa15801561006d573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610091919061029f565b83111561009d57600080fd5b600c80546001600160a01b038088166001600160a01b031992831617909255600d805492871692909116919091179055600e8390556040516100e39083906020016102b8565b604051602081830303815290604052600a9081610100919061036e565b508160405160200161011291906102b8565b604051602081830303815290604052600b908161012f919061036e565b5050601080546001600160a01b031916331790555061042d92505050565b6001600160a01b038116811461016257600080fd5b50565b80516101708161014d565b919050565b634e487b7160e01b600052604160045260246000fd5b60005b838110156101a657818101518382015260200161018e565b50506000910152565b600080600080600060a086880312156101c757600080fd5b85516101d28161014d565b60208701519095506101e38161014d565b6040870151606088015191955093506001600160401b038082111561020757600080fd5b818801915088601f83011261021b57600080fd5b81518181111561022d5761022d610175565b604051601f8201601f19908116603f0116810190838211818310171561025557610255610175565b816040528281528b602084870101111561026e57600080fd5b61027f83602083016020880161018b565b809650505050505061029360808701610165565b90509295509295909350565b6000602082840312156102b157600080fd5b5051919050565b644c4e544e2d60d81b8152600082516102d881600585016020870161018b565b9190910160050192915050565b600181811c908216806102f957607f821691505b60208210810361031957634e487b7160e01b600052602260045260246000fd5b50919050565b601f82111561036957600081815260208120601f850160051c810160208610156103465750805b601f850160051c820191505b8181101561036557828155600101610352565b5050505b505050565b81516001600160401b0381111561038757610387610175565b61039b8161039584546102e5565b8461031f565b602080601f8311600181146103d057600084156103b85750858301515b600019600386901b1c1916600185901b178555610365565b600085815260208120601f198616915b828110156103ff578886015182559484019460019091019084016103e0565b508582101561041d5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b6101d48061043c6000396000f3fe60806040523661001b57610019610014610022565b61013e565b005b6100196100145b600080601060009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff166396f18a326040518163ffffffff1660e01b8152600401602060405180830381865afa158015610092573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906100b69190610161565b905073ffffffffffffffffffffffffffffffffffffffff8116610139576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152601d60248201527f6c6971756964206c6f67696320636f6e7472616374206e6f7420736574000000604482015260640160405180910390fd5b919050565b3660008037600080366000845af43d6000803e8061015b573d6000fd5b503d6000f35b60006020828403121561017357600080fd5b815173ffffffffffffffffffffffffffffffffffffffff8116811461019757600080fd5b939250505056fea264697066735822122076b5b72323e3d9ea33f6c72d4f6af1b0947104c33397f7484304cd24721df42c64736f6c63430008150033a2646970667358221220de7936e4c9243b740568bb676e363c542f1439c0658aaf92f39fa9311369a4f364736f6c63430008150033608060405234801561001057600080fd5b50601080546001600160a01b03191633179055611cc4806100326000396000f3fe6080604052600436106101a55760003560e01c80633e4eb36c116100e1578063949813b81161008a578063a0ce552d11610064578063a0ce552d1461054b578063a9059cbb1461055e578063bd96102f1461057e578063dd62ed3e14610593576101ac565b8063949813b8146104e157806395d89b41146105165780639dc29fac1461052b576101ac565b806370a08231116100bb57806370a082311461045e5780637eee288d146104a157806384955c88146104c1576101ac565b80633e4eb36c146103e657806340c10f19146103fb578063593557361461041b576101ac565b80631eeffad01161014e5780632f2c3f2e116101285780632f2c3f2e14610374578063313ce5671461038a578063372500ab146103a65780633b19e84a146103bb576101ac565b80631eeffad01461031f57806323b872dd14610334578063282d3fdf14610354576101ac565b806318160ddd1161017f57806318160ddd146102c6578063187cf4d7146102e557806319fac8fd146102fd576101ac565b806306fdde031461021f578063095ea7b31461024a5780631195e07e1461027a576101ac565b366101ac57005b60405162461bcd60e51b815260206004820152602860248201527f66616c6c6261636b206e6f7420696d706c656d656e74656420666f72204c697160448201527f7569644c6f67696300000000000000000000000000000000000000000000000060648201526084015b60405180910390fd5b34801561022b57600080fd5b506102346105e6565b6040516102419190611a10565b60405180910390f35b34801561025657600080fd5b5061026a610265366004611aa5565b610678565b6040519015158152602001610241565b34801561028657600080fd5b50600c5473ffffffffffffffffffffffffffffffffffffffff165b60405173ffffffffffffffffffffffffffffffffffffffff9091168152602001610241565b3480156102d257600080fd5b506003545b604051908152602001610241565b3480156102f157600080fd5b506102d7633b9aca0081565b34801561030957600080fd5b5061031d610318366004611acf565b61068f565b005b34801561032b57600080fd5b50600f546102d7565b34801561034057600080fd5b5061026a61034f366004611ae8565b610721565b34801561036057600080fd5b5061031d61036f366004611aa5565b610864565b34801561038057600080fd5b506102d761271081565b34801561039657600080fd5b5060405160128152602001610241565b3480156103b257600080fd5b5061031d6109de565b3480156103c757600080fd5b50600d5473ffffffffffffffffffffffffffffffffffffffff166102a1565b3480156103f257600080fd5b50600e546102d7565b34801561040757600080fd5b5061031d610416366004611aa5565b610c08565b34801561042757600080fd5b506102d7610436366004611b24565b73ffffffffffffffffffffffffffffffffffffffff1660009081526001602052604090205490565b34801561046a57600080fd5b506102d7610479366004611b24565b73ffffffffffffffffffffffffffffffffffffffff1660009081526020819052604090205490565b3480156104ad57600080fd5b5061031d6104bc366004611aa5565b610cf2565b3480156104cd57600080fd5b506102d76104dc366004611b24565b610e4f565b3480156104ed57600080fd5b506105016104fc366004611b24565b610e89565b60408051928352602083019190915201610241565b34801561052257600080fd5b50610234610f75565b34801561053757600080fd5b5061031d610546366004611aa5565b610f84565b610501610559366004611acf565b611066565b34801561056a57600080fd5b5061026a610579366004611aa5565b6113f0565b34801561058a57600080fd5b5061031d61145c565b34801561059f57600080fd5b506102d76105ae366004611b3f565b73ffffffffffffffffffffffffffffffffffffffff918216600090815260026020908152604080832093909416825291909152205490565b6060600a80546105f590611b72565b80601f016020809104026020016040519081016040528092919081815260200182805461062190611b72565b801561066e5780601f106106435761010080835404028352916020019161066e565b820191906000526020600020905b81548152906001019060200180831161065157829003601f168201915b5050505050905090565b60006106853384846115a3565b5060015b92915050565b60105473ffffffffffffffffffffffffffffffffffffffff16331461071c5760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610216565b600e55565b73ffffffffffffffffffffffffffffffffffffffff83166000908152600260209081526040808320338452909152812054828110156107c85760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e742065786365656473206160448201527f6c6c6f77616e63650000000000000000000000000000000000000000000000006064820152608401610216565b6107dc85336107d78685611bf4565b6115a3565b6107e68584611722565b6107f0848461183d565b8373ffffffffffffffffffffffffffffffffffffffff168573ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8560405161084f91815260200190565b60405180910390a360019150505b9392505050565b60105473ffffffffffffffffffffffffffffffffffffffff1633146108f15760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610216565b73ffffffffffffffffffffffffffffffffffffffff82166000908152600160209081526040808320549183905290912054829161092d91611bf4565b10156109a05760405162461bcd60e51b8152602060048201526024808201527f63616e2774206c6f636b206d6f72652066756e6473207468616e20617661696c60448201527f61626c65000000000000000000000000000000000000000000000000000000006064820152608401610216565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260016020526040812080548392906109d5908490611c07565b90915550505050565b6000806109ea33611896565b3360009081526004602090815260408083208390556007909152812081905591935091508115610afe576010546040517fa9059cbb0000000000000000000000000000000000000000000000000000000081523360048201526024810184905273ffffffffffffffffffffffffffffffffffffffff9091169063a9059cbb906044016020604051808303816000875af1158015610a8b573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610aaf9190611c1a565b905080610afe5760405162461bcd60e51b815260206004820152601260248201527f4661696c656420746f2073656e64204e544e00000000000000000000000000006044820152606401610216565b333b15610b6b573373ffffffffffffffffffffffffffffffffffffffff1663161605e3846040518263ffffffff1660e01b81526004016000604051808303818588803b158015610b4d57600080fd5b505af1158015610b61573d6000803e3d6000fd5b5050505050505050565b60405133908490600081818185875af1925050503d8060008114610bab576040519150601f19603f3d011682016040523d82523d6000602084013e610bb0565b606091505b50508091505080610c035760405162461bcd60e51b815260206004820152601260248201527f4661696c656420746f2073656e642041544e00000000000000000000000000006044820152606401610216565b505050565b60105473ffffffffffffffffffffffffffffffffffffffff163314610c955760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610216565b610c9f828261183d565b60405181815273ffffffffffffffffffffffffffffffffffffffff8316906000907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef906020015b60405180910390a35050565b60105473ffffffffffffffffffffffffffffffffffffffff163314610d7f5760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610216565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260016020526040902054811115610e1a5760405162461bcd60e51b815260206004820152602360248201527f63616e277420756e6c6f636b206d6f72652066756e6473207468616e206c6f6360448201527f6b656400000000000000000000000000000000000000000000000000000000006064820152608401610216565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260016020526040812080548392906109d5908490611bf4565b73ffffffffffffffffffffffffffffffffffffffff8116600090815260016020908152604080832054918390528220546106899190611bf4565b73ffffffffffffffffffffffffffffffffffffffff81166000908152602081815260408083205460065460059093529083205483928391610ecb9184916119d2565b73ffffffffffffffffffffffffffffffffffffffff8616600090815260046020526040902054909150610eff908290611c07565b60095473ffffffffffffffffffffffffffffffffffffffff871660009081526008602052604081205492965091610f379185916119d2565b73ffffffffffffffffffffffffffffffffffffffff8716600090815260076020526040902054909150610f6b908290611c07565b9350505050915091565b6060600b80546105f590611b72565b60105473ffffffffffffffffffffffffffffffffffffffff1633146110115760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610216565b61101b8282611722565b60405181815260009073ffffffffffffffffffffffffffffffffffffffff8416907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef90602001610ce6565b601054600090819073ffffffffffffffffffffffffffffffffffffffff1633146110f85760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610216565b600e5434906000906127109061110e9084611c3c565b6111189190611c53565b90508181111561116a5760405162461bcd60e51b815260206004820152601c60248201527f696e76616c69642061746e2076616c696461746f7220726577617264000000006044820152606401610216565b6111748183611bf4565b600d5460405191935060009173ffffffffffffffffffffffffffffffffffffffff909116906108fc90849084818181858888f193505050503d80600081146111d8576040519150601f19603f3d011682016040523d82523d6000602084013e6111dd565b606091505b50909150508015156000036112045781600f60008282546111fe9190611c07565b90915550505b6000612710600e54886112179190611c3c565b6112219190611c53565b9050868111156112735760405162461bcd60e51b815260206004820152601c60248201527f696e76616c6964206e746e2076616c696461746f7220726577617264000000006044820152606401610216565b61127d8188611bf4565b9650801561132657601054600d546040517fa9059cbb00000000000000000000000000000000000000000000000000000000815273ffffffffffffffffffffffffffffffffffffffff91821660048201526024810184905291169063a9059cbb906044016020604051808303816000875af1158015611300573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906113249190611c1a565b505b60035460009061133a633b9aca0087611c3c565b6113449190611c53565b9050806006546113549190611c07565b60065560035460009061136b633b9aca008b611c3c565b6113759190611c53565b9050806009546113859190611c07565b600955600354600090633b9aca009061139e9085611c3c565b6113a89190611c53565b90506000633b9aca00600354846113bf9190611c3c565b6113c99190611c53565b90506113d58288611c07565b6113df8287611c07565b995099505050505050505050915091565b60006113fc3383611722565b611406838361183d565b60405182815273ffffffffffffffffffffffffffffffffffffffff84169033907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef9060200160405180910390a350600192915050565b600d5473ffffffffffffffffffffffffffffffffffffffff1633146114e95760405162461bcd60e51b815260206004820152602260248201527f6f6e6c792074726561737572792063616e20636c61696d20686973207265776160448201527f72640000000000000000000000000000000000000000000000000000000000006064820152608401610216565b600d54600f5460405160009273ffffffffffffffffffffffffffffffffffffffff1691908381818185875af1925050503d8060008114611545576040519150601f19603f3d011682016040523d82523d6000602084013e61154a565b606091505b505090508061159b5760405162461bcd60e51b815260206004820152601260248201527f6661696c656420746f2073656e642041544e00000000000000000000000000006044820152606401610216565b506000600f55565b73ffffffffffffffffffffffffffffffffffffffff831661162b5760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f2061646460448201527f72657373000000000000000000000000000000000000000000000000000000006064820152608401610216565b73ffffffffffffffffffffffffffffffffffffffff82166116b45760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f20616464726560448201527f73730000000000000000000000000000000000000000000000000000000000006064820152608401610216565b73ffffffffffffffffffffffffffffffffffffffff83811660008181526002602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b61172b82611896565b505073ffffffffffffffffffffffffffffffffffffffff8216600090815260208181526040808320546001909252909120546117679082611bf4565b8211156117b65760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e7420756e6c6f636b65642066756e647300000000006044820152606401610216565b6117c08282611bf4565b73ffffffffffffffffffffffffffffffffffffffff84166000908152602081905260409020558082036118215773ffffffffffffffffffffffffffffffffffffffff8316600090815260056020908152604080832083905560089091528120555b81600360008282546118339190611bf4565b9091555050505050565b61184682611896565b505073ffffffffffffffffffffffffffffffffffffffff82166000908152602081905260408120805483929061187d908490611c07565b9250508190555080600360008282546109d59190611c07565b73ffffffffffffffffffffffffffffffffffffffff811660009081526020818152604080832054600654600590935290832054839283916118d89184916119d2565b73ffffffffffffffffffffffffffffffffffffffff861660009081526004602052604090205490915061190c908290611c07565b73ffffffffffffffffffffffffffffffffffffffff8616600090815260046020908152604080832084905560065460058352818420556009546008909252822054929650909161195d9185916119d2565b73ffffffffffffffffffffffffffffffffffffffff8716600090815260076020526040902054909150611991908290611c07565b73ffffffffffffffffffffffffffffffffffffffff909616600090815260076020908152604080832089905560095460089092529091205550929492505050565b6000836000036119e45750600061085d565b633b9aca00846119f48486611bf4565b6119fe9190611c3c565b611a089190611c53565b949350505050565b600060208083528351808285015260005b81811015611a3d57858101830151858201604001528201611a21565b5060006040828601015260407fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe0601f8301168501019250505092915050565b803573ffffffffffffffffffffffffffffffffffffffff81168114611aa057600080fd5b919050565b60008060408385031215611ab857600080fd5b611ac183611a7c565b946020939093013593505050565b600060208284031215611ae157600080fd5b5035919050565b600080600060608486031215611afd57600080fd5b611b0684611a7c565b9250611b1460208501611a7c565b9150604084013590509250925092565b600060208284031215611b3657600080fd5b61085d82611a7c565b60008060408385031215611b5257600080fd5b611b5b83611a7c565b9150611b6960208401611a7c565b90509250929050565b600181811c90821680611b8657607f821691505b602082108103611bbf577f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b8181038181111561068957610689611bc5565b8082018082111561068957610689611bc5565b600060208284031215611c2c57600080fd5b8151801515811461085d57600080fd5b808202811582820484141761068957610689611bc5565b600082611c89577f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b50049056fea2646970667358221220c40cfeb7839f47ede12ce7d3fcaa82248ce59a08c7888588cde0dd0aa0aae0aa64736f6c63430008150033608060405234801561001057600080fd5b5060405161061038038061061083398101604081905261002f916101af565b806001600160a01b0316632f2c3f2e6040518163ffffffff1660e01b8152600401602060405180830381865afa15801561006d573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610091919061029f565b83111561009d57600080fd5b600c80546001600160a01b038088166001600160a01b031992831617909255600d805492871692909116919091179055600e8390556040516100e39083906020016102b8565b604051602081830303815290604052600a9081610100919061036e565b508160405160200161011291906102b8565b604051602081830303815290604052600b908161012f919061036e565b5050601080546001600160a01b031916331790555061042d92505050565b6001600160a01b038116811461016257600080fd5b50565b80516101708161014d565b919050565b634e487b7160e01b600052604160045260246000fd5b60005b838110156101a657818101518382015260200161018e565b50506000910152565b600080600080600060a086880312156101c757600080fd5b85516101d28161014d565b60208701519095506101e38161014d565b6040870151606088015191955093506001600160401b038082111561020757600080fd5b818801915088601f83011261021b57600080fd5b81518181111561022d5761022d610175565b604051601f8201601f19908116603f0116810190838211818310171561025557610255610175565b816040528281528b602084870101111561026e57600080fd5b61027f83602083016020880161018b565b809650505050505061029360808701610165565b90509295509295909350565b6000602082840312156102b157600080fd5b5051919050565b644c4e544e2d60d81b8152600082516102d881600585016020870161018b565b9190910160050192915050565b600181811c908216806102f957607f821691505b60208210810361031957634e487b7160e01b600052602260045260246000fd5b50919050565b601f82111561036957600081815260208120601f850160051c810160208610156103465750805b601f850160051c820191505b8181101561036557828155600101610352565b5050505b505050565b81516001600160401b0381111561038757610387610175565b61039b8161039584546102e5565b8461031f565b602080601f8311600181146103d057600084156103b85750858301515b600019600386901b1c1916600185901b178555610365565b600085815260208120601f198616915b828110156103ff578886015182559484019460019091019084016103e0565b508582101561041d5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b6101d48061043c6000396000f3fe60806040523661001b57610019610014610022565b61013e565b005b6100196100145b600080601060009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff166396f18a326040518163ffffffff1660e01b8152600401602060405180830381865afa158015610092573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906100b69190610161565b905073ffffffffffffffffffffffffffffffffffffffff8116610139576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152601d60248201527f6c6971756964206c6f67696320636f6e7472616374206e6f7420736574000000604482015260640160405180910390fd5b919050565b3660008037600080366000845af43d6000803e8061015b573d6000fd5b503d6000f35b60006020828403121561017357600080fd5b815173ffffffffffffffffffffffffffffffffffffffff8116811461019757600080fd5b939250505056fea264697066735822122076b5b72323e3d9ea33f6c72d4f6af1b0947104c33397f7484304cd24721df42c64736f6c63430008150033")
 
 var AutonityTestAbi, _ = abi.JSON(strings.NewReader(`[
    {
@@ -443,6 +443,62 @@ var AutonityTestAbi, _ = abi.JSON(strings.NewReader(`[
       "name" : "CommissionRateChange",
       "type" : "event"
    },
+   {
+      "anonymous" : false,
+      "inputs" : [
+         {
+            "components" : [
+               {
+                  "internalType" : "uint256",
+                  "name" : "proposeInitial",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "proposeDelta",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "prevoteInitial",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "prevoteDelta",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "precommitInitial",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "precommitDelta",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "bool",
+                  "name" : "adaptive",
+                  "type" : "bool"
+               }
+            ],
+            "indexed" : false,
+            "internalType" : "struct Autonity.ConsensusTimeouts",
+            "name" : "timeouts",
+            "type" : "tuple"
+         },
+         {
+            "indexed" : false,
+            "internalType" : "uint256",
+            "name" : "toBeAppliedAtBlock",
+            "type" : "uint256"
+         }
+      ],
+      "name" : "ConsensusTimeoutsUpdated",
+      "type" : "event"
+   },
    {
       "anonymous" : false,
       "inputs" : [
@@ -1334,6 +1390,103 @@ var AutonityTestAbi, _ = abi.JSON(strings.NewReader(`[
       "stateMutability" : "view",
       "type" : "function"
    },
+   {
+      "inputs" : [],
+      "name" : "getConsensusTimeouts",
+      "outputs" : [
+         {
+            "components" : [
+               {
+                  "internalType" : "uint256",
+                  "name" : "proposeInitial",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "proposeDelta",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "prevoteInitial",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "prevoteDelta",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "precommitInitial",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "precommitDelta",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "bool",
+                  "name" : "adaptive",
+                  "type" : "bool"
+               }
+            ],
+            "internalType" : "struct Autonity.ConsensusTimeouts",
+            "name" : "",
+            "type" : "tuple"
+         },
+         {
+            "components" : [
+               {
+                  "internalType" : "uint256",
+                  "name" : "proposeInitial",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "proposeDelta",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "prevoteInitial",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "prevoteDelta",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "precommitInitial",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "precommitDelta",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "bool",
+                  "name" : "adaptive",
+                  "type" : "bool"
+               }
+            ],
+            "internalType" : "struct Autonity.ConsensusTimeouts",
+            "name" : "",
+            "type" : "tuple"
+         },
+         {
+            "internalType" : "uint256",
+            "name" : "",
+            "type" : "uint256"
+         }
+      ],
+      "stateMutability" : "view",
+      "type" : "function"
+   },
    {
       "inputs" : [
          {
@@ -1704,7 +1857,7 @@ var AutonityTestAbi, _ = abi.JSON(strings.NewReader(`[
       "inputs" : [
          {
             "internalType" : "uint256",
-            "name" : "_id",
+            "name" : "_unbondingID",
             "type" : "uint256"
          }
       ],
@@ -2142,6 +2295,56 @@ var AutonityTestAbi, _ = abi.JSON(strings.NewReader(`[
       "stateMutability" : "nonpayable",
       "type" : "function"
    },
+   {
+      "inputs" : [
+         {
+            "components" : [
+               {
+                  "internalType" : "uint256",
+                  "name" : "proposeInitial",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "proposeDelta",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "prevoteInitial",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "prevoteDelta",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "precommitInitial",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "precommitDelta",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "bool",
+                  "name" : "adaptive",
+                  "type" : "bool"
+               }
+            ],
+            "internalType" : "struct Autonity.ConsensusTimeouts",
+            "name" : "_timeouts",
+            "type" : "tuple"
+         }
+      ],
+      "name" : "setConsensusTimeouts",
+      "outputs" : [],
+      "stateMutability" : "nonpayable",
+      "type" : "function"
+   },
    {
       "inputs" : [
          {
@@ -4,7 +4,7 @@ import "strings"
 import "github.com/autonity/autonity/accounts/abi"
 import "github.com/autonity/autonity/common"
 
-var AutonityUpgradeTestBytecode = common.Hex2Bytes("608060405261c35060045561c35060055561c350600655614e2060075560006010556000601155633b9aca006037553480156200003b57600080fd5b50604080516000808252602082019092529062000071565b6200005d62000fcf565b815260200190600190039081620000535790505b506040805161014081018252601254608080830191825260135460a08085019190915260145460c08086019190915260155460e080870191909152601654610100808801919091526017546001600160a01b0390811661012089015295875287519081018852601854861681526019548616602082810191909152601a548716828a0152601b548716606080840191909152601c54881683880152601d54881695830195909552601e54871693820193909352601f5486169181019190915281860152855192830186528054909316825260215492820192909252602254818501526023548183015292820192909252602454918101829052906000036200019157603d80546001600160a01b03191633179055620001918282620001ae565b5050602454600103620001a857620001a8620005f0565b620013fa565b80518051601255602080820151601355604080830151601455606080840151601555608080850151601681905560a095860151601780546001600160a01b03199081166001600160a01b03938416179091558689015180516018805484169185169190911790558088015160198054841691851691909117905580870151601a8054841691851691909117905580860151601b8054841691851691909117905593840151601c8054831691841691909117905596830151601d8054891691831691909117905560c0830151601e8054891691831691909117905560e090920151601f805488169184169190911790558387015180518654909716969092169590951784559283015160218190558383015160225592810151602355840151602455602891909155603c9190915551620002e7906200109f565b604051809103906000f08015801562000304573d6000803e3d6000fd5b50603e80546001600160a01b0319166001600160a01b039290921691909117905560005b8251811015620005eb5760008382815181106200034957620003496200114c565b602002602001015160a00151905060008483815181106200036e576200036e6200114c565b60200260200101516101a001818152505060008483815181106200039657620003966200114c565b602002602001015161018001906001600160a01b031690816001600160a01b0316815250506000848381518110620003d257620003d26200114c565b602002602001015160a00181815250506000848381518110620003f957620003f96200114c565b60209081029190910101516101c0015260145484518590849081106200042357620004236200114c565b6020026020010151608001818152505060008483815181106200044a576200044a6200114c565b6020026020010151610260019060038111156200046b576200046b62001162565b9081600381111562000481576200048162001162565b8152505060008483815181106200049c576200049c6200114c565b6020026020010151610160018181525050620004da848381518110620004c657620004c66200114c565b60200260200101516200076160201b60201c565b62000507848381518110620004f357620004f36200114c565b60200260200101516200089860201b60201c565b80603960008685815181106200052157620005216200114c565b6020026020010151600001516001600160a01b03166001600160a01b0316815260200190815260200160002060008282546200055e91906200118e565b9250508190555080603b60008282546200057991906200118e565b92505081905550620005d38483815181106200059957620005996200114c565b60200260200101516020015182868581518110620005bb57620005bb6200114c565b60200260200101516000015162000b4560201b60201c565b50508080620005e290620011aa565b91505062000328565b505050565b603d546001600160a01b031633146200065c5760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201526218dbdb60ea1b60648201526084015b60405180910390fd5b6002603a600060256001815481106200067957620006796200114c565b60009182526020808320909101546001600160a01b0316835282019290925260400181206005018054909190620006b2908490620011dc565b925050819055506002603a60006025600181548110620006d657620006d66200114c565b60009182526020808320909101546001600160a01b03168352820192909252604001812060080180549091906200070f908490620011dc565b90915550506002602455602080546001600160a01b031660009081526039909152604081206103e890556200074790600190620010ad565b6200075560026000620010ad565b6003805460ff19169055565b600062000778826060015162000d5c60201b60201c565b6001600160a01b03909116602084015290508015620007c85760405162461bcd60e51b815260206004820152600b60248201526a32b737b2329032b93937b960a91b604482015260640162000653565b6020808301516001600160a01b039081166000908152603a90925260409091206001015416156200083c5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c7265616479207265676973746572656400000000604482015260640162000653565b61271082608001511115620008945760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e2072617465000000000000000000604482015260640162000653565b5050565b6101808101516001600160a01b03166200099757603e546001600160a01b0316620009115760405162461bcd60e51b815260206004820152602260248201527f6c6971756964206c6f67696320636f6e7472616374206e6f74206465706c6f79604482015261195960f21b606482015260840162000653565b602554600090620009229062000daa565b905081602001518260000151836080015183603e60009054906101000a90046001600160a01b03166040516200095890620010ef565b62000968959493929190620011f3565b604051809103906000f08015801562000985573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051602580546001808201835560009283527f401968ff42a154441da5f6c4c935ac46b8671f0e062baaa62a7545ba53bb6e4c90910180546001600160a01b03199081166001600160a01b0395861617909155845184168352603a9095526040918290208651815487169085161781559351908401805486169184169190911790558401516002830180549094169116179091556060820151829190600382019062000a49908262001301565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c820180546001600160a01b0319166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f82015561020082015160108201556102208201516011820155610240820151601282019062000b13908262001301565b5061026082015160138201805460ff1916600183600381111562000b3b5762000b3b62001162565b0217905550505050565b600080831162000ba45760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201526269766560e81b606482015260840162000653565b6001600160a01b03821660009081526039602052604090205483111562000c0e5760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e63650000000000604482015260640162000653565b6001600160a01b0382166000908152603960205260408120805485929062000c38908490620013cd565b9091555050604080516080810182526001600160a01b03808516825286811660208084019182528385018881524360608601908152600a805460009081526008909452968320865181549087166001600160a01b03199182161782559451600182018054919097169516949094179094555160028301559151600390910155825491929062000cc783620011aa565b90915550506001600160a01b038581166000818152603a6020908152604091829020548251908516948816948514808252918101899052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d910160405180910390a3833b1562000d415762000d41848762000eca565b6001600a5462000d529190620013cd565b9695505050505050565b60008062000d69620010fd565b60008060ff9050604083875160208901845afa62000d8657600080fd5b505080516020909101516c0100000000000000000000000090910494909350915050565b60608160000362000dd25750506040805180820190915260018152600360fc1b602082015290565b8160005b811562000e02578062000de981620011aa565b915062000dfa9050600a83620011dc565b915062000dd6565b6000816001600160401b0381111562000e1f5762000e1f62001136565b6040519080825280601f01601f19166020018201604052801562000e4a576020820181803683370190505b5090505b841562000ec25762000e62600183620013cd565b915062000e71600a86620013e3565b62000e7e9060306200118e565b60f81b81838151811062000e965762000e966200114c565b60200101906001600160f81b031916908160001a90535062000eba600a86620011dc565b945062000e4e565b949350505050565b6001600160a01b038216600090815260346020526040812054900362000f48576001600160a01b03821660008181526034602052604081206001908190556035805491820181559091527fcfa4bec1d3298408bb5afcfcd9c430549c5b31f8aa5c5848151c0a55f473c34d0180546001600160a01b03191690911790555b6001600160a01b038083166000908152603260209081526040808320938516835292905290812054900362000894576001600160a01b03808316600081815260326020908152604080832094861680845294825280832060019081905593835260338252822080549384018155825290200180546001600160a01b03191690911790555050565b60405180610280016040528060006001600160a01b0316815260200160006001600160a01b0316815260200160006001600160a01b0316815260200160608152602001600081526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b03168152602001600081526020016000815260200160008152602001600081526020016000815260200160608152602001600060038111156200109a576200109a62001162565b905290565b611cf6806200b5d483390190565b508054620010bb9062001273565b6000825580601f10620010cc575050565b601f016020900490600052602060002090810190620010ec91906200111b565b50565b610610806200d2ca83390190565b60405180604001604052806002906020820280368337509192915050565b5b808211156200113257600081556001016200111c565b5090565b634e487b7160e01b600052604160045260246000fd5b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052602160045260246000fd5b634e487b7160e01b600052601160045260246000fd5b80820180821115620011a457620011a462001178565b92915050565b600060018201620011bf57620011bf62001178565b5060010190565b634e487b7160e01b600052601260045260246000fd5b600082620011ee57620011ee620011c6565b500490565b600060018060a01b03808816835260208188168185015286604085015260a06060850152855191508160a085015260005b82811015620012425786810182015185820160c00152810162001224565b5050600060c0828501015260c0601f19601f83011684010191505062000d5260808301846001600160a01b03169052565b600181811c908216806200128857607f821691505b602082108103620012a957634e487b7160e01b600052602260045260246000fd5b50919050565b601f821115620005eb57600081815260208120601f850160051c81016020861015620012d85750805b601f850160051c820191505b81811015620012f957828155600101620012e4565b505050505050565b81516001600160401b038111156200131d576200131d62001136565b62001335816200132e845462001273565b84620012af565b602080601f8311600181146200136d5760008415620013545750858301515b600019600386901b1c1916600185901b178555620012f9565b600085815260208120601f198616915b828110156200139e578886015182559484019460019091019084016200137d565b5085821015620013bd5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b81810381811115620011a457620011a462001178565b600082620013f557620013f5620011c6565b500690565b61a1ca806200140a6000396000f3fe608060405260043610620005cf5760003560e01c8063872cf05911620002f7578063b46e55201162000197578063d5f3948811620000e7578063e7f43c68116200009b578063f03b959d1162000075578063f03b959d146200121e578063f1d592a71462001243578063f7866ee3146200126857005b8063e7f43c6814620011c1578063e98712b214620011e1578063ee7ff28d14620011f957005b8063d886f8a211620000cd578063d886f8a2146200113a578063dd62ed3e146200115f578063dfb1a4d214620011a957005b8063d5f394881462001100578063d861b0e8146200112257005b8063cb696f54116200014b578063cf9c57191162000125578063cf9c5719146200109e578063cfd19fb914620010b6578063d372c07e14620010db57005b8063cb696f54146200103c578063ceaad4551462001061578063cef98450146200108657005b8063b6ef8c67116200017d578063b6ef8c671462000fd8578063b7ab4db51462000ffd578063c9d97af4146200102457005b8063b46e55201462000f8b578063b66b3e791462000fb057005b8063a5d059ca1162000253578063ab8f6ffe1162000207578063affb1cf111620001e1578063affb1cf11462000f1c578063b2ea9adb1462000f41578063b3ecbadd1462000f6657005b8063ab8f6ffe1462000eb5578063ae1da0b51462000edc578063ae1f5fa01462000ef457005b8063a9059cbb1162000239578063a9059cbb1462000e20578063a9af10591462000e45578063a9fd1a8f1462000e8b57005b8063a5d059ca1462000de2578063a8b2216e1462000df957005b806396f18a3211620002ab5780639c98e47111620002915780639c98e4711462000d8e5780639dc29fac1462000da6578063a515366a1462000dcb57005b806396f18a321462000d545780639ac310741462000d7657005b80638bac7dad11620002dd5780638bac7dad1462000cc257806395d89b411462000ce757806396b477cb1462000d2f57005b8063872cf0591462000c9257806389c614b81462000caa57005b806340c10f19116200046f57806370a0823111620003bf57806379502c551162000373578063833b1fce116200034d578063833b1fce1462000c1457806384467fdb1462000c48578063852c48491462000c6d57005b806379502c551462000ada5780637e660ac91462000bd8578063819b64631462000bfd57005b8063731b3a0311620003a5578063731b3a031462000a6757806377e741c71462000a90578063784304b51462000ab557005b806370a082311462000a1557806371d1bc591462000a4f57005b8063573963b811620004235780636b5f444c11620003fd5780636b5f444c14620009c15780636bb9cb0e14620009e65780636fd2c80b14620009fe57005b8063573963b8146200096c5780635bdedd1614620009845780636a929cef146200099c57005b8063496ccd9b1162000455578063496ccd9b14620008f75780634bb278f3146200091c578063520fdbbc146200094757005b806340c10f1914620008bb5780634364596914620008e057005b8063161605e3116200052b57806325ce1bb911620004df578063313ce56711620004b9578063313ce567146200086057806335be16e0146200087e578063386a827b14620008a357005b806325ce1bb9146200080857806329070c6d14620008315780632f2c3f2e146200084857005b80631904bb2e11620005115780631904bb2e146200078a5780631a0cf2e514620007be57806323b872dd14620007e357005b8063161605e314620005d757806318160ddd146200077357005b80630fe501091162000583578063114eaf551162000569578063114eaf5514620007115780631250a28d14620007365780631604e416146200075b57005b80630fe5010914620006d55780631122063314620006fa57005b80630ae65e7a11620005b55780630ae65e7a146200066a5780630b21fb1d146200068f5780630d8e6e2c14620006b457005b806306fdde0314620005d9578063095ea7b3146200063457005b36620005d757005b005b348015620005e657600080fd5b5060408051808201909152600681527f4e6577746f6e000000000000000000000000000000000000000000000000000060208201525b6040516200062b9190620089fd565b60405180910390f35b3480156200064157600080fd5b50620006596200065336600462008a2f565b62001288565b60405190151581526020016200062b565b3480156200067757600080fd5b50620005d76200068936600462008a5e565b620012a1565b3480156200069c57600080fd5b50620005d7620006ae36600462008a7e565b620013c7565b348015620006c157600080fd5b506024545b6040519081526020016200062b565b348015620006e257600080fd5b50620005d7620006f436600462008a7e565b62001428565b3480156200070757600080fd5b50601354620006c6565b3480156200071e57600080fd5b50620005d76200073036600462008a7e565b62001489565b3480156200074357600080fd5b50620005d76200075536600462008a5e565b620014ea565b3480156200076857600080fd5b50620006c6602f5481565b3480156200078057600080fd5b50603b54620006c6565b3480156200079757600080fd5b50620007af620007a936600462008a5e565b62001577565b6040516200062b919062008b0e565b348015620007cb57600080fd5b50620005d7620007dd36600462008a5e565b62001854565b348015620007f057600080fd5b50620006596200080236600462008c87565b620018df565b3480156200081557600080fd5b506026546000908152602c6020526040902060030154620006c6565b3480156200083e57600080fd5b50601254620006c6565b3480156200085557600080fd5b50620006c661271081565b3480156200086d57600080fd5b50604051601281526020016200062b565b3480156200088b57600080fd5b50620005d76200089d36600462008ccd565b620019f9565b348015620008b057600080fd5b50620006c660055481565b348015620008c857600080fd5b50620005d7620008da36600462008a2f565b62001bef565b348015620008ed57600080fd5b50602254620006c6565b3480156200090457600080fd5b50620005d76200091636600462008a5e565b62001c5b565b3480156200092957600080fd5b506200093462001dd3565b6040516200062b95949392919062008d8e565b3480156200095457600080fd5b50620005d76200096636600462008a5e565b6200271f565b3480156200097957600080fd5b50620006c660285481565b3480156200099157600080fd5b50620006c660075481565b348015620009a957600080fd5b50620005d7620009bb36600462008a7e565b620029d3565b348015620009ce57600080fd5b50620005d7620009e036600462008a7e565b62002a34565b348015620009f357600080fd5b50620006c660375481565b34801562000a0b57600080fd5b50601554620006c6565b34801562000a2257600080fd5b50620006c662000a3436600462008a5e565b6001600160a01b031660009081526039602052604090205490565b34801562000a5c57600080fd5b50620006c660065481565b34801562000a7457600080fd5b506026546000908152602c6020526040902060020154620006c6565b34801562000a9d57600080fd5b50620005d762000aaf36600462008a7e565b62002ae9565b34801562000ac257600080fd5b50620005d762000ad436600462008e8b565b62002b4a565b34801562000ae757600080fd5b506040805160c080820183526012548252601354602080840191909152601454838501526015546060808501919091526016546080808601919091526017546001600160a01b0390811660a08088019190915287516101008101895260185483168152601954831681870152601a548316818a0152601b54831681860152601c54831681850152601d54831691810191909152601e54821695810195909552601f54811660e086015286519182018752835416815260215492810192909252602254948201949094526023549381019390935260245462000bc6939084565b6040516200062b949392919062008ee1565b34801562000be557600080fd5b50620005d762000bf736600462008a7e565b62002dbc565b34801562000c0a57600080fd5b50602354620006c6565b34801562000c2157600080fd5b506019546001600160a01b03165b6040516001600160a01b0390911681526020016200062b565b34801562000c5557600080fd5b50620005d762000c6736600462008fe3565b62002e1d565b34801562000c7a57600080fd5b50620005d762000c8c36600462008a2f565b62002f4c565b34801562000c9f57600080fd5b50620005d76200316e565b34801562000cb757600080fd5b50620006c6602a5481565b34801562000ccf57600080fd5b50620005d762000ce136600462008a7e565b620031d9565b34801562000cf457600080fd5b5060408051808201909152600381527f4e544e000000000000000000000000000000000000000000000000000000000060208201526200061c565b34801562000d3c57600080fd5b50620006c662000d4e36600462008a7e565b6200328c565b34801562000d6157600080fd5b50603e5462000c2f906001600160a01b031681565b34801562000d8357600080fd5b50620006c6603c5481565b34801562000d9b57600080fd5b50620006c6602b5481565b34801562000db357600080fd5b50620005d762000dc536600462008a2f565b6200333b565b620006c662000ddc36600462008a2f565b6200348e565b620006c662000df336600462008a2f565b620035da565b34801562000e0657600080fd5b5062000e11620036ea565b6040516200062b91906200908c565b34801562000e2d57600080fd5b506200065962000e3f36600462008a2f565b620037cd565b34801562000e5257600080fd5b5062000e7c62000e6436600462008a7e565b6000908152600b602052604090206006015460ff1690565b6040516200062b919062009110565b34801562000e9857600080fd5b5062000ea362003825565b6040516200062b949392919062009125565b34801562000ec257600080fd5b5062000ecd620039a2565b6040516200062b919062009156565b34801562000ee957600080fd5b50620006c660295481565b34801562000f0157600080fd5b5062000f0c62003ab4565b6040516200062b929190620091b1565b34801562000f2957600080fd5b5062000ea362000f3b36600462008a7e565b62003de0565b34801562000f4e57600080fd5b50620005d762000f60366004620091e3565b62004001565b34801562000f7357600080fd5b50620005d762000f8536600462008a5e565b62004077565b34801562000f9857600080fd5b50620005d762000faa36600462008a5e565b62004102565b34801562000fbd57600080fd5b5062000fc8620043d7565b6040516200062b92919062009244565b34801562000fe557600080fd5b50620006c662000ff736600462008a7e565b6200450e565b3480156200100a57600080fd5b5062001015620045a0565b6040516200062b91906200926d565b3480156200103157600080fd5b50620006c660265481565b3480156200104957600080fd5b50620005d76200105b36600462008a7e565b62004604565b3480156200106e57600080fd5b50620005d76200108036600462008a5e565b6200469b565b3480156200109357600080fd5b50620006c660045481565b348015620010ab57600080fd5b50620005d762004726565b348015620010c357600080fd5b50620005d7620010d536600462008a5e565b620047aa565b348015620010e857600080fd5b50620005d7620010fa36600462008a5e565b62004835565b3480156200110d57600080fd5b50603d5462000c2f906001600160a01b031681565b3480156200112f57600080fd5b50620005d7620048c0565b3480156200114757600080fd5b50620005d76200115936600462008a5e565b62004aca565b3480156200116c57600080fd5b50620006c66200117e36600462009282565b6001600160a01b03918216600090815260316020908152604080832093909416825291909152205490565b348015620011b657600080fd5b50620006c662004b55565b348015620011ce57600080fd5b506020546001600160a01b031662000c2f565b348015620011ee57600080fd5b50620006c6602e5481565b3480156200120657600080fd5b50620005d76200121836600462008a7e565b62004b72565b3480156200122b57600080fd5b50620005d76200123d36600462008a5e565b62004bd3565b3480156200125057600080fd5b50620005d76200126236600462008a5e565b62004c5e565b3480156200127557600080fd5b506017546001600160a01b031662000c2f565b60006200129733848462004d67565b5060015b92915050565b620012ab62004ec4565b6001600160a01b038082166000818152603a6020526040902060010154909116146200131e5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d75737420626520726567697374657265640000000060448201526064015b60405180910390fd5b6001600160a01b038181166000908152603a6020526040902054163314620013af5760405162461bcd60e51b815260206004820152602c60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72206160448201527f646d696e206163636f756e740000000000000000000000000000000000000000606482015260840162001315565b620013ba8162004f1d565b620013c460008055565b50565b6020546001600160a01b03163314620014235760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b600555565b6020546001600160a01b03163314620014845760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b600455565b6020546001600160a01b03163314620014e55760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b601555565b6020546001600160a01b03163314620015465760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b6018805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b565b62001581620087b6565b6001600160a01b038083166000818152603a602052604090206001015490911614620015f05760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f7420726567697374657265640000000000000000604482015260640162001315565b6001600160a01b038083166000908152603a602090815260409182902082516102808101845281548516815260018201548516928101929092526002810154909316918101919091526003820180549192916060840191906200165390620092c0565b80601f01602080910402602001604051908101604052809291908181526020018280546200168190620092c0565b8015620016d25780601f10620016a657610100808354040283529160200191620016d2565b820191906000526020600020905b815481529060010190602001808311620016b457829003601f168201915b505050918352505060048201546020820152600582015460408201526006820154606082015260078201546080820152600882015460a0820152600982015460c0820152600a82015460e0820152600b820154610100820152600c8201546001600160a01b0316610120820152600d820154610140820152600e820154610160820152600f82015461018082015260108201546101a082015260118201546101c08201526012820180546101e0909201916200178e90620092c0565b80601f0160208091040260200160405190810160405280929190818152602001828054620017bc90620092c0565b80156200180d5780601f10620017e1576101008083540402835291602001916200180d565b820191906000526020600020905b815481529060010190602001808311620017ef57829003601f168201915b5050509183525050601382015460209091019060ff16600381111562001837576200183762008a98565b60038111156200184b576200184b62008a98565b90525092915050565b6020546001600160a01b03163314620018b05760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b601f805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6001600160a01b0383166000908152603160209081526040808320338452909152812054828110156200197b5760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e742065786365656473206160448201527f6c6c6f77616e6365000000000000000000000000000000000000000000000000606482015260840162001315565b620019888585856200500d565b620019a085336200199a868562009344565b62004d67565b836001600160a01b0316856001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef85604051620019e691815260200190565b60405180910390a3506001949350505050565b6018546001600160a01b0316331462001a7b5760405162461bcd60e51b815260206004820152602360248201527f63616c6c6572206973206e6f742074686520736c617368696e6720636f6e747260448201527f6163740000000000000000000000000000000000000000000000000000000000606482015260840162001315565b6000610120820135603a8262001a98604086016020870162008a5e565b6001600160a01b03166001600160a01b031681526020019081526020016000206009015462001ac8919062009344565b60c0830135603a600062001ae3604087016020880162008a5e565b6001600160a01b03166001600160a01b031681526020019081526020016000206006015462001b13919062009344565b60a0840135603a600062001b2e604088016020890162008a5e565b6001600160a01b03166001600160a01b031681526020019081526020016000206005015462001b5e919062009344565b62001b6a91906200935a565b62001b7691906200935a565b6017546001600160a01b031660009081526039602052604081208054929350839290919062001ba79084906200935a565b90915550829050603a600062001bc4604084016020850162008a5e565b6001600160a01b03168152602081019190915260400160002062001be9828262009535565b50505050565b6020546001600160a01b0316331462001c4b5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b62001c57828262005146565b5050565b6020546001600160a01b0316331462001cb75760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b6019805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b03838116918217909255601a546040517f7adbf973000000000000000000000000000000000000000000000000000000008152600481019290925290911690637adbf97390602401600060405180830381600087803b15801562001d3c57600080fd5b505af115801562001d51573d6000803e3d6000fd5b5050601c546040517f7adbf9730000000000000000000000000000000000000000000000000000000081526001600160a01b0385811660048301529091169250637adbf97391506024015b600060405180830381600087803b15801562001db757600080fd5b505af115801562001dcc573d6000803e3d6000fd5b5050505050565b603d546000908190606090829081906001600160a01b0316331462001e615760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c0000000000000000000000000000000000000000000000000000000000606482015260840162001315565b62001e6b62004ec4565b4360298190556026546000828152602760209081526040808320849055928252602c90528190206003015460185491517f6c9789b000000000000000000000000000000000000000000000000000000000815292101560048301819052916001600160a01b0390911690636c9789b090602401600060405180830381600087803b15801562001ef957600080fd5b505af115801562001f0e573d6000803e3d6000fd5b505050508015620024a557601e54603b54603c54602a546040517f92eff3cd0000000000000000000000000000000000000000000000000000000081526004810193909352602483019190915260448201524260648201526000916001600160a01b0316906392eff3cd90608401602060405180830381865afa15801562001f9a573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019062001fc091906200971a565b905080603c54101562001fd25750603c545b62001fde308262005146565b80603c600082825462001ff2919062009344565b9091555050601f54604080517ff968f49300000000000000000000000000000000000000000000000000000000815281516001600160a01b039093169263f968f4939260048084019391929182900301816000875af192505050801562002078575060408051601f3d908101601f19168201909252620020759181019062009734565b60015b620020b6576040514281527ff1217bb194383aa5c937c81cbbb445990a478c7511b080df329f9b0fb64233c99060200160405180910390a1620020e9565b601754620020ce906001600160a01b03168262005146565b601f54620020e6906001600160a01b03168362005146565b50505b3060009081526039602052604090205462002106904790620051cc565b6200211062005744565b6200211a62005a07565b6200212462005af6565b6200212e62005b95565b6000806200213b62003ab4565b6019546040517f845023f20000000000000000000000000000000000000000000000000000000081529294509092506001600160a01b03169063845023f2906200218a9085906004016200926d565b600060405180830381600087803b158015620021a557600080fd5b505af1158015620021ba573d6000803e3d6000fd5b50506018546040517fe08b14ed0000000000000000000000000000000000000000000000000000000081526001600160a01b03909116925063e08b14ed9150620022099084906004016200926d565b600060405180830381600087803b1580156200222457600080fd5b505af115801562002239573d6000803e3d6000fd5b50506026546000908152602c602052604090206002015460285460215491935014801591506200226a575060285415155b15620022f15760285460218190556018546040517f6b5f444c00000000000000000000000000000000000000000000000000000000815260048101929092526001600160a01b031690636b5f444c90602401600060405180830381600087803b158015620022d757600080fd5b505af1158015620022ec573d6000803e3d6000fd5b505050505b6021546000906200230390436200935a565b905042602a819055506001602660008282546200232191906200935a565b909155505060265460408051602d805460a0602082028401810190945260808301818152620024639594849391929060009085015b8282101562002444576000848152602090819020604080516060810182526003860290920180546001600160a01b0316835260018101549383019390935260028301805492939291840191620023ac90620092c0565b80601f0160208091040260200160405190810160405280929190818152602001828054620023da90620092c0565b80156200242b5780601f10620023ff576101008083540402835291602001916200242b565b820191906000526020600020905b8154815290600101906020018083116200240d57829003601f168201915b5050505050815250508152602001906001019062002356565b5050505081526020018581526020014381526020018481525062005cd6565b7febad8099c467528a56c98b63c8d476d251cf1ffb4c75db94b4d23fa2b6a1e3356026546040516200249791815260200190565b60405180910390a150505050505b601954604080517f4bb278f300000000000000000000000000000000000000000000000000000000815290516000926001600160a01b031691634bb278f3916004808301926020929190829003018187875af11580156200250a573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019062002530919062009759565b90508015620025c557601a54604080517fa2e6204500000000000000000000000000000000000000000000000000000000815290516001600160a01b039092169163a2e620459160048082019260209290919082900301816000875af1925050508015620025bd575060408051601f3d908101601f19168201909252620025ba9181019062009759565b60015b15620025c557505b600380546026546000908152602c602090815260408083206001810154950154602d805483518186028101860190945280845260ff909616968996919590949293928692919084015b82821015620026fc576000848152602090819020604080516060810182526003860290920180546001600160a01b03168352600181015493830193909352600283018054929392918401916200266490620092c0565b80601f01602080910402602001604051908101604052809291908181526020018280546200269290620092c0565b8015620026e35780601f10620026b757610100808354040283529160200191620026e3565b820191906000526020600020905b815481529060010190602001808311620026c557829003601f168201915b505050505081525050815260200190600101906200260e565b5050505092509650965096509650965050506200271860008055565b9091929394565b6020546001600160a01b031633146200277b5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b6020805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b038381169182179092556019546040517fb3ab15fb00000000000000000000000000000000000000000000000000000000815260048101929092529091169063b3ab15fb90602401600060405180830381600087803b1580156200280057600080fd5b505af115801562002815573d6000803e3d6000fd5b5050601a546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b1580156200287a57600080fd5b505af11580156200288f573d6000803e3d6000fd5b5050601b546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b158015620028f457600080fd5b505af115801562002909573d6000803e3d6000fd5b5050601c546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b1580156200296e57600080fd5b505af115801562002983573d6000803e3d6000fd5b5050601d546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb915060240162001d9c565b6020546001600160a01b0316331462002a2f5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b603755565b6020546001600160a01b0316331462002a905760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b60288190556026546000908152602c6020908152604091829020600301548251848152918201819052917f2eea6438d890c8603d4df81ad1bad2a4ea45c02b4837165f461ff3c81603abc7910160405180910390a15050565b6020546001600160a01b0316331462002b455760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b601255565b6001600160a01b038083166000818152603a6020526040902060018101549092161462002bba5760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f7420726567697374657265640000000000000000604482015260640162001315565b80546001600160a01b0316331462002c3b5760405162461bcd60e51b815260206004820152602f60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72207460448201527f72656173757279206163636f756e740000000000000000000000000000000000606482015260840162001315565b62002c468362005db3565b1562002cbb5760405162461bcd60e51b815260206004820152602260248201527f76616c696461746f72206d757374206e6f7420626520696e20636f6d6d69747460448201527f6565000000000000000000000000000000000000000000000000000000000000606482015260840162001315565b60008062002cc98462005e24565b92509050811562002d1d5760405162461bcd60e51b815260206004820152600b60248201527f656e6f6465206572726f72000000000000000000000000000000000000000000604482015260640162001315565b60018301546001600160a01b0382811691161462002da45760405162461bcd60e51b815260206004820152602760248201527f76616c696461746f72206e6f646520616464726573732063616e27742062652060448201527f7570646174656400000000000000000000000000000000000000000000000000606482015260840162001315565b6003830162002db485826200977d565b505050505050565b6020546001600160a01b0316331462002e185760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b600755565b6000604051806102800160405280336001600160a01b0316815260200160006001600160a01b03168152602001856001600160a01b0316815260200186815260200160126000016002015481526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b03168152602001600081526020014381526020016000815260200160008152602001600081526020018481526020016000600381111562002eec5762002eec62008a98565b9052905062002efc818362005e72565b60208101516101808201516040517f8ad8bd2eb6950e5f332fd3a6dca48cb358ecfe3057848902b98cbdfe455c915c9262002f3d92339289918b916200984a565b60405180910390a15050505050565b6001600160a01b038083166000818152603a60205260409020600101549091161462002fbb5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d757374206265207265676973746572656400000000604482015260640162001315565b6001600160a01b038281166000908152603a60205260409020541633146200304c5760405162461bcd60e51b815260206004820152602c60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72206160448201527f646d696e206163636f756e740000000000000000000000000000000000000000606482015260840162001315565b612710811115620030a05760405162461bcd60e51b815260206004820152601f60248201527f7265717569726520636f727265637420636f6d6d697373696f6e207261746500604482015260640162001315565b604080516060810182526001600160a01b038481168252436020808401918252838501868152601180546000908152600f9093529582208551815473ffffffffffffffffffffffffffffffffffffffff1916951694909417845591516001808501919091559151600290930192909255835492939092909190620031269084906200935a565b90915550506040518281526001600160a01b038416907f4fba51c92fa3d6ad8374d394f6cd5766857552e153d7384a8f23aa4ce9a8a7cf9060200160405180910390a2505050565b6020546001600160a01b03163314620031ca5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b6003805460ff19166001179055565b6020546001600160a01b03163314620032355760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b60008111620032875760405162461bcd60e51b815260206004820152601960248201527f636f6d6d69747465652073697a652063616e2774206265203000000000000000604482015260640162001315565b602355565b600060295460016200329f91906200935a565b821115620033165760405162461bcd60e51b815260206004820152602660248201527f63616e6e6f74206765742065706f636820696420666f7220612066757475726560448201527f20626c6f636b0000000000000000000000000000000000000000000000000000606482015260840162001315565b602954821162003333575060009081526027602052604090205490565b505060265490565b6020546001600160a01b03163314620033975760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b6001600160a01b038216600090815260396020526040902054811115620034015760405162461bcd60e51b815260206004820152601660248201527f416d6f756e7420657863656564732062616c616e636500000000000000000000604482015260640162001315565b6001600160a01b038216600090815260396020526040812080548392906200342b90849062009344565b9250508190555080603b600082825462003446919062009344565b90915550506040518181526001600160a01b038316907f5024dbeedf0c06664c9bd7be836915730c955e936972c020683dadf11d5488a3906020015b60405180910390a25050565b60006200349a62004ec4565b6001600160a01b038084166000818152603a602052604090206001015490911614620035095760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f7420726567697374657265640000000000000000604482015260640162001315565b6001600160a01b0383166000908152603a602052604081206013015460ff1660038111156200353c576200353c62008a98565b146200358b5760405162461bcd60e51b815260206004820152601b60248201527f76616c696461746f72206e65656420746f206265206163746976650000000000604482015260640162001315565b6037546200359a9034620098c3565b3360009081526038602052604081208054909190620035bb9084906200935a565b90915550620035ce905083833362006315565b90506200129b60008055565b6000620035e662004ec4565b6001600160a01b038084166000818152603a602052604090206001015490911614620036555760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f7420726567697374657265640000000000000000604482015260640162001315565b60008211620036a75760405162461bcd60e51b815260206004820152601560248201527f756e626f6e64696e6720616d6f756e7420697320300000000000000000000000604482015260640162001315565b603754620036b69034620098c3565b3360009081526038602052604081208054909190620036d79084906200935a565b90915550620035ce905083833362006553565b60606030805480602002602001604051908101604052809291908181526020016000905b82821015620037c45783829060005260206000200180546200373090620092c0565b80601f01602080910402602001604051908101604052809291908181526020018280546200375e90620092c0565b8015620037af5780601f106200378357610100808354040283529160200191620037af565b820191906000526020600020905b8154815290600101906020018083116200379157829003601f168201915b5050505050815260200190600101906200370e565b50505050905090565b6000620037dc3384846200500d565b6040518281526001600160a01b0384169033907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef9060200160405180910390a350600192915050565b6026546000908152602c602090815260408083208151815460a094810282018501909352608081018381526060959485948594859493909284928491879085015b8282101562003954576000848152602090819020604080516060810182526003860290920180546001600160a01b0316835260018101549383019390935260028301805492939291840191620038bc90620092c0565b80601f0160208091040260200160405190810160405280929190818152602001828054620038ea90620092c0565b80156200393b5780601f106200390f576101008083540402835291602001916200393b565b820191906000526020600020905b8154815290600101906020018083116200391d57829003601f168201915b5050505050815250508152602001906001019062003866565b50505090825250600182015460208083019190915260028301546040808401919091526003909301546060928301528351908401519284015193909101519098919750919550909350915050565b6060602d805480602002602001604051908101604052809291908181526020016000905b82821015620037c4576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162003a1c90620092c0565b80601f016020809104026020016040519081016040528092919081815260200182805462003a4a90620092c0565b801562003a9b5780601f1062003a6f5761010080835404028352916020019162003a9b565b820191906000526020600020905b81548152906001019060200180831162003a7d57829003601f168201915b50505050508152505081526020019060010190620039c6565b603d5460609081906001600160a01b0316331462003b3b5760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c0000000000000000000000000000000000000000000000000000000000606482015260840162001315565b60255462003b8c5760405162461bcd60e51b815260206004820152601860248201527f5468657265206d7573742062652076616c696461746f72730000000000000000604482015260640162001315565b62003b9662008886565b602354608082015260258152603a6020820152602d6040820152602b606082015262003bc281620069d7565b62003bd060306000620088a4565b602d548062003c225760405162461bcd60e51b815260206004820152601260248201527f636f6d6d697474656520697320656d7074790000000000000000000000000000604482015260640162001315565b60008167ffffffffffffffff81111562003c405762003c4062008dc7565b60405190808252806020026020018201604052801562003c6a578160200160208202803683370190505b50905060008267ffffffffffffffff81111562003c8b5762003c8b62008dc7565b60405190808252806020026020018201604052801562003cb5578160200160208202803683370190505b50905060005b8381101562003dd4576000603a6000602d848154811062003ce05762003ce0620098da565b60009182526020808320600392830201546001600160a01b031684528301939093526040909101812060308054600181018255925292507f6ff97a59c90d62cc7236ba3a37cd85351bf564556780cf8c1157a220f31f0cbb019062003d489083018262009909565b50600281015484516001600160a01b039091169085908490811062003d715762003d71620098da565b6001600160a01b0392831660209182029290920101526001820154845191169084908490811062003da65762003da6620098da565b6001600160a01b0390921660209283029190910190910152508062003dcb81620099eb565b91505062003cbb565b50909450925050509091565b60606000806000602954600162003df891906200935a565b85111562003e6f5760405162461bcd60e51b815260206004820152602360248201527f63616e6e6f74206765742065706f636820666f7220612066757475726520626c60448201527f6f636b0000000000000000000000000000000000000000000000000000000000606482015260840162001315565b602654602954861162003e8d57506000858152602760205260409020545b6000818152602c602090815260408083208151815460a09481028201850190935260808101838152909391928492849190879085015b8282101562003fb1576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162003f1990620092c0565b80601f016020809104026020016040519081016040528092919081815260200182805462003f4790620092c0565b801562003f985780601f1062003f6c5761010080835404028352916020019162003f98565b820191906000526020600020905b81548152906001019060200180831162003f7a57829003601f168201915b5050505050815250508152602001906001019062003ec3565b5050509082525060018201546020808301919091526002830154604080840191909152600390930154606092830152835190840151928401519390910151909a9199509197509095509350505050565b6020546001600160a01b031633146200405d5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b6200406a600183620069f7565b62001c57600282620069f7565b6020546001600160a01b03163314620040d35760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b601b805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6200410c62004ec4565b6001600160a01b038082166000818152603a6020526040902060010154909116146200417b5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d757374206265207265676973746572656400000000604482015260640162001315565b6001600160a01b038082166000908152603a60205260409020805490911633146200420f5760405162461bcd60e51b815260206004820152602f60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72207460448201527f72656173757279206163636f756e740000000000000000000000000000000000606482015260840162001315565b6000601382015460ff1660038111156200422d576200422d62008a98565b036200427c5760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f7220616c7265616479206163746976650000000000000000604482015260640162001315565b6002601382015460ff1660038111156200429a576200429a62008a98565b148015620042ab5750438160100154115b15620042fa5760405162461bcd60e51b815260206004820152601760248201527f76616c696461746f72207374696c6c20696e206a61696c000000000000000000604482015260640162001315565b6003601382015460ff16600381111562004318576200431862008a98565b03620043675760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206a61696c6564207065726d616e656e746c7900000000604482015260640162001315565b60138101805460ff1916905580546026546000908152602c60209081526040918290206003015491519182526001600160a01b038581169316917f60fcbf2d07dc712a93e59fb28f1edb626d7c2497c57ba71a8c0b3999ecb9a3b5910160405180910390a350620013c460008055565b60608060016002818054620043ec90620092c0565b80601f01602080910402602001604051908101604052809291908181526020018280546200441a90620092c0565b80156200446b5780601f106200443f576101008083540402835291602001916200446b565b820191906000526020600020905b8154815290600101906020018083116200444d57829003601f168201915b505050505091508080546200448090620092c0565b80601f0160208091040260200160405190810160405280929190818152602001828054620044ae90620092c0565b8015620044ff5780601f10620044d357610100808354040283529160200191620044ff565b820191906000526020600020905b815481529060010190602001808311620044e157829003601f168201915b50505050509050915091509091565b600060036000838152600b602052604090206006015460ff1660038111156200453b576200453b62008a98565b146200458a5760405162461bcd60e51b815260206004820152601e60248201527f756e626f6e64696e672072656c65617365206e6f742072657665727465640000604482015260640162001315565b506000908152600b602052604090206005015490565b60606025805480602002602001604051908101604052809291908181526020018280548015620045fa57602002820191906000526020600020905b81546001600160a01b03168152600190910190602001808311620045db575b5050505050905090565b6020546001600160a01b03163314620046605760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b60138190556040518181527f1f4d2fc7529047a5bd96d3229bfea127fd18b7748f13586e097c69fccd3891289060200160405180910390a150565b6020546001600160a01b03163314620046f75760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b601d805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6020546001600160a01b03163314620047825760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b6200479060016000620088c4565b6200479e60026000620088c4565b6003805460ff19169055565b6020546001600160a01b03163314620048065760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b601c805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6020546001600160a01b03163314620048915760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b601a805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b603d546001600160a01b03163314620049425760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c0000000000000000000000000000000000000000000000000000000000606482015260840162001315565b6200494c62004ec4565b6200495662005a07565b6200496062003ab4565b505042602a5543602981905560009081526027602090815260408083208390556026548151602d805460a0958102830186019094526080820184815262004ac096939592948594919390919085015b8282101562004a9d576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162004a0590620092c0565b80601f016020809104026020016040519081016040528092919081815260200182805462004a3390620092c0565b801562004a845780601f1062004a585761010080835404028352916020019162004a84565b820191906000526020600020905b81548152906001019060200180831162004a6657829003601f168201915b50505050508152505081526020019060010190620049af565b505050908252506000602082015243604082015260215460609091015262005cd6565b6200157560008055565b6020546001600160a01b0316331462004b265760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b6017805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6028546021546000911462004b6b575060285490565b5060215490565b6020546001600160a01b0316331462004bce5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b600655565b6020546001600160a01b0316331462004c2f5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b601e805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6020546001600160a01b0316331462004cba5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001315565b6001600160a01b03811662004d385760405162461bcd60e51b815260206004820152602960248201527f696e76616c696420636f6e7472616374206164647265737320666f72206c697160448201527f756964206c6f6769630000000000000000000000000000000000000000000000606482015260840162001315565b603e805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6001600160a01b03831662004de45760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f2061646460448201527f7265737300000000000000000000000000000000000000000000000000000000606482015260840162001315565b6001600160a01b03821662004e625760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f20616464726560448201527f7373000000000000000000000000000000000000000000000000000000000000606482015260840162001315565b6001600160a01b0383811660008181526031602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92591015b60405180910390a3505050565b6000541562004f165760405162461bcd60e51b815260206004820152601360248201527f7265656e7472616e637920646574656374656400000000000000000000000000604482015260640162001315565b6001600055565b6001600160a01b0381166000908152603a6020526040812090601382015460ff16600381111562004f525762004f5262008a98565b1462004fa15760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206d757374206265206163746976650000000000000000604482015260640162001315565b60138101805460ff1916600117905580546026546000908152602c60209081526040918290206003015491519182526001600160a01b038581169316917f75bdcdbe540758778e669d108fbcb7ede734f27f46e4e5525eeb8ecf91849a9c910160405180910390a35050565b6001600160a01b038316600090815260396020526040902054811115620050775760405162461bcd60e51b815260206004820152601660248201527f616d6f756e7420657863656564732062616c616e636500000000000000000000604482015260640162001315565b6001600160a01b03831660009081526039602052604081208054839290620050a190849062009344565b9250508190555080603b6000828254620050bc91906200935a565b90915550620050cf905081600262009a08565b6001600160a01b03831660009081526039602052604081208054909190620050f99084906200935a565b92505081905550816001600160a01b0316836001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8360405162004eb791815260200190565b6001600160a01b03821660009081526039602052604081208054839290620051709084906200935a565b9250508190555080603b60008282546200518b91906200935a565b90915550506040518181526001600160a01b038316907f48490b4407bb949b708ec5f514b4167f08f4969baaf78d53b05028adf369bfcf9060200162003482565b81158015620051d9575080155b15620051e3575050565b601254600090670de0b6b3a7640000906200520090859062009a08565b6200520c9190620098c3565b905080156200528a576017546040516000916001600160a01b03169083908381818185875af1925050503d806000811462005264576040519150601f19603f3d011682016040523d82523d6000602084013e62005269565b606091505b5090915050801515600103620052885762005285828562009344565b93505b505b82602e60008282546200529e91906200935a565b90915550600090505b602d5481101562001be9576000603a6000602d8481548110620052ce57620052ce620098da565b600091825260208083206003909202909101546001600160a01b031683528201929092526040018120602b54602d8054929450909188919086908110620053195762005319620098da565b90600052602060002090600302016001015462005337919062009a08565b620053439190620098c3565b90506000602b5486602d8681548110620053615762005361620098da565b9060005260206000209060030201600101546200537f919062009a08565b6200538b9190620098c3565b905060008211806200539d5750600081115b156200572b576002601384015460ff166003811115620053c157620053c162008a98565b1480620053e957506003601384015460ff166003811115620053e757620053e762008a98565b145b15620054cb57601854620054099030906001600160a01b0316836200500d565b601854602d80546001600160a01b039092169163a8031a1d91859188908110620054375762005437620098da565b600091825260209091206003909102015460405160e084901b7fffffffff000000000000000000000000000000000000000000000000000000001681526001600160a01b039091166004820152602481018590526044016000604051808303818588803b158015620054a857600080fd5b505af1158015620054bd573d6000803e3d6000fd5b50505050505050506200572f565b60008360050154838560080154620054e4919062009a08565b620054f09190620098c3565b90508015620055b257835460405160009182916001600160a01b03909116906108fc90859084818181858888f193505050503d806000811462005550576040519150601f19603f3d011682016040523d82523d6000602084013e62005555565b606091505b509092509050811515600003620055af5785546040517f1137d8c966ce69b9630fb2294be011f3d64cc56e91fad7d375f0662568e9d35291620055a6916001600160a01b0390911690849062009a22565b60405180910390a15b50505b60008460050154838660080154620055cb919062009a08565b620055d79190620098c3565b90508015620055f9578454620055f99030906001600160a01b0316836200500d565b600062005607828562009344565b9050600062005617848762009344565b90506000811180620056295750600082115b15620056dc57600c8701546200564b9030906001600160a01b0316846200500d565b600c8701546040517fa0ce552d000000000000000000000000000000000000000000000000000000008152600481018490526001600160a01b039091169063a0ce552d908390602401604080518083038185885af1158015620056b2573d6000803e3d6000fd5b50505050506040513d601f19601f82011682018060405250810190620056d9919062009734565b50505b600187015460408051888152602081018890526001600160a01b03909216917f291e8ba3c0f4b0bd86e6e2346fcee1e7ca0975b1cc1886bfbc722d34f3f24d91910160405180910390a2505050505b5050505b806200573b81620099eb565b915050620052a7565b60355460005b8181101562001c57576000603582815481106200576b576200576b620098da565b60009182526020808320909101546001600160a01b031680835260338252604080842080548251818602810186019093528083529295509092909190830182828015620057e257602002820191906000526020600020905b81546001600160a01b03168152600190910190602001808311620057c3575b5050505050905060005b81518110156200586a576001600160a01b03831660009081526032602052604081208351909190849084908110620058285762005828620098da565b60200260200101516001600160a01b03166001600160a01b031681526020019081526020016000206000905580806200586190620099eb565b915050620057ec565b506001600160a01b03821660009081526033602052604081206200588e9162008903565b6001600160a01b0382166000908152603860205260409020548151600754620058b8919062009a08565b811115620058d4578151600754620058d1919062009a08565b90505b60005a9050836001600160a01b031663d18736ab83856040518363ffffffff1660e01b81526004016200590891906200926d565b600060405180830381600088803b1580156200592357600080fd5b5087f19350505050801562005936575060015b6200596c575a62005948908262009344565b6001600160a01b03851660009081526036602052604090206001905590506200597c565b5a62005979908262009344565b90505b6001600160a01b038416600090815260386020526040902054811015620059d3576001600160a01b03841660009081526038602052604081208054839290620059c790849062009344565b90915550620059ed9050565b6001600160a01b0384166000908152603860205260408120555b505050508080620059fe90620099eb565b9150506200574a565b6009545b600a5481101562005a345762005a2e8162005a2681620099eb565b925062006b4a565b62005a0b565b50600a54600955600d54600c540362005a4957565b600e545b600d5481101562005a765762005a708162005a6881620099eb565b925062006e04565b62005a4d565b50600d54600e55600c54805b600d5481101562005af0576015546000828152600b6020526040902060040154439162005aaf916200935a565b1162005ad55762005ac081620071ef565b62005acd6001836200935a565b915062005adb565b62005af0565b8062005ae781620099eb565b91505062005a82565b50600c55565b60355460005b8181101562005b865760006035828154811062005b1d5762005b1d620098da565b60009182526020808320909101546001600160a01b0316808352603482526040808420849055603690925291205490915060010362005b70576001600160a01b0381166000908152603660205260408120555b508062005b7d81620099eb565b91505062005afc565b50620013c46035600062008903565b601154601054101562001575576010546000908152600f602052604090206015546001820154439162005bc8916200935a565b111562005bd25750565b80546001600160a01b039081166000908152603a60205260409081902060028401546004808301829055600c83015493517f19fac8fd000000000000000000000000000000000000000000000000000000008152929493909316926319fac8fd9262005c4392910190815260200190565b600060405180830381600087803b15801562005c5e57600080fd5b505af115801562005c73573d6000803e3d6000fd5b5050601080546000908152600f60205260408120805473ffffffffffffffffffffffffffffffffffffffff191681556001808201839055600290910182905582549094509192509062005cc89084906200935a565b9091555062005b9592505050565b6000828152602c60209081526040808320918401516001830155830151600282015560608301516003820155905b82515181101562001be957825180518391908390811062005d295762005d29620098da565b6020908102919091018101518254600180820185556000948552938390208251600390920201805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0390921691909117815591810151928201929092556040820151600282019062005d9a90826200977d565b505050808062005daa90620099eb565b91505062005d04565b6000805b602d5481101562005e1b57602d818154811062005dd85762005dd8620098da565b60009182526020909120600390910201546001600160a01b039081169084160362005e065750600192915050565b8062005e1281620099eb565b91505062005db7565b50600092915050565b60008062005e3162008923565b60008060ff9050604083875160208901845afa62005e4e57600080fd5b505080516020909101516c0100000000000000000000000090910494909350915050565b60e281511462005ec55760405162461bcd60e51b815260206004820152601460248201527f496e76616c69642070726f6f66206c656e677468000000000000000000000000604482015260640162001315565b6030826102400151511462005f1d5760405162461bcd60e51b815260206004820152601c60248201527f496e76616c696420636f6e73656e737573206b6579206c656e67746800000000604482015260640162001315565b62005f288262007398565b604080518082018252601a81527f19457468657265756d205369676e6564204d6573736167653a0a00000000000060208083019190915284519251919260009262005f9e920160609190911b7fffffffffffffffffffffffffffffffffffffffff00000000000000000000000016815260140190565b604051602081830303815290604052905060008262005fbe8351620074d7565b8360405160200162005fd39392919062009a54565b60408051601f19818403018152828252805160209182012060028085526060850184529094506000939290918301908036833701905050905060008080806200602b8982620060256041600262009a08565b6200762c565b905060006200604a8a620060426041600262009a08565b60606200762c565b905060205b82518110156200611b5762006065838262007760565b6040805160008152602081018083528d905260ff8316918101919091526060810184905260808101839052929850909650945060019060a0016020604051602081039080840390855afa158015620060c1573d6000803e3d6000fd5b5050604051601f190151905087620060db604184620098c3565b81518110620060ee57620060ee620098da565b6001600160a01b0390921660209283029190910190910152620061136041826200935a565b90506200604f565b508a602001516001600160a01b031686600081518110620061405762006140620098da565b60200260200101516001600160a01b031614620061c65760405162461bcd60e51b815260206004820152602960248201527f496e76616c6964206e6f6465206b6579206f776e6572736869702070726f6f6660448201527f2070726f76696465640000000000000000000000000000000000000000000000606482015260840162001315565b8a604001516001600160a01b031686600181518110620061ea57620061ea620098da565b60200260200101516001600160a01b031614620062705760405162461bcd60e51b815260206004820152602b60248201527f496e76616c6964206f7261636c65206b6579206f776e6572736869702070726f60448201527f6f662070726f7669646564000000000000000000000000000000000000000000606482015260840162001315565b6001620062888c6102400151838e6000015162007797565b14620062fd5760405162461bcd60e51b815260206004820152603660248201527f496e76616c696420636f6e73656e737573206b6579206f776e6572736869702060448201527f70726f6f6620666f7220726567697374726174696f6e00000000000000000000606482015260840162001315565b620063088b62007806565b5050505050505050505050565b60008083116200638e5760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201527f6976650000000000000000000000000000000000000000000000000000000000606482015260840162001315565b6001600160a01b038216600090815260396020526040902054831115620063f85760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e63650000000000604482015260640162001315565b6001600160a01b038216600090815260396020526040812080548592906200642290849062009344565b9091555050604080516080810182526001600160a01b03808516825286811660208084019182528385018881524360608601908152600a8054600090815260089094529683208651815490871673ffffffffffffffffffffffffffffffffffffffff1991821617825594516001820180549190971695169490941790945551600283015591516003909101558254919290620064be83620099eb565b90915550506001600160a01b038581166000818152603a6020908152604091829020548251908516948816948514808252918101899052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d910160405180910390a3833b15620065385762006538848762007ae8565b6001600a5462006549919062009344565b9695505050505050565b6001600160a01b038084166000908152603a60205260408120805491929091848216911614806200670f57600c8201546040517f84955c880000000000000000000000000000000000000000000000000000000081526001600160a01b03868116600483015260009216906384955c8890602401602060405180830381865afa158015620065e5573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906200660b91906200971a565b905085811015620066855760405162461bcd60e51b815260206004820152602b60248201527f696e73756666696369656e7420756e6c6f636b6564204c6971756964204e657760448201527f746f6e2062616c616e6365000000000000000000000000000000000000000000606482015260840162001315565b600c8301546040517f282d3fdf0000000000000000000000000000000000000000000000000000000081526001600160a01b038781166004830152602482018990529091169063282d3fdf90604401600060405180830381600087803b158015620066ef57600080fd5b505af115801562006704573d6000803e3d6000fd5b5050505050620067b8565b8482600b0154836008015462006726919062009344565b10156200679c5760405162461bcd60e51b815260206004820152602760248201527f696e73756666696369656e742073656c6620626f6e646564206e6577746f6e2060448201527f62616c616e636500000000000000000000000000000000000000000000000000606482015260840162001315565b8482600b016000828254620067b291906200935a565b90915550505b604051806101200160405280856001600160a01b03168152602001876001600160a01b0316815260200186815260200160008152602001438152602001600081526020016000600381111562006812576200681262008a98565b815260006020808301829052841515604093840152600d548252600b815290829020835181546001600160a01b0391821673ffffffffffffffffffffffffffffffffffffffff199182161783559285015160018381018054929093169190941617905591830151600283015560608301516003808401919091556080840151600484015560a0840151600584015560c08401516006840180549193909260ff19909216918490811115620068ca57620068ca62008a98565b021790555060e08201516006909101805461010093840151151562010000027fffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ffff931515909402929092167fffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000ff90921691909117919091179055600d80549060006200695483620099eb565b9190505550836001600160a01b0316866001600160a01b03167f63f8870909f7c59c9c4932bf98dbd491647c8d2e89ca0a032aacdd943a13e2fc8388604051620069aa9291909115158252602082015260400190565b60405180910390a3833b15620069c657620069c6848762007ae8565b6001600d5462006549919062009344565b60fa60a06000808285855af4620069f2573d6000803e3d6000fd5b505050565b81546002600180831615610100020382160482518082016020811060208410016002811462006aa6576001811462006acc578660005260208404602060002001600160028402018855602085068060200390508088018589016001836101000a0392508282511684540184556001840193506020820191505b8082101562006a8f578151845560018401935060208201915062006a70565b815191036101000a90819004029091555062006b41565b60028302826020036101000a846020036101000a60208901510402018501875562006b41565b8660005260208404602060002001600160028402018855846020038088018589016001836101000a0392508282511660ff198a160184556020820191506001840193505b8082101562006b2f578151845560018401935060208201915062006b10565b815191036101000a9081900402909155505b50505050505050565b600081815260086020908152604080832060018101546001600160a01b03168452603a90925282209091601382015460ff16600381111562006b905762006b9062008a98565b14158062006bb7575081546001600160a01b03166000908152603660205260409020546001145b1562006c7757600282015482546001600160a01b03166000908152603960205260408120805490919062006bed9084906200935a565b909155505081546001830154600284015460138401546040516001600160a01b0394851694909316927f1ff2b052afa4bb37ce30d9aaccde416a700b97e632d089111749af937f8783429262006c4b92909160ff9091169062009a9d565b60405180910390a380548254620069f29185916000916001600160a01b03918216911614600162007c07565b805482546001600160a01b0390811691161462006db4576000808260080154836005015462006ca7919062009344565b90508060000362006cbf578360020154915062006ce5565b80846002015484600d015462006cd6919062009a08565b62006ce29190620098c3565b91505b600c83015484546040517f40c10f190000000000000000000000000000000000000000000000000000000081526001600160a01b039182166004820152602481018590529116906340c10f1990604401600060405180830381600087803b15801562006d5057600080fd5b505af115801562006d65573d6000803e3d6000fd5b505050508183600d01600082825462006d7f91906200935a565b9091555050600284015460058401805460009062006d9f9084906200935a565b9091555062001dcc9050858360008062007c07565b816002015481600801600082825462006dce91906200935a565b9091555050600282015460058201805460009062006dee9084906200935a565b90915550620069f2905083600060018162007c07565b6000818152600b6020908152604080832080546001600160a01b0316845260369092529091205460010362006ea6578054600182015460068301546002840154604080516201000090930460ff161515835260208301919091526001600160a01b0393841693909216917fec3ccfdbfc982a0ea188f4fd85459da1d6a7b332ddc4ca6b7a774c1abfc75866910160405180910390a362001c5782600162007da9565b60018101546001600160a01b03166000908152603a60205260408120600683015490919062010000900460ff16620070c0576002830154600c83015484546040517f7eee288d0000000000000000000000000000000000000000000000000000000081526001600160a01b03918216600482015260248101849052911690637eee288d90604401600060405180830381600087803b15801562006f4857600080fd5b505af115801562006f5d573d6000803e3d6000fd5b50505050600c83015484546040517f9dc29fac0000000000000000000000000000000000000000000000000000000081526001600160a01b03918216600482015260248101849052911690639dc29fac90604401600060405180830381600087803b15801562006fcc57600080fd5b505af115801562006fe1573d6000803e3d6000fd5b5050505060008360080154846005015462006ffd919062009344565b600d85015490915062007011828462009a08565b6200701d9190620098c3565b92508184600d01600082825462007035919062009344565b909155505060068401546000036200705457600385018390556200707c565b600684015460078501546200706a908562009a08565b620070769190620098c3565b60038601555b828460060160008282546200709291906200935a565b90915550506003850154600785018054600090620070b29084906200935a565b909155506200719592505050565b5060028201546008820154811115620070da575060088101545b8160090154600003620070f457600383018190556200711c565b6009820154600a8301546200710a908362009a08565b620071169190620098c3565b60038401555b808260090160008282546200713291906200935a565b90915550506003830154600a83018054600090620071529084906200935a565b92505081905550808260080160008282546200716f919062009344565b90915550506002830154600b830180546000906200718f90849062009344565b90915550505b6006830180547fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ff16610100179055600582018054829190600090620071dc90849062009344565b9091555062001be9905084600062007da9565b6000818152600b602052604090206006810154610100900460ff166200722d5760068101805460ff1916600217905562001c57826000600162007f35565b60068101805460ff191660011790556003810154600003620072575762001c578260008062007f35565b60018101546001600160a01b03166000908152603a60205260408120600683015490919062010000900460ff16620072f457816007015482600601548460030154620072a4919062009a08565b620072b09190620098c3565b905080826006016000828254620072c8919062009344565b90915550506003830154600783018054600090620072e890849062009344565b90915550620073599050565b81600a0154826009015484600301546200730f919062009a08565b6200731b9190620098c3565b90508082600901600082825462007333919062009344565b90915550506003830154600a830180546000906200735390849062009344565b90915550505b82546001600160a01b031660009081526039602052604081208054839290620073849084906200935a565b9091555062001be990508482600062007f35565b6000620073a9826060015162005e24565b6001600160a01b039091166020840152905080156200740b5760405162461bcd60e51b815260206004820152600b60248201527f656e6f6465206572726f72000000000000000000000000000000000000000000604482015260640162001315565b6020808301516001600160a01b039081166000908152603a90925260409091206001015416156200747f5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c7265616479207265676973746572656400000000604482015260640162001315565b6127108260800151111562001c575760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e2072617465000000000000000000604482015260640162001315565b6060816000036200751b57505060408051808201909152600181527f3000000000000000000000000000000000000000000000000000000000000000602082015290565b8160005b81156200754b57806200753281620099eb565b9150620075439050600a83620098c3565b91506200751f565b60008167ffffffffffffffff81111562007569576200756962008dc7565b6040519080825280601f01601f19166020018201604052801562007594576020820181803683370190505b5090505b84156200762457620075ac60018362009344565b9150620075bb600a8662009abc565b620075c89060306200935a565b60f81b818381518110620075e057620075e0620098da565b60200101907effffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916908160001a9053506200761c600a86620098c3565b945062007598565b949350505050565b6060816200763c81601f6200935a565b10156200768c5760405162461bcd60e51b815260206004820152600e60248201527f736c6963655f6f766572666c6f77000000000000000000000000000000000000604482015260640162001315565b6200769882846200935a565b84511015620076ea5760405162461bcd60e51b815260206004820152601160248201527f736c6963655f6f75744f66426f756e6473000000000000000000000000000000604482015260640162001315565b6060821580156200770b576040519150600082526020820160405262007757565b6040519150601f8416801560200281840101858101878315602002848b0101015b81831015620077465780518352602092830192016200772c565b5050858452601f01601f1916604052505b50949350505050565b8181018051602082015160409092015190919060001a601b81101562007790576200778d601b8262009ad3565b90505b9250925092565b6000620077a362008941565b6000858585604051602001620077bc9392919062009aef565b6040516020818303038152906040529050600060fb9050600082516020620077e591906200935a565b90506020848285855afa620077f957600080fd5b5050905195945050505050565b6101808101516001600160a01b03166200792057603e546001600160a01b03166200789a5760405162461bcd60e51b815260206004820152602260248201527f6c6971756964206c6f67696320636f6e7472616374206e6f74206465706c6f7960448201527f6564000000000000000000000000000000000000000000000000000000000000606482015260840162001315565b602554600090620078ab90620074d7565b905081602001518260000151836080015183603e60009054906101000a90046001600160a01b0316604051620078e1906200895f565b620078f195949392919062009b51565b604051809103906000f0801580156200790e573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051602580546001808201835560009283527f401968ff42a154441da5f6c4c935ac46b8671f0e062baaa62a7545ba53bb6e4c909101805473ffffffffffffffffffffffffffffffffffffffff199081166001600160a01b0395861617909155845184168352603a90955260409182902086518154871690851617815593519084018054861691841691909117905584015160028301805490941691161790915560608201518291906003820190620079df90826200977d565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c8201805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f82015561020082015160108201556102208201516011820155610240820151601282019062007ab690826200977d565b5061026082015160138201805460ff1916600183600381111562007ade5762007ade62008a98565b0217905550505050565b6001600160a01b038216600090815260346020526040812054900362007b73576001600160a01b03821660008181526034602052604081206001908190556035805491820181559091527fcfa4bec1d3298408bb5afcfcd9c430549c5b31f8aa5c5848151c0a55f473c34d01805473ffffffffffffffffffffffffffffffffffffffff191690911790555b6001600160a01b038083166000908152603260209081526040808320938516835292905290812054900362001c57576001600160a01b038083166000818152603260209081526040808320948616808452948252808320600190819055938352603382528220805493840181558252902001805473ffffffffffffffffffffffffffffffffffffffff191690911790555050565b600084815260086020526040902080546001600160a01b0316803b62007c2f57505062001be9565b6001600160a01b03811660009081526038602052604090205460045481111562007c5857506004545b60005a60018501546040517f9dfd1b8e000000000000000000000000000000000000000000000000000000008152600481018b90526001600160a01b039182166024820152604481018a905288151560648201528715156084820152919250841690639dfd1b8e90849060a401600060405180830381600088803b15801562007ce057600080fd5b5087f19350505050801562007cf3575060015b62007d1e575a62007d05908262009344565b90508462007d185762007d1888620080be565b62007d2e565b5a62007d2b908262009344565b90505b6001600160a01b03831660009081526038602052604090205481101562007d85576001600160a01b0383166000908152603860205260408120805483929062007d7990849062009344565b9091555062007d9f9050565b6001600160a01b0383166000908152603860205260408120555b5050505050505050565b6000828152600b6020526040902080546001600160a01b0316803b62007dcf5750505050565b6001600160a01b03811660009081526038602052604090205460055481111562007df857506005545b60005a60018501546040517fa8920241000000000000000000000000000000000000000000000000000000008152600481018990526001600160a01b039182166024820152871515604482015291925084169063a8920241908490606401600060405180830381600088803b15801562007e7157600080fd5b5087f19350505050801562007e84575060015b62007eaf575a62007e96908262009344565b90508462007ea95762007ea9866200829e565b62007ebf565b5a62007ebc908262009344565b90505b6001600160a01b03831660009081526038602052604090205481101562007f16576001600160a01b0383166000908152603860205260408120805483929062007f0a90849062009344565b9091555062002db49050565b50506001600160a01b0316600090815260386020526040812055505050565b6000838152600b6020526040902080546001600160a01b0316803b62007f5c575050505050565b6001600160a01b03811660009081526038602052604090205460065481111562007f8557506006545b60005a6040517f3c54c290000000000000000000000000000000000000000000000000000000008152600481018990526024810188905286151560448201529091506001600160a01b03841690633c54c290908490606401600060405180830381600088803b15801562007ff857600080fd5b5087f1935050505080156200800b575060015b62008037575a6200801d908262009344565b90508462008031576200803187876200852a565b62008047565b5a62008044908262009344565b90505b6001600160a01b0383166000908152603860205260409020548110156200809e576001600160a01b038316600090815260386020526040812080548392906200809290849062009344565b9091555062006b419050565b50506001600160a01b031660009081526038602052604081205550505050565b600081815260086020908152604080832060018101546001600160a01b039081168552603a9093529220805483549192918216911614620081dc5760008160080154826005015462008111919062009344565b836002015483600d015462008127919062009a08565b620081339190620098c3565b600c83015484546040517f9dc29fac0000000000000000000000000000000000000000000000000000000081526001600160a01b039182166004820152602481018490529293501690639dc29fac90604401600060405180830381600087803b158015620081a057600080fd5b505af1158015620081b5573d6000803e3d6000fd5b505050508082600d016000828254620081cf919062009344565b90915550620081fc915050565b8160020154816008016000828254620081f6919062009344565b90915550505b600282015482546001600160a01b0316600090815260396020526040812080549091906200822c9084906200935a565b909155505060028201546005820180546000906200824c90849062009344565b90915550508154600183015460028401546040519081526001600160a01b0392831692909116907f2d2530c45d577d38d6fc598e41ade6fb0bfbde20d09244c88348d3a2797a9d909060200162004eb7565b6000818152600b6020908152604080832060018101546001600160a01b03168452603a909252822060068201549192909162010000900460ff16620083ed576002830154600c83015484546040517f40c10f190000000000000000000000000000000000000000000000000000000081526001600160a01b039182166004820152602481018490529116906340c10f1990604401600060405180830381600087803b1580156200834d57600080fd5b505af115801562008362573d6000803e3d6000fd5b505050508083600d0160008282546200837c91906200935a565b90915550506007830154600684015460038601546200839c919062009a08565b620083a89190620098c3565b915081836006016000828254620083c0919062009344565b90915550506003840154600784018054600090620083e090849062009344565b909155506200846f915050565b81600a01548260090154846003015462008408919062009a08565b620084149190620098c3565b9050808260090160008282546200842c919062009344565b90915550506003830154600a830180546000906200844c90849062009344565b92505081905550808260080160008282546200846991906200935a565b90915550505b6000600384018190556006840180547fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ff169055600583018054839290620084b89084906200935a565b90915550508254600184015460068501546002860154604080516201000090930460ff161515835260208301919091526001600160a01b0393841693909216917f52c1b482483796c697edd43958bc63e332069083ea38782d960693145db2cf0c91015b60405180910390a350505050565b6000828152600b6020526040812090829003620085ae57600681018054600360ff19909116179081905581546001830154604080516201000090940460ff1615158452602084018690526001600160a01b039283169392909116917f0a4bfc4c4e35975ab1fe5fd21d963e8bb8d483f04aebdbc0a059b246bec3f5fc910162004eb7565b60018101546001600160a01b03166000908152603a60205260409020600682015462010000900460ff16620086d75760008082600801548360050154620085f6919062009344565b9050806000036200860a578491506200862c565b808584600d01546200861d919062009a08565b620086299190620098c3565b91505b600c83015484546040517f40c10f190000000000000000000000000000000000000000000000000000000081526001600160a01b039182166004820152602481018590529116906340c10f1990604401600060405180830381600087803b1580156200869757600080fd5b505af1158015620086ac573d6000803e3d6000fd5b505050508183600d016000828254620086c691906200935a565b9091555050506005830155620086fc565b82826005018190555082816008016000828254620086f691906200935a565b90915550505b60068201805460ff1916600317905581546001600160a01b0316600090815260396020526040812080548592906200873690849062009344565b92505081905550828160050160008282546200875391906200935a565b9091555050815460018301546006840154604080516201000090920460ff1615158252602082018790526001600160a01b0393841693909216917f0a4bfc4c4e35975ab1fe5fd21d963e8bb8d483f04aebdbc0a059b246bec3f5fc91016200851c565b60405180610280016040528060006001600160a01b0316815260200160006001600160a01b0316815260200160006001600160a01b0316815260200160608152602001600081526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b031681526020016000815260200160008152602001600081526020016000815260200160008152602001606081526020016000600381111562008881576200888162008a98565b905290565b6040518060a001604052806005906020820280368337509192915050565b5080546000825590600052602060002090810190620013c491906200896d565b508054620088d290620092c0565b6000825580601f10620088e3575050565b601f016020900490600052602060002090810190620013c4919062008992565b5080546000825590600052602060002090810190620013c4919062008992565b60405180604001604052806002906020820280368337509192915050565b60405180602001604052806001906020820280368337509192915050565b6106108062009b8583390190565b808211156200898e576000620089848282620088c4565b506001016200896d565b5090565b5b808211156200898e576000815560010162008993565b60005b83811015620089c6578181015183820152602001620089ac565b50506000910152565b60008151808452620089e9816020860160208601620089a9565b601f01601f19169290920160200192915050565b60208152600062008a126020830184620089cf565b9392505050565b6001600160a01b0381168114620013c457600080fd5b6000806040838503121562008a4357600080fd5b823562008a508162008a19565b946020939093013593505050565b60006020828403121562008a7157600080fd5b813562008a128162008a19565b60006020828403121562008a9157600080fd5b5035919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b60048110620013c4577f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b62008b0a8162008ac7565b9052565b6020815262008b296020820183516001600160a01b03169052565b6000602083015162008b4660408401826001600160a01b03169052565b5060408301516001600160a01b038116606084015250606083015161028080608085015262008b7a6102a0850183620089cf565b9150608085015160a085015260a085015160c085015260c085015160e085015260e08501516101008181870152808701519150506101208181870152808701519150506101408181870152808701519150506101608181870152808701519150506101808181870152808701519150506101a062008c02818701836001600160a01b03169052565b8601516101c0868101919091528601516101e080870191909152860151610200808701919091528601516102208087019190915286015161024080870191909152860151858403601f19016102608088019190915290915062008c668483620089cf565b93508087015191505062008c7d8286018262008aff565b5090949350505050565b60008060006060848603121562008c9d57600080fd5b833562008caa8162008a19565b9250602084013562008cbc8162008a19565b929592945050506040919091013590565b60006020828403121562008ce057600080fd5b813567ffffffffffffffff81111562008cf857600080fd5b8201610280818503121562008a1257600080fd5b600081518084526020808501808196508360051b8101915082860160005b8581101562008d81578284038952815180516001600160a01b03168552858101518686015260409081015160609186018290529062008d6c81870183620089cf565b9a87019a955050509084019060010162008d2a565b5091979650505050505050565b8515158152841515602082015260a06040820152600062008db360a083018662008d0c565b606083019490945250608001529392505050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b600082601f83011262008e0857600080fd5b813567ffffffffffffffff8082111562008e265762008e2662008dc7565b604051601f8301601f19908116603f0116810190828211818310171562008e515762008e5162008dc7565b8160405283815286602085880101111562008e6b57600080fd5b836020870160208301376000602085830101528094505050505092915050565b6000806040838503121562008e9f57600080fd5b823562008eac8162008a19565b9150602083013567ffffffffffffffff81111562008ec957600080fd5b62008ed78582860162008df6565b9150509250929050565b600061026082019050855182526020860151602083015260408601516040830152606086015160608301526080860151608083015260a08601516001600160a01b0380821660a08501528087511660c08501528060208801511660e0850152806040880151166101008501525050606085015162008f6b6101208401826001600160a01b03169052565b5060808501516001600160a01b0390811661014084015260a0860151811661016084015260c0860151811661018084015260e09095015185166101a083015283519094166101c082015260208301516101e0820152604083015161020082015260609092015161022083015261024090910152919050565b6000806000806080858703121562008ffa57600080fd5b843567ffffffffffffffff808211156200901357600080fd5b620090218883890162008df6565b955060208701359150620090358262008a19565b909350604086013590808211156200904c57600080fd5b6200905a8883890162008df6565b935060608701359150808211156200907157600080fd5b50620090808782880162008df6565b91505092959194509250565b6000602080830181845280855180835260408601915060408160051b870101925083870160005b8281101562009103577fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffc0888603018452620090f0858351620089cf565b94509285019290850190600101620090b3565b5092979650505050505050565b602081016200911f8362008ac7565b91905290565b6080815260006200913a608083018762008d0c565b6020830195909552506040810192909252606090910152919050565b60208152600062008a12602083018462008d0c565b600081518084526020808501945080840160005b83811015620091a65781516001600160a01b0316875295820195908201906001016200917f565b509495945050505050565b604081526000620091c660408301856200916b565b8281036020840152620091da81856200916b565b95945050505050565b60008060408385031215620091f757600080fd5b823567ffffffffffffffff808211156200921057600080fd5b6200921e8683870162008df6565b935060208501359150808211156200923557600080fd5b5062008ed78582860162008df6565b604081526000620092596040830185620089cf565b8281036020840152620091da8185620089cf565b60208152600062008a1260208301846200916b565b600080604083850312156200929657600080fd5b8235620092a38162008a19565b91506020830135620092b58162008a19565b809150509250929050565b600181811c90821680620092d557607f821691505b6020821081036200930f577f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b818103818111156200129b576200129b62009315565b808201808211156200129b576200129b62009315565b600081356200129b8162008a19565b60008083357fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe1843603018112620093b557600080fd5b83018035915067ffffffffffffffff821115620093d157600080fd5b602001915036819003821315620093e757600080fd5b9250929050565b601f821115620069f257600081815260208120601f850160051c81016020861015620094175750805b601f850160051c820191505b8181101562002db45782815560010162009423565b67ffffffffffffffff83111562009453576200945362008dc7565b6200946b83620094648354620092c0565b83620093ee565b6000601f841160018114620094a25760008515620094895750838201355b600019600387901b1c1916600186901b17835562001dcc565b600083815260209020601f19861690835b82811015620094d55786850135825560209485019460019092019101620094b3565b5086821015620094f35760001960f88860031b161c19848701351681555b505060018560011b0183555050505050565b60008135600481106200129b57600080fd5b620095228262008ac7565b60ff1981541660ff831681178255505050565b6200956e620095448362009370565b825473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0391909116178255565b620095ae620095806020840162009370565b600183016001600160a01b03821673ffffffffffffffffffffffffffffffffffffffff198254161781555050565b620095ee620095c06040840162009370565b600283016001600160a01b03821673ffffffffffffffffffffffffffffffffffffffff198254161781555050565b620095fd60608301836200937f565b6200960d81836003860162009438565b50506080820135600482015560a0820135600582015560c0820135600682015560e0820135600782015561010082013560088201556101208201356009820155610140820135600a820155610160820135600b820155620096a462009676610180840162009370565b600c83016001600160a01b03821673ffffffffffffffffffffffffffffffffffffffff198254161781555050565b6101a0820135600d8201556101c0820135600e8201556101e0820135600f82015561020082013560108201556102208201356011820155620096eb6102408301836200937f565b620096fb81836012860162009438565b505062001c5762009710610260840162009505565b6013830162009517565b6000602082840312156200972d57600080fd5b5051919050565b600080604083850312156200974857600080fd5b505080516020909101519092909150565b6000602082840312156200976c57600080fd5b8151801515811462008a1257600080fd5b815167ffffffffffffffff8111156200979a576200979a62008dc7565b620097b281620097ab8454620092c0565b84620093ee565b602080601f831160018114620097ea5760008415620097d15750858301515b600019600386901b1c1916600185901b17855562002db4565b600085815260208120601f198616915b828110156200981b57888601518255948401946001909101908401620097fa565b50858210156200983a5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b60006001600160a01b0380881683528087166020840152808616604084015260a060608401526200987f60a0840186620089cf565b91508084166080840152509695505050505050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b600082620098d557620098d562009894565b500490565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052603260045260246000fd5b81810362009915575050565b620099218254620092c0565b67ffffffffffffffff8111156200993c576200993c62008dc7565b6200994d81620097ab8454620092c0565b6000601f8211600181146200998457600083156200996b5750848201545b600019600385901b1c1916600184901b17845562001dcc565b600085815260209020601f19841690600086815260209020845b83811015620099c057828601548255600195860195909101906020016200999e565b50858310156200983a5793015460001960f8600387901b161c19169092555050600190811b01905550565b6000600019820362009a015762009a0162009315565b5060010190565b80820281158282048414176200129b576200129b62009315565b6001600160a01b03831681526060602082015260006060820152608060408201526000620076246080830184620089cf565b6000845162009a68818460208901620089a9565b84519083019062009a7e818360208901620089a9565b845191019062009a93818360208801620089a9565b0195945050505050565b8281526040810162009aaf8362008ac7565b8260208301529392505050565b60008262009ace5762009ace62009894565b500690565b60ff81811683821601908111156200129b576200129b62009315565b6000845162009b03818460208901620089a9565b84519083019062009b19818360208901620089a9565b60609490941b7fffffffffffffffffffffffffffffffffffffffff000000000000000000000000169301928352505060140192915050565b60006001600160a01b038088168352808716602084015285604084015260a060608401526200987f60a0840186620089cf56fe608060405234801561001057600080fd5b5060405161061038038061061083398101604081905261002f916101af565b806001600160a01b0316632f2c3f2e6040518163ffffffff1660e01b8152600401602060405180830381865afa15801561006d573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610091919061029f565b83111561009d57600080fd5b600c80546001600160a01b038088166001600160a01b031992831617909255600d805492871692909116919091179055600e8390556040516100e39083906020016102b8565b604051602081830303815290604052600a9081610100919061036e565b508160405160200161011291906102b8565b604051602081830303815290604052600b908161012f919061036e565b5050601080546001600160a01b031916331790555061042d92505050565b6001600160a01b038116811461016257600080fd5b50565b80516101708161014d565b919050565b634e487b7160e01b600052604160045260246000fd5b60005b838110156101a657818101518382015260200161018e565b50506000910152565b600080600080600060a086880312156101c757600080fd5b85516101d28161014d565b60208701519095506101e38161014d565b6040870151606088015191955093506001600160401b038082111561020757600080fd5b818801915088601f83011261021b57600080fd5b81518181111561022d5761022d610175565b604051601f8201601f19908116603f0116810190838211818310171561025557610255610175565b816040528281528b602084870101111561026e57600080fd5b61027f83602083016020880161018b565b809650505050505061029360808701610165565b90509295509295909350565b6000602082840312156102b157600080fd5b5051919050565b644c4e544e2d60d81b8152600082516102d881600585016020870161018b565b9190910160050192915050565b600181811c908216806102f957607f821691505b60208210810361031957634e487b7160e01b600052602260045260246000fd5b50919050565b601f82111561036957600081815260208120601f850160051c810160208610156103465750805b601f850160051c820191505b8181101561036557828155600101610352565b5050505b505050565b81516001600160401b0381111561038757610387610175565b61039b8161039584546102e5565b8461031f565b602080601f8311600181146103d057600084156103b85750858301515b600019600386901b1c1916600185901b178555610365565b600085815260208120601f198616915b828110156103ff578886015182559484019460019091019084016103e0565b508582101561041d5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b6101d48061043c6000396000f3fe60806040523661001b57610019610014610022565b61013e565b005b6100196100145b600080601060009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff166396f18a326040518163ffffffff1660e01b8152600401602060405180830381865afa158015610092573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906100b69190610161565b905073ffffffffffffffffffffffffffffffffffffffff8116610139576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152601d60248201527f6c6971756964206c6f67696320636f6e7472616374206e6f7420736574000000604482015260640160405180910390fd5b919050565b3660008037600080366000845af43d6000803e8061015b573d6000fd5b503d6000f35b60006020828403121561017357600080fd5b815173ffffffffffffffffffffffffffffffffffffffff8116811461019757600080fd5b939250505056fea26469706673582212200db76088bf5d8acdca170dade31d69898e8f986dad784e43d3aefe7fdd43feff64736f6c63430008150033a2646970667358221220b8b074af72cd4d64fef7eb11cdba7edf61289ffad129d3b685e599c4a91bfb1d64736f6c63430008150033608060405234801561001057600080fd5b50601080546001600160a01b03191633179055611cc4806100326000396000f3fe6080604052600436106101a55760003560e01c80633e4eb36c116100e1578063949813b81161008a578063a0ce552d11610064578063a0ce552d1461054b578063a9059cbb1461055e578063bd96102f1461057e578063dd62ed3e14610593576101ac565b8063949813b8146104e157806395d89b41146105165780639dc29fac1461052b576101ac565b806370a08231116100bb57806370a082311461045e5780637eee288d146104a157806384955c88146104c1576101ac565b80633e4eb36c146103e657806340c10f19146103fb578063593557361461041b576101ac565b80631eeffad01161014e5780632f2c3f2e116101285780632f2c3f2e14610374578063313ce5671461038a578063372500ab146103a65780633b19e84a146103bb576101ac565b80631eeffad01461031f57806323b872dd14610334578063282d3fdf14610354576101ac565b806318160ddd1161017f57806318160ddd146102c6578063187cf4d7146102e557806319fac8fd146102fd576101ac565b806306fdde031461021f578063095ea7b31461024a5780631195e07e1461027a576101ac565b366101ac57005b60405162461bcd60e51b815260206004820152602860248201527f66616c6c6261636b206e6f7420696d706c656d656e74656420666f72204c697160448201527f7569644c6f67696300000000000000000000000000000000000000000000000060648201526084015b60405180910390fd5b34801561022b57600080fd5b506102346105e6565b6040516102419190611a10565b60405180910390f35b34801561025657600080fd5b5061026a610265366004611aa5565b610678565b6040519015158152602001610241565b34801561028657600080fd5b50600c5473ffffffffffffffffffffffffffffffffffffffff165b60405173ffffffffffffffffffffffffffffffffffffffff9091168152602001610241565b3480156102d257600080fd5b506003545b604051908152602001610241565b3480156102f157600080fd5b506102d7633b9aca0081565b34801561030957600080fd5b5061031d610318366004611acf565b61068f565b005b34801561032b57600080fd5b50600f546102d7565b34801561034057600080fd5b5061026a61034f366004611ae8565b610721565b34801561036057600080fd5b5061031d61036f366004611aa5565b610864565b34801561038057600080fd5b506102d761271081565b34801561039657600080fd5b5060405160128152602001610241565b3480156103b257600080fd5b5061031d6109de565b3480156103c757600080fd5b50600d5473ffffffffffffffffffffffffffffffffffffffff166102a1565b3480156103f257600080fd5b50600e546102d7565b34801561040757600080fd5b5061031d610416366004611aa5565b610c08565b34801561042757600080fd5b506102d7610436366004611b24565b73ffffffffffffffffffffffffffffffffffffffff1660009081526001602052604090205490565b34801561046a57600080fd5b506102d7610479366004611b24565b73ffffffffffffffffffffffffffffffffffffffff1660009081526020819052604090205490565b3480156104ad57600080fd5b5061031d6104bc366004611aa5565b610cf2565b3480156104cd57600080fd5b506102d76104dc366004611b24565b610e4f565b3480156104ed57600080fd5b506105016104fc366004611b24565b610e89565b60408051928352602083019190915201610241565b34801561052257600080fd5b50610234610f75565b34801561053757600080fd5b5061031d610546366004611aa5565b610f84565b610501610559366004611acf565b611066565b34801561056a57600080fd5b5061026a610579366004611aa5565b6113f0565b34801561058a57600080fd5b5061031d61145c565b34801561059f57600080fd5b506102d76105ae366004611b3f565b73ffffffffffffffffffffffffffffffffffffffff918216600090815260026020908152604080832093909416825291909152205490565b6060600a80546105f590611b72565b80601f016020809104026020016040519081016040528092919081815260200182805461062190611b72565b801561066e5780601f106106435761010080835404028352916020019161066e565b820191906000526020600020905b81548152906001019060200180831161065157829003601f168201915b5050505050905090565b60006106853384846115a3565b5060015b92915050565b60105473ffffffffffffffffffffffffffffffffffffffff16331461071c5760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610216565b600e55565b73ffffffffffffffffffffffffffffffffffffffff83166000908152600260209081526040808320338452909152812054828110156107c85760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e742065786365656473206160448201527f6c6c6f77616e63650000000000000000000000000000000000000000000000006064820152608401610216565b6107dc85336107d78685611bf4565b6115a3565b6107e68584611722565b6107f0848461183d565b8373ffffffffffffffffffffffffffffffffffffffff168573ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8560405161084f91815260200190565b60405180910390a360019150505b9392505050565b60105473ffffffffffffffffffffffffffffffffffffffff1633146108f15760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610216565b73ffffffffffffffffffffffffffffffffffffffff82166000908152600160209081526040808320549183905290912054829161092d91611bf4565b10156109a05760405162461bcd60e51b8152602060048201526024808201527f63616e2774206c6f636b206d6f72652066756e6473207468616e20617661696c60448201527f61626c65000000000000000000000000000000000000000000000000000000006064820152608401610216565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260016020526040812080548392906109d5908490611c07565b90915550505050565b6000806109ea33611896565b3360009081526004602090815260408083208390556007909152812081905591935091508115610afe576010546040517fa9059cbb0000000000000000000000000000000000000000000000000000000081523360048201526024810184905273ffffffffffffffffffffffffffffffffffffffff9091169063a9059cbb906044016020604051808303816000875af1158015610a8b573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610aaf9190611c1a565b905080610afe5760405162461bcd60e51b815260206004820152601260248201527f4661696c656420746f2073656e64204e544e00000000000000000000000000006044820152606401610216565b333b15610b6b573373ffffffffffffffffffffffffffffffffffffffff1663161605e3846040518263ffffffff1660e01b81526004016000604051808303818588803b158015610b4d57600080fd5b505af1158015610b61573d6000803e3d6000fd5b5050505050505050565b60405133908490600081818185875af1925050503d8060008114610bab576040519150601f19603f3d011682016040523d82523d6000602084013e610bb0565b606091505b50508091505080610c035760405162461bcd60e51b815260206004820152601260248201527f4661696c656420746f2073656e642041544e00000000000000000000000000006044820152606401610216565b505050565b60105473ffffffffffffffffffffffffffffffffffffffff163314610c955760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610216565b610c9f828261183d565b60405181815273ffffffffffffffffffffffffffffffffffffffff8316906000907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef906020015b60405180910390a35050565b60105473ffffffffffffffffffffffffffffffffffffffff163314610d7f5760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610216565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260016020526040902054811115610e1a5760405162461bcd60e51b815260206004820152602360248201527f63616e277420756e6c6f636b206d6f72652066756e6473207468616e206c6f6360448201527f6b656400000000000000000000000000000000000000000000000000000000006064820152608401610216565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260016020526040812080548392906109d5908490611bf4565b73ffffffffffffffffffffffffffffffffffffffff8116600090815260016020908152604080832054918390528220546106899190611bf4565b73ffffffffffffffffffffffffffffffffffffffff81166000908152602081815260408083205460065460059093529083205483928391610ecb9184916119d2565b73ffffffffffffffffffffffffffffffffffffffff8616600090815260046020526040902054909150610eff908290611c07565b60095473ffffffffffffffffffffffffffffffffffffffff871660009081526008602052604081205492965091610f379185916119d2565b73ffffffffffffffffffffffffffffffffffffffff8716600090815260076020526040902054909150610f6b908290611c07565b9350505050915091565b6060600b80546105f590611b72565b60105473ffffffffffffffffffffffffffffffffffffffff1633146110115760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610216565b61101b8282611722565b60405181815260009073ffffffffffffffffffffffffffffffffffffffff8416907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef90602001610ce6565b601054600090819073ffffffffffffffffffffffffffffffffffffffff1633146110f85760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610216565b600e5434906000906127109061110e9084611c3c565b6111189190611c53565b90508181111561116a5760405162461bcd60e51b815260206004820152601c60248201527f696e76616c69642061746e2076616c696461746f7220726577617264000000006044820152606401610216565b6111748183611bf4565b600d5460405191935060009173ffffffffffffffffffffffffffffffffffffffff909116906108fc90849084818181858888f193505050503d80600081146111d8576040519150601f19603f3d011682016040523d82523d6000602084013e6111dd565b606091505b50909150508015156000036112045781600f60008282546111fe9190611c07565b90915550505b6000612710600e54886112179190611c3c565b6112219190611c53565b9050868111156112735760405162461bcd60e51b815260206004820152601c60248201527f696e76616c6964206e746e2076616c696461746f7220726577617264000000006044820152606401610216565b61127d8188611bf4565b9650801561132657601054600d546040517fa9059cbb00000000000000000000000000000000000000000000000000000000815273ffffffffffffffffffffffffffffffffffffffff91821660048201526024810184905291169063a9059cbb906044016020604051808303816000875af1158015611300573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906113249190611c1a565b505b60035460009061133a633b9aca0087611c3c565b6113449190611c53565b9050806006546113549190611c07565b60065560035460009061136b633b9aca008b611c3c565b6113759190611c53565b9050806009546113859190611c07565b600955600354600090633b9aca009061139e9085611c3c565b6113a89190611c53565b90506000633b9aca00600354846113bf9190611c3c565b6113c99190611c53565b90506113d58288611c07565b6113df8287611c07565b995099505050505050505050915091565b60006113fc3383611722565b611406838361183d565b60405182815273ffffffffffffffffffffffffffffffffffffffff84169033907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef9060200160405180910390a350600192915050565b600d5473ffffffffffffffffffffffffffffffffffffffff1633146114e95760405162461bcd60e51b815260206004820152602260248201527f6f6e6c792074726561737572792063616e20636c61696d20686973207265776160448201527f72640000000000000000000000000000000000000000000000000000000000006064820152608401610216565b600d54600f5460405160009273ffffffffffffffffffffffffffffffffffffffff1691908381818185875af1925050503d8060008114611545576040519150601f19603f3d011682016040523d82523d6000602084013e61154a565b606091505b505090508061159b5760405162461bcd60e51b815260206004820152601260248201527f6661696c656420746f2073656e642041544e00000000000000000000000000006044820152606401610216565b506000600f55565b73ffffffffffffffffffffffffffffffffffffffff831661162b5760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f2061646460448201527f72657373000000000000000000000000000000000000000000000000000000006064820152608401610216565b73ffffffffffffffffffffffffffffffffffffffff82166116b45760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f20616464726560448201527f73730000000000000000000000000000000000000000000000000000000000006064820152608401610216565b73ffffffffffffffffffffffffffffffffffffffff83811660008181526002602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b61172b82611896565b505073ffffffffffffffffffffffffffffffffffffffff8216600090815260208181526040808320546001909252909120546117679082611bf4565b8211156117b65760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e7420756e6c6f636b65642066756e647300000000006044820152606401610216565b6117c08282611bf4565b73ffffffffffffffffffffffffffffffffffffffff84166000908152602081905260409020558082036118215773ffffffffffffffffffffffffffffffffffffffff8316600090815260056020908152604080832083905560089091528120555b81600360008282546118339190611bf4565b9091555050505050565b61184682611896565b505073ffffffffffffffffffffffffffffffffffffffff82166000908152602081905260408120805483929061187d908490611c07565b9250508190555080600360008282546109d59190611c07565b73ffffffffffffffffffffffffffffffffffffffff811660009081526020818152604080832054600654600590935290832054839283916118d89184916119d2565b73ffffffffffffffffffffffffffffffffffffffff861660009081526004602052604090205490915061190c908290611c07565b73ffffffffffffffffffffffffffffffffffffffff8616600090815260046020908152604080832084905560065460058352818420556009546008909252822054929650909161195d9185916119d2565b73ffffffffffffffffffffffffffffffffffffffff8716600090815260076020526040902054909150611991908290611c07565b73ffffffffffffffffffffffffffffffffffffffff909616600090815260076020908152604080832089905560095460089092529091205550929492505050565b6000836000036119e45750600061085d565b633b9aca00846119f48486611bf4565b6119fe9190611c3c565b611a089190611c53565b949350505050565b600060208083528351808285015260005b81811015611a3d57858101830151858201604001528201611a21565b5060006040828601015260407fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe0601f8301168501019250505092915050565b803573ffffffffffffffffffffffffffffffffffffffff81168114611aa057600080fd5b919050565b60008060408385031215611ab857600080fd5b611ac183611a7c565b946020939093013593505050565b600060208284031215611ae157600080fd5b5035919050565b600080600060608486031215611afd57600080fd5b611b0684611a7c565b9250611b1460208501611a7c565b9150604084013590509250925092565b600060208284031215611b3657600080fd5b61085d82611a7c565b60008060408385031215611b5257600080fd5b611b5b83611a7c565b9150611b6960208401611a7c565b90509250929050565b600181811c90821680611b8657607f821691505b602082108103611bbf577f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b8181038181111561068957610689611bc5565b8082018082111561068957610689611bc5565b600060208284031215611c2c57600080fd5b8151801515811461085d57600080fd5b808202811582820484141761068957610689611bc5565b600082611c89577f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b50049056fea264697066735822122048a7cbfe3833669d997bb4362e8661ceaea3c483f62fac7ae471e39149e5682964736f6c63430008150033608060405234801561001057600080fd5b5060405161061038038061061083398101604081905261002f916101af565b806001600160a01b0316632f2c3f2e6040518163ffffffff1660e01b8152600401602060405180830381865afa15801561006d573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610091919061029f565b83111561009d57600080fd5b600c80546001600160a01b038088166001600160a01b031992831617909255600d805492871692909116919091179055600e8390556040516100e39083906020016102b8565b604051602081830303815290604052600a9081610100919061036e565b508160405160200161011291906102b8565b604051602081830303815290604052600b908161012f919061036e565b5050601080546001600160a01b031916331790555061042d92505050565b6001600160a01b038116811461016257600080fd5b50565b80516101708161014d565b919050565b634e487b7160e01b600052604160045260246000fd5b60005b838110156101a657818101518382015260200161018e565b50506000910152565b600080600080600060a086880312156101c757600080fd5b85516101d28161014d565b60208701519095506101e38161014d565b6040870151606088015191955093506001600160401b038082111561020757600080fd5b818801915088601f83011261021b57600080fd5b81518181111561022d5761022d610175565b604051601f8201601f19908116603f0116810190838211818310171561025557610255610175565b816040528281528b602084870101111561026e57600080fd5b61027f83602083016020880161018b565b809650505050505061029360808701610165565b90509295509295909350565b6000602082840312156102b157600080fd5b5051919050565b644c4e544e2d60d81b8152600082516102d881600585016020870161018b565b9190910160050192915050565b600181811c908216806102f957607f821691505b60208210810361031957634e487b7160e01b600052602260045260246000fd5b50919050565b601f82111561036957600081815260208120601f850160051c810160208610156103465750805b601f850160051c820191505b8181101561036557828155600101610352565b5050505b505050565b81516001600160401b0381111561038757610387610175565b61039b8161039584546102e5565b8461031f565b602080601f8311600181146103d057600084156103b85750858301515b600019600386901b1c1916600185901b178555610365565b600085815260208120601f198616915b828110156103ff578886015182559484019460019091019084016103e0565b508582101561041d5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b6101d48061043c6000396000f3fe60806040523661001b57610019610014610022565b61013e565b005b6100196100145b600080601060009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff166396f18a326040518163ffffffff1660e01b8152600401602060405180830381865afa158015610092573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906100b69190610161565b905073ffffffffffffffffffffffffffffffffffffffff8116610139576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152601d60248201527f6c6971756964206c6f67696320636f6e7472616374206e6f7420736574000000604482015260640160405180910390fd5b919050565b3660008037600080366000845af43d6000803e8061015b573d6000fd5b503d6000f35b60006020828403121561017357600080fd5b815173ffffffffffffffffffffffffffffffffffffffff8116811461019757600080fd5b939250505056fea26469706673582212200db76088bf5d8acdca170dade31d69898e8f986dad784e43d3aefe7fdd43feff64736f6c63430008150033")
+var AutonityUpgradeTestBytecode = common.Hex2Bytes("608060405261c35060045561c35060055561c350600655614e2060075560006010556000601155633b9aca006037553480156200003b57600080fd5b50604080516000808252602082019092529062000071565b6200005d62001046565b815260200190600190039081620000535790505b506040805161014081018252601254608080830191825260135460a08085019190915260145460c08086019190915260155460e080870191909152601654610100808801919091526017546001600160a01b0390811661012089015295875287519081018852601854861681526019548616602082810191909152601a548716828a0152601b548716606080840191909152601c54881683880152601d54881695830195909552601e54871693820193909352601f5486169181019190915281860152855192830186528054909316825260215492820192909252602254818501526023548183015292820192909252602454918101829052906000036200019157603d80546001600160a01b03191633179055620001918282620001ae565b5050602454600103620001a857620001a862000667565b62001471565b80518051601255602080820151601355604080830151601455606080840151601555608080850151601681905560a095860151601780546001600160a01b03199081166001600160a01b03938416179091558689015180516018805484169185169190911790558088015160198054841691851691909117905580870151601a8054841691851691909117905580860151601b8054841691851691909117905593840151601c8054831691841691909117905596830151601d8054891691831691909117905560c0830151601e8054891691831691909117905560e090920151601f805488169184169190911790558387015180518654909716969092169590951784559283015160218190558383015160225592810151602355840151602455602891909155603c9190915551620002e79062001116565b604051809103906000f08015801562000304573d6000803e3d6000fd5b50603e80546001600160a01b0319166001600160a01b039290921691909117905560005b825181101562000662576000838281518110620003495762000349620011c3565b602002602001015160a001519050604e8483815181106200036e576200036e620011c3565b6020908102919091018101516102600151825460018101845560009384529282902091830490910180549192909160ff601f9092166101000a918202191690836003811115620003c257620003c2620011d9565b02179055506000848381518110620003de57620003de620011c3565b60200260200101516101a00181815250506000848381518110620004065762000406620011c3565b602002602001015161018001906001600160a01b031690816001600160a01b0316815250506000848381518110620004425762000442620011c3565b602002602001015160a00181815250506000848381518110620004695762000469620011c3565b60209081029190910101516101c001526014548451859084908110620004935762000493620011c3565b602002602001015160800181815250506000848381518110620004ba57620004ba620011c3565b602002602001015161026001906003811115620004db57620004db620011d9565b90816003811115620004f157620004f1620011d9565b8152505060008483815181106200050c576200050c620011c3565b60200260200101516101600181815250506200054a848381518110620005365762000536620011c3565b6020026020010151620007d860201b60201c565b62000577848381518110620005635762000563620011c3565b60200260200101516200090f60201b60201c565b80156200064c578060396000868581518110620005985762000598620011c3565b6020026020010151600001516001600160a01b03166001600160a01b031681526020019081526020016000206000828254620005d5919062001205565b9250508190555080603b6000828254620005f0919062001205565b925050819055506200064a848381518110620006105762000610620011c3565b60200260200101516020015182868581518110620006325762000632620011c3565b60200260200101516000015162000bbc60201b60201c565b505b5080620006598162001221565b91505062000328565b505050565b603d546001600160a01b03163314620006d35760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201526218dbdb60ea1b60648201526084015b60405180910390fd5b6002603a60006025600181548110620006f057620006f0620011c3565b60009182526020808320909101546001600160a01b03168352820192909252604001812060050180549091906200072990849062001253565b925050819055506002603a600060256001815481106200074d576200074d620011c3565b60009182526020808320909101546001600160a01b03168352820192909252604001812060080180549091906200078690849062001253565b90915550506002602455602080546001600160a01b031660009081526039909152604081206103e89055620007be9060019062001124565b620007cc6002600062001124565b6003805460ff19169055565b6000620007ef826060015162000dd360201b60201c565b6001600160a01b039091166020840152905080156200083f5760405162461bcd60e51b815260206004820152600b60248201526a32b737b2329032b93937b960a91b6044820152606401620006ca565b6020808301516001600160a01b039081166000908152603a9092526040909120600101541615620008b35760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c72656164792072656769737465726564000000006044820152606401620006ca565b612710826080015111156200090b5760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e20726174650000000000000000006044820152606401620006ca565b5050565b6101808101516001600160a01b031662000a0e57603e546001600160a01b0316620009885760405162461bcd60e51b815260206004820152602260248201527f6c6971756964206c6f67696320636f6e7472616374206e6f74206465706c6f79604482015261195960f21b6064820152608401620006ca565b602554600090620009999062000e21565b905081602001518260000151836080015183603e60009054906101000a90046001600160a01b0316604051620009cf9062001166565b620009df9594939291906200126a565b604051809103906000f080158015620009fc573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051602580546001808201835560009283527f401968ff42a154441da5f6c4c935ac46b8671f0e062baaa62a7545ba53bb6e4c90910180546001600160a01b03199081166001600160a01b0395861617909155845184168352603a9095526040918290208651815487169085161781559351908401805486169184169190911790558401516002830180549094169116179091556060820151829190600382019062000ac0908262001378565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c820180546001600160a01b0319166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f82015561020082015160108201556102208201516011820155610240820151601282019062000b8a908262001378565b5061026082015160138201805460ff1916600183600381111562000bb25762000bb2620011d9565b0217905550505050565b600080831162000c1b5760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201526269766560e81b6064820152608401620006ca565b6001600160a01b03821660009081526039602052604090205483111562000c855760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e636500000000006044820152606401620006ca565b6001600160a01b0382166000908152603960205260408120805485929062000caf90849062001444565b9091555050604080516080810182526001600160a01b03808516825286811660208084019182528385018881524360608601908152600a805460009081526008909452968320865181549087166001600160a01b03199182161782559451600182018054919097169516949094179094555160028301559151600390910155825491929062000d3e8362001221565b90915550506001600160a01b038581166000818152603a6020908152604091829020548251908516948816948514808252918101899052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d910160405180910390a3833b1562000db85762000db8848762000f41565b6001600a5462000dc9919062001444565b9695505050505050565b60008062000de062001174565b60008060ff9050604083875160208901845afa62000dfd57600080fd5b505080516020909101516c0100000000000000000000000090910494909350915050565b60608160000362000e495750506040805180820190915260018152600360fc1b602082015290565b8160005b811562000e79578062000e608162001221565b915062000e719050600a8362001253565b915062000e4d565b6000816001600160401b0381111562000e965762000e96620011ad565b6040519080825280601f01601f19166020018201604052801562000ec1576020820181803683370190505b5090505b841562000f395762000ed960018362001444565b915062000ee8600a866200145a565b62000ef590603062001205565b60f81b81838151811062000f0d5762000f0d620011c3565b60200101906001600160f81b031916908160001a90535062000f31600a8662001253565b945062000ec5565b949350505050565b6001600160a01b038216600090815260346020526040812054900362000fbf576001600160a01b03821660008181526034602052604081206001908190556035805491820181559091527fcfa4bec1d3298408bb5afcfcd9c430549c5b31f8aa5c5848151c0a55f473c34d0180546001600160a01b03191690911790555b6001600160a01b03808316600090815260326020908152604080832093851683529290529081205490036200090b576001600160a01b03808316600081815260326020908152604080832094861680845294825280832060019081905593835260338252822080549384018155825290200180546001600160a01b03191690911790555050565b60405180610280016040528060006001600160a01b0316815260200160006001600160a01b0316815260200160006001600160a01b0316815260200160608152602001600081526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b0316815260200160008152602001600081526020016000815260200160008152602001600081526020016060815260200160006003811115620011115762001111620011d9565b905290565b611cf6806200bf0383390190565b5080546200113290620012ea565b6000825580601f1062001143575050565b601f01602090049060005260206000209081019062001163919062001192565b50565b610610806200dbf983390190565b60405180604001604052806002906020820280368337509192915050565b5b80821115620011a9576000815560010162001193565b5090565b634e487b7160e01b600052604160045260246000fd5b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052602160045260246000fd5b634e487b7160e01b600052601160045260246000fd5b808201808211156200121b576200121b620011ef565b92915050565b600060018201620012365762001236620011ef565b5060010190565b634e487b7160e01b600052601260045260246000fd5b6000826200126557620012656200123d565b500490565b600060018060a01b03808816835260208188168185015286604085015260a06060850152855191508160a085015260005b82811015620012b95786810182015185820160c0015281016200129b565b5050600060c0828501015260c0601f19601f83011684010191505062000dc960808301846001600160a01b03169052565b600181811c90821680620012ff57607f821691505b6020821081036200132057634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156200066257600081815260208120601f850160051c810160208610156200134f5750805b601f850160051c820191505b8181101562001370578281556001016200135b565b505050505050565b81516001600160401b03811115620013945762001394620011ad565b620013ac81620013a58454620012ea565b8462001326565b602080601f831160018114620013e45760008415620013cb5750858301515b600019600386901b1c1916600185901b17855562001370565b600085815260208120601f198616915b828110156200141557888601518255948401946001909101908401620013f4565b5085821015620014345787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b818103818111156200121b576200121b620011ef565b6000826200146c576200146c6200123d565b500690565b61aa8280620014816000396000f3fe608060405260043610620005f35760003560e01c8063852c4849116200030f578063b3ecbadd11620001a3578063d372c07e11620000f3578063e7f43c68116200009b578063f03b959d1162000075578063f03b959d14620012c4578063f1d592a714620012e9578063f7866ee3146200130e57005b8063e7f43c681462001267578063e98712b21462001287578063ee7ff28d146200129f57005b8063d886f8a211620000cd578063d886f8a214620011e0578063dd62ed3e1462001205578063dfb1a4d2146200124f57005b8063d372c07e1462001181578063d5f3948814620011a6578063d861b0e814620011c857005b8063c9d97af41162000157578063cef984501162000131578063cef98450146200112c578063cf9c57191462001144578063cfd19fb9146200115c57005b8063c9d97af414620010ca578063cb696f5414620010e2578063ceaad455146200110757005b8063b66b3e791162000189578063b66b3e791462001056578063b6ef8c67146200107e578063b7ab4db514620010a357005b8063b3ecbadd146200100c578063b46e5520146200103157005b8063a515366a116200025f578063a9fd1a8f1162000213578063ae1f5fa011620001ed578063ae1f5fa01462000f9a578063affb1cf11462000fc2578063b2ea9adb1462000fe757005b8063a9fd1a8f1462000f31578063ab8f6ffe1462000f5b578063ae1da0b51462000f8257005b8063a8b2216e1162000245578063a8b2216e1462000e9f578063a9059cbb1462000ec6578063a9af10591462000eeb57005b8063a515366a1462000e71578063a5d059ca1462000e8857005b806395d89b4111620002c35780639ac31074116200029d5780639ac310741462000e1c5780639c98e4711462000e345780639dc29fac1462000e4c57005b806395d89b411462000d8d57806396b477cb1462000dd557806396f18a321462000dfa57005b806389c614b811620002f557806389c614b81462000d2b5780638bac7dad1462000d435780638d7835ea1462000d6857005b8063852c48491462000cee578063872cf0591462000d1357005b806340c10f1911620004875780636fd2c80b11620003d7578063784304b5116200038b578063819b64631162000365578063819b64631462000c7e578063833b1fce1462000c9557806384467fdb1462000cc957005b8063784304b51462000b3657806379502c551462000b5b5780637e660ac91462000c5957005b806371d1bc5911620003bd57806371d1bc591462000ad0578063731b3a031462000ae857806377e741c71462000b1157005b80636fd2c80b1462000a7f57806370a082311462000a9657005b8063573963b8116200043b5780636b5f444c11620004155780636b5f444c1462000a195780636bb9cb0e1462000a3e5780636be0487b1462000a5657005b8063573963b814620009c45780635bdedd1614620009dc5780636a929cef14620009f457005b8063496ccd9b116200046d578063496ccd9b146200094f5780634bb278f31462000974578063520fdbbc146200099f57005b806340c10f19146200091357806343645969146200093857005b806318160ddd116200054357806329070c6d11620004f7578063313ce56711620004d1578063313ce56714620008b857806335be16e014620008d6578063386a827b14620008fb57005b806329070c6d14620008555780632f2c3f2e146200086c57806330bcb81c146200088457005b80631a0cf2e511620005295780631a0cf2e514620007e257806323b872dd146200080757806325ce1bb9146200082c57005b806318160ddd14620007975780631904bb2e14620007ae57005b80630fe5010911620005a75780631250a28d11620005815780631250a28d146200075a5780631604e416146200077f578063161605e314620005fb57005b80630fe5010914620006f957806311220633146200071e578063114eaf55146200073557005b80630ae65e7a11620005d95780630ae65e7a146200068e5780630b21fb1d14620006b35780630d8e6e2c14620006d857005b806306fdde0314620005fd578063095ea7b3146200065857005b36620005fb57005b005b3480156200060a57600080fd5b5060408051808201909152600681527f4e6577746f6e000000000000000000000000000000000000000000000000000060208201525b6040516200064f91906200908e565b60405180910390f35b3480156200066557600080fd5b506200067d62000677366004620090c0565b6200132e565b60405190151581526020016200064f565b3480156200069b57600080fd5b50620005fb620006ad366004620090ef565b62001347565b348015620006c057600080fd5b50620005fb620006d23660046200910f565b6200146d565b348015620006e557600080fd5b506024545b6040519081526020016200064f565b3480156200070657600080fd5b50620005fb620007183660046200910f565b620014ce565b3480156200072b57600080fd5b50601354620006ea565b3480156200074257600080fd5b50620005fb620007543660046200910f565b6200152f565b3480156200076757600080fd5b50620005fb62000779366004620090ef565b62001590565b3480156200078c57600080fd5b50620006ea602f5481565b348015620007a457600080fd5b50603b54620006ea565b348015620007bb57600080fd5b50620007d3620007cd366004620090ef565b6200161d565b6040516200064f91906200919f565b348015620007ef57600080fd5b50620005fb62000801366004620090ef565b620018fa565b3480156200081457600080fd5b506200067d6200082636600462009318565b62001985565b3480156200083957600080fd5b506026546000908152602c6020526040902060030154620006ea565b3480156200086257600080fd5b50601254620006ea565b3480156200087957600080fd5b50620006ea61271081565b3480156200089157600080fd5b50620008a9620008a33660046200910f565b62001a9f565b6040516200064f91906200935e565b348015620008c557600080fd5b50604051601281526020016200064f565b348015620008e357600080fd5b50620005fb620008f5366004620093e5565b62001b73565b3480156200090857600080fd5b50620006ea60055481565b3480156200092057600080fd5b50620005fb62000932366004620090c0565b62001d69565b3480156200094557600080fd5b50602254620006ea565b3480156200095c57600080fd5b50620005fb6200096e366004620090ef565b62001dd5565b3480156200098157600080fd5b506200098c62001f4d565b6040516200064f959493929190620094a6565b348015620009ac57600080fd5b50620005fb620009be366004620090ef565b620028e4565b348015620009d157600080fd5b50620006ea60285481565b348015620009e957600080fd5b50620006ea60075481565b34801562000a0157600080fd5b50620005fb62000a133660046200910f565b62002b98565b34801562000a2657600080fd5b50620005fb62000a383660046200910f565b62002bf9565b34801562000a4b57600080fd5b50620006ea60375481565b34801562000a6357600080fd5b5062000a6e62002cae565b6040516200064f93929190620094df565b34801562000a8c57600080fd5b50601554620006ea565b34801562000aa357600080fd5b50620006ea62000ab5366004620090ef565b6001600160a01b031660009081526039602052604090205490565b34801562000add57600080fd5b50620006ea60065481565b34801562000af557600080fd5b506026546000908152602c6020526040902060020154620006ea565b34801562000b1e57600080fd5b50620005fb62000b303660046200910f565b62002ddf565b34801562000b4357600080fd5b50620005fb62000b553660046200964a565b62002e40565b34801562000b6857600080fd5b506040805160c080820183526012548252601354602080840191909152601454838501526015546060808501919091526016546080808601919091526017546001600160a01b0390811660a08088019190915287516101008101895260185483168152601954831681870152601a548316818a0152601b54831681860152601c54831681850152601d54831691810191909152601e54821695810195909552601f54811660e086015286519182018752835416815260215492810192909252602254948201949094526023549381019390935260245462000c47939084565b6040516200064f9493929190620096a0565b34801562000c6657600080fd5b50620005fb62000c783660046200910f565b620030b2565b34801562000c8b57600080fd5b50602354620006ea565b34801562000ca257600080fd5b506019546001600160a01b03165b6040516001600160a01b0390911681526020016200064f565b34801562000cd657600080fd5b50620005fb62000ce8366004620097a2565b62003113565b34801562000cfb57600080fd5b50620005fb62000d0d366004620090c0565b62003242565b34801562000d2057600080fd5b50620005fb62003464565b34801562000d3857600080fd5b50620006ea602a5481565b34801562000d5057600080fd5b50620005fb62000d623660046200910f565b620034cf565b34801562000d7557600080fd5b50620005fb62000d873660046200985a565b62003582565b34801562000d9a57600080fd5b5060408051808201909152600381527f4e544e0000000000000000000000000000000000000000000000000000000000602082015262000640565b34801562000de257600080fd5b50620006ea62000df43660046200910f565b6200378e565b34801562000e0757600080fd5b50603e5462000cb0906001600160a01b031681565b34801562000e2957600080fd5b50620006ea603c5481565b34801562000e4157600080fd5b50620006ea602b5481565b34801562000e5957600080fd5b50620005fb62000e6b366004620090c0565b6200383d565b620006ea62000e82366004620090c0565b62003990565b620006ea62000e99366004620090c0565b62003adc565b34801562000eac57600080fd5b5062000eb762003bec565b6040516200064f9190620098ea565b34801562000ed357600080fd5b506200067d62000ee5366004620090c0565b62003ccf565b34801562000ef857600080fd5b5062000f2262000f0a3660046200910f565b6000908152600b602052604090206006015460ff1690565b6040516200064f91906200996e565b34801562000f3e57600080fd5b5062000f4962003d27565b6040516200064f949392919062009983565b34801562000f6857600080fd5b5062000f7362003ea4565b6040516200064f9190620099b4565b34801562000f8f57600080fd5b50620006ea60295481565b34801562000fa757600080fd5b5062000fb262003fb6565b6040516200064f92919062009a0f565b34801562000fcf57600080fd5b5062000f4962000fe13660046200910f565b620042e2565b34801562000ff457600080fd5b50620005fb6200100636600462009a41565b62004503565b3480156200101957600080fd5b50620005fb6200102b366004620090ef565b62004579565b3480156200103e57600080fd5b50620005fb62001050366004620090ef565b62004604565b3480156200106357600080fd5b506200106e620048d9565b6040516200064f92919062009aa2565b3480156200108b57600080fd5b50620006ea6200109d3660046200910f565b62004a10565b348015620010b057600080fd5b50620010bb62004aa2565b6040516200064f919062009acb565b348015620010d757600080fd5b50620006ea60265481565b348015620010ef57600080fd5b50620005fb620011013660046200910f565b62004b06565b3480156200111457600080fd5b50620005fb62001126366004620090ef565b62004b98565b3480156200113957600080fd5b50620006ea60045481565b3480156200115157600080fd5b50620005fb62004c23565b3480156200116957600080fd5b50620005fb6200117b366004620090ef565b62004ca7565b3480156200118e57600080fd5b50620005fb620011a0366004620090ef565b62004d32565b348015620011b357600080fd5b50603d5462000cb0906001600160a01b031681565b348015620011d557600080fd5b50620005fb62004dbd565b348015620011ed57600080fd5b50620005fb620011ff366004620090ef565b62005092565b3480156200121257600080fd5b50620006ea6200122436600462009ae0565b6001600160a01b03918216600090815260316020908152604080832093909416825291909152205490565b3480156200125c57600080fd5b50620006ea6200511d565b3480156200127457600080fd5b506020546001600160a01b031662000cb0565b3480156200129457600080fd5b50620006ea602e5481565b348015620012ac57600080fd5b50620005fb620012be3660046200910f565b6200513a565b348015620012d157600080fd5b50620005fb620012e3366004620090ef565b6200519b565b348015620012f657600080fd5b50620005fb62001308366004620090ef565b62005226565b3480156200131b57600080fd5b506017546001600160a01b031662000cb0565b60006200133d3384846200532f565b5060015b92915050565b620013516200548c565b6001600160a01b038082166000818152603a602052604090206001015490911614620013c45760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d75737420626520726567697374657265640000000060448201526064015b60405180910390fd5b6001600160a01b038181166000908152603a6020526040902054163314620014555760405162461bcd60e51b815260206004820152602c60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72206160448201527f646d696e206163636f756e7400000000000000000000000000000000000000006064820152608401620013bb565b6200146081620054e5565b6200146a60008055565b50565b6020546001600160a01b03163314620014c95760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b600555565b6020546001600160a01b031633146200152a5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b600455565b6020546001600160a01b031633146200158b5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b601555565b6020546001600160a01b03163314620015ec5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b6018805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b565b6200162762008dae565b6001600160a01b038083166000818152603a602052604090206001015490911614620016965760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f74207265676973746572656400000000000000006044820152606401620013bb565b6001600160a01b038083166000908152603a60209081526040918290208251610280810184528154851681526001820154851692810192909252600281015490931691810191909152600382018054919291606084019190620016f99062009b1e565b80601f0160208091040260200160405190810160405280929190818152602001828054620017279062009b1e565b8015620017785780601f106200174c5761010080835404028352916020019162001778565b820191906000526020600020905b8154815290600101906020018083116200175a57829003601f168201915b505050918352505060048201546020820152600582015460408201526006820154606082015260078201546080820152600882015460a0820152600982015460c0820152600a82015460e0820152600b820154610100820152600c8201546001600160a01b0316610120820152600d820154610140820152600e820154610160820152600f82015461018082015260108201546101a082015260118201546101c08201526012820180546101e090920191620018349062009b1e565b80601f0160208091040260200160405190810160405280929190818152602001828054620018629062009b1e565b8015620018b35780601f106200188757610100808354040283529160200191620018b3565b820191906000526020600020905b8154815290600101906020018083116200189557829003601f168201915b5050509183525050601382015460209091019060ff166003811115620018dd57620018dd62009129565b6003811115620018f157620018f162009129565b90525092915050565b6020546001600160a01b03163314620019565760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b601f805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6001600160a01b03831660009081526031602090815260408083203384529091528120548281101562001a215760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e742065786365656473206160448201527f6c6c6f77616e63650000000000000000000000000000000000000000000000006064820152608401620013bb565b62001a2e858585620055d5565b62001a46853362001a40868562009ba2565b6200532f565b836001600160a01b0316856001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8560405162001a8c91815260200190565b60405180910390a3506001949350505050565b62001aa962008e7e565b6000828152600b602090815260409182902082516101208101845281546001600160a01b03908116825260018301541692810192909252600281015492820192909252600380830154606083015260048301546080830152600583015460a0830152600683015491929160c084019160ff9091169081111562001b305762001b3062009129565b600381111562001b445762001b4462009129565b81526006919091015460ff61010082048116151560208401526201000090910416151560409091015292915050565b6018546001600160a01b0316331462001bf55760405162461bcd60e51b815260206004820152602360248201527f63616c6c6572206973206e6f742074686520736c617368696e6720636f6e747260448201527f61637400000000000000000000000000000000000000000000000000000000006064820152608401620013bb565b6000610120820135603a8262001c126040860160208701620090ef565b6001600160a01b03166001600160a01b031681526020019081526020016000206009015462001c42919062009ba2565b60c0830135603a600062001c5d6040870160208801620090ef565b6001600160a01b03166001600160a01b031681526020019081526020016000206006015462001c8d919062009ba2565b60a0840135603a600062001ca86040880160208901620090ef565b6001600160a01b03166001600160a01b031681526020019081526020016000206005015462001cd8919062009ba2565b62001ce4919062009bb8565b62001cf0919062009bb8565b6017546001600160a01b031660009081526039602052604081208054929350839290919062001d2190849062009bb8565b90915550829050603a600062001d3e6040840160208501620090ef565b6001600160a01b03168152602081019190915260400160002062001d63828262009d93565b50505050565b6020546001600160a01b0316331462001dc55760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b62001dd182826200570e565b5050565b6020546001600160a01b0316331462001e315760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b6019805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b03838116918217909255601a546040517f7adbf973000000000000000000000000000000000000000000000000000000008152600481019290925290911690637adbf97390602401600060405180830381600087803b15801562001eb657600080fd5b505af115801562001ecb573d6000803e3d6000fd5b5050601c546040517f7adbf9730000000000000000000000000000000000000000000000000000000081526001600160a01b0385811660048301529091169250637adbf97391506024015b600060405180830381600087803b15801562001f3157600080fd5b505af115801562001f46573d6000803e3d6000fd5b5050505050565b603d546000908190606090829081906001600160a01b0316331462001fdb5760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c00000000000000000000000000000000000000000000000000000000006064820152608401620013bb565b62001fe56200548c565b4360298190556026546000828152602760209081526040808320849055928252602c90528190206003015460185491517f6c9789b000000000000000000000000000000000000000000000000000000000815292101560048301819052916001600160a01b0390911690636c9789b090602401600060405180830381600087803b1580156200207357600080fd5b505af115801562002088573d6000803e3d6000fd5b5050505080156200266a57601e54603b54603c54602a546040517f92eff3cd0000000000000000000000000000000000000000000000000000000081526004810193909352602483019190915260448201524260648201526000916001600160a01b0316906392eff3cd90608401602060405180830381865afa15801562002114573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906200213a919062009f78565b905080603c5410156200214c5750603c545b6200215830826200570e565b80603c60008282546200216c919062009ba2565b9091555050601f54604080517ff968f49300000000000000000000000000000000000000000000000000000000815281516001600160a01b039093169263f968f4939260048084019391929182900301816000875af1925050508015620021f2575060408051601f3d908101601f19168201909252620021ef9181019062009f92565b60015b62002230576040514281527ff1217bb194383aa5c937c81cbbb445990a478c7511b080df329f9b0fb64233c99060200160405180910390a162002263565b60175462002248906001600160a01b0316826200570e565b601f5462002260906001600160a01b0316836200570e565b50505b306000908152603960205260409020546200228090479062005794565b6200228a62005d0c565b6200229462005fcf565b6200229e620060be565b620022a86200615d565b600080620022b562003fb6565b6019546040517f845023f20000000000000000000000000000000000000000000000000000000081529294509092506001600160a01b03169063845023f2906200230490859060040162009acb565b600060405180830381600087803b1580156200231f57600080fd5b505af115801562002334573d6000803e3d6000fd5b50506018546040517fe08b14ed0000000000000000000000000000000000000000000000000000000081526001600160a01b03909116925063e08b14ed91506200238390849060040162009acb565b600060405180830381600087803b1580156200239e57600080fd5b505af1158015620023b3573d6000803e3d6000fd5b50506026546000908152602c60205260409020600201546028546021549193501480159150620023e4575060285415155b156200246b5760285460218190556018546040517f6b5f444c00000000000000000000000000000000000000000000000000000000815260048101929092526001600160a01b031690636b5f444c90602401600060405180830381600087803b1580156200245157600080fd5b505af115801562002466573d6000803e3d6000fd5b505050505b604d5415620024b657604654603f55604754604055604854604155604954604255604a54604355604b54604455604c546045805460ff191660ff90921615159190911790556000604d555b602154600090620024c8904362009bb8565b905042602a81905550600160266000828254620024e6919062009bb8565b909155505060265460408051602d805460a0602082028401810190945260808301818152620026289594849391929060009085015b8282101562002609576000848152602090819020604080516060810182526003860290920180546001600160a01b0316835260018101549383019390935260028301805492939291840191620025719062009b1e565b80601f01602080910402602001604051908101604052809291908181526020018280546200259f9062009b1e565b8015620025f05780601f10620025c457610100808354040283529160200191620025f0565b820191906000526020600020905b815481529060010190602001808311620025d257829003601f168201915b505050505081525050815260200190600101906200251b565b505050508152602001858152602001438152602001848152506200629e565b7febad8099c467528a56c98b63c8d476d251cf1ffb4c75db94b4d23fa2b6a1e3356026546040516200265c91815260200190565b60405180910390a150505050505b601954604080517f4bb278f300000000000000000000000000000000000000000000000000000000815290516000926001600160a01b031691634bb278f3916004808301926020929190829003018187875af1158015620026cf573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190620026f5919062009fb7565b905080156200278a57601a54604080517fa2e6204500000000000000000000000000000000000000000000000000000000815290516001600160a01b039092169163a2e620459160048082019260209290919082900301816000875af192505050801562002782575060408051601f3d908101601f191682019092526200277f9181019062009fb7565b60015b156200278a57505b600380546026546000908152602c602090815260408083206001810154950154602d805483518186028101860190945280845260ff909616968996919590949293928692919084015b82821015620028c1576000848152602090819020604080516060810182526003860290920180546001600160a01b0316835260018101549383019390935260028301805492939291840191620028299062009b1e565b80601f0160208091040260200160405190810160405280929190818152602001828054620028579062009b1e565b8015620028a85780601f106200287c57610100808354040283529160200191620028a8565b820191906000526020600020905b8154815290600101906020018083116200288a57829003601f168201915b50505050508152505081526020019060010190620027d3565b505050509250965096509650965096505050620028dd60008055565b9091929394565b6020546001600160a01b03163314620029405760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b6020805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b038381169182179092556019546040517fb3ab15fb00000000000000000000000000000000000000000000000000000000815260048101929092529091169063b3ab15fb90602401600060405180830381600087803b158015620029c557600080fd5b505af1158015620029da573d6000803e3d6000fd5b5050601a546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b15801562002a3f57600080fd5b505af115801562002a54573d6000803e3d6000fd5b5050601b546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b15801562002ab957600080fd5b505af115801562002ace573d6000803e3d6000fd5b5050601c546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b15801562002b3357600080fd5b505af115801562002b48573d6000803e3d6000fd5b5050601d546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb915060240162001f16565b6020546001600160a01b0316331462002bf45760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b603755565b6020546001600160a01b0316331462002c555760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b60288190556026546000908152602c6020908152604091829020600301548251848152918201819052917f2eea6438d890c8603d4df81ad1bad2a4ea45c02b4837165f461ff3c81603abc7910160405180910390a15050565b62002cf16040518060e001604052806000815260200160008152602001600081526020016000815260200160008152602001600081526020016000151581525090565b62002d346040518060e001604052806000815260200160008152602001600081526020016000815260200160008152602001600081526020016000151581525090565b5050604d546040805160e08082018352603f54825282546020808401919091526041548385015260425460608085019190915260435460808086019190915260445460a08087019190915260455460ff908116151560c080890191909152885196870189526046548752604754958701959095526048549786019790975260495492850192909252604a5490840152604b5490830152604c5490931615159281019290925292909190565b6020546001600160a01b0316331462002e3b5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b601255565b6001600160a01b038083166000818152603a6020526040902060018101549092161462002eb05760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f74207265676973746572656400000000000000006044820152606401620013bb565b80546001600160a01b0316331462002f315760405162461bcd60e51b815260206004820152602f60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72207460448201527f72656173757279206163636f756e7400000000000000000000000000000000006064820152608401620013bb565b62002f3c836200637b565b1562002fb15760405162461bcd60e51b815260206004820152602260248201527f76616c696461746f72206d757374206e6f7420626520696e20636f6d6d69747460448201527f65650000000000000000000000000000000000000000000000000000000000006064820152608401620013bb565b60008062002fbf84620063ec565b925090508115620030135760405162461bcd60e51b815260206004820152600b60248201527f656e6f6465206572726f720000000000000000000000000000000000000000006044820152606401620013bb565b60018301546001600160a01b038281169116146200309a5760405162461bcd60e51b815260206004820152602760248201527f76616c696461746f72206e6f646520616464726573732063616e27742062652060448201527f75706461746564000000000000000000000000000000000000000000000000006064820152608401620013bb565b60038301620030aa858262009fd7565b505050505050565b6020546001600160a01b031633146200310e5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b600755565b6000604051806102800160405280336001600160a01b0316815260200160006001600160a01b03168152602001856001600160a01b0316815260200186815260200160126000016002015481526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b031681526020016000815260200143815260200160008152602001600081526020016000815260200184815260200160006003811115620031e257620031e262009129565b90529050620031f281836200643a565b60208101516101808201516040517f8ad8bd2eb6950e5f332fd3a6dca48cb358ecfe3057848902b98cbdfe455c915c926200323392339289918b916200a0a4565b60405180910390a15050505050565b6001600160a01b038083166000818152603a602052604090206001015490911614620032b15760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d7573742062652072656769737465726564000000006044820152606401620013bb565b6001600160a01b038281166000908152603a6020526040902054163314620033425760405162461bcd60e51b815260206004820152602c60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72206160448201527f646d696e206163636f756e7400000000000000000000000000000000000000006064820152608401620013bb565b612710811115620033965760405162461bcd60e51b815260206004820152601f60248201527f7265717569726520636f727265637420636f6d6d697373696f6e2072617465006044820152606401620013bb565b604080516060810182526001600160a01b038481168252436020808401918252838501868152601180546000908152600f9093529582208551815473ffffffffffffffffffffffffffffffffffffffff19169516949094178455915160018085019190915591516002909301929092558354929390929091906200341c90849062009bb8565b90915550506040518281526001600160a01b038416907f4fba51c92fa3d6ad8374d394f6cd5766857552e153d7384a8f23aa4ce9a8a7cf9060200160405180910390a2505050565b6020546001600160a01b03163314620034c05760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b6003805460ff19166001179055565b6020546001600160a01b031633146200352b5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b600081116200357d5760405162461bcd60e51b815260206004820152601960248201527f636f6d6d69747465652073697a652063616e27742062652030000000000000006044820152606401620013bb565b602355565b6020546001600160a01b03163314620035de5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b8051620035eb90620068dd565b8015620036025750620036028160400151620068dd565b8015620036195750620036198160800151620068dd565b620036675760405162461bcd60e51b815260206004820152601d60248201527f696e697469616c2074696d656f7574206f7574206f6620626f756e64730000006044820152606401620013bb565b620036768160200151620068f5565b80156200368d57506200368d8160600151620068f5565b8015620036a45750620036a48160a00151620068f5565b620036f25760405162461bcd60e51b815260206004820152601b60248201527f74696d656f75742064656c7461206f7574206f6620626f756e647300000000006044820152606401620013bb565b805160465560208082015160475560408083015160485560608301516049556080830151604a5560a0830151604b5560c0830151604c805460ff19169115159190911790556026546000908152602c9092529081902060030154604d81905590517fa4cc9b6d7e44f45ad3dfc950f52c5b7c915f5aaab5b2316be12db869407309f29162003783918491906200a0ee565b60405180910390a150565b60006029546001620037a1919062009bb8565b821115620038185760405162461bcd60e51b815260206004820152602660248201527f63616e6e6f74206765742065706f636820696420666f7220612066757475726560448201527f20626c6f636b00000000000000000000000000000000000000000000000000006064820152608401620013bb565b602954821162003835575060009081526027602052604090205490565b505060265490565b6020546001600160a01b03163314620038995760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b6001600160a01b038216600090815260396020526040902054811115620039035760405162461bcd60e51b815260206004820152601660248201527f416d6f756e7420657863656564732062616c616e6365000000000000000000006044820152606401620013bb565b6001600160a01b038216600090815260396020526040812080548392906200392d90849062009ba2565b9250508190555080603b600082825462003948919062009ba2565b90915550506040518181526001600160a01b038316907f5024dbeedf0c06664c9bd7be836915730c955e936972c020683dadf11d5488a3906020015b60405180910390a25050565b60006200399c6200548c565b6001600160a01b038084166000818152603a60205260409020600101549091161462003a0b5760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f74207265676973746572656400000000000000006044820152606401620013bb565b6001600160a01b0383166000908152603a602052604081206013015460ff16600381111562003a3e5762003a3e62009129565b1462003a8d5760405162461bcd60e51b815260206004820152601b60248201527f76616c696461746f72206e65656420746f2062652061637469766500000000006044820152606401620013bb565b60375462003a9c90346200a17b565b336000908152603860205260408120805490919062003abd90849062009bb8565b9091555062003ad090508383336200690d565b90506200134160008055565b600062003ae86200548c565b6001600160a01b038084166000818152603a60205260409020600101549091161462003b575760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f74207265676973746572656400000000000000006044820152606401620013bb565b6000821162003ba95760405162461bcd60e51b815260206004820152601560248201527f756e626f6e64696e6720616d6f756e74206973203000000000000000000000006044820152606401620013bb565b60375462003bb890346200a17b565b336000908152603860205260408120805490919062003bd990849062009bb8565b9091555062003ad0905083833362006b4b565b60606030805480602002602001604051908101604052809291908181526020016000905b8282101562003cc657838290600052602060002001805462003c329062009b1e565b80601f016020809104026020016040519081016040528092919081815260200182805462003c609062009b1e565b801562003cb15780601f1062003c855761010080835404028352916020019162003cb1565b820191906000526020600020905b81548152906001019060200180831162003c9357829003601f168201915b50505050508152602001906001019062003c10565b50505050905090565b600062003cde338484620055d5565b6040518281526001600160a01b0384169033907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef9060200160405180910390a350600192915050565b6026546000908152602c602090815260408083208151815460a094810282018501909352608081018381526060959485948594859493909284928491879085015b8282101562003e56576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162003dbe9062009b1e565b80601f016020809104026020016040519081016040528092919081815260200182805462003dec9062009b1e565b801562003e3d5780601f1062003e115761010080835404028352916020019162003e3d565b820191906000526020600020905b81548152906001019060200180831162003e1f57829003601f168201915b5050505050815250508152602001906001019062003d68565b50505090825250600182015460208083019190915260028301546040808401919091526003909301546060928301528351908401519284015193909101519098919750919550909350915050565b6060602d805480602002602001604051908101604052809291908181526020016000905b8282101562003cc6576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162003f1e9062009b1e565b80601f016020809104026020016040519081016040528092919081815260200182805462003f4c9062009b1e565b801562003f9d5780601f1062003f715761010080835404028352916020019162003f9d565b820191906000526020600020905b81548152906001019060200180831162003f7f57829003601f168201915b5050505050815250508152602001906001019062003ec8565b603d5460609081906001600160a01b031633146200403d5760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c00000000000000000000000000000000000000000000000000000000006064820152608401620013bb565b6025546200408e5760405162461bcd60e51b815260206004820152601860248201527f5468657265206d7573742062652076616c696461746f727300000000000000006044820152606401620013bb565b6200409862008ef0565b602354608082015260258152603a6020820152602d6040820152602b6060820152620040c48162006fcf565b620040d26030600062008f0e565b602d5480620041245760405162461bcd60e51b815260206004820152601260248201527f636f6d6d697474656520697320656d70747900000000000000000000000000006044820152606401620013bb565b60008167ffffffffffffffff81111562004142576200414262009586565b6040519080825280602002602001820160405280156200416c578160200160208202803683370190505b50905060008267ffffffffffffffff8111156200418d576200418d62009586565b604051908082528060200260200182016040528015620041b7578160200160208202803683370190505b50905060005b83811015620042d6576000603a6000602d8481548110620041e257620041e26200a192565b60009182526020808320600392830201546001600160a01b031684528301939093526040909101812060308054600181018255925292507f6ff97a59c90d62cc7236ba3a37cd85351bf564556780cf8c1157a220f31f0cbb01906200424a908301826200a1c1565b50600281015484516001600160a01b03909116908590849081106200427357620042736200a192565b6001600160a01b03928316602091820292909201015260018201548451911690849084908110620042a857620042a86200a192565b6001600160a01b03909216602092830291909101909101525080620042cd816200a2a3565b915050620041bd565b50909450925050509091565b606060008060006029546001620042fa919062009bb8565b851115620043715760405162461bcd60e51b815260206004820152602360248201527f63616e6e6f74206765742065706f636820666f7220612066757475726520626c60448201527f6f636b00000000000000000000000000000000000000000000000000000000006064820152608401620013bb565b60265460295486116200438f57506000858152602760205260409020545b6000818152602c602090815260408083208151815460a09481028201850190935260808101838152909391928492849190879085015b82821015620044b3576000848152602090819020604080516060810182526003860290920180546001600160a01b03168352600181015493830193909352600283018054929392918401916200441b9062009b1e565b80601f0160208091040260200160405190810160405280929190818152602001828054620044499062009b1e565b80156200449a5780601f106200446e576101008083540402835291602001916200449a565b820191906000526020600020905b8154815290600101906020018083116200447c57829003601f168201915b50505050508152505081526020019060010190620043c5565b5050509082525060018201546020808301919091526002830154604080840191909152600390930154606092830152835190840151928401519390910151909a9199509197509095509350505050565b6020546001600160a01b031633146200455f5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b6200456c60018362006fef565b62001dd160028262006fef565b6020546001600160a01b03163314620045d55760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b601b805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6200460e6200548c565b6001600160a01b038082166000818152603a6020526040902060010154909116146200467d5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d7573742062652072656769737465726564000000006044820152606401620013bb565b6001600160a01b038082166000908152603a6020526040902080549091163314620047115760405162461bcd60e51b815260206004820152602f60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72207460448201527f72656173757279206163636f756e7400000000000000000000000000000000006064820152608401620013bb565b6000601382015460ff1660038111156200472f576200472f62009129565b036200477e5760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f7220616c72656164792061637469766500000000000000006044820152606401620013bb565b6002601382015460ff1660038111156200479c576200479c62009129565b148015620047ad5750438160100154115b15620047fc5760405162461bcd60e51b815260206004820152601760248201527f76616c696461746f72207374696c6c20696e206a61696c0000000000000000006044820152606401620013bb565b6003601382015460ff1660038111156200481a576200481a62009129565b03620048695760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206a61696c6564207065726d616e656e746c79000000006044820152606401620013bb565b60138101805460ff1916905580546026546000908152602c60209081526040918290206003015491519182526001600160a01b038581169316917f60fcbf2d07dc712a93e59fb28f1edb626d7c2497c57ba71a8c0b3999ecb9a3b5910160405180910390a3506200146a60008055565b60608060016002818054620048ee9062009b1e565b80601f01602080910402602001604051908101604052809291908181526020018280546200491c9062009b1e565b80156200496d5780601f1062004941576101008083540402835291602001916200496d565b820191906000526020600020905b8154815290600101906020018083116200494f57829003601f168201915b50505050509150808054620049829062009b1e565b80601f0160208091040260200160405190810160405280929190818152602001828054620049b09062009b1e565b801562004a015780601f10620049d55761010080835404028352916020019162004a01565b820191906000526020600020905b815481529060010190602001808311620049e357829003601f168201915b50505050509050915091509091565b600060036000838152600b602052604090206006015460ff16600381111562004a3d5762004a3d62009129565b1462004a8c5760405162461bcd60e51b815260206004820152601e60248201527f756e626f6e64696e672072656c65617365206e6f7420726576657274656400006044820152606401620013bb565b506000908152600b602052604090206005015490565b6060602580548060200260200160405190810160405280929190818152602001828054801562004afc57602002820191906000526020600020905b81546001600160a01b0316815260019091019060200180831162004add575b5050505050905090565b6020546001600160a01b0316331462004b625760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b60138190556040518181527f1f4d2fc7529047a5bd96d3229bfea127fd18b7748f13586e097c69fccd3891289060200162003783565b6020546001600160a01b0316331462004bf45760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b601d805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6020546001600160a01b0316331462004c7f5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b62004c8d6001600062008f2e565b62004c9b6002600062008f2e565b6003805460ff19169055565b6020546001600160a01b0316331462004d035760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b601c805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6020546001600160a01b0316331462004d8e5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b601a805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b603d546001600160a01b0316331462004e3f5760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c00000000000000000000000000000000000000000000000000000000006064820152608401620013bb565b62004e496200548c565b62004e5362005fcf565b60005b604e5481101562004f0f57604e818154811062004e775762004e776200a192565b90600052602060002090602091828204019190069054906101000a900460ff16603a60006025848154811062004eb15762004eb16200a192565b60009182526020808320909101546001600160a01b031683528201929092526040019020601301805460ff1916600183600381111562004ef55762004ef562009129565b02179055508062004f06816200a2a3565b91505062004e56565b5062004f1e604e600062008f6d565b62004f2862003fb6565b505042602a5543602981905560009081526027602090815260408083208390556026548151602d805460a095810283018601909452608082018481526200508896939592948594919390919085015b8282101562005065576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162004fcd9062009b1e565b80601f016020809104026020016040519081016040528092919081815260200182805462004ffb9062009b1e565b80156200504c5780601f1062005020576101008083540402835291602001916200504c565b820191906000526020600020905b8154815290600101906020018083116200502e57829003601f168201915b5050505050815250508152602001906001019062004f77565b50505090825250600060208201524360408201526021546060909101526200629e565b6200161b60008055565b6020546001600160a01b03163314620050ee5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b6017805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6028546021546000911462005133575060285490565b5060215490565b6020546001600160a01b03163314620051965760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b600655565b6020546001600160a01b03163314620051f75760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b601e805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6020546001600160a01b03163314620052825760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620013bb565b6001600160a01b038116620053005760405162461bcd60e51b815260206004820152602960248201527f696e76616c696420636f6e7472616374206164647265737320666f72206c697160448201527f756964206c6f67696300000000000000000000000000000000000000000000006064820152608401620013bb565b603e805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6001600160a01b038316620053ac5760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f2061646460448201527f72657373000000000000000000000000000000000000000000000000000000006064820152608401620013bb565b6001600160a01b0382166200542a5760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f20616464726560448201527f73730000000000000000000000000000000000000000000000000000000000006064820152608401620013bb565b6001600160a01b0383811660008181526031602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92591015b60405180910390a3505050565b60005415620054de5760405162461bcd60e51b815260206004820152601360248201527f7265656e7472616e6379206465746563746564000000000000000000000000006044820152606401620013bb565b6001600055565b6001600160a01b0381166000908152603a6020526040812090601382015460ff1660038111156200551a576200551a62009129565b14620055695760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206d7573742062652061637469766500000000000000006044820152606401620013bb565b60138101805460ff1916600117905580546026546000908152602c60209081526040918290206003015491519182526001600160a01b038581169316917f75bdcdbe540758778e669d108fbcb7ede734f27f46e4e5525eeb8ecf91849a9c910160405180910390a35050565b6001600160a01b0383166000908152603960205260409020548111156200563f5760405162461bcd60e51b815260206004820152601660248201527f616d6f756e7420657863656564732062616c616e6365000000000000000000006044820152606401620013bb565b6001600160a01b038316600090815260396020526040812080548392906200566990849062009ba2565b9250508190555080603b600082825462005684919062009bb8565b909155506200569790508160026200a2c0565b6001600160a01b03831660009081526039602052604081208054909190620056c190849062009bb8565b92505081905550816001600160a01b0316836001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef836040516200547f91815260200190565b6001600160a01b038216600090815260396020526040812080548392906200573890849062009bb8565b9250508190555080603b600082825462005753919062009bb8565b90915550506040518181526001600160a01b038316907f48490b4407bb949b708ec5f514b4167f08f4969baaf78d53b05028adf369bfcf9060200162003984565b81158015620057a1575080155b15620057ab575050565b601254600090670de0b6b3a764000090620057c89085906200a2c0565b620057d491906200a17b565b9050801562005852576017546040516000916001600160a01b03169083908381818185875af1925050503d80600081146200582c576040519150601f19603f3d011682016040523d82523d6000602084013e62005831565b606091505b509091505080151560010362005850576200584d828562009ba2565b93505b505b82602e600082825462005866919062009bb8565b90915550600090505b602d5481101562001d63576000603a6000602d84815481106200589657620058966200a192565b600091825260208083206003909202909101546001600160a01b031683528201929092526040018120602b54602d8054929450909188919086908110620058e157620058e16200a192565b906000526020600020906003020160010154620058ff91906200a2c0565b6200590b91906200a17b565b90506000602b5486602d86815481106200592957620059296200a192565b9060005260206000209060030201600101546200594791906200a2c0565b6200595391906200a17b565b90506000821180620059655750600081115b1562005cf3576002601384015460ff16600381111562005989576200598962009129565b1480620059b157506003601384015460ff166003811115620059af57620059af62009129565b145b1562005a9357601854620059d19030906001600160a01b031683620055d5565b601854602d80546001600160a01b039092169163a8031a1d91859188908110620059ff57620059ff6200a192565b600091825260209091206003909102015460405160e084901b7fffffffff000000000000000000000000000000000000000000000000000000001681526001600160a01b039091166004820152602481018590526044016000604051808303818588803b15801562005a7057600080fd5b505af115801562005a85573d6000803e3d6000fd5b505050505050505062005cf7565b6000836005015483856008015462005aac91906200a2c0565b62005ab891906200a17b565b9050801562005b7a57835460405160009182916001600160a01b03909116906108fc90859084818181858888f193505050503d806000811462005b18576040519150601f19603f3d011682016040523d82523d6000602084013e62005b1d565b606091505b50909250905081151560000362005b775785546040517f1137d8c966ce69b9630fb2294be011f3d64cc56e91fad7d375f0662568e9d3529162005b6e916001600160a01b039091169084906200a2da565b60405180910390a15b50505b6000846005015483866008015462005b9391906200a2c0565b62005b9f91906200a17b565b9050801562005bc157845462005bc19030906001600160a01b031683620055d5565b600062005bcf828562009ba2565b9050600062005bdf848762009ba2565b9050600081118062005bf15750600082115b1562005ca457600c87015462005c139030906001600160a01b031684620055d5565b600c8701546040517fa0ce552d000000000000000000000000000000000000000000000000000000008152600481018490526001600160a01b039091169063a0ce552d908390602401604080518083038185885af115801562005c7a573d6000803e3d6000fd5b50505050506040513d601f19601f8201168201806040525081019062005ca1919062009f92565b50505b600187015460408051888152602081018890526001600160a01b03909216917f291e8ba3c0f4b0bd86e6e2346fcee1e7ca0975b1cc1886bfbc722d34f3f24d91910160405180910390a2505050505b5050505b8062005d03816200a2a3565b9150506200586f565b60355460005b8181101562001dd15760006035828154811062005d335762005d336200a192565b60009182526020808320909101546001600160a01b03168083526033825260408084208054825181860281018601909352808352929550909290919083018282801562005daa57602002820191906000526020600020905b81546001600160a01b0316815260019091019060200180831162005d8b575b5050505050905060005b815181101562005e32576001600160a01b0383166000908152603260205260408120835190919084908490811062005df05762005df06200a192565b60200260200101516001600160a01b03166001600160a01b0316815260200190815260200160002060009055808062005e29906200a2a3565b91505062005db4565b506001600160a01b038216600090815260336020526040812062005e569162008f94565b6001600160a01b038216600090815260386020526040902054815160075462005e8091906200a2c0565b81111562005e9c57815160075462005e9991906200a2c0565b90505b60005a9050836001600160a01b031663d18736ab83856040518363ffffffff1660e01b815260040162005ed0919062009acb565b600060405180830381600088803b15801562005eeb57600080fd5b5087f19350505050801562005efe575060015b62005f34575a62005f10908262009ba2565b6001600160a01b038516600090815260366020526040902060019055905062005f44565b5a62005f41908262009ba2565b90505b6001600160a01b03841660009081526038602052604090205481101562005f9b576001600160a01b0384166000908152603860205260408120805483929062005f8f90849062009ba2565b9091555062005fb59050565b6001600160a01b0384166000908152603860205260408120555b50505050808062005fc6906200a2a3565b91505062005d12565b6009545b600a5481101562005ffc5762005ff68162005fee816200a2a3565b925062007142565b62005fd3565b50600a54600955600d54600c54036200601157565b600e545b600d548110156200603e57620060388162006030816200a2a3565b9250620073fc565b62006015565b50600d54600e55600c54805b600d54811015620060b8576015546000828152600b60205260409020600401544391620060779162009bb8565b116200609d576200608881620077e7565b6200609560018362009bb8565b9150620060a3565b620060b8565b80620060af816200a2a3565b9150506200604a565b50600c55565b60355460005b818110156200614e57600060358281548110620060e557620060e56200a192565b60009182526020808320909101546001600160a01b0316808352603482526040808420849055603690925291205490915060010362006138576001600160a01b0381166000908152603660205260408120555b508062006145816200a2a3565b915050620060c4565b506200146a6035600062008f94565b60115460105410156200161b576010546000908152600f6020526040902060155460018201544391620061909162009bb8565b11156200619a5750565b80546001600160a01b039081166000908152603a60205260409081902060028401546004808301829055600c83015493517f19fac8fd000000000000000000000000000000000000000000000000000000008152929493909316926319fac8fd926200620b92910190815260200190565b600060405180830381600087803b1580156200622657600080fd5b505af11580156200623b573d6000803e3d6000fd5b5050601080546000908152600f60205260408120805473ffffffffffffffffffffffffffffffffffffffff19168155600180820183905560029091018290558254909450919250906200629090849062009bb8565b909155506200615d92505050565b6000828152602c60209081526040808320918401516001830155830151600282015560608301516003820155905b82515181101562001d63578251805183919083908110620062f157620062f16200a192565b6020908102919091018101518254600180820185556000948552938390208251600390920201805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0390921691909117815591810151928201929092556040820151600282019062006362908262009fd7565b505050808062006372906200a2a3565b915050620062cc565b6000805b602d54811015620063e357602d8181548110620063a057620063a06200a192565b60009182526020909120600390910201546001600160a01b0390811690841603620063ce5750600192915050565b80620063da816200a2a3565b9150506200637f565b50600092915050565b600080620063f962008fb4565b60008060ff9050604083875160208901845afa6200641657600080fd5b505080516020909101516c0100000000000000000000000090910494909350915050565b60e28151146200648d5760405162461bcd60e51b815260206004820152601460248201527f496e76616c69642070726f6f66206c656e6774680000000000000000000000006044820152606401620013bb565b60308261024001515114620064e55760405162461bcd60e51b815260206004820152601c60248201527f496e76616c696420636f6e73656e737573206b6579206c656e677468000000006044820152606401620013bb565b620064f08262007990565b604080518082018252601a81527f19457468657265756d205369676e6564204d6573736167653a0a00000000000060208083019190915284519251919260009262006566920160609190911b7fffffffffffffffffffffffffffffffffffffffff00000000000000000000000016815260140190565b604051602081830303815290604052905060008262006586835162007acf565b836040516020016200659b939291906200a30c565b60408051601f1981840301815282825280516020918201206002808552606085018452909450600093929091830190803683370190505090506000808080620065f38982620065ed604160026200a2c0565b62007c24565b90506000620066128a6200660a604160026200a2c0565b606062007c24565b905060205b8251811015620066e3576200662d838262007d58565b6040805160008152602081018083528d905260ff8316918101919091526060810184905260808101839052929850909650945060019060a0016020604051602081039080840390855afa15801562006689573d6000803e3d6000fd5b5050604051601f190151905087620066a36041846200a17b565b81518110620066b657620066b66200a192565b6001600160a01b0390921660209283029190910190910152620066db60418262009bb8565b905062006617565b508a602001516001600160a01b0316866000815181106200670857620067086200a192565b60200260200101516001600160a01b0316146200678e5760405162461bcd60e51b815260206004820152602960248201527f496e76616c6964206e6f6465206b6579206f776e6572736869702070726f6f6660448201527f2070726f766964656400000000000000000000000000000000000000000000006064820152608401620013bb565b8a604001516001600160a01b031686600181518110620067b257620067b26200a192565b60200260200101516001600160a01b031614620068385760405162461bcd60e51b815260206004820152602b60248201527f496e76616c6964206f7261636c65206b6579206f776e6572736869702070726f60448201527f6f662070726f76696465640000000000000000000000000000000000000000006064820152608401620013bb565b6001620068508c6102400151838e6000015162007d8f565b14620068c55760405162461bcd60e51b815260206004820152603660248201527f496e76616c696420636f6e73656e737573206b6579206f776e6572736869702060448201527f70726f6f6620666f7220726567697374726174696f6e000000000000000000006064820152608401620013bb565b620068d08b62007dfe565b5050505050505050505050565b60006064821015801562001341575050617530101590565b6000600a821015801562001341575050612710101590565b6000808311620069865760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201527f69766500000000000000000000000000000000000000000000000000000000006064820152608401620013bb565b6001600160a01b038216600090815260396020526040902054831115620069f05760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e636500000000006044820152606401620013bb565b6001600160a01b0382166000908152603960205260408120805485929062006a1a90849062009ba2565b9091555050604080516080810182526001600160a01b03808516825286811660208084019182528385018881524360608601908152600a8054600090815260089094529683208651815490871673ffffffffffffffffffffffffffffffffffffffff199182161782559451600182018054919097169516949094179094555160028301559151600390910155825491929062006ab6836200a2a3565b90915550506001600160a01b038581166000818152603a6020908152604091829020548251908516948816948514808252918101899052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d910160405180910390a3833b1562006b305762006b308487620080e0565b6001600a5462006b41919062009ba2565b9695505050505050565b6001600160a01b038084166000908152603a602052604081208054919290918482169116148062006d0757600c8201546040517f84955c880000000000000000000000000000000000000000000000000000000081526001600160a01b03868116600483015260009216906384955c8890602401602060405180830381865afa15801562006bdd573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019062006c03919062009f78565b90508581101562006c7d5760405162461bcd60e51b815260206004820152602b60248201527f696e73756666696369656e7420756e6c6f636b6564204c6971756964204e657760448201527f746f6e2062616c616e63650000000000000000000000000000000000000000006064820152608401620013bb565b600c8301546040517f282d3fdf0000000000000000000000000000000000000000000000000000000081526001600160a01b038781166004830152602482018990529091169063282d3fdf90604401600060405180830381600087803b15801562006ce757600080fd5b505af115801562006cfc573d6000803e3d6000fd5b505050505062006db0565b8482600b0154836008015462006d1e919062009ba2565b101562006d945760405162461bcd60e51b815260206004820152602760248201527f696e73756666696369656e742073656c6620626f6e646564206e6577746f6e2060448201527f62616c616e6365000000000000000000000000000000000000000000000000006064820152608401620013bb565b8482600b01600082825462006daa919062009bb8565b90915550505b604051806101200160405280856001600160a01b03168152602001876001600160a01b0316815260200186815260200160008152602001438152602001600081526020016000600381111562006e0a5762006e0a62009129565b815260006020808301829052841515604093840152600d548252600b815290829020835181546001600160a01b0391821673ffffffffffffffffffffffffffffffffffffffff199182161783559285015160018381018054929093169190941617905591830151600283015560608301516003808401919091556080840151600484015560a0840151600584015560c08401516006840180549193909260ff1990921691849081111562006ec25762006ec262009129565b021790555060e08201516006909101805461010093840151151562010000027fffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ffff931515909402929092167fffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000ff90921691909117919091179055600d805490600062006f4c836200a2a3565b9190505550836001600160a01b0316866001600160a01b03167f63f8870909f7c59c9c4932bf98dbd491647c8d2e89ca0a032aacdd943a13e2fc838860405162006fa29291909115158252602082015260400190565b60405180910390a3833b1562006fbe5762006fbe8487620080e0565b6001600d5462006b41919062009ba2565b60fa60a06000808285855af462006fea573d6000803e3d6000fd5b505050565b8154600260018083161561010002038216048251808201602081106020841001600281146200709e5760018114620070c4578660005260208404602060002001600160028402018855602085068060200390508088018589016001836101000a0392508282511684540184556001840193506020820191505b8082101562007087578151845560018401935060208201915062007068565b815191036101000a90819004029091555062007139565b60028302826020036101000a846020036101000a60208901510402018501875562007139565b8660005260208404602060002001600160028402018855846020038088018589016001836101000a0392508282511660ff198a160184556020820191506001840193505b8082101562007127578151845560018401935060208201915062007108565b815191036101000a9081900402909155505b50505050505050565b600081815260086020908152604080832060018101546001600160a01b03168452603a90925282209091601382015460ff16600381111562007188576200718862009129565b141580620071af575081546001600160a01b03166000908152603660205260409020546001145b156200726f57600282015482546001600160a01b031660009081526039602052604081208054909190620071e590849062009bb8565b909155505081546001830154600284015460138401546040516001600160a01b0394851694909316927f1ff2b052afa4bb37ce30d9aaccde416a700b97e632d089111749af937f878342926200724392909160ff909116906200a355565b60405180910390a38054825462006fea9185916000916001600160a01b039182169116146001620081ff565b805482546001600160a01b03908116911614620073ac57600080826008015483600501546200729f919062009ba2565b905080600003620072b75783600201549150620072dd565b80846002015484600d0154620072ce91906200a2c0565b620072da91906200a17b565b91505b600c83015484546040517f40c10f190000000000000000000000000000000000000000000000000000000081526001600160a01b039182166004820152602481018590529116906340c10f1990604401600060405180830381600087803b1580156200734857600080fd5b505af11580156200735d573d6000803e3d6000fd5b505050508183600d01600082825462007377919062009bb8565b909155505060028401546005840180546000906200739790849062009bb8565b9091555062001f4690508583600080620081ff565b8160020154816008016000828254620073c6919062009bb8565b90915550506002820154600582018054600090620073e690849062009bb8565b9091555062006fea9050836000600181620081ff565b6000818152600b6020908152604080832080546001600160a01b031684526036909252909120546001036200749e578054600182015460068301546002840154604080516201000090930460ff161515835260208301919091526001600160a01b0393841693909216917fec3ccfdbfc982a0ea188f4fd85459da1d6a7b332ddc4ca6b7a774c1abfc75866910160405180910390a362001dd1826001620083a1565b60018101546001600160a01b03166000908152603a60205260408120600683015490919062010000900460ff16620076b8576002830154600c83015484546040517f7eee288d0000000000000000000000000000000000000000000000000000000081526001600160a01b03918216600482015260248101849052911690637eee288d90604401600060405180830381600087803b1580156200754057600080fd5b505af115801562007555573d6000803e3d6000fd5b50505050600c83015484546040517f9dc29fac0000000000000000000000000000000000000000000000000000000081526001600160a01b03918216600482015260248101849052911690639dc29fac90604401600060405180830381600087803b158015620075c457600080fd5b505af1158015620075d9573d6000803e3d6000fd5b50505050600083600801548460050154620075f5919062009ba2565b600d8501549091506200760982846200a2c0565b6200761591906200a17b565b92508184600d0160008282546200762d919062009ba2565b909155505060068401546000036200764c576003850183905562007674565b600684015460078501546200766290856200a2c0565b6200766e91906200a17b565b60038601555b828460060160008282546200768a919062009bb8565b90915550506003850154600785018054600090620076aa90849062009bb8565b909155506200778d92505050565b5060028201546008820154811115620076d2575060088101545b8160090154600003620076ec576003830181905562007714565b6009820154600a8301546200770290836200a2c0565b6200770e91906200a17b565b60038401555b808260090160008282546200772a919062009bb8565b90915550506003830154600a830180546000906200774a90849062009bb8565b925050819055508082600801600082825462007767919062009ba2565b90915550506002830154600b830180546000906200778790849062009ba2565b90915550505b6006830180547fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ff16610100179055600582018054829190600090620077d490849062009ba2565b9091555062001d639050846000620083a1565b6000818152600b602052604090206006810154610100900460ff16620078255760068101805460ff1916600217905562001dd182600060016200852d565b60068101805460ff1916600117905560038101546000036200784f5762001dd1826000806200852d565b60018101546001600160a01b03166000908152603a60205260408120600683015490919062010000900460ff16620078ec578160070154826006015484600301546200789c91906200a2c0565b620078a891906200a17b565b905080826006016000828254620078c0919062009ba2565b90915550506003830154600783018054600090620078e090849062009ba2565b90915550620079519050565b81600a0154826009015484600301546200790791906200a2c0565b6200791391906200a17b565b9050808260090160008282546200792b919062009ba2565b90915550506003830154600a830180546000906200794b90849062009ba2565b90915550505b82546001600160a01b0316600090815260396020526040812080548392906200797c90849062009bb8565b9091555062001d639050848260006200852d565b6000620079a18260600151620063ec565b6001600160a01b0390911660208401529050801562007a035760405162461bcd60e51b815260206004820152600b60248201527f656e6f6465206572726f720000000000000000000000000000000000000000006044820152606401620013bb565b6020808301516001600160a01b039081166000908152603a909252604090912060010154161562007a775760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c72656164792072656769737465726564000000006044820152606401620013bb565b6127108260800151111562001dd15760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e20726174650000000000000000006044820152606401620013bb565b60608160000362007b1357505060408051808201909152600181527f3000000000000000000000000000000000000000000000000000000000000000602082015290565b8160005b811562007b43578062007b2a816200a2a3565b915062007b3b9050600a836200a17b565b915062007b17565b60008167ffffffffffffffff81111562007b615762007b6162009586565b6040519080825280601f01601f19166020018201604052801562007b8c576020820181803683370190505b5090505b841562007c1c5762007ba460018362009ba2565b915062007bb3600a866200a374565b62007bc090603062009bb8565b60f81b81838151811062007bd85762007bd86200a192565b60200101907effffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916908160001a90535062007c14600a866200a17b565b945062007b90565b949350505050565b60608162007c3481601f62009bb8565b101562007c845760405162461bcd60e51b815260206004820152600e60248201527f736c6963655f6f766572666c6f770000000000000000000000000000000000006044820152606401620013bb565b62007c90828462009bb8565b8451101562007ce25760405162461bcd60e51b815260206004820152601160248201527f736c6963655f6f75744f66426f756e64730000000000000000000000000000006044820152606401620013bb565b60608215801562007d03576040519150600082526020820160405262007d4f565b6040519150601f8416801560200281840101858101878315602002848b0101015b8183101562007d3e57805183526020928301920162007d24565b5050858452601f01601f1916604052505b50949350505050565b8181018051602082015160409092015190919060001a601b81101562007d885762007d85601b826200a38b565b90505b9250925092565b600062007d9b62008fd2565b600085858560405160200162007db4939291906200a3a7565b6040516020818303038152906040529050600060fb905060008251602062007ddd919062009bb8565b90506020848285855afa62007df157600080fd5b5050905195945050505050565b6101808101516001600160a01b031662007f1857603e546001600160a01b031662007e925760405162461bcd60e51b815260206004820152602260248201527f6c6971756964206c6f67696320636f6e7472616374206e6f74206465706c6f7960448201527f65640000000000000000000000000000000000000000000000000000000000006064820152608401620013bb565b60255460009062007ea39062007acf565b905081602001518260000151836080015183603e60009054906101000a90046001600160a01b031660405162007ed99062008ff0565b62007ee99594939291906200a409565b604051809103906000f08015801562007f06573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051602580546001808201835560009283527f401968ff42a154441da5f6c4c935ac46b8671f0e062baaa62a7545ba53bb6e4c909101805473ffffffffffffffffffffffffffffffffffffffff199081166001600160a01b0395861617909155845184168352603a9095526040918290208651815487169085161781559351908401805486169184169190911790558401516002830180549094169116179091556060820151829190600382019062007fd7908262009fd7565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c8201805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f820155610200820151601082015561022082015160118201556102408201516012820190620080ae908262009fd7565b5061026082015160138201805460ff19166001836003811115620080d657620080d662009129565b0217905550505050565b6001600160a01b03821660009081526034602052604081205490036200816b576001600160a01b03821660008181526034602052604081206001908190556035805491820181559091527fcfa4bec1d3298408bb5afcfcd9c430549c5b31f8aa5c5848151c0a55f473c34d01805473ffffffffffffffffffffffffffffffffffffffff191690911790555b6001600160a01b038083166000908152603260209081526040808320938516835292905290812054900362001dd1576001600160a01b038083166000818152603260209081526040808320948616808452948252808320600190819055938352603382528220805493840181558252902001805473ffffffffffffffffffffffffffffffffffffffff191690911790555050565b600084815260086020526040902080546001600160a01b0316803b6200822757505062001d63565b6001600160a01b0381166000908152603860205260409020546004548111156200825057506004545b60005a60018501546040517f9dfd1b8e000000000000000000000000000000000000000000000000000000008152600481018b90526001600160a01b039182166024820152604481018a905288151560648201528715156084820152919250841690639dfd1b8e90849060a401600060405180830381600088803b158015620082d857600080fd5b5087f193505050508015620082eb575060015b62008316575a620082fd908262009ba2565b90508462008310576200831088620086b6565b62008326565b5a62008323908262009ba2565b90505b6001600160a01b0383166000908152603860205260409020548110156200837d576001600160a01b038316600090815260386020526040812080548392906200837190849062009ba2565b90915550620083979050565b6001600160a01b0383166000908152603860205260408120555b5050505050505050565b6000828152600b6020526040902080546001600160a01b0316803b620083c75750505050565b6001600160a01b038116600090815260386020526040902054600554811115620083f057506005545b60005a60018501546040517fa8920241000000000000000000000000000000000000000000000000000000008152600481018990526001600160a01b039182166024820152871515604482015291925084169063a8920241908490606401600060405180830381600088803b1580156200846957600080fd5b5087f1935050505080156200847c575060015b620084a7575a6200848e908262009ba2565b905084620084a157620084a18662008896565b620084b7565b5a620084b4908262009ba2565b90505b6001600160a01b0383166000908152603860205260409020548110156200850e576001600160a01b038316600090815260386020526040812080548392906200850290849062009ba2565b90915550620030aa9050565b50506001600160a01b0316600090815260386020526040812055505050565b6000838152600b6020526040902080546001600160a01b0316803b62008554575050505050565b6001600160a01b0381166000908152603860205260409020546006548111156200857d57506006545b60005a6040517f3c54c290000000000000000000000000000000000000000000000000000000008152600481018990526024810188905286151560448201529091506001600160a01b03841690633c54c290908490606401600060405180830381600088803b158015620085f057600080fd5b5087f19350505050801562008603575060015b6200862f575a62008615908262009ba2565b905084620086295762008629878762008b22565b6200863f565b5a6200863c908262009ba2565b90505b6001600160a01b03831660009081526038602052604090205481101562008696576001600160a01b038316600090815260386020526040812080548392906200868a90849062009ba2565b90915550620071399050565b50506001600160a01b031660009081526038602052604081205550505050565b600081815260086020908152604080832060018101546001600160a01b039081168552603a9093529220805483549192918216911614620087d45760008160080154826005015462008709919062009ba2565b836002015483600d01546200871f91906200a2c0565b6200872b91906200a17b565b600c83015484546040517f9dc29fac0000000000000000000000000000000000000000000000000000000081526001600160a01b039182166004820152602481018490529293501690639dc29fac90604401600060405180830381600087803b1580156200879857600080fd5b505af1158015620087ad573d6000803e3d6000fd5b505050508082600d016000828254620087c7919062009ba2565b90915550620087f4915050565b8160020154816008016000828254620087ee919062009ba2565b90915550505b600282015482546001600160a01b0316600090815260396020526040812080549091906200882490849062009bb8565b909155505060028201546005820180546000906200884490849062009ba2565b90915550508154600183015460028401546040519081526001600160a01b0392831692909116907f2d2530c45d577d38d6fc598e41ade6fb0bfbde20d09244c88348d3a2797a9d90906020016200547f565b6000818152600b6020908152604080832060018101546001600160a01b03168452603a909252822060068201549192909162010000900460ff16620089e5576002830154600c83015484546040517f40c10f190000000000000000000000000000000000000000000000000000000081526001600160a01b039182166004820152602481018490529116906340c10f1990604401600060405180830381600087803b1580156200894557600080fd5b505af11580156200895a573d6000803e3d6000fd5b505050508083600d01600082825462008974919062009bb8565b90915550506007830154600684015460038601546200899491906200a2c0565b620089a091906200a17b565b915081836006016000828254620089b8919062009ba2565b90915550506003840154600784018054600090620089d890849062009ba2565b9091555062008a67915050565b81600a01548260090154846003015462008a0091906200a2c0565b62008a0c91906200a17b565b90508082600901600082825462008a24919062009ba2565b90915550506003830154600a8301805460009062008a4490849062009ba2565b925050819055508082600801600082825462008a61919062009bb8565b90915550505b6000600384018190556006840180547fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ff16905560058301805483929062008ab090849062009bb8565b90915550508254600184015460068501546002860154604080516201000090930460ff161515835260208301919091526001600160a01b0393841693909216917f52c1b482483796c697edd43958bc63e332069083ea38782d960693145db2cf0c91015b60405180910390a350505050565b6000828152600b602052604081209082900362008ba657600681018054600360ff19909116179081905581546001830154604080516201000090940460ff1615158452602084018690526001600160a01b039283169392909116917f0a4bfc4c4e35975ab1fe5fd21d963e8bb8d483f04aebdbc0a059b246bec3f5fc91016200547f565b60018101546001600160a01b03166000908152603a60205260409020600682015462010000900460ff1662008ccf576000808260080154836005015462008bee919062009ba2565b90508060000362008c025784915062008c24565b808584600d015462008c1591906200a2c0565b62008c2191906200a17b565b91505b600c83015484546040517f40c10f190000000000000000000000000000000000000000000000000000000081526001600160a01b039182166004820152602481018590529116906340c10f1990604401600060405180830381600087803b15801562008c8f57600080fd5b505af115801562008ca4573d6000803e3d6000fd5b505050508183600d01600082825462008cbe919062009bb8565b909155505050600583015562008cf4565b8282600501819055508281600801600082825462008cee919062009bb8565b90915550505b60068201805460ff1916600317905581546001600160a01b03166000908152603960205260408120805485929062008d2e90849062009ba2565b925050819055508281600501600082825462008d4b919062009bb8565b9091555050815460018301546006840154604080516201000090920460ff1615158252602082018790526001600160a01b0393841693909216917f0a4bfc4c4e35975ab1fe5fd21d963e8bb8d483f04aebdbc0a059b246bec3f5fc910162008b14565b60405180610280016040528060006001600160a01b0316815260200160006001600160a01b0316815260200160006001600160a01b0316815260200160608152602001600081526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b031681526020016000815260200160008152602001600081526020016000815260200160008152602001606081526020016000600381111562008e795762008e7962009129565b905290565b60405180610120016040528060006001600160a01b0316815260200160006001600160a01b03168152602001600081526020016000815260200160008152602001600081526020016000600381111562008edc5762008edc62009129565b815260006020820181905260409091015290565b6040518060a001604052806005906020820280368337509192915050565b50805460008255906000526020600020908101906200146a919062008ffe565b50805462008f3c9062009b1e565b6000825580601f1062008f4d575050565b601f0160209004906000526020600020908101906200146a919062009023565b50805460008255601f0160209004906000526020600020908101906200146a919062009023565b50805460008255906000526020600020908101906200146a919062009023565b60405180604001604052806002906020820280368337509192915050565b60405180602001604052806001906020820280368337509192915050565b610610806200a43d83390190565b808211156200901f57600062009015828262008f2e565b5060010162008ffe565b5090565b5b808211156200901f576000815560010162009024565b60005b83811015620090575781810151838201526020016200903d565b50506000910152565b600081518084526200907a8160208601602086016200903a565b601f01601f19169290920160200192915050565b602081526000620090a3602083018462009060565b9392505050565b6001600160a01b03811681146200146a57600080fd5b60008060408385031215620090d457600080fd5b8235620090e181620090aa565b946020939093013593505050565b6000602082840312156200910257600080fd5b8135620090a381620090aa565b6000602082840312156200912257600080fd5b5035919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b600481106200146a577f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b6200919b8162009158565b9052565b60208152620091ba6020820183516001600160a01b03169052565b60006020830151620091d760408401826001600160a01b03169052565b5060408301516001600160a01b03811660608401525060608301516102808060808501526200920b6102a085018362009060565b9150608085015160a085015260a085015160c085015260c085015160e085015260e08501516101008181870152808701519150506101208181870152808701519150506101408181870152808701519150506101608181870152808701519150506101808181870152808701519150506101a062009293818701836001600160a01b03169052565b8601516101c0868101919091528601516101e080870191909152860151610200808701919091528601516102208087019190915286015161024080870191909152860151858403601f190161026080880191909152909150620092f7848362009060565b9350808701519150506200930e8286018262009190565b5090949350505050565b6000806000606084860312156200932e57600080fd5b83356200933b81620090aa565b925060208401356200934d81620090aa565b929592945050506040919091013590565b6000610120820190506001600160a01b038084511683528060208501511660208401525060408301516040830152606083015160608301526080830151608083015260a083015160a083015260c0830151620093be60c084018262009190565b5060e0830151620093d360e084018215159052565b50610100928301511515919092015290565b600060208284031215620093f857600080fd5b813567ffffffffffffffff8111156200941057600080fd5b82016102808185031215620090a357600080fd5b600081518084526020808501808196508360051b8101915082860160005b8581101562009499578284038952815180516001600160a01b031685528581015186860152604090810151606091860182905290620094848187018362009060565b9a87019a955050509084019060010162009442565b5091979650505050505050565b8515158152841515602082015260a060408201526000620094cb60a083018662009424565b606083019490945250608001529392505050565b6101e08101620095308286805182526020810151602083015260408101516040830152606081015160608301526080810151608083015260a081015160a083015260c0810151151560c08301525050565b835160e0830152602084015161010083015260408401516101208301526060840151610140830152608084015161016083015260a084015161018083015260c09093015115156101a08201526101c00152919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b600082601f830112620095c757600080fd5b813567ffffffffffffffff80821115620095e557620095e562009586565b604051601f8301601f19908116603f0116810190828211818310171562009610576200961062009586565b816040528381528660208588010111156200962a57600080fd5b836020870160208301376000602085830101528094505050505092915050565b600080604083850312156200965e57600080fd5b82356200966b81620090aa565b9150602083013567ffffffffffffffff8111156200968857600080fd5b6200969685828601620095b5565b9150509250929050565b600061026082019050855182526020860151602083015260408601516040830152606086015160608301526080860151608083015260a08601516001600160a01b0380821660a08501528087511660c08501528060208801511660e085015280604088015116610100850152505060608501516200972a6101208401826001600160a01b03169052565b5060808501516001600160a01b0390811661014084015260a0860151811661016084015260c0860151811661018084015260e09095015185166101a083015283519094166101c082015260208301516101e0820152604083015161020082015260609092015161022083015261024090910152919050565b60008060008060808587031215620097b957600080fd5b843567ffffffffffffffff80821115620097d257600080fd5b620097e088838901620095b5565b955060208701359150620097f482620090aa565b909350604086013590808211156200980b57600080fd5b6200981988838901620095b5565b935060608701359150808211156200983057600080fd5b506200983f87828801620095b5565b91505092959194509250565b80151581146200146a57600080fd5b600060e082840312156200986d57600080fd5b60405160e0810181811067ffffffffffffffff8211171562009893576200989362009586565b8060405250823581526020830135602082015260408301356040820152606083013560608201526080830135608082015260a083013560a082015260c0830135620098de816200984b565b60c08201529392505050565b6000602080830181845280855180835260408601915060408160051b870101925083870160005b8281101562009961577fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffc08886030184526200994e85835162009060565b9450928501929085019060010162009911565b5092979650505050505050565b602081016200997d8362009158565b91905290565b60808152600062009998608083018762009424565b6020830195909552506040810192909252606090910152919050565b602081526000620090a3602083018462009424565b600081518084526020808501945080840160005b8381101562009a045781516001600160a01b031687529582019590820190600101620099dd565b509495945050505050565b60408152600062009a246040830185620099c9565b828103602084015262009a388185620099c9565b95945050505050565b6000806040838503121562009a5557600080fd5b823567ffffffffffffffff8082111562009a6e57600080fd5b62009a7c86838701620095b5565b9350602085013591508082111562009a9357600080fd5b506200969685828601620095b5565b60408152600062009ab7604083018562009060565b828103602084015262009a38818562009060565b602081526000620090a36020830184620099c9565b6000806040838503121562009af457600080fd5b823562009b0181620090aa565b9150602083013562009b1381620090aa565b809150509250929050565b600181811c9082168062009b3357607f821691505b60208210810362009b6d577f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b8181038181111562001341576200134162009b73565b8082018082111562001341576200134162009b73565b600081356200134181620090aa565b60008083357fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe184360301811262009c1357600080fd5b83018035915067ffffffffffffffff82111562009c2f57600080fd5b60200191503681900382131562009c4557600080fd5b9250929050565b601f82111562006fea57600081815260208120601f850160051c8101602086101562009c755750805b601f850160051c820191505b81811015620030aa5782815560010162009c81565b67ffffffffffffffff83111562009cb15762009cb162009586565b62009cc98362009cc2835462009b1e565b8362009c4c565b6000601f84116001811462009d00576000851562009ce75750838201355b600019600387901b1c1916600186901b17835562001f46565b600083815260209020601f19861690835b8281101562009d33578685013582556020948501946001909201910162009d11565b508682101562009d515760001960f88860031b161c19848701351681555b505060018560011b0183555050505050565b60008135600481106200134157600080fd5b62009d808262009158565b60ff1981541660ff831681178255505050565b62009dcc62009da28362009bce565b825473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0391909116178255565b62009e0c62009dde6020840162009bce565b600183016001600160a01b03821673ffffffffffffffffffffffffffffffffffffffff198254161781555050565b62009e4c62009e1e6040840162009bce565b600283016001600160a01b03821673ffffffffffffffffffffffffffffffffffffffff198254161781555050565b62009e5b606083018362009bdd565b62009e6b81836003860162009c96565b50506080820135600482015560a0820135600582015560c0820135600682015560e0820135600782015561010082013560088201556101208201356009820155610140820135600a820155610160820135600b82015562009f0262009ed4610180840162009bce565b600c83016001600160a01b03821673ffffffffffffffffffffffffffffffffffffffff198254161781555050565b6101a0820135600d8201556101c0820135600e8201556101e0820135600f8201556102008201356010820155610220820135601182015562009f4961024083018362009bdd565b62009f5981836012860162009c96565b505062001dd162009f6e610260840162009d63565b6013830162009d75565b60006020828403121562009f8b57600080fd5b5051919050565b6000806040838503121562009fa657600080fd5b505080516020909101519092909150565b60006020828403121562009fca57600080fd5b8151620090a3816200984b565b815167ffffffffffffffff81111562009ff45762009ff462009586565b6200a00c816200a005845462009b1e565b8462009c4c565b602080601f8311600181146200a04457600084156200a02b5750858301515b600019600386901b1c1916600185901b178555620030aa565b600085815260208120601f198616915b828110156200a075578886015182559484019460019091019084016200a054565b50858210156200a0945787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b60006001600160a01b0380881683528087166020840152808616604084015260a060608401526200a0d960a084018662009060565b91508084166080840152509695505050505050565b61010081016200a13f8285805182526020810151602083015260408101516040830152606081015160608301526080810151608083015260a081015160a083015260c0810151151560c08301525050565b8260e08301529392505050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b6000826200a18d576200a18d6200a14c565b500490565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052603260045260246000fd5b8181036200a1cd575050565b6200a1d9825462009b1e565b67ffffffffffffffff8111156200a1f4576200a1f462009586565b6200a205816200a005845462009b1e565b6000601f8211600181146200a23c57600083156200a2235750848201545b600019600385901b1c1916600184901b17845562001f46565b600085815260209020601f19841690600086815260209020845b838110156200a27857828601548255600195860195909101906020016200a256565b50858310156200a0945793015460001960f8600387901b161c19169092555050600190811b01905550565b600060001982036200a2b9576200a2b962009b73565b5060010190565b808202811582820484141762001341576200134162009b73565b6001600160a01b0383168152606060208201526000606082015260806040820152600062007c1c608083018462009060565b600084516200a3208184602089016200903a565b8451908301906200a3368183602089016200903a565b84519101906200a34b8183602088016200903a565b0195945050505050565b828152604081016200a3678362009158565b8260208301529392505050565b6000826200a386576200a3866200a14c565b500690565b60ff818116838216019081111562001341576200134162009b73565b600084516200a3bb8184602089016200903a565b8451908301906200a3d18183602089016200903a565b60609490941b7fffffffffffffffffffffffffffffffffffffffff000000000000000000000000169301928352505060140192915050565b60006001600160a01b038088168352808716602084015285604084015260a060608401526200a0d960a08401866200906056fe608060405234801561001057600080fd5b5060405161061038038061061083398101604081905261002f916101af565b806001600160a01b0316632f2c3f2e6040518163ffffffff1660e01b8152600401602060405180830381865afa15801561006d573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610091919061029f565b83111561009d57600080fd5b600c80546001600160a01b038088166001600160a01b031992831617909255600d805492871692909116919091179055600e8390556040516100e39083906020016102b8565b604051602081830303815290604052600a9081610100919061036e565b508160405160200161011291906102b8565b604051602081830303815290604052600b908161012f919061036e565b5050601080546001600160a01b031916331790555061042d92505050565b6001600160a01b038116811461016257600080fd5b50565b80516101708161014d565b919050565b634e487b7160e01b600052604160045260246000fd5b60005b838110156101a657818101518382015260200161018e565b50506000910152565b600080600080600060a086880312156101c757600080fd5b85516101d28161014d565b60208701519095506101e38161014d565b6040870151606088015191955093506001600160401b038082111561020757600080fd5b818801915088601f83011261021b57600080fd5b81518181111561022d5761022d610175565b604051601f8201601f19908116603f0116810190838211818310171561025557610255610175565b816040528281528b602084870101111561026e57600080fd5b61027f83602083016020880161018b565b809650505050505061029360808701610165565b90509295509295909350565b6000602082840312156102b157600080fd5b5051919050565b644c4e544e2d60d81b8152600082516102d881600585016020870161018b565b9190910160050192915050565b600181811c908216806102f957607f821691505b60208210810361031957634e487b7160e01b600052602260045260246000fd5b50919050565b601f82111561036957600081815260208120601f850160051c810160208610156103465750805b601f850160051c820191505b8181101561036557828155600101610352565b5050505b505050565b81516001600160401b0381111561038757610387610175565b61039b8161039584546102e5565b8461031f565b602080601f8311600181146103d057600084156103b85750858301515b600019600386901b1c1916600185901b178555610365565b600085815260208120601f198616915b828110156103ff578886015182559484019460019091019084016103e0565b508582101561041d5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b6101d48061043c6000396000f3fe60806040523661001b57610019610014610022565b61013e565b005b6100196100145b600080601060009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff166396f18a326040518163ffffffff1660e01b8152600401602060405180830381865afa158015610092573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906100b69190610161565b905073ffffffffffffffffffffffffffffffffffffffff8116610139576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152601d60248201527f6c6971756964206c6f67696320636f6e7472616374206e6f7420736574000000604482015260640160405180910390fd5b919050565b3660008037600080366000845af43d6000803e8061015b573d6000fd5b503d6000f35b60006020828403121561017357600080fd5b815173ffffffffffffffffffffffffffffffffffffffff8116811461019757600080fd5b939250505056fea264697066735822122076b5b72323e3d9ea33f6c72d4f6af1b0947104c33397f7484304cd24721df42c64736f6c63430008150033a2646970667358221220b9124f66572cab1e8369410d6ef6ffb04ced7977d898abbc1a5cb00e56d9e7da64736f6c63430008150033608060405234801561001057600080fd5b50601080546001600160a01b03191633179055611cc4806100326000396000f3fe6080604052600436106101a55760003560e01c80633e4eb36c116100e1578063949813b81161008a578063a0ce552d11610064578063a0ce552d1461054b578063a9059cbb1461055e578063bd96102f1461057e578063dd62ed3e14610593576101ac565b8063949813b8146104e157806395d89b41146105165780639dc29fac1461052b576101ac565b806370a08231116100bb57806370a082311461045e5780637eee288d146104a157806384955c88146104c1576101ac565b80633e4eb36c146103e657806340c10f19146103fb578063593557361461041b576101ac565b80631eeffad01161014e5780632f2c3f2e116101285780632f2c3f2e14610374578063313ce5671461038a578063372500ab146103a65780633b19e84a146103bb576101ac565b80631eeffad01461031f57806323b872dd14610334578063282d3fdf14610354576101ac565b806318160ddd1161017f57806318160ddd146102c6578063187cf4d7146102e557806319fac8fd146102fd576101ac565b806306fdde031461021f578063095ea7b31461024a5780631195e07e1461027a576101ac565b366101ac57005b60405162461bcd60e51b815260206004820152602860248201527f66616c6c6261636b206e6f7420696d706c656d656e74656420666f72204c697160448201527f7569644c6f67696300000000000000000000000000000000000000000000000060648201526084015b60405180910390fd5b34801561022b57600080fd5b506102346105e6565b6040516102419190611a10565b60405180910390f35b34801561025657600080fd5b5061026a610265366004611aa5565b610678565b6040519015158152602001610241565b34801561028657600080fd5b50600c5473ffffffffffffffffffffffffffffffffffffffff165b60405173ffffffffffffffffffffffffffffffffffffffff9091168152602001610241565b3480156102d257600080fd5b506003545b604051908152602001610241565b3480156102f157600080fd5b506102d7633b9aca0081565b34801561030957600080fd5b5061031d610318366004611acf565b61068f565b005b34801561032b57600080fd5b50600f546102d7565b34801561034057600080fd5b5061026a61034f366004611ae8565b610721565b34801561036057600080fd5b5061031d61036f366004611aa5565b610864565b34801561038057600080fd5b506102d761271081565b34801561039657600080fd5b5060405160128152602001610241565b3480156103b257600080fd5b5061031d6109de565b3480156103c757600080fd5b50600d5473ffffffffffffffffffffffffffffffffffffffff166102a1565b3480156103f257600080fd5b50600e546102d7565b34801561040757600080fd5b5061031d610416366004611aa5565b610c08565b34801561042757600080fd5b506102d7610436366004611b24565b73ffffffffffffffffffffffffffffffffffffffff1660009081526001602052604090205490565b34801561046a57600080fd5b506102d7610479366004611b24565b73ffffffffffffffffffffffffffffffffffffffff1660009081526020819052604090205490565b3480156104ad57600080fd5b5061031d6104bc366004611aa5565b610cf2565b3480156104cd57600080fd5b506102d76104dc366004611b24565b610e4f565b3480156104ed57600080fd5b506105016104fc366004611b24565b610e89565b60408051928352602083019190915201610241565b34801561052257600080fd5b50610234610f75565b34801561053757600080fd5b5061031d610546366004611aa5565b610f84565b610501610559366004611acf565b611066565b34801561056a57600080fd5b5061026a610579366004611aa5565b6113f0565b34801561058a57600080fd5b5061031d61145c565b34801561059f57600080fd5b506102d76105ae366004611b3f565b73ffffffffffffffffffffffffffffffffffffffff918216600090815260026020908152604080832093909416825291909152205490565b6060600a80546105f590611b72565b80601f016020809104026020016040519081016040528092919081815260200182805461062190611b72565b801561066e5780601f106106435761010080835404028352916020019161066e565b820191906000526020600020905b81548152906001019060200180831161065157829003601f168201915b5050505050905090565b60006106853384846115a3565b5060015b92915050565b60105473ffffffffffffffffffffffffffffffffffffffff16331461071c5760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610216565b600e55565b73ffffffffffffffffffffffffffffffffffffffff83166000908152600260209081526040808320338452909152812054828110156107c85760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e742065786365656473206160448201527f6c6c6f77616e63650000000000000000000000000000000000000000000000006064820152608401610216565b6107dc85336107d78685611bf4565b6115a3565b6107e68584611722565b6107f0848461183d565b8373ffffffffffffffffffffffffffffffffffffffff168573ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8560405161084f91815260200190565b60405180910390a360019150505b9392505050565b60105473ffffffffffffffffffffffffffffffffffffffff1633146108f15760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610216565b73ffffffffffffffffffffffffffffffffffffffff82166000908152600160209081526040808320549183905290912054829161092d91611bf4565b10156109a05760405162461bcd60e51b8152602060048201526024808201527f63616e2774206c6f636b206d6f72652066756e6473207468616e20617661696c60448201527f61626c65000000000000000000000000000000000000000000000000000000006064820152608401610216565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260016020526040812080548392906109d5908490611c07565b90915550505050565b6000806109ea33611896565b3360009081526004602090815260408083208390556007909152812081905591935091508115610afe576010546040517fa9059cbb0000000000000000000000000000000000000000000000000000000081523360048201526024810184905273ffffffffffffffffffffffffffffffffffffffff9091169063a9059cbb906044016020604051808303816000875af1158015610a8b573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610aaf9190611c1a565b905080610afe5760405162461bcd60e51b815260206004820152601260248201527f4661696c656420746f2073656e64204e544e00000000000000000000000000006044820152606401610216565b333b15610b6b573373ffffffffffffffffffffffffffffffffffffffff1663161605e3846040518263ffffffff1660e01b81526004016000604051808303818588803b158015610b4d57600080fd5b505af1158015610b61573d6000803e3d6000fd5b5050505050505050565b60405133908490600081818185875af1925050503d8060008114610bab576040519150601f19603f3d011682016040523d82523d6000602084013e610bb0565b606091505b50508091505080610c035760405162461bcd60e51b815260206004820152601260248201527f4661696c656420746f2073656e642041544e00000000000000000000000000006044820152606401610216565b505050565b60105473ffffffffffffffffffffffffffffffffffffffff163314610c955760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610216565b610c9f828261183d565b60405181815273ffffffffffffffffffffffffffffffffffffffff8316906000907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef906020015b60405180910390a35050565b60105473ffffffffffffffffffffffffffffffffffffffff163314610d7f5760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610216565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260016020526040902054811115610e1a5760405162461bcd60e51b815260206004820152602360248201527f63616e277420756e6c6f636b206d6f72652066756e6473207468616e206c6f6360448201527f6b656400000000000000000000000000000000000000000000000000000000006064820152608401610216565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260016020526040812080548392906109d5908490611bf4565b73ffffffffffffffffffffffffffffffffffffffff8116600090815260016020908152604080832054918390528220546106899190611bf4565b73ffffffffffffffffffffffffffffffffffffffff81166000908152602081815260408083205460065460059093529083205483928391610ecb9184916119d2565b73ffffffffffffffffffffffffffffffffffffffff8616600090815260046020526040902054909150610eff908290611c07565b60095473ffffffffffffffffffffffffffffffffffffffff871660009081526008602052604081205492965091610f379185916119d2565b73ffffffffffffffffffffffffffffffffffffffff8716600090815260076020526040902054909150610f6b908290611c07565b9350505050915091565b6060600b80546105f590611b72565b60105473ffffffffffffffffffffffffffffffffffffffff1633146110115760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610216565b61101b8282611722565b60405181815260009073ffffffffffffffffffffffffffffffffffffffff8416907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef90602001610ce6565b601054600090819073ffffffffffffffffffffffffffffffffffffffff1633146110f85760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610216565b600e5434906000906127109061110e9084611c3c565b6111189190611c53565b90508181111561116a5760405162461bcd60e51b815260206004820152601c60248201527f696e76616c69642061746e2076616c696461746f7220726577617264000000006044820152606401610216565b6111748183611bf4565b600d5460405191935060009173ffffffffffffffffffffffffffffffffffffffff909116906108fc90849084818181858888f193505050503d80600081146111d8576040519150601f19603f3d011682016040523d82523d6000602084013e6111dd565b606091505b50909150508015156000036112045781600f60008282546111fe9190611c07565b90915550505b6000612710600e54886112179190611c3c565b6112219190611c53565b9050868111156112735760405162461bcd60e51b815260206004820152601c60248201527f696e76616c6964206e746e2076616c696461746f7220726577617264000000006044820152606401610216565b61127d8188611bf4565b9650801561132657601054600d546040517fa9059cbb00000000000000000000000000000000000000000000000000000000815273ffffffffffffffffffffffffffffffffffffffff91821660048201526024810184905291169063a9059cbb906044016020604051808303816000875af1158015611300573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906113249190611c1a565b505b60035460009061133a633b9aca0087611c3c565b6113449190611c53565b9050806006546113549190611c07565b60065560035460009061136b633b9aca008b611c3c565b6113759190611c53565b9050806009546113859190611c07565b600955600354600090633b9aca009061139e9085611c3c565b6113a89190611c53565b90506000633b9aca00600354846113bf9190611c3c565b6113c99190611c53565b90506113d58288611c07565b6113df8287611c07565b995099505050505050505050915091565b60006113fc3383611722565b611406838361183d565b60405182815273ffffffffffffffffffffffffffffffffffffffff84169033907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef9060200160405180910390a350600192915050565b600d5473ffffffffffffffffffffffffffffffffffffffff1633146114e95760405162461bcd60e51b815260206004820152602260248201527f6f6e6c792074726561737572792063616e20636c61696d20686973207265776160448201527f72640000000000000000000000000000000000000000000000000000000000006064820152608401610216565b600d54600f5460405160009273ffffffffffffffffffffffffffffffffffffffff1691908381818185875af1925050503d8060008114611545576040519150601f19603f3d011682016040523d82523d6000602084013e61154a565b606091505b505090508061159b5760405162461bcd60e51b815260206004820152601260248201527f6661696c656420746f2073656e642041544e00000000000000000000000000006044820152606401610216565b506000600f55565b73ffffffffffffffffffffffffffffffffffffffff831661162b5760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f2061646460448201527f72657373000000000000000000000000000000000000000000000000000000006064820152608401610216565b73ffffffffffffffffffffffffffffffffffffffff82166116b45760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f20616464726560448201527f73730000000000000000000000000000000000000000000000000000000000006064820152608401610216565b73ffffffffffffffffffffffffffffffffffffffff83811660008181526002602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b61172b82611896565b505073ffffffffffffffffffffffffffffffffffffffff8216600090815260208181526040808320546001909252909120546117679082611bf4565b8211156117b65760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e7420756e6c6f636b65642066756e647300000000006044820152606401610216565b6117c08282611bf4565b73ffffffffffffffffffffffffffffffffffffffff84166000908152602081905260409020558082036118215773ffffffffffffffffffffffffffffffffffffffff8316600090815260056020908152604080832083905560089091528120555b81600360008282546118339190611bf4565b9091555050505050565b61184682611896565b505073ffffffffffffffffffffffffffffffffffffffff82166000908152602081905260408120805483929061187d908490611c07565b9250508190555080600360008282546109d59190611c07565b73ffffffffffffffffffffffffffffffffffffffff811660009081526020818152604080832054600654600590935290832054839283916118d89184916119d2565b73ffffffffffffffffffffffffffffffffffffffff861660009081526004602052604090205490915061190c908290611c07565b73ffffffffffffffffffffffffffffffffffffffff8616600090815260046020908152604080832084905560065460058352818420556009546008909252822054929650909161195d9185916119d2565b73ffffffffffffffffffffffffffffffffffffffff8716600090815260076020526040902054909150611991908290611c07565b73ffffffffffffffffffffffffffffffffffffffff909616600090815260076020908152604080832089905560095460089092529091205550929492505050565b6000836000036119e45750600061085d565b633b9aca00846119f48486611bf4565b6119fe9190611c3c565b611a089190611c53565b949350505050565b600060208083528351808285015260005b81811015611a3d57858101830151858201604001528201611a21565b5060006040828601015260407fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe0601f8301168501019250505092915050565b803573ffffffffffffffffffffffffffffffffffffffff81168114611aa057600080fd5b919050565b60008060408385031215611ab857600080fd5b611ac183611a7c565b946020939093013593505050565b600060208284031215611ae157600080fd5b5035919050565b600080600060608486031215611afd57600080fd5b611b0684611a7c565b9250611b1460208501611a7c565b9150604084013590509250925092565b600060208284031215611b3657600080fd5b61085d82611a7c565b60008060408385031215611b5257600080fd5b611b5b83611a7c565b9150611b6960208401611a7c565b90509250929050565b600181811c90821680611b8657607f821691505b602082108103611bbf577f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b8181038181111561068957610689611bc5565b8082018082111561068957610689611bc5565b600060208284031215611c2c57600080fd5b8151801515811461085d57600080fd5b808202811582820484141761068957610689611bc5565b600082611c89577f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b50049056fea2646970667358221220c40cfeb7839f47ede12ce7d3fcaa82248ce59a08c7888588cde0dd0aa0aae0aa64736f6c63430008150033608060405234801561001057600080fd5b5060405161061038038061061083398101604081905261002f916101af565b806001600160a01b0316632f2c3f2e6040518163ffffffff1660e01b8152600401602060405180830381865afa15801561006d573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610091919061029f565b83111561009d57600080fd5b600c80546001600160a01b038088166001600160a01b031992831617909255600d805492871692909116919091179055600e8390556040516100e39083906020016102b8565b604051602081830303815290604052600a9081610100919061036e565b508160405160200161011291906102b8565b604051602081830303815290604052600b908161012f919061036e565b5050601080546001600160a01b031916331790555061042d92505050565b6001600160a01b038116811461016257600080fd5b50565b80516101708161014d565b919050565b634e487b7160e01b600052604160045260246000fd5b60005b838110156101a657818101518382015260200161018e565b50506000910152565b600080600080600060a086880312156101c757600080fd5b85516101d28161014d565b60208701519095506101e38161014d565b6040870151606088015191955093506001600160401b038082111561020757600080fd5b818801915088601f83011261021b57600080fd5b81518181111561022d5761022d610175565b604051601f8201601f19908116603f0116810190838211818310171561025557610255610175565b816040528281528b602084870101111561026e57600080fd5b61027f83602083016020880161018b565b809650505050505061029360808701610165565b90509295509295909350565b6000602082840312156102b157600080fd5b5051919050565b644c4e544e2d60d81b8152600082516102d881600585016020870161018b565b9190910160050192915050565b600181811c908216806102f957607f821691505b60208210810361031957634e487b7160e01b600052602260045260246000fd5b50919050565b601f82111561036957600081815260208120601f850160051c810160208610156103465750805b601f850160051c820191505b8181101561036557828155600101610352565b5050505b505050565b81516001600160401b0381111561038757610387610175565b61039b8161039584546102e5565b8461031f565b602080601f8311600181146103d057600084156103b85750858301515b600019600386901b1c1916600185901b178555610365565b600085815260208120601f198616915b828110156103ff578886015182559484019460019091019084016103e0565b508582101561041d5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b6101d48061043c6000396000f3fe60806040523661001b57610019610014610022565b61013e565b005b6100196100145b600080601060009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff166396f18a326040518163ffffffff1660e01b8152600401602060405180830381865afa158015610092573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906100b69190610161565b905073ffffffffffffffffffffffffffffffffffffffff8116610139576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152601d60248201527f6c6971756964206c6f67696320636f6e7472616374206e6f7420736574000000604482015260640160405180910390fd5b919050565b3660008037600080366000845af43d6000803e8061015b573d6000fd5b503d6000f35b60006020828403121561017357600080fd5b815173ffffffffffffffffffffffffffffffffffffffff8116811461019757600080fd5b939250505056fea264697066735822122076b5b72323e3d9ea33f6c72d4f6af1b0947104c33397f7484304cd24721df42c64736f6c63430008150033")
 
 var AutonityUpgradeTestAbi, _ = abi.JSON(strings.NewReader(`[
    {
@@ -212,6 +212,62 @@ var AutonityUpgradeTestAbi, _ = abi.JSON(strings.NewReader(`[
       "name" : "CommissionRateChange",
       "type" : "event"
    },
+   {
+      "anonymous" : false,
+      "inputs" : [
+         {
+            "components" : [
+               {
+                  "internalType" : "uint256",
+                  "name" : "proposeInitial",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "proposeDelta",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "prevoteInitial",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "prevoteDelta",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "precommitInitial",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "precommitDelta",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "bool",
+                  "name" : "adaptive",
+                  "type" : "bool"
+               }
+            ],
+            "indexed" : false,
+            "internalType" : "struct Autonity.ConsensusTimeouts",
+            "name" : "timeouts",
+            "type" : "tuple"
+         },
+         {
+            "indexed" : false,
+            "internalType" : "uint256",
+            "name" : "toBeAppliedAtBlock",
+            "type" : "uint256"
+         }
+      ],
+      "name" : "ConsensusTimeoutsUpdated",
+      "type" : "event"
+   },
    {
       "anonymous" : false,
       "inputs" : [
@@ -1048,6 +1104,103 @@ var AutonityUpgradeTestAbi, _ = abi.JSON(strings.NewReader(`[
       "stateMutability" : "view",
       "type" : "function"
    },
+   {
+      "inputs" : [],
+      "name" : "getConsensusTimeouts",
+      "outputs" : [
+         {
+            "components" : [
+               {
+                  "internalType" : "uint256",
+                  "name" : "proposeInitial",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "proposeDelta",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "prevoteInitial",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "prevoteDelta",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "precommitInitial",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "precommitDelta",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "bool",
+                  "name" : "adaptive",
+                  "type" : "bool"
+               }
+            ],
+            "internalType" : "struct Autonity.ConsensusTimeouts",
+            "name" : "",
+            "type" : "tuple"
+         },
+         {
+            "components" : [
+               {
+                  "internalType" : "uint256",
+                  "name" : "proposeInitial",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "proposeDelta",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "prevoteInitial",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "prevoteDelta",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "precommitInitial",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "precommitDelta",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "bool",
+                  "name" : "adaptive",
+                  "type" : "bool"
+               }
+            ],
+            "internalType" : "struct Autonity.ConsensusTimeouts",
+            "name" : "",
+            "type" : "tuple"
+         },
+         {
+            "internalType" : "uint256",
+            "name" : "",
+            "type" : "uint256"
+         }
+      ],
+      "stateMutability" : "view",
+      "type" : "function"
+   },
    {
       "inputs" : [
          {
@@ -1349,6 +1502,72 @@ var AutonityUpgradeTestAbi, _ = abi.JSON(strings.NewReader(`[
       "stateMutability" : "view",
       "type" : "function"
    },
+   {
+      "inputs" : [
+         {
+            "internalType" : "uint256",
+            "name" : "_unbondingID",
+            "type" : "uint256"
+         }
+      ],
+      "name" : "getUnbondingRequest",
+      "outputs" : [
+         {
+            "components" : [
+               {
+                  "internalType" : "address payable",
+                  "name" : "delegator",
+                  "type" : "address"
+               },
+               {
+                  "internalType" : "address",
+                  "name" : "delegatee",
+                  "type" : "address"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "amount",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "unbondingShare",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "requestBlock",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "revertingAmount",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "enum Autonity.UnbondingReleaseState",
+                  "name" : "state",
+                  "type" : "uint8"
+               },
+               {
+                  "internalType" : "bool",
+                  "name" : "unlocked",
+                  "type" : "bool"
+               },
+               {
+                  "internalType" : "bool",
+                  "name" : "selfDelegation",
+                  "type" : "bool"
+               }
+            ],
+            "internalType" : "struct Autonity.UnbondingRequest",
+            "name" : "",
+            "type" : "tuple"
+         }
+      ],
+      "stateMutability" : "view",
+      "type" : "function"
+   },
    {
       "inputs" : [
          {
@@ -1725,6 +1944,56 @@ var AutonityUpgradeTestAbi, _ = abi.JSON(strings.NewReader(`[
       "stateMutability" : "nonpayable",
       "type" : "function"
    },
+   {
+      "inputs" : [
+         {
+            "components" : [
+               {
+                  "internalType" : "uint256",
+                  "name" : "proposeInitial",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "proposeDelta",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "prevoteInitial",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "prevoteDelta",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "precommitInitial",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "precommitDelta",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "bool",
+                  "name" : "adaptive",
+                  "type" : "bool"
+               }
+            ],
+            "internalType" : "struct Autonity.ConsensusTimeouts",
+            "name" : "_timeouts",
+            "type" : "tuple"
+         }
+      ],
+      "name" : "setConsensusTimeouts",
+      "outputs" : [],
+      "stateMutability" : "nonpayable",
+      "type" : "function"
+   },
    {
       "inputs" : [
          {
@@ -283,7 +283,8 @@ func (v *Validator) Validate() error {
 		return errors.New("enode must be specified")
 	}
 
-	if v.BondedStake == nil || v.BondedStake.Cmp(new(big.Int)) == 0 {
+	// a validator may be registered with delegated stake only, bonded from the genesis allocation
+	if v.BondedStake == nil || v.BondedStake.Sign() < 0 {
 		return errors.New("bonded stake must be specified")
 	}
 	nodeAddr, err := v.AddressFromEnode()
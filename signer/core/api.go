@@ -31,10 +31,13 @@ import (
 	"github.com/autonity/autonity/accounts/usbwallet"
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/common/hexutil"
+	"github.com/autonity/autonity/consensus/tendermint/core/message"
+	"github.com/autonity/autonity/crypto/blst"
 	"github.com/autonity/autonity/internal/ethapi"
 	"github.com/autonity/autonity/log"
 	"github.com/autonity/autonity/rpc"
 	"github.com/autonity/autonity/signer/core/apitypes"
+	"github.com/autonity/autonity/signer/slashing"
 	"github.com/autonity/autonity/signer/storage"
 )
 
@@ -42,9 +45,9 @@ const (
 	// numberOfAccountsToDerive For hardware wallets, the number of accounts to derive
 	numberOfAccountsToDerive = 10
 	// ExternalAPIVersion -- see extapi_changelog.md
-	ExternalAPIVersion = "6.1.0"
+	ExternalAPIVersion = "6.2.0"
 	// InternalAPIVersion -- see intapi_changelog.md
-	InternalAPIVersion = "7.0.1"
+	InternalAPIVersion = "7.1.0"
 )
 
 // ExternalAPI defines the external API through which signing requests are made.
@@ -65,6 +68,12 @@ type ExternalAPI interface {
 	Version(ctx context.Context) (string, error)
 	// SignGnosisSafeTransaction signs/confirms a gnosis-safe multisig transaction
 	SignGnosisSafeTx(ctx context.Context, signerAddress common.MixedcaseAddress, gnosisTx GnosisSafeTx, methodSelector *string) (*GnosisSafeTx, error)
+	// ConsensusKey returns the public consensus key of the validator
+	ConsensusKey(ctx context.Context) (hexutil.Bytes, error)
+	// SignConsensusMessage signs a consensus message with the consensus key, unless it would equivocate
+	SignConsensusMessage(ctx context.Context, request message.SignatureRequest) (hexutil.Bytes, error)
+	// SignOwnershipProof signs the ownership proof of the consensus key for the given treasury
+	SignOwnershipProof(ctx context.Context, treasury common.Address) (hexutil.Bytes, error)
 }
 
 // UIClientAPI specifies what method a UI needs to implement to be able to be used as a
@@ -74,6 +83,8 @@ type UIClientAPI interface {
 	ApproveTx(request *SignTxRequest) (SignTxResponse, error)
 	// ApproveSignData prompt the user for confirmation to request to sign data
 	ApproveSignData(request *SignDataRequest) (SignDataResponse, error)
+	// ApproveSignConsensus prompt the user for confirmation to request to sign with the consensus key
+	ApproveSignConsensus(request *SignConsensusRequest) (SignDataResponse, error)
 	// ApproveListing prompt the user for confirmation to list accounts
 	// the list of accounts to list can be modified by the UI
 	ApproveListing(request *ListRequest) (ListResponse, error)
@@ -117,6 +128,9 @@ type SignerAPI struct {
 	validator   Validator
 	rejectMode  bool
 	credentials storage.Storage
+
+	consensusKey      blst.SecretKey     // consensus key of the validator, nil if consensus signing is disabled
+	slashingProtector *slashing.Database // records the consensus messages signed with consensusKey
 }
 
 // Metadata about a request
@@ -245,6 +259,15 @@ type (
 	SignDataResponse struct {
 		Approved bool `json:"approved"`
 	}
+	// SignConsensusRequest contains info about a consensus message or an ownership proof to sign with the
+	// consensus key. Only one of Message and Treasury is set.
+	SignConsensusRequest struct {
+		ConsensusKey hexutil.Bytes             `json:"consensus_key"`
+		Message      *message.SignatureRequest `json:"message,omitempty"`
+		Treasury     *common.Address           `json:"treasury,omitempty"`
+		Hash         hexutil.Bytes             `json:"hash"`
+		Meta         Metadata                  `json:"meta"`
+	}
 	NewAccountRequest struct {
 		Meta Metadata `json:"meta"`
 	}
@@ -285,7 +308,7 @@ func NewSignerAPI(am *accounts.Manager, chainID int64, noUSB bool, ui UIClientAP
 	if advancedMode {
 		log.Info("Clef is in advanced mode: will warn instead of reject")
 	}
-	signer := &SignerAPI{big.NewInt(chainID), am, ui, validator, !advancedMode, credentials}
+	signer := &SignerAPI{big.NewInt(chainID), am, ui, validator, !advancedMode, credentials, nil, nil}
 	if !noUSB {
 		signer.startUSBListener()
 	}
@@ -19,6 +19,7 @@ package core_test
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"math/big"
@@ -31,12 +32,17 @@ import (
 	"github.com/autonity/autonity/accounts/keystore"
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/common/hexutil"
+	"github.com/autonity/autonity/consensus/tendermint/core/message"
+	"github.com/autonity/autonity/core/rawdb"
 	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/crypto/blst"
 	"github.com/autonity/autonity/internal/ethapi"
 	"github.com/autonity/autonity/rlp"
 	"github.com/autonity/autonity/signer/core"
 	"github.com/autonity/autonity/signer/core/apitypes"
 	"github.com/autonity/autonity/signer/fourbyte"
+	"github.com/autonity/autonity/signer/slashing"
 	"github.com/autonity/autonity/signer/storage"
 )
 
@@ -76,6 +82,11 @@ func (ui *headlessUi) ApproveSignData(request *core.SignDataRequest) (core.SignD
 	return core.SignDataResponse{approved}, nil
 }
 
+func (ui *headlessUi) ApproveSignConsensus(request *core.SignConsensusRequest) (core.SignDataResponse, error) {
+	approved := (<-ui.approveCh == "Y")
+	return core.SignDataResponse{approved}, nil
+}
+
 func (ui *headlessUi) ApproveListing(request *core.ListRequest) (core.ListResponse, error) {
 	approval := <-ui.approveCh
 	//fmt.Printf("approval %s\n", approval)
@@ -327,3 +338,65 @@ func TestSignTx(t *testing.T) {
 	}
 
 }
+
+func TestSignConsensusMessage(t *testing.T) {
+	api, control := setup(t)
+	request := message.SignatureRequest{Code: message.PrevoteCode, Height: 5, Round: 0, ValidRound: -1, Value: common.Hash{0x01}}
+	if _, err := api.SignConsensusMessage(context.Background(), request); err != core.ErrNoConsensusKey {
+		t.Fatalf("expected %v, got %v", core.ErrNoConsensusKey, err)
+	}
+
+	consensusKey, err := blst.RandKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	api.EnableConsensusSigning(consensusKey, slashing.New(rawdb.NewMemoryDatabase()))
+	key, err := api.ConsensusKey(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(key, consensusKey.PublicKey().Marshal()) {
+		t.Fatalf("wrong consensus key: %x", key)
+	}
+
+	// a denied request must not be recorded in the slashing protection database
+	control.approveCh <- "N"
+	equivocation := request
+	equivocation.Value = common.Hash{0x02}
+	if _, err := api.SignConsensusMessage(context.Background(), equivocation); err != core.ErrRequestDenied {
+		t.Fatalf("expected %v, got %v", core.ErrRequestDenied, err)
+	}
+
+	control.approveCh <- "Y"
+	res, err := api.SignConsensusMessage(context.Background(), request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signature, err := blst.SignatureFromBytes(res)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signatureInput := message.VoteSignatureInput(request.Height, uint64(request.Round), request.Code, request.Value)
+	if !signature.Verify(consensusKey.PublicKey(), signatureInput[:]) {
+		t.Fatal("invalid consensus message signature")
+	}
+
+	control.approveCh <- "Y"
+	if _, err := api.SignConsensusMessage(context.Background(), equivocation); !errors.Is(err, slashing.ErrSlashable) {
+		t.Fatalf("expected %v, got %v", slashing.ErrSlashable, err)
+	}
+
+	treasury := common.HexToAddress("0x850c1eb8d190e05845ad7f84ac95a318c8aab07f")
+	control.approveCh <- "Y"
+	proof, err := api.SignOwnershipProof(context.Background(), treasury)
+	if err != nil {
+		t.Fatal(err)
+	}
+	popSignature, err := blst.SignatureFromBytes(proof)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := crypto.BLSPOPVerify(consensusKey.PublicKey(), popSignature, treasury.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/common/hexutil"
+	"github.com/autonity/autonity/consensus/tendermint/core/message"
 	"github.com/autonity/autonity/internal/ethapi"
 	"github.com/autonity/autonity/log"
 	"github.com/autonity/autonity/signer/core/apitypes"
@@ -71,6 +72,26 @@ func (l *AuditLogger) SignData(ctx context.Context, contentType string, addr com
 	return b, e
 }
 
+func (l *AuditLogger) ConsensusKey(ctx context.Context) (hexutil.Bytes, error) {
+	return l.api.ConsensusKey(ctx)
+}
+
+func (l *AuditLogger) SignConsensusMessage(ctx context.Context, request message.SignatureRequest) (hexutil.Bytes, error) {
+	l.log.Info("SignConsensusMessage", "type", "request", "metadata", MetadataFromContext(ctx).String(),
+		"height", request.Height, "round", request.Round, "step", request.Step(), "validRound", request.ValidRound, "value", request.Value)
+	b, e := l.api.SignConsensusMessage(ctx, request)
+	l.log.Info("SignConsensusMessage", "type", "response", "data", common.Bytes2Hex(b), "error", e)
+	return b, e
+}
+
+func (l *AuditLogger) SignOwnershipProof(ctx context.Context, treasury common.Address) (hexutil.Bytes, error) {
+	l.log.Info("SignOwnershipProof", "type", "request", "metadata", MetadataFromContext(ctx).String(),
+		"treasury", treasury.String())
+	b, e := l.api.SignOwnershipProof(ctx, treasury)
+	l.log.Info("SignOwnershipProof", "type", "response", "data", common.Bytes2Hex(b), "error", e)
+	return b, e
+}
+
 func (l *AuditLogger) SignGnosisSafeTx(ctx context.Context, addr common.MixedcaseAddress, gnosisTx GnosisSafeTx, methodSelector *string) (*GnosisSafeTx, error) {
 	sel := "<nil>"
 	if methodSelector != nil {
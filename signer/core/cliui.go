@@ -25,6 +25,7 @@ import (
 	"sync"
 
 	"github.com/autonity/autonity/common/hexutil"
+	"github.com/autonity/autonity/consensus/tendermint/core/message"
 	"github.com/autonity/autonity/console/prompt"
 	"github.com/autonity/autonity/internal/ethapi"
 	"github.com/autonity/autonity/log"
@@ -186,6 +187,34 @@ func (ui *CommandlineUI) ApproveSignData(request *SignDataRequest) (SignDataResp
 	return SignDataResponse{true}, nil
 }
 
+// ApproveSignConsensus prompt the user for confirmation to request to sign with the consensus key
+func (ui *CommandlineUI) ApproveSignConsensus(request *SignConsensusRequest) (SignDataResponse, error) {
+	ui.mu.Lock()
+	defer ui.mu.Unlock()
+
+	fmt.Printf("-------- Sign consensus request--------------\n")
+	fmt.Printf("Consensus key:  %v\n", request.ConsensusKey)
+	if request.Message != nil {
+		fmt.Printf("step:   %s\n", request.Message.Step())
+		fmt.Printf("height: %d\n", request.Message.Height)
+		fmt.Printf("round:  %d\n", request.Message.Round)
+		if request.Message.Code == message.ProposalCode {
+			fmt.Printf("valid round: %d\n", request.Message.ValidRound)
+		}
+		fmt.Printf("value:  %v\n", request.Message.Value)
+	}
+	if request.Treasury != nil {
+		fmt.Printf("ownership proof for treasury: %v\n", request.Treasury)
+	}
+	fmt.Printf("hash:   %v\n", request.Hash)
+	fmt.Printf("-------------------------------------------\n")
+	showMetadata(request.Meta)
+	if !ui.confirm() {
+		return SignDataResponse{false}, nil
+	}
+	return SignDataResponse{true}, nil
+}
+
 // ApproveListing prompt the user for confirmation to list accounts
 // the list of accounts to list can be modified by the UI
 func (ui *CommandlineUI) ApproveListing(request *ListRequest) (ListResponse, error) {
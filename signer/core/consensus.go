@@ -0,0 +1,86 @@
+package core
+
+import (
+	"context"
+	"errors"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/common/hexutil"
+	"github.com/autonity/autonity/consensus/tendermint/core/message"
+	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/crypto/blst"
+	"github.com/autonity/autonity/log"
+	"github.com/autonity/autonity/signer/slashing"
+)
+
+// ErrNoConsensusKey is returned by the consensus signing methods when no consensus key was loaded.
+var ErrNoConsensusKey = errors.New("no consensus key configured")
+
+// EnableConsensusSigning makes the consensus key available for signing consensus messages and ownership proofs.
+// Every consensus message is checked against the slashing protection database before being signed.
+func (api *SignerAPI) EnableConsensusSigning(consensusKey blst.SecretKey, protector *slashing.Database) {
+	api.consensusKey = consensusKey
+	api.slashingProtector = protector
+}
+
+// ConsensusKey returns the public consensus key of the validator.
+func (api *SignerAPI) ConsensusKey(ctx context.Context) (hexutil.Bytes, error) {
+	if api.consensusKey == nil {
+		return nil, ErrNoConsensusKey
+	}
+	return api.consensusKey.PublicKey().Marshal(), nil
+}
+
+// SignConsensusMessage signs a proposal, prevote or precommit with the consensus key. The request is refused if
+// another message was signed before for the same height, round and step.
+func (api *SignerAPI) SignConsensusMessage(ctx context.Context, request message.SignatureRequest) (hexutil.Bytes, error) {
+	if api.consensusKey == nil {
+		return nil, ErrNoConsensusKey
+	}
+	signatureInput, err := request.SignatureInput()
+	if err != nil {
+		return nil, err
+	}
+	req := &SignConsensusRequest{
+		ConsensusKey: api.consensusKey.PublicKey().Marshal(),
+		Message:      &request,
+		Hash:         signatureInput.Bytes(),
+		Meta:         MetadataFromContext(ctx),
+	}
+	res, err := api.UI.ApproveSignConsensus(req)
+	if err != nil {
+		return nil, err
+	}
+	if !res.Approved {
+		return nil, ErrRequestDenied
+	}
+	// the message is recorded only once approved, otherwise a denied request would prevent signing the legit one.
+	if _, err := api.slashingProtector.CheckAndRecord(&request); err != nil {
+		log.Warn("Refusing to sign consensus message", "height", request.Height, "round", request.Round, "step", request.Step(), "err", err)
+		api.UI.ShowError(err.Error())
+		return nil, err
+	}
+	return api.consensusKey.Sign(signatureInput.Bytes()).Marshal(), nil
+}
+
+// SignOwnershipProof signs the proof of possession of the consensus key which is required to register a validator
+// with the given treasury.
+func (api *SignerAPI) SignOwnershipProof(ctx context.Context, treasury common.Address) (hexutil.Bytes, error) {
+	if api.consensusKey == nil {
+		return nil, ErrNoConsensusKey
+	}
+	req := &SignConsensusRequest{
+		ConsensusKey: api.consensusKey.PublicKey().Marshal(),
+		Treasury:     &treasury,
+		Hash:         crypto.Hash(append(treasury.Bytes(), api.consensusKey.PublicKey().Marshal()...)).Bytes(),
+		Meta:         MetadataFromContext(ctx),
+	}
+	res, err := api.UI.ApproveSignConsensus(req)
+	if err != nil {
+		return nil, err
+	}
+	if !res.Approved {
+		return nil, ErrRequestDenied
+	}
+	return crypto.BLSPOPProof(api.consensusKey, treasury.Bytes())
+}
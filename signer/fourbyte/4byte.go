@@ -1,6 +1,6 @@
 // Code generated by go-bindata. DO NOT EDIT.
 // sources:
-// 4byte.json (5.7kB)
+// 4byte.json (5.9kB)
 
 package fourbyte
 
@@ -185,7 +185,10 @@ var __4byteJson = []byte(`{
   "a8920241": "unbondingApplied(uint256,address,bool)",
   "3c54c290": "unbondingReleased(uint256,uint256,bool)",
   "187cf4d7": "FEE_FACTOR_UNIT_RECIP()",
-  "affb1cf1": "getEpochByHeight(uint256)"
+  "affb1cf1": "getEpochByHeight(uint256)",
+  "6be0487b": "getConsensusTimeouts()",
+  "8d7835ea": "setConsensusTimeouts((uint256,uint256,uint256,uint256,uint256,uint256,bool))",
+  "30bcb81c": "getUnbondingRequest(uint256)"
 }
 `)
 
@@ -200,7 +203,7 @@ func _4byteJson() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "4byte.json", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xc2, 0x1, 0x14, 0xd4, 0x4f, 0xaf, 0x4b, 0xb8, 0x90, 0x9e, 0x10, 0x20, 0x37, 0xea, 0x8e, 0x4b, 0xc4, 0x47, 0x2a, 0xe1, 0xf1, 0x6a, 0x72, 0xe8, 0xad, 0x5d, 0xd9, 0xee, 0xb0, 0x1f, 0x3, 0xe1}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x3b, 0x69, 0x1, 0x66, 0xf3, 0x2c, 0xd6, 0xc3, 0xd7, 0x8c, 0x53, 0x31, 0x51, 0xa5, 0x1b, 0xb7, 0xe7, 0xb7, 0xcd, 0xb3, 0xf5, 0x16, 0xae, 0x1c, 0x8f, 0xd3, 0x86, 0x16, 0x3e, 0x6b, 0x31, 0xe8}}
 	return a, nil
 }
 
@@ -0,0 +1,82 @@
+// Package slashing implements the slashing protection database used by clef when signing consensus messages with the
+// consensus key of a validator. It remembers the signature input of every message signed for a given height, round
+// and step, and refuses to sign a different one for the same height, round and step, as it would be an equivocation
+// punished by the accountability protocol.
+package slashing
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/consensus/tendermint/core/message"
+	"github.com/autonity/autonity/ethdb"
+)
+
+// ErrSlashable is returned when signing the request would equivocate with a message signed previously.
+var ErrSlashable = errors.New("slashable signature request")
+
+var signedPrefix = []byte("s") // signedPrefix + height (uint64 big endian) + round (uint64 big endian) + code -> signature input
+
+// Database is a slashing protection database.
+type Database struct {
+	db   ethdb.KeyValueStore
+	lock sync.Mutex
+}
+
+// New creates a slashing protection database on top of the given key-value store.
+func New(db ethdb.KeyValueStore) *Database {
+	return &Database{db: db}
+}
+
+func signedKey(request *message.SignatureRequest) []byte {
+	key := make([]byte, len(signedPrefix)+8+8+1)
+	copy(key, signedPrefix)
+	binary.BigEndian.PutUint64(key[len(signedPrefix):], request.Height)
+	binary.BigEndian.PutUint64(key[len(signedPrefix)+8:], uint64(request.Round))
+	key[len(key)-1] = request.Code
+	return key
+}
+
+// CheckAndRecord checks that signing the request does not conflict with a message signed earlier for the same height,
+// round and step, and records it. Signing the exact same message again is allowed. It returns the signature input of
+// the request.
+func (d *Database) CheckAndRecord(request *message.SignatureRequest) (common.Hash, error) {
+	if request.Round < 0 {
+		return common.Hash{}, fmt.Errorf("invalid round %d", request.Round)
+	}
+	signatureInput, err := request.SignatureInput()
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	key := signedKey(request)
+	has, err := d.db.Has(key)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if has {
+		signed, err := d.db.Get(key)
+		if err != nil {
+			return common.Hash{}, err
+		}
+		if common.BytesToHash(signed) != signatureInput {
+			return common.Hash{}, fmt.Errorf("%w: already signed another %s at height %d round %d", ErrSlashable, request.Step(), request.Height, request.Round)
+		}
+		return signatureInput, nil
+	}
+	if err := d.db.Put(key, signatureInput.Bytes()); err != nil {
+		return common.Hash{}, err
+	}
+	return signatureInput, nil
+}
+
+// Close closes the underlying key-value store.
+func (d *Database) Close() error {
+	return d.db.Close()
+}
@@ -0,0 +1,53 @@
+package slashing
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/consensus/tendermint/core/message"
+	"github.com/autonity/autonity/core/rawdb"
+)
+
+func TestCheckAndRecord(t *testing.T) {
+	db := New(rawdb.NewMemoryDatabase())
+	prevote := &message.SignatureRequest{Code: message.PrevoteCode, Height: 10, Round: 1, ValidRound: -1, Value: common.Hash{0x01}}
+	if _, err := db.CheckAndRecord(prevote); err != nil {
+		t.Fatalf("first prevote refused: %v", err)
+	}
+	// signing the same message again is harmless
+	if _, err := db.CheckAndRecord(prevote); err != nil {
+		t.Fatalf("identical prevote refused: %v", err)
+	}
+	// a different value for the same height, round and step is an equivocation
+	equivocation := *prevote
+	equivocation.Value = common.Hash{0x02}
+	if _, err := db.CheckAndRecord(&equivocation); !errors.Is(err, ErrSlashable) {
+		t.Fatalf("equivocating prevote: have %v, want %v", err, ErrSlashable)
+	}
+	// other steps and rounds are independent
+	precommit := *prevote
+	precommit.Code = message.PrecommitCode
+	precommit.Value = common.Hash{0x02}
+	if _, err := db.CheckAndRecord(&precommit); err != nil {
+		t.Fatalf("precommit refused: %v", err)
+	}
+	nextRound := equivocation
+	nextRound.Round = 2
+	if _, err := db.CheckAndRecord(&nextRound); err != nil {
+		t.Fatalf("next round prevote refused: %v", err)
+	}
+	// a proposal with another valid round is a different message
+	proposal := &message.SignatureRequest{Code: message.ProposalCode, Height: 10, Round: 1, ValidRound: -1, Value: common.Hash{0x01}}
+	if _, err := db.CheckAndRecord(proposal); err != nil {
+		t.Fatalf("proposal refused: %v", err)
+	}
+	proposal.ValidRound = 0
+	if _, err := db.CheckAndRecord(proposal); !errors.Is(err, ErrSlashable) {
+		t.Fatalf("equivocating proposal: have %v, want %v", err, ErrSlashable)
+	}
+	// light proposals are never signed directly
+	if _, err := db.CheckAndRecord(&message.SignatureRequest{Code: message.LightProposalCode}); err == nil {
+		t.Fatal("light proposal accepted")
+	}
+}